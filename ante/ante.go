@@ -11,6 +11,7 @@ import (
 
 	beaconante "github.com/unification-com/mainchain/x/beacon/ante"
 	entante "github.com/unification-com/mainchain/x/enterprise/ante"
+	streamante "github.com/unification-com/mainchain/x/stream/ante"
 	wrkante "github.com/unification-com/mainchain/x/wrkchain/ante"
 )
 
@@ -22,6 +23,7 @@ type HandlerOptions struct {
 	BeaconKeeper     beaconante.BeaconKeeper
 	EnterpriseKeeper entante.EnterpriseKeeper
 	IBCKeeper        *ibckeeper.Keeper
+	StreamKeeper     streamante.StreamKeeper
 	WrkchainKeeper   wrkante.WrkchainKeeper
 }
 
@@ -51,6 +53,9 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.EnterpriseKeeper == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "ibc keeper is required for AnteHandler")
 	}
+	if options.StreamKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "stream keeper is required for AnteHandler")
+	}
 	if options.CircuitKeeper == nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "circuit keeper is required for AnteHandler")
 	}
@@ -66,6 +71,7 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		wrkante.NewCorrectWrkChainFeeDecorator(options.BK, options.AccountKeeper, options.WrkchainKeeper, options.EnterpriseKeeper), // WRKChain check Tx fees. Specifically check after MemPool, but before consuming fees/gas and undelegating locked FUND
 		beaconante.NewCorrectBeaconFeeDecorator(options.BK, options.AccountKeeper, options.BeaconKeeper, options.EnterpriseKeeper),  // BEACON check Tx fees. Specifically check after MemPool, but before consuming fees/gas and undelegating locked FUND
 		entante.NewCheckLockedUndDecorator(options.EnterpriseKeeper),                                                                // check for and unlock any locked FUND for valid WRKChain/BEACON Txs
+		streamante.NewClaimStreamFeeDecorator(options.BK, options.StreamKeeper),                                                     // pay fees for a receiver's stream claim Tx from the claim, if the receiver cannot
 		authante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
 		authante.NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		authante.NewValidateSigCountDecorator(options.AccountKeeper),
@@ -584,14 +584,15 @@ func (x *_EnterpriseUndPurchaseOrder_7_list) IsValid() bool {
 }
 
 var (
-	md_EnterpriseUndPurchaseOrder                 protoreflect.MessageDescriptor
-	fd_EnterpriseUndPurchaseOrder_id              protoreflect.FieldDescriptor
-	fd_EnterpriseUndPurchaseOrder_purchaser       protoreflect.FieldDescriptor
-	fd_EnterpriseUndPurchaseOrder_amount          protoreflect.FieldDescriptor
-	fd_EnterpriseUndPurchaseOrder_status          protoreflect.FieldDescriptor
-	fd_EnterpriseUndPurchaseOrder_raise_time      protoreflect.FieldDescriptor
-	fd_EnterpriseUndPurchaseOrder_completion_time protoreflect.FieldDescriptor
-	fd_EnterpriseUndPurchaseOrder_decisions       protoreflect.FieldDescriptor
+	md_EnterpriseUndPurchaseOrder                   protoreflect.MessageDescriptor
+	fd_EnterpriseUndPurchaseOrder_id                protoreflect.FieldDescriptor
+	fd_EnterpriseUndPurchaseOrder_purchaser         protoreflect.FieldDescriptor
+	fd_EnterpriseUndPurchaseOrder_amount            protoreflect.FieldDescriptor
+	fd_EnterpriseUndPurchaseOrder_status            protoreflect.FieldDescriptor
+	fd_EnterpriseUndPurchaseOrder_raise_time        protoreflect.FieldDescriptor
+	fd_EnterpriseUndPurchaseOrder_completion_time   protoreflect.FieldDescriptor
+	fd_EnterpriseUndPurchaseOrder_decisions         protoreflect.FieldDescriptor
+	fd_EnterpriseUndPurchaseOrder_efund_expiry_time protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -604,6 +605,7 @@ func init() {
 	fd_EnterpriseUndPurchaseOrder_raise_time = md_EnterpriseUndPurchaseOrder.Fields().ByName("raise_time")
 	fd_EnterpriseUndPurchaseOrder_completion_time = md_EnterpriseUndPurchaseOrder.Fields().ByName("completion_time")
 	fd_EnterpriseUndPurchaseOrder_decisions = md_EnterpriseUndPurchaseOrder.Fields().ByName("decisions")
+	fd_EnterpriseUndPurchaseOrder_efund_expiry_time = md_EnterpriseUndPurchaseOrder.Fields().ByName("efund_expiry_time")
 }
 
 var _ protoreflect.Message = (*fastReflection_EnterpriseUndPurchaseOrder)(nil)
@@ -713,6 +715,12 @@ func (x *fastReflection_EnterpriseUndPurchaseOrder) Range(f func(protoreflect.Fi
 			return
 		}
 	}
+	if x.EfundExpiryTime != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EfundExpiryTime)
+		if !f(fd_EnterpriseUndPurchaseOrder_efund_expiry_time, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -742,6 +750,8 @@ func (x *fastReflection_EnterpriseUndPurchaseOrder) Has(fd protoreflect.FieldDes
 		return x.CompletionTime != uint64(0)
 	case "mainchain.enterprise.v1.EnterpriseUndPurchaseOrder.decisions":
 		return len(x.Decisions) != 0
+	case "mainchain.enterprise.v1.EnterpriseUndPurchaseOrder.efund_expiry_time":
+		return x.EfundExpiryTime != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUndPurchaseOrder"))
@@ -772,6 +782,8 @@ func (x *fastReflection_EnterpriseUndPurchaseOrder) Clear(fd protoreflect.FieldD
 		x.CompletionTime = uint64(0)
 	case "mainchain.enterprise.v1.EnterpriseUndPurchaseOrder.decisions":
 		x.Decisions = nil
+	case "mainchain.enterprise.v1.EnterpriseUndPurchaseOrder.efund_expiry_time":
+		x.EfundExpiryTime = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUndPurchaseOrder"))
@@ -812,6 +824,9 @@ func (x *fastReflection_EnterpriseUndPurchaseOrder) Get(descriptor protoreflect.
 		}
 		listValue := &_EnterpriseUndPurchaseOrder_7_list{list: &x.Decisions}
 		return protoreflect.ValueOfList(listValue)
+	case "mainchain.enterprise.v1.EnterpriseUndPurchaseOrder.efund_expiry_time":
+		value := x.EfundExpiryTime
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUndPurchaseOrder"))
@@ -848,6 +863,8 @@ func (x *fastReflection_EnterpriseUndPurchaseOrder) Set(fd protoreflect.FieldDes
 		lv := value.List()
 		clv := lv.(*_EnterpriseUndPurchaseOrder_7_list)
 		x.Decisions = *clv.list
+	case "mainchain.enterprise.v1.EnterpriseUndPurchaseOrder.efund_expiry_time":
+		x.EfundExpiryTime = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUndPurchaseOrder"))
@@ -889,6 +906,8 @@ func (x *fastReflection_EnterpriseUndPurchaseOrder) Mutable(fd protoreflect.Fiel
 		panic(fmt.Errorf("field raise_time of message mainchain.enterprise.v1.EnterpriseUndPurchaseOrder is not mutable"))
 	case "mainchain.enterprise.v1.EnterpriseUndPurchaseOrder.completion_time":
 		panic(fmt.Errorf("field completion_time of message mainchain.enterprise.v1.EnterpriseUndPurchaseOrder is not mutable"))
+	case "mainchain.enterprise.v1.EnterpriseUndPurchaseOrder.efund_expiry_time":
+		panic(fmt.Errorf("field efund_expiry_time of message mainchain.enterprise.v1.EnterpriseUndPurchaseOrder is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUndPurchaseOrder"))
@@ -918,6 +937,8 @@ func (x *fastReflection_EnterpriseUndPurchaseOrder) NewField(fd protoreflect.Fie
 	case "mainchain.enterprise.v1.EnterpriseUndPurchaseOrder.decisions":
 		list := []*PurchaseOrderDecision{}
 		return protoreflect.ValueOfList(&_EnterpriseUndPurchaseOrder_7_list{list: &list})
+	case "mainchain.enterprise.v1.EnterpriseUndPurchaseOrder.efund_expiry_time":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUndPurchaseOrder"))
@@ -1013,6 +1034,9 @@ func (x *fastReflection_EnterpriseUndPurchaseOrder) ProtoMethods() *protoiface.M
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.EfundExpiryTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.EfundExpiryTime))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1042,6 +1066,11 @@ func (x *fastReflection_EnterpriseUndPurchaseOrder) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.EfundExpiryTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EfundExpiryTime))
+			i--
+			dAtA[i] = 0x40
+		}
 		if len(x.Decisions) > 0 {
 			for iNdEx := len(x.Decisions) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Decisions[iNdEx])
@@ -1326,6 +1355,25 @@ func (x *fastReflection_EnterpriseUndPurchaseOrder) ProtoMethods() *protoiface.M
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EfundExpiryTime", wireType)
+				}
+				x.EfundExpiryTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EfundExpiryTime |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2355,27 +2403,35 @@ func (x *fastReflection_LockedUnd) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_SpentEFUND        protoreflect.MessageDescriptor
-	fd_SpentEFUND_owner  protoreflect.FieldDescriptor
-	fd_SpentEFUND_amount protoreflect.FieldDescriptor
+	md_LockedEFUNDLot                   protoreflect.MessageDescriptor
+	fd_LockedEFUNDLot_id                protoreflect.FieldDescriptor
+	fd_LockedEFUNDLot_owner             protoreflect.FieldDescriptor
+	fd_LockedEFUNDLot_purchase_order_id protoreflect.FieldDescriptor
+	fd_LockedEFUNDLot_amount            protoreflect.FieldDescriptor
+	fd_LockedEFUNDLot_locked_at         protoreflect.FieldDescriptor
+	fd_LockedEFUNDLot_expiry_time       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mainchain_enterprise_v1_enterprise_proto_init()
-	md_SpentEFUND = File_mainchain_enterprise_v1_enterprise_proto.Messages().ByName("SpentEFUND")
-	fd_SpentEFUND_owner = md_SpentEFUND.Fields().ByName("owner")
-	fd_SpentEFUND_amount = md_SpentEFUND.Fields().ByName("amount")
+	md_LockedEFUNDLot = File_mainchain_enterprise_v1_enterprise_proto.Messages().ByName("LockedEFUNDLot")
+	fd_LockedEFUNDLot_id = md_LockedEFUNDLot.Fields().ByName("id")
+	fd_LockedEFUNDLot_owner = md_LockedEFUNDLot.Fields().ByName("owner")
+	fd_LockedEFUNDLot_purchase_order_id = md_LockedEFUNDLot.Fields().ByName("purchase_order_id")
+	fd_LockedEFUNDLot_amount = md_LockedEFUNDLot.Fields().ByName("amount")
+	fd_LockedEFUNDLot_locked_at = md_LockedEFUNDLot.Fields().ByName("locked_at")
+	fd_LockedEFUNDLot_expiry_time = md_LockedEFUNDLot.Fields().ByName("expiry_time")
 }
 
-var _ protoreflect.Message = (*fastReflection_SpentEFUND)(nil)
+var _ protoreflect.Message = (*fastReflection_LockedEFUNDLot)(nil)
 
-type fastReflection_SpentEFUND SpentEFUND
+type fastReflection_LockedEFUNDLot LockedEFUNDLot
 
-func (x *SpentEFUND) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SpentEFUND)(x)
+func (x *LockedEFUNDLot) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LockedEFUNDLot)(x)
 }
 
-func (x *SpentEFUND) slowProtoReflect() protoreflect.Message {
+func (x *LockedEFUNDLot) slowProtoReflect() protoreflect.Message {
 	mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2387,43 +2443,43 @@ func (x *SpentEFUND) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SpentEFUND_messageType fastReflection_SpentEFUND_messageType
-var _ protoreflect.MessageType = fastReflection_SpentEFUND_messageType{}
+var _fastReflection_LockedEFUNDLot_messageType fastReflection_LockedEFUNDLot_messageType
+var _ protoreflect.MessageType = fastReflection_LockedEFUNDLot_messageType{}
 
-type fastReflection_SpentEFUND_messageType struct{}
+type fastReflection_LockedEFUNDLot_messageType struct{}
 
-func (x fastReflection_SpentEFUND_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SpentEFUND)(nil)
+func (x fastReflection_LockedEFUNDLot_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LockedEFUNDLot)(nil)
 }
-func (x fastReflection_SpentEFUND_messageType) New() protoreflect.Message {
-	return new(fastReflection_SpentEFUND)
+func (x fastReflection_LockedEFUNDLot_messageType) New() protoreflect.Message {
+	return new(fastReflection_LockedEFUNDLot)
 }
-func (x fastReflection_SpentEFUND_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SpentEFUND
+func (x fastReflection_LockedEFUNDLot_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockedEFUNDLot
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SpentEFUND) Descriptor() protoreflect.MessageDescriptor {
-	return md_SpentEFUND
+func (x *fastReflection_LockedEFUNDLot) Descriptor() protoreflect.MessageDescriptor {
+	return md_LockedEFUNDLot
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SpentEFUND) Type() protoreflect.MessageType {
-	return _fastReflection_SpentEFUND_messageType
+func (x *fastReflection_LockedEFUNDLot) Type() protoreflect.MessageType {
+	return _fastReflection_LockedEFUNDLot_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SpentEFUND) New() protoreflect.Message {
-	return new(fastReflection_SpentEFUND)
+func (x *fastReflection_LockedEFUNDLot) New() protoreflect.Message {
+	return new(fastReflection_LockedEFUNDLot)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SpentEFUND) Interface() protoreflect.ProtoMessage {
-	return (*SpentEFUND)(x)
+func (x *fastReflection_LockedEFUNDLot) Interface() protoreflect.ProtoMessage {
+	return (*LockedEFUNDLot)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2431,16 +2487,40 @@ func (x *fastReflection_SpentEFUND) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SpentEFUND) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_LockedEFUNDLot) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_LockedEFUNDLot_id, value) {
+			return
+		}
+	}
 	if x.Owner != "" {
 		value := protoreflect.ValueOfString(x.Owner)
-		if !f(fd_SpentEFUND_owner, value) {
+		if !f(fd_LockedEFUNDLot_owner, value) {
+			return
+		}
+	}
+	if x.PurchaseOrderId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PurchaseOrderId)
+		if !f(fd_LockedEFUNDLot_purchase_order_id, value) {
 			return
 		}
 	}
 	if x.Amount != nil {
 		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
-		if !f(fd_SpentEFUND_amount, value) {
+		if !f(fd_LockedEFUNDLot_amount, value) {
+			return
+		}
+	}
+	if x.LockedAt != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LockedAt)
+		if !f(fd_LockedEFUNDLot_locked_at, value) {
+			return
+		}
+	}
+	if x.ExpiryTime != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExpiryTime)
+		if !f(fd_LockedEFUNDLot_expiry_time, value) {
 			return
 		}
 	}
@@ -2457,17 +2537,25 @@ func (x *fastReflection_SpentEFUND) Range(f func(protoreflect.FieldDescriptor, p
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SpentEFUND) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_LockedEFUNDLot) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.SpentEFUND.owner":
+	case "mainchain.enterprise.v1.LockedEFUNDLot.id":
+		return x.Id != uint64(0)
+	case "mainchain.enterprise.v1.LockedEFUNDLot.owner":
 		return x.Owner != ""
-	case "mainchain.enterprise.v1.SpentEFUND.amount":
+	case "mainchain.enterprise.v1.LockedEFUNDLot.purchase_order_id":
+		return x.PurchaseOrderId != uint64(0)
+	case "mainchain.enterprise.v1.LockedEFUNDLot.amount":
 		return x.Amount != nil
+	case "mainchain.enterprise.v1.LockedEFUNDLot.locked_at":
+		return x.LockedAt != uint64(0)
+	case "mainchain.enterprise.v1.LockedEFUNDLot.expiry_time":
+		return x.ExpiryTime != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.SpentEFUND"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.LockedEFUNDLot"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.SpentEFUND does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.LockedEFUNDLot does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2477,17 +2565,25 @@ func (x *fastReflection_SpentEFUND) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SpentEFUND) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_LockedEFUNDLot) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.SpentEFUND.owner":
+	case "mainchain.enterprise.v1.LockedEFUNDLot.id":
+		x.Id = uint64(0)
+	case "mainchain.enterprise.v1.LockedEFUNDLot.owner":
 		x.Owner = ""
-	case "mainchain.enterprise.v1.SpentEFUND.amount":
+	case "mainchain.enterprise.v1.LockedEFUNDLot.purchase_order_id":
+		x.PurchaseOrderId = uint64(0)
+	case "mainchain.enterprise.v1.LockedEFUNDLot.amount":
 		x.Amount = nil
+	case "mainchain.enterprise.v1.LockedEFUNDLot.locked_at":
+		x.LockedAt = uint64(0)
+	case "mainchain.enterprise.v1.LockedEFUNDLot.expiry_time":
+		x.ExpiryTime = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.SpentEFUND"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.LockedEFUNDLot"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.SpentEFUND does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.LockedEFUNDLot does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2497,19 +2593,31 @@ func (x *fastReflection_SpentEFUND) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SpentEFUND) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LockedEFUNDLot) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mainchain.enterprise.v1.SpentEFUND.owner":
+	case "mainchain.enterprise.v1.LockedEFUNDLot.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.enterprise.v1.LockedEFUNDLot.owner":
 		value := x.Owner
 		return protoreflect.ValueOfString(value)
-	case "mainchain.enterprise.v1.SpentEFUND.amount":
+	case "mainchain.enterprise.v1.LockedEFUNDLot.purchase_order_id":
+		value := x.PurchaseOrderId
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.enterprise.v1.LockedEFUNDLot.amount":
 		value := x.Amount
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.enterprise.v1.LockedEFUNDLot.locked_at":
+		value := x.LockedAt
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.enterprise.v1.LockedEFUNDLot.expiry_time":
+		value := x.ExpiryTime
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.SpentEFUND"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.LockedEFUNDLot"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.SpentEFUND does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.LockedEFUNDLot does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2523,17 +2631,25 @@ func (x *fastReflection_SpentEFUND) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SpentEFUND) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_LockedEFUNDLot) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.SpentEFUND.owner":
+	case "mainchain.enterprise.v1.LockedEFUNDLot.id":
+		x.Id = value.Uint()
+	case "mainchain.enterprise.v1.LockedEFUNDLot.owner":
 		x.Owner = value.Interface().(string)
-	case "mainchain.enterprise.v1.SpentEFUND.amount":
+	case "mainchain.enterprise.v1.LockedEFUNDLot.purchase_order_id":
+		x.PurchaseOrderId = value.Uint()
+	case "mainchain.enterprise.v1.LockedEFUNDLot.amount":
 		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	case "mainchain.enterprise.v1.LockedEFUNDLot.locked_at":
+		x.LockedAt = value.Uint()
+	case "mainchain.enterprise.v1.LockedEFUNDLot.expiry_time":
+		x.ExpiryTime = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.SpentEFUND"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.LockedEFUNDLot"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.SpentEFUND does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.LockedEFUNDLot does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2547,48 +2663,64 @@ func (x *fastReflection_SpentEFUND) Set(fd protoreflect.FieldDescriptor, value p
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SpentEFUND) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LockedEFUNDLot) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.SpentEFUND.amount":
+	case "mainchain.enterprise.v1.LockedEFUNDLot.amount":
 		if x.Amount == nil {
 			x.Amount = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
-	case "mainchain.enterprise.v1.SpentEFUND.owner":
-		panic(fmt.Errorf("field owner of message mainchain.enterprise.v1.SpentEFUND is not mutable"))
+	case "mainchain.enterprise.v1.LockedEFUNDLot.id":
+		panic(fmt.Errorf("field id of message mainchain.enterprise.v1.LockedEFUNDLot is not mutable"))
+	case "mainchain.enterprise.v1.LockedEFUNDLot.owner":
+		panic(fmt.Errorf("field owner of message mainchain.enterprise.v1.LockedEFUNDLot is not mutable"))
+	case "mainchain.enterprise.v1.LockedEFUNDLot.purchase_order_id":
+		panic(fmt.Errorf("field purchase_order_id of message mainchain.enterprise.v1.LockedEFUNDLot is not mutable"))
+	case "mainchain.enterprise.v1.LockedEFUNDLot.locked_at":
+		panic(fmt.Errorf("field locked_at of message mainchain.enterprise.v1.LockedEFUNDLot is not mutable"))
+	case "mainchain.enterprise.v1.LockedEFUNDLot.expiry_time":
+		panic(fmt.Errorf("field expiry_time of message mainchain.enterprise.v1.LockedEFUNDLot is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.SpentEFUND"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.LockedEFUNDLot"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.SpentEFUND does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.LockedEFUNDLot does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SpentEFUND) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LockedEFUNDLot) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.SpentEFUND.owner":
+	case "mainchain.enterprise.v1.LockedEFUNDLot.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.enterprise.v1.LockedEFUNDLot.owner":
 		return protoreflect.ValueOfString("")
-	case "mainchain.enterprise.v1.SpentEFUND.amount":
+	case "mainchain.enterprise.v1.LockedEFUNDLot.purchase_order_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.enterprise.v1.LockedEFUNDLot.amount":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.enterprise.v1.LockedEFUNDLot.locked_at":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.enterprise.v1.LockedEFUNDLot.expiry_time":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.SpentEFUND"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.LockedEFUNDLot"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.SpentEFUND does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.LockedEFUNDLot does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SpentEFUND) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_LockedEFUNDLot) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.SpentEFUND", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.LockedEFUNDLot", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2596,7 +2728,7 @@ func (x *fastReflection_SpentEFUND) WhichOneof(d protoreflect.OneofDescriptor) p
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SpentEFUND) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_LockedEFUNDLot) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2607,7 +2739,7 @@ func (x *fastReflection_SpentEFUND) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SpentEFUND) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_LockedEFUNDLot) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2619,7 +2751,7 @@ func (x *fastReflection_SpentEFUND) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SpentEFUND) IsValid() bool {
+func (x *fastReflection_LockedEFUNDLot) IsValid() bool {
 	return x != nil
 }
 
@@ -2629,9 +2761,9 @@ func (x *fastReflection_SpentEFUND) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SpentEFUND) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_LockedEFUNDLot) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SpentEFUND)
+		x := input.Message.Interface().(*LockedEFUNDLot)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2643,14 +2775,26 @@ func (x *fastReflection_SpentEFUND) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
 		l = len(x.Owner)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.PurchaseOrderId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PurchaseOrderId))
+		}
 		if x.Amount != nil {
 			l = options.Size(x.Amount)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.LockedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.LockedAt))
+		}
+		if x.ExpiryTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryTime))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2661,7 +2805,7 @@ func (x *fastReflection_SpentEFUND) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SpentEFUND)
+		x := input.Message.Interface().(*LockedEFUNDLot)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2680,6 +2824,16 @@ func (x *fastReflection_SpentEFUND) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ExpiryTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryTime))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.LockedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LockedAt))
+			i--
+			dAtA[i] = 0x28
+		}
 		if x.Amount != nil {
 			encoded, err := options.Marshal(x.Amount)
 			if err != nil {
@@ -2692,14 +2846,24 @@ func (x *fastReflection_SpentEFUND) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
+		}
+		if x.PurchaseOrderId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PurchaseOrderId))
+			i--
+			dAtA[i] = 0x18
 		}
 		if len(x.Owner) > 0 {
 			i -= len(x.Owner)
 			copy(dAtA[i:], x.Owner)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -2712,7 +2876,7 @@ func (x *fastReflection_SpentEFUND) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SpentEFUND)
+		x := input.Message.Interface().(*LockedEFUNDLot)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2744,13 +2908,32 @@ func (x *fastReflection_SpentEFUND) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SpentEFUND: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockedEFUNDLot: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SpentEFUND: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LockedEFUNDLot: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 				}
@@ -2782,7 +2965,26 @@ func (x *fastReflection_SpentEFUND) ProtoMethods() *protoiface.Methods {
 				}
 				x.Owner = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PurchaseOrderId", wireType)
+				}
+				x.PurchaseOrderId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PurchaseOrderId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
@@ -2818,6 +3020,44 @@ func (x *fastReflection_SpentEFUND) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedAt", wireType)
+				}
+				x.LockedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LockedAt |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+				}
+				x.ExpiryTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryTime |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2853,82 +3093,3601 @@ func (x *fastReflection_SpentEFUND) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-var (
-	md_EnterpriseUserAccount                protoreflect.MessageDescriptor
-	fd_EnterpriseUserAccount_owner          protoreflect.FieldDescriptor
-	fd_EnterpriseUserAccount_locked_efund   protoreflect.FieldDescriptor
-	fd_EnterpriseUserAccount_general_supply protoreflect.FieldDescriptor
-	fd_EnterpriseUserAccount_spent_efund    protoreflect.FieldDescriptor
-	fd_EnterpriseUserAccount_spendable      protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_EFUNDLedgerEntry_7_list)(nil)
 
-func init() {
-	file_mainchain_enterprise_v1_enterprise_proto_init()
-	md_EnterpriseUserAccount = File_mainchain_enterprise_v1_enterprise_proto.Messages().ByName("EnterpriseUserAccount")
-	fd_EnterpriseUserAccount_owner = md_EnterpriseUserAccount.Fields().ByName("owner")
-	fd_EnterpriseUserAccount_locked_efund = md_EnterpriseUserAccount.Fields().ByName("locked_efund")
-	fd_EnterpriseUserAccount_general_supply = md_EnterpriseUserAccount.Fields().ByName("general_supply")
-	fd_EnterpriseUserAccount_spent_efund = md_EnterpriseUserAccount.Fields().ByName("spent_efund")
-	fd_EnterpriseUserAccount_spendable = md_EnterpriseUserAccount.Fields().ByName("spendable")
+type _EFUNDLedgerEntry_7_list struct {
+	list *[]string
 }
 
-var _ protoreflect.Message = (*fastReflection_EnterpriseUserAccount)(nil)
-
-type fastReflection_EnterpriseUserAccount EnterpriseUserAccount
+func (x *_EFUNDLedgerEntry_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-func (x *EnterpriseUserAccount) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EnterpriseUserAccount)(x)
+func (x *_EFUNDLedgerEntry_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *EnterpriseUserAccount) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[5]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_EFUNDLedgerEntry_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EFUNDLedgerEntry_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EFUNDLedgerEntry_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EFUNDLedgerEntry at list field MsgTypes as it is not of Message kind"))
+}
+
+func (x *_EFUNDLedgerEntry_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EFUNDLedgerEntry_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EFUNDLedgerEntry_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EFUNDLedgerEntry                   protoreflect.MessageDescriptor
+	fd_EFUNDLedgerEntry_id                protoreflect.FieldDescriptor
+	fd_EFUNDLedgerEntry_owner             protoreflect.FieldDescriptor
+	fd_EFUNDLedgerEntry_entry_type        protoreflect.FieldDescriptor
+	fd_EFUNDLedgerEntry_amount            protoreflect.FieldDescriptor
+	fd_EFUNDLedgerEntry_purchase_order_id protoreflect.FieldDescriptor
+	fd_EFUNDLedgerEntry_tx_hash           protoreflect.FieldDescriptor
+	fd_EFUNDLedgerEntry_msg_types         protoreflect.FieldDescriptor
+	fd_EFUNDLedgerEntry_height            protoreflect.FieldDescriptor
+	fd_EFUNDLedgerEntry_timestamp         protoreflect.FieldDescriptor
+	fd_EFUNDLedgerEntry_locked_balance    protoreflect.FieldDescriptor
+	fd_EFUNDLedgerEntry_spent_balance     protoreflect.FieldDescriptor
+	fd_EFUNDLedgerEntry_counterparty      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_enterprise_v1_enterprise_proto_init()
+	md_EFUNDLedgerEntry = File_mainchain_enterprise_v1_enterprise_proto.Messages().ByName("EFUNDLedgerEntry")
+	fd_EFUNDLedgerEntry_id = md_EFUNDLedgerEntry.Fields().ByName("id")
+	fd_EFUNDLedgerEntry_owner = md_EFUNDLedgerEntry.Fields().ByName("owner")
+	fd_EFUNDLedgerEntry_entry_type = md_EFUNDLedgerEntry.Fields().ByName("entry_type")
+	fd_EFUNDLedgerEntry_amount = md_EFUNDLedgerEntry.Fields().ByName("amount")
+	fd_EFUNDLedgerEntry_purchase_order_id = md_EFUNDLedgerEntry.Fields().ByName("purchase_order_id")
+	fd_EFUNDLedgerEntry_tx_hash = md_EFUNDLedgerEntry.Fields().ByName("tx_hash")
+	fd_EFUNDLedgerEntry_msg_types = md_EFUNDLedgerEntry.Fields().ByName("msg_types")
+	fd_EFUNDLedgerEntry_height = md_EFUNDLedgerEntry.Fields().ByName("height")
+	fd_EFUNDLedgerEntry_timestamp = md_EFUNDLedgerEntry.Fields().ByName("timestamp")
+	fd_EFUNDLedgerEntry_locked_balance = md_EFUNDLedgerEntry.Fields().ByName("locked_balance")
+	fd_EFUNDLedgerEntry_spent_balance = md_EFUNDLedgerEntry.Fields().ByName("spent_balance")
+	fd_EFUNDLedgerEntry_counterparty = md_EFUNDLedgerEntry.Fields().ByName("counterparty")
+}
+
+var _ protoreflect.Message = (*fastReflection_EFUNDLedgerEntry)(nil)
+
+type fastReflection_EFUNDLedgerEntry EFUNDLedgerEntry
+
+func (x *EFUNDLedgerEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EFUNDLedgerEntry)(x)
+}
+
+func (x *EFUNDLedgerEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EFUNDLedgerEntry_messageType fastReflection_EFUNDLedgerEntry_messageType
+var _ protoreflect.MessageType = fastReflection_EFUNDLedgerEntry_messageType{}
+
+type fastReflection_EFUNDLedgerEntry_messageType struct{}
+
+func (x fastReflection_EFUNDLedgerEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EFUNDLedgerEntry)(nil)
+}
+func (x fastReflection_EFUNDLedgerEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_EFUNDLedgerEntry)
+}
+func (x fastReflection_EFUNDLedgerEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EFUNDLedgerEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EFUNDLedgerEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_EFUNDLedgerEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EFUNDLedgerEntry) Type() protoreflect.MessageType {
+	return _fastReflection_EFUNDLedgerEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EFUNDLedgerEntry) New() protoreflect.Message {
+	return new(fastReflection_EFUNDLedgerEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EFUNDLedgerEntry) Interface() protoreflect.ProtoMessage {
+	return (*EFUNDLedgerEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EFUNDLedgerEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_EFUNDLedgerEntry_id, value) {
+			return
+		}
+	}
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_EFUNDLedgerEntry_owner, value) {
+			return
+		}
+	}
+	if x.EntryType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.EntryType))
+		if !f(fd_EFUNDLedgerEntry_entry_type, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_EFUNDLedgerEntry_amount, value) {
+			return
+		}
+	}
+	if x.PurchaseOrderId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PurchaseOrderId)
+		if !f(fd_EFUNDLedgerEntry_purchase_order_id, value) {
+			return
+		}
+	}
+	if x.TxHash != "" {
+		value := protoreflect.ValueOfString(x.TxHash)
+		if !f(fd_EFUNDLedgerEntry_tx_hash, value) {
+			return
+		}
+	}
+	if len(x.MsgTypes) != 0 {
+		value := protoreflect.ValueOfList(&_EFUNDLedgerEntry_7_list{list: &x.MsgTypes})
+		if !f(fd_EFUNDLedgerEntry_msg_types, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_EFUNDLedgerEntry_height, value) {
+			return
+		}
+	}
+	if x.Timestamp != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Timestamp)
+		if !f(fd_EFUNDLedgerEntry_timestamp, value) {
+			return
+		}
+	}
+	if x.LockedBalance != nil {
+		value := protoreflect.ValueOfMessage(x.LockedBalance.ProtoReflect())
+		if !f(fd_EFUNDLedgerEntry_locked_balance, value) {
+			return
+		}
+	}
+	if x.SpentBalance != nil {
+		value := protoreflect.ValueOfMessage(x.SpentBalance.ProtoReflect())
+		if !f(fd_EFUNDLedgerEntry_spent_balance, value) {
+			return
+		}
+	}
+	if x.Counterparty != "" {
+		value := protoreflect.ValueOfString(x.Counterparty)
+		if !f(fd_EFUNDLedgerEntry_counterparty, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EFUNDLedgerEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.id":
+		return x.Id != uint64(0)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.owner":
+		return x.Owner != ""
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.entry_type":
+		return x.EntryType != 0
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.amount":
+		return x.Amount != nil
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.purchase_order_id":
+		return x.PurchaseOrderId != uint64(0)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.tx_hash":
+		return x.TxHash != ""
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.msg_types":
+		return len(x.MsgTypes) != 0
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.height":
+		return x.Height != int64(0)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.timestamp":
+		return x.Timestamp != uint64(0)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.locked_balance":
+		return x.LockedBalance != nil
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.spent_balance":
+		return x.SpentBalance != nil
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.counterparty":
+		return x.Counterparty != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EFUNDLedgerEntry"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EFUNDLedgerEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EFUNDLedgerEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.id":
+		x.Id = uint64(0)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.owner":
+		x.Owner = ""
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.entry_type":
+		x.EntryType = 0
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.amount":
+		x.Amount = nil
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.purchase_order_id":
+		x.PurchaseOrderId = uint64(0)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.tx_hash":
+		x.TxHash = ""
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.msg_types":
+		x.MsgTypes = nil
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.height":
+		x.Height = int64(0)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.timestamp":
+		x.Timestamp = uint64(0)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.locked_balance":
+		x.LockedBalance = nil
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.spent_balance":
+		x.SpentBalance = nil
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.counterparty":
+		x.Counterparty = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EFUNDLedgerEntry"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EFUNDLedgerEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EFUNDLedgerEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.entry_type":
+		value := x.EntryType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.purchase_order_id":
+		value := x.PurchaseOrderId
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.tx_hash":
+		value := x.TxHash
+		return protoreflect.ValueOfString(value)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.msg_types":
+		if len(x.MsgTypes) == 0 {
+			return protoreflect.ValueOfList(&_EFUNDLedgerEntry_7_list{})
+		}
+		listValue := &_EFUNDLedgerEntry_7_list{list: &x.MsgTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.timestamp":
+		value := x.Timestamp
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.locked_balance":
+		value := x.LockedBalance
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.spent_balance":
+		value := x.SpentBalance
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.counterparty":
+		value := x.Counterparty
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EFUNDLedgerEntry"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EFUNDLedgerEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EFUNDLedgerEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.id":
+		x.Id = value.Uint()
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.owner":
+		x.Owner = value.Interface().(string)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.entry_type":
+		x.EntryType = (LedgerEntryType)(value.Enum())
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.purchase_order_id":
+		x.PurchaseOrderId = value.Uint()
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.tx_hash":
+		x.TxHash = value.Interface().(string)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.msg_types":
+		lv := value.List()
+		clv := lv.(*_EFUNDLedgerEntry_7_list)
+		x.MsgTypes = *clv.list
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.height":
+		x.Height = value.Int()
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.timestamp":
+		x.Timestamp = value.Uint()
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.locked_balance":
+		x.LockedBalance = value.Message().Interface().(*v1beta1.Coin)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.spent_balance":
+		x.SpentBalance = value.Message().Interface().(*v1beta1.Coin)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.counterparty":
+		x.Counterparty = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EFUNDLedgerEntry"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EFUNDLedgerEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EFUNDLedgerEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.msg_types":
+		if x.MsgTypes == nil {
+			x.MsgTypes = []string{}
+		}
+		value := &_EFUNDLedgerEntry_7_list{list: &x.MsgTypes}
+		return protoreflect.ValueOfList(value)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.locked_balance":
+		if x.LockedBalance == nil {
+			x.LockedBalance = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.LockedBalance.ProtoReflect())
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.spent_balance":
+		if x.SpentBalance == nil {
+			x.SpentBalance = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.SpentBalance.ProtoReflect())
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.id":
+		panic(fmt.Errorf("field id of message mainchain.enterprise.v1.EFUNDLedgerEntry is not mutable"))
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.owner":
+		panic(fmt.Errorf("field owner of message mainchain.enterprise.v1.EFUNDLedgerEntry is not mutable"))
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.entry_type":
+		panic(fmt.Errorf("field entry_type of message mainchain.enterprise.v1.EFUNDLedgerEntry is not mutable"))
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.purchase_order_id":
+		panic(fmt.Errorf("field purchase_order_id of message mainchain.enterprise.v1.EFUNDLedgerEntry is not mutable"))
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.tx_hash":
+		panic(fmt.Errorf("field tx_hash of message mainchain.enterprise.v1.EFUNDLedgerEntry is not mutable"))
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.height":
+		panic(fmt.Errorf("field height of message mainchain.enterprise.v1.EFUNDLedgerEntry is not mutable"))
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.timestamp":
+		panic(fmt.Errorf("field timestamp of message mainchain.enterprise.v1.EFUNDLedgerEntry is not mutable"))
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.counterparty":
+		panic(fmt.Errorf("field counterparty of message mainchain.enterprise.v1.EFUNDLedgerEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EFUNDLedgerEntry"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EFUNDLedgerEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EFUNDLedgerEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.owner":
+		return protoreflect.ValueOfString("")
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.entry_type":
+		return protoreflect.ValueOfEnum(0)
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.purchase_order_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.tx_hash":
+		return protoreflect.ValueOfString("")
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.msg_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EFUNDLedgerEntry_7_list{list: &list})
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.timestamp":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.locked_balance":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.spent_balance":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.enterprise.v1.EFUNDLedgerEntry.counterparty":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EFUNDLedgerEntry"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EFUNDLedgerEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EFUNDLedgerEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.EFUNDLedgerEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EFUNDLedgerEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EFUNDLedgerEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EFUNDLedgerEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EFUNDLedgerEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EFUNDLedgerEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EntryType != 0 {
+			n += 1 + runtime.Sov(uint64(x.EntryType))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PurchaseOrderId != 0 {
+			n += 1 + runtime.Sov(uint64(x.PurchaseOrderId))
+		}
+		l = len(x.TxHash)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.MsgTypes) > 0 {
+			for _, s := range x.MsgTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Timestamp != 0 {
+			n += 1 + runtime.Sov(uint64(x.Timestamp))
+		}
+		if x.LockedBalance != nil {
+			l = options.Size(x.LockedBalance)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SpentBalance != nil {
+			l = options.Size(x.SpentBalance)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Counterparty)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EFUNDLedgerEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Counterparty) > 0 {
+			i -= len(x.Counterparty)
+			copy(dAtA[i:], x.Counterparty)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Counterparty)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if x.SpentBalance != nil {
+			encoded, err := options.Marshal(x.SpentBalance)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.LockedBalance != nil {
+			encoded, err := options.Marshal(x.LockedBalance)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Timestamp != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Timestamp))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.MsgTypes) > 0 {
+			for iNdEx := len(x.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.MsgTypes[iNdEx])
+				copy(dAtA[i:], x.MsgTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.TxHash) > 0 {
+			i -= len(x.TxHash)
+			copy(dAtA[i:], x.TxHash)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TxHash)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.PurchaseOrderId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PurchaseOrderId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.EntryType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EntryType))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EFUNDLedgerEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EFUNDLedgerEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EFUNDLedgerEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EntryType", wireType)
+				}
+				x.EntryType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EntryType |= LedgerEntryType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PurchaseOrderId", wireType)
+				}
+				x.PurchaseOrderId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PurchaseOrderId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TxHash = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypes = append(x.MsgTypes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				}
+				x.Timestamp = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Timestamp |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedBalance", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LockedBalance == nil {
+					x.LockedBalance = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockedBalance); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpentBalance", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SpentBalance == nil {
+					x.SpentBalance = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpentBalance); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Counterparty = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SpentEFUND        protoreflect.MessageDescriptor
+	fd_SpentEFUND_owner  protoreflect.FieldDescriptor
+	fd_SpentEFUND_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_enterprise_v1_enterprise_proto_init()
+	md_SpentEFUND = File_mainchain_enterprise_v1_enterprise_proto.Messages().ByName("SpentEFUND")
+	fd_SpentEFUND_owner = md_SpentEFUND.Fields().ByName("owner")
+	fd_SpentEFUND_amount = md_SpentEFUND.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_SpentEFUND)(nil)
+
+type fastReflection_SpentEFUND SpentEFUND
+
+func (x *SpentEFUND) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SpentEFUND)(x)
+}
+
+func (x *SpentEFUND) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SpentEFUND_messageType fastReflection_SpentEFUND_messageType
+var _ protoreflect.MessageType = fastReflection_SpentEFUND_messageType{}
+
+type fastReflection_SpentEFUND_messageType struct{}
+
+func (x fastReflection_SpentEFUND_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SpentEFUND)(nil)
+}
+func (x fastReflection_SpentEFUND_messageType) New() protoreflect.Message {
+	return new(fastReflection_SpentEFUND)
+}
+func (x fastReflection_SpentEFUND_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SpentEFUND
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SpentEFUND) Descriptor() protoreflect.MessageDescriptor {
+	return md_SpentEFUND
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SpentEFUND) Type() protoreflect.MessageType {
+	return _fastReflection_SpentEFUND_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SpentEFUND) New() protoreflect.Message {
+	return new(fastReflection_SpentEFUND)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SpentEFUND) Interface() protoreflect.ProtoMessage {
+	return (*SpentEFUND)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SpentEFUND) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_SpentEFUND_owner, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_SpentEFUND_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SpentEFUND) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.SpentEFUND.owner":
+		return x.Owner != ""
+	case "mainchain.enterprise.v1.SpentEFUND.amount":
+		return x.Amount != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.SpentEFUND"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.SpentEFUND does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpentEFUND) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.SpentEFUND.owner":
+		x.Owner = ""
+	case "mainchain.enterprise.v1.SpentEFUND.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.SpentEFUND"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.SpentEFUND does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SpentEFUND) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.enterprise.v1.SpentEFUND.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "mainchain.enterprise.v1.SpentEFUND.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.SpentEFUND"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.SpentEFUND does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpentEFUND) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.SpentEFUND.owner":
+		x.Owner = value.Interface().(string)
+	case "mainchain.enterprise.v1.SpentEFUND.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.SpentEFUND"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.SpentEFUND does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpentEFUND) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.SpentEFUND.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "mainchain.enterprise.v1.SpentEFUND.owner":
+		panic(fmt.Errorf("field owner of message mainchain.enterprise.v1.SpentEFUND is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.SpentEFUND"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.SpentEFUND does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SpentEFUND) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.SpentEFUND.owner":
+		return protoreflect.ValueOfString("")
+	case "mainchain.enterprise.v1.SpentEFUND.amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.SpentEFUND"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.SpentEFUND does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SpentEFUND) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.SpentEFUND", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SpentEFUND) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SpentEFUND) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SpentEFUND) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SpentEFUND) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SpentEFUND)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SpentEFUND)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SpentEFUND)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SpentEFUND: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SpentEFUND: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EnterpriseUserAccount                protoreflect.MessageDescriptor
+	fd_EnterpriseUserAccount_owner          protoreflect.FieldDescriptor
+	fd_EnterpriseUserAccount_locked_efund   protoreflect.FieldDescriptor
+	fd_EnterpriseUserAccount_general_supply protoreflect.FieldDescriptor
+	fd_EnterpriseUserAccount_spent_efund    protoreflect.FieldDescriptor
+	fd_EnterpriseUserAccount_spendable      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_enterprise_v1_enterprise_proto_init()
+	md_EnterpriseUserAccount = File_mainchain_enterprise_v1_enterprise_proto.Messages().ByName("EnterpriseUserAccount")
+	fd_EnterpriseUserAccount_owner = md_EnterpriseUserAccount.Fields().ByName("owner")
+	fd_EnterpriseUserAccount_locked_efund = md_EnterpriseUserAccount.Fields().ByName("locked_efund")
+	fd_EnterpriseUserAccount_general_supply = md_EnterpriseUserAccount.Fields().ByName("general_supply")
+	fd_EnterpriseUserAccount_spent_efund = md_EnterpriseUserAccount.Fields().ByName("spent_efund")
+	fd_EnterpriseUserAccount_spendable = md_EnterpriseUserAccount.Fields().ByName("spendable")
+}
+
+var _ protoreflect.Message = (*fastReflection_EnterpriseUserAccount)(nil)
+
+type fastReflection_EnterpriseUserAccount EnterpriseUserAccount
+
+func (x *EnterpriseUserAccount) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EnterpriseUserAccount)(x)
+}
+
+func (x *EnterpriseUserAccount) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
 var _fastReflection_EnterpriseUserAccount_messageType fastReflection_EnterpriseUserAccount_messageType
 var _ protoreflect.MessageType = fastReflection_EnterpriseUserAccount_messageType{}
 
-type fastReflection_EnterpriseUserAccount_messageType struct{}
+type fastReflection_EnterpriseUserAccount_messageType struct{}
+
+func (x fastReflection_EnterpriseUserAccount_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EnterpriseUserAccount)(nil)
+}
+func (x fastReflection_EnterpriseUserAccount_messageType) New() protoreflect.Message {
+	return new(fastReflection_EnterpriseUserAccount)
+}
+func (x fastReflection_EnterpriseUserAccount_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EnterpriseUserAccount
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EnterpriseUserAccount) Descriptor() protoreflect.MessageDescriptor {
+	return md_EnterpriseUserAccount
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EnterpriseUserAccount) Type() protoreflect.MessageType {
+	return _fastReflection_EnterpriseUserAccount_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EnterpriseUserAccount) New() protoreflect.Message {
+	return new(fastReflection_EnterpriseUserAccount)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EnterpriseUserAccount) Interface() protoreflect.ProtoMessage {
+	return (*EnterpriseUserAccount)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EnterpriseUserAccount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_EnterpriseUserAccount_owner, value) {
+			return
+		}
+	}
+	if x.LockedEfund != nil {
+		value := protoreflect.ValueOfMessage(x.LockedEfund.ProtoReflect())
+		if !f(fd_EnterpriseUserAccount_locked_efund, value) {
+			return
+		}
+	}
+	if x.GeneralSupply != nil {
+		value := protoreflect.ValueOfMessage(x.GeneralSupply.ProtoReflect())
+		if !f(fd_EnterpriseUserAccount_general_supply, value) {
+			return
+		}
+	}
+	if x.SpentEfund != nil {
+		value := protoreflect.ValueOfMessage(x.SpentEfund.ProtoReflect())
+		if !f(fd_EnterpriseUserAccount_spent_efund, value) {
+			return
+		}
+	}
+	if x.Spendable != nil {
+		value := protoreflect.ValueOfMessage(x.Spendable.ProtoReflect())
+		if !f(fd_EnterpriseUserAccount_spendable, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EnterpriseUserAccount) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.owner":
+		return x.Owner != ""
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.locked_efund":
+		return x.LockedEfund != nil
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.general_supply":
+		return x.GeneralSupply != nil
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.spent_efund":
+		return x.SpentEfund != nil
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.spendable":
+		return x.Spendable != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUserAccount"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EnterpriseUserAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EnterpriseUserAccount) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.owner":
+		x.Owner = ""
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.locked_efund":
+		x.LockedEfund = nil
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.general_supply":
+		x.GeneralSupply = nil
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.spent_efund":
+		x.SpentEfund = nil
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.spendable":
+		x.Spendable = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUserAccount"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EnterpriseUserAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EnterpriseUserAccount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.locked_efund":
+		value := x.LockedEfund
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.general_supply":
+		value := x.GeneralSupply
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.spent_efund":
+		value := x.SpentEfund
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.spendable":
+		value := x.Spendable
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUserAccount"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EnterpriseUserAccount does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EnterpriseUserAccount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.owner":
+		x.Owner = value.Interface().(string)
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.locked_efund":
+		x.LockedEfund = value.Message().Interface().(*v1beta1.Coin)
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.general_supply":
+		x.GeneralSupply = value.Message().Interface().(*v1beta1.Coin)
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.spent_efund":
+		x.SpentEfund = value.Message().Interface().(*v1beta1.Coin)
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.spendable":
+		x.Spendable = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUserAccount"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EnterpriseUserAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EnterpriseUserAccount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.locked_efund":
+		if x.LockedEfund == nil {
+			x.LockedEfund = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.LockedEfund.ProtoReflect())
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.general_supply":
+		if x.GeneralSupply == nil {
+			x.GeneralSupply = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.GeneralSupply.ProtoReflect())
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.spent_efund":
+		if x.SpentEfund == nil {
+			x.SpentEfund = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.SpentEfund.ProtoReflect())
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.spendable":
+		if x.Spendable == nil {
+			x.Spendable = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Spendable.ProtoReflect())
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.owner":
+		panic(fmt.Errorf("field owner of message mainchain.enterprise.v1.EnterpriseUserAccount is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUserAccount"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EnterpriseUserAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EnterpriseUserAccount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.owner":
+		return protoreflect.ValueOfString("")
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.locked_efund":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.general_supply":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.spent_efund":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.enterprise.v1.EnterpriseUserAccount.spendable":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUserAccount"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EnterpriseUserAccount does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EnterpriseUserAccount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.EnterpriseUserAccount", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EnterpriseUserAccount) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EnterpriseUserAccount) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EnterpriseUserAccount) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EnterpriseUserAccount) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EnterpriseUserAccount)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LockedEfund != nil {
+			l = options.Size(x.LockedEfund)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.GeneralSupply != nil {
+			l = options.Size(x.GeneralSupply)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SpentEfund != nil {
+			l = options.Size(x.SpentEfund)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Spendable != nil {
+			l = options.Size(x.Spendable)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EnterpriseUserAccount)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Spendable != nil {
+			encoded, err := options.Marshal(x.Spendable)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.SpentEfund != nil {
+			encoded, err := options.Marshal(x.SpentEfund)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.GeneralSupply != nil {
+			encoded, err := options.Marshal(x.GeneralSupply)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.LockedEfund != nil {
+			encoded, err := options.Marshal(x.LockedEfund)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EnterpriseUserAccount)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EnterpriseUserAccount: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EnterpriseUserAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedEfund", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LockedEfund == nil {
+					x.LockedEfund = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockedEfund); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GeneralSupply", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.GeneralSupply == nil {
+					x.GeneralSupply = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GeneralSupply); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpentEfund", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.SpentEfund == nil {
+					x.SpentEfund = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpentEfund); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spendable", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Spendable == nil {
+					x.Spendable = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Spendable); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_WhitelistAddresses_1_list)(nil)
+
+type _WhitelistAddresses_1_list struct {
+	list *[]string
+}
+
+func (x *_WhitelistAddresses_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_WhitelistAddresses_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_WhitelistAddresses_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_WhitelistAddresses_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_WhitelistAddresses_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message WhitelistAddresses at list field Addresses as it is not of Message kind"))
+}
+
+func (x *_WhitelistAddresses_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_WhitelistAddresses_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_WhitelistAddresses_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_WhitelistAddresses           protoreflect.MessageDescriptor
+	fd_WhitelistAddresses_addresses protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_enterprise_v1_enterprise_proto_init()
+	md_WhitelistAddresses = File_mainchain_enterprise_v1_enterprise_proto.Messages().ByName("WhitelistAddresses")
+	fd_WhitelistAddresses_addresses = md_WhitelistAddresses.Fields().ByName("addresses")
+}
+
+var _ protoreflect.Message = (*fastReflection_WhitelistAddresses)(nil)
+
+type fastReflection_WhitelistAddresses WhitelistAddresses
+
+func (x *WhitelistAddresses) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_WhitelistAddresses)(x)
+}
+
+func (x *WhitelistAddresses) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_WhitelistAddresses_messageType fastReflection_WhitelistAddresses_messageType
+var _ protoreflect.MessageType = fastReflection_WhitelistAddresses_messageType{}
+
+type fastReflection_WhitelistAddresses_messageType struct{}
+
+func (x fastReflection_WhitelistAddresses_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_WhitelistAddresses)(nil)
+}
+func (x fastReflection_WhitelistAddresses_messageType) New() protoreflect.Message {
+	return new(fastReflection_WhitelistAddresses)
+}
+func (x fastReflection_WhitelistAddresses_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_WhitelistAddresses
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_WhitelistAddresses) Descriptor() protoreflect.MessageDescriptor {
+	return md_WhitelistAddresses
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_WhitelistAddresses) Type() protoreflect.MessageType {
+	return _fastReflection_WhitelistAddresses_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_WhitelistAddresses) New() protoreflect.Message {
+	return new(fastReflection_WhitelistAddresses)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_WhitelistAddresses) Interface() protoreflect.ProtoMessage {
+	return (*WhitelistAddresses)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_WhitelistAddresses) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Addresses) != 0 {
+		value := protoreflect.ValueOfList(&_WhitelistAddresses_1_list{list: &x.Addresses})
+		if !f(fd_WhitelistAddresses_addresses, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_WhitelistAddresses) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.WhitelistAddresses.addresses":
+		return len(x.Addresses) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistAddresses"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistAddresses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WhitelistAddresses) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.WhitelistAddresses.addresses":
+		x.Addresses = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistAddresses"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistAddresses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_WhitelistAddresses) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.enterprise.v1.WhitelistAddresses.addresses":
+		if len(x.Addresses) == 0 {
+			return protoreflect.ValueOfList(&_WhitelistAddresses_1_list{})
+		}
+		listValue := &_WhitelistAddresses_1_list{list: &x.Addresses}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistAddresses"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistAddresses does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WhitelistAddresses) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.WhitelistAddresses.addresses":
+		lv := value.List()
+		clv := lv.(*_WhitelistAddresses_1_list)
+		x.Addresses = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistAddresses"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistAddresses does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WhitelistAddresses) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.WhitelistAddresses.addresses":
+		if x.Addresses == nil {
+			x.Addresses = []string{}
+		}
+		value := &_WhitelistAddresses_1_list{list: &x.Addresses}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistAddresses"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistAddresses does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_WhitelistAddresses) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.WhitelistAddresses.addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_WhitelistAddresses_1_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistAddresses"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistAddresses does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_WhitelistAddresses) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.WhitelistAddresses", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_WhitelistAddresses) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WhitelistAddresses) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_WhitelistAddresses) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_WhitelistAddresses) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*WhitelistAddresses)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Addresses) > 0 {
+			for _, s := range x.Addresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*WhitelistAddresses)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Addresses) > 0 {
+			for iNdEx := len(x.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Addresses[iNdEx])
+				copy(dAtA[i:], x.Addresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Addresses[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*WhitelistAddresses)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WhitelistAddresses: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WhitelistAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Addresses = append(x.Addresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_WhitelistEntry                 protoreflect.MessageDescriptor
+	fd_WhitelistEntry_address         protoreflect.FieldDescriptor
+	fd_WhitelistEntry_expiry_time     protoreflect.FieldDescriptor
+	fd_WhitelistEntry_max_purchasable protoreflect.FieldDescriptor
+	fd_WhitelistEntry_tier            protoreflect.FieldDescriptor
+	fd_WhitelistEntry_added_at        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_enterprise_v1_enterprise_proto_init()
+	md_WhitelistEntry = File_mainchain_enterprise_v1_enterprise_proto.Messages().ByName("WhitelistEntry")
+	fd_WhitelistEntry_address = md_WhitelistEntry.Fields().ByName("address")
+	fd_WhitelistEntry_expiry_time = md_WhitelistEntry.Fields().ByName("expiry_time")
+	fd_WhitelistEntry_max_purchasable = md_WhitelistEntry.Fields().ByName("max_purchasable")
+	fd_WhitelistEntry_tier = md_WhitelistEntry.Fields().ByName("tier")
+	fd_WhitelistEntry_added_at = md_WhitelistEntry.Fields().ByName("added_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_WhitelistEntry)(nil)
+
+type fastReflection_WhitelistEntry WhitelistEntry
+
+func (x *WhitelistEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_WhitelistEntry)(x)
+}
+
+func (x *WhitelistEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_WhitelistEntry_messageType fastReflection_WhitelistEntry_messageType
+var _ protoreflect.MessageType = fastReflection_WhitelistEntry_messageType{}
+
+type fastReflection_WhitelistEntry_messageType struct{}
+
+func (x fastReflection_WhitelistEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_WhitelistEntry)(nil)
+}
+func (x fastReflection_WhitelistEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_WhitelistEntry)
+}
+func (x fastReflection_WhitelistEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_WhitelistEntry
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_WhitelistEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_WhitelistEntry
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_WhitelistEntry) Type() protoreflect.MessageType {
+	return _fastReflection_WhitelistEntry_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_WhitelistEntry) New() protoreflect.Message {
+	return new(fastReflection_WhitelistEntry)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_WhitelistEntry) Interface() protoreflect.ProtoMessage {
+	return (*WhitelistEntry)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_WhitelistEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_WhitelistEntry_address, value) {
+			return
+		}
+	}
+	if x.ExpiryTime != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExpiryTime)
+		if !f(fd_WhitelistEntry_expiry_time, value) {
+			return
+		}
+	}
+	if x.MaxPurchasable != nil {
+		value := protoreflect.ValueOfMessage(x.MaxPurchasable.ProtoReflect())
+		if !f(fd_WhitelistEntry_max_purchasable, value) {
+			return
+		}
+	}
+	if x.Tier != "" {
+		value := protoreflect.ValueOfString(x.Tier)
+		if !f(fd_WhitelistEntry_tier, value) {
+			return
+		}
+	}
+	if x.AddedAt != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AddedAt)
+		if !f(fd_WhitelistEntry_added_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_WhitelistEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.WhitelistEntry.address":
+		return x.Address != ""
+	case "mainchain.enterprise.v1.WhitelistEntry.expiry_time":
+		return x.ExpiryTime != uint64(0)
+	case "mainchain.enterprise.v1.WhitelistEntry.max_purchasable":
+		return x.MaxPurchasable != nil
+	case "mainchain.enterprise.v1.WhitelistEntry.tier":
+		return x.Tier != ""
+	case "mainchain.enterprise.v1.WhitelistEntry.added_at":
+		return x.AddedAt != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistEntry"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WhitelistEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.WhitelistEntry.address":
+		x.Address = ""
+	case "mainchain.enterprise.v1.WhitelistEntry.expiry_time":
+		x.ExpiryTime = uint64(0)
+	case "mainchain.enterprise.v1.WhitelistEntry.max_purchasable":
+		x.MaxPurchasable = nil
+	case "mainchain.enterprise.v1.WhitelistEntry.tier":
+		x.Tier = ""
+	case "mainchain.enterprise.v1.WhitelistEntry.added_at":
+		x.AddedAt = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistEntry"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_WhitelistEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.enterprise.v1.WhitelistEntry.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "mainchain.enterprise.v1.WhitelistEntry.expiry_time":
+		value := x.ExpiryTime
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.enterprise.v1.WhitelistEntry.max_purchasable":
+		value := x.MaxPurchasable
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.enterprise.v1.WhitelistEntry.tier":
+		value := x.Tier
+		return protoreflect.ValueOfString(value)
+	case "mainchain.enterprise.v1.WhitelistEntry.added_at":
+		value := x.AddedAt
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistEntry"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WhitelistEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.WhitelistEntry.address":
+		x.Address = value.Interface().(string)
+	case "mainchain.enterprise.v1.WhitelistEntry.expiry_time":
+		x.ExpiryTime = value.Uint()
+	case "mainchain.enterprise.v1.WhitelistEntry.max_purchasable":
+		x.MaxPurchasable = value.Message().Interface().(*v1beta1.Coin)
+	case "mainchain.enterprise.v1.WhitelistEntry.tier":
+		x.Tier = value.Interface().(string)
+	case "mainchain.enterprise.v1.WhitelistEntry.added_at":
+		x.AddedAt = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistEntry"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WhitelistEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.WhitelistEntry.max_purchasable":
+		if x.MaxPurchasable == nil {
+			x.MaxPurchasable = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.MaxPurchasable.ProtoReflect())
+	case "mainchain.enterprise.v1.WhitelistEntry.address":
+		panic(fmt.Errorf("field address of message mainchain.enterprise.v1.WhitelistEntry is not mutable"))
+	case "mainchain.enterprise.v1.WhitelistEntry.expiry_time":
+		panic(fmt.Errorf("field expiry_time of message mainchain.enterprise.v1.WhitelistEntry is not mutable"))
+	case "mainchain.enterprise.v1.WhitelistEntry.tier":
+		panic(fmt.Errorf("field tier of message mainchain.enterprise.v1.WhitelistEntry is not mutable"))
+	case "mainchain.enterprise.v1.WhitelistEntry.added_at":
+		panic(fmt.Errorf("field added_at of message mainchain.enterprise.v1.WhitelistEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistEntry"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_WhitelistEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.WhitelistEntry.address":
+		return protoreflect.ValueOfString("")
+	case "mainchain.enterprise.v1.WhitelistEntry.expiry_time":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.enterprise.v1.WhitelistEntry.max_purchasable":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.enterprise.v1.WhitelistEntry.tier":
+		return protoreflect.ValueOfString("")
+	case "mainchain.enterprise.v1.WhitelistEntry.added_at":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistEntry"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_WhitelistEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.WhitelistEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_WhitelistEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_WhitelistEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_WhitelistEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_WhitelistEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*WhitelistEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExpiryTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiryTime))
+		}
+		if x.MaxPurchasable != nil {
+			l = options.Size(x.MaxPurchasable)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Tier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AddedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.AddedAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*WhitelistEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AddedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AddedAt))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Tier) > 0 {
+			i -= len(x.Tier)
+			copy(dAtA[i:], x.Tier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Tier)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.MaxPurchasable != nil {
+			encoded, err := options.Marshal(x.MaxPurchasable)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.ExpiryTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiryTime))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*WhitelistEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WhitelistEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WhitelistEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+				}
+				x.ExpiryTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiryTime |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPurchasable", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxPurchasable == nil {
+					x.MaxPurchasable = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxPurchasable); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Tier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+				}
+				x.AddedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AddedAt |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
 
-func (x fastReflection_EnterpriseUserAccount_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EnterpriseUserAccount)(nil)
+var (
+	md_LinkedAddress          protoreflect.MessageDescriptor
+	fd_LinkedAddress_owner    protoreflect.FieldDescriptor
+	fd_LinkedAddress_linked   protoreflect.FieldDescriptor
+	fd_LinkedAddress_added_at protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_enterprise_v1_enterprise_proto_init()
+	md_LinkedAddress = File_mainchain_enterprise_v1_enterprise_proto.Messages().ByName("LinkedAddress")
+	fd_LinkedAddress_owner = md_LinkedAddress.Fields().ByName("owner")
+	fd_LinkedAddress_linked = md_LinkedAddress.Fields().ByName("linked")
+	fd_LinkedAddress_added_at = md_LinkedAddress.Fields().ByName("added_at")
 }
-func (x fastReflection_EnterpriseUserAccount_messageType) New() protoreflect.Message {
-	return new(fastReflection_EnterpriseUserAccount)
+
+var _ protoreflect.Message = (*fastReflection_LinkedAddress)(nil)
+
+type fastReflection_LinkedAddress LinkedAddress
+
+func (x *LinkedAddress) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LinkedAddress)(x)
 }
-func (x fastReflection_EnterpriseUserAccount_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EnterpriseUserAccount
+
+func (x *LinkedAddress) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LinkedAddress_messageType fastReflection_LinkedAddress_messageType
+var _ protoreflect.MessageType = fastReflection_LinkedAddress_messageType{}
+
+type fastReflection_LinkedAddress_messageType struct{}
+
+func (x fastReflection_LinkedAddress_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LinkedAddress)(nil)
+}
+func (x fastReflection_LinkedAddress_messageType) New() protoreflect.Message {
+	return new(fastReflection_LinkedAddress)
+}
+func (x fastReflection_LinkedAddress_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LinkedAddress
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EnterpriseUserAccount) Descriptor() protoreflect.MessageDescriptor {
-	return md_EnterpriseUserAccount
+func (x *fastReflection_LinkedAddress) Descriptor() protoreflect.MessageDescriptor {
+	return md_LinkedAddress
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EnterpriseUserAccount) Type() protoreflect.MessageType {
-	return _fastReflection_EnterpriseUserAccount_messageType
+func (x *fastReflection_LinkedAddress) Type() protoreflect.MessageType {
+	return _fastReflection_LinkedAddress_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EnterpriseUserAccount) New() protoreflect.Message {
-	return new(fastReflection_EnterpriseUserAccount)
+func (x *fastReflection_LinkedAddress) New() protoreflect.Message {
+	return new(fastReflection_LinkedAddress)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EnterpriseUserAccount) Interface() protoreflect.ProtoMessage {
-	return (*EnterpriseUserAccount)(x)
+func (x *fastReflection_LinkedAddress) Interface() protoreflect.ProtoMessage {
+	return (*LinkedAddress)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2936,34 +6695,22 @@ func (x *fastReflection_EnterpriseUserAccount) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EnterpriseUserAccount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_LinkedAddress) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Owner != "" {
 		value := protoreflect.ValueOfString(x.Owner)
-		if !f(fd_EnterpriseUserAccount_owner, value) {
-			return
-		}
-	}
-	if x.LockedEfund != nil {
-		value := protoreflect.ValueOfMessage(x.LockedEfund.ProtoReflect())
-		if !f(fd_EnterpriseUserAccount_locked_efund, value) {
-			return
-		}
-	}
-	if x.GeneralSupply != nil {
-		value := protoreflect.ValueOfMessage(x.GeneralSupply.ProtoReflect())
-		if !f(fd_EnterpriseUserAccount_general_supply, value) {
+		if !f(fd_LinkedAddress_owner, value) {
 			return
 		}
 	}
-	if x.SpentEfund != nil {
-		value := protoreflect.ValueOfMessage(x.SpentEfund.ProtoReflect())
-		if !f(fd_EnterpriseUserAccount_spent_efund, value) {
+	if x.Linked != "" {
+		value := protoreflect.ValueOfString(x.Linked)
+		if !f(fd_LinkedAddress_linked, value) {
 			return
 		}
 	}
-	if x.Spendable != nil {
-		value := protoreflect.ValueOfMessage(x.Spendable.ProtoReflect())
-		if !f(fd_EnterpriseUserAccount_spendable, value) {
+	if x.AddedAt != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AddedAt)
+		if !f(fd_LinkedAddress_added_at, value) {
 			return
 		}
 	}
@@ -2980,23 +6727,19 @@ func (x *fastReflection_EnterpriseUserAccount) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EnterpriseUserAccount) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_LinkedAddress) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.owner":
+	case "mainchain.enterprise.v1.LinkedAddress.owner":
 		return x.Owner != ""
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.locked_efund":
-		return x.LockedEfund != nil
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.general_supply":
-		return x.GeneralSupply != nil
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.spent_efund":
-		return x.SpentEfund != nil
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.spendable":
-		return x.Spendable != nil
+	case "mainchain.enterprise.v1.LinkedAddress.linked":
+		return x.Linked != ""
+	case "mainchain.enterprise.v1.LinkedAddress.added_at":
+		return x.AddedAt != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUserAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.LinkedAddress"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.EnterpriseUserAccount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.LinkedAddress does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3006,23 +6749,19 @@ func (x *fastReflection_EnterpriseUserAccount) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EnterpriseUserAccount) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_LinkedAddress) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.owner":
+	case "mainchain.enterprise.v1.LinkedAddress.owner":
 		x.Owner = ""
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.locked_efund":
-		x.LockedEfund = nil
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.general_supply":
-		x.GeneralSupply = nil
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.spent_efund":
-		x.SpentEfund = nil
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.spendable":
-		x.Spendable = nil
+	case "mainchain.enterprise.v1.LinkedAddress.linked":
+		x.Linked = ""
+	case "mainchain.enterprise.v1.LinkedAddress.added_at":
+		x.AddedAt = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUserAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.LinkedAddress"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.EnterpriseUserAccount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.LinkedAddress does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3032,28 +6771,22 @@ func (x *fastReflection_EnterpriseUserAccount) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EnterpriseUserAccount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LinkedAddress) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.owner":
+	case "mainchain.enterprise.v1.LinkedAddress.owner":
 		value := x.Owner
 		return protoreflect.ValueOfString(value)
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.locked_efund":
-		value := x.LockedEfund
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.general_supply":
-		value := x.GeneralSupply
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.spent_efund":
-		value := x.SpentEfund
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.spendable":
-		value := x.Spendable
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.enterprise.v1.LinkedAddress.linked":
+		value := x.Linked
+		return protoreflect.ValueOfString(value)
+	case "mainchain.enterprise.v1.LinkedAddress.added_at":
+		value := x.AddedAt
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUserAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.LinkedAddress"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.EnterpriseUserAccount does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.LinkedAddress does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3067,23 +6800,19 @@ func (x *fastReflection_EnterpriseUserAccount) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EnterpriseUserAccount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_LinkedAddress) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.owner":
+	case "mainchain.enterprise.v1.LinkedAddress.owner":
 		x.Owner = value.Interface().(string)
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.locked_efund":
-		x.LockedEfund = value.Message().Interface().(*v1beta1.Coin)
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.general_supply":
-		x.GeneralSupply = value.Message().Interface().(*v1beta1.Coin)
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.spent_efund":
-		x.SpentEfund = value.Message().Interface().(*v1beta1.Coin)
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.spendable":
-		x.Spendable = value.Message().Interface().(*v1beta1.Coin)
+	case "mainchain.enterprise.v1.LinkedAddress.linked":
+		x.Linked = value.Interface().(string)
+	case "mainchain.enterprise.v1.LinkedAddress.added_at":
+		x.AddedAt = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUserAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.LinkedAddress"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.EnterpriseUserAccount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.LinkedAddress does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3097,72 +6826,48 @@ func (x *fastReflection_EnterpriseUserAccount) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EnterpriseUserAccount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LinkedAddress) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.locked_efund":
-		if x.LockedEfund == nil {
-			x.LockedEfund = new(v1beta1.Coin)
-		}
-		return protoreflect.ValueOfMessage(x.LockedEfund.ProtoReflect())
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.general_supply":
-		if x.GeneralSupply == nil {
-			x.GeneralSupply = new(v1beta1.Coin)
-		}
-		return protoreflect.ValueOfMessage(x.GeneralSupply.ProtoReflect())
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.spent_efund":
-		if x.SpentEfund == nil {
-			x.SpentEfund = new(v1beta1.Coin)
-		}
-		return protoreflect.ValueOfMessage(x.SpentEfund.ProtoReflect())
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.spendable":
-		if x.Spendable == nil {
-			x.Spendable = new(v1beta1.Coin)
-		}
-		return protoreflect.ValueOfMessage(x.Spendable.ProtoReflect())
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.owner":
-		panic(fmt.Errorf("field owner of message mainchain.enterprise.v1.EnterpriseUserAccount is not mutable"))
+	case "mainchain.enterprise.v1.LinkedAddress.owner":
+		panic(fmt.Errorf("field owner of message mainchain.enterprise.v1.LinkedAddress is not mutable"))
+	case "mainchain.enterprise.v1.LinkedAddress.linked":
+		panic(fmt.Errorf("field linked of message mainchain.enterprise.v1.LinkedAddress is not mutable"))
+	case "mainchain.enterprise.v1.LinkedAddress.added_at":
+		panic(fmt.Errorf("field added_at of message mainchain.enterprise.v1.LinkedAddress is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUserAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.LinkedAddress"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.EnterpriseUserAccount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.LinkedAddress does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EnterpriseUserAccount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_LinkedAddress) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.owner":
+	case "mainchain.enterprise.v1.LinkedAddress.owner":
 		return protoreflect.ValueOfString("")
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.locked_efund":
-		m := new(v1beta1.Coin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.general_supply":
-		m := new(v1beta1.Coin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.spent_efund":
-		m := new(v1beta1.Coin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "mainchain.enterprise.v1.EnterpriseUserAccount.spendable":
-		m := new(v1beta1.Coin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.enterprise.v1.LinkedAddress.linked":
+		return protoreflect.ValueOfString("")
+	case "mainchain.enterprise.v1.LinkedAddress.added_at":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EnterpriseUserAccount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.LinkedAddress"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.EnterpriseUserAccount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.LinkedAddress does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EnterpriseUserAccount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_LinkedAddress) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.EnterpriseUserAccount", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.LinkedAddress", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3170,7 +6875,7 @@ func (x *fastReflection_EnterpriseUserAccount) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EnterpriseUserAccount) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_LinkedAddress) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3181,7 +6886,7 @@ func (x *fastReflection_EnterpriseUserAccount) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EnterpriseUserAccount) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_LinkedAddress) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3193,7 +6898,7 @@ func (x *fastReflection_EnterpriseUserAccount) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EnterpriseUserAccount) IsValid() bool {
+func (x *fastReflection_LinkedAddress) IsValid() bool {
 	return x != nil
 }
 
@@ -3203,9 +6908,9 @@ func (x *fastReflection_EnterpriseUserAccount) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EnterpriseUserAccount) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_LinkedAddress) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EnterpriseUserAccount)
+		x := input.Message.Interface().(*LinkedAddress)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3221,21 +6926,12 @@ func (x *fastReflection_EnterpriseUserAccount) ProtoMethods() *protoiface.Method
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.LockedEfund != nil {
-			l = options.Size(x.LockedEfund)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.GeneralSupply != nil {
-			l = options.Size(x.GeneralSupply)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.SpentEfund != nil {
-			l = options.Size(x.SpentEfund)
+		l = len(x.Linked)
+		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Spendable != nil {
-			l = options.Size(x.Spendable)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.AddedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.AddedAt))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3247,7 +6943,7 @@ func (x *fastReflection_EnterpriseUserAccount) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EnterpriseUserAccount)
+		x := input.Message.Interface().(*LinkedAddress)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3266,59 +6962,15 @@ func (x *fastReflection_EnterpriseUserAccount) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Spendable != nil {
-			encoded, err := options.Marshal(x.Spendable)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if x.SpentEfund != nil {
-			encoded, err := options.Marshal(x.SpentEfund)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.GeneralSupply != nil {
-			encoded, err := options.Marshal(x.GeneralSupply)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if x.AddedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AddedAt))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if x.LockedEfund != nil {
-			encoded, err := options.Marshal(x.LockedEfund)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+		if len(x.Linked) > 0 {
+			i -= len(x.Linked)
+			copy(dAtA[i:], x.Linked)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Linked)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -3340,7 +6992,7 @@ func (x *fastReflection_EnterpriseUserAccount) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EnterpriseUserAccount)
+		x := input.Message.Interface().(*LinkedAddress)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3372,10 +7024,10 @@ func (x *fastReflection_EnterpriseUserAccount) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EnterpriseUserAccount: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LinkedAddress: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EnterpriseUserAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LinkedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3412,9 +7064,9 @@ func (x *fastReflection_EnterpriseUserAccount) ProtoMethods() *protoiface.Method
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedEfund", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Linked", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3424,105 +7076,29 @@ func (x *fastReflection_EnterpriseUserAccount) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.LockedEfund == nil {
-					x.LockedEfund = &v1beta1.Coin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockedEfund); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Linked = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GeneralSupply", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.GeneralSupply == nil {
-					x.GeneralSupply = &v1beta1.Coin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.GeneralSupply); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpentEfund", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.SpentEfund == nil {
-					x.SpentEfund = &v1beta1.Coin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpentEfund); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Spendable", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
 				}
-				var msglen int
+				x.AddedAt = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3532,28 +7108,11 @@ func (x *fastReflection_EnterpriseUserAccount) ProtoMethods() *protoiface.Method
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					x.AddedAt |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Spendable == nil {
-					x.Spendable = &v1beta1.Coin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Spendable); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3589,73 +7148,31 @@ func (x *fastReflection_EnterpriseUserAccount) ProtoMethods() *protoiface.Method
 	}
 }
 
-var _ protoreflect.List = (*_WhitelistAddresses_1_list)(nil)
-
-type _WhitelistAddresses_1_list struct {
-	list *[]string
-}
-
-func (x *_WhitelistAddresses_1_list) Len() int {
-	if x.list == nil {
-		return 0
-	}
-	return len(*x.list)
-}
-
-func (x *_WhitelistAddresses_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
-}
-
-func (x *_WhitelistAddresses_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	(*x.list)[i] = concreteValue
-}
-
-func (x *_WhitelistAddresses_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
-	*x.list = append(*x.list, concreteValue)
-}
-
-func (x *_WhitelistAddresses_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message WhitelistAddresses at list field Addresses as it is not of Message kind"))
-}
-
-func (x *_WhitelistAddresses_1_list) Truncate(n int) {
-	*x.list = (*x.list)[:n]
-}
-
-func (x *_WhitelistAddresses_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
-}
-
-func (x *_WhitelistAddresses_1_list) IsValid() bool {
-	return x.list != nil
-}
-
 var (
-	md_WhitelistAddresses           protoreflect.MessageDescriptor
-	fd_WhitelistAddresses_addresses protoreflect.FieldDescriptor
+	md_EntSigner          protoreflect.MessageDescriptor
+	fd_EntSigner_address  protoreflect.FieldDescriptor
+	fd_EntSigner_name     protoreflect.FieldDescriptor
+	fd_EntSigner_added_at protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mainchain_enterprise_v1_enterprise_proto_init()
-	md_WhitelistAddresses = File_mainchain_enterprise_v1_enterprise_proto.Messages().ByName("WhitelistAddresses")
-	fd_WhitelistAddresses_addresses = md_WhitelistAddresses.Fields().ByName("addresses")
+	md_EntSigner = File_mainchain_enterprise_v1_enterprise_proto.Messages().ByName("EntSigner")
+	fd_EntSigner_address = md_EntSigner.Fields().ByName("address")
+	fd_EntSigner_name = md_EntSigner.Fields().ByName("name")
+	fd_EntSigner_added_at = md_EntSigner.Fields().ByName("added_at")
 }
 
-var _ protoreflect.Message = (*fastReflection_WhitelistAddresses)(nil)
+var _ protoreflect.Message = (*fastReflection_EntSigner)(nil)
 
-type fastReflection_WhitelistAddresses WhitelistAddresses
+type fastReflection_EntSigner EntSigner
 
-func (x *WhitelistAddresses) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_WhitelistAddresses)(x)
+func (x *EntSigner) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EntSigner)(x)
 }
 
-func (x *WhitelistAddresses) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[6]
+func (x *EntSigner) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3666,43 +7183,43 @@ func (x *WhitelistAddresses) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_WhitelistAddresses_messageType fastReflection_WhitelistAddresses_messageType
-var _ protoreflect.MessageType = fastReflection_WhitelistAddresses_messageType{}
+var _fastReflection_EntSigner_messageType fastReflection_EntSigner_messageType
+var _ protoreflect.MessageType = fastReflection_EntSigner_messageType{}
 
-type fastReflection_WhitelistAddresses_messageType struct{}
+type fastReflection_EntSigner_messageType struct{}
 
-func (x fastReflection_WhitelistAddresses_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_WhitelistAddresses)(nil)
+func (x fastReflection_EntSigner_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EntSigner)(nil)
 }
-func (x fastReflection_WhitelistAddresses_messageType) New() protoreflect.Message {
-	return new(fastReflection_WhitelistAddresses)
+func (x fastReflection_EntSigner_messageType) New() protoreflect.Message {
+	return new(fastReflection_EntSigner)
 }
-func (x fastReflection_WhitelistAddresses_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_WhitelistAddresses
+func (x fastReflection_EntSigner_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EntSigner
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_WhitelistAddresses) Descriptor() protoreflect.MessageDescriptor {
-	return md_WhitelistAddresses
+func (x *fastReflection_EntSigner) Descriptor() protoreflect.MessageDescriptor {
+	return md_EntSigner
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_WhitelistAddresses) Type() protoreflect.MessageType {
-	return _fastReflection_WhitelistAddresses_messageType
+func (x *fastReflection_EntSigner) Type() protoreflect.MessageType {
+	return _fastReflection_EntSigner_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_WhitelistAddresses) New() protoreflect.Message {
-	return new(fastReflection_WhitelistAddresses)
+func (x *fastReflection_EntSigner) New() protoreflect.Message {
+	return new(fastReflection_EntSigner)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_WhitelistAddresses) Interface() protoreflect.ProtoMessage {
-	return (*WhitelistAddresses)(x)
+func (x *fastReflection_EntSigner) Interface() protoreflect.ProtoMessage {
+	return (*EntSigner)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3710,10 +7227,22 @@ func (x *fastReflection_WhitelistAddresses) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_WhitelistAddresses) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Addresses) != 0 {
-		value := protoreflect.ValueOfList(&_WhitelistAddresses_1_list{list: &x.Addresses})
-		if !f(fd_WhitelistAddresses_addresses, value) {
+func (x *fastReflection_EntSigner) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_EntSigner_address, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_EntSigner_name, value) {
+			return
+		}
+	}
+	if x.AddedAt != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AddedAt)
+		if !f(fd_EntSigner_added_at, value) {
 			return
 		}
 	}
@@ -3730,15 +7259,19 @@ func (x *fastReflection_WhitelistAddresses) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_WhitelistAddresses) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EntSigner) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.WhitelistAddresses.addresses":
-		return len(x.Addresses) != 0
+	case "mainchain.enterprise.v1.EntSigner.address":
+		return x.Address != ""
+	case "mainchain.enterprise.v1.EntSigner.name":
+		return x.Name != ""
+	case "mainchain.enterprise.v1.EntSigner.added_at":
+		return x.AddedAt != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistAddresses"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EntSigner"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistAddresses does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EntSigner does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3748,15 +7281,19 @@ func (x *fastReflection_WhitelistAddresses) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WhitelistAddresses) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EntSigner) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.WhitelistAddresses.addresses":
-		x.Addresses = nil
+	case "mainchain.enterprise.v1.EntSigner.address":
+		x.Address = ""
+	case "mainchain.enterprise.v1.EntSigner.name":
+		x.Name = ""
+	case "mainchain.enterprise.v1.EntSigner.added_at":
+		x.AddedAt = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistAddresses"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EntSigner"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistAddresses does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EntSigner does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3766,19 +7303,22 @@ func (x *fastReflection_WhitelistAddresses) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_WhitelistAddresses) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EntSigner) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mainchain.enterprise.v1.WhitelistAddresses.addresses":
-		if len(x.Addresses) == 0 {
-			return protoreflect.ValueOfList(&_WhitelistAddresses_1_list{})
-		}
-		listValue := &_WhitelistAddresses_1_list{list: &x.Addresses}
-		return protoreflect.ValueOfList(listValue)
+	case "mainchain.enterprise.v1.EntSigner.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "mainchain.enterprise.v1.EntSigner.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "mainchain.enterprise.v1.EntSigner.added_at":
+		value := x.AddedAt
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistAddresses"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EntSigner"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistAddresses does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EntSigner does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3792,17 +7332,19 @@ func (x *fastReflection_WhitelistAddresses) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WhitelistAddresses) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EntSigner) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.WhitelistAddresses.addresses":
-		lv := value.List()
-		clv := lv.(*_WhitelistAddresses_1_list)
-		x.Addresses = *clv.list
+	case "mainchain.enterprise.v1.EntSigner.address":
+		x.Address = value.Interface().(string)
+	case "mainchain.enterprise.v1.EntSigner.name":
+		x.Name = value.Interface().(string)
+	case "mainchain.enterprise.v1.EntSigner.added_at":
+		x.AddedAt = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistAddresses"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EntSigner"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistAddresses does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EntSigner does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3816,45 +7358,48 @@ func (x *fastReflection_WhitelistAddresses) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WhitelistAddresses) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EntSigner) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.WhitelistAddresses.addresses":
-		if x.Addresses == nil {
-			x.Addresses = []string{}
-		}
-		value := &_WhitelistAddresses_1_list{list: &x.Addresses}
-		return protoreflect.ValueOfList(value)
+	case "mainchain.enterprise.v1.EntSigner.address":
+		panic(fmt.Errorf("field address of message mainchain.enterprise.v1.EntSigner is not mutable"))
+	case "mainchain.enterprise.v1.EntSigner.name":
+		panic(fmt.Errorf("field name of message mainchain.enterprise.v1.EntSigner is not mutable"))
+	case "mainchain.enterprise.v1.EntSigner.added_at":
+		panic(fmt.Errorf("field added_at of message mainchain.enterprise.v1.EntSigner is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistAddresses"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EntSigner"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistAddresses does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EntSigner does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_WhitelistAddresses) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EntSigner) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.WhitelistAddresses.addresses":
-		list := []string{}
-		return protoreflect.ValueOfList(&_WhitelistAddresses_1_list{list: &list})
+	case "mainchain.enterprise.v1.EntSigner.address":
+		return protoreflect.ValueOfString("")
+	case "mainchain.enterprise.v1.EntSigner.name":
+		return protoreflect.ValueOfString("")
+	case "mainchain.enterprise.v1.EntSigner.added_at":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.WhitelistAddresses"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.EntSigner"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.WhitelistAddresses does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.EntSigner does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_WhitelistAddresses) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EntSigner) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.WhitelistAddresses", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.EntSigner", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3862,7 +7407,7 @@ func (x *fastReflection_WhitelistAddresses) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_WhitelistAddresses) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EntSigner) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3873,7 +7418,7 @@ func (x *fastReflection_WhitelistAddresses) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_WhitelistAddresses) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EntSigner) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3885,7 +7430,7 @@ func (x *fastReflection_WhitelistAddresses) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_WhitelistAddresses) IsValid() bool {
+func (x *fastReflection_EntSigner) IsValid() bool {
 	return x != nil
 }
 
@@ -3895,9 +7440,9 @@ func (x *fastReflection_WhitelistAddresses) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_WhitelistAddresses) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EntSigner) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*WhitelistAddresses)
+		x := input.Message.Interface().(*EntSigner)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3909,11 +7454,16 @@ func (x *fastReflection_WhitelistAddresses) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if len(x.Addresses) > 0 {
-			for _, s := range x.Addresses {
-				l = len(s)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AddedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.AddedAt))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -3925,7 +7475,7 @@ func (x *fastReflection_WhitelistAddresses) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*WhitelistAddresses)
+		x := input.Message.Interface().(*EntSigner)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3944,14 +7494,24 @@ func (x *fastReflection_WhitelistAddresses) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Addresses) > 0 {
-			for iNdEx := len(x.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Addresses[iNdEx])
-				copy(dAtA[i:], x.Addresses[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Addresses[iNdEx])))
-				i--
-				dAtA[i] = 0xa
-			}
+		if x.AddedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AddedAt))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -3964,7 +7524,7 @@ func (x *fastReflection_WhitelistAddresses) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*WhitelistAddresses)
+		x := input.Message.Interface().(*EntSigner)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3996,15 +7556,15 @@ func (x *fastReflection_WhitelistAddresses) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WhitelistAddresses: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EntSigner: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: WhitelistAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EntSigner: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4032,8 +7592,59 @@ func (x *fastReflection_WhitelistAddresses) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Addresses = append(x.Addresses, string(dAtA[iNdEx:postIndex]))
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+				}
+				x.AddedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AddedAt |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4069,12 +7680,63 @@ func (x *fastReflection_WhitelistAddresses) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_Params_6_list)(nil)
+
+type _Params_6_list struct {
+	list *[]string
+}
+
+func (x *_Params_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field EfundFeeMsgTypes as it is not of Message kind"))
+}
+
+func (x *_Params_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                     protoreflect.MessageDescriptor
-	fd_Params_ent_signers         protoreflect.FieldDescriptor
-	fd_Params_denom               protoreflect.FieldDescriptor
-	fd_Params_min_accepts         protoreflect.FieldDescriptor
-	fd_Params_decision_time_limit protoreflect.FieldDescriptor
+	md_Params                         protoreflect.MessageDescriptor
+	fd_Params_ent_signers             protoreflect.FieldDescriptor
+	fd_Params_denom                   protoreflect.FieldDescriptor
+	fd_Params_min_accepts             protoreflect.FieldDescriptor
+	fd_Params_decision_time_limit     protoreflect.FieldDescriptor
+	fd_Params_efund_expiry_period     protoreflect.FieldDescriptor
+	fd_Params_efund_fee_msg_types     protoreflect.FieldDescriptor
+	fd_Params_group_policy_address    protoreflect.FieldDescriptor
+	fd_Params_ledger_retention_period protoreflect.FieldDescriptor
+	fd_Params_efund_max_fee           protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -4084,6 +7746,11 @@ func init() {
 	fd_Params_denom = md_Params.Fields().ByName("denom")
 	fd_Params_min_accepts = md_Params.Fields().ByName("min_accepts")
 	fd_Params_decision_time_limit = md_Params.Fields().ByName("decision_time_limit")
+	fd_Params_efund_expiry_period = md_Params.Fields().ByName("efund_expiry_period")
+	fd_Params_efund_fee_msg_types = md_Params.Fields().ByName("efund_fee_msg_types")
+	fd_Params_group_policy_address = md_Params.Fields().ByName("group_policy_address")
+	fd_Params_ledger_retention_period = md_Params.Fields().ByName("ledger_retention_period")
+	fd_Params_efund_max_fee = md_Params.Fields().ByName("efund_max_fee")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -4095,7 +7762,7 @@ func (x *Params) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[7]
+	mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4175,6 +7842,36 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.EfundExpiryPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EfundExpiryPeriod)
+		if !f(fd_Params_efund_expiry_period, value) {
+			return
+		}
+	}
+	if len(x.EfundFeeMsgTypes) != 0 {
+		value := protoreflect.ValueOfList(&_Params_6_list{list: &x.EfundFeeMsgTypes})
+		if !f(fd_Params_efund_fee_msg_types, value) {
+			return
+		}
+	}
+	if x.GroupPolicyAddress != "" {
+		value := protoreflect.ValueOfString(x.GroupPolicyAddress)
+		if !f(fd_Params_group_policy_address, value) {
+			return
+		}
+	}
+	if x.LedgerRetentionPeriod != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LedgerRetentionPeriod)
+		if !f(fd_Params_ledger_retention_period, value) {
+			return
+		}
+	}
+	if x.EfundMaxFee != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EfundMaxFee)
+		if !f(fd_Params_efund_max_fee, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4198,6 +7895,16 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.MinAccepts != uint64(0)
 	case "mainchain.enterprise.v1.Params.decision_time_limit":
 		return x.DecisionTimeLimit != uint64(0)
+	case "mainchain.enterprise.v1.Params.efund_expiry_period":
+		return x.EfundExpiryPeriod != uint64(0)
+	case "mainchain.enterprise.v1.Params.efund_fee_msg_types":
+		return len(x.EfundFeeMsgTypes) != 0
+	case "mainchain.enterprise.v1.Params.group_policy_address":
+		return x.GroupPolicyAddress != ""
+	case "mainchain.enterprise.v1.Params.ledger_retention_period":
+		return x.LedgerRetentionPeriod != uint64(0)
+	case "mainchain.enterprise.v1.Params.efund_max_fee":
+		return x.EfundMaxFee != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.Params"))
@@ -4222,6 +7929,16 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.MinAccepts = uint64(0)
 	case "mainchain.enterprise.v1.Params.decision_time_limit":
 		x.DecisionTimeLimit = uint64(0)
+	case "mainchain.enterprise.v1.Params.efund_expiry_period":
+		x.EfundExpiryPeriod = uint64(0)
+	case "mainchain.enterprise.v1.Params.efund_fee_msg_types":
+		x.EfundFeeMsgTypes = nil
+	case "mainchain.enterprise.v1.Params.group_policy_address":
+		x.GroupPolicyAddress = ""
+	case "mainchain.enterprise.v1.Params.ledger_retention_period":
+		x.LedgerRetentionPeriod = uint64(0)
+	case "mainchain.enterprise.v1.Params.efund_max_fee":
+		x.EfundMaxFee = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.Params"))
@@ -4250,6 +7967,24 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "mainchain.enterprise.v1.Params.decision_time_limit":
 		value := x.DecisionTimeLimit
 		return protoreflect.ValueOfUint64(value)
+	case "mainchain.enterprise.v1.Params.efund_expiry_period":
+		value := x.EfundExpiryPeriod
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.enterprise.v1.Params.efund_fee_msg_types":
+		if len(x.EfundFeeMsgTypes) == 0 {
+			return protoreflect.ValueOfList(&_Params_6_list{})
+		}
+		listValue := &_Params_6_list{list: &x.EfundFeeMsgTypes}
+		return protoreflect.ValueOfList(listValue)
+	case "mainchain.enterprise.v1.Params.group_policy_address":
+		value := x.GroupPolicyAddress
+		return protoreflect.ValueOfString(value)
+	case "mainchain.enterprise.v1.Params.ledger_retention_period":
+		value := x.LedgerRetentionPeriod
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.enterprise.v1.Params.efund_max_fee":
+		value := x.EfundMaxFee
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.Params"))
@@ -4278,6 +8013,18 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.MinAccepts = value.Uint()
 	case "mainchain.enterprise.v1.Params.decision_time_limit":
 		x.DecisionTimeLimit = value.Uint()
+	case "mainchain.enterprise.v1.Params.efund_expiry_period":
+		x.EfundExpiryPeriod = value.Uint()
+	case "mainchain.enterprise.v1.Params.efund_fee_msg_types":
+		lv := value.List()
+		clv := lv.(*_Params_6_list)
+		x.EfundFeeMsgTypes = *clv.list
+	case "mainchain.enterprise.v1.Params.group_policy_address":
+		x.GroupPolicyAddress = value.Interface().(string)
+	case "mainchain.enterprise.v1.Params.ledger_retention_period":
+		x.LedgerRetentionPeriod = value.Uint()
+	case "mainchain.enterprise.v1.Params.efund_max_fee":
+		x.EfundMaxFee = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.Params"))
@@ -4298,6 +8045,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "mainchain.enterprise.v1.Params.efund_fee_msg_types":
+		if x.EfundFeeMsgTypes == nil {
+			x.EfundFeeMsgTypes = []string{}
+		}
+		value := &_Params_6_list{list: &x.EfundFeeMsgTypes}
+		return protoreflect.ValueOfList(value)
 	case "mainchain.enterprise.v1.Params.ent_signers":
 		panic(fmt.Errorf("field ent_signers of message mainchain.enterprise.v1.Params is not mutable"))
 	case "mainchain.enterprise.v1.Params.denom":
@@ -4306,6 +8059,14 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field min_accepts of message mainchain.enterprise.v1.Params is not mutable"))
 	case "mainchain.enterprise.v1.Params.decision_time_limit":
 		panic(fmt.Errorf("field decision_time_limit of message mainchain.enterprise.v1.Params is not mutable"))
+	case "mainchain.enterprise.v1.Params.efund_expiry_period":
+		panic(fmt.Errorf("field efund_expiry_period of message mainchain.enterprise.v1.Params is not mutable"))
+	case "mainchain.enterprise.v1.Params.group_policy_address":
+		panic(fmt.Errorf("field group_policy_address of message mainchain.enterprise.v1.Params is not mutable"))
+	case "mainchain.enterprise.v1.Params.ledger_retention_period":
+		panic(fmt.Errorf("field ledger_retention_period of message mainchain.enterprise.v1.Params is not mutable"))
+	case "mainchain.enterprise.v1.Params.efund_max_fee":
+		panic(fmt.Errorf("field efund_max_fee of message mainchain.enterprise.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.Params"))
@@ -4327,6 +8088,17 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfUint64(uint64(0))
 	case "mainchain.enterprise.v1.Params.decision_time_limit":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.enterprise.v1.Params.efund_expiry_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.enterprise.v1.Params.efund_fee_msg_types":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_6_list{list: &list})
+	case "mainchain.enterprise.v1.Params.group_policy_address":
+		return protoreflect.ValueOfString("")
+	case "mainchain.enterprise.v1.Params.ledger_retention_period":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.enterprise.v1.Params.efund_max_fee":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.Params"))
@@ -4410,6 +8182,25 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.DecisionTimeLimit != 0 {
 			n += 1 + runtime.Sov(uint64(x.DecisionTimeLimit))
 		}
+		if x.EfundExpiryPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.EfundExpiryPeriod))
+		}
+		if len(x.EfundFeeMsgTypes) > 0 {
+			for _, s := range x.EfundFeeMsgTypes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.GroupPolicyAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LedgerRetentionPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.LedgerRetentionPeriod))
+		}
+		if x.EfundMaxFee != 0 {
+			n += 1 + runtime.Sov(uint64(x.EfundMaxFee))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4439,6 +8230,37 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.EfundMaxFee != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EfundMaxFee))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.LedgerRetentionPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LedgerRetentionPeriod))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.GroupPolicyAddress) > 0 {
+			i -= len(x.GroupPolicyAddress)
+			copy(dAtA[i:], x.GroupPolicyAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.GroupPolicyAddress)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.EfundFeeMsgTypes) > 0 {
+			for iNdEx := len(x.EfundFeeMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.EfundFeeMsgTypes[iNdEx])
+				copy(dAtA[i:], x.EfundFeeMsgTypes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EfundFeeMsgTypes[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.EfundExpiryPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EfundExpiryPeriod))
+			i--
+			dAtA[i] = 0x28
+		}
 		if x.DecisionTimeLimit != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.DecisionTimeLimit))
 			i--
@@ -4493,28 +8315,149 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EntSigners", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EntSigners = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinAccepts", wireType)
+				}
+				x.MinAccepts = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinAccepts |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecisionTimeLimit", wireType)
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.DecisionTimeLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DecisionTimeLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EfundExpiryPeriod", wireType)
+				}
+				x.EfundExpiryPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EfundExpiryPeriod |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EntSigners", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EfundFeeMsgTypes", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4542,11 +8485,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.EntSigners = string(dAtA[iNdEx:postIndex])
+				x.EfundFeeMsgTypes = append(x.EfundFeeMsgTypes, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
-			case 2:
+			case 7:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4574,13 +8517,13 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
+				x.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 8:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinAccepts", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LedgerRetentionPeriod", wireType)
 				}
-				x.MinAccepts = 0
+				x.LedgerRetentionPeriod = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4590,16 +8533,16 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.MinAccepts |= uint64(b&0x7F) << shift
+					x.LedgerRetentionPeriod |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 4:
+			case 9:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecisionTimeLimit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EfundMaxFee", wireType)
 				}
-				x.DecisionTimeLimit = 0
+				x.EfundMaxFee = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -4609,7 +8552,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.DecisionTimeLimit |= uint64(b&0x7F) << shift
+					x.EfundMaxFee |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -4676,6 +8619,8 @@ const (
 	PurchaseOrderStatus_STATUS_REJECTED PurchaseOrderStatus = 3
 	// STATUS_COMPLETED defines a completed status.
 	PurchaseOrderStatus_STATUS_COMPLETED PurchaseOrderStatus = 4
+	// STATUS_WITHDRAWN defines a withdrawn status.
+	PurchaseOrderStatus_STATUS_WITHDRAWN PurchaseOrderStatus = 5
 )
 
 // Enum value maps for PurchaseOrderStatus.
@@ -4686,6 +8631,7 @@ var (
 		2: "STATUS_ACCEPTED",
 		3: "STATUS_REJECTED",
 		4: "STATUS_COMPLETED",
+		5: "STATUS_WITHDRAWN",
 	}
 	PurchaseOrderStatus_value = map[string]int32{
 		"STATUS_NIL":       0,
@@ -4693,6 +8639,7 @@ var (
 		"STATUS_ACCEPTED":  2,
 		"STATUS_REJECTED":  3,
 		"STATUS_COMPLETED": 4,
+		"STATUS_WITHDRAWN": 5,
 	}
 )
 
@@ -4776,6 +8723,71 @@ func (WhitelistAction) EnumDescriptor() ([]byte, []int) {
 	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{1}
 }
 
+// LedgerEntryType enumerates the events recorded in an account's eFUND ledger.
+type LedgerEntryType int32
+
+const (
+	// LEDGER_ENTRY_TYPE_NIL defines a no-op type.
+	LedgerEntryType_LEDGER_ENTRY_TYPE_NIL LedgerEntryType = 0
+	// LEDGER_ENTRY_TYPE_PURCHASE_ORDER defines eFUND locked by an accepted purchase order.
+	LedgerEntryType_LEDGER_ENTRY_TYPE_PURCHASE_ORDER LedgerEntryType = 1
+	// LEDGER_ENTRY_TYPE_FEE defines locked eFUND unlocked and minted to pay Tx fees.
+	LedgerEntryType_LEDGER_ENTRY_TYPE_FEE LedgerEntryType = 2
+	// LEDGER_ENTRY_TYPE_EXPIRED defines locked eFUND removed when a lot expired.
+	LedgerEntryType_LEDGER_ENTRY_TYPE_EXPIRED LedgerEntryType = 3
+	// LEDGER_ENTRY_TYPE_TRANSFER_OUT defines locked eFUND transferred to another account.
+	LedgerEntryType_LEDGER_ENTRY_TYPE_TRANSFER_OUT LedgerEntryType = 4
+	// LEDGER_ENTRY_TYPE_TRANSFER_IN defines locked eFUND transferred from another account.
+	LedgerEntryType_LEDGER_ENTRY_TYPE_TRANSFER_IN LedgerEntryType = 5
+)
+
+// Enum value maps for LedgerEntryType.
+var (
+	LedgerEntryType_name = map[int32]string{
+		0: "LEDGER_ENTRY_TYPE_NIL",
+		1: "LEDGER_ENTRY_TYPE_PURCHASE_ORDER",
+		2: "LEDGER_ENTRY_TYPE_FEE",
+		3: "LEDGER_ENTRY_TYPE_EXPIRED",
+		4: "LEDGER_ENTRY_TYPE_TRANSFER_OUT",
+		5: "LEDGER_ENTRY_TYPE_TRANSFER_IN",
+	}
+	LedgerEntryType_value = map[string]int32{
+		"LEDGER_ENTRY_TYPE_NIL":            0,
+		"LEDGER_ENTRY_TYPE_PURCHASE_ORDER": 1,
+		"LEDGER_ENTRY_TYPE_FEE":            2,
+		"LEDGER_ENTRY_TYPE_EXPIRED":        3,
+		"LEDGER_ENTRY_TYPE_TRANSFER_OUT":   4,
+		"LEDGER_ENTRY_TYPE_TRANSFER_IN":    5,
+	}
+)
+
+func (x LedgerEntryType) Enum() *LedgerEntryType {
+	p := new(LedgerEntryType)
+	*p = x
+	return p
+}
+
+func (x LedgerEntryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LedgerEntryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_mainchain_enterprise_v1_enterprise_proto_enumTypes[2].Descriptor()
+}
+
+func (LedgerEntryType) Type() protoreflect.EnumType {
+	return &file_mainchain_enterprise_v1_enterprise_proto_enumTypes[2]
+}
+
+func (x LedgerEntryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LedgerEntryType.Descriptor instead.
+func (LedgerEntryType) EnumDescriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{2}
+}
+
 // PurchaseOrderDecision defines a decision made for a given purchase order, ie,
 // whether to accept or reject
 type PurchaseOrderDecision struct {
@@ -4852,6 +8864,9 @@ type EnterpriseUndPurchaseOrder struct {
 	CompletionTime uint64 `protobuf:"varint,6,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
 	// decisions is an array of decisions made by authorised addresses
 	Decisions []*PurchaseOrderDecision `protobuf:"bytes,7,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	// efund_expiry_time is a unix epoch value of the time the eFUND locked by the order expires. It is set
+	// from the efund_expiry_period param when the order is accepted. 0 if the eFUND does not expire
+	EfundExpiryTime uint64 `protobuf:"varint,8,opt,name=efund_expiry_time,json=efundExpiryTime,proto3" json:"efund_expiry_time,omitempty"`
 }
 
 func (x *EnterpriseUndPurchaseOrder) Reset() {
@@ -4923,6 +8938,13 @@ func (x *EnterpriseUndPurchaseOrder) GetDecisions() []*PurchaseOrderDecision {
 	return nil
 }
 
+func (x *EnterpriseUndPurchaseOrder) GetEfundExpiryTime() uint64 {
+	if x != nil {
+		return x.EfundExpiryTime
+	}
+	return 0
+}
+
 // PurchaseOrders defines a list of purchase orders
 type PurchaseOrders struct {
 	state         protoimpl.MessageState
@@ -4952,57 +8974,277 @@ func (*PurchaseOrders) Descriptor() ([]byte, []int) {
 	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *PurchaseOrders) GetPurchaseOrders() []*EnterpriseUndPurchaseOrder {
+func (x *PurchaseOrders) GetPurchaseOrders() []*EnterpriseUndPurchaseOrder {
+	if x != nil {
+		return x.PurchaseOrders
+	}
+	return nil
+}
+
+// LockedUnd defines the amount of locked FUND for an account
+type LockedUnd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner is the address of the locked FUND owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// amount is the amount currently locked and available to pay for beacon/wrkchain fees
+	Amount *v1beta1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *LockedUnd) Reset() {
+	*x = LockedUnd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockedUnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockedUnd) ProtoMessage() {}
+
+// Deprecated: Use LockedUnd.ProtoReflect.Descriptor instead.
+func (*LockedUnd) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LockedUnd) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *LockedUnd) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// LockedEFUNDLot defines an amount of locked eFUND created by an accepted purchase order. An account's
+// lots are consumed oldest first, and any remaining amount is removed once the lot expires
+type LockedEFUNDLot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique id of the lot
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the locked eFUND owner
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// purchase_order_id is the id of the purchase order which created the lot. 0 for eFUND locked before lots existed
+	PurchaseOrderId uint64 `protobuf:"varint,3,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"`
+	// amount is the amount remaining in the lot
+	Amount *v1beta1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// locked_at is the unix time at which the lot was created
+	LockedAt uint64 `protobuf:"varint,5,opt,name=locked_at,json=lockedAt,proto3" json:"locked_at,omitempty"`
+	// expiry_time is the unix time at which any remaining amount expires. 0 if the lot does not expire
+	ExpiryTime uint64 `protobuf:"varint,6,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+}
+
+func (x *LockedEFUNDLot) Reset() {
+	*x = LockedEFUNDLot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LockedEFUNDLot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LockedEFUNDLot) ProtoMessage() {}
+
+// Deprecated: Use LockedEFUNDLot.ProtoReflect.Descriptor instead.
+func (*LockedEFUNDLot) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LockedEFUNDLot) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LockedEFUNDLot) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *LockedEFUNDLot) GetPurchaseOrderId() uint64 {
+	if x != nil {
+		return x.PurchaseOrderId
+	}
+	return 0
+}
+
+func (x *LockedEFUNDLot) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *LockedEFUNDLot) GetLockedAt() uint64 {
+	if x != nil {
+		return x.LockedAt
+	}
+	return 0
+}
+
+func (x *LockedEFUNDLot) GetExpiryTime() uint64 {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return 0
+}
+
+// EFUNDLedgerEntry defines a single credit or debit of an account's locked eFUND, with the account's running
+// balances after it was applied
+type EFUNDLedgerEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique id of the entry
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the eFUND owner
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// entry_type is the LedgerEntryType
+	EntryType LedgerEntryType `protobuf:"varint,3,opt,name=entry_type,json=entryType,proto3,enum=mainchain.enterprise.v1.LedgerEntryType" json:"entry_type,omitempty"`
+	// amount is the amount credited to, or debited from, the account's locked eFUND
+	Amount *v1beta1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// purchase_order_id is the id of the purchase order the entry relates to, if any
+	PurchaseOrderId uint64 `protobuf:"varint,5,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"`
+	// tx_hash is the hash of the Tx whose fees were paid. Fee entries only
+	TxHash string `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// msg_types are the Msg type URLs in the Tx whose fees were paid. Fee entries only
+	MsgTypes []string `protobuf:"bytes,7,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty"`
+	// height is the block height at which the entry was recorded
+	Height int64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	// timestamp is the unix time at which the entry was recorded
+	Timestamp uint64 `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// locked_balance is the account's locked eFUND after the entry was applied
+	LockedBalance *v1beta1.Coin `protobuf:"bytes,10,opt,name=locked_balance,json=lockedBalance,proto3" json:"locked_balance,omitempty"`
+	// spent_balance is the account's spent eFUND after the entry was applied
+	SpentBalance *v1beta1.Coin `protobuf:"bytes,11,opt,name=spent_balance,json=spentBalance,proto3" json:"spent_balance,omitempty"`
+	// counterparty is the other account in a locked eFUND transfer. Transfer entries only
+	Counterparty string `protobuf:"bytes,12,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+}
+
+func (x *EFUNDLedgerEntry) Reset() {
+	*x = EFUNDLedgerEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EFUNDLedgerEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EFUNDLedgerEntry) ProtoMessage() {}
+
+// Deprecated: Use EFUNDLedgerEntry.ProtoReflect.Descriptor instead.
+func (*EFUNDLedgerEntry) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EFUNDLedgerEntry) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *EFUNDLedgerEntry) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EFUNDLedgerEntry) GetEntryType() LedgerEntryType {
+	if x != nil {
+		return x.EntryType
+	}
+	return LedgerEntryType_LEDGER_ENTRY_TYPE_NIL
+}
+
+func (x *EFUNDLedgerEntry) GetAmount() *v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *EFUNDLedgerEntry) GetPurchaseOrderId() uint64 {
+	if x != nil {
+		return x.PurchaseOrderId
+	}
+	return 0
+}
+
+func (x *EFUNDLedgerEntry) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *EFUNDLedgerEntry) GetMsgTypes() []string {
 	if x != nil {
-		return x.PurchaseOrders
+		return x.MsgTypes
 	}
 	return nil
 }
 
-// LockedUnd defines the amount of locked FUND for an account
-type LockedUnd struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// owner is the address of the locked FUND owner
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// amount is the amount currently locked and available to pay for beacon/wrkchain fees
-	Amount *v1beta1.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-}
-
-func (x *LockedUnd) Reset() {
-	*x = LockedUnd{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *EFUNDLedgerEntry) GetHeight() int64 {
+	if x != nil {
+		return x.Height
 	}
+	return 0
 }
 
-func (x *LockedUnd) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *EFUNDLedgerEntry) GetTimestamp() uint64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
 }
 
-func (*LockedUnd) ProtoMessage() {}
-
-// Deprecated: Use LockedUnd.ProtoReflect.Descriptor instead.
-func (*LockedUnd) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{3}
+func (x *EFUNDLedgerEntry) GetLockedBalance() *v1beta1.Coin {
+	if x != nil {
+		return x.LockedBalance
+	}
+	return nil
 }
 
-func (x *LockedUnd) GetOwner() string {
+func (x *EFUNDLedgerEntry) GetSpentBalance() *v1beta1.Coin {
 	if x != nil {
-		return x.Owner
+		return x.SpentBalance
 	}
-	return ""
+	return nil
 }
 
-func (x *LockedUnd) GetAmount() *v1beta1.Coin {
+func (x *EFUNDLedgerEntry) GetCounterparty() string {
 	if x != nil {
-		return x.Amount
+		return x.Counterparty
 	}
-	return nil
+	return ""
 }
 
 // SpentEFUND defines the amount of spent eFUND for an account
@@ -5020,7 +9262,7 @@ type SpentEFUND struct {
 func (x *SpentEFUND) Reset() {
 	*x = SpentEFUND{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[4]
+		mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5034,7 +9276,7 @@ func (*SpentEFUND) ProtoMessage() {}
 
 // Deprecated: Use SpentEFUND.ProtoReflect.Descriptor instead.
 func (*SpentEFUND) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{4}
+	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SpentEFUND) GetOwner() string {
@@ -5075,7 +9317,7 @@ type EnterpriseUserAccount struct {
 func (x *EnterpriseUserAccount) Reset() {
 	*x = EnterpriseUserAccount{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[5]
+		mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5089,7 +9331,7 @@ func (*EnterpriseUserAccount) ProtoMessage() {}
 
 // Deprecated: Use EnterpriseUserAccount.ProtoReflect.Descriptor instead.
 func (*EnterpriseUserAccount) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{5}
+	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *EnterpriseUserAccount) GetOwner() string {
@@ -5139,7 +9381,7 @@ type WhitelistAddresses struct {
 func (x *WhitelistAddresses) Reset() {
 	*x = WhitelistAddresses{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[6]
+		mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5153,7 +9395,7 @@ func (*WhitelistAddresses) ProtoMessage() {}
 
 // Deprecated: Use WhitelistAddresses.ProtoReflect.Descriptor instead.
 func (*WhitelistAddresses) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{6}
+	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *WhitelistAddresses) GetAddresses() []string {
@@ -5163,13 +9405,201 @@ func (x *WhitelistAddresses) GetAddresses() []string {
 	return nil
 }
 
+// WhitelistEntry defines an address authorised to raise enterprise purchase orders, and the limits on its purchases
+type WhitelistEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the whitelisted address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// expiry_time is the unix time from which the address can no longer raise purchase orders. 0 means no expiry
+	ExpiryTime uint64 `protobuf:"varint,2,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	// max_purchasable is the maximum cumulative amount of eFUND the address can purchase, counted against its
+	// accepted purchase orders. A zero amount means no cap
+	MaxPurchasable *v1beta1.Coin `protobuf:"bytes,3,opt,name=max_purchasable,json=maxPurchasable,proto3" json:"max_purchasable,omitempty"`
+	// tier is an optional label for the whitelist entry
+	Tier string `protobuf:"bytes,4,opt,name=tier,proto3" json:"tier,omitempty"`
+	// added_at is the unix time at which the address was whitelisted
+	AddedAt uint64 `protobuf:"varint,5,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+}
+
+func (x *WhitelistEntry) Reset() {
+	*x = WhitelistEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WhitelistEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhitelistEntry) ProtoMessage() {}
+
+// Deprecated: Use WhitelistEntry.ProtoReflect.Descriptor instead.
+func (*WhitelistEntry) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *WhitelistEntry) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *WhitelistEntry) GetExpiryTime() uint64 {
+	if x != nil {
+		return x.ExpiryTime
+	}
+	return 0
+}
+
+func (x *WhitelistEntry) GetMaxPurchasable() *v1beta1.Coin {
+	if x != nil {
+		return x.MaxPurchasable
+	}
+	return nil
+}
+
+func (x *WhitelistEntry) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *WhitelistEntry) GetAddedAt() uint64 {
+	if x != nil {
+		return x.AddedAt
+	}
+	return 0
+}
+
+// LinkedAddress defines an ent signer approved link from an address holding locked eFUND to another address
+// it can transfer locked eFUND to, for example another account controlled by the same enterprise
+type LinkedAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner is the address which can transfer locked eFUND to the linked address
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// linked is the address which can receive locked eFUND from the owner
+	Linked string `protobuf:"bytes,2,opt,name=linked,proto3" json:"linked,omitempty"`
+	// added_at is the unix time at which the link was approved
+	AddedAt uint64 `protobuf:"varint,3,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+}
+
+func (x *LinkedAddress) Reset() {
+	*x = LinkedAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LinkedAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkedAddress) ProtoMessage() {}
+
+// Deprecated: Use LinkedAddress.ProtoReflect.Descriptor instead.
+func (*LinkedAddress) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LinkedAddress) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *LinkedAddress) GetLinked() string {
+	if x != nil {
+		return x.Linked
+	}
+	return ""
+}
+
+func (x *LinkedAddress) GetAddedAt() uint64 {
+	if x != nil {
+		return x.AddedAt
+	}
+	return 0
+}
+
+// EntSigner defines an address authorised to make decisions on raised purchase orders
+type EntSigner struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the address of the signer
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// name is an optional human readable identifier for the signer
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// added_at is the unix time at which the signer was added to the registry
+	AddedAt uint64 `protobuf:"varint,3,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+}
+
+func (x *EntSigner) Reset() {
+	*x = EntSigner{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EntSigner) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EntSigner) ProtoMessage() {}
+
+// Deprecated: Use EntSigner.ProtoReflect.Descriptor instead.
+func (*EntSigner) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *EntSigner) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *EntSigner) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EntSigner) GetAddedAt() uint64 {
+	if x != nil {
+		return x.AddedAt
+	}
+	return 0
+}
+
 // Params defines the parameters for the enterprise module.
 type Params struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ent_signers is a list of addresses authorised to make decisions on raised purchase orders
+	// ent_signers is a legacy comma separated list of addresses authorised to make decisions on raised purchase
+	// orders. Signers are now held in the on-chain signer registry, managed via MsgAddEntSigner and
+	// MsgRemoveEntSigner. This value is only read when importing legacy genesis and migrating the store, and
+	// must otherwise be empty.
 	EntSigners string `protobuf:"bytes,1,opt,name=ent_signers,json=entSigners,proto3" json:"ent_signers,omitempty"`
 	// denom is the denomination of eFUND, e.g. nund
 	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -5177,12 +9607,30 @@ type Params struct {
 	MinAccepts uint64 `protobuf:"varint,3,opt,name=min_accepts,json=minAccepts,proto3" json:"min_accepts,omitempty"`
 	// decision_time_limit is the time limit within which all decisions must be made for a raised purchase order.
 	DecisionTimeLimit uint64 `protobuf:"varint,4,opt,name=decision_time_limit,json=decisionTimeLimit,proto3" json:"decision_time_limit,omitempty"`
+	// efund_expiry_period is the number of seconds after a purchase order is accepted that its locked eFUND
+	// expires. The expiry time is recorded on each order when it is accepted. 0 means locked eFUND does not expire
+	EfundExpiryPeriod uint64 `protobuf:"varint,5,opt,name=efund_expiry_period,json=efundExpiryPeriod,proto3" json:"efund_expiry_period,omitempty"`
+	// efund_fee_msg_types is the list of Msg type URLs whose Tx fees can be paid using locked eFUND. Locked eFUND
+	// is only used if every Msg in the Tx is in the list
+	EfundFeeMsgTypes []string `protobuf:"bytes,6,rep,name=efund_fee_msg_types,json=efundFeeMsgTypes,proto3" json:"efund_fee_msg_types,omitempty"`
+	// group_policy_address is an optional x/group policy address. If set, purchase orders are decided by a single
+	// MsgProcessUndPurchaseOrder executed by the policy via a group proposal, using the group's decision policy.
+	// If empty, purchase orders are decided by the ent signers, tallied against min_accepts
+	GroupPolicyAddress string `protobuf:"bytes,7,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// ledger_retention_period is the number of seconds an account's eFUND ledger entries are kept for. Older
+	// entries are pruned as new entries are recorded for the account. 0 means entries are kept indefinitely
+	LedgerRetentionPeriod uint64 `protobuf:"varint,8,opt,name=ledger_retention_period,json=ledgerRetentionPeriod,proto3" json:"ledger_retention_period,omitempty"`
+	// efund_max_fee is the maximum amount of locked eFUND, in denom, that can be unlocked to pay the fees of a Tx
+	// containing any Msgs other than WRKChain and BEACON Msgs, whose fees are set by their own module params. Any
+	// fee above this amount must be paid from the fee payer's spendable FUND. 0 means no locked eFUND is unlocked
+	// for such Txs
+	EfundMaxFee uint64 `protobuf:"varint,9,opt,name=efund_max_fee,json=efundMaxFee,proto3" json:"efund_max_fee,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[7]
+		mi := &file_mainchain_enterprise_v1_enterprise_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -5196,7 +9644,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{7}
+	return file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *Params) GetEntSigners() string {
@@ -5227,6 +9675,41 @@ func (x *Params) GetDecisionTimeLimit() uint64 {
 	return 0
 }
 
+func (x *Params) GetEfundExpiryPeriod() uint64 {
+	if x != nil {
+		return x.EfundExpiryPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetEfundFeeMsgTypes() []string {
+	if x != nil {
+		return x.EfundFeeMsgTypes
+	}
+	return nil
+}
+
+func (x *Params) GetGroupPolicyAddress() string {
+	if x != nil {
+		return x.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (x *Params) GetLedgerRetentionPeriod() uint64 {
+	if x != nil {
+		return x.LedgerRetentionPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetEfundMaxFee() uint64 {
+	if x != nil {
+		return x.EfundMaxFee
+	}
+	return 0
+}
+
 var File_mainchain_enterprise_v1_enterprise_proto protoreflect.FileDescriptor
 
 var file_mainchain_enterprise_v1_enterprise_proto_rawDesc = []byte{
@@ -5254,7 +9737,7 @@ var file_mainchain_enterprise_v1_enterprise_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69,
 	0x6d, 0x65, 0x3a, 0x28, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72,
 	0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x4f,
-	0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xcd, 0x03, 0x0a,
+	0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0xf9, 0x03, 0x0a,
 	0x1a, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x55, 0x6e, 0x64, 0x50, 0x75,
 	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x09, 0x70,
@@ -5280,105 +9763,231 @@ var file_mainchain_enterprise_v1_enterprise_proto_rawDesc = []byte{
 	0x4f, 0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x23, 0xc8,
 	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x16, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x4f,
 	0x72, 0x64, 0x65, 0x72, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0xa8, 0xe7, 0xb0,
-	0x2a, 0x01, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x2d, 0x8a,
-	0xe7, 0xb0, 0x2a, 0x28, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76,
-	0x31, 0x2f, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x55, 0x6e, 0x64, 0x50,
-	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x91, 0x01, 0x0a,
-	0x0e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12,
-	0x5c, 0x0a, 0x0f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x55, 0x6e, 0x64,
-	0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0e, 0x70,
-	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x3a, 0x21, 0x8a,
-	0xe7, 0xb0, 0x2a, 0x1c, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76,
-	0x31, 0x2f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73,
-	0x22, 0x92, 0x01, 0x0a, 0x09, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x6e, 0x64, 0x12, 0x2e,
-	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x37,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x1c, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x65, 0x6e,
-	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x4c, 0x6f, 0x63, 0x6b,
-	0x65, 0x64, 0x55, 0x6e, 0x64, 0x22, 0x94, 0x01, 0x0a, 0x0a, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x45,
-	0x46, 0x55, 0x4e, 0x44, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x2a, 0x01, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x3a, 0x2d, 0x8a, 0xe7, 0xb0, 0x2a, 0x28,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x45, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x55, 0x6e, 0x64, 0x50, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x22, 0x91, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x72,
+	0x63, 0x68, 0x61, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x5c, 0x0a, 0x0f, 0x70,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45,
+	0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x55, 0x6e, 0x64, 0x50, 0x75, 0x72, 0x63,
+	0x68, 0x61, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x0e, 0x70, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x3a, 0x21, 0x8a, 0xe7, 0xb0, 0x2a, 0x1c,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x75,
+	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x22, 0x92, 0x01, 0x0a,
+	0x09, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x6e, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x3a, 0x1c, 0x8a, 0xe7, 0xb0, 0x2a, 0x17, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x55, 0x6e,
+	0x64, 0x22, 0xf3, 0x01, 0x0a, 0x0e, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x45, 0x46, 0x55, 0x4e,
+	0x44, 0x4c, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
+	0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xb8, 0x04, 0x0a, 0x10, 0x45, 0x46, 0x55, 0x4e,
+	0x44, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x47, 0x0a, 0x0a,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x28, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x65, 0x6e, 0x74, 0x72,
+	0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a,
+	0x0a, 0x11, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x70, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x46, 0x0a, 0x0e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52,
+	0x0d, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x44,
+	0x0a, 0x0d, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70,
+	0x61, 0x72, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x61, 0x72,
+	0x74, 0x79, 0x22, 0x94, 0x01, 0x0a, 0x0a, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x45, 0x46, 0x55, 0x4e,
+	0x44, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x1d, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x18, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x53,
+	0x70, 0x65, 0x6e, 0x74, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x22, 0xfe, 0x02, 0x0a, 0x15, 0x45, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x45, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x67, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12,
+	0x40, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x45, 0x66, 0x75, 0x6e,
+	0x64, 0x12, 0x3d, 0x0a, 0x09, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
 	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x1d, 0x8a,
-	0xe7, 0xb0, 0x2a, 0x18, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76,
-	0x31, 0x2f, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x22, 0xfe, 0x02, 0x0a,
-	0x15, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
-	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
-	0x5f, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x6c,
-	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x45, 0x66, 0x75, 0x6e, 0x64, 0x12, 0x46, 0x0a, 0x0e, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x0d, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70,
-	0x6c, 0x79, 0x12, 0x40, 0x0a, 0x0b, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x5f, 0x65, 0x66, 0x75, 0x6e,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
-	0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x45,
-	0x66, 0x75, 0x6e, 0x64, 0x12, 0x3d, 0x0a, 0x09, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
-	0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x61,
-	0x62, 0x6c, 0x65, 0x3a, 0x28, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70,
-	0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69,
-	0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x73, 0x0a,
-	0x12, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x65, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x3a, 0x25, 0x8a, 0xe7, 0xb0,
-	0x2a, 0x20, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f,
-	0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x65, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x0a,
-	0x0b, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
-	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65,
-	0x70, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x41, 0x63,
-	0x63, 0x65, 0x70, 0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
-	0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x11, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x19, 0x8a, 0xe7, 0xb0, 0x2a, 0x14, 0x65, 0x6e, 0x74, 0x65,
-	0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x2a, 0xdc, 0x01, 0x0a, 0x13, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x4f, 0x72, 0x64,
-	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x53, 0x54, 0x41, 0x54,
-	0x55, 0x53, 0x5f, 0x4e, 0x49, 0x4c, 0x10, 0x00, 0x1a, 0x0d, 0x8a, 0x9d, 0x20, 0x09, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x4e, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x52, 0x41, 0x49, 0x53, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x10, 0x8a, 0x9d, 0x20, 0x0c,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x61, 0x69, 0x73, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f,
-	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10,
-	0x02, 0x1a, 0x12, 0x8a, 0x9d, 0x20, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x12, 0x8a, 0x9d, 0x20, 0x0e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x29,
-	0x0a, 0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4c, 0x45, 0x54,
-	0x45, 0x44, 0x10, 0x04, 0x1a, 0x13, 0x8a, 0x9d, 0x20, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a,
-	0xb3, 0x01, 0x0a, 0x0f, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x57, 0x48, 0x49, 0x54, 0x45, 0x4c, 0x49, 0x53, 0x54,
-	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x49, 0x4c, 0x10, 0x00, 0x1a, 0x16, 0x8a,
-	0x9d, 0x20, 0x12, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x4e, 0x69, 0x6c, 0x12, 0x30, 0x0a, 0x14, 0x57, 0x48, 0x49, 0x54, 0x45, 0x4c, 0x49,
-	0x53, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x44, 0x44, 0x10, 0x01, 0x1a,
-	0x16, 0x8a, 0x9d, 0x20, 0x12, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x57, 0x48, 0x49, 0x54, 0x45,
-	0x4c, 0x49, 0x53, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4d, 0x4f,
-	0x56, 0x45, 0x10, 0x02, 0x1a, 0x19, 0x8a, 0x9d, 0x20, 0x15, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c,
-	0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x61, 0x62, 0x6c, 0x65,
+	0x3a, 0x28, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73,
+	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x73, 0x0a, 0x12, 0x57, 0x68,
+	0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x12, 0x36, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x3a, 0x25, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x57, 0x68, 0x69,
+	0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22,
+	0xde, 0x01, 0x0a, 0x0e, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x48, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x70,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x74, 0x69, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x64, 0x64, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x8c, 0x01, 0x0a, 0x0d, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x6c, 0x69,
+	0x6e, 0x6b, 0x65, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x64, 0x64, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x6e, 0x0a, 0x09, 0x45, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x64, 0x64, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0xb2, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x65, 0x6e,
+	0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x70,
+	0x74, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x11, 0x64, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x11, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x12, 0x2d, 0x0a, 0x13, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x5f,
+	0x6d, 0x73, 0x67, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x10, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x46, 0x65, 0x65, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x12, 0x4a, 0x0a, 0x14, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x12, 0x67, 0x72, 0x6f, 0x75, 0x70,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x36, 0x0a,
+	0x17, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15,
+	0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x4d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x3a, 0x19, 0x8a, 0xe7, 0xb0, 0x2a, 0x14,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x2a, 0x87, 0x02, 0x0a, 0x13, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73,
+	0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x49, 0x4c, 0x10, 0x00, 0x1a, 0x0d, 0x8a, 0x9d,
+	0x20, 0x09, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x4e, 0x69, 0x6c, 0x12, 0x23, 0x0a, 0x0d, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x52, 0x41, 0x49, 0x53, 0x45, 0x44, 0x10, 0x01, 0x1a, 0x10,
+	0x8a, 0x9d, 0x20, 0x0c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x61, 0x69, 0x73, 0x65, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50,
+	0x54, 0x45, 0x44, 0x10, 0x02, 0x1a, 0x12, 0x8a, 0x9d, 0x20, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x12,
+	0x8a, 0x9d, 0x20, 0x0e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4f, 0x4d,
+	0x50, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x04, 0x1a, 0x13, 0x8a, 0x9d, 0x20, 0x0f, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x29, 0x0a,
+	0x10, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x44, 0x52, 0x41, 0x57,
+	0x4e, 0x10, 0x05, 0x1a, 0x13, 0x8a, 0x9d, 0x20, 0x0f, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x57,
+	0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x6e, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xb3,
+	0x01, 0x0a, 0x0f, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x14, 0x57, 0x48, 0x49, 0x54, 0x45, 0x4c, 0x49, 0x53, 0x54, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4e, 0x49, 0x4c, 0x10, 0x00, 0x1a, 0x16, 0x8a, 0x9d,
+	0x20, 0x12, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4e, 0x69, 0x6c, 0x12, 0x30, 0x0a, 0x14, 0x57, 0x48, 0x49, 0x54, 0x45, 0x4c, 0x49, 0x53,
+	0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x44, 0x44, 0x10, 0x01, 0x1a, 0x16,
+	0x8a, 0x9d, 0x20, 0x12, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x41, 0x64, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x57, 0x48, 0x49, 0x54, 0x45, 0x4c,
+	0x49, 0x53, 0x54, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x56,
+	0x45, 0x10, 0x02, 0x1a, 0x19, 0x8a, 0x9d, 0x20, 0x15, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69,
+	0x73, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x04,
+	0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xee, 0x02, 0x0a, 0x0f, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2d, 0x0a, 0x15, 0x4c, 0x45, 0x44, 0x47,
+	0x45, 0x52, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4e, 0x49,
+	0x4c, 0x10, 0x00, 0x1a, 0x12, 0x8a, 0x9d, 0x20, 0x0e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x4e, 0x69, 0x6c, 0x12, 0x42, 0x0a, 0x20, 0x4c, 0x45, 0x44, 0x47, 0x45,
+	0x52, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x52,
+	0x43, 0x48, 0x41, 0x53, 0x45, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x10, 0x01, 0x1a, 0x1c, 0x8a,
+	0x9d, 0x20, 0x18, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x50, 0x75,
+	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x15, 0x4c,
+	0x45, 0x44, 0x47, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x46, 0x45, 0x45, 0x10, 0x02, 0x1a, 0x12, 0x8a, 0x9d, 0x20, 0x0e, 0x4c, 0x65, 0x64, 0x67,
+	0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x46, 0x65, 0x65, 0x12, 0x35, 0x0a, 0x19, 0x4c, 0x45,
+	0x44, 0x47, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0x03, 0x1a, 0x16, 0x8a, 0x9d, 0x20, 0x12, 0x4c,
+	0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x64, 0x12, 0x3e, 0x0a, 0x1e, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x54, 0x52,
+	0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f,
+	0x4f, 0x55, 0x54, 0x10, 0x04, 0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4f, 0x75,
+	0x74, 0x12, 0x3c, 0x0a, 0x1d, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x52, 0x5f, 0x45, 0x4e, 0x54, 0x52,
+	0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x46, 0x45, 0x52, 0x5f,
+	0x49, 0x4e, 0x10, 0x05, 0x1a, 0x19, 0x8a, 0x9d, 0x20, 0x15, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x49, 0x6e, 0x1a,
 	0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xe3, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61,
 	0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69,
 	0x73, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0f, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73,
@@ -5409,38 +10018,50 @@ func file_mainchain_enterprise_v1_enterprise_proto_rawDescGZIP() []byte {
 	return file_mainchain_enterprise_v1_enterprise_proto_rawDescData
 }
 
-var file_mainchain_enterprise_v1_enterprise_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_mainchain_enterprise_v1_enterprise_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_mainchain_enterprise_v1_enterprise_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_mainchain_enterprise_v1_enterprise_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
 var file_mainchain_enterprise_v1_enterprise_proto_goTypes = []interface{}{
 	(PurchaseOrderStatus)(0),           // 0: mainchain.enterprise.v1.PurchaseOrderStatus
 	(WhitelistAction)(0),               // 1: mainchain.enterprise.v1.WhitelistAction
-	(*PurchaseOrderDecision)(nil),      // 2: mainchain.enterprise.v1.PurchaseOrderDecision
-	(*EnterpriseUndPurchaseOrder)(nil), // 3: mainchain.enterprise.v1.EnterpriseUndPurchaseOrder
-	(*PurchaseOrders)(nil),             // 4: mainchain.enterprise.v1.PurchaseOrders
-	(*LockedUnd)(nil),                  // 5: mainchain.enterprise.v1.LockedUnd
-	(*SpentEFUND)(nil),                 // 6: mainchain.enterprise.v1.SpentEFUND
-	(*EnterpriseUserAccount)(nil),      // 7: mainchain.enterprise.v1.EnterpriseUserAccount
-	(*WhitelistAddresses)(nil),         // 8: mainchain.enterprise.v1.WhitelistAddresses
-	(*Params)(nil),                     // 9: mainchain.enterprise.v1.Params
-	(*v1beta1.Coin)(nil),               // 10: cosmos.base.v1beta1.Coin
+	(LedgerEntryType)(0),               // 2: mainchain.enterprise.v1.LedgerEntryType
+	(*PurchaseOrderDecision)(nil),      // 3: mainchain.enterprise.v1.PurchaseOrderDecision
+	(*EnterpriseUndPurchaseOrder)(nil), // 4: mainchain.enterprise.v1.EnterpriseUndPurchaseOrder
+	(*PurchaseOrders)(nil),             // 5: mainchain.enterprise.v1.PurchaseOrders
+	(*LockedUnd)(nil),                  // 6: mainchain.enterprise.v1.LockedUnd
+	(*LockedEFUNDLot)(nil),             // 7: mainchain.enterprise.v1.LockedEFUNDLot
+	(*EFUNDLedgerEntry)(nil),           // 8: mainchain.enterprise.v1.EFUNDLedgerEntry
+	(*SpentEFUND)(nil),                 // 9: mainchain.enterprise.v1.SpentEFUND
+	(*EnterpriseUserAccount)(nil),      // 10: mainchain.enterprise.v1.EnterpriseUserAccount
+	(*WhitelistAddresses)(nil),         // 11: mainchain.enterprise.v1.WhitelistAddresses
+	(*WhitelistEntry)(nil),             // 12: mainchain.enterprise.v1.WhitelistEntry
+	(*LinkedAddress)(nil),              // 13: mainchain.enterprise.v1.LinkedAddress
+	(*EntSigner)(nil),                  // 14: mainchain.enterprise.v1.EntSigner
+	(*Params)(nil),                     // 15: mainchain.enterprise.v1.Params
+	(*v1beta1.Coin)(nil),               // 16: cosmos.base.v1beta1.Coin
 }
 var file_mainchain_enterprise_v1_enterprise_proto_depIdxs = []int32{
 	0,  // 0: mainchain.enterprise.v1.PurchaseOrderDecision.decision:type_name -> mainchain.enterprise.v1.PurchaseOrderStatus
-	10, // 1: mainchain.enterprise.v1.EnterpriseUndPurchaseOrder.amount:type_name -> cosmos.base.v1beta1.Coin
+	16, // 1: mainchain.enterprise.v1.EnterpriseUndPurchaseOrder.amount:type_name -> cosmos.base.v1beta1.Coin
 	0,  // 2: mainchain.enterprise.v1.EnterpriseUndPurchaseOrder.status:type_name -> mainchain.enterprise.v1.PurchaseOrderStatus
-	2,  // 3: mainchain.enterprise.v1.EnterpriseUndPurchaseOrder.decisions:type_name -> mainchain.enterprise.v1.PurchaseOrderDecision
-	3,  // 4: mainchain.enterprise.v1.PurchaseOrders.purchase_orders:type_name -> mainchain.enterprise.v1.EnterpriseUndPurchaseOrder
-	10, // 5: mainchain.enterprise.v1.LockedUnd.amount:type_name -> cosmos.base.v1beta1.Coin
-	10, // 6: mainchain.enterprise.v1.SpentEFUND.amount:type_name -> cosmos.base.v1beta1.Coin
-	10, // 7: mainchain.enterprise.v1.EnterpriseUserAccount.locked_efund:type_name -> cosmos.base.v1beta1.Coin
-	10, // 8: mainchain.enterprise.v1.EnterpriseUserAccount.general_supply:type_name -> cosmos.base.v1beta1.Coin
-	10, // 9: mainchain.enterprise.v1.EnterpriseUserAccount.spent_efund:type_name -> cosmos.base.v1beta1.Coin
-	10, // 10: mainchain.enterprise.v1.EnterpriseUserAccount.spendable:type_name -> cosmos.base.v1beta1.Coin
-	11, // [11:11] is the sub-list for method output_type
-	11, // [11:11] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+	3,  // 3: mainchain.enterprise.v1.EnterpriseUndPurchaseOrder.decisions:type_name -> mainchain.enterprise.v1.PurchaseOrderDecision
+	4,  // 4: mainchain.enterprise.v1.PurchaseOrders.purchase_orders:type_name -> mainchain.enterprise.v1.EnterpriseUndPurchaseOrder
+	16, // 5: mainchain.enterprise.v1.LockedUnd.amount:type_name -> cosmos.base.v1beta1.Coin
+	16, // 6: mainchain.enterprise.v1.LockedEFUNDLot.amount:type_name -> cosmos.base.v1beta1.Coin
+	2,  // 7: mainchain.enterprise.v1.EFUNDLedgerEntry.entry_type:type_name -> mainchain.enterprise.v1.LedgerEntryType
+	16, // 8: mainchain.enterprise.v1.EFUNDLedgerEntry.amount:type_name -> cosmos.base.v1beta1.Coin
+	16, // 9: mainchain.enterprise.v1.EFUNDLedgerEntry.locked_balance:type_name -> cosmos.base.v1beta1.Coin
+	16, // 10: mainchain.enterprise.v1.EFUNDLedgerEntry.spent_balance:type_name -> cosmos.base.v1beta1.Coin
+	16, // 11: mainchain.enterprise.v1.SpentEFUND.amount:type_name -> cosmos.base.v1beta1.Coin
+	16, // 12: mainchain.enterprise.v1.EnterpriseUserAccount.locked_efund:type_name -> cosmos.base.v1beta1.Coin
+	16, // 13: mainchain.enterprise.v1.EnterpriseUserAccount.general_supply:type_name -> cosmos.base.v1beta1.Coin
+	16, // 14: mainchain.enterprise.v1.EnterpriseUserAccount.spent_efund:type_name -> cosmos.base.v1beta1.Coin
+	16, // 15: mainchain.enterprise.v1.EnterpriseUserAccount.spendable:type_name -> cosmos.base.v1beta1.Coin
+	16, // 16: mainchain.enterprise.v1.WhitelistEntry.max_purchasable:type_name -> cosmos.base.v1beta1.Coin
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_mainchain_enterprise_v1_enterprise_proto_init() }
@@ -5498,7 +10119,7 @@ func file_mainchain_enterprise_v1_enterprise_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_enterprise_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SpentEFUND); i {
+			switch v := v.(*LockedEFUNDLot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5510,7 +10131,7 @@ func file_mainchain_enterprise_v1_enterprise_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_enterprise_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnterpriseUserAccount); i {
+			switch v := v.(*EFUNDLedgerEntry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5522,7 +10143,7 @@ func file_mainchain_enterprise_v1_enterprise_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_enterprise_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WhitelistAddresses); i {
+			switch v := v.(*SpentEFUND); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5534,6 +10155,66 @@ func file_mainchain_enterprise_v1_enterprise_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_enterprise_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnterpriseUserAccount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_enterprise_v1_enterprise_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WhitelistAddresses); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_enterprise_v1_enterprise_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WhitelistEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_enterprise_v1_enterprise_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LinkedAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_enterprise_v1_enterprise_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EntSigner); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_enterprise_v1_enterprise_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
@@ -5551,8 +10232,8 @@ func file_mainchain_enterprise_v1_enterprise_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mainchain_enterprise_v1_enterprise_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   8,
+			NumEnums:      3,
+			NumMessages:   13,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -213,16 +213,278 @@ func (x *_GenesisState_7_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*EntSigner
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EntSigner)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EntSigner)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(EntSigner)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(EntSigner)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*LockedEFUNDLot
+}
+
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockedEFUNDLot)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockedEFUNDLot)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(LockedEFUNDLot)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(LockedEFUNDLot)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*WhitelistEntry
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WhitelistEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*WhitelistEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(WhitelistEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(WhitelistEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*EFUNDLedgerEntry
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EFUNDLedgerEntry)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EFUNDLedgerEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(EFUNDLedgerEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(EFUNDLedgerEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*LinkedAddress
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LinkedAddress)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LinkedAddress)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(LinkedAddress)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(LinkedAddress)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState                            protoreflect.MessageDescriptor
-	fd_GenesisState_params                     protoreflect.FieldDescriptor
-	fd_GenesisState_starting_purchase_order_id protoreflect.FieldDescriptor
-	fd_GenesisState_purchase_orders            protoreflect.FieldDescriptor
-	fd_GenesisState_locked_und                 protoreflect.FieldDescriptor
-	fd_GenesisState_total_locked               protoreflect.FieldDescriptor
-	fd_GenesisState_whitelist                  protoreflect.FieldDescriptor
-	fd_GenesisState_spent_efund                protoreflect.FieldDescriptor
-	fd_GenesisState_total_spent                protoreflect.FieldDescriptor
+	md_GenesisState                              protoreflect.MessageDescriptor
+	fd_GenesisState_params                       protoreflect.FieldDescriptor
+	fd_GenesisState_starting_purchase_order_id   protoreflect.FieldDescriptor
+	fd_GenesisState_purchase_orders              protoreflect.FieldDescriptor
+	fd_GenesisState_locked_und                   protoreflect.FieldDescriptor
+	fd_GenesisState_total_locked                 protoreflect.FieldDescriptor
+	fd_GenesisState_whitelist                    protoreflect.FieldDescriptor
+	fd_GenesisState_spent_efund                  protoreflect.FieldDescriptor
+	fd_GenesisState_total_spent                  protoreflect.FieldDescriptor
+	fd_GenesisState_ent_signers                  protoreflect.FieldDescriptor
+	fd_GenesisState_locked_efund_lots            protoreflect.FieldDescriptor
+	fd_GenesisState_starting_locked_efund_lot_id protoreflect.FieldDescriptor
+	fd_GenesisState_whitelist_entries            protoreflect.FieldDescriptor
+	fd_GenesisState_ledger_entries               protoreflect.FieldDescriptor
+	fd_GenesisState_starting_ledger_entry_id     protoreflect.FieldDescriptor
+	fd_GenesisState_linked_addresses             protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -236,6 +498,13 @@ func init() {
 	fd_GenesisState_whitelist = md_GenesisState.Fields().ByName("whitelist")
 	fd_GenesisState_spent_efund = md_GenesisState.Fields().ByName("spent_efund")
 	fd_GenesisState_total_spent = md_GenesisState.Fields().ByName("total_spent")
+	fd_GenesisState_ent_signers = md_GenesisState.Fields().ByName("ent_signers")
+	fd_GenesisState_locked_efund_lots = md_GenesisState.Fields().ByName("locked_efund_lots")
+	fd_GenesisState_starting_locked_efund_lot_id = md_GenesisState.Fields().ByName("starting_locked_efund_lot_id")
+	fd_GenesisState_whitelist_entries = md_GenesisState.Fields().ByName("whitelist_entries")
+	fd_GenesisState_ledger_entries = md_GenesisState.Fields().ByName("ledger_entries")
+	fd_GenesisState_starting_ledger_entry_id = md_GenesisState.Fields().ByName("starting_ledger_entry_id")
+	fd_GenesisState_linked_addresses = md_GenesisState.Fields().ByName("linked_addresses")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -351,6 +620,48 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.EntSigners) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.EntSigners})
+		if !f(fd_GenesisState_ent_signers, value) {
+			return
+		}
+	}
+	if len(x.LockedEfundLots) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.LockedEfundLots})
+		if !f(fd_GenesisState_locked_efund_lots, value) {
+			return
+		}
+	}
+	if x.StartingLockedEfundLotId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartingLockedEfundLotId)
+		if !f(fd_GenesisState_starting_locked_efund_lot_id, value) {
+			return
+		}
+	}
+	if len(x.WhitelistEntries) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.WhitelistEntries})
+		if !f(fd_GenesisState_whitelist_entries, value) {
+			return
+		}
+	}
+	if len(x.LedgerEntries) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.LedgerEntries})
+		if !f(fd_GenesisState_ledger_entries, value) {
+			return
+		}
+	}
+	if x.StartingLedgerEntryId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartingLedgerEntryId)
+		if !f(fd_GenesisState_starting_ledger_entry_id, value) {
+			return
+		}
+	}
+	if len(x.LinkedAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.LinkedAddresses})
+		if !f(fd_GenesisState_linked_addresses, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -382,6 +693,20 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return len(x.SpentEfund) != 0
 	case "mainchain.enterprise.v1.GenesisState.total_spent":
 		return x.TotalSpent != nil
+	case "mainchain.enterprise.v1.GenesisState.ent_signers":
+		return len(x.EntSigners) != 0
+	case "mainchain.enterprise.v1.GenesisState.locked_efund_lots":
+		return len(x.LockedEfundLots) != 0
+	case "mainchain.enterprise.v1.GenesisState.starting_locked_efund_lot_id":
+		return x.StartingLockedEfundLotId != uint64(0)
+	case "mainchain.enterprise.v1.GenesisState.whitelist_entries":
+		return len(x.WhitelistEntries) != 0
+	case "mainchain.enterprise.v1.GenesisState.ledger_entries":
+		return len(x.LedgerEntries) != 0
+	case "mainchain.enterprise.v1.GenesisState.starting_ledger_entry_id":
+		return x.StartingLedgerEntryId != uint64(0)
+	case "mainchain.enterprise.v1.GenesisState.linked_addresses":
+		return len(x.LinkedAddresses) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.GenesisState"))
@@ -414,6 +739,20 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.SpentEfund = nil
 	case "mainchain.enterprise.v1.GenesisState.total_spent":
 		x.TotalSpent = nil
+	case "mainchain.enterprise.v1.GenesisState.ent_signers":
+		x.EntSigners = nil
+	case "mainchain.enterprise.v1.GenesisState.locked_efund_lots":
+		x.LockedEfundLots = nil
+	case "mainchain.enterprise.v1.GenesisState.starting_locked_efund_lot_id":
+		x.StartingLockedEfundLotId = uint64(0)
+	case "mainchain.enterprise.v1.GenesisState.whitelist_entries":
+		x.WhitelistEntries = nil
+	case "mainchain.enterprise.v1.GenesisState.ledger_entries":
+		x.LedgerEntries = nil
+	case "mainchain.enterprise.v1.GenesisState.starting_ledger_entry_id":
+		x.StartingLedgerEntryId = uint64(0)
+	case "mainchain.enterprise.v1.GenesisState.linked_addresses":
+		x.LinkedAddresses = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.GenesisState"))
@@ -466,6 +805,42 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "mainchain.enterprise.v1.GenesisState.total_spent":
 		value := x.TotalSpent
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.enterprise.v1.GenesisState.ent_signers":
+		if len(x.EntSigners) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.EntSigners}
+		return protoreflect.ValueOfList(listValue)
+	case "mainchain.enterprise.v1.GenesisState.locked_efund_lots":
+		if len(x.LockedEfundLots) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.LockedEfundLots}
+		return protoreflect.ValueOfList(listValue)
+	case "mainchain.enterprise.v1.GenesisState.starting_locked_efund_lot_id":
+		value := x.StartingLockedEfundLotId
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.enterprise.v1.GenesisState.whitelist_entries":
+		if len(x.WhitelistEntries) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.WhitelistEntries}
+		return protoreflect.ValueOfList(listValue)
+	case "mainchain.enterprise.v1.GenesisState.ledger_entries":
+		if len(x.LedgerEntries) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.LedgerEntries}
+		return protoreflect.ValueOfList(listValue)
+	case "mainchain.enterprise.v1.GenesisState.starting_ledger_entry_id":
+		value := x.StartingLedgerEntryId
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.enterprise.v1.GenesisState.linked_addresses":
+		if len(x.LinkedAddresses) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.LinkedAddresses}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.GenesisState"))
@@ -510,6 +885,30 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.SpentEfund = *clv.list
 	case "mainchain.enterprise.v1.GenesisState.total_spent":
 		x.TotalSpent = value.Message().Interface().(*v1beta1.Coin)
+	case "mainchain.enterprise.v1.GenesisState.ent_signers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.EntSigners = *clv.list
+	case "mainchain.enterprise.v1.GenesisState.locked_efund_lots":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.LockedEfundLots = *clv.list
+	case "mainchain.enterprise.v1.GenesisState.starting_locked_efund_lot_id":
+		x.StartingLockedEfundLotId = value.Uint()
+	case "mainchain.enterprise.v1.GenesisState.whitelist_entries":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.WhitelistEntries = *clv.list
+	case "mainchain.enterprise.v1.GenesisState.ledger_entries":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.LedgerEntries = *clv.list
+	case "mainchain.enterprise.v1.GenesisState.starting_ledger_entry_id":
+		x.StartingLedgerEntryId = value.Uint()
+	case "mainchain.enterprise.v1.GenesisState.linked_addresses":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.LinkedAddresses = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.GenesisState"))
@@ -569,8 +968,42 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.TotalSpent = new(v1beta1.Coin)
 		}
 		return protoreflect.ValueOfMessage(x.TotalSpent.ProtoReflect())
+	case "mainchain.enterprise.v1.GenesisState.ent_signers":
+		if x.EntSigners == nil {
+			x.EntSigners = []*EntSigner{}
+		}
+		value := &_GenesisState_9_list{list: &x.EntSigners}
+		return protoreflect.ValueOfList(value)
+	case "mainchain.enterprise.v1.GenesisState.locked_efund_lots":
+		if x.LockedEfundLots == nil {
+			x.LockedEfundLots = []*LockedEFUNDLot{}
+		}
+		value := &_GenesisState_10_list{list: &x.LockedEfundLots}
+		return protoreflect.ValueOfList(value)
+	case "mainchain.enterprise.v1.GenesisState.whitelist_entries":
+		if x.WhitelistEntries == nil {
+			x.WhitelistEntries = []*WhitelistEntry{}
+		}
+		value := &_GenesisState_12_list{list: &x.WhitelistEntries}
+		return protoreflect.ValueOfList(value)
+	case "mainchain.enterprise.v1.GenesisState.ledger_entries":
+		if x.LedgerEntries == nil {
+			x.LedgerEntries = []*EFUNDLedgerEntry{}
+		}
+		value := &_GenesisState_13_list{list: &x.LedgerEntries}
+		return protoreflect.ValueOfList(value)
+	case "mainchain.enterprise.v1.GenesisState.linked_addresses":
+		if x.LinkedAddresses == nil {
+			x.LinkedAddresses = []*LinkedAddress{}
+		}
+		value := &_GenesisState_15_list{list: &x.LinkedAddresses}
+		return protoreflect.ValueOfList(value)
 	case "mainchain.enterprise.v1.GenesisState.starting_purchase_order_id":
 		panic(fmt.Errorf("field starting_purchase_order_id of message mainchain.enterprise.v1.GenesisState is not mutable"))
+	case "mainchain.enterprise.v1.GenesisState.starting_locked_efund_lot_id":
+		panic(fmt.Errorf("field starting_locked_efund_lot_id of message mainchain.enterprise.v1.GenesisState is not mutable"))
+	case "mainchain.enterprise.v1.GenesisState.starting_ledger_entry_id":
+		panic(fmt.Errorf("field starting_ledger_entry_id of message mainchain.enterprise.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.GenesisState"))
@@ -607,6 +1040,25 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "mainchain.enterprise.v1.GenesisState.total_spent":
 		m := new(v1beta1.Coin)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.enterprise.v1.GenesisState.ent_signers":
+		list := []*EntSigner{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "mainchain.enterprise.v1.GenesisState.locked_efund_lots":
+		list := []*LockedEFUNDLot{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "mainchain.enterprise.v1.GenesisState.starting_locked_efund_lot_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.enterprise.v1.GenesisState.whitelist_entries":
+		list := []*WhitelistEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "mainchain.enterprise.v1.GenesisState.ledger_entries":
+		list := []*EFUNDLedgerEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "mainchain.enterprise.v1.GenesisState.starting_ledger_entry_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.enterprise.v1.GenesisState.linked_addresses":
+		list := []*LinkedAddress{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.GenesisState"))
@@ -715,6 +1167,42 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.TotalSpent)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.EntSigners) > 0 {
+			for _, e := range x.EntSigners {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LockedEfundLots) > 0 {
+			for _, e := range x.LockedEfundLots {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.StartingLockedEfundLotId != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartingLockedEfundLotId))
+		}
+		if len(x.WhitelistEntries) > 0 {
+			for _, e := range x.WhitelistEntries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.LedgerEntries) > 0 {
+			for _, e := range x.LedgerEntries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.StartingLedgerEntryId != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartingLedgerEntryId))
+		}
+		if len(x.LinkedAddresses) > 0 {
+			for _, e := range x.LinkedAddresses {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -732,17 +1220,107 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				Buf:               input.Buf,
 			}, nil
 		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.LinkedAddresses) > 0 {
+			for iNdEx := len(x.LinkedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LinkedAddresses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if x.StartingLedgerEntryId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartingLedgerEntryId))
+			i--
+			dAtA[i] = 0x70
+		}
+		if len(x.LedgerEntries) > 0 {
+			for iNdEx := len(x.LedgerEntries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LedgerEntries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.WhitelistEntries) > 0 {
+			for iNdEx := len(x.WhitelistEntries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.WhitelistEntries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if x.StartingLockedEfundLotId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartingLockedEfundLotId))
+			i--
+			dAtA[i] = 0x58
+		}
+		if len(x.LockedEfundLots) > 0 {
+			for iNdEx := len(x.LockedEfundLots) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LockedEfundLots[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.EntSigners) > 0 {
+			for iNdEx := len(x.EntSigners) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EntSigners[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
 		}
 		if x.TotalSpent != nil {
 			encoded, err := options.Marshal(x.TotalSpent)
@@ -1158,6 +1736,214 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EntSigners", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EntSigners = append(x.EntSigners, &EntSigner{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EntSigners[len(x.EntSigners)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LockedEfundLots", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LockedEfundLots = append(x.LockedEfundLots, &LockedEFUNDLot{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LockedEfundLots[len(x.LockedEfundLots)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartingLockedEfundLotId", wireType)
+				}
+				x.StartingLockedEfundLotId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartingLockedEfundLotId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WhitelistEntries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WhitelistEntries = append(x.WhitelistEntries, &WhitelistEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.WhitelistEntries[len(x.WhitelistEntries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LedgerEntries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LedgerEntries = append(x.LedgerEntries, &EFUNDLedgerEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LedgerEntries[len(x.LedgerEntries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartingLedgerEntryId", wireType)
+				}
+				x.StartingLedgerEntryId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartingLedgerEntryId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkedAddresses", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LinkedAddresses = append(x.LinkedAddresses, &LinkedAddress{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LinkedAddresses[len(x.LinkedAddresses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1213,14 +1999,24 @@ type GenesisState struct {
 	unknownFields protoimpl.UnknownFields
 
 	// params defines all the paramaters of the module.
-	Params                  *Params                       `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	StartingPurchaseOrderId uint64                        `protobuf:"varint,2,opt,name=starting_purchase_order_id,json=startingPurchaseOrderId,proto3" json:"starting_purchase_order_id,omitempty"`
-	PurchaseOrders          []*EnterpriseUndPurchaseOrder `protobuf:"bytes,3,rep,name=purchase_orders,json=purchaseOrders,proto3" json:"purchase_orders,omitempty"`
-	LockedUnd               []*LockedUnd                  `protobuf:"bytes,4,rep,name=locked_und,json=lockedUnd,proto3" json:"locked_und,omitempty"`
-	TotalLocked             *v1beta1.Coin                 `protobuf:"bytes,5,opt,name=total_locked,json=totalLocked,proto3" json:"total_locked,omitempty"`
-	Whitelist               []string                      `protobuf:"bytes,6,rep,name=whitelist,proto3" json:"whitelist,omitempty"`
-	SpentEfund              []*SpentEFUND                 `protobuf:"bytes,7,rep,name=spent_efund,json=spentEfund,proto3" json:"spent_efund,omitempty"`
-	TotalSpent              *v1beta1.Coin                 `protobuf:"bytes,8,opt,name=total_spent,json=totalSpent,proto3" json:"total_spent,omitempty"`
+	Params                   *Params                       `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	StartingPurchaseOrderId  uint64                        `protobuf:"varint,2,opt,name=starting_purchase_order_id,json=startingPurchaseOrderId,proto3" json:"starting_purchase_order_id,omitempty"`
+	PurchaseOrders           []*EnterpriseUndPurchaseOrder `protobuf:"bytes,3,rep,name=purchase_orders,json=purchaseOrders,proto3" json:"purchase_orders,omitempty"`
+	LockedUnd                []*LockedUnd                  `protobuf:"bytes,4,rep,name=locked_und,json=lockedUnd,proto3" json:"locked_und,omitempty"`
+	TotalLocked              *v1beta1.Coin                 `protobuf:"bytes,5,opt,name=total_locked,json=totalLocked,proto3" json:"total_locked,omitempty"`
+	Whitelist                []string                      `protobuf:"bytes,6,rep,name=whitelist,proto3" json:"whitelist,omitempty"`
+	SpentEfund               []*SpentEFUND                 `protobuf:"bytes,7,rep,name=spent_efund,json=spentEfund,proto3" json:"spent_efund,omitempty"`
+	TotalSpent               *v1beta1.Coin                 `protobuf:"bytes,8,opt,name=total_spent,json=totalSpent,proto3" json:"total_spent,omitempty"`
+	EntSigners               []*EntSigner                  `protobuf:"bytes,9,rep,name=ent_signers,json=entSigners,proto3" json:"ent_signers,omitempty"`
+	LockedEfundLots          []*LockedEFUNDLot             `protobuf:"bytes,10,rep,name=locked_efund_lots,json=lockedEfundLots,proto3" json:"locked_efund_lots,omitempty"`
+	StartingLockedEfundLotId uint64                        `protobuf:"varint,11,opt,name=starting_locked_efund_lot_id,json=startingLockedEfundLotId,proto3" json:"starting_locked_efund_lot_id,omitempty"`
+	// whitelist_entries are the whitelisted addresses with their expiry, cap and tier. Addresses in the legacy
+	// whitelist field are imported as entries with no expiry or cap
+	WhitelistEntries      []*WhitelistEntry   `protobuf:"bytes,12,rep,name=whitelist_entries,json=whitelistEntries,proto3" json:"whitelist_entries,omitempty"`
+	LedgerEntries         []*EFUNDLedgerEntry `protobuf:"bytes,13,rep,name=ledger_entries,json=ledgerEntries,proto3" json:"ledger_entries,omitempty"`
+	StartingLedgerEntryId uint64              `protobuf:"varint,14,opt,name=starting_ledger_entry_id,json=startingLedgerEntryId,proto3" json:"starting_ledger_entry_id,omitempty"`
+	// linked_addresses are the ent signer approved links between addresses which locked eFUND can be transferred along
+	LinkedAddresses []*LinkedAddress `protobuf:"bytes,15,rep,name=linked_addresses,json=linkedAddresses,proto3" json:"linked_addresses,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1299,6 +2095,55 @@ func (x *GenesisState) GetTotalSpent() *v1beta1.Coin {
 	return nil
 }
 
+func (x *GenesisState) GetEntSigners() []*EntSigner {
+	if x != nil {
+		return x.EntSigners
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLockedEfundLots() []*LockedEFUNDLot {
+	if x != nil {
+		return x.LockedEfundLots
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStartingLockedEfundLotId() uint64 {
+	if x != nil {
+		return x.StartingLockedEfundLotId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetWhitelistEntries() []*WhitelistEntry {
+	if x != nil {
+		return x.WhitelistEntries
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLedgerEntries() []*EFUNDLedgerEntry {
+	if x != nil {
+		return x.LedgerEntries
+	}
+	return nil
+}
+
+func (x *GenesisState) GetStartingLedgerEntryId() uint64 {
+	if x != nil {
+		return x.StartingLedgerEntryId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetLinkedAddresses() []*LinkedAddress {
+	if x != nil {
+		return x.LinkedAddresses
+	}
+	return nil
+}
+
 var File_mainchain_enterprise_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_mainchain_enterprise_v1_genesis_proto_rawDesc = []byte{
@@ -1312,7 +2157,7 @@ var file_mainchain_enterprise_v1_genesis_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x28, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69,
 	0x6e, 0x2f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f,
 	0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xf4, 0x04, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x22, 0xa0, 0x09, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74,
 	0x65, 0x12, 0x3d, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e,
 	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61,
@@ -1351,22 +2196,56 @@ var file_mainchain_enterprise_v1_genesis_proto_rawDesc = []byte{
 	0x70, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
 	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x42, 0xe0, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e,
+	0x61, 0x6c, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x12, 0x49, 0x0a, 0x0b, 0x65, 0x6e, 0x74, 0x5f, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d,
+	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x65, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x73, 0x12, 0x59, 0x0a, 0x11, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x65, 0x66, 0x75,
+	0x6e, 0x64, 0x5f, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e,
 	0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70,
-	0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76,
-	0x31, 0x3b, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x76, 0x31, 0xa2, 0x02,
-	0x03, 0x4d, 0x45, 0x58, 0xaa, 0x02, 0x17, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2e, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02,
-	0x17, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x45, 0x6e, 0x74, 0x65, 0x72,
-	0x70, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x4d, 0x61, 0x69, 0x6e, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x5c,
-	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x19, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x45, 0x6e, 0x74, 0x65,
-	0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x45, 0x46,
+	0x55, 0x4e, 0x44, 0x4c, 0x6f, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x45, 0x66, 0x75, 0x6e, 0x64, 0x4c, 0x6f, 0x74, 0x73, 0x12, 0x3e, 0x0a,
+	0x1c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x18, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x45, 0x66, 0x75, 0x6e, 0x64, 0x4c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x5a, 0x0a,
+	0x11, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69,
+	0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x0e, 0x6c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x46, 0x55, 0x4e,
+	0x44, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x37, 0x0a, 0x18, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x15, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x12, 0x57, 0x0a, 0x10, 0x6c, 0x69,
+	0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x0f,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x0f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x42, 0xe0, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x35, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x45, 0x58,
+	0xaa, 0x02, 0x17, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x45, 0x6e, 0x74,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x17, 0x4d, 0x61, 0x69,
+	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73,
+	0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5c, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x4d, 0x61, 0x69,
+	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69,
+	0x73, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1389,19 +2268,29 @@ var file_mainchain_enterprise_v1_genesis_proto_goTypes = []interface{}{
 	(*LockedUnd)(nil),                  // 3: mainchain.enterprise.v1.LockedUnd
 	(*v1beta1.Coin)(nil),               // 4: cosmos.base.v1beta1.Coin
 	(*SpentEFUND)(nil),                 // 5: mainchain.enterprise.v1.SpentEFUND
+	(*EntSigner)(nil),                  // 6: mainchain.enterprise.v1.EntSigner
+	(*LockedEFUNDLot)(nil),             // 7: mainchain.enterprise.v1.LockedEFUNDLot
+	(*WhitelistEntry)(nil),             // 8: mainchain.enterprise.v1.WhitelistEntry
+	(*EFUNDLedgerEntry)(nil),           // 9: mainchain.enterprise.v1.EFUNDLedgerEntry
+	(*LinkedAddress)(nil),              // 10: mainchain.enterprise.v1.LinkedAddress
 }
 var file_mainchain_enterprise_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: mainchain.enterprise.v1.GenesisState.params:type_name -> mainchain.enterprise.v1.Params
-	2, // 1: mainchain.enterprise.v1.GenesisState.purchase_orders:type_name -> mainchain.enterprise.v1.EnterpriseUndPurchaseOrder
-	3, // 2: mainchain.enterprise.v1.GenesisState.locked_und:type_name -> mainchain.enterprise.v1.LockedUnd
-	4, // 3: mainchain.enterprise.v1.GenesisState.total_locked:type_name -> cosmos.base.v1beta1.Coin
-	5, // 4: mainchain.enterprise.v1.GenesisState.spent_efund:type_name -> mainchain.enterprise.v1.SpentEFUND
-	4, // 5: mainchain.enterprise.v1.GenesisState.total_spent:type_name -> cosmos.base.v1beta1.Coin
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	1,  // 0: mainchain.enterprise.v1.GenesisState.params:type_name -> mainchain.enterprise.v1.Params
+	2,  // 1: mainchain.enterprise.v1.GenesisState.purchase_orders:type_name -> mainchain.enterprise.v1.EnterpriseUndPurchaseOrder
+	3,  // 2: mainchain.enterprise.v1.GenesisState.locked_und:type_name -> mainchain.enterprise.v1.LockedUnd
+	4,  // 3: mainchain.enterprise.v1.GenesisState.total_locked:type_name -> cosmos.base.v1beta1.Coin
+	5,  // 4: mainchain.enterprise.v1.GenesisState.spent_efund:type_name -> mainchain.enterprise.v1.SpentEFUND
+	4,  // 5: mainchain.enterprise.v1.GenesisState.total_spent:type_name -> cosmos.base.v1beta1.Coin
+	6,  // 6: mainchain.enterprise.v1.GenesisState.ent_signers:type_name -> mainchain.enterprise.v1.EntSigner
+	7,  // 7: mainchain.enterprise.v1.GenesisState.locked_efund_lots:type_name -> mainchain.enterprise.v1.LockedEFUNDLot
+	8,  // 8: mainchain.enterprise.v1.GenesisState.whitelist_entries:type_name -> mainchain.enterprise.v1.WhitelistEntry
+	9,  // 9: mainchain.enterprise.v1.GenesisState.ledger_entries:type_name -> mainchain.enterprise.v1.EFUNDLedgerEntry
+	10, // 10: mainchain.enterprise.v1.GenesisState.linked_addresses:type_name -> mainchain.enterprise.v1.LinkedAddress
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_mainchain_enterprise_v1_genesis_proto_init() }
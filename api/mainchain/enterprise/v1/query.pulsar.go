@@ -3623,23 +3623,25 @@ func (x *fastReflection_QueryLockedUndByAddressResponse) ProtoMethods() *protoif
 }
 
 var (
-	md_QueryTotalLockedRequest protoreflect.MessageDescriptor
+	md_QueryLockedEFUNDLotsByAddressRequest       protoreflect.MessageDescriptor
+	fd_QueryLockedEFUNDLotsByAddressRequest_owner protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mainchain_enterprise_v1_query_proto_init()
-	md_QueryTotalLockedRequest = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryTotalLockedRequest")
+	md_QueryLockedEFUNDLotsByAddressRequest = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryLockedEFUNDLotsByAddressRequest")
+	fd_QueryLockedEFUNDLotsByAddressRequest_owner = md_QueryLockedEFUNDLotsByAddressRequest.Fields().ByName("owner")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTotalLockedRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryLockedEFUNDLotsByAddressRequest)(nil)
 
-type fastReflection_QueryTotalLockedRequest QueryTotalLockedRequest
+type fastReflection_QueryLockedEFUNDLotsByAddressRequest QueryLockedEFUNDLotsByAddressRequest
 
-func (x *QueryTotalLockedRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTotalLockedRequest)(x)
+func (x *QueryLockedEFUNDLotsByAddressRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLockedEFUNDLotsByAddressRequest)(x)
 }
 
-func (x *QueryTotalLockedRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryLockedEFUNDLotsByAddressRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3651,43 +3653,43 @@ func (x *QueryTotalLockedRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTotalLockedRequest_messageType fastReflection_QueryTotalLockedRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTotalLockedRequest_messageType{}
+var _fastReflection_QueryLockedEFUNDLotsByAddressRequest_messageType fastReflection_QueryLockedEFUNDLotsByAddressRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLockedEFUNDLotsByAddressRequest_messageType{}
 
-type fastReflection_QueryTotalLockedRequest_messageType struct{}
+type fastReflection_QueryLockedEFUNDLotsByAddressRequest_messageType struct{}
 
-func (x fastReflection_QueryTotalLockedRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTotalLockedRequest)(nil)
+func (x fastReflection_QueryLockedEFUNDLotsByAddressRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLockedEFUNDLotsByAddressRequest)(nil)
 }
-func (x fastReflection_QueryTotalLockedRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalLockedRequest)
+func (x fastReflection_QueryLockedEFUNDLotsByAddressRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLockedEFUNDLotsByAddressRequest)
 }
-func (x fastReflection_QueryTotalLockedRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalLockedRequest
+func (x fastReflection_QueryLockedEFUNDLotsByAddressRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLockedEFUNDLotsByAddressRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTotalLockedRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalLockedRequest
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLockedEFUNDLotsByAddressRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTotalLockedRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTotalLockedRequest_messageType
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLockedEFUNDLotsByAddressRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTotalLockedRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalLockedRequest)
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryLockedEFUNDLotsByAddressRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTotalLockedRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTotalLockedRequest)(x)
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryLockedEFUNDLotsByAddressRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3695,7 +3697,13 @@ func (x *fastReflection_QueryTotalLockedRequest) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTotalLockedRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_QueryLockedEFUNDLotsByAddressRequest_owner, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3709,13 +3717,15 @@ func (x *fastReflection_QueryTotalLockedRequest) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTotalLockedRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest.owner":
+		return x.Owner != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3725,13 +3735,15 @@ func (x *fastReflection_QueryTotalLockedRequest) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalLockedRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest.owner":
+		x.Owner = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3741,13 +3753,16 @@ func (x *fastReflection_QueryTotalLockedRequest) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTotalLockedRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3761,13 +3776,15 @@ func (x *fastReflection_QueryTotalLockedRequest) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalLockedRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest.owner":
+		x.Owner = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3781,36 +3798,40 @@ func (x *fastReflection_QueryTotalLockedRequest) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalLockedRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest.owner":
+		panic(fmt.Errorf("field owner of message mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTotalLockedRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest.owner":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTotalLockedRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryTotalLockedRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3818,7 +3839,7 @@ func (x *fastReflection_QueryTotalLockedRequest) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTotalLockedRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3829,7 +3850,7 @@ func (x *fastReflection_QueryTotalLockedRequest) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalLockedRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3841,7 +3862,7 @@ func (x *fastReflection_QueryTotalLockedRequest) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTotalLockedRequest) IsValid() bool {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -3851,9 +3872,9 @@ func (x *fastReflection_QueryTotalLockedRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTotalLockedRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTotalLockedRequest)
+		x := input.Message.Interface().(*QueryLockedEFUNDLotsByAddressRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3865,6 +3886,10 @@ func (x *fastReflection_QueryTotalLockedRequest) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3875,7 +3900,7 @@ func (x *fastReflection_QueryTotalLockedRequest) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalLockedRequest)
+		x := input.Message.Interface().(*QueryLockedEFUNDLotsByAddressRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3894,6 +3919,13 @@ func (x *fastReflection_QueryTotalLockedRequest) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -3905,7 +3937,7 @@ func (x *fastReflection_QueryTotalLockedRequest) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalLockedRequest)
+		x := input.Message.Interface().(*QueryLockedEFUNDLotsByAddressRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3937,12 +3969,44 @@ func (x *fastReflection_QueryTotalLockedRequest) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalLockedRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLockedEFUNDLotsByAddressRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalLockedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLockedEFUNDLotsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3978,26 +4042,77 @@ func (x *fastReflection_QueryTotalLockedRequest) ProtoMethods() *protoiface.Meth
 	}
 }
 
+var _ protoreflect.List = (*_QueryLockedEFUNDLotsByAddressResponse_1_list)(nil)
+
+type _QueryLockedEFUNDLotsByAddressResponse_1_list struct {
+	list *[]*LockedEFUNDLot
+}
+
+func (x *_QueryLockedEFUNDLotsByAddressResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryLockedEFUNDLotsByAddressResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryLockedEFUNDLotsByAddressResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockedEFUNDLot)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryLockedEFUNDLotsByAddressResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LockedEFUNDLot)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryLockedEFUNDLotsByAddressResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(LockedEFUNDLot)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLockedEFUNDLotsByAddressResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryLockedEFUNDLotsByAddressResponse_1_list) NewElement() protoreflect.Value {
+	v := new(LockedEFUNDLot)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryLockedEFUNDLotsByAddressResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryTotalLockedResponse        protoreflect.MessageDescriptor
-	fd_QueryTotalLockedResponse_amount protoreflect.FieldDescriptor
+	md_QueryLockedEFUNDLotsByAddressResponse      protoreflect.MessageDescriptor
+	fd_QueryLockedEFUNDLotsByAddressResponse_lots protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mainchain_enterprise_v1_query_proto_init()
-	md_QueryTotalLockedResponse = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryTotalLockedResponse")
-	fd_QueryTotalLockedResponse_amount = md_QueryTotalLockedResponse.Fields().ByName("amount")
+	md_QueryLockedEFUNDLotsByAddressResponse = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryLockedEFUNDLotsByAddressResponse")
+	fd_QueryLockedEFUNDLotsByAddressResponse_lots = md_QueryLockedEFUNDLotsByAddressResponse.Fields().ByName("lots")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTotalLockedResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryLockedEFUNDLotsByAddressResponse)(nil)
 
-type fastReflection_QueryTotalLockedResponse QueryTotalLockedResponse
+type fastReflection_QueryLockedEFUNDLotsByAddressResponse QueryLockedEFUNDLotsByAddressResponse
 
-func (x *QueryTotalLockedResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTotalLockedResponse)(x)
+func (x *QueryLockedEFUNDLotsByAddressResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLockedEFUNDLotsByAddressResponse)(x)
 }
 
-func (x *QueryTotalLockedResponse) slowProtoReflect() protoreflect.Message {
+func (x *QueryLockedEFUNDLotsByAddressResponse) slowProtoReflect() protoreflect.Message {
 	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4009,43 +4124,43 @@ func (x *QueryTotalLockedResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTotalLockedResponse_messageType fastReflection_QueryTotalLockedResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTotalLockedResponse_messageType{}
+var _fastReflection_QueryLockedEFUNDLotsByAddressResponse_messageType fastReflection_QueryLockedEFUNDLotsByAddressResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLockedEFUNDLotsByAddressResponse_messageType{}
 
-type fastReflection_QueryTotalLockedResponse_messageType struct{}
+type fastReflection_QueryLockedEFUNDLotsByAddressResponse_messageType struct{}
 
-func (x fastReflection_QueryTotalLockedResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTotalLockedResponse)(nil)
+func (x fastReflection_QueryLockedEFUNDLotsByAddressResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLockedEFUNDLotsByAddressResponse)(nil)
 }
-func (x fastReflection_QueryTotalLockedResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalLockedResponse)
+func (x fastReflection_QueryLockedEFUNDLotsByAddressResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLockedEFUNDLotsByAddressResponse)
 }
-func (x fastReflection_QueryTotalLockedResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalLockedResponse
+func (x fastReflection_QueryLockedEFUNDLotsByAddressResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLockedEFUNDLotsByAddressResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTotalLockedResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalLockedResponse
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLockedEFUNDLotsByAddressResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTotalLockedResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTotalLockedResponse_messageType
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLockedEFUNDLotsByAddressResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTotalLockedResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalLockedResponse)
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryLockedEFUNDLotsByAddressResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTotalLockedResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTotalLockedResponse)(x)
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryLockedEFUNDLotsByAddressResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4053,10 +4168,10 @@ func (x *fastReflection_QueryTotalLockedResponse) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTotalLockedResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Amount != nil {
-		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
-		if !f(fd_QueryTotalLockedResponse_amount, value) {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Lots) != 0 {
+		value := protoreflect.ValueOfList(&_QueryLockedEFUNDLotsByAddressResponse_1_list{list: &x.Lots})
+		if !f(fd_QueryLockedEFUNDLotsByAddressResponse_lots, value) {
 			return
 		}
 	}
@@ -4073,15 +4188,15 @@ func (x *fastReflection_QueryTotalLockedResponse) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTotalLockedResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalLockedResponse.amount":
-		return x.Amount != nil
+	case "mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse.lots":
+		return len(x.Lots) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4091,15 +4206,15 @@ func (x *fastReflection_QueryTotalLockedResponse) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalLockedResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalLockedResponse.amount":
-		x.Amount = nil
+	case "mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse.lots":
+		x.Lots = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4109,16 +4224,19 @@ func (x *fastReflection_QueryTotalLockedResponse) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTotalLockedResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalLockedResponse.amount":
-		value := x.Amount
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse.lots":
+		if len(x.Lots) == 0 {
+			return protoreflect.ValueOfList(&_QueryLockedEFUNDLotsByAddressResponse_1_list{})
+		}
+		listValue := &_QueryLockedEFUNDLotsByAddressResponse_1_list{list: &x.Lots}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4132,15 +4250,17 @@ func (x *fastReflection_QueryTotalLockedResponse) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalLockedResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalLockedResponse.amount":
-		x.Amount = value.Message().Interface().(*v1beta11.Coin)
+	case "mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse.lots":
+		lv := value.List()
+		clv := lv.(*_QueryLockedEFUNDLotsByAddressResponse_1_list)
+		x.Lots = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4154,44 +4274,45 @@ func (x *fastReflection_QueryTotalLockedResponse) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalLockedResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalLockedResponse.amount":
-		if x.Amount == nil {
-			x.Amount = new(v1beta11.Coin)
+	case "mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse.lots":
+		if x.Lots == nil {
+			x.Lots = []*LockedEFUNDLot{}
 		}
-		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		value := &_QueryLockedEFUNDLotsByAddressResponse_1_list{list: &x.Lots}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTotalLockedResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalLockedResponse.amount":
-		m := new(v1beta11.Coin)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse.lots":
+		list := []*LockedEFUNDLot{}
+		return protoreflect.ValueOfList(&_QueryLockedEFUNDLotsByAddressResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTotalLockedResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryTotalLockedResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4199,7 +4320,7 @@ func (x *fastReflection_QueryTotalLockedResponse) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTotalLockedResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4210,7 +4331,7 @@ func (x *fastReflection_QueryTotalLockedResponse) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalLockedResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4222,7 +4343,7 @@ func (x *fastReflection_QueryTotalLockedResponse) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTotalLockedResponse) IsValid() bool {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -4232,9 +4353,9 @@ func (x *fastReflection_QueryTotalLockedResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTotalLockedResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryLockedEFUNDLotsByAddressResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTotalLockedResponse)
+		x := input.Message.Interface().(*QueryLockedEFUNDLotsByAddressResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4246,9 +4367,11 @@ func (x *fastReflection_QueryTotalLockedResponse) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if x.Amount != nil {
-			l = options.Size(x.Amount)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Lots) > 0 {
+			for _, e := range x.Lots {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -4260,7 +4383,7 @@ func (x *fastReflection_QueryTotalLockedResponse) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalLockedResponse)
+		x := input.Message.Interface().(*QueryLockedEFUNDLotsByAddressResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4279,19 +4402,21 @@ func (x *fastReflection_QueryTotalLockedResponse) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Amount != nil {
-			encoded, err := options.Marshal(x.Amount)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
+		if len(x.Lots) > 0 {
+			for iNdEx := len(x.Lots) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Lots[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -4304,7 +4429,7 @@ func (x *fastReflection_QueryTotalLockedResponse) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalLockedResponse)
+		x := input.Message.Interface().(*QueryLockedEFUNDLotsByAddressResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4336,15 +4461,15 @@ func (x *fastReflection_QueryTotalLockedResponse) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalLockedResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLockedEFUNDLotsByAddressResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalLockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLockedEFUNDLotsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Lots", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -4371,11 +4496,9 @@ func (x *fastReflection_QueryTotalLockedResponse) ProtoMethods() *protoiface.Met
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Amount == nil {
-					x.Amount = &v1beta11.Coin{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Lots = append(x.Lots, &LockedEFUNDLot{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Lots[len(x.Lots)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
 			default:
@@ -4414,23 +4537,31 @@ func (x *fastReflection_QueryTotalLockedResponse) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_QueryTotalUnlockedRequest protoreflect.MessageDescriptor
+	md_QueryEnterpriseLedgerRequest            protoreflect.MessageDescriptor
+	fd_QueryEnterpriseLedgerRequest_owner      protoreflect.FieldDescriptor
+	fd_QueryEnterpriseLedgerRequest_from_time  protoreflect.FieldDescriptor
+	fd_QueryEnterpriseLedgerRequest_to_time    protoreflect.FieldDescriptor
+	fd_QueryEnterpriseLedgerRequest_pagination protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mainchain_enterprise_v1_query_proto_init()
-	md_QueryTotalUnlockedRequest = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryTotalUnlockedRequest")
+	md_QueryEnterpriseLedgerRequest = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryEnterpriseLedgerRequest")
+	fd_QueryEnterpriseLedgerRequest_owner = md_QueryEnterpriseLedgerRequest.Fields().ByName("owner")
+	fd_QueryEnterpriseLedgerRequest_from_time = md_QueryEnterpriseLedgerRequest.Fields().ByName("from_time")
+	fd_QueryEnterpriseLedgerRequest_to_time = md_QueryEnterpriseLedgerRequest.Fields().ByName("to_time")
+	fd_QueryEnterpriseLedgerRequest_pagination = md_QueryEnterpriseLedgerRequest.Fields().ByName("pagination")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTotalUnlockedRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryEnterpriseLedgerRequest)(nil)
 
-type fastReflection_QueryTotalUnlockedRequest QueryTotalUnlockedRequest
+type fastReflection_QueryEnterpriseLedgerRequest QueryEnterpriseLedgerRequest
 
-func (x *QueryTotalUnlockedRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTotalUnlockedRequest)(x)
+func (x *QueryEnterpriseLedgerRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEnterpriseLedgerRequest)(x)
 }
 
-func (x *QueryTotalUnlockedRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryEnterpriseLedgerRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4442,43 +4573,43 @@ func (x *QueryTotalUnlockedRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTotalUnlockedRequest_messageType fastReflection_QueryTotalUnlockedRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTotalUnlockedRequest_messageType{}
+var _fastReflection_QueryEnterpriseLedgerRequest_messageType fastReflection_QueryEnterpriseLedgerRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEnterpriseLedgerRequest_messageType{}
 
-type fastReflection_QueryTotalUnlockedRequest_messageType struct{}
+type fastReflection_QueryEnterpriseLedgerRequest_messageType struct{}
 
-func (x fastReflection_QueryTotalUnlockedRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTotalUnlockedRequest)(nil)
+func (x fastReflection_QueryEnterpriseLedgerRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEnterpriseLedgerRequest)(nil)
 }
-func (x fastReflection_QueryTotalUnlockedRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalUnlockedRequest)
+func (x fastReflection_QueryEnterpriseLedgerRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEnterpriseLedgerRequest)
 }
-func (x fastReflection_QueryTotalUnlockedRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalUnlockedRequest
+func (x fastReflection_QueryEnterpriseLedgerRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEnterpriseLedgerRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTotalUnlockedRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalUnlockedRequest
+func (x *fastReflection_QueryEnterpriseLedgerRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEnterpriseLedgerRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTotalUnlockedRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTotalUnlockedRequest_messageType
+func (x *fastReflection_QueryEnterpriseLedgerRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEnterpriseLedgerRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTotalUnlockedRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalUnlockedRequest)
+func (x *fastReflection_QueryEnterpriseLedgerRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryEnterpriseLedgerRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTotalUnlockedRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTotalUnlockedRequest)(x)
+func (x *fastReflection_QueryEnterpriseLedgerRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryEnterpriseLedgerRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4486,7 +4617,31 @@ func (x *fastReflection_QueryTotalUnlockedRequest) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTotalUnlockedRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryEnterpriseLedgerRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_QueryEnterpriseLedgerRequest_owner, value) {
+			return
+		}
+	}
+	if x.FromTime != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FromTime)
+		if !f(fd_QueryEnterpriseLedgerRequest_from_time, value) {
+			return
+		}
+	}
+	if x.ToTime != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ToTime)
+		if !f(fd_QueryEnterpriseLedgerRequest_to_time, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryEnterpriseLedgerRequest_pagination, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4500,13 +4655,21 @@ func (x *fastReflection_QueryTotalUnlockedRequest) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTotalUnlockedRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryEnterpriseLedgerRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.owner":
+		return x.Owner != ""
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.from_time":
+		return x.FromTime != uint64(0)
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.to_time":
+		return x.ToTime != uint64(0)
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEnterpriseLedgerRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEnterpriseLedgerRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4516,13 +4679,21 @@ func (x *fastReflection_QueryTotalUnlockedRequest) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalUnlockedRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryEnterpriseLedgerRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.owner":
+		x.Owner = ""
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.from_time":
+		x.FromTime = uint64(0)
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.to_time":
+		x.ToTime = uint64(0)
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEnterpriseLedgerRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEnterpriseLedgerRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4532,13 +4703,25 @@ func (x *fastReflection_QueryTotalUnlockedRequest) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTotalUnlockedRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEnterpriseLedgerRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.from_time":
+		value := x.FromTime
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.to_time":
+		value := x.ToTime
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEnterpriseLedgerRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEnterpriseLedgerRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4552,13 +4735,21 @@ func (x *fastReflection_QueryTotalUnlockedRequest) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalUnlockedRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryEnterpriseLedgerRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.owner":
+		x.Owner = value.Interface().(string)
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.from_time":
+		x.FromTime = value.Uint()
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.to_time":
+		x.ToTime = value.Uint()
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEnterpriseLedgerRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEnterpriseLedgerRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4572,36 +4763,56 @@ func (x *fastReflection_QueryTotalUnlockedRequest) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalUnlockedRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEnterpriseLedgerRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.owner":
+		panic(fmt.Errorf("field owner of message mainchain.enterprise.v1.QueryEnterpriseLedgerRequest is not mutable"))
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.from_time":
+		panic(fmt.Errorf("field from_time of message mainchain.enterprise.v1.QueryEnterpriseLedgerRequest is not mutable"))
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.to_time":
+		panic(fmt.Errorf("field to_time of message mainchain.enterprise.v1.QueryEnterpriseLedgerRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEnterpriseLedgerRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEnterpriseLedgerRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTotalUnlockedRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEnterpriseLedgerRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.owner":
+		return protoreflect.ValueOfString("")
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.from_time":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.to_time":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEnterpriseLedgerRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEnterpriseLedgerRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTotalUnlockedRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryEnterpriseLedgerRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryTotalUnlockedRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryEnterpriseLedgerRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4609,7 +4820,7 @@ func (x *fastReflection_QueryTotalUnlockedRequest) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTotalUnlockedRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryEnterpriseLedgerRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4620,7 +4831,7 @@ func (x *fastReflection_QueryTotalUnlockedRequest) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalUnlockedRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryEnterpriseLedgerRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4632,7 +4843,7 @@ func (x *fastReflection_QueryTotalUnlockedRequest) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTotalUnlockedRequest) IsValid() bool {
+func (x *fastReflection_QueryEnterpriseLedgerRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -4642,9 +4853,9 @@ func (x *fastReflection_QueryTotalUnlockedRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTotalUnlockedRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryEnterpriseLedgerRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTotalUnlockedRequest)
+		x := input.Message.Interface().(*QueryEnterpriseLedgerRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4656,6 +4867,20 @@ func (x *fastReflection_QueryTotalUnlockedRequest) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FromTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.FromTime))
+		}
+		if x.ToTime != 0 {
+			n += 1 + runtime.Sov(uint64(x.ToTime))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4666,7 +4891,7 @@ func (x *fastReflection_QueryTotalUnlockedRequest) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalUnlockedRequest)
+		x := input.Message.Interface().(*QueryEnterpriseLedgerRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4685,6 +4910,37 @@ func (x *fastReflection_QueryTotalUnlockedRequest) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.ToTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ToTime))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.FromTime != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FromTime))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -4696,7 +4952,7 @@ func (x *fastReflection_QueryTotalUnlockedRequest) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalUnlockedRequest)
+		x := input.Message.Interface().(*QueryEnterpriseLedgerRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4728,12 +4984,118 @@ func (x *fastReflection_QueryTotalUnlockedRequest) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalUnlockedRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEnterpriseLedgerRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalUnlockedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEnterpriseLedgerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FromTime", wireType)
+				}
+				x.FromTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FromTime |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ToTime", wireType)
+				}
+				x.ToTime = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ToTime |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4769,74 +5131,127 @@ func (x *fastReflection_QueryTotalUnlockedRequest) ProtoMethods() *protoiface.Me
 	}
 }
 
-var (
-	md_QueryTotalUnlockedResponse        protoreflect.MessageDescriptor
-	fd_QueryTotalUnlockedResponse_amount protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_QueryEnterpriseLedgerResponse_1_list)(nil)
 
-func init() {
-	file_mainchain_enterprise_v1_query_proto_init()
-	md_QueryTotalUnlockedResponse = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryTotalUnlockedResponse")
-	fd_QueryTotalUnlockedResponse_amount = md_QueryTotalUnlockedResponse.Fields().ByName("amount")
+type _QueryEnterpriseLedgerResponse_1_list struct {
+	list *[]*EFUNDLedgerEntry
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTotalUnlockedResponse)(nil)
+func (x *_QueryEnterpriseLedgerResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_QueryTotalUnlockedResponse QueryTotalUnlockedResponse
+func (x *_QueryEnterpriseLedgerResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *QueryTotalUnlockedResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTotalUnlockedResponse)(x)
+func (x *_QueryEnterpriseLedgerResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EFUNDLedgerEntry)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *QueryTotalUnlockedResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
+func (x *_QueryEnterpriseLedgerResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EFUNDLedgerEntry)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryEnterpriseLedgerResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(EFUNDLedgerEntry)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEnterpriseLedgerResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryEnterpriseLedgerResponse_1_list) NewElement() protoreflect.Value {
+	v := new(EFUNDLedgerEntry)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEnterpriseLedgerResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryEnterpriseLedgerResponse            protoreflect.MessageDescriptor
+	fd_QueryEnterpriseLedgerResponse_entries    protoreflect.FieldDescriptor
+	fd_QueryEnterpriseLedgerResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_enterprise_v1_query_proto_init()
+	md_QueryEnterpriseLedgerResponse = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryEnterpriseLedgerResponse")
+	fd_QueryEnterpriseLedgerResponse_entries = md_QueryEnterpriseLedgerResponse.Fields().ByName("entries")
+	fd_QueryEnterpriseLedgerResponse_pagination = md_QueryEnterpriseLedgerResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryEnterpriseLedgerResponse)(nil)
+
+type fastReflection_QueryEnterpriseLedgerResponse QueryEnterpriseLedgerResponse
+
+func (x *QueryEnterpriseLedgerResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEnterpriseLedgerResponse)(x)
+}
+
+func (x *QueryEnterpriseLedgerResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
 		return ms
 	}
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTotalUnlockedResponse_messageType fastReflection_QueryTotalUnlockedResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTotalUnlockedResponse_messageType{}
+var _fastReflection_QueryEnterpriseLedgerResponse_messageType fastReflection_QueryEnterpriseLedgerResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEnterpriseLedgerResponse_messageType{}
 
-type fastReflection_QueryTotalUnlockedResponse_messageType struct{}
+type fastReflection_QueryEnterpriseLedgerResponse_messageType struct{}
 
-func (x fastReflection_QueryTotalUnlockedResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTotalUnlockedResponse)(nil)
+func (x fastReflection_QueryEnterpriseLedgerResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEnterpriseLedgerResponse)(nil)
 }
-func (x fastReflection_QueryTotalUnlockedResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalUnlockedResponse)
+func (x fastReflection_QueryEnterpriseLedgerResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEnterpriseLedgerResponse)
 }
-func (x fastReflection_QueryTotalUnlockedResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalUnlockedResponse
+func (x fastReflection_QueryEnterpriseLedgerResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEnterpriseLedgerResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTotalUnlockedResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalUnlockedResponse
+func (x *fastReflection_QueryEnterpriseLedgerResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEnterpriseLedgerResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTotalUnlockedResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTotalUnlockedResponse_messageType
+func (x *fastReflection_QueryEnterpriseLedgerResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEnterpriseLedgerResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTotalUnlockedResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalUnlockedResponse)
+func (x *fastReflection_QueryEnterpriseLedgerResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryEnterpriseLedgerResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTotalUnlockedResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTotalUnlockedResponse)(x)
+func (x *fastReflection_QueryEnterpriseLedgerResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryEnterpriseLedgerResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4844,10 +5259,16 @@ func (x *fastReflection_QueryTotalUnlockedResponse) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTotalUnlockedResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Amount != nil {
-		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
-		if !f(fd_QueryTotalUnlockedResponse_amount, value) {
+func (x *fastReflection_QueryEnterpriseLedgerResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Entries) != 0 {
+		value := protoreflect.ValueOfList(&_QueryEnterpriseLedgerResponse_1_list{list: &x.Entries})
+		if !f(fd_QueryEnterpriseLedgerResponse_entries, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryEnterpriseLedgerResponse_pagination, value) {
 			return
 		}
 	}
@@ -4864,15 +5285,17 @@ func (x *fastReflection_QueryTotalUnlockedResponse) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTotalUnlockedResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryEnterpriseLedgerResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalUnlockedResponse.amount":
-		return x.Amount != nil
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerResponse.entries":
+		return len(x.Entries) != 0
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerResponse.pagination":
+		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEnterpriseLedgerResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEnterpriseLedgerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4882,15 +5305,17 @@ func (x *fastReflection_QueryTotalUnlockedResponse) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalUnlockedResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryEnterpriseLedgerResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalUnlockedResponse.amount":
-		x.Amount = nil
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerResponse.entries":
+		x.Entries = nil
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerResponse.pagination":
+		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEnterpriseLedgerResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEnterpriseLedgerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4900,16 +5325,22 @@ func (x *fastReflection_QueryTotalUnlockedResponse) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTotalUnlockedResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEnterpriseLedgerResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalUnlockedResponse.amount":
-		value := x.Amount
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerResponse.entries":
+		if len(x.Entries) == 0 {
+			return protoreflect.ValueOfList(&_QueryEnterpriseLedgerResponse_1_list{})
+		}
+		listValue := &_QueryEnterpriseLedgerResponse_1_list{list: &x.Entries}
+		return protoreflect.ValueOfList(listValue)
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerResponse.pagination":
+		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEnterpriseLedgerResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEnterpriseLedgerResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4923,15 +5354,19 @@ func (x *fastReflection_QueryTotalUnlockedResponse) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalUnlockedResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryEnterpriseLedgerResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalUnlockedResponse.amount":
-		x.Amount = value.Message().Interface().(*v1beta11.Coin)
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerResponse.entries":
+		lv := value.List()
+		clv := lv.(*_QueryEnterpriseLedgerResponse_1_list)
+		x.Entries = *clv.list
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEnterpriseLedgerResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEnterpriseLedgerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4945,44 +5380,53 @@ func (x *fastReflection_QueryTotalUnlockedResponse) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalUnlockedResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEnterpriseLedgerResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalUnlockedResponse.amount":
-		if x.Amount == nil {
-			x.Amount = new(v1beta11.Coin)
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerResponse.entries":
+		if x.Entries == nil {
+			x.Entries = []*EFUNDLedgerEntry{}
 		}
-		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		value := &_QueryEnterpriseLedgerResponse_1_list{list: &x.Entries}
+		return protoreflect.ValueOfList(value)
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEnterpriseLedgerResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEnterpriseLedgerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTotalUnlockedResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEnterpriseLedgerResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalUnlockedResponse.amount":
-		m := new(v1beta11.Coin)
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerResponse.entries":
+		list := []*EFUNDLedgerEntry{}
+		return protoreflect.ValueOfList(&_QueryEnterpriseLedgerResponse_1_list{list: &list})
+	case "mainchain.enterprise.v1.QueryEnterpriseLedgerResponse.pagination":
+		m := new(v1beta1.PageResponse)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEnterpriseLedgerResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEnterpriseLedgerResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTotalUnlockedResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryEnterpriseLedgerResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryTotalUnlockedResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryEnterpriseLedgerResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4990,7 +5434,7 @@ func (x *fastReflection_QueryTotalUnlockedResponse) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTotalUnlockedResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryEnterpriseLedgerResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5001,7 +5445,7 @@ func (x *fastReflection_QueryTotalUnlockedResponse) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalUnlockedResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryEnterpriseLedgerResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5013,7 +5457,7 @@ func (x *fastReflection_QueryTotalUnlockedResponse) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTotalUnlockedResponse) IsValid() bool {
+func (x *fastReflection_QueryEnterpriseLedgerResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5023,9 +5467,9 @@ func (x *fastReflection_QueryTotalUnlockedResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTotalUnlockedResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryEnterpriseLedgerResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTotalUnlockedResponse)
+		x := input.Message.Interface().(*QueryEnterpriseLedgerResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5037,8 +5481,14 @@ func (x *fastReflection_QueryTotalUnlockedResponse) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		if x.Amount != nil {
-			l = options.Size(x.Amount)
+		if len(x.Entries) > 0 {
+			for _, e := range x.Entries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -5051,7 +5501,7 @@ func (x *fastReflection_QueryTotalUnlockedResponse) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalUnlockedResponse)
+		x := input.Message.Interface().(*QueryEnterpriseLedgerResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5070,8 +5520,8 @@ func (x *fastReflection_QueryTotalUnlockedResponse) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Amount != nil {
-			encoded, err := options.Marshal(x.Amount)
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5082,7 +5532,23 @@ func (x *fastReflection_QueryTotalUnlockedResponse) ProtoMethods() *protoiface.M
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
+		}
+		if len(x.Entries) > 0 {
+			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Entries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -5095,7 +5561,7 @@ func (x *fastReflection_QueryTotalUnlockedResponse) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalUnlockedResponse)
+		x := input.Message.Interface().(*QueryEnterpriseLedgerResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5127,15 +5593,15 @@ func (x *fastReflection_QueryTotalUnlockedResponse) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalUnlockedResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEnterpriseLedgerResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalUnlockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEnterpriseLedgerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -5162,10 +5628,44 @@ func (x *fastReflection_QueryTotalUnlockedResponse) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Amount == nil {
-					x.Amount = &v1beta11.Coin{}
+				x.Entries = append(x.Entries, &EFUNDLedgerEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -5205,25 +5705,23 @@ func (x *fastReflection_QueryTotalUnlockedResponse) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_QueryTotalSupplyRequest            protoreflect.MessageDescriptor
-	fd_QueryTotalSupplyRequest_pagination protoreflect.FieldDescriptor
+	md_QueryTotalLockedRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_mainchain_enterprise_v1_query_proto_init()
-	md_QueryTotalSupplyRequest = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryTotalSupplyRequest")
-	fd_QueryTotalSupplyRequest_pagination = md_QueryTotalSupplyRequest.Fields().ByName("pagination")
+	md_QueryTotalLockedRequest = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryTotalLockedRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTotalSupplyRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryTotalLockedRequest)(nil)
 
-type fastReflection_QueryTotalSupplyRequest QueryTotalSupplyRequest
+type fastReflection_QueryTotalLockedRequest QueryTotalLockedRequest
 
-func (x *QueryTotalSupplyRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTotalSupplyRequest)(x)
+func (x *QueryTotalLockedRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTotalLockedRequest)(x)
 }
 
-func (x *QueryTotalSupplyRequest) slowProtoReflect() protoreflect.Message {
+func (x *QueryTotalLockedRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5235,43 +5733,43 @@ func (x *QueryTotalSupplyRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTotalSupplyRequest_messageType fastReflection_QueryTotalSupplyRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTotalSupplyRequest_messageType{}
+var _fastReflection_QueryTotalLockedRequest_messageType fastReflection_QueryTotalLockedRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTotalLockedRequest_messageType{}
 
-type fastReflection_QueryTotalSupplyRequest_messageType struct{}
+type fastReflection_QueryTotalLockedRequest_messageType struct{}
 
-func (x fastReflection_QueryTotalSupplyRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTotalSupplyRequest)(nil)
+func (x fastReflection_QueryTotalLockedRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTotalLockedRequest)(nil)
 }
-func (x fastReflection_QueryTotalSupplyRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalSupplyRequest)
+func (x fastReflection_QueryTotalLockedRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalLockedRequest)
 }
-func (x fastReflection_QueryTotalSupplyRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalSupplyRequest
+func (x fastReflection_QueryTotalLockedRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalLockedRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTotalSupplyRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalSupplyRequest
+func (x *fastReflection_QueryTotalLockedRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalLockedRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTotalSupplyRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTotalSupplyRequest_messageType
+func (x *fastReflection_QueryTotalLockedRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTotalLockedRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTotalSupplyRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalSupplyRequest)
+func (x *fastReflection_QueryTotalLockedRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalLockedRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTotalSupplyRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryTotalSupplyRequest)(x)
+func (x *fastReflection_QueryTotalLockedRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTotalLockedRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5279,13 +5777,7 @@ func (x *fastReflection_QueryTotalSupplyRequest) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTotalSupplyRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryTotalSupplyRequest_pagination, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryTotalLockedRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -5299,15 +5791,13 @@ func (x *fastReflection_QueryTotalSupplyRequest) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTotalSupplyRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryTotalLockedRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalSupplyRequest.pagination":
-		return x.Pagination != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5317,34 +5807,3474 @@ func (x *fastReflection_QueryTotalSupplyRequest) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalSupplyRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryTotalLockedRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalSupplyRequest.pagination":
-		x.Pagination = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTotalLockedRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalLockedRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalLockedRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTotalLockedRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTotalLockedRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryTotalLockedRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTotalLockedRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalLockedRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTotalLockedRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTotalLockedRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTotalLockedRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTotalLockedRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTotalLockedRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalLockedRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalLockedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTotalLockedResponse        protoreflect.MessageDescriptor
+	fd_QueryTotalLockedResponse_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_enterprise_v1_query_proto_init()
+	md_QueryTotalLockedResponse = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryTotalLockedResponse")
+	fd_QueryTotalLockedResponse_amount = md_QueryTotalLockedResponse.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTotalLockedResponse)(nil)
+
+type fastReflection_QueryTotalLockedResponse QueryTotalLockedResponse
+
+func (x *QueryTotalLockedResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTotalLockedResponse)(x)
+}
+
+func (x *QueryTotalLockedResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTotalLockedResponse_messageType fastReflection_QueryTotalLockedResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTotalLockedResponse_messageType{}
+
+type fastReflection_QueryTotalLockedResponse_messageType struct{}
+
+func (x fastReflection_QueryTotalLockedResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTotalLockedResponse)(nil)
+}
+func (x fastReflection_QueryTotalLockedResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalLockedResponse)
+}
+func (x fastReflection_QueryTotalLockedResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalLockedResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTotalLockedResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalLockedResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTotalLockedResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTotalLockedResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTotalLockedResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalLockedResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTotalLockedResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTotalLockedResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTotalLockedResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_QueryTotalLockedResponse_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTotalLockedResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalLockedResponse.amount":
+		return x.Amount != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalLockedResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalLockedResponse.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTotalLockedResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalLockedResponse.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalLockedResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalLockedResponse.amount":
+		x.Amount = value.Message().Interface().(*v1beta11.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalLockedResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalLockedResponse.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTotalLockedResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalLockedResponse.amount":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalLockedResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalLockedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTotalLockedResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryTotalLockedResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTotalLockedResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalLockedResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTotalLockedResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTotalLockedResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTotalLockedResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTotalLockedResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTotalLockedResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalLockedResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalLockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTotalUnlockedRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_mainchain_enterprise_v1_query_proto_init()
+	md_QueryTotalUnlockedRequest = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryTotalUnlockedRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTotalUnlockedRequest)(nil)
+
+type fastReflection_QueryTotalUnlockedRequest QueryTotalUnlockedRequest
+
+func (x *QueryTotalUnlockedRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTotalUnlockedRequest)(x)
+}
+
+func (x *QueryTotalUnlockedRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTotalUnlockedRequest_messageType fastReflection_QueryTotalUnlockedRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTotalUnlockedRequest_messageType{}
+
+type fastReflection_QueryTotalUnlockedRequest_messageType struct{}
+
+func (x fastReflection_QueryTotalUnlockedRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTotalUnlockedRequest)(nil)
+}
+func (x fastReflection_QueryTotalUnlockedRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalUnlockedRequest)
+}
+func (x fastReflection_QueryTotalUnlockedRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalUnlockedRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTotalUnlockedRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalUnlockedRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTotalUnlockedRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTotalUnlockedRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTotalUnlockedRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalUnlockedRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTotalUnlockedRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTotalUnlockedRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTotalUnlockedRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTotalUnlockedRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalUnlockedRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTotalUnlockedRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalUnlockedRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalUnlockedRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTotalUnlockedRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTotalUnlockedRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryTotalUnlockedRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTotalUnlockedRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalUnlockedRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTotalUnlockedRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTotalUnlockedRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTotalUnlockedRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTotalUnlockedRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTotalUnlockedRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalUnlockedRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalUnlockedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTotalUnlockedResponse        protoreflect.MessageDescriptor
+	fd_QueryTotalUnlockedResponse_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_enterprise_v1_query_proto_init()
+	md_QueryTotalUnlockedResponse = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryTotalUnlockedResponse")
+	fd_QueryTotalUnlockedResponse_amount = md_QueryTotalUnlockedResponse.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTotalUnlockedResponse)(nil)
+
+type fastReflection_QueryTotalUnlockedResponse QueryTotalUnlockedResponse
+
+func (x *QueryTotalUnlockedResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTotalUnlockedResponse)(x)
+}
+
+func (x *QueryTotalUnlockedResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTotalUnlockedResponse_messageType fastReflection_QueryTotalUnlockedResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTotalUnlockedResponse_messageType{}
+
+type fastReflection_QueryTotalUnlockedResponse_messageType struct{}
+
+func (x fastReflection_QueryTotalUnlockedResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTotalUnlockedResponse)(nil)
+}
+func (x fastReflection_QueryTotalUnlockedResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalUnlockedResponse)
+}
+func (x fastReflection_QueryTotalUnlockedResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalUnlockedResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTotalUnlockedResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalUnlockedResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTotalUnlockedResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTotalUnlockedResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTotalUnlockedResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalUnlockedResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTotalUnlockedResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTotalUnlockedResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTotalUnlockedResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_QueryTotalUnlockedResponse_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTotalUnlockedResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalUnlockedResponse.amount":
+		return x.Amount != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalUnlockedResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalUnlockedResponse.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTotalUnlockedResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalUnlockedResponse.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalUnlockedResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalUnlockedResponse.amount":
+		x.Amount = value.Message().Interface().(*v1beta11.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalUnlockedResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalUnlockedResponse.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTotalUnlockedResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalUnlockedResponse.amount":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalUnlockedResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalUnlockedResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTotalUnlockedResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryTotalUnlockedResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTotalUnlockedResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalUnlockedResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTotalUnlockedResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTotalUnlockedResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTotalUnlockedResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTotalUnlockedResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTotalUnlockedResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalUnlockedResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalUnlockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryTotalSupplyRequest            protoreflect.MessageDescriptor
+	fd_QueryTotalSupplyRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_enterprise_v1_query_proto_init()
+	md_QueryTotalSupplyRequest = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryTotalSupplyRequest")
+	fd_QueryTotalSupplyRequest_pagination = md_QueryTotalSupplyRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTotalSupplyRequest)(nil)
+
+type fastReflection_QueryTotalSupplyRequest QueryTotalSupplyRequest
+
+func (x *QueryTotalSupplyRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTotalSupplyRequest)(x)
+}
+
+func (x *QueryTotalSupplyRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTotalSupplyRequest_messageType fastReflection_QueryTotalSupplyRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTotalSupplyRequest_messageType{}
+
+type fastReflection_QueryTotalSupplyRequest_messageType struct{}
+
+func (x fastReflection_QueryTotalSupplyRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTotalSupplyRequest)(nil)
+}
+func (x fastReflection_QueryTotalSupplyRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalSupplyRequest)
+}
+func (x fastReflection_QueryTotalSupplyRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalSupplyRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTotalSupplyRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalSupplyRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTotalSupplyRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTotalSupplyRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTotalSupplyRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalSupplyRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTotalSupplyRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryTotalSupplyRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTotalSupplyRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryTotalSupplyRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTotalSupplyRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalSupplyRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalSupplyRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalSupplyRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTotalSupplyRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalSupplyRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalSupplyRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalSupplyRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalSupplyRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalSupplyRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTotalSupplyRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalSupplyRequest.pagination":
+		m := new(v1beta1.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyRequest"))
 		}
 		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyRequest does not contain field %s", fd.FullName()))
 	}
 }
 
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTotalSupplyRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryTotalSupplyRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTotalSupplyRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalSupplyRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTotalSupplyRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTotalSupplyRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTotalSupplyRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTotalSupplyRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTotalSupplyRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalSupplyRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryTotalSupplyResponse_1_list)(nil)
+
+type _QueryTotalSupplyResponse_1_list struct {
+	list *[]*v1beta11.Coin
+}
+
+func (x *_QueryTotalSupplyResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryTotalSupplyResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryTotalSupplyResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryTotalSupplyResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryTotalSupplyResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTotalSupplyResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryTotalSupplyResponse_1_list) NewElement() protoreflect.Value {
+	v := new(v1beta11.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryTotalSupplyResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryTotalSupplyResponse            protoreflect.MessageDescriptor
+	fd_QueryTotalSupplyResponse_supply     protoreflect.FieldDescriptor
+	fd_QueryTotalSupplyResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_enterprise_v1_query_proto_init()
+	md_QueryTotalSupplyResponse = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryTotalSupplyResponse")
+	fd_QueryTotalSupplyResponse_supply = md_QueryTotalSupplyResponse.Fields().ByName("supply")
+	fd_QueryTotalSupplyResponse_pagination = md_QueryTotalSupplyResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryTotalSupplyResponse)(nil)
+
+type fastReflection_QueryTotalSupplyResponse QueryTotalSupplyResponse
+
+func (x *QueryTotalSupplyResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryTotalSupplyResponse)(x)
+}
+
+func (x *QueryTotalSupplyResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryTotalSupplyResponse_messageType fastReflection_QueryTotalSupplyResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryTotalSupplyResponse_messageType{}
+
+type fastReflection_QueryTotalSupplyResponse_messageType struct{}
+
+func (x fastReflection_QueryTotalSupplyResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryTotalSupplyResponse)(nil)
+}
+func (x fastReflection_QueryTotalSupplyResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalSupplyResponse)
+}
+func (x fastReflection_QueryTotalSupplyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalSupplyResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryTotalSupplyResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryTotalSupplyResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryTotalSupplyResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryTotalSupplyResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryTotalSupplyResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryTotalSupplyResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryTotalSupplyResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryTotalSupplyResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryTotalSupplyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Supply) != 0 {
+		value := protoreflect.ValueOfList(&_QueryTotalSupplyResponse_1_list{list: &x.Supply})
+		if !f(fd_QueryTotalSupplyResponse_supply, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryTotalSupplyResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryTotalSupplyResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.supply":
+		return len(x.Supply) != 0
+	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalSupplyResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.supply":
+		x.Supply = nil
+	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryTotalSupplyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.supply":
+		if len(x.Supply) == 0 {
+			return protoreflect.ValueOfList(&_QueryTotalSupplyResponse_1_list{})
+		}
+		listValue := &_QueryTotalSupplyResponse_1_list{list: &x.Supply}
+		return protoreflect.ValueOfList(listValue)
+	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalSupplyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.supply":
+		lv := value.List()
+		clv := lv.(*_QueryTotalSupplyResponse_1_list)
+		x.Supply = *clv.list
+	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalSupplyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.supply":
+		if x.Supply == nil {
+			x.Supply = []*v1beta11.Coin{}
+		}
+		value := &_QueryTotalSupplyResponse_1_list{list: &x.Supply}
+		return protoreflect.ValueOfList(value)
+	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta1.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryTotalSupplyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.supply":
+		list := []*v1beta11.Coin{}
+		return protoreflect.ValueOfList(&_QueryTotalSupplyResponse_1_list{list: &list})
+	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.pagination":
+		m := new(v1beta1.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryTotalSupplyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryTotalSupplyResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryTotalSupplyResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryTotalSupplyResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryTotalSupplyResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryTotalSupplyResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryTotalSupplyResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Supply) > 0 {
+			for _, e := range x.Supply {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTotalSupplyResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Supply) > 0 {
+			for iNdEx := len(x.Supply) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Supply[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryTotalSupplyResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalSupplyResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Supply = append(x.Supply, &v1beta11.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Supply[len(x.Supply)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta1.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySupplyOfRequest       protoreflect.MessageDescriptor
+	fd_QuerySupplyOfRequest_denom protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_enterprise_v1_query_proto_init()
+	md_QuerySupplyOfRequest = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QuerySupplyOfRequest")
+	fd_QuerySupplyOfRequest_denom = md_QuerySupplyOfRequest.Fields().ByName("denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySupplyOfRequest)(nil)
+
+type fastReflection_QuerySupplyOfRequest QuerySupplyOfRequest
+
+func (x *QuerySupplyOfRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySupplyOfRequest)(x)
+}
+
+func (x *QuerySupplyOfRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySupplyOfRequest_messageType fastReflection_QuerySupplyOfRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySupplyOfRequest_messageType{}
+
+type fastReflection_QuerySupplyOfRequest_messageType struct{}
+
+func (x fastReflection_QuerySupplyOfRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySupplyOfRequest)(nil)
+}
+func (x fastReflection_QuerySupplyOfRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySupplyOfRequest)
+}
+func (x fastReflection_QuerySupplyOfRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySupplyOfRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySupplyOfRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySupplyOfRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySupplyOfRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySupplyOfRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySupplyOfRequest) New() protoreflect.Message {
+	return new(fastReflection_QuerySupplyOfRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySupplyOfRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuerySupplyOfRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySupplyOfRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_QuerySupplyOfRequest_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySupplyOfRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QuerySupplyOfRequest.denom":
+		return x.Denom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySupplyOfRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QuerySupplyOfRequest.denom":
+		x.Denom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySupplyOfRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.enterprise.v1.QuerySupplyOfRequest.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySupplyOfRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QuerySupplyOfRequest.denom":
+		x.Denom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySupplyOfRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QuerySupplyOfRequest.denom":
+		panic(fmt.Errorf("field denom of message mainchain.enterprise.v1.QuerySupplyOfRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySupplyOfRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QuerySupplyOfRequest.denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySupplyOfRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QuerySupplyOfRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySupplyOfRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySupplyOfRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySupplyOfRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySupplyOfRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySupplyOfRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySupplyOfRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySupplyOfRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySupplyOfRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySupplyOfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuerySupplyOfResponse        protoreflect.MessageDescriptor
+	fd_QuerySupplyOfResponse_amount protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_enterprise_v1_query_proto_init()
+	md_QuerySupplyOfResponse = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QuerySupplyOfResponse")
+	fd_QuerySupplyOfResponse_amount = md_QuerySupplyOfResponse.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuerySupplyOfResponse)(nil)
+
+type fastReflection_QuerySupplyOfResponse QuerySupplyOfResponse
+
+func (x *QuerySupplyOfResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuerySupplyOfResponse)(x)
+}
+
+func (x *QuerySupplyOfResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuerySupplyOfResponse_messageType fastReflection_QuerySupplyOfResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QuerySupplyOfResponse_messageType{}
+
+type fastReflection_QuerySupplyOfResponse_messageType struct{}
+
+func (x fastReflection_QuerySupplyOfResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuerySupplyOfResponse)(nil)
+}
+func (x fastReflection_QuerySupplyOfResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuerySupplyOfResponse)
+}
+func (x fastReflection_QuerySupplyOfResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySupplyOfResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuerySupplyOfResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuerySupplyOfResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuerySupplyOfResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QuerySupplyOfResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuerySupplyOfResponse) New() protoreflect.Message {
+	return new(fastReflection_QuerySupplyOfResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuerySupplyOfResponse) Interface() protoreflect.ProtoMessage {
+	return (*QuerySupplyOfResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuerySupplyOfResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_QuerySupplyOfResponse_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuerySupplyOfResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QuerySupplyOfResponse.amount":
+		return x.Amount != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySupplyOfResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QuerySupplyOfResponse.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuerySupplyOfResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.enterprise.v1.QuerySupplyOfResponse.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySupplyOfResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QuerySupplyOfResponse.amount":
+		x.Amount = value.Message().Interface().(*v1beta11.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySupplyOfResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QuerySupplyOfResponse.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta11.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuerySupplyOfResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QuerySupplyOfResponse.amount":
+		m := new(v1beta11.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuerySupplyOfResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QuerySupplyOfResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuerySupplyOfResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuerySupplyOfResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuerySupplyOfResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuerySupplyOfResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuerySupplyOfResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySupplyOfResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuerySupplyOfResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySupplyOfResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySupplyOfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta11.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryWhitelistRequest protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_mainchain_enterprise_v1_query_proto_init()
+	md_QueryWhitelistRequest = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryWhitelistRequest")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryWhitelistRequest)(nil)
+
+type fastReflection_QueryWhitelistRequest QueryWhitelistRequest
+
+func (x *QueryWhitelistRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryWhitelistRequest)(x)
+}
+
+func (x *QueryWhitelistRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryWhitelistRequest_messageType fastReflection_QueryWhitelistRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryWhitelistRequest_messageType{}
+
+type fastReflection_QueryWhitelistRequest_messageType struct{}
+
+func (x fastReflection_QueryWhitelistRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryWhitelistRequest)(nil)
+}
+func (x fastReflection_QueryWhitelistRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryWhitelistRequest)
+}
+func (x fastReflection_QueryWhitelistRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWhitelistRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryWhitelistRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWhitelistRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryWhitelistRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryWhitelistRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryWhitelistRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryWhitelistRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryWhitelistRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryWhitelistRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryWhitelistRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryWhitelistRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryWhitelistRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistRequest does not contain field %s", fd.FullName()))
+	}
+}
+
 // Get retrieves the value for a field.
 //
 // For unpopulated scalars, it returns the default value, where
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTotalSupplyRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWhitelistRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalSupplyRequest.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5358,15 +9288,13 @@ func (x *fastReflection_QueryTotalSupplyRequest) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalSupplyRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryWhitelistRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalSupplyRequest.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageRequest)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5380,44 +9308,36 @@ func (x *fastReflection_QueryTotalSupplyRequest) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalSupplyRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWhitelistRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalSupplyRequest.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageRequest)
-		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTotalSupplyRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWhitelistRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalSupplyRequest.pagination":
-		m := new(v1beta1.PageRequest)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTotalSupplyRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryWhitelistRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryTotalSupplyRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryWhitelistRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5425,7 +9345,7 @@ func (x *fastReflection_QueryTotalSupplyRequest) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTotalSupplyRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryWhitelistRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5436,7 +9356,7 @@ func (x *fastReflection_QueryTotalSupplyRequest) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalSupplyRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryWhitelistRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5448,7 +9368,7 @@ func (x *fastReflection_QueryTotalSupplyRequest) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTotalSupplyRequest) IsValid() bool {
+func (x *fastReflection_QueryWhitelistRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -5458,9 +9378,9 @@ func (x *fastReflection_QueryTotalSupplyRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTotalSupplyRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryWhitelistRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTotalSupplyRequest)
+		x := input.Message.Interface().(*QueryWhitelistRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5472,10 +9392,6 @@ func (x *fastReflection_QueryTotalSupplyRequest) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5486,7 +9402,7 @@ func (x *fastReflection_QueryTotalSupplyRequest) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalSupplyRequest)
+		x := input.Message.Interface().(*QueryWhitelistRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5505,20 +9421,6 @@ func (x *fastReflection_QueryTotalSupplyRequest) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -5530,7 +9432,7 @@ func (x *fastReflection_QueryTotalSupplyRequest) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalSupplyRequest)
+		x := input.Message.Interface().(*QueryWhitelistRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5562,48 +9464,12 @@ func (x *fastReflection_QueryTotalSupplyRequest) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalSupplyRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWhitelistRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-				}
-				var msglen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					msglen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if msglen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + msglen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageRequest{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5639,80 +9505,126 @@ func (x *fastReflection_QueryTotalSupplyRequest) ProtoMethods() *protoiface.Meth
 	}
 }
 
-var _ protoreflect.List = (*_QueryTotalSupplyResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryWhitelistResponse_1_list)(nil)
 
-type _QueryTotalSupplyResponse_1_list struct {
-	list *[]*v1beta11.Coin
+type _QueryWhitelistResponse_1_list struct {
+	list *[]string
 }
 
-func (x *_QueryTotalSupplyResponse_1_list) Len() int {
+func (x *_QueryWhitelistResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryTotalSupplyResponse_1_list) Get(i int) protoreflect.Value {
+func (x *_QueryWhitelistResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_QueryWhitelistResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryWhitelistResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryWhitelistResponse_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message QueryWhitelistResponse at list field Addresses as it is not of Message kind"))
+}
+
+func (x *_QueryWhitelistResponse_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryWhitelistResponse_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_QueryWhitelistResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_QueryWhitelistResponse_2_list)(nil)
+
+type _QueryWhitelistResponse_2_list struct {
+	list *[]*WhitelistEntry
+}
+
+func (x *_QueryWhitelistResponse_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryWhitelistResponse_2_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryTotalSupplyResponse_1_list) Set(i int, value protoreflect.Value) {
+func (x *_QueryWhitelistResponse_2_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	concreteValue := valueUnwrapped.Interface().(*WhitelistEntry)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryTotalSupplyResponse_1_list) Append(value protoreflect.Value) {
+func (x *_QueryWhitelistResponse_2_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.Message()
-	concreteValue := valueUnwrapped.Interface().(*v1beta11.Coin)
+	concreteValue := valueUnwrapped.Interface().(*WhitelistEntry)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryTotalSupplyResponse_1_list) AppendMutable() protoreflect.Value {
-	v := new(v1beta11.Coin)
+func (x *_QueryWhitelistResponse_2_list) AppendMutable() protoreflect.Value {
+	v := new(WhitelistEntry)
 	*x.list = append(*x.list, v)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryTotalSupplyResponse_1_list) Truncate(n int) {
+func (x *_QueryWhitelistResponse_2_list) Truncate(n int) {
 	for i := n; i < len(*x.list); i++ {
 		(*x.list)[i] = nil
 	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryTotalSupplyResponse_1_list) NewElement() protoreflect.Value {
-	v := new(v1beta11.Coin)
+func (x *_QueryWhitelistResponse_2_list) NewElement() protoreflect.Value {
+	v := new(WhitelistEntry)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryTotalSupplyResponse_1_list) IsValid() bool {
+func (x *_QueryWhitelistResponse_2_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryTotalSupplyResponse            protoreflect.MessageDescriptor
-	fd_QueryTotalSupplyResponse_supply     protoreflect.FieldDescriptor
-	fd_QueryTotalSupplyResponse_pagination protoreflect.FieldDescriptor
+	md_QueryWhitelistResponse           protoreflect.MessageDescriptor
+	fd_QueryWhitelistResponse_addresses protoreflect.FieldDescriptor
+	fd_QueryWhitelistResponse_entries   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mainchain_enterprise_v1_query_proto_init()
-	md_QueryTotalSupplyResponse = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryTotalSupplyResponse")
-	fd_QueryTotalSupplyResponse_supply = md_QueryTotalSupplyResponse.Fields().ByName("supply")
-	fd_QueryTotalSupplyResponse_pagination = md_QueryTotalSupplyResponse.Fields().ByName("pagination")
+	md_QueryWhitelistResponse = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryWhitelistResponse")
+	fd_QueryWhitelistResponse_addresses = md_QueryWhitelistResponse.Fields().ByName("addresses")
+	fd_QueryWhitelistResponse_entries = md_QueryWhitelistResponse.Fields().ByName("entries")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryTotalSupplyResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryWhitelistResponse)(nil)
 
-type fastReflection_QueryTotalSupplyResponse QueryTotalSupplyResponse
+type fastReflection_QueryWhitelistResponse QueryWhitelistResponse
 
-func (x *QueryTotalSupplyResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryTotalSupplyResponse)(x)
+func (x *QueryWhitelistResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryWhitelistResponse)(x)
 }
 
-func (x *QueryTotalSupplyResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[13]
+func (x *QueryWhitelistResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5723,43 +9635,43 @@ func (x *QueryTotalSupplyResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryTotalSupplyResponse_messageType fastReflection_QueryTotalSupplyResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryTotalSupplyResponse_messageType{}
+var _fastReflection_QueryWhitelistResponse_messageType fastReflection_QueryWhitelistResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryWhitelistResponse_messageType{}
 
-type fastReflection_QueryTotalSupplyResponse_messageType struct{}
+type fastReflection_QueryWhitelistResponse_messageType struct{}
 
-func (x fastReflection_QueryTotalSupplyResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryTotalSupplyResponse)(nil)
+func (x fastReflection_QueryWhitelistResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryWhitelistResponse)(nil)
 }
-func (x fastReflection_QueryTotalSupplyResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalSupplyResponse)
+func (x fastReflection_QueryWhitelistResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryWhitelistResponse)
 }
-func (x fastReflection_QueryTotalSupplyResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalSupplyResponse
+func (x fastReflection_QueryWhitelistResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWhitelistResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryTotalSupplyResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryTotalSupplyResponse
+func (x *fastReflection_QueryWhitelistResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWhitelistResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryTotalSupplyResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryTotalSupplyResponse_messageType
+func (x *fastReflection_QueryWhitelistResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryWhitelistResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryTotalSupplyResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryTotalSupplyResponse)
+func (x *fastReflection_QueryWhitelistResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryWhitelistResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryTotalSupplyResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryTotalSupplyResponse)(x)
+func (x *fastReflection_QueryWhitelistResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryWhitelistResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5767,16 +9679,16 @@ func (x *fastReflection_QueryTotalSupplyResponse) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryTotalSupplyResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Supply) != 0 {
-		value := protoreflect.ValueOfList(&_QueryTotalSupplyResponse_1_list{list: &x.Supply})
-		if !f(fd_QueryTotalSupplyResponse_supply, value) {
+func (x *fastReflection_QueryWhitelistResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Addresses) != 0 {
+		value := protoreflect.ValueOfList(&_QueryWhitelistResponse_1_list{list: &x.Addresses})
+		if !f(fd_QueryWhitelistResponse_addresses, value) {
 			return
 		}
 	}
-	if x.Pagination != nil {
-		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
-		if !f(fd_QueryTotalSupplyResponse_pagination, value) {
+	if len(x.Entries) != 0 {
+		value := protoreflect.ValueOfList(&_QueryWhitelistResponse_2_list{list: &x.Entries})
+		if !f(fd_QueryWhitelistResponse_entries, value) {
 			return
 		}
 	}
@@ -5793,17 +9705,17 @@ func (x *fastReflection_QueryTotalSupplyResponse) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryTotalSupplyResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryWhitelistResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.supply":
-		return len(x.Supply) != 0
-	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.pagination":
-		return x.Pagination != nil
+	case "mainchain.enterprise.v1.QueryWhitelistResponse.addresses":
+		return len(x.Addresses) != 0
+	case "mainchain.enterprise.v1.QueryWhitelistResponse.entries":
+		return len(x.Entries) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5813,17 +9725,17 @@ func (x *fastReflection_QueryTotalSupplyResponse) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalSupplyResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryWhitelistResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.supply":
-		x.Supply = nil
-	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.pagination":
-		x.Pagination = nil
+	case "mainchain.enterprise.v1.QueryWhitelistResponse.addresses":
+		x.Addresses = nil
+	case "mainchain.enterprise.v1.QueryWhitelistResponse.entries":
+		x.Entries = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5833,22 +9745,25 @@ func (x *fastReflection_QueryTotalSupplyResponse) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryTotalSupplyResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWhitelistResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.supply":
-		if len(x.Supply) == 0 {
-			return protoreflect.ValueOfList(&_QueryTotalSupplyResponse_1_list{})
+	case "mainchain.enterprise.v1.QueryWhitelistResponse.addresses":
+		if len(x.Addresses) == 0 {
+			return protoreflect.ValueOfList(&_QueryWhitelistResponse_1_list{})
 		}
-		listValue := &_QueryTotalSupplyResponse_1_list{list: &x.Supply}
+		listValue := &_QueryWhitelistResponse_1_list{list: &x.Addresses}
+		return protoreflect.ValueOfList(listValue)
+	case "mainchain.enterprise.v1.QueryWhitelistResponse.entries":
+		if len(x.Entries) == 0 {
+			return protoreflect.ValueOfList(&_QueryWhitelistResponse_2_list{})
+		}
+		listValue := &_QueryWhitelistResponse_2_list{list: &x.Entries}
 		return protoreflect.ValueOfList(listValue)
-	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.pagination":
-		value := x.Pagination
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5862,19 +9777,21 @@ func (x *fastReflection_QueryTotalSupplyResponse) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalSupplyResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryWhitelistResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.supply":
+	case "mainchain.enterprise.v1.QueryWhitelistResponse.addresses":
 		lv := value.List()
-		clv := lv.(*_QueryTotalSupplyResponse_1_list)
-		x.Supply = *clv.list
-	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.pagination":
-		x.Pagination = value.Message().Interface().(*v1beta1.PageResponse)
+		clv := lv.(*_QueryWhitelistResponse_1_list)
+		x.Addresses = *clv.list
+	case "mainchain.enterprise.v1.QueryWhitelistResponse.entries":
+		lv := value.List()
+		clv := lv.(*_QueryWhitelistResponse_2_list)
+		x.Entries = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5888,53 +9805,54 @@ func (x *fastReflection_QueryTotalSupplyResponse) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalSupplyResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWhitelistResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.supply":
-		if x.Supply == nil {
-			x.Supply = []*v1beta11.Coin{}
+	case "mainchain.enterprise.v1.QueryWhitelistResponse.addresses":
+		if x.Addresses == nil {
+			x.Addresses = []string{}
 		}
-		value := &_QueryTotalSupplyResponse_1_list{list: &x.Supply}
+		value := &_QueryWhitelistResponse_1_list{list: &x.Addresses}
 		return protoreflect.ValueOfList(value)
-	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.pagination":
-		if x.Pagination == nil {
-			x.Pagination = new(v1beta1.PageResponse)
+	case "mainchain.enterprise.v1.QueryWhitelistResponse.entries":
+		if x.Entries == nil {
+			x.Entries = []*WhitelistEntry{}
 		}
-		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		value := &_QueryWhitelistResponse_2_list{list: &x.Entries}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryTotalSupplyResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWhitelistResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.supply":
-		list := []*v1beta11.Coin{}
-		return protoreflect.ValueOfList(&_QueryTotalSupplyResponse_1_list{list: &list})
-	case "mainchain.enterprise.v1.QueryTotalSupplyResponse.pagination":
-		m := new(v1beta1.PageResponse)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.enterprise.v1.QueryWhitelistResponse.addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_QueryWhitelistResponse_1_list{list: &list})
+	case "mainchain.enterprise.v1.QueryWhitelistResponse.entries":
+		list := []*WhitelistEntry{}
+		return protoreflect.ValueOfList(&_QueryWhitelistResponse_2_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryTotalSupplyResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryTotalSupplyResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryTotalSupplyResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryWhitelistResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryTotalSupplyResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryWhitelistResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5942,7 +9860,7 @@ func (x *fastReflection_QueryTotalSupplyResponse) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryTotalSupplyResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryWhitelistResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5953,7 +9871,7 @@ func (x *fastReflection_QueryTotalSupplyResponse) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryTotalSupplyResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryWhitelistResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5965,7 +9883,7 @@ func (x *fastReflection_QueryTotalSupplyResponse) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryTotalSupplyResponse) IsValid() bool {
+func (x *fastReflection_QueryWhitelistResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -5975,9 +9893,9 @@ func (x *fastReflection_QueryTotalSupplyResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryTotalSupplyResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryWhitelistResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryTotalSupplyResponse)
+		x := input.Message.Interface().(*QueryWhitelistResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5989,15 +9907,17 @@ func (x *fastReflection_QueryTotalSupplyResponse) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		if len(x.Supply) > 0 {
-			for _, e := range x.Supply {
-				l = options.Size(e)
+		if len(x.Addresses) > 0 {
+			for _, s := range x.Addresses {
+				l = len(s)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
-		if x.Pagination != nil {
-			l = options.Size(x.Pagination)
-			n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.Entries) > 0 {
+			for _, e := range x.Entries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -6009,7 +9929,7 @@ func (x *fastReflection_QueryTotalSupplyResponse) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalSupplyResponse)
+		x := input.Message.Interface().(*QueryWhitelistResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6028,23 +9948,9 @@ func (x *fastReflection_QueryTotalSupplyResponse) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Pagination != nil {
-			encoded, err := options.Marshal(x.Pagination)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Supply) > 0 {
-			for iNdEx := len(x.Supply) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Supply[iNdEx])
+		if len(x.Entries) > 0 {
+			for iNdEx := len(x.Entries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Entries[iNdEx])
 				if err != nil {
 					return protoiface.MarshalOutput{
 						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6055,6 +9961,15 @@ func (x *fastReflection_QueryTotalSupplyResponse) ProtoMethods() *protoiface.Met
 				copy(dAtA[i:], encoded)
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Addresses) > 0 {
+			for iNdEx := len(x.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Addresses[iNdEx])
+				copy(dAtA[i:], x.Addresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Addresses[iNdEx])))
+				i--
 				dAtA[i] = 0xa
 			}
 		}
@@ -6069,7 +9984,7 @@ func (x *fastReflection_QueryTotalSupplyResponse) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryTotalSupplyResponse)
+		x := input.Message.Interface().(*QueryWhitelistResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6101,17 +10016,17 @@ func (x *fastReflection_QueryTotalSupplyResponse) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalSupplyResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWhitelistResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryTotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 				}
-				var msglen int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -6121,29 +10036,27 @@ func (x *fastReflection_QueryTotalSupplyResponse) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					msglen |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if msglen < 0 {
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + msglen
+				postIndex := iNdEx + intStringLen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Supply = append(x.Supply, &v1beta11.Coin{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Supply[len(x.Supply)-1]); err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
+				x.Addresses = append(x.Addresses, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -6170,10 +10083,8 @@ func (x *fastReflection_QueryTotalSupplyResponse) ProtoMethods() *protoiface.Met
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Pagination == nil {
-					x.Pagination = &v1beta1.PageResponse{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+				x.Entries = append(x.Entries, &WhitelistEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entries[len(x.Entries)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -6213,26 +10124,26 @@ func (x *fastReflection_QueryTotalSupplyResponse) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_QuerySupplyOfRequest       protoreflect.MessageDescriptor
-	fd_QuerySupplyOfRequest_denom protoreflect.FieldDescriptor
+	md_QueryWhitelistedRequest         protoreflect.MessageDescriptor
+	fd_QueryWhitelistedRequest_address protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mainchain_enterprise_v1_query_proto_init()
-	md_QuerySupplyOfRequest = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QuerySupplyOfRequest")
-	fd_QuerySupplyOfRequest_denom = md_QuerySupplyOfRequest.Fields().ByName("denom")
+	md_QueryWhitelistedRequest = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryWhitelistedRequest")
+	fd_QueryWhitelistedRequest_address = md_QueryWhitelistedRequest.Fields().ByName("address")
 }
 
-var _ protoreflect.Message = (*fastReflection_QuerySupplyOfRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryWhitelistedRequest)(nil)
 
-type fastReflection_QuerySupplyOfRequest QuerySupplyOfRequest
+type fastReflection_QueryWhitelistedRequest QueryWhitelistedRequest
 
-func (x *QuerySupplyOfRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QuerySupplyOfRequest)(x)
+func (x *QueryWhitelistedRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryWhitelistedRequest)(x)
 }
 
-func (x *QuerySupplyOfRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[14]
+func (x *QueryWhitelistedRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6243,43 +10154,43 @@ func (x *QuerySupplyOfRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QuerySupplyOfRequest_messageType fastReflection_QuerySupplyOfRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QuerySupplyOfRequest_messageType{}
+var _fastReflection_QueryWhitelistedRequest_messageType fastReflection_QueryWhitelistedRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryWhitelistedRequest_messageType{}
 
-type fastReflection_QuerySupplyOfRequest_messageType struct{}
+type fastReflection_QueryWhitelistedRequest_messageType struct{}
 
-func (x fastReflection_QuerySupplyOfRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QuerySupplyOfRequest)(nil)
+func (x fastReflection_QueryWhitelistedRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryWhitelistedRequest)(nil)
 }
-func (x fastReflection_QuerySupplyOfRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QuerySupplyOfRequest)
+func (x fastReflection_QueryWhitelistedRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryWhitelistedRequest)
 }
-func (x fastReflection_QuerySupplyOfRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySupplyOfRequest
+func (x fastReflection_QueryWhitelistedRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWhitelistedRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QuerySupplyOfRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySupplyOfRequest
+func (x *fastReflection_QueryWhitelistedRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWhitelistedRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QuerySupplyOfRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QuerySupplyOfRequest_messageType
+func (x *fastReflection_QueryWhitelistedRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryWhitelistedRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QuerySupplyOfRequest) New() protoreflect.Message {
-	return new(fastReflection_QuerySupplyOfRequest)
+func (x *fastReflection_QueryWhitelistedRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryWhitelistedRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QuerySupplyOfRequest) Interface() protoreflect.ProtoMessage {
-	return (*QuerySupplyOfRequest)(x)
+func (x *fastReflection_QueryWhitelistedRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryWhitelistedRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6287,10 +10198,10 @@ func (x *fastReflection_QuerySupplyOfRequest) Interface() protoreflect.ProtoMess
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QuerySupplyOfRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_QuerySupplyOfRequest_denom, value) {
+func (x *fastReflection_QueryWhitelistedRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryWhitelistedRequest_address, value) {
 			return
 		}
 	}
@@ -6307,15 +10218,15 @@ func (x *fastReflection_QuerySupplyOfRequest) Range(f func(protoreflect.FieldDes
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QuerySupplyOfRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryWhitelistedRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QuerySupplyOfRequest.denom":
-		return x.Denom != ""
+	case "mainchain.enterprise.v1.QueryWhitelistedRequest.address":
+		return x.Address != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6325,15 +10236,15 @@ func (x *fastReflection_QuerySupplyOfRequest) Has(fd protoreflect.FieldDescripto
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySupplyOfRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryWhitelistedRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QuerySupplyOfRequest.denom":
-		x.Denom = ""
+	case "mainchain.enterprise.v1.QueryWhitelistedRequest.address":
+		x.Address = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6343,16 +10254,16 @@ func (x *fastReflection_QuerySupplyOfRequest) Clear(fd protoreflect.FieldDescrip
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QuerySupplyOfRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWhitelistedRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mainchain.enterprise.v1.QuerySupplyOfRequest.denom":
-		value := x.Denom
+	case "mainchain.enterprise.v1.QueryWhitelistedRequest.address":
+		value := x.Address
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6366,15 +10277,15 @@ func (x *fastReflection_QuerySupplyOfRequest) Get(descriptor protoreflect.FieldD
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySupplyOfRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryWhitelistedRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QuerySupplyOfRequest.denom":
-		x.Denom = value.Interface().(string)
+	case "mainchain.enterprise.v1.QueryWhitelistedRequest.address":
+		x.Address = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6388,40 +10299,40 @@ func (x *fastReflection_QuerySupplyOfRequest) Set(fd protoreflect.FieldDescripto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySupplyOfRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWhitelistedRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QuerySupplyOfRequest.denom":
-		panic(fmt.Errorf("field denom of message mainchain.enterprise.v1.QuerySupplyOfRequest is not mutable"))
+	case "mainchain.enterprise.v1.QueryWhitelistedRequest.address":
+		panic(fmt.Errorf("field address of message mainchain.enterprise.v1.QueryWhitelistedRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QuerySupplyOfRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWhitelistedRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QuerySupplyOfRequest.denom":
+	case "mainchain.enterprise.v1.QueryWhitelistedRequest.address":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QuerySupplyOfRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryWhitelistedRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QuerySupplyOfRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryWhitelistedRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6429,7 +10340,7 @@ func (x *fastReflection_QuerySupplyOfRequest) WhichOneof(d protoreflect.OneofDes
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QuerySupplyOfRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryWhitelistedRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6440,7 +10351,7 @@ func (x *fastReflection_QuerySupplyOfRequest) GetUnknown() protoreflect.RawField
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySupplyOfRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryWhitelistedRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6452,7 +10363,7 @@ func (x *fastReflection_QuerySupplyOfRequest) SetUnknown(fields protoreflect.Raw
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QuerySupplyOfRequest) IsValid() bool {
+func (x *fastReflection_QueryWhitelistedRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6462,9 +10373,9 @@ func (x *fastReflection_QuerySupplyOfRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QuerySupplyOfRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryWhitelistedRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QuerySupplyOfRequest)
+		x := input.Message.Interface().(*QueryWhitelistedRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6476,7 +10387,7 @@ func (x *fastReflection_QuerySupplyOfRequest) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.Denom)
+		l = len(x.Address)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -6490,7 +10401,7 @@ func (x *fastReflection_QuerySupplyOfRequest) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySupplyOfRequest)
+		x := input.Message.Interface().(*QueryWhitelistedRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6509,10 +10420,10 @@ func (x *fastReflection_QuerySupplyOfRequest) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6527,7 +10438,7 @@ func (x *fastReflection_QuerySupplyOfRequest) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySupplyOfRequest)
+		x := input.Message.Interface().(*QueryWhitelistedRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6559,15 +10470,15 @@ func (x *fastReflection_QuerySupplyOfRequest) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySupplyOfRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWhitelistedRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySupplyOfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWhitelistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6595,7 +10506,7 @@ func (x *fastReflection_QuerySupplyOfRequest) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
+				x.Address = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6633,26 +10544,30 @@ func (x *fastReflection_QuerySupplyOfRequest) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_QuerySupplyOfResponse        protoreflect.MessageDescriptor
-	fd_QuerySupplyOfResponse_amount protoreflect.FieldDescriptor
+	md_QueryWhitelistedResponse             protoreflect.MessageDescriptor
+	fd_QueryWhitelistedResponse_address     protoreflect.FieldDescriptor
+	fd_QueryWhitelistedResponse_whitelisted protoreflect.FieldDescriptor
+	fd_QueryWhitelistedResponse_entry       protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mainchain_enterprise_v1_query_proto_init()
-	md_QuerySupplyOfResponse = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QuerySupplyOfResponse")
-	fd_QuerySupplyOfResponse_amount = md_QuerySupplyOfResponse.Fields().ByName("amount")
+	md_QueryWhitelistedResponse = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryWhitelistedResponse")
+	fd_QueryWhitelistedResponse_address = md_QueryWhitelistedResponse.Fields().ByName("address")
+	fd_QueryWhitelistedResponse_whitelisted = md_QueryWhitelistedResponse.Fields().ByName("whitelisted")
+	fd_QueryWhitelistedResponse_entry = md_QueryWhitelistedResponse.Fields().ByName("entry")
 }
 
-var _ protoreflect.Message = (*fastReflection_QuerySupplyOfResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryWhitelistedResponse)(nil)
 
-type fastReflection_QuerySupplyOfResponse QuerySupplyOfResponse
+type fastReflection_QueryWhitelistedResponse QueryWhitelistedResponse
 
-func (x *QuerySupplyOfResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QuerySupplyOfResponse)(x)
+func (x *QueryWhitelistedResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryWhitelistedResponse)(x)
 }
 
-func (x *QuerySupplyOfResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[15]
+func (x *QueryWhitelistedResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6663,43 +10578,43 @@ func (x *QuerySupplyOfResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QuerySupplyOfResponse_messageType fastReflection_QuerySupplyOfResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QuerySupplyOfResponse_messageType{}
+var _fastReflection_QueryWhitelistedResponse_messageType fastReflection_QueryWhitelistedResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryWhitelistedResponse_messageType{}
 
-type fastReflection_QuerySupplyOfResponse_messageType struct{}
+type fastReflection_QueryWhitelistedResponse_messageType struct{}
 
-func (x fastReflection_QuerySupplyOfResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QuerySupplyOfResponse)(nil)
+func (x fastReflection_QueryWhitelistedResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryWhitelistedResponse)(nil)
 }
-func (x fastReflection_QuerySupplyOfResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QuerySupplyOfResponse)
+func (x fastReflection_QueryWhitelistedResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryWhitelistedResponse)
 }
-func (x fastReflection_QuerySupplyOfResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySupplyOfResponse
+func (x fastReflection_QueryWhitelistedResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWhitelistedResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QuerySupplyOfResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QuerySupplyOfResponse
+func (x *fastReflection_QueryWhitelistedResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryWhitelistedResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QuerySupplyOfResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QuerySupplyOfResponse_messageType
+func (x *fastReflection_QueryWhitelistedResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryWhitelistedResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QuerySupplyOfResponse) New() protoreflect.Message {
-	return new(fastReflection_QuerySupplyOfResponse)
+func (x *fastReflection_QueryWhitelistedResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryWhitelistedResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QuerySupplyOfResponse) Interface() protoreflect.ProtoMessage {
-	return (*QuerySupplyOfResponse)(x)
+func (x *fastReflection_QueryWhitelistedResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryWhitelistedResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6707,10 +10622,22 @@ func (x *fastReflection_QuerySupplyOfResponse) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QuerySupplyOfResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Amount != nil {
-		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
-		if !f(fd_QuerySupplyOfResponse_amount, value) {
+func (x *fastReflection_QueryWhitelistedResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_QueryWhitelistedResponse_address, value) {
+			return
+		}
+	}
+	if x.Whitelisted != false {
+		value := protoreflect.ValueOfBool(x.Whitelisted)
+		if !f(fd_QueryWhitelistedResponse_whitelisted, value) {
+			return
+		}
+	}
+	if x.Entry != nil {
+		value := protoreflect.ValueOfMessage(x.Entry.ProtoReflect())
+		if !f(fd_QueryWhitelistedResponse_entry, value) {
 			return
 		}
 	}
@@ -6727,15 +10654,19 @@ func (x *fastReflection_QuerySupplyOfResponse) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QuerySupplyOfResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryWhitelistedResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QuerySupplyOfResponse.amount":
-		return x.Amount != nil
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.address":
+		return x.Address != ""
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.whitelisted":
+		return x.Whitelisted != false
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.entry":
+		return x.Entry != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6745,15 +10676,19 @@ func (x *fastReflection_QuerySupplyOfResponse) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySupplyOfResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryWhitelistedResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QuerySupplyOfResponse.amount":
-		x.Amount = nil
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.address":
+		x.Address = ""
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.whitelisted":
+		x.Whitelisted = false
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.entry":
+		x.Entry = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6763,16 +10698,22 @@ func (x *fastReflection_QuerySupplyOfResponse) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QuerySupplyOfResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWhitelistedResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mainchain.enterprise.v1.QuerySupplyOfResponse.amount":
-		value := x.Amount
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.whitelisted":
+		value := x.Whitelisted
+		return protoreflect.ValueOfBool(value)
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.entry":
+		value := x.Entry
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6786,15 +10727,19 @@ func (x *fastReflection_QuerySupplyOfResponse) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySupplyOfResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryWhitelistedResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QuerySupplyOfResponse.amount":
-		x.Amount = value.Message().Interface().(*v1beta11.Coin)
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.address":
+		x.Address = value.Interface().(string)
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.whitelisted":
+		x.Whitelisted = value.Bool()
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.entry":
+		x.Entry = value.Message().Interface().(*WhitelistEntry)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6808,44 +10753,52 @@ func (x *fastReflection_QuerySupplyOfResponse) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySupplyOfResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWhitelistedResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QuerySupplyOfResponse.amount":
-		if x.Amount == nil {
-			x.Amount = new(v1beta11.Coin)
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.entry":
+		if x.Entry == nil {
+			x.Entry = new(WhitelistEntry)
 		}
-		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		return protoreflect.ValueOfMessage(x.Entry.ProtoReflect())
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.address":
+		panic(fmt.Errorf("field address of message mainchain.enterprise.v1.QueryWhitelistedResponse is not mutable"))
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.whitelisted":
+		panic(fmt.Errorf("field whitelisted of message mainchain.enterprise.v1.QueryWhitelistedResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QuerySupplyOfResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryWhitelistedResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QuerySupplyOfResponse.amount":
-		m := new(v1beta11.Coin)
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.address":
+		return protoreflect.ValueOfString("")
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.whitelisted":
+		return protoreflect.ValueOfBool(false)
+	case "mainchain.enterprise.v1.QueryWhitelistedResponse.entry":
+		m := new(WhitelistEntry)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QuerySupplyOfResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QuerySupplyOfResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QuerySupplyOfResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryWhitelistedResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QuerySupplyOfResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryWhitelistedResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6853,7 +10806,7 @@ func (x *fastReflection_QuerySupplyOfResponse) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QuerySupplyOfResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryWhitelistedResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6864,7 +10817,7 @@ func (x *fastReflection_QuerySupplyOfResponse) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QuerySupplyOfResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryWhitelistedResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6876,7 +10829,7 @@ func (x *fastReflection_QuerySupplyOfResponse) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QuerySupplyOfResponse) IsValid() bool {
+func (x *fastReflection_QueryWhitelistedResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -6886,9 +10839,9 @@ func (x *fastReflection_QuerySupplyOfResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QuerySupplyOfResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryWhitelistedResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QuerySupplyOfResponse)
+		x := input.Message.Interface().(*QueryWhitelistedResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6900,8 +10853,15 @@ func (x *fastReflection_QuerySupplyOfResponse) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		if x.Amount != nil {
-			l = options.Size(x.Amount)
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Whitelisted {
+			n += 2
+		}
+		if x.Entry != nil {
+			l = options.Size(x.Entry)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -6914,7 +10874,7 @@ func (x *fastReflection_QuerySupplyOfResponse) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySupplyOfResponse)
+		x := input.Message.Interface().(*QueryWhitelistedResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6933,8 +10893,8 @@ func (x *fastReflection_QuerySupplyOfResponse) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Amount != nil {
-			encoded, err := options.Marshal(x.Amount)
+		if x.Entry != nil {
+			encoded, err := options.Marshal(x.Entry)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6945,6 +10905,23 @@ func (x *fastReflection_QuerySupplyOfResponse) ProtoMethods() *protoiface.Method
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Whitelisted {
+			i--
+			if x.Whitelisted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -6958,7 +10935,7 @@ func (x *fastReflection_QuerySupplyOfResponse) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QuerySupplyOfResponse)
+		x := input.Message.Interface().(*QueryWhitelistedResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6977,28 +10954,80 @@ func (x *fastReflection_QuerySupplyOfResponse) ProtoMethods() *protoiface.Method
 				if shift >= 64 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWhitelistedResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWhitelistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Whitelisted", wireType)
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySupplyOfResponse: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuerySupplyOfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Whitelisted = bool(v != 0)
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -7025,10 +11054,10 @@ func (x *fastReflection_QuerySupplyOfResponse) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Amount == nil {
-					x.Amount = &v1beta11.Coin{}
+				if x.Entry == nil {
+					x.Entry = &WhitelistEntry{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Entry); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -7068,24 +11097,26 @@ func (x *fastReflection_QuerySupplyOfResponse) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_QueryWhitelistRequest protoreflect.MessageDescriptor
+	md_QueryLinkedAddressesRequest       protoreflect.MessageDescriptor
+	fd_QueryLinkedAddressesRequest_owner protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mainchain_enterprise_v1_query_proto_init()
-	md_QueryWhitelistRequest = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryWhitelistRequest")
+	md_QueryLinkedAddressesRequest = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryLinkedAddressesRequest")
+	fd_QueryLinkedAddressesRequest_owner = md_QueryLinkedAddressesRequest.Fields().ByName("owner")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryWhitelistRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryLinkedAddressesRequest)(nil)
 
-type fastReflection_QueryWhitelistRequest QueryWhitelistRequest
+type fastReflection_QueryLinkedAddressesRequest QueryLinkedAddressesRequest
 
-func (x *QueryWhitelistRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryWhitelistRequest)(x)
+func (x *QueryLinkedAddressesRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLinkedAddressesRequest)(x)
 }
 
-func (x *QueryWhitelistRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[16]
+func (x *QueryLinkedAddressesRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7096,43 +11127,43 @@ func (x *QueryWhitelistRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryWhitelistRequest_messageType fastReflection_QueryWhitelistRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryWhitelistRequest_messageType{}
+var _fastReflection_QueryLinkedAddressesRequest_messageType fastReflection_QueryLinkedAddressesRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLinkedAddressesRequest_messageType{}
 
-type fastReflection_QueryWhitelistRequest_messageType struct{}
+type fastReflection_QueryLinkedAddressesRequest_messageType struct{}
 
-func (x fastReflection_QueryWhitelistRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryWhitelistRequest)(nil)
+func (x fastReflection_QueryLinkedAddressesRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLinkedAddressesRequest)(nil)
 }
-func (x fastReflection_QueryWhitelistRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryWhitelistRequest)
+func (x fastReflection_QueryLinkedAddressesRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLinkedAddressesRequest)
 }
-func (x fastReflection_QueryWhitelistRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWhitelistRequest
+func (x fastReflection_QueryLinkedAddressesRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLinkedAddressesRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryWhitelistRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWhitelistRequest
+func (x *fastReflection_QueryLinkedAddressesRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLinkedAddressesRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryWhitelistRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryWhitelistRequest_messageType
+func (x *fastReflection_QueryLinkedAddressesRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLinkedAddressesRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryWhitelistRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryWhitelistRequest)
+func (x *fastReflection_QueryLinkedAddressesRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryLinkedAddressesRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryWhitelistRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryWhitelistRequest)(x)
+func (x *fastReflection_QueryLinkedAddressesRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryLinkedAddressesRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7140,7 +11171,13 @@ func (x *fastReflection_QueryWhitelistRequest) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryWhitelistRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_QueryLinkedAddressesRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_QueryLinkedAddressesRequest_owner, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -7154,13 +11191,15 @@ func (x *fastReflection_QueryWhitelistRequest) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryWhitelistRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryLinkedAddressesRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryLinkedAddressesRequest.owner":
+		return x.Owner != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLinkedAddressesRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLinkedAddressesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7170,13 +11209,15 @@ func (x *fastReflection_QueryWhitelistRequest) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWhitelistRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryLinkedAddressesRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryLinkedAddressesRequest.owner":
+		x.Owner = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLinkedAddressesRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLinkedAddressesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7186,13 +11227,16 @@ func (x *fastReflection_QueryWhitelistRequest) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryWhitelistRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLinkedAddressesRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "mainchain.enterprise.v1.QueryLinkedAddressesRequest.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLinkedAddressesRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLinkedAddressesRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7206,13 +11250,15 @@ func (x *fastReflection_QueryWhitelistRequest) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWhitelistRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryLinkedAddressesRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryLinkedAddressesRequest.owner":
+		x.Owner = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLinkedAddressesRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLinkedAddressesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7226,36 +11272,40 @@ func (x *fastReflection_QueryWhitelistRequest) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWhitelistRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLinkedAddressesRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryLinkedAddressesRequest.owner":
+		panic(fmt.Errorf("field owner of message mainchain.enterprise.v1.QueryLinkedAddressesRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLinkedAddressesRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLinkedAddressesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryWhitelistRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLinkedAddressesRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "mainchain.enterprise.v1.QueryLinkedAddressesRequest.owner":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLinkedAddressesRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLinkedAddressesRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryWhitelistRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryLinkedAddressesRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryWhitelistRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryLinkedAddressesRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7263,7 +11313,7 @@ func (x *fastReflection_QueryWhitelistRequest) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryWhitelistRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryLinkedAddressesRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7274,7 +11324,7 @@ func (x *fastReflection_QueryWhitelistRequest) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWhitelistRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryLinkedAddressesRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7286,7 +11336,7 @@ func (x *fastReflection_QueryWhitelistRequest) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryWhitelistRequest) IsValid() bool {
+func (x *fastReflection_QueryLinkedAddressesRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7296,9 +11346,9 @@ func (x *fastReflection_QueryWhitelistRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryWhitelistRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryLinkedAddressesRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryWhitelistRequest)
+		x := input.Message.Interface().(*QueryLinkedAddressesRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7310,6 +11360,10 @@ func (x *fastReflection_QueryWhitelistRequest) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7320,7 +11374,7 @@ func (x *fastReflection_QueryWhitelistRequest) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWhitelistRequest)
+		x := input.Message.Interface().(*QueryLinkedAddressesRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7339,6 +11393,13 @@ func (x *fastReflection_QueryWhitelistRequest) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -7350,7 +11411,7 @@ func (x *fastReflection_QueryWhitelistRequest) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWhitelistRequest)
+		x := input.Message.Interface().(*QueryLinkedAddressesRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7382,12 +11443,44 @@ func (x *fastReflection_QueryWhitelistRequest) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWhitelistRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLinkedAddressesRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLinkedAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7423,73 +11516,78 @@ func (x *fastReflection_QueryWhitelistRequest) ProtoMethods() *protoiface.Method
 	}
 }
 
-var _ protoreflect.List = (*_QueryWhitelistResponse_1_list)(nil)
+var _ protoreflect.List = (*_QueryLinkedAddressesResponse_1_list)(nil)
 
-type _QueryWhitelistResponse_1_list struct {
-	list *[]string
+type _QueryLinkedAddressesResponse_1_list struct {
+	list *[]*LinkedAddress
 }
 
-func (x *_QueryWhitelistResponse_1_list) Len() int {
+func (x *_QueryLinkedAddressesResponse_1_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_QueryWhitelistResponse_1_list) Get(i int) protoreflect.Value {
-	return protoreflect.ValueOfString((*x.list)[i])
+func (x *_QueryLinkedAddressesResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-func (x *_QueryWhitelistResponse_1_list) Set(i int, value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_QueryLinkedAddressesResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LinkedAddress)
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_QueryWhitelistResponse_1_list) Append(value protoreflect.Value) {
-	valueUnwrapped := value.String()
-	concreteValue := valueUnwrapped
+func (x *_QueryLinkedAddressesResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LinkedAddress)
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_QueryWhitelistResponse_1_list) AppendMutable() protoreflect.Value {
-	panic(fmt.Errorf("AppendMutable can not be called on message QueryWhitelistResponse at list field Addresses as it is not of Message kind"))
+func (x *_QueryLinkedAddressesResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(LinkedAddress)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryWhitelistResponse_1_list) Truncate(n int) {
+func (x *_QueryLinkedAddressesResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_QueryWhitelistResponse_1_list) NewElement() protoreflect.Value {
-	v := ""
-	return protoreflect.ValueOfString(v)
+func (x *_QueryLinkedAddressesResponse_1_list) NewElement() protoreflect.Value {
+	v := new(LinkedAddress)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_QueryWhitelistResponse_1_list) IsValid() bool {
+func (x *_QueryLinkedAddressesResponse_1_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_QueryWhitelistResponse           protoreflect.MessageDescriptor
-	fd_QueryWhitelistResponse_addresses protoreflect.FieldDescriptor
+	md_QueryLinkedAddressesResponse                  protoreflect.MessageDescriptor
+	fd_QueryLinkedAddressesResponse_linked_addresses protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mainchain_enterprise_v1_query_proto_init()
-	md_QueryWhitelistResponse = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryWhitelistResponse")
-	fd_QueryWhitelistResponse_addresses = md_QueryWhitelistResponse.Fields().ByName("addresses")
+	md_QueryLinkedAddressesResponse = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryLinkedAddressesResponse")
+	fd_QueryLinkedAddressesResponse_linked_addresses = md_QueryLinkedAddressesResponse.Fields().ByName("linked_addresses")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryWhitelistResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryLinkedAddressesResponse)(nil)
 
-type fastReflection_QueryWhitelistResponse QueryWhitelistResponse
+type fastReflection_QueryLinkedAddressesResponse QueryLinkedAddressesResponse
 
-func (x *QueryWhitelistResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryWhitelistResponse)(x)
+func (x *QueryLinkedAddressesResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryLinkedAddressesResponse)(x)
 }
 
-func (x *QueryWhitelistResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[17]
+func (x *QueryLinkedAddressesResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7500,43 +11598,43 @@ func (x *QueryWhitelistResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryWhitelistResponse_messageType fastReflection_QueryWhitelistResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryWhitelistResponse_messageType{}
+var _fastReflection_QueryLinkedAddressesResponse_messageType fastReflection_QueryLinkedAddressesResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryLinkedAddressesResponse_messageType{}
 
-type fastReflection_QueryWhitelistResponse_messageType struct{}
+type fastReflection_QueryLinkedAddressesResponse_messageType struct{}
 
-func (x fastReflection_QueryWhitelistResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryWhitelistResponse)(nil)
+func (x fastReflection_QueryLinkedAddressesResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryLinkedAddressesResponse)(nil)
 }
-func (x fastReflection_QueryWhitelistResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryWhitelistResponse)
+func (x fastReflection_QueryLinkedAddressesResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryLinkedAddressesResponse)
 }
-func (x fastReflection_QueryWhitelistResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWhitelistResponse
+func (x fastReflection_QueryLinkedAddressesResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLinkedAddressesResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryWhitelistResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWhitelistResponse
+func (x *fastReflection_QueryLinkedAddressesResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryLinkedAddressesResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryWhitelistResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryWhitelistResponse_messageType
+func (x *fastReflection_QueryLinkedAddressesResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryLinkedAddressesResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryWhitelistResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryWhitelistResponse)
+func (x *fastReflection_QueryLinkedAddressesResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryLinkedAddressesResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryWhitelistResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryWhitelistResponse)(x)
+func (x *fastReflection_QueryLinkedAddressesResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryLinkedAddressesResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7544,10 +11642,10 @@ func (x *fastReflection_QueryWhitelistResponse) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryWhitelistResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Addresses) != 0 {
-		value := protoreflect.ValueOfList(&_QueryWhitelistResponse_1_list{list: &x.Addresses})
-		if !f(fd_QueryWhitelistResponse_addresses, value) {
+func (x *fastReflection_QueryLinkedAddressesResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.LinkedAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_QueryLinkedAddressesResponse_1_list{list: &x.LinkedAddresses})
+		if !f(fd_QueryLinkedAddressesResponse_linked_addresses, value) {
 			return
 		}
 	}
@@ -7564,15 +11662,15 @@ func (x *fastReflection_QueryWhitelistResponse) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryWhitelistResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryLinkedAddressesResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistResponse.addresses":
-		return len(x.Addresses) != 0
+	case "mainchain.enterprise.v1.QueryLinkedAddressesResponse.linked_addresses":
+		return len(x.LinkedAddresses) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLinkedAddressesResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLinkedAddressesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7582,15 +11680,15 @@ func (x *fastReflection_QueryWhitelistResponse) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWhitelistResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryLinkedAddressesResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistResponse.addresses":
-		x.Addresses = nil
+	case "mainchain.enterprise.v1.QueryLinkedAddressesResponse.linked_addresses":
+		x.LinkedAddresses = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLinkedAddressesResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLinkedAddressesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7600,19 +11698,19 @@ func (x *fastReflection_QueryWhitelistResponse) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryWhitelistResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLinkedAddressesResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistResponse.addresses":
-		if len(x.Addresses) == 0 {
-			return protoreflect.ValueOfList(&_QueryWhitelistResponse_1_list{})
+	case "mainchain.enterprise.v1.QueryLinkedAddressesResponse.linked_addresses":
+		if len(x.LinkedAddresses) == 0 {
+			return protoreflect.ValueOfList(&_QueryLinkedAddressesResponse_1_list{})
 		}
-		listValue := &_QueryWhitelistResponse_1_list{list: &x.Addresses}
+		listValue := &_QueryLinkedAddressesResponse_1_list{list: &x.LinkedAddresses}
 		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLinkedAddressesResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLinkedAddressesResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7626,17 +11724,17 @@ func (x *fastReflection_QueryWhitelistResponse) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWhitelistResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryLinkedAddressesResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistResponse.addresses":
+	case "mainchain.enterprise.v1.QueryLinkedAddressesResponse.linked_addresses":
 		lv := value.List()
-		clv := lv.(*_QueryWhitelistResponse_1_list)
-		x.Addresses = *clv.list
+		clv := lv.(*_QueryLinkedAddressesResponse_1_list)
+		x.LinkedAddresses = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLinkedAddressesResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLinkedAddressesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7650,45 +11748,45 @@ func (x *fastReflection_QueryWhitelistResponse) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWhitelistResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLinkedAddressesResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistResponse.addresses":
-		if x.Addresses == nil {
-			x.Addresses = []string{}
+	case "mainchain.enterprise.v1.QueryLinkedAddressesResponse.linked_addresses":
+		if x.LinkedAddresses == nil {
+			x.LinkedAddresses = []*LinkedAddress{}
 		}
-		value := &_QueryWhitelistResponse_1_list{list: &x.Addresses}
+		value := &_QueryLinkedAddressesResponse_1_list{list: &x.LinkedAddresses}
 		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLinkedAddressesResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLinkedAddressesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryWhitelistResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryLinkedAddressesResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistResponse.addresses":
-		list := []string{}
-		return protoreflect.ValueOfList(&_QueryWhitelistResponse_1_list{list: &list})
+	case "mainchain.enterprise.v1.QueryLinkedAddressesResponse.linked_addresses":
+		list := []*LinkedAddress{}
+		return protoreflect.ValueOfList(&_QueryLinkedAddressesResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryLinkedAddressesResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryLinkedAddressesResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryWhitelistResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryLinkedAddressesResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryWhitelistResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryLinkedAddressesResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7696,7 +11794,7 @@ func (x *fastReflection_QueryWhitelistResponse) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryWhitelistResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryLinkedAddressesResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7707,7 +11805,7 @@ func (x *fastReflection_QueryWhitelistResponse) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWhitelistResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryLinkedAddressesResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7719,7 +11817,7 @@ func (x *fastReflection_QueryWhitelistResponse) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryWhitelistResponse) IsValid() bool {
+func (x *fastReflection_QueryLinkedAddressesResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -7729,9 +11827,9 @@ func (x *fastReflection_QueryWhitelistResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryWhitelistResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryLinkedAddressesResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryWhitelistResponse)
+		x := input.Message.Interface().(*QueryLinkedAddressesResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7743,9 +11841,9 @@ func (x *fastReflection_QueryWhitelistResponse) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		if len(x.Addresses) > 0 {
-			for _, s := range x.Addresses {
-				l = len(s)
+		if len(x.LinkedAddresses) > 0 {
+			for _, e := range x.LinkedAddresses {
+				l = options.Size(e)
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
@@ -7759,7 +11857,7 @@ func (x *fastReflection_QueryWhitelistResponse) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWhitelistResponse)
+		x := input.Message.Interface().(*QueryLinkedAddressesResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7778,11 +11876,18 @@ func (x *fastReflection_QueryWhitelistResponse) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Addresses) > 0 {
-			for iNdEx := len(x.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Addresses[iNdEx])
-				copy(dAtA[i:], x.Addresses[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Addresses[iNdEx])))
+		if len(x.LinkedAddresses) > 0 {
+			for iNdEx := len(x.LinkedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LinkedAddresses[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 				i--
 				dAtA[i] = 0xa
 			}
@@ -7798,7 +11903,7 @@ func (x *fastReflection_QueryWhitelistResponse) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWhitelistResponse)
+		x := input.Message.Interface().(*QueryLinkedAddressesResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7830,17 +11935,17 @@ func (x *fastReflection_QueryWhitelistResponse) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWhitelistResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLinkedAddressesResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryLinkedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LinkedAddresses", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7850,23 +11955,25 @@ func (x *fastReflection_QueryWhitelistResponse) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Addresses = append(x.Addresses, string(dAtA[iNdEx:postIndex]))
+				x.LinkedAddresses = append(x.LinkedAddresses, &LinkedAddress{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LinkedAddresses[len(x.LinkedAddresses)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7904,26 +12011,24 @@ func (x *fastReflection_QueryWhitelistResponse) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_QueryWhitelistedRequest         protoreflect.MessageDescriptor
-	fd_QueryWhitelistedRequest_address protoreflect.FieldDescriptor
+	md_QueryEntSignersRequest protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_mainchain_enterprise_v1_query_proto_init()
-	md_QueryWhitelistedRequest = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryWhitelistedRequest")
-	fd_QueryWhitelistedRequest_address = md_QueryWhitelistedRequest.Fields().ByName("address")
+	md_QueryEntSignersRequest = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryEntSignersRequest")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryWhitelistedRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryEntSignersRequest)(nil)
 
-type fastReflection_QueryWhitelistedRequest QueryWhitelistedRequest
+type fastReflection_QueryEntSignersRequest QueryEntSignersRequest
 
-func (x *QueryWhitelistedRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryWhitelistedRequest)(x)
+func (x *QueryEntSignersRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEntSignersRequest)(x)
 }
 
-func (x *QueryWhitelistedRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[18]
+func (x *QueryEntSignersRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7934,43 +12039,43 @@ func (x *QueryWhitelistedRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryWhitelistedRequest_messageType fastReflection_QueryWhitelistedRequest_messageType
-var _ protoreflect.MessageType = fastReflection_QueryWhitelistedRequest_messageType{}
+var _fastReflection_QueryEntSignersRequest_messageType fastReflection_QueryEntSignersRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEntSignersRequest_messageType{}
 
-type fastReflection_QueryWhitelistedRequest_messageType struct{}
+type fastReflection_QueryEntSignersRequest_messageType struct{}
 
-func (x fastReflection_QueryWhitelistedRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryWhitelistedRequest)(nil)
+func (x fastReflection_QueryEntSignersRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEntSignersRequest)(nil)
 }
-func (x fastReflection_QueryWhitelistedRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryWhitelistedRequest)
+func (x fastReflection_QueryEntSignersRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEntSignersRequest)
 }
-func (x fastReflection_QueryWhitelistedRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWhitelistedRequest
+func (x fastReflection_QueryEntSignersRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEntSignersRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryWhitelistedRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWhitelistedRequest
+func (x *fastReflection_QueryEntSignersRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEntSignersRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryWhitelistedRequest) Type() protoreflect.MessageType {
-	return _fastReflection_QueryWhitelistedRequest_messageType
+func (x *fastReflection_QueryEntSignersRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEntSignersRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryWhitelistedRequest) New() protoreflect.Message {
-	return new(fastReflection_QueryWhitelistedRequest)
+func (x *fastReflection_QueryEntSignersRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryEntSignersRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryWhitelistedRequest) Interface() protoreflect.ProtoMessage {
-	return (*QueryWhitelistedRequest)(x)
+func (x *fastReflection_QueryEntSignersRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryEntSignersRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7978,13 +12083,7 @@ func (x *fastReflection_QueryWhitelistedRequest) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryWhitelistedRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_QueryWhitelistedRequest_address, value) {
-			return
-		}
-	}
+func (x *fastReflection_QueryEntSignersRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -7998,15 +12097,13 @@ func (x *fastReflection_QueryWhitelistedRequest) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryWhitelistedRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryEntSignersRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistedRequest.address":
-		return x.Address != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEntSignersRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEntSignersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8016,15 +12113,13 @@ func (x *fastReflection_QueryWhitelistedRequest) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWhitelistedRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryEntSignersRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistedRequest.address":
-		x.Address = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEntSignersRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEntSignersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8034,16 +12129,13 @@ func (x *fastReflection_QueryWhitelistedRequest) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryWhitelistedRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEntSignersRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistedRequest.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEntSignersRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEntSignersRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8057,15 +12149,13 @@ func (x *fastReflection_QueryWhitelistedRequest) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWhitelistedRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryEntSignersRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistedRequest.address":
-		x.Address = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEntSignersRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEntSignersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8079,40 +12169,36 @@ func (x *fastReflection_QueryWhitelistedRequest) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWhitelistedRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEntSignersRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistedRequest.address":
-		panic(fmt.Errorf("field address of message mainchain.enterprise.v1.QueryWhitelistedRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEntSignersRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEntSignersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryWhitelistedRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEntSignersRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistedRequest.address":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEntSignersRequest"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEntSignersRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryWhitelistedRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryEntSignersRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryWhitelistedRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryEntSignersRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8120,7 +12206,7 @@ func (x *fastReflection_QueryWhitelistedRequest) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryWhitelistedRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryEntSignersRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8131,7 +12217,7 @@ func (x *fastReflection_QueryWhitelistedRequest) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWhitelistedRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryEntSignersRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8143,7 +12229,7 @@ func (x *fastReflection_QueryWhitelistedRequest) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryWhitelistedRequest) IsValid() bool {
+func (x *fastReflection_QueryEntSignersRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -8153,9 +12239,9 @@ func (x *fastReflection_QueryWhitelistedRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryWhitelistedRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryEntSignersRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryWhitelistedRequest)
+		x := input.Message.Interface().(*QueryEntSignersRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8167,10 +12253,6 @@ func (x *fastReflection_QueryWhitelistedRequest) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -8181,7 +12263,7 @@ func (x *fastReflection_QueryWhitelistedRequest) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWhitelistedRequest)
+		x := input.Message.Interface().(*QueryEntSignersRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8200,13 +12282,6 @@ func (x *fastReflection_QueryWhitelistedRequest) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -8218,7 +12293,7 @@ func (x *fastReflection_QueryWhitelistedRequest) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWhitelistedRequest)
+		x := input.Message.Interface().(*QueryEntSignersRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8250,44 +12325,12 @@ func (x *fastReflection_QueryWhitelistedRequest) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWhitelistedRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEntSignersRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWhitelistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEntSignersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Address = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8323,29 +12366,78 @@ func (x *fastReflection_QueryWhitelistedRequest) ProtoMethods() *protoiface.Meth
 	}
 }
 
+var _ protoreflect.List = (*_QueryEntSignersResponse_1_list)(nil)
+
+type _QueryEntSignersResponse_1_list struct {
+	list *[]*EntSigner
+}
+
+func (x *_QueryEntSignersResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryEntSignersResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryEntSignersResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EntSigner)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryEntSignersResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EntSigner)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryEntSignersResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(EntSigner)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEntSignersResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryEntSignersResponse_1_list) NewElement() protoreflect.Value {
+	v := new(EntSigner)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryEntSignersResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_QueryWhitelistedResponse             protoreflect.MessageDescriptor
-	fd_QueryWhitelistedResponse_address     protoreflect.FieldDescriptor
-	fd_QueryWhitelistedResponse_whitelisted protoreflect.FieldDescriptor
+	md_QueryEntSignersResponse             protoreflect.MessageDescriptor
+	fd_QueryEntSignersResponse_ent_signers protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mainchain_enterprise_v1_query_proto_init()
-	md_QueryWhitelistedResponse = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryWhitelistedResponse")
-	fd_QueryWhitelistedResponse_address = md_QueryWhitelistedResponse.Fields().ByName("address")
-	fd_QueryWhitelistedResponse_whitelisted = md_QueryWhitelistedResponse.Fields().ByName("whitelisted")
+	md_QueryEntSignersResponse = File_mainchain_enterprise_v1_query_proto.Messages().ByName("QueryEntSignersResponse")
+	fd_QueryEntSignersResponse_ent_signers = md_QueryEntSignersResponse.Fields().ByName("ent_signers")
 }
 
-var _ protoreflect.Message = (*fastReflection_QueryWhitelistedResponse)(nil)
+var _ protoreflect.Message = (*fastReflection_QueryEntSignersResponse)(nil)
 
-type fastReflection_QueryWhitelistedResponse QueryWhitelistedResponse
+type fastReflection_QueryEntSignersResponse QueryEntSignersResponse
 
-func (x *QueryWhitelistedResponse) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_QueryWhitelistedResponse)(x)
+func (x *QueryEntSignersResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryEntSignersResponse)(x)
 }
 
-func (x *QueryWhitelistedResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[19]
+func (x *QueryEntSignersResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8356,43 +12448,43 @@ func (x *QueryWhitelistedResponse) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_QueryWhitelistedResponse_messageType fastReflection_QueryWhitelistedResponse_messageType
-var _ protoreflect.MessageType = fastReflection_QueryWhitelistedResponse_messageType{}
+var _fastReflection_QueryEntSignersResponse_messageType fastReflection_QueryEntSignersResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryEntSignersResponse_messageType{}
 
-type fastReflection_QueryWhitelistedResponse_messageType struct{}
+type fastReflection_QueryEntSignersResponse_messageType struct{}
 
-func (x fastReflection_QueryWhitelistedResponse_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_QueryWhitelistedResponse)(nil)
+func (x fastReflection_QueryEntSignersResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryEntSignersResponse)(nil)
 }
-func (x fastReflection_QueryWhitelistedResponse_messageType) New() protoreflect.Message {
-	return new(fastReflection_QueryWhitelistedResponse)
+func (x fastReflection_QueryEntSignersResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryEntSignersResponse)
 }
-func (x fastReflection_QueryWhitelistedResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWhitelistedResponse
+func (x fastReflection_QueryEntSignersResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEntSignersResponse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_QueryWhitelistedResponse) Descriptor() protoreflect.MessageDescriptor {
-	return md_QueryWhitelistedResponse
+func (x *fastReflection_QueryEntSignersResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryEntSignersResponse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_QueryWhitelistedResponse) Type() protoreflect.MessageType {
-	return _fastReflection_QueryWhitelistedResponse_messageType
+func (x *fastReflection_QueryEntSignersResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryEntSignersResponse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_QueryWhitelistedResponse) New() protoreflect.Message {
-	return new(fastReflection_QueryWhitelistedResponse)
+func (x *fastReflection_QueryEntSignersResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryEntSignersResponse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_QueryWhitelistedResponse) Interface() protoreflect.ProtoMessage {
-	return (*QueryWhitelistedResponse)(x)
+func (x *fastReflection_QueryEntSignersResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryEntSignersResponse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8400,16 +12492,10 @@ func (x *fastReflection_QueryWhitelistedResponse) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_QueryWhitelistedResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Address != "" {
-		value := protoreflect.ValueOfString(x.Address)
-		if !f(fd_QueryWhitelistedResponse_address, value) {
-			return
-		}
-	}
-	if x.Whitelisted != false {
-		value := protoreflect.ValueOfBool(x.Whitelisted)
-		if !f(fd_QueryWhitelistedResponse_whitelisted, value) {
+func (x *fastReflection_QueryEntSignersResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.EntSigners) != 0 {
+		value := protoreflect.ValueOfList(&_QueryEntSignersResponse_1_list{list: &x.EntSigners})
+		if !f(fd_QueryEntSignersResponse_ent_signers, value) {
 			return
 		}
 	}
@@ -8426,17 +12512,15 @@ func (x *fastReflection_QueryWhitelistedResponse) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_QueryWhitelistedResponse) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_QueryEntSignersResponse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistedResponse.address":
-		return x.Address != ""
-	case "mainchain.enterprise.v1.QueryWhitelistedResponse.whitelisted":
-		return x.Whitelisted != false
+	case "mainchain.enterprise.v1.QueryEntSignersResponse.ent_signers":
+		return len(x.EntSigners) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEntSignersResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEntSignersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8446,17 +12530,15 @@ func (x *fastReflection_QueryWhitelistedResponse) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWhitelistedResponse) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_QueryEntSignersResponse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistedResponse.address":
-		x.Address = ""
-	case "mainchain.enterprise.v1.QueryWhitelistedResponse.whitelisted":
-		x.Whitelisted = false
+	case "mainchain.enterprise.v1.QueryEntSignersResponse.ent_signers":
+		x.EntSigners = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEntSignersResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEntSignersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8465,20 +12547,20 @@ func (x *fastReflection_QueryWhitelistedResponse) Clear(fd protoreflect.FieldDes
 // For unpopulated scalars, it returns the default value, where
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_QueryWhitelistedResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistedResponse.address":
-		value := x.Address
-		return protoreflect.ValueOfString(value)
-	case "mainchain.enterprise.v1.QueryWhitelistedResponse.whitelisted":
-		value := x.Whitelisted
-		return protoreflect.ValueOfBool(value)
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryEntSignersResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.enterprise.v1.QueryEntSignersResponse.ent_signers":
+		if len(x.EntSigners) == 0 {
+			return protoreflect.ValueOfList(&_QueryEntSignersResponse_1_list{})
+		}
+		listValue := &_QueryEntSignersResponse_1_list{list: &x.EntSigners}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEntSignersResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedResponse does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEntSignersResponse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8492,17 +12574,17 @@ func (x *fastReflection_QueryWhitelistedResponse) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWhitelistedResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_QueryEntSignersResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistedResponse.address":
-		x.Address = value.Interface().(string)
-	case "mainchain.enterprise.v1.QueryWhitelistedResponse.whitelisted":
-		x.Whitelisted = value.Bool()
+	case "mainchain.enterprise.v1.QueryEntSignersResponse.ent_signers":
+		lv := value.List()
+		clv := lv.(*_QueryEntSignersResponse_1_list)
+		x.EntSigners = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEntSignersResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEntSignersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8516,44 +12598,45 @@ func (x *fastReflection_QueryWhitelistedResponse) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWhitelistedResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEntSignersResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistedResponse.address":
-		panic(fmt.Errorf("field address of message mainchain.enterprise.v1.QueryWhitelistedResponse is not mutable"))
-	case "mainchain.enterprise.v1.QueryWhitelistedResponse.whitelisted":
-		panic(fmt.Errorf("field whitelisted of message mainchain.enterprise.v1.QueryWhitelistedResponse is not mutable"))
+	case "mainchain.enterprise.v1.QueryEntSignersResponse.ent_signers":
+		if x.EntSigners == nil {
+			x.EntSigners = []*EntSigner{}
+		}
+		value := &_QueryEntSignersResponse_1_list{list: &x.EntSigners}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEntSignersResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEntSignersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_QueryWhitelistedResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_QueryEntSignersResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.enterprise.v1.QueryWhitelistedResponse.address":
-		return protoreflect.ValueOfString("")
-	case "mainchain.enterprise.v1.QueryWhitelistedResponse.whitelisted":
-		return protoreflect.ValueOfBool(false)
+	case "mainchain.enterprise.v1.QueryEntSignersResponse.ent_signers":
+		list := []*EntSigner{}
+		return protoreflect.ValueOfList(&_QueryEntSignersResponse_1_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryWhitelistedResponse"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.enterprise.v1.QueryEntSignersResponse"))
 		}
-		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryWhitelistedResponse does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.enterprise.v1.QueryEntSignersResponse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_QueryWhitelistedResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_QueryEntSignersResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryWhitelistedResponse", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.enterprise.v1.QueryEntSignersResponse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8561,7 +12644,7 @@ func (x *fastReflection_QueryWhitelistedResponse) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_QueryWhitelistedResponse) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_QueryEntSignersResponse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8572,7 +12655,7 @@ func (x *fastReflection_QueryWhitelistedResponse) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_QueryWhitelistedResponse) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_QueryEntSignersResponse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8584,7 +12667,7 @@ func (x *fastReflection_QueryWhitelistedResponse) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_QueryWhitelistedResponse) IsValid() bool {
+func (x *fastReflection_QueryEntSignersResponse) IsValid() bool {
 	return x != nil
 }
 
@@ -8594,9 +12677,9 @@ func (x *fastReflection_QueryWhitelistedResponse) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_QueryWhitelistedResponse) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_QueryEntSignersResponse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*QueryWhitelistedResponse)
+		x := input.Message.Interface().(*QueryEntSignersResponse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8608,12 +12691,11 @@ func (x *fastReflection_QueryWhitelistedResponse) ProtoMethods() *protoiface.Met
 		var n int
 		var l int
 		_ = l
-		l = len(x.Address)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.Whitelisted {
-			n += 2
+		if len(x.EntSigners) > 0 {
+			for _, e := range x.EntSigners {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8625,7 +12707,7 @@ func (x *fastReflection_QueryWhitelistedResponse) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWhitelistedResponse)
+		x := input.Message.Interface().(*QueryEntSignersResponse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8644,22 +12726,21 @@ func (x *fastReflection_QueryWhitelistedResponse) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Whitelisted {
-			i--
-			if x.Whitelisted {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
+		if len(x.EntSigners) > 0 {
+			for iNdEx := len(x.EntSigners) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EntSigners[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
 			}
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.Address) > 0 {
-			i -= len(x.Address)
-			copy(dAtA[i:], x.Address)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
-			i--
-			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8672,7 +12753,7 @@ func (x *fastReflection_QueryWhitelistedResponse) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*QueryWhitelistedResponse)
+		x := input.Message.Interface().(*QueryEntSignersResponse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8704,17 +12785,17 @@ func (x *fastReflection_QueryWhitelistedResponse) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWhitelistedResponse: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEntSignersResponse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryWhitelistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryEntSignersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EntSigners", wireType)
 				}
-				var stringLen uint64
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8724,44 +12805,26 @@ func (x *fastReflection_QueryWhitelistedResponse) ProtoMethods() *protoiface.Met
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + intStringLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Address = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Whitelisted", wireType)
-				}
-				var v int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				x.EntSigners = append(x.EntSigners, &EntSigner{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EntSigners[len(x.EntSigners)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				x.Whitelisted = bool(v != 0)
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -8817,7 +12880,7 @@ func (x *QueryEnterpriseAccountRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryEnterpriseAccountRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[20]
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9237,7 +13300,7 @@ func (x *QueryEnterpriseAccountResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryEnterpriseAccountResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[21]
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9670,7 +13733,7 @@ func (x *QueryTotalSpentEFUNDRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryTotalSpentEFUNDRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[22]
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10028,7 +14091,7 @@ func (x *QueryTotalSpentEFUNDResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QueryTotalSpentEFUNDResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[23]
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10463,7 +14526,7 @@ func (x *QuerySpentEFUNDByAddressRequest) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QuerySpentEFUNDByAddressRequest) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[24]
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10883,7 +14946,7 @@ func (x *QuerySpentEFUNDByAddressResponse) ProtoReflect() protoreflect.Message {
 }
 
 func (x *QuerySpentEFUNDByAddressResponse) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[25]
+	mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11513,109 +15576,291 @@ type QueryEnterpriseUndPurchaseOrdersResponse struct {
 	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryEnterpriseUndPurchaseOrdersResponse) Reset() {
-	*x = QueryEnterpriseUndPurchaseOrdersResponse{}
+func (x *QueryEnterpriseUndPurchaseOrdersResponse) Reset() {
+	*x = QueryEnterpriseUndPurchaseOrdersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryEnterpriseUndPurchaseOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEnterpriseUndPurchaseOrdersResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryEnterpriseUndPurchaseOrdersResponse.ProtoReflect.Descriptor instead.
+func (*QueryEnterpriseUndPurchaseOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *QueryEnterpriseUndPurchaseOrdersResponse) GetPurchaseOrders() []*EnterpriseUndPurchaseOrder {
+	if x != nil {
+		return x.PurchaseOrders
+	}
+	return nil
+}
+
+func (x *QueryEnterpriseUndPurchaseOrdersResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryLockedUndByAddressRequest is the request type for the Query/LockedUndByAddress RPC method
+type QueryLockedUndByAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner is the address to query
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *QueryLockedUndByAddressRequest) Reset() {
+	*x = QueryLockedUndByAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLockedUndByAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLockedUndByAddressRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryLockedUndByAddressRequest.ProtoReflect.Descriptor instead.
+func (*QueryLockedUndByAddressRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QueryLockedUndByAddressRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+// QueryLockedUndByAddressResponse is the response type for the Query/LockedUndByAddress RPC method
+type QueryLockedUndByAddressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount *v1beta11.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *QueryLockedUndByAddressResponse) Reset() {
+	*x = QueryLockedUndByAddressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLockedUndByAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLockedUndByAddressResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryLockedUndByAddressResponse.ProtoReflect.Descriptor instead.
+func (*QueryLockedUndByAddressResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryLockedUndByAddressResponse) GetAmount() *v1beta11.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// QueryLockedEFUNDLotsByAddressRequest is the request type for the Query/LockedEFUNDLotsByAddress RPC method
+type QueryLockedEFUNDLotsByAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner is the address to query
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (x *QueryLockedEFUNDLotsByAddressRequest) Reset() {
+	*x = QueryLockedEFUNDLotsByAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryLockedEFUNDLotsByAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryLockedEFUNDLotsByAddressRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryLockedEFUNDLotsByAddressRequest.ProtoReflect.Descriptor instead.
+func (*QueryLockedEFUNDLotsByAddressRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryLockedEFUNDLotsByAddressRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+// QueryLockedEFUNDLotsByAddressResponse is the response type for the Query/LockedEFUNDLotsByAddress RPC method
+type QueryLockedEFUNDLotsByAddressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// lots are the account's locked eFUND lots, oldest first
+	Lots []*LockedEFUNDLot `protobuf:"bytes,1,rep,name=lots,proto3" json:"lots,omitempty"`
+}
+
+func (x *QueryLockedEFUNDLotsByAddressResponse) Reset() {
+	*x = QueryLockedEFUNDLotsByAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[5]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryEnterpriseUndPurchaseOrdersResponse) String() string {
+func (x *QueryLockedEFUNDLotsByAddressResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryEnterpriseUndPurchaseOrdersResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryEnterpriseUndPurchaseOrdersResponse.ProtoReflect.Descriptor instead.
-func (*QueryEnterpriseUndPurchaseOrdersResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{5}
-}
+func (*QueryLockedEFUNDLotsByAddressResponse) ProtoMessage() {}
 
-func (x *QueryEnterpriseUndPurchaseOrdersResponse) GetPurchaseOrders() []*EnterpriseUndPurchaseOrder {
-	if x != nil {
-		return x.PurchaseOrders
-	}
-	return nil
+// Deprecated: Use QueryLockedEFUNDLotsByAddressResponse.ProtoReflect.Descriptor instead.
+func (*QueryLockedEFUNDLotsByAddressResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *QueryEnterpriseUndPurchaseOrdersResponse) GetPagination() *v1beta1.PageResponse {
+func (x *QueryLockedEFUNDLotsByAddressResponse) GetLots() []*LockedEFUNDLot {
 	if x != nil {
-		return x.Pagination
+		return x.Lots
 	}
 	return nil
 }
 
-// QueryLockedUndByAddressRequest is the request type for the Query/LockedUndByAddress RPC method
-type QueryLockedUndByAddressRequest struct {
+// QueryEnterpriseLedgerRequest is the request type for the Query/EnterpriseLedger RPC method
+type QueryEnterpriseLedgerRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// owner is the address to query
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// from_time is the optional unix time from which to return entries, inclusive
+	FromTime uint64 `protobuf:"varint,2,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	// to_time is the optional unix time up to which to return entries, exclusive. 0 means no upper limit
+	ToTime     uint64               `protobuf:"varint,3,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	Pagination *v1beta1.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryLockedUndByAddressRequest) Reset() {
-	*x = QueryLockedUndByAddressRequest{}
+func (x *QueryEnterpriseLedgerRequest) Reset() {
+	*x = QueryEnterpriseLedgerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[6]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryLockedUndByAddressRequest) String() string {
+func (x *QueryEnterpriseLedgerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLockedUndByAddressRequest) ProtoMessage() {}
+func (*QueryEnterpriseLedgerRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryLockedUndByAddressRequest.ProtoReflect.Descriptor instead.
-func (*QueryLockedUndByAddressRequest) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryEnterpriseLedgerRequest.ProtoReflect.Descriptor instead.
+func (*QueryEnterpriseLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *QueryLockedUndByAddressRequest) GetOwner() string {
+func (x *QueryEnterpriseLedgerRequest) GetOwner() string {
 	if x != nil {
 		return x.Owner
 	}
 	return ""
 }
 
-// QueryLockedUndByAddressResponse is the response type for the Query/LockedUndByAddress RPC method
-type QueryLockedUndByAddressResponse struct {
+func (x *QueryEnterpriseLedgerRequest) GetFromTime() uint64 {
+	if x != nil {
+		return x.FromTime
+	}
+	return 0
+}
+
+func (x *QueryEnterpriseLedgerRequest) GetToTime() uint64 {
+	if x != nil {
+		return x.ToTime
+	}
+	return 0
+}
+
+func (x *QueryEnterpriseLedgerRequest) GetPagination() *v1beta1.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryEnterpriseLedgerResponse is the response type for the Query/EnterpriseLedger RPC method
+type QueryEnterpriseLedgerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount *v1beta11.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// entries are the account's ledger entries, oldest first
+	Entries    []*EFUNDLedgerEntry   `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Pagination *v1beta1.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryLockedUndByAddressResponse) Reset() {
-	*x = QueryLockedUndByAddressResponse{}
+func (x *QueryEnterpriseLedgerResponse) Reset() {
+	*x = QueryEnterpriseLedgerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[7]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryLockedUndByAddressResponse) String() string {
+func (x *QueryEnterpriseLedgerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryLockedUndByAddressResponse) ProtoMessage() {}
+func (*QueryEnterpriseLedgerResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryLockedUndByAddressResponse.ProtoReflect.Descriptor instead.
-func (*QueryLockedUndByAddressResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use QueryEnterpriseLedgerResponse.ProtoReflect.Descriptor instead.
+func (*QueryEnterpriseLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *QueryLockedUndByAddressResponse) GetAmount() *v1beta11.Coin {
+func (x *QueryEnterpriseLedgerResponse) GetEntries() []*EFUNDLedgerEntry {
 	if x != nil {
-		return x.Amount
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *QueryEnterpriseLedgerResponse) GetPagination() *v1beta1.PageResponse {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
@@ -11630,7 +15875,7 @@ type QueryTotalLockedRequest struct {
 func (x *QueryTotalLockedRequest) Reset() {
 	*x = QueryTotalLockedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[8]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11644,7 +15889,7 @@ func (*QueryTotalLockedRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTotalLockedRequest.ProtoReflect.Descriptor instead.
 func (*QueryTotalLockedRequest) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{8}
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{12}
 }
 
 // QueryTotalLockedResponse is the response type for the Query/TotalLocked RPC method
@@ -11659,7 +15904,7 @@ type QueryTotalLockedResponse struct {
 func (x *QueryTotalLockedResponse) Reset() {
 	*x = QueryTotalLockedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[9]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11673,7 +15918,7 @@ func (*QueryTotalLockedResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTotalLockedResponse.ProtoReflect.Descriptor instead.
 func (*QueryTotalLockedResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{9}
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *QueryTotalLockedResponse) GetAmount() *v1beta11.Coin {
@@ -11693,7 +15938,7 @@ type QueryTotalUnlockedRequest struct {
 func (x *QueryTotalUnlockedRequest) Reset() {
 	*x = QueryTotalUnlockedRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[10]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11707,7 +15952,7 @@ func (*QueryTotalUnlockedRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTotalUnlockedRequest.ProtoReflect.Descriptor instead.
 func (*QueryTotalUnlockedRequest) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{10}
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
 // QueryTotalUnlockedResponse is the response type for the Query/TotalUnlocked RPC method
@@ -11722,7 +15967,7 @@ type QueryTotalUnlockedResponse struct {
 func (x *QueryTotalUnlockedResponse) Reset() {
 	*x = QueryTotalUnlockedResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[11]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11736,7 +15981,7 @@ func (*QueryTotalUnlockedResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTotalUnlockedResponse.ProtoReflect.Descriptor instead.
 func (*QueryTotalUnlockedResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{11}
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *QueryTotalUnlockedResponse) GetAmount() *v1beta11.Coin {
@@ -11758,7 +16003,7 @@ type QueryTotalSupplyRequest struct {
 func (x *QueryTotalSupplyRequest) Reset() {
 	*x = QueryTotalSupplyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[12]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11772,7 +16017,7 @@ func (*QueryTotalSupplyRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTotalSupplyRequest.ProtoReflect.Descriptor instead.
 func (*QueryTotalSupplyRequest) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{12}
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *QueryTotalSupplyRequest) GetPagination() *v1beta1.PageRequest {
@@ -11795,7 +16040,7 @@ type QueryTotalSupplyResponse struct {
 func (x *QueryTotalSupplyResponse) Reset() {
 	*x = QueryTotalSupplyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[13]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11809,7 +16054,7 @@ func (*QueryTotalSupplyResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTotalSupplyResponse.ProtoReflect.Descriptor instead.
 func (*QueryTotalSupplyResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{13}
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *QueryTotalSupplyResponse) GetSupply() []*v1beta11.Coin {
@@ -11839,7 +16084,7 @@ type QuerySupplyOfRequest struct {
 func (x *QuerySupplyOfRequest) Reset() {
 	*x = QuerySupplyOfRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[14]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11853,7 +16098,7 @@ func (*QuerySupplyOfRequest) ProtoMessage() {}
 
 // Deprecated: Use QuerySupplyOfRequest.ProtoReflect.Descriptor instead.
 func (*QuerySupplyOfRequest) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{14}
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *QuerySupplyOfRequest) GetDenom() string {
@@ -11876,7 +16121,7 @@ type QuerySupplyOfResponse struct {
 func (x *QuerySupplyOfResponse) Reset() {
 	*x = QuerySupplyOfResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[15]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -11890,158 +16135,312 @@ func (*QuerySupplyOfResponse) ProtoMessage() {}
 
 // Deprecated: Use QuerySupplyOfResponse.ProtoReflect.Descriptor instead.
 func (*QuerySupplyOfResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{15}
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *QuerySupplyOfResponse) GetAmount() *v1beta11.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// QueryWhitelistRequest is the request type for the Query/Whitelist RPC method.
+type QueryWhitelistRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryWhitelistRequest) Reset() {
+	*x = QueryWhitelistRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryWhitelistRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryWhitelistRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryWhitelistRequest.ProtoReflect.Descriptor instead.
+func (*QueryWhitelistRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{20}
+}
+
+// QueryWhitelistResponse is the response type for the Query/Whitelist RPC method.
+type QueryWhitelistResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// entries are the whitelist entries for the addresses, including any expiry, cap and tier
+	Entries []*WhitelistEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *QueryWhitelistResponse) Reset() {
+	*x = QueryWhitelistResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryWhitelistResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryWhitelistResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryWhitelistResponse.ProtoReflect.Descriptor instead.
+func (*QueryWhitelistResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *QueryWhitelistResponse) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *QueryWhitelistResponse) GetEntries() []*WhitelistEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// QueryWhitelistedRequest is the request type for the Query/Whitelisted RPC method.
+type QueryWhitelistedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// address is the address to query
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *QueryWhitelistedRequest) Reset() {
+	*x = QueryWhitelistedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryWhitelistedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryWhitelistedRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryWhitelistedRequest.ProtoReflect.Descriptor instead.
+func (*QueryWhitelistedRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *QueryWhitelistedRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+// QueryWhitelistedResponse is the response type for the Query/Whitelisted RPC method.
+type QueryWhitelistedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Whitelisted bool   `protobuf:"varint,2,opt,name=whitelisted,proto3" json:"whitelisted,omitempty"`
+	// entry is the address's whitelist entry, if it has one. The entry may have expired
+	Entry *WhitelistEntry `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry,omitempty"`
+}
+
+func (x *QueryWhitelistedResponse) Reset() {
+	*x = QueryWhitelistedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryWhitelistedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryWhitelistedResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryWhitelistedResponse.ProtoReflect.Descriptor instead.
+func (*QueryWhitelistedResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *QueryWhitelistedResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *QueryWhitelistedResponse) GetWhitelisted() bool {
+	if x != nil {
+		return x.Whitelisted
+	}
+	return false
 }
 
-func (x *QuerySupplyOfResponse) GetAmount() *v1beta11.Coin {
+func (x *QueryWhitelistedResponse) GetEntry() *WhitelistEntry {
 	if x != nil {
-		return x.Amount
+		return x.Entry
 	}
 	return nil
 }
 
-// QueryWhitelistRequest is the request type for the Query/Whitelist RPC method.
-type QueryWhitelistRequest struct {
+// QueryLinkedAddressesRequest is the request type for the Query/LinkedAddresses RPC method.
+type QueryLinkedAddressesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// owner is the address to query
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func (x *QueryWhitelistRequest) Reset() {
-	*x = QueryWhitelistRequest{}
+func (x *QueryLinkedAddressesRequest) Reset() {
+	*x = QueryLinkedAddressesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[16]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryWhitelistRequest) String() string {
+func (x *QueryLinkedAddressesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryWhitelistRequest) ProtoMessage() {}
+func (*QueryLinkedAddressesRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryWhitelistRequest.ProtoReflect.Descriptor instead.
-func (*QueryWhitelistRequest) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use QueryLinkedAddressesRequest.ProtoReflect.Descriptor instead.
+func (*QueryLinkedAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{24}
 }
 
-// QueryWhitelistResponse is the response type for the Query/Whitelist RPC method.
-type QueryWhitelistResponse struct {
+func (x *QueryLinkedAddressesRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+// QueryLinkedAddressesResponse is the response type for the Query/LinkedAddresses RPC method.
+type QueryLinkedAddressesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	LinkedAddresses []*LinkedAddress `protobuf:"bytes,1,rep,name=linked_addresses,json=linkedAddresses,proto3" json:"linked_addresses,omitempty"`
 }
 
-func (x *QueryWhitelistResponse) Reset() {
-	*x = QueryWhitelistResponse{}
+func (x *QueryLinkedAddressesResponse) Reset() {
+	*x = QueryLinkedAddressesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[17]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryWhitelistResponse) String() string {
+func (x *QueryLinkedAddressesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryWhitelistResponse) ProtoMessage() {}
+func (*QueryLinkedAddressesResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryWhitelistResponse.ProtoReflect.Descriptor instead.
-func (*QueryWhitelistResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use QueryLinkedAddressesResponse.ProtoReflect.Descriptor instead.
+func (*QueryLinkedAddressesResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *QueryWhitelistResponse) GetAddresses() []string {
+func (x *QueryLinkedAddressesResponse) GetLinkedAddresses() []*LinkedAddress {
 	if x != nil {
-		return x.Addresses
+		return x.LinkedAddresses
 	}
 	return nil
 }
 
-// QueryWhitelistedRequest is the request type for the Query/Whitelisted RPC method.
-type QueryWhitelistedRequest struct {
+// QueryEntSignersRequest is the request type for the Query/EntSigners RPC method.
+type QueryEntSignersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// address is the address to query
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *QueryWhitelistedRequest) Reset() {
-	*x = QueryWhitelistedRequest{}
+func (x *QueryEntSignersRequest) Reset() {
+	*x = QueryEntSignersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[18]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryWhitelistedRequest) String() string {
+func (x *QueryEntSignersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryWhitelistedRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryWhitelistedRequest.ProtoReflect.Descriptor instead.
-func (*QueryWhitelistedRequest) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{18}
-}
+func (*QueryEntSignersRequest) ProtoMessage() {}
 
-func (x *QueryWhitelistedRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use QueryEntSignersRequest.ProtoReflect.Descriptor instead.
+func (*QueryEntSignersRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{26}
 }
 
-// QueryWhitelistedResponse is the response type for the Query/Whitelisted RPC method.
-type QueryWhitelistedResponse struct {
+// QueryEntSignersResponse is the response type for the Query/EntSigners RPC method.
+type QueryEntSignersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Whitelisted bool   `protobuf:"varint,2,opt,name=whitelisted,proto3" json:"whitelisted,omitempty"`
+	EntSigners []*EntSigner `protobuf:"bytes,1,rep,name=ent_signers,json=entSigners,proto3" json:"ent_signers,omitempty"`
 }
 
-func (x *QueryWhitelistedResponse) Reset() {
-	*x = QueryWhitelistedResponse{}
+func (x *QueryEntSignersResponse) Reset() {
+	*x = QueryEntSignersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[19]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryWhitelistedResponse) String() string {
+func (x *QueryEntSignersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryWhitelistedResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryWhitelistedResponse.ProtoReflect.Descriptor instead.
-func (*QueryWhitelistedResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{19}
-}
+func (*QueryEntSignersResponse) ProtoMessage() {}
 
-func (x *QueryWhitelistedResponse) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+// Deprecated: Use QueryEntSignersResponse.ProtoReflect.Descriptor instead.
+func (*QueryEntSignersResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *QueryWhitelistedResponse) GetWhitelisted() bool {
+func (x *QueryEntSignersResponse) GetEntSigners() []*EntSigner {
 	if x != nil {
-		return x.Whitelisted
+		return x.EntSigners
 	}
-	return false
+	return nil
 }
 
 // QueryEnterpriseAccountRequest is the request type for the Query/EnterpriseAccount RPC method.
@@ -12057,7 +16456,7 @@ type QueryEnterpriseAccountRequest struct {
 func (x *QueryEnterpriseAccountRequest) Reset() {
 	*x = QueryEnterpriseAccountRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[20]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12071,7 +16470,7 @@ func (*QueryEnterpriseAccountRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryEnterpriseAccountRequest.ProtoReflect.Descriptor instead.
 func (*QueryEnterpriseAccountRequest) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{20}
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *QueryEnterpriseAccountRequest) GetAddress() string {
@@ -12093,7 +16492,7 @@ type QueryEnterpriseAccountResponse struct {
 func (x *QueryEnterpriseAccountResponse) Reset() {
 	*x = QueryEnterpriseAccountResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[21]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12107,7 +16506,7 @@ func (*QueryEnterpriseAccountResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryEnterpriseAccountResponse.ProtoReflect.Descriptor instead.
 func (*QueryEnterpriseAccountResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{21}
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *QueryEnterpriseAccountResponse) GetAccount() *EnterpriseUserAccount {
@@ -12127,7 +16526,7 @@ type QueryTotalSpentEFUNDRequest struct {
 func (x *QueryTotalSpentEFUNDRequest) Reset() {
 	*x = QueryTotalSpentEFUNDRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[22]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12141,7 +16540,7 @@ func (*QueryTotalSpentEFUNDRequest) ProtoMessage() {}
 
 // Deprecated: Use QueryTotalSpentEFUNDRequest.ProtoReflect.Descriptor instead.
 func (*QueryTotalSpentEFUNDRequest) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{22}
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{30}
 }
 
 // QueryTotalSpentEFUNDResponse is the response type for the Query/TotalSpentEFUND RPC method.
@@ -12156,7 +16555,7 @@ type QueryTotalSpentEFUNDResponse struct {
 func (x *QueryTotalSpentEFUNDResponse) Reset() {
 	*x = QueryTotalSpentEFUNDResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[23]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12170,7 +16569,7 @@ func (*QueryTotalSpentEFUNDResponse) ProtoMessage() {}
 
 // Deprecated: Use QueryTotalSpentEFUNDResponse.ProtoReflect.Descriptor instead.
 func (*QueryTotalSpentEFUNDResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{23}
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *QueryTotalSpentEFUNDResponse) GetAmount() *v1beta11.Coin {
@@ -12193,7 +16592,7 @@ type QuerySpentEFUNDByAddressRequest struct {
 func (x *QuerySpentEFUNDByAddressRequest) Reset() {
 	*x = QuerySpentEFUNDByAddressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[24]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12207,7 +16606,7 @@ func (*QuerySpentEFUNDByAddressRequest) ProtoMessage() {}
 
 // Deprecated: Use QuerySpentEFUNDByAddressRequest.ProtoReflect.Descriptor instead.
 func (*QuerySpentEFUNDByAddressRequest) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{24}
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *QuerySpentEFUNDByAddressRequest) GetAddress() string {
@@ -12229,7 +16628,7 @@ type QuerySpentEFUNDByAddressResponse struct {
 func (x *QuerySpentEFUNDByAddressResponse) Reset() {
 	*x = QuerySpentEFUNDByAddressResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[25]
+		mi := &file_mainchain_enterprise_v1_query_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -12243,7 +16642,7 @@ func (*QuerySpentEFUNDByAddressResponse) ProtoMessage() {}
 
 // Deprecated: Use QuerySpentEFUNDByAddressResponse.ProtoReflect.Descriptor instead.
 func (*QuerySpentEFUNDByAddressResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{25}
+	return file_mainchain_enterprise_v1_query_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *QuerySpentEFUNDByAddressResponse) GetAmount() *v1beta11.Coin {
@@ -12332,64 +16731,130 @@ var file_mainchain_enterprise_v1_query_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
 	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x19, 0x0a,
-	0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65,
-	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
-	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1b, 0x0a,
-	0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x6c, 0x6f, 0x63,
-	0x6b, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x1a, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
-	0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
-	0x74, 0x22, 0x61, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53,
-	0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc8, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x63, 0x0a, 0x06, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x56, 0x0a,
+	0x24, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x45, 0x46, 0x55, 0x4e,
+	0x44, 0x4c, 0x6f, 0x74, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05,
+	0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x6a, 0x0a, 0x25, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x4c, 0x6f, 0x74, 0x73, 0x42, 0x79, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41,
+	0x0a, 0x04, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d,
+	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x45, 0x46, 0x55,
+	0x4e, 0x44, 0x4c, 0x6f, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x6c, 0x6f, 0x74,
+	0x73, 0x22, 0xcc, 0x01, 0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x65, 0x72,
+	0x70, 0x72, 0x69, 0x73, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x2e, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x06, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xb3, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70,
+	0x72, 0x69, 0x73, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x49, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x46,
+	0x55, 0x4e, 0x44, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x47, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x19, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x53, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x1b, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x55, 0x0a, 0x1a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde,
-	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
-	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x06,
-	0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76,
-	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22,
-	0x2c, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x4f, 0x66,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x50, 0x0a,
-	0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x4f, 0x66, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22,
-	0x17, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x36, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
-	0x22, 0x4d, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69,
-	0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
-	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0x56, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73,
-	0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69,
-	0x73, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x77, 0x68, 0x69, 0x74,
-	0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x22, 0x53, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x61, 0x0a, 0x17, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc8, 0x01,
+	0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x75, 0x70, 0x70,
+	0x6c, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x06, 0x73, 0x75,
+	0x70, 0x70, 0x6c, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x06, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x12,
+	0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x0a, 0x14, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x4f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x50, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53,
+	0x75, 0x70, 0x70, 0x6c, 0x79, 0x4f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x7f, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x61, 0x69,
+	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x22, 0x4d, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x9b, 0x01, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x77, 0x68, 0x69, 0x74,
+	0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x77,
+	0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x12, 0x43, 0x0a, 0x05, 0x65, 0x6e,
+	0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x22,
+	0x4d, 0x0a, 0x1b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x77,
+	0x0a, 0x1c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x57,
+	0x0a, 0x10, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x45, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x64, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x53, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x0b,
+	0x65, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6e, 0x74, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x65, 0x6e, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x53, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79,
 	0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
 	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
 	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
@@ -12420,7 +16885,7 @@ var file_mainchain_enterprise_v1_query_proto_rawDesc = []byte{
 	0x65, 0x12, 0x37, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
 	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde,
-	0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x85, 0x0e, 0x0a, 0x05, 0x51,
+	0x1f, 0x00, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0xde, 0x13, 0x0a, 0x05, 0x51,
 	0x75, 0x65, 0x72, 0x79, 0x12, 0x8c, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
 	0x2b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65,
 	0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
@@ -12468,86 +16933,132 @@ var file_mainchain_enterprise_v1_query_proto_rawDesc = []byte{
 	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x6d,
 	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72,
 	0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x2f, 0x7b, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x7d, 0x12, 0x9b, 0x01, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c,
-	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x30, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69,
+	0x77, 0x6e, 0x65, 0x72, 0x7d, 0x12, 0xcf, 0x01, 0x0a, 0x18, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x45, 0x46, 0x55, 0x4e, 0x44, 0x4c, 0x6f, 0x74, 0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x3d, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x4c, 0x6f, 0x74,
+	0x73, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x3e, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x4c, 0x6f, 0x74, 0x73,
+	0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x6d, 0x61, 0x69, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x2f, 0x7b, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x7d, 0x2f, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0xb2, 0x01, 0x0a, 0x10, 0x45, 0x6e, 0x74, 0x65,
+	0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x35, 0x2e, 0x6d,
+	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x65,
+	0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x4c, 0x65, 0x64,
+	0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x2f, 0x7b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x7d, 0x12, 0x9b, 0x01, 0x0a,
+	0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x30, 0x2e, 0x6d,
+	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12, 0x1f, 0x2f, 0x6d, 0x61, 0x69, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x98, 0x01, 0x0a, 0x09, 0x57,
+	0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x24, 0x12, 0x22, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x68, 0x69, 0x74,
+	0x65, 0x6c, 0x69, 0x73, 0x74, 0x12, 0xa8, 0x01, 0x0a, 0x0b, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c,
+	0x69, 0x73, 0x74, 0x65, 0x64, 0x12, 0x30, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69,
 	0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64,
 	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68,
 	0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x6f, 0x63, 0x6b,
-	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x21, 0x12, 0x1f, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65,
-	0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x63,
-	0x6b, 0x65, 0x64, 0x12, 0x98, 0x01, 0x0a, 0x09, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73,
-	0x74, 0x12, 0x2e, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e,
-	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x68, 0x69,
+	0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d,
+	0x12, 0xaf, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x65, 0x73, 0x12, 0x34, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6d, 0x61, 0x69,
+	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x6d, 0x61, 0x69, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65,
+	0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x2f, 0x7b, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x7d, 0x12, 0x9d, 0x01, 0x0a, 0x0a, 0x45, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x73, 0x12, 0x2f, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e,
 	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x6d, 0x61, 0x69,
-	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73,
-	0x65, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x12, 0xa8,
-	0x01, 0x0a, 0x0b, 0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x12, 0x30,
-	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72,
-	0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x68,
-	0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x31, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74,
+	0x79, 0x45, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65,
+	0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x45, 0x6e, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x6d,
+	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72,
+	0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x73, 0x12, 0xb8, 0x01, 0x0a, 0x11, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73,
+	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69,
+	0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x37, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74,
 	0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x57, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x34, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2e, 0x12, 0x2c, 0x2f, 0x6d, 0x61,
-	0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69,
-	0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x77, 0x68, 0x69, 0x74, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x2f,
-	0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xb8, 0x01, 0x0a, 0x11, 0x45, 0x6e,
-	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x36, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65,
-	0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45,
-	0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68,
+	0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2c, 0x12, 0x2a, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x12, 0xac, 0x01,
+	0x0a, 0x0f, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x45, 0x46, 0x55, 0x4e,
+	0x44, 0x12, 0x34, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x45, 0x46, 0x55, 0x4e, 0x44,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68,
 	0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73,
-	0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c, 0x12, 0x2a, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f,
-	0x76, 0x31, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x7d, 0x12, 0xac, 0x01, 0x0a, 0x0f, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x70,
-	0x65, 0x6e, 0x74, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x12, 0x34, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x70, 0x65,
-	0x6e, 0x74, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35,
+	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x70, 0x65, 0x6e,
+	0x74, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31,
+	0x2f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x12, 0xbc, 0x01, 0x0a,
+	0x13, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x42, 0x79, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x38, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x42, 0x79,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39,
 	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72,
-	0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x26, 0x12, 0x24, 0x2f,
-	0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70,
-	0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x70,
-	0x65, 0x6e, 0x74, 0x12, 0xbc, 0x01, 0x0a, 0x13, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x45, 0x46, 0x55,
-	0x4e, 0x44, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x38, 0x2e, 0x6d, 0x61,
-	0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69,
-	0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x65, 0x6e, 0x74,
-	0x45, 0x46, 0x55, 0x4e, 0x44, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70, 0x65, 0x6e, 0x74, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x42,
-	0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f,
-	0x76, 0x31, 0x2f, 0x73, 0x70, 0x65, 0x6e, 0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x7d, 0x42, 0xde, 0x01, 0x0a, 0x1b, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e,
-	0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01,
-	0x5a, 0x35, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x74,
-	0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x74, 0x65, 0x72,
-	0x70, 0x72, 0x69, 0x73, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x45, 0x58, 0xaa, 0x02, 0x17,
-	0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70,
-	0x72, 0x69, 0x73, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x17, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5c, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x56,
-	0x31, 0xe2, 0x02, 0x23, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x45, 0x6e,
-	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x19, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x70,
+	0x65, 0x6e, 0x74, 0x45, 0x46, 0x55, 0x4e, 0x44, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x2a, 0x12, 0x28, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x70, 0x65, 0x6e,
+	0x74, 0x2f, 0x7b, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x7d, 0x42, 0xde, 0x01, 0x0a, 0x1b,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x65, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x35, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x69, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65,
+	0x2f, 0x76, 0x31, 0x3b, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x4d, 0x45, 0x58, 0xaa, 0x02, 0x17, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x17, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x45, 0x6e, 0x74,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x23, 0x4d, 0x61, 0x69,
+	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x45, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73,
+	0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0xea, 0x02, 0x19, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x45, 0x6e,
+	0x74, 0x65, 0x72, 0x70, 0x72, 0x69, 0x73, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -12562,7 +17073,7 @@ func file_mainchain_enterprise_v1_query_proto_rawDescGZIP() []byte {
 	return file_mainchain_enterprise_v1_query_proto_rawDescData
 }
 
-var file_mainchain_enterprise_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_mainchain_enterprise_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_mainchain_enterprise_v1_query_proto_goTypes = []interface{}{
 	(*QueryParamsRequest)(nil),                       // 0: mainchain.enterprise.v1.QueryParamsRequest
 	(*QueryParamsResponse)(nil),                      // 1: mainchain.enterprise.v1.QueryParamsResponse
@@ -12572,74 +17083,103 @@ var file_mainchain_enterprise_v1_query_proto_goTypes = []interface{}{
 	(*QueryEnterpriseUndPurchaseOrdersResponse)(nil), // 5: mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrdersResponse
 	(*QueryLockedUndByAddressRequest)(nil),           // 6: mainchain.enterprise.v1.QueryLockedUndByAddressRequest
 	(*QueryLockedUndByAddressResponse)(nil),          // 7: mainchain.enterprise.v1.QueryLockedUndByAddressResponse
-	(*QueryTotalLockedRequest)(nil),                  // 8: mainchain.enterprise.v1.QueryTotalLockedRequest
-	(*QueryTotalLockedResponse)(nil),                 // 9: mainchain.enterprise.v1.QueryTotalLockedResponse
-	(*QueryTotalUnlockedRequest)(nil),                // 10: mainchain.enterprise.v1.QueryTotalUnlockedRequest
-	(*QueryTotalUnlockedResponse)(nil),               // 11: mainchain.enterprise.v1.QueryTotalUnlockedResponse
-	(*QueryTotalSupplyRequest)(nil),                  // 12: mainchain.enterprise.v1.QueryTotalSupplyRequest
-	(*QueryTotalSupplyResponse)(nil),                 // 13: mainchain.enterprise.v1.QueryTotalSupplyResponse
-	(*QuerySupplyOfRequest)(nil),                     // 14: mainchain.enterprise.v1.QuerySupplyOfRequest
-	(*QuerySupplyOfResponse)(nil),                    // 15: mainchain.enterprise.v1.QuerySupplyOfResponse
-	(*QueryWhitelistRequest)(nil),                    // 16: mainchain.enterprise.v1.QueryWhitelistRequest
-	(*QueryWhitelistResponse)(nil),                   // 17: mainchain.enterprise.v1.QueryWhitelistResponse
-	(*QueryWhitelistedRequest)(nil),                  // 18: mainchain.enterprise.v1.QueryWhitelistedRequest
-	(*QueryWhitelistedResponse)(nil),                 // 19: mainchain.enterprise.v1.QueryWhitelistedResponse
-	(*QueryEnterpriseAccountRequest)(nil),            // 20: mainchain.enterprise.v1.QueryEnterpriseAccountRequest
-	(*QueryEnterpriseAccountResponse)(nil),           // 21: mainchain.enterprise.v1.QueryEnterpriseAccountResponse
-	(*QueryTotalSpentEFUNDRequest)(nil),              // 22: mainchain.enterprise.v1.QueryTotalSpentEFUNDRequest
-	(*QueryTotalSpentEFUNDResponse)(nil),             // 23: mainchain.enterprise.v1.QueryTotalSpentEFUNDResponse
-	(*QuerySpentEFUNDByAddressRequest)(nil),          // 24: mainchain.enterprise.v1.QuerySpentEFUNDByAddressRequest
-	(*QuerySpentEFUNDByAddressResponse)(nil),         // 25: mainchain.enterprise.v1.QuerySpentEFUNDByAddressResponse
-	(*Params)(nil),                                   // 26: mainchain.enterprise.v1.Params
-	(*EnterpriseUndPurchaseOrder)(nil),               // 27: mainchain.enterprise.v1.EnterpriseUndPurchaseOrder
-	(*v1beta1.PageRequest)(nil),                      // 28: cosmos.base.query.v1beta1.PageRequest
-	(PurchaseOrderStatus)(0),                         // 29: mainchain.enterprise.v1.PurchaseOrderStatus
-	(*v1beta1.PageResponse)(nil),                     // 30: cosmos.base.query.v1beta1.PageResponse
-	(*v1beta11.Coin)(nil),                            // 31: cosmos.base.v1beta1.Coin
-	(*EnterpriseUserAccount)(nil),                    // 32: mainchain.enterprise.v1.EnterpriseUserAccount
+	(*QueryLockedEFUNDLotsByAddressRequest)(nil),     // 8: mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest
+	(*QueryLockedEFUNDLotsByAddressResponse)(nil),    // 9: mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse
+	(*QueryEnterpriseLedgerRequest)(nil),             // 10: mainchain.enterprise.v1.QueryEnterpriseLedgerRequest
+	(*QueryEnterpriseLedgerResponse)(nil),            // 11: mainchain.enterprise.v1.QueryEnterpriseLedgerResponse
+	(*QueryTotalLockedRequest)(nil),                  // 12: mainchain.enterprise.v1.QueryTotalLockedRequest
+	(*QueryTotalLockedResponse)(nil),                 // 13: mainchain.enterprise.v1.QueryTotalLockedResponse
+	(*QueryTotalUnlockedRequest)(nil),                // 14: mainchain.enterprise.v1.QueryTotalUnlockedRequest
+	(*QueryTotalUnlockedResponse)(nil),               // 15: mainchain.enterprise.v1.QueryTotalUnlockedResponse
+	(*QueryTotalSupplyRequest)(nil),                  // 16: mainchain.enterprise.v1.QueryTotalSupplyRequest
+	(*QueryTotalSupplyResponse)(nil),                 // 17: mainchain.enterprise.v1.QueryTotalSupplyResponse
+	(*QuerySupplyOfRequest)(nil),                     // 18: mainchain.enterprise.v1.QuerySupplyOfRequest
+	(*QuerySupplyOfResponse)(nil),                    // 19: mainchain.enterprise.v1.QuerySupplyOfResponse
+	(*QueryWhitelistRequest)(nil),                    // 20: mainchain.enterprise.v1.QueryWhitelistRequest
+	(*QueryWhitelistResponse)(nil),                   // 21: mainchain.enterprise.v1.QueryWhitelistResponse
+	(*QueryWhitelistedRequest)(nil),                  // 22: mainchain.enterprise.v1.QueryWhitelistedRequest
+	(*QueryWhitelistedResponse)(nil),                 // 23: mainchain.enterprise.v1.QueryWhitelistedResponse
+	(*QueryLinkedAddressesRequest)(nil),              // 24: mainchain.enterprise.v1.QueryLinkedAddressesRequest
+	(*QueryLinkedAddressesResponse)(nil),             // 25: mainchain.enterprise.v1.QueryLinkedAddressesResponse
+	(*QueryEntSignersRequest)(nil),                   // 26: mainchain.enterprise.v1.QueryEntSignersRequest
+	(*QueryEntSignersResponse)(nil),                  // 27: mainchain.enterprise.v1.QueryEntSignersResponse
+	(*QueryEnterpriseAccountRequest)(nil),            // 28: mainchain.enterprise.v1.QueryEnterpriseAccountRequest
+	(*QueryEnterpriseAccountResponse)(nil),           // 29: mainchain.enterprise.v1.QueryEnterpriseAccountResponse
+	(*QueryTotalSpentEFUNDRequest)(nil),              // 30: mainchain.enterprise.v1.QueryTotalSpentEFUNDRequest
+	(*QueryTotalSpentEFUNDResponse)(nil),             // 31: mainchain.enterprise.v1.QueryTotalSpentEFUNDResponse
+	(*QuerySpentEFUNDByAddressRequest)(nil),          // 32: mainchain.enterprise.v1.QuerySpentEFUNDByAddressRequest
+	(*QuerySpentEFUNDByAddressResponse)(nil),         // 33: mainchain.enterprise.v1.QuerySpentEFUNDByAddressResponse
+	(*Params)(nil),                                   // 34: mainchain.enterprise.v1.Params
+	(*EnterpriseUndPurchaseOrder)(nil),               // 35: mainchain.enterprise.v1.EnterpriseUndPurchaseOrder
+	(*v1beta1.PageRequest)(nil),                      // 36: cosmos.base.query.v1beta1.PageRequest
+	(PurchaseOrderStatus)(0),                         // 37: mainchain.enterprise.v1.PurchaseOrderStatus
+	(*v1beta1.PageResponse)(nil),                     // 38: cosmos.base.query.v1beta1.PageResponse
+	(*v1beta11.Coin)(nil),                            // 39: cosmos.base.v1beta1.Coin
+	(*LockedEFUNDLot)(nil),                           // 40: mainchain.enterprise.v1.LockedEFUNDLot
+	(*EFUNDLedgerEntry)(nil),                         // 41: mainchain.enterprise.v1.EFUNDLedgerEntry
+	(*WhitelistEntry)(nil),                           // 42: mainchain.enterprise.v1.WhitelistEntry
+	(*LinkedAddress)(nil),                            // 43: mainchain.enterprise.v1.LinkedAddress
+	(*EntSigner)(nil),                                // 44: mainchain.enterprise.v1.EntSigner
+	(*EnterpriseUserAccount)(nil),                    // 45: mainchain.enterprise.v1.EnterpriseUserAccount
 }
 var file_mainchain_enterprise_v1_query_proto_depIdxs = []int32{
-	26, // 0: mainchain.enterprise.v1.QueryParamsResponse.params:type_name -> mainchain.enterprise.v1.Params
-	27, // 1: mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrderResponse.purchase_order:type_name -> mainchain.enterprise.v1.EnterpriseUndPurchaseOrder
-	28, // 2: mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrdersRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	29, // 3: mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrdersRequest.status:type_name -> mainchain.enterprise.v1.PurchaseOrderStatus
-	27, // 4: mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrdersResponse.purchase_orders:type_name -> mainchain.enterprise.v1.EnterpriseUndPurchaseOrder
-	30, // 5: mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrdersResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	31, // 6: mainchain.enterprise.v1.QueryLockedUndByAddressResponse.amount:type_name -> cosmos.base.v1beta1.Coin
-	31, // 7: mainchain.enterprise.v1.QueryTotalLockedResponse.amount:type_name -> cosmos.base.v1beta1.Coin
-	31, // 8: mainchain.enterprise.v1.QueryTotalUnlockedResponse.amount:type_name -> cosmos.base.v1beta1.Coin
-	28, // 9: mainchain.enterprise.v1.QueryTotalSupplyRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	31, // 10: mainchain.enterprise.v1.QueryTotalSupplyResponse.supply:type_name -> cosmos.base.v1beta1.Coin
-	30, // 11: mainchain.enterprise.v1.QueryTotalSupplyResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	31, // 12: mainchain.enterprise.v1.QuerySupplyOfResponse.amount:type_name -> cosmos.base.v1beta1.Coin
-	32, // 13: mainchain.enterprise.v1.QueryEnterpriseAccountResponse.account:type_name -> mainchain.enterprise.v1.EnterpriseUserAccount
-	31, // 14: mainchain.enterprise.v1.QueryTotalSpentEFUNDResponse.amount:type_name -> cosmos.base.v1beta1.Coin
-	31, // 15: mainchain.enterprise.v1.QuerySpentEFUNDByAddressResponse.amount:type_name -> cosmos.base.v1beta1.Coin
-	0,  // 16: mainchain.enterprise.v1.Query.Params:input_type -> mainchain.enterprise.v1.QueryParamsRequest
-	2,  // 17: mainchain.enterprise.v1.Query.EnterpriseUndPurchaseOrder:input_type -> mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrderRequest
-	4,  // 18: mainchain.enterprise.v1.Query.EnterpriseUndPurchaseOrders:input_type -> mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrdersRequest
-	6,  // 19: mainchain.enterprise.v1.Query.LockedUndByAddress:input_type -> mainchain.enterprise.v1.QueryLockedUndByAddressRequest
-	8,  // 20: mainchain.enterprise.v1.Query.TotalLocked:input_type -> mainchain.enterprise.v1.QueryTotalLockedRequest
-	16, // 21: mainchain.enterprise.v1.Query.Whitelist:input_type -> mainchain.enterprise.v1.QueryWhitelistRequest
-	18, // 22: mainchain.enterprise.v1.Query.Whitelisted:input_type -> mainchain.enterprise.v1.QueryWhitelistedRequest
-	20, // 23: mainchain.enterprise.v1.Query.EnterpriseAccount:input_type -> mainchain.enterprise.v1.QueryEnterpriseAccountRequest
-	22, // 24: mainchain.enterprise.v1.Query.TotalSpentEFUND:input_type -> mainchain.enterprise.v1.QueryTotalSpentEFUNDRequest
-	24, // 25: mainchain.enterprise.v1.Query.SpentEFUNDByAddress:input_type -> mainchain.enterprise.v1.QuerySpentEFUNDByAddressRequest
-	1,  // 26: mainchain.enterprise.v1.Query.Params:output_type -> mainchain.enterprise.v1.QueryParamsResponse
-	3,  // 27: mainchain.enterprise.v1.Query.EnterpriseUndPurchaseOrder:output_type -> mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrderResponse
-	5,  // 28: mainchain.enterprise.v1.Query.EnterpriseUndPurchaseOrders:output_type -> mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrdersResponse
-	7,  // 29: mainchain.enterprise.v1.Query.LockedUndByAddress:output_type -> mainchain.enterprise.v1.QueryLockedUndByAddressResponse
-	9,  // 30: mainchain.enterprise.v1.Query.TotalLocked:output_type -> mainchain.enterprise.v1.QueryTotalLockedResponse
-	17, // 31: mainchain.enterprise.v1.Query.Whitelist:output_type -> mainchain.enterprise.v1.QueryWhitelistResponse
-	19, // 32: mainchain.enterprise.v1.Query.Whitelisted:output_type -> mainchain.enterprise.v1.QueryWhitelistedResponse
-	21, // 33: mainchain.enterprise.v1.Query.EnterpriseAccount:output_type -> mainchain.enterprise.v1.QueryEnterpriseAccountResponse
-	23, // 34: mainchain.enterprise.v1.Query.TotalSpentEFUND:output_type -> mainchain.enterprise.v1.QueryTotalSpentEFUNDResponse
-	25, // 35: mainchain.enterprise.v1.Query.SpentEFUNDByAddress:output_type -> mainchain.enterprise.v1.QuerySpentEFUNDByAddressResponse
-	26, // [26:36] is the sub-list for method output_type
-	16, // [16:26] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	34, // 0: mainchain.enterprise.v1.QueryParamsResponse.params:type_name -> mainchain.enterprise.v1.Params
+	35, // 1: mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrderResponse.purchase_order:type_name -> mainchain.enterprise.v1.EnterpriseUndPurchaseOrder
+	36, // 2: mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrdersRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	37, // 3: mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrdersRequest.status:type_name -> mainchain.enterprise.v1.PurchaseOrderStatus
+	35, // 4: mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrdersResponse.purchase_orders:type_name -> mainchain.enterprise.v1.EnterpriseUndPurchaseOrder
+	38, // 5: mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrdersResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	39, // 6: mainchain.enterprise.v1.QueryLockedUndByAddressResponse.amount:type_name -> cosmos.base.v1beta1.Coin
+	40, // 7: mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse.lots:type_name -> mainchain.enterprise.v1.LockedEFUNDLot
+	36, // 8: mainchain.enterprise.v1.QueryEnterpriseLedgerRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	41, // 9: mainchain.enterprise.v1.QueryEnterpriseLedgerResponse.entries:type_name -> mainchain.enterprise.v1.EFUNDLedgerEntry
+	38, // 10: mainchain.enterprise.v1.QueryEnterpriseLedgerResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	39, // 11: mainchain.enterprise.v1.QueryTotalLockedResponse.amount:type_name -> cosmos.base.v1beta1.Coin
+	39, // 12: mainchain.enterprise.v1.QueryTotalUnlockedResponse.amount:type_name -> cosmos.base.v1beta1.Coin
+	36, // 13: mainchain.enterprise.v1.QueryTotalSupplyRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	39, // 14: mainchain.enterprise.v1.QueryTotalSupplyResponse.supply:type_name -> cosmos.base.v1beta1.Coin
+	38, // 15: mainchain.enterprise.v1.QueryTotalSupplyResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	39, // 16: mainchain.enterprise.v1.QuerySupplyOfResponse.amount:type_name -> cosmos.base.v1beta1.Coin
+	42, // 17: mainchain.enterprise.v1.QueryWhitelistResponse.entries:type_name -> mainchain.enterprise.v1.WhitelistEntry
+	42, // 18: mainchain.enterprise.v1.QueryWhitelistedResponse.entry:type_name -> mainchain.enterprise.v1.WhitelistEntry
+	43, // 19: mainchain.enterprise.v1.QueryLinkedAddressesResponse.linked_addresses:type_name -> mainchain.enterprise.v1.LinkedAddress
+	44, // 20: mainchain.enterprise.v1.QueryEntSignersResponse.ent_signers:type_name -> mainchain.enterprise.v1.EntSigner
+	45, // 21: mainchain.enterprise.v1.QueryEnterpriseAccountResponse.account:type_name -> mainchain.enterprise.v1.EnterpriseUserAccount
+	39, // 22: mainchain.enterprise.v1.QueryTotalSpentEFUNDResponse.amount:type_name -> cosmos.base.v1beta1.Coin
+	39, // 23: mainchain.enterprise.v1.QuerySpentEFUNDByAddressResponse.amount:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 24: mainchain.enterprise.v1.Query.Params:input_type -> mainchain.enterprise.v1.QueryParamsRequest
+	2,  // 25: mainchain.enterprise.v1.Query.EnterpriseUndPurchaseOrder:input_type -> mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrderRequest
+	4,  // 26: mainchain.enterprise.v1.Query.EnterpriseUndPurchaseOrders:input_type -> mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrdersRequest
+	6,  // 27: mainchain.enterprise.v1.Query.LockedUndByAddress:input_type -> mainchain.enterprise.v1.QueryLockedUndByAddressRequest
+	8,  // 28: mainchain.enterprise.v1.Query.LockedEFUNDLotsByAddress:input_type -> mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest
+	10, // 29: mainchain.enterprise.v1.Query.EnterpriseLedger:input_type -> mainchain.enterprise.v1.QueryEnterpriseLedgerRequest
+	12, // 30: mainchain.enterprise.v1.Query.TotalLocked:input_type -> mainchain.enterprise.v1.QueryTotalLockedRequest
+	20, // 31: mainchain.enterprise.v1.Query.Whitelist:input_type -> mainchain.enterprise.v1.QueryWhitelistRequest
+	22, // 32: mainchain.enterprise.v1.Query.Whitelisted:input_type -> mainchain.enterprise.v1.QueryWhitelistedRequest
+	24, // 33: mainchain.enterprise.v1.Query.LinkedAddresses:input_type -> mainchain.enterprise.v1.QueryLinkedAddressesRequest
+	26, // 34: mainchain.enterprise.v1.Query.EntSigners:input_type -> mainchain.enterprise.v1.QueryEntSignersRequest
+	28, // 35: mainchain.enterprise.v1.Query.EnterpriseAccount:input_type -> mainchain.enterprise.v1.QueryEnterpriseAccountRequest
+	30, // 36: mainchain.enterprise.v1.Query.TotalSpentEFUND:input_type -> mainchain.enterprise.v1.QueryTotalSpentEFUNDRequest
+	32, // 37: mainchain.enterprise.v1.Query.SpentEFUNDByAddress:input_type -> mainchain.enterprise.v1.QuerySpentEFUNDByAddressRequest
+	1,  // 38: mainchain.enterprise.v1.Query.Params:output_type -> mainchain.enterprise.v1.QueryParamsResponse
+	3,  // 39: mainchain.enterprise.v1.Query.EnterpriseUndPurchaseOrder:output_type -> mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrderResponse
+	5,  // 40: mainchain.enterprise.v1.Query.EnterpriseUndPurchaseOrders:output_type -> mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrdersResponse
+	7,  // 41: mainchain.enterprise.v1.Query.LockedUndByAddress:output_type -> mainchain.enterprise.v1.QueryLockedUndByAddressResponse
+	9,  // 42: mainchain.enterprise.v1.Query.LockedEFUNDLotsByAddress:output_type -> mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse
+	11, // 43: mainchain.enterprise.v1.Query.EnterpriseLedger:output_type -> mainchain.enterprise.v1.QueryEnterpriseLedgerResponse
+	13, // 44: mainchain.enterprise.v1.Query.TotalLocked:output_type -> mainchain.enterprise.v1.QueryTotalLockedResponse
+	21, // 45: mainchain.enterprise.v1.Query.Whitelist:output_type -> mainchain.enterprise.v1.QueryWhitelistResponse
+	23, // 46: mainchain.enterprise.v1.Query.Whitelisted:output_type -> mainchain.enterprise.v1.QueryWhitelistedResponse
+	25, // 47: mainchain.enterprise.v1.Query.LinkedAddresses:output_type -> mainchain.enterprise.v1.QueryLinkedAddressesResponse
+	27, // 48: mainchain.enterprise.v1.Query.EntSigners:output_type -> mainchain.enterprise.v1.QueryEntSignersResponse
+	29, // 49: mainchain.enterprise.v1.Query.EnterpriseAccount:output_type -> mainchain.enterprise.v1.QueryEnterpriseAccountResponse
+	31, // 50: mainchain.enterprise.v1.Query.TotalSpentEFUND:output_type -> mainchain.enterprise.v1.QueryTotalSpentEFUNDResponse
+	33, // 51: mainchain.enterprise.v1.Query.SpentEFUNDByAddress:output_type -> mainchain.enterprise.v1.QuerySpentEFUNDByAddressResponse
+	38, // [38:52] is the sub-list for method output_type
+	24, // [24:38] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_mainchain_enterprise_v1_query_proto_init() }
@@ -12746,7 +17286,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTotalLockedRequest); i {
+			switch v := v.(*QueryLockedEFUNDLotsByAddressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12758,7 +17298,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTotalLockedResponse); i {
+			switch v := v.(*QueryLockedEFUNDLotsByAddressResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12770,7 +17310,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTotalUnlockedRequest); i {
+			switch v := v.(*QueryEnterpriseLedgerRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12782,7 +17322,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTotalUnlockedResponse); i {
+			switch v := v.(*QueryEnterpriseLedgerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12794,7 +17334,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTotalSupplyRequest); i {
+			switch v := v.(*QueryTotalLockedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12806,7 +17346,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTotalSupplyResponse); i {
+			switch v := v.(*QueryTotalLockedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12818,7 +17358,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuerySupplyOfRequest); i {
+			switch v := v.(*QueryTotalUnlockedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12830,7 +17370,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuerySupplyOfResponse); i {
+			switch v := v.(*QueryTotalUnlockedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12842,7 +17382,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryWhitelistRequest); i {
+			switch v := v.(*QueryTotalSupplyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12854,7 +17394,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryWhitelistResponse); i {
+			switch v := v.(*QueryTotalSupplyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12866,7 +17406,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryWhitelistedRequest); i {
+			switch v := v.(*QuerySupplyOfRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12878,7 +17418,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryWhitelistedResponse); i {
+			switch v := v.(*QuerySupplyOfResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12890,7 +17430,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryEnterpriseAccountRequest); i {
+			switch v := v.(*QueryWhitelistRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12902,7 +17442,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryEnterpriseAccountResponse); i {
+			switch v := v.(*QueryWhitelistResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12914,7 +17454,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTotalSpentEFUNDRequest); i {
+			switch v := v.(*QueryWhitelistedRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12926,7 +17466,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QueryTotalSpentEFUNDResponse); i {
+			switch v := v.(*QueryWhitelistedResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12938,7 +17478,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*QuerySpentEFUNDByAddressRequest); i {
+			switch v := v.(*QueryLinkedAddressesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12950,6 +17490,102 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			}
 		}
 		file_mainchain_enterprise_v1_query_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryLinkedAddressesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_enterprise_v1_query_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEntSignersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_enterprise_v1_query_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEntSignersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_enterprise_v1_query_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEnterpriseAccountRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_enterprise_v1_query_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryEnterpriseAccountResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_enterprise_v1_query_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTotalSpentEFUNDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_enterprise_v1_query_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryTotalSpentEFUNDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_enterprise_v1_query_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuerySpentEFUNDByAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_enterprise_v1_query_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QuerySpentEFUNDByAddressResponse); i {
 			case 0:
 				return &v.state
@@ -12968,7 +17604,7 @@ func file_mainchain_enterprise_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mainchain_enterprise_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,9 +23,13 @@ const (
 	Query_EnterpriseUndPurchaseOrder_FullMethodName  = "/mainchain.enterprise.v1.Query/EnterpriseUndPurchaseOrder"
 	Query_EnterpriseUndPurchaseOrders_FullMethodName = "/mainchain.enterprise.v1.Query/EnterpriseUndPurchaseOrders"
 	Query_LockedUndByAddress_FullMethodName          = "/mainchain.enterprise.v1.Query/LockedUndByAddress"
+	Query_LockedEFUNDLotsByAddress_FullMethodName    = "/mainchain.enterprise.v1.Query/LockedEFUNDLotsByAddress"
+	Query_EnterpriseLedger_FullMethodName            = "/mainchain.enterprise.v1.Query/EnterpriseLedger"
 	Query_TotalLocked_FullMethodName                 = "/mainchain.enterprise.v1.Query/TotalLocked"
 	Query_Whitelist_FullMethodName                   = "/mainchain.enterprise.v1.Query/Whitelist"
 	Query_Whitelisted_FullMethodName                 = "/mainchain.enterprise.v1.Query/Whitelisted"
+	Query_LinkedAddresses_FullMethodName             = "/mainchain.enterprise.v1.Query/LinkedAddresses"
+	Query_EntSigners_FullMethodName                  = "/mainchain.enterprise.v1.Query/EntSigners"
 	Query_EnterpriseAccount_FullMethodName           = "/mainchain.enterprise.v1.Query/EnterpriseAccount"
 	Query_TotalSpentEFUND_FullMethodName             = "/mainchain.enterprise.v1.Query/TotalSpentEFUND"
 	Query_SpentEFUNDByAddress_FullMethodName         = "/mainchain.enterprise.v1.Query/SpentEFUNDByAddress"
@@ -43,12 +47,20 @@ type QueryClient interface {
 	EnterpriseUndPurchaseOrders(ctx context.Context, in *QueryEnterpriseUndPurchaseOrdersRequest, opts ...grpc.CallOption) (*QueryEnterpriseUndPurchaseOrdersResponse, error)
 	// LockedUndByAddress queries an account address for their locked FUND
 	LockedUndByAddress(ctx context.Context, in *QueryLockedUndByAddressRequest, opts ...grpc.CallOption) (*QueryLockedUndByAddressResponse, error)
+	// LockedEFUNDLotsByAddress queries an account address for the lots making up their locked eFUND
+	LockedEFUNDLotsByAddress(ctx context.Context, in *QueryLockedEFUNDLotsByAddressRequest, opts ...grpc.CallOption) (*QueryLockedEFUNDLotsByAddressResponse, error)
+	// EnterpriseLedger queries an account's eFUND ledger, oldest first, optionally filtered by time range
+	EnterpriseLedger(ctx context.Context, in *QueryEnterpriseLedgerRequest, opts ...grpc.CallOption) (*QueryEnterpriseLedgerResponse, error)
 	// TotalLocked queries the total locked FUND
 	TotalLocked(ctx context.Context, in *QueryTotalLockedRequest, opts ...grpc.CallOption) (*QueryTotalLockedResponse, error)
 	// Whitelist queries whitelisted addresses authorised to raise new purchase orders
 	Whitelist(ctx context.Context, in *QueryWhitelistRequest, opts ...grpc.CallOption) (*QueryWhitelistResponse, error)
 	// Whitelisted queries whether or not the given address is authorised to raise new purchase orders
 	Whitelisted(ctx context.Context, in *QueryWhitelistedRequest, opts ...grpc.CallOption) (*QueryWhitelistedResponse, error)
+	// LinkedAddresses queries the addresses the given address can transfer locked eFUND to
+	LinkedAddresses(ctx context.Context, in *QueryLinkedAddressesRequest, opts ...grpc.CallOption) (*QueryLinkedAddressesResponse, error)
+	// EntSigners queries the addresses authorised to make decisions on raised purchase orders
+	EntSigners(ctx context.Context, in *QueryEntSignersRequest, opts ...grpc.CallOption) (*QueryEntSignersResponse, error)
 	// EnterpriseAccount queries an account address for their locked FUND and other data
 	EnterpriseAccount(ctx context.Context, in *QueryEnterpriseAccountRequest, opts ...grpc.CallOption) (*QueryEnterpriseAccountResponse, error)
 	// TotalSpentEFUND queries the total eFUND usage to date - i.e. the amount used to pay fees
@@ -101,6 +113,24 @@ func (c *queryClient) LockedUndByAddress(ctx context.Context, in *QueryLockedUnd
 	return out, nil
 }
 
+func (c *queryClient) LockedEFUNDLotsByAddress(ctx context.Context, in *QueryLockedEFUNDLotsByAddressRequest, opts ...grpc.CallOption) (*QueryLockedEFUNDLotsByAddressResponse, error) {
+	out := new(QueryLockedEFUNDLotsByAddressResponse)
+	err := c.cc.Invoke(ctx, Query_LockedEFUNDLotsByAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EnterpriseLedger(ctx context.Context, in *QueryEnterpriseLedgerRequest, opts ...grpc.CallOption) (*QueryEnterpriseLedgerResponse, error) {
+	out := new(QueryEnterpriseLedgerResponse)
+	err := c.cc.Invoke(ctx, Query_EnterpriseLedger_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TotalLocked(ctx context.Context, in *QueryTotalLockedRequest, opts ...grpc.CallOption) (*QueryTotalLockedResponse, error) {
 	out := new(QueryTotalLockedResponse)
 	err := c.cc.Invoke(ctx, Query_TotalLocked_FullMethodName, in, out, opts...)
@@ -128,6 +158,24 @@ func (c *queryClient) Whitelisted(ctx context.Context, in *QueryWhitelistedReque
 	return out, nil
 }
 
+func (c *queryClient) LinkedAddresses(ctx context.Context, in *QueryLinkedAddressesRequest, opts ...grpc.CallOption) (*QueryLinkedAddressesResponse, error) {
+	out := new(QueryLinkedAddressesResponse)
+	err := c.cc.Invoke(ctx, Query_LinkedAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntSigners(ctx context.Context, in *QueryEntSignersRequest, opts ...grpc.CallOption) (*QueryEntSignersResponse, error) {
+	out := new(QueryEntSignersResponse)
+	err := c.cc.Invoke(ctx, Query_EntSigners_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EnterpriseAccount(ctx context.Context, in *QueryEnterpriseAccountRequest, opts ...grpc.CallOption) (*QueryEnterpriseAccountResponse, error) {
 	out := new(QueryEnterpriseAccountResponse)
 	err := c.cc.Invoke(ctx, Query_EnterpriseAccount_FullMethodName, in, out, opts...)
@@ -167,12 +215,20 @@ type QueryServer interface {
 	EnterpriseUndPurchaseOrders(context.Context, *QueryEnterpriseUndPurchaseOrdersRequest) (*QueryEnterpriseUndPurchaseOrdersResponse, error)
 	// LockedUndByAddress queries an account address for their locked FUND
 	LockedUndByAddress(context.Context, *QueryLockedUndByAddressRequest) (*QueryLockedUndByAddressResponse, error)
+	// LockedEFUNDLotsByAddress queries an account address for the lots making up their locked eFUND
+	LockedEFUNDLotsByAddress(context.Context, *QueryLockedEFUNDLotsByAddressRequest) (*QueryLockedEFUNDLotsByAddressResponse, error)
+	// EnterpriseLedger queries an account's eFUND ledger, oldest first, optionally filtered by time range
+	EnterpriseLedger(context.Context, *QueryEnterpriseLedgerRequest) (*QueryEnterpriseLedgerResponse, error)
 	// TotalLocked queries the total locked FUND
 	TotalLocked(context.Context, *QueryTotalLockedRequest) (*QueryTotalLockedResponse, error)
 	// Whitelist queries whitelisted addresses authorised to raise new purchase orders
 	Whitelist(context.Context, *QueryWhitelistRequest) (*QueryWhitelistResponse, error)
 	// Whitelisted queries whether or not the given address is authorised to raise new purchase orders
 	Whitelisted(context.Context, *QueryWhitelistedRequest) (*QueryWhitelistedResponse, error)
+	// LinkedAddresses queries the addresses the given address can transfer locked eFUND to
+	LinkedAddresses(context.Context, *QueryLinkedAddressesRequest) (*QueryLinkedAddressesResponse, error)
+	// EntSigners queries the addresses authorised to make decisions on raised purchase orders
+	EntSigners(context.Context, *QueryEntSignersRequest) (*QueryEntSignersResponse, error)
 	// EnterpriseAccount queries an account address for their locked FUND and other data
 	EnterpriseAccount(context.Context, *QueryEnterpriseAccountRequest) (*QueryEnterpriseAccountResponse, error)
 	// TotalSpentEFUND queries the total eFUND usage to date - i.e. the amount used to pay fees
@@ -198,6 +254,12 @@ func (UnimplementedQueryServer) EnterpriseUndPurchaseOrders(context.Context, *Qu
 func (UnimplementedQueryServer) LockedUndByAddress(context.Context, *QueryLockedUndByAddressRequest) (*QueryLockedUndByAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockedUndByAddress not implemented")
 }
+func (UnimplementedQueryServer) LockedEFUNDLotsByAddress(context.Context, *QueryLockedEFUNDLotsByAddressRequest) (*QueryLockedEFUNDLotsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockedEFUNDLotsByAddress not implemented")
+}
+func (UnimplementedQueryServer) EnterpriseLedger(context.Context, *QueryEnterpriseLedgerRequest) (*QueryEnterpriseLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnterpriseLedger not implemented")
+}
 func (UnimplementedQueryServer) TotalLocked(context.Context, *QueryTotalLockedRequest) (*QueryTotalLockedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalLocked not implemented")
 }
@@ -207,6 +269,12 @@ func (UnimplementedQueryServer) Whitelist(context.Context, *QueryWhitelistReques
 func (UnimplementedQueryServer) Whitelisted(context.Context, *QueryWhitelistedRequest) (*QueryWhitelistedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Whitelisted not implemented")
 }
+func (UnimplementedQueryServer) LinkedAddresses(context.Context, *QueryLinkedAddressesRequest) (*QueryLinkedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkedAddresses not implemented")
+}
+func (UnimplementedQueryServer) EntSigners(context.Context, *QueryEntSignersRequest) (*QueryEntSignersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntSigners not implemented")
+}
 func (UnimplementedQueryServer) EnterpriseAccount(context.Context, *QueryEnterpriseAccountRequest) (*QueryEnterpriseAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnterpriseAccount not implemented")
 }
@@ -301,6 +369,42 @@ func _Query_LockedUndByAddress_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LockedEFUNDLotsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockedEFUNDLotsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockedEFUNDLotsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LockedEFUNDLotsByAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockedEFUNDLotsByAddress(ctx, req.(*QueryLockedEFUNDLotsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EnterpriseLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEnterpriseLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EnterpriseLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EnterpriseLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EnterpriseLedger(ctx, req.(*QueryEnterpriseLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TotalLocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTotalLockedRequest)
 	if err := dec(in); err != nil {
@@ -355,6 +459,42 @@ func _Query_Whitelisted_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LinkedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLinkedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LinkedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_LinkedAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LinkedAddresses(ctx, req.(*QueryLinkedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntSigners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntSignersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntSigners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EntSigners_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntSigners(ctx, req.(*QueryEntSignersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EnterpriseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryEnterpriseAccountRequest)
 	if err := dec(in); err != nil {
@@ -432,6 +572,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LockedUndByAddress",
 			Handler:    _Query_LockedUndByAddress_Handler,
 		},
+		{
+			MethodName: "LockedEFUNDLotsByAddress",
+			Handler:    _Query_LockedEFUNDLotsByAddress_Handler,
+		},
+		{
+			MethodName: "EnterpriseLedger",
+			Handler:    _Query_EnterpriseLedger_Handler,
+		},
 		{
 			MethodName: "TotalLocked",
 			Handler:    _Query_TotalLocked_Handler,
@@ -444,6 +592,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Whitelisted",
 			Handler:    _Query_Whitelisted_Handler,
 		},
+		{
+			MethodName: "LinkedAddresses",
+			Handler:    _Query_LinkedAddresses_Handler,
+		},
+		{
+			MethodName: "EntSigners",
+			Handler:    _Query_EntSigners_Handler,
+		},
 		{
 			MethodName: "EnterpriseAccount",
 			Handler:    _Query_EnterpriseAccount_Handler,
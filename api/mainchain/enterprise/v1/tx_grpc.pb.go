@@ -19,10 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UndPurchaseOrder_FullMethodName        = "/mainchain.enterprise.v1.Msg/UndPurchaseOrder"
-	Msg_ProcessUndPurchaseOrder_FullMethodName = "/mainchain.enterprise.v1.Msg/ProcessUndPurchaseOrder"
-	Msg_WhitelistAddress_FullMethodName        = "/mainchain.enterprise.v1.Msg/WhitelistAddress"
-	Msg_UpdateParams_FullMethodName            = "/mainchain.enterprise.v1.Msg/UpdateParams"
+	Msg_UndPurchaseOrder_FullMethodName         = "/mainchain.enterprise.v1.Msg/UndPurchaseOrder"
+	Msg_ProcessUndPurchaseOrder_FullMethodName  = "/mainchain.enterprise.v1.Msg/ProcessUndPurchaseOrder"
+	Msg_WhitelistAddress_FullMethodName         = "/mainchain.enterprise.v1.Msg/WhitelistAddress"
+	Msg_WithdrawUndPurchaseOrder_FullMethodName = "/mainchain.enterprise.v1.Msg/WithdrawUndPurchaseOrder"
+	Msg_AmendUndPurchaseOrder_FullMethodName    = "/mainchain.enterprise.v1.Msg/AmendUndPurchaseOrder"
+	Msg_TransferLockedEFUND_FullMethodName      = "/mainchain.enterprise.v1.Msg/TransferLockedEFUND"
+	Msg_LinkAddress_FullMethodName              = "/mainchain.enterprise.v1.Msg/LinkAddress"
+	Msg_AddEntSigner_FullMethodName             = "/mainchain.enterprise.v1.Msg/AddEntSigner"
+	Msg_RemoveEntSigner_FullMethodName          = "/mainchain.enterprise.v1.Msg/RemoveEntSigner"
+	Msg_UpdateParams_FullMethodName             = "/mainchain.enterprise.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -35,6 +41,19 @@ type MsgClient interface {
 	ProcessUndPurchaseOrder(ctx context.Context, in *MsgProcessUndPurchaseOrder, opts ...grpc.CallOption) (*MsgProcessUndPurchaseOrderResponse, error)
 	// WhitelistAddress defines a method to execute a whitelist action.
 	WhitelistAddress(ctx context.Context, in *MsgWhitelistAddress, opts ...grpc.CallOption) (*MsgWhitelistAddressResponse, error)
+	// WithdrawUndPurchaseOrder defines a method for a purchaser to withdraw a raised purchase order.
+	WithdrawUndPurchaseOrder(ctx context.Context, in *MsgWithdrawUndPurchaseOrder, opts ...grpc.CallOption) (*MsgWithdrawUndPurchaseOrderResponse, error)
+	// AmendUndPurchaseOrder defines a method for a purchaser to amend the amount of a raised purchase order.
+	AmendUndPurchaseOrder(ctx context.Context, in *MsgAmendUndPurchaseOrder, opts ...grpc.CallOption) (*MsgAmendUndPurchaseOrderResponse, error)
+	// TransferLockedEFUND defines a method for moving locked eFUND to another whitelisted or linked address.
+	TransferLockedEFUND(ctx context.Context, in *MsgTransferLockedEFUND, opts ...grpc.CallOption) (*MsgTransferLockedEFUNDResponse, error)
+	// LinkAddress defines a method for an ent signer to add/remove a link from an address to another address it can
+	// transfer locked eFUND to.
+	LinkAddress(ctx context.Context, in *MsgLinkAddress, opts ...grpc.CallOption) (*MsgLinkAddressResponse, error)
+	// AddEntSigner defines a governance operation for adding an address to the purchase order signer registry.
+	AddEntSigner(ctx context.Context, in *MsgAddEntSigner, opts ...grpc.CallOption) (*MsgAddEntSignerResponse, error)
+	// RemoveEntSigner defines a governance operation for removing an address from the purchase order signer registry.
+	RemoveEntSigner(ctx context.Context, in *MsgRemoveEntSigner, opts ...grpc.CallOption) (*MsgRemoveEntSignerResponse, error)
 	// UpdateParams defines an operation for updating the x/enterprise module
 	// parameters.
 	// Since: cosmos-sdk 0.47
@@ -76,6 +95,60 @@ func (c *msgClient) WhitelistAddress(ctx context.Context, in *MsgWhitelistAddres
 	return out, nil
 }
 
+func (c *msgClient) WithdrawUndPurchaseOrder(ctx context.Context, in *MsgWithdrawUndPurchaseOrder, opts ...grpc.CallOption) (*MsgWithdrawUndPurchaseOrderResponse, error) {
+	out := new(MsgWithdrawUndPurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, Msg_WithdrawUndPurchaseOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AmendUndPurchaseOrder(ctx context.Context, in *MsgAmendUndPurchaseOrder, opts ...grpc.CallOption) (*MsgAmendUndPurchaseOrderResponse, error) {
+	out := new(MsgAmendUndPurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, Msg_AmendUndPurchaseOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferLockedEFUND(ctx context.Context, in *MsgTransferLockedEFUND, opts ...grpc.CallOption) (*MsgTransferLockedEFUNDResponse, error) {
+	out := new(MsgTransferLockedEFUNDResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferLockedEFUND_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LinkAddress(ctx context.Context, in *MsgLinkAddress, opts ...grpc.CallOption) (*MsgLinkAddressResponse, error) {
+	out := new(MsgLinkAddressResponse)
+	err := c.cc.Invoke(ctx, Msg_LinkAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddEntSigner(ctx context.Context, in *MsgAddEntSigner, opts ...grpc.CallOption) (*MsgAddEntSignerResponse, error) {
+	out := new(MsgAddEntSignerResponse)
+	err := c.cc.Invoke(ctx, Msg_AddEntSigner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveEntSigner(ctx context.Context, in *MsgRemoveEntSigner, opts ...grpc.CallOption) (*MsgRemoveEntSignerResponse, error) {
+	out := new(MsgRemoveEntSignerResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveEntSigner_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -95,6 +168,19 @@ type MsgServer interface {
 	ProcessUndPurchaseOrder(context.Context, *MsgProcessUndPurchaseOrder) (*MsgProcessUndPurchaseOrderResponse, error)
 	// WhitelistAddress defines a method to execute a whitelist action.
 	WhitelistAddress(context.Context, *MsgWhitelistAddress) (*MsgWhitelistAddressResponse, error)
+	// WithdrawUndPurchaseOrder defines a method for a purchaser to withdraw a raised purchase order.
+	WithdrawUndPurchaseOrder(context.Context, *MsgWithdrawUndPurchaseOrder) (*MsgWithdrawUndPurchaseOrderResponse, error)
+	// AmendUndPurchaseOrder defines a method for a purchaser to amend the amount of a raised purchase order.
+	AmendUndPurchaseOrder(context.Context, *MsgAmendUndPurchaseOrder) (*MsgAmendUndPurchaseOrderResponse, error)
+	// TransferLockedEFUND defines a method for moving locked eFUND to another whitelisted or linked address.
+	TransferLockedEFUND(context.Context, *MsgTransferLockedEFUND) (*MsgTransferLockedEFUNDResponse, error)
+	// LinkAddress defines a method for an ent signer to add/remove a link from an address to another address it can
+	// transfer locked eFUND to.
+	LinkAddress(context.Context, *MsgLinkAddress) (*MsgLinkAddressResponse, error)
+	// AddEntSigner defines a governance operation for adding an address to the purchase order signer registry.
+	AddEntSigner(context.Context, *MsgAddEntSigner) (*MsgAddEntSignerResponse, error)
+	// RemoveEntSigner defines a governance operation for removing an address from the purchase order signer registry.
+	RemoveEntSigner(context.Context, *MsgRemoveEntSigner) (*MsgRemoveEntSignerResponse, error)
 	// UpdateParams defines an operation for updating the x/enterprise module
 	// parameters.
 	// Since: cosmos-sdk 0.47
@@ -115,6 +201,24 @@ func (UnimplementedMsgServer) ProcessUndPurchaseOrder(context.Context, *MsgProce
 func (UnimplementedMsgServer) WhitelistAddress(context.Context, *MsgWhitelistAddress) (*MsgWhitelistAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WhitelistAddress not implemented")
 }
+func (UnimplementedMsgServer) WithdrawUndPurchaseOrder(context.Context, *MsgWithdrawUndPurchaseOrder) (*MsgWithdrawUndPurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawUndPurchaseOrder not implemented")
+}
+func (UnimplementedMsgServer) AmendUndPurchaseOrder(context.Context, *MsgAmendUndPurchaseOrder) (*MsgAmendUndPurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendUndPurchaseOrder not implemented")
+}
+func (UnimplementedMsgServer) TransferLockedEFUND(context.Context, *MsgTransferLockedEFUND) (*MsgTransferLockedEFUNDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLockedEFUND not implemented")
+}
+func (UnimplementedMsgServer) LinkAddress(context.Context, *MsgLinkAddress) (*MsgLinkAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkAddress not implemented")
+}
+func (UnimplementedMsgServer) AddEntSigner(context.Context, *MsgAddEntSigner) (*MsgAddEntSignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEntSigner not implemented")
+}
+func (UnimplementedMsgServer) RemoveEntSigner(context.Context, *MsgRemoveEntSigner) (*MsgRemoveEntSignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEntSigner not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -185,6 +289,114 @@ func _Msg_WhitelistAddress_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_WithdrawUndPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawUndPurchaseOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawUndPurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_WithdrawUndPurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawUndPurchaseOrder(ctx, req.(*MsgWithdrawUndPurchaseOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AmendUndPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendUndPurchaseOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendUndPurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AmendUndPurchaseOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendUndPurchaseOrder(ctx, req.(*MsgAmendUndPurchaseOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferLockedEFUND_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferLockedEFUND)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferLockedEFUND(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferLockedEFUND_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferLockedEFUND(ctx, req.(*MsgTransferLockedEFUND))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LinkAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLinkAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LinkAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_LinkAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LinkAddress(ctx, req.(*MsgLinkAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddEntSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddEntSigner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddEntSigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddEntSigner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddEntSigner(ctx, req.(*MsgAddEntSigner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveEntSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveEntSigner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveEntSigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveEntSigner_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveEntSigner(ctx, req.(*MsgRemoveEntSigner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -222,6 +434,30 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WhitelistAddress",
 			Handler:    _Msg_WhitelistAddress_Handler,
 		},
+		{
+			MethodName: "WithdrawUndPurchaseOrder",
+			Handler:    _Msg_WithdrawUndPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "AmendUndPurchaseOrder",
+			Handler:    _Msg_AmendUndPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "TransferLockedEFUND",
+			Handler:    _Msg_TransferLockedEFUND_Handler,
+		},
+		{
+			MethodName: "LinkAddress",
+			Handler:    _Msg_LinkAddress_Handler,
+		},
+		{
+			MethodName: "AddEntSigner",
+			Handler:    _Msg_AddEntSigner_Handler,
+		},
+		{
+			MethodName: "RemoveEntSigner",
+			Handler:    _Msg_RemoveEntSigner_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -9,6 +9,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -65,10 +66,167 @@ func (x *_GenesisState_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*StreamStats
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamStats)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamStats)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(StreamStats)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(StreamStats)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*StreamHistory
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamHistory)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamHistory)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(StreamHistory)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(StreamHistory)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*StreamAuditPrune
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamAuditPrune)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamAuditPrune)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(StreamAuditPrune)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(StreamAuditPrune)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState         protoreflect.MessageDescriptor
-	fd_GenesisState_params  protoreflect.FieldDescriptor
-	fd_GenesisState_streams protoreflect.FieldDescriptor
+	md_GenesisState                    protoreflect.MessageDescriptor
+	fd_GenesisState_params             protoreflect.FieldDescriptor
+	fd_GenesisState_streams            protoreflect.FieldDescriptor
+	fd_GenesisState_starting_stream_id protoreflect.FieldDescriptor
+	fd_GenesisState_stats              protoreflect.FieldDescriptor
+	fd_GenesisState_histories          protoreflect.FieldDescriptor
+	fd_GenesisState_audit_prunes       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -76,6 +234,10 @@ func init() {
 	md_GenesisState = File_mainchain_stream_v1_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_streams = md_GenesisState.Fields().ByName("streams")
+	fd_GenesisState_starting_stream_id = md_GenesisState.Fields().ByName("starting_stream_id")
+	fd_GenesisState_stats = md_GenesisState.Fields().ByName("stats")
+	fd_GenesisState_histories = md_GenesisState.Fields().ByName("histories")
+	fd_GenesisState_audit_prunes = md_GenesisState.Fields().ByName("audit_prunes")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -155,6 +317,30 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.StartingStreamId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StartingStreamId)
+		if !f(fd_GenesisState_starting_stream_id, value) {
+			return
+		}
+	}
+	if len(x.Stats) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.Stats})
+		if !f(fd_GenesisState_stats, value) {
+			return
+		}
+	}
+	if len(x.Histories) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Histories})
+		if !f(fd_GenesisState_histories, value) {
+			return
+		}
+	}
+	if len(x.AuditPrunes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.AuditPrunes})
+		if !f(fd_GenesisState_audit_prunes, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -174,6 +360,14 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Params != nil
 	case "mainchain.stream.v1.GenesisState.streams":
 		return len(x.Streams) != 0
+	case "mainchain.stream.v1.GenesisState.starting_stream_id":
+		return x.StartingStreamId != uint64(0)
+	case "mainchain.stream.v1.GenesisState.stats":
+		return len(x.Stats) != 0
+	case "mainchain.stream.v1.GenesisState.histories":
+		return len(x.Histories) != 0
+	case "mainchain.stream.v1.GenesisState.audit_prunes":
+		return len(x.AuditPrunes) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.GenesisState"))
@@ -194,6 +388,14 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Params = nil
 	case "mainchain.stream.v1.GenesisState.streams":
 		x.Streams = nil
+	case "mainchain.stream.v1.GenesisState.starting_stream_id":
+		x.StartingStreamId = uint64(0)
+	case "mainchain.stream.v1.GenesisState.stats":
+		x.Stats = nil
+	case "mainchain.stream.v1.GenesisState.histories":
+		x.Histories = nil
+	case "mainchain.stream.v1.GenesisState.audit_prunes":
+		x.AuditPrunes = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.GenesisState"))
@@ -219,6 +421,27 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 		}
 		listValue := &_GenesisState_2_list{list: &x.Streams}
 		return protoreflect.ValueOfList(listValue)
+	case "mainchain.stream.v1.GenesisState.starting_stream_id":
+		value := x.StartingStreamId
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.stream.v1.GenesisState.stats":
+		if len(x.Stats) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.Stats}
+		return protoreflect.ValueOfList(listValue)
+	case "mainchain.stream.v1.GenesisState.histories":
+		if len(x.Histories) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.Histories}
+		return protoreflect.ValueOfList(listValue)
+	case "mainchain.stream.v1.GenesisState.audit_prunes":
+		if len(x.AuditPrunes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.AuditPrunes}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.GenesisState"))
@@ -245,6 +468,20 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		lv := value.List()
 		clv := lv.(*_GenesisState_2_list)
 		x.Streams = *clv.list
+	case "mainchain.stream.v1.GenesisState.starting_stream_id":
+		x.StartingStreamId = value.Uint()
+	case "mainchain.stream.v1.GenesisState.stats":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.Stats = *clv.list
+	case "mainchain.stream.v1.GenesisState.histories":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.Histories = *clv.list
+	case "mainchain.stream.v1.GenesisState.audit_prunes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.AuditPrunes = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.GenesisState"))
@@ -276,6 +513,26 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 		}
 		value := &_GenesisState_2_list{list: &x.Streams}
 		return protoreflect.ValueOfList(value)
+	case "mainchain.stream.v1.GenesisState.stats":
+		if x.Stats == nil {
+			x.Stats = []*StreamStats{}
+		}
+		value := &_GenesisState_4_list{list: &x.Stats}
+		return protoreflect.ValueOfList(value)
+	case "mainchain.stream.v1.GenesisState.histories":
+		if x.Histories == nil {
+			x.Histories = []*StreamHistory{}
+		}
+		value := &_GenesisState_5_list{list: &x.Histories}
+		return protoreflect.ValueOfList(value)
+	case "mainchain.stream.v1.GenesisState.audit_prunes":
+		if x.AuditPrunes == nil {
+			x.AuditPrunes = []*StreamAuditPrune{}
+		}
+		value := &_GenesisState_6_list{list: &x.AuditPrunes}
+		return protoreflect.ValueOfList(value)
+	case "mainchain.stream.v1.GenesisState.starting_stream_id":
+		panic(fmt.Errorf("field starting_stream_id of message mainchain.stream.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.GenesisState"))
@@ -295,6 +552,17 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "mainchain.stream.v1.GenesisState.streams":
 		list := []*StreamExport{}
 		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "mainchain.stream.v1.GenesisState.starting_stream_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.stream.v1.GenesisState.stats":
+		list := []*StreamStats{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "mainchain.stream.v1.GenesisState.histories":
+		list := []*StreamHistory{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "mainchain.stream.v1.GenesisState.audit_prunes":
+		list := []*StreamAuditPrune{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.GenesisState"))
@@ -374,6 +642,27 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.StartingStreamId != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartingStreamId))
+		}
+		if len(x.Stats) > 0 {
+			for _, e := range x.Stats {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Histories) > 0 {
+			for _, e := range x.Histories {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AuditPrunes) > 0 {
+			for _, e := range x.AuditPrunes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -403,6 +692,59 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.AuditPrunes) > 0 {
+			for iNdEx := len(x.AuditPrunes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AuditPrunes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Histories) > 0 {
+			for iNdEx := len(x.Histories) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Histories[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Stats) > 0 {
+			for iNdEx := len(x.Stats) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Stats[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.StartingStreamId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartingStreamId))
+			i--
+			dAtA[i] = 0x18
+		}
 		if len(x.Streams) > 0 {
 			for iNdEx := len(x.Streams) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Streams[iNdEx])
@@ -518,9 +860,691 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Streams = append(x.Streams, &StreamExport{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Streams[len(x.Streams)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartingStreamId", wireType)
+				}
+				x.StartingStreamId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartingStreamId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Stats = append(x.Stats, &StreamStats{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stats[len(x.Stats)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Histories", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Histories = append(x.Histories, &StreamHistory{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Histories[len(x.Histories)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuditPrunes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuditPrunes = append(x.AuditPrunes, &StreamAuditPrune{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AuditPrunes[len(x.AuditPrunes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_StreamExport          protoreflect.MessageDescriptor
+	fd_StreamExport_receiver protoreflect.FieldDescriptor
+	fd_StreamExport_sender   protoreflect.FieldDescriptor
+	fd_StreamExport_stream   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_stream_v1_genesis_proto_init()
+	md_StreamExport = File_mainchain_stream_v1_genesis_proto.Messages().ByName("StreamExport")
+	fd_StreamExport_receiver = md_StreamExport.Fields().ByName("receiver")
+	fd_StreamExport_sender = md_StreamExport.Fields().ByName("sender")
+	fd_StreamExport_stream = md_StreamExport.Fields().ByName("stream")
+}
+
+var _ protoreflect.Message = (*fastReflection_StreamExport)(nil)
+
+type fastReflection_StreamExport StreamExport
+
+func (x *StreamExport) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StreamExport)(x)
+}
+
+func (x *StreamExport) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_stream_v1_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StreamExport_messageType fastReflection_StreamExport_messageType
+var _ protoreflect.MessageType = fastReflection_StreamExport_messageType{}
+
+type fastReflection_StreamExport_messageType struct{}
+
+func (x fastReflection_StreamExport_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StreamExport)(nil)
+}
+func (x fastReflection_StreamExport_messageType) New() protoreflect.Message {
+	return new(fastReflection_StreamExport)
+}
+func (x fastReflection_StreamExport_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StreamExport
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StreamExport) Descriptor() protoreflect.MessageDescriptor {
+	return md_StreamExport
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StreamExport) Type() protoreflect.MessageType {
+	return _fastReflection_StreamExport_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StreamExport) New() protoreflect.Message {
+	return new(fastReflection_StreamExport)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StreamExport) Interface() protoreflect.ProtoMessage {
+	return (*StreamExport)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StreamExport) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Receiver != "" {
+		value := protoreflect.ValueOfString(x.Receiver)
+		if !f(fd_StreamExport_receiver, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_StreamExport_sender, value) {
+			return
+		}
+	}
+	if x.Stream != nil {
+		value := protoreflect.ValueOfMessage(x.Stream.ProtoReflect())
+		if !f(fd_StreamExport_stream, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StreamExport) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.StreamExport.receiver":
+		return x.Receiver != ""
+	case "mainchain.stream.v1.StreamExport.sender":
+		return x.Sender != ""
+	case "mainchain.stream.v1.StreamExport.stream":
+		return x.Stream != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamExport"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamExport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StreamExport) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.StreamExport.receiver":
+		x.Receiver = ""
+	case "mainchain.stream.v1.StreamExport.sender":
+		x.Sender = ""
+	case "mainchain.stream.v1.StreamExport.stream":
+		x.Stream = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamExport"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamExport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StreamExport) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.stream.v1.StreamExport.receiver":
+		value := x.Receiver
+		return protoreflect.ValueOfString(value)
+	case "mainchain.stream.v1.StreamExport.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "mainchain.stream.v1.StreamExport.stream":
+		value := x.Stream
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamExport"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamExport does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StreamExport) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.StreamExport.receiver":
+		x.Receiver = value.Interface().(string)
+	case "mainchain.stream.v1.StreamExport.sender":
+		x.Sender = value.Interface().(string)
+	case "mainchain.stream.v1.StreamExport.stream":
+		x.Stream = value.Message().Interface().(*Stream)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamExport"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamExport does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StreamExport) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.StreamExport.stream":
+		if x.Stream == nil {
+			x.Stream = new(Stream)
+		}
+		return protoreflect.ValueOfMessage(x.Stream.ProtoReflect())
+	case "mainchain.stream.v1.StreamExport.receiver":
+		panic(fmt.Errorf("field receiver of message mainchain.stream.v1.StreamExport is not mutable"))
+	case "mainchain.stream.v1.StreamExport.sender":
+		panic(fmt.Errorf("field sender of message mainchain.stream.v1.StreamExport is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamExport"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamExport does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StreamExport) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.StreamExport.receiver":
+		return protoreflect.ValueOfString("")
+	case "mainchain.stream.v1.StreamExport.sender":
+		return protoreflect.ValueOfString("")
+	case "mainchain.stream.v1.StreamExport.stream":
+		m := new(Stream)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamExport"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamExport does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StreamExport) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.stream.v1.StreamExport", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StreamExport) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StreamExport) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StreamExport) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StreamExport) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StreamExport)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Receiver)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Stream != nil {
+			l = options.Size(x.Stream)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StreamExport)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Stream != nil {
+			encoded, err := options.Marshal(x.Stream)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Receiver) > 0 {
+			i -= len(x.Receiver)
+			copy(dAtA[i:], x.Receiver)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Receiver)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StreamExport)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StreamExport: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StreamExport: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Receiver = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -547,8 +1571,10 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Streams = append(x.Streams, &StreamExport{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Streams[len(x.Streams)-1]); err != nil {
+				if x.Stream == nil {
+					x.Stream = &Stream{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stream); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -588,30 +1614,28 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_StreamExport          protoreflect.MessageDescriptor
-	fd_StreamExport_receiver protoreflect.FieldDescriptor
-	fd_StreamExport_sender   protoreflect.FieldDescriptor
-	fd_StreamExport_stream   protoreflect.FieldDescriptor
+	md_StreamAuditPrune            protoreflect.MessageDescriptor
+	fd_StreamAuditPrune_stream_id  protoreflect.FieldDescriptor
+	fd_StreamAuditPrune_prune_time protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mainchain_stream_v1_genesis_proto_init()
-	md_StreamExport = File_mainchain_stream_v1_genesis_proto.Messages().ByName("StreamExport")
-	fd_StreamExport_receiver = md_StreamExport.Fields().ByName("receiver")
-	fd_StreamExport_sender = md_StreamExport.Fields().ByName("sender")
-	fd_StreamExport_stream = md_StreamExport.Fields().ByName("stream")
+	md_StreamAuditPrune = File_mainchain_stream_v1_genesis_proto.Messages().ByName("StreamAuditPrune")
+	fd_StreamAuditPrune_stream_id = md_StreamAuditPrune.Fields().ByName("stream_id")
+	fd_StreamAuditPrune_prune_time = md_StreamAuditPrune.Fields().ByName("prune_time")
 }
 
-var _ protoreflect.Message = (*fastReflection_StreamExport)(nil)
+var _ protoreflect.Message = (*fastReflection_StreamAuditPrune)(nil)
 
-type fastReflection_StreamExport StreamExport
+type fastReflection_StreamAuditPrune StreamAuditPrune
 
-func (x *StreamExport) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_StreamExport)(x)
+func (x *StreamAuditPrune) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StreamAuditPrune)(x)
 }
 
-func (x *StreamExport) slowProtoReflect() protoreflect.Message {
-	mi := &file_mainchain_stream_v1_genesis_proto_msgTypes[1]
+func (x *StreamAuditPrune) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_stream_v1_genesis_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,43 +1646,43 @@ func (x *StreamExport) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_StreamExport_messageType fastReflection_StreamExport_messageType
-var _ protoreflect.MessageType = fastReflection_StreamExport_messageType{}
+var _fastReflection_StreamAuditPrune_messageType fastReflection_StreamAuditPrune_messageType
+var _ protoreflect.MessageType = fastReflection_StreamAuditPrune_messageType{}
 
-type fastReflection_StreamExport_messageType struct{}
+type fastReflection_StreamAuditPrune_messageType struct{}
 
-func (x fastReflection_StreamExport_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_StreamExport)(nil)
+func (x fastReflection_StreamAuditPrune_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StreamAuditPrune)(nil)
 }
-func (x fastReflection_StreamExport_messageType) New() protoreflect.Message {
-	return new(fastReflection_StreamExport)
+func (x fastReflection_StreamAuditPrune_messageType) New() protoreflect.Message {
+	return new(fastReflection_StreamAuditPrune)
 }
-func (x fastReflection_StreamExport_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_StreamExport
+func (x fastReflection_StreamAuditPrune_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StreamAuditPrune
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_StreamExport) Descriptor() protoreflect.MessageDescriptor {
-	return md_StreamExport
+func (x *fastReflection_StreamAuditPrune) Descriptor() protoreflect.MessageDescriptor {
+	return md_StreamAuditPrune
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_StreamExport) Type() protoreflect.MessageType {
-	return _fastReflection_StreamExport_messageType
+func (x *fastReflection_StreamAuditPrune) Type() protoreflect.MessageType {
+	return _fastReflection_StreamAuditPrune_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_StreamExport) New() protoreflect.Message {
-	return new(fastReflection_StreamExport)
+func (x *fastReflection_StreamAuditPrune) New() protoreflect.Message {
+	return new(fastReflection_StreamAuditPrune)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_StreamExport) Interface() protoreflect.ProtoMessage {
-	return (*StreamExport)(x)
+func (x *fastReflection_StreamAuditPrune) Interface() protoreflect.ProtoMessage {
+	return (*StreamAuditPrune)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -666,22 +1690,16 @@ func (x *fastReflection_StreamExport) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_StreamExport) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Receiver != "" {
-		value := protoreflect.ValueOfString(x.Receiver)
-		if !f(fd_StreamExport_receiver, value) {
-			return
-		}
-	}
-	if x.Sender != "" {
-		value := protoreflect.ValueOfString(x.Sender)
-		if !f(fd_StreamExport_sender, value) {
+func (x *fastReflection_StreamAuditPrune) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StreamId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StreamId)
+		if !f(fd_StreamAuditPrune_stream_id, value) {
 			return
 		}
 	}
-	if x.Stream != nil {
-		value := protoreflect.ValueOfMessage(x.Stream.ProtoReflect())
-		if !f(fd_StreamExport_stream, value) {
+	if x.PruneTime != nil {
+		value := protoreflect.ValueOfMessage(x.PruneTime.ProtoReflect())
+		if !f(fd_StreamAuditPrune_prune_time, value) {
 			return
 		}
 	}
@@ -698,19 +1716,17 @@ func (x *fastReflection_StreamExport) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_StreamExport) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_StreamAuditPrune) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "mainchain.stream.v1.StreamExport.receiver":
-		return x.Receiver != ""
-	case "mainchain.stream.v1.StreamExport.sender":
-		return x.Sender != ""
-	case "mainchain.stream.v1.StreamExport.stream":
-		return x.Stream != nil
+	case "mainchain.stream.v1.StreamAuditPrune.stream_id":
+		return x.StreamId != uint64(0)
+	case "mainchain.stream.v1.StreamAuditPrune.prune_time":
+		return x.PruneTime != nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamExport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamAuditPrune"))
 		}
-		panic(fmt.Errorf("message mainchain.stream.v1.StreamExport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamAuditPrune does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -720,19 +1736,17 @@ func (x *fastReflection_StreamExport) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_StreamExport) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_StreamAuditPrune) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "mainchain.stream.v1.StreamExport.receiver":
-		x.Receiver = ""
-	case "mainchain.stream.v1.StreamExport.sender":
-		x.Sender = ""
-	case "mainchain.stream.v1.StreamExport.stream":
-		x.Stream = nil
+	case "mainchain.stream.v1.StreamAuditPrune.stream_id":
+		x.StreamId = uint64(0)
+	case "mainchain.stream.v1.StreamAuditPrune.prune_time":
+		x.PruneTime = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamExport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamAuditPrune"))
 		}
-		panic(fmt.Errorf("message mainchain.stream.v1.StreamExport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamAuditPrune does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -742,22 +1756,19 @@ func (x *fastReflection_StreamExport) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_StreamExport) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StreamAuditPrune) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "mainchain.stream.v1.StreamExport.receiver":
-		value := x.Receiver
-		return protoreflect.ValueOfString(value)
-	case "mainchain.stream.v1.StreamExport.sender":
-		value := x.Sender
-		return protoreflect.ValueOfString(value)
-	case "mainchain.stream.v1.StreamExport.stream":
-		value := x.Stream
+	case "mainchain.stream.v1.StreamAuditPrune.stream_id":
+		value := x.StreamId
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.stream.v1.StreamAuditPrune.prune_time":
+		value := x.PruneTime
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamExport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamAuditPrune"))
 		}
-		panic(fmt.Errorf("message mainchain.stream.v1.StreamExport does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamAuditPrune does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -771,19 +1782,17 @@ func (x *fastReflection_StreamExport) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_StreamExport) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_StreamAuditPrune) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "mainchain.stream.v1.StreamExport.receiver":
-		x.Receiver = value.Interface().(string)
-	case "mainchain.stream.v1.StreamExport.sender":
-		x.Sender = value.Interface().(string)
-	case "mainchain.stream.v1.StreamExport.stream":
-		x.Stream = value.Message().Interface().(*Stream)
+	case "mainchain.stream.v1.StreamAuditPrune.stream_id":
+		x.StreamId = value.Uint()
+	case "mainchain.stream.v1.StreamAuditPrune.prune_time":
+		x.PruneTime = value.Message().Interface().(*timestamppb.Timestamp)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamExport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamAuditPrune"))
 		}
-		panic(fmt.Errorf("message mainchain.stream.v1.StreamExport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamAuditPrune does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -797,52 +1806,48 @@ func (x *fastReflection_StreamExport) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_StreamExport) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StreamAuditPrune) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.stream.v1.StreamExport.stream":
-		if x.Stream == nil {
-			x.Stream = new(Stream)
+	case "mainchain.stream.v1.StreamAuditPrune.prune_time":
+		if x.PruneTime == nil {
+			x.PruneTime = new(timestamppb.Timestamp)
 		}
-		return protoreflect.ValueOfMessage(x.Stream.ProtoReflect())
-	case "mainchain.stream.v1.StreamExport.receiver":
-		panic(fmt.Errorf("field receiver of message mainchain.stream.v1.StreamExport is not mutable"))
-	case "mainchain.stream.v1.StreamExport.sender":
-		panic(fmt.Errorf("field sender of message mainchain.stream.v1.StreamExport is not mutable"))
+		return protoreflect.ValueOfMessage(x.PruneTime.ProtoReflect())
+	case "mainchain.stream.v1.StreamAuditPrune.stream_id":
+		panic(fmt.Errorf("field stream_id of message mainchain.stream.v1.StreamAuditPrune is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamExport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamAuditPrune"))
 		}
-		panic(fmt.Errorf("message mainchain.stream.v1.StreamExport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamAuditPrune does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_StreamExport) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_StreamAuditPrune) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "mainchain.stream.v1.StreamExport.receiver":
-		return protoreflect.ValueOfString("")
-	case "mainchain.stream.v1.StreamExport.sender":
-		return protoreflect.ValueOfString("")
-	case "mainchain.stream.v1.StreamExport.stream":
-		m := new(Stream)
+	case "mainchain.stream.v1.StreamAuditPrune.stream_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.stream.v1.StreamAuditPrune.prune_time":
+		m := new(timestamppb.Timestamp)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamExport"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamAuditPrune"))
 		}
-		panic(fmt.Errorf("message mainchain.stream.v1.StreamExport does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamAuditPrune does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_StreamExport) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_StreamAuditPrune) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in mainchain.stream.v1.StreamExport", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.stream.v1.StreamAuditPrune", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -850,7 +1855,7 @@ func (x *fastReflection_StreamExport) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_StreamExport) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_StreamAuditPrune) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -861,7 +1866,7 @@ func (x *fastReflection_StreamExport) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_StreamExport) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_StreamAuditPrune) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -873,7 +1878,7 @@ func (x *fastReflection_StreamExport) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_StreamExport) IsValid() bool {
+func (x *fastReflection_StreamAuditPrune) IsValid() bool {
 	return x != nil
 }
 
@@ -883,9 +1888,9 @@ func (x *fastReflection_StreamExport) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_StreamExport) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_StreamAuditPrune) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*StreamExport)
+		x := input.Message.Interface().(*StreamAuditPrune)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -897,16 +1902,11 @@ func (x *fastReflection_StreamExport) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Receiver)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Sender)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.StreamId != 0 {
+			n += 1 + runtime.Sov(uint64(x.StreamId))
 		}
-		if x.Stream != nil {
-			l = options.Size(x.Stream)
+		if x.PruneTime != nil {
+			l = options.Size(x.PruneTime)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
@@ -919,7 +1919,7 @@ func (x *fastReflection_StreamExport) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*StreamExport)
+		x := input.Message.Interface().(*StreamAuditPrune)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -938,8 +1938,8 @@ func (x *fastReflection_StreamExport) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Stream != nil {
-			encoded, err := options.Marshal(x.Stream)
+		if x.PruneTime != nil {
+			encoded, err := options.Marshal(x.PruneTime)
 			if err != nil {
 				return protoiface.MarshalOutput{
 					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -950,21 +1950,12 @@ func (x *fastReflection_StreamExport) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], encoded)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Sender) > 0 {
-			i -= len(x.Sender)
-			copy(dAtA[i:], x.Sender)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
-			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Receiver) > 0 {
-			i -= len(x.Receiver)
-			copy(dAtA[i:], x.Receiver)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Receiver)))
+		if x.StreamId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StreamId))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -977,7 +1968,7 @@ func (x *fastReflection_StreamExport) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*StreamExport)
+		x := input.Message.Interface().(*StreamAuditPrune)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1009,17 +2000,17 @@ func (x *fastReflection_StreamExport) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StreamExport: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StreamAuditPrune: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StreamExport: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StreamAuditPrune: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 				}
-				var stringLen uint64
+				x.StreamId = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1029,59 +2020,14 @@ func (x *fastReflection_StreamExport) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.StreamId |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Receiver = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Sender = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PruneTime", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1108,10 +2054,10 @@ func (x *fastReflection_StreamExport) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Stream == nil {
-					x.Stream = &Stream{}
+				if x.PruneTime == nil {
+					x.PruneTime = &timestamppb.Timestamp{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stream); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PruneTime); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1171,6 +2117,14 @@ type GenesisState struct {
 
 	Params  *Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
 	Streams []*StreamExport `protobuf:"bytes,2,rep,name=streams,proto3" json:"streams,omitempty"`
+	// starting_stream_id is the ID that will be assigned to the next new stream
+	StartingStreamId uint64 `protobuf:"varint,3,opt,name=starting_stream_id,json=startingStreamId,proto3" json:"starting_stream_id,omitempty"`
+	// stats are the cumulative claim statistics for streams
+	Stats []*StreamStats `protobuf:"bytes,4,rep,name=stats,proto3" json:"stats,omitempty"`
+	// histories are the flow rate change histories for streams
+	Histories []*StreamHistory `protobuf:"bytes,5,rep,name=histories,proto3" json:"histories,omitempty"`
+	// audit_prunes are the times deleted streams' claim statistics and flow rate change histories are pruned
+	AuditPrunes []*StreamAuditPrune `protobuf:"bytes,6,rep,name=audit_prunes,json=auditPrunes,proto3" json:"audit_prunes,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1207,6 +2161,34 @@ func (x *GenesisState) GetStreams() []*StreamExport {
 	return nil
 }
 
+func (x *GenesisState) GetStartingStreamId() uint64 {
+	if x != nil {
+		return x.StartingStreamId
+	}
+	return 0
+}
+
+func (x *GenesisState) GetStats() []*StreamStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+func (x *GenesisState) GetHistories() []*StreamHistory {
+	if x != nil {
+		return x.Histories
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAuditPrunes() []*StreamAuditPrune {
+	if x != nil {
+		return x.AuditPrunes
+	}
+	return nil
+}
+
 // StreamExport holds genesis export data for a stream
 type StreamExport struct {
 	state         protoimpl.MessageState
@@ -1262,6 +2244,52 @@ func (x *StreamExport) GetStream() *Stream {
 	return nil
 }
 
+// StreamAuditPrune holds the time a deleted stream's audit data is pruned
+type StreamAuditPrune struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// stream_id is the ID of the deleted stream
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// prune_time is the time the deleted stream's claim statistics and flow rate change history are pruned
+	PruneTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=prune_time,json=pruneTime,proto3" json:"prune_time,omitempty"`
+}
+
+func (x *StreamAuditPrune) Reset() {
+	*x = StreamAuditPrune{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_stream_v1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamAuditPrune) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamAuditPrune) ProtoMessage() {}
+
+// Deprecated: Use StreamAuditPrune.ProtoReflect.Descriptor instead.
+func (*StreamAuditPrune) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *StreamAuditPrune) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *StreamAuditPrune) GetPruneTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PruneTime
+	}
+	return nil
+}
+
 var File_mainchain_stream_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_mainchain_stream_v1_genesis_proto_rawDesc = []byte{
@@ -1271,44 +2299,70 @@ var file_mainchain_stream_v1_genesis_proto_rawDesc = []byte{
 	0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x6d, 0x61, 0x69, 0x6e, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x70,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x6d, 0x61, 0x69,
-	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31,
-	0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8c, 0x01,
-	0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39,
-	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
-	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f,
-	0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x69,
+	0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x6d, 0x61, 0x69, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f,
+	0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x6d, 0x61,
+	0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76,
+	0x31, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x90,
+	0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x41, 0x0a, 0x07, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61,
+	0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x2c, 0x0a,
+	0x12, 0x73, 0x74, 0x61, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x61, 0x69,
+	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x04, 0xc8, 0xde,
+	0x1f, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x12, 0x46, 0x0a, 0x09, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d,
+	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x09, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x4e, 0x0a, 0x0c, 0x61, 0x75, 0x64, 0x69, 0x74, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x41, 0x75, 0x64, 0x69, 0x74, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x61, 0x75, 0x64, 0x69, 0x74, 0x50, 0x72, 0x75, 0x6e, 0x65,
+	0x73, 0x22, 0xb1, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
+	0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x69,
 	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x04, 0xc8,
-	0xde, 0x1f, 0x00, 0x52, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x22, 0xb1, 0x01, 0x0a,
-	0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x34, 0x0a,
-	0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x42, 0xc4, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d,
-	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f,
-	0x76, 0x31, 0x3b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x53,
-	0x58, 0xaa, 0x02, 0x13, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f,
-	0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x15, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x74, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x43, 0x0a, 0x0a, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01,
+	0x52, 0x09, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x42, 0xc4, 0x01, 0x0a, 0x17,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x53, 0x58, 0xaa, 0x02, 0x13, 0x4d,
+	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x13, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4d, 0x61, 0x69, 0x6e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4d, 0x61, 0x69,
+	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1323,22 +2377,30 @@ func file_mainchain_stream_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_mainchain_stream_v1_genesis_proto_rawDescData
 }
 
-var file_mainchain_stream_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_mainchain_stream_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_mainchain_stream_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: mainchain.stream.v1.GenesisState
-	(*StreamExport)(nil), // 1: mainchain.stream.v1.StreamExport
-	(*Params)(nil),       // 2: mainchain.stream.v1.Params
-	(*Stream)(nil),       // 3: mainchain.stream.v1.Stream
+	(*GenesisState)(nil),          // 0: mainchain.stream.v1.GenesisState
+	(*StreamExport)(nil),          // 1: mainchain.stream.v1.StreamExport
+	(*StreamAuditPrune)(nil),      // 2: mainchain.stream.v1.StreamAuditPrune
+	(*Params)(nil),                // 3: mainchain.stream.v1.Params
+	(*StreamStats)(nil),           // 4: mainchain.stream.v1.StreamStats
+	(*StreamHistory)(nil),         // 5: mainchain.stream.v1.StreamHistory
+	(*Stream)(nil),                // 6: mainchain.stream.v1.Stream
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
 }
 var file_mainchain_stream_v1_genesis_proto_depIdxs = []int32{
-	2, // 0: mainchain.stream.v1.GenesisState.params:type_name -> mainchain.stream.v1.Params
+	3, // 0: mainchain.stream.v1.GenesisState.params:type_name -> mainchain.stream.v1.Params
 	1, // 1: mainchain.stream.v1.GenesisState.streams:type_name -> mainchain.stream.v1.StreamExport
-	3, // 2: mainchain.stream.v1.StreamExport.stream:type_name -> mainchain.stream.v1.Stream
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	4, // 2: mainchain.stream.v1.GenesisState.stats:type_name -> mainchain.stream.v1.StreamStats
+	5, // 3: mainchain.stream.v1.GenesisState.histories:type_name -> mainchain.stream.v1.StreamHistory
+	2, // 4: mainchain.stream.v1.GenesisState.audit_prunes:type_name -> mainchain.stream.v1.StreamAuditPrune
+	6, // 5: mainchain.stream.v1.StreamExport.stream:type_name -> mainchain.stream.v1.Stream
+	7, // 6: mainchain.stream.v1.StreamAuditPrune.prune_time:type_name -> google.protobuf.Timestamp
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_mainchain_stream_v1_genesis_proto_init() }
@@ -1373,6 +2435,18 @@ func file_mainchain_stream_v1_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_mainchain_stream_v1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamAuditPrune); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1380,7 +2454,7 @@ func file_mainchain_stream_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mainchain_stream_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
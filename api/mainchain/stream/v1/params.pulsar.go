@@ -3,6 +3,7 @@ package streamv1
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -15,15 +16,130 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_2_list)(nil)
+
+type _Params_2_list struct {
+	list *[]string
+}
+
+func (x *_Params_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedDenoms as it is not of Message kind"))
+}
+
+func (x *_Params_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_4_list)(nil)
+
+type _Params_4_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_Params_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params               protoreflect.MessageDescriptor
-	fd_Params_validator_fee protoreflect.FieldDescriptor
+	md_Params                        protoreflect.MessageDescriptor
+	fd_Params_validator_fee          protoreflect.FieldDescriptor
+	fd_Params_allowed_denoms         protoreflect.FieldDescriptor
+	fd_Params_min_duration           protoreflect.FieldDescriptor
+	fd_Params_min_deposit            protoreflect.FieldDescriptor
+	fd_Params_max_flow_rate          protoreflect.FieldDescriptor
+	fd_Params_fee_collector_ratio    protoreflect.FieldDescriptor
+	fd_Params_community_pool_ratio   protoreflect.FieldDescriptor
+	fd_Params_burn_ratio             protoreflect.FieldDescriptor
+	fd_Params_min_termination_notice protoreflect.FieldDescriptor
+	fd_Params_max_claim_fee_ratio    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_mainchain_stream_v1_params_proto_init()
 	md_Params = File_mainchain_stream_v1_params_proto.Messages().ByName("Params")
 	fd_Params_validator_fee = md_Params.Fields().ByName("validator_fee")
+	fd_Params_allowed_denoms = md_Params.Fields().ByName("allowed_denoms")
+	fd_Params_min_duration = md_Params.Fields().ByName("min_duration")
+	fd_Params_min_deposit = md_Params.Fields().ByName("min_deposit")
+	fd_Params_max_flow_rate = md_Params.Fields().ByName("max_flow_rate")
+	fd_Params_fee_collector_ratio = md_Params.Fields().ByName("fee_collector_ratio")
+	fd_Params_community_pool_ratio = md_Params.Fields().ByName("community_pool_ratio")
+	fd_Params_burn_ratio = md_Params.Fields().ByName("burn_ratio")
+	fd_Params_min_termination_notice = md_Params.Fields().ByName("min_termination_notice")
+	fd_Params_max_claim_fee_ratio = md_Params.Fields().ByName("max_claim_fee_ratio")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -97,6 +213,60 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.AllowedDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_2_list{list: &x.AllowedDenoms})
+		if !f(fd_Params_allowed_denoms, value) {
+			return
+		}
+	}
+	if x.MinDuration != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinDuration)
+		if !f(fd_Params_min_duration, value) {
+			return
+		}
+	}
+	if len(x.MinDeposit) != 0 {
+		value := protoreflect.ValueOfList(&_Params_4_list{list: &x.MinDeposit})
+		if !f(fd_Params_min_deposit, value) {
+			return
+		}
+	}
+	if x.MaxFlowRate != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxFlowRate)
+		if !f(fd_Params_max_flow_rate, value) {
+			return
+		}
+	}
+	if x.FeeCollectorRatio != "" {
+		value := protoreflect.ValueOfString(x.FeeCollectorRatio)
+		if !f(fd_Params_fee_collector_ratio, value) {
+			return
+		}
+	}
+	if x.CommunityPoolRatio != "" {
+		value := protoreflect.ValueOfString(x.CommunityPoolRatio)
+		if !f(fd_Params_community_pool_ratio, value) {
+			return
+		}
+	}
+	if x.BurnRatio != "" {
+		value := protoreflect.ValueOfString(x.BurnRatio)
+		if !f(fd_Params_burn_ratio, value) {
+			return
+		}
+	}
+	if x.MinTerminationNotice != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MinTerminationNotice)
+		if !f(fd_Params_min_termination_notice, value) {
+			return
+		}
+	}
+	if x.MaxClaimFeeRatio != "" {
+		value := protoreflect.ValueOfString(x.MaxClaimFeeRatio)
+		if !f(fd_Params_max_claim_fee_ratio, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -114,6 +284,24 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "mainchain.stream.v1.Params.validator_fee":
 		return x.ValidatorFee != ""
+	case "mainchain.stream.v1.Params.allowed_denoms":
+		return len(x.AllowedDenoms) != 0
+	case "mainchain.stream.v1.Params.min_duration":
+		return x.MinDuration != uint64(0)
+	case "mainchain.stream.v1.Params.min_deposit":
+		return len(x.MinDeposit) != 0
+	case "mainchain.stream.v1.Params.max_flow_rate":
+		return x.MaxFlowRate != int64(0)
+	case "mainchain.stream.v1.Params.fee_collector_ratio":
+		return x.FeeCollectorRatio != ""
+	case "mainchain.stream.v1.Params.community_pool_ratio":
+		return x.CommunityPoolRatio != ""
+	case "mainchain.stream.v1.Params.burn_ratio":
+		return x.BurnRatio != ""
+	case "mainchain.stream.v1.Params.min_termination_notice":
+		return x.MinTerminationNotice != uint64(0)
+	case "mainchain.stream.v1.Params.max_claim_fee_ratio":
+		return x.MaxClaimFeeRatio != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.Params"))
@@ -132,6 +320,24 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "mainchain.stream.v1.Params.validator_fee":
 		x.ValidatorFee = ""
+	case "mainchain.stream.v1.Params.allowed_denoms":
+		x.AllowedDenoms = nil
+	case "mainchain.stream.v1.Params.min_duration":
+		x.MinDuration = uint64(0)
+	case "mainchain.stream.v1.Params.min_deposit":
+		x.MinDeposit = nil
+	case "mainchain.stream.v1.Params.max_flow_rate":
+		x.MaxFlowRate = int64(0)
+	case "mainchain.stream.v1.Params.fee_collector_ratio":
+		x.FeeCollectorRatio = ""
+	case "mainchain.stream.v1.Params.community_pool_ratio":
+		x.CommunityPoolRatio = ""
+	case "mainchain.stream.v1.Params.burn_ratio":
+		x.BurnRatio = ""
+	case "mainchain.stream.v1.Params.min_termination_notice":
+		x.MinTerminationNotice = uint64(0)
+	case "mainchain.stream.v1.Params.max_claim_fee_ratio":
+		x.MaxClaimFeeRatio = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.Params"))
@@ -151,6 +357,39 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "mainchain.stream.v1.Params.validator_fee":
 		value := x.ValidatorFee
 		return protoreflect.ValueOfString(value)
+	case "mainchain.stream.v1.Params.allowed_denoms":
+		if len(x.AllowedDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_2_list{})
+		}
+		listValue := &_Params_2_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "mainchain.stream.v1.Params.min_duration":
+		value := x.MinDuration
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.stream.v1.Params.min_deposit":
+		if len(x.MinDeposit) == 0 {
+			return protoreflect.ValueOfList(&_Params_4_list{})
+		}
+		listValue := &_Params_4_list{list: &x.MinDeposit}
+		return protoreflect.ValueOfList(listValue)
+	case "mainchain.stream.v1.Params.max_flow_rate":
+		value := x.MaxFlowRate
+		return protoreflect.ValueOfInt64(value)
+	case "mainchain.stream.v1.Params.fee_collector_ratio":
+		value := x.FeeCollectorRatio
+		return protoreflect.ValueOfString(value)
+	case "mainchain.stream.v1.Params.community_pool_ratio":
+		value := x.CommunityPoolRatio
+		return protoreflect.ValueOfString(value)
+	case "mainchain.stream.v1.Params.burn_ratio":
+		value := x.BurnRatio
+		return protoreflect.ValueOfString(value)
+	case "mainchain.stream.v1.Params.min_termination_notice":
+		value := x.MinTerminationNotice
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.stream.v1.Params.max_claim_fee_ratio":
+		value := x.MaxClaimFeeRatio
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.Params"))
@@ -173,6 +412,28 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 	switch fd.FullName() {
 	case "mainchain.stream.v1.Params.validator_fee":
 		x.ValidatorFee = value.Interface().(string)
+	case "mainchain.stream.v1.Params.allowed_denoms":
+		lv := value.List()
+		clv := lv.(*_Params_2_list)
+		x.AllowedDenoms = *clv.list
+	case "mainchain.stream.v1.Params.min_duration":
+		x.MinDuration = value.Uint()
+	case "mainchain.stream.v1.Params.min_deposit":
+		lv := value.List()
+		clv := lv.(*_Params_4_list)
+		x.MinDeposit = *clv.list
+	case "mainchain.stream.v1.Params.max_flow_rate":
+		x.MaxFlowRate = value.Int()
+	case "mainchain.stream.v1.Params.fee_collector_ratio":
+		x.FeeCollectorRatio = value.Interface().(string)
+	case "mainchain.stream.v1.Params.community_pool_ratio":
+		x.CommunityPoolRatio = value.Interface().(string)
+	case "mainchain.stream.v1.Params.burn_ratio":
+		x.BurnRatio = value.Interface().(string)
+	case "mainchain.stream.v1.Params.min_termination_notice":
+		x.MinTerminationNotice = value.Uint()
+	case "mainchain.stream.v1.Params.max_claim_fee_ratio":
+		x.MaxClaimFeeRatio = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.Params"))
@@ -193,8 +454,34 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "mainchain.stream.v1.Params.allowed_denoms":
+		if x.AllowedDenoms == nil {
+			x.AllowedDenoms = []string{}
+		}
+		value := &_Params_2_list{list: &x.AllowedDenoms}
+		return protoreflect.ValueOfList(value)
+	case "mainchain.stream.v1.Params.min_deposit":
+		if x.MinDeposit == nil {
+			x.MinDeposit = []*v1beta1.Coin{}
+		}
+		value := &_Params_4_list{list: &x.MinDeposit}
+		return protoreflect.ValueOfList(value)
 	case "mainchain.stream.v1.Params.validator_fee":
 		panic(fmt.Errorf("field validator_fee of message mainchain.stream.v1.Params is not mutable"))
+	case "mainchain.stream.v1.Params.min_duration":
+		panic(fmt.Errorf("field min_duration of message mainchain.stream.v1.Params is not mutable"))
+	case "mainchain.stream.v1.Params.max_flow_rate":
+		panic(fmt.Errorf("field max_flow_rate of message mainchain.stream.v1.Params is not mutable"))
+	case "mainchain.stream.v1.Params.fee_collector_ratio":
+		panic(fmt.Errorf("field fee_collector_ratio of message mainchain.stream.v1.Params is not mutable"))
+	case "mainchain.stream.v1.Params.community_pool_ratio":
+		panic(fmt.Errorf("field community_pool_ratio of message mainchain.stream.v1.Params is not mutable"))
+	case "mainchain.stream.v1.Params.burn_ratio":
+		panic(fmt.Errorf("field burn_ratio of message mainchain.stream.v1.Params is not mutable"))
+	case "mainchain.stream.v1.Params.min_termination_notice":
+		panic(fmt.Errorf("field min_termination_notice of message mainchain.stream.v1.Params is not mutable"))
+	case "mainchain.stream.v1.Params.max_claim_fee_ratio":
+		panic(fmt.Errorf("field max_claim_fee_ratio of message mainchain.stream.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.Params"))
@@ -210,6 +497,26 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	switch fd.FullName() {
 	case "mainchain.stream.v1.Params.validator_fee":
 		return protoreflect.ValueOfString("")
+	case "mainchain.stream.v1.Params.allowed_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_2_list{list: &list})
+	case "mainchain.stream.v1.Params.min_duration":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.stream.v1.Params.min_deposit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_Params_4_list{list: &list})
+	case "mainchain.stream.v1.Params.max_flow_rate":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "mainchain.stream.v1.Params.fee_collector_ratio":
+		return protoreflect.ValueOfString("")
+	case "mainchain.stream.v1.Params.community_pool_ratio":
+		return protoreflect.ValueOfString("")
+	case "mainchain.stream.v1.Params.burn_ratio":
+		return protoreflect.ValueOfString("")
+	case "mainchain.stream.v1.Params.min_termination_notice":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.stream.v1.Params.max_claim_fee_ratio":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.Params"))
@@ -283,6 +590,43 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.AllowedDenoms) > 0 {
+			for _, s := range x.AllowedDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MinDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinDuration))
+		}
+		if len(x.MinDeposit) > 0 {
+			for _, e := range x.MinDeposit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.MaxFlowRate != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxFlowRate))
+		}
+		l = len(x.FeeCollectorRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CommunityPoolRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BurnRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinTerminationNotice != 0 {
+			n += 1 + runtime.Sov(uint64(x.MinTerminationNotice))
+		}
+		l = len(x.MaxClaimFeeRatio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -312,6 +656,74 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MaxClaimFeeRatio) > 0 {
+			i -= len(x.MaxClaimFeeRatio)
+			copy(dAtA[i:], x.MaxClaimFeeRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxClaimFeeRatio)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.MinTerminationNotice != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinTerminationNotice))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.BurnRatio) > 0 {
+			i -= len(x.BurnRatio)
+			copy(dAtA[i:], x.BurnRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BurnRatio)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.CommunityPoolRatio) > 0 {
+			i -= len(x.CommunityPoolRatio)
+			copy(dAtA[i:], x.CommunityPoolRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CommunityPoolRatio)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.FeeCollectorRatio) > 0 {
+			i -= len(x.FeeCollectorRatio)
+			copy(dAtA[i:], x.FeeCollectorRatio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeCollectorRatio)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MaxFlowRate != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxFlowRate))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.MinDeposit) > 0 {
+			for iNdEx := len(x.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinDeposit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.MinDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MinDuration))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.AllowedDenoms) > 0 {
+			for iNdEx := len(x.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedDenoms[iNdEx])
+				copy(dAtA[i:], x.AllowedDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if len(x.ValidatorFee) > 0 {
 			i -= len(x.ValidatorFee)
 			copy(dAtA[i:], x.ValidatorFee)
@@ -400,6 +812,257 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				}
 				x.ValidatorFee = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedDenoms = append(x.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDuration", wireType)
+				}
+				x.MinDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinDuration |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinDeposit = append(x.MinDeposit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinDeposit[len(x.MinDeposit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxFlowRate", wireType)
+				}
+				x.MaxFlowRate = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxFlowRate |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeCollectorRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CommunityPoolRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BurnRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinTerminationNotice", wireType)
+				}
+				x.MinTerminationNotice = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MinTerminationNotice |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxClaimFeeRatio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxClaimFeeRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -456,6 +1119,32 @@ type Params struct {
 
 	// validator_fee is the chain-wide fee validators will receive from stream payments. A percentage value from 0 to 1
 	ValidatorFee string `protobuf:"bytes,1,opt,name=validator_fee,json=validatorFee,proto3" json:"validator_fee,omitempty"`
+	// allowed_denoms is the list of denominations, including IBC vouchers, that may be used to create and fund streams
+	AllowedDenoms []string `protobuf:"bytes,2,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// min_duration is the minimum number of seconds a new stream's deposit must last for at its flow rate
+	MinDuration uint64 `protobuf:"varint,3,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`
+	// min_deposit is the minimum amount, per denom, that can be deposited when creating or topping up a stream.
+	// Denoms not listed have no minimum
+	MinDeposit []*v1beta1.Coin `protobuf:"bytes,4,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit,omitempty"`
+	// max_flow_rate is the maximum flow rate, in the smallest unit of a denom per second, a stream can have.
+	// Zero means there is no maximum
+	MaxFlowRate int64 `protobuf:"varint,5,opt,name=max_flow_rate,json=maxFlowRate,proto3" json:"max_flow_rate,omitempty"`
+	// fee_collector_ratio is the share of the validator fee sent to the fee collector for distribution to
+	// validators and delegators. A value from 0 to 1
+	FeeCollectorRatio string `protobuf:"bytes,6,opt,name=fee_collector_ratio,json=feeCollectorRatio,proto3" json:"fee_collector_ratio,omitempty"`
+	// community_pool_ratio is the share of the validator fee sent to the community pool. A value from 0 to 1
+	CommunityPoolRatio string `protobuf:"bytes,7,opt,name=community_pool_ratio,json=communityPoolRatio,proto3" json:"community_pool_ratio,omitempty"`
+	// burn_ratio is the share of the validator fee that is burned. A value from 0 to 1. The fee_collector_ratio,
+	// community_pool_ratio and burn_ratio must sum to 1. Only fees in the native denomination are burned, and the
+	// burn share of fees in any other allowed denomination is sent to the fee collector
+	BurnRatio string `protobuf:"bytes,8,opt,name=burn_ratio,json=burnRatio,proto3" json:"burn_ratio,omitempty"`
+	// min_termination_notice is the minimum number of seconds of notice a sender must give when requesting the
+	// termination of a stream
+	MinTerminationNotice uint64 `protobuf:"varint,9,opt,name=min_termination_notice,json=minTerminationNotice,proto3" json:"min_termination_notice,omitempty"`
+	// max_claim_fee_ratio is the maximum share of the amount a receiver claims from a stream that can be used to pay
+	// the fees of the claim Tx, when the receiver does not have the funds to pay them. A value from 0 to 1. Zero means
+	// fees can never be paid from a claim
+	MaxClaimFeeRatio string `protobuf:"bytes,10,opt,name=max_claim_fee_ratio,json=maxClaimFeeRatio,proto3" json:"max_claim_fee_ratio,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -485,6 +1174,69 @@ func (x *Params) GetValidatorFee() string {
 	return ""
 }
 
+func (x *Params) GetAllowedDenoms() []string {
+	if x != nil {
+		return x.AllowedDenoms
+	}
+	return nil
+}
+
+func (x *Params) GetMinDuration() uint64 {
+	if x != nil {
+		return x.MinDuration
+	}
+	return 0
+}
+
+func (x *Params) GetMinDeposit() []*v1beta1.Coin {
+	if x != nil {
+		return x.MinDeposit
+	}
+	return nil
+}
+
+func (x *Params) GetMaxFlowRate() int64 {
+	if x != nil {
+		return x.MaxFlowRate
+	}
+	return 0
+}
+
+func (x *Params) GetFeeCollectorRatio() string {
+	if x != nil {
+		return x.FeeCollectorRatio
+	}
+	return ""
+}
+
+func (x *Params) GetCommunityPoolRatio() string {
+	if x != nil {
+		return x.CommunityPoolRatio
+	}
+	return ""
+}
+
+func (x *Params) GetBurnRatio() string {
+	if x != nil {
+		return x.BurnRatio
+	}
+	return ""
+}
+
+func (x *Params) GetMinTerminationNotice() uint64 {
+	if x != nil {
+		return x.MinTerminationNotice
+	}
+	return 0
+}
+
+func (x *Params) GetMaxClaimFeeRatio() string {
+	if x != nil {
+		return x.MaxClaimFeeRatio
+	}
+	return ""
+}
+
 var File_mainchain_stream_v1_params_proto protoreflect.FileDescriptor
 
 var file_mainchain_stream_v1_params_proto_rawDesc = []byte{
@@ -492,31 +1244,77 @@ var file_mainchain_stream_v1_params_proto_rawDesc = []byte{
 	0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x12, 0x13, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74,
 	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61,
-	0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d,
+	0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f,
+	0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x7c, 0x0a, 0x06, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5b, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde,
+	0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb5, 0x06, 0x0a, 0x06,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5b, 0x0a, 0x0d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x46, 0x65, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69,
+	0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x82, 0x01,
+	0x0a, 0x0b, 0x6d, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46,
+	0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0x9a, 0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e,
+	0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x46, 0x6c,
+	0x6f, 0x77, 0x52, 0x61, 0x74, 0x65, 0x12, 0x66, 0x0a, 0x13, 0x66, 0x65, 0x65, 0x5f, 0x63, 0x6f,
+	0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x66, 0x65, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x68,
+	0x0a, 0x14, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74, 0x79, 0x50,
+	0x6f, 0x6f, 0x6c, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x55, 0x0a, 0x0a, 0x62, 0x75, 0x72, 0x6e,
+	0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42, 0x36, 0xc8, 0xde,
 	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
 	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
 	0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8,
-	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x46,
-	0x65, 0x65, 0x3a, 0x15, 0x8a, 0xe7, 0xb0, 0x2a, 0x10, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f,
-	0x76, 0x31, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0xc3, 0x01, 0x0a, 0x17, 0x63, 0x6f,
-	0x6d, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x53, 0x58, 0xaa, 0x02, 0x13, 0x4d, 0x61, 0x69, 0x6e,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x56, 0x31, 0xca,
-	0x02, 0x13, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x09, 0x62, 0x75, 0x72, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12,
+	0x34, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x14, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e,
+	0x6f, 0x74, 0x69, 0x63, 0x65, 0x12, 0x65, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x61,
+	0x69, 0x6d, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x36, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x43,
+	0x6c, 0x61, 0x69, 0x6d, 0x46, 0x65, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x3a, 0x15, 0x8a, 0xe7,
+	0xb0, 0x2a, 0x10, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x42, 0xc3, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x42,
+	0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x4d, 0x53, 0x58, 0xaa, 0x02, 0x13, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4d, 0x61, 0x69, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1f, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0xea, 0x02, 0x15, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -533,14 +1331,16 @@ func file_mainchain_stream_v1_params_proto_rawDescGZIP() []byte {
 
 var file_mainchain_stream_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_mainchain_stream_v1_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: mainchain.stream.v1.Params
+	(*Params)(nil),       // 0: mainchain.stream.v1.Params
+	(*v1beta1.Coin)(nil), // 1: cosmos.base.v1beta1.Coin
 }
 var file_mainchain_stream_v1_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: mainchain.stream.v1.Params.min_deposit:type_name -> cosmos.base.v1beta1.Coin
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_mainchain_stream_v1_params_proto_init() }
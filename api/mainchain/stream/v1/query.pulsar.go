@@ -13,6 +13,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
@@ -3527,6 +3528,7 @@ var (
 	md_QueryAllStreamsForReceiverRequest               protoreflect.MessageDescriptor
 	fd_QueryAllStreamsForReceiverRequest_receiver_addr protoreflect.FieldDescriptor
 	fd_QueryAllStreamsForReceiverRequest_pagination    protoreflect.FieldDescriptor
+	fd_QueryAllStreamsForReceiverRequest_denom         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -3534,6 +3536,7 @@ func init() {
 	md_QueryAllStreamsForReceiverRequest = File_mainchain_stream_v1_query_proto.Messages().ByName("QueryAllStreamsForReceiverRequest")
 	fd_QueryAllStreamsForReceiverRequest_receiver_addr = md_QueryAllStreamsForReceiverRequest.Fields().ByName("receiver_addr")
 	fd_QueryAllStreamsForReceiverRequest_pagination = md_QueryAllStreamsForReceiverRequest.Fields().ByName("pagination")
+	fd_QueryAllStreamsForReceiverRequest_denom = md_QueryAllStreamsForReceiverRequest.Fields().ByName("denom")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryAllStreamsForReceiverRequest)(nil)
@@ -3613,6 +3616,12 @@ func (x *fastReflection_QueryAllStreamsForReceiverRequest) Range(f func(protoref
 			return
 		}
 	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_QueryAllStreamsForReceiverRequest_denom, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3632,6 +3641,8 @@ func (x *fastReflection_QueryAllStreamsForReceiverRequest) Has(fd protoreflect.F
 		return x.ReceiverAddr != ""
 	case "mainchain.stream.v1.QueryAllStreamsForReceiverRequest.pagination":
 		return x.Pagination != nil
+	case "mainchain.stream.v1.QueryAllStreamsForReceiverRequest.denom":
+		return x.Denom != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryAllStreamsForReceiverRequest"))
@@ -3652,6 +3663,8 @@ func (x *fastReflection_QueryAllStreamsForReceiverRequest) Clear(fd protoreflect
 		x.ReceiverAddr = ""
 	case "mainchain.stream.v1.QueryAllStreamsForReceiverRequest.pagination":
 		x.Pagination = nil
+	case "mainchain.stream.v1.QueryAllStreamsForReceiverRequest.denom":
+		x.Denom = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryAllStreamsForReceiverRequest"))
@@ -3674,6 +3687,9 @@ func (x *fastReflection_QueryAllStreamsForReceiverRequest) Get(descriptor protor
 	case "mainchain.stream.v1.QueryAllStreamsForReceiverRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.stream.v1.QueryAllStreamsForReceiverRequest.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryAllStreamsForReceiverRequest"))
@@ -3698,6 +3714,8 @@ func (x *fastReflection_QueryAllStreamsForReceiverRequest) Set(fd protoreflect.F
 		x.ReceiverAddr = value.Interface().(string)
 	case "mainchain.stream.v1.QueryAllStreamsForReceiverRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta11.PageRequest)
+	case "mainchain.stream.v1.QueryAllStreamsForReceiverRequest.denom":
+		x.Denom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryAllStreamsForReceiverRequest"))
@@ -3725,6 +3743,8 @@ func (x *fastReflection_QueryAllStreamsForReceiverRequest) Mutable(fd protorefle
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	case "mainchain.stream.v1.QueryAllStreamsForReceiverRequest.receiver_addr":
 		panic(fmt.Errorf("field receiver_addr of message mainchain.stream.v1.QueryAllStreamsForReceiverRequest is not mutable"))
+	case "mainchain.stream.v1.QueryAllStreamsForReceiverRequest.denom":
+		panic(fmt.Errorf("field denom of message mainchain.stream.v1.QueryAllStreamsForReceiverRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryAllStreamsForReceiverRequest"))
@@ -3743,6 +3763,8 @@ func (x *fastReflection_QueryAllStreamsForReceiverRequest) NewField(fd protorefl
 	case "mainchain.stream.v1.QueryAllStreamsForReceiverRequest.pagination":
 		m := new(v1beta11.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.stream.v1.QueryAllStreamsForReceiverRequest.denom":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryAllStreamsForReceiverRequest"))
@@ -3820,6 +3842,10 @@ func (x *fastReflection_QueryAllStreamsForReceiverRequest) ProtoMethods() *proto
 			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3849,6 +3875,13 @@ func (x *fastReflection_QueryAllStreamsForReceiverRequest) ProtoMethods() *proto
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.Pagination != nil {
 			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
@@ -3987,6 +4020,38 @@ func (x *fastReflection_QueryAllStreamsForReceiverRequest) ProtoMethods() *proto
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4663,6 +4728,7 @@ var (
 	md_QueryStreamByReceiverSenderRequest               protoreflect.MessageDescriptor
 	fd_QueryStreamByReceiverSenderRequest_receiver_addr protoreflect.FieldDescriptor
 	fd_QueryStreamByReceiverSenderRequest_sender_addr   protoreflect.FieldDescriptor
+	fd_QueryStreamByReceiverSenderRequest_denom         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -4670,6 +4736,7 @@ func init() {
 	md_QueryStreamByReceiverSenderRequest = File_mainchain_stream_v1_query_proto.Messages().ByName("QueryStreamByReceiverSenderRequest")
 	fd_QueryStreamByReceiverSenderRequest_receiver_addr = md_QueryStreamByReceiverSenderRequest.Fields().ByName("receiver_addr")
 	fd_QueryStreamByReceiverSenderRequest_sender_addr = md_QueryStreamByReceiverSenderRequest.Fields().ByName("sender_addr")
+	fd_QueryStreamByReceiverSenderRequest_denom = md_QueryStreamByReceiverSenderRequest.Fields().ByName("denom")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryStreamByReceiverSenderRequest)(nil)
@@ -4749,6 +4816,12 @@ func (x *fastReflection_QueryStreamByReceiverSenderRequest) Range(f func(protore
 			return
 		}
 	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_QueryStreamByReceiverSenderRequest_denom, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4768,6 +4841,8 @@ func (x *fastReflection_QueryStreamByReceiverSenderRequest) Has(fd protoreflect.
 		return x.ReceiverAddr != ""
 	case "mainchain.stream.v1.QueryStreamByReceiverSenderRequest.sender_addr":
 		return x.SenderAddr != ""
+	case "mainchain.stream.v1.QueryStreamByReceiverSenderRequest.denom":
+		return x.Denom != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByReceiverSenderRequest"))
@@ -4788,6 +4863,8 @@ func (x *fastReflection_QueryStreamByReceiverSenderRequest) Clear(fd protoreflec
 		x.ReceiverAddr = ""
 	case "mainchain.stream.v1.QueryStreamByReceiverSenderRequest.sender_addr":
 		x.SenderAddr = ""
+	case "mainchain.stream.v1.QueryStreamByReceiverSenderRequest.denom":
+		x.Denom = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByReceiverSenderRequest"))
@@ -4810,6 +4887,9 @@ func (x *fastReflection_QueryStreamByReceiverSenderRequest) Get(descriptor proto
 	case "mainchain.stream.v1.QueryStreamByReceiverSenderRequest.sender_addr":
 		value := x.SenderAddr
 		return protoreflect.ValueOfString(value)
+	case "mainchain.stream.v1.QueryStreamByReceiverSenderRequest.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByReceiverSenderRequest"))
@@ -4834,6 +4914,8 @@ func (x *fastReflection_QueryStreamByReceiverSenderRequest) Set(fd protoreflect.
 		x.ReceiverAddr = value.Interface().(string)
 	case "mainchain.stream.v1.QueryStreamByReceiverSenderRequest.sender_addr":
 		x.SenderAddr = value.Interface().(string)
+	case "mainchain.stream.v1.QueryStreamByReceiverSenderRequest.denom":
+		x.Denom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByReceiverSenderRequest"))
@@ -4858,6 +4940,8 @@ func (x *fastReflection_QueryStreamByReceiverSenderRequest) Mutable(fd protorefl
 		panic(fmt.Errorf("field receiver_addr of message mainchain.stream.v1.QueryStreamByReceiverSenderRequest is not mutable"))
 	case "mainchain.stream.v1.QueryStreamByReceiverSenderRequest.sender_addr":
 		panic(fmt.Errorf("field sender_addr of message mainchain.stream.v1.QueryStreamByReceiverSenderRequest is not mutable"))
+	case "mainchain.stream.v1.QueryStreamByReceiverSenderRequest.denom":
+		panic(fmt.Errorf("field denom of message mainchain.stream.v1.QueryStreamByReceiverSenderRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByReceiverSenderRequest"))
@@ -4875,6 +4959,8 @@ func (x *fastReflection_QueryStreamByReceiverSenderRequest) NewField(fd protoref
 		return protoreflect.ValueOfString("")
 	case "mainchain.stream.v1.QueryStreamByReceiverSenderRequest.sender_addr":
 		return protoreflect.ValueOfString("")
+	case "mainchain.stream.v1.QueryStreamByReceiverSenderRequest.denom":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByReceiverSenderRequest"))
@@ -4952,6 +5038,10 @@ func (x *fastReflection_QueryStreamByReceiverSenderRequest) ProtoMethods() *prot
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4981,6 +5071,13 @@ func (x *fastReflection_QueryStreamByReceiverSenderRequest) ProtoMethods() *prot
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.SenderAddr) > 0 {
 			i -= len(x.SenderAddr)
 			copy(dAtA[i:], x.SenderAddr)
@@ -5108,6 +5205,38 @@ func (x *fastReflection_QueryStreamByReceiverSenderRequest) ProtoMethods() *prot
 				}
 				x.SenderAddr = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5582,6 +5711,7 @@ var (
 	md_QueryStreamReceiverSenderCurrentFlowRequest               protoreflect.MessageDescriptor
 	fd_QueryStreamReceiverSenderCurrentFlowRequest_receiver_addr protoreflect.FieldDescriptor
 	fd_QueryStreamReceiverSenderCurrentFlowRequest_sender_addr   protoreflect.FieldDescriptor
+	fd_QueryStreamReceiverSenderCurrentFlowRequest_denom         protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -5589,6 +5719,7 @@ func init() {
 	md_QueryStreamReceiverSenderCurrentFlowRequest = File_mainchain_stream_v1_query_proto.Messages().ByName("QueryStreamReceiverSenderCurrentFlowRequest")
 	fd_QueryStreamReceiverSenderCurrentFlowRequest_receiver_addr = md_QueryStreamReceiverSenderCurrentFlowRequest.Fields().ByName("receiver_addr")
 	fd_QueryStreamReceiverSenderCurrentFlowRequest_sender_addr = md_QueryStreamReceiverSenderCurrentFlowRequest.Fields().ByName("sender_addr")
+	fd_QueryStreamReceiverSenderCurrentFlowRequest_denom = md_QueryStreamReceiverSenderCurrentFlowRequest.Fields().ByName("denom")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryStreamReceiverSenderCurrentFlowRequest)(nil)
@@ -5668,6 +5799,12 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowRequest) Range(f fun
 			return
 		}
 	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_QueryStreamReceiverSenderCurrentFlowRequest_denom, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -5687,6 +5824,8 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowRequest) Has(fd prot
 		return x.ReceiverAddr != ""
 	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest.sender_addr":
 		return x.SenderAddr != ""
+	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest.denom":
+		return x.Denom != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest"))
@@ -5707,6 +5846,8 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowRequest) Clear(fd pr
 		x.ReceiverAddr = ""
 	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest.sender_addr":
 		x.SenderAddr = ""
+	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest.denom":
+		x.Denom = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest"))
@@ -5729,6 +5870,9 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowRequest) Get(descrip
 	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest.sender_addr":
 		value := x.SenderAddr
 		return protoreflect.ValueOfString(value)
+	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest"))
@@ -5753,6 +5897,8 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowRequest) Set(fd prot
 		x.ReceiverAddr = value.Interface().(string)
 	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest.sender_addr":
 		x.SenderAddr = value.Interface().(string)
+	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest.denom":
+		x.Denom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest"))
@@ -5777,6 +5923,8 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowRequest) Mutable(fd
 		panic(fmt.Errorf("field receiver_addr of message mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest is not mutable"))
 	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest.sender_addr":
 		panic(fmt.Errorf("field sender_addr of message mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest is not mutable"))
+	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest.denom":
+		panic(fmt.Errorf("field denom of message mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest"))
@@ -5794,6 +5942,8 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowRequest) NewField(fd
 		return protoreflect.ValueOfString("")
 	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest.sender_addr":
 		return protoreflect.ValueOfString("")
+	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest.denom":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest"))
@@ -5871,6 +6021,10 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowRequest) ProtoMethod
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5900,6 +6054,13 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowRequest) ProtoMethod
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.SenderAddr) > 0 {
 			i -= len(x.SenderAddr)
 			copy(dAtA[i:], x.SenderAddr)
@@ -6027,6 +6188,38 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowRequest) ProtoMethod
 				}
 				x.SenderAddr = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6066,6 +6259,7 @@ var (
 	md_QueryStreamReceiverSenderCurrentFlowResponse                      protoreflect.MessageDescriptor
 	fd_QueryStreamReceiverSenderCurrentFlowResponse_configured_flow_rate protoreflect.FieldDescriptor
 	fd_QueryStreamReceiverSenderCurrentFlowResponse_current_flow_rate    protoreflect.FieldDescriptor
+	fd_QueryStreamReceiverSenderCurrentFlowResponse_status               protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -6073,6 +6267,7 @@ func init() {
 	md_QueryStreamReceiverSenderCurrentFlowResponse = File_mainchain_stream_v1_query_proto.Messages().ByName("QueryStreamReceiverSenderCurrentFlowResponse")
 	fd_QueryStreamReceiverSenderCurrentFlowResponse_configured_flow_rate = md_QueryStreamReceiverSenderCurrentFlowResponse.Fields().ByName("configured_flow_rate")
 	fd_QueryStreamReceiverSenderCurrentFlowResponse_current_flow_rate = md_QueryStreamReceiverSenderCurrentFlowResponse.Fields().ByName("current_flow_rate")
+	fd_QueryStreamReceiverSenderCurrentFlowResponse_status = md_QueryStreamReceiverSenderCurrentFlowResponse.Fields().ByName("status")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryStreamReceiverSenderCurrentFlowResponse)(nil)
@@ -6152,6 +6347,12 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowResponse) Range(f fu
 			return
 		}
 	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_QueryStreamReceiverSenderCurrentFlowResponse_status, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -6171,6 +6372,8 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowResponse) Has(fd pro
 		return x.ConfiguredFlowRate != int64(0)
 	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse.current_flow_rate":
 		return x.CurrentFlowRate != int64(0)
+	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse.status":
+		return x.Status != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse"))
@@ -6191,6 +6394,8 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowResponse) Clear(fd p
 		x.ConfiguredFlowRate = int64(0)
 	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse.current_flow_rate":
 		x.CurrentFlowRate = int64(0)
+	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse.status":
+		x.Status = 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse"))
@@ -6213,6 +6418,9 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowResponse) Get(descri
 	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse.current_flow_rate":
 		value := x.CurrentFlowRate
 		return protoreflect.ValueOfInt64(value)
+	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse"))
@@ -6237,6 +6445,8 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowResponse) Set(fd pro
 		x.ConfiguredFlowRate = value.Int()
 	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse.current_flow_rate":
 		x.CurrentFlowRate = value.Int()
+	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse.status":
+		x.Status = (StreamStatus)(value.Enum())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse"))
@@ -6261,6 +6471,8 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowResponse) Mutable(fd
 		panic(fmt.Errorf("field configured_flow_rate of message mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse is not mutable"))
 	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse.current_flow_rate":
 		panic(fmt.Errorf("field current_flow_rate of message mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse is not mutable"))
+	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse.status":
+		panic(fmt.Errorf("field status of message mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse"))
@@ -6278,6 +6490,8 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowResponse) NewField(f
 		return protoreflect.ValueOfInt64(int64(0))
 	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse.current_flow_rate":
 		return protoreflect.ValueOfInt64(int64(0))
+	case "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse.status":
+		return protoreflect.ValueOfEnum(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse"))
@@ -6353,6 +6567,9 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowResponse) ProtoMetho
 		if x.CurrentFlowRate != 0 {
 			n += 1 + runtime.Sov(uint64(x.CurrentFlowRate))
 		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6382,6 +6599,11 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowResponse) ProtoMetho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x18
+		}
 		if x.CurrentFlowRate != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.CurrentFlowRate))
 			i--
@@ -6479,6 +6701,25 @@ func (x *fastReflection_QueryStreamReceiverSenderCurrentFlowResponse) ProtoMetho
 						break
 					}
 				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= StreamStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6518,6 +6759,7 @@ var (
 	md_QueryAllStreamsForSenderRequest             protoreflect.MessageDescriptor
 	fd_QueryAllStreamsForSenderRequest_sender_addr protoreflect.FieldDescriptor
 	fd_QueryAllStreamsForSenderRequest_pagination  protoreflect.FieldDescriptor
+	fd_QueryAllStreamsForSenderRequest_denom       protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -6525,6 +6767,7 @@ func init() {
 	md_QueryAllStreamsForSenderRequest = File_mainchain_stream_v1_query_proto.Messages().ByName("QueryAllStreamsForSenderRequest")
 	fd_QueryAllStreamsForSenderRequest_sender_addr = md_QueryAllStreamsForSenderRequest.Fields().ByName("sender_addr")
 	fd_QueryAllStreamsForSenderRequest_pagination = md_QueryAllStreamsForSenderRequest.Fields().ByName("pagination")
+	fd_QueryAllStreamsForSenderRequest_denom = md_QueryAllStreamsForSenderRequest.Fields().ByName("denom")
 }
 
 var _ protoreflect.Message = (*fastReflection_QueryAllStreamsForSenderRequest)(nil)
@@ -6604,6 +6847,12 @@ func (x *fastReflection_QueryAllStreamsForSenderRequest) Range(f func(protorefle
 			return
 		}
 	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_QueryAllStreamsForSenderRequest_denom, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -6623,6 +6872,8 @@ func (x *fastReflection_QueryAllStreamsForSenderRequest) Has(fd protoreflect.Fie
 		return x.SenderAddr != ""
 	case "mainchain.stream.v1.QueryAllStreamsForSenderRequest.pagination":
 		return x.Pagination != nil
+	case "mainchain.stream.v1.QueryAllStreamsForSenderRequest.denom":
+		return x.Denom != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryAllStreamsForSenderRequest"))
@@ -6643,6 +6894,8 @@ func (x *fastReflection_QueryAllStreamsForSenderRequest) Clear(fd protoreflect.F
 		x.SenderAddr = ""
 	case "mainchain.stream.v1.QueryAllStreamsForSenderRequest.pagination":
 		x.Pagination = nil
+	case "mainchain.stream.v1.QueryAllStreamsForSenderRequest.denom":
+		x.Denom = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryAllStreamsForSenderRequest"))
@@ -6665,6 +6918,9 @@ func (x *fastReflection_QueryAllStreamsForSenderRequest) Get(descriptor protoref
 	case "mainchain.stream.v1.QueryAllStreamsForSenderRequest.pagination":
 		value := x.Pagination
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.stream.v1.QueryAllStreamsForSenderRequest.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryAllStreamsForSenderRequest"))
@@ -6689,6 +6945,8 @@ func (x *fastReflection_QueryAllStreamsForSenderRequest) Set(fd protoreflect.Fie
 		x.SenderAddr = value.Interface().(string)
 	case "mainchain.stream.v1.QueryAllStreamsForSenderRequest.pagination":
 		x.Pagination = value.Message().Interface().(*v1beta11.PageRequest)
+	case "mainchain.stream.v1.QueryAllStreamsForSenderRequest.denom":
+		x.Denom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryAllStreamsForSenderRequest"))
@@ -6716,6 +6974,8 @@ func (x *fastReflection_QueryAllStreamsForSenderRequest) Mutable(fd protoreflect
 		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
 	case "mainchain.stream.v1.QueryAllStreamsForSenderRequest.sender_addr":
 		panic(fmt.Errorf("field sender_addr of message mainchain.stream.v1.QueryAllStreamsForSenderRequest is not mutable"))
+	case "mainchain.stream.v1.QueryAllStreamsForSenderRequest.denom":
+		panic(fmt.Errorf("field denom of message mainchain.stream.v1.QueryAllStreamsForSenderRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryAllStreamsForSenderRequest"))
@@ -6734,6 +6994,8 @@ func (x *fastReflection_QueryAllStreamsForSenderRequest) NewField(fd protoreflec
 	case "mainchain.stream.v1.QueryAllStreamsForSenderRequest.pagination":
 		m := new(v1beta11.PageRequest)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.stream.v1.QueryAllStreamsForSenderRequest.denom":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryAllStreamsForSenderRequest"))
@@ -6811,6 +7073,10 @@ func (x *fastReflection_QueryAllStreamsForSenderRequest) ProtoMethods() *protoif
 			l = options.Size(x.Pagination)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6840,6 +7106,13 @@ func (x *fastReflection_QueryAllStreamsForSenderRequest) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if x.Pagination != nil {
 			encoded, err := options.Marshal(x.Pagination)
 			if err != nil {
@@ -6978,6 +7251,38 @@ func (x *fastReflection_QueryAllStreamsForSenderRequest) ProtoMethods() *protoif
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7650,720 +7955,4683 @@ func (x *fastReflection_QueryAllStreamsForSenderResponse) ProtoMethods() *protoi
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: mainchain/stream/v1/query.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_QueryStreamByIdRequest           protoreflect.MessageDescriptor
+	fd_QueryStreamByIdRequest_stream_id protoreflect.FieldDescriptor
 )
 
-// StreamResult contains data about a stream
-type StreamResult struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_mainchain_stream_v1_query_proto_init()
+	md_QueryStreamByIdRequest = File_mainchain_stream_v1_query_proto.Messages().ByName("QueryStreamByIdRequest")
+	fd_QueryStreamByIdRequest_stream_id = md_QueryStreamByIdRequest.Fields().ByName("stream_id")
+}
 
-	// receiver is the wallet that will receive stream payments
-	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	// sender is the wallet making the update
-	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
-	// stream is the stream data
-	Stream *Stream `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
+var _ protoreflect.Message = (*fastReflection_QueryStreamByIdRequest)(nil)
+
+type fastReflection_QueryStreamByIdRequest QueryStreamByIdRequest
+
+func (x *QueryStreamByIdRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryStreamByIdRequest)(x)
 }
 
-func (x *StreamResult) Reset() {
-	*x = StreamResult{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_stream_v1_query_proto_msgTypes[0]
+func (x *QueryStreamByIdRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_stream_v1_query_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *StreamResult) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_QueryStreamByIdRequest_messageType fastReflection_QueryStreamByIdRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryStreamByIdRequest_messageType{}
 
-func (*StreamResult) ProtoMessage() {}
+type fastReflection_QueryStreamByIdRequest_messageType struct{}
 
-// Deprecated: Use StreamResult.ProtoReflect.Descriptor instead.
-func (*StreamResult) Descriptor() ([]byte, []int) {
-	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_QueryStreamByIdRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryStreamByIdRequest)(nil)
 }
-
-func (x *StreamResult) GetReceiver() string {
-	if x != nil {
-		return x.Receiver
-	}
-	return ""
+func (x fastReflection_QueryStreamByIdRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryStreamByIdRequest)
 }
-
-func (x *StreamResult) GetSender() string {
-	if x != nil {
-		return x.Sender
-	}
-	return ""
+func (x fastReflection_QueryStreamByIdRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStreamByIdRequest
 }
 
-func (x *StreamResult) GetStream() *Stream {
-	if x != nil {
-		return x.Stream
-	}
-	return nil
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryStreamByIdRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStreamByIdRequest
 }
 
-// QueryParamsRequest is request type for the Query/Params RPC method.
-type QueryParamsRequest struct {
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryStreamByIdRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryStreamByIdRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryStreamByIdRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryStreamByIdRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryStreamByIdRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryStreamByIdRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryStreamByIdRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StreamId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StreamId)
+		if !f(fd_QueryStreamByIdRequest_stream_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryStreamByIdRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamByIdRequest.stream_id":
+		return x.StreamId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByIdRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamByIdRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamByIdRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamByIdRequest.stream_id":
+		x.StreamId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByIdRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamByIdRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryStreamByIdRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.stream.v1.QueryStreamByIdRequest.stream_id":
+		value := x.StreamId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByIdRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamByIdRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamByIdRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamByIdRequest.stream_id":
+		x.StreamId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByIdRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamByIdRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamByIdRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamByIdRequest.stream_id":
+		panic(fmt.Errorf("field stream_id of message mainchain.stream.v1.QueryStreamByIdRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByIdRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamByIdRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryStreamByIdRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamByIdRequest.stream_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByIdRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamByIdRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryStreamByIdRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.stream.v1.QueryStreamByIdRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryStreamByIdRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamByIdRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryStreamByIdRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryStreamByIdRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryStreamByIdRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StreamId != 0 {
+			n += 1 + runtime.Sov(uint64(x.StreamId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryStreamByIdRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.StreamId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StreamId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryStreamByIdRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStreamByIdRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStreamByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+				}
+				x.StreamId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StreamId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryStreamByIdResponse        protoreflect.MessageDescriptor
+	fd_QueryStreamByIdResponse_stream protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_stream_v1_query_proto_init()
+	md_QueryStreamByIdResponse = File_mainchain_stream_v1_query_proto.Messages().ByName("QueryStreamByIdResponse")
+	fd_QueryStreamByIdResponse_stream = md_QueryStreamByIdResponse.Fields().ByName("stream")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryStreamByIdResponse)(nil)
+
+type fastReflection_QueryStreamByIdResponse QueryStreamByIdResponse
+
+func (x *QueryStreamByIdResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryStreamByIdResponse)(x)
+}
+
+func (x *QueryStreamByIdResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_stream_v1_query_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryStreamByIdResponse_messageType fastReflection_QueryStreamByIdResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryStreamByIdResponse_messageType{}
+
+type fastReflection_QueryStreamByIdResponse_messageType struct{}
+
+func (x fastReflection_QueryStreamByIdResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryStreamByIdResponse)(nil)
+}
+func (x fastReflection_QueryStreamByIdResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryStreamByIdResponse)
+}
+func (x fastReflection_QueryStreamByIdResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStreamByIdResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryStreamByIdResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStreamByIdResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryStreamByIdResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryStreamByIdResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryStreamByIdResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryStreamByIdResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryStreamByIdResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryStreamByIdResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryStreamByIdResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Stream != nil {
+		value := protoreflect.ValueOfMessage(x.Stream.ProtoReflect())
+		if !f(fd_QueryStreamByIdResponse_stream, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryStreamByIdResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamByIdResponse.stream":
+		return x.Stream != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByIdResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamByIdResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamByIdResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamByIdResponse.stream":
+		x.Stream = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByIdResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamByIdResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryStreamByIdResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.stream.v1.QueryStreamByIdResponse.stream":
+		value := x.Stream
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByIdResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamByIdResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamByIdResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamByIdResponse.stream":
+		x.Stream = value.Message().Interface().(*StreamResult)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByIdResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamByIdResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamByIdResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamByIdResponse.stream":
+		if x.Stream == nil {
+			x.Stream = new(StreamResult)
+		}
+		return protoreflect.ValueOfMessage(x.Stream.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByIdResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamByIdResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryStreamByIdResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamByIdResponse.stream":
+		m := new(StreamResult)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamByIdResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamByIdResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryStreamByIdResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.stream.v1.QueryStreamByIdResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryStreamByIdResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamByIdResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryStreamByIdResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryStreamByIdResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryStreamByIdResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Stream != nil {
+			l = options.Size(x.Stream)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryStreamByIdResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Stream != nil {
+			encoded, err := options.Marshal(x.Stream)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryStreamByIdResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStreamByIdResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStreamByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Stream == nil {
+					x.Stream = &StreamResult{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stream); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryStreamStatsRequest           protoreflect.MessageDescriptor
+	fd_QueryStreamStatsRequest_stream_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_stream_v1_query_proto_init()
+	md_QueryStreamStatsRequest = File_mainchain_stream_v1_query_proto.Messages().ByName("QueryStreamStatsRequest")
+	fd_QueryStreamStatsRequest_stream_id = md_QueryStreamStatsRequest.Fields().ByName("stream_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryStreamStatsRequest)(nil)
+
+type fastReflection_QueryStreamStatsRequest QueryStreamStatsRequest
+
+func (x *QueryStreamStatsRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryStreamStatsRequest)(x)
+}
+
+func (x *QueryStreamStatsRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_stream_v1_query_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryStreamStatsRequest_messageType fastReflection_QueryStreamStatsRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryStreamStatsRequest_messageType{}
+
+type fastReflection_QueryStreamStatsRequest_messageType struct{}
+
+func (x fastReflection_QueryStreamStatsRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryStreamStatsRequest)(nil)
+}
+func (x fastReflection_QueryStreamStatsRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryStreamStatsRequest)
+}
+func (x fastReflection_QueryStreamStatsRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStreamStatsRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryStreamStatsRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStreamStatsRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryStreamStatsRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryStreamStatsRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryStreamStatsRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryStreamStatsRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryStreamStatsRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryStreamStatsRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryStreamStatsRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StreamId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StreamId)
+		if !f(fd_QueryStreamStatsRequest_stream_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryStreamStatsRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamStatsRequest.stream_id":
+		return x.StreamId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamStatsRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamStatsRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamStatsRequest.stream_id":
+		x.StreamId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamStatsRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryStreamStatsRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.stream.v1.QueryStreamStatsRequest.stream_id":
+		value := x.StreamId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamStatsRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamStatsRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamStatsRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamStatsRequest.stream_id":
+		x.StreamId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamStatsRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamStatsRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamStatsRequest.stream_id":
+		panic(fmt.Errorf("field stream_id of message mainchain.stream.v1.QueryStreamStatsRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamStatsRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryStreamStatsRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamStatsRequest.stream_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamStatsRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamStatsRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryStreamStatsRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.stream.v1.QueryStreamStatsRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryStreamStatsRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamStatsRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryStreamStatsRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryStreamStatsRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryStreamStatsRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StreamId != 0 {
+			n += 1 + runtime.Sov(uint64(x.StreamId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryStreamStatsRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.StreamId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StreamId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryStreamStatsRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStreamStatsRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStreamStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+				}
+				x.StreamId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StreamId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryStreamStatsResponse       protoreflect.MessageDescriptor
+	fd_QueryStreamStatsResponse_stats protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_stream_v1_query_proto_init()
+	md_QueryStreamStatsResponse = File_mainchain_stream_v1_query_proto.Messages().ByName("QueryStreamStatsResponse")
+	fd_QueryStreamStatsResponse_stats = md_QueryStreamStatsResponse.Fields().ByName("stats")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryStreamStatsResponse)(nil)
+
+type fastReflection_QueryStreamStatsResponse QueryStreamStatsResponse
+
+func (x *QueryStreamStatsResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryStreamStatsResponse)(x)
+}
+
+func (x *QueryStreamStatsResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_stream_v1_query_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryStreamStatsResponse_messageType fastReflection_QueryStreamStatsResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryStreamStatsResponse_messageType{}
+
+type fastReflection_QueryStreamStatsResponse_messageType struct{}
+
+func (x fastReflection_QueryStreamStatsResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryStreamStatsResponse)(nil)
+}
+func (x fastReflection_QueryStreamStatsResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryStreamStatsResponse)
+}
+func (x fastReflection_QueryStreamStatsResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStreamStatsResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryStreamStatsResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStreamStatsResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryStreamStatsResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryStreamStatsResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryStreamStatsResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryStreamStatsResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryStreamStatsResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryStreamStatsResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryStreamStatsResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Stats != nil {
+		value := protoreflect.ValueOfMessage(x.Stats.ProtoReflect())
+		if !f(fd_QueryStreamStatsResponse_stats, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryStreamStatsResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamStatsResponse.stats":
+		return x.Stats != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamStatsResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamStatsResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamStatsResponse.stats":
+		x.Stats = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamStatsResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryStreamStatsResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.stream.v1.QueryStreamStatsResponse.stats":
+		value := x.Stats
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamStatsResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamStatsResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamStatsResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamStatsResponse.stats":
+		x.Stats = value.Message().Interface().(*StreamStats)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamStatsResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamStatsResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamStatsResponse.stats":
+		if x.Stats == nil {
+			x.Stats = new(StreamStats)
+		}
+		return protoreflect.ValueOfMessage(x.Stats.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamStatsResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryStreamStatsResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamStatsResponse.stats":
+		m := new(StreamStats)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamStatsResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamStatsResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryStreamStatsResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.stream.v1.QueryStreamStatsResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryStreamStatsResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamStatsResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryStreamStatsResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryStreamStatsResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryStreamStatsResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Stats != nil {
+			l = options.Size(x.Stats)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryStreamStatsResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Stats != nil {
+			encoded, err := options.Marshal(x.Stats)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryStreamStatsResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStreamStatsResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStreamStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Stats == nil {
+					x.Stats = &StreamStats{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Stats); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryStreamHistoryRequest           protoreflect.MessageDescriptor
+	fd_QueryStreamHistoryRequest_stream_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_stream_v1_query_proto_init()
+	md_QueryStreamHistoryRequest = File_mainchain_stream_v1_query_proto.Messages().ByName("QueryStreamHistoryRequest")
+	fd_QueryStreamHistoryRequest_stream_id = md_QueryStreamHistoryRequest.Fields().ByName("stream_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryStreamHistoryRequest)(nil)
+
+type fastReflection_QueryStreamHistoryRequest QueryStreamHistoryRequest
+
+func (x *QueryStreamHistoryRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryStreamHistoryRequest)(x)
+}
+
+func (x *QueryStreamHistoryRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_stream_v1_query_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryStreamHistoryRequest_messageType fastReflection_QueryStreamHistoryRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryStreamHistoryRequest_messageType{}
+
+type fastReflection_QueryStreamHistoryRequest_messageType struct{}
+
+func (x fastReflection_QueryStreamHistoryRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryStreamHistoryRequest)(nil)
+}
+func (x fastReflection_QueryStreamHistoryRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryStreamHistoryRequest)
+}
+func (x fastReflection_QueryStreamHistoryRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStreamHistoryRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryStreamHistoryRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStreamHistoryRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryStreamHistoryRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryStreamHistoryRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryStreamHistoryRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryStreamHistoryRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryStreamHistoryRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryStreamHistoryRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryStreamHistoryRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StreamId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StreamId)
+		if !f(fd_QueryStreamHistoryRequest_stream_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryStreamHistoryRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamHistoryRequest.stream_id":
+		return x.StreamId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamHistoryRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamHistoryRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamHistoryRequest.stream_id":
+		x.StreamId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamHistoryRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryStreamHistoryRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.stream.v1.QueryStreamHistoryRequest.stream_id":
+		value := x.StreamId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamHistoryRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamHistoryRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamHistoryRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamHistoryRequest.stream_id":
+		x.StreamId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamHistoryRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamHistoryRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamHistoryRequest.stream_id":
+		panic(fmt.Errorf("field stream_id of message mainchain.stream.v1.QueryStreamHistoryRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamHistoryRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryStreamHistoryRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamHistoryRequest.stream_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamHistoryRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamHistoryRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryStreamHistoryRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.stream.v1.QueryStreamHistoryRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryStreamHistoryRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamHistoryRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryStreamHistoryRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryStreamHistoryRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryStreamHistoryRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StreamId != 0 {
+			n += 1 + runtime.Sov(uint64(x.StreamId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryStreamHistoryRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.StreamId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StreamId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryStreamHistoryRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStreamHistoryRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStreamHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+				}
+				x.StreamId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StreamId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryStreamHistoryResponse         protoreflect.MessageDescriptor
+	fd_QueryStreamHistoryResponse_history protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_stream_v1_query_proto_init()
+	md_QueryStreamHistoryResponse = File_mainchain_stream_v1_query_proto.Messages().ByName("QueryStreamHistoryResponse")
+	fd_QueryStreamHistoryResponse_history = md_QueryStreamHistoryResponse.Fields().ByName("history")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryStreamHistoryResponse)(nil)
+
+type fastReflection_QueryStreamHistoryResponse QueryStreamHistoryResponse
+
+func (x *QueryStreamHistoryResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryStreamHistoryResponse)(x)
+}
+
+func (x *QueryStreamHistoryResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_stream_v1_query_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryStreamHistoryResponse_messageType fastReflection_QueryStreamHistoryResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryStreamHistoryResponse_messageType{}
+
+type fastReflection_QueryStreamHistoryResponse_messageType struct{}
+
+func (x fastReflection_QueryStreamHistoryResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryStreamHistoryResponse)(nil)
+}
+func (x fastReflection_QueryStreamHistoryResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryStreamHistoryResponse)
+}
+func (x fastReflection_QueryStreamHistoryResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStreamHistoryResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryStreamHistoryResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStreamHistoryResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryStreamHistoryResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryStreamHistoryResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryStreamHistoryResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryStreamHistoryResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryStreamHistoryResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryStreamHistoryResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryStreamHistoryResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.History != nil {
+		value := protoreflect.ValueOfMessage(x.History.ProtoReflect())
+		if !f(fd_QueryStreamHistoryResponse_history, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryStreamHistoryResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamHistoryResponse.history":
+		return x.History != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamHistoryResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamHistoryResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamHistoryResponse.history":
+		x.History = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamHistoryResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryStreamHistoryResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.stream.v1.QueryStreamHistoryResponse.history":
+		value := x.History
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamHistoryResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamHistoryResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamHistoryResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamHistoryResponse.history":
+		x.History = value.Message().Interface().(*StreamHistory)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamHistoryResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamHistoryResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamHistoryResponse.history":
+		if x.History == nil {
+			x.History = new(StreamHistory)
+		}
+		return protoreflect.ValueOfMessage(x.History.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamHistoryResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryStreamHistoryResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamHistoryResponse.history":
+		m := new(StreamHistory)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamHistoryResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamHistoryResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryStreamHistoryResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.stream.v1.QueryStreamHistoryResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryStreamHistoryResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamHistoryResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryStreamHistoryResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryStreamHistoryResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryStreamHistoryResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.History != nil {
+			l = options.Size(x.History)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryStreamHistoryResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.History != nil {
+			encoded, err := options.Marshal(x.History)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryStreamHistoryResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStreamHistoryResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStreamHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.History == nil {
+					x.History = &StreamHistory{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.History); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QueryStreamsExpiringBeforeRequest            protoreflect.MessageDescriptor
+	fd_QueryStreamsExpiringBeforeRequest_before     protoreflect.FieldDescriptor
+	fd_QueryStreamsExpiringBeforeRequest_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_stream_v1_query_proto_init()
+	md_QueryStreamsExpiringBeforeRequest = File_mainchain_stream_v1_query_proto.Messages().ByName("QueryStreamsExpiringBeforeRequest")
+	fd_QueryStreamsExpiringBeforeRequest_before = md_QueryStreamsExpiringBeforeRequest.Fields().ByName("before")
+	fd_QueryStreamsExpiringBeforeRequest_pagination = md_QueryStreamsExpiringBeforeRequest.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryStreamsExpiringBeforeRequest)(nil)
+
+type fastReflection_QueryStreamsExpiringBeforeRequest QueryStreamsExpiringBeforeRequest
+
+func (x *QueryStreamsExpiringBeforeRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryStreamsExpiringBeforeRequest)(x)
+}
+
+func (x *QueryStreamsExpiringBeforeRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_stream_v1_query_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryStreamsExpiringBeforeRequest_messageType fastReflection_QueryStreamsExpiringBeforeRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QueryStreamsExpiringBeforeRequest_messageType{}
+
+type fastReflection_QueryStreamsExpiringBeforeRequest_messageType struct{}
+
+func (x fastReflection_QueryStreamsExpiringBeforeRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryStreamsExpiringBeforeRequest)(nil)
+}
+func (x fastReflection_QueryStreamsExpiringBeforeRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryStreamsExpiringBeforeRequest)
+}
+func (x fastReflection_QueryStreamsExpiringBeforeRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStreamsExpiringBeforeRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryStreamsExpiringBeforeRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStreamsExpiringBeforeRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryStreamsExpiringBeforeRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QueryStreamsExpiringBeforeRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryStreamsExpiringBeforeRequest) New() protoreflect.Message {
+	return new(fastReflection_QueryStreamsExpiringBeforeRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryStreamsExpiringBeforeRequest) Interface() protoreflect.ProtoMessage {
+	return (*QueryStreamsExpiringBeforeRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryStreamsExpiringBeforeRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Before != nil {
+		value := protoreflect.ValueOfMessage(x.Before.ProtoReflect())
+		if !f(fd_QueryStreamsExpiringBeforeRequest_before, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryStreamsExpiringBeforeRequest_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryStreamsExpiringBeforeRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeRequest.before":
+		return x.Before != nil
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeRequest.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamsExpiringBeforeRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamsExpiringBeforeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamsExpiringBeforeRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeRequest.before":
+		x.Before = nil
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeRequest.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamsExpiringBeforeRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamsExpiringBeforeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryStreamsExpiringBeforeRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeRequest.before":
+		value := x.Before
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeRequest.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamsExpiringBeforeRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamsExpiringBeforeRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamsExpiringBeforeRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeRequest.before":
+		x.Before = value.Message().Interface().(*timestamppb.Timestamp)
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeRequest.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageRequest)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamsExpiringBeforeRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamsExpiringBeforeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamsExpiringBeforeRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeRequest.before":
+		if x.Before == nil {
+			x.Before = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Before.ProtoReflect())
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeRequest.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta11.PageRequest)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamsExpiringBeforeRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamsExpiringBeforeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryStreamsExpiringBeforeRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeRequest.before":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeRequest.pagination":
+		m := new(v1beta11.PageRequest)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamsExpiringBeforeRequest"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamsExpiringBeforeRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryStreamsExpiringBeforeRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.stream.v1.QueryStreamsExpiringBeforeRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryStreamsExpiringBeforeRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamsExpiringBeforeRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryStreamsExpiringBeforeRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryStreamsExpiringBeforeRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryStreamsExpiringBeforeRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Before != nil {
+			l = options.Size(x.Before)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryStreamsExpiringBeforeRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Before != nil {
+			encoded, err := options.Marshal(x.Before)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryStreamsExpiringBeforeRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStreamsExpiringBeforeRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStreamsExpiringBeforeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Before == nil {
+					x.Before = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Before); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta11.PageRequest{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_QueryStreamsExpiringBeforeResponse_1_list)(nil)
+
+type _QueryStreamsExpiringBeforeResponse_1_list struct {
+	list *[]*StreamResult
+}
+
+func (x *_QueryStreamsExpiringBeforeResponse_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_QueryStreamsExpiringBeforeResponse_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_QueryStreamsExpiringBeforeResponse_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamResult)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_QueryStreamsExpiringBeforeResponse_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*StreamResult)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_QueryStreamsExpiringBeforeResponse_1_list) AppendMutable() protoreflect.Value {
+	v := new(StreamResult)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryStreamsExpiringBeforeResponse_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_QueryStreamsExpiringBeforeResponse_1_list) NewElement() protoreflect.Value {
+	v := new(StreamResult)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_QueryStreamsExpiringBeforeResponse_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_QueryStreamsExpiringBeforeResponse            protoreflect.MessageDescriptor
+	fd_QueryStreamsExpiringBeforeResponse_streams    protoreflect.FieldDescriptor
+	fd_QueryStreamsExpiringBeforeResponse_pagination protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_stream_v1_query_proto_init()
+	md_QueryStreamsExpiringBeforeResponse = File_mainchain_stream_v1_query_proto.Messages().ByName("QueryStreamsExpiringBeforeResponse")
+	fd_QueryStreamsExpiringBeforeResponse_streams = md_QueryStreamsExpiringBeforeResponse.Fields().ByName("streams")
+	fd_QueryStreamsExpiringBeforeResponse_pagination = md_QueryStreamsExpiringBeforeResponse.Fields().ByName("pagination")
+}
+
+var _ protoreflect.Message = (*fastReflection_QueryStreamsExpiringBeforeResponse)(nil)
+
+type fastReflection_QueryStreamsExpiringBeforeResponse QueryStreamsExpiringBeforeResponse
+
+func (x *QueryStreamsExpiringBeforeResponse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QueryStreamsExpiringBeforeResponse)(x)
+}
+
+func (x *QueryStreamsExpiringBeforeResponse) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_stream_v1_query_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QueryStreamsExpiringBeforeResponse_messageType fastReflection_QueryStreamsExpiringBeforeResponse_messageType
+var _ protoreflect.MessageType = fastReflection_QueryStreamsExpiringBeforeResponse_messageType{}
+
+type fastReflection_QueryStreamsExpiringBeforeResponse_messageType struct{}
+
+func (x fastReflection_QueryStreamsExpiringBeforeResponse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QueryStreamsExpiringBeforeResponse)(nil)
+}
+func (x fastReflection_QueryStreamsExpiringBeforeResponse_messageType) New() protoreflect.Message {
+	return new(fastReflection_QueryStreamsExpiringBeforeResponse)
+}
+func (x fastReflection_QueryStreamsExpiringBeforeResponse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStreamsExpiringBeforeResponse
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QueryStreamsExpiringBeforeResponse) Descriptor() protoreflect.MessageDescriptor {
+	return md_QueryStreamsExpiringBeforeResponse
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QueryStreamsExpiringBeforeResponse) Type() protoreflect.MessageType {
+	return _fastReflection_QueryStreamsExpiringBeforeResponse_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QueryStreamsExpiringBeforeResponse) New() protoreflect.Message {
+	return new(fastReflection_QueryStreamsExpiringBeforeResponse)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QueryStreamsExpiringBeforeResponse) Interface() protoreflect.ProtoMessage {
+	return (*QueryStreamsExpiringBeforeResponse)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QueryStreamsExpiringBeforeResponse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Streams) != 0 {
+		value := protoreflect.ValueOfList(&_QueryStreamsExpiringBeforeResponse_1_list{list: &x.Streams})
+		if !f(fd_QueryStreamsExpiringBeforeResponse_streams, value) {
+			return
+		}
+	}
+	if x.Pagination != nil {
+		value := protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+		if !f(fd_QueryStreamsExpiringBeforeResponse_pagination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QueryStreamsExpiringBeforeResponse) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeResponse.streams":
+		return len(x.Streams) != 0
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeResponse.pagination":
+		return x.Pagination != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamsExpiringBeforeResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamsExpiringBeforeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamsExpiringBeforeResponse) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeResponse.streams":
+		x.Streams = nil
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeResponse.pagination":
+		x.Pagination = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamsExpiringBeforeResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamsExpiringBeforeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QueryStreamsExpiringBeforeResponse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeResponse.streams":
+		if len(x.Streams) == 0 {
+			return protoreflect.ValueOfList(&_QueryStreamsExpiringBeforeResponse_1_list{})
+		}
+		listValue := &_QueryStreamsExpiringBeforeResponse_1_list{list: &x.Streams}
+		return protoreflect.ValueOfList(listValue)
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeResponse.pagination":
+		value := x.Pagination
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamsExpiringBeforeResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamsExpiringBeforeResponse does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamsExpiringBeforeResponse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeResponse.streams":
+		lv := value.List()
+		clv := lv.(*_QueryStreamsExpiringBeforeResponse_1_list)
+		x.Streams = *clv.list
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeResponse.pagination":
+		x.Pagination = value.Message().Interface().(*v1beta11.PageResponse)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamsExpiringBeforeResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamsExpiringBeforeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamsExpiringBeforeResponse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeResponse.streams":
+		if x.Streams == nil {
+			x.Streams = []*StreamResult{}
+		}
+		value := &_QueryStreamsExpiringBeforeResponse_1_list{list: &x.Streams}
+		return protoreflect.ValueOfList(value)
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeResponse.pagination":
+		if x.Pagination == nil {
+			x.Pagination = new(v1beta11.PageResponse)
+		}
+		return protoreflect.ValueOfMessage(x.Pagination.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamsExpiringBeforeResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamsExpiringBeforeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QueryStreamsExpiringBeforeResponse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeResponse.streams":
+		list := []*StreamResult{}
+		return protoreflect.ValueOfList(&_QueryStreamsExpiringBeforeResponse_1_list{list: &list})
+	case "mainchain.stream.v1.QueryStreamsExpiringBeforeResponse.pagination":
+		m := new(v1beta11.PageResponse)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.QueryStreamsExpiringBeforeResponse"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.QueryStreamsExpiringBeforeResponse does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QueryStreamsExpiringBeforeResponse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.stream.v1.QueryStreamsExpiringBeforeResponse", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QueryStreamsExpiringBeforeResponse) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QueryStreamsExpiringBeforeResponse) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QueryStreamsExpiringBeforeResponse) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QueryStreamsExpiringBeforeResponse) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QueryStreamsExpiringBeforeResponse)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.Streams) > 0 {
+			for _, e := range x.Streams {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Pagination != nil {
+			l = options.Size(x.Pagination)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QueryStreamsExpiringBeforeResponse)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Pagination != nil {
+			encoded, err := options.Marshal(x.Pagination)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Streams) > 0 {
+			for iNdEx := len(x.Streams) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Streams[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QueryStreamsExpiringBeforeResponse)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStreamsExpiringBeforeResponse: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QueryStreamsExpiringBeforeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Streams = append(x.Streams, &StreamResult{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Streams[len(x.Streams)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Pagination == nil {
+					x.Pagination = &v1beta11.PageResponse{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Pagination); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: mainchain/stream/v1/query.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StreamResult contains data about a stream
+type StreamResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// receiver is the wallet that will receive stream payments
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// sender is the wallet making the update
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// stream is the stream data
+	Stream *Stream `protobuf:"bytes,3,opt,name=stream,proto3" json:"stream,omitempty"`
+}
+
+func (x *StreamResult) Reset() {
+	*x = StreamResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamResult) ProtoMessage() {}
+
+// Deprecated: Use StreamResult.ProtoReflect.Descriptor instead.
+func (*StreamResult) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StreamResult) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *StreamResult) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *StreamResult) GetStream() *Stream {
+	if x != nil {
+		return x.Stream
+	}
+	return nil
+}
+
+// QueryParamsRequest is request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *QueryParamsRequest) Reset() {
+	*x = QueryParamsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+// QueryParamsResponse is response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// params holds all the parameters of this module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (x *QueryParamsResponse) Reset() {
+	*x = QueryParamsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryParamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryParamsResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryParamsResponse) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// QueryCalculateFlowRateRequest is the request type for the Query/CalculateFlowRate RPC method
+type QueryCalculateFlowRateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// coin is the coin to be used in the calculation, e.g. 10000000000nund. This is the total amount to be paid over
+	// the specified duration
+	Coin string `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`
+	// period is the enumerated value of a period to be used in the calculation, e.g. month = 6
+	Period StreamPeriod `protobuf:"varint,2,opt,name=period,proto3,enum=mainchain.stream.v1.StreamPeriod" json:"period,omitempty"`
+	// duration is the number of periods to be used in the calculation, e.g. 2 = 2 months
+	Duration uint64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *QueryCalculateFlowRateRequest) Reset() {
+	*x = QueryCalculateFlowRateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCalculateFlowRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCalculateFlowRateRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryCalculateFlowRateRequest.ProtoReflect.Descriptor instead.
+func (*QueryCalculateFlowRateRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *QueryCalculateFlowRateRequest) GetCoin() string {
+	if x != nil {
+		return x.Coin
+	}
+	return ""
+}
+
+func (x *QueryCalculateFlowRateRequest) GetPeriod() StreamPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return StreamPeriod_STREAM_PERIOD_UNSPECIFIED
+}
+
+func (x *QueryCalculateFlowRateRequest) GetDuration() uint64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+// QueryCalculateFlowRateResponse is the response type for the Query/CalculateFlowRate RPC method
+type QueryCalculateFlowRateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// coin is the coin used in the calculation
+	Coin *v1beta1.Coin `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`
+	// period is the enumerated value of a period used in the calculation, e.g. month = 6
+	Period StreamPeriod `protobuf:"varint,2,opt,name=period,proto3,enum=mainchain.stream.v1.StreamPeriod" json:"period,omitempty"`
+	// duration is the number of periods used in the calculation, e.g. 2 = 2 months
+	Duration uint64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// seconds is the calculated number of seconds for the stream (seconds in period * duration)
+	Seconds uint64 `protobuf:"varint,4,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	// flow_rate is the calculated rate of coins per second, in the lowest denomination - e.g. nund
+	FlowRate int64 `protobuf:"varint,5,opt,name=flow_rate,json=flowRate,proto3" json:"flow_rate,omitempty"`
+}
+
+func (x *QueryCalculateFlowRateResponse) Reset() {
+	*x = QueryCalculateFlowRateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryCalculateFlowRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryCalculateFlowRateResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryCalculateFlowRateResponse.ProtoReflect.Descriptor instead.
+func (*QueryCalculateFlowRateResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *QueryCalculateFlowRateResponse) GetCoin() *v1beta1.Coin {
+	if x != nil {
+		return x.Coin
+	}
+	return nil
+}
+
+func (x *QueryCalculateFlowRateResponse) GetPeriod() StreamPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return StreamPeriod_STREAM_PERIOD_UNSPECIFIED
+}
+
+func (x *QueryCalculateFlowRateResponse) GetDuration() uint64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *QueryCalculateFlowRateResponse) GetSeconds() uint64 {
+	if x != nil {
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *QueryCalculateFlowRateResponse) GetFlowRate() int64 {
+	if x != nil {
+		return x.FlowRate
+	}
+	return 0
+}
+
+// QueryStreamsRequest is the request type for the Query/Streams RPC method
+type QueryStreamsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Pagination *v1beta11.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryParamsRequest) Reset() {
-	*x = QueryParamsRequest{}
+func (x *QueryStreamsRequest) Reset() {
+	*x = QueryStreamsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_stream_v1_query_proto_msgTypes[1]
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsRequest) String() string {
+func (x *QueryStreamsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsRequest) ProtoMessage() {}
+func (*QueryStreamsRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use QueryStreamsRequest.ProtoReflect.Descriptor instead.
+func (*QueryStreamsRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{5}
 }
 
-// QueryParamsResponse is response type for the Query/Params RPC method.
-type QueryParamsResponse struct {
+func (x *QueryStreamsRequest) GetPagination() *v1beta11.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryStreamsResponse is the response type for the Query/Streams RPC method
+type QueryStreamsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// params holds all the parameters of this module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// streams is the list of all streams
+	Streams []*StreamResult `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta11.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryParamsResponse) Reset() {
-	*x = QueryParamsResponse{}
+func (x *QueryStreamsResponse) Reset() {
+	*x = QueryStreamsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_stream_v1_query_proto_msgTypes[2]
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryParamsResponse) String() string {
+func (x *QueryStreamsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryParamsResponse) ProtoMessage() {}
+func (*QueryStreamsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryParamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use QueryStreamsResponse.ProtoReflect.Descriptor instead.
+func (*QueryStreamsResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *QueryParamsResponse) GetParams() *Params {
+func (x *QueryStreamsResponse) GetStreams() []*StreamResult {
 	if x != nil {
-		return x.Params
+		return x.Streams
+	}
+	return nil
+}
+
+func (x *QueryStreamsResponse) GetPagination() *v1beta11.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryAllStreamsForReceiverRequest is the request type for the Query/AllStreamsForReceiver RPC method
+type QueryAllStreamsForReceiverRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// receiver_addr is the receiver wallet address being queried
+	ReceiverAddr string `protobuf:"bytes,1,opt,name=receiver_addr,json=receiverAddr,proto3" json:"receiver_addr,omitempty"`
+	// pagination is the pagination parameters for the request
+	Pagination *v1beta11.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// denom optionally filters the results by stream denomination
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (x *QueryAllStreamsForReceiverRequest) Reset() {
+	*x = QueryAllStreamsForReceiverRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllStreamsForReceiverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllStreamsForReceiverRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryAllStreamsForReceiverRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllStreamsForReceiverRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *QueryAllStreamsForReceiverRequest) GetReceiverAddr() string {
+	if x != nil {
+		return x.ReceiverAddr
+	}
+	return ""
+}
+
+func (x *QueryAllStreamsForReceiverRequest) GetPagination() *v1beta11.PageRequest {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *QueryAllStreamsForReceiverRequest) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+// QueryAllStreamsForReceiverResponse is the response type for the Query/AllStreamsForReceiver RPC method
+type QueryAllStreamsForReceiverResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// receiver_addr is the receiver wallet address being queried
+	ReceiverAddr string `protobuf:"bytes,1,opt,name=receiver_addr,json=receiverAddr,proto3" json:"receiver_addr,omitempty"`
+	// streams is the list of all streams
+	Streams []*StreamResult `protobuf:"bytes,2,rep,name=streams,proto3" json:"streams,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *v1beta11.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *QueryAllStreamsForReceiverResponse) Reset() {
+	*x = QueryAllStreamsForReceiverResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryAllStreamsForReceiverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAllStreamsForReceiverResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryAllStreamsForReceiverResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllStreamsForReceiverResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *QueryAllStreamsForReceiverResponse) GetReceiverAddr() string {
+	if x != nil {
+		return x.ReceiverAddr
+	}
+	return ""
+}
+
+func (x *QueryAllStreamsForReceiverResponse) GetStreams() []*StreamResult {
+	if x != nil {
+		return x.Streams
+	}
+	return nil
+}
+
+func (x *QueryAllStreamsForReceiverResponse) GetPagination() *v1beta11.PageResponse {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+// QueryStreamByReceiverSenderRequest is the request type for the Query/StreamByReceiverSender RPC method
+type QueryStreamByReceiverSenderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// receiver_addr is the receiver wallet address being queried
+	ReceiverAddr string `protobuf:"bytes,1,opt,name=receiver_addr,json=receiverAddr,proto3" json:"receiver_addr,omitempty"`
+	// sender_addr is the sender wallet address being queried
+	SenderAddr string `protobuf:"bytes,2,opt,name=sender_addr,json=senderAddr,proto3" json:"sender_addr,omitempty"`
+	// denom is the denomination of the stream being queried. Optional if the pair only has a single stream
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (x *QueryStreamByReceiverSenderRequest) Reset() {
+	*x = QueryStreamByReceiverSenderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryStreamByReceiverSenderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryStreamByReceiverSenderRequest) ProtoMessage() {}
+
+// Deprecated: Use QueryStreamByReceiverSenderRequest.ProtoReflect.Descriptor instead.
+func (*QueryStreamByReceiverSenderRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *QueryStreamByReceiverSenderRequest) GetReceiverAddr() string {
+	if x != nil {
+		return x.ReceiverAddr
+	}
+	return ""
+}
+
+func (x *QueryStreamByReceiverSenderRequest) GetSenderAddr() string {
+	if x != nil {
+		return x.SenderAddr
+	}
+	return ""
+}
+
+func (x *QueryStreamByReceiverSenderRequest) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+// QueryStreamByReceiverSenderResponse is the response type for the Query/StreamByReceiverSender RPC method
+type QueryStreamByReceiverSenderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// stream is the StreamResult
+	Stream *StreamResult `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+}
+
+func (x *QueryStreamByReceiverSenderResponse) Reset() {
+	*x = QueryStreamByReceiverSenderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QueryStreamByReceiverSenderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryStreamByReceiverSenderResponse) ProtoMessage() {}
+
+// Deprecated: Use QueryStreamByReceiverSenderResponse.ProtoReflect.Descriptor instead.
+func (*QueryStreamByReceiverSenderResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *QueryStreamByReceiverSenderResponse) GetStream() *StreamResult {
+	if x != nil {
+		return x.Stream
 	}
 	return nil
 }
 
-// QueryCalculateFlowRateRequest is the request type for the Query/CalculateFlowRate RPC method
-type QueryCalculateFlowRateRequest struct {
+// QueryStreamReceiverSenderCurrentFlowRequest is the request type for the Query/StreamReceiverSenderCurrentFlow RPC method
+type QueryStreamReceiverSenderCurrentFlowRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// coin is the coin to be used in the calculation, e.g. 10000000000nund. This is the total amount to be paid over
-	// the specified duration
-	Coin string `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`
-	// period is the enumerated value of a period to be used in the calculation, e.g. month = 6
-	Period StreamPeriod `protobuf:"varint,2,opt,name=period,proto3,enum=mainchain.stream.v1.StreamPeriod" json:"period,omitempty"`
-	// duration is the number of periods to be used in the calculation, e.g. 2 = 2 months
-	Duration uint64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	// receiver_addr is the receiver wallet address being queried
+	ReceiverAddr string `protobuf:"bytes,1,opt,name=receiver_addr,json=receiverAddr,proto3" json:"receiver_addr,omitempty"`
+	// sender_addr is the sender wallet address being queried
+	SenderAddr string `protobuf:"bytes,2,opt,name=sender_addr,json=senderAddr,proto3" json:"sender_addr,omitempty"`
+	// denom is the denomination of the stream being queried. Optional if the pair only has a single stream
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (x *QueryCalculateFlowRateRequest) Reset() {
-	*x = QueryCalculateFlowRateRequest{}
+func (x *QueryStreamReceiverSenderCurrentFlowRequest) Reset() {
+	*x = QueryStreamReceiverSenderCurrentFlowRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_stream_v1_query_proto_msgTypes[3]
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCalculateFlowRateRequest) String() string {
+func (x *QueryStreamReceiverSenderCurrentFlowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCalculateFlowRateRequest) ProtoMessage() {}
+func (*QueryStreamReceiverSenderCurrentFlowRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryCalculateFlowRateRequest.ProtoReflect.Descriptor instead.
-func (*QueryCalculateFlowRateRequest) Descriptor() ([]byte, []int) {
-	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use QueryStreamReceiverSenderCurrentFlowRequest.ProtoReflect.Descriptor instead.
+func (*QueryStreamReceiverSenderCurrentFlowRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *QueryCalculateFlowRateRequest) GetCoin() string {
+func (x *QueryStreamReceiverSenderCurrentFlowRequest) GetReceiverAddr() string {
 	if x != nil {
-		return x.Coin
+		return x.ReceiverAddr
 	}
 	return ""
 }
 
-func (x *QueryCalculateFlowRateRequest) GetPeriod() StreamPeriod {
+func (x *QueryStreamReceiverSenderCurrentFlowRequest) GetSenderAddr() string {
 	if x != nil {
-		return x.Period
+		return x.SenderAddr
 	}
-	return StreamPeriod_STREAM_PERIOD_UNSPECIFIED
+	return ""
 }
 
-func (x *QueryCalculateFlowRateRequest) GetDuration() uint64 {
+func (x *QueryStreamReceiverSenderCurrentFlowRequest) GetDenom() string {
 	if x != nil {
-		return x.Duration
+		return x.Denom
 	}
-	return 0
+	return ""
 }
 
-// QueryCalculateFlowRateResponse is the response type for the Query/CalculateFlowRate RPC method
-type QueryCalculateFlowRateResponse struct {
+// QueryStreamReceiverSenderCurrentFlowResponse is the response type for the Query/StreamReceiverSenderCurrentFlow RPC method
+type QueryStreamReceiverSenderCurrentFlowResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// coin is the coin used in the calculation
-	Coin *v1beta1.Coin `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`
-	// period is the enumerated value of a period used in the calculation, e.g. month = 6
-	Period StreamPeriod `protobuf:"varint,2,opt,name=period,proto3,enum=mainchain.stream.v1.StreamPeriod" json:"period,omitempty"`
-	// duration is the number of periods used in the calculation, e.g. 2 = 2 months
-	Duration uint64 `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
-	// seconds is the calculated number of seconds for the stream (seconds in period * duration)
-	Seconds uint64 `protobuf:"varint,4,opt,name=seconds,proto3" json:"seconds,omitempty"`
-	// flow_rate is the calculated rate of coins per second, in the lowest denomination - e.g. nund
-	FlowRate int64 `protobuf:"varint,5,opt,name=flow_rate,json=flowRate,proto3" json:"flow_rate,omitempty"`
+	// configured_flow_rate is the flow rate configured in the stream
+	ConfiguredFlowRate int64 `protobuf:"varint,1,opt,name=configured_flow_rate,json=configuredFlowRate,proto3" json:"configured_flow_rate,omitempty"`
+	// current_flow_rate is the actual flow rate. This will be zero if the depositZeroTime has passed, or deposit is zero
+	CurrentFlowRate int64 `protobuf:"varint,2,opt,name=current_flow_rate,json=currentFlowRate,proto3" json:"current_flow_rate,omitempty"`
+	// status is the current status of the stream - pending, cliff, flowing or ended
+	Status StreamStatus `protobuf:"varint,3,opt,name=status,proto3,enum=mainchain.stream.v1.StreamStatus" json:"status,omitempty"`
 }
 
-func (x *QueryCalculateFlowRateResponse) Reset() {
-	*x = QueryCalculateFlowRateResponse{}
+func (x *QueryStreamReceiverSenderCurrentFlowResponse) Reset() {
+	*x = QueryStreamReceiverSenderCurrentFlowResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_stream_v1_query_proto_msgTypes[4]
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryCalculateFlowRateResponse) String() string {
+func (x *QueryStreamReceiverSenderCurrentFlowResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryCalculateFlowRateResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryCalculateFlowRateResponse.ProtoReflect.Descriptor instead.
-func (*QueryCalculateFlowRateResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *QueryCalculateFlowRateResponse) GetCoin() *v1beta1.Coin {
-	if x != nil {
-		return x.Coin
-	}
-	return nil
-}
+func (*QueryStreamReceiverSenderCurrentFlowResponse) ProtoMessage() {}
 
-func (x *QueryCalculateFlowRateResponse) GetPeriod() StreamPeriod {
-	if x != nil {
-		return x.Period
-	}
-	return StreamPeriod_STREAM_PERIOD_UNSPECIFIED
+// Deprecated: Use QueryStreamReceiverSenderCurrentFlowResponse.ProtoReflect.Descriptor instead.
+func (*QueryStreamReceiverSenderCurrentFlowResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *QueryCalculateFlowRateResponse) GetDuration() uint64 {
+func (x *QueryStreamReceiverSenderCurrentFlowResponse) GetConfiguredFlowRate() int64 {
 	if x != nil {
-		return x.Duration
+		return x.ConfiguredFlowRate
 	}
 	return 0
 }
 
-func (x *QueryCalculateFlowRateResponse) GetSeconds() uint64 {
+func (x *QueryStreamReceiverSenderCurrentFlowResponse) GetCurrentFlowRate() int64 {
 	if x != nil {
-		return x.Seconds
+		return x.CurrentFlowRate
 	}
 	return 0
 }
 
-func (x *QueryCalculateFlowRateResponse) GetFlowRate() int64 {
+func (x *QueryStreamReceiverSenderCurrentFlowResponse) GetStatus() StreamStatus {
 	if x != nil {
-		return x.FlowRate
+		return x.Status
 	}
-	return 0
+	return StreamStatus_STREAM_STATUS_UNSPECIFIED
 }
 
-// QueryStreamsRequest is the request type for the Query/Streams RPC method
-type QueryStreamsRequest struct {
+// QueryAllStreamsForSenderRequest is the request type for the Query/AllStreamsForSender RPC method
+type QueryAllStreamsForSenderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *v1beta11.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// sender_addr is the receiver wallet address being queried
+	SenderAddr string `protobuf:"bytes,1,opt,name=sender_addr,json=senderAddr,proto3" json:"sender_addr,omitempty"`
+	// pagination is the pagination parameters for the request
+	Pagination *v1beta11.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// denom optionally filters the results by stream denomination
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (x *QueryStreamsRequest) Reset() {
-	*x = QueryStreamsRequest{}
+func (x *QueryAllStreamsForSenderRequest) Reset() {
+	*x = QueryAllStreamsForSenderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_stream_v1_query_proto_msgTypes[5]
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryStreamsRequest) String() string {
+func (x *QueryAllStreamsForSenderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryStreamsRequest) ProtoMessage() {}
+func (*QueryAllStreamsForSenderRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryStreamsRequest.ProtoReflect.Descriptor instead.
-func (*QueryStreamsRequest) Descriptor() ([]byte, []int) {
-	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use QueryAllStreamsForSenderRequest.ProtoReflect.Descriptor instead.
+func (*QueryAllStreamsForSenderRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *QueryStreamsRequest) GetPagination() *v1beta11.PageRequest {
+func (x *QueryAllStreamsForSenderRequest) GetSenderAddr() string {
+	if x != nil {
+		return x.SenderAddr
+	}
+	return ""
+}
+
+func (x *QueryAllStreamsForSenderRequest) GetPagination() *v1beta11.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryStreamsResponse is the response type for the Query/Streams RPC method
-type QueryStreamsResponse struct {
+func (x *QueryAllStreamsForSenderRequest) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+// QueryAllStreamsForSenderResponse is the response type for the Query/AllStreamsForSender RPC method
+type QueryAllStreamsForSenderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// sender_addr is the receiver wallet address being queried
+	SenderAddr string `protobuf:"bytes,1,opt,name=sender_addr,json=senderAddr,proto3" json:"sender_addr,omitempty"`
 	// streams is the list of all streams
-	Streams []*StreamResult `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+	Streams []*StreamResult `protobuf:"bytes,2,rep,name=streams,proto3" json:"streams,omitempty"`
 	// pagination defines the pagination in the response.
-	Pagination *v1beta11.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination *v1beta11.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryStreamsResponse) Reset() {
-	*x = QueryStreamsResponse{}
+func (x *QueryAllStreamsForSenderResponse) Reset() {
+	*x = QueryAllStreamsForSenderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_stream_v1_query_proto_msgTypes[6]
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryStreamsResponse) String() string {
+func (x *QueryAllStreamsForSenderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryStreamsResponse) ProtoMessage() {}
+func (*QueryAllStreamsForSenderResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryStreamsResponse.ProtoReflect.Descriptor instead.
-func (*QueryStreamsResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use QueryAllStreamsForSenderResponse.ProtoReflect.Descriptor instead.
+func (*QueryAllStreamsForSenderResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QueryStreamsResponse) GetStreams() []*StreamResult {
+func (x *QueryAllStreamsForSenderResponse) GetSenderAddr() string {
+	if x != nil {
+		return x.SenderAddr
+	}
+	return ""
+}
+
+func (x *QueryAllStreamsForSenderResponse) GetStreams() []*StreamResult {
 	if x != nil {
 		return x.Streams
 	}
 	return nil
 }
 
-func (x *QueryStreamsResponse) GetPagination() *v1beta11.PageResponse {
+func (x *QueryAllStreamsForSenderResponse) GetPagination() *v1beta11.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryAllStreamsForReceiverRequest is the request type for the Query/AllStreamsForReceiver RPC method
-type QueryAllStreamsForReceiverRequest struct {
+// QueryStreamByIdRequest is the request type for the Query/StreamById RPC method
+type QueryStreamByIdRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// receiver_addr is the receiver wallet address being queried
-	ReceiverAddr string `protobuf:"bytes,1,opt,name=receiver_addr,json=receiverAddr,proto3" json:"receiver_addr,omitempty"`
-	// pagination is the pagination parameters for the request
-	Pagination *v1beta11.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// stream_id is the ID of the stream being queried
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 }
 
-func (x *QueryAllStreamsForReceiverRequest) Reset() {
-	*x = QueryAllStreamsForReceiverRequest{}
+func (x *QueryStreamByIdRequest) Reset() {
+	*x = QueryStreamByIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_stream_v1_query_proto_msgTypes[7]
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllStreamsForReceiverRequest) String() string {
+func (x *QueryStreamByIdRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllStreamsForReceiverRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryAllStreamsForReceiverRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllStreamsForReceiverRequest) Descriptor() ([]byte, []int) {
-	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{7}
-}
+func (*QueryStreamByIdRequest) ProtoMessage() {}
 
-func (x *QueryAllStreamsForReceiverRequest) GetReceiverAddr() string {
-	if x != nil {
-		return x.ReceiverAddr
-	}
-	return ""
+// Deprecated: Use QueryStreamByIdRequest.ProtoReflect.Descriptor instead.
+func (*QueryStreamByIdRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *QueryAllStreamsForReceiverRequest) GetPagination() *v1beta11.PageRequest {
+func (x *QueryStreamByIdRequest) GetStreamId() uint64 {
 	if x != nil {
-		return x.Pagination
+		return x.StreamId
 	}
-	return nil
+	return 0
 }
 
-// QueryAllStreamsForReceiverResponse is the response type for the Query/AllStreamsForReceiver RPC method
-type QueryAllStreamsForReceiverResponse struct {
+// QueryStreamByIdResponse is the response type for the Query/StreamById RPC method
+type QueryStreamByIdResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// receiver_addr is the receiver wallet address being queried
-	ReceiverAddr string `protobuf:"bytes,1,opt,name=receiver_addr,json=receiverAddr,proto3" json:"receiver_addr,omitempty"`
-	// streams is the list of all streams
-	Streams []*StreamResult `protobuf:"bytes,2,rep,name=streams,proto3" json:"streams,omitempty"`
-	// pagination defines the pagination in the response.
-	Pagination *v1beta11.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// stream is the StreamResult
+	Stream *StreamResult `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
 }
 
-func (x *QueryAllStreamsForReceiverResponse) Reset() {
-	*x = QueryAllStreamsForReceiverResponse{}
+func (x *QueryStreamByIdResponse) Reset() {
+	*x = QueryStreamByIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_stream_v1_query_proto_msgTypes[8]
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllStreamsForReceiverResponse) String() string {
+func (x *QueryStreamByIdResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllStreamsForReceiverResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryAllStreamsForReceiverResponse.ProtoReflect.Descriptor instead.
-func (*QueryAllStreamsForReceiverResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{8}
-}
-
-func (x *QueryAllStreamsForReceiverResponse) GetReceiverAddr() string {
-	if x != nil {
-		return x.ReceiverAddr
-	}
-	return ""
-}
+func (*QueryStreamByIdResponse) ProtoMessage() {}
 
-func (x *QueryAllStreamsForReceiverResponse) GetStreams() []*StreamResult {
-	if x != nil {
-		return x.Streams
-	}
-	return nil
+// Deprecated: Use QueryStreamByIdResponse.ProtoReflect.Descriptor instead.
+func (*QueryStreamByIdResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QueryAllStreamsForReceiverResponse) GetPagination() *v1beta11.PageResponse {
+func (x *QueryStreamByIdResponse) GetStream() *StreamResult {
 	if x != nil {
-		return x.Pagination
+		return x.Stream
 	}
 	return nil
 }
 
-// QueryStreamByReceiverSenderRequest is the request type for the Query/StreamByReceiverSender RPC method
-type QueryStreamByReceiverSenderRequest struct {
+// QueryStreamStatsRequest is the request type for the Query/StreamStats RPC method
+type QueryStreamStatsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// receiver_addr is the receiver wallet address being queried
-	ReceiverAddr string `protobuf:"bytes,1,opt,name=receiver_addr,json=receiverAddr,proto3" json:"receiver_addr,omitempty"`
-	// sender_addr is the sender wallet address being queried
-	SenderAddr string `protobuf:"bytes,2,opt,name=sender_addr,json=senderAddr,proto3" json:"sender_addr,omitempty"`
+	// stream_id is the ID of the stream being queried
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 }
 
-func (x *QueryStreamByReceiverSenderRequest) Reset() {
-	*x = QueryStreamByReceiverSenderRequest{}
+func (x *QueryStreamStatsRequest) Reset() {
+	*x = QueryStreamStatsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_stream_v1_query_proto_msgTypes[9]
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryStreamByReceiverSenderRequest) String() string {
+func (x *QueryStreamStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryStreamByReceiverSenderRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryStreamByReceiverSenderRequest.ProtoReflect.Descriptor instead.
-func (*QueryStreamByReceiverSenderRequest) Descriptor() ([]byte, []int) {
-	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{9}
-}
+func (*QueryStreamStatsRequest) ProtoMessage() {}
 
-func (x *QueryStreamByReceiverSenderRequest) GetReceiverAddr() string {
-	if x != nil {
-		return x.ReceiverAddr
-	}
-	return ""
+// Deprecated: Use QueryStreamStatsRequest.ProtoReflect.Descriptor instead.
+func (*QueryStreamStatsRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *QueryStreamByReceiverSenderRequest) GetSenderAddr() string {
+func (x *QueryStreamStatsRequest) GetStreamId() uint64 {
 	if x != nil {
-		return x.SenderAddr
+		return x.StreamId
 	}
-	return ""
+	return 0
 }
 
-// QueryStreamByReceiverSenderResponse is the response type for the Query/StreamByReceiverSender RPC method
-type QueryStreamByReceiverSenderResponse struct {
+// QueryStreamStatsResponse is the response type for the Query/StreamStats RPC method
+type QueryStreamStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// stream is the StreamResult
-	Stream *StreamResult `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream,omitempty"`
+	// stats are the stream's cumulative claim statistics
+	Stats *StreamStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
-func (x *QueryStreamByReceiverSenderResponse) Reset() {
-	*x = QueryStreamByReceiverSenderResponse{}
+func (x *QueryStreamStatsResponse) Reset() {
+	*x = QueryStreamStatsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_stream_v1_query_proto_msgTypes[10]
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryStreamByReceiverSenderResponse) String() string {
+func (x *QueryStreamStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryStreamByReceiverSenderResponse) ProtoMessage() {}
+func (*QueryStreamStatsResponse) ProtoMessage() {}
 
-// Deprecated: Use QueryStreamByReceiverSenderResponse.ProtoReflect.Descriptor instead.
-func (*QueryStreamByReceiverSenderResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use QueryStreamStatsResponse.ProtoReflect.Descriptor instead.
+func (*QueryStreamStatsResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *QueryStreamByReceiverSenderResponse) GetStream() *StreamResult {
+func (x *QueryStreamStatsResponse) GetStats() *StreamStats {
 	if x != nil {
-		return x.Stream
+		return x.Stats
 	}
 	return nil
 }
 
-// QueryStreamReceiverSenderCurrentFlowRequest is the request type for the Query/StreamReceiverSenderCurrentFlow RPC method
-type QueryStreamReceiverSenderCurrentFlowRequest struct {
+// QueryStreamHistoryRequest is the request type for the Query/StreamHistory RPC method
+type QueryStreamHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// receiver_addr is the receiver wallet address being queried
-	ReceiverAddr string `protobuf:"bytes,1,opt,name=receiver_addr,json=receiverAddr,proto3" json:"receiver_addr,omitempty"`
-	// sender_addr is the sender wallet address being queried
-	SenderAddr string `protobuf:"bytes,2,opt,name=sender_addr,json=senderAddr,proto3" json:"sender_addr,omitempty"`
+	// stream_id is the ID of the stream being queried
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 }
 
-func (x *QueryStreamReceiverSenderCurrentFlowRequest) Reset() {
-	*x = QueryStreamReceiverSenderCurrentFlowRequest{}
+func (x *QueryStreamHistoryRequest) Reset() {
+	*x = QueryStreamHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_stream_v1_query_proto_msgTypes[11]
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryStreamReceiverSenderCurrentFlowRequest) String() string {
+func (x *QueryStreamHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryStreamReceiverSenderCurrentFlowRequest) ProtoMessage() {}
-
-// Deprecated: Use QueryStreamReceiverSenderCurrentFlowRequest.ProtoReflect.Descriptor instead.
-func (*QueryStreamReceiverSenderCurrentFlowRequest) Descriptor() ([]byte, []int) {
-	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{11}
-}
+func (*QueryStreamHistoryRequest) ProtoMessage() {}
 
-func (x *QueryStreamReceiverSenderCurrentFlowRequest) GetReceiverAddr() string {
-	if x != nil {
-		return x.ReceiverAddr
-	}
-	return ""
+// Deprecated: Use QueryStreamHistoryRequest.ProtoReflect.Descriptor instead.
+func (*QueryStreamHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *QueryStreamReceiverSenderCurrentFlowRequest) GetSenderAddr() string {
+func (x *QueryStreamHistoryRequest) GetStreamId() uint64 {
 	if x != nil {
-		return x.SenderAddr
+		return x.StreamId
 	}
-	return ""
+	return 0
 }
 
-// QueryStreamReceiverSenderCurrentFlowResponse is the response type for the Query/StreamReceiverSenderCurrentFlow RPC method
-type QueryStreamReceiverSenderCurrentFlowResponse struct {
+// QueryStreamHistoryResponse is the response type for the Query/StreamHistory RPC method
+type QueryStreamHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// configured_flow_rate is the flow rate configured in the stream
-	ConfiguredFlowRate int64 `protobuf:"varint,1,opt,name=configured_flow_rate,json=configuredFlowRate,proto3" json:"configured_flow_rate,omitempty"`
-	// current_flow_rate is the actual flow rate. This will be zero if the depositZeroTime has passed, or deposit is zero
-	CurrentFlowRate int64 `protobuf:"varint,2,opt,name=current_flow_rate,json=currentFlowRate,proto3" json:"current_flow_rate,omitempty"`
+	// history is the stream's flow rate change history
+	History *StreamHistory `protobuf:"bytes,1,opt,name=history,proto3" json:"history,omitempty"`
 }
 
-func (x *QueryStreamReceiverSenderCurrentFlowResponse) Reset() {
-	*x = QueryStreamReceiverSenderCurrentFlowResponse{}
+func (x *QueryStreamHistoryResponse) Reset() {
+	*x = QueryStreamHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_stream_v1_query_proto_msgTypes[12]
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryStreamReceiverSenderCurrentFlowResponse) String() string {
+func (x *QueryStreamHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryStreamReceiverSenderCurrentFlowResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryStreamReceiverSenderCurrentFlowResponse.ProtoReflect.Descriptor instead.
-func (*QueryStreamReceiverSenderCurrentFlowResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{12}
-}
+func (*QueryStreamHistoryResponse) ProtoMessage() {}
 
-func (x *QueryStreamReceiverSenderCurrentFlowResponse) GetConfiguredFlowRate() int64 {
-	if x != nil {
-		return x.ConfiguredFlowRate
-	}
-	return 0
+// Deprecated: Use QueryStreamHistoryResponse.ProtoReflect.Descriptor instead.
+func (*QueryStreamHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *QueryStreamReceiverSenderCurrentFlowResponse) GetCurrentFlowRate() int64 {
+func (x *QueryStreamHistoryResponse) GetHistory() *StreamHistory {
 	if x != nil {
-		return x.CurrentFlowRate
+		return x.History
 	}
-	return 0
+	return nil
 }
 
-// QueryAllStreamsForSenderRequest is the request type for the Query/AllStreamsForSender RPC method
-type QueryAllStreamsForSenderRequest struct {
+// QueryStreamsExpiringBeforeRequest is the request type for the Query/StreamsExpiringBefore RPC method
+type QueryStreamsExpiringBeforeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sender_addr is the receiver wallet address being queried
-	SenderAddr string `protobuf:"bytes,1,opt,name=sender_addr,json=senderAddr,proto3" json:"sender_addr,omitempty"`
+	// before is the time streams must expire before
+	Before *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
 	// pagination is the pagination parameters for the request
 	Pagination *v1beta11.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryAllStreamsForSenderRequest) Reset() {
-	*x = QueryAllStreamsForSenderRequest{}
+func (x *QueryStreamsExpiringBeforeRequest) Reset() {
+	*x = QueryStreamsExpiringBeforeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_stream_v1_query_proto_msgTypes[13]
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllStreamsForSenderRequest) String() string {
+func (x *QueryStreamsExpiringBeforeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllStreamsForSenderRequest) ProtoMessage() {}
+func (*QueryStreamsExpiringBeforeRequest) ProtoMessage() {}
 
-// Deprecated: Use QueryAllStreamsForSenderRequest.ProtoReflect.Descriptor instead.
-func (*QueryAllStreamsForSenderRequest) Descriptor() ([]byte, []int) {
-	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use QueryStreamsExpiringBeforeRequest.ProtoReflect.Descriptor instead.
+func (*QueryStreamsExpiringBeforeRequest) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *QueryAllStreamsForSenderRequest) GetSenderAddr() string {
+func (x *QueryStreamsExpiringBeforeRequest) GetBefore() *timestamppb.Timestamp {
 	if x != nil {
-		return x.SenderAddr
+		return x.Before
 	}
-	return ""
+	return nil
 }
 
-func (x *QueryAllStreamsForSenderRequest) GetPagination() *v1beta11.PageRequest {
+func (x *QueryStreamsExpiringBeforeRequest) GetPagination() *v1beta11.PageRequest {
 	if x != nil {
 		return x.Pagination
 	}
 	return nil
 }
 
-// QueryAllStreamsForSenderResponse is the response type for the Query/AllStreamsForSender RPC method
-type QueryAllStreamsForSenderResponse struct {
+// QueryStreamsExpiringBeforeResponse is the response type for the Query/StreamsExpiringBefore RPC method
+type QueryStreamsExpiringBeforeResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sender_addr is the receiver wallet address being queried
-	SenderAddr string `protobuf:"bytes,1,opt,name=sender_addr,json=senderAddr,proto3" json:"sender_addr,omitempty"`
-	// streams is the list of all streams
-	Streams []*StreamResult `protobuf:"bytes,2,rep,name=streams,proto3" json:"streams,omitempty"`
+	// streams is the list of streams expiring before the given time
+	Streams []*StreamResult `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
 	// pagination defines the pagination in the response.
-	Pagination *v1beta11.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Pagination *v1beta11.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *QueryAllStreamsForSenderResponse) Reset() {
-	*x = QueryAllStreamsForSenderResponse{}
+func (x *QueryStreamsExpiringBeforeResponse) Reset() {
+	*x = QueryStreamsExpiringBeforeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_mainchain_stream_v1_query_proto_msgTypes[14]
+		mi := &file_mainchain_stream_v1_query_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *QueryAllStreamsForSenderResponse) String() string {
+func (x *QueryStreamsExpiringBeforeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueryAllStreamsForSenderResponse) ProtoMessage() {}
-
-// Deprecated: Use QueryAllStreamsForSenderResponse.ProtoReflect.Descriptor instead.
-func (*QueryAllStreamsForSenderResponse) Descriptor() ([]byte, []int) {
-	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{14}
-}
+func (*QueryStreamsExpiringBeforeResponse) ProtoMessage() {}
 
-func (x *QueryAllStreamsForSenderResponse) GetSenderAddr() string {
-	if x != nil {
-		return x.SenderAddr
-	}
-	return ""
+// Deprecated: Use QueryStreamsExpiringBeforeResponse.ProtoReflect.Descriptor instead.
+func (*QueryStreamsExpiringBeforeResponse) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_query_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *QueryAllStreamsForSenderResponse) GetStreams() []*StreamResult {
+func (x *QueryStreamsExpiringBeforeResponse) GetStreams() []*StreamResult {
 	if x != nil {
 		return x.Streams
 	}
 	return nil
 }
 
-func (x *QueryAllStreamsForSenderResponse) GetPagination() *v1beta11.PageResponse {
+func (x *QueryStreamsExpiringBeforeResponse) GetPagination() *v1beta11.PageResponse {
 	if x != nil {
 		return x.Pagination
 	}
@@ -8386,114 +12654,100 @@ var file_mainchain_stream_v1_query_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f,
 	0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
+	0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x01, 0x0a, 0x0c, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52,
+	0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a,
+	0x13, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
+	0x94, 0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x69, 0x6e, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x08, 0x88, 0xa0,
+	0x1f, 0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xe3, 0x01, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x69,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f,
+	0x69, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x12, 0x39,
+	0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x1b, 0x0a, 0x09, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65, 0x22, 0x5d, 0x0a, 0x13,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x01, 0x0a, 0x14,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc0, 0x01, 0x0a, 0x21, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x46, 0x6f,
+	0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3d, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
+	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0xe9, 0x01,
+	0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
 	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
-	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12,
-	0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x12, 0x33, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x06,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x14, 0x0a, 0x12, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x50, 0x0a, 0x13,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x94,
-	0x01, 0x0a, 0x1d, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
-	0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x63, 0x6f, 0x69, 0x6e, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x08, 0x88, 0xa0, 0x1f,
-	0x00, 0xe8, 0xa0, 0x1f, 0x00, 0x22, 0xe3, 0x01, 0x0a, 0x1e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43,
-	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x63, 0x6f, 0x69, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69,
-	0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x04, 0x63, 0x6f, 0x69, 0x6e, 0x12, 0x39, 0x0a,
-	0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e,
-	0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
-	0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1b,
-	0x0a, 0x09, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x08, 0x66, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65, 0x22, 0x5d, 0x0a, 0x13, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
-	0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a,
-	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x9c, 0x01, 0x0a, 0x14, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01,
+	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x02,
 	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
 	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
 	0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73,
-	0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
 	0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
 	0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaa, 0x01, 0x0a, 0x21, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x46, 0x6f, 0x72,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x3d, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x46,
-	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
-	0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe9, 0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a,
-	0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3b, 0x0a, 0x07,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
-	0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x52, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72,
-	0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x22, 0x9e, 0x01, 0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x42, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x72, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41,
-	0x64, 0x64, 0x72, 0x22, 0x6b, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x42, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x69,
-	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0xc8,
-	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x22, 0xa7, 0x01, 0x0a, 0x2b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb4, 0x01, 0x0a, 0x22, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x3d, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
 	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
@@ -8501,137 +12755,256 @@ var file_mainchain_stream_v1_query_proto_rawDesc = []byte{
 	0x39, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
 	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a,
-	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x22, 0x93, 0x01, 0x0a, 0x2c, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
-	0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46,
-	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x72,
-	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x75, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65, 0x12, 0x31, 0x0a,
-	0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x72, 0x61,
-	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x05, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
-	0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65,
-	0x22, 0xa4, 0x01, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12,
-	0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73,
-	0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
-	0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe3, 0x01, 0x0a, 0x20, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0b,
-	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x22, 0x6b, 0x0a, 0x23, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42,
+	0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0xbd, 0x01,
+	0x0a, 0x2b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a,
+	0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0c,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x39, 0x0a, 0x0b,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
 	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x0a, 0x73, 0x65, 0x6e,
-	0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
-	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62,
-	0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xae, 0x0a,
-	0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x80, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x61,
-	0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f,
-	0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xae, 0x01, 0x0a, 0x11, 0x43,
-	0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65,
-	0x12, 0x32, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63,
-	0x75, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x2a, 0x12, 0x28, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74,
-	0x65, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x52, 0x61, 0x74, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x07,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x28, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x22, 0x12, 0x20, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x73, 0x2f, 0x61, 0x6c, 0x6c, 0x12, 0xc7, 0x01, 0x0a, 0x15, 0x41, 0x6c, 0x6c, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
-	0x12, 0x36, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63,
+	0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0xce, 0x01,
+	0x0a, 0x2c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30,
+	0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x5f, 0x66, 0x6c, 0x6f,
+	0x77, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x64, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x31, 0x0a, 0x11, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77,
+	0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x05, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x52,
+	0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xba,
+	0x01, 0x0a, 0x1f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x39, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x46, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0xe3, 0x01, 0x0a, 0x20,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x46,
+	0x6f, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x39, 0x0a, 0x0b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x0a, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x12, 0x3b, 0x0a, 0x07, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d,
+	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52,
+	0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x35, 0x0a, 0x16, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x5f, 0x0a, 0x17, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x22, 0x36, 0x0a, 0x17, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x64, 0x22, 0x5d, 0x0a, 0x18, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d,
+	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x42, 0x09,
+	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
+	0x22, 0x38, 0x0a, 0x19, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x22, 0x65, 0x0a, 0x1a, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x09, 0xc8,
+	0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x22, 0xa9, 0x01, 0x0a, 0x21, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x62, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x42, 0x08, 0xc8, 0xde, 0x1f, 0x00, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x06, 0x62,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x46, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31,
+	0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xaa, 0x01,
+	0x0a, 0x22, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x73, 0x12, 0x47, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2e, 0x50, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a,
+	0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0xdf, 0x0f, 0x0a, 0x05, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x12, 0x80, 0x01, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x27, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63,
 	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x46, 0x6f,
-	0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x3d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x6d, 0x61, 0x69, 0x6e,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f,
-	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
-	0x2f, 0x7b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d,
-	0x12, 0xd8, 0x01, 0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x79, 0x52, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x37, 0x2e, 0x6d, 0x61,
-	0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76,
-	0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x79, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x75, 0x65, 0x72, 0x79, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x12, 0x1b, 0x2f, 0x6d, 0x61, 0x69,
+	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0xae, 0x01, 0x0a, 0x11, 0x43, 0x61, 0x6c, 0x63,
+	0x75, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65, 0x12, 0x32, 0x2e,
+	0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61,
+	0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x33, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x43, 0x61, 0x6c,
+	0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x12, 0x28,
+	0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x61, 0x6c, 0x63, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x5f, 0x66,
+	0x6c, 0x6f, 0x77, 0x5f, 0x52, 0x61, 0x74, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x07, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x73, 0x12, 0x28, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
 	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
-	0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x45, 0x12, 0x43, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x22, 0x12, 0x20, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x2f,
+	0x61, 0x6c, 0x6c, 0x12, 0xc7, 0x01, 0x0a, 0x15, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x36, 0x2e,
+	0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3d,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x37, 0x12, 0x35, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61,
 	0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72,
 	0x65, 0x61, 0x6d, 0x73, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x2f, 0x7b, 0x72,
-	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x2f, 0x7b, 0x73,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x12, 0x80, 0x02, 0x0a, 0x1f,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x53, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x12,
-	0x40, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43,
-	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x41, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74,
+	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x12, 0xd8, 0x01,
+	0x0a, 0x16, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x37, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x79, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x38, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74,
 	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x42, 0x79, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4b, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x45, 0x12, 0x43, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x73, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x2f, 0x7b, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x2f, 0x7b, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x12, 0x80, 0x02, 0x0a, 0x1f, 0x53, 0x74, 0x72,
 	0x65, 0x61, 0x6d, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x58, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x52, 0x12, 0x50, 0x2f, 0x6d,
-	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f,
-	0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x72, 0x2f, 0x7b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64,
-	0x64, 0x72, 0x7d, 0x2f, 0x7b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x7d, 0x2f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0xbd,
-	0x01, 0x0a, 0x13, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x46, 0x6f, 0x72,
-	0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x34, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65,
-	0x72, 0x79, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x46, 0x6f, 0x72, 0x53,
-	0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6d,
+	0x72, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x12, 0x40, 0x2e, 0x6d,
+	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x41,
+	0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x43, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x58, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x52, 0x12, 0x50, 0x2f, 0x6d, 0x61, 0x69, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x2f, 0x7b, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d,
+	0x2f, 0x7b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x2f, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0xbd, 0x01, 0x0a, 0x13,
+	0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x12, 0x34, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41,
+	0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6d, 0x61, 0x69, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x46,
+	0x6f, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x2f, 0x7b, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x12, 0x9c, 0x01, 0x0a, 0x0a,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x79, 0x49, 0x64, 0x12, 0x2b, 0x2e, 0x6d, 0x61, 0x69,
+	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x79, 0x49, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x79, 0x49, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x33, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2d, 0x12, 0x2b, 0x2f,
+	0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x69, 0x64, 0x2f, 0x7b,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0xa5, 0x01, 0x0a, 0x0b, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x6d, 0x61, 0x69,
+	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31,
+	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12,
+	0x31, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x69, 0x64,
+	0x2f, 0x7b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0xad, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x12, 0x2e, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x35, 0x12, 0x33, 0x2f,
+	0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x69, 0x64, 0x2f, 0x7b,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x12, 0xb7, 0x01, 0x0a, 0x15, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x45, 0x78,
+	0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x36, 0x2e, 0x6d,
 	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e,
-	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x41, 0x6c, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x73, 0x46, 0x6f, 0x72, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x6d, 0x61,
-	0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76,
-	0x31, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x2f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x2f, 0x7b, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x7d, 0x42, 0xc2,
-	0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x73,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x53, 0x58, 0xaa, 0x02, 0x13,
-	0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4d, 0x61, 0x69, 0x6e,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4d, 0x61,
-	0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x27, 0x12, 0x25, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x73, 0x2f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x69, 0x6e, 0x67, 0x42, 0xc2, 0x01, 0x0a,
+	0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x51, 0x75, 0x65, 0x72, 0x79, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x53, 0x58, 0xaa, 0x02, 0x13, 0x4d, 0x61,
+	0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x56,
+	0x31, 0xca, 0x02, 0x13, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
+	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4d, 0x61, 0x69, 0x6e,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -8646,7 +13019,7 @@ func file_mainchain_stream_v1_query_proto_rawDescGZIP() []byte {
 	return file_mainchain_stream_v1_query_proto_rawDescData
 }
 
-var file_mainchain_stream_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_mainchain_stream_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_mainchain_stream_v1_query_proto_goTypes = []interface{}{
 	(*StreamResult)(nil),                                 // 0: mainchain.stream.v1.StreamResult
 	(*QueryParamsRequest)(nil),                           // 1: mainchain.stream.v1.QueryParamsRequest
@@ -8663,48 +13036,76 @@ var file_mainchain_stream_v1_query_proto_goTypes = []interface{}{
 	(*QueryStreamReceiverSenderCurrentFlowResponse)(nil), // 12: mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse
 	(*QueryAllStreamsForSenderRequest)(nil),              // 13: mainchain.stream.v1.QueryAllStreamsForSenderRequest
 	(*QueryAllStreamsForSenderResponse)(nil),             // 14: mainchain.stream.v1.QueryAllStreamsForSenderResponse
-	(*Stream)(nil),                                       // 15: mainchain.stream.v1.Stream
-	(*Params)(nil),                                       // 16: mainchain.stream.v1.Params
-	(StreamPeriod)(0),                                    // 17: mainchain.stream.v1.StreamPeriod
-	(*v1beta1.Coin)(nil),                                 // 18: cosmos.base.v1beta1.Coin
-	(*v1beta11.PageRequest)(nil),                         // 19: cosmos.base.query.v1beta1.PageRequest
-	(*v1beta11.PageResponse)(nil),                        // 20: cosmos.base.query.v1beta1.PageResponse
+	(*QueryStreamByIdRequest)(nil),                       // 15: mainchain.stream.v1.QueryStreamByIdRequest
+	(*QueryStreamByIdResponse)(nil),                      // 16: mainchain.stream.v1.QueryStreamByIdResponse
+	(*QueryStreamStatsRequest)(nil),                      // 17: mainchain.stream.v1.QueryStreamStatsRequest
+	(*QueryStreamStatsResponse)(nil),                     // 18: mainchain.stream.v1.QueryStreamStatsResponse
+	(*QueryStreamHistoryRequest)(nil),                    // 19: mainchain.stream.v1.QueryStreamHistoryRequest
+	(*QueryStreamHistoryResponse)(nil),                   // 20: mainchain.stream.v1.QueryStreamHistoryResponse
+	(*QueryStreamsExpiringBeforeRequest)(nil),            // 21: mainchain.stream.v1.QueryStreamsExpiringBeforeRequest
+	(*QueryStreamsExpiringBeforeResponse)(nil),           // 22: mainchain.stream.v1.QueryStreamsExpiringBeforeResponse
+	(*Stream)(nil),                                       // 23: mainchain.stream.v1.Stream
+	(*Params)(nil),                                       // 24: mainchain.stream.v1.Params
+	(StreamPeriod)(0),                                    // 25: mainchain.stream.v1.StreamPeriod
+	(*v1beta1.Coin)(nil),                                 // 26: cosmos.base.v1beta1.Coin
+	(*v1beta11.PageRequest)(nil),                         // 27: cosmos.base.query.v1beta1.PageRequest
+	(*v1beta11.PageResponse)(nil),                        // 28: cosmos.base.query.v1beta1.PageResponse
+	(StreamStatus)(0),                                    // 29: mainchain.stream.v1.StreamStatus
+	(*StreamStats)(nil),                                  // 30: mainchain.stream.v1.StreamStats
+	(*StreamHistory)(nil),                                // 31: mainchain.stream.v1.StreamHistory
+	(*timestamppb.Timestamp)(nil),                        // 32: google.protobuf.Timestamp
 }
 var file_mainchain_stream_v1_query_proto_depIdxs = []int32{
-	15, // 0: mainchain.stream.v1.StreamResult.stream:type_name -> mainchain.stream.v1.Stream
-	16, // 1: mainchain.stream.v1.QueryParamsResponse.params:type_name -> mainchain.stream.v1.Params
-	17, // 2: mainchain.stream.v1.QueryCalculateFlowRateRequest.period:type_name -> mainchain.stream.v1.StreamPeriod
-	18, // 3: mainchain.stream.v1.QueryCalculateFlowRateResponse.coin:type_name -> cosmos.base.v1beta1.Coin
-	17, // 4: mainchain.stream.v1.QueryCalculateFlowRateResponse.period:type_name -> mainchain.stream.v1.StreamPeriod
-	19, // 5: mainchain.stream.v1.QueryStreamsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	23, // 0: mainchain.stream.v1.StreamResult.stream:type_name -> mainchain.stream.v1.Stream
+	24, // 1: mainchain.stream.v1.QueryParamsResponse.params:type_name -> mainchain.stream.v1.Params
+	25, // 2: mainchain.stream.v1.QueryCalculateFlowRateRequest.period:type_name -> mainchain.stream.v1.StreamPeriod
+	26, // 3: mainchain.stream.v1.QueryCalculateFlowRateResponse.coin:type_name -> cosmos.base.v1beta1.Coin
+	25, // 4: mainchain.stream.v1.QueryCalculateFlowRateResponse.period:type_name -> mainchain.stream.v1.StreamPeriod
+	27, // 5: mainchain.stream.v1.QueryStreamsRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
 	0,  // 6: mainchain.stream.v1.QueryStreamsResponse.streams:type_name -> mainchain.stream.v1.StreamResult
-	20, // 7: mainchain.stream.v1.QueryStreamsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	19, // 8: mainchain.stream.v1.QueryAllStreamsForReceiverRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	28, // 7: mainchain.stream.v1.QueryStreamsResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	27, // 8: mainchain.stream.v1.QueryAllStreamsForReceiverRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
 	0,  // 9: mainchain.stream.v1.QueryAllStreamsForReceiverResponse.streams:type_name -> mainchain.stream.v1.StreamResult
-	20, // 10: mainchain.stream.v1.QueryAllStreamsForReceiverResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	28, // 10: mainchain.stream.v1.QueryAllStreamsForReceiverResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
 	0,  // 11: mainchain.stream.v1.QueryStreamByReceiverSenderResponse.stream:type_name -> mainchain.stream.v1.StreamResult
-	19, // 12: mainchain.stream.v1.QueryAllStreamsForSenderRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
-	0,  // 13: mainchain.stream.v1.QueryAllStreamsForSenderResponse.streams:type_name -> mainchain.stream.v1.StreamResult
-	20, // 14: mainchain.stream.v1.QueryAllStreamsForSenderResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
-	1,  // 15: mainchain.stream.v1.Query.Params:input_type -> mainchain.stream.v1.QueryParamsRequest
-	3,  // 16: mainchain.stream.v1.Query.CalculateFlowRate:input_type -> mainchain.stream.v1.QueryCalculateFlowRateRequest
-	5,  // 17: mainchain.stream.v1.Query.Streams:input_type -> mainchain.stream.v1.QueryStreamsRequest
-	7,  // 18: mainchain.stream.v1.Query.AllStreamsForReceiver:input_type -> mainchain.stream.v1.QueryAllStreamsForReceiverRequest
-	9,  // 19: mainchain.stream.v1.Query.StreamByReceiverSender:input_type -> mainchain.stream.v1.QueryStreamByReceiverSenderRequest
-	11, // 20: mainchain.stream.v1.Query.StreamReceiverSenderCurrentFlow:input_type -> mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest
-	13, // 21: mainchain.stream.v1.Query.AllStreamsForSender:input_type -> mainchain.stream.v1.QueryAllStreamsForSenderRequest
-	2,  // 22: mainchain.stream.v1.Query.Params:output_type -> mainchain.stream.v1.QueryParamsResponse
-	4,  // 23: mainchain.stream.v1.Query.CalculateFlowRate:output_type -> mainchain.stream.v1.QueryCalculateFlowRateResponse
-	6,  // 24: mainchain.stream.v1.Query.Streams:output_type -> mainchain.stream.v1.QueryStreamsResponse
-	8,  // 25: mainchain.stream.v1.Query.AllStreamsForReceiver:output_type -> mainchain.stream.v1.QueryAllStreamsForReceiverResponse
-	10, // 26: mainchain.stream.v1.Query.StreamByReceiverSender:output_type -> mainchain.stream.v1.QueryStreamByReceiverSenderResponse
-	12, // 27: mainchain.stream.v1.Query.StreamReceiverSenderCurrentFlow:output_type -> mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse
-	14, // 28: mainchain.stream.v1.Query.AllStreamsForSender:output_type -> mainchain.stream.v1.QueryAllStreamsForSenderResponse
-	22, // [22:29] is the sub-list for method output_type
-	15, // [15:22] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	29, // 12: mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse.status:type_name -> mainchain.stream.v1.StreamStatus
+	27, // 13: mainchain.stream.v1.QueryAllStreamsForSenderRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	0,  // 14: mainchain.stream.v1.QueryAllStreamsForSenderResponse.streams:type_name -> mainchain.stream.v1.StreamResult
+	28, // 15: mainchain.stream.v1.QueryAllStreamsForSenderResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	0,  // 16: mainchain.stream.v1.QueryStreamByIdResponse.stream:type_name -> mainchain.stream.v1.StreamResult
+	30, // 17: mainchain.stream.v1.QueryStreamStatsResponse.stats:type_name -> mainchain.stream.v1.StreamStats
+	31, // 18: mainchain.stream.v1.QueryStreamHistoryResponse.history:type_name -> mainchain.stream.v1.StreamHistory
+	32, // 19: mainchain.stream.v1.QueryStreamsExpiringBeforeRequest.before:type_name -> google.protobuf.Timestamp
+	27, // 20: mainchain.stream.v1.QueryStreamsExpiringBeforeRequest.pagination:type_name -> cosmos.base.query.v1beta1.PageRequest
+	0,  // 21: mainchain.stream.v1.QueryStreamsExpiringBeforeResponse.streams:type_name -> mainchain.stream.v1.StreamResult
+	28, // 22: mainchain.stream.v1.QueryStreamsExpiringBeforeResponse.pagination:type_name -> cosmos.base.query.v1beta1.PageResponse
+	1,  // 23: mainchain.stream.v1.Query.Params:input_type -> mainchain.stream.v1.QueryParamsRequest
+	3,  // 24: mainchain.stream.v1.Query.CalculateFlowRate:input_type -> mainchain.stream.v1.QueryCalculateFlowRateRequest
+	5,  // 25: mainchain.stream.v1.Query.Streams:input_type -> mainchain.stream.v1.QueryStreamsRequest
+	7,  // 26: mainchain.stream.v1.Query.AllStreamsForReceiver:input_type -> mainchain.stream.v1.QueryAllStreamsForReceiverRequest
+	9,  // 27: mainchain.stream.v1.Query.StreamByReceiverSender:input_type -> mainchain.stream.v1.QueryStreamByReceiverSenderRequest
+	11, // 28: mainchain.stream.v1.Query.StreamReceiverSenderCurrentFlow:input_type -> mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowRequest
+	13, // 29: mainchain.stream.v1.Query.AllStreamsForSender:input_type -> mainchain.stream.v1.QueryAllStreamsForSenderRequest
+	15, // 30: mainchain.stream.v1.Query.StreamById:input_type -> mainchain.stream.v1.QueryStreamByIdRequest
+	17, // 31: mainchain.stream.v1.Query.StreamStats:input_type -> mainchain.stream.v1.QueryStreamStatsRequest
+	19, // 32: mainchain.stream.v1.Query.StreamHistory:input_type -> mainchain.stream.v1.QueryStreamHistoryRequest
+	21, // 33: mainchain.stream.v1.Query.StreamsExpiringBefore:input_type -> mainchain.stream.v1.QueryStreamsExpiringBeforeRequest
+	2,  // 34: mainchain.stream.v1.Query.Params:output_type -> mainchain.stream.v1.QueryParamsResponse
+	4,  // 35: mainchain.stream.v1.Query.CalculateFlowRate:output_type -> mainchain.stream.v1.QueryCalculateFlowRateResponse
+	6,  // 36: mainchain.stream.v1.Query.Streams:output_type -> mainchain.stream.v1.QueryStreamsResponse
+	8,  // 37: mainchain.stream.v1.Query.AllStreamsForReceiver:output_type -> mainchain.stream.v1.QueryAllStreamsForReceiverResponse
+	10, // 38: mainchain.stream.v1.Query.StreamByReceiverSender:output_type -> mainchain.stream.v1.QueryStreamByReceiverSenderResponse
+	12, // 39: mainchain.stream.v1.Query.StreamReceiverSenderCurrentFlow:output_type -> mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse
+	14, // 40: mainchain.stream.v1.Query.AllStreamsForSender:output_type -> mainchain.stream.v1.QueryAllStreamsForSenderResponse
+	16, // 41: mainchain.stream.v1.Query.StreamById:output_type -> mainchain.stream.v1.QueryStreamByIdResponse
+	18, // 42: mainchain.stream.v1.Query.StreamStats:output_type -> mainchain.stream.v1.QueryStreamStatsResponse
+	20, // 43: mainchain.stream.v1.Query.StreamHistory:output_type -> mainchain.stream.v1.QueryStreamHistoryResponse
+	22, // 44: mainchain.stream.v1.Query.StreamsExpiringBefore:output_type -> mainchain.stream.v1.QueryStreamsExpiringBeforeResponse
+	34, // [34:45] is the sub-list for method output_type
+	23, // [23:34] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_mainchain_stream_v1_query_proto_init() }
@@ -8895,6 +13296,102 @@ func file_mainchain_stream_v1_query_proto_init() {
 				return nil
 			}
 		}
+		file_mainchain_stream_v1_query_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryStreamByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_stream_v1_query_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryStreamByIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_stream_v1_query_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryStreamStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_stream_v1_query_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryStreamStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_stream_v1_query_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryStreamHistoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_stream_v1_query_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryStreamHistoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_stream_v1_query_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryStreamsExpiringBeforeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_stream_v1_query_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QueryStreamsExpiringBeforeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -8902,7 +13399,7 @@ func file_mainchain_stream_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mainchain_stream_v1_query_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
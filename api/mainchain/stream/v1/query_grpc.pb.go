@@ -26,6 +26,10 @@ const (
 	Query_StreamByReceiverSender_FullMethodName          = "/mainchain.stream.v1.Query/StreamByReceiverSender"
 	Query_StreamReceiverSenderCurrentFlow_FullMethodName = "/mainchain.stream.v1.Query/StreamReceiverSenderCurrentFlow"
 	Query_AllStreamsForSender_FullMethodName             = "/mainchain.stream.v1.Query/AllStreamsForSender"
+	Query_StreamById_FullMethodName                      = "/mainchain.stream.v1.Query/StreamById"
+	Query_StreamStats_FullMethodName                     = "/mainchain.stream.v1.Query/StreamStats"
+	Query_StreamHistory_FullMethodName                   = "/mainchain.stream.v1.Query/StreamHistory"
+	Query_StreamsExpiringBefore_FullMethodName           = "/mainchain.stream.v1.Query/StreamsExpiringBefore"
 )
 
 // QueryClient is the client API for Query service.
@@ -46,6 +50,15 @@ type QueryClient interface {
 	StreamReceiverSenderCurrentFlow(ctx context.Context, in *QueryStreamReceiverSenderCurrentFlowRequest, opts ...grpc.CallOption) (*QueryStreamReceiverSenderCurrentFlowResponse, error)
 	// AllStreamsForSender queries all Streams for a given sender address
 	AllStreamsForSender(ctx context.Context, in *QueryAllStreamsForSenderRequest, opts ...grpc.CallOption) (*QueryAllStreamsForSenderResponse, error)
+	// StreamById queries a stream by its stream ID
+	StreamById(ctx context.Context, in *QueryStreamByIdRequest, opts ...grpc.CallOption) (*QueryStreamByIdResponse, error)
+	// StreamStats queries the cumulative claim statistics for a stream, including a recently deleted stream
+	StreamStats(ctx context.Context, in *QueryStreamStatsRequest, opts ...grpc.CallOption) (*QueryStreamStatsResponse, error)
+	// StreamHistory queries the most recent flow rate changes for a stream, including a recently deleted stream
+	StreamHistory(ctx context.Context, in *QueryStreamHistoryRequest, opts ...grpc.CallOption) (*QueryStreamHistoryResponse, error)
+	// StreamsExpiringBefore queries streams which will stop flowing before the given time, either because their
+	// deposit runs out or they reach their end time, in expiry order
+	StreamsExpiringBefore(ctx context.Context, in *QueryStreamsExpiringBeforeRequest, opts ...grpc.CallOption) (*QueryStreamsExpiringBeforeResponse, error)
 }
 
 type queryClient struct {
@@ -119,6 +132,42 @@ func (c *queryClient) AllStreamsForSender(ctx context.Context, in *QueryAllStrea
 	return out, nil
 }
 
+func (c *queryClient) StreamById(ctx context.Context, in *QueryStreamByIdRequest, opts ...grpc.CallOption) (*QueryStreamByIdResponse, error) {
+	out := new(QueryStreamByIdResponse)
+	err := c.cc.Invoke(ctx, Query_StreamById_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamStats(ctx context.Context, in *QueryStreamStatsRequest, opts ...grpc.CallOption) (*QueryStreamStatsResponse, error) {
+	out := new(QueryStreamStatsResponse)
+	err := c.cc.Invoke(ctx, Query_StreamStats_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamHistory(ctx context.Context, in *QueryStreamHistoryRequest, opts ...grpc.CallOption) (*QueryStreamHistoryResponse, error) {
+	out := new(QueryStreamHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_StreamHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamsExpiringBefore(ctx context.Context, in *QueryStreamsExpiringBeforeRequest, opts ...grpc.CallOption) (*QueryStreamsExpiringBeforeResponse, error) {
+	out := new(QueryStreamsExpiringBeforeResponse)
+	err := c.cc.Invoke(ctx, Query_StreamsExpiringBefore_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -137,6 +186,15 @@ type QueryServer interface {
 	StreamReceiverSenderCurrentFlow(context.Context, *QueryStreamReceiverSenderCurrentFlowRequest) (*QueryStreamReceiverSenderCurrentFlowResponse, error)
 	// AllStreamsForSender queries all Streams for a given sender address
 	AllStreamsForSender(context.Context, *QueryAllStreamsForSenderRequest) (*QueryAllStreamsForSenderResponse, error)
+	// StreamById queries a stream by its stream ID
+	StreamById(context.Context, *QueryStreamByIdRequest) (*QueryStreamByIdResponse, error)
+	// StreamStats queries the cumulative claim statistics for a stream, including a recently deleted stream
+	StreamStats(context.Context, *QueryStreamStatsRequest) (*QueryStreamStatsResponse, error)
+	// StreamHistory queries the most recent flow rate changes for a stream, including a recently deleted stream
+	StreamHistory(context.Context, *QueryStreamHistoryRequest) (*QueryStreamHistoryResponse, error)
+	// StreamsExpiringBefore queries streams which will stop flowing before the given time, either because their
+	// deposit runs out or they reach their end time, in expiry order
+	StreamsExpiringBefore(context.Context, *QueryStreamsExpiringBeforeRequest) (*QueryStreamsExpiringBeforeResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -165,6 +223,18 @@ func (UnimplementedQueryServer) StreamReceiverSenderCurrentFlow(context.Context,
 func (UnimplementedQueryServer) AllStreamsForSender(context.Context, *QueryAllStreamsForSenderRequest) (*QueryAllStreamsForSenderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllStreamsForSender not implemented")
 }
+func (UnimplementedQueryServer) StreamById(context.Context, *QueryStreamByIdRequest) (*QueryStreamByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamById not implemented")
+}
+func (UnimplementedQueryServer) StreamStats(context.Context, *QueryStreamStatsRequest) (*QueryStreamStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamStats not implemented")
+}
+func (UnimplementedQueryServer) StreamHistory(context.Context, *QueryStreamHistoryRequest) (*QueryStreamHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamHistory not implemented")
+}
+func (UnimplementedQueryServer) StreamsExpiringBefore(context.Context, *QueryStreamsExpiringBeforeRequest) (*QueryStreamsExpiringBeforeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamsExpiringBefore not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -304,6 +374,78 @@ func _Query_AllStreamsForSender_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StreamById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StreamById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamById(ctx, req.(*QueryStreamByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StreamStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamStats(ctx, req.(*QueryStreamStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StreamHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamHistory(ctx, req.(*QueryStreamHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamsExpiringBefore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamsExpiringBeforeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamsExpiringBefore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_StreamsExpiringBefore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamsExpiringBefore(ctx, req.(*QueryStreamsExpiringBeforeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -339,6 +481,22 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllStreamsForSender",
 			Handler:    _Query_AllStreamsForSender_Handler,
 		},
+		{
+			MethodName: "StreamById",
+			Handler:    _Query_StreamById_Handler,
+		},
+		{
+			MethodName: "StreamStats",
+			Handler:    _Query_StreamStats_Handler,
+		},
+		{
+			MethodName: "StreamHistory",
+			Handler:    _Query_StreamHistory_Handler,
+		},
+		{
+			MethodName: "StreamsExpiringBefore",
+			Handler:    _Query_StreamsExpiringBefore_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mainchain/stream/v1/query.proto",
@@ -18,12 +18,24 @@ import (
 )
 
 var (
-	md_Stream                   protoreflect.MessageDescriptor
-	fd_Stream_deposit           protoreflect.FieldDescriptor
-	fd_Stream_flow_rate         protoreflect.FieldDescriptor
-	fd_Stream_last_outflow_time protoreflect.FieldDescriptor
-	fd_Stream_deposit_zero_time protoreflect.FieldDescriptor
-	fd_Stream_cancellable       protoreflect.FieldDescriptor
+	md_Stream                       protoreflect.MessageDescriptor
+	fd_Stream_deposit               protoreflect.FieldDescriptor
+	fd_Stream_flow_rate             protoreflect.FieldDescriptor
+	fd_Stream_last_outflow_time     protoreflect.FieldDescriptor
+	fd_Stream_deposit_zero_time     protoreflect.FieldDescriptor
+	fd_Stream_cancellable           protoreflect.FieldDescriptor
+	fd_Stream_stream_id             protoreflect.FieldDescriptor
+	fd_Stream_receiver              protoreflect.FieldDescriptor
+	fd_Stream_sender                protoreflect.FieldDescriptor
+	fd_Stream_start_time            protoreflect.FieldDescriptor
+	fd_Stream_cliff_time            protoreflect.FieldDescriptor
+	fd_Stream_end_time              protoreflect.FieldDescriptor
+	fd_Stream_pausable              protoreflect.FieldDescriptor
+	fd_Stream_paused_at             protoreflect.FieldDescriptor
+	fd_Stream_low_deposit_threshold protoreflect.FieldDescriptor
+	fd_Stream_low_deposit_alerted   protoreflect.FieldDescriptor
+	fd_Stream_termination_time      protoreflect.FieldDescriptor
+	fd_Stream_pausable_accepted     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -34,6 +46,18 @@ func init() {
 	fd_Stream_last_outflow_time = md_Stream.Fields().ByName("last_outflow_time")
 	fd_Stream_deposit_zero_time = md_Stream.Fields().ByName("deposit_zero_time")
 	fd_Stream_cancellable = md_Stream.Fields().ByName("cancellable")
+	fd_Stream_stream_id = md_Stream.Fields().ByName("stream_id")
+	fd_Stream_receiver = md_Stream.Fields().ByName("receiver")
+	fd_Stream_sender = md_Stream.Fields().ByName("sender")
+	fd_Stream_start_time = md_Stream.Fields().ByName("start_time")
+	fd_Stream_cliff_time = md_Stream.Fields().ByName("cliff_time")
+	fd_Stream_end_time = md_Stream.Fields().ByName("end_time")
+	fd_Stream_pausable = md_Stream.Fields().ByName("pausable")
+	fd_Stream_paused_at = md_Stream.Fields().ByName("paused_at")
+	fd_Stream_low_deposit_threshold = md_Stream.Fields().ByName("low_deposit_threshold")
+	fd_Stream_low_deposit_alerted = md_Stream.Fields().ByName("low_deposit_alerted")
+	fd_Stream_termination_time = md_Stream.Fields().ByName("termination_time")
+	fd_Stream_pausable_accepted = md_Stream.Fields().ByName("pausable_accepted")
 }
 
 var _ protoreflect.Message = (*fastReflection_Stream)(nil)
@@ -131,6 +155,78 @@ func (x *fastReflection_Stream) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.StreamId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StreamId)
+		if !f(fd_Stream_stream_id, value) {
+			return
+		}
+	}
+	if x.Receiver != "" {
+		value := protoreflect.ValueOfString(x.Receiver)
+		if !f(fd_Stream_receiver, value) {
+			return
+		}
+	}
+	if x.Sender != "" {
+		value := protoreflect.ValueOfString(x.Sender)
+		if !f(fd_Stream_sender, value) {
+			return
+		}
+	}
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_Stream_start_time, value) {
+			return
+		}
+	}
+	if x.CliffTime != nil {
+		value := protoreflect.ValueOfMessage(x.CliffTime.ProtoReflect())
+		if !f(fd_Stream_cliff_time, value) {
+			return
+		}
+	}
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_Stream_end_time, value) {
+			return
+		}
+	}
+	if x.Pausable != false {
+		value := protoreflect.ValueOfBool(x.Pausable)
+		if !f(fd_Stream_pausable, value) {
+			return
+		}
+	}
+	if x.PausedAt != nil {
+		value := protoreflect.ValueOfMessage(x.PausedAt.ProtoReflect())
+		if !f(fd_Stream_paused_at, value) {
+			return
+		}
+	}
+	if x.LowDepositThreshold != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.LowDepositThreshold)
+		if !f(fd_Stream_low_deposit_threshold, value) {
+			return
+		}
+	}
+	if x.LowDepositAlerted != false {
+		value := protoreflect.ValueOfBool(x.LowDepositAlerted)
+		if !f(fd_Stream_low_deposit_alerted, value) {
+			return
+		}
+	}
+	if x.TerminationTime != nil {
+		value := protoreflect.ValueOfMessage(x.TerminationTime.ProtoReflect())
+		if !f(fd_Stream_termination_time, value) {
+			return
+		}
+	}
+	if x.PausableAccepted != false {
+		value := protoreflect.ValueOfBool(x.PausableAccepted)
+		if !f(fd_Stream_pausable_accepted, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -156,6 +252,30 @@ func (x *fastReflection_Stream) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.DepositZeroTime != nil
 	case "mainchain.stream.v1.Stream.cancellable":
 		return x.Cancellable != false
+	case "mainchain.stream.v1.Stream.stream_id":
+		return x.StreamId != uint64(0)
+	case "mainchain.stream.v1.Stream.receiver":
+		return x.Receiver != ""
+	case "mainchain.stream.v1.Stream.sender":
+		return x.Sender != ""
+	case "mainchain.stream.v1.Stream.start_time":
+		return x.StartTime != nil
+	case "mainchain.stream.v1.Stream.cliff_time":
+		return x.CliffTime != nil
+	case "mainchain.stream.v1.Stream.end_time":
+		return x.EndTime != nil
+	case "mainchain.stream.v1.Stream.pausable":
+		return x.Pausable != false
+	case "mainchain.stream.v1.Stream.paused_at":
+		return x.PausedAt != nil
+	case "mainchain.stream.v1.Stream.low_deposit_threshold":
+		return x.LowDepositThreshold != uint64(0)
+	case "mainchain.stream.v1.Stream.low_deposit_alerted":
+		return x.LowDepositAlerted != false
+	case "mainchain.stream.v1.Stream.termination_time":
+		return x.TerminationTime != nil
+	case "mainchain.stream.v1.Stream.pausable_accepted":
+		return x.PausableAccepted != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.Stream"))
@@ -182,6 +302,30 @@ func (x *fastReflection_Stream) Clear(fd protoreflect.FieldDescriptor) {
 		x.DepositZeroTime = nil
 	case "mainchain.stream.v1.Stream.cancellable":
 		x.Cancellable = false
+	case "mainchain.stream.v1.Stream.stream_id":
+		x.StreamId = uint64(0)
+	case "mainchain.stream.v1.Stream.receiver":
+		x.Receiver = ""
+	case "mainchain.stream.v1.Stream.sender":
+		x.Sender = ""
+	case "mainchain.stream.v1.Stream.start_time":
+		x.StartTime = nil
+	case "mainchain.stream.v1.Stream.cliff_time":
+		x.CliffTime = nil
+	case "mainchain.stream.v1.Stream.end_time":
+		x.EndTime = nil
+	case "mainchain.stream.v1.Stream.pausable":
+		x.Pausable = false
+	case "mainchain.stream.v1.Stream.paused_at":
+		x.PausedAt = nil
+	case "mainchain.stream.v1.Stream.low_deposit_threshold":
+		x.LowDepositThreshold = uint64(0)
+	case "mainchain.stream.v1.Stream.low_deposit_alerted":
+		x.LowDepositAlerted = false
+	case "mainchain.stream.v1.Stream.termination_time":
+		x.TerminationTime = nil
+	case "mainchain.stream.v1.Stream.pausable_accepted":
+		x.PausableAccepted = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.Stream"))
@@ -213,6 +357,42 @@ func (x *fastReflection_Stream) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "mainchain.stream.v1.Stream.cancellable":
 		value := x.Cancellable
 		return protoreflect.ValueOfBool(value)
+	case "mainchain.stream.v1.Stream.stream_id":
+		value := x.StreamId
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.stream.v1.Stream.receiver":
+		value := x.Receiver
+		return protoreflect.ValueOfString(value)
+	case "mainchain.stream.v1.Stream.sender":
+		value := x.Sender
+		return protoreflect.ValueOfString(value)
+	case "mainchain.stream.v1.Stream.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.stream.v1.Stream.cliff_time":
+		value := x.CliffTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.stream.v1.Stream.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.stream.v1.Stream.pausable":
+		value := x.Pausable
+		return protoreflect.ValueOfBool(value)
+	case "mainchain.stream.v1.Stream.paused_at":
+		value := x.PausedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.stream.v1.Stream.low_deposit_threshold":
+		value := x.LowDepositThreshold
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.stream.v1.Stream.low_deposit_alerted":
+		value := x.LowDepositAlerted
+		return protoreflect.ValueOfBool(value)
+	case "mainchain.stream.v1.Stream.termination_time":
+		value := x.TerminationTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.stream.v1.Stream.pausable_accepted":
+		value := x.PausableAccepted
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.Stream"))
@@ -243,6 +423,30 @@ func (x *fastReflection_Stream) Set(fd protoreflect.FieldDescriptor, value proto
 		x.DepositZeroTime = value.Message().Interface().(*timestamppb.Timestamp)
 	case "mainchain.stream.v1.Stream.cancellable":
 		x.Cancellable = value.Bool()
+	case "mainchain.stream.v1.Stream.stream_id":
+		x.StreamId = value.Uint()
+	case "mainchain.stream.v1.Stream.receiver":
+		x.Receiver = value.Interface().(string)
+	case "mainchain.stream.v1.Stream.sender":
+		x.Sender = value.Interface().(string)
+	case "mainchain.stream.v1.Stream.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "mainchain.stream.v1.Stream.cliff_time":
+		x.CliffTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "mainchain.stream.v1.Stream.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "mainchain.stream.v1.Stream.pausable":
+		x.Pausable = value.Bool()
+	case "mainchain.stream.v1.Stream.paused_at":
+		x.PausedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "mainchain.stream.v1.Stream.low_deposit_threshold":
+		x.LowDepositThreshold = value.Uint()
+	case "mainchain.stream.v1.Stream.low_deposit_alerted":
+		x.LowDepositAlerted = value.Bool()
+	case "mainchain.stream.v1.Stream.termination_time":
+		x.TerminationTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "mainchain.stream.v1.Stream.pausable_accepted":
+		x.PausableAccepted = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.Stream"))
@@ -278,10 +482,49 @@ func (x *fastReflection_Stream) Mutable(fd protoreflect.FieldDescriptor) protore
 			x.DepositZeroTime = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.DepositZeroTime.ProtoReflect())
+	case "mainchain.stream.v1.Stream.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "mainchain.stream.v1.Stream.cliff_time":
+		if x.CliffTime == nil {
+			x.CliffTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.CliffTime.ProtoReflect())
+	case "mainchain.stream.v1.Stream.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	case "mainchain.stream.v1.Stream.paused_at":
+		if x.PausedAt == nil {
+			x.PausedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.PausedAt.ProtoReflect())
+	case "mainchain.stream.v1.Stream.termination_time":
+		if x.TerminationTime == nil {
+			x.TerminationTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.TerminationTime.ProtoReflect())
 	case "mainchain.stream.v1.Stream.flow_rate":
 		panic(fmt.Errorf("field flow_rate of message mainchain.stream.v1.Stream is not mutable"))
 	case "mainchain.stream.v1.Stream.cancellable":
 		panic(fmt.Errorf("field cancellable of message mainchain.stream.v1.Stream is not mutable"))
+	case "mainchain.stream.v1.Stream.stream_id":
+		panic(fmt.Errorf("field stream_id of message mainchain.stream.v1.Stream is not mutable"))
+	case "mainchain.stream.v1.Stream.receiver":
+		panic(fmt.Errorf("field receiver of message mainchain.stream.v1.Stream is not mutable"))
+	case "mainchain.stream.v1.Stream.sender":
+		panic(fmt.Errorf("field sender of message mainchain.stream.v1.Stream is not mutable"))
+	case "mainchain.stream.v1.Stream.pausable":
+		panic(fmt.Errorf("field pausable of message mainchain.stream.v1.Stream is not mutable"))
+	case "mainchain.stream.v1.Stream.low_deposit_threshold":
+		panic(fmt.Errorf("field low_deposit_threshold of message mainchain.stream.v1.Stream is not mutable"))
+	case "mainchain.stream.v1.Stream.low_deposit_alerted":
+		panic(fmt.Errorf("field low_deposit_alerted of message mainchain.stream.v1.Stream is not mutable"))
+	case "mainchain.stream.v1.Stream.pausable_accepted":
+		panic(fmt.Errorf("field pausable_accepted of message mainchain.stream.v1.Stream is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.Stream"))
@@ -308,6 +551,35 @@ func (x *fastReflection_Stream) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "mainchain.stream.v1.Stream.cancellable":
 		return protoreflect.ValueOfBool(false)
+	case "mainchain.stream.v1.Stream.stream_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.stream.v1.Stream.receiver":
+		return protoreflect.ValueOfString("")
+	case "mainchain.stream.v1.Stream.sender":
+		return protoreflect.ValueOfString("")
+	case "mainchain.stream.v1.Stream.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.stream.v1.Stream.cliff_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.stream.v1.Stream.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.stream.v1.Stream.pausable":
+		return protoreflect.ValueOfBool(false)
+	case "mainchain.stream.v1.Stream.paused_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.stream.v1.Stream.low_deposit_threshold":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.stream.v1.Stream.low_deposit_alerted":
+		return protoreflect.ValueOfBool(false)
+	case "mainchain.stream.v1.Stream.termination_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.stream.v1.Stream.pausable_accepted":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.Stream"))
@@ -395,6 +667,49 @@ func (x *fastReflection_Stream) ProtoMethods() *protoiface.Methods {
 		if x.Cancellable {
 			n += 2
 		}
+		if x.StreamId != 0 {
+			n += 1 + runtime.Sov(uint64(x.StreamId))
+		}
+		l = len(x.Receiver)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CliffTime != nil {
+			l = options.Size(x.CliffTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Pausable {
+			n += 2
+		}
+		if x.PausedAt != nil {
+			l = options.Size(x.PausedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LowDepositThreshold != 0 {
+			n += 1 + runtime.Sov(uint64(x.LowDepositThreshold))
+		}
+		if x.LowDepositAlerted {
+			n += 2
+		}
+		if x.TerminationTime != nil {
+			l = options.Size(x.TerminationTime)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.PausableAccepted {
+			n += 3
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -424,6 +739,134 @@ func (x *fastReflection_Stream) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.PausableAccepted {
+			i--
+			if x.PausableAccepted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.TerminationTime != nil {
+			encoded, err := options.Marshal(x.TerminationTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.LowDepositAlerted {
+			i--
+			if x.LowDepositAlerted {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.LowDepositThreshold != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LowDepositThreshold))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.PausedAt != nil {
+			encoded, err := options.Marshal(x.PausedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.Pausable {
+			i--
+			if x.Pausable {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.CliffTime != nil {
+			encoded, err := options.Marshal(x.CliffTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Receiver) > 0 {
+			i -= len(x.Receiver)
+			copy(dAtA[i:], x.Receiver)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Receiver)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.StreamId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StreamId))
+			i--
+			dAtA[i] = 0x30
+		}
 		if x.Cancellable {
 			i--
 			if x.Cancellable {
@@ -677,65 +1120,2217 @@ func (x *fastReflection_Stream) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Cancellable = bool(v != 0)
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				x.StreamId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StreamId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Receiver = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: mainchain/stream/v1/stream.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// StreamPeriod enumerates the valid periods for calculating flow rates
-type StreamPeriod int32
-
-const (
-	// STREAM_PERIOD_UNSPECIFIED defines unspecified
-	StreamPeriod_STREAM_PERIOD_UNSPECIFIED StreamPeriod = 0
-	// STREAM_PERIOD_SECOND defines second
-	StreamPeriod_STREAM_PERIOD_SECOND StreamPeriod = 1
-	// STREAM_PERIOD_MINUTE defines minute
-	StreamPeriod_STREAM_PERIOD_MINUTE StreamPeriod = 2
-	// STREAM_PERIOD_HOUR defines hour
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Sender = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CliffTime == nil {
+					x.CliffTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CliffTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Pausable", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Pausable = bool(v != 0)
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.PausedAt == nil {
+					x.PausedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PausedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LowDepositThreshold", wireType)
+				}
+				x.LowDepositThreshold = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LowDepositThreshold |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LowDepositAlerted", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.LowDepositAlerted = bool(v != 0)
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TerminationTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TerminationTime == nil {
+					x.TerminationTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TerminationTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PausableAccepted", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.PausableAccepted = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_StreamStats                      protoreflect.MessageDescriptor
+	fd_StreamStats_stream_id            protoreflect.FieldDescriptor
+	fd_StreamStats_total_claimed        protoreflect.FieldDescriptor
+	fd_StreamStats_total_validator_fees protoreflect.FieldDescriptor
+	fd_StreamStats_num_claims           protoreflect.FieldDescriptor
+	fd_StreamStats_last_claim_time      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_stream_v1_stream_proto_init()
+	md_StreamStats = File_mainchain_stream_v1_stream_proto.Messages().ByName("StreamStats")
+	fd_StreamStats_stream_id = md_StreamStats.Fields().ByName("stream_id")
+	fd_StreamStats_total_claimed = md_StreamStats.Fields().ByName("total_claimed")
+	fd_StreamStats_total_validator_fees = md_StreamStats.Fields().ByName("total_validator_fees")
+	fd_StreamStats_num_claims = md_StreamStats.Fields().ByName("num_claims")
+	fd_StreamStats_last_claim_time = md_StreamStats.Fields().ByName("last_claim_time")
+}
+
+var _ protoreflect.Message = (*fastReflection_StreamStats)(nil)
+
+type fastReflection_StreamStats StreamStats
+
+func (x *StreamStats) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StreamStats)(x)
+}
+
+func (x *StreamStats) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_stream_v1_stream_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StreamStats_messageType fastReflection_StreamStats_messageType
+var _ protoreflect.MessageType = fastReflection_StreamStats_messageType{}
+
+type fastReflection_StreamStats_messageType struct{}
+
+func (x fastReflection_StreamStats_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StreamStats)(nil)
+}
+func (x fastReflection_StreamStats_messageType) New() protoreflect.Message {
+	return new(fastReflection_StreamStats)
+}
+func (x fastReflection_StreamStats_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StreamStats
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StreamStats) Descriptor() protoreflect.MessageDescriptor {
+	return md_StreamStats
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StreamStats) Type() protoreflect.MessageType {
+	return _fastReflection_StreamStats_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StreamStats) New() protoreflect.Message {
+	return new(fastReflection_StreamStats)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StreamStats) Interface() protoreflect.ProtoMessage {
+	return (*StreamStats)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StreamStats) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StreamId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StreamId)
+		if !f(fd_StreamStats_stream_id, value) {
+			return
+		}
+	}
+	if x.TotalClaimed != nil {
+		value := protoreflect.ValueOfMessage(x.TotalClaimed.ProtoReflect())
+		if !f(fd_StreamStats_total_claimed, value) {
+			return
+		}
+	}
+	if x.TotalValidatorFees != nil {
+		value := protoreflect.ValueOfMessage(x.TotalValidatorFees.ProtoReflect())
+		if !f(fd_StreamStats_total_validator_fees, value) {
+			return
+		}
+	}
+	if x.NumClaims != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NumClaims)
+		if !f(fd_StreamStats_num_claims, value) {
+			return
+		}
+	}
+	if x.LastClaimTime != nil {
+		value := protoreflect.ValueOfMessage(x.LastClaimTime.ProtoReflect())
+		if !f(fd_StreamStats_last_claim_time, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StreamStats) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.StreamStats.stream_id":
+		return x.StreamId != uint64(0)
+	case "mainchain.stream.v1.StreamStats.total_claimed":
+		return x.TotalClaimed != nil
+	case "mainchain.stream.v1.StreamStats.total_validator_fees":
+		return x.TotalValidatorFees != nil
+	case "mainchain.stream.v1.StreamStats.num_claims":
+		return x.NumClaims != uint64(0)
+	case "mainchain.stream.v1.StreamStats.last_claim_time":
+		return x.LastClaimTime != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamStats"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StreamStats) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.StreamStats.stream_id":
+		x.StreamId = uint64(0)
+	case "mainchain.stream.v1.StreamStats.total_claimed":
+		x.TotalClaimed = nil
+	case "mainchain.stream.v1.StreamStats.total_validator_fees":
+		x.TotalValidatorFees = nil
+	case "mainchain.stream.v1.StreamStats.num_claims":
+		x.NumClaims = uint64(0)
+	case "mainchain.stream.v1.StreamStats.last_claim_time":
+		x.LastClaimTime = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamStats"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StreamStats) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.stream.v1.StreamStats.stream_id":
+		value := x.StreamId
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.stream.v1.StreamStats.total_claimed":
+		value := x.TotalClaimed
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.stream.v1.StreamStats.total_validator_fees":
+		value := x.TotalValidatorFees
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.stream.v1.StreamStats.num_claims":
+		value := x.NumClaims
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.stream.v1.StreamStats.last_claim_time":
+		value := x.LastClaimTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamStats"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamStats does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StreamStats) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.StreamStats.stream_id":
+		x.StreamId = value.Uint()
+	case "mainchain.stream.v1.StreamStats.total_claimed":
+		x.TotalClaimed = value.Message().Interface().(*v1beta1.Coin)
+	case "mainchain.stream.v1.StreamStats.total_validator_fees":
+		x.TotalValidatorFees = value.Message().Interface().(*v1beta1.Coin)
+	case "mainchain.stream.v1.StreamStats.num_claims":
+		x.NumClaims = value.Uint()
+	case "mainchain.stream.v1.StreamStats.last_claim_time":
+		x.LastClaimTime = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamStats"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StreamStats) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.StreamStats.total_claimed":
+		if x.TotalClaimed == nil {
+			x.TotalClaimed = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.TotalClaimed.ProtoReflect())
+	case "mainchain.stream.v1.StreamStats.total_validator_fees":
+		if x.TotalValidatorFees == nil {
+			x.TotalValidatorFees = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.TotalValidatorFees.ProtoReflect())
+	case "mainchain.stream.v1.StreamStats.last_claim_time":
+		if x.LastClaimTime == nil {
+			x.LastClaimTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.LastClaimTime.ProtoReflect())
+	case "mainchain.stream.v1.StreamStats.stream_id":
+		panic(fmt.Errorf("field stream_id of message mainchain.stream.v1.StreamStats is not mutable"))
+	case "mainchain.stream.v1.StreamStats.num_claims":
+		panic(fmt.Errorf("field num_claims of message mainchain.stream.v1.StreamStats is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamStats"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StreamStats) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.StreamStats.stream_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.stream.v1.StreamStats.total_claimed":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.stream.v1.StreamStats.total_validator_fees":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.stream.v1.StreamStats.num_claims":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.stream.v1.StreamStats.last_claim_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamStats"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamStats does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StreamStats) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.stream.v1.StreamStats", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StreamStats) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StreamStats) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StreamStats) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StreamStats) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StreamStats)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StreamId != 0 {
+			n += 1 + runtime.Sov(uint64(x.StreamId))
+		}
+		if x.TotalClaimed != nil {
+			l = options.Size(x.TotalClaimed)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TotalValidatorFees != nil {
+			l = options.Size(x.TotalValidatorFees)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NumClaims != 0 {
+			n += 1 + runtime.Sov(uint64(x.NumClaims))
+		}
+		if x.LastClaimTime != nil {
+			l = options.Size(x.LastClaimTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StreamStats)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LastClaimTime != nil {
+			encoded, err := options.Marshal(x.LastClaimTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.NumClaims != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NumClaims))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.TotalValidatorFees != nil {
+			encoded, err := options.Marshal(x.TotalValidatorFees)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.TotalClaimed != nil {
+			encoded, err := options.Marshal(x.TotalClaimed)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.StreamId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StreamId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StreamStats)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StreamStats: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StreamStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+				}
+				x.StreamId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StreamId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalClaimed", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TotalClaimed == nil {
+					x.TotalClaimed = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalClaimed); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalValidatorFees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.TotalValidatorFees == nil {
+					x.TotalValidatorFees = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalValidatorFees); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NumClaims", wireType)
+				}
+				x.NumClaims = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NumClaims |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastClaimTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LastClaimTime == nil {
+					x.LastClaimTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LastClaimTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FlowRateChange               protoreflect.MessageDescriptor
+	fd_FlowRateChange_old_flow_rate protoreflect.FieldDescriptor
+	fd_FlowRateChange_new_flow_rate protoreflect.FieldDescriptor
+	fd_FlowRateChange_changed_at    protoreflect.FieldDescriptor
+	fd_FlowRateChange_block_height  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_stream_v1_stream_proto_init()
+	md_FlowRateChange = File_mainchain_stream_v1_stream_proto.Messages().ByName("FlowRateChange")
+	fd_FlowRateChange_old_flow_rate = md_FlowRateChange.Fields().ByName("old_flow_rate")
+	fd_FlowRateChange_new_flow_rate = md_FlowRateChange.Fields().ByName("new_flow_rate")
+	fd_FlowRateChange_changed_at = md_FlowRateChange.Fields().ByName("changed_at")
+	fd_FlowRateChange_block_height = md_FlowRateChange.Fields().ByName("block_height")
+}
+
+var _ protoreflect.Message = (*fastReflection_FlowRateChange)(nil)
+
+type fastReflection_FlowRateChange FlowRateChange
+
+func (x *FlowRateChange) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FlowRateChange)(x)
+}
+
+func (x *FlowRateChange) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_stream_v1_stream_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FlowRateChange_messageType fastReflection_FlowRateChange_messageType
+var _ protoreflect.MessageType = fastReflection_FlowRateChange_messageType{}
+
+type fastReflection_FlowRateChange_messageType struct{}
+
+func (x fastReflection_FlowRateChange_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FlowRateChange)(nil)
+}
+func (x fastReflection_FlowRateChange_messageType) New() protoreflect.Message {
+	return new(fastReflection_FlowRateChange)
+}
+func (x fastReflection_FlowRateChange_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FlowRateChange
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FlowRateChange) Descriptor() protoreflect.MessageDescriptor {
+	return md_FlowRateChange
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FlowRateChange) Type() protoreflect.MessageType {
+	return _fastReflection_FlowRateChange_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FlowRateChange) New() protoreflect.Message {
+	return new(fastReflection_FlowRateChange)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FlowRateChange) Interface() protoreflect.ProtoMessage {
+	return (*FlowRateChange)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FlowRateChange) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OldFlowRate != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OldFlowRate)
+		if !f(fd_FlowRateChange_old_flow_rate, value) {
+			return
+		}
+	}
+	if x.NewFlowRate != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NewFlowRate)
+		if !f(fd_FlowRateChange_new_flow_rate, value) {
+			return
+		}
+	}
+	if x.ChangedAt != nil {
+		value := protoreflect.ValueOfMessage(x.ChangedAt.ProtoReflect())
+		if !f(fd_FlowRateChange_changed_at, value) {
+			return
+		}
+	}
+	if x.BlockHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.BlockHeight)
+		if !f(fd_FlowRateChange_block_height, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FlowRateChange) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.FlowRateChange.old_flow_rate":
+		return x.OldFlowRate != int64(0)
+	case "mainchain.stream.v1.FlowRateChange.new_flow_rate":
+		return x.NewFlowRate != int64(0)
+	case "mainchain.stream.v1.FlowRateChange.changed_at":
+		return x.ChangedAt != nil
+	case "mainchain.stream.v1.FlowRateChange.block_height":
+		return x.BlockHeight != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.FlowRateChange"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.FlowRateChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FlowRateChange) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.FlowRateChange.old_flow_rate":
+		x.OldFlowRate = int64(0)
+	case "mainchain.stream.v1.FlowRateChange.new_flow_rate":
+		x.NewFlowRate = int64(0)
+	case "mainchain.stream.v1.FlowRateChange.changed_at":
+		x.ChangedAt = nil
+	case "mainchain.stream.v1.FlowRateChange.block_height":
+		x.BlockHeight = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.FlowRateChange"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.FlowRateChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FlowRateChange) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.stream.v1.FlowRateChange.old_flow_rate":
+		value := x.OldFlowRate
+		return protoreflect.ValueOfInt64(value)
+	case "mainchain.stream.v1.FlowRateChange.new_flow_rate":
+		value := x.NewFlowRate
+		return protoreflect.ValueOfInt64(value)
+	case "mainchain.stream.v1.FlowRateChange.changed_at":
+		value := x.ChangedAt
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "mainchain.stream.v1.FlowRateChange.block_height":
+		value := x.BlockHeight
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.FlowRateChange"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.FlowRateChange does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FlowRateChange) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.FlowRateChange.old_flow_rate":
+		x.OldFlowRate = value.Int()
+	case "mainchain.stream.v1.FlowRateChange.new_flow_rate":
+		x.NewFlowRate = value.Int()
+	case "mainchain.stream.v1.FlowRateChange.changed_at":
+		x.ChangedAt = value.Message().Interface().(*timestamppb.Timestamp)
+	case "mainchain.stream.v1.FlowRateChange.block_height":
+		x.BlockHeight = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.FlowRateChange"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.FlowRateChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FlowRateChange) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.FlowRateChange.changed_at":
+		if x.ChangedAt == nil {
+			x.ChangedAt = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.ChangedAt.ProtoReflect())
+	case "mainchain.stream.v1.FlowRateChange.old_flow_rate":
+		panic(fmt.Errorf("field old_flow_rate of message mainchain.stream.v1.FlowRateChange is not mutable"))
+	case "mainchain.stream.v1.FlowRateChange.new_flow_rate":
+		panic(fmt.Errorf("field new_flow_rate of message mainchain.stream.v1.FlowRateChange is not mutable"))
+	case "mainchain.stream.v1.FlowRateChange.block_height":
+		panic(fmt.Errorf("field block_height of message mainchain.stream.v1.FlowRateChange is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.FlowRateChange"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.FlowRateChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FlowRateChange) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.FlowRateChange.old_flow_rate":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "mainchain.stream.v1.FlowRateChange.new_flow_rate":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "mainchain.stream.v1.FlowRateChange.changed_at":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "mainchain.stream.v1.FlowRateChange.block_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.FlowRateChange"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.FlowRateChange does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FlowRateChange) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.stream.v1.FlowRateChange", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FlowRateChange) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FlowRateChange) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FlowRateChange) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FlowRateChange) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FlowRateChange)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.OldFlowRate != 0 {
+			n += 1 + runtime.Sov(uint64(x.OldFlowRate))
+		}
+		if x.NewFlowRate != 0 {
+			n += 1 + runtime.Sov(uint64(x.NewFlowRate))
+		}
+		if x.ChangedAt != nil {
+			l = options.Size(x.ChangedAt)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BlockHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.BlockHeight))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FlowRateChange)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.BlockHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BlockHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.ChangedAt != nil {
+			encoded, err := options.Marshal(x.ChangedAt)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.NewFlowRate != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewFlowRate))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.OldFlowRate != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OldFlowRate))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FlowRateChange)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FlowRateChange: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FlowRateChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldFlowRate", wireType)
+				}
+				x.OldFlowRate = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OldFlowRate |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewFlowRate", wireType)
+				}
+				x.NewFlowRate = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NewFlowRate |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChangedAt", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.ChangedAt == nil {
+					x.ChangedAt = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ChangedAt); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				}
+				x.BlockHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BlockHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_StreamHistory_2_list)(nil)
+
+type _StreamHistory_2_list struct {
+	list *[]*FlowRateChange
+}
+
+func (x *_StreamHistory_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_StreamHistory_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_StreamHistory_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FlowRateChange)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_StreamHistory_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FlowRateChange)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_StreamHistory_2_list) AppendMutable() protoreflect.Value {
+	v := new(FlowRateChange)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StreamHistory_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_StreamHistory_2_list) NewElement() protoreflect.Value {
+	v := new(FlowRateChange)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_StreamHistory_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_StreamHistory                   protoreflect.MessageDescriptor
+	fd_StreamHistory_stream_id         protoreflect.FieldDescriptor
+	fd_StreamHistory_flow_rate_changes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_mainchain_stream_v1_stream_proto_init()
+	md_StreamHistory = File_mainchain_stream_v1_stream_proto.Messages().ByName("StreamHistory")
+	fd_StreamHistory_stream_id = md_StreamHistory.Fields().ByName("stream_id")
+	fd_StreamHistory_flow_rate_changes = md_StreamHistory.Fields().ByName("flow_rate_changes")
+}
+
+var _ protoreflect.Message = (*fastReflection_StreamHistory)(nil)
+
+type fastReflection_StreamHistory StreamHistory
+
+func (x *StreamHistory) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_StreamHistory)(x)
+}
+
+func (x *StreamHistory) slowProtoReflect() protoreflect.Message {
+	mi := &file_mainchain_stream_v1_stream_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_StreamHistory_messageType fastReflection_StreamHistory_messageType
+var _ protoreflect.MessageType = fastReflection_StreamHistory_messageType{}
+
+type fastReflection_StreamHistory_messageType struct{}
+
+func (x fastReflection_StreamHistory_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_StreamHistory)(nil)
+}
+func (x fastReflection_StreamHistory_messageType) New() protoreflect.Message {
+	return new(fastReflection_StreamHistory)
+}
+func (x fastReflection_StreamHistory_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_StreamHistory
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_StreamHistory) Descriptor() protoreflect.MessageDescriptor {
+	return md_StreamHistory
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_StreamHistory) Type() protoreflect.MessageType {
+	return _fastReflection_StreamHistory_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_StreamHistory) New() protoreflect.Message {
+	return new(fastReflection_StreamHistory)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_StreamHistory) Interface() protoreflect.ProtoMessage {
+	return (*StreamHistory)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_StreamHistory) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.StreamId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.StreamId)
+		if !f(fd_StreamHistory_stream_id, value) {
+			return
+		}
+	}
+	if len(x.FlowRateChanges) != 0 {
+		value := protoreflect.ValueOfList(&_StreamHistory_2_list{list: &x.FlowRateChanges})
+		if !f(fd_StreamHistory_flow_rate_changes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_StreamHistory) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.StreamHistory.stream_id":
+		return x.StreamId != uint64(0)
+	case "mainchain.stream.v1.StreamHistory.flow_rate_changes":
+		return len(x.FlowRateChanges) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamHistory"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamHistory does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StreamHistory) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.StreamHistory.stream_id":
+		x.StreamId = uint64(0)
+	case "mainchain.stream.v1.StreamHistory.flow_rate_changes":
+		x.FlowRateChanges = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamHistory"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamHistory does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_StreamHistory) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "mainchain.stream.v1.StreamHistory.stream_id":
+		value := x.StreamId
+		return protoreflect.ValueOfUint64(value)
+	case "mainchain.stream.v1.StreamHistory.flow_rate_changes":
+		if len(x.FlowRateChanges) == 0 {
+			return protoreflect.ValueOfList(&_StreamHistory_2_list{})
+		}
+		listValue := &_StreamHistory_2_list{list: &x.FlowRateChanges}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamHistory"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamHistory does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StreamHistory) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.StreamHistory.stream_id":
+		x.StreamId = value.Uint()
+	case "mainchain.stream.v1.StreamHistory.flow_rate_changes":
+		lv := value.List()
+		clv := lv.(*_StreamHistory_2_list)
+		x.FlowRateChanges = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamHistory"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamHistory does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StreamHistory) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.StreamHistory.flow_rate_changes":
+		if x.FlowRateChanges == nil {
+			x.FlowRateChanges = []*FlowRateChange{}
+		}
+		value := &_StreamHistory_2_list{list: &x.FlowRateChanges}
+		return protoreflect.ValueOfList(value)
+	case "mainchain.stream.v1.StreamHistory.stream_id":
+		panic(fmt.Errorf("field stream_id of message mainchain.stream.v1.StreamHistory is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamHistory"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamHistory does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_StreamHistory) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "mainchain.stream.v1.StreamHistory.stream_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "mainchain.stream.v1.StreamHistory.flow_rate_changes":
+		list := []*FlowRateChange{}
+		return protoreflect.ValueOfList(&_StreamHistory_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.stream.v1.StreamHistory"))
+		}
+		panic(fmt.Errorf("message mainchain.stream.v1.StreamHistory does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_StreamHistory) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in mainchain.stream.v1.StreamHistory", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_StreamHistory) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_StreamHistory) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_StreamHistory) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_StreamHistory) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*StreamHistory)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.StreamId != 0 {
+			n += 1 + runtime.Sov(uint64(x.StreamId))
+		}
+		if len(x.FlowRateChanges) > 0 {
+			for _, e := range x.FlowRateChanges {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*StreamHistory)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.FlowRateChanges) > 0 {
+			for iNdEx := len(x.FlowRateChanges) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FlowRateChanges[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.StreamId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StreamId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*StreamHistory)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StreamHistory: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: StreamHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+				}
+				x.StreamId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StreamId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FlowRateChanges", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FlowRateChanges = append(x.FlowRateChanges, &FlowRateChange{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FlowRateChanges[len(x.FlowRateChanges)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: mainchain/stream/v1/stream.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StreamPeriod enumerates the valid periods for calculating flow rates
+type StreamPeriod int32
+
+const (
+	// STREAM_PERIOD_UNSPECIFIED defines unspecified
+	StreamPeriod_STREAM_PERIOD_UNSPECIFIED StreamPeriod = 0
+	// STREAM_PERIOD_SECOND defines second
+	StreamPeriod_STREAM_PERIOD_SECOND StreamPeriod = 1
+	// STREAM_PERIOD_MINUTE defines minute
+	StreamPeriod_STREAM_PERIOD_MINUTE StreamPeriod = 2
+	// STREAM_PERIOD_HOUR defines hour
 	StreamPeriod_STREAM_PERIOD_HOUR StreamPeriod = 3
 	// STREAM_PERIOD_DAY defines day
 	StreamPeriod_STREAM_PERIOD_DAY StreamPeriod = 4
@@ -798,6 +3393,71 @@ func (StreamPeriod) EnumDescriptor() ([]byte, []int) {
 	return file_mainchain_stream_v1_stream_proto_rawDescGZIP(), []int{0}
 }
 
+// StreamStatus enumerates the states a stream can be in
+type StreamStatus int32
+
+const (
+	// STREAM_STATUS_UNSPECIFIED defines unspecified
+	StreamStatus_STREAM_STATUS_UNSPECIFIED StreamStatus = 0
+	// STREAM_STATUS_PENDING defines a stream whose start time has not yet been reached
+	StreamStatus_STREAM_STATUS_PENDING StreamStatus = 1
+	// STREAM_STATUS_CLIFF defines a stream that is flowing, but whose cliff time has not yet been reached
+	StreamStatus_STREAM_STATUS_CLIFF StreamStatus = 2
+	// STREAM_STATUS_FLOWING defines a stream that is flowing and claimable
+	StreamStatus_STREAM_STATUS_FLOWING StreamStatus = 3
+	// STREAM_STATUS_ENDED defines a stream that has reached its end time, or whose deposit has run out
+	StreamStatus_STREAM_STATUS_ENDED StreamStatus = 4
+	// STREAM_STATUS_PAUSED defines a stream that has been paused by its sender
+	StreamStatus_STREAM_STATUS_PAUSED StreamStatus = 5
+)
+
+// Enum value maps for StreamStatus.
+var (
+	StreamStatus_name = map[int32]string{
+		0: "STREAM_STATUS_UNSPECIFIED",
+		1: "STREAM_STATUS_PENDING",
+		2: "STREAM_STATUS_CLIFF",
+		3: "STREAM_STATUS_FLOWING",
+		4: "STREAM_STATUS_ENDED",
+		5: "STREAM_STATUS_PAUSED",
+	}
+	StreamStatus_value = map[string]int32{
+		"STREAM_STATUS_UNSPECIFIED": 0,
+		"STREAM_STATUS_PENDING":     1,
+		"STREAM_STATUS_CLIFF":       2,
+		"STREAM_STATUS_FLOWING":     3,
+		"STREAM_STATUS_ENDED":       4,
+		"STREAM_STATUS_PAUSED":      5,
+	}
+)
+
+func (x StreamStatus) Enum() *StreamStatus {
+	p := new(StreamStatus)
+	*p = x
+	return p
+}
+
+func (x StreamStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StreamStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_mainchain_stream_v1_stream_proto_enumTypes[1].Descriptor()
+}
+
+func (StreamStatus) Type() protoreflect.EnumType {
+	return &file_mainchain_stream_v1_stream_proto_enumTypes[1]
+}
+
+func (x StreamStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StreamStatus.Descriptor instead.
+func (StreamStatus) EnumDescriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_stream_proto_rawDescGZIP(), []int{1}
+}
+
 // Stream holds data about a stream
 type Stream struct {
 	state         protoimpl.MessageState
@@ -814,6 +3474,35 @@ type Stream struct {
 	DepositZeroTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=deposit_zero_time,json=depositZeroTime,proto3" json:"deposit_zero_time,omitempty"`
 	// cancellable is whether a stream can be cancelled. Default is true, but will be false for example id eFUND is used
 	Cancellable bool `protobuf:"varint,5,opt,name=cancellable,proto3" json:"cancellable,omitempty"`
+	// stream_id is the unique ID of the stream
+	StreamId uint64 `protobuf:"varint,6,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// receiver is the wallet that receives stream payments
+	Receiver string `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// sender is the wallet that created and funds the stream
+	Sender string `protobuf:"bytes,8,opt,name=sender,proto3" json:"sender,omitempty"`
+	// start_time is the timestamp from which the stream starts flowing
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// cliff_time is the optional timestamp before which nothing can be claimed from the stream
+	CliffTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=cliff_time,json=cliffTime,proto3" json:"cliff_time,omitempty"`
+	// end_time is the optional timestamp at which the stream stops flowing. Any remaining deposit is returned to the sender
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// pausable is whether the sender can pause the stream. It is set when the stream is created, so the receiver
+	// knows the terms of the stream they are receiving. The stream cannot be paused until the receiver has
+	// accepted this, see pausable_accepted
+	Pausable bool `protobuf:"varint,12,opt,name=pausable,proto3" json:"pausable,omitempty"`
+	// paused_at is the timestamp the stream was paused at. Zero if the stream is not paused
+	PausedAt *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=paused_at,json=pausedAt,proto3" json:"paused_at,omitempty"` // low_deposit_threshold is the optional number of seconds of remaining deposit below which a stream_low_deposit
+	// event is emitted. Zero disables the alert
+	LowDepositThreshold uint64 `protobuf:"varint,14,opt,name=low_deposit_threshold,json=lowDepositThreshold,proto3" json:"low_deposit_threshold,omitempty"`
+	// low_deposit_alerted is whether the stream_low_deposit event has been emitted since the stream's remaining
+	// deposit last fell below the low_deposit_threshold
+	LowDepositAlerted bool `protobuf:"varint,15,opt,name=low_deposit_alerted,json=lowDepositAlerted,proto3" json:"low_deposit_alerted,omitempty"`
+	// termination_time is the timestamp at which the stream will be terminated, following a termination request
+	// from the sender. Zero if termination has not been requested
+	TerminationTime *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=termination_time,json=terminationTime,proto3" json:"termination_time,omitempty"`
+	// pausable_accepted is whether the receiver has accepted that a pausable stream can be paused by the sender.
+	// Reset when the stream is transferred to a new receiver
+	PausableAccepted bool `protobuf:"varint,17,opt,name=pausable_accepted,json=pausableAccepted,proto3" json:"pausable_accepted,omitempty"`
 }
 
 func (x *Stream) Reset() {
@@ -871,6 +3560,276 @@ func (x *Stream) GetCancellable() bool {
 	return false
 }
 
+func (x *Stream) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *Stream) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
+}
+
+func (x *Stream) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *Stream) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Stream) GetCliffTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CliffTime
+	}
+	return nil
+}
+
+func (x *Stream) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Stream) GetPausable() bool {
+	if x != nil {
+		return x.Pausable
+	}
+	return false
+}
+
+func (x *Stream) GetPausedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PausedAt
+	}
+	return nil
+}
+
+func (x *Stream) GetLowDepositThreshold() uint64 {
+	if x != nil {
+		return x.LowDepositThreshold
+	}
+	return 0
+}
+
+func (x *Stream) GetLowDepositAlerted() bool {
+	if x != nil {
+		return x.LowDepositAlerted
+	}
+	return false
+}
+
+func (x *Stream) GetTerminationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TerminationTime
+	}
+	return nil
+}
+
+func (x *Stream) GetPausableAccepted() bool {
+	if x != nil {
+		return x.PausableAccepted
+	}
+	return false
+}
+
+// StreamStats holds cumulative claim statistics for a stream. They are kept for the lifetime of the stream, and
+// for DeletedStreamAuditRetention after it is deleted
+type StreamStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// stream_id is the ID of the stream
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// total_claimed is the total value claimed from the stream's deposit, including validator fees
+	TotalClaimed *v1beta1.Coin `protobuf:"bytes,2,opt,name=total_claimed,json=totalClaimed,proto3" json:"total_claimed,omitempty"`
+	// total_validator_fees is the total value of validator fees paid from the stream's claims
+	TotalValidatorFees *v1beta1.Coin `protobuf:"bytes,3,opt,name=total_validator_fees,json=totalValidatorFees,proto3" json:"total_validator_fees,omitempty"`
+	// num_claims is the number of claims that have paid out from the stream
+	NumClaims uint64 `protobuf:"varint,4,opt,name=num_claims,json=numClaims,proto3" json:"num_claims,omitempty"`
+	// last_claim_time is the timestamp of the most recent claim that paid out from the stream
+	LastClaimTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_claim_time,json=lastClaimTime,proto3" json:"last_claim_time,omitempty"`
+}
+
+func (x *StreamStats) Reset() {
+	*x = StreamStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_stream_v1_stream_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamStats) ProtoMessage() {}
+
+// Deprecated: Use StreamStats.ProtoReflect.Descriptor instead.
+func (*StreamStats) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_stream_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StreamStats) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *StreamStats) GetTotalClaimed() *v1beta1.Coin {
+	if x != nil {
+		return x.TotalClaimed
+	}
+	return nil
+}
+
+func (x *StreamStats) GetTotalValidatorFees() *v1beta1.Coin {
+	if x != nil {
+		return x.TotalValidatorFees
+	}
+	return nil
+}
+
+func (x *StreamStats) GetNumClaims() uint64 {
+	if x != nil {
+		return x.NumClaims
+	}
+	return 0
+}
+
+func (x *StreamStats) GetLastClaimTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastClaimTime
+	}
+	return nil
+}
+
+// FlowRateChange records a change to a stream's flow rate
+type FlowRateChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// old_flow_rate is the flow rate before the change
+	OldFlowRate int64 `protobuf:"varint,1,opt,name=old_flow_rate,json=oldFlowRate,proto3" json:"old_flow_rate,omitempty"`
+	// new_flow_rate is the flow rate after the change
+	NewFlowRate int64 `protobuf:"varint,2,opt,name=new_flow_rate,json=newFlowRate,proto3" json:"new_flow_rate,omitempty"`
+	// changed_at is the block time of the change
+	ChangedAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	// block_height is the block height of the change
+	BlockHeight int64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (x *FlowRateChange) Reset() {
+	*x = FlowRateChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_stream_v1_stream_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlowRateChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlowRateChange) ProtoMessage() {}
+
+// Deprecated: Use FlowRateChange.ProtoReflect.Descriptor instead.
+func (*FlowRateChange) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_stream_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FlowRateChange) GetOldFlowRate() int64 {
+	if x != nil {
+		return x.OldFlowRate
+	}
+	return 0
+}
+
+func (x *FlowRateChange) GetNewFlowRate() int64 {
+	if x != nil {
+		return x.NewFlowRate
+	}
+	return 0
+}
+
+func (x *FlowRateChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+func (x *FlowRateChange) GetBlockHeight() int64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+// StreamHistory holds a stream's most recent flow rate changes, oldest first. At most MaxFlowRateChangeHistory
+// changes are kept, and the history is kept for the lifetime of the stream, and for DeletedStreamAuditRetention
+// after it is deleted
+type StreamHistory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// stream_id is the ID of the stream
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// flow_rate_changes are the most recent flow rate changes, oldest first
+	FlowRateChanges []*FlowRateChange `protobuf:"bytes,2,rep,name=flow_rate_changes,json=flowRateChanges,proto3" json:"flow_rate_changes,omitempty"`
+}
+
+func (x *StreamHistory) Reset() {
+	*x = StreamHistory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_mainchain_stream_v1_stream_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamHistory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamHistory) ProtoMessage() {}
+
+// Deprecated: Use StreamHistory.ProtoReflect.Descriptor instead.
+func (*StreamHistory) Descriptor() ([]byte, []int) {
+	return file_mainchain_stream_v1_stream_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *StreamHistory) GetStreamId() uint64 {
+	if x != nil {
+		return x.StreamId
+	}
+	return 0
+}
+
+func (x *StreamHistory) GetFlowRateChanges() []*FlowRateChange {
+	if x != nil {
+		return x.FlowRateChanges
+	}
+	return nil
+}
+
 var File_mainchain_stream_v1_stream_proto protoreflect.FileDescriptor
 
 var file_mainchain_stream_v1_stream_proto_rawDesc = []byte{
@@ -885,7 +3844,7 @@ var file_mainchain_stream_v1_stream_proto_rawDesc = []byte{
 	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
 	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
-	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf5, 0x02, 0x0a,
+	0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf2, 0x08, 0x0a,
 	0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x39, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73,
 	0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
 	0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43,
@@ -907,47 +3866,159 @@ var file_mainchain_stream_v1_stream_proto_rawDesc = []byte{
 	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0f, 0x64, 0x65, 0x70, 0x6f,
 	0x73, 0x69, 0x74, 0x5a, 0x65, 0x72, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x63,
 	0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x3a, 0x15, 0x8a,
-	0xe7, 0xb0, 0x2a, 0x10, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x2a, 0x9a, 0x03, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
-	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f,
-	0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
-	0x45, 0x44, 0x10, 0x00, 0x1a, 0x1b, 0x8a, 0x9d, 0x20, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65,
-	0x64, 0x12, 0x30, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x50, 0x45, 0x52, 0x49,
-	0x4f, 0x44, 0x5f, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x10, 0x01, 0x1a, 0x16, 0x8a, 0x9d, 0x20,
-	0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x50, 0x45,
-	0x52, 0x49, 0x4f, 0x44, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x10, 0x02, 0x1a, 0x16, 0x8a,
-	0x9d, 0x20, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4d,
-	0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f,
-	0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x48, 0x4f, 0x55, 0x52, 0x10, 0x03, 0x1a, 0x14, 0x8a,
-	0x9d, 0x20, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x48,
-	0x6f, 0x75, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x50, 0x45,
-	0x52, 0x49, 0x4f, 0x44, 0x5f, 0x44, 0x41, 0x59, 0x10, 0x04, 0x1a, 0x13, 0x8a, 0x9d, 0x20, 0x0f,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x44, 0x61, 0x79, 0x12,
-	0x2c, 0x0a, 0x12, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44,
-	0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x05, 0x1a, 0x14, 0x8a, 0x9d, 0x20, 0x10, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x57, 0x65, 0x65, 0x6b, 0x12, 0x2e, 0x0a,
-	0x13, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x4d,
-	0x4f, 0x4e, 0x54, 0x48, 0x10, 0x06, 0x1a, 0x15, 0x8a, 0x9d, 0x20, 0x11, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x2c, 0x0a,
-	0x12, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x59,
-	0x45, 0x41, 0x52, 0x10, 0x07, 0x1a, 0x14, 0x8a, 0x9d, 0x20, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x59, 0x65, 0x61, 0x72, 0x1a, 0x04, 0x88, 0xa3, 0x1e,
-	0x00, 0x42, 0xc3, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x53,
-	0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x2d, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d,
-	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f,
-	0x76, 0x31, 0x3b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x53,
-	0x58, 0xaa, 0x02, 0x13, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1f,
-	0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x15, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x0b, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x34, 0x0a, 0x08, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x12, 0x30, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x12, 0x58, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x58, 0x0a, 0x0a,
+	0x63, 0x6c, 0x69, 0x66, 0x66, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1d, 0xc8, 0xde,
+	0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6c, 0x69, 0x66,
+	0x66, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x63, 0x6c, 0x69,
+	0x66, 0x66, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x52, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x42, 0x1b, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x0f, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x90, 0xdf, 0x1f,
+	0x01, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x70, 0x61,
+	0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x55, 0x0a, 0x09, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1c, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f, 0x10, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x90,
+	0xdf, 0x1f, 0x01, 0x52, 0x08, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x41, 0x74, 0x12, 0x32, 0x0a,
+	0x15, 0x6c, 0x6f, 0x77, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x6c, 0x6f,
+	0x77, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6c, 0x6f, 0x77, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x5f, 0x61, 0x6c, 0x65, 0x72, 0x74, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11,
+	0x6c, 0x6f, 0x77, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x65,
+	0x64, 0x12, 0x6a, 0x0a, 0x10, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xf2, 0xde, 0x1f,
+	0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x74, 0x65, 0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x0f, 0x74, 0x65,
+	0x72, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2b, 0x0a,
+	0x11, 0x70, 0x61, 0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x61, 0x75, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x65, 0x64, 0x3a, 0x15, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x10, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x2f, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x22, 0xca, 0x02, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x44,
+	0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x6c, 0x61,
+	0x69, 0x6d, 0x65, 0x64, 0x12, 0x51, 0x0a, 0x14, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x46, 0x65, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x75, 0x6d, 0x5f, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6e, 0x75, 0x6d,
+	0x43, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x66, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x22, 0xc8, 0xde, 0x1f,
+	0x00, 0xf2, 0xde, 0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x63, 0x6c, 0x61, 0x69, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xd5,
+	0x01, 0x0a, 0x0e, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x5f, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6f, 0x6c, 0x64, 0x46, 0x6c, 0x6f,
+	0x77, 0x52, 0x61, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x6e, 0x65, 0x77, 0x5f, 0x66, 0x6c, 0x6f,
+	0x77, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6e, 0x65,
+	0x77, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65, 0x12, 0x58, 0x0a, 0x0a, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xf2,
+	0xde, 0x1f, 0x11, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x22, 0x90, 0xdf, 0x1f, 0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x55, 0x0a, 0x11, 0x66, 0x6c, 0x6f, 0x77, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x6c, 0x6f, 0x77, 0x52, 0x61, 0x74, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x66, 0x6c, 0x6f,
+	0x77, 0x52, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x2a, 0x9a, 0x03, 0x0a,
+	0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x3a, 0x0a,
+	0x19, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x55,
+	0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x1b, 0x8a, 0x9d,
+	0x20, 0x17, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x55, 0x6e,
+	0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x53, 0x54, 0x52,
+	0x45, 0x41, 0x4d, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x53, 0x45, 0x43, 0x4f, 0x4e,
+	0x44, 0x10, 0x01, 0x1a, 0x16, 0x8a, 0x9d, 0x20, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x53,
+	0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x4d, 0x49, 0x4e,
+	0x55, 0x54, 0x45, 0x10, 0x02, 0x1a, 0x16, 0x8a, 0x9d, 0x20, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x2c, 0x0a,
+	0x12, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x48,
+	0x4f, 0x55, 0x52, 0x10, 0x03, 0x1a, 0x14, 0x8a, 0x9d, 0x20, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x53,
+	0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x44, 0x41, 0x59,
+	0x10, 0x04, 0x1a, 0x13, 0x8a, 0x9d, 0x20, 0x0f, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x44, 0x61, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x53, 0x54, 0x52, 0x45, 0x41,
+	0x4d, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x57, 0x45, 0x45, 0x4b, 0x10, 0x05, 0x1a,
+	0x14, 0x8a, 0x9d, 0x20, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f,
+	0x64, 0x57, 0x65, 0x65, 0x6b, 0x12, 0x2e, 0x0a, 0x13, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f,
+	0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x10, 0x06, 0x1a, 0x15,
+	0x8a, 0x9d, 0x20, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x2c, 0x0a, 0x12, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f,
+	0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x59, 0x45, 0x41, 0x52, 0x10, 0x07, 0x1a, 0x14, 0x8a,
+	0x9d, 0x20, 0x10, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x59,
+	0x65, 0x61, 0x72, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x2a, 0xca, 0x02, 0x0a, 0x0c, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x53, 0x54,
+	0x52, 0x45, 0x41, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x1a, 0x1b, 0x8a, 0x9d, 0x20, 0x17, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x55, 0x6e, 0x73, 0x70, 0x65,
+	0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x15, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10,
+	0x01, 0x1a, 0x17, 0x8a, 0x9d, 0x20, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x13, 0x53, 0x54,
+	0x52, 0x45, 0x41, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x4c, 0x49, 0x46,
+	0x46, 0x10, 0x02, 0x1a, 0x15, 0x8a, 0x9d, 0x20, 0x11, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6c, 0x69, 0x66, 0x66, 0x12, 0x32, 0x0a, 0x15, 0x53, 0x54,
+	0x52, 0x45, 0x41, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x4c, 0x4f, 0x57,
+	0x49, 0x4e, 0x47, 0x10, 0x03, 0x1a, 0x17, 0x8a, 0x9d, 0x20, 0x13, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x46, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x2e,
+	0x0a, 0x13, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x04, 0x1a, 0x15, 0x8a, 0x9d, 0x20, 0x11, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x6e, 0x64, 0x65, 0x64, 0x12, 0x30,
+	0x0a, 0x14, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
+	0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x10, 0x05, 0x1a, 0x16, 0x8a, 0x9d, 0x20, 0x12, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xc3, 0x01, 0x0a, 0x17, 0x63, 0x6f, 0x6d, 0x2e, 0x6d,
+	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e,
+	0x76, 0x31, 0x42, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x2d, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x2f, 0x76, 0x31, 0x3b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x4d, 0x53, 0x58, 0xaa, 0x02, 0x13, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x13, 0x4d,
+	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c,
+	0x56, 0x31, 0xe2, 0x02, 0x1f, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x15, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x3a, 0x3a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -962,23 +4033,37 @@ func file_mainchain_stream_v1_stream_proto_rawDescGZIP() []byte {
 	return file_mainchain_stream_v1_stream_proto_rawDescData
 }
 
-var file_mainchain_stream_v1_stream_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_mainchain_stream_v1_stream_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_mainchain_stream_v1_stream_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_mainchain_stream_v1_stream_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_mainchain_stream_v1_stream_proto_goTypes = []interface{}{
 	(StreamPeriod)(0),             // 0: mainchain.stream.v1.StreamPeriod
-	(*Stream)(nil),                // 1: mainchain.stream.v1.Stream
-	(*v1beta1.Coin)(nil),          // 2: cosmos.base.v1beta1.Coin
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(StreamStatus)(0),             // 1: mainchain.stream.v1.StreamStatus
+	(*Stream)(nil),                // 2: mainchain.stream.v1.Stream
+	(*StreamStats)(nil),           // 3: mainchain.stream.v1.StreamStats
+	(*FlowRateChange)(nil),        // 4: mainchain.stream.v1.FlowRateChange
+	(*StreamHistory)(nil),         // 5: mainchain.stream.v1.StreamHistory
+	(*v1beta1.Coin)(nil),          // 6: cosmos.base.v1beta1.Coin
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
 }
 var file_mainchain_stream_v1_stream_proto_depIdxs = []int32{
-	2, // 0: mainchain.stream.v1.Stream.deposit:type_name -> cosmos.base.v1beta1.Coin
-	3, // 1: mainchain.stream.v1.Stream.last_outflow_time:type_name -> google.protobuf.Timestamp
-	3, // 2: mainchain.stream.v1.Stream.deposit_zero_time:type_name -> google.protobuf.Timestamp
-	3, // [3:3] is the sub-list for method output_type
-	3, // [3:3] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	6,  // 0: mainchain.stream.v1.Stream.deposit:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 1: mainchain.stream.v1.Stream.last_outflow_time:type_name -> google.protobuf.Timestamp
+	7,  // 2: mainchain.stream.v1.Stream.deposit_zero_time:type_name -> google.protobuf.Timestamp
+	7,  // 3: mainchain.stream.v1.Stream.start_time:type_name -> google.protobuf.Timestamp
+	7,  // 4: mainchain.stream.v1.Stream.cliff_time:type_name -> google.protobuf.Timestamp
+	7,  // 5: mainchain.stream.v1.Stream.end_time:type_name -> google.protobuf.Timestamp
+	7,  // 6: mainchain.stream.v1.Stream.paused_at:type_name -> google.protobuf.Timestamp
+	7,  // 7: mainchain.stream.v1.Stream.termination_time:type_name -> google.protobuf.Timestamp
+	6,  // 8: mainchain.stream.v1.StreamStats.total_claimed:type_name -> cosmos.base.v1beta1.Coin
+	6,  // 9: mainchain.stream.v1.StreamStats.total_validator_fees:type_name -> cosmos.base.v1beta1.Coin
+	7,  // 10: mainchain.stream.v1.StreamStats.last_claim_time:type_name -> google.protobuf.Timestamp
+	7,  // 11: mainchain.stream.v1.FlowRateChange.changed_at:type_name -> google.protobuf.Timestamp
+	4,  // 12: mainchain.stream.v1.StreamHistory.flow_rate_changes:type_name -> mainchain.stream.v1.FlowRateChange
+	13, // [13:13] is the sub-list for method output_type
+	13, // [13:13] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_mainchain_stream_v1_stream_proto_init() }
@@ -999,14 +4084,50 @@ func file_mainchain_stream_v1_stream_proto_init() {
 				return nil
 			}
 		}
+		file_mainchain_stream_v1_stream_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_stream_v1_stream_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlowRateChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_mainchain_stream_v1_stream_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamHistory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_mainchain_stream_v1_stream_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
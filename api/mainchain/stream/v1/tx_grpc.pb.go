@@ -19,12 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_CreateStream_FullMethodName   = "/mainchain.stream.v1.Msg/CreateStream"
-	Msg_ClaimStream_FullMethodName    = "/mainchain.stream.v1.Msg/ClaimStream"
-	Msg_TopUpDeposit_FullMethodName   = "/mainchain.stream.v1.Msg/TopUpDeposit"
-	Msg_UpdateFlowRate_FullMethodName = "/mainchain.stream.v1.Msg/UpdateFlowRate"
-	Msg_CancelStream_FullMethodName   = "/mainchain.stream.v1.Msg/CancelStream"
-	Msg_UpdateParams_FullMethodName   = "/mainchain.stream.v1.Msg/UpdateParams"
+	Msg_CreateStream_FullMethodName             = "/mainchain.stream.v1.Msg/CreateStream"
+	Msg_ClaimStream_FullMethodName              = "/mainchain.stream.v1.Msg/ClaimStream"
+	Msg_TopUpDeposit_FullMethodName             = "/mainchain.stream.v1.Msg/TopUpDeposit"
+	Msg_UpdateFlowRate_FullMethodName           = "/mainchain.stream.v1.Msg/UpdateFlowRate"
+	Msg_CancelStream_FullMethodName             = "/mainchain.stream.v1.Msg/CancelStream"
+	Msg_ClaimStreamById_FullMethodName          = "/mainchain.stream.v1.Msg/ClaimStreamById"
+	Msg_TopUpDepositById_FullMethodName         = "/mainchain.stream.v1.Msg/TopUpDepositById"
+	Msg_UpdateFlowRateById_FullMethodName       = "/mainchain.stream.v1.Msg/UpdateFlowRateById"
+	Msg_CancelStreamById_FullMethodName         = "/mainchain.stream.v1.Msg/CancelStreamById"
+	Msg_ClaimAllStreams_FullMethodName          = "/mainchain.stream.v1.Msg/ClaimAllStreams"
+	Msg_TransferStreamReceiver_FullMethodName   = "/mainchain.stream.v1.Msg/TransferStreamReceiver"
+	Msg_PauseStream_FullMethodName              = "/mainchain.stream.v1.Msg/PauseStream"
+	Msg_ResumeStream_FullMethodName             = "/mainchain.stream.v1.Msg/ResumeStream"
+	Msg_SetLowDepositThreshold_FullMethodName   = "/mainchain.stream.v1.Msg/SetLowDepositThreshold"
+	Msg_RequestStreamTermination_FullMethodName = "/mainchain.stream.v1.Msg/RequestStreamTermination"
+	Msg_AcceptStreamTermination_FullMethodName  = "/mainchain.stream.v1.Msg/AcceptStreamTermination"
+	Msg_AcceptPausableStream_FullMethodName     = "/mainchain.stream.v1.Msg/AcceptPausableStream"
+	Msg_UpdateParams_FullMethodName             = "/mainchain.stream.v1.Msg/UpdateParams"
 )
 
 // MsgClient is the client API for Msg service.
@@ -41,6 +53,30 @@ type MsgClient interface {
 	UpdateFlowRate(ctx context.Context, in *MsgUpdateFlowRate, opts ...grpc.CallOption) (*MsgUpdateFlowRateResponse, error)
 	// CancelStream defines a method to cancel a stream
 	CancelStream(ctx context.Context, in *MsgCancelStream, opts ...grpc.CallOption) (*MsgCancelStreamResponse, error)
+	// ClaimStreamById defines a method for a receiver to claim from a stream using the stream ID
+	ClaimStreamById(ctx context.Context, in *MsgClaimStreamById, opts ...grpc.CallOption) (*MsgClaimStreamByIdResponse, error)
+	// TopUpDepositById defines a method for senders to top up a stream using the stream ID
+	TopUpDepositById(ctx context.Context, in *MsgTopUpDepositById, opts ...grpc.CallOption) (*MsgTopUpDepositByIdResponse, error)
+	// UpdateFlowRateById defines a method to update a stream flow rate using the stream ID
+	UpdateFlowRateById(ctx context.Context, in *MsgUpdateFlowRateById, opts ...grpc.CallOption) (*MsgUpdateFlowRateByIdResponse, error)
+	// CancelStreamById defines a method to cancel a stream using the stream ID
+	CancelStreamById(ctx context.Context, in *MsgCancelStreamById, opts ...grpc.CallOption) (*MsgCancelStreamByIdResponse, error)
+	// ClaimAllStreams defines a method for a receiver to claim from all, or a batch of, their streams
+	ClaimAllStreams(ctx context.Context, in *MsgClaimAllStreams, opts ...grpc.CallOption) (*MsgClaimAllStreamsResponse, error)
+	// TransferStreamReceiver defines a method for a receiver to transfer a stream to a new receiver
+	TransferStreamReceiver(ctx context.Context, in *MsgTransferStreamReceiver, opts ...grpc.CallOption) (*MsgTransferStreamReceiverResponse, error)
+	// PauseStream defines a method for a sender to pause a pausable stream
+	PauseStream(ctx context.Context, in *MsgPauseStream, opts ...grpc.CallOption) (*MsgPauseStreamResponse, error)
+	// ResumeStream defines a method for a sender to resume a paused stream
+	ResumeStream(ctx context.Context, in *MsgResumeStream, opts ...grpc.CallOption) (*MsgResumeStreamResponse, error)
+	// SetLowDepositThreshold defines a method for a sender to set a stream's low deposit alert threshold
+	SetLowDepositThreshold(ctx context.Context, in *MsgSetLowDepositThreshold, opts ...grpc.CallOption) (*MsgSetLowDepositThresholdResponse, error)
+	// RequestStreamTermination defines a method for a sender to request the termination of a stream after a notice period
+	RequestStreamTermination(ctx context.Context, in *MsgRequestStreamTermination, opts ...grpc.CallOption) (*MsgRequestStreamTerminationResponse, error)
+	// AcceptStreamTermination defines a method for a receiver to accept the early termination of a stream
+	AcceptStreamTermination(ctx context.Context, in *MsgAcceptStreamTermination, opts ...grpc.CallOption) (*MsgAcceptStreamTerminationResponse, error)
+	// AcceptPausableStream defines a method for a receiver to accept that a pausable stream can be paused by its sender
+	AcceptPausableStream(ctx context.Context, in *MsgAcceptPausableStream, opts ...grpc.CallOption) (*MsgAcceptPausableStreamResponse, error)
 	// UpdateParams defines an operation for updating the x/stream module
 	// parameters.
 	// Since: cosmos-sdk 0.47
@@ -100,6 +136,114 @@ func (c *msgClient) CancelStream(ctx context.Context, in *MsgCancelStream, opts
 	return out, nil
 }
 
+func (c *msgClient) ClaimStreamById(ctx context.Context, in *MsgClaimStreamById, opts ...grpc.CallOption) (*MsgClaimStreamByIdResponse, error) {
+	out := new(MsgClaimStreamByIdResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimStreamById_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TopUpDepositById(ctx context.Context, in *MsgTopUpDepositById, opts ...grpc.CallOption) (*MsgTopUpDepositByIdResponse, error) {
+	out := new(MsgTopUpDepositByIdResponse)
+	err := c.cc.Invoke(ctx, Msg_TopUpDepositById_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFlowRateById(ctx context.Context, in *MsgUpdateFlowRateById, opts ...grpc.CallOption) (*MsgUpdateFlowRateByIdResponse, error) {
+	out := new(MsgUpdateFlowRateByIdResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateFlowRateById_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelStreamById(ctx context.Context, in *MsgCancelStreamById, opts ...grpc.CallOption) (*MsgCancelStreamByIdResponse, error) {
+	out := new(MsgCancelStreamByIdResponse)
+	err := c.cc.Invoke(ctx, Msg_CancelStreamById_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimAllStreams(ctx context.Context, in *MsgClaimAllStreams, opts ...grpc.CallOption) (*MsgClaimAllStreamsResponse, error) {
+	out := new(MsgClaimAllStreamsResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimAllStreams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferStreamReceiver(ctx context.Context, in *MsgTransferStreamReceiver, opts ...grpc.CallOption) (*MsgTransferStreamReceiverResponse, error) {
+	out := new(MsgTransferStreamReceiverResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferStreamReceiver_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseStream(ctx context.Context, in *MsgPauseStream, opts ...grpc.CallOption) (*MsgPauseStreamResponse, error) {
+	out := new(MsgPauseStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_PauseStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeStream(ctx context.Context, in *MsgResumeStream, opts ...grpc.CallOption) (*MsgResumeStreamResponse, error) {
+	out := new(MsgResumeStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_ResumeStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetLowDepositThreshold(ctx context.Context, in *MsgSetLowDepositThreshold, opts ...grpc.CallOption) (*MsgSetLowDepositThresholdResponse, error) {
+	out := new(MsgSetLowDepositThresholdResponse)
+	err := c.cc.Invoke(ctx, Msg_SetLowDepositThreshold_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestStreamTermination(ctx context.Context, in *MsgRequestStreamTermination, opts ...grpc.CallOption) (*MsgRequestStreamTerminationResponse, error) {
+	out := new(MsgRequestStreamTerminationResponse)
+	err := c.cc.Invoke(ctx, Msg_RequestStreamTermination_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptStreamTermination(ctx context.Context, in *MsgAcceptStreamTermination, opts ...grpc.CallOption) (*MsgAcceptStreamTerminationResponse, error) {
+	out := new(MsgAcceptStreamTerminationResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptStreamTermination_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptPausableStream(ctx context.Context, in *MsgAcceptPausableStream, opts ...grpc.CallOption) (*MsgAcceptPausableStreamResponse, error) {
+	out := new(MsgAcceptPausableStreamResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptPausableStream_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -123,6 +267,30 @@ type MsgServer interface {
 	UpdateFlowRate(context.Context, *MsgUpdateFlowRate) (*MsgUpdateFlowRateResponse, error)
 	// CancelStream defines a method to cancel a stream
 	CancelStream(context.Context, *MsgCancelStream) (*MsgCancelStreamResponse, error)
+	// ClaimStreamById defines a method for a receiver to claim from a stream using the stream ID
+	ClaimStreamById(context.Context, *MsgClaimStreamById) (*MsgClaimStreamByIdResponse, error)
+	// TopUpDepositById defines a method for senders to top up a stream using the stream ID
+	TopUpDepositById(context.Context, *MsgTopUpDepositById) (*MsgTopUpDepositByIdResponse, error)
+	// UpdateFlowRateById defines a method to update a stream flow rate using the stream ID
+	UpdateFlowRateById(context.Context, *MsgUpdateFlowRateById) (*MsgUpdateFlowRateByIdResponse, error)
+	// CancelStreamById defines a method to cancel a stream using the stream ID
+	CancelStreamById(context.Context, *MsgCancelStreamById) (*MsgCancelStreamByIdResponse, error)
+	// ClaimAllStreams defines a method for a receiver to claim from all, or a batch of, their streams
+	ClaimAllStreams(context.Context, *MsgClaimAllStreams) (*MsgClaimAllStreamsResponse, error)
+	// TransferStreamReceiver defines a method for a receiver to transfer a stream to a new receiver
+	TransferStreamReceiver(context.Context, *MsgTransferStreamReceiver) (*MsgTransferStreamReceiverResponse, error)
+	// PauseStream defines a method for a sender to pause a pausable stream
+	PauseStream(context.Context, *MsgPauseStream) (*MsgPauseStreamResponse, error)
+	// ResumeStream defines a method for a sender to resume a paused stream
+	ResumeStream(context.Context, *MsgResumeStream) (*MsgResumeStreamResponse, error)
+	// SetLowDepositThreshold defines a method for a sender to set a stream's low deposit alert threshold
+	SetLowDepositThreshold(context.Context, *MsgSetLowDepositThreshold) (*MsgSetLowDepositThresholdResponse, error)
+	// RequestStreamTermination defines a method for a sender to request the termination of a stream after a notice period
+	RequestStreamTermination(context.Context, *MsgRequestStreamTermination) (*MsgRequestStreamTerminationResponse, error)
+	// AcceptStreamTermination defines a method for a receiver to accept the early termination of a stream
+	AcceptStreamTermination(context.Context, *MsgAcceptStreamTermination) (*MsgAcceptStreamTerminationResponse, error)
+	// AcceptPausableStream defines a method for a receiver to accept that a pausable stream can be paused by its sender
+	AcceptPausableStream(context.Context, *MsgAcceptPausableStream) (*MsgAcceptPausableStreamResponse, error)
 	// UpdateParams defines an operation for updating the x/stream module
 	// parameters.
 	// Since: cosmos-sdk 0.47
@@ -149,6 +317,42 @@ func (UnimplementedMsgServer) UpdateFlowRate(context.Context, *MsgUpdateFlowRate
 func (UnimplementedMsgServer) CancelStream(context.Context, *MsgCancelStream) (*MsgCancelStreamResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelStream not implemented")
 }
+func (UnimplementedMsgServer) ClaimStreamById(context.Context, *MsgClaimStreamById) (*MsgClaimStreamByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimStreamById not implemented")
+}
+func (UnimplementedMsgServer) TopUpDepositById(context.Context, *MsgTopUpDepositById) (*MsgTopUpDepositByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpDepositById not implemented")
+}
+func (UnimplementedMsgServer) UpdateFlowRateById(context.Context, *MsgUpdateFlowRateById) (*MsgUpdateFlowRateByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFlowRateById not implemented")
+}
+func (UnimplementedMsgServer) CancelStreamById(context.Context, *MsgCancelStreamById) (*MsgCancelStreamByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelStreamById not implemented")
+}
+func (UnimplementedMsgServer) ClaimAllStreams(context.Context, *MsgClaimAllStreams) (*MsgClaimAllStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAllStreams not implemented")
+}
+func (UnimplementedMsgServer) TransferStreamReceiver(context.Context, *MsgTransferStreamReceiver) (*MsgTransferStreamReceiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferStreamReceiver not implemented")
+}
+func (UnimplementedMsgServer) PauseStream(context.Context, *MsgPauseStream) (*MsgPauseStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseStream not implemented")
+}
+func (UnimplementedMsgServer) ResumeStream(context.Context, *MsgResumeStream) (*MsgResumeStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeStream not implemented")
+}
+func (UnimplementedMsgServer) SetLowDepositThreshold(context.Context, *MsgSetLowDepositThreshold) (*MsgSetLowDepositThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLowDepositThreshold not implemented")
+}
+func (UnimplementedMsgServer) RequestStreamTermination(context.Context, *MsgRequestStreamTermination) (*MsgRequestStreamTerminationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestStreamTermination not implemented")
+}
+func (UnimplementedMsgServer) AcceptStreamTermination(context.Context, *MsgAcceptStreamTermination) (*MsgAcceptStreamTerminationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptStreamTermination not implemented")
+}
+func (UnimplementedMsgServer) AcceptPausableStream(context.Context, *MsgAcceptPausableStream) (*MsgAcceptPausableStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPausableStream not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -255,6 +459,222 @@ func _Msg_CancelStream_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ClaimStreamById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimStreamById)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimStreamById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimStreamById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimStreamById(ctx, req.(*MsgClaimStreamById))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TopUpDepositById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTopUpDepositById)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TopUpDepositById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TopUpDepositById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TopUpDepositById(ctx, req.(*MsgTopUpDepositById))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFlowRateById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFlowRateById)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFlowRateById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateFlowRateById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFlowRateById(ctx, req.(*MsgUpdateFlowRateById))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelStreamById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelStreamById)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelStreamById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelStreamById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelStreamById(ctx, req.(*MsgCancelStreamById))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimAllStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAllStreams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAllStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimAllStreams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAllStreams(ctx, req.(*MsgClaimAllStreams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferStreamReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferStreamReceiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferStreamReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferStreamReceiver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferStreamReceiver(ctx, req.(*MsgTransferStreamReceiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PauseStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseStream(ctx, req.(*MsgPauseStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResumeStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeStream(ctx, req.(*MsgResumeStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetLowDepositThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetLowDepositThreshold)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetLowDepositThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetLowDepositThreshold_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetLowDepositThreshold(ctx, req.(*MsgSetLowDepositThreshold))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestStreamTermination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestStreamTermination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestStreamTermination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RequestStreamTermination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestStreamTermination(ctx, req.(*MsgRequestStreamTermination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptStreamTermination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptStreamTermination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptStreamTermination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptStreamTermination_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptStreamTermination(ctx, req.(*MsgAcceptStreamTermination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptPausableStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptPausableStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptPausableStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptPausableStream_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptPausableStream(ctx, req.(*MsgAcceptPausableStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -300,6 +720,54 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CancelStream",
 			Handler:    _Msg_CancelStream_Handler,
 		},
+		{
+			MethodName: "ClaimStreamById",
+			Handler:    _Msg_ClaimStreamById_Handler,
+		},
+		{
+			MethodName: "TopUpDepositById",
+			Handler:    _Msg_TopUpDepositById_Handler,
+		},
+		{
+			MethodName: "UpdateFlowRateById",
+			Handler:    _Msg_UpdateFlowRateById_Handler,
+		},
+		{
+			MethodName: "CancelStreamById",
+			Handler:    _Msg_CancelStreamById_Handler,
+		},
+		{
+			MethodName: "ClaimAllStreams",
+			Handler:    _Msg_ClaimAllStreams_Handler,
+		},
+		{
+			MethodName: "TransferStreamReceiver",
+			Handler:    _Msg_TransferStreamReceiver_Handler,
+		},
+		{
+			MethodName: "PauseStream",
+			Handler:    _Msg_PauseStream_Handler,
+		},
+		{
+			MethodName: "ResumeStream",
+			Handler:    _Msg_ResumeStream_Handler,
+		},
+		{
+			MethodName: "SetLowDepositThreshold",
+			Handler:    _Msg_SetLowDepositThreshold_Handler,
+		},
+		{
+			MethodName: "RequestStreamTermination",
+			Handler:    _Msg_RequestStreamTermination_Handler,
+		},
+		{
+			MethodName: "AcceptStreamTermination",
+			Handler:    _Msg_AcceptStreamTermination_Handler,
+		},
+		{
+			MethodName: "AcceptPausableStream",
+			Handler:    _Msg_AcceptPausableStream_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
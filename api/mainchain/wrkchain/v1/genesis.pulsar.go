@@ -1457,11 +1457,58 @@ func (x *_WrkChainExport_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_WrkChainExport_4_list)(nil)
+
+type _WrkChainExport_4_list struct {
+	list *[]string
+}
+
+func (x *_WrkChainExport_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_WrkChainExport_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_WrkChainExport_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_WrkChainExport_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_WrkChainExport_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message WrkChainExport at list field Recorders as it is not of Message kind"))
+}
+
+func (x *_WrkChainExport_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_WrkChainExport_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_WrkChainExport_4_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
 	md_WrkChainExport                protoreflect.MessageDescriptor
 	fd_WrkChainExport_wrkchain       protoreflect.FieldDescriptor
 	fd_WrkChainExport_in_state_limit protoreflect.FieldDescriptor
 	fd_WrkChainExport_blocks         protoreflect.FieldDescriptor
+	fd_WrkChainExport_recorders      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1470,6 +1517,7 @@ func init() {
 	fd_WrkChainExport_wrkchain = md_WrkChainExport.Fields().ByName("wrkchain")
 	fd_WrkChainExport_in_state_limit = md_WrkChainExport.Fields().ByName("in_state_limit")
 	fd_WrkChainExport_blocks = md_WrkChainExport.Fields().ByName("blocks")
+	fd_WrkChainExport_recorders = md_WrkChainExport.Fields().ByName("recorders")
 }
 
 var _ protoreflect.Message = (*fastReflection_WrkChainExport)(nil)
@@ -1555,6 +1603,12 @@ func (x *fastReflection_WrkChainExport) Range(f func(protoreflect.FieldDescripto
 			return
 		}
 	}
+	if len(x.Recorders) != 0 {
+		value := protoreflect.ValueOfList(&_WrkChainExport_4_list{list: &x.Recorders})
+		if !f(fd_WrkChainExport_recorders, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1576,6 +1630,8 @@ func (x *fastReflection_WrkChainExport) Has(fd protoreflect.FieldDescriptor) boo
 		return x.InStateLimit != uint64(0)
 	case "mainchain.wrkchain.v1.WrkChainExport.blocks":
 		return len(x.Blocks) != 0
+	case "mainchain.wrkchain.v1.WrkChainExport.recorders":
+		return len(x.Recorders) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.wrkchain.v1.WrkChainExport"))
@@ -1598,6 +1654,8 @@ func (x *fastReflection_WrkChainExport) Clear(fd protoreflect.FieldDescriptor) {
 		x.InStateLimit = uint64(0)
 	case "mainchain.wrkchain.v1.WrkChainExport.blocks":
 		x.Blocks = nil
+	case "mainchain.wrkchain.v1.WrkChainExport.recorders":
+		x.Recorders = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.wrkchain.v1.WrkChainExport"))
@@ -1626,6 +1684,12 @@ func (x *fastReflection_WrkChainExport) Get(descriptor protoreflect.FieldDescrip
 		}
 		listValue := &_WrkChainExport_3_list{list: &x.Blocks}
 		return protoreflect.ValueOfList(listValue)
+	case "mainchain.wrkchain.v1.WrkChainExport.recorders":
+		if len(x.Recorders) == 0 {
+			return protoreflect.ValueOfList(&_WrkChainExport_4_list{})
+		}
+		listValue := &_WrkChainExport_4_list{list: &x.Recorders}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.wrkchain.v1.WrkChainExport"))
@@ -1654,6 +1718,10 @@ func (x *fastReflection_WrkChainExport) Set(fd protoreflect.FieldDescriptor, val
 		lv := value.List()
 		clv := lv.(*_WrkChainExport_3_list)
 		x.Blocks = *clv.list
+	case "mainchain.wrkchain.v1.WrkChainExport.recorders":
+		lv := value.List()
+		clv := lv.(*_WrkChainExport_4_list)
+		x.Recorders = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.wrkchain.v1.WrkChainExport"))
@@ -1685,6 +1753,12 @@ func (x *fastReflection_WrkChainExport) Mutable(fd protoreflect.FieldDescriptor)
 		}
 		value := &_WrkChainExport_3_list{list: &x.Blocks}
 		return protoreflect.ValueOfList(value)
+	case "mainchain.wrkchain.v1.WrkChainExport.recorders":
+		if x.Recorders == nil {
+			x.Recorders = []string{}
+		}
+		value := &_WrkChainExport_4_list{list: &x.Recorders}
+		return protoreflect.ValueOfList(value)
 	case "mainchain.wrkchain.v1.WrkChainExport.in_state_limit":
 		panic(fmt.Errorf("field in_state_limit of message mainchain.wrkchain.v1.WrkChainExport is not mutable"))
 	default:
@@ -1708,6 +1782,9 @@ func (x *fastReflection_WrkChainExport) NewField(fd protoreflect.FieldDescriptor
 	case "mainchain.wrkchain.v1.WrkChainExport.blocks":
 		list := []*WrkChainBlockGenesisExport{}
 		return protoreflect.ValueOfList(&_WrkChainExport_3_list{list: &list})
+	case "mainchain.wrkchain.v1.WrkChainExport.recorders":
+		list := []string{}
+		return protoreflect.ValueOfList(&_WrkChainExport_4_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: mainchain.wrkchain.v1.WrkChainExport"))
@@ -1790,6 +1867,12 @@ func (x *fastReflection_WrkChainExport) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.Recorders) > 0 {
+			for _, s := range x.Recorders {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1819,6 +1902,15 @@ func (x *fastReflection_WrkChainExport) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Recorders) > 0 {
+			for iNdEx := len(x.Recorders) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Recorders[iNdEx])
+				copy(dAtA[i:], x.Recorders[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recorders[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.Blocks) > 0 {
 			for iNdEx := len(x.Blocks) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Blocks[iNdEx])
@@ -1992,6 +2084,38 @@ func (x *fastReflection_WrkChainExport) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recorders", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recorders = append(x.Recorders, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2187,6 +2311,8 @@ type WrkChainExport struct {
 	Wrkchain     *WrkChain                     `protobuf:"bytes,1,opt,name=wrkchain,proto3" json:"wrkchain,omitempty"`
 	InStateLimit uint64                        `protobuf:"varint,2,opt,name=in_state_limit,json=inStateLimit,proto3" json:"in_state_limit,omitempty"`
 	Blocks       []*WrkChainBlockGenesisExport `protobuf:"bytes,3,rep,name=blocks,proto3" json:"blocks,omitempty"`
+	// recorders are the addresses authorised by the owner to record block hashes for the wrkchain
+	Recorders []string `protobuf:"bytes,4,rep,name=recorders,proto3" json:"recorders,omitempty"`
 }
 
 func (x *WrkChainExport) Reset() {
@@ -2230,6 +2356,13 @@ func (x *WrkChainExport) GetBlocks() []*WrkChainBlockGenesisExport {
 	return nil
 }
 
+func (x *WrkChainExport) GetRecorders() []string {
+	if x != nil {
+		return x.Recorders
+	}
+	return nil
+}
+
 var File_mainchain_wrkchain_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_mainchain_wrkchain_v1_genesis_proto_rawDesc = []byte{
@@ -2264,7 +2397,7 @@ var file_mainchain_wrkchain_v1_genesis_proto_rawDesc = []byte{
 	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x68, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x68, 0x32,
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x68, 0x32, 0x12, 0x0e, 0x0a, 0x02, 0x68, 0x33,
 	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x68, 0x33, 0x12, 0x0e, 0x0a, 0x02, 0x73, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x73, 0x74, 0x22, 0xe9, 0x01, 0x0a, 0x0e, 0x57,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x73, 0x74, 0x22, 0x87, 0x02, 0x0a, 0x0e, 0x57,
 	0x72, 0x6b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x41, 0x0a,
 	0x08, 0x77, 0x72, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1f, 0x2e, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x77, 0x72, 0x6b, 0x63,
@@ -2279,21 +2412,23 @@ var file_mainchain_wrkchain_v1_genesis_proto_rawDesc = []byte{
 	0x73, 0x69, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xaa,
 	0xdf, 0x1f, 0x1b, 0x57, 0x72, 0x6b, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
 	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x06,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x42, 0xd2, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6d,
-	0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x77, 0x72, 0x6b, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x31, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
-	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x2f, 0x77, 0x72, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x77, 0x72, 0x6b,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x57, 0x58, 0xaa, 0x02, 0x15,
-	0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x57, 0x72, 0x6b, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5c, 0x57, 0x72, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21,
-	0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x57, 0x72, 0x6b, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x17, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x57,
-	0x72, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64,
+	0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x6f, 0x72,
+	0x64, 0x65, 0x72, 0x73, 0x42, 0xd2, 0x01, 0x0a, 0x19, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x61, 0x69,
+	0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x77, 0x72, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e,
+	0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x31, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x77,
+	0x72, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2f, 0x76, 0x31, 0x3b, 0x77, 0x72, 0x6b, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x4d, 0x57, 0x58, 0xaa, 0x02, 0x15, 0x4d, 0x61,
+	0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x2e, 0x57, 0x72, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x2e, 0x56, 0x31, 0xca, 0x02, 0x15, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c,
+	0x57, 0x72, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x21, 0x4d, 0x61,
+	0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5c, 0x57, 0x72, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
+	0x02, 0x17, 0x4d, 0x61, 0x69, 0x6e, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x57, 0x72, 0x6b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
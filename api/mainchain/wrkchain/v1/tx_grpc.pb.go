@@ -22,6 +22,9 @@ const (
 	Msg_RegisterWrkChain_FullMethodName             = "/mainchain.wrkchain.v1.Msg/RegisterWrkChain"
 	Msg_RecordWrkChainBlock_FullMethodName          = "/mainchain.wrkchain.v1.Msg/RecordWrkChainBlock"
 	Msg_PurchaseWrkChainStateStorage_FullMethodName = "/mainchain.wrkchain.v1.Msg/PurchaseWrkChainStateStorage"
+	Msg_TransferWrkChainOwnership_FullMethodName    = "/mainchain.wrkchain.v1.Msg/TransferWrkChainOwnership"
+	Msg_AddWrkChainRecorder_FullMethodName          = "/mainchain.wrkchain.v1.Msg/AddWrkChainRecorder"
+	Msg_RemoveWrkChainRecorder_FullMethodName       = "/mainchain.wrkchain.v1.Msg/RemoveWrkChainRecorder"
 	Msg_UpdateParams_FullMethodName                 = "/mainchain.wrkchain.v1.Msg/UpdateParams"
 )
 
@@ -35,6 +38,12 @@ type MsgClient interface {
 	RecordWrkChainBlock(ctx context.Context, in *MsgRecordWrkChainBlock, opts ...grpc.CallOption) (*MsgRecordWrkChainBlockResponse, error)
 	// PurchaseWrkChainStateStorage defines the method to purchase more state storage
 	PurchaseWrkChainStateStorage(ctx context.Context, in *MsgPurchaseWrkChainStateStorage, opts ...grpc.CallOption) (*MsgPurchaseWrkChainStateStorageResponse, error)
+	// TransferWrkChainOwnership defines a method to transfer a wrkchain to a new owner
+	TransferWrkChainOwnership(ctx context.Context, in *MsgTransferWrkChainOwnership, opts ...grpc.CallOption) (*MsgTransferWrkChainOwnershipResponse, error)
+	// AddWrkChainRecorder defines a method for a wrkchain owner to authorise an address to record block hashes
+	AddWrkChainRecorder(ctx context.Context, in *MsgAddWrkChainRecorder, opts ...grpc.CallOption) (*MsgAddWrkChainRecorderResponse, error)
+	// RemoveWrkChainRecorder defines a method for a wrkchain owner to revoke a recorder's authorisation
+	RemoveWrkChainRecorder(ctx context.Context, in *MsgRemoveWrkChainRecorder, opts ...grpc.CallOption) (*MsgRemoveWrkChainRecorderResponse, error)
 	// UpdateParams defines an operation for updating the x/wrkchain module
 	// parameters.
 	// Since: cosmos-sdk 0.47
@@ -76,6 +85,33 @@ func (c *msgClient) PurchaseWrkChainStateStorage(ctx context.Context, in *MsgPur
 	return out, nil
 }
 
+func (c *msgClient) TransferWrkChainOwnership(ctx context.Context, in *MsgTransferWrkChainOwnership, opts ...grpc.CallOption) (*MsgTransferWrkChainOwnershipResponse, error) {
+	out := new(MsgTransferWrkChainOwnershipResponse)
+	err := c.cc.Invoke(ctx, Msg_TransferWrkChainOwnership_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddWrkChainRecorder(ctx context.Context, in *MsgAddWrkChainRecorder, opts ...grpc.CallOption) (*MsgAddWrkChainRecorderResponse, error) {
+	out := new(MsgAddWrkChainRecorderResponse)
+	err := c.cc.Invoke(ctx, Msg_AddWrkChainRecorder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveWrkChainRecorder(ctx context.Context, in *MsgRemoveWrkChainRecorder, opts ...grpc.CallOption) (*MsgRemoveWrkChainRecorderResponse, error) {
+	out := new(MsgRemoveWrkChainRecorderResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveWrkChainRecorder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_UpdateParams_FullMethodName, in, out, opts...)
@@ -95,6 +131,12 @@ type MsgServer interface {
 	RecordWrkChainBlock(context.Context, *MsgRecordWrkChainBlock) (*MsgRecordWrkChainBlockResponse, error)
 	// PurchaseWrkChainStateStorage defines the method to purchase more state storage
 	PurchaseWrkChainStateStorage(context.Context, *MsgPurchaseWrkChainStateStorage) (*MsgPurchaseWrkChainStateStorageResponse, error)
+	// TransferWrkChainOwnership defines a method to transfer a wrkchain to a new owner
+	TransferWrkChainOwnership(context.Context, *MsgTransferWrkChainOwnership) (*MsgTransferWrkChainOwnershipResponse, error)
+	// AddWrkChainRecorder defines a method for a wrkchain owner to authorise an address to record block hashes
+	AddWrkChainRecorder(context.Context, *MsgAddWrkChainRecorder) (*MsgAddWrkChainRecorderResponse, error)
+	// RemoveWrkChainRecorder defines a method for a wrkchain owner to revoke a recorder's authorisation
+	RemoveWrkChainRecorder(context.Context, *MsgRemoveWrkChainRecorder) (*MsgRemoveWrkChainRecorderResponse, error)
 	// UpdateParams defines an operation for updating the x/wrkchain module
 	// parameters.
 	// Since: cosmos-sdk 0.47
@@ -115,6 +157,15 @@ func (UnimplementedMsgServer) RecordWrkChainBlock(context.Context, *MsgRecordWrk
 func (UnimplementedMsgServer) PurchaseWrkChainStateStorage(context.Context, *MsgPurchaseWrkChainStateStorage) (*MsgPurchaseWrkChainStateStorageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurchaseWrkChainStateStorage not implemented")
 }
+func (UnimplementedMsgServer) TransferWrkChainOwnership(context.Context, *MsgTransferWrkChainOwnership) (*MsgTransferWrkChainOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferWrkChainOwnership not implemented")
+}
+func (UnimplementedMsgServer) AddWrkChainRecorder(context.Context, *MsgAddWrkChainRecorder) (*MsgAddWrkChainRecorderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWrkChainRecorder not implemented")
+}
+func (UnimplementedMsgServer) RemoveWrkChainRecorder(context.Context, *MsgRemoveWrkChainRecorder) (*MsgRemoveWrkChainRecorderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWrkChainRecorder not implemented")
+}
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -185,6 +236,60 @@ func _Msg_PurchaseWrkChainStateStorage_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferWrkChainOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferWrkChainOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferWrkChainOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_TransferWrkChainOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferWrkChainOwnership(ctx, req.(*MsgTransferWrkChainOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddWrkChainRecorder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddWrkChainRecorder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddWrkChainRecorder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddWrkChainRecorder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddWrkChainRecorder(ctx, req.(*MsgAddWrkChainRecorder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveWrkChainRecorder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveWrkChainRecorder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveWrkChainRecorder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveWrkChainRecorder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveWrkChainRecorder(ctx, req.(*MsgRemoveWrkChainRecorder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -222,6 +327,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PurchaseWrkChainStateStorage",
 			Handler:    _Msg_PurchaseWrkChainStateStorage_Handler,
 		},
+		{
+			MethodName: "TransferWrkChainOwnership",
+			Handler:    _Msg_TransferWrkChainOwnership_Handler,
+		},
+		{
+			MethodName: "AddWrkChainRecorder",
+			Handler:    _Msg_AddWrkChainRecorder_Handler,
+		},
+		{
+			MethodName: "RemoveWrkChainRecorder",
+			Handler:    _Msg_RemoveWrkChainRecorder_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -151,7 +151,7 @@ var (
 		// ToDo: Next version will only require authtypes.Minter
 		enttypes.ModuleName:         {authtypes.Minter, authtypes.Staking},
 		ibctransfertypes.ModuleName: {authtypes.Minter, authtypes.Burner},
-		streamtypes.ModuleName:      nil,
+		streamtypes.ModuleName:      {authtypes.Burner},
 	}
 )
 
@@ -208,6 +208,9 @@ type App struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// module invariants
+	invariants *InvariantRegistry
 }
 
 func init() {
@@ -441,13 +444,13 @@ func NewApp(
 	// If evidence needs to be handled for the app, set routes in router here and seal
 	app.EvidenceKeeper = *evidenceKeeper
 
-	app.EnterpriseKeeper = entkeeper.NewKeeper(keys[enttypes.StoreKey], app.BankKeeper, app.AccountKeeper, appCodec, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	app.EnterpriseKeeper = entkeeper.NewKeeper(keys[enttypes.StoreKey], app.BankKeeper, app.AccountKeeper, app.GroupKeeper, appCodec, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	app.BeaconKeeper = beaconkeeper.NewKeeper(keys[beacontypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	app.WrkchainKeeper = wrkchainkeeper.NewKeeper(keys[wrkchaintypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
-	app.StreamKeeper = streamkeeper.NewKeeper(keys[streamtypes.StoreKey], app.BankKeeper, app.AccountKeeper, appCodec, authtypes.FeeCollectorName, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	app.StreamKeeper = streamkeeper.NewKeeper(keys[streamtypes.StoreKey], app.BankKeeper, app.AccountKeeper, app.DistrKeeper, appCodec, authtypes.FeeCollectorName, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	/****  Module Options ****/
 
@@ -532,6 +535,8 @@ func NewApp(
 		genutiltypes.ModuleName,
 		feegrant.ModuleName,
 		group.ModuleName,
+		enttypes.ModuleName,
+		streamtypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -577,6 +582,8 @@ func NewApp(
 		panic(err)
 	}
 
+	app.registerInvariants()
+
 	// RegisterUpgradeHandlers is used for registering any on-chain upgrades.
 	// Make sure it's called after `app.ModuleManager` and `app.configurator` are set.
 	app.registerUpgradeHandlers()
@@ -627,6 +634,7 @@ func NewApp(
 			BeaconKeeper:     app.BeaconKeeper,
 			EnterpriseKeeper: app.EnterpriseKeeper,
 			WrkchainKeeper:   app.WrkchainKeeper,
+			StreamKeeper:     app.StreamKeeper,
 			BK:               app.BankKeeper,
 			CircuitKeeper:    &app.CircuitKeeper,
 		},
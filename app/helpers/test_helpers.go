@@ -245,17 +245,22 @@ func genesisStateWithValSet(t *testing.T,
 
 	// Enterprise
 	enterpriseGenesis := enttypes.NewGenesisState(
-		enttypes.NewParams(sdk.DefaultBondDenom, 1, 1000, genAccs[0].GetAddress().String()),
+		enttypes.NewParams(sdk.DefaultBondDenom, 1, 1000, "", enttypes.DefaultEFUNDFeeMsgTypes),
 		1, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewIntFromUint64(0)),
 		enttypes.EnterpriseUndPurchaseOrders{}, enttypes.LockedUnds{}, enttypes.Whitelists{},
 		sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewIntFromUint64(0)), enttypes.SpentEFUNDs{},
+		[]enttypes.EntSigner{{Address: genAccs[0].GetAddress().String()}}, enttypes.LockedEFUNDLots{}, 1,
 	)
 	genesisState[enttypes.ModuleName] = app.AppCodec().MustMarshalJSON(enterpriseGenesis)
 
 	// Streams
 	streamGenesis := streamtypes.NewGenesisState(
 		[]streamtypes.StreamExport{},
-		streamtypes.NewParams(SimTestDefaultStreamValFee),
+		streamtypes.NewParams(SimTestDefaultStreamValFee, streamtypes.DefaultAllowedDenoms, streamtypes.DefaultMinDuration, streamtypes.DefaultMinDeposit, streamtypes.DefaultMaxFlowRate, streamtypes.DefaultFeeCollectorRatio, streamtypes.DefaultCommunityPoolRatio, streamtypes.DefaultBurnRatio, streamtypes.DefaultMinTerminationNotice, streamtypes.DefaultMaxClaimFeeRatio),
+		streamtypes.DefaultStartingStreamID,
+		[]streamtypes.StreamStats{},
+		[]streamtypes.StreamHistory{},
+		[]streamtypes.StreamAuditPrune{},
 	)
 	genesisState[streamtypes.ModuleName] = app.AppCodec().MustMarshalJSON(streamGenesis)
 
@@ -0,0 +1,74 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// InvariantRoute is an invariant registered by a module
+type InvariantRoute struct {
+	ModuleName string
+	Route      string
+	Invar      sdk.Invariant
+}
+
+// FullRoute returns the invariant's module and route name
+func (r InvariantRoute) FullRoute() string {
+	return r.ModuleName + "/" + r.Route
+}
+
+// InvariantRegistry holds the invariants registered by the app's modules. The app does not include the crisis
+// module, so invariants are not asserted on chain, but are run by the simulations and tests.
+type InvariantRegistry struct {
+	routes []InvariantRoute
+}
+
+var _ sdk.InvariantRegistry = (*InvariantRegistry)(nil)
+
+// RegisterRoute adds an invariant to the registry
+func (ir *InvariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	ir.routes = append(ir.routes, InvariantRoute{
+		ModuleName: moduleName,
+		Route:      route,
+		Invar:      invar,
+	})
+}
+
+// Routes returns all the registered invariants
+func (ir *InvariantRegistry) Routes() []InvariantRoute {
+	return ir.routes
+}
+
+// registerInvariants registers the invariants of every module which has them
+func (app *App) registerInvariants() {
+	app.invariants = &InvariantRegistry{}
+	for _, m := range app.ModuleManager.Modules {
+		if im, ok := m.(module.HasInvariants); ok {
+			im.RegisterInvariants(app.invariants)
+		}
+	}
+}
+
+// Invariants returns the app's invariant registry
+func (app *App) Invariants() *InvariantRegistry {
+	return app.invariants
+}
+
+// AssertInvariants runs every registered invariant, and returns an error describing any that are broken
+func (app *App) AssertInvariants(ctx sdk.Context) error {
+	var broken []string
+	for _, route := range app.invariants.Routes() {
+		if res, stop := route.Invar(ctx); stop {
+			broken = append(broken, res)
+		}
+	}
+
+	if len(broken) > 0 {
+		return fmt.Errorf("%d invariants broken:\n%s", len(broken), strings.Join(broken, "\n"))
+	}
+
+	return nil
+}
@@ -12,6 +12,8 @@ import (
 	"github.com/spf13/cobra"
 
 	appparams "github.com/unification-com/mainchain/app/params"
+	enterprisecli "github.com/unification-com/mainchain/x/enterprise/client/cli"
+	streamcli "github.com/unification-com/mainchain/x/stream/client/cli"
 )
 
 const (
@@ -138,5 +140,7 @@ Example:
 // addDebugCommands injects custom debug commands into another command as children.
 func addDebugCommands(cmd *cobra.Command) *cobra.Command {
 	cmd.AddCommand(AddBech32ConvertCommand())
+	cmd.AddCommand(streamcli.GetCmdStreamAudit())
+	cmd.AddCommand(enterprisecli.GetCmdEnterpriseAudit())
 	return cmd
 }
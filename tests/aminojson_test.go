@@ -399,18 +399,34 @@ func TestAminoJSON_LegacyParity(t *testing.T) {
 		},
 		"stream/v1/Stream": {
 			gogo: &streamtypes.Stream{
-				Deposit:         testSdkCoin,
-				FlowRate:        1234,
-				LastOutflowTime: now,
-				DepositZeroTime: now,
-				Cancellable:     true,
+				Deposit:             testSdkCoin,
+				FlowRate:            1234,
+				LastOutflowTime:     now,
+				DepositZeroTime:     now,
+				Cancellable:         true,
+				StartTime:           now,
+				CliffTime:           now,
+				EndTime:             now,
+				Pausable:            true,
+				PausedAt:            now,
+				LowDepositThreshold: 300,
+				LowDepositAlerted:   true,
+				TerminationTime:     now,
 			},
 			pulsar: &streamapi.Stream{
-				Deposit:         &testV1beta1Coin,
-				FlowRate:        1234,
-				LastOutflowTime: timestamppb.New(now),
-				DepositZeroTime: timestamppb.New(now),
-				Cancellable:     true,
+				Deposit:             &testV1beta1Coin,
+				FlowRate:            1234,
+				LastOutflowTime:     timestamppb.New(now),
+				DepositZeroTime:     timestamppb.New(now),
+				Cancellable:         true,
+				StartTime:           timestamppb.New(now),
+				CliffTime:           timestamppb.New(now),
+				EndTime:             timestamppb.New(now),
+				Pausable:            true,
+				PausedAt:            timestamppb.New(now),
+				LowDepositThreshold: 300,
+				LowDepositAlerted:   true,
+				TerminationTime:     timestamppb.New(now),
 			},
 		},
 		"stream/v1/Params": {
@@ -194,10 +194,14 @@ func checkFeePayerHasFunds(ctx sdk.Context, bankKeeper BankKeeper, accKeeper Acc
 
 	potentialCoins := coins
 
-	//get any locked enterprise FUND
-	lockedUnd := ek.GetLockedUndAmountForAccount(ctx, feePayer)
+	// get any locked enterprise FUND. Locked FUND can only be used if every Msg in the Tx is in the
+	// enterprise module's EfundFeeMsgTypes param. Mixed Txs must pay fees from unlocked FUND
+	lockedUndCoins := sdk.NewCoins()
+	if ek.IsEFUNDFeeTx(ctx, tx.GetMsgs()) {
+		lockedUnd := ek.GetLockedUndAmountForAccount(ctx, feePayer)
+		lockedUndCoins = sdk.NewCoins(lockedUnd)
+	}
 
-	lockedUndCoins := sdk.NewCoins(lockedUnd)
 	// include any locked FUND in potential coins. We need to do this because if these checks pass,
 	// the locked FUND will be unlocked in the next decorator
 	potentialCoins = potentialCoins.Add(lockedUndCoins...)
@@ -25,6 +25,7 @@ type BankKeeper interface {
 
 type EnterpriseKeeper interface {
 	GetLockedUndAmountForAccount(ctx sdk.Context, address sdk.AccAddress) sdk.Coin
+	IsEFUNDFeeTx(ctx sdk.Context, msgs []sdk.Msg) bool
 }
 
 type BeaconKeeper interface {
@@ -30,3 +30,15 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 
 	return nil
 }
+
+// EndBlocker removes the remaining amount of expired locked eFUND lots. Failures are isolated to the lot
+// concerned, and never returned, so a single lot cannot halt the chain
+func EndBlocker(ctx context.Context, k keeper.Keeper) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.ExpireLockedEFUNDLots(sdkCtx)
+
+	return nil
+}
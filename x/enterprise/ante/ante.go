@@ -5,8 +5,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
-	beacon "github.com/unification-com/mainchain/x/beacon/exported"
-	wrkchain "github.com/unification-com/mainchain/x/wrkchain/exported"
+	beacontypes "github.com/unification-com/mainchain/x/beacon/types"
+	"github.com/unification-com/mainchain/x/enterprise/types"
+	wrkchaintypes "github.com/unification-com/mainchain/x/wrkchain/types"
 )
 
 type CheckLockedUndDecorator struct {
@@ -28,14 +29,37 @@ func (ld CheckLockedUndDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 
 	feePayer := feeTx.FeePayer()
 
-	if (wrkchain.CheckIsWrkChainTx(feeTx) || beacon.CheckIsBeaconTx(feeTx)) && ld.entk.IsLocked(ctx, feePayer) {
-		// WRKChain/BEACON Tx and has locked Enterprise FUND.
+	if ld.entk.IsEFUNDFeeTx(ctx, feeTx.GetMsgs()) && ld.entk.IsLocked(ctx, feePayer) {
+		// Every Msg is allowed to have its fees paid with eFUND, and has locked Enterprise FUND.
 		// check for and mint any Locked FUND to pay for fees
 		// We unlock and mint (instead of msg_server) because
 		// fees are paid during the Ante process, further in the chain
-		// WRKChain/BEACON Txs have been checked before this decorator is called
+		// WRKChain/BEACON Txs have been checked before this decorator is called.
+		// Txs which mix allowed and other Msgs do not unlock any eFUND, and must pay fees from general supply FUND
+		// Fees paid with locked eFUND can only be in the eFUND denomination.
 
-		err := ld.entk.UnlockAndMintCoinsForFees(ctx, feePayer, feeTx.GetFee())
+		denom := ld.entk.GetParamDenom(ctx)
+		for _, fee := range feeTx.GetFee() {
+			if fee.Denom != denom {
+				return ctx, errorsmod.Wrapf(types.ErrInvalidDenomination, "fees paid with locked eFUND must only be in %s", denom)
+			}
+		}
+
+		// WRKChain/BEACON fees are set by their module params. The locked eFUND unlocked for any other Msgs is
+		// capped by the EfundMaxFee param, and any fee above it must be paid from the fee payer's spendable FUND
+		feesToUnlock := feeTx.GetFee()
+		if !isWrkChainOrBeaconOnlyTx(feeTx.GetMsgs()) {
+			maxFee := ld.entk.GetParamEFUNDMaxFee(ctx)
+			if feesToUnlock.AmountOf(denom).GT(maxFee.Amount) {
+				feesToUnlock = sdk.NewCoins(maxFee)
+			}
+		}
+
+		if feesToUnlock.IsZero() {
+			return next(ctx, tx, simulate)
+		}
+
+		err := ld.entk.UnlockAndMintCoinsForFees(ctx, feePayer, feesToUnlock, feeTx.GetMsgs())
 
 		if err != nil {
 			return ctx, errorsmod.Wrap(err, "failed to unlock enterprise und")
@@ -45,3 +69,21 @@ func (ld CheckLockedUndDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 
 	return next(ctx, tx, simulate)
 }
+
+// isWrkChainOrBeaconOnlyTx returns true if every Msg is a WRKChain or BEACON Msg
+func isWrkChainOrBeaconOnlyTx(msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		switch msg.(type) {
+		case *wrkchaintypes.MsgRegisterWrkChain,
+			*wrkchaintypes.MsgRecordWrkChainBlock,
+			*wrkchaintypes.MsgPurchaseWrkChainStateStorage,
+			*beacontypes.MsgRegisterBeacon,
+			*beacontypes.MsgRecordBeaconTimestamp,
+			*beacontypes.MsgPurchaseBeaconStateStorage:
+			continue
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -11,6 +11,7 @@ import (
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
@@ -19,6 +20,7 @@ import (
 	beacontypes "github.com/unification-com/mainchain/x/beacon/types"
 	"github.com/unification-com/mainchain/x/enterprise/ante"
 	"github.com/unification-com/mainchain/x/enterprise/types"
+	streamtypes "github.com/unification-com/mainchain/x/stream/types"
 	wrkchaintypes "github.com/unification-com/mainchain/x/wrkchain/types"
 )
 
@@ -181,11 +183,82 @@ func (s *AnteTestSuite) TestAnteHandler() {
 			expTotalLocked: sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
 			expSpent:       sdk.NewInt64Coin(sdk.DefaultBondDenom, 350),
 		},
+		{
+			name: "full fee correctly minted for MsgCreateStream no locked left",
+			msgs: []sdk.Msg{
+				&streamtypes.MsgCreateStream{
+					Receiver: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+					Sender:   s.addr.String(),
+					Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+					FlowRate: 1,
+				},
+			},
+			feeToSend:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			toLock:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			expectErr:      false,
+			expErrMsg:      "",
+			expTotalSupply: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000001000550),
+			expAccLocked:   sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+			expTotalLocked: sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+			expSpent:       sdk.NewInt64Coin(sdk.DefaultBondDenom, 450),
+		},
+		{
+			name: "mixed Tx with msg not in allowlist, nothing minted",
+			msgs: []sdk.Msg{
+				&beacontypes.MsgRegisterBeacon{
+					Moniker: "test1",
+					Name:    "test1",
+					Owner:   s.addr.String(),
+				},
+				banktypes.NewMsgSend(s.addr, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))),
+			},
+			feeToSend:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			toLock:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			expectErr:      false,
+			expErrMsg:      "",
+			expTotalSupply: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000001000550), // no change to total supply
+			expAccLocked:   sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			expTotalLocked: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			expSpent:       sdk.NewInt64Coin(sdk.DefaultBondDenom, 450),
+		},
+		{
+			name: "msg not in allowlist, nothing minted",
+			msgs: []sdk.Msg{
+				banktypes.NewMsgSend(s.addr, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))),
+			},
+			feeToSend:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			toLock:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), // still has 100 from previous test
+			expectErr:      false,
+			expErrMsg:      "",
+			expTotalSupply: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000001000550), // no change to total supply
+			expAccLocked:   sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			expTotalLocked: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			expSpent:       sdk.NewInt64Coin(sdk.DefaultBondDenom, 450),
+		},
+		{
+			name: "multi denom fee for MsgCreateStream rejected, nothing minted",
+			msgs: []sdk.Msg{
+				&streamtypes.MsgCreateStream{
+					Receiver: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+					Sender:   s.addr.String(),
+					Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+					FlowRate: 1,
+				},
+			},
+			feeToSend:      sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), sdk.NewInt64Coin("ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", 1000)),
+			toLock:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), // still has 100 from previous test
+			expectErr:      true,
+			expErrMsg:      "fees paid with locked eFUND must only be in nund",
+			expTotalSupply: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000001000550), // no change to total supply
+			expAccLocked:   sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			expTotalLocked: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+			expSpent:       sdk.NewInt64Coin(sdk.DefaultBondDenom, 450),
+		},
 	}
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			_ = s.app.EnterpriseKeeper.CreateAndLockEFUND(s.ctx, s.addr, tc.toLock)
+			_ = s.app.EnterpriseKeeper.CreateAndLockEFUND(s.ctx, s.addr, tc.toLock, 0, 0)
 
 			tx, _ := simtestutil.GenSignedMockTx(r, s.txGen, tc.msgs, tc.feeToSend, uint64(0), simapphelpers.SimAppChainID, []uint64{0}, []uint64{0}, s.privKey)
 
@@ -212,6 +285,13 @@ func (s *AnteTestSuite) TestAnteHandler() {
 
 			totalSpentEfund := s.app.EnterpriseKeeper.GetTotalSpentEFUND(s.ctx)
 			s.Require().Equal(tc.expSpent, totalSpentEfund)
+
+			// only the eFUND denomination is ever minted
+			for _, fee := range tc.feeToSend {
+				if fee.Denom != sdk.DefaultBondDenom {
+					s.Require().True(s.app.BankKeeper.GetSupply(s.ctx, fee.Denom).IsZero())
+				}
+			}
 		})
 	}
 
@@ -352,7 +432,7 @@ func TestCheckLockedUndDecoratorSuccessfulUnlock(t *testing.T) {
 	err := fundAccount(ctx, app.BankKeeper, addr, initCoins)
 	require.NoError(t, err)
 
-	_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, sdk.NewInt64Coin(actualFeeDenom, int64(actualRegFeeAmt)))
+	_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, sdk.NewInt64Coin(actualFeeDenom, int64(actualRegFeeAmt)), 0, 0)
 
 	feeInt := int64(1)
 	msg := wrkchaintypes.NewMsgRegisterWrkChain("test", "hash", "Test", "geth", addr)
@@ -451,3 +531,63 @@ func TestNoMintingIfInsufficientBalanceAndLocked(t *testing.T) {
 	lockedAfter := app.EnterpriseKeeper.GetLockedUndForAccount(ctx, addr)
 	require.Equal(t, lockeUnd.Amount, lockedAfter.Amount)
 }
+
+func TestEFUNDMaxFeeCapsUnlockedFees(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(true)
+	txGen := app.GetTxConfig()
+
+	feeDecorator := ante.NewCheckLockedUndDecorator(app.EnterpriseKeeper)
+	antehandler := sdk.ChainAnteDecorators(feeDecorator)
+
+	params := app.EnterpriseKeeper.GetParams(ctx)
+	params.EfundMaxFee = 100
+	require.NoError(t, app.EnterpriseKeeper.SetParams(ctx, params))
+
+	privK := ed25519.GenPrivKey()
+	addr := sdk.AccAddress(privK.PubKey().Address())
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), 0, 0)
+
+	streamMsg := &streamtypes.MsgCreateStream{
+		Receiver: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+		Sender:   addr.String(),
+		Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 10),
+		FlowRate: 1,
+	}
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 300))
+
+	// only the max fee is unlocked for a stream Msg, the remainder must be paid from spendable FUND
+	tx, _ := simtestutil.GenSignedMockTx(r, txGen, []sdk.Msg{streamMsg}, fee, uint64(0), TestChainID, []uint64{0}, []uint64{0}, privK)
+	_, err := antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 900), app.EnterpriseKeeper.GetLockedUndForAccount(ctx, addr).Amount)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), app.BankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom))
+
+	// WRKChain fees are set by the WRKChain module params, and are not capped
+	wrkMsg := wrkchaintypes.NewMsgRegisterWrkChain("test", "hash", "Test", "geth", addr)
+	tx, _ = simtestutil.GenSignedMockTx(r, txGen, []sdk.Msg{wrkMsg}, fee, uint64(0), TestChainID, []uint64{0}, []uint64{0}, privK)
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 600), app.EnterpriseKeeper.GetLockedUndForAccount(ctx, addr).Amount)
+
+	// Txs mixing WRKChain and stream Msgs are capped
+	tx, _ = simtestutil.GenSignedMockTx(r, txGen, []sdk.Msg{wrkMsg, streamMsg}, fee, uint64(0), TestChainID, []uint64{0}, []uint64{0}, privK)
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 500), app.EnterpriseKeeper.GetLockedUndForAccount(ctx, addr).Amount)
+
+	// a max fee of 0 unlocks nothing for a stream Msg
+	params.EfundMaxFee = 0
+	require.NoError(t, app.EnterpriseKeeper.SetParams(ctx, params))
+
+	supplyBefore := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+	tx, _ = simtestutil.GenSignedMockTx(r, txGen, []sdk.Msg{streamMsg}, fee, uint64(0), TestChainID, []uint64{0}, []uint64{0}, privK)
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 500), app.EnterpriseKeeper.GetLockedUndForAccount(ctx, addr).Amount)
+	require.Equal(t, supplyBefore, app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom))
+}
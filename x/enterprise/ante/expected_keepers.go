@@ -5,7 +5,10 @@ import (
 )
 
 type EnterpriseKeeper interface {
+	GetParamDenom(ctx sdk.Context) string
 	GetLockedUndAmountForAccount(ctx sdk.Context, address sdk.AccAddress) sdk.Coin
 	IsLocked(ctx sdk.Context, address sdk.AccAddress) bool
-	UnlockAndMintCoinsForFees(ctx sdk.Context, feePayer sdk.AccAddress, feesToPay sdk.Coins) error
+	IsEFUNDFeeTx(ctx sdk.Context, msgs []sdk.Msg) bool
+	GetParamEFUNDMaxFee(ctx sdk.Context) sdk.Coin
+	UnlockAndMintCoinsForFees(ctx sdk.Context, feePayer sdk.AccAddress, feesToPay sdk.Coins, msgs []sdk.Msg) error
 }
@@ -12,8 +12,8 @@ import (
 
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
-		// This is in place of func (AppModuleBasic) GetQueryCmd() *cobra.Command in module.go
-		// and replaces client/cli/query.go
+		// These enhance func (AppModuleBasic) GetQueryCmd() *cobra.Command in module.go, which
+		// only holds the ledger CSV export
 		Query: &autocliv1.ServiceCommandDescriptor{
 			Service: enterprisev1.Query_ServiceDesc.ServiceName,
 			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
@@ -43,6 +43,26 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "owner"},
 					},
 				},
+				{
+					RpcMethod: "LockedEFUNDLotsByAddress",
+					Use:       "locked-lots [owner]",
+					Short:     "Query a given address's locked eFUND lots",
+					Long:      "Query the lots making up a given address's locked eFUND, oldest first, including when each lot expires",
+					Example:   fmt.Sprintf("$ %s query enterprise locked-lots und1chknpc8nf2tmj5582vhlvphnjyekc9ypspx5ay", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
+				{
+					RpcMethod: "EnterpriseLedger",
+					Use:       "ledger [owner]",
+					Short:     "Query a given address's eFUND ledger",
+					Long:      "Query a given address's eFUND ledger, oldest first, with optional unix time range filters and pagination. Use export-ledger to export it as CSV",
+					Example:   fmt.Sprintf("$ %s query enterprise ledger und1chknpc8nf2tmj5582vhlvphnjyekc9ypspx5ay --from-time 1735689600", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
 				{
 					RpcMethod: "TotalLocked",
 					Use:       "total-locked",
@@ -66,6 +86,23 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "address"},
 					},
 				},
+				{
+					RpcMethod: "LinkedAddresses",
+					Use:       "linked [owner]",
+					Short:     "Query the addresses a given address can transfer locked eFUND to",
+					Long:      "Query the addresses ent signers have linked to a given address, which it can transfer locked eFUND to",
+					Example:   fmt.Sprintf("$ %s query enterprise linked und1chknpc8nf2tmj5582vhlvphnjyekc9ypspx5ay", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "owner"},
+					},
+				},
+				{
+					RpcMethod: "EntSigners",
+					Use:       "ent-signers",
+					Short:     "Query registered ent signers",
+					Long:      "Query all addresses currently authorised to make decisions on raised purchase orders, and to modify the whitelist",
+					Example:   fmt.Sprintf("$ %s query enterprise ent-signers", version.AppName),
+				},
 				{
 					RpcMethod: "EnterpriseAccount",
 					Use:       "account [address]",
@@ -100,6 +137,7 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Long:      "Query values set as enterprise parameters.",
 				},
 			},
+			EnhanceCustomCommand: true,
 		},
 		// Note - we're still using func (AppModuleBasic) GetTxCmd() *cobra.Command in module.go for Tx commands
 		// this is here just for an example for future use
@@ -116,6 +154,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						{ProtoField: "amount"},
 					},
 				},
+				{
+					RpcMethod: "AddEntSigner",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveEntSigner",
+					Skip:      true, // skipped because authority gated
+				},
 				{
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/version"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+// GetCmdEnterpriseAudit returns the command to run the enterprise module's invariant checks against an exported
+// genesis file
+func GetCmdEnterpriseAudit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "enterprise-audit [genesis-file]",
+		Short: "Audit the enterprise module state in an exported genesis file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Run the enterprise module's invariant checks against an exported genesis file. Checks that
+the total locked eFUND equals the sum of every account's locked eFUND, that the total spent eFUND equals the sum
+of every account's spent eFUND, and that completed purchase orders cover the total locked and spent eFUND. The
+Raised and Accepted purchase order queues are not exported, so are not checked.
+
+Example:
+$ %s debug enterprise-audit /path/to/exported_genesis.json
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var appState map[string]json.RawMessage
+			if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+				return fmt.Errorf("failed to unmarshal app state: %w", err)
+			}
+
+			if appState[types.ModuleName] == nil {
+				return fmt.Errorf("%s module state not found in genesis file", types.ModuleName)
+			}
+
+			var entGenesis types.GenesisState
+			if err := clientCtx.Codec.UnmarshalJSON(appState[types.ModuleName], &entGenesis); err != nil {
+				return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+			}
+
+			problems := types.AuditGenesis(entGenesis)
+			if err := types.ValidateGenesis(entGenesis); err != nil {
+				problems = append(problems, fmt.Errorf("invalid genesis state: %w", err))
+			}
+
+			cmd.Printf("audited %d purchase orders and %d accounts. total locked: %s, total spent: %s\n",
+				len(entGenesis.PurchaseOrders), len(entGenesis.LockedUnd), entGenesis.TotalLocked.String(), entGenesis.TotalSpent.String())
+
+			if len(problems) == 0 {
+				cmd.Println("no problems found")
+				return nil
+			}
+
+			for _, problem := range problems {
+				cmd.Printf("\t%s\n", problem.Error())
+			}
+
+			return fmt.Errorf("found %d problems", len(problems))
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,100 @@
+package cli_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	testutilmod "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unification-com/mainchain/x/enterprise"
+	"github.com/unification-com/mainchain/x/enterprise/client/cli"
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+func TestEnterpriseAuditCmd(t *testing.T) {
+	encCfg := testutilmod.MakeTestEncodingConfig(enterprise.AppModuleBasic{})
+	clientCtx := client.Context{}.WithCodec(encCfg.Codec).WithOutput(io.Discard)
+
+	purchaser := sdk.AccAddress("purchaser___________").String()
+
+	writeGenesis := func(totalLocked, totalSpent int64) string {
+		entGenesis := types.DefaultGenesisState()
+		// signers are held in the registry, rather than legacy params
+		entGenesis.Params.EntSigners = ""
+		entGenesis.EntSigners = []types.EntSigner{{Address: sdk.AccAddress("signer______________").String()}}
+		entGenesis.StartingPurchaseOrderId = 2
+		entGenesis.PurchaseOrders = types.EnterpriseUndPurchaseOrders{
+			{
+				Id:        1,
+				Purchaser: purchaser,
+				Amount:    sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+				Status:    types.StatusCompleted,
+			},
+		}
+		entGenesis.LockedUnd = types.LockedUnds{{Owner: purchaser, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 600)}}
+		entGenesis.SpentEfund = types.SpentEFUNDs{{Owner: purchaser, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 400)}}
+		entGenesis.TotalLocked = sdk.NewInt64Coin(sdk.DefaultBondDenom, totalLocked)
+		entGenesis.TotalSpent = sdk.NewInt64Coin(sdk.DefaultBondDenom, totalSpent)
+
+		appState, err := json.Marshal(map[string]json.RawMessage{
+			types.ModuleName: encCfg.Codec.MustMarshalJSON(entGenesis),
+		})
+		require.NoError(t, err)
+
+		genFile := filepath.Join(t.TempDir(), "genesis.json")
+		require.NoError(t, genutiltypes.NewAppGenesisWithVersion("test-chain", appState).SaveAs(genFile))
+
+		return genFile
+	}
+
+	testCases := []struct {
+		name      string
+		genFile   string
+		expErrMsg string
+	}{
+		{
+			"valid",
+			writeGenesis(600, 400),
+			"",
+		},
+		{
+			"total locked does not match",
+			writeGenesis(700, 400),
+			"found 2 problems",
+		},
+		{
+			"total spent does not match",
+			writeGenesis(600, 300),
+			"found 1 problems",
+		},
+		{
+			"genesis file does not exist",
+			filepath.Join(t.TempDir(), "missing.json"),
+			"no such file or directory",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := cli.GetCmdEnterpriseAudit()
+			cmd.SetOut(io.Discard)
+			cmd.SetArgs([]string{tc.genFile})
+
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+			err := cmd.ExecuteContext(ctx)
+
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
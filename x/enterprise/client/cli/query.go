@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+const (
+	FlagFromTime = "from-time"
+	FlagToTime   = "to-time"
+	FlagOutput   = "output-file"
+
+	ledgerExportPageLimit = 100
+)
+
+// LedgerCSVHeader is the header row of an exported eFUND ledger
+var LedgerCSVHeader = []string{
+	"id", "timestamp", "time", "height", "type", "amount", "purchase_order_id", "tx_hash", "msg_types",
+	"locked_balance", "spent_balance", "counterparty",
+}
+
+// GetQueryCmd returns the root query command for the enterprise module. All other query
+// commands are generated by autocli
+func GetQueryCmd() *cobra.Command {
+	enterpriseQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the enterprise module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	enterpriseQueryCmd.AddCommand(
+		GetCmdExportLedger(),
+	)
+
+	return enterpriseQueryCmd
+}
+
+// GetCmdExportLedger is the CLI command for exporting an address's eFUND ledger as CSV
+func GetCmdExportLedger() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-ledger [owner]",
+		Short: "Export a given address's eFUND ledger as CSV",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export a given address's eFUND ledger as CSV, oldest first. Each row is an accepted purchase
+order, a Tx fee paid with locked eFUND, expired locked eFUND, or a locked eFUND transfer, with the address's running locked and spent
+eFUND balances. Optionally filtered by a unix time range, and written to stdout unless an output file is given.
+Example:
+$ %s query %s export-ledger und1chknpc8nf2tmj5582vhlvphnjyekc9ypspx5ay
+$ %s query %s export-ledger und1chknpc8nf2tmj5582vhlvphnjyekc9ypspx5ay --from-time 1735689600 --to-time 1767225600 --output-file ledger.csv
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromTime, _ := cmd.Flags().GetUint64(FlagFromTime)
+			toTime, _ := cmd.Flags().GetUint64(FlagToTime)
+			outputFile, _ := cmd.Flags().GetString(FlagOutput)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var entries []types.EFUNDLedgerEntry
+			pageReq := &query.PageRequest{Limit: ledgerExportPageLimit}
+			for {
+				res, err := queryClient.EnterpriseLedger(cmd.Context(), &types.QueryEnterpriseLedgerRequest{
+					Owner:      args[0],
+					FromTime:   fromTime,
+					ToTime:     toTime,
+					Pagination: pageReq,
+				})
+				if err != nil {
+					return err
+				}
+
+				entries = append(entries, res.Entries...)
+
+				if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+					break
+				}
+				pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: ledgerExportPageLimit}
+			}
+
+			out := cmd.OutOrStdout()
+			if outputFile != "" {
+				f, err := os.Create(outputFile)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				out = f
+			}
+
+			return WriteLedgerCSV(out, entries)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagFromTime, 0, "optional unix time from which to export entries, inclusive")
+	cmd.Flags().Uint64(FlagToTime, 0, "optional unix time up to which to export entries, exclusive")
+	cmd.Flags().String(FlagOutput, "", "optional file to write the CSV to. Defaults to stdout")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// WriteLedgerCSV writes eFUND ledger entries as CSV, with a header row
+func WriteLedgerCSV(out io.Writer, entries []types.EFUNDLedgerEntry) error {
+	w := csv.NewWriter(out)
+
+	if err := w.Write(LedgerCSVHeader); err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		row := []string{
+			strconv.FormatUint(entry.Id, 10),
+			strconv.FormatUint(entry.Timestamp, 10),
+			time.Unix(int64(entry.Timestamp), 0).UTC().Format(time.RFC3339),
+			strconv.FormatInt(entry.Height, 10),
+			entry.EntryType.String(),
+			entry.Amount.String(),
+			strconv.FormatUint(entry.PurchaseOrderId, 10),
+			entry.TxHash,
+			strings.Join(entry.MsgTypes, ";"),
+			entry.LockedBalance.String(),
+			entry.SpentBalance.String(),
+			entry.Counterparty,
+		}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
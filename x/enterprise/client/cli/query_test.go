@@ -0,0 +1,59 @@
+package cli_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unification-com/mainchain/x/enterprise/client/cli"
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+func TestWriteLedgerCSV(t *testing.T) {
+	owner := sdk.AccAddress("addr1").String()
+	entries := []types.EFUNDLedgerEntry{
+		{
+			Id:              1,
+			Owner:           owner,
+			EntryType:       types.LedgerEntryPurchaseOrder,
+			Amount:          sdk.NewInt64Coin("nund", 1000),
+			PurchaseOrderId: 3,
+			Height:          10,
+			Timestamp:       1735689600,
+			LockedBalance:   sdk.NewInt64Coin("nund", 1000),
+			SpentBalance:    sdk.NewInt64Coin("nund", 0),
+		},
+		{
+			Id:            2,
+			Owner:         owner,
+			EntryType:     types.LedgerEntryFee,
+			Amount:        sdk.NewInt64Coin("nund", 300),
+			TxHash:        "ABCDEF",
+			MsgTypes:      []string{"/mainchain.beacon.v1.MsgRecordBeaconTimestamp", "/mainchain.wrkchain.v1.MsgRecordWrkChainBlock"},
+			Height:        11,
+			Timestamp:     1735689605,
+			LockedBalance: sdk.NewInt64Coin("nund", 700),
+			SpentBalance:  sdk.NewInt64Coin("nund", 300),
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, cli.WriteLedgerCSV(&buf, entries))
+
+	rows, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	require.Equal(t, cli.LedgerCSVHeader, rows[0])
+	require.Equal(t, []string{
+		"1", "1735689600", "2025-01-01T00:00:00Z", "10", "LEDGER_ENTRY_TYPE_PURCHASE_ORDER", "1000nund", "3", "", "",
+		"1000nund", "0nund", "",
+	}, rows[1])
+	require.Equal(t, []string{
+		"2", "1735689605", "2025-01-01T00:00:05Z", "11", "LEDGER_ENTRY_TYPE_FEE", "300nund", "0", "ABCDEF",
+		"/mainchain.beacon.v1.MsgRecordBeaconTimestamp;/mainchain.wrkchain.v1.MsgRecordWrkChainBlock",
+		"700nund", "300nund", "",
+	}, rows[2])
+}
@@ -20,6 +20,9 @@ import (
 const (
 	FlagPurchaseOrderStatus = "status"
 	FlagPurchaser           = "purchaser"
+	FlagExpiryTime          = "expiry-time"
+	FlagMaxPurchasable      = "max-purchasable"
+	FlagTier                = "tier"
 )
 
 func GetTxCmd() *cobra.Command {
@@ -35,6 +38,10 @@ func GetTxCmd() *cobra.Command {
 		GetCmdRaisePurchaseOrder(),
 		GetCmdProcessPurchaseOrder(),
 		GetCmdWhitelistAction(),
+		GetCmdWithdrawPurchaseOrder(),
+		GetCmdAmendPurchaseOrder(),
+		GetCmdTransferLockedEFUND(),
+		GetCmdLinkAddressAction(),
 	)
 
 	return enterpriseTxCmd
@@ -138,12 +145,14 @@ func GetCmdWhitelistAction() *cobra.Command {
 		Use:   "whitelist [action] [address]",
 		Short: "Add/Remove an address from the enterprise purchase order whitelist",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Add/Remove an address from the enterprise purchase order whitelist
+			fmt.Sprintf(`Add/Remove an address from the enterprise purchase order whitelist. When adding, an optional
+unix expiry time, maximum cumulative eFUND purchasable and tier label can be set
 Example:
 $ %s tx %s whitelist add und1x8pl6wzqf9atkm77ymc5vn5dnpl5xytmn200xy --from ent
+$ %s tx %s whitelist add und1x8pl6wzqf9atkm77ymc5vn5dnpl5xytmn200xy --expiry-time 1767225600 --max-purchasable 1000000000000nund --tier gold --from ent
 $ %s tx %s whitelist remove und1x8pl6wzqf9atkm77ymc5vn5dnpl5xytmn200xy --from ent
 `,
-				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName, version.AppName, types.ModuleName,
 			),
 		),
 		Args: cobra.ExactArgs(2),
@@ -169,6 +178,23 @@ $ %s tx %s whitelist remove und1x8pl6wzqf9atkm77ymc5vn5dnpl5xytmn200xy --from en
 			}
 
 			msg := types.NewMsgWhitelistAddress(address, action, from)
+
+			if action == types.WhitelistActionAdd {
+				expiryTime, _ := cmd.Flags().GetUint64(FlagExpiryTime)
+				tier, _ := cmd.Flags().GetString(FlagTier)
+				maxPurchasableStr, _ := cmd.Flags().GetString(FlagMaxPurchasable)
+
+				maxPurchasable := sdk.Coin{}
+				if maxPurchasableStr != "" {
+					maxPurchasable, err = sdk.ParseCoinNormalized(maxPurchasableStr)
+					if err != nil {
+						return err
+					}
+				}
+
+				msg = types.NewMsgWhitelistAddressWithAttributes(address, from, expiryTime, maxPurchasable, tier)
+			}
+
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -177,6 +203,200 @@ $ %s tx %s whitelist remove und1x8pl6wzqf9atkm77ymc5vn5dnpl5xytmn200xy --from en
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().Uint64(FlagExpiryTime, 0, "optional unix time from which the address can no longer raise purchase orders")
+	cmd.Flags().String(FlagMaxPurchasable, "", "optional maximum cumulative eFUND the address can purchase, e.g. 1000000000000nund")
+	cmd.Flags().String(FlagTier, "", "optional tier label for the whitelist entry")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdWithdrawPurchaseOrder is the CLI command for withdrawing a raised Enterprise FUND purchase order
+func GetCmdWithdrawPurchaseOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw [purchase_order_id]",
+		Short: "Withdraw an Enterprise FUND purchase order you have raised",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Withdraw an Enterprise FUND purchase order you have raised. The order can only be withdrawn
+while it is raised, and before any decisions have been made on it.
+Example:
+$ %s tx %s withdraw 24 --from wrktest
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress()
+
+			purchaseOrderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("purchase_order_id %s not a valid int, please input a valid purchase_order_id", args[0])
+			}
+
+			msg := types.NewMsgWithdrawUndPurchaseOrder(from, purchaseOrderId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAmendPurchaseOrder is the CLI command for amending the amount of a raised Enterprise FUND purchase order
+func GetCmdAmendPurchaseOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "amend [purchase_order_id] [amount]",
+		Short: "Amend the amount of an Enterprise FUND purchase order you have raised",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Amend the amount of an Enterprise FUND purchase order you have raised. The order can only be
+amended while it is raised, and before any decisions have been made on it. Amending the order resets its raise time.
+Example:
+$ %s tx %s amend 24 2000000000000%s --from wrktest
+`,
+				version.AppName, types.ModuleName, sdk.DefaultBondDenom,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress()
+
+			purchaseOrderId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("purchase_order_id %s not a valid int, please input a valid purchase_order_id", args[0])
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			if amount.Denom != sdk.DefaultBondDenom {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, fmt.Sprintf("denomination should be %s", sdk.DefaultBondDenom))
+			}
+
+			msg := types.NewMsgAmendUndPurchaseOrder(from, purchaseOrderId, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdTransferLockedEFUND is the CLI command for moving locked eFUND to another whitelisted or linked address
+func GetCmdTransferLockedEFUND() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-locked [to] [amount]",
+		Short: "Transfer locked eFUND to another whitelisted or linked address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer locked eFUND to another address, for example a hot wallet used to submit WRKChain or
+BEACON hashes. The address must be whitelisted, or have been linked to yours by an ent signer, see link-address.
+The eFUND remains locked, and keeps the expiry time of the purchase order it came from.
+Example:
+$ %s tx %s transfer-locked und1x8pl6wzqf9atkm77ymc5vn5dnpl5xytmn200xy 1000000000%s --from wrktest
+`,
+				version.AppName, types.ModuleName, sdk.DefaultBondDenom,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress()
+
+			to, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			if amount.Denom != sdk.DefaultBondDenom {
+				return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, fmt.Sprintf("denomination should be %s", sdk.DefaultBondDenom))
+			}
+
+			msg := types.NewMsgTransferLockedEFUND(from, to, amount)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdLinkAddressAction is the CLI command for adding/removing a link from an address to another address it can
+// transfer locked eFUND to
+func GetCmdLinkAddressAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "link-address [action] [owner] [linked]",
+		Short: "Add/Remove a link from an address to another address it can transfer locked eFUND to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Add/Remove a link from an owner address to another address, for example a hot wallet controlled
+by the same enterprise, which the owner can transfer locked eFUND to. Only ent signers can link addresses
+Example:
+$ %s tx %s link-address add und1x8pl6wzqf9atkm77ymc5vn5dnpl5xytmn200xy und1chknpc8nf2tmj5582vhlvphnjyekc9ypspx5ay --from ent
+$ %s tx %s link-address remove und1x8pl6wzqf9atkm77ymc5vn5dnpl5xytmn200xy und1chknpc8nf2tmj5582vhlvphnjyekc9ypspx5ay --from ent
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress()
+
+			action, err := types.WhitelistActionFromString(args[0])
+			if err != nil {
+				return err
+			}
+
+			if !types.ValidWhitelistAction(action) {
+				return errorsmod.Wrap(types.ErrInvalidWhitelistAction, "action should be add or remove")
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			linked, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgLinkAddress(owner, linked, action, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
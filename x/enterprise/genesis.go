@@ -17,9 +17,38 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, bankKeeper types.BankKee
 		panic(fmt.Sprintf("%s module account has not been set", types.ModuleName))
 	}
 
-	keeper.SetParams(ctx, data.Params)
+	for _, entSigner := range data.EntSigners {
+		err := keeper.SetEntSigner(ctx, entSigner)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	// import signers from legacy genesis params into the signer registry
+	params := data.Params
+	for _, authAddr := range params.LegacyEntSigners() {
+		addr, err := sdk.AccAddressFromBech32(authAddr)
+		if err != nil {
+			panic(err)
+		}
+		if keeper.IsEntSigner(ctx, addr) {
+			continue
+		}
+		err = keeper.SetEntSigner(ctx, types.EntSigner{Address: addr.String()})
+		if err != nil {
+			panic(err)
+		}
+	}
+	params.EntSigners = ""
+
+	err := keeper.SetParams(ctx, params)
+	if err != nil {
+		panic(err)
+	}
+
 	keeper.SetHighestPurchaseOrderID(ctx, data.StartingPurchaseOrderId)
 
+	// legacy whitelisted addresses have no expiry or purchase cap
 	if data.Whitelist != nil {
 		for _, wlAddr := range data.Whitelist {
 			addr, err := sdk.AccAddressFromBech32(wlAddr)
@@ -33,7 +62,21 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, bankKeeper types.BankKee
 		}
 	}
 
-	err := keeper.SetTotalLockedUnd(ctx, data.TotalLocked)
+	for _, entry := range data.WhitelistEntries {
+		err = keeper.SetWhitelistEntry(ctx, entry)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for _, link := range data.LinkedAddresses {
+		err = keeper.SetLinkedAddress(ctx, link)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	err = keeper.SetTotalLockedUnd(ctx, data.TotalLocked)
 	if err != nil {
 		panic(err)
 	}
@@ -79,6 +122,40 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, bankKeeper types.BankKee
 		}
 	}
 
+	startingLotID := data.StartingLockedEfundLotId
+	if startingLotID == 0 {
+		startingLotID = 1
+	}
+
+	for _, lot := range data.LockedEfundLots {
+		err = keeper.SetLockedEFUNDLot(ctx, lot)
+		if err != nil {
+			panic(err)
+		}
+		if lot.Id >= startingLotID {
+			startingLotID = lot.Id + 1
+		}
+	}
+
+	keeper.SetHighestLockedEFUNDLotID(ctx, startingLotID)
+
+	startingLedgerEntryID := data.StartingLedgerEntryId
+	if startingLedgerEntryID == 0 {
+		startingLedgerEntryID = 1
+	}
+
+	for _, entry := range data.LedgerEntries {
+		err = keeper.SetLedgerEntry(ctx, entry)
+		if err != nil {
+			panic(err)
+		}
+		if entry.Id >= startingLedgerEntryID {
+			startingLedgerEntryID = entry.Id + 1
+		}
+	}
+
+	keeper.SetHighestLedgerEntryID(ctx, startingLedgerEntryID)
+
 	for _, spent := range data.SpentEfund {
 		err = keeper.SetSpentEFUNDForAccount(ctx, spent)
 		if err != nil {
@@ -110,18 +187,30 @@ func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	purchaseOrders := keeper.GetAllPurchaseOrders(ctx)
 	lockedUnds := keeper.GetAllLockedUnds(ctx)
 	totalLocked := keeper.GetTotalLockedUnd(ctx)
-	whitelist := keeper.GetAllWhitelistedAddresses(ctx)
+	whitelistEntries := keeper.GetAllWhitelistEntries(ctx)
+	linkedAddresses := keeper.GetAllLinkedAddresses(ctx)
 	totalSpent := keeper.GetTotalSpentEFUND(ctx)
 	spentEFUNDs := keeper.GetAllSpentEFUNDs(ctx)
+	entSigners := keeper.GetAllEntSigners(ctx)
+	lots := keeper.GetAllLockedEFUNDLots(ctx)
+	lotID, _ := keeper.GetHighestLockedEFUNDLotID(ctx)
+	ledgerEntries := keeper.GetAllLedgerEntries(ctx)
+	ledgerEntryID, _ := keeper.GetHighestLedgerEntryID(ctx)
 
 	return &types.GenesisState{
-		Params:                  params,
-		StartingPurchaseOrderId: purchaseOrderId,
-		PurchaseOrders:          purchaseOrders,
-		LockedUnd:               lockedUnds,
-		TotalLocked:             totalLocked,
-		Whitelist:               whitelist,
-		TotalSpent:              totalSpent,
-		SpentEfund:              spentEFUNDs,
+		Params:                   params,
+		StartingPurchaseOrderId:  purchaseOrderId,
+		PurchaseOrders:           purchaseOrders,
+		LockedUnd:                lockedUnds,
+		TotalLocked:              totalLocked,
+		WhitelistEntries:         whitelistEntries,
+		TotalSpent:               totalSpent,
+		SpentEfund:               spentEFUNDs,
+		EntSigners:               entSigners,
+		LockedEfundLots:          lots,
+		StartingLockedEfundLotId: lotID,
+		LedgerEntries:            ledgerEntries,
+		StartingLedgerEntryId:    ledgerEntryID,
+		LinkedAddresses:          linkedAddresses,
 	}
 }
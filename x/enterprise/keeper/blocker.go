@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"strconv"
-	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -15,9 +14,11 @@ func (k Keeper) TallyPurchaseOrderDecisions(ctx sdk.Context) error {
 	entParams := k.GetParams(ctx)
 	timeNow := uint64(ctx.BlockHeader().Time.Unix())
 
-	entSigners := strings.Split(entParams.EntSigners, ",")
+	rejectThreshold := int(k.GetNumEntSigners(ctx)) - int(entParams.MinAccepts)
 
-	rejectThreshold := len(entSigners) - int(entParams.MinAccepts)
+	// if decided by an x/group policy, decisions are final when made, so only stale purchase
+	// orders need to be checked
+	groupPolicyMode := entParams.IsGroupPolicyMode()
 
 	logger := k.Logger(ctx)
 
@@ -44,7 +45,7 @@ func (k Keeper) TallyPurchaseOrderDecisions(ctx sdk.Context) error {
 
 		// first check if it's a stale PO
 		timeDiff := timeNow - po.RaiseTime
-		if timeDiff >= entParams.DecisionTimeLimit && numAccepts < int(entParams.MinAccepts) {
+		if timeDiff >= entParams.DecisionTimeLimit && (groupPolicyMode || numAccepts < int(entParams.MinAccepts)) {
 			po.Status = types.StatusRejected
 			po.CompletionTime = timeNow
 			err := k.SetPurchaseOrder(ctx, po)
@@ -68,8 +69,12 @@ func (k Keeper) TallyPurchaseOrderDecisions(ctx sdk.Context) error {
 			continue
 		}
 
-		// check rejects
-		if numRejects > rejectThreshold {
+		if groupPolicyMode {
+			continue
+		}
+
+		// check rejects. Orders which would exceed the purchaser's cap are rejected rather than accepted
+		if numRejects > rejectThreshold || (numAccepts >= int(entParams.MinAccepts) && k.exceedsPurchaseCap(ctx, po)) {
 			po.Status = types.StatusRejected
 			po.CompletionTime = timeNow
 			err := k.SetPurchaseOrder(ctx, po)
@@ -100,6 +105,7 @@ func (k Keeper) TallyPurchaseOrderDecisions(ctx sdk.Context) error {
 		if numAccepts >= int(entParams.MinAccepts) {
 			po.Status = types.StatusAccepted
 			po.CompletionTime = timeNow
+			po.EfundExpiryTime = k.getEFUNDExpiryTime(ctx)
 			err := k.SetPurchaseOrder(ctx, po)
 			if err != nil {
 				return err
@@ -158,7 +164,7 @@ func (k Keeper) ProcessAcceptedPurchaseOrders(ctx sdk.Context) error {
 		}
 
 		// Mint the Enterprise FUND
-		err = k.CreateAndLockEFUND(ctx, purchaser, po.Amount)
+		err = k.CreateAndLockEFUND(ctx, purchaser, po.Amount, po.Id, po.EfundExpiryTime)
 		if err != nil {
 			return err
 		}
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"math/rand"
+	"reflect"
 
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -21,7 +22,7 @@ func createRandomAccounts(accNum int) []sdk.AccAddress {
 }
 
 func ParamsEqual(paramsA, paramsB types.Params) bool {
-	return paramsA == paramsB
+	return reflect.DeepEqual(paramsA, paramsB)
 }
 
 func LockedUndEqual(lA, lB types.LockedUnd) bool {
@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+// IsEntSigner checks if an address is in the ent signer registry
+func (k Keeper) IsEntSigner(ctx sdk.Context, address sdk.AccAddress) bool {
+	if address.Empty() {
+		return false
+	}
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.EntSignerStoreKey(address))
+}
+
+// GetEntSigner returns the registry entry for an ent signer
+func (k Keeper) GetEntSigner(ctx sdk.Context, address sdk.AccAddress) (types.EntSigner, bool) {
+	var entSigner types.EntSigner
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EntSignerStoreKey(address))
+	if bz == nil {
+		return entSigner, false
+	}
+	k.cdc.MustUnmarshal(bz, &entSigner)
+	return entSigner, true
+}
+
+// SetEntSigner adds or updates an ent signer in the registry
+func (k Keeper) SetEntSigner(ctx sdk.Context, entSigner types.EntSigner) error {
+	address, err := sdk.AccAddressFromBech32(entSigner.Address)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EntSignerStoreKey(address), k.cdc.MustMarshal(&entSigner))
+
+	return nil
+}
+
+// DeleteEntSigner removes an ent signer from the registry. Signers cannot be removed if the
+// registry would be left with fewer than MinAccepts signers, unless purchase orders are decided
+// by an x/group policy
+func (k Keeper) DeleteEntSigner(ctx sdk.Context, address sdk.AccAddress) error {
+	if !k.IsEntSigner(ctx, address) {
+		return errorsmod.Wrapf(types.ErrEntSignerNotRegistered, "%s not registered", address)
+	}
+
+	numEntSigners := k.GetNumEntSigners(ctx)
+	minAccepts := k.GetParamMinAccepts(ctx)
+	if !k.IsGroupPolicyMode(ctx) && numEntSigners-1 < minAccepts {
+		return errorsmod.Wrapf(types.ErrInsufficientEntSigners, "min accepts %d > %d signers", minAccepts, numEntSigners-1)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.EntSignerStoreKey(address))
+
+	return nil
+}
+
+// IterateEntSigners iterates over all the ent signers in the registry and performs a callback function
+func (k Keeper) IterateEntSigners(ctx sdk.Context, cb func(entSigner types.EntSigner) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.EntSignerKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entSigner types.EntSigner
+		k.cdc.MustUnmarshal(iterator.Value(), &entSigner)
+
+		if cb(entSigner) {
+			break
+		}
+	}
+}
+
+// GetAllEntSigners returns all the ent signers in the registry
+func (k Keeper) GetAllEntSigners(ctx sdk.Context) (entSigners []types.EntSigner) {
+	k.IterateEntSigners(ctx, func(entSigner types.EntSigner) bool {
+		entSigners = append(entSigners, entSigner)
+		return false
+	})
+	return
+}
+
+// GetEntSignersAsAddressArray returns the addresses of all the ent signers in the registry
+func (k Keeper) GetEntSignersAsAddressArray(ctx sdk.Context) []sdk.AccAddress {
+	var entSignersArray []sdk.AccAddress
+	k.IterateEntSigners(ctx, func(entSigner types.EntSigner) bool {
+		addr, err := sdk.AccAddressFromBech32(entSigner.Address)
+		if err == nil && !addr.Empty() {
+			entSignersArray = append(entSignersArray, addr)
+		}
+		return false
+	})
+	return entSignersArray
+}
+
+// GetNumEntSigners returns the number of ent signers in the registry
+func (k Keeper) GetNumEntSigners(ctx sdk.Context) uint64 {
+	numEntSigners := uint64(0)
+	k.IterateEntSigners(ctx, func(_ types.EntSigner) bool {
+		numEntSigners++
+		return false
+	})
+	return numEntSigners
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	simapphelpers "github.com/unification-com/mainchain/app/helpers"
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+func TestSetGetEntSigners(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(10)
+
+	// genesis registers a single signer
+	numGenesisSigners := app.EnterpriseKeeper.GetNumEntSigners(ctx)
+	require.Equal(t, uint64(1), numGenesisSigners)
+
+	for _, addr := range testAddrs {
+		require.False(t, app.EnterpriseKeeper.IsEntSigner(ctx, addr))
+
+		entSigner := types.EntSigner{
+			Address: addr.String(),
+			Name:    "signer",
+			AddedAt: uint64(ctx.BlockHeader().Time.Unix()),
+		}
+		err := app.EnterpriseKeeper.SetEntSigner(ctx, entSigner)
+		require.NoError(t, err)
+
+		entSignerDb, found := app.EnterpriseKeeper.GetEntSigner(ctx, addr)
+		require.True(t, found)
+		require.Equal(t, entSigner, entSignerDb)
+		require.True(t, app.EnterpriseKeeper.IsEntSigner(ctx, addr))
+		require.True(t, app.EnterpriseKeeper.IsAuthorisedToDecide(ctx, addr))
+	}
+
+	require.Equal(t, numGenesisSigners+uint64(len(testAddrs)), app.EnterpriseKeeper.GetNumEntSigners(ctx))
+	require.Len(t, app.EnterpriseKeeper.GetAllEntSigners(ctx), len(testAddrs)+1)
+	require.Len(t, app.EnterpriseKeeper.GetEntSignersAsAddressArray(ctx), len(testAddrs)+1)
+
+	err := app.EnterpriseKeeper.SetEntSigner(ctx, types.EntSigner{Address: "rubbish"})
+	require.Error(t, err)
+}
+
+func TestDeleteEntSigner(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(2)
+
+	err := app.EnterpriseKeeper.DeleteEntSigner(ctx, testAddrs[0])
+	require.ErrorIs(t, err, types.ErrEntSignerNotRegistered)
+
+	err = app.EnterpriseKeeper.SetEntSigner(ctx, types.EntSigner{Address: testAddrs[0].String()})
+	require.NoError(t, err)
+	err = app.EnterpriseKeeper.SetEntSigner(ctx, types.EntSigner{Address: testAddrs[1].String()})
+	require.NoError(t, err)
+
+	params := app.EnterpriseKeeper.GetParams(ctx)
+	params.MinAccepts = 2
+	err = app.EnterpriseKeeper.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	err = app.EnterpriseKeeper.DeleteEntSigner(ctx, testAddrs[0])
+	require.NoError(t, err)
+	require.False(t, app.EnterpriseKeeper.IsEntSigner(ctx, testAddrs[0]))
+
+	// cannot leave fewer signers than min accepts
+	err = app.EnterpriseKeeper.DeleteEntSigner(ctx, testAddrs[1])
+	require.ErrorIs(t, err, types.ErrInsufficientEntSigners)
+	require.True(t, app.EnterpriseKeeper.IsEntSigner(ctx, testAddrs[1]))
+}
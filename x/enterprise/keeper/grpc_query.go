@@ -2,7 +2,6 @@ package keeper
 
 import (
 	"context"
-	"strings"
 
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -47,7 +46,7 @@ func (q Keeper) EnterpriseUndPurchaseOrder(c context.Context, req *types.QueryEn
 	return &types.QueryEnterpriseUndPurchaseOrderResponse{PurchaseOrder: purchaseOrder}, nil
 }
 
-// Purchase Orders paginated
+// Purchase Orders paginated. Filtering by purchaser and/or status uses the purchase order indexes
 func (q Keeper) EnterpriseUndPurchaseOrders(c context.Context, req *types.QueryEnterpriseUndPurchaseOrdersRequest) (*types.QueryEnterpriseUndPurchaseOrdersResponse, error) {
 	if req == nil {
 		return nil, status.Errorf(codes.InvalidArgument, "empty request")
@@ -57,25 +56,51 @@ func (q Keeper) EnterpriseUndPurchaseOrders(c context.Context, req *types.QueryE
 	store := ctx.KVStore(q.storeKey)
 	var purchaseOrders []types.EnterpriseUndPurchaseOrder
 
-	poStore := prefix.NewStore(store, types.PurchaseOrderIDKeyPrefix)
+	filterStatus := req.Status != types.StatusNil
+	if filterStatus && !types.ValidPurchaseOrderStatus(req.Status) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid status: %s", req.Status.String())
+	}
 
-	pageRes, err := query.FilteredPaginate(poStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
-		var info types.EnterpriseUndPurchaseOrder
-		err := q.cdc.Unmarshal(value, &info)
+	var indexStore prefix.Store
+	switch {
+	case req.Purchaser != "":
+		purchaser, err := sdk.AccAddressFromBech32(req.Purchaser)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid purchaser: %s", err)
+		}
+		indexStore = prefix.NewStore(store, types.PurchaseOrdersByPurchaserPrefix(purchaser))
+	case filterStatus:
+		indexStore = prefix.NewStore(store, types.PurchaseOrdersByStatusPrefix(req.Status))
+	default:
+		poStore := prefix.NewStore(store, types.PurchaseOrderIDKeyPrefix)
+		pageRes, err := query.Paginate(poStore, req.Pagination, func(key []byte, value []byte) error {
+			var po types.EnterpriseUndPurchaseOrder
+			if err := q.cdc.Unmarshal(value, &po); err != nil {
+				return err
+			}
+			purchaseOrders = append(purchaseOrders, po)
+			return nil
+		})
 		if err != nil {
-			return false, err
+			return nil, err
 		}
+		return &types.QueryEnterpriseUndPurchaseOrdersResponse{PurchaseOrders: purchaseOrders, Pagination: pageRes}, nil
+	}
 
-		if req.Status.String() != "STATUS_NIL" && !strings.EqualFold(info.GetStatus().String(), req.Status.String()) {
+	// index keys are the purchase order IDs. When filtering by both, the purchaser's index is
+	// used, with the status checked for each of their purchase orders
+	pageRes, err := query.FilteredPaginate(indexStore, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		po, found := q.GetPurchaseOrder(ctx, types.GetPurchaseOrderIDFromBytes(key))
+		if !found {
 			return false, nil
 		}
 
-		if req.Purchaser != "" && !strings.EqualFold(info.Purchaser, req.Purchaser) {
+		if filterStatus && po.Status != req.Status {
 			return false, nil
 		}
 
 		if accumulate {
-			purchaseOrders = append(purchaseOrders, info)
+			purchaseOrders = append(purchaseOrders, po)
 		}
 
 		return true, nil
@@ -117,9 +142,13 @@ func (q Keeper) TotalLocked(c context.Context, req *types.QueryTotalLockedReques
 func (q Keeper) Whitelist(c context.Context, req *types.QueryWhitelistRequest) (*types.QueryWhitelistResponse, error) {
 
 	ctx := sdk.UnwrapSDKContext(c)
-	whitelist := q.GetAllWhitelistedAddresses(ctx)
+	entries := q.GetActiveWhitelistEntries(ctx)
+	whitelist := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		whitelist = append(whitelist, entry.Address)
+	}
 
-	return &types.QueryWhitelistResponse{Addresses: whitelist}, nil
+	return &types.QueryWhitelistResponse{Addresses: whitelist, Entries: entries}, nil
 }
 
 func (q Keeper) Whitelisted(c context.Context, req *types.QueryWhitelistedRequest) (*types.QueryWhitelistedResponse, error) {
@@ -139,8 +168,108 @@ func (q Keeper) Whitelisted(c context.Context, req *types.QueryWhitelistedReques
 
 	ctx := sdk.UnwrapSDKContext(c)
 	isWhilelisted := q.AddressIsWhitelisted(ctx, addr)
+	entry, _ := q.GetWhitelistEntry(ctx, addr)
+
+	return &types.QueryWhitelistedResponse{Address: req.Address, Whitelisted: isWhilelisted, Entry: entry}, nil
+}
+
+// EnterpriseLedger queries an account's eFUND ledger paginated, oldest first. Ledger keys are prefixed with the
+// entry's timestamp, so the time range is checked without unmarshalling entries outside of it
+func (q Keeper) EnterpriseLedger(c context.Context, req *types.QueryEnterpriseLedgerRequest) (*types.QueryEnterpriseLedgerResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid owner: %s", err)
+	}
+
+	if req.ToTime > 0 && req.ToTime <= req.FromTime {
+		return nil, status.Errorf(codes.InvalidArgument, "to time must be after from time")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := ctx.KVStore(q.storeKey)
+	ledgerStore := prefix.NewStore(store, types.LedgerEntriesByOwnerPrefix(owner))
+	var entries []types.EFUNDLedgerEntry
+
+	pageRes, err := query.FilteredPaginate(ledgerStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		timestamp := types.GetLedgerTimestampFromKey(key)
+		if timestamp < req.FromTime || (req.ToTime > 0 && timestamp >= req.ToTime) {
+			return false, nil
+		}
+
+		if accumulate {
+			var entry types.EFUNDLedgerEntry
+			if err := q.cdc.Unmarshal(value, &entry); err != nil {
+				return false, err
+			}
+			entries = append(entries, entry)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEnterpriseLedgerResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+func (q Keeper) LockedEFUNDLotsByAddress(c context.Context, req *types.QueryLockedEFUNDLotsByAddressRequest) (*types.QueryLockedEFUNDLotsByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	lots := q.GetLockedEFUNDLotsForAccount(ctx, owner)
+
+	return &types.QueryLockedEFUNDLotsByAddressResponse{Lots: lots}, nil
+}
+
+func (q Keeper) LinkedAddresses(c context.Context, req *types.QueryLinkedAddressesRequest) (*types.QueryLinkedAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.Owner == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	owner, err := sdk.AccAddressFromBech32(req.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	links := q.GetLinkedAddressesByOwner(ctx, owner)
+
+	return &types.QueryLinkedAddressesResponse{LinkedAddresses: links}, nil
+}
+
+func (q Keeper) EntSigners(c context.Context, req *types.QueryEntSignersRequest) (*types.QueryEntSignersResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	entSigners := q.GetAllEntSigners(ctx)
 
-	return &types.QueryWhitelistedResponse{Address: req.Address, Whitelisted: isWhilelisted}, nil
+	return &types.QueryEntSignersResponse{EntSigners: entSigners}, nil
 }
 
 func (q Keeper) EnterpriseAccount(c context.Context, req *types.QueryEnterpriseAccountRequest) (*types.QueryEnterpriseAccountResponse, error) {
@@ -13,10 +13,9 @@ import (
 )
 
 func (s *KeeperTestSuite) TestGRPCQueryParams() {
-	app, ctx, queryClient, addrs := s.app, s.ctx, s.queryClient, s.addrs
+	app, ctx, queryClient := s.app, s.ctx, s.queryClient
 
 	testParams := types.Params{
-		EntSigners:        addrs[0].String(),
 		Denom:             sdk.DefaultBondDenom,
 		MinAccepts:        1,
 		DecisionTimeLimit: 600,
@@ -29,6 +28,65 @@ func (s *KeeperTestSuite) TestGRPCQueryParams() {
 	s.Equal(testParams, paramsResp.Params)
 }
 
+func (s *KeeperTestSuite) TestGRPCQueryLockedEFUNDLotsByAddress() {
+	app, ctx, queryClient, addrs := s.app, s.ctx, s.queryClient, s.addrs
+
+	_, err := queryClient.LockedEFUNDLotsByAddress(gocontext.Background(), &types.QueryLockedEFUNDLotsByAddressRequest{})
+	s.Require().Error(err)
+
+	s.Require().NoError(app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), 1, 0))
+	s.Require().NoError(app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000), 2, 0))
+
+	res, err := queryClient.LockedEFUNDLotsByAddress(gocontext.Background(), &types.QueryLockedEFUNDLotsByAddressRequest{Owner: addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Lots, 2)
+	s.Require().Equal(uint64(1), res.Lots[0].PurchaseOrderId)
+	s.Require().Equal(uint64(2), res.Lots[1].PurchaseOrderId)
+
+	res, err = queryClient.LockedEFUNDLotsByAddress(gocontext.Background(), &types.QueryLockedEFUNDLotsByAddressRequest{Owner: addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Lots, 0)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryLinkedAddresses() {
+	app, ctx, queryClient, addrs := s.app, s.ctx, s.queryClient, s.addrs
+
+	_, err := queryClient.LinkedAddresses(gocontext.Background(), &types.QueryLinkedAddressesRequest{})
+	s.Require().Error(err)
+
+	link := types.LinkedAddress{
+		Owner:   addrs[0].String(),
+		Linked:  addrs[1].String(),
+		AddedAt: uint64(ctx.BlockHeader().Time.Unix()),
+	}
+	s.Require().NoError(app.EnterpriseKeeper.SetLinkedAddress(ctx, link))
+
+	res, err := queryClient.LinkedAddresses(gocontext.Background(), &types.QueryLinkedAddressesRequest{Owner: addrs[0].String()})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.LinkedAddress{link}, res.LinkedAddresses)
+
+	res, err = queryClient.LinkedAddresses(gocontext.Background(), &types.QueryLinkedAddressesRequest{Owner: addrs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.LinkedAddresses, 0)
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryEntSigners() {
+	app, ctx, queryClient, addrs := s.app, s.ctx, s.queryClient, s.addrs
+
+	expected := app.EnterpriseKeeper.GetAllEntSigners(ctx)
+	newSigner := types.EntSigner{
+		Address: addrs[0].String(),
+		Name:    "signer one",
+		AddedAt: uint64(ctx.BlockHeader().Time.Unix()),
+	}
+	s.Require().NoError(app.EnterpriseKeeper.SetEntSigner(ctx, newSigner))
+
+	res, err := queryClient.EntSigners(gocontext.Background(), &types.QueryEntSignersRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(res.EntSigners, len(expected)+1)
+	s.Require().Contains(res.EntSigners, newSigner)
+}
+
 func (s *KeeperTestSuite) TestGRPCQueryEnterpriseUndPurchaseOrder() {
 	app, ctx, queryClient, addrs := s.app, s.ctx, s.queryClient, s.addrs
 
@@ -239,6 +297,66 @@ func (s *KeeperTestSuite) TestGRPCQueryEnterpriseUndPurchaseOrders() {
 			},
 			true,
 		},
+		{
+			"request purchaser filter 2nd page",
+			func() {
+				// more pos for the same purchaser
+				for i := 0; i < 3; i++ {
+					poId, err := app.EnterpriseKeeper.RaiseNewPurchaseOrder(ctx, types.EnterpriseUndPurchaseOrder{
+						Purchaser: addrs[1].String(),
+						Amount:    sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+					})
+					s.Require().NoError(err)
+					expectedPo, _ := app.EnterpriseKeeper.GetPurchaseOrder(ctx, poId)
+					testPos = append(testPos, expectedPo)
+				}
+
+				req = &types.QueryEnterpriseUndPurchaseOrdersRequest{
+					Purchaser:  addrs[1].String(),
+					Pagination: &query.PageRequest{Offset: 2, Limit: 2},
+				}
+
+				expRes = &types.QueryEnterpriseUndPurchaseOrdersResponse{
+					PurchaseOrders: testPos[len(addrs)+1:],
+				}
+			},
+			true,
+		},
+		{
+			"request status filter 2nd page",
+			func() {
+				req = &types.QueryEnterpriseUndPurchaseOrdersRequest{
+					Status:     types.StatusRaised,
+					Pagination: &query.PageRequest{Offset: 9, Limit: 2},
+				}
+
+				expRes = &types.QueryEnterpriseUndPurchaseOrdersResponse{
+					PurchaseOrders: testPos[len(addrs) : len(addrs)+2],
+				}
+			},
+			true,
+		},
+		{
+			"request with purchaser and status filters no match",
+			func() {
+				req = &types.QueryEnterpriseUndPurchaseOrdersRequest{
+					Purchaser: addrs[1].String(),
+					Status:    types.StatusCompleted,
+				}
+
+				expRes = &types.QueryEnterpriseUndPurchaseOrdersResponse{}
+			},
+			true,
+		},
+		{
+			"request with invalid purchaser",
+			func() {
+				req = &types.QueryEnterpriseUndPurchaseOrdersRequest{
+					Purchaser: "rubbish",
+				}
+			},
+			false,
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -363,6 +481,46 @@ func (s *KeeperTestSuite) TestGRPCQueryWhitelist() {
 	res, err := queryClient.Whitelist(gocontext.Background(), req)
 	s.Require().NoError(err)
 	s.Require().Equal(whitelistedAddrs, res.Addresses)
+	s.Require().Len(res.Entries, len(whitelistedAddrs))
+	for i, entry := range res.Entries {
+		s.Require().Equal(whitelistedAddrs[i], entry.Address)
+	}
+}
+
+func (s *KeeperTestSuite) TestGRPCQueryWhitelistEntries() {
+	app, ctx, queryClient, addrs := s.app, s.ctx, s.queryClient, s.addrs
+	timeNow := uint64(ctx.BlockTime().Unix())
+
+	active := types.WhitelistEntry{
+		Address:        addrs[0].String(),
+		ExpiryTime:     timeNow + 1000,
+		MaxPurchasable: sdk.NewInt64Coin("nund", 1000),
+		Tier:           "gold",
+	}
+	expired := types.WhitelistEntry{
+		Address:        addrs[1].String(),
+		ExpiryTime:     timeNow,
+		MaxPurchasable: sdk.NewInt64Coin("nund", 0),
+		Tier:           "silver",
+	}
+
+	s.Require().NoError(app.EnterpriseKeeper.SetWhitelistEntry(ctx, active))
+	s.Require().NoError(app.EnterpriseKeeper.SetWhitelistEntry(ctx, expired))
+
+	res, err := queryClient.Whitelist(gocontext.Background(), &types.QueryWhitelistRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]string{active.Address}, res.Addresses)
+	s.Require().Equal([]types.WhitelistEntry{active}, res.Entries)
+
+	wlRes, err := queryClient.Whitelisted(gocontext.Background(), &types.QueryWhitelistedRequest{Address: active.Address})
+	s.Require().NoError(err)
+	s.Require().True(wlRes.Whitelisted)
+	s.Require().Equal(active, wlRes.Entry)
+
+	wlRes, err = queryClient.Whitelisted(gocontext.Background(), &types.QueryWhitelistedRequest{Address: expired.Address})
+	s.Require().NoError(err)
+	s.Require().False(wlRes.Whitelisted)
+	s.Require().Equal(expired, wlRes.Entry)
 }
 
 func (s *KeeperTestSuite) TestGRPCQueryWhitelisted() {
@@ -412,10 +570,10 @@ func (s *KeeperTestSuite) TestTotalSpentEFUND() {
 		expectedPo.Status = types.StatusCompleted
 		_ = app.EnterpriseKeeper.SetPurchaseOrder(ctx, expectedPo)
 
-		err = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addrs[i], poAmountCoin)
+		err = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addrs[i], poAmountCoin, 0, 0)
 		s.Require().NoError(err)
 
-		err = app.EnterpriseKeeper.UnlockAndMintCoinsForFees(ctx, addrs[i], sdk.Coins{toUnlockCoin})
+		err = app.EnterpriseKeeper.UnlockAndMintCoinsForFees(ctx, addrs[i], sdk.Coins{toUnlockCoin}, nil)
 		s.Require().NoError(err)
 
 		totalUnlocked += toUnlock
@@ -448,10 +606,10 @@ func (s *KeeperTestSuite) TestSpentEFUNDByAddress() {
 		expectedPo.Status = types.StatusCompleted
 		_ = app.EnterpriseKeeper.SetPurchaseOrder(ctx, expectedPo)
 
-		err = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addrs[i], poAmountCoin)
+		err = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addrs[i], poAmountCoin, 0, 0)
 		s.Require().NoError(err)
 
-		err = app.EnterpriseKeeper.UnlockAndMintCoinsForFees(ctx, addrs[i], sdk.Coins{toUnlockCoin})
+		err = app.EnterpriseKeeper.UnlockAndMintCoinsForFees(ctx, addrs[i], sdk.Coins{toUnlockCoin}, nil)
 		s.Require().NoError(err)
 
 		expectedResp := &types.QuerySpentEFUNDByAddressResponse{Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(toUnlock))}
@@ -464,3 +622,81 @@ func (s *KeeperTestSuite) TestSpentEFUNDByAddress() {
 		s.Require().Equal(expectedResp.Amount.String(), res.Amount.String())
 	}
 }
+
+func (s *KeeperTestSuite) TestGRPCQueryEnterpriseLedger() {
+	app, ctx, queryClient, addrs := s.app, s.ctx, s.queryClient, s.addrs
+
+	for i := int64(0); i < 5; i++ {
+		entryCtx := ctx.WithBlockTime(time.Unix(1000+i*100, 0))
+		err := app.EnterpriseKeeper.CreateAndLockEFUND(entryCtx, addrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), uint64(i+1), 0)
+		s.Require().NoError(err)
+	}
+	_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx.WithBlockTime(time.Unix(1000, 0)), addrs[1], sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), 6, 0)
+
+	testCases := []struct {
+		name        string
+		req         *types.QueryEnterpriseLedgerRequest
+		expectErr   bool
+		expectedPos []uint64
+	}{
+		{
+			name:      "empty request",
+			req:       nil,
+			expectErr: true,
+		},
+		{
+			name:      "invalid owner",
+			req:       &types.QueryEnterpriseLedgerRequest{Owner: "rubbish"},
+			expectErr: true,
+		},
+		{
+			name:      "invalid time range",
+			req:       &types.QueryEnterpriseLedgerRequest{Owner: addrs[0].String(), FromTime: 1200, ToTime: 1100},
+			expectErr: true,
+		},
+		{
+			name:        "all entries",
+			req:         &types.QueryEnterpriseLedgerRequest{Owner: addrs[0].String()},
+			expectedPos: []uint64{1, 2, 3, 4, 5},
+		},
+		{
+			name:        "from time",
+			req:         &types.QueryEnterpriseLedgerRequest{Owner: addrs[0].String(), FromTime: 1200},
+			expectedPos: []uint64{3, 4, 5},
+		},
+		{
+			name:        "time range",
+			req:         &types.QueryEnterpriseLedgerRequest{Owner: addrs[0].String(), FromTime: 1100, ToTime: 1300},
+			expectedPos: []uint64{2, 3},
+		},
+		{
+			name:        "paginated",
+			req:         &types.QueryEnterpriseLedgerRequest{Owner: addrs[0].String(), FromTime: 1100, Pagination: &query.PageRequest{Limit: 2}},
+			expectedPos: []uint64{2, 3},
+		},
+		{
+			name:        "no entries",
+			req:         &types.QueryEnterpriseLedgerRequest{Owner: addrs[2].String()},
+			expectedPos: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			res, err := queryClient.EnterpriseLedger(gocontext.Background(), tc.req)
+			if tc.expectErr {
+				s.Require().Error(err)
+				return
+			}
+			s.Require().NoError(err)
+
+			var poIDs []uint64
+			for _, entry := range res.Entries {
+				s.Require().Equal(tc.req.Owner, entry.Owner)
+				poIDs = append(poIDs, entry.PurchaseOrderId)
+			}
+			s.Require().Equal(tc.expectedPos, poIDs)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+// RegisterInvariants registers all enterprise module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "total-locked", TotalLockedInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-spent", TotalSpentInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "purchase-orders", PurchaseOrdersInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "purchase-order-queues", PurchaseOrderQueuesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the enterprise module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := TotalLockedInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = TotalSpentInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = PurchaseOrdersInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return PurchaseOrderQueuesInvariant(k)(ctx)
+	}
+}
+
+// TotalLockedInvariant checks that the total locked eFUND equals the sum of every account's locked eFUND
+func TotalLockedInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		err := types.CheckTotalLocked(k.GetAllLockedUnds(ctx), k.GetTotalLockedUnd(ctx))
+		broken := err != nil
+
+		msg := "total locked eFUND equals the sum of accounts' locked eFUND\n"
+		if broken {
+			msg = err.Error() + "\n"
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "total-locked", msg), broken
+	}
+}
+
+// TotalSpentInvariant checks that the total spent eFUND equals the sum of every account's spent eFUND
+func TotalSpentInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		err := types.CheckTotalSpent(k.GetAllSpentEFUNDs(ctx), k.GetTotalSpentEFUND(ctx))
+		broken := err != nil
+
+		msg := "total spent eFUND equals the sum of accounts' spent eFUND\n"
+		if broken {
+			msg = err.Error() + "\n"
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "total-spent", msg), broken
+	}
+}
+
+// PurchaseOrdersInvariant checks that completed purchase orders cover the total locked and spent eFUND
+func PurchaseOrdersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		err := types.CheckPurchaseOrdersCoverEFUND(k.GetAllPurchaseOrders(ctx), k.GetTotalLockedUnd(ctx), k.GetTotalSpentEFUND(ctx))
+		broken := err != nil
+
+		msg := "completed purchase orders cover the total locked and spent eFUND\n"
+		if broken {
+			msg = err.Error() + "\n"
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "purchase-orders", msg), broken
+	}
+}
+
+// PurchaseOrderQueuesInvariant checks that the Raised and Accepted queues only hold existing purchase orders
+// with the matching status
+func PurchaseOrderQueuesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		check := func(queueStatus types.PurchaseOrderStatus) func(purchaseOrderId uint64) bool {
+			return func(purchaseOrderId uint64) bool {
+				po, found := k.GetPurchaseOrder(ctx, purchaseOrderId)
+				if err := types.CheckQueuedPurchaseOrder(purchaseOrderId, po, found, queueStatus); err != nil {
+					count++
+					msg += fmt.Sprintf("\t%s\n", err.Error())
+				}
+				return false
+			}
+		}
+
+		k.IterateRaisedQueue(ctx, check(types.StatusRaised))
+		k.IterateAcceptedQueue(ctx, check(types.StatusAccepted))
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, "purchase-order-queues", fmt.Sprintf("found %d invalid queued purchase orders\n%s", count, msg)), broken
+	}
+}
@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/enterprise/keeper"
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+func (s *KeeperTestSuite) TestInvariants() {
+	purchaser := s.addrs[0]
+	hotWallet := s.addrs[1]
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)
+
+	params := s.app.EnterpriseKeeper.GetParams(s.ctx)
+	params.EfundExpiryPeriod = 3600
+	s.Require().NoError(s.app.EnterpriseKeeper.SetParams(s.ctx, params))
+
+	checkInvariants := func(ctx sdk.Context) {
+		msg, broken := keeper.AllInvariants(s.app.EnterpriseKeeper)(ctx)
+		s.Require().False(broken, msg)
+		s.Require().NoError(s.app.AssertInvariants(ctx))
+	}
+
+	checkInvariants(s.ctx)
+
+	// raise, accept and complete purchase orders
+	poID, err := s.app.EnterpriseKeeper.RaiseNewPurchaseOrder(s.ctx, types.EnterpriseUndPurchaseOrder{Purchaser: purchaser.String(), Amount: amount})
+	s.Require().NoError(err)
+	rejectedID, err := s.app.EnterpriseKeeper.RaiseNewPurchaseOrder(s.ctx, types.EnterpriseUndPurchaseOrder{Purchaser: purchaser.String(), Amount: amount})
+	s.Require().NoError(err)
+	checkInvariants(s.ctx)
+
+	s.Require().NoError(s.app.EnterpriseKeeper.FinalisePurchaseOrderDecision(s.ctx, poID, types.StatusAccepted))
+	s.Require().NoError(s.app.EnterpriseKeeper.FinalisePurchaseOrderDecision(s.ctx, rejectedID, types.StatusRejected))
+	checkInvariants(s.ctx)
+
+	s.Require().NoError(s.app.EnterpriseKeeper.ProcessAcceptedPurchaseOrders(s.ctx))
+	checkInvariants(s.ctx)
+
+	// pay fees, transfer and expire locked eFUND
+	err = s.app.EnterpriseKeeper.UnlockAndMintCoinsForFees(s.ctx, purchaser, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), nil)
+	s.Require().NoError(err)
+	checkInvariants(s.ctx)
+
+	s.Require().NoError(s.app.EnterpriseKeeper.TransferLockedEFUND(s.ctx, purchaser, hotWallet, sdk.NewInt64Coin(sdk.DefaultBondDenom, 4000)))
+	checkInvariants(s.ctx)
+
+	expireCtx := s.ctx.WithBlockTime(s.ctx.BlockHeader().Time.Add(time.Hour))
+	s.app.EnterpriseKeeper.ExpireLockedEFUNDLots(expireCtx)
+	s.Require().True(s.app.EnterpriseKeeper.GetTotalLockedUnd(expireCtx).IsZero())
+	checkInvariants(expireCtx)
+
+	// break the total locked eFUND
+	s.Require().NoError(s.app.EnterpriseKeeper.SetTotalLockedUnd(s.ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+
+	msg, broken := keeper.TotalLockedInvariant(s.app.EnterpriseKeeper)(s.ctx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, "does not equal the sum of accounts' locked eFUND")
+
+	// break the total spent eFUND, which is then no longer covered by purchase orders
+	s.Require().NoError(s.app.EnterpriseKeeper.SetTotalSpentEFUND(s.ctx, amount))
+
+	msg, broken = keeper.TotalSpentInvariant(s.app.EnterpriseKeeper)(s.ctx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, "does not equal the sum of accounts' spent eFUND")
+
+	msg, broken = keeper.PurchaseOrdersInvariant(s.app.EnterpriseKeeper)(s.ctx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, "is more than the")
+
+	// break the queues
+	s.app.EnterpriseKeeper.AddPoToRaisedQueue(s.ctx, poID)
+	s.app.EnterpriseKeeper.AddPoToAcceptedQueue(s.ctx, 99)
+
+	msg, broken = keeper.PurchaseOrderQueuesInvariant(s.app.EnterpriseKeeper)(s.ctx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, "found 2 invalid queued purchase orders")
+
+	_, broken = keeper.AllInvariants(s.app.EnterpriseKeeper)(s.ctx)
+	s.Require().True(broken)
+	s.Require().ErrorContains(s.app.AssertInvariants(s.ctx), "4 invariants broken")
+}
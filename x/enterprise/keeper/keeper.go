@@ -14,10 +14,11 @@ import (
 
 // Keeper maintains the link to data storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
-	storeKey   storetypes.StoreKey // Unexposed key to access store from sdk.Context
-	bankKeeper types.BankKeeper
-	accKeeper  types.AccountKeeper
-	cdc        codec.BinaryCodec // The wire codec for binary encoding/decoding.
+	storeKey    storetypes.StoreKey // Unexposed key to access store from sdk.Context
+	bankKeeper  types.BankKeeper
+	accKeeper   types.AccountKeeper
+	groupKeeper types.GroupKeeper
+	cdc         codec.BinaryCodec // The wire codec for binary encoding/decoding.
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -25,7 +26,7 @@ type Keeper struct {
 
 // NewKeeper creates new instances of the enterprise Keeper
 func NewKeeper(storeKey storetypes.StoreKey, bankKeeper types.BankKeeper,
-	accKeeper types.AccountKeeper, cdc codec.BinaryCodec, authority string) Keeper {
+	accKeeper types.AccountKeeper, groupKeeper types.GroupKeeper, cdc codec.BinaryCodec, authority string) Keeper {
 
 	// ensure module account is set in SupplyKeeper
 	if addr := accKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -33,11 +34,12 @@ func NewKeeper(storeKey storetypes.StoreKey, bankKeeper types.BankKeeper,
 	}
 
 	return Keeper{
-		storeKey:   storeKey,
-		bankKeeper: bankKeeper,
-		accKeeper:  accKeeper,
-		cdc:        cdc,
-		authority:  authority,
+		storeKey:    storeKey,
+		bankKeeper:  bankKeeper,
+		accKeeper:   accKeeper,
+		groupKeeper: groupKeeper,
+		cdc:         cdc,
+		authority:   authority,
 	}
 }
 
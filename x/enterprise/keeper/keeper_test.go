@@ -60,13 +60,31 @@ func (s *KeeperTestSuite) TestParams() {
 			expectErr: true,
 		},
 		{
-			name: "set full valid params",
+			name: "set legacy ent signers",
 			input: types.Params{
 				EntSigners:        "und1djn9sr7vtghtarp5ccvtrc0mwg9dlzjrj7alw6,und1eq239sgefyzm4crl85nfyvt7kw83vrna3f0eed",
+				Denom:             sdk.DefaultBondDenom,
+				MinAccepts:        1,
+				DecisionTimeLimit: 2,
+			},
+			expectErr: true,
+		},
+		{
+			name: "set min accepts greater than number of signers",
+			input: types.Params{
 				Denom:             sdk.DefaultBondDenom,
 				MinAccepts:        2,
 				DecisionTimeLimit: 2,
 			},
+			expectErr: true,
+		},
+		{
+			name: "set full valid params",
+			input: types.Params{
+				Denom:             sdk.DefaultBondDenom,
+				MinAccepts:        1,
+				DecisionTimeLimit: 2,
+			},
 			expectErr: false,
 		},
 	}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+//__eFUND_LEDGER_________________________________________________________
+
+// GetHighestLedgerEntryID gets the next eFUND ledger entry ID
+func (k Keeper) GetHighestLedgerEntryID(ctx sdk.Context) (entryID uint64, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.HighestLedgerEntryIDKey)
+	if bz == nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidGenesis, "initial ledger entry ID hasn't been set")
+	}
+	return types.GetPurchaseOrderIDFromBytes(bz), nil
+}
+
+// SetHighestLedgerEntryID sets the next eFUND ledger entry ID
+func (k Keeper) SetHighestLedgerEntryID(ctx sdk.Context, entryID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.HighestLedgerEntryIDKey, types.GetPurchaseOrderIDBytes(entryID))
+}
+
+// SetLedgerEntry stores an eFUND ledger entry, keyed by its owner and timestamp
+func (k Keeper) SetLedgerEntry(ctx sdk.Context, entry types.EFUNDLedgerEntry) error {
+	owner, accErr := sdk.AccAddressFromBech32(entry.Owner)
+	if accErr != nil {
+		return accErr
+	}
+
+	if entry.Id == 0 {
+		return errorsmod.Wrap(types.ErrMissingData, "unable to set ledger entry - id cannot be zero")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LedgerEntryKey(owner, entry.Timestamp, entry.Id), k.cdc.MustMarshal(&entry))
+
+	return nil
+}
+
+// IterateLedgerEntriesByOwner iterates over an account's eFUND ledger entries, oldest first, and performs a
+// callback function
+func (k Keeper) IterateLedgerEntriesByOwner(ctx sdk.Context, owner sdk.AccAddress, cb func(entry types.EFUNDLedgerEntry) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.LedgerEntriesByOwnerPrefix(owner))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.EFUNDLedgerEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+
+		if cb(entry) {
+			break
+		}
+	}
+}
+
+// GetLedgerEntriesForAccount returns an account's eFUND ledger entries, oldest first
+func (k Keeper) GetLedgerEntriesForAccount(ctx sdk.Context, owner sdk.AccAddress) (entries []types.EFUNDLedgerEntry) {
+	k.IterateLedgerEntriesByOwner(ctx, owner, func(entry types.EFUNDLedgerEntry) bool {
+		entries = append(entries, entry)
+		return false
+	})
+	return
+}
+
+// GetAllLedgerEntries returns the eFUND ledger entries for all accounts
+func (k Keeper) GetAllLedgerEntries(ctx sdk.Context) (entries []types.EFUNDLedgerEntry) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.LedgerEntryKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.EFUNDLedgerEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		entries = append(entries, entry)
+	}
+	return
+}
+
+// recordLedgerEntry records a credit or debit of an account's locked eFUND in its ledger, along with the
+// account's balances after it was applied. Should be called once the locked and spent eFUND have been updated.
+// The counterparty is only set for transfers
+func (k Keeper) recordLedgerEntry(ctx sdk.Context, owner sdk.AccAddress, entryType types.LedgerEntryType, amount sdk.Coin, purchaseOrderID uint64, msgs []sdk.Msg, counterparty string) error {
+	entryID, err := k.GetHighestLedgerEntryID(ctx)
+	if err != nil {
+		return err
+	}
+
+	entry := types.EFUNDLedgerEntry{
+		Id:              entryID,
+		Owner:           owner.String(),
+		EntryType:       entryType,
+		Amount:          amount,
+		PurchaseOrderId: purchaseOrderID,
+		Height:          ctx.BlockHeight(),
+		Timestamp:       uint64(ctx.BlockHeader().Time.Unix()),
+		LockedBalance:   k.GetLockedUndAmountForAccount(ctx, owner),
+		SpentBalance:    k.GetSpentEFUNDAmountForAccount(ctx, owner),
+		Counterparty:    counterparty,
+	}
+
+	if len(ctx.TxBytes()) > 0 {
+		entry.TxHash = fmt.Sprintf("%X", tmhash.Sum(ctx.TxBytes()))
+	}
+
+	for _, msg := range msgs {
+		entry.MsgTypes = append(entry.MsgTypes, sdk.MsgTypeURL(msg))
+	}
+
+	err = k.SetLedgerEntry(ctx, entry)
+	if err != nil {
+		return err
+	}
+
+	k.SetHighestLedgerEntryID(ctx, entryID+1)
+
+	k.pruneLedgerEntries(ctx, owner)
+
+	return nil
+}
+
+// pruneLedgerEntries removes an account's ledger entries which are older than the LedgerRetentionPeriod param
+func (k Keeper) pruneLedgerEntries(ctx sdk.Context, owner sdk.AccAddress) {
+	retention := k.GetParams(ctx).LedgerRetentionPeriod
+	timeNow := uint64(ctx.BlockHeader().Time.Unix())
+	if retention == 0 || timeNow <= retention {
+		return
+	}
+
+	var keys [][]byte
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.LedgerEntriesByOwnerPrefix(owner), types.LedgerEntriesByOwnerTimePrefix(owner, timeNow-retention))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
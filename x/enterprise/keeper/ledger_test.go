@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	simapphelpers "github.com/unification-com/mainchain/app/helpers"
+	"github.com/unification-com/mainchain/x/enterprise/types"
+	wrkchaintypes "github.com/unification-com/mainchain/x/wrkchain/types"
+)
+
+func TestLedgerRecordsLockFeeAndExpiry(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Unix(1000, 0)).WithBlockHeight(10)
+	addr := simapphelpers.GenerateRandomTestAccounts(1)[0]
+	denom := sdk.DefaultBondDenom
+
+	expiryTime := uint64(ctx.BlockHeader().Time.Unix()) + 3600
+
+	err := app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, sdk.NewInt64Coin(denom, 1000), 7, expiryTime)
+	require.NoError(t, err)
+
+	txBytes := []byte("some tx bytes")
+	feeCtx := ctx.WithBlockHeight(11).WithBlockTime(time.Unix(1010, 0)).WithTxBytes(txBytes)
+	msgs := []sdk.Msg{&wrkchaintypes.MsgRecordWrkChainBlock{}}
+	err = app.EnterpriseKeeper.UnlockAndMintCoinsForFees(feeCtx, addr, sdk.NewCoins(sdk.NewInt64Coin(denom, 300)), msgs)
+	require.NoError(t, err)
+
+	expireCtx := ctx.WithBlockHeight(12).WithBlockTime(time.Unix(4600, 0))
+	app.EnterpriseKeeper.ExpireLockedEFUNDLots(expireCtx)
+
+	entries := app.EnterpriseKeeper.GetLedgerEntriesForAccount(ctx, addr)
+	require.Len(t, entries, 3)
+
+	require.Equal(t, types.LedgerEntryPurchaseOrder, entries[0].EntryType)
+	require.Equal(t, addr.String(), entries[0].Owner)
+	require.Equal(t, sdk.NewInt64Coin(denom, 1000), entries[0].Amount)
+	require.Equal(t, uint64(7), entries[0].PurchaseOrderId)
+	require.Equal(t, int64(10), entries[0].Height)
+	require.Equal(t, uint64(1000), entries[0].Timestamp)
+	require.Equal(t, sdk.NewInt64Coin(denom, 1000), entries[0].LockedBalance)
+	require.Equal(t, sdk.NewInt64Coin(denom, 0), entries[0].SpentBalance)
+	require.Empty(t, entries[0].TxHash)
+
+	require.Equal(t, types.LedgerEntryFee, entries[1].EntryType)
+	require.Equal(t, sdk.NewInt64Coin(denom, 300), entries[1].Amount)
+	require.Equal(t, fmt.Sprintf("%X", tmhash.Sum(txBytes)), entries[1].TxHash)
+	require.Equal(t, []string{sdk.MsgTypeURL(msgs[0])}, entries[1].MsgTypes)
+	require.Equal(t, int64(11), entries[1].Height)
+	require.Equal(t, sdk.NewInt64Coin(denom, 700), entries[1].LockedBalance)
+	require.Equal(t, sdk.NewInt64Coin(denom, 300), entries[1].SpentBalance)
+
+	require.Equal(t, types.LedgerEntryExpired, entries[2].EntryType)
+	require.Equal(t, sdk.NewInt64Coin(denom, 700), entries[2].Amount)
+	require.Equal(t, uint64(7), entries[2].PurchaseOrderId)
+	require.Equal(t, sdk.NewInt64Coin(denom, 0), entries[2].LockedBalance)
+	require.Equal(t, sdk.NewInt64Coin(denom, 300), entries[2].SpentBalance)
+
+	require.Less(t, entries[0].Id, entries[1].Id)
+	require.Less(t, entries[1].Id, entries[2].Id)
+}
+
+func TestLedgerNotRecordedWhenFeesNotPaidWithEFUND(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	addr := simapphelpers.GenerateRandomTestAccounts(1)[0]
+
+	_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), 1, 0)
+
+	// the account can't cover the fees, so nothing is unlocked
+	err := app.EnterpriseKeeper.UnlockAndMintCoinsForFees(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), nil)
+	require.NoError(t, err)
+
+	entries := app.EnterpriseKeeper.GetLedgerEntriesForAccount(ctx, addr)
+	require.Len(t, entries, 1)
+	require.Equal(t, types.LedgerEntryPurchaseOrder, entries[0].EntryType)
+}
+
+func TestLedgerRetentionPeriodPrunesOldEntries(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Unix(1000, 0))
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(2)
+	denom := sdk.DefaultBondDenom
+
+	for i := int64(0); i < 5; i++ {
+		entryCtx := ctx.WithBlockTime(time.Unix(1000+i*100, 0))
+		_ = app.EnterpriseKeeper.CreateAndLockEFUND(entryCtx, testAddrs[0], sdk.NewInt64Coin(denom, 100), uint64(i+1), 0)
+		_ = app.EnterpriseKeeper.CreateAndLockEFUND(entryCtx, testAddrs[1], sdk.NewInt64Coin(denom, 100), uint64(i+1), 0)
+	}
+
+	// kept indefinitely by default
+	require.Len(t, app.EnterpriseKeeper.GetLedgerEntriesForAccount(ctx, testAddrs[0]), 5)
+
+	params := app.EnterpriseKeeper.GetParams(ctx)
+	params.LedgerRetentionPeriod = 250
+	require.NoError(t, app.EnterpriseKeeper.SetParams(ctx, params))
+
+	// entries before 1500 - 250 are pruned when the next entry is recorded for the account
+	pruneCtx := ctx.WithBlockTime(time.Unix(1500, 0))
+	_ = app.EnterpriseKeeper.CreateAndLockEFUND(pruneCtx, testAddrs[0], sdk.NewInt64Coin(denom, 100), 6, 0)
+
+	entries := app.EnterpriseKeeper.GetLedgerEntriesForAccount(ctx, testAddrs[0])
+	require.Len(t, entries, 3)
+	require.Equal(t, uint64(1300), entries[0].Timestamp)
+	require.Equal(t, uint64(1500), entries[2].Timestamp)
+
+	// other accounts are untouched until they record an entry
+	require.Len(t, app.EnterpriseKeeper.GetLedgerEntriesForAccount(ctx, testAddrs[1]), 5)
+}
+
+func TestLedgerRecordsLockedEFUNDTransfers(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(2)
+	from, to := testAddrs[0], testAddrs[1]
+	denom := sdk.DefaultBondDenom
+
+	_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, from, sdk.NewInt64Coin(denom, 1000), 1, 0)
+
+	err := app.EnterpriseKeeper.TransferLockedEFUND(ctx, from, to, sdk.NewInt64Coin(denom, 400))
+	require.NoError(t, err)
+
+	entries := app.EnterpriseKeeper.GetLedgerEntriesForAccount(ctx, from)
+	require.Len(t, entries, 2)
+	require.Equal(t, types.LedgerEntryTransferOut, entries[1].EntryType)
+	require.Equal(t, sdk.NewInt64Coin(denom, 400), entries[1].Amount)
+	require.Equal(t, to.String(), entries[1].Counterparty)
+	require.Equal(t, sdk.NewInt64Coin(denom, 600), entries[1].LockedBalance)
+	require.Equal(t, sdk.NewInt64Coin(denom, 0), entries[1].SpentBalance)
+
+	entries = app.EnterpriseKeeper.GetLedgerEntriesForAccount(ctx, to)
+	require.Len(t, entries, 1)
+	require.Equal(t, types.LedgerEntryTransferIn, entries[0].EntryType)
+	require.Equal(t, sdk.NewInt64Coin(denom, 400), entries[0].Amount)
+	require.Equal(t, from.String(), entries[0].Counterparty)
+	require.Equal(t, sdk.NewInt64Coin(denom, 400), entries[0].LockedBalance)
+}
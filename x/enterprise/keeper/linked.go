@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+// IsLinkedAddress checks if an ent signer has linked an owner to an address it can transfer locked eFUND to
+func (k Keeper) IsLinkedAddress(ctx sdk.Context, owner, linked sdk.AccAddress) bool {
+	if owner.Empty() || linked.Empty() {
+		return false
+	}
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.LinkedAddressStoreKey(owner, linked))
+}
+
+// SetLinkedAddress adds or updates a link from an owner to an address it can transfer locked eFUND to
+func (k Keeper) SetLinkedAddress(ctx sdk.Context, link types.LinkedAddress) error {
+	owner, err := sdk.AccAddressFromBech32(link.Owner)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	linked, err := sdk.AccAddressFromBech32(link.Linked)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if owner.Equals(linked) {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot link an address to itself")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LinkedAddressStoreKey(owner, linked), k.cdc.MustMarshal(&link))
+
+	return nil
+}
+
+// RemoveLinkedAddress removes a link from an owner to an address it can transfer locked eFUND to
+func (k Keeper) RemoveLinkedAddress(ctx sdk.Context, owner, linked sdk.AccAddress) error {
+	if !k.IsLinkedAddress(ctx, owner, linked) {
+		return errorsmod.Wrapf(types.ErrAddressNotLinked, "%s is not linked to %s", linked, owner)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.LinkedAddressStoreKey(owner, linked))
+
+	return nil
+}
+
+// ProcessLinkAddressAction processes the add/remove link address messages
+func (k Keeper) ProcessLinkAddressAction(ctx sdk.Context, owner, linked sdk.AccAddress, action types.WhitelistAction) error {
+	switch action {
+	case types.WhitelistActionAdd:
+		if k.IsLinkedAddress(ctx, owner, linked) {
+			return errorsmod.Wrapf(types.ErrInvalidData, "%s is already linked to %s", linked, owner)
+		}
+		return k.SetLinkedAddress(ctx, types.LinkedAddress{
+			Owner:   owner.String(),
+			Linked:  linked.String(),
+			AddedAt: uint64(ctx.BlockHeader().Time.Unix()),
+		})
+	case types.WhitelistActionRemove:
+		return k.RemoveLinkedAddress(ctx, owner, linked)
+	default:
+		return errorsmod.Wrap(types.ErrInvalidWhitelistAction, "action should be add or remove")
+	}
+}
+
+// IterateLinkedAddressesByOwner iterates over the addresses linked to an owner and performs a callback function
+func (k Keeper) IterateLinkedAddressesByOwner(ctx sdk.Context, owner sdk.AccAddress, cb func(link types.LinkedAddress) (stop bool)) {
+	k.iterateLinkedAddresses(ctx, types.LinkedAddressesByOwnerPrefix(owner), cb)
+}
+
+// IterateLinkedAddresses iterates over all the linked addresses and performs a callback function
+func (k Keeper) IterateLinkedAddresses(ctx sdk.Context, cb func(link types.LinkedAddress) (stop bool)) {
+	k.iterateLinkedAddresses(ctx, types.LinkedAddressKeyPrefix, cb)
+}
+
+func (k Keeper) iterateLinkedAddresses(ctx sdk.Context, prefix []byte, cb func(link types.LinkedAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var link types.LinkedAddress
+		k.cdc.MustUnmarshal(iterator.Value(), &link)
+
+		if cb(link) {
+			break
+		}
+	}
+}
+
+// GetLinkedAddressesByOwner returns the addresses linked to an owner
+func (k Keeper) GetLinkedAddressesByOwner(ctx sdk.Context, owner sdk.AccAddress) (links []types.LinkedAddress) {
+	k.IterateLinkedAddressesByOwner(ctx, owner, func(link types.LinkedAddress) bool {
+		links = append(links, link)
+		return false
+	})
+	return
+}
+
+// GetAllLinkedAddresses returns all the linked addresses
+func (k Keeper) GetAllLinkedAddresses(ctx sdk.Context) (links []types.LinkedAddress) {
+	k.IterateLinkedAddresses(ctx, func(link types.LinkedAddress) bool {
+		links = append(links, link)
+		return false
+	})
+	return
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	simapphelpers "github.com/unification-com/mainchain/app/helpers"
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+func TestSetGetLinkedAddresses(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(4)
+	owner := testAddrs[0]
+
+	for _, addr := range testAddrs[1:] {
+		require.False(t, app.EnterpriseKeeper.IsLinkedAddress(ctx, owner, addr))
+
+		link := types.LinkedAddress{
+			Owner:   owner.String(),
+			Linked:  addr.String(),
+			AddedAt: uint64(ctx.BlockHeader().Time.Unix()),
+		}
+		require.NoError(t, app.EnterpriseKeeper.SetLinkedAddress(ctx, link))
+		require.True(t, app.EnterpriseKeeper.IsLinkedAddress(ctx, owner, addr))
+		require.False(t, app.EnterpriseKeeper.IsLinkedAddress(ctx, addr, owner))
+	}
+
+	// another owner's links are kept separately
+	require.NoError(t, app.EnterpriseKeeper.SetLinkedAddress(ctx, types.LinkedAddress{Owner: testAddrs[1].String(), Linked: testAddrs[2].String()}))
+
+	require.Len(t, app.EnterpriseKeeper.GetLinkedAddressesByOwner(ctx, owner), len(testAddrs)-1)
+	require.Len(t, app.EnterpriseKeeper.GetLinkedAddressesByOwner(ctx, testAddrs[1]), 1)
+	require.Len(t, app.EnterpriseKeeper.GetAllLinkedAddresses(ctx), len(testAddrs))
+
+	require.Error(t, app.EnterpriseKeeper.SetLinkedAddress(ctx, types.LinkedAddress{Owner: "rubbish", Linked: owner.String()}))
+	require.Error(t, app.EnterpriseKeeper.SetLinkedAddress(ctx, types.LinkedAddress{Owner: owner.String(), Linked: owner.String()}))
+}
+
+func TestRemoveLinkedAddress(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(2)
+	owner := testAddrs[0]
+	linked := testAddrs[1]
+
+	err := app.EnterpriseKeeper.RemoveLinkedAddress(ctx, owner, linked)
+	require.ErrorIs(t, err, types.ErrAddressNotLinked)
+
+	require.NoError(t, app.EnterpriseKeeper.ProcessLinkAddressAction(ctx, owner, linked, types.WhitelistActionAdd))
+	require.True(t, app.EnterpriseKeeper.IsLinkedAddress(ctx, owner, linked))
+
+	require.NoError(t, app.EnterpriseKeeper.ProcessLinkAddressAction(ctx, owner, linked, types.WhitelistActionRemove))
+	require.False(t, app.EnterpriseKeeper.IsLinkedAddress(ctx, owner, linked))
+	require.Empty(t, app.EnterpriseKeeper.GetLinkedAddressesByOwner(ctx, owner))
+}
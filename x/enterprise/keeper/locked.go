@@ -139,8 +139,9 @@ func (k Keeper) GetAllSpentEFUNDs(ctx sdk.Context) (spentEFUNDs []types.SpentEFU
 
 // CreateAndLockEFUND creates and locks eFUND
 // CreateAndLockEFUND to be used in BeginBlocker.
-// Minting will be handled in UnlockCoinsForFees
-func (k Keeper) CreateAndLockEFUND(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coin) error {
+// Minting will be handled in UnlockCoinsForFees. The locked eFUND is tracked in a new lot
+// for the purchase order, which expires at expiryTime. 0 if it does not expire
+func (k Keeper) CreateAndLockEFUND(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coin, purchaseOrderID, expiryTime uint64) error {
 	if amount.Amount.IsZero() {
 		// skip as no coins need to be minted
 		return nil
@@ -151,12 +152,17 @@ func (k Keeper) CreateAndLockEFUND(ctx sdk.Context, recipient sdk.AccAddress, am
 		return err
 	}
 
-	return nil
+	err = k.createLockedEFUNDLot(ctx, recipient, amount, purchaseOrderID, expiryTime)
+	if err != nil {
+		return err
+	}
+
+	return k.recordLedgerEntry(ctx, recipient, types.LedgerEntryPurchaseOrder, amount, purchaseOrderID, nil, "")
 }
 
 // UnlockAndMintCoinsForFees unlocks any locked eFUND and mints them in the bank keeper
-// to pay for fees
-func (k Keeper) UnlockAndMintCoinsForFees(ctx sdk.Context, feePayer sdk.AccAddress, feesToPay sdk.Coins) error {
+// to pay for fees. The debit is recorded in the fee payer's ledger along with the Tx's msgs
+func (k Keeper) UnlockAndMintCoinsForFees(ctx sdk.Context, feePayer sdk.AccAddress, feesToPay sdk.Coins, msgs []sdk.Msg) error {
 
 	logger := k.Logger(ctx)
 	lockedUnd := k.GetLockedUndForAccount(ctx, feePayer).Amount
@@ -171,14 +177,15 @@ func (k Keeper) UnlockAndMintCoinsForFees(ctx sdk.Context, feePayer sdk.AccAddre
 
 	if !hasNeg {
 		// locked FUND >= total fees
-		// mint the fee amount to allow for payment
-		err := k.bankKeeper.MintCoins(ctx, types.ModuleName, feesToPay)
+		// mint the fee amount to allow for payment. Only the eFUND denomination is minted, since only
+		// that amount is debited from the locked eFUND
+		err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(feeNundCoin))
 		if err != nil {
 			return err
 		}
 
 		// Send them to the purchaser's account
-		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, feePayer, feesToPay)
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, feePayer, sdk.NewCoins(feeNundCoin))
 		if err != nil {
 			return err
 		}
@@ -195,6 +202,11 @@ func (k Keeper) UnlockAndMintCoinsForFees(ctx sdk.Context, feePayer sdk.AccAddre
 			return err
 		}
 
+		err = k.recordLedgerEntry(ctx, feePayer, types.LedgerEntryFee, feeNundCoin, 0, msgs, "")
+		if err != nil {
+			return err
+		}
+
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeUndUnlocked,
@@ -247,6 +259,11 @@ func (k Keeper) UnlockAndMintCoinsForFees(ctx sdk.Context, feePayer sdk.AccAddre
 				return err
 			}
 
+			err = k.recordLedgerEntry(ctx, feePayer, types.LedgerEntryFee, lockedUnd, 0, msgs, "")
+			if err != nil {
+				return err
+			}
+
 			ctx.EventManager().EmitEvent(
 				sdk.NewEvent(
 					types.EventTypeUndUnlocked,
@@ -263,6 +280,51 @@ func (k Keeper) UnlockAndMintCoinsForFees(ctx sdk.Context, feePayer sdk.AccAddre
 	return nil
 }
 
+// TransferLockedEFUND moves locked eFUND from one account to another. Nothing is minted or unlocked, so the
+// total locked eFUND is unchanged. The sender's lots are consumed oldest first, and the recipient receives
+// matching lots which keep their original expiry times. Any of the sender's locked eFUND not held in lots
+// is also moved without a lot.
+func (k Keeper) TransferLockedEFUND(ctx sdk.Context, from, to sdk.AccAddress, amount sdk.Coin) error {
+	if amount.Denom != k.GetParamDenom(ctx) {
+		return errorsmod.Wrapf(types.ErrInvalidDenomination, "denomination must be %s", k.GetParamDenom(ctx))
+	}
+
+	fromLocked := k.GetLockedUndForAccount(ctx, from)
+	if fromLocked.Amount.IsLT(amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientLockedEFUND, "%s has %s locked, cannot transfer %s", from, fromLocked.Amount, amount)
+	}
+
+	portions, err := k.consumeLockedEFUNDLots(ctx, from, fromLocked.Amount, amount)
+	if err != nil {
+		return err
+	}
+
+	err = k.transferLockedEFUNDLots(ctx, to, portions)
+	if err != nil {
+		return err
+	}
+
+	fromLocked.Amount = fromLocked.Amount.Sub(amount)
+	err = k.SetLockedUndForAccount(ctx, fromLocked)
+	if err != nil {
+		return err
+	}
+
+	toLocked := k.GetLockedUndForAccount(ctx, to)
+	toLocked.Amount = toLocked.Amount.Add(amount)
+	err = k.SetLockedUndForAccount(ctx, toLocked)
+	if err != nil {
+		return err
+	}
+
+	err = k.recordLedgerEntry(ctx, from, types.LedgerEntryTransferOut, amount, 0, nil, to.String())
+	if err != nil {
+		return err
+	}
+
+	return k.recordLedgerEntry(ctx, to, types.LedgerEntryTransferIn, amount, 0, nil, from.String())
+}
+
 //__LOCKED_FUND__________________________________________________________
 
 // Check if a record exists for locked FUND given an account address
@@ -379,8 +441,18 @@ func (k Keeper) incrementLockedUnd(ctx sdk.Context, address sdk.AccAddress, amou
 	return nil
 }
 
-// decrementLockedUnd decrements the amount of locked FUND - used when purchase order is accepted
+// decrementLockedUnd decrements the amount of locked FUND, consuming the account's lots oldest first
 func (k Keeper) decrementLockedUnd(ctx sdk.Context, address sdk.AccAddress, amount sdk.Coin) error {
+	_, err := k.consumeLockedEFUNDLots(ctx, address, k.GetLockedUndAmountForAccount(ctx, address), amount)
+	if err != nil {
+		return err
+	}
+
+	return k.reduceLockedUnd(ctx, address, amount)
+}
+
+// reduceLockedUnd reduces the amount of locked FUND for the account, and in total
+func (k Keeper) reduceLockedUnd(ctx sdk.Context, address sdk.AccAddress, amount sdk.Coin) error {
 
 	lockedUnd := k.GetLockedUndForAccount(ctx, address)
 	lockedCoins := sdk.NewCoins(lockedUnd.Amount)
@@ -157,7 +157,7 @@ func TestCreateAndLockEFUND(t *testing.T) {
 
 		toCreate := sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)
 
-		err := app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, toCreate)
+		err := app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, toCreate, 0, 0)
 		require.NoError(t, err)
 
 		isLocked := app.EnterpriseKeeper.IsLocked(ctx, addr)
@@ -200,9 +200,9 @@ func TestUnlockAndMintCoinsForFees(t *testing.T) {
 		expBalanceAfter := balanceBefore.Add(toUnlock)
 		expTotalSupply = expTotalSupply.Add(toUnlock)
 
-		_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, toMint)
+		_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, toMint, 0, 0)
 
-		err := app.EnterpriseKeeper.UnlockAndMintCoinsForFees(ctx, addr, toUnlockCoins)
+		err := app.EnterpriseKeeper.UnlockAndMintCoinsForFees(ctx, addr, toUnlockCoins, nil)
 		require.NoError(t, err)
 
 		totalAmount = totalAmount - amountToUnlock
@@ -227,6 +227,22 @@ func TestUnlockAndMintCoinsForFees(t *testing.T) {
 
 }
 
+func TestUnlockAndMintCoinsForFeesOnlyMintsEFUNDDenom(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	addr := simapphelpers.GenerateRandomTestAccounts(1)[0]
+	_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), 0, 0)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), sdk.NewInt64Coin("otherdenom", 5000))
+	err := app.EnterpriseKeeper.UnlockAndMintCoinsForFees(ctx, addr, fees, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), app.BankKeeper.GetBalance(ctx, addr, sdk.DefaultBondDenom))
+	require.True(t, app.BankKeeper.GetBalance(ctx, addr, "otherdenom").IsZero())
+	require.True(t, app.BankKeeper.GetSupply(ctx, "otherdenom").IsZero())
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 900), app.EnterpriseKeeper.GetLockedUndForAccount(ctx, addr).Amount)
+}
 func TestUnlockCoinsForFeesAndUsedCounter(t *testing.T) {
 	app := simapphelpers.Setup(t)
 	ctx := app.BaseApp.NewContext(false)
@@ -244,9 +260,9 @@ func TestUnlockCoinsForFeesAndUsedCounter(t *testing.T) {
 		toUnlockCoins := sdk.NewCoins(toUnlock)
 		totalUsed = totalUsed + amountToUnlock
 
-		_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, toMint)
+		_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, toMint, 0, 0)
 
-		err := app.EnterpriseKeeper.UnlockAndMintCoinsForFees(ctx, addr, toUnlockCoins)
+		err := app.EnterpriseKeeper.UnlockAndMintCoinsForFees(ctx, addr, toUnlockCoins, nil)
 		require.NoError(t, err)
 
 		usedDb := app.EnterpriseKeeper.GetSpentEFUNDForAccount(ctx, addr)
@@ -279,9 +295,9 @@ func TestUnlockCoinsForFeesAndUsedCounterWithHalfFunds(t *testing.T) {
 		fee := sdk.NewInt64Coin(sdk.DefaultBondDenom, feeToPay)
 		feeCoins := sdk.NewCoins(fee)
 
-		_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, toMint)
+		_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, toMint, 0, 0)
 
-		err := app.EnterpriseKeeper.UnlockAndMintCoinsForFees(ctx, addr, feeCoins)
+		err := app.EnterpriseKeeper.UnlockAndMintCoinsForFees(ctx, addr, feeCoins, nil)
 		require.NoError(t, err)
 
 		usedDb := app.EnterpriseKeeper.GetSpentEFUNDForAccount(ctx, addr)
@@ -0,0 +1,313 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+//__LOCKED_eFUND_LOTS____________________________________________________
+
+// GetHighestLockedEFUNDLotID gets the next locked eFUND lot ID
+func (k Keeper) GetHighestLockedEFUNDLotID(ctx sdk.Context) (lotID uint64, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.HighestLockedEFUNDLotIDKey)
+	if bz == nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidGenesis, "initial locked eFUND lot ID hasn't been set")
+	}
+	return types.GetPurchaseOrderIDFromBytes(bz), nil
+}
+
+// SetHighestLockedEFUNDLotID sets the next locked eFUND lot ID
+func (k Keeper) SetHighestLockedEFUNDLotID(ctx sdk.Context, lotID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.HighestLockedEFUNDLotIDKey, types.GetPurchaseOrderIDBytes(lotID))
+}
+
+// GetLockedEFUNDLot returns a locked eFUND lot given its ID
+func (k Keeper) GetLockedEFUNDLot(ctx sdk.Context, lotID uint64) (types.LockedEFUNDLot, bool) {
+	var lot types.LockedEFUNDLot
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LockedEFUNDLotKey(lotID))
+	if bz == nil {
+		return lot, false
+	}
+	k.cdc.MustUnmarshal(bz, &lot)
+	return lot, true
+}
+
+// SetLockedEFUNDLot stores a locked eFUND lot, and indexes it by owner and expiry time
+func (k Keeper) SetLockedEFUNDLot(ctx sdk.Context, lot types.LockedEFUNDLot) error {
+	owner, accErr := sdk.AccAddressFromBech32(lot.Owner)
+	if accErr != nil {
+		return accErr
+	}
+
+	if lot.Id == 0 {
+		return errorsmod.Wrap(types.ErrMissingData, "unable to set locked eFUND lot - id cannot be zero")
+	}
+
+	if lot.Amount.IsNegative() {
+		return errorsmod.Wrap(types.ErrMissingData, "unable to set locked eFUND lot - amount must be positive")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LockedEFUNDLotKey(lot.Id), k.cdc.MustMarshal(&lot))
+	store.Set(types.LockedEFUNDLotOwnerKey(owner, lot.Id), []byte{})
+	if lot.ExpiryTime > 0 {
+		store.Set(types.LockedEFUNDLotExpiryQueueKey(lot.ExpiryTime, lot.Id), []byte{})
+	}
+
+	return nil
+}
+
+// DeleteLockedEFUNDLot removes a locked eFUND lot and its indexes
+func (k Keeper) DeleteLockedEFUNDLot(ctx sdk.Context, lot types.LockedEFUNDLot) {
+	owner := sdk.MustAccAddressFromBech32(lot.Owner)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.LockedEFUNDLotKey(lot.Id))
+	store.Delete(types.LockedEFUNDLotOwnerKey(owner, lot.Id))
+	if lot.ExpiryTime > 0 {
+		store.Delete(types.LockedEFUNDLotExpiryQueueKey(lot.ExpiryTime, lot.Id))
+	}
+}
+
+// IterateLockedEFUNDLotsByOwner iterates over an account's locked eFUND lots, oldest first, and performs a
+// callback function
+func (k Keeper) IterateLockedEFUNDLotsByOwner(ctx sdk.Context, owner sdk.AccAddress, cb func(lot types.LockedEFUNDLot) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.LockedEFUNDLotsByOwnerPrefix(owner))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		lot, found := k.GetLockedEFUNDLot(ctx, types.SplitLockedEFUNDLotOwnerKey(iterator.Key()))
+		if !found {
+			continue
+		}
+
+		if cb(lot) {
+			break
+		}
+	}
+}
+
+// GetLockedEFUNDLotsForAccount returns an account's locked eFUND lots, oldest first
+func (k Keeper) GetLockedEFUNDLotsForAccount(ctx sdk.Context, owner sdk.AccAddress) (lots types.LockedEFUNDLots) {
+	k.IterateLockedEFUNDLotsByOwner(ctx, owner, func(lot types.LockedEFUNDLot) bool {
+		lots = append(lots, lot)
+		return false
+	})
+	return
+}
+
+// GetAllLockedEFUNDLots returns all locked eFUND lots
+func (k Keeper) GetAllLockedEFUNDLots(ctx sdk.Context) (lots types.LockedEFUNDLots) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.LockedEFUNDLotKeyPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var lot types.LockedEFUNDLot
+		k.cdc.MustUnmarshal(iterator.Value(), &lot)
+		lots = append(lots, lot)
+	}
+	return
+}
+
+// getEFUNDExpiryTime returns the expiry time for the eFUND locked by a purchase order accepted in the current
+// block, using the EfundExpiryPeriod param. 0 if locked eFUND does not expire
+func (k Keeper) getEFUNDExpiryTime(ctx sdk.Context) uint64 {
+	expiryPeriod := k.GetParams(ctx).EfundExpiryPeriod
+	if expiryPeriod == 0 {
+		return 0
+	}
+	return uint64(ctx.BlockHeader().Time.Unix()) + expiryPeriod
+}
+
+// createLockedEFUNDLot adds a new lot for eFUND locked by an accepted purchase order. Its expiry time is
+// the one recorded on the purchase order when it was accepted
+func (k Keeper) createLockedEFUNDLot(ctx sdk.Context, owner sdk.AccAddress, amount sdk.Coin, purchaseOrderID, expiryTime uint64) error {
+	lotID, err := k.GetHighestLockedEFUNDLotID(ctx)
+	if err != nil {
+		return err
+	}
+
+	lockedAt := uint64(ctx.BlockHeader().Time.Unix())
+
+	lot := types.LockedEFUNDLot{
+		Id:              lotID,
+		Owner:           owner.String(),
+		PurchaseOrderId: purchaseOrderID,
+		Amount:          amount,
+		LockedAt:        lockedAt,
+		ExpiryTime:      expiryTime,
+	}
+
+	err = k.SetLockedEFUNDLot(ctx, lot)
+	if err != nil {
+		return err
+	}
+
+	k.SetHighestLockedEFUNDLotID(ctx, lotID+1)
+
+	return nil
+}
+
+// consumeLockedEFUNDLots reduces an account's lots by the given amount, oldest first. Any of the account's
+// locked eFUND not held in lots predates them, so is consumed first. The portion taken from each lot is
+// returned, oldest first.
+func (k Keeper) consumeLockedEFUNDLots(ctx sdk.Context, owner sdk.AccAddress, locked sdk.Coin, amount sdk.Coin) (consumed types.LockedEFUNDLots, err error) {
+	lots := k.GetLockedEFUNDLotsForAccount(ctx, owner)
+
+	inLots := sdk.NewInt64Coin(locked.Denom, 0)
+	for _, lot := range lots {
+		inLots = inLots.Add(lot.Amount)
+	}
+
+	toConsume := amount.Amount
+	if locked.Amount.GT(inLots.Amount) {
+		toConsume = toConsume.Sub(locked.Amount.Sub(inLots.Amount))
+	}
+
+	for _, lot := range lots {
+		if !toConsume.IsPositive() {
+			break
+		}
+
+		if lot.Amount.Amount.LTE(toConsume) {
+			toConsume = toConsume.Sub(lot.Amount.Amount)
+			k.DeleteLockedEFUNDLot(ctx, lot)
+			consumed = append(consumed, lot)
+			continue
+		}
+
+		portion := lot
+		portion.Amount = sdk.NewCoin(lot.Amount.Denom, toConsume)
+		consumed = append(consumed, portion)
+
+		lot.Amount.Amount = lot.Amount.Amount.Sub(toConsume)
+		toConsume = toConsume.Sub(toConsume)
+		err = k.SetLockedEFUNDLot(ctx, lot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return consumed, nil
+}
+
+// transferLockedEFUNDLots creates lots for the recipient from portions consumed from another account's lots.
+// Each keeps the purchase order, lock time and expiry time of the lot it was taken from
+func (k Keeper) transferLockedEFUNDLots(ctx sdk.Context, to sdk.AccAddress, portions types.LockedEFUNDLots) error {
+	for _, portion := range portions {
+		lotID, err := k.GetHighestLockedEFUNDLotID(ctx)
+		if err != nil {
+			return err
+		}
+
+		lot := types.LockedEFUNDLot{
+			Id:              lotID,
+			Owner:           to.String(),
+			PurchaseOrderId: portion.PurchaseOrderId,
+			Amount:          portion.Amount,
+			LockedAt:        portion.LockedAt,
+			ExpiryTime:      portion.ExpiryTime,
+		}
+
+		err = k.SetLockedEFUNDLot(ctx, lot)
+		if err != nil {
+			return err
+		}
+
+		k.SetHighestLockedEFUNDLotID(ctx, lotID+1)
+	}
+
+	return nil
+}
+
+// ExpireLockedEFUNDLots removes the remaining amount of lots which have expired from their owner's locked
+// eFUND, and from the total locked eFUND. Locked eFUND has not yet been minted, so is effectively burned.
+// At most types.MaxLockedEFUNDLotExpiriesPerBlock lots are expired per block. Each lot is expired in its own
+// cached context, so a lot which fails to expire cannot halt the chain or affect any other lot. A failed lot is
+// removed from the expiry queue so that it does not block the queue.
+func (k Keeper) ExpireLockedEFUNDLots(ctx sdk.Context) {
+	logger := k.Logger(ctx)
+	timeNow := uint64(ctx.BlockHeader().Time.Unix())
+
+	// collect first - expiring modifies the queue
+	var queueKeys [][]byte
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.LockedEFUNDLotExpiryQueuePrefix, types.LockedEFUNDLotExpiryQueueByTimePrefix(timeNow+1))
+	for ; iterator.Valid() && len(queueKeys) < types.MaxLockedEFUNDLotExpiriesPerBlock; iterator.Next() {
+		queueKeys = append(queueKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, queueKey := range queueKeys {
+		lotID := types.SplitLockedEFUNDLotExpiryQueueKey(queueKey)
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		err := k.expireLockedEFUNDLot(cacheCtx, lotID)
+		if err != nil {
+			store.Delete(queueKey)
+
+			logger.Error("failed to expire locked eFUND lot", "lotid", lotID, "err", err)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeLockedEFUNDLotExpiryFailed,
+					sdk.NewAttribute(types.AttributeKeyLotID, strconv.FormatUint(lotID, 10)),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			continue
+		}
+
+		writeCache()
+	}
+}
+
+// expireLockedEFUNDLot removes the remaining amount of an expired lot from its owner's locked eFUND
+func (k Keeper) expireLockedEFUNDLot(ctx sdk.Context, lotID uint64) error {
+	lot, found := k.GetLockedEFUNDLot(ctx, lotID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrMissingData, "locked eFUND lot %d not found", lotID)
+	}
+
+	owner, err := sdk.AccAddressFromBech32(lot.Owner)
+	if err != nil {
+		return err
+	}
+
+	k.DeleteLockedEFUNDLot(ctx, lot)
+
+	err = k.reduceLockedUnd(ctx, owner, lot.Amount)
+	if err != nil {
+		return err
+	}
+
+	err = k.recordLedgerEntry(ctx, owner, types.LedgerEntryExpired, lot.Amount, lot.PurchaseOrderId, nil, "")
+	if err != nil {
+		return err
+	}
+
+	if !ctx.IsCheckTx() {
+		k.Logger(ctx).Debug("locked eFUND lot expired", "lotid", lot.Id, "owner", lot.Owner, "amt", lot.Amount.String())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLockedEFUNDLotExpired,
+			sdk.NewAttribute(types.AttributeKeyLotID, strconv.FormatUint(lot.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, lot.Owner),
+			sdk.NewAttribute(types.AttributeKeyPurchaseOrderID, strconv.FormatUint(lot.PurchaseOrderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyAmount, lot.Amount.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,288 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	simapphelpers "github.com/unification-com/mainchain/app/helpers"
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+func TestCreateAndLockEFUNDCreatesLots(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	addr := simapphelpers.GenerateRandomTestAccounts(1)[0]
+
+	// no expiry
+	err := app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), 1, 0)
+	require.NoError(t, err)
+
+	expiryTime := uint64(ctx.BlockHeader().Time.Unix()) + 3600
+	err = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000), 2, expiryTime)
+	require.NoError(t, err)
+
+	lots := app.EnterpriseKeeper.GetLockedEFUNDLotsForAccount(ctx, addr)
+	require.Len(t, lots, 2)
+
+	now := uint64(ctx.BlockHeader().Time.Unix())
+	require.Equal(t, uint64(1), lots[0].PurchaseOrderId)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), lots[0].Amount)
+	require.Equal(t, now, lots[0].LockedAt)
+	require.Equal(t, uint64(0), lots[0].ExpiryTime)
+
+	require.Equal(t, uint64(2), lots[1].PurchaseOrderId)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000), lots[1].Amount)
+	require.Equal(t, now+3600, lots[1].ExpiryTime)
+	require.Greater(t, lots[1].Id, lots[0].Id)
+
+	require.Len(t, app.EnterpriseKeeper.GetAllLockedEFUNDLots(ctx), 2)
+}
+
+func TestUnlockAndMintCoinsForFeesConsumesLotsOldestFirst(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	addr := simapphelpers.GenerateRandomTestAccounts(1)[0]
+
+	expiryTime := uint64(ctx.BlockHeader().Time.Unix()) + 3600
+
+	_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), 1, expiryTime)
+	_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), 2, expiryTime)
+
+	err := app.EnterpriseKeeper.UnlockAndMintCoinsForFees(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600)), nil)
+	require.NoError(t, err)
+
+	lots := app.EnterpriseKeeper.GetLockedEFUNDLotsForAccount(ctx, addr)
+	require.Len(t, lots, 2)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 400), lots[0].Amount)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), lots[1].Amount)
+
+	// spans both lots. The first is used up and removed
+	err = app.EnterpriseKeeper.UnlockAndMintCoinsForFees(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 700)), nil)
+	require.NoError(t, err)
+
+	lots = app.EnterpriseKeeper.GetLockedEFUNDLotsForAccount(ctx, addr)
+	require.Len(t, lots, 1)
+	require.Equal(t, uint64(2), lots[0].PurchaseOrderId)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 700), lots[0].Amount)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 700), app.EnterpriseKeeper.GetLockedUndAmountForAccount(ctx, addr))
+}
+
+func TestUnlockAndMintCoinsForFeesConsumesUnlottedFirst(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	addr := simapphelpers.GenerateRandomTestAccounts(1)[0]
+
+	// locked eFUND without a lot predates lots
+	err := app.EnterpriseKeeper.SetLockedUndForAccount(ctx, types.LockedUnd{
+		Owner:  addr.String(),
+		Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 500),
+	})
+	require.NoError(t, err)
+	_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), 1, 0)
+
+	err = app.EnterpriseKeeper.UnlockAndMintCoinsForFees(ctx, addr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 700)), nil)
+	require.NoError(t, err)
+
+	lots := app.EnterpriseKeeper.GetLockedEFUNDLotsForAccount(ctx, addr)
+	require.Len(t, lots, 1)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 800), lots[0].Amount)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 800), app.EnterpriseKeeper.GetLockedUndAmountForAccount(ctx, addr))
+}
+
+func TestExpireLockedEFUNDLots(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(2)
+
+	totalLockedBefore := app.EnterpriseKeeper.GetTotalLockedUnd(ctx)
+
+	_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, testAddrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), 1, 0)
+	expiryTime := uint64(ctx.BlockHeader().Time.Unix()) + 3600
+	_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, testAddrs[0], sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000), 2, expiryTime)
+	_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, testAddrs[1], sdk.NewInt64Coin(sdk.DefaultBondDenom, 3000), 3, expiryTime)
+
+	// partially spend an expiring lot
+	err := app.EnterpriseKeeper.UnlockAndMintCoinsForFees(ctx, testAddrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)), nil)
+	require.NoError(t, err)
+
+	// not yet expired
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(3599 * time.Second))
+	app.EnterpriseKeeper.ExpireLockedEFUNDLots(ctx)
+	require.Len(t, app.EnterpriseKeeper.GetAllLockedEFUNDLots(ctx), 3)
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Second))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	app.EnterpriseKeeper.ExpireLockedEFUNDLots(ctx)
+
+	lots := app.EnterpriseKeeper.GetAllLockedEFUNDLots(ctx)
+	require.Len(t, lots, 1)
+	require.Equal(t, uint64(1), lots[0].PurchaseOrderId)
+
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), app.EnterpriseKeeper.GetLockedUndAmountForAccount(ctx, testAddrs[0]))
+	require.True(t, app.EnterpriseKeeper.GetLockedUndAmountForAccount(ctx, testAddrs[1]).IsZero())
+	require.Equal(t, totalLockedBefore.AddAmount(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000).Amount), app.EnterpriseKeeper.GetTotalLockedUnd(ctx))
+
+	numExpired := 0
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == types.EventTypeLockedEFUNDLotExpired {
+			numExpired++
+		}
+	}
+	require.Equal(t, 2, numExpired)
+}
+
+func TestTransferLockedEFUNDMovesLotsOldestFirst(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(2)
+	from, to := testAddrs[0], testAddrs[1]
+
+	// locked eFUND without a lot predates lots
+	err := app.EnterpriseKeeper.SetLockedUndForAccount(ctx, types.LockedUnd{
+		Owner:  from.String(),
+		Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 200),
+	})
+	require.NoError(t, err)
+
+	expiryTime := uint64(ctx.BlockHeader().Time.Unix()) + 3600
+	_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, from, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), 1, expiryTime)
+	_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, from, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), 2, expiryTime)
+
+	totalLockedBefore := app.EnterpriseKeeper.GetTotalLockedUnd(ctx)
+	senderLots := app.EnterpriseKeeper.GetLockedEFUNDLotsForAccount(ctx, from)
+
+	err = app.EnterpriseKeeper.TransferLockedEFUND(ctx, from, to, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1500))
+	require.NoError(t, err)
+
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 700), app.EnterpriseKeeper.GetLockedUndAmountForAccount(ctx, from))
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1500), app.EnterpriseKeeper.GetLockedUndAmountForAccount(ctx, to))
+	require.Equal(t, totalLockedBefore, app.EnterpriseKeeper.GetTotalLockedUnd(ctx))
+
+	// the unlotted eFUND is moved first, then the oldest lot
+	lots := app.EnterpriseKeeper.GetLockedEFUNDLotsForAccount(ctx, from)
+	require.Len(t, lots, 1)
+	require.Equal(t, uint64(2), lots[0].PurchaseOrderId)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 700), lots[0].Amount)
+
+	// the recipient's lots keep the purchase order and expiry they came from
+	lots = app.EnterpriseKeeper.GetLockedEFUNDLotsForAccount(ctx, to)
+	require.Len(t, lots, 2)
+	require.Equal(t, uint64(1), lots[0].PurchaseOrderId)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), lots[0].Amount)
+	require.Equal(t, senderLots[0].ExpiryTime, lots[0].ExpiryTime)
+	require.Equal(t, senderLots[0].LockedAt, lots[0].LockedAt)
+	require.Equal(t, uint64(2), lots[1].PurchaseOrderId)
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 300), lots[1].Amount)
+	require.Equal(t, senderLots[1].ExpiryTime, lots[1].ExpiryTime)
+
+	// the unlotted portion stays unlotted
+	require.Len(t, app.EnterpriseKeeper.GetAllLockedEFUNDLots(ctx), 3)
+
+	err = app.EnterpriseKeeper.TransferLockedEFUND(ctx, from, to, sdk.NewInt64Coin(sdk.DefaultBondDenom, 701))
+	require.ErrorIs(t, err, types.ErrInsufficientLockedEFUND)
+}
+
+func TestExpireLockedEFUNDLotsFailureIsolated(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(3)
+
+	expiryTime := uint64(ctx.BlockHeader().Time.Unix()) + 3600
+	for i, addr := range testAddrs {
+		_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), uint64(i+1), expiryTime)
+	}
+
+	// corrupt the second lot's owner, so it cannot be expired
+	badLot := app.EnterpriseKeeper.GetLockedEFUNDLotsForAccount(ctx, testAddrs[1])[0]
+	badLot.Owner = "not an address"
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	store.Set(types.LockedEFUNDLotKey(badLot.Id), app.AppCodec().MustMarshal(&badLot))
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour)).WithEventManager(sdk.NewEventManager())
+	app.EnterpriseKeeper.ExpireLockedEFUNDLots(ctx)
+
+	require.True(t, app.EnterpriseKeeper.GetLockedUndAmountForAccount(ctx, testAddrs[0]).IsZero())
+	require.True(t, app.EnterpriseKeeper.GetLockedUndAmountForAccount(ctx, testAddrs[2]).IsZero())
+
+	// the failed lot is untouched, but no longer blocks the queue
+	require.Equal(t, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), app.EnterpriseKeeper.GetLockedUndAmountForAccount(ctx, testAddrs[1]))
+	lot, found := app.EnterpriseKeeper.GetLockedEFUNDLot(ctx, badLot.Id)
+	require.True(t, found)
+	require.Equal(t, badLot, lot)
+
+	numExpired, numFailed := 0, 0
+	for _, ev := range ctx.EventManager().Events() {
+		switch ev.Type {
+		case types.EventTypeLockedEFUNDLotExpired:
+			numExpired++
+		case types.EventTypeLockedEFUNDLotExpiryFailed:
+			numFailed++
+		}
+	}
+	require.Equal(t, 2, numExpired)
+	require.Equal(t, 1, numFailed)
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Second)).WithEventManager(sdk.NewEventManager())
+	app.EnterpriseKeeper.ExpireLockedEFUNDLots(ctx)
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+func TestExpireLockedEFUNDLotsPerBlockLimit(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	addr := simapphelpers.GenerateRandomTestAccounts(1)[0]
+
+	numLots := types.MaxLockedEFUNDLotExpiriesPerBlock + 10
+	expiryTime := uint64(ctx.BlockHeader().Time.Unix()) + 3600
+	for i := 0; i < numLots; i++ {
+		_ = app.EnterpriseKeeper.CreateAndLockEFUND(ctx, addr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), uint64(i+1), expiryTime)
+	}
+
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour))
+	app.EnterpriseKeeper.ExpireLockedEFUNDLots(ctx)
+	require.Len(t, app.EnterpriseKeeper.GetAllLockedEFUNDLots(ctx), 10)
+
+	// the remainder are expired in the next block
+	app.EnterpriseKeeper.ExpireLockedEFUNDLots(ctx)
+	require.Empty(t, app.EnterpriseKeeper.GetAllLockedEFUNDLots(ctx))
+	require.True(t, app.EnterpriseKeeper.GetLockedUndAmountForAccount(ctx, addr).IsZero())
+}
+
+func TestLockedEFUNDLotExpiryRecordedOnAcceptedPurchaseOrder(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	addr := simapphelpers.GenerateRandomTestAccounts(1)[0]
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+
+	params := app.EnterpriseKeeper.GetParams(ctx)
+	params.EfundExpiryPeriod = 3600
+	require.NoError(t, app.EnterpriseKeeper.SetParams(ctx, params))
+
+	poID, err := app.EnterpriseKeeper.RaiseNewPurchaseOrder(ctx, types.EnterpriseUndPurchaseOrder{Purchaser: addr.String(), Amount: amount})
+	require.NoError(t, err)
+	rejectedID, err := app.EnterpriseKeeper.RaiseNewPurchaseOrder(ctx, types.EnterpriseUndPurchaseOrder{Purchaser: addr.String(), Amount: amount})
+	require.NoError(t, err)
+
+	acceptedAt := uint64(ctx.BlockHeader().Time.Unix())
+	require.NoError(t, app.EnterpriseKeeper.FinalisePurchaseOrderDecision(ctx, poID, types.StatusAccepted))
+	require.NoError(t, app.EnterpriseKeeper.FinalisePurchaseOrderDecision(ctx, rejectedID, types.StatusRejected))
+
+	po, _ := app.EnterpriseKeeper.GetPurchaseOrder(ctx, poID)
+	require.Equal(t, acceptedAt+3600, po.EfundExpiryTime)
+	po, _ = app.EnterpriseKeeper.GetPurchaseOrder(ctx, rejectedID)
+	require.Equal(t, uint64(0), po.EfundExpiryTime)
+
+	// changing the param, or processing in a later block, does not change the expiry already recorded
+	params.EfundExpiryPeriod = 60
+	require.NoError(t, app.EnterpriseKeeper.SetParams(ctx, params))
+	ctx = ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Minute))
+	require.NoError(t, app.EnterpriseKeeper.ProcessAcceptedPurchaseOrders(ctx))
+
+	lots := app.EnterpriseKeeper.GetLockedEFUNDLotsForAccount(ctx, addr)
+	require.Len(t, lots, 1)
+	require.Equal(t, poID, lots[0].PurchaseOrderId)
+	require.Equal(t, acceptedAt+3600, lots[0].ExpiryTime)
+}
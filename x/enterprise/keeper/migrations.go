@@ -3,6 +3,13 @@ package keeper
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	v10 "github.com/unification-com/mainchain/x/enterprise/migrations/v10"
+	v5 "github.com/unification-com/mainchain/x/enterprise/migrations/v5"
+	v6 "github.com/unification-com/mainchain/x/enterprise/migrations/v6"
+	v7 "github.com/unification-com/mainchain/x/enterprise/migrations/v7"
+	v8 "github.com/unification-com/mainchain/x/enterprise/migrations/v8"
+	v9 "github.com/unification-com/mainchain/x/enterprise/migrations/v9"
+
 	"github.com/unification-com/mainchain/x/enterprise/types"
 )
 
@@ -38,3 +45,40 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 	ctx.Logger().Info("Migrating Enterprise Module")
 	return nil
 }
+
+// Migrate4to5 migrates the x/enterprise module state from the consensus version 4 to
+// version 5. Specifically, it moves the ent signers from the EntSigners param into the
+// signer registry.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
+// Migrate5to6 migrates the x/enterprise module state from the consensus version 5 to
+// version 6. Specifically, it moves existing locked eFUND into non-expiring lots.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
+// Migrate6to7 migrates the x/enterprise module state from the consensus version 6 to
+// version 7. Specifically, it sets the EfundFeeMsgTypes param to its default values.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
+// Migrate7to8 migrates the x/enterprise module state from the consensus version 7 to
+// version 8. Specifically, it indexes existing purchase orders by purchaser and status.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v8.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
+// Migrate8to9 migrates the x/enterprise module state from the consensus version 8 to
+// version 9. Specifically, it converts whitelisted addresses into whitelist entries.
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	return v9.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
+// Migrate9to10 migrates the x/enterprise module state from the consensus version 9 to
+// version 10. Specifically, it initialises the eFUND ledger.
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	return v10.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
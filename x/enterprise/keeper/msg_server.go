@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
+	mathmod "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -50,6 +51,10 @@ func (k msgServer) UndPurchaseOrder(goCtx context.Context, msg *types.MsgUndPurc
 		return nil, errorsmod.Wrap(types.ErrNotAuthorisedToRaisePO, fmt.Sprintf("%s is not whitelisted to raise purchase orders", msg.Purchaser))
 	}
 
+	if err := k.CheckPurchaseCap(ctx, accAddr, msg.Amount); err != nil {
+		return nil, err
+	}
+
 	po := types.EnterpriseUndPurchaseOrder{
 		Purchaser: msg.Purchaser,
 		Amount:    msg.Amount,
@@ -128,6 +133,27 @@ func (k msgServer) ProcessUndPurchaseOrder(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 
+	// the group policy's decision is final, so there is nothing to tally in the EndBlocker
+	if k.IsGroupPolicyMode(ctx) {
+		err = k.FinalisePurchaseOrderDecision(ctx, msg.PurchaseOrderId, msg.Decision)
+		if err != nil {
+			return nil, err
+		}
+
+		// rejected if accepting would exceed the purchaser's cap
+		purchaseOrder, _ = k.GetPurchaseOrder(ctx, msg.PurchaseOrderId)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTallyPurchaseOrderDecisions,
+				sdk.NewAttribute(types.AttributeKeyPurchaseOrderID, strconv.FormatUint(msg.PurchaseOrderId, 10)),
+				sdk.NewAttribute(types.AttributeKeyPurchaser, purchaseOrder.Purchaser),
+				sdk.NewAttribute(types.AttributeKeyDecision, purchaseOrder.Status.String()),
+				sdk.NewAttribute(types.AttributeKeyGroupPolicy, msg.Signer),
+			),
+		)
+	}
+
 	defer telemetry.IncrCounter(1, types.ModuleName, types.ProcessAction, msg.Decision.String())
 
 	ctx.EventManager().EmitEvent(
@@ -163,7 +189,30 @@ func (k msgServer) WhitelistAddress(goCtx context.Context, msg *types.MsgWhiteli
 		return nil, errorsmod.Wrap(types.ErrInvalidDecision, "action should be add or remove")
 	}
 
-	err := k.ProcessWhitelistAction(ctx, addr, msg.Action, signer)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if msg.ExpiryTime > 0 && msg.ExpiryTime <= uint64(ctx.BlockHeader().Time.Unix()) {
+		return nil, errorsmod.Wrap(types.ErrInvalidData, "expiry time must be in the future")
+	}
+
+	// a zero max purchasable means no cap
+	entry := types.WhitelistEntry{
+		Address:        addr.String(),
+		ExpiryTime:     msg.ExpiryTime,
+		MaxPurchasable: sdk.NewCoin(k.GetParamDenom(ctx), mathmod.ZeroInt()),
+		Tier:           msg.Tier,
+	}
+
+	if msg.MaxPurchasable != nil && !msg.MaxPurchasable.Amount.IsNil() && msg.MaxPurchasable.IsPositive() {
+		if msg.MaxPurchasable.Denom != k.GetParamDenom(ctx) {
+			return nil, errorsmod.Wrap(types.ErrInvalidDenomination, fmt.Sprintf("max purchasable denomination must be %s", k.GetParamDenom(ctx)))
+		}
+		entry.MaxPurchasable = *msg.MaxPurchasable
+	}
+
+	err := k.ProcessWhitelistAction(ctx, entry, msg.Action, signer)
 
 	if err != nil {
 		return nil, err
@@ -177,18 +226,301 @@ func (k msgServer) WhitelistAddress(goCtx context.Context, msg *types.MsgWhiteli
 			sdk.NewAttribute(types.AttributeWhitelistAddress, msg.Address),
 			sdk.NewAttribute(types.AttributeKeySigner, msg.Signer),
 			sdk.NewAttribute(types.AttributeKeyWhitelistAction, msg.Action.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiryTime, strconv.FormatUint(entry.ExpiryTime, 10)),
+			sdk.NewAttribute(types.AttributeKeyMaxPurchasable, entry.MaxPurchasable.String()),
+			sdk.NewAttribute(types.AttributeKeyTier, entry.Tier),
 		),
 	)
 
 	return &types.MsgWhitelistAddressResponse{}, nil
 }
 
+func (k msgServer) WithdrawUndPurchaseOrder(goCtx context.Context, msg *types.MsgWithdrawUndPurchaseOrder) (*types.MsgWithdrawUndPurchaseOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, accErr := sdk.AccAddressFromBech32(msg.Purchaser)
+	if accErr != nil {
+		return nil, accErr
+	}
+
+	purchaseOrder, err := k.getPurchaserRaisedPurchaseOrder(ctx, msg.PurchaseOrderId, msg.Purchaser)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.WithdrawPurchaseOrder(ctx, msg.PurchaseOrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(1, types.ModuleName, types.WithdrawAction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeWithdrawPurchaseOrder,
+			sdk.NewAttribute(types.AttributeKeyPurchaseOrderID, strconv.FormatUint(msg.PurchaseOrderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPurchaser, msg.Purchaser),
+			sdk.NewAttribute(types.AttributeKeyAmount, purchaseOrder.Amount.String()),
+		),
+	)
+
+	return &types.MsgWithdrawUndPurchaseOrderResponse{}, nil
+}
+
+func (k msgServer) AmendUndPurchaseOrder(goCtx context.Context, msg *types.MsgAmendUndPurchaseOrder) (*types.MsgAmendUndPurchaseOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	accAddr, accErr := sdk.AccAddressFromBech32(msg.Purchaser)
+	if accErr != nil {
+		return nil, accErr
+	}
+
+	if msg.Amount.Denom != k.GetParamDenom(ctx) {
+		return nil, errorsmod.Wrap(types.ErrInvalidDenomination, fmt.Sprintf("denomination must be %s", k.GetParamDenom(ctx)))
+	}
+
+	if !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(types.ErrInvalidData, "amount must be greater than zero")
+	}
+
+	if !k.AddressIsWhitelisted(ctx, accAddr) {
+		return nil, errorsmod.Wrap(types.ErrNotAuthorisedToRaisePO, fmt.Sprintf("%s is not whitelisted to raise purchase orders", msg.Purchaser))
+	}
+
+	purchaseOrder, err := k.getPurchaserRaisedPurchaseOrder(ctx, msg.PurchaseOrderId, msg.Purchaser)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.CheckPurchaseCap(ctx, accAddr, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.AmendPurchaseOrder(ctx, msg.PurchaseOrderId, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(1, types.ModuleName, types.AmendAction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAmendPurchaseOrder,
+			sdk.NewAttribute(types.AttributeKeyPurchaseOrderID, strconv.FormatUint(msg.PurchaseOrderId, 10)),
+			sdk.NewAttribute(types.AttributeKeyPurchaser, msg.Purchaser),
+			sdk.NewAttribute(types.AttributeKeyOldAmount, purchaseOrder.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgAmendUndPurchaseOrderResponse{}, nil
+}
+
+func (k msgServer) TransferLockedEFUND(goCtx context.Context, msg *types.MsgTransferLockedEFUND) (*types.MsgTransferLockedEFUNDResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	from, accErr := sdk.AccAddressFromBech32(msg.From)
+	if accErr != nil {
+		return nil, errorsmod.Wrap(accErr, "from address")
+	}
+
+	to, accErr := sdk.AccAddressFromBech32(msg.To)
+	if accErr != nil {
+		return nil, errorsmod.Wrap(accErr, "to address")
+	}
+
+	if from.Equals(to) {
+		return nil, errorsmod.Wrap(types.ErrInvalidData, "cannot transfer locked eFUND to the same address")
+	}
+
+	if msg.Amount.Denom != k.GetParamDenom(ctx) {
+		return nil, errorsmod.Wrap(types.ErrInvalidDenomination, fmt.Sprintf("denomination must be %s", k.GetParamDenom(ctx)))
+	}
+
+	if !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(types.ErrInvalidData, "amount must be greater than zero")
+	}
+
+	// locked eFUND can only be moved to addresses the ent signers have whitelisted, or have linked to the sender
+	// as another account of the same enterprise
+	if !k.AddressIsWhitelisted(ctx, to) && !k.IsLinkedAddress(ctx, from, to) {
+		return nil, errorsmod.Wrap(types.ErrAddressNotWhitelisted, fmt.Sprintf("%s is neither whitelisted nor linked to %s", msg.To, msg.From))
+	}
+
+	err := k.Keeper.TransferLockedEFUND(ctx, from, to, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(1, types.ModuleName, types.TransferLockedAction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferLockedEFUND,
+			sdk.NewAttribute(types.AttributeKeyFrom, msg.From),
+			sdk.NewAttribute(types.AttributeKeyTo, msg.To),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgTransferLockedEFUNDResponse{}, nil
+}
+
+func (k msgServer) LinkAddress(goCtx context.Context, msg *types.MsgLinkAddress) (*types.MsgLinkAddressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, accErr := sdk.AccAddressFromBech32(msg.Signer)
+	if accErr != nil {
+		return nil, errorsmod.Wrap(accErr, "signer address")
+	}
+
+	owner, accErr := sdk.AccAddressFromBech32(msg.Owner)
+	if accErr != nil {
+		return nil, errorsmod.Wrap(accErr, "owner address")
+	}
+
+	linked, accErr := sdk.AccAddressFromBech32(msg.Linked)
+	if accErr != nil {
+		return nil, errorsmod.Wrap(accErr, "linked address")
+	}
+
+	if !k.IsAuthorisedToDecide(ctx, signer) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "unauthorised signer modifying linked addresses")
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	err := k.ProcessLinkAddressAction(ctx, owner, linked, msg.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(1, types.ModuleName, types.LinkAddressAction, msg.Action.String())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLinkAddress,
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyLinked, msg.Linked),
+			sdk.NewAttribute(types.AttributeKeySigner, msg.Signer),
+			sdk.NewAttribute(types.AttributeKeyWhitelistAction, msg.Action.String()),
+		),
+	)
+
+	return &types.MsgLinkAddressResponse{}, nil
+}
+
+// getPurchaserRaisedPurchaseOrder returns a purchase order which can be withdrawn or amended by the purchaser.
+// It must have been raised by the purchaser, and not yet had any decisions made on it
+func (k msgServer) getPurchaserRaisedPurchaseOrder(ctx sdk.Context, purchaseOrderID uint64, purchaser string) (types.EnterpriseUndPurchaseOrder, error) {
+	if purchaseOrderID == 0 {
+		return types.EnterpriseUndPurchaseOrder{}, errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "purchase order id must be greater than zero")
+	}
+
+	purchaseOrder, found := k.GetPurchaseOrder(ctx, purchaseOrderID)
+
+	if !found {
+		return types.EnterpriseUndPurchaseOrder{}, errorsmod.Wrapf(types.ErrPurchaseOrderDoesNotExist, "purchase order id %d does not exist", purchaseOrderID)
+	}
+
+	if purchaseOrder.Purchaser != purchaser {
+		return types.EnterpriseUndPurchaseOrder{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the purchaser of purchase order %d", purchaser, purchaseOrderID)
+	}
+
+	if purchaseOrder.Status != types.StatusRaised {
+		return types.EnterpriseUndPurchaseOrder{}, errorsmod.Wrapf(types.ErrPurchaseOrderAlreadyProcessed, "id %d already processed: %s", purchaseOrderID, purchaseOrder.Status.String())
+	}
+
+	if len(purchaseOrder.Decisions) > 0 {
+		return types.EnterpriseUndPurchaseOrder{}, errorsmod.Wrapf(types.ErrPurchaseOrderAlreadyProcessed, "id %d already has %d decisions", purchaseOrderID, len(purchaseOrder.Decisions))
+	}
+
+	return purchaseOrder, nil
+}
+
+func (k msgServer) AddEntSigner(goCtx context.Context, req *types.MsgAddEntSigner) (*types.MsgAddEntSignerResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, accErr := sdk.AccAddressFromBech32(req.Address)
+	if accErr != nil {
+		return nil, errorsmod.Wrap(accErr, "ent signer address")
+	}
+
+	if len(req.Name) > types.MaxEntSignerNameLength {
+		return nil, errorsmod.Wrapf(types.ErrInvalidData, "name cannot be longer than %d characters", types.MaxEntSignerNameLength)
+	}
+
+	if k.IsEntSigner(ctx, addr) {
+		return nil, errorsmod.Wrapf(types.ErrEntSignerAlreadyRegistered, "%s already registered", addr)
+	}
+
+	entSigner := types.EntSigner{
+		Address: addr.String(),
+		Name:    req.Name,
+		AddedAt: uint64(ctx.BlockHeader().Time.Unix()),
+	}
+
+	if err := k.SetEntSigner(ctx, entSigner); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddEntSigner,
+			sdk.NewAttribute(types.AttributeKeySigner, entSigner.Address),
+			sdk.NewAttribute(types.AttributeKeyEntSignerName, entSigner.Name),
+		),
+	)
+
+	return &types.MsgAddEntSignerResponse{}, nil
+}
+
+func (k msgServer) RemoveEntSigner(goCtx context.Context, req *types.MsgRemoveEntSigner) (*types.MsgRemoveEntSignerResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, accErr := sdk.AccAddressFromBech32(req.Address)
+	if accErr != nil {
+		return nil, errorsmod.Wrap(accErr, "ent signer address")
+	}
+
+	if err := k.DeleteEntSigner(ctx, addr); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveEntSigner,
+			sdk.NewAttribute(types.AttributeKeySigner, addr.String()),
+		),
+	)
+
+	return &types.MsgRemoveEntSignerResponse{}, nil
+}
+
 func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.authority != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.Params.IsGroupPolicyMode() {
+		if err := k.CheckGroupPolicyExists(ctx, req.Params.GroupPolicyAddress); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
@@ -1,7 +1,12 @@
 package keeper_test
 
 import (
+	"strings"
+	"time"
+
+	mathmod "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
 
 	"github.com/unification-com/mainchain/x/enterprise/types"
 )
@@ -33,16 +38,40 @@ func (s *KeeperTestSuite) TestUpdateParams() {
 			expectErr: true,
 		},
 		{
-			name: "set full valid params",
+			name: "set legacy ent signers",
 			request: &types.MsgUpdateParams{
 				Authority: s.app.EnterpriseKeeper.GetAuthority(),
 				Params: types.Params{
 					EntSigners:        "und1djn9sr7vtghtarp5ccvtrc0mwg9dlzjrj7alw6,und1eq239sgefyzm4crl85nfyvt7kw83vrna3f0eed",
+					Denom:             sdk.DefaultBondDenom,
+					MinAccepts:        1,
+					DecisionTimeLimit: 2,
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "set min accepts greater than number of signers",
+			request: &types.MsgUpdateParams{
+				Authority: s.app.EnterpriseKeeper.GetAuthority(),
+				Params: types.Params{
 					Denom:             sdk.DefaultBondDenom,
 					MinAccepts:        2,
 					DecisionTimeLimit: 2,
 				},
 			},
+			expectErr: true,
+		},
+		{
+			name: "set full valid params",
+			request: &types.MsgUpdateParams{
+				Authority: s.app.EnterpriseKeeper.GetAuthority(),
+				Params: types.Params{
+					Denom:             sdk.DefaultBondDenom,
+					MinAccepts:        1,
+					DecisionTimeLimit: 2,
+				},
+			},
 			expectErr: false,
 		},
 	}
@@ -65,15 +94,21 @@ func (s *KeeperTestSuite) TestUndPurchaseOrder() {
 	entSigner := s.addrs[0]
 	whitelisted := s.addrs[1]
 	notWhitelisted := s.addrs[2]
+	capped := s.addrs[3]
+
+	_ = s.app.EnterpriseKeeper.SetEntSigner(s.ctx, types.EntSigner{Address: entSigner.String()})
 
 	_ = s.app.EnterpriseKeeper.SetParams(s.ctx, types.Params{
-		EntSigners:        entSigner.String(),
 		Denom:             sdk.DefaultBondDenom,
 		MinAccepts:        1,
 		DecisionTimeLimit: 999999999,
 	})
 
 	_ = s.app.EnterpriseKeeper.AddAddressToWhitelist(s.ctx, whitelisted)
+	_ = s.app.EnterpriseKeeper.SetWhitelistEntry(s.ctx, types.WhitelistEntry{
+		Address:        capped.String(),
+		MaxPurchasable: sdk.NewInt64Coin("nund", 100),
+	})
 
 	testCases := []struct {
 		name        string
@@ -125,6 +160,24 @@ func (s *KeeperTestSuite) TestUndPurchaseOrder() {
 			expectErr:   false,
 			expectedErr: "",
 		},
+		{
+			name: "purchase cap exceeded",
+			request: &types.MsgUndPurchaseOrder{
+				Purchaser: capped.String(),
+				Amount:    sdk.NewInt64Coin("nund", 101),
+			},
+			expectErr:   true,
+			expectedErr: "purchase cap exceeded",
+		},
+		{
+			name: "valid within purchase cap",
+			request: &types.MsgUndPurchaseOrder{
+				Purchaser: capped.String(),
+				Amount:    sdk.NewInt64Coin("nund", 100),
+			},
+			expectErr:   false,
+			expectedErr: "",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -144,8 +197,9 @@ func (s *KeeperTestSuite) TestUndPurchaseOrder() {
 func (s *KeeperTestSuite) TestProcessUndPurchaseOrder() {
 	entSigner := s.addrs[0]
 
+	_ = s.app.EnterpriseKeeper.SetEntSigner(s.ctx, types.EntSigner{Address: entSigner.String()})
+
 	_ = s.app.EnterpriseKeeper.SetParams(s.ctx, types.Params{
-		EntSigners:        entSigner.String(),
 		Denom:             sdk.DefaultBondDenom,
 		MinAccepts:        1,
 		DecisionTimeLimit: 999999999,
@@ -270,8 +324,9 @@ func (s *KeeperTestSuite) TestProcessUndPurchaseOrder() {
 func (s *KeeperTestSuite) TestWhitelistAddress() {
 	entSigner := s.addrs[0]
 
+	_ = s.app.EnterpriseKeeper.SetEntSigner(s.ctx, types.EntSigner{Address: entSigner.String()})
+
 	_ = s.app.EnterpriseKeeper.SetParams(s.ctx, types.Params{
-		EntSigners:        entSigner.String(),
 		Denom:             sdk.DefaultBondDenom,
 		MinAccepts:        1,
 		DecisionTimeLimit: 999999999,
@@ -319,6 +374,27 @@ func (s *KeeperTestSuite) TestWhitelistAddress() {
 			expectErr:   true,
 			expectedErr: "action should be add or remove",
 		},
+		{
+			name: "expiry time in the past",
+			request: types.NewMsgWhitelistAddressWithAttributes(
+				s.addrs[1], entSigner, uint64(s.ctx.BlockTime().Unix()), sdk.Coin{}, ""),
+			expectErr:   true,
+			expectedErr: "expiry time must be in the future",
+		},
+		{
+			name: "max purchasable incorrect denomination",
+			request: types.NewMsgWhitelistAddressWithAttributes(
+				s.addrs[1], entSigner, 0, sdk.NewInt64Coin("rubbish", 100), ""),
+			expectErr:   true,
+			expectedErr: "max purchasable denomination must be nund",
+		},
+		{
+			name: "tier too long",
+			request: types.NewMsgWhitelistAddressWithAttributes(
+				s.addrs[1], entSigner, 0, sdk.Coin{}, strings.Repeat("a", types.MaxWhitelistTierLength+1)),
+			expectErr:   true,
+			expectedErr: "tier cannot be longer than",
+		},
 		{
 			name: "ok",
 			request: &types.MsgWhitelistAddress{
@@ -329,6 +405,13 @@ func (s *KeeperTestSuite) TestWhitelistAddress() {
 			expectErr:   false,
 			expectedErr: "",
 		},
+		{
+			name: "ok with attributes",
+			request: types.NewMsgWhitelistAddressWithAttributes(
+				s.addrs[2], entSigner, uint64(s.ctx.BlockTime().Unix())+1000, sdk.NewInt64Coin("nund", 100), "gold"),
+			expectErr:   false,
+			expectedErr: "",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -344,3 +427,517 @@ func (s *KeeperTestSuite) TestWhitelistAddress() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestLinkAddress() {
+	entSigner := s.addrs[0]
+	owner := s.addrs[1]
+	linked := s.addrs[2]
+
+	_ = s.app.EnterpriseKeeper.SetEntSigner(s.ctx, types.EntSigner{Address: entSigner.String()})
+
+	_ = s.app.EnterpriseKeeper.SetParams(s.ctx, types.Params{
+		Denom:             sdk.DefaultBondDenom,
+		MinAccepts:        1,
+		DecisionTimeLimit: 999999999,
+	})
+
+	testCases := []struct {
+		name        string
+		request     *types.MsgLinkAddress
+		expectErr   bool
+		expectedErr string
+		expLinked   bool
+	}{
+		{
+			name: "invalid signer",
+			request: &types.MsgLinkAddress{
+				Signer: "bored_with_unit_tests",
+			},
+			expectErr:   true,
+			expectedErr: "decoding bech32 failed",
+		},
+		{
+			name: "invalid owner",
+			request: &types.MsgLinkAddress{
+				Signer: entSigner.String(),
+				Owner:  "yarbles",
+			},
+			expectErr:   true,
+			expectedErr: "decoding bech32 failed",
+		},
+		{
+			name:        "unauthorised",
+			request:     types.NewMsgLinkAddress(owner, linked, types.WhitelistActionAdd, s.addrs[3]),
+			expectErr:   true,
+			expectedErr: "unauthorised signer modifying linked addresses",
+		},
+		{
+			name:        "invalid action",
+			request:     types.NewMsgLinkAddress(owner, linked, types.WhitelistActionNil, entSigner),
+			expectErr:   true,
+			expectedErr: "action must be add or remove",
+		},
+		{
+			name:        "linked to itself",
+			request:     types.NewMsgLinkAddress(owner, owner, types.WhitelistActionAdd, entSigner),
+			expectErr:   true,
+			expectedErr: "cannot link an address to itself",
+		},
+		{
+			name:        "remove not linked",
+			request:     types.NewMsgLinkAddress(owner, linked, types.WhitelistActionRemove, entSigner),
+			expectErr:   true,
+			expectedErr: "address not linked",
+		},
+		{
+			name:      "add",
+			request:   types.NewMsgLinkAddress(owner, linked, types.WhitelistActionAdd, entSigner),
+			expLinked: true,
+		},
+		{
+			name:        "add already linked",
+			request:     types.NewMsgLinkAddress(owner, linked, types.WhitelistActionAdd, entSigner),
+			expectErr:   true,
+			expectedErr: "already linked",
+		},
+		{
+			name:      "remove",
+			request:   types.NewMsgLinkAddress(owner, linked, types.WhitelistActionRemove, entSigner),
+			expLinked: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.LinkAddress(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectedErr)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expLinked, s.app.EnterpriseKeeper.IsLinkedAddress(s.ctx, owner, linked))
+				// links are one way
+				s.Require().False(s.app.EnterpriseKeeper.IsLinkedAddress(s.ctx, linked, owner))
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestWithdrawUndPurchaseOrder() {
+	purchaser := s.addrs[0]
+	other := s.addrs[1]
+	s.Require().NoError(s.app.EnterpriseKeeper.AddAddressToWhitelist(s.ctx, purchaser))
+
+	entSigners := s.app.EnterpriseKeeper.GetEntSignersAsAddressArray(s.ctx)
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+
+	res, err := s.msgServer.UndPurchaseOrder(s.ctx, types.NewMsgUndPurchaseOrder(purchaser, amount))
+	s.Require().NoError(err)
+	decidedRes, err := s.msgServer.UndPurchaseOrder(s.ctx, types.NewMsgUndPurchaseOrder(purchaser, amount))
+	s.Require().NoError(err)
+	_, err = s.msgServer.ProcessUndPurchaseOrder(s.ctx, types.NewMsgProcessUndPurchaseOrder(decidedRes.PurchaseOrderId, types.StatusAccepted, entSigners[0]))
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgWithdrawUndPurchaseOrder
+		expErrMsg string
+	}{
+		{
+			name:      "purchase order does not exist",
+			request:   types.NewMsgWithdrawUndPurchaseOrder(purchaser, 99),
+			expErrMsg: "purchase order id 99 does not exist",
+		},
+		{
+			name:      "not the purchaser",
+			request:   types.NewMsgWithdrawUndPurchaseOrder(other, res.PurchaseOrderId),
+			expErrMsg: "is not the purchaser of purchase order",
+		},
+		{
+			name:      "decisions already made",
+			request:   types.NewMsgWithdrawUndPurchaseOrder(purchaser, decidedRes.PurchaseOrderId),
+			expErrMsg: "already has 1 decisions",
+		},
+		{
+			name:    "withdrawn",
+			request: types.NewMsgWithdrawUndPurchaseOrder(purchaser, res.PurchaseOrderId),
+		},
+		{
+			name:      "already withdrawn",
+			request:   types.NewMsgWithdrawUndPurchaseOrder(purchaser, res.PurchaseOrderId),
+			expErrMsg: "already processed: STATUS_WITHDRAWN",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.WithdrawUndPurchaseOrder(s.ctx, tc.request)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				po, _ := s.app.EnterpriseKeeper.GetPurchaseOrder(s.ctx, tc.request.PurchaseOrderId)
+				s.Require().Equal(types.StatusWithdrawn, po.Status)
+			}
+		})
+	}
+
+	// withdrawn orders are no longer tallied
+	err = s.app.EnterpriseKeeper.TallyPurchaseOrderDecisions(s.ctx)
+	s.Require().NoError(err)
+	po, _ := s.app.EnterpriseKeeper.GetPurchaseOrder(s.ctx, res.PurchaseOrderId)
+	s.Require().Equal(types.StatusWithdrawn, po.Status)
+}
+
+func (s *KeeperTestSuite) TestAmendUndPurchaseOrder() {
+	purchaser := s.addrs[0]
+	other := s.addrs[1]
+	s.Require().NoError(s.app.EnterpriseKeeper.AddAddressToWhitelist(s.ctx, purchaser))
+
+	entSigners := s.app.EnterpriseKeeper.GetEntSignersAsAddressArray(s.ctx)
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	newAmount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000)
+
+	res, err := s.msgServer.UndPurchaseOrder(s.ctx, types.NewMsgUndPurchaseOrder(purchaser, amount))
+	s.Require().NoError(err)
+	decidedRes, err := s.msgServer.UndPurchaseOrder(s.ctx, types.NewMsgUndPurchaseOrder(purchaser, amount))
+	s.Require().NoError(err)
+	_, err = s.msgServer.ProcessUndPurchaseOrder(s.ctx, types.NewMsgProcessUndPurchaseOrder(decidedRes.PurchaseOrderId, types.StatusAccepted, entSigners[0]))
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgAmendUndPurchaseOrder
+		expErrMsg string
+	}{
+		{
+			name:      "purchase order does not exist",
+			request:   types.NewMsgAmendUndPurchaseOrder(purchaser, 99, newAmount),
+			expErrMsg: "purchase order id 99 does not exist",
+		},
+		{
+			name:      "wrong denomination",
+			request:   types.NewMsgAmendUndPurchaseOrder(purchaser, res.PurchaseOrderId, sdk.NewInt64Coin("rubbish", 2000)),
+			expErrMsg: "denomination must be",
+		},
+		{
+			name:      "zero amount",
+			request:   types.NewMsgAmendUndPurchaseOrder(purchaser, res.PurchaseOrderId, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)),
+			expErrMsg: "amount must be greater than zero",
+		},
+		{
+			name:      "not the purchaser",
+			request:   types.NewMsgAmendUndPurchaseOrder(other, res.PurchaseOrderId, newAmount),
+			expErrMsg: "is not whitelisted to raise purchase orders",
+		},
+		{
+			name:      "decisions already made",
+			request:   types.NewMsgAmendUndPurchaseOrder(purchaser, decidedRes.PurchaseOrderId, newAmount),
+			expErrMsg: "already has 1 decisions",
+		},
+		{
+			name:    "amended",
+			request: types.NewMsgAmendUndPurchaseOrder(purchaser, res.PurchaseOrderId, newAmount),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.AmendUndPurchaseOrder(s.ctx, tc.request)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				po, _ := s.app.EnterpriseKeeper.GetPurchaseOrder(s.ctx, tc.request.PurchaseOrderId)
+				s.Require().Equal(types.StatusRaised, po.Status)
+				s.Require().Equal(tc.request.Amount, po.Amount)
+			}
+		})
+	}
+
+	// a whitelisted address which did not raise the order cannot amend it
+	s.Require().NoError(s.app.EnterpriseKeeper.AddAddressToWhitelist(s.ctx, other))
+	_, err = s.msgServer.AmendUndPurchaseOrder(s.ctx, types.NewMsgAmendUndPurchaseOrder(other, res.PurchaseOrderId, newAmount))
+	s.Require().ErrorContains(err, "is not the purchaser of purchase order")
+
+	// amending cannot take the purchaser over their purchase cap
+	accepted := s.app.EnterpriseKeeper.GetAcceptedPurchaseAmount(s.ctx, purchaser)
+	s.Require().NoError(s.app.EnterpriseKeeper.SetWhitelistEntry(s.ctx, types.WhitelistEntry{
+		Address:        purchaser.String(),
+		MaxPurchasable: accepted.AddAmount(mathmod.NewInt(1500)),
+	}))
+	_, err = s.msgServer.AmendUndPurchaseOrder(s.ctx, types.NewMsgAmendUndPurchaseOrder(purchaser, res.PurchaseOrderId, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1501)))
+	s.Require().ErrorIs(err, types.ErrPurchaseCapExceeded)
+	_, err = s.msgServer.AmendUndPurchaseOrder(s.ctx, types.NewMsgAmendUndPurchaseOrder(purchaser, res.PurchaseOrderId, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1500)))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestAddEntSigner() {
+	authority := s.app.EnterpriseKeeper.GetAuthority()
+	newSigner := s.addrs[0]
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgAddEntSigner
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			request:   types.NewMsgAddEntSigner(s.addrs[1].String(), newSigner, "signer"),
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "invalid address",
+			request:   &types.MsgAddEntSigner{Authority: authority, Address: "rubbish"},
+			expErrMsg: "decoding bech32 failed",
+		},
+		{
+			name:    "added",
+			request: types.NewMsgAddEntSigner(authority, newSigner, "signer"),
+		},
+		{
+			name:      "already registered",
+			request:   types.NewMsgAddEntSigner(authority, newSigner, "signer"),
+			expErrMsg: "already registered",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.AddEntSigner(s.ctx, tc.request)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				entSigner, found := s.app.EnterpriseKeeper.GetEntSigner(s.ctx, newSigner)
+				s.Require().True(found)
+				s.Require().Equal(tc.request.Name, entSigner.Name)
+				s.Require().Equal(uint64(s.ctx.BlockHeader().Time.Unix()), entSigner.AddedAt)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestRemoveEntSigner() {
+	authority := s.app.EnterpriseKeeper.GetAuthority()
+	signer1 := s.addrs[0]
+	signer2 := s.addrs[1]
+
+	for _, addr := range s.app.EnterpriseKeeper.GetEntSignersAsAddressArray(s.ctx) {
+		_, err := s.msgServer.RemoveEntSigner(s.ctx, types.NewMsgRemoveEntSigner(authority, addr))
+		s.Require().ErrorContains(err, "min accepts")
+	}
+
+	_, err := s.msgServer.AddEntSigner(s.ctx, types.NewMsgAddEntSigner(authority, signer1, ""))
+	s.Require().NoError(err)
+	_, err = s.msgServer.AddEntSigner(s.ctx, types.NewMsgAddEntSigner(authority, signer2, ""))
+	s.Require().NoError(err)
+
+	params := s.app.EnterpriseKeeper.GetParams(s.ctx)
+	params.MinAccepts = s.app.EnterpriseKeeper.GetNumEntSigners(s.ctx) - 1
+	s.Require().NoError(s.app.EnterpriseKeeper.SetParams(s.ctx, params))
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgRemoveEntSigner
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			request:   types.NewMsgRemoveEntSigner(signer2.String(), signer1),
+			expErrMsg: "invalid authority",
+		},
+		{
+			name:      "not registered",
+			request:   types.NewMsgRemoveEntSigner(authority, s.addrs[2]),
+			expErrMsg: "not registered",
+		},
+		{
+			name:    "removed",
+			request: types.NewMsgRemoveEntSigner(authority, signer1),
+		},
+		{
+			name:      "fewer signers than min accepts",
+			request:   types.NewMsgRemoveEntSigner(authority, signer2),
+			expErrMsg: "min accepts",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.RemoveEntSigner(s.ctx, tc.request)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().False(s.app.EnterpriseKeeper.IsEntSigner(s.ctx, signer1))
+				s.Require().False(s.app.EnterpriseKeeper.IsAuthorisedToDecide(s.ctx, signer1))
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestProcessUndPurchaseOrderGroupPolicy() {
+	authority := s.app.EnterpriseKeeper.GetAuthority()
+	purchaser := s.addrs[0]
+	entSigners := s.app.EnterpriseKeeper.GetEntSignersAsAddressArray(s.ctx)
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+
+	// create a group with two members, either of which can decide
+	members := []group.MemberRequest{
+		{Address: s.addrs[1].String(), Weight: "1"},
+		{Address: s.addrs[2].String(), Weight: "1"},
+	}
+	createMsg, err := group.NewMsgCreateGroupWithPolicy(s.addrs[1].String(), members, "", "", true, group.NewThresholdDecisionPolicy("1", time.Hour, 0))
+	s.Require().NoError(err)
+	groupRes, err := s.app.GroupKeeper.CreateGroupWithPolicy(s.ctx, createMsg)
+	s.Require().NoError(err)
+	groupPolicy, err := sdk.AccAddressFromBech32(groupRes.GroupPolicyAddress)
+	s.Require().NoError(err)
+
+	// the group policy must exist
+	params := s.app.EnterpriseKeeper.GetParams(s.ctx)
+	params.GroupPolicyAddress = s.addrs[3].String()
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	s.Require().ErrorIs(err, types.ErrGroupPolicyNotFound)
+
+	params.GroupPolicyAddress = groupPolicy.String()
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	s.Require().NoError(err)
+	s.Require().True(s.app.EnterpriseKeeper.IsGroupPolicyMode(s.ctx))
+
+	s.Require().NoError(s.app.EnterpriseKeeper.AddAddressToWhitelist(s.ctx, purchaser))
+	res, err := s.msgServer.UndPurchaseOrder(s.ctx, types.NewMsgUndPurchaseOrder(purchaser, amount))
+	s.Require().NoError(err)
+
+	// ent signers can no longer decide
+	_, err = s.msgServer.ProcessUndPurchaseOrder(s.ctx, types.NewMsgProcessUndPurchaseOrder(res.PurchaseOrderId, types.StatusAccepted, entSigners[0]))
+	s.Require().ErrorContains(err, "unauthorised signer processing purchase order")
+
+	// decide via a group proposal, executed as soon as the threshold is met
+	proposal, err := group.NewMsgSubmitProposal(groupPolicy.String(), []string{s.addrs[1].String()},
+		[]sdk.Msg{types.NewMsgProcessUndPurchaseOrder(res.PurchaseOrderId, types.StatusAccepted, groupPolicy)},
+		"", group.Exec_EXEC_TRY, "accept po", "accept po")
+	s.Require().NoError(err)
+	_, err = s.app.GroupKeeper.SubmitProposal(s.ctx, proposal)
+	s.Require().NoError(err)
+
+	po, found := s.app.EnterpriseKeeper.GetPurchaseOrder(s.ctx, res.PurchaseOrderId)
+	s.Require().True(found)
+	s.Require().Equal(types.StatusAccepted, po.Status)
+	s.Require().Len(po.Decisions, 1)
+	s.Require().Equal(groupPolicy.String(), po.Decisions[0].Signer)
+	s.Require().Equal([]uint64{res.PurchaseOrderId}, s.app.EnterpriseKeeper.GetAllAcceptedPurchaseOrders(s.ctx))
+	s.Require().Empty(s.app.EnterpriseKeeper.GetAllRaisedPurchaseOrders(s.ctx))
+
+	s.Require().NoError(s.app.EnterpriseKeeper.ProcessAcceptedPurchaseOrders(s.ctx))
+	s.Require().Equal(amount, s.app.EnterpriseKeeper.GetLockedUndAmountForAccount(s.ctx, purchaser))
+
+	// undecided purchase orders are still rejected once stale
+	staleRes, err := s.msgServer.UndPurchaseOrder(s.ctx, types.NewMsgUndPurchaseOrder(purchaser, amount))
+	s.Require().NoError(err)
+	s.Require().NoError(s.app.EnterpriseKeeper.TallyPurchaseOrderDecisions(s.ctx))
+	po, _ = s.app.EnterpriseKeeper.GetPurchaseOrder(s.ctx, staleRes.PurchaseOrderId)
+	s.Require().Equal(types.StatusRaised, po.Status)
+
+	staleCtx := s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Duration(params.DecisionTimeLimit) * time.Second))
+	s.Require().NoError(s.app.EnterpriseKeeper.TallyPurchaseOrderDecisions(staleCtx))
+	po, _ = s.app.EnterpriseKeeper.GetPurchaseOrder(staleCtx, staleRes.PurchaseOrderId)
+	s.Require().Equal(types.StatusRejected, po.Status)
+
+	// switch back to the legacy ent signer mode
+	params.GroupPolicyAddress = ""
+	_, err = s.msgServer.UpdateParams(s.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	s.Require().NoError(err)
+	res, err = s.msgServer.UndPurchaseOrder(s.ctx, types.NewMsgUndPurchaseOrder(purchaser, amount))
+	s.Require().NoError(err)
+	_, err = s.msgServer.ProcessUndPurchaseOrder(s.ctx, types.NewMsgProcessUndPurchaseOrder(res.PurchaseOrderId, types.StatusAccepted, groupPolicy))
+	s.Require().ErrorContains(err, "unauthorised signer processing purchase order")
+	_, err = s.msgServer.ProcessUndPurchaseOrder(s.ctx, types.NewMsgProcessUndPurchaseOrder(res.PurchaseOrderId, types.StatusAccepted, entSigners[0]))
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestTransferLockedEFUND() {
+	from := s.addrs[0]
+	whitelisted := s.addrs[1]
+	linked := s.addrs[2]
+	unrelated := s.addrs[3]
+
+	s.Require().NoError(s.app.EnterpriseKeeper.CreateAndLockEFUND(s.ctx, from, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), 1, 0))
+	s.Require().NoError(s.app.EnterpriseKeeper.AddAddressToWhitelist(s.ctx, whitelisted))
+	s.Require().NoError(s.app.EnterpriseKeeper.ProcessLinkAddressAction(s.ctx, from, linked, types.WhitelistActionAdd))
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgTransferLockedEFUND
+		expErrMsg string
+		expFrom   sdk.Coin
+		expTo     sdk.Coin
+	}{
+		{
+			name:      "same address",
+			request:   types.NewMsgTransferLockedEFUND(from, from, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			expErrMsg: "cannot transfer locked eFUND to the same address",
+		},
+		{
+			name:      "invalid denom",
+			request:   types.NewMsgTransferLockedEFUND(from, whitelisted, sdk.NewInt64Coin("abc", 100)),
+			expErrMsg: "denomination must be",
+		},
+		{
+			name:      "destination neither whitelisted nor linked",
+			request:   types.NewMsgTransferLockedEFUND(from, unrelated, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			expErrMsg: "is neither whitelisted nor linked",
+		},
+		{
+			name:      "link is one way",
+			request:   types.NewMsgTransferLockedEFUND(linked, from, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			expErrMsg: "is neither whitelisted nor linked",
+		},
+		{
+			name:      "insufficient locked eFUND",
+			request:   types.NewMsgTransferLockedEFUND(from, whitelisted, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1001)),
+			expErrMsg: "insufficient locked eFUND",
+		},
+		{
+			name:    "transferred to whitelisted address which is not linked",
+			request: types.NewMsgTransferLockedEFUND(from, whitelisted, sdk.NewInt64Coin(sdk.DefaultBondDenom, 400)),
+			expFrom: sdk.NewInt64Coin(sdk.DefaultBondDenom, 600),
+			expTo:   sdk.NewInt64Coin(sdk.DefaultBondDenom, 400),
+		},
+		{
+			name:    "transferred to linked address which is not whitelisted",
+			request: types.NewMsgTransferLockedEFUND(from, linked, sdk.NewInt64Coin(sdk.DefaultBondDenom, 250)),
+			expFrom: sdk.NewInt64Coin(sdk.DefaultBondDenom, 350),
+			expTo:   sdk.NewInt64Coin(sdk.DefaultBondDenom, 250),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+			_, err := s.msgServer.TransferLockedEFUND(ctx, tc.request)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expFrom, s.app.EnterpriseKeeper.GetLockedUndAmountForAccount(s.ctx, from))
+				s.Require().Equal(tc.expTo, s.app.EnterpriseKeeper.GetLockedUndAmountForAccount(s.ctx, sdk.MustAccAddressFromBech32(tc.request.To)))
+
+				found := false
+				for _, ev := range ctx.EventManager().Events() {
+					if ev.Type == types.EventTypeTransferLockedEFUND {
+						found = true
+					}
+				}
+				s.Require().True(found)
+			}
+		})
+	}
+}
@@ -1,9 +1,10 @@
 package keeper
 
 import (
-	"strings"
-
+	errorsmod "cosmossdk.io/errors"
+	mathmod "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
 
 	"github.com/unification-com/mainchain/x/enterprise/types"
 )
@@ -22,21 +23,31 @@ func (k Keeper) GetParamDecisionLimit(ctx sdk.Context) uint64 {
 	return k.GetParams(ctx).DecisionTimeLimit
 }
 
-func (k Keeper) GetParamEntSigners(ctx sdk.Context) string {
-	return k.GetParams(ctx).EntSigners
+func (k Keeper) GetParamEFUNDFeeMsgTypes(ctx sdk.Context) []string {
+	return k.GetParams(ctx).EfundFeeMsgTypes
+}
+
+// GetParamEFUNDMaxFee returns the maximum amount of locked eFUND that can be unlocked to pay the fees of a Tx
+// containing Msgs other than WRKChain and BEACON Msgs
+func (k Keeper) GetParamEFUNDMaxFee(ctx sdk.Context) sdk.Coin {
+	params := k.GetParams(ctx)
+	return sdk.NewCoin(params.Denom, mathmod.NewIntFromUint64(params.EfundMaxFee))
 }
 
-func (k Keeper) GetParamEntSignersAsAddressArray(ctx sdk.Context) []sdk.AccAddress {
-	var entSignersArray []sdk.AccAddress
-	paramEntSigners := k.GetParamEntSigners(ctx)
-	entSigners := strings.Split(paramEntSigners, ",")
-	for _, authAddr := range entSigners {
-		addr, err := sdk.AccAddressFromBech32(authAddr)
-		if err == nil && !addr.Empty() {
-			entSignersArray = append(entSignersArray, addr)
+// IsEFUNDFeeTx returns true if Tx fees for the given Msgs can be paid using locked eFUND. Every Msg must be
+// in the EfundFeeMsgTypes param. Txs mixing these with any other Msgs must pay fees from general supply FUND.
+func (k Keeper) IsEFUNDFeeTx(ctx sdk.Context, msgs []sdk.Msg) bool {
+	if len(msgs) == 0 {
+		return false
+	}
+
+	params := k.GetParams(ctx)
+	for _, msg := range msgs {
+		if !params.IsEFUNDFeeMsgType(sdk.MsgTypeURL(msg)) {
+			return false
 		}
 	}
-	return entSignersArray
+	return true
 }
 
 // GetParams returns the total set of Enterprise FUND parameters.
@@ -52,12 +63,42 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return params
 }
 
-// SetParams sets the total set of Enterprise FUND parameters.
+// GetParamGroupPolicyAddress returns the x/group policy address deciding purchase orders, if set
+func (k Keeper) GetParamGroupPolicyAddress(ctx sdk.Context) string {
+	return k.GetParams(ctx).GroupPolicyAddress
+}
+
+// IsGroupPolicyMode returns true if purchase orders are decided by an x/group policy
+func (k Keeper) IsGroupPolicyMode(ctx sdk.Context) bool {
+	return k.GetParams(ctx).IsGroupPolicyMode()
+}
+
+// CheckGroupPolicyExists returns an error if the given address is not an x/group policy account
+func (k Keeper) CheckGroupPolicyExists(ctx sdk.Context, groupPolicyAddress string) error {
+	_, err := k.groupKeeper.GroupPolicyInfo(ctx, &group.QueryGroupPolicyInfoRequest{Address: groupPolicyAddress})
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrGroupPolicyNotFound, "%s: %s", groupPolicyAddress, err)
+	}
+	return nil
+}
+
+// SetParams sets the total set of Enterprise FUND parameters. Signers are held in the signer
+// registry, which must already contain at least MinAccepts signers, unless purchase orders
+// are decided by an x/group policy.
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	if err := params.Validate(); err != nil {
 		return err
 	}
 
+	if params.EntSigners != "" {
+		return errorsmod.Wrap(types.ErrInvalidData, "ent_signers is deprecated. Signers are managed via MsgAddEntSigner and MsgRemoveEntSigner")
+	}
+
+	numEntSigners := k.GetNumEntSigners(ctx)
+	if !params.IsGroupPolicyMode() && params.MinAccepts > numEntSigners {
+		return errorsmod.Wrapf(types.ErrInsufficientEntSigners, "min accepts %d > %d signers", params.MinAccepts, numEntSigners)
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	bz := k.cdc.MustMarshal(&params)
 	store.Set(types.ParamsKey, bz)
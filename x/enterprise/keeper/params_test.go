@@ -3,24 +3,87 @@ package keeper_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 
 	simapphelpers "github.com/unification-com/mainchain/app/helpers"
 	"github.com/unification-com/mainchain/x/enterprise/types"
+	streamtypes "github.com/unification-com/mainchain/x/stream/types"
+	wrkchaintypes "github.com/unification-com/mainchain/x/wrkchain/types"
 )
 
 func TestSetGetParams(t *testing.T) {
 	app := simapphelpers.Setup(t)
 	ctx := app.BaseApp.NewContext(false)
-	testAddrs := simapphelpers.GenerateRandomTestAccounts(3)
-	entSigners := testAddrs[1].String() + "," + testAddrs[2].String()
 	denom := "testc"
-	params := types.NewParams(denom, 1, 3600, entSigners)
+	params := types.NewParams(denom, 1, 3600, "", types.DefaultEFUNDFeeMsgTypes)
 
-	app.EnterpriseKeeper.SetParams(ctx, params)
+	err := app.EnterpriseKeeper.SetParams(ctx, params)
+	require.NoError(t, err)
 
 	paramsDb := app.EnterpriseKeeper.GetParams(ctx)
 
-	require.True(t, params == paramsDb)
+	require.True(t, ParamsEqual(params, paramsDb))
 	require.True(t, paramsDb.Denom == denom)
 }
+
+func TestSetParamsMinAcceptsAgainstEntSigners(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(2)
+
+	// genesis registers a single signer
+	params := types.NewParams("testc", 2, 3600, "", types.DefaultEFUNDFeeMsgTypes)
+	err := app.EnterpriseKeeper.SetParams(ctx, params)
+	require.ErrorIs(t, err, types.ErrInsufficientEntSigners)
+
+	err = app.EnterpriseKeeper.SetEntSigner(ctx, types.EntSigner{Address: testAddrs[0].String()})
+	require.NoError(t, err)
+
+	err = app.EnterpriseKeeper.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	// signers must be managed via the registry
+	params.EntSigners = testAddrs[1].String()
+	err = app.EnterpriseKeeper.SetParams(ctx, params)
+	require.ErrorIs(t, err, types.ErrInvalidData)
+}
+
+func TestIsEFUNDFeeTx(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(2)
+
+	wrkchainMsg := &wrkchaintypes.MsgRecordWrkChainBlock{WrkchainId: 1, Height: 1, BlockHash: "hash", Owner: testAddrs[0].String()}
+	streamMsg := &streamtypes.MsgTopUpDeposit{Receiver: testAddrs[1].String(), Sender: testAddrs[0].String()}
+	sendMsg := banktypes.NewMsgSend(testAddrs[0], testAddrs[1], sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+
+	testCases := []struct {
+		name     string
+		msgs     []sdk.Msg
+		expected bool
+	}{
+		{"no msgs", []sdk.Msg{}, false},
+		{"wrkchain msg", []sdk.Msg{wrkchainMsg}, true},
+		{"stream msg", []sdk.Msg{streamMsg}, true},
+		{"multiple allowed msgs", []sdk.Msg{wrkchainMsg, streamMsg}, true},
+		{"msg not allowed", []sdk.Msg{sendMsg}, false},
+		{"mixed msgs", []sdk.Msg{wrkchainMsg, sendMsg}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, app.EnterpriseKeeper.IsEFUNDFeeTx(ctx, tc.msgs))
+		})
+	}
+
+	// remove the stream msg from the allowlist
+	params := app.EnterpriseKeeper.GetParams(ctx)
+	params.EfundFeeMsgTypes = []string{sdk.MsgTypeURL(wrkchainMsg)}
+	err := app.EnterpriseKeeper.SetParams(ctx, params)
+	require.NoError(t, err)
+
+	require.True(t, app.EnterpriseKeeper.IsEFUNDFeeTx(ctx, []sdk.Msg{wrkchainMsg}))
+	require.False(t, app.EnterpriseKeeper.IsEFUNDFeeTx(ctx, []sdk.Msg{streamMsg}))
+}
@@ -211,48 +211,65 @@ func (k Keeper) GetAllPurchaseOrders(ctx sdk.Context) (purchaseOrders []types.En
 	return
 }
 
-//// GetPurchaseOrdersFiltered retrieves purchase orders filtered by a given set of params which
-//// include pagination parameters along a purchase order status.
-////
-//// NOTE: If no filters are provided, all proposals will be returned in paginated
-//// form.
-//func (k Keeper) GetPurchaseOrdersFiltered(ctx sdk.Context, params types.QueryPurchaseOrdersParams) []types.EnterpriseUndPurchaseOrder {
-//	purchaseOrders := k.GetAllPurchaseOrders(ctx)
-//	filteredPurchaseOrders := make([]types.EnterpriseUndPurchaseOrder, 0, len(purchaseOrders))
-//
-//	for _, po := range purchaseOrders {
-//		matchStatus, matchPurchaser := true, true
-//
-//		// match status (if supplied/valid)
-//		if types.ValidPurchaseOrderStatus(params.PurchaseOrderStatus) {
-//			matchStatus = po.Status == params.PurchaseOrderStatus
-//		}
-//
-//		if len(params.Purchaser) > 0 {
-//			matchPurchaser = po.Purchaser == params.Purchaser.String()
-//		}
-//
-//		if matchStatus && matchPurchaser {
-//			filteredPurchaseOrders = append(filteredPurchaseOrders, po)
-//		}
-//	}
-//
-//	start, end := client.Paginate(len(filteredPurchaseOrders), params.Page, params.Limit, 100)
-//	if start < 0 || end < 0 {
-//		filteredPurchaseOrders = []types.EnterpriseUndPurchaseOrder{}
-//	} else {
-//		filteredPurchaseOrders = filteredPurchaseOrders[start:end]
-//	}
-//
-//	return filteredPurchaseOrders
-//}
-
-// Sets the Purchase Order data
+// IteratePurchaseOrdersByPurchaser iterates over a purchaser's purchase orders, in ID order, and performs a callback function
+func (k Keeper) IteratePurchaseOrdersByPurchaser(ctx sdk.Context, purchaser sdk.AccAddress, cb func(purchaseOrder types.EnterpriseUndPurchaseOrder) (stop bool)) {
+	k.iteratePurchaseOrderIndex(ctx, types.PurchaseOrdersByPurchaserPrefix(purchaser), cb)
+}
+
+// IteratePurchaseOrdersByStatus iterates over the purchase orders with a given status, in ID order, and performs a callback function
+func (k Keeper) IteratePurchaseOrdersByStatus(ctx sdk.Context, status types.PurchaseOrderStatus, cb func(purchaseOrder types.EnterpriseUndPurchaseOrder) (stop bool)) {
+	k.iteratePurchaseOrderIndex(ctx, types.PurchaseOrdersByStatusPrefix(status), cb)
+}
+
+func (k Keeper) iteratePurchaseOrderIndex(ctx sdk.Context, indexPrefix []byte, cb func(purchaseOrder types.EnterpriseUndPurchaseOrder) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, indexPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		poId := types.GetPurchaseOrderIDFromBytes(iterator.Key()[len(indexPrefix):])
+		po, found := k.GetPurchaseOrder(ctx, poId)
+		if !found {
+			continue
+		}
+
+		if cb(po) {
+			break
+		}
+	}
+}
+
+// setPurchaseOrderIndexes indexes a purchase order by purchaser and status, removing any
+// index entries for its previous status
+func (k Keeper) setPurchaseOrderIndexes(ctx sdk.Context, purchaseOrder types.EnterpriseUndPurchaseOrder) error {
+	purchaser, err := sdk.AccAddressFromBech32(purchaseOrder.Purchaser)
+	if err != nil {
+		return errorsmod.Wrap(err, "unable to set purchase order - invalid purchaser")
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	if prev, found := k.GetPurchaseOrder(ctx, purchaseOrder.Id); found && prev.Status != purchaseOrder.Status {
+		store.Delete(types.PurchaseOrderStatusIndexKey(prev.Status, prev.Id))
+	}
+
+	store.Set(types.PurchaseOrderPurchaserIndexKey(purchaser, purchaseOrder.Id), []byte{})
+	store.Set(types.PurchaseOrderStatusIndexKey(purchaseOrder.Status, purchaseOrder.Id), []byte{})
+
+	return nil
+}
+
+// Sets the Purchase Order data, and maintains the purchaser and status indexes
 func (k Keeper) SetPurchaseOrder(ctx sdk.Context, purchaseOrder types.EnterpriseUndPurchaseOrder) error {
 	if !types.ValidPurchaseOrderStatus(purchaseOrder.Status) {
 		return errorsmod.Wrap(types.ErrInvalidStatus, "unable to set purchase order - invalid status")
 	}
 
+	// indexes are updated first, since the previous status is needed
+	if err := k.setPurchaseOrderIndexes(ctx, purchaseOrder); err != nil {
+		return err
+	}
+
 	store := ctx.KVStore(k.storeKey)
 	store.Set(types.PurchaseOrderKey(purchaseOrder.Id), k.cdc.MustMarshal(&purchaseOrder))
 
@@ -288,15 +305,14 @@ func (k Keeper) RaiseNewPurchaseOrder(ctx sdk.Context, purchaseOrder types.Enter
 	return purchaseOrderId, nil
 }
 
+// IsAuthorisedToDecide returns true if the signer can make purchase order decisions. If the
+// GroupPolicyAddress param is set, only the group policy can decide. Otherwise, any ent signer can.
 func (k Keeper) IsAuthorisedToDecide(ctx sdk.Context, signer sdk.AccAddress) bool {
-	entSigners := k.GetParamEntSignersAsAddressArray(ctx)
-	isAuthorised := false
-	for _, authAddr := range entSigners {
-		if signer.Equals(authAddr) {
-			isAuthorised = true
-		}
+	groupPolicyAddress := k.GetParamGroupPolicyAddress(ctx)
+	if groupPolicyAddress != "" {
+		return signer.String() == groupPolicyAddress
 	}
-	return isAuthorised
+	return k.IsEntSigner(ctx, signer)
 }
 
 func (k Keeper) ProcessPurchaseOrderDecision(ctx sdk.Context, purchaseOrderID uint64, decision types.PurchaseOrderStatus, signer sdk.AccAddress) error {
@@ -325,3 +341,100 @@ func (k Keeper) ProcessPurchaseOrderDecision(ctx sdk.Context, purchaseOrderID ui
 	}
 	return nil
 }
+
+// FinalisePurchaseOrderDecision sets the final accept or reject status of a raised purchase order
+// and removes it from the raised queue. Accepted purchase orders are added to the accepted queue,
+// to be processed in the EndBlocker. Used when purchase orders are decided by an x/group policy,
+// since the group's decision policy has already been applied to the decision. A purchase order which would
+// take its purchaser over their purchase cap is rejected instead of accepted.
+func (k Keeper) FinalisePurchaseOrderDecision(ctx sdk.Context, purchaseOrderID uint64, decision types.PurchaseOrderStatus) error {
+
+	logger := k.Logger(ctx)
+
+	purchaseOrder, found := k.GetPurchaseOrder(ctx, purchaseOrderID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrPurchaseOrderDoesNotExist, "purchase order id %d does not exist", purchaseOrderID)
+	}
+
+	if decision == types.StatusAccepted && k.exceedsPurchaseCap(ctx, purchaseOrder) {
+		decision = types.StatusRejected
+	}
+
+	purchaseOrder.Status = decision
+	purchaseOrder.CompletionTime = uint64(ctx.BlockHeader().Time.Unix())
+	if decision == types.StatusAccepted {
+		purchaseOrder.EfundExpiryTime = k.getEFUNDExpiryTime(ctx)
+	}
+
+	err := k.SetPurchaseOrder(ctx, purchaseOrder)
+	if err != nil {
+		return err
+	}
+
+	k.RemovePurchaseOrderFromRaisedQueue(ctx, purchaseOrderID)
+
+	if decision == types.StatusAccepted {
+		k.AddPoToAcceptedQueue(ctx, purchaseOrderID)
+	}
+
+	if !ctx.IsCheckTx() {
+		logger.Debug("enterprise und purchase order decided by group policy", "id", purchaseOrderID, "decision", decision)
+	}
+
+	return nil
+}
+
+// WithdrawPurchaseOrder marks a raised purchase order as withdrawn by its purchaser, and removes it
+// from the raised queue so that it is no longer tallied
+func (k Keeper) WithdrawPurchaseOrder(ctx sdk.Context, purchaseOrderID uint64) error {
+
+	logger := k.Logger(ctx)
+
+	purchaseOrder, found := k.GetPurchaseOrder(ctx, purchaseOrderID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrPurchaseOrderDoesNotExist, "purchase order id %d does not exist", purchaseOrderID)
+	}
+
+	purchaseOrder.Status = types.StatusWithdrawn
+	purchaseOrder.CompletionTime = uint64(ctx.BlockHeader().Time.Unix())
+
+	err := k.SetPurchaseOrder(ctx, purchaseOrder)
+	if err != nil {
+		return err
+	}
+
+	k.RemovePurchaseOrderFromRaisedQueue(ctx, purchaseOrderID)
+
+	if !ctx.IsCheckTx() {
+		logger.Debug("enterprise und purchase order withdrawn", "id", purchaseOrderID, "by", purchaseOrder.Purchaser)
+	}
+
+	return nil
+}
+
+// AmendPurchaseOrder sets a new amount for a raised purchase order. Any decisions already made are
+// cleared and the raise time reset, so the amended order is decided afresh
+func (k Keeper) AmendPurchaseOrder(ctx sdk.Context, purchaseOrderID uint64, amount sdk.Coin) error {
+
+	logger := k.Logger(ctx)
+
+	purchaseOrder, found := k.GetPurchaseOrder(ctx, purchaseOrderID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrPurchaseOrderDoesNotExist, "purchase order id %d does not exist", purchaseOrderID)
+	}
+
+	purchaseOrder.Amount = amount
+	purchaseOrder.Decisions = types.PurchaseOrderDecisions{}
+	purchaseOrder.RaiseTime = uint64(ctx.BlockHeader().Time.Unix())
+
+	err := k.SetPurchaseOrder(ctx, purchaseOrder)
+	if err != nil {
+		return err
+	}
+
+	if !ctx.IsCheckTx() {
+		logger.Debug("enterprise und purchase order amended", "id", purchaseOrderID, "by", purchaseOrder.Purchaser, "amt", amount.String())
+	}
+
+	return nil
+}
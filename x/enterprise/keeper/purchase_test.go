@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -75,6 +76,63 @@ func TestSetGetPurchaseOrder(t *testing.T) {
 
 }
 
+func TestPurchaseOrderIndexes(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(2)
+
+	getIds := func(iterate func(cb func(po types.EnterpriseUndPurchaseOrder) bool)) (ids []uint64) {
+		iterate(func(po types.EnterpriseUndPurchaseOrder) bool {
+			ids = append(ids, po.Id)
+			return false
+		})
+		return
+	}
+	byPurchaser := func(addr sdk.AccAddress) []uint64 {
+		return getIds(func(cb func(po types.EnterpriseUndPurchaseOrder) bool) {
+			app.EnterpriseKeeper.IteratePurchaseOrdersByPurchaser(ctx, addr, cb)
+		})
+	}
+	byStatus := func(status types.PurchaseOrderStatus) []uint64 {
+		return getIds(func(cb func(po types.EnterpriseUndPurchaseOrder) bool) {
+			app.EnterpriseKeeper.IteratePurchaseOrdersByStatus(ctx, status, cb)
+		})
+	}
+
+	for i := 0; i < 3; i++ {
+		_, err := app.EnterpriseKeeper.RaiseNewPurchaseOrder(ctx, types.EnterpriseUndPurchaseOrder{
+			Purchaser: testAddrs[i%2].String(),
+			Amount:    sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+		})
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, []uint64{1, 3}, byPurchaser(testAddrs[0]))
+	require.Equal(t, []uint64{2}, byPurchaser(testAddrs[1]))
+	require.Equal(t, []uint64{1, 2, 3}, byStatus(types.StatusRaised))
+	require.Empty(t, byStatus(types.StatusAccepted))
+
+	// status transitions move the purchase order between status indexes
+	require.NoError(t, app.EnterpriseKeeper.FinalisePurchaseOrderDecision(ctx, 1, types.StatusAccepted))
+	require.NoError(t, app.EnterpriseKeeper.WithdrawPurchaseOrder(ctx, 2))
+	require.Equal(t, []uint64{3}, byStatus(types.StatusRaised))
+	require.Equal(t, []uint64{1}, byStatus(types.StatusAccepted))
+	require.Equal(t, []uint64{2}, byStatus(types.StatusWithdrawn))
+
+	require.NoError(t, app.EnterpriseKeeper.ProcessAcceptedPurchaseOrders(ctx))
+	require.Empty(t, byStatus(types.StatusAccepted))
+	require.Equal(t, []uint64{1}, byStatus(types.StatusCompleted))
+
+	// purchaser index is unchanged
+	require.Equal(t, []uint64{1, 3}, byPurchaser(testAddrs[0]))
+	require.Equal(t, []uint64{2}, byPurchaser(testAddrs[1]))
+
+	// purchase orders must have a valid purchaser to be indexed
+	err := app.EnterpriseKeeper.SetPurchaseOrder(ctx, types.EnterpriseUndPurchaseOrder{Id: 4, Purchaser: "rubbish", Status: types.StatusRaised})
+	require.Error(t, err)
+	require.False(t, app.EnterpriseKeeper.PurchaseOrderExists(ctx, 4))
+}
+
 // Tests for Raise new Purchase Order
 
 func TestRaiseNewPurchaseOrder(t *testing.T) {
@@ -172,7 +230,7 @@ func TestProcessPurchaseOrderAfterRaise(t *testing.T) {
 
 	testAddrs := simapphelpers.GenerateRandomTestAccounts(1)
 
-	entSigners := app.EnterpriseKeeper.GetParamEntSignersAsAddressArray(ctx)
+	entSigners := app.EnterpriseKeeper.GetEntSignersAsAddressArray(ctx)
 	entSignerAddr := entSigners[0]
 
 	from := testAddrs[0]
@@ -206,6 +264,74 @@ func TestProcessPurchaseOrderAfterRaise(t *testing.T) {
 	}
 }
 
+func TestWithdrawPurchaseOrder(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(1)
+	from := testAddrs[0]
+
+	err := app.EnterpriseKeeper.WithdrawPurchaseOrder(ctx, 99)
+	require.ErrorIs(t, err, types.ErrPurchaseOrderDoesNotExist)
+
+	po := types.EnterpriseUndPurchaseOrder{
+		Purchaser: from.String(),
+		Amount:    sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+	}
+	poID, err := app.EnterpriseKeeper.RaiseNewPurchaseOrder(ctx, po)
+	require.NoError(t, err)
+	require.True(t, app.EnterpriseKeeper.PurchaseOrderIsInRaisedQueue(ctx, poID))
+
+	err = app.EnterpriseKeeper.WithdrawPurchaseOrder(ctx, poID)
+	require.NoError(t, err)
+
+	poDb, found := app.EnterpriseKeeper.GetPurchaseOrder(ctx, poID)
+	require.True(t, found)
+	require.Equal(t, types.StatusWithdrawn, poDb.Status)
+	require.Equal(t, uint64(ctx.BlockHeader().Time.Unix()), poDb.CompletionTime)
+	require.False(t, app.EnterpriseKeeper.PurchaseOrderIsInRaisedQueue(ctx, poID))
+}
+
+func TestAmendPurchaseOrder(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(1)
+	from := testAddrs[0]
+
+	entSigners := app.EnterpriseKeeper.GetEntSignersAsAddressArray(ctx)
+
+	newAmount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000)
+
+	err := app.EnterpriseKeeper.AmendPurchaseOrder(ctx, 99, newAmount)
+	require.ErrorIs(t, err, types.ErrPurchaseOrderDoesNotExist)
+
+	po := types.EnterpriseUndPurchaseOrder{
+		Purchaser: from.String(),
+		Amount:    sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+	}
+	poID, err := app.EnterpriseKeeper.RaiseNewPurchaseOrder(ctx, po)
+	require.NoError(t, err)
+
+	err = app.EnterpriseKeeper.ProcessPurchaseOrderDecision(ctx, poID, types.StatusAccepted, entSigners[0])
+	require.NoError(t, err)
+
+	amendTime := ctx.BlockHeader().Time.Add(time.Hour)
+	ctx = ctx.WithBlockTime(amendTime)
+
+	err = app.EnterpriseKeeper.AmendPurchaseOrder(ctx, poID, newAmount)
+	require.NoError(t, err)
+
+	// the amended order is still raised, and decided afresh
+	poDb, found := app.EnterpriseKeeper.GetPurchaseOrder(ctx, poID)
+	require.True(t, found)
+	require.Equal(t, types.StatusRaised, poDb.Status)
+	require.Equal(t, newAmount, poDb.Amount)
+	require.Len(t, poDb.Decisions, 0)
+	require.Equal(t, uint64(amendTime.Unix()), poDb.RaiseTime)
+	require.True(t, app.EnterpriseKeeper.PurchaseOrderIsInRaisedQueue(ctx, poID))
+}
+
 func TestRaisedQueue(t *testing.T) {
 	app := simapphelpers.Setup(t)
 	ctx := app.BaseApp.NewContext(false)
@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"strconv"
+
 	errorsmod "cosmossdk.io/errors"
+	mathmod "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -9,29 +12,62 @@ import (
 	"github.com/unification-com/mainchain/x/enterprise/types"
 )
 
-// Check if a record exists for locked FUND given an account address
+// Check if an address has a whitelist entry which has not expired
 func (k Keeper) AddressIsWhitelisted(ctx sdk.Context, address sdk.AccAddress) bool {
 	if address.Empty() {
 		return false
 	}
+	entry, found := k.GetWhitelistEntry(ctx, address)
+	if !found {
+		return false
+	}
+	return entry.IsActive(uint64(ctx.BlockHeader().Time.Unix()))
+}
+
+// GetWhitelistEntry returns the whitelist entry for an address. The entry may have expired
+func (k Keeper) GetWhitelistEntry(ctx sdk.Context, address sdk.AccAddress) (types.WhitelistEntry, bool) {
 	store := ctx.KVStore(k.storeKey)
-	addressKeyBz := types.WhitelistAddressStoreKey(address)
-	return store.Has(addressKeyBz)
+	bz := store.Get(types.WhitelistAddressStoreKey(address))
+	if bz == nil {
+		return types.WhitelistEntry{}, false
+	}
+
+	var entry types.WhitelistEntry
+	k.cdc.MustUnmarshal(bz, &entry)
+	return entry, true
 }
 
-// AddAddressToWhitelist adds an address to the whitelist
-func (k Keeper) AddAddressToWhitelist(ctx sdk.Context, address sdk.AccAddress) error {
+// SetWhitelistEntry sets a whitelist entry, replacing any existing entry for the address
+func (k Keeper) SetWhitelistEntry(ctx sdk.Context, entry types.WhitelistEntry) error {
+	address, err := sdk.AccAddressFromBech32(entry.Address)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
 
-	if address.Empty() {
-		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "address cannot be empty")
+	if err := types.ValidateWhitelistEntryAttributes(entry.MaxPurchasable, entry.Tier); err != nil {
+		return err
 	}
 
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.WhitelistAddressStoreKey(address), address)
+	store.Set(types.WhitelistAddressStoreKey(address), k.cdc.MustMarshal(&entry))
 
 	return nil
 }
 
+// AddAddressToWhitelist adds an address to the whitelist, with no expiry or purchase cap
+func (k Keeper) AddAddressToWhitelist(ctx sdk.Context, address sdk.AccAddress) error {
+
+	if address.Empty() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "address cannot be empty")
+	}
+
+	return k.SetWhitelistEntry(ctx, types.WhitelistEntry{
+		Address:        address.String(),
+		MaxPurchasable: sdk.NewCoin(k.GetParamDenom(ctx), mathmod.ZeroInt()),
+		AddedAt:        uint64(ctx.BlockHeader().Time.Unix()),
+	})
+}
+
 // RemoveAddressFromWhitelist removes an address from the whitelist
 func (k Keeper) RemoveAddressFromWhitelist(ctx sdk.Context, address sdk.AccAddress) error {
 
@@ -39,46 +75,130 @@ func (k Keeper) RemoveAddressFromWhitelist(ctx sdk.Context, address sdk.AccAddre
 		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "address cannot be empty")
 	}
 
-	if k.AddressIsWhitelisted(ctx, address) {
-		store := ctx.KVStore(k.storeKey)
-		store.Delete(types.WhitelistAddressStoreKey(address))
-	}
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.WhitelistAddressStoreKey(address))
 
 	return nil
 }
 
-// IterateWhitelist iterates over the all the whitelisted addresses and performs a callback function
-func (k Keeper) IterateWhitelist(ctx sdk.Context, cb func(addr sdk.AccAddress) (stop bool)) {
+// IterateWhitelist iterates over the all the whitelist entries, including expired entries, and performs a callback function
+func (k Keeper) IterateWhitelist(ctx sdk.Context, cb func(entry types.WhitelistEntry) (stop bool)) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := storetypes.KVStorePrefixIterator(store, types.WhitelistKeyPrefix)
 
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
-		addr := iterator.Value()
+		var entry types.WhitelistEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
 
-		if cb(addr) {
+		if cb(entry) {
 			break
 		}
 	}
 }
 
+// GetAllWhitelistEntries returns all whitelist entries, including expired entries
+func (k Keeper) GetAllWhitelistEntries(ctx sdk.Context) (entries []types.WhitelistEntry) {
+	k.IterateWhitelist(ctx, func(entry types.WhitelistEntry) bool {
+		entries = append(entries, entry)
+		return false
+	})
+	return
+}
+
+// GetActiveWhitelistEntries returns the whitelist entries which have not expired
+func (k Keeper) GetActiveWhitelistEntries(ctx sdk.Context) (entries []types.WhitelistEntry) {
+	timeNow := uint64(ctx.BlockHeader().Time.Unix())
+	k.IterateWhitelist(ctx, func(entry types.WhitelistEntry) bool {
+		if entry.IsActive(timeNow) {
+			entries = append(entries, entry)
+		}
+		return false
+	})
+	return
+}
+
 // GetAllWhitelistedAddresses returns an array of all currently whitelisted addresses
 func (k Keeper) GetAllWhitelistedAddresses(ctx sdk.Context) (addresses []string) {
-	k.IterateWhitelist(ctx, func(addr sdk.AccAddress) bool {
-		addresses = append(addresses, addr.String())
+	for _, entry := range k.GetActiveWhitelistEntries(ctx) {
+		addresses = append(addresses, entry.Address)
+	}
+	return
+}
+
+// GetAcceptedPurchaseAmount returns the total amount of a purchaser's accepted purchase orders, including
+// those which have been completed and their eFUND minted
+func (k Keeper) GetAcceptedPurchaseAmount(ctx sdk.Context, purchaser sdk.AccAddress) sdk.Coin {
+	total := sdk.NewCoin(k.GetParamDenom(ctx), mathmod.ZeroInt())
+	k.IteratePurchaseOrdersByPurchaser(ctx, purchaser, func(po types.EnterpriseUndPurchaseOrder) bool {
+		if (po.Status == types.StatusAccepted || po.Status == types.StatusCompleted) && po.Amount.Denom == total.Denom {
+			total = total.Add(po.Amount)
+		}
 		return false
 	})
-	return
+	return total
 }
 
-// ProcessWhitelistAction processes the add/remove whitelist messages
-func (k Keeper) ProcessWhitelistAction(ctx sdk.Context, address sdk.AccAddress, action types.WhitelistAction, signer sdk.AccAddress) error {
+// CheckPurchaseCap returns an error if purchasing the given amount would take the purchaser's accepted
+// purchase orders over the maximum purchasable in their whitelist entry
+func (k Keeper) CheckPurchaseCap(ctx sdk.Context, purchaser sdk.AccAddress, amount sdk.Coin) error {
+	entry, found := k.GetWhitelistEntry(ctx, purchaser)
+	if !found || !entry.HasPurchaseCap() {
+		return nil
+	}
+
+	accepted := k.GetAcceptedPurchaseAmount(ctx, purchaser)
+	if accepted.Add(amount).Amount.GT(entry.MaxPurchasable.Amount) {
+		return errorsmod.Wrapf(types.ErrPurchaseCapExceeded, "%s already accepted, cannot purchase %s with a cap of %s", accepted, amount, entry.MaxPurchasable)
+	}
+
+	return nil
+}
+
+// exceedsPurchaseCap checks the purchase cap again before a raised purchase order is accepted, since other
+// purchase orders may have been accepted since it was raised. Emits an event if the cap would be exceeded
+func (k Keeper) exceedsPurchaseCap(ctx sdk.Context, po types.EnterpriseUndPurchaseOrder) bool {
+	purchaser, err := sdk.AccAddressFromBech32(po.Purchaser)
+	if err != nil {
+		return false
+	}
+
+	err = k.CheckPurchaseCap(ctx, purchaser, po.Amount)
+	if err == nil {
+		return false
+	}
+
+	if !ctx.IsCheckTx() {
+		k.Logger(ctx).Debug("purchase order would exceed purchase cap", "poid", po.Id, "purchaser", po.Purchaser, "err", err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePurchaseCapExceeded,
+			sdk.NewAttribute(types.AttributeKeyPurchaseOrderID, strconv.FormatUint(po.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyPurchaser, po.Purchaser),
+			sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+		),
+	)
+
+	return true
+}
+
+// ProcessWhitelistAction processes the add/remove whitelist messages. When adding, the entry's
+// expiry time, purchase cap and tier are set. Expired entries can be replaced.
+func (k Keeper) ProcessWhitelistAction(ctx sdk.Context, entry types.WhitelistEntry, action types.WhitelistAction, signer sdk.AccAddress) error {
 
 	logger := k.Logger(ctx)
 
+	address, err := sdk.AccAddressFromBech32(entry.Address)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
 	if action == types.WhitelistActionAdd {
 		if !k.AddressIsWhitelisted(ctx, address) {
-			err := k.AddAddressToWhitelist(ctx, address)
+			entry.AddedAt = uint64(ctx.BlockHeader().Time.Unix())
+			err := k.SetWhitelistEntry(ctx, entry)
 			if err != nil {
 				return err
 			}
@@ -88,7 +208,7 @@ func (k Keeper) ProcessWhitelistAction(ctx sdk.Context, address sdk.AccAddress,
 		}
 	}
 	if action == types.WhitelistActionRemove {
-		if k.AddressIsWhitelisted(ctx, address) {
+		if _, found := k.GetWhitelistEntry(ctx, address); found {
 			err := k.RemoveAddressFromWhitelist(ctx, address)
 			if err != nil {
 				return err
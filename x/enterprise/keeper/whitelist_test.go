@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -9,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	simapphelpers "github.com/unification-com/mainchain/app/helpers"
+	"github.com/unification-com/mainchain/x/enterprise/types"
 )
 
 func TestAddAddressesToWhitelist(t *testing.T) {
@@ -67,3 +69,152 @@ func TestAddressIsWhitelisted(t *testing.T) {
 	isWhitelisted := app.EnterpriseKeeper.AddressIsWhitelisted(ctx, sdk.AccAddress{})
 	require.False(t, isWhitelisted)
 }
+
+func TestWhitelistEntryExpiry(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithBlockTime(time.Unix(1000, 0))
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(2)
+	signer := testAddrs[1]
+
+	entry := types.WhitelistEntry{
+		Address:        testAddrs[0].String(),
+		ExpiryTime:     2000,
+		MaxPurchasable: sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+		Tier:           "gold",
+	}
+
+	err := app.EnterpriseKeeper.ProcessWhitelistAction(ctx, entry, types.WhitelistActionAdd, signer)
+	require.NoError(t, err)
+	require.True(t, app.EnterpriseKeeper.AddressIsWhitelisted(ctx, testAddrs[0]))
+	require.Equal(t, []string{testAddrs[0].String()}, app.EnterpriseKeeper.GetAllWhitelistedAddresses(ctx))
+
+	dbEntry, found := app.EnterpriseKeeper.GetWhitelistEntry(ctx, testAddrs[0])
+	require.True(t, found)
+	require.Equal(t, uint64(1000), dbEntry.AddedAt)
+	require.Equal(t, "gold", dbEntry.Tier)
+
+	err = app.EnterpriseKeeper.ProcessWhitelistAction(ctx, entry, types.WhitelistActionAdd, signer)
+	require.ErrorIs(t, err, types.ErrAlreadyWhitelisted)
+
+	// expired entries are no longer whitelisted, but are retained until replaced or removed
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	require.False(t, app.EnterpriseKeeper.AddressIsWhitelisted(ctx, testAddrs[0]))
+	require.Empty(t, app.EnterpriseKeeper.GetAllWhitelistedAddresses(ctx))
+	require.Len(t, app.EnterpriseKeeper.GetAllWhitelistEntries(ctx), 1)
+
+	entry.ExpiryTime = 3000
+	err = app.EnterpriseKeeper.ProcessWhitelistAction(ctx, entry, types.WhitelistActionAdd, signer)
+	require.NoError(t, err)
+	require.True(t, app.EnterpriseKeeper.AddressIsWhitelisted(ctx, testAddrs[0]))
+
+	dbEntry, _ = app.EnterpriseKeeper.GetWhitelistEntry(ctx, testAddrs[0])
+	require.Equal(t, uint64(2000), dbEntry.AddedAt)
+	require.Equal(t, uint64(3000), dbEntry.ExpiryTime)
+
+	ctx = ctx.WithBlockTime(time.Unix(3000, 0))
+	err = app.EnterpriseKeeper.ProcessWhitelistAction(ctx, entry, types.WhitelistActionRemove, signer)
+	require.NoError(t, err)
+	_, found = app.EnterpriseKeeper.GetWhitelistEntry(ctx, testAddrs[0])
+	require.False(t, found)
+}
+
+func TestCheckPurchaseCap(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(2)
+	denom := app.EnterpriseKeeper.GetParamDenom(ctx)
+
+	// no cap
+	_ = app.EnterpriseKeeper.AddAddressToWhitelist(ctx, testAddrs[1])
+	require.NoError(t, app.EnterpriseKeeper.CheckPurchaseCap(ctx, testAddrs[1], sdk.NewInt64Coin(denom, 1000000)))
+
+	err := app.EnterpriseKeeper.SetWhitelistEntry(ctx, types.WhitelistEntry{
+		Address:        testAddrs[0].String(),
+		MaxPurchasable: sdk.NewInt64Coin(denom, 1000),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, app.EnterpriseKeeper.CheckPurchaseCap(ctx, testAddrs[0], sdk.NewInt64Coin(denom, 1000)))
+
+	statuses := []types.PurchaseOrderStatus{
+		types.StatusAccepted,
+		types.StatusCompleted,
+		types.StatusRaised,
+		types.StatusRejected,
+	}
+
+	for i, status := range statuses {
+		err = app.EnterpriseKeeper.SetPurchaseOrder(ctx, types.EnterpriseUndPurchaseOrder{
+			Id:        uint64(i + 1),
+			Purchaser: testAddrs[0].String(),
+			Amount:    sdk.NewInt64Coin(denom, 300),
+			Status:    status,
+		})
+		require.NoError(t, err)
+	}
+
+	require.Equal(t, sdk.NewInt64Coin(denom, 600), app.EnterpriseKeeper.GetAcceptedPurchaseAmount(ctx, testAddrs[0]))
+	require.NoError(t, app.EnterpriseKeeper.CheckPurchaseCap(ctx, testAddrs[0], sdk.NewInt64Coin(denom, 400)))
+	err = app.EnterpriseKeeper.CheckPurchaseCap(ctx, testAddrs[0], sdk.NewInt64Coin(denom, 401))
+	require.ErrorIs(t, err, types.ErrPurchaseCapExceeded)
+}
+
+func TestPurchaseCapCheckedOnAcceptance(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false).WithEventManager(sdk.NewEventManager())
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(2)
+	denom := app.EnterpriseKeeper.GetParamDenom(ctx)
+	entSignerAddr := app.EnterpriseKeeper.GetEntSignersAsAddressArray(ctx)[0]
+
+	// each order is within the cap when raised, but together they exceed it
+	raiseTwo := func(purchaser sdk.AccAddress) (uint64, uint64) {
+		err := app.EnterpriseKeeper.SetWhitelistEntry(ctx, types.WhitelistEntry{
+			Address:        purchaser.String(),
+			MaxPurchasable: sdk.NewInt64Coin(denom, 1000),
+		})
+		require.NoError(t, err)
+
+		var poIDs []uint64
+		for i := 0; i < 2; i++ {
+			amount := sdk.NewInt64Coin(denom, 600)
+			require.NoError(t, app.EnterpriseKeeper.CheckPurchaseCap(ctx, purchaser, amount))
+			poID, err := app.EnterpriseKeeper.RaiseNewPurchaseOrder(ctx, types.EnterpriseUndPurchaseOrder{Purchaser: purchaser.String(), Amount: amount})
+			require.NoError(t, err)
+			poIDs = append(poIDs, poID)
+		}
+		return poIDs[0], poIDs[1]
+	}
+
+	requireStatus := func(poID uint64, status types.PurchaseOrderStatus) {
+		po, found := app.EnterpriseKeeper.GetPurchaseOrder(ctx, poID)
+		require.True(t, found)
+		require.Equal(t, status, po.Status)
+	}
+
+	// decided by an x/group policy
+	firstID, secondID := raiseTwo(testAddrs[0])
+	require.NoError(t, app.EnterpriseKeeper.FinalisePurchaseOrderDecision(ctx, firstID, types.StatusAccepted))
+	require.NoError(t, app.EnterpriseKeeper.FinalisePurchaseOrderDecision(ctx, secondID, types.StatusAccepted))
+	requireStatus(firstID, types.StatusAccepted)
+	requireStatus(secondID, types.StatusRejected)
+	require.Equal(t, sdk.NewInt64Coin(denom, 600), app.EnterpriseKeeper.GetAcceptedPurchaseAmount(ctx, testAddrs[0]))
+	require.NotContains(t, app.EnterpriseKeeper.GetAllRaisedPurchaseOrders(ctx), secondID)
+
+	// tallied in the EndBlocker
+	firstID, secondID = raiseTwo(testAddrs[1])
+	require.NoError(t, app.EnterpriseKeeper.ProcessPurchaseOrderDecision(ctx, firstID, types.StatusAccepted, entSignerAddr))
+	require.NoError(t, app.EnterpriseKeeper.ProcessPurchaseOrderDecision(ctx, secondID, types.StatusAccepted, entSignerAddr))
+	require.NoError(t, app.EnterpriseKeeper.TallyPurchaseOrderDecisions(ctx))
+	requireStatus(firstID, types.StatusAccepted)
+	requireStatus(secondID, types.StatusRejected)
+	require.Equal(t, sdk.NewInt64Coin(denom, 600), app.EnterpriseKeeper.GetAcceptedPurchaseAmount(ctx, testAddrs[1]))
+	require.Empty(t, app.EnterpriseKeeper.GetAllRaisedPurchaseOrders(ctx))
+
+	numCapExceeded := 0
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == types.EventTypePurchaseCapExceeded {
+			numCapExceeded++
+		}
+	}
+	require.Equal(t, 2, numCapExceeded)
+}
@@ -0,0 +1,24 @@
+package v10
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+const (
+	ModuleName = "enterprise"
+)
+
+// Migrate performs in-place store migrations from v9 to v10. The eFUND ledger starts empty, and
+// records entries from the upgrade height onwards. The LedgerRetentionPeriod param defaults to 0, so
+// entries are kept indefinitely.
+func Migrate(ctx sdk.Context, store storetypes.KVStore, _ codec.BinaryCodec) error {
+	ctx.Logger().Info("Migrating Enterprise Module - initialising the eFUND ledger")
+	if !store.Has(types.HighestLedgerEntryIDKey) {
+		store.Set(types.HighestLedgerEntryIDKey, types.GetPurchaseOrderIDBytes(1))
+	}
+	return nil
+}
@@ -0,0 +1,32 @@
+package v10_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unification-com/mainchain/x/enterprise"
+	v10 "github.com/unification-com/mainchain/x/enterprise/migrations/v10"
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(enterprise.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v10.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	require.False(t, store.Has(types.HighestLedgerEntryIDKey))
+
+	// Run migrations.
+	err := v10.Migrate(ctx, store, cdc)
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(1), types.GetPurchaseOrderIDFromBytes(store.Get(types.HighestLedgerEntryIDKey)))
+}
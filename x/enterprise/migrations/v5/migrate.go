@@ -0,0 +1,70 @@
+package v5
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+const (
+	ModuleName = "enterprise"
+)
+
+// migrateEntSigners moves the signers held in the legacy comma separated EntSigners param into the
+// signer registry, then clears the param. All other params are kept.
+func migrateEntSigners(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return fmt.Errorf("enterprise params not found")
+	}
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	addedAt := uint64(ctx.BlockHeader().Time.Unix())
+	numEntSigners := 0
+
+	for _, authAddr := range params.LegacyEntSigners() {
+		addr, err := sdk.AccAddressFromBech32(authAddr)
+		if err != nil {
+			return err
+		}
+
+		key := types.EntSignerStoreKey(addr)
+		if store.Has(key) {
+			continue
+		}
+
+		entSigner := types.EntSigner{
+			Address: addr.String(),
+			AddedAt: addedAt,
+		}
+		store.Set(key, cdc.MustMarshal(&entSigner))
+		numEntSigners++
+	}
+
+	if numEntSigners < int(params.MinAccepts) {
+		return fmt.Errorf("number of ent signers (%d) must be >= number of minimum accepts (%d)", numEntSigners, params.MinAccepts)
+	}
+
+	params.EntSigners = ""
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
+
+// Migrate performs in-place store migrations from v4 to v5.
+func Migrate(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Migrating Enterprise Module - moving ent signers to the signer registry")
+	return migrateEntSigners(ctx, store, cdc)
+}
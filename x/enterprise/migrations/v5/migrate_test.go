@@ -0,0 +1,83 @@
+package v5_test
+
+import (
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unification-com/mainchain/x/enterprise"
+	v5 "github.com/unification-com/mainchain/x/enterprise/migrations/v5"
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(enterprise.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v5.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	ctx = ctx.WithBlockTime(time.Unix(1700000000, 0))
+	store := ctx.KVStore(storeKey)
+
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// v4 params hold the signers as a comma separated string
+	oldParams := types.Params{
+		EntSigners:        addr1.String() + "," + addr2.String(),
+		Denom:             "nund",
+		MinAccepts:        2,
+		DecisionTimeLimit: 3600,
+	}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	// Run migrations.
+	err := v5.Migrate(ctx, store, cdc)
+	require.NoError(t, err)
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, "", params.EntSigners)
+	require.Equal(t, oldParams.Denom, params.Denom)
+	require.Equal(t, oldParams.MinAccepts, params.MinAccepts)
+	require.Equal(t, oldParams.DecisionTimeLimit, params.DecisionTimeLimit)
+
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		bz := store.Get(types.EntSignerStoreKey(addr))
+		require.NotNil(t, bz)
+		var entSigner types.EntSigner
+		cdc.MustUnmarshal(bz, &entSigner)
+		require.Equal(t, addr.String(), entSigner.Address)
+		require.Equal(t, uint64(1700000000), entSigner.AddedAt)
+	}
+}
+
+func TestMigrateInsufficientSigners(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(enterprise.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v5.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	oldParams := types.Params{
+		EntSigners:        addr1.String() + "," + addr1.String(),
+		Denom:             "nund",
+		MinAccepts:        2,
+		DecisionTimeLimit: 3600,
+	}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	err := v5.Migrate(ctx, store, cdc)
+	require.Error(t, err)
+}
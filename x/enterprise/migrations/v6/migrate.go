@@ -0,0 +1,60 @@
+package v6
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+const (
+	ModuleName = "enterprise"
+)
+
+// migrateLockedUnd moves each account's existing locked eFUND into a single lot, which does not expire.
+// All lots created afterward expire at the time recorded on their purchase order when it was accepted.
+func migrateLockedUnd(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	lockedAt := uint64(ctx.BlockHeader().Time.Unix())
+	lotID := uint64(1)
+
+	var lockedUnds []types.LockedUnd
+	iterator := storetypes.KVStorePrefixIterator(store, types.LockedUndAddressKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var locked types.LockedUnd
+		cdc.MustUnmarshal(iterator.Value(), &locked)
+		lockedUnds = append(lockedUnds, locked)
+	}
+	iterator.Close()
+
+	for _, locked := range lockedUnds {
+		if !locked.Amount.IsPositive() {
+			continue
+		}
+
+		owner, err := sdk.AccAddressFromBech32(locked.Owner)
+		if err != nil {
+			return err
+		}
+
+		lot := types.LockedEFUNDLot{
+			Id:       lotID,
+			Owner:    locked.Owner,
+			Amount:   locked.Amount,
+			LockedAt: lockedAt,
+		}
+		store.Set(types.LockedEFUNDLotKey(lot.Id), cdc.MustMarshal(&lot))
+		store.Set(types.LockedEFUNDLotOwnerKey(owner, lot.Id), []byte{})
+		lotID++
+	}
+
+	store.Set(types.HighestLockedEFUNDLotIDKey, types.GetPurchaseOrderIDBytes(lotID))
+
+	return nil
+}
+
+// Migrate performs in-place store migrations from v5 to v6.
+func Migrate(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Migrating Enterprise Module - moving locked eFUND into lots")
+	return migrateLockedUnd(ctx, store, cdc)
+}
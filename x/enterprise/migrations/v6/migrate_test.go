@@ -0,0 +1,71 @@
+package v6_test
+
+import (
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unification-com/mainchain/x/enterprise"
+	v6 "github.com/unification-com/mainchain/x/enterprise/migrations/v6"
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(enterprise.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v6.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	ctx = ctx.WithBlockTime(time.Unix(1700000000, 0))
+	store := ctx.KVStore(storeKey)
+
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr3 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	lockedUnds := []types.LockedUnd{
+		{Owner: addr1.String(), Amount: sdk.NewInt64Coin("nund", 1000)},
+		{Owner: addr2.String(), Amount: sdk.NewInt64Coin("nund", 2000)},
+		{Owner: addr3.String(), Amount: sdk.NewInt64Coin("nund", 0)},
+	}
+	for _, locked := range lockedUnds {
+		owner := sdk.MustAccAddressFromBech32(locked.Owner)
+		store.Set(types.LockedUndAddressStoreKey(owner), cdc.MustMarshal(&locked))
+	}
+
+	// Run migrations.
+	err := v6.Migrate(ctx, store, cdc)
+	require.NoError(t, err)
+
+	lotsByOwner := make(map[string]types.LockedEFUNDLot)
+	iterator := storetypes.KVStorePrefixIterator(store, types.LockedEFUNDLotKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var lot types.LockedEFUNDLot
+		cdc.MustUnmarshal(iterator.Value(), &lot)
+		lotsByOwner[lot.Owner] = lot
+
+		owner := sdk.MustAccAddressFromBech32(lot.Owner)
+		require.True(t, store.Has(types.LockedEFUNDLotOwnerKey(owner, lot.Id)))
+	}
+	iterator.Close()
+
+	// zero balances do not get a lot
+	require.Len(t, lotsByOwner, 2)
+	for _, locked := range lockedUnds[:2] {
+		lot, ok := lotsByOwner[locked.Owner]
+		require.True(t, ok)
+		require.Equal(t, locked.Amount, lot.Amount)
+		require.Equal(t, uint64(0), lot.PurchaseOrderId)
+		require.Equal(t, uint64(1700000000), lot.LockedAt)
+		require.Equal(t, uint64(0), lot.ExpiryTime)
+	}
+
+	require.Equal(t, uint64(3), types.GetPurchaseOrderIDFromBytes(store.Get(types.HighestLockedEFUNDLotIDKey)))
+}
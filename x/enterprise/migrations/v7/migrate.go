@@ -0,0 +1,46 @@
+package v7
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+const (
+	ModuleName = "enterprise"
+)
+
+// migrateParams sets the EfundFeeMsgTypes param to the defaults, which keep the existing WRKChain and
+// BEACON Msgs and add the stream MsgCreateStream and MsgTopUpDeposit, and sets the EfundMaxFee param
+// capping the eFUND unlocked for the stream Msgs' fees. All other params are kept.
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return fmt.Errorf("enterprise params not found")
+	}
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	params.EfundFeeMsgTypes = types.DefaultEFUNDFeeMsgTypes
+	params.EfundMaxFee = types.DefaultEFUNDMaxFee
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
+
+// Migrate performs in-place store migrations from v6 to v7.
+func Migrate(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Migrating Enterprise Module - setting eFUND fee msg types param")
+	return migrateParams(store, cdc)
+}
@@ -0,0 +1,47 @@
+package v7_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unification-com/mainchain/x/enterprise"
+	v7 "github.com/unification-com/mainchain/x/enterprise/migrations/v7"
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(enterprise.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v7.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	oldParams := types.Params{
+		Denom:             "nund",
+		MinAccepts:        1,
+		DecisionTimeLimit: 3600,
+		EfundExpiryPeriod: 86400,
+	}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	// Run migrations.
+	err := v7.Migrate(ctx, store, cdc)
+	require.NoError(t, err)
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, oldParams.Denom, params.Denom)
+	require.Equal(t, oldParams.MinAccepts, params.MinAccepts)
+	require.Equal(t, oldParams.DecisionTimeLimit, params.DecisionTimeLimit)
+	require.Equal(t, oldParams.EfundExpiryPeriod, params.EfundExpiryPeriod)
+	require.Equal(t, types.DefaultEFUNDFeeMsgTypes, params.EfundFeeMsgTypes)
+	require.Equal(t, types.DefaultEFUNDMaxFee, params.EfundMaxFee)
+	require.True(t, params.IsEFUNDFeeMsgType("/mainchain.stream.v1.MsgCreateStream"))
+	require.True(t, params.IsEFUNDFeeMsgType("/mainchain.wrkchain.v1.MsgRecordWrkChainBlock"))
+}
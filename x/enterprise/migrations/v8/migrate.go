@@ -0,0 +1,43 @@
+package v8
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+const (
+	ModuleName = "enterprise"
+)
+
+// indexPurchaseOrders builds the purchaser and status indexes for all existing purchase orders
+func indexPurchaseOrders(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var purchaseOrders []types.EnterpriseUndPurchaseOrder
+	iterator := storetypes.KVStorePrefixIterator(store, types.PurchaseOrderIDKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var po types.EnterpriseUndPurchaseOrder
+		cdc.MustUnmarshal(iterator.Value(), &po)
+		purchaseOrders = append(purchaseOrders, po)
+	}
+	iterator.Close()
+
+	for _, po := range purchaseOrders {
+		purchaser, err := sdk.AccAddressFromBech32(po.Purchaser)
+		if err != nil {
+			return err
+		}
+
+		store.Set(types.PurchaseOrderPurchaserIndexKey(purchaser, po.Id), []byte{})
+		store.Set(types.PurchaseOrderStatusIndexKey(po.Status, po.Id), []byte{})
+	}
+
+	return nil
+}
+
+// Migrate performs in-place store migrations from v7 to v8.
+func Migrate(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Migrating Enterprise Module - indexing purchase orders by purchaser and status")
+	return indexPurchaseOrders(store, cdc)
+}
@@ -0,0 +1,51 @@
+package v8_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unification-com/mainchain/x/enterprise"
+	v8 "github.com/unification-com/mainchain/x/enterprise/migrations/v8"
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(enterprise.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v8.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	purchaseOrders := []types.EnterpriseUndPurchaseOrder{
+		{Id: 1, Purchaser: addr1.String(), Amount: sdk.NewInt64Coin("nund", 100), Status: types.StatusCompleted},
+		{Id: 2, Purchaser: addr2.String(), Amount: sdk.NewInt64Coin("nund", 100), Status: types.StatusRaised},
+		{Id: 3, Purchaser: addr1.String(), Amount: sdk.NewInt64Coin("nund", 100), Status: types.StatusRaised},
+	}
+	for _, po := range purchaseOrders {
+		store.Set(types.PurchaseOrderKey(po.Id), cdc.MustMarshal(&po))
+	}
+
+	// Run migrations.
+	err := v8.Migrate(ctx, store, cdc)
+	require.NoError(t, err)
+
+	for _, po := range purchaseOrders {
+		purchaser, _ := sdk.AccAddressFromBech32(po.Purchaser)
+		require.True(t, store.Has(types.PurchaseOrderPurchaserIndexKey(purchaser, po.Id)))
+		require.True(t, store.Has(types.PurchaseOrderStatusIndexKey(po.Status, po.Id)))
+	}
+
+	require.False(t, store.Has(types.PurchaseOrderPurchaserIndexKey(addr2, 1)))
+	require.False(t, store.Has(types.PurchaseOrderStatusIndexKey(types.StatusRaised, 1)))
+}
@@ -0,0 +1,55 @@
+package v9
+
+import (
+	"fmt"
+
+	mathmod "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+const (
+	ModuleName = "enterprise"
+)
+
+// migrateWhitelist converts each whitelisted address, previously stored as the raw address bytes, into a
+// whitelist entry with no expiry, purchase cap or tier
+func migrateWhitelist(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return fmt.Errorf("enterprise params not found")
+	}
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	addedAt := uint64(ctx.BlockHeader().Time.Unix())
+
+	var addresses []sdk.AccAddress
+	iterator := storetypes.KVStorePrefixIterator(store, types.WhitelistKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, sdk.AccAddress(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, addr := range addresses {
+		entry := types.WhitelistEntry{
+			Address:        addr.String(),
+			MaxPurchasable: sdk.NewCoin(params.Denom, mathmod.ZeroInt()),
+			AddedAt:        addedAt,
+		}
+		store.Set(types.WhitelistAddressStoreKey(addr), cdc.MustMarshal(&entry))
+	}
+
+	return nil
+}
+
+// Migrate performs in-place store migrations from v8 to v9.
+func Migrate(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Migrating Enterprise Module - converting the whitelist to whitelist entries")
+	return migrateWhitelist(ctx, store, cdc)
+}
@@ -0,0 +1,55 @@
+package v9_test
+
+import (
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unification-com/mainchain/x/enterprise"
+	v9 "github.com/unification-com/mainchain/x/enterprise/migrations/v9"
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(enterprise.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v9.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	ctx = ctx.WithBlockTime(time.Unix(1700000000, 0))
+	store := ctx.KVStore(storeKey)
+
+	params := types.DefaultParams()
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	addr1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	addr2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	// v8 stores the raw address bytes
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		store.Set(types.WhitelistAddressStoreKey(addr), addr)
+	}
+
+	// Run migrations.
+	err := v9.Migrate(ctx, store, cdc)
+	require.NoError(t, err)
+
+	for _, addr := range []sdk.AccAddress{addr1, addr2} {
+		var entry types.WhitelistEntry
+		cdc.MustUnmarshal(store.Get(types.WhitelistAddressStoreKey(addr)), &entry)
+		require.Equal(t, addr.String(), entry.Address)
+		require.Equal(t, uint64(0), entry.ExpiryTime)
+		require.False(t, entry.HasPurchaseCap())
+		require.Equal(t, params.Denom, entry.MaxPurchasable.Denom)
+		require.Equal(t, "", entry.Tier)
+		require.Equal(t, uint64(1700000000), entry.AddedAt)
+		require.True(t, entry.IsActive(uint64(1800000000)))
+	}
+}
@@ -41,10 +41,20 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvB.Value, &tlB)
 			return fmt.Sprintf("%v\n%v", tlA, tlB)
 		case bytes.Equal(kvA.Key[:1], types.WhitelistKeyPrefix):
-			var wlA, wlB types.WhitelistAddresses
+			var wlA, wlB types.WhitelistEntry
 			cdc.MustUnmarshal(kvA.Value, &wlA)
 			cdc.MustUnmarshal(kvB.Value, &wlB)
 			return fmt.Sprintf("%v\n%v", wlA, wlB)
+		case bytes.Equal(kvA.Key[:1], types.LedgerEntryKeyPrefix):
+			var leA, leB types.EFUNDLedgerEntry
+			cdc.MustUnmarshal(kvA.Value, &leA)
+			cdc.MustUnmarshal(kvB.Value, &leB)
+			return fmt.Sprintf("%v\n%v", leA, leB)
+		case bytes.Equal(kvA.Key[:1], types.LinkedAddressKeyPrefix):
+			var laA, laB types.LinkedAddress
+			cdc.MustUnmarshal(kvA.Value, &laA)
+			cdc.MustUnmarshal(kvB.Value, &laB)
+			return fmt.Sprintf("%v\n%v", laA, laB)
 		case bytes.Equal(kvA.Key[:1], types.HighestPurchaseOrderIDKey):
 			kA := binary.BigEndian.Uint64(kvA.Value)
 			kB := binary.BigEndian.Uint64(kvB.Value)
@@ -17,6 +17,8 @@ import (
 var (
 	delPk1   = ed25519.GenPrivKey().PubKey()
 	delAddr1 = sdk.AccAddress(delPk1.Address())
+	delPk2   = ed25519.GenPrivKey().PubKey()
+	delAddr2 = sdk.AccAddress(delPk2.Address())
 )
 
 func TestDecodeStore(t *testing.T) {
@@ -43,11 +45,16 @@ func TestDecodeStore(t *testing.T) {
 	totalLockedBz, err := cdc.Marshal(&totalLocked)
 	require.NoError(t, err)
 
+	linkedAddress := types.LinkedAddress{Owner: delAddr1.String(), Linked: delAddr2.String(), AddedAt: 1234}
+	linkedAddressBz, err := cdc.Marshal(&linkedAddress)
+	require.NoError(t, err)
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.PurchaseOrderKey(1), Value: purchaseOrderBz},
 			{Key: types.LockedUndAddressStoreKey(delAddr1), Value: lockedUndBz},
 			{Key: types.TotalLockedUndKey, Value: totalLockedBz},
+			{Key: types.LinkedAddressStoreKey(delAddr1, delAddr2), Value: linkedAddressBz},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -59,6 +66,7 @@ func TestDecodeStore(t *testing.T) {
 		{"purchase orders", fmt.Sprintf("%v\n%v", purchaseOrder, purchaseOrder)},
 		{"locked unds", fmt.Sprintf("%v\n%v", lockedUnd, lockedUnd)},
 		{"total locked", fmt.Sprintf("%v\n%v", totalLocked, totalLocked)},
+		{"linked addresses", fmt.Sprintf("%v\n%v", linkedAddress, linkedAddress)},
 		{"other", ""},
 	}
 
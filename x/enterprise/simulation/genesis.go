@@ -66,13 +66,19 @@ func RandomizedGenState(simState *module.SimulationState) {
 		},
 	)
 
+	var entSigners []types.EntSigner
+	for _, addr := range strings.Split(entAddress, ",") {
+		entSigners = append(entSigners, types.EntSigner{Address: addr})
+	}
+
 	// NOTE: for simulation, we're using sdk.DefaultBondDenom ("stake"), since "stake" is hard-coded
 	// into the SDK's module simulation functions
 	entGenesis := types.NewGenesisState(
-		types.NewParams(sdk.DefaultBondDenom, minAccepts, decisionLimit, entAddress),
+		types.NewParams(sdk.DefaultBondDenom, minAccepts, decisionLimit, "", types.DefaultEFUNDFeeMsgTypes),
 		uint64(1),
 		sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), nil, nil, nil,
 		sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), nil,
+		entSigners, nil, 1,
 	)
 
 	bz, err := json.MarshalIndent(&entGenesis, "", " ")
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
-	"strings"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -23,10 +22,14 @@ const (
 	OpWeightMsgUndPurchaseOrder        = "op_weight_msg_raise_ent_po"
 	OpWeightMsgProcessUndPurchaseOrder = "op_weight_msg_proc_ent_po"
 	OpWeightMsgWhitelistAddress        = "op_weight_msg_ent_whitelist"
+	OpWeightMsgTransferLockedEFUND     = "op_weight_msg_ent_transfer_locked"
+	OpWeightMsgLinkAddress             = "op_weight_msg_ent_link_address"
 
 	DefaultMsgUndPurchaseOrder        = 20
 	DefaultMsgProcessUndPurchaseOrder = 20
 	DefaultMsgWhitelistAddress        = 20
+	DefaultMsgTransferLockedEFUND     = 10
+	DefaultMsgLinkAddress             = 10
 )
 
 //func WeightedOperations(
@@ -46,6 +49,8 @@ func WeightedOperations(
 		weightMsgUndPurchaseOrder        int
 		weightMsgProcessUndPurchaseOrder int
 		weightMsgWhitelistAddress        int
+		weightMsgTransferLockedEFUND     int
+		weightMsgLinkAddress             int
 	)
 
 	appParams.GetOrGenerate(OpWeightMsgUndPurchaseOrder, &weightMsgUndPurchaseOrder, nil,
@@ -66,6 +71,18 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(OpWeightMsgTransferLockedEFUND, &weightMsgTransferLockedEFUND, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferLockedEFUND = DefaultMsgTransferLockedEFUND
+		},
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgLinkAddress, &weightMsgLinkAddress, nil,
+		func(_ *rand.Rand) {
+			weightMsgLinkAddress = DefaultMsgLinkAddress
+		},
+	)
+
 	wEntOps := simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgWhitelistAddress,
@@ -79,6 +96,14 @@ func WeightedOperations(
 			weightMsgProcessUndPurchaseOrder,
 			SimulateMsgProcessUndPurchaseOrder(k, bk, ak),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgLinkAddress,
+			SimulateMsgLinkAddress(k, bk, ak),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgTransferLockedEFUND,
+			SimulateMsgTransferLockedEFUND(k, bk, ak),
+		),
 	}
 
 	return wEntOps
@@ -150,8 +175,7 @@ func SimulateMsgUndPurchaseOrder(k keeper.Keeper, bk types.BankKeeper, ak types.
 
 		// schedule POs for decisions
 		// first, get ent signers
-		entSignerList := k.GetParamEntSigners(ctx)
-		entSignerArray := strings.Split(entSignerList, ",")
+		entSignerArray := k.GetEntSignersAsAddressArray(ctx)
 
 		// allow some POs to go stale
 		blocksInFuture := int64(1)
@@ -169,10 +193,9 @@ func SimulateMsgUndPurchaseOrder(k keeper.Keeper, bk types.BankKeeper, ak types.
 		fops := make([]simtypes.FutureOperation, len(entSignerArray))
 		for i := 0; i < len(entSignerArray); i++ {
 			whenDecide := ctx.BlockHeader().Height + blocksInFuture
-			signer, _ := sdk.AccAddressFromBech32(entSignerArray[i])
 			fops[i] = simtypes.FutureOperation{
 				BlockHeight: int(whenDecide),
-				Op:          operationSimulateMsgProcessUndPurchaseOrder(k, bk, ak, signer, int64(poId-1)),
+				Op:          operationSimulateMsgProcessUndPurchaseOrder(k, bk, ak, entSignerArray[i], int64(poId-1)),
 			}
 		}
 
@@ -323,16 +346,84 @@ func operationSimulateMsgProcessUndPurchaseOrder(k keeper.Keeper, bk types.BankK
 	}
 }
 
+func SimulateMsgTransferLockedEFUND(k keeper.Keeper, bk types.BankKeeper, ak types.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		fromAcc, _ := simtypes.RandomAcc(r, accs)
+		locked := k.GetLockedUndAmountForAccount(ctx, fromAcc.Address)
+
+		if !locked.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, types.TransferLockedAction, "no locked eFUND"), nil, nil
+		}
+
+		toAcc, _ := simtypes.RandomAcc(r, accs)
+
+		if toAcc.Address.Equals(fromAcc.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TransferLockedAction, "same address"), nil, nil
+		}
+
+		if !k.AddressIsWhitelisted(ctx, toAcc.Address) && !k.IsLinkedAddress(ctx, fromAcc.Address, toAcc.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.TransferLockedAction, "destination not whitelisted or linked"), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, fromAcc.Address)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TransferLockedAction, "unable to generate fees"), nil, err
+		}
+
+		randAmt, err := simtypes.RandPositiveInt(r, locked.Amount)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TransferLockedAction, "unable to generate amount"), nil, err
+		}
+
+		msg := types.NewMsgTransferLockedEFUND(fromAcc.Address, toAcc.Address, sdk.NewCoin(sdk.DefaultBondDenom, randAmt))
+
+		txGen := moduletestutil.MakeTestEncodingConfig().TxConfig
+
+		tx, err := simtestutil.GenSignedMockTx(
+			r,
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			simtestutil.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			fromAcc.PrivKey,
+		)
+
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, res, err := app.SimDeliver(txGen.TxEncoder(), tx)
+
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, errors.New(res.Log)
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
 func SimulateMsgProcessUndPurchaseOrder(k keeper.Keeper, bk types.BankKeeper, ak types.AccountKeeper) simtypes.Operation {
 	return operationSimulateMsgProcessUndPurchaseOrder(k, bk, ak, sdk.AccAddress{}, -1)
 }
 
 func getRandomEntSignerAcc(r *rand.Rand, k keeper.Keeper,
 	ctx sdk.Context) (entSigner sdk.AccAddress, err error) {
-	entSignerList := k.GetParamEntSigners(ctx)
-	entSignerArray := strings.Split(entSignerList, ",")
+	entSignerArray := k.GetEntSignersAsAddressArray(ctx)
+	if len(entSignerArray) == 0 {
+		return nil, errors.New("no ent signers registered")
+	}
 	rndIdx := r.Intn(len(entSignerArray))
-	return sdk.AccAddressFromBech32(entSignerArray[rndIdx])
+	return entSignerArray[rndIdx], nil
 }
 
 // Pick a random voting option
@@ -346,3 +437,71 @@ func randomDecisionOption(r *rand.Rand) types.PurchaseOrderStatus {
 		panic("invalid vote option")
 	}
 }
+
+func SimulateMsgLinkAddress(k keeper.Keeper, bk types.BankKeeper, ak types.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+
+		ownerAcc, _ := simtypes.RandomAcc(r, accs)
+		linkedAcc, _ := simtypes.RandomAcc(r, accs)
+
+		if ownerAcc.Address.Equals(linkedAcc.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.LinkAddressAction, "same address"), nil, nil
+		}
+
+		linkAction := types.WhitelistActionAdd
+		if k.IsLinkedAddress(ctx, ownerAcc.Address, linkedAcc.Address) {
+			linkAction = types.WhitelistActionRemove
+		}
+
+		enSignerAddr, err := getRandomEntSignerAcc(r, k, ctx)
+
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.LinkAddressAction, "unable to get addr from bech32"), nil, err
+		}
+
+		enSignerAccount, found := simtypes.FindAccount(accs, enSignerAddr)
+
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.LinkAddressAction, fmt.Sprintf("unable to find ent signer account %s", enSignerAddr)), nil, errors.New("unable to find ent signer account")
+		}
+
+		account := ak.GetAccount(ctx, enSignerAddr)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.LinkAddressAction, "unable to generate fees"), nil, err
+		}
+
+		msg := types.NewMsgLinkAddress(ownerAcc.Address, linkedAcc.Address, linkAction, enSignerAccount.Address)
+
+		txGen := moduletestutil.MakeTestEncodingConfig().TxConfig
+
+		tx, err := simtestutil.GenSignedMockTx(
+			r,
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			simtestutil.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			enSignerAccount.PrivKey,
+		)
+
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, res, err := app.SimDeliver(txGen.TxEncoder(), tx)
+
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, errors.New(res.Log)
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
@@ -2,7 +2,6 @@ package simulation
 
 import (
 	"math/rand"
-	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -34,24 +33,12 @@ func SimulateMsgUpdateParams(r *rand.Rand, _ sdk.Context, accs []simtypes.Accoun
 	// use the default gov module account address as authority
 	var authority sdk.AccAddress = address.Module("gov")
 
-	minAccepts := uint64(simtypes.RandIntBetween(r, 1, 3))
-	entAddresses := make([]string, minAccepts)
-
-	i := uint64(0)
-	for i < minAccepts {
-		randAcc, _ := simtypes.RandomAcc(r, accs)
-		for contains(entAddresses, randAcc.Address.String()) {
-			randAcc, _ = simtypes.RandomAcc(r, accs)
-		}
-		entAddresses[i] = randAcc.Address.String()
-		i += 1
-	}
-
+	// ent signers are held in the signer registry, which always has at least one signer
 	params := types.DefaultParams()
 	params.Denom = sdk.DefaultBondDenom
 	params.DecisionTimeLimit = uint64(simtypes.RandIntBetween(r, 360, 3600))
-	params.MinAccepts = uint64(simtypes.RandIntBetween(r, 1, 2))
-	params.EntSigners = strings.Join(entAddresses, ",")
+	params.MinAccepts = 1
+	params.EntSigners = ""
 
 	return &types.MsgUpdateParams{
 		Authority: authority.String(),
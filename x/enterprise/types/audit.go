@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CheckTotalLocked returns an error if the sum of every account's locked eFUND does not equal the total locked eFUND
+func CheckTotalLocked(lockedUnds []LockedUnd, totalLocked sdk.Coin) error {
+	sum := sdk.NewCoins()
+	for _, locked := range lockedUnds {
+		if !locked.Amount.IsValid() {
+			return fmt.Errorf("account %s has invalid locked eFUND %s", locked.Owner, locked.Amount.String())
+		}
+		sum = sum.Add(locked.Amount)
+	}
+
+	if !sum.Equal(sdk.NewCoins(totalLocked)) {
+		return fmt.Errorf("total locked eFUND %s does not equal the sum of accounts' locked eFUND %s",
+			totalLocked.String(), sum.String())
+	}
+
+	return nil
+}
+
+// CheckTotalSpent returns an error if the sum of every account's spent eFUND does not equal the total spent eFUND
+func CheckTotalSpent(spentEFUNDs []SpentEFUND, totalSpent sdk.Coin) error {
+	sum := sdk.NewCoins()
+	for _, spent := range spentEFUNDs {
+		if !spent.Amount.IsValid() {
+			return fmt.Errorf("account %s has invalid spent eFUND %s", spent.Owner, spent.Amount.String())
+		}
+		sum = sum.Add(spent.Amount)
+	}
+
+	if !sum.Equal(sdk.NewCoins(totalSpent)) {
+		return fmt.Errorf("total spent eFUND %s does not equal the sum of accounts' spent eFUND %s",
+			totalSpent.String(), sum.String())
+	}
+
+	return nil
+}
+
+// CheckPurchaseOrdersCoverEFUND returns an error if the total locked and spent eFUND is more than was
+// created by completed purchase orders. Accepted purchase orders are only locked once they are completed. The
+// totals may be less, since expired eFUND is neither locked nor spent.
+func CheckPurchaseOrdersCoverEFUND(purchaseOrders []EnterpriseUndPurchaseOrder, totalLocked, totalSpent sdk.Coin) error {
+	purchased := sdk.NewCoins()
+	for _, po := range purchaseOrders {
+		if po.Status == StatusCompleted && po.Amount.IsValid() {
+			purchased = purchased.Add(po.Amount)
+		}
+	}
+
+	lockedAndSpent := sdk.NewCoins(totalLocked).Add(totalSpent)
+	if !purchased.IsAllGTE(lockedAndSpent) {
+		return fmt.Errorf("total locked and spent eFUND %s is more than the %s purchased by completed purchase orders",
+			lockedAndSpent.String(), purchased.String())
+	}
+
+	return nil
+}
+
+// CheckQueuedPurchaseOrder returns an error if a purchase order held in a queue does not exist, or does not have
+// the status for that queue
+func CheckQueuedPurchaseOrder(purchaseOrderID uint64, po EnterpriseUndPurchaseOrder, found bool, queueStatus PurchaseOrderStatus) error {
+	if !found {
+		return fmt.Errorf("purchase order %d in the %s queue does not exist", purchaseOrderID, queueStatus.String())
+	}
+
+	if po.Status != queueStatus {
+		return fmt.Errorf("purchase order %d in the %s queue has status %s", purchaseOrderID, queueStatus.String(), po.Status.String())
+	}
+
+	return nil
+}
+
+// AuditGenesis runs every eFUND check which can be made against an exported genesis state, and returns each
+// problem found. The Raised and Accepted queues are not exported, since they are rebuilt from purchase order
+// statuses on import
+func AuditGenesis(data GenesisState) []error {
+	var problems []error
+
+	if err := CheckTotalLocked(data.LockedUnd, data.TotalLocked); err != nil {
+		problems = append(problems, err)
+	}
+
+	if err := CheckTotalSpent(data.SpentEfund, data.TotalSpent); err != nil {
+		problems = append(problems, err)
+	}
+
+	if err := CheckPurchaseOrdersCoverEFUND(data.PurchaseOrders, data.TotalLocked, data.TotalSpent); err != nil {
+		problems = append(problems, err)
+	}
+
+	return problems
+}
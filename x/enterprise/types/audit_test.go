@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unification-com/mainchain/x/enterprise/types"
+)
+
+func TestCheckTotalLocked(t *testing.T) {
+	lockedUnds := []types.LockedUnd{
+		{Owner: sdk.AccAddress("addr1").String(), Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)},
+		{Owner: sdk.AccAddress("addr2").String(), Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)},
+	}
+
+	require.NoError(t, types.CheckTotalLocked(lockedUnds, sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)))
+	require.NoError(t, types.CheckTotalLocked(nil, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)))
+	require.ErrorContains(t, types.CheckTotalLocked(lockedUnds, sdk.NewInt64Coin(sdk.DefaultBondDenom, 301)), "does not equal the sum")
+	require.ErrorContains(t, types.CheckTotalLocked(nil, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)), "does not equal the sum")
+}
+
+func TestCheckTotalSpent(t *testing.T) {
+	spentEFUNDs := []types.SpentEFUND{
+		{Owner: sdk.AccAddress("addr1").String(), Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)},
+		{Owner: sdk.AccAddress("addr2").String(), Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)},
+	}
+
+	require.NoError(t, types.CheckTotalSpent(spentEFUNDs, sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)))
+	require.ErrorContains(t, types.CheckTotalSpent(spentEFUNDs, sdk.NewInt64Coin(sdk.DefaultBondDenom, 299)), "does not equal the sum")
+}
+
+func TestCheckPurchaseOrdersCoverEFUND(t *testing.T) {
+	purchaseOrders := []types.EnterpriseUndPurchaseOrder{
+		{Id: 1, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), Status: types.StatusCompleted},
+		{Id: 2, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 500), Status: types.StatusAccepted},
+		{Id: 3, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 500), Status: types.StatusRejected},
+	}
+
+	locked := sdk.NewInt64Coin(sdk.DefaultBondDenom, 600)
+
+	require.NoError(t, types.CheckPurchaseOrdersCoverEFUND(purchaseOrders, locked, sdk.NewInt64Coin(sdk.DefaultBondDenom, 400)))
+	// some eFUND has expired
+	require.NoError(t, types.CheckPurchaseOrdersCoverEFUND(purchaseOrders, locked, sdk.NewInt64Coin(sdk.DefaultBondDenom, 300)))
+	// accepted purchase orders are not yet locked
+	require.ErrorContains(t, types.CheckPurchaseOrdersCoverEFUND(purchaseOrders, locked, sdk.NewInt64Coin(sdk.DefaultBondDenom, 401)), "is more than")
+}
+
+func TestCheckQueuedPurchaseOrder(t *testing.T) {
+	po := types.EnterpriseUndPurchaseOrder{Id: 1, Status: types.StatusRaised}
+
+	require.NoError(t, types.CheckQueuedPurchaseOrder(1, po, true, types.StatusRaised))
+	require.ErrorContains(t, types.CheckQueuedPurchaseOrder(1, po, true, types.StatusAccepted), "has status STATUS_RAISED")
+	require.ErrorContains(t, types.CheckQueuedPurchaseOrder(2, types.EnterpriseUndPurchaseOrder{}, false, types.StatusRaised), "does not exist")
+}
+
+func TestAuditGenesis(t *testing.T) {
+	owner := sdk.AccAddress("addr1").String()
+	genesis := types.DefaultGenesisState()
+	genesis.PurchaseOrders = types.EnterpriseUndPurchaseOrders{
+		{Id: 1, Purchaser: owner, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), Status: types.StatusCompleted},
+	}
+	genesis.LockedUnd = types.LockedUnds{{Owner: owner, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)}}
+	genesis.TotalLocked = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+
+	require.Empty(t, types.AuditGenesis(*genesis))
+
+	genesis.TotalSpent = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)
+	require.Len(t, types.AuditGenesis(*genesis), 2)
+}
@@ -23,9 +23,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Params{}, "enterprise/v1/Params", nil)
 
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "mainchain/x/enterprise/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgAddEntSigner{}, "enterprise/MsgAddEntSigner")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveEntSigner{}, "enterprise/MsgRemoveEntSigner")
 	legacy.RegisterAminoMsg(cdc, &MsgUndPurchaseOrder{}, "enterprise/MsgUndPurchaseOrder")
 	legacy.RegisterAminoMsg(cdc, &MsgProcessUndPurchaseOrder{}, "enterprise/MsgProcessUndPurchaseOrder")
 	legacy.RegisterAminoMsg(cdc, &MsgWhitelistAddress{}, "enterprise/MsgWhitelistAddress")
+	legacy.RegisterAminoMsg(cdc, &MsgWithdrawUndPurchaseOrder{}, "enterprise/MsgWithdrawUndPurchaseOrder")
+	legacy.RegisterAminoMsg(cdc, &MsgAmendUndPurchaseOrder{}, "enterprise/MsgAmendUndPurchaseOrder")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferLockedEFUND{}, "enterprise/MsgTransferLockedEFUND")
+	legacy.RegisterAminoMsg(cdc, &MsgLinkAddress{}, "enterprise/MsgLinkAddress")
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -33,6 +39,12 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgUndPurchaseOrder{},
 		&MsgProcessUndPurchaseOrder{},
 		&MsgWhitelistAddress{},
+		&MsgWithdrawUndPurchaseOrder{},
+		&MsgAmendUndPurchaseOrder{},
+		&MsgTransferLockedEFUND{},
+		&MsgLinkAddress{},
+		&MsgAddEntSigner{},
+		&MsgRemoveEntSigner{},
 		&MsgUpdateParams{},
 	)
 
@@ -40,6 +40,8 @@ const (
 	StatusRejected PurchaseOrderStatus = 3
 	// STATUS_COMPLETED defines a completed status.
 	StatusCompleted PurchaseOrderStatus = 4
+	// STATUS_WITHDRAWN defines a withdrawn status.
+	StatusWithdrawn PurchaseOrderStatus = 5
 )
 
 var PurchaseOrderStatus_name = map[int32]string{
@@ -48,6 +50,7 @@ var PurchaseOrderStatus_name = map[int32]string{
 	2: "STATUS_ACCEPTED",
 	3: "STATUS_REJECTED",
 	4: "STATUS_COMPLETED",
+	5: "STATUS_WITHDRAWN",
 }
 
 var PurchaseOrderStatus_value = map[string]int32{
@@ -56,6 +59,7 @@ var PurchaseOrderStatus_value = map[string]int32{
 	"STATUS_ACCEPTED":  2,
 	"STATUS_REJECTED":  3,
 	"STATUS_COMPLETED": 4,
+	"STATUS_WITHDRAWN": 5,
 }
 
 func (x PurchaseOrderStatus) String() string {
@@ -98,6 +102,50 @@ func (WhitelistAction) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_0031edbd5eb0f2fc, []int{1}
 }
 
+// LedgerEntryType enumerates the events recorded in an account's eFUND ledger.
+type LedgerEntryType int32
+
+const (
+	// LEDGER_ENTRY_TYPE_NIL defines a no-op type.
+	LedgerEntryNil LedgerEntryType = 0
+	// LEDGER_ENTRY_TYPE_PURCHASE_ORDER defines eFUND locked by an accepted purchase order.
+	LedgerEntryPurchaseOrder LedgerEntryType = 1
+	// LEDGER_ENTRY_TYPE_FEE defines locked eFUND unlocked and minted to pay Tx fees.
+	LedgerEntryFee LedgerEntryType = 2
+	// LEDGER_ENTRY_TYPE_EXPIRED defines locked eFUND removed when a lot expired.
+	LedgerEntryExpired LedgerEntryType = 3
+	// LEDGER_ENTRY_TYPE_TRANSFER_OUT defines locked eFUND transferred to another account.
+	LedgerEntryTransferOut LedgerEntryType = 4
+	// LEDGER_ENTRY_TYPE_TRANSFER_IN defines locked eFUND transferred from another account.
+	LedgerEntryTransferIn LedgerEntryType = 5
+)
+
+var LedgerEntryType_name = map[int32]string{
+	0: "LEDGER_ENTRY_TYPE_NIL",
+	1: "LEDGER_ENTRY_TYPE_PURCHASE_ORDER",
+	2: "LEDGER_ENTRY_TYPE_FEE",
+	3: "LEDGER_ENTRY_TYPE_EXPIRED",
+	4: "LEDGER_ENTRY_TYPE_TRANSFER_OUT",
+	5: "LEDGER_ENTRY_TYPE_TRANSFER_IN",
+}
+
+var LedgerEntryType_value = map[string]int32{
+	"LEDGER_ENTRY_TYPE_NIL":            0,
+	"LEDGER_ENTRY_TYPE_PURCHASE_ORDER": 1,
+	"LEDGER_ENTRY_TYPE_FEE":            2,
+	"LEDGER_ENTRY_TYPE_EXPIRED":        3,
+	"LEDGER_ENTRY_TYPE_TRANSFER_OUT":   4,
+	"LEDGER_ENTRY_TYPE_TRANSFER_IN":    5,
+}
+
+func (x LedgerEntryType) String() string {
+	return proto.EnumName(LedgerEntryType_name, int32(x))
+}
+
+func (LedgerEntryType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_0031edbd5eb0f2fc, []int{2}
+}
+
 // PurchaseOrderDecision defines a decision made for a given purchase order, ie,
 // whether to accept or reject
 type PurchaseOrderDecision struct {
@@ -179,6 +227,9 @@ type EnterpriseUndPurchaseOrder struct {
 	CompletionTime uint64 `protobuf:"varint,6,opt,name=completion_time,json=completionTime,proto3" json:"completion_time,omitempty"`
 	// decisions is an array of decisions made by authorised addresses
 	Decisions PurchaseOrderDecisions `protobuf:"bytes,7,rep,name=decisions,proto3,castrepeated=PurchaseOrderDecisions" json:"decisions"`
+	// efund_expiry_time is a unix epoch value of the time the eFUND locked by the order expires. It is set
+	// from the efund_expiry_period param when the order is accepted. 0 if the eFUND does not expire
+	EfundExpiryTime uint64 `protobuf:"varint,8,opt,name=efund_expiry_time,json=efundExpiryTime,proto3" json:"efund_expiry_time,omitempty"`
 }
 
 func (m *EnterpriseUndPurchaseOrder) Reset()         { *m = EnterpriseUndPurchaseOrder{} }
@@ -263,6 +314,13 @@ func (m *EnterpriseUndPurchaseOrder) GetDecisions() PurchaseOrderDecisions {
 	return nil
 }
 
+func (m *EnterpriseUndPurchaseOrder) GetEfundExpiryTime() uint64 {
+	if m != nil {
+		return m.EfundExpiryTime
+	}
+	return 0
+}
+
 // PurchaseOrders defines a list of purchase orders
 type PurchaseOrders struct {
 	PurchaseOrders []*EnterpriseUndPurchaseOrder `protobuf:"bytes,1,rep,name=purchase_orders,json=purchaseOrders,proto3" json:"purchase_orders,omitempty"`
@@ -363,6 +421,244 @@ func (m *LockedUnd) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+// LockedEFUNDLot defines an amount of locked eFUND created by an accepted purchase order. An account's
+// lots are consumed oldest first, and any remaining amount is removed once the lot expires
+type LockedEFUNDLot struct {
+	// id is the unique id of the lot
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the locked eFUND owner
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// purchase_order_id is the id of the purchase order which created the lot. 0 for eFUND locked before lots existed
+	PurchaseOrderId uint64 `protobuf:"varint,3,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"`
+	// amount is the amount remaining in the lot
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// locked_at is the unix time at which the lot was created
+	LockedAt uint64 `protobuf:"varint,5,opt,name=locked_at,json=lockedAt,proto3" json:"locked_at,omitempty"`
+	// expiry_time is the unix time at which any remaining amount expires. 0 if the lot does not expire
+	ExpiryTime uint64 `protobuf:"varint,6,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+}
+
+func (m *LockedEFUNDLot) Reset()         { *m = LockedEFUNDLot{} }
+func (m *LockedEFUNDLot) String() string { return proto.CompactTextString(m) }
+func (*LockedEFUNDLot) ProtoMessage()    {}
+func (*LockedEFUNDLot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0031edbd5eb0f2fc, []int{4}
+}
+func (m *LockedEFUNDLot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LockedEFUNDLot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LockedEFUNDLot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LockedEFUNDLot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockedEFUNDLot.Merge(m, src)
+}
+func (m *LockedEFUNDLot) XXX_Size() int {
+	return m.Size()
+}
+func (m *LockedEFUNDLot) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockedEFUNDLot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LockedEFUNDLot proto.InternalMessageInfo
+
+func (m *LockedEFUNDLot) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *LockedEFUNDLot) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *LockedEFUNDLot) GetPurchaseOrderId() uint64 {
+	if m != nil {
+		return m.PurchaseOrderId
+	}
+	return 0
+}
+
+func (m *LockedEFUNDLot) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *LockedEFUNDLot) GetLockedAt() uint64 {
+	if m != nil {
+		return m.LockedAt
+	}
+	return 0
+}
+
+func (m *LockedEFUNDLot) GetExpiryTime() uint64 {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return 0
+}
+
+// EFUNDLedgerEntry defines a single credit or debit of an account's locked eFUND, with the account's running
+// balances after it was applied
+type EFUNDLedgerEntry struct {
+	// id is the unique id of the entry
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// owner is the address of the eFUND owner
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// entry_type is the LedgerEntryType
+	EntryType LedgerEntryType `protobuf:"varint,3,opt,name=entry_type,json=entryType,proto3,enum=mainchain.enterprise.v1.LedgerEntryType" json:"entry_type,omitempty"`
+	// amount is the amount credited to, or debited from, the account's locked eFUND
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// purchase_order_id is the id of the purchase order the entry relates to, if any
+	PurchaseOrderId uint64 `protobuf:"varint,5,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"`
+	// tx_hash is the hash of the Tx whose fees were paid. Fee entries only
+	TxHash string `protobuf:"bytes,6,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// msg_types are the Msg type URLs in the Tx whose fees were paid. Fee entries only
+	MsgTypes []string `protobuf:"bytes,7,rep,name=msg_types,json=msgTypes,proto3" json:"msg_types,omitempty"`
+	// height is the block height at which the entry was recorded
+	Height int64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	// timestamp is the unix time at which the entry was recorded
+	Timestamp uint64 `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// locked_balance is the account's locked eFUND after the entry was applied
+	LockedBalance types.Coin `protobuf:"bytes,10,opt,name=locked_balance,json=lockedBalance,proto3" json:"locked_balance"`
+	// spent_balance is the account's spent eFUND after the entry was applied
+	SpentBalance types.Coin `protobuf:"bytes,11,opt,name=spent_balance,json=spentBalance,proto3" json:"spent_balance"`
+	// counterparty is the other account in a locked eFUND transfer. Transfer entries only
+	Counterparty string `protobuf:"bytes,12,opt,name=counterparty,proto3" json:"counterparty,omitempty"`
+}
+
+func (m *EFUNDLedgerEntry) Reset()         { *m = EFUNDLedgerEntry{} }
+func (m *EFUNDLedgerEntry) String() string { return proto.CompactTextString(m) }
+func (*EFUNDLedgerEntry) ProtoMessage()    {}
+func (*EFUNDLedgerEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0031edbd5eb0f2fc, []int{5}
+}
+func (m *EFUNDLedgerEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EFUNDLedgerEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EFUNDLedgerEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EFUNDLedgerEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EFUNDLedgerEntry.Merge(m, src)
+}
+func (m *EFUNDLedgerEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *EFUNDLedgerEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_EFUNDLedgerEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EFUNDLedgerEntry proto.InternalMessageInfo
+
+func (m *EFUNDLedgerEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EFUNDLedgerEntry) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EFUNDLedgerEntry) GetEntryType() LedgerEntryType {
+	if m != nil {
+		return m.EntryType
+	}
+	return LedgerEntryNil
+}
+
+func (m *EFUNDLedgerEntry) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *EFUNDLedgerEntry) GetPurchaseOrderId() uint64 {
+	if m != nil {
+		return m.PurchaseOrderId
+	}
+	return 0
+}
+
+func (m *EFUNDLedgerEntry) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *EFUNDLedgerEntry) GetMsgTypes() []string {
+	if m != nil {
+		return m.MsgTypes
+	}
+	return nil
+}
+
+func (m *EFUNDLedgerEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *EFUNDLedgerEntry) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *EFUNDLedgerEntry) GetLockedBalance() types.Coin {
+	if m != nil {
+		return m.LockedBalance
+	}
+	return types.Coin{}
+}
+
+func (m *EFUNDLedgerEntry) GetSpentBalance() types.Coin {
+	if m != nil {
+		return m.SpentBalance
+	}
+	return types.Coin{}
+}
+
+func (m *EFUNDLedgerEntry) GetCounterparty() string {
+	if m != nil {
+		return m.Counterparty
+	}
+	return ""
+}
+
 // SpentEFUND defines the amount of spent eFUND for an account
 type SpentEFUND struct {
 	// owner is the address of the eFUND owner
@@ -375,7 +671,7 @@ func (m *SpentEFUND) Reset()         { *m = SpentEFUND{} }
 func (m *SpentEFUND) String() string { return proto.CompactTextString(m) }
 func (*SpentEFUND) ProtoMessage()    {}
 func (*SpentEFUND) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0031edbd5eb0f2fc, []int{4}
+	return fileDescriptor_0031edbd5eb0f2fc, []int{6}
 }
 func (m *SpentEFUND) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,7 +735,7 @@ func (m *EnterpriseUserAccount) Reset()         { *m = EnterpriseUserAccount{} }
 func (m *EnterpriseUserAccount) String() string { return proto.CompactTextString(m) }
 func (*EnterpriseUserAccount) ProtoMessage()    {}
 func (*EnterpriseUserAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0031edbd5eb0f2fc, []int{5}
+	return fileDescriptor_0031edbd5eb0f2fc, []int{7}
 }
 func (m *EnterpriseUserAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -512,7 +808,7 @@ func (m *WhitelistAddresses) Reset()         { *m = WhitelistAddresses{} }
 func (m *WhitelistAddresses) String() string { return proto.CompactTextString(m) }
 func (*WhitelistAddresses) ProtoMessage()    {}
 func (*WhitelistAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0031edbd5eb0f2fc, []int{6}
+	return fileDescriptor_0031edbd5eb0f2fc, []int{8}
 }
 func (m *WhitelistAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -548,30 +844,33 @@ func (m *WhitelistAddresses) GetAddresses() []string {
 	return nil
 }
 
-// Params defines the parameters for the enterprise module.
-type Params struct {
-	// ent_signers is a list of addresses authorised to make decisions on raised purchase orders
-	EntSigners string `protobuf:"bytes,1,opt,name=ent_signers,json=entSigners,proto3" json:"ent_signers,omitempty"`
-	// denom is the denomination of eFUND, e.g. nund
-	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	// min_accepts is the minumum number of ent_signers required to accept a PO before it is processed and efUND minted
-	MinAccepts uint64 `protobuf:"varint,3,opt,name=min_accepts,json=minAccepts,proto3" json:"min_accepts,omitempty"`
-	// decision_time_limit is the time limit within which all decisions must be made for a raised purchase order.
-	DecisionTimeLimit uint64 `protobuf:"varint,4,opt,name=decision_time_limit,json=decisionTimeLimit,proto3" json:"decision_time_limit,omitempty"`
+// WhitelistEntry defines an address authorised to raise enterprise purchase orders, and the limits on its purchases
+type WhitelistEntry struct {
+	// address is the whitelisted address
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// expiry_time is the unix time from which the address can no longer raise purchase orders. 0 means no expiry
+	ExpiryTime uint64 `protobuf:"varint,2,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	// max_purchasable is the maximum cumulative amount of eFUND the address can purchase, counted against its
+	// accepted purchase orders. A zero amount means no cap
+	MaxPurchasable types.Coin `protobuf:"bytes,3,opt,name=max_purchasable,json=maxPurchasable,proto3" json:"max_purchasable"`
+	// tier is an optional label for the whitelist entry
+	Tier string `protobuf:"bytes,4,opt,name=tier,proto3" json:"tier,omitempty"`
+	// added_at is the unix time at which the address was whitelisted
+	AddedAt uint64 `protobuf:"varint,5,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
 }
 
-func (m *Params) Reset()         { *m = Params{} }
-func (m *Params) String() string { return proto.CompactTextString(m) }
-func (*Params) ProtoMessage()    {}
-func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0031edbd5eb0f2fc, []int{7}
+func (m *WhitelistEntry) Reset()         { *m = WhitelistEntry{} }
+func (m *WhitelistEntry) String() string { return proto.CompactTextString(m) }
+func (*WhitelistEntry) ProtoMessage()    {}
+func (*WhitelistEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0031edbd5eb0f2fc, []int{9}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *WhitelistEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *WhitelistEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_WhitelistEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -581,144 +880,455 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *WhitelistEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhitelistEntry.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *WhitelistEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *WhitelistEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhitelistEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_WhitelistEntry proto.InternalMessageInfo
 
-func (m *Params) GetEntSigners() string {
+func (m *WhitelistEntry) GetAddress() string {
 	if m != nil {
-		return m.EntSigners
+		return m.Address
 	}
 	return ""
 }
 
-func (m *Params) GetDenom() string {
+func (m *WhitelistEntry) GetExpiryTime() uint64 {
 	if m != nil {
-		return m.Denom
+		return m.ExpiryTime
 	}
-	return ""
+	return 0
 }
 
-func (m *Params) GetMinAccepts() uint64 {
+func (m *WhitelistEntry) GetMaxPurchasable() types.Coin {
 	if m != nil {
-		return m.MinAccepts
+		return m.MaxPurchasable
 	}
-	return 0
+	return types.Coin{}
 }
 
-func (m *Params) GetDecisionTimeLimit() uint64 {
+func (m *WhitelistEntry) GetTier() string {
 	if m != nil {
-		return m.DecisionTimeLimit
+		return m.Tier
+	}
+	return ""
+}
+
+func (m *WhitelistEntry) GetAddedAt() uint64 {
+	if m != nil {
+		return m.AddedAt
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterEnum("mainchain.enterprise.v1.PurchaseOrderStatus", PurchaseOrderStatus_name, PurchaseOrderStatus_value)
-	proto.RegisterEnum("mainchain.enterprise.v1.WhitelistAction", WhitelistAction_name, WhitelistAction_value)
-	proto.RegisterType((*PurchaseOrderDecision)(nil), "mainchain.enterprise.v1.PurchaseOrderDecision")
-	proto.RegisterType((*EnterpriseUndPurchaseOrder)(nil), "mainchain.enterprise.v1.EnterpriseUndPurchaseOrder")
-	proto.RegisterType((*PurchaseOrders)(nil), "mainchain.enterprise.v1.PurchaseOrders")
-	proto.RegisterType((*LockedUnd)(nil), "mainchain.enterprise.v1.LockedUnd")
-	proto.RegisterType((*SpentEFUND)(nil), "mainchain.enterprise.v1.SpentEFUND")
-	proto.RegisterType((*EnterpriseUserAccount)(nil), "mainchain.enterprise.v1.EnterpriseUserAccount")
-	proto.RegisterType((*WhitelistAddresses)(nil), "mainchain.enterprise.v1.WhitelistAddresses")
-	proto.RegisterType((*Params)(nil), "mainchain.enterprise.v1.Params")
+// LinkedAddress defines an ent signer approved link from an address holding locked eFUND to another address
+// it can transfer locked eFUND to, for example another account controlled by the same enterprise
+type LinkedAddress struct {
+	// owner is the address which can transfer locked eFUND to the linked address
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// linked is the address which can receive locked eFUND from the owner
+	Linked string `protobuf:"bytes,2,opt,name=linked,proto3" json:"linked,omitempty"`
+	// added_at is the unix time at which the link was approved
+	AddedAt uint64 `protobuf:"varint,3,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("mainchain/enterprise/v1/enterprise.proto", fileDescriptor_0031edbd5eb0f2fc)
+func (m *LinkedAddress) Reset()         { *m = LinkedAddress{} }
+func (m *LinkedAddress) String() string { return proto.CompactTextString(m) }
+func (*LinkedAddress) ProtoMessage()    {}
+func (*LinkedAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0031edbd5eb0f2fc, []int{10}
+}
+func (m *LinkedAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LinkedAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LinkedAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LinkedAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkedAddress.Merge(m, src)
+}
+func (m *LinkedAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *LinkedAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkedAddress.DiscardUnknown(m)
 }
 
-var fileDescriptor_0031edbd5eb0f2fc = []byte{
-	// 1022 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0x3a, 0x8e, 0xc1, 0xe3, 0xc4, 0x71, 0x27, 0x4e, 0xe3, 0x58, 0xad, 0xb3, 0x38, 0x42,
-	0x98, 0x88, 0xac, 0x49, 0x2a, 0x51, 0xc9, 0x12, 0x12, 0xfe, 0xb3, 0x55, 0x8d, 0xdc, 0x24, 0xac,
-	0x1d, 0x2a, 0x21, 0x24, 0x6b, 0xbd, 0x3b, 0x75, 0x06, 0xbc, 0xb3, 0xcb, 0xce, 0x3a, 0xd0, 0x6f,
-	0x80, 0x7c, 0x02, 0xc4, 0xd5, 0x27, 0x2e, 0x08, 0x2e, 0x95, 0xe0, 0x43, 0xf4, 0x82, 0x54, 0x71,
-	0xe2, 0x80, 0x00, 0x25, 0x87, 0x7e, 0x0b, 0x84, 0xe6, 0x8f, 0xff, 0x6c, 0xea, 0x44, 0x2e, 0x87,
-	0x5e, 0x2c, 0xcf, 0x7b, 0xbf, 0xdf, 0xcc, 0xef, 0xbd, 0xb7, 0xf3, 0xde, 0x80, 0xa2, 0x63, 0x62,
-	0x62, 0x9d, 0x9a, 0x98, 0x94, 0x10, 0x09, 0x90, 0xef, 0xf9, 0x98, 0xa2, 0xd2, 0xd9, 0xfe, 0xcc,
-	0x4a, 0xf3, 0x7c, 0x37, 0x70, 0xe1, 0xe6, 0x04, 0xa9, 0xcd, 0xf8, 0xce, 0xf6, 0x73, 0x37, 0x4c,
-	0x07, 0x13, 0xb7, 0xc4, 0x7f, 0x05, 0x36, 0x97, 0xb7, 0x5c, 0xea, 0xb8, 0xb4, 0xd4, 0x35, 0xf9,
-	0x66, 0x5d, 0x14, 0x98, 0xfb, 0x25, 0xcb, 0xc5, 0x44, 0xfa, 0xb7, 0x84, 0xbf, 0xc3, 0x57, 0x25,
-	0xb1, 0x90, 0xae, 0x4c, 0xcf, 0xed, 0xb9, 0xc2, 0xce, 0xfe, 0x09, 0x6b, 0xe1, 0x5c, 0x01, 0x1b,
-	0xc7, 0x03, 0xdf, 0x3a, 0x35, 0x29, 0x3a, 0xf2, 0x6d, 0xe4, 0xd7, 0x91, 0x85, 0x29, 0x76, 0x09,
-	0x7c, 0x17, 0xc4, 0x29, 0xee, 0x11, 0xe4, 0x67, 0x15, 0x55, 0x29, 0x26, 0xaa, 0xd9, 0xdf, 0x7f,
-	0xdd, 0xcb, 0xc8, 0x1d, 0x2b, 0xb6, 0xed, 0x23, 0x4a, 0x5b, 0x81, 0x8f, 0x49, 0xcf, 0x90, 0x38,
-	0x78, 0x1f, 0xbc, 0x6e, 0x4b, 0x76, 0x36, 0xaa, 0x2a, 0xc5, 0xd4, 0xc1, 0x3b, 0xda, 0x15, 0xb1,
-	0x69, 0xa1, 0x33, 0x5b, 0x81, 0x19, 0x0c, 0xa8, 0x31, 0x61, 0xc3, 0x1d, 0xb0, 0x3a, 0xfe, 0xdf,
-	0x09, 0xb0, 0x83, 0xb2, 0x4b, 0xaa, 0x52, 0x8c, 0x19, 0x2b, 0x63, 0x63, 0x1b, 0x3b, 0xa8, 0x5c,
-	0x1c, 0x3e, 0x7f, 0xb2, 0xbb, 0x13, 0x4e, 0xee, 0xdc, 0x50, 0x0a, 0xbf, 0x2d, 0x81, 0x9c, 0x3e,
-	0xc1, 0x9d, 0x10, 0x3b, 0x04, 0x83, 0x29, 0x10, 0xc5, 0x36, 0x8f, 0x32, 0x66, 0x44, 0xb1, 0x0d,
-	0xdf, 0x03, 0x09, 0x4f, 0x02, 0x7c, 0x1e, 0xc8, 0x75, 0xc1, 0x4f, 0xa1, 0xf0, 0x2e, 0x88, 0x9b,
-	0x8e, 0x3b, 0x20, 0x01, 0x97, 0x9b, 0x3c, 0xd8, 0xd2, 0x24, 0x83, 0x55, 0x4b, 0x93, 0xd5, 0xd2,
-	0x6a, 0x2e, 0x26, 0xd5, 0xd8, 0xd3, 0xbf, 0xb6, 0x23, 0x86, 0x84, 0xc3, 0x3a, 0x88, 0x53, 0x9e,
-	0x82, 0x6c, 0xec, 0x7f, 0xa4, 0x4d, 0x72, 0xe1, 0x6d, 0x00, 0x7c, 0x13, 0x53, 0x24, 0x32, 0xb6,
-	0xcc, 0xc3, 0x49, 0x70, 0x0b, 0x4b, 0x17, 0x7c, 0x0b, 0xac, 0x59, 0xae, 0xe3, 0xf5, 0x51, 0x30,
-	0xc9, 0x6a, 0x9c, 0x63, 0x52, 0x53, 0x33, 0x07, 0x7e, 0x01, 0x12, 0xe3, 0x3c, 0xd3, 0xec, 0x6b,
-	0xea, 0x52, 0x31, 0x79, 0xa0, 0x2d, 0x26, 0x68, 0x9c, 0xf0, 0xea, 0x0e, 0x0b, 0xef, 0xa7, 0xbf,
-	0xb7, 0x6f, 0xce, 0x75, 0xd3, 0x1f, 0x9f, 0x3f, 0xd9, 0x55, 0x8c, 0xe9, 0x29, 0xe5, 0x3d, 0x56,
-	0xca, 0x62, 0xb8, 0x94, 0x57, 0x17, 0xac, 0xf0, 0xad, 0x02, 0x52, 0x21, 0x0b, 0x85, 0x9f, 0x82,
-	0xb5, 0x71, 0x21, 0x3a, 0x2e, 0x37, 0x65, 0x15, 0x2e, 0xfd, 0xce, 0x95, 0xd2, 0xaf, 0x3e, 0xc0,
-	0x48, 0x79, 0xa1, 0xdd, 0xcb, 0x6f, 0x30, 0x7d, 0xb7, 0xae, 0xf9, 0xd4, 0x68, 0xe1, 0x3b, 0x05,
-	0x24, 0x9a, 0xae, 0xf5, 0x39, 0xb2, 0x4f, 0x88, 0x0d, 0x35, 0xb0, 0xec, 0x7e, 0xb9, 0xc8, 0xdd,
-	0x11, 0xb0, 0x99, 0x4f, 0x27, 0xfa, 0x52, 0x9f, 0x4e, 0xf9, 0x16, 0x53, 0xb6, 0x19, 0x56, 0x36,
-	0x91, 0x51, 0xf8, 0x5e, 0x01, 0xa0, 0xe5, 0x21, 0x12, 0xe8, 0xf7, 0x4e, 0x0e, 0xeb, 0xaf, 0x4e,
-	0xd5, 0x6d, 0xa6, 0x2a, 0x1b, 0x56, 0x35, 0xd5, 0x51, 0xf8, 0x37, 0x0a, 0x36, 0x66, 0xb2, 0x4f,
-	0x91, 0x5f, 0xb1, 0x2c, 0x7e, 0x13, 0x5e, 0x56, 0x61, 0x15, 0xac, 0xf4, 0x79, 0xb4, 0x1d, 0xf4,
-	0x68, 0x40, 0xec, 0x45, 0x75, 0x26, 0x05, 0x49, 0x67, 0x1c, 0x78, 0x0f, 0xa4, 0x7a, 0x88, 0x20,
-	0xdf, 0xec, 0x77, 0xe8, 0xc0, 0xf3, 0xfa, 0x8f, 0x17, 0xbd, 0xbe, 0xab, 0x92, 0xd6, 0xe2, 0x2c,
-	0xf8, 0x01, 0x48, 0x52, 0x16, 0xa3, 0x94, 0x12, 0x5b, 0x6c, 0x13, 0xc0, 0x39, 0x42, 0xc9, 0xfb,
-	0x20, 0xc1, 0x56, 0xb6, 0xd9, 0xed, 0x8b, 0x0b, 0xbc, 0x00, 0x7f, 0xca, 0x98, 0xdb, 0x10, 0xe7,
-	0xa6, 0xb9, 0x40, 0x01, 0x7c, 0x78, 0x8a, 0x03, 0xd4, 0xc7, 0x34, 0x90, 0x79, 0x45, 0x94, 0xf5,
-	0x3d, 0x73, 0xbc, 0xe0, 0xb7, 0xe7, 0xda, 0xbe, 0x37, 0x81, 0x96, 0xdf, 0x64, 0xe7, 0xaa, 0xe1,
-	0x73, 0x5f, 0xdc, 0xbe, 0xf0, 0xb3, 0x02, 0xe2, 0xc7, 0xa6, 0x6f, 0x3a, 0x14, 0x6e, 0x83, 0x24,
-	0x4b, 0x94, 0x98, 0x1b, 0x54, 0x14, 0xdb, 0x00, 0x88, 0x04, 0x2d, 0x61, 0x81, 0x19, 0xb0, 0x6c,
-	0x23, 0xe2, 0x3a, 0xa2, 0xfd, 0x1a, 0x62, 0xc1, 0x68, 0x0e, 0x26, 0x1d, 0xd3, 0xb2, 0x90, 0x17,
-	0x50, 0x39, 0x14, 0x80, 0x83, 0x49, 0x45, 0x58, 0xa0, 0x06, 0xd6, 0x43, 0x73, 0xa3, 0xd3, 0xc7,
-	0x0e, 0x0e, 0x78, 0x29, 0x62, 0xc6, 0x8d, 0xd9, 0xe9, 0xd1, 0x64, 0x8e, 0xf2, 0x16, 0x53, 0x9e,
-	0xb9, 0x74, 0xaf, 0xb9, 0xc4, 0xdd, 0x3f, 0x15, 0xb0, 0x3e, 0xa7, 0xdb, 0xb2, 0x2e, 0xdb, 0x6a,
-	0x57, 0xda, 0x27, 0xad, 0xce, 0x61, 0xa3, 0x99, 0x8e, 0xe4, 0x56, 0x87, 0x23, 0x35, 0x21, 0x7c,
-	0x87, 0xb8, 0xcf, 0x26, 0x97, 0x74, 0x1b, 0x95, 0x46, 0x4b, 0xaf, 0xa7, 0x95, 0x5c, 0x7a, 0x38,
-	0x52, 0x57, 0x64, 0xaf, 0x66, 0xdd, 0xd8, 0x66, 0xad, 0x58, 0x82, 0x2a, 0xb5, 0x9a, 0x7e, 0xdc,
-	0xd6, 0xeb, 0xe9, 0x68, 0x0e, 0x0e, 0x47, 0x6a, 0x4a, 0xc0, 0x44, 0x38, 0x21, 0xa0, 0xa1, 0x7f,
-	0xa8, 0xd7, 0x18, 0x70, 0x69, 0x16, 0x68, 0xa0, 0xcf, 0x90, 0xc5, 0x80, 0x6f, 0x83, 0xb4, 0x04,
-	0xd6, 0x8e, 0x1e, 0x1c, 0x37, 0x75, 0x86, 0x8c, 0xe5, 0xd6, 0x87, 0x23, 0x75, 0x4d, 0x20, 0x6b,
-	0xa2, 0xc7, 0x23, 0x3b, 0x17, 0xfb, 0xfa, 0x87, 0x7c, 0x64, 0xf7, 0x17, 0x05, 0xac, 0x4d, 0x6b,
-	0x64, 0x05, 0x62, 0xe2, 0x67, 0x1e, 0xde, 0x6f, 0xb4, 0xf5, 0x66, 0xa3, 0xd5, 0xee, 0x54, 0x6a,
-	0xed, 0xc6, 0xd1, 0xa1, 0x0c, 0xf2, 0xe6, 0x70, 0xa4, 0xc2, 0x4b, 0x70, 0x16, 0xed, 0x3c, 0x46,
-	0xa5, 0xce, 0x82, 0x9e, 0xc7, 0xa8, 0xd8, 0x6c, 0xb6, 0x6e, 0xbe, 0xc0, 0x30, 0xf4, 0x07, 0x47,
-	0x1f, 0xeb, 0xe9, 0x68, 0x6e, 0x6b, 0x38, 0x52, 0x37, 0x2e, 0x91, 0x0c, 0xe4, 0xb8, 0x67, 0x48,
-	0xa8, 0xae, 0x7e, 0xf4, 0xf4, 0x3c, 0xaf, 0x3c, 0x3b, 0xcf, 0x2b, 0xff, 0x9c, 0xe7, 0x95, 0x6f,
-	0x2e, 0xf2, 0x91, 0x67, 0x17, 0xf9, 0xc8, 0x1f, 0x17, 0xf9, 0xc8, 0x27, 0x77, 0x7b, 0x38, 0x38,
-	0x1d, 0x74, 0x35, 0xcb, 0x75, 0x4a, 0x03, 0x82, 0x1f, 0x61, 0xcb, 0x64, 0xec, 0x3d, 0xb6, 0x9e,
-	0xbe, 0xc4, 0xbe, 0x9a, 0x7d, 0x8b, 0x05, 0x8f, 0x3d, 0x44, 0xbb, 0x71, 0xfe, 0x0e, 0xba, 0xf3,
-	0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xec, 0xea, 0x34, 0xa6, 0xb0, 0x09, 0x00, 0x00,
+var xxx_messageInfo_LinkedAddress proto.InternalMessageInfo
+
+func (m *LinkedAddress) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *PurchaseOrderDecision) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LinkedAddress) GetLinked() string {
+	if m != nil {
+		return m.Linked
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PurchaseOrderDecision) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LinkedAddress) GetAddedAt() uint64 {
+	if m != nil {
+		return m.AddedAt
+	}
+	return 0
+}
+
+// EntSigner defines an address authorised to make decisions on raised purchase orders
+type EntSigner struct {
+	// address is the address of the signer
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// name is an optional human readable identifier for the signer
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// added_at is the unix time at which the signer was added to the registry
+	AddedAt uint64 `protobuf:"varint,3,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+}
+
+func (m *EntSigner) Reset()         { *m = EntSigner{} }
+func (m *EntSigner) String() string { return proto.CompactTextString(m) }
+func (*EntSigner) ProtoMessage()    {}
+func (*EntSigner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0031edbd5eb0f2fc, []int{11}
+}
+func (m *EntSigner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EntSigner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EntSigner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EntSigner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntSigner.Merge(m, src)
+}
+func (m *EntSigner) XXX_Size() int {
+	return m.Size()
+}
+func (m *EntSigner) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntSigner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EntSigner proto.InternalMessageInfo
+
+func (m *EntSigner) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EntSigner) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EntSigner) GetAddedAt() uint64 {
+	if m != nil {
+		return m.AddedAt
+	}
+	return 0
+}
+
+// Params defines the parameters for the enterprise module.
+type Params struct {
+	// ent_signers is a legacy comma separated list of addresses authorised to make decisions on raised purchase
+	// orders. Signers are now held in the on-chain signer registry, managed via MsgAddEntSigner and
+	// MsgRemoveEntSigner. This value is only read when importing legacy genesis and migrating the store, and
+	// must otherwise be empty.
+	EntSigners string `protobuf:"bytes,1,opt,name=ent_signers,json=entSigners,proto3" json:"ent_signers,omitempty"`
+	// denom is the denomination of eFUND, e.g. nund
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// min_accepts is the minumum number of ent_signers required to accept a PO before it is processed and efUND minted
+	MinAccepts uint64 `protobuf:"varint,3,opt,name=min_accepts,json=minAccepts,proto3" json:"min_accepts,omitempty"`
+	// decision_time_limit is the time limit within which all decisions must be made for a raised purchase order.
+	DecisionTimeLimit uint64 `protobuf:"varint,4,opt,name=decision_time_limit,json=decisionTimeLimit,proto3" json:"decision_time_limit,omitempty"`
+	// efund_expiry_period is the number of seconds after a purchase order is accepted that its locked eFUND
+	// expires. The expiry time is recorded on each order when it is accepted. 0 means locked eFUND does not expire
+	EfundExpiryPeriod uint64 `protobuf:"varint,5,opt,name=efund_expiry_period,json=efundExpiryPeriod,proto3" json:"efund_expiry_period,omitempty"`
+	// efund_fee_msg_types is the list of Msg type URLs whose Tx fees can be paid using locked eFUND. Locked eFUND
+	// is only used if every Msg in the Tx is in the list
+	EfundFeeMsgTypes []string `protobuf:"bytes,6,rep,name=efund_fee_msg_types,json=efundFeeMsgTypes,proto3" json:"efund_fee_msg_types,omitempty"`
+	// group_policy_address is an optional x/group policy address. If set, purchase orders are decided by a single
+	// MsgProcessUndPurchaseOrder executed by the policy via a group proposal, using the group's decision policy.
+	// If empty, purchase orders are decided by the ent signers, tallied against min_accepts
+	GroupPolicyAddress string `protobuf:"bytes,7,opt,name=group_policy_address,json=groupPolicyAddress,proto3" json:"group_policy_address,omitempty"`
+	// ledger_retention_period is the number of seconds an account's eFUND ledger entries are kept for. Older
+	// entries are pruned as new entries are recorded for the account. 0 means entries are kept indefinitely
+	LedgerRetentionPeriod uint64 `protobuf:"varint,8,opt,name=ledger_retention_period,json=ledgerRetentionPeriod,proto3" json:"ledger_retention_period,omitempty"`
+	// efund_max_fee is the maximum amount of locked eFUND, in denom, that can be unlocked to pay the fees of a Tx
+	// containing any Msgs other than WRKChain and BEACON Msgs, whose fees are set by their own module params. Any
+	// fee above this amount must be paid from the fee payer's spendable FUND. 0 means no locked eFUND is unlocked
+	// for such Txs
+	EfundMaxFee uint64 `protobuf:"varint,9,opt,name=efund_max_fee,json=efundMaxFee,proto3" json:"efund_max_fee,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0031edbd5eb0f2fc, []int{12}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEntSigners() string {
+	if m != nil {
+		return m.EntSigners
+	}
+	return ""
+}
+
+func (m *Params) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Params) GetMinAccepts() uint64 {
+	if m != nil {
+		return m.MinAccepts
+	}
+	return 0
+}
+
+func (m *Params) GetDecisionTimeLimit() uint64 {
+	if m != nil {
+		return m.DecisionTimeLimit
+	}
+	return 0
+}
+
+func (m *Params) GetEfundExpiryPeriod() uint64 {
+	if m != nil {
+		return m.EfundExpiryPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetEfundFeeMsgTypes() []string {
+	if m != nil {
+		return m.EfundFeeMsgTypes
+	}
+	return nil
+}
+
+func (m *Params) GetGroupPolicyAddress() string {
+	if m != nil {
+		return m.GroupPolicyAddress
+	}
+	return ""
+}
+
+func (m *Params) GetLedgerRetentionPeriod() uint64 {
+	if m != nil {
+		return m.LedgerRetentionPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetEfundMaxFee() uint64 {
+	if m != nil {
+		return m.EfundMaxFee
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("mainchain.enterprise.v1.PurchaseOrderStatus", PurchaseOrderStatus_name, PurchaseOrderStatus_value)
+	proto.RegisterEnum("mainchain.enterprise.v1.WhitelistAction", WhitelistAction_name, WhitelistAction_value)
+	proto.RegisterEnum("mainchain.enterprise.v1.LedgerEntryType", LedgerEntryType_name, LedgerEntryType_value)
+	proto.RegisterType((*PurchaseOrderDecision)(nil), "mainchain.enterprise.v1.PurchaseOrderDecision")
+	proto.RegisterType((*EnterpriseUndPurchaseOrder)(nil), "mainchain.enterprise.v1.EnterpriseUndPurchaseOrder")
+	proto.RegisterType((*PurchaseOrders)(nil), "mainchain.enterprise.v1.PurchaseOrders")
+	proto.RegisterType((*LockedUnd)(nil), "mainchain.enterprise.v1.LockedUnd")
+	proto.RegisterType((*LockedEFUNDLot)(nil), "mainchain.enterprise.v1.LockedEFUNDLot")
+	proto.RegisterType((*EFUNDLedgerEntry)(nil), "mainchain.enterprise.v1.EFUNDLedgerEntry")
+	proto.RegisterType((*SpentEFUND)(nil), "mainchain.enterprise.v1.SpentEFUND")
+	proto.RegisterType((*EnterpriseUserAccount)(nil), "mainchain.enterprise.v1.EnterpriseUserAccount")
+	proto.RegisterType((*WhitelistAddresses)(nil), "mainchain.enterprise.v1.WhitelistAddresses")
+	proto.RegisterType((*WhitelistEntry)(nil), "mainchain.enterprise.v1.WhitelistEntry")
+	proto.RegisterType((*LinkedAddress)(nil), "mainchain.enterprise.v1.LinkedAddress")
+	proto.RegisterType((*EntSigner)(nil), "mainchain.enterprise.v1.EntSigner")
+	proto.RegisterType((*Params)(nil), "mainchain.enterprise.v1.Params")
+}
+
+func init() {
+	proto.RegisterFile("mainchain/enterprise/v1/enterprise.proto", fileDescriptor_0031edbd5eb0f2fc)
+}
+
+var fileDescriptor_0031edbd5eb0f2fc = []byte{
+	// 1660 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcf, 0x6f, 0x23, 0x49,
+	0x15, 0x4e, 0x3b, 0x8e, 0x93, 0x7e, 0x49, 0x1c, 0xa7, 0x26, 0x99, 0x38, 0x66, 0xc6, 0x63, 0x3c,
+	0x42, 0x84, 0x88, 0x38, 0x3b, 0x59, 0xb1, 0x2b, 0x45, 0x0b, 0xc2, 0x89, 0x3b, 0x1b, 0xaf, 0x3c,
+	0x89, 0x69, 0x3b, 0x84, 0x45, 0x48, 0xad, 0x4e, 0x77, 0xc5, 0x2e, 0x70, 0xff, 0xa0, 0xbb, 0x3c,
+	0x9b, 0xfc, 0x05, 0x20, 0x8b, 0x03, 0x20, 0xae, 0x3e, 0x71, 0x41, 0x9c, 0x56, 0xc0, 0x81, 0x3f,
+	0x61, 0x8f, 0x2b, 0x4e, 0x9c, 0x16, 0x34, 0x73, 0xd8, 0x1b, 0x17, 0x4e, 0x5c, 0x10, 0xaa, 0x1f,
+	0x6d, 0x77, 0x27, 0xce, 0xc4, 0x33, 0x48, 0x7b, 0x89, 0x5c, 0x55, 0xdf, 0x57, 0xf5, 0xd5, 0xf7,
+	0xaa, 0x5e, 0xbd, 0x0e, 0x6c, 0x39, 0x26, 0x71, 0xad, 0xae, 0x49, 0xdc, 0x5d, 0xec, 0x52, 0x1c,
+	0xf8, 0x01, 0x09, 0xf1, 0xee, 0x8b, 0x67, 0xb1, 0x56, 0xc5, 0x0f, 0x3c, 0xea, 0xa1, 0x8d, 0x11,
+	0xb2, 0x12, 0x1b, 0x7b, 0xf1, 0xac, 0xb0, 0x6a, 0x3a, 0xc4, 0xf5, 0x76, 0xf9, 0x5f, 0x81, 0x2d,
+	0x14, 0x2d, 0x2f, 0x74, 0xbc, 0x70, 0xf7, 0xc2, 0xe4, 0x93, 0x5d, 0x60, 0x6a, 0x3e, 0xdb, 0xb5,
+	0x3c, 0xe2, 0xca, 0xf1, 0x4d, 0x31, 0x6e, 0xf0, 0xd6, 0xae, 0x68, 0xc8, 0xa1, 0xb5, 0x8e, 0xd7,
+	0xf1, 0x44, 0x3f, 0xfb, 0x25, 0x7a, 0xcb, 0x2f, 0x15, 0x58, 0x6f, 0xf6, 0x03, 0xab, 0x6b, 0x86,
+	0xf8, 0x34, 0xb0, 0x71, 0x50, 0xc3, 0x16, 0x09, 0x89, 0xe7, 0xa2, 0x77, 0x20, 0x13, 0x92, 0x8e,
+	0x8b, 0x83, 0xbc, 0x52, 0x52, 0xb6, 0xd4, 0x83, 0xfc, 0xdf, 0xfe, 0xb2, 0xb3, 0x26, 0x67, 0xac,
+	0xda, 0x76, 0x80, 0xc3, 0xb0, 0x45, 0x03, 0xe2, 0x76, 0x74, 0x89, 0x43, 0xc7, 0xb0, 0x60, 0x4b,
+	0x76, 0x3e, 0x55, 0x52, 0xb6, 0xb2, 0x7b, 0xdf, 0xae, 0xdc, 0xb1, 0xb7, 0x4a, 0x62, 0xcd, 0x16,
+	0x35, 0x69, 0x3f, 0xd4, 0x47, 0x6c, 0xf4, 0x14, 0x96, 0xa3, 0xdf, 0x06, 0x25, 0x0e, 0xce, 0xcf,
+	0x96, 0x94, 0xad, 0xb4, 0xbe, 0x14, 0x75, 0xb6, 0x89, 0x83, 0xf7, 0xb7, 0x06, 0x5f, 0x7e, 0xba,
+	0xfd, 0x34, 0x69, 0xee, 0xc4, 0xad, 0x94, 0xff, 0x33, 0x0b, 0x05, 0x6d, 0x84, 0x3b, 0x73, 0xed,
+	0x04, 0x0c, 0x65, 0x21, 0x45, 0x6c, 0xbe, 0xcb, 0xb4, 0x9e, 0x22, 0x36, 0x7a, 0x0f, 0x54, 0x5f,
+	0x02, 0x82, 0x7c, 0xea, 0x9e, 0xcd, 0x8f, 0xa1, 0xe8, 0x7d, 0xc8, 0x98, 0x8e, 0xd7, 0x77, 0x29,
+	0x97, 0xbb, 0xb8, 0xb7, 0x59, 0x91, 0x0c, 0x16, 0xad, 0x8a, 0x8c, 0x56, 0xe5, 0xd0, 0x23, 0xee,
+	0x41, 0xfa, 0xb3, 0x2f, 0x9e, 0xcc, 0xe8, 0x12, 0x8e, 0x6a, 0x90, 0x09, 0xb9, 0x05, 0xf9, 0xf4,
+	0x5b, 0xd8, 0x26, 0xb9, 0xe8, 0x31, 0x40, 0x60, 0x92, 0x10, 0x0b, 0xc7, 0xe6, 0xf8, 0x76, 0x54,
+	0xde, 0xc3, 0xec, 0x42, 0xdf, 0x84, 0x15, 0xcb, 0x73, 0xfc, 0x1e, 0xa6, 0x23, 0x57, 0x33, 0x1c,
+	0x93, 0x1d, 0x77, 0x73, 0xe0, 0xcf, 0x41, 0x8d, 0x7c, 0x0e, 0xf3, 0xf3, 0xa5, 0xd9, 0xad, 0xc5,
+	0xbd, 0xca, 0x74, 0x82, 0x22, 0xc3, 0x0f, 0x9e, 0xb2, 0xed, 0xfd, 0xf1, 0x1f, 0x4f, 0x1e, 0x4e,
+	0x1c, 0x0e, 0xff, 0xf0, 0xe5, 0xa7, 0xdb, 0x8a, 0x3e, 0x5e, 0x05, 0x6d, 0xc3, 0x2a, 0xbe, 0xec,
+	0xbb, 0xb6, 0x81, 0xaf, 0x7c, 0x12, 0x5c, 0x0b, 0x75, 0x0b, 0x5c, 0xdd, 0x0a, 0x1f, 0xd0, 0x78,
+	0x3f, 0x0f, 0xfb, 0x0e, 0x0b, 0xfb, 0x56, 0x32, 0xec, 0x77, 0x07, 0xb7, 0xfc, 0x1b, 0x05, 0xb2,
+	0x89, 0x9e, 0x10, 0xfd, 0x04, 0x56, 0xa2, 0xa0, 0x19, 0x1e, 0xef, 0xca, 0x2b, 0x7c, 0x9b, 0xef,
+	0xde, 0xb9, 0xcd, 0xbb, 0x17, 0xd0, 0xb3, 0x7e, 0x62, 0xf6, 0xfd, 0xaf, 0x33, 0x7d, 0x8f, 0x5e,
+	0x73, 0x2c, 0xc3, 0xf2, 0x6f, 0x15, 0x50, 0x1b, 0x9e, 0xf5, 0x33, 0x6c, 0x9f, 0xb9, 0x36, 0xaa,
+	0xc0, 0x9c, 0xf7, 0xc9, 0x34, 0xf7, 0x4c, 0xc0, 0x62, 0xc7, 0x2c, 0xf5, 0x46, 0xc7, 0x6c, 0xff,
+	0x11, 0x53, 0xb6, 0x91, 0x54, 0x36, 0x92, 0x51, 0xfe, 0xb7, 0x02, 0x59, 0xd1, 0xd2, 0x8e, 0xce,
+	0x4e, 0x6a, 0x0d, 0x8f, 0xde, 0xba, 0x18, 0x23, 0xa5, 0xa9, 0xe9, 0x94, 0x6e, 0xc3, 0x6a, 0xd2,
+	0x68, 0x83, 0xd8, 0xf2, 0x2a, 0xaf, 0x24, 0x5c, 0xab, 0xdb, 0xb1, 0x5d, 0xa5, 0xdf, 0xec, 0xf2,
+	0x7c, 0x0d, 0xd4, 0x1e, 0x97, 0x6d, 0x98, 0x54, 0x9e, 0xfa, 0x05, 0xd1, 0x51, 0xa5, 0xe8, 0x09,
+	0x2c, 0xc6, 0x8f, 0x94, 0x38, 0xf0, 0x80, 0x47, 0xa7, 0xa9, 0xfc, 0xd7, 0x34, 0xe4, 0xc4, 0x7e,
+	0xb1, 0xdd, 0xc1, 0x81, 0xe6, 0xd2, 0xe0, 0xfa, 0xff, 0xde, 0xf7, 0x87, 0x00, 0x98, 0x4d, 0x64,
+	0xd0, 0x6b, 0x5f, 0xe4, 0xae, 0xec, 0xde, 0xd6, 0x9d, 0x67, 0x2b, 0xb6, 0x72, 0xfb, 0xda, 0xc7,
+	0xba, 0x8a, 0xa3, 0x9f, 0x6f, 0x6f, 0xca, 0x44, 0xe7, 0xe7, 0x26, 0x3b, 0xbf, 0x01, 0xf3, 0xf4,
+	0xca, 0xe8, 0x9a, 0x61, 0x97, 0xfb, 0xa3, 0xea, 0x19, 0x7a, 0x75, 0x6c, 0x86, 0x5d, 0xe6, 0xac,
+	0x13, 0x76, 0xf8, 0x26, 0x44, 0x22, 0x50, 0xf5, 0x05, 0x27, 0xec, 0x30, 0x65, 0x21, 0x7a, 0x08,
+	0x99, 0x2e, 0x26, 0x9d, 0x2e, 0xe5, 0xf7, 0x74, 0x56, 0x97, 0x2d, 0xf4, 0x08, 0x54, 0x66, 0x75,
+	0x48, 0x4d, 0xc7, 0xcf, 0xab, 0x22, 0x09, 0x8d, 0x3a, 0xd0, 0x11, 0x64, 0x65, 0xb0, 0x2e, 0xcc,
+	0x9e, 0xe9, 0x5a, 0x38, 0x0f, 0xd3, 0x6d, 0x6c, 0x59, 0xd0, 0x0e, 0x04, 0x0b, 0xd5, 0x60, 0x39,
+	0xf4, 0xb1, 0x4b, 0x47, 0xd3, 0x2c, 0x4e, 0x37, 0xcd, 0x12, 0x67, 0x45, 0xb3, 0x7c, 0x00, 0x4b,
+	0x16, 0xb3, 0x0b, 0x07, 0xbe, 0x19, 0xd0, 0xeb, 0xfc, 0xd2, 0x3d, 0xe1, 0x4d, 0xa0, 0xcb, 0xbf,
+	0x53, 0x00, 0x5a, 0x6c, 0x3a, 0x7e, 0x7e, 0xbe, 0xba, 0x6b, 0xfc, 0x98, 0x5d, 0xe3, 0x7c, 0xf2,
+	0x1a, 0x8f, 0x75, 0x94, 0xff, 0x9b, 0x82, 0xf5, 0x58, 0xba, 0x0a, 0x71, 0x50, 0xb5, 0xb8, 0xee,
+	0x37, 0x56, 0x78, 0x00, 0x4b, 0x32, 0x58, 0x3c, 0x07, 0x4f, 0xab, 0x73, 0x51, 0x90, 0x34, 0xc6,
+	0x61, 0x01, 0xef, 0x60, 0x17, 0x07, 0x66, 0xcf, 0x08, 0xfb, 0xbe, 0xdf, 0xbb, 0x9e, 0xf6, 0x6d,
+	0x5c, 0x96, 0xb4, 0x16, 0x67, 0xa1, 0xef, 0xc3, 0xa2, 0x08, 0xb8, 0x90, 0x32, 0xe5, 0x75, 0x00,
+	0xce, 0x11, 0x4a, 0xbe, 0x0b, 0x2a, 0x6b, 0xd9, 0xe6, 0x45, 0x4f, 0xbc, 0x8e, 0x53, 0xf0, 0xc7,
+	0x8c, 0x89, 0xd5, 0xc6, 0x44, 0x9b, 0xcb, 0x21, 0xa0, 0xf3, 0x2e, 0xa1, 0xb8, 0x47, 0x42, 0x2a,
+	0x7d, 0xc5, 0x21, 0x2b, 0x2a, 0xcc, 0xa8, 0xc1, 0x9f, 0x9b, 0xd7, 0x16, 0x15, 0x23, 0xe8, 0xfe,
+	0x37, 0xd8, 0xba, 0xa5, 0xe4, 0xba, 0xb7, 0xa7, 0x2f, 0x7f, 0xa1, 0x40, 0x76, 0xd4, 0x2d, 0xb2,
+	0xd8, 0x1e, 0xcc, 0xcb, 0x69, 0xee, 0x0d, 0x78, 0x04, 0xbc, 0x99, 0x2f, 0x53, 0x37, 0xf3, 0x25,
+	0x3a, 0x86, 0x15, 0xc7, 0xbc, 0x32, 0x64, 0x0e, 0xe1, 0x5e, 0x4e, 0x19, 0xd0, 0xac, 0x63, 0x5e,
+	0x35, 0xc7, 0x34, 0x84, 0x20, 0x4d, 0x09, 0x0e, 0x78, 0x28, 0x55, 0x9d, 0xff, 0x46, 0x9b, 0xb0,
+	0x60, 0xda, 0x76, 0x3c, 0x95, 0xcf, 0xf3, 0x76, 0x95, 0x96, 0x7f, 0xa5, 0xc0, 0x72, 0x83, 0xb8,
+	0x2c, 0xad, 0x4b, 0xad, 0x6f, 0x7a, 0x9c, 0xdf, 0x81, 0x4c, 0x8f, 0x4f, 0x70, 0x6f, 0x1a, 0x97,
+	0xb8, 0x84, 0x9c, 0xd9, 0xa4, 0x1c, 0x17, 0x54, 0xcd, 0xa5, 0x2d, 0x51, 0xf8, 0xbe, 0x8d, 0xd3,
+	0x08, 0xd2, 0xae, 0x29, 0x2d, 0x56, 0x75, 0xfe, 0xfb, 0x75, 0xeb, 0xfd, 0x69, 0x16, 0x32, 0x4d,
+	0x33, 0x30, 0x1d, 0x11, 0x23, 0x97, 0x1a, 0xa2, 0xe8, 0x96, 0x2b, 0xea, 0x80, 0x23, 0x35, 0x21,
+	0x5a, 0x83, 0x39, 0x1b, 0xbb, 0x9e, 0x23, 0xe7, 0x16, 0x0d, 0x46, 0x73, 0x88, 0x6b, 0x98, 0x96,
+	0x85, 0x7d, 0x1a, 0xca, 0xf9, 0xc1, 0x21, 0x6e, 0x55, 0xf4, 0xa0, 0x0a, 0x3c, 0x48, 0x14, 0xdd,
+	0x46, 0x8f, 0x38, 0x44, 0xbc, 0x3c, 0x69, 0x7d, 0x35, 0x5e, 0x7a, 0x37, 0xd8, 0x00, 0xc3, 0x27,
+	0x8a, 0x36, 0x1f, 0x07, 0xc4, 0x8b, 0x5e, 0x99, 0xd5, 0x58, 0xd9, 0xd6, 0xe4, 0x03, 0x68, 0x27,
+	0xc2, 0x5f, 0x62, 0x6c, 0x8c, 0x1f, 0x96, 0x0c, 0x7f, 0x58, 0x72, 0x7c, 0xe8, 0x08, 0xe3, 0xe7,
+	0xd1, 0x03, 0xf3, 0x11, 0xac, 0x75, 0x02, 0xaf, 0xef, 0x1b, 0xbe, 0xd7, 0x23, 0xd6, 0xb5, 0x11,
+	0x39, 0x3c, 0x7f, 0x8f, 0xc3, 0x88, 0xb3, 0x9a, 0x9c, 0x14, 0x1d, 0x95, 0xf7, 0x60, 0xa3, 0xc7,
+	0x5f, 0x59, 0x23, 0xc0, 0x14, 0xbb, 0xbc, 0x02, 0x96, 0x72, 0x45, 0x95, 0xb9, 0x2e, 0x86, 0xf5,
+	0x68, 0x54, 0x4a, 0x2e, 0xc3, 0xb2, 0x90, 0xcc, 0xce, 0xfc, 0x25, 0xc6, 0xf2, 0x41, 0x5b, 0xe4,
+	0x9d, 0xcf, 0xcd, 0xab, 0x23, 0x8c, 0xf7, 0x37, 0xd9, 0x05, 0x5d, 0xbb, 0x51, 0xef, 0xf1, 0x48,
+	0x6d, 0xff, 0x22, 0x05, 0x0f, 0x26, 0x54, 0xec, 0xac, 0x52, 0x6f, 0xb5, 0xab, 0xed, 0xb3, 0x96,
+	0x71, 0x52, 0x6f, 0xe4, 0x66, 0x0a, 0xcb, 0x83, 0x61, 0x49, 0x15, 0x63, 0x27, 0xa4, 0xc7, 0xbe,
+	0x7e, 0xe4, 0xb0, 0x5e, 0xad, 0xb7, 0xb4, 0x5a, 0x4e, 0x29, 0xe4, 0x06, 0xc3, 0xd2, 0x92, 0x40,
+	0xe8, 0xac, 0xa2, 0xb7, 0x59, 0x39, 0x2f, 0x41, 0xd5, 0xc3, 0x43, 0xad, 0xd9, 0xd6, 0x6a, 0xb9,
+	0x54, 0x01, 0x0d, 0x86, 0xa5, 0xac, 0x80, 0x89, 0xa8, 0x26, 0x80, 0xba, 0xf6, 0x91, 0x76, 0xc8,
+	0x80, 0xb3, 0x71, 0xa0, 0x8e, 0x7f, 0x8a, 0x2d, 0x06, 0xfc, 0x16, 0xe4, 0x24, 0xf0, 0xf0, 0xf4,
+	0x79, 0xb3, 0xa1, 0x31, 0x64, 0xba, 0xf0, 0x60, 0x30, 0x2c, 0xad, 0x08, 0xe4, 0xa1, 0xf8, 0x4e,
+	0x48, 0x40, 0xcf, 0xeb, 0xed, 0xe3, 0x9a, 0x5e, 0x3d, 0x3f, 0xc9, 0xcd, 0xc5, 0xa1, 0xe7, 0x84,
+	0x76, 0xed, 0xc0, 0xfc, 0xc4, 0x2d, 0xa4, 0x7f, 0xf9, 0xfb, 0xe2, 0xcc, 0xf6, 0x9f, 0x15, 0x58,
+	0x19, 0x67, 0x2d, 0x8b, 0x8a, 0x0f, 0xcc, 0xb5, 0xf3, 0xe3, 0x7a, 0x5b, 0x6b, 0xd4, 0x5b, 0x6d,
+	0xa3, 0x7a, 0xd8, 0xae, 0x9f, 0x9e, 0x48, 0x3f, 0x1e, 0x0e, 0x86, 0x25, 0x74, 0x03, 0xce, 0x8c,
+	0x99, 0xc4, 0xa8, 0xd6, 0x98, 0x3f, 0x93, 0x18, 0x55, 0x9b, 0x7d, 0xca, 0x6d, 0xdc, 0x62, 0xe8,
+	0xda, 0xf3, 0xd3, 0x1f, 0x6a, 0xb9, 0x54, 0x61, 0x73, 0x30, 0x2c, 0xad, 0xdf, 0x20, 0xe9, 0xd8,
+	0xf1, 0x5e, 0x60, 0xa9, 0xfa, 0x5f, 0x29, 0x58, 0xb9, 0x51, 0x9d, 0xa1, 0x1d, 0x58, 0x6f, 0x68,
+	0xb5, 0x0f, 0x35, 0xdd, 0xd0, 0x4e, 0xda, 0xfa, 0xc7, 0x46, 0xfb, 0xe3, 0xa6, 0x26, 0x65, 0x73,
+	0x53, 0x63, 0x78, 0x26, 0xf9, 0x00, 0x4a, 0xb7, 0xe1, 0xcd, 0x33, 0xfd, 0xf0, 0xb8, 0xda, 0xd2,
+	0x8c, 0x53, 0xbd, 0xa6, 0xe9, 0x39, 0xa5, 0xf0, 0x68, 0x30, 0x2c, 0xe5, 0x63, 0xcc, 0xe4, 0xf7,
+	0xe9, 0xc4, 0x25, 0x8f, 0x34, 0x2d, 0x0a, 0x78, 0x8c, 0x78, 0x84, 0x31, 0xfa, 0x0e, 0x6c, 0xde,
+	0x86, 0x6b, 0x3f, 0x6a, 0xd6, 0x75, 0x1e, 0x7a, 0x6e, 0x55, 0x8c, 0xc2, 0xef, 0x28, 0xb6, 0xd1,
+	0xf7, 0xa0, 0x78, 0x9b, 0xd6, 0xd6, 0xab, 0x27, 0xad, 0x23, 0x4d, 0x37, 0x4e, 0xcf, 0xda, 0xb9,
+	0x74, 0xa1, 0x30, 0x18, 0x96, 0x1e, 0xc6, 0x1d, 0x09, 0x4c, 0x37, 0xbc, 0xc4, 0xc1, 0x69, 0x9f,
+	0xa2, 0x0f, 0xe0, 0xf1, 0x6b, 0xf8, 0x75, 0x76, 0x40, 0xb8, 0xe1, 0x13, 0xe8, 0x75, 0x79, 0x4c,
+	0x0e, 0x7e, 0xf0, 0xd9, 0xcb, 0xa2, 0xf2, 0xf9, 0xcb, 0xa2, 0xf2, 0xcf, 0x97, 0x45, 0xe5, 0xd7,
+	0xaf, 0x8a, 0x33, 0x9f, 0xbf, 0x2a, 0xce, 0xfc, 0xfd, 0x55, 0x71, 0xe6, 0xc7, 0xef, 0x77, 0x08,
+	0xed, 0xf6, 0x2f, 0x2a, 0x96, 0xe7, 0xec, 0xf6, 0x5d, 0x72, 0x49, 0x2c, 0x93, 0x85, 0x6b, 0x87,
+	0xb5, 0xc7, 0xff, 0x69, 0xb9, 0x8a, 0xff, 0xaf, 0x85, 0xa7, 0x97, 0x8b, 0x0c, 0xff, 0x3f, 0xc7,
+	0xbb, 0xff, 0x1b, 0x00, 0xd1, 0xf6, 0x68, 0x71, 0x90, 0x11, 0x00, 0x00,
+}
+
+func (m *PurchaseOrderDecision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PurchaseOrderDecision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
 func (m *PurchaseOrderDecision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
@@ -766,6 +1376,11 @@ func (m *EnterpriseUndPurchaseOrder) MarshalToSizedBuffer(dAtA []byte) (int, err
 	_ = i
 	var l int
 	_ = l
+	if m.EfundExpiryTime != 0 {
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.EfundExpiryTime))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Decisions) > 0 {
 		for iNdEx := len(m.Decisions) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -897,7 +1512,7 @@ func (m *LockedUnd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SpentEFUND) Marshal() (dAtA []byte, err error) {
+func (m *LockedEFUNDLot) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -907,16 +1522,26 @@ func (m *SpentEFUND) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SpentEFUND) MarshalTo(dAtA []byte) (int, error) {
+func (m *LockedEFUNDLot) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SpentEFUND) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LockedEFUNDLot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryTime != 0 {
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.ExpiryTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LockedAt != 0 {
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.LockedAt))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
 		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -926,18 +1551,28 @@ func (m *SpentEFUND) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintEnterprise(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x22
+	if m.PurchaseOrderId != 0 {
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.PurchaseOrderId))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
 		i = encodeVarintEnterprise(dAtA, i, uint64(len(m.Owner)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EnterpriseUserAccount) Marshal() (dAtA []byte, err error) {
+func (m *EFUNDLedgerEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -947,18 +1582,25 @@ func (m *EnterpriseUserAccount) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EnterpriseUserAccount) MarshalTo(dAtA []byte) (int, error) {
+func (m *EFUNDLedgerEntry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EnterpriseUserAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EFUNDLedgerEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Counterparty) > 0 {
+		i -= len(m.Counterparty)
+		copy(dAtA[i:], m.Counterparty)
+		i = encodeVarintEnterprise(dAtA, i, uint64(len(m.Counterparty)))
+		i--
+		dAtA[i] = 0x62
+	}
 	{
-		size, err := m.Spendable.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.SpentBalance.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -966,9 +1608,9 @@ func (m *EnterpriseUserAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintEnterprise(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
+	dAtA[i] = 0x5a
 	{
-		size, err := m.SpentEfund.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.LockedBalance.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -976,19 +1618,40 @@ func (m *EnterpriseUserAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintEnterprise(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.GeneralSupply.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	dAtA[i] = 0x52
+	if m.Timestamp != 0 {
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Height != 0 {
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.MsgTypes) > 0 {
+		for iNdEx := len(m.MsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypes[iNdEx])
+			copy(dAtA[i:], m.MsgTypes[iNdEx])
+			i = encodeVarintEnterprise(dAtA, i, uint64(len(m.MsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
 		}
-		i -= size
-		i = encodeVarintEnterprise(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x1a
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintEnterprise(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PurchaseOrderId != 0 {
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.PurchaseOrderId))
+		i--
+		dAtA[i] = 0x28
+	}
 	{
-		size, err := m.LockedEfund.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -996,18 +1659,28 @@ func (m *EnterpriseUserAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintEnterprise(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x22
+	if m.EntryType != 0 {
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.EntryType))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
 		i = encodeVarintEnterprise(dAtA, i, uint64(len(m.Owner)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *WhitelistAddresses) Marshal() (dAtA []byte, err error) {
+func (m *SpentEFUND) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1017,30 +1690,281 @@ func (m *WhitelistAddresses) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WhitelistAddresses) MarshalTo(dAtA []byte) (int, error) {
+func (m *SpentEFUND) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WhitelistAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SpentEFUND) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintEnterprise(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintEnterprise(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEnterprise(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EnterpriseUserAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EnterpriseUserAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EnterpriseUserAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Spendable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEnterprise(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.SpentEfund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEnterprise(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.GeneralSupply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEnterprise(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.LockedEfund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEnterprise(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEnterprise(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WhitelistAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhitelistAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhitelistAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintEnterprise(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WhitelistEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhitelistEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhitelistEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddedAt != 0 {
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.AddedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Tier) > 0 {
+		i -= len(m.Tier)
+		copy(dAtA[i:], m.Tier)
+		i = encodeVarintEnterprise(dAtA, i, uint64(len(m.Tier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.MaxPurchasable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEnterprise(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ExpiryTime != 0 {
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.ExpiryTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEnterprise(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LinkedAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LinkedAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LinkedAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddedAt != 0 {
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.AddedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Linked) > 0 {
+		i -= len(m.Linked)
+		copy(dAtA[i:], m.Linked)
+		i = encodeVarintEnterprise(dAtA, i, uint64(len(m.Linked)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEnterprise(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EntSigner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EntSigner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EntSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddedAt != 0 {
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.AddedAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEnterprise(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEnterprise(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -1059,6 +1983,37 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EfundMaxFee != 0 {
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.EfundMaxFee))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LedgerRetentionPeriod != 0 {
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.LedgerRetentionPeriod))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.GroupPolicyAddress) > 0 {
+		i -= len(m.GroupPolicyAddress)
+		copy(dAtA[i:], m.GroupPolicyAddress)
+		i = encodeVarintEnterprise(dAtA, i, uint64(len(m.GroupPolicyAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.EfundFeeMsgTypes) > 0 {
+		for iNdEx := len(m.EfundFeeMsgTypes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EfundFeeMsgTypes[iNdEx])
+			copy(dAtA[i:], m.EfundFeeMsgTypes[iNdEx])
+			i = encodeVarintEnterprise(dAtA, i, uint64(len(m.EfundFeeMsgTypes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.EfundExpiryPeriod != 0 {
+		i = encodeVarintEnterprise(dAtA, i, uint64(m.EfundExpiryPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.DecisionTimeLimit != 0 {
 		i = encodeVarintEnterprise(dAtA, i, uint64(m.DecisionTimeLimit))
 		i--
@@ -1146,6 +2101,9 @@ func (m *EnterpriseUndPurchaseOrder) Size() (n int) {
 			n += 1 + l + sovEnterprise(uint64(l))
 		}
 	}
+	if m.EfundExpiryTime != 0 {
+		n += 1 + sovEnterprise(uint64(m.EfundExpiryTime))
+	}
 	return n
 }
 
@@ -1179,34 +2137,109 @@ func (m *LockedUnd) Size() (n int) {
 	return n
 }
 
-func (m *SpentEFUND) Size() (n int) {
+func (m *LockedEFUNDLot) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEnterprise(uint64(m.Id))
+	}
 	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovEnterprise(uint64(l))
 	}
+	if m.PurchaseOrderId != 0 {
+		n += 1 + sovEnterprise(uint64(m.PurchaseOrderId))
+	}
 	l = m.Amount.Size()
 	n += 1 + l + sovEnterprise(uint64(l))
+	if m.LockedAt != 0 {
+		n += 1 + sovEnterprise(uint64(m.LockedAt))
+	}
+	if m.ExpiryTime != 0 {
+		n += 1 + sovEnterprise(uint64(m.ExpiryTime))
+	}
 	return n
 }
 
-func (m *EnterpriseUserAccount) Size() (n int) {
+func (m *EFUNDLedgerEntry) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEnterprise(uint64(m.Id))
+	}
 	l = len(m.Owner)
 	if l > 0 {
 		n += 1 + l + sovEnterprise(uint64(l))
 	}
-	l = m.LockedEfund.Size()
+	if m.EntryType != 0 {
+		n += 1 + sovEnterprise(uint64(m.EntryType))
+	}
+	l = m.Amount.Size()
 	n += 1 + l + sovEnterprise(uint64(l))
-	l = m.GeneralSupply.Size()
+	if m.PurchaseOrderId != 0 {
+		n += 1 + sovEnterprise(uint64(m.PurchaseOrderId))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovEnterprise(uint64(l))
+	}
+	if len(m.MsgTypes) > 0 {
+		for _, s := range m.MsgTypes {
+			l = len(s)
+			n += 1 + l + sovEnterprise(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovEnterprise(uint64(m.Height))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovEnterprise(uint64(m.Timestamp))
+	}
+	l = m.LockedBalance.Size()
+	n += 1 + l + sovEnterprise(uint64(l))
+	l = m.SpentBalance.Size()
+	n += 1 + l + sovEnterprise(uint64(l))
+	l = len(m.Counterparty)
+	if l > 0 {
+		n += 1 + l + sovEnterprise(uint64(l))
+	}
+	return n
+}
+
+func (m *SpentEFUND) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEnterprise(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEnterprise(uint64(l))
+	return n
+}
+
+func (m *EnterpriseUserAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEnterprise(uint64(l))
+	}
+	l = m.LockedEfund.Size()
+	n += 1 + l + sovEnterprise(uint64(l))
+	l = m.GeneralSupply.Size()
 	n += 1 + l + sovEnterprise(uint64(l))
 	l = m.SpentEfund.Size()
 	n += 1 + l + sovEnterprise(uint64(l))
@@ -1230,6 +2263,71 @@ func (m *WhitelistAddresses) Size() (n int) {
 	return n
 }
 
+func (m *WhitelistEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEnterprise(uint64(l))
+	}
+	if m.ExpiryTime != 0 {
+		n += 1 + sovEnterprise(uint64(m.ExpiryTime))
+	}
+	l = m.MaxPurchasable.Size()
+	n += 1 + l + sovEnterprise(uint64(l))
+	l = len(m.Tier)
+	if l > 0 {
+		n += 1 + l + sovEnterprise(uint64(l))
+	}
+	if m.AddedAt != 0 {
+		n += 1 + sovEnterprise(uint64(m.AddedAt))
+	}
+	return n
+}
+
+func (m *LinkedAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEnterprise(uint64(l))
+	}
+	l = len(m.Linked)
+	if l > 0 {
+		n += 1 + l + sovEnterprise(uint64(l))
+	}
+	if m.AddedAt != 0 {
+		n += 1 + sovEnterprise(uint64(m.AddedAt))
+	}
+	return n
+}
+
+func (m *EntSigner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEnterprise(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEnterprise(uint64(l))
+	}
+	if m.AddedAt != 0 {
+		n += 1 + sovEnterprise(uint64(m.AddedAt))
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1250,6 +2348,25 @@ func (m *Params) Size() (n int) {
 	if m.DecisionTimeLimit != 0 {
 		n += 1 + sovEnterprise(uint64(m.DecisionTimeLimit))
 	}
+	if m.EfundExpiryPeriod != 0 {
+		n += 1 + sovEnterprise(uint64(m.EfundExpiryPeriod))
+	}
+	if len(m.EfundFeeMsgTypes) > 0 {
+		for _, s := range m.EfundFeeMsgTypes {
+			l = len(s)
+			n += 1 + l + sovEnterprise(uint64(l))
+		}
+	}
+	l = len(m.GroupPolicyAddress)
+	if l > 0 {
+		n += 1 + l + sovEnterprise(uint64(l))
+	}
+	if m.LedgerRetentionPeriod != 0 {
+		n += 1 + sovEnterprise(uint64(m.LedgerRetentionPeriod))
+	}
+	if m.EfundMaxFee != 0 {
+		n += 1 + sovEnterprise(uint64(m.EfundMaxFee))
+	}
 	return n
 }
 
@@ -1339,11 +2456,1118 @@ func (m *PurchaseOrderDecision) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTime", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecisionTime", wireType)
+			}
+			m.DecisionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecisionTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnterprise(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EnterpriseUndPurchaseOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnterprise
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EnterpriseUndPurchaseOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EnterpriseUndPurchaseOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchaser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= PurchaseOrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RaiseTime", wireType)
+			}
+			m.RaiseTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RaiseTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			m.CompletionTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CompletionTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Decisions = append(m.Decisions, PurchaseOrderDecision{})
+			if err := m.Decisions[len(m.Decisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EfundExpiryTime", wireType)
+			}
+			m.EfundExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EfundExpiryTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnterprise(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PurchaseOrders) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnterprise
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PurchaseOrders: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PurchaseOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PurchaseOrders = append(m.PurchaseOrders, &EnterpriseUndPurchaseOrder{})
+			if err := m.PurchaseOrders[len(m.PurchaseOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnterprise(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedUnd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnterprise
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedUnd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedUnd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnterprise(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LockedEFUNDLot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnterprise
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LockedEFUNDLot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LockedEFUNDLot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseOrderId", wireType)
+			}
+			m.PurchaseOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurchaseOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAt", wireType)
+			}
+			m.LockedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnterprise(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EFUNDLedgerEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnterprise
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EFUNDLedgerEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EFUNDLedgerEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntryType", wireType)
+			}
+			m.EntryType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EntryType |= LedgerEntryType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseOrderId", wireType)
+			}
+			m.PurchaseOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurchaseOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypes = append(m.MsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LockedBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentBalance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpentBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counterparty", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Counterparty = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEnterprise(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpentEFUND) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEnterprise
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpentEFUND: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpentEFUND: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.DecisionTime = 0
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEnterprise
@@ -1353,11 +3577,25 @@ func (m *PurchaseOrderDecision) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DecisionTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEnterprise(dAtA[iNdEx:])
@@ -1379,7 +3617,7 @@ func (m *PurchaseOrderDecision) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnterpriseUndPurchaseOrder) Unmarshal(dAtA []byte) error {
+func (m *EnterpriseUserAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1402,34 +3640,15 @@ func (m *EnterpriseUndPurchaseOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EnterpriseUndPurchaseOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: EnterpriseUserAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EnterpriseUndPurchaseOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EnterpriseUserAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEnterprise
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1457,11 +3676,11 @@ func (m *EnterpriseUndPurchaseOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Purchaser = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedEfund", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1488,15 +3707,15 @@ func (m *EnterpriseUndPurchaseOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LockedEfund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeneralSupply", wireType)
 			}
-			m.Status = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEnterprise
@@ -1506,35 +3725,30 @@ func (m *EnterpriseUndPurchaseOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= PurchaseOrderStatus(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RaiseTime", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthEnterprise
 			}
-			m.RaiseTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEnterprise
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RaiseTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.CompletionTime = 0
+			if err := m.GeneralSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpentEfund", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEnterprise
@@ -1544,14 +3758,28 @@ func (m *EnterpriseUndPurchaseOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CompletionTime |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			if msglen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpentEfund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spendable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1578,8 +3806,7 @@ func (m *EnterpriseUndPurchaseOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Decisions = append(m.Decisions, PurchaseOrderDecision{})
-			if err := m.Decisions[len(m.Decisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spendable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1604,7 +3831,7 @@ func (m *EnterpriseUndPurchaseOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PurchaseOrders) Unmarshal(dAtA []byte) error {
+func (m *WhitelistAddresses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1627,17 +3854,17 @@ func (m *PurchaseOrders) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PurchaseOrders: wiretype end group for non-group")
+			return fmt.Errorf("proto: WhitelistAddresses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PurchaseOrders: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WhitelistAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEnterprise
@@ -1647,25 +3874,23 @@ func (m *PurchaseOrders) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEnterprise
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEnterprise
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PurchaseOrders = append(m.PurchaseOrders, &EnterpriseUndPurchaseOrder{})
-			if err := m.PurchaseOrders[len(m.PurchaseOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1688,7 +3913,7 @@ func (m *PurchaseOrders) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LockedUnd) Unmarshal(dAtA []byte) error {
+func (m *WhitelistEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1711,15 +3936,15 @@ func (m *LockedUnd) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LockedUnd: wiretype end group for non-group")
+			return fmt.Errorf("proto: WhitelistEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LockedUnd: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WhitelistEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1747,11 +3972,30 @@ func (m *LockedUnd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPurchasable", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1778,63 +4022,13 @@ func (m *LockedUnd) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxPurchasable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEnterprise(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEnterprise
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SpentEFUND) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEnterprise
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SpentEFUND: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpentEFUND: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1862,13 +4056,13 @@ func (m *SpentEFUND) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Tier = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
 			}
-			var msglen int
+			m.AddedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEnterprise
@@ -1878,25 +4072,11 @@ func (m *SpentEFUND) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AddedAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEnterprise
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEnterprise
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEnterprise(dAtA[iNdEx:])
@@ -1918,7 +4098,7 @@ func (m *SpentEFUND) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EnterpriseUserAccount) Unmarshal(dAtA []byte) error {
+func (m *LinkedAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1941,10 +4121,10 @@ func (m *EnterpriseUserAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EnterpriseUserAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: LinkedAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EnterpriseUserAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LinkedAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1981,9 +4161,9 @@ func (m *EnterpriseUserAccount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LockedEfund", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Linked", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEnterprise
@@ -1993,96 +4173,29 @@ func (m *EnterpriseUserAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEnterprise
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEnterprise
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LockedEfund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Linked = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GeneralSupply", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEnterprise
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEnterprise
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEnterprise
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.GeneralSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpentEfund", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEnterprise
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthEnterprise
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEnterprise
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SpentEfund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spendable", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
 			}
-			var msglen int
+			m.AddedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEnterprise
@@ -2092,25 +4205,11 @@ func (m *EnterpriseUserAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.AddedAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEnterprise
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEnterprise
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Spendable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEnterprise(dAtA[iNdEx:])
@@ -2132,7 +4231,7 @@ func (m *EnterpriseUserAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *WhitelistAddresses) Unmarshal(dAtA []byte) error {
+func (m *EntSigner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2155,15 +4254,15 @@ func (m *WhitelistAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: WhitelistAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: EntSigner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: WhitelistAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EntSigner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2191,8 +4290,59 @@ func (m *WhitelistAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+			}
+			m.AddedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEnterprise(dAtA[iNdEx:])
@@ -2345,6 +4495,127 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EfundExpiryPeriod", wireType)
+			}
+			m.EfundExpiryPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EfundExpiryPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EfundFeeMsgTypes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EfundFeeMsgTypes = append(m.EfundFeeMsgTypes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupPolicyAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEnterprise
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupPolicyAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerRetentionPeriod", wireType)
+			}
+			m.LedgerRetentionPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LedgerRetentionPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EfundMaxFee", wireType)
+			}
+			m.EfundMaxFee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEnterprise
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EfundMaxFee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEnterprise(dAtA[iNdEx:])
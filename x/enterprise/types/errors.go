@@ -19,6 +19,13 @@ const (
 	CodeAlreadyWhitelisted            = 112
 	CodeAddressNotWhitelisted         = 113
 	CodeNotAuthorisedToRaisePO        = 114
+	CodeEntSignerAlreadyRegistered    = 115
+	CodeEntSignerNotRegistered        = 116
+	CodeInsufficientEntSigners        = 117
+	CodeGroupPolicyNotFound           = 118
+	CodePurchaseCapExceeded           = 119
+	CodeInsufficientLockedEFUND       = 120
+	CodeAddressNotLinked              = 121
 )
 
 var (
@@ -36,4 +43,11 @@ var (
 	ErrAlreadyWhitelisted            = errorsmod.Register(ModuleName, CodeAlreadyWhitelisted, "address already whitelisted")
 	ErrAddressNotWhitelisted         = errorsmod.Register(ModuleName, CodeAddressNotWhitelisted, "address not whitelisted")
 	ErrNotAuthorisedToRaisePO        = errorsmod.Register(ModuleName, CodeNotAuthorisedToRaisePO, "address not authorised to raise purchase orders")
+	ErrEntSignerAlreadyRegistered    = errorsmod.Register(ModuleName, CodeEntSignerAlreadyRegistered, "ent signer already registered")
+	ErrEntSignerNotRegistered        = errorsmod.Register(ModuleName, CodeEntSignerNotRegistered, "ent signer not registered")
+	ErrInsufficientEntSigners        = errorsmod.Register(ModuleName, CodeInsufficientEntSigners, "number of ent signers must be >= number of minimum accepts")
+	ErrGroupPolicyNotFound           = errorsmod.Register(ModuleName, CodeGroupPolicyNotFound, "group policy not found")
+	ErrPurchaseCapExceeded           = errorsmod.Register(ModuleName, CodePurchaseCapExceeded, "purchase cap exceeded")
+	ErrInsufficientLockedEFUND       = errorsmod.Register(ModuleName, CodeInsufficientLockedEFUND, "insufficient locked eFUND")
+	ErrAddressNotLinked              = errorsmod.Register(ModuleName, CodeAddressNotLinked, "address not linked")
 )
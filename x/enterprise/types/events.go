@@ -8,16 +8,37 @@ var (
 	EventTypeUndPurchaseComplete          = "und_purchase_complete"
 	EventTypeUndUnlocked                  = "und_unlocked"
 	EventTypeWhitelistAddress             = "whitelist_purchase_order_address"
+	EventTypeWithdrawPurchaseOrder        = "withdraw_purchase_order"
+	EventTypeAmendPurchaseOrder           = "amend_purchase_order"
+	EventTypeAddEntSigner                 = "add_ent_signer"
+	EventTypeRemoveEntSigner              = "remove_ent_signer"
+	EventTypeLockedEFUNDLotExpired        = "locked_efund_lot_expired"
+	EventTypeLockedEFUNDLotExpiryFailed   = "locked_efund_lot_expiry_failed"
+	EventTypeTransferLockedEFUND          = "transfer_locked_efund"
+	EventTypePurchaseCapExceeded          = "purchase_cap_exceeded"
+	EventTypeLinkAddress                  = "link_address"
 
 	AttributeValueCategory = ModuleName
 
 	AttributeKeyPurchaseOrderID = "id"
 	AttributeKeyPurchaser       = "purchaser"
 	AttributeKeyAmount          = "amount"
+	AttributeKeyOldAmount       = "old_amount"
 	AttributeKeyDecision        = "decision"
 	AttributeKeySigner          = "signer"
 	AttributeKeyNumAccepts      = "accepts"
 	AttributeKeyNumRejects      = "rejects"
 	AttributeKeyWhitelistAction = "action"
 	AttributeWhitelistAddress   = "address"
+	AttributeKeyEntSignerName   = "name"
+	AttributeKeyOwner           = "owner"
+	AttributeKeyLotID           = "lot_id"
+	AttributeKeyGroupPolicy     = "group_policy"
+	AttributeKeyExpiryTime      = "expiry_time"
+	AttributeKeyMaxPurchasable  = "max_purchasable"
+	AttributeKeyTier            = "tier"
+	AttributeKeyFrom            = "from"
+	AttributeKeyTo              = "to"
+	AttributeKeyError           = "error"
+	AttributeKeyLinked          = "linked"
 )
@@ -11,6 +11,28 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	// legacy genesis files hold signers in params, which are imported into the signer registry
+	entSigners := make(map[string]bool)
+	for _, signer := range data.EntSigners {
+		if _, err := sdk.AccAddressFromBech32(signer.Address); err != nil {
+			return fmt.Errorf("invalid ent signer: Address: %s. Error: %s", signer.Address, err)
+		}
+		if len(signer.Name) > MaxEntSignerNameLength {
+			return fmt.Errorf("invalid ent signer: Name: %s. Error: Name too long", signer.Name)
+		}
+		if entSigners[signer.Address] {
+			return fmt.Errorf("invalid ent signer: Address: %s. Error: Duplicate signer", signer.Address)
+		}
+		entSigners[signer.Address] = true
+	}
+	for _, addr := range data.Params.LegacyEntSigners() {
+		entSigners[addr] = true
+	}
+
+	if !data.Params.IsGroupPolicyMode() && len(entSigners) < int(data.Params.MinAccepts) {
+		return fmt.Errorf("number of ent signers (%d) must be >= number of minimum accepts (%d)", len(entSigners), data.Params.MinAccepts)
+	}
+
 	if data.StartingPurchaseOrderId == 0 {
 		return fmt.Errorf("enterprise starting purchase order id should be greater than 0")
 	}
@@ -57,6 +79,118 @@ func ValidateGenesis(data GenesisState) error {
 		}
 	}
 
+	lockedByOwner := make(map[string]sdk.Coin)
+	for _, locked := range data.LockedUnd {
+		lockedByOwner[locked.Owner] = locked.Amount
+	}
+
+	lotIDs := make(map[uint64]bool)
+	inLotsByOwner := make(map[string]sdk.Coin)
+	for _, lot := range data.LockedEfundLots {
+		if lot.Id == 0 {
+			return fmt.Errorf("invalid locked eFUND lot: Id: %d. Error: Missing Id", lot.Id)
+		}
+		if lotIDs[lot.Id] {
+			return fmt.Errorf("invalid locked eFUND lot: Id: %d. Error: Duplicate Id", lot.Id)
+		}
+		lotIDs[lot.Id] = true
+
+		if data.StartingLockedEfundLotId > 0 && lot.Id >= data.StartingLockedEfundLotId {
+			return fmt.Errorf("invalid locked eFUND lot: Id: %d. Error: Id must be less than starting lot id %d", lot.Id, data.StartingLockedEfundLotId)
+		}
+
+		_, err := sdk.AccAddressFromBech32(lot.Owner)
+		if err != nil {
+			return fmt.Errorf("invalid locked eFUND lot: Owner: %s. Error: Missing Owner", lot.Owner)
+		}
+
+		if !lot.Amount.IsValid() || !lot.Amount.IsPositive() {
+			return fmt.Errorf("invalid locked eFUND lot: Amount: %s. Error: Amount must be greater than 0", lot.Amount)
+		}
+
+		inLots, ok := inLotsByOwner[lot.Owner]
+		if ok {
+			inLots = inLots.Add(lot.Amount)
+		} else {
+			inLots = lot.Amount
+		}
+		inLotsByOwner[lot.Owner] = inLots
+
+		locked, ok := lockedByOwner[lot.Owner]
+		if !ok || locked.Denom != inLots.Denom || locked.Amount.LT(inLots.Amount) {
+			return fmt.Errorf("invalid locked eFUND lot: Owner: %s. Error: Lots exceed locked eFUND", lot.Owner)
+		}
+	}
+
+	whitelisted := make(map[string]bool)
+	for _, wlAddr := range data.Whitelist {
+		if _, err := sdk.AccAddressFromBech32(wlAddr); err != nil {
+			return fmt.Errorf("invalid whitelist: Address: %s. Error: %s", wlAddr, err)
+		}
+		if whitelisted[wlAddr] {
+			return fmt.Errorf("invalid whitelist: Address: %s. Error: Duplicate address", wlAddr)
+		}
+		whitelisted[wlAddr] = true
+	}
+	for _, entry := range data.WhitelistEntries {
+		if _, err := sdk.AccAddressFromBech32(entry.Address); err != nil {
+			return fmt.Errorf("invalid whitelist entry: Address: %s. Error: %s", entry.Address, err)
+		}
+		if whitelisted[entry.Address] {
+			return fmt.Errorf("invalid whitelist entry: Address: %s. Error: Duplicate address", entry.Address)
+		}
+		whitelisted[entry.Address] = true
+
+		if err := ValidateWhitelistEntryAttributes(entry.MaxPurchasable, entry.Tier); err != nil {
+			return fmt.Errorf("invalid whitelist entry: Address: %s. Error: %s", entry.Address, err)
+		}
+	}
+
+	links := make(map[string]bool)
+	for _, link := range data.LinkedAddresses {
+		if _, err := sdk.AccAddressFromBech32(link.Owner); err != nil {
+			return fmt.Errorf("invalid linked address: Owner: %s. Error: %s", link.Owner, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(link.Linked); err != nil {
+			return fmt.Errorf("invalid linked address: Linked: %s. Error: %s", link.Linked, err)
+		}
+		if link.Owner == link.Linked {
+			return fmt.Errorf("invalid linked address: Owner: %s. Error: Linked to itself", link.Owner)
+		}
+		key := link.Owner + "/" + link.Linked
+		if links[key] {
+			return fmt.Errorf("invalid linked address: Owner: %s, Linked: %s. Error: Duplicate link", link.Owner, link.Linked)
+		}
+		links[key] = true
+	}
+
+	ledgerEntryIDs := make(map[uint64]bool)
+	for _, entry := range data.LedgerEntries {
+		if entry.Id == 0 {
+			return fmt.Errorf("invalid ledger entry: Id: %d. Error: Missing Id", entry.Id)
+		}
+		if ledgerEntryIDs[entry.Id] {
+			return fmt.Errorf("invalid ledger entry: Id: %d. Error: Duplicate Id", entry.Id)
+		}
+		ledgerEntryIDs[entry.Id] = true
+
+		if data.StartingLedgerEntryId > 0 && entry.Id >= data.StartingLedgerEntryId {
+			return fmt.Errorf("invalid ledger entry: Id: %d. Error: Id must be less than starting ledger entry id %d", entry.Id, data.StartingLedgerEntryId)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(entry.Owner); err != nil {
+			return fmt.Errorf("invalid ledger entry: Owner: %s. Error: Missing Owner", entry.Owner)
+		}
+
+		if !ValidLedgerEntryType(entry.EntryType) {
+			return fmt.Errorf("invalid ledger entry: Id: %d. Error: Invalid type %s", entry.Id, entry.EntryType)
+		}
+
+		if !entry.Amount.IsValid() {
+			return fmt.Errorf("invalid ledger entry: Amount: %s. Error: Invalid Amount", entry.Amount)
+		}
+	}
+
 	for _, spent := range data.SpentEfund {
 		_, err := sdk.AccAddressFromBech32(spent.Owner)
 		if err != nil {
@@ -73,16 +207,20 @@ func ValidateGenesis(data GenesisState) error {
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(params Params, startingPurchaseOrderId uint64, totalLocked sdk.Coin,
-	purchaseOrders EnterpriseUndPurchaseOrders, locked LockedUnds, whitelist Whitelists, totalSpent sdk.Coin, spentEFUND SpentEFUNDs) *GenesisState {
+	purchaseOrders EnterpriseUndPurchaseOrders, locked LockedUnds, whitelist Whitelists, totalSpent sdk.Coin, spentEFUND SpentEFUNDs,
+	entSigners []EntSigner, lots LockedEFUNDLots, startingLotID uint64) *GenesisState {
 	return &GenesisState{
-		Params:                  params,
-		StartingPurchaseOrderId: startingPurchaseOrderId,
-		PurchaseOrders:          purchaseOrders,
-		LockedUnd:               locked,
-		TotalLocked:             totalLocked,
-		Whitelist:               whitelist,
-		TotalSpent:              totalSpent,
-		SpentEfund:              spentEFUND,
+		Params:                   params,
+		StartingPurchaseOrderId:  startingPurchaseOrderId,
+		PurchaseOrders:           purchaseOrders,
+		LockedUnd:                locked,
+		TotalLocked:              totalLocked,
+		Whitelist:                whitelist,
+		TotalSpent:               totalSpent,
+		SpentEfund:               spentEFUND,
+		EntSigners:               entSigners,
+		LockedEfundLots:          lots,
+		StartingLockedEfundLotId: startingLotID,
 	}
 }
 
@@ -94,6 +232,6 @@ func DefaultGenesisState() *GenesisState {
 		sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
 		nil, nil, nil,
 		sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
-		nil,
+		nil, nil, nil, 1,
 	)
 }
@@ -27,14 +27,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the enterprise module's genesis state.
 type GenesisState struct {
 	// params defines all the paramaters of the module.
-	Params                  Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	StartingPurchaseOrderId uint64                      `protobuf:"varint,2,opt,name=starting_purchase_order_id,json=startingPurchaseOrderId,proto3" json:"starting_purchase_order_id,omitempty"`
-	PurchaseOrders          EnterpriseUndPurchaseOrders `protobuf:"bytes,3,rep,name=purchase_orders,json=purchaseOrders,proto3,castrepeated=EnterpriseUndPurchaseOrders" json:"purchase_orders"`
-	LockedUnd               LockedUnds                  `protobuf:"bytes,4,rep,name=locked_und,json=lockedUnd,proto3,castrepeated=LockedUnds" json:"locked_und"`
-	TotalLocked             types.Coin                  `protobuf:"bytes,5,opt,name=total_locked,json=totalLocked,proto3" json:"total_locked"`
-	Whitelist               Whitelists                  `protobuf:"bytes,6,rep,name=whitelist,proto3,castrepeated=Whitelists" json:"whitelist,omitempty"`
-	SpentEfund              SpentEFUNDs                 `protobuf:"bytes,7,rep,name=spent_efund,json=spentEfund,proto3,castrepeated=SpentEFUNDs" json:"spent_efund"`
-	TotalSpent              types.Coin                  `protobuf:"bytes,8,opt,name=total_spent,json=totalSpent,proto3" json:"total_spent"`
+	Params                   Params                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	StartingPurchaseOrderId  uint64                      `protobuf:"varint,2,opt,name=starting_purchase_order_id,json=startingPurchaseOrderId,proto3" json:"starting_purchase_order_id,omitempty"`
+	PurchaseOrders           EnterpriseUndPurchaseOrders `protobuf:"bytes,3,rep,name=purchase_orders,json=purchaseOrders,proto3,castrepeated=EnterpriseUndPurchaseOrders" json:"purchase_orders"`
+	LockedUnd                LockedUnds                  `protobuf:"bytes,4,rep,name=locked_und,json=lockedUnd,proto3,castrepeated=LockedUnds" json:"locked_und"`
+	TotalLocked              types.Coin                  `protobuf:"bytes,5,opt,name=total_locked,json=totalLocked,proto3" json:"total_locked"`
+	Whitelist                Whitelists                  `protobuf:"bytes,6,rep,name=whitelist,proto3,castrepeated=Whitelists" json:"whitelist,omitempty"`
+	SpentEfund               SpentEFUNDs                 `protobuf:"bytes,7,rep,name=spent_efund,json=spentEfund,proto3,castrepeated=SpentEFUNDs" json:"spent_efund"`
+	TotalSpent               types.Coin                  `protobuf:"bytes,8,opt,name=total_spent,json=totalSpent,proto3" json:"total_spent"`
+	EntSigners               []EntSigner                 `protobuf:"bytes,9,rep,name=ent_signers,json=entSigners,proto3" json:"ent_signers"`
+	LockedEfundLots          []LockedEFUNDLot            `protobuf:"bytes,10,rep,name=locked_efund_lots,json=lockedEfundLots,proto3" json:"locked_efund_lots"`
+	StartingLockedEfundLotId uint64                      `protobuf:"varint,11,opt,name=starting_locked_efund_lot_id,json=startingLockedEfundLotId,proto3" json:"starting_locked_efund_lot_id,omitempty"`
+	// whitelist_entries are the whitelisted addresses with their expiry, cap and tier. Addresses in the legacy
+	// whitelist field are imported as entries with no expiry or cap
+	WhitelistEntries      []WhitelistEntry   `protobuf:"bytes,12,rep,name=whitelist_entries,json=whitelistEntries,proto3" json:"whitelist_entries"`
+	LedgerEntries         []EFUNDLedgerEntry `protobuf:"bytes,13,rep,name=ledger_entries,json=ledgerEntries,proto3" json:"ledger_entries"`
+	StartingLedgerEntryId uint64             `protobuf:"varint,14,opt,name=starting_ledger_entry_id,json=startingLedgerEntryId,proto3" json:"starting_ledger_entry_id,omitempty"`
+	// linked_addresses are the ent signer approved links between addresses which locked eFUND can be transferred along
+	LinkedAddresses []LinkedAddress `protobuf:"bytes,15,rep,name=linked_addresses,json=linkedAddresses,proto3" json:"linked_addresses"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -126,6 +136,55 @@ func (m *GenesisState) GetTotalSpent() types.Coin {
 	return types.Coin{}
 }
 
+func (m *GenesisState) GetEntSigners() []EntSigner {
+	if m != nil {
+		return m.EntSigners
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockedEfundLots() []LockedEFUNDLot {
+	if m != nil {
+		return m.LockedEfundLots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStartingLockedEfundLotId() uint64 {
+	if m != nil {
+		return m.StartingLockedEfundLotId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetWhitelistEntries() []WhitelistEntry {
+	if m != nil {
+		return m.WhitelistEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLedgerEntries() []EFUNDLedgerEntry {
+	if m != nil {
+		return m.LedgerEntries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStartingLedgerEntryId() uint64 {
+	if m != nil {
+		return m.StartingLedgerEntryId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetLinkedAddresses() []LinkedAddress {
+	if m != nil {
+		return m.LinkedAddresses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "mainchain.enterprise.v1.GenesisState")
 }
@@ -135,38 +194,48 @@ func init() {
 }
 
 var fileDescriptor_dfcf11da3dee12f2 = []byte{
-	// 481 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcd, 0x8a, 0x13, 0x41,
-	0x10, 0xce, 0x98, 0x18, 0x4d, 0xcf, 0xb2, 0x42, 0x2b, 0xec, 0x18, 0x61, 0x12, 0x76, 0x11, 0xe6,
-	0xa0, 0x3d, 0x64, 0xf7, 0xe0, 0x41, 0x04, 0x19, 0x8d, 0x22, 0x88, 0xae, 0xb3, 0x04, 0xd1, 0xcb,
-	0xd0, 0x99, 0xe9, 0x9d, 0x34, 0x26, 0xdd, 0x43, 0x57, 0x67, 0xd5, 0xa3, 0x6f, 0xe0, 0x73, 0xf8,
-	0x24, 0x7b, 0xdc, 0xa3, 0x27, 0x95, 0xe4, 0x15, 0x7c, 0x00, 0xe9, 0x9e, 0xc9, 0xdf, 0x61, 0xc0,
-	0x5b, 0xd5, 0xd7, 0xdf, 0xf7, 0x55, 0x55, 0x57, 0xa1, 0xfb, 0x33, 0xca, 0x45, 0x3a, 0xa1, 0x5c,
-	0x84, 0x4c, 0x68, 0xa6, 0x0a, 0xc5, 0x81, 0x85, 0x17, 0x83, 0x30, 0x67, 0x82, 0x01, 0x07, 0x52,
-	0x28, 0xa9, 0x25, 0x3e, 0x58, 0xd3, 0xc8, 0x86, 0x46, 0x2e, 0x06, 0x5d, 0x3f, 0x95, 0x30, 0x93,
-	0x10, 0x8e, 0xa9, 0x95, 0x8d, 0x99, 0xa6, 0x83, 0x30, 0x95, 0x5c, 0x94, 0xc2, 0xee, 0x9d, 0x5c,
-	0xe6, 0xd2, 0x86, 0xa1, 0x89, 0x2a, 0x34, 0xa8, 0xab, 0xba, 0x65, 0x6e, 0x99, 0x87, 0x7f, 0x5b,
-	0x68, 0xef, 0x65, 0xd9, 0xca, 0x99, 0xa6, 0x9a, 0xe1, 0x27, 0xa8, 0x5d, 0x50, 0x45, 0x67, 0xe0,
-	0x39, 0x7d, 0x27, 0x70, 0x8f, 0x7b, 0xa4, 0xa6, 0x35, 0x72, 0x6a, 0x69, 0x51, 0xeb, 0xf2, 0x57,
-	0xaf, 0x11, 0x57, 0x22, 0xfc, 0x18, 0x75, 0x41, 0x53, 0xa5, 0xb9, 0xc8, 0x93, 0x62, 0xae, 0xd2,
-	0x09, 0x05, 0x96, 0x48, 0x95, 0x31, 0x95, 0xf0, 0xcc, 0xbb, 0xd6, 0x77, 0x82, 0x56, 0x7c, 0xb0,
-	0x62, 0x9c, 0x56, 0x84, 0xb7, 0xe6, 0xfd, 0x55, 0x86, 0xbf, 0x39, 0xe8, 0xd6, 0xae, 0x08, 0xbc,
-	0x66, 0xbf, 0x19, 0xb8, 0xc7, 0x27, 0xb5, 0x5d, 0x0c, 0xd7, 0xd9, 0x48, 0x64, 0x3b, 0x86, 0xd1,
-	0x91, 0xe9, 0xec, 0xc7, 0xef, 0xde, 0xbd, 0x7a, 0x0e, 0xc4, 0xfb, 0xc5, 0x4e, 0x8e, 0x47, 0x08,
-	0x4d, 0x65, 0xfa, 0x89, 0x65, 0xc9, 0x5c, 0x64, 0x5e, 0xcb, 0x56, 0x3f, 0xac, 0xad, 0xfe, 0xda,
-	0x52, 0x47, 0x22, 0x8b, 0x70, 0x55, 0x0c, 0xad, 0x21, 0x88, 0x3b, 0xd3, 0x55, 0x8c, 0x23, 0xb4,
-	0xa7, 0xa5, 0xa6, 0xd3, 0xa4, 0x84, 0xbc, 0xeb, 0xf6, 0x73, 0xef, 0x92, 0x72, 0xbd, 0xc4, 0xac,
-	0x97, 0x54, 0xeb, 0x25, 0xcf, 0x24, 0x17, 0xd5, 0xb7, 0xba, 0x56, 0x54, 0x5a, 0xe2, 0x07, 0xa8,
-	0xf3, 0x79, 0xc2, 0x35, 0x9b, 0x72, 0xd0, 0x5e, 0xbb, 0xdf, 0x0c, 0x3a, 0xd1, 0xbe, 0xa9, 0xf8,
-	0x7e, 0x05, 0x42, 0xbc, 0x21, 0xe0, 0x0f, 0xc8, 0x85, 0x82, 0x09, 0x9d, 0xb0, 0x73, 0x33, 0xc9,
-	0x0d, 0x3b, 0xc9, 0x51, 0xed, 0x24, 0x67, 0x86, 0x3b, 0x7c, 0x31, 0x7a, 0xf3, 0x3c, 0xba, 0x5d,
-	0x8d, 0xe2, 0x6e, 0x30, 0x88, 0x91, 0x35, 0x1b, 0x1a, 0x2f, 0xfc, 0x14, 0x95, 0x7d, 0x25, 0x16,
-	0xf3, 0x6e, 0xfe, 0xdf, 0x2c, 0xc8, 0x6a, 0xac, 0x67, 0xf4, 0xee, 0x72, 0xe1, 0x3b, 0x57, 0x0b,
-	0xdf, 0xf9, 0xb3, 0xf0, 0x9d, 0xef, 0x4b, 0xbf, 0x71, 0xb5, 0xf4, 0x1b, 0x3f, 0x97, 0x7e, 0xe3,
-	0xe3, 0xa3, 0x9c, 0xeb, 0xc9, 0x7c, 0x4c, 0x52, 0x39, 0x0b, 0xe7, 0x82, 0x9f, 0xf3, 0x94, 0x6a,
-	0x2e, 0xc5, 0x43, 0x93, 0x6f, 0xae, 0xfa, 0xcb, 0xf6, 0x5d, 0xeb, 0xaf, 0x05, 0x83, 0x71, 0xdb,
-	0x1e, 0xf4, 0xc9, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xe9, 0x58, 0xb2, 0x72, 0x03, 0x00,
-	0x00,
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5d, 0x4f, 0xd4, 0x40,
+	0x14, 0xdd, 0x0a, 0xa2, 0x3b, 0x85, 0x05, 0x46, 0x0d, 0x15, 0x4d, 0xd9, 0x40, 0xd4, 0x35, 0xd1,
+	0x36, 0xc0, 0x03, 0x0f, 0x46, 0xa3, 0xd5, 0xd5, 0x6c, 0xb2, 0x51, 0x5c, 0xb2, 0x12, 0x78, 0x69,
+	0xba, 0xed, 0xd0, 0x9d, 0xd8, 0x9d, 0x69, 0x3a, 0xb3, 0x20, 0x8f, 0xfe, 0x03, 0x7f, 0x82, 0xcf,
+	0xfe, 0x12, 0x1e, 0x79, 0xf4, 0x49, 0x0d, 0xfc, 0x11, 0x33, 0xb7, 0x5f, 0xbb, 0x26, 0x0d, 0xbe,
+	0x75, 0x4e, 0xcf, 0x3d, 0xf7, 0x9e, 0x7b, 0x26, 0x83, 0x1e, 0x8c, 0x3c, 0xca, 0xfc, 0xa1, 0x47,
+	0x99, 0x4d, 0x98, 0x24, 0x49, 0x9c, 0x50, 0x41, 0xec, 0xe3, 0x4d, 0x3b, 0x24, 0x8c, 0x08, 0x2a,
+	0xac, 0x38, 0xe1, 0x92, 0xe3, 0x95, 0x82, 0x66, 0x95, 0x34, 0xeb, 0x78, 0x73, 0xd5, 0xf4, 0xb9,
+	0x18, 0x71, 0x61, 0x0f, 0x3c, 0x28, 0x1b, 0x10, 0xe9, 0x6d, 0xda, 0x3e, 0xa7, 0x2c, 0x2d, 0x5c,
+	0xbd, 0x1d, 0xf2, 0x90, 0xc3, 0xa7, 0xad, 0xbe, 0x32, 0xb4, 0x55, 0xd5, 0x75, 0x42, 0x1c, 0x98,
+	0xeb, 0xdf, 0xeb, 0x68, 0xfe, 0x5d, 0x3a, 0xca, 0x9e, 0xf4, 0x24, 0xc1, 0xcf, 0xd1, 0x5c, 0xec,
+	0x25, 0xde, 0x48, 0x18, 0x5a, 0x53, 0x6b, 0xe9, 0x5b, 0x6b, 0x56, 0xc5, 0x68, 0xd6, 0x2e, 0xd0,
+	0x9c, 0xd9, 0xb3, 0x5f, 0x6b, 0xb5, 0x5e, 0x56, 0x84, 0x9f, 0xa1, 0x55, 0x21, 0xbd, 0x44, 0x52,
+	0x16, 0xba, 0xf1, 0x38, 0xf1, 0x87, 0x9e, 0x20, 0x2e, 0x4f, 0x02, 0x92, 0xb8, 0x34, 0x30, 0xae,
+	0x35, 0xb5, 0xd6, 0x6c, 0x6f, 0x25, 0x67, 0xec, 0x66, 0x84, 0x0f, 0xea, 0x7f, 0x27, 0xc0, 0x5f,
+	0x35, 0xb4, 0x38, 0x5d, 0x24, 0x8c, 0x99, 0xe6, 0x4c, 0x4b, 0xdf, 0xda, 0xae, 0x9c, 0xa2, 0x5d,
+	0x9c, 0xfa, 0x2c, 0x98, 0x12, 0x74, 0x36, 0xd4, 0x64, 0x3f, 0x7e, 0xaf, 0xdd, 0xab, 0xe6, 0x88,
+	0x5e, 0x23, 0x9e, 0x3a, 0xe3, 0x3e, 0x42, 0x11, 0xf7, 0x3f, 0x93, 0xc0, 0x1d, 0xb3, 0xc0, 0x98,
+	0x85, 0xee, 0xeb, 0x95, 0xdd, 0xbb, 0x40, 0xed, 0xb3, 0xc0, 0xc1, 0x59, 0x33, 0x54, 0x40, 0xa2,
+	0x57, 0x8f, 0xf2, 0x6f, 0xec, 0xa0, 0x79, 0xc9, 0xa5, 0x17, 0xb9, 0x29, 0x64, 0x5c, 0x87, 0xe5,
+	0xde, 0xb5, 0xd2, 0x78, 0x2d, 0x15, 0xaf, 0x95, 0xc5, 0x6b, 0xbd, 0xe6, 0x94, 0x65, 0x6b, 0xd5,
+	0xa1, 0x28, 0x95, 0xc4, 0x4f, 0x50, 0xfd, 0x64, 0x48, 0x25, 0x89, 0xa8, 0x90, 0xc6, 0x5c, 0x73,
+	0xa6, 0x55, 0x77, 0x1a, 0xaa, 0xe3, 0x7e, 0x0e, 0x8a, 0x5e, 0x49, 0xc0, 0x07, 0x48, 0x17, 0x31,
+	0x61, 0xd2, 0x25, 0x47, 0xca, 0xc9, 0x0d, 0x70, 0xb2, 0x51, 0xe9, 0x64, 0x4f, 0x71, 0xdb, 0x6f,
+	0xfb, 0xef, 0xdf, 0x38, 0xb7, 0x32, 0x2b, 0x7a, 0x89, 0x89, 0x1e, 0x02, 0xb1, 0xb6, 0xd2, 0xc2,
+	0x2f, 0x51, 0x3a, 0x97, 0x0b, 0x98, 0x71, 0xf3, 0xff, 0xbc, 0x20, 0xa8, 0x01, 0x4d, 0xdc, 0x41,
+	0xba, 0x1a, 0x4d, 0xd0, 0x90, 0xa9, 0x90, 0xeb, 0x57, 0xac, 0xb9, 0xcd, 0xe4, 0x1e, 0x50, 0x73,
+	0x29, 0x92, 0x03, 0x02, 0x1f, 0xa0, 0xe5, 0x2c, 0x30, 0x30, 0xea, 0x46, 0x5c, 0x0a, 0x03, 0x81,
+	0xe0, 0xa3, 0x2b, 0x72, 0x03, 0x6b, 0x5d, 0x2e, 0x33, 0xd5, 0xc5, 0x54, 0x07, 0x3c, 0x76, 0xb9,
+	0x14, 0xf8, 0x05, 0xba, 0x5f, 0x5c, 0xe6, 0x7f, 0x7b, 0xa8, 0xeb, 0xac, 0xc3, 0x75, 0x36, 0x72,
+	0x4e, 0x77, 0xaa, 0xbc, 0x13, 0xe0, 0x43, 0xb4, 0x5c, 0xe4, 0xe1, 0x12, 0x26, 0x13, 0x4a, 0x84,
+	0x31, 0x7f, 0xc5, 0x68, 0x45, 0x9a, 0x6d, 0x26, 0x93, 0xd3, 0x6c, 0xb4, 0xa5, 0x93, 0x49, 0x94,
+	0x12, 0x81, 0x3f, 0xa1, 0x46, 0x44, 0x82, 0x90, 0x24, 0x85, 0xf0, 0x02, 0x08, 0x3f, 0xae, 0x5e,
+	0x22, 0xb8, 0x85, 0x9a, 0x49, 0xe9, 0x85, 0xa8, 0x80, 0x94, 0xee, 0x0e, 0x32, 0x4a, 0xcf, 0x65,
+	0x83, 0x53, 0xe5, 0xb7, 0x01, 0x7e, 0xef, 0x14, 0x7e, 0x4b, 0xad, 0x4e, 0x80, 0xf7, 0xd1, 0x52,
+	0x44, 0x99, 0xda, 0x91, 0x17, 0x04, 0x09, 0x11, 0x82, 0x08, 0x63, 0x11, 0x46, 0x7a, 0x58, 0x1d,
+	0x03, 0x14, 0xbc, 0x4a, 0xf9, 0x45, 0x0a, 0x93, 0x20, 0x11, 0xce, 0xc7, 0xb3, 0x0b, 0x53, 0x3b,
+	0xbf, 0x30, 0xb5, 0x3f, 0x17, 0xa6, 0xf6, 0xed, 0xd2, 0xac, 0x9d, 0x5f, 0x9a, 0xb5, 0x9f, 0x97,
+	0x66, 0xed, 0x70, 0x27, 0xa4, 0x72, 0x38, 0x1e, 0x58, 0x3e, 0x1f, 0xd9, 0x63, 0x46, 0x8f, 0xa8,
+	0xef, 0x49, 0xca, 0xd9, 0x53, 0x75, 0x2e, 0x5f, 0xc0, 0x2f, 0x93, 0x6f, 0xa0, 0x3c, 0x8d, 0x89,
+	0x18, 0xcc, 0xc1, 0xe3, 0xb7, 0xfd, 0x77, 0x00, 0xc1, 0x91, 0x4f, 0xae, 0x9e, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -189,6 +258,86 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LinkedAddresses) > 0 {
+		for iNdEx := len(m.LinkedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LinkedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.StartingLedgerEntryId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartingLedgerEntryId))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.LedgerEntries) > 0 {
+		for iNdEx := len(m.LedgerEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LedgerEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.WhitelistEntries) > 0 {
+		for iNdEx := len(m.WhitelistEntries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WhitelistEntries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.StartingLockedEfundLotId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartingLockedEfundLotId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.LockedEfundLots) > 0 {
+		for iNdEx := len(m.LockedEfundLots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LockedEfundLots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.EntSigners) > 0 {
+		for iNdEx := len(m.EntSigners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntSigners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size, err := m.TotalSpent.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -328,6 +477,42 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.TotalSpent.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.EntSigners) > 0 {
+		for _, e := range m.EntSigners {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockedEfundLots) > 0 {
+		for _, e := range m.LockedEfundLots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StartingLockedEfundLotId != 0 {
+		n += 1 + sovGenesis(uint64(m.StartingLockedEfundLotId))
+	}
+	if len(m.WhitelistEntries) > 0 {
+		for _, e := range m.WhitelistEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LedgerEntries) > 0 {
+		for _, e := range m.LedgerEntries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StartingLedgerEntryId != 0 {
+		n += 1 + sovGenesis(uint64(m.StartingLedgerEntryId))
+	}
+	if len(m.LinkedAddresses) > 0 {
+		for _, e := range m.LinkedAddresses {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -618,6 +803,214 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntSigners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntSigners = append(m.EntSigners, EntSigner{})
+			if err := m.EntSigners[len(m.EntSigners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedEfundLots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedEfundLots = append(m.LockedEfundLots, LockedEFUNDLot{})
+			if err := m.LockedEfundLots[len(m.LockedEfundLots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingLockedEfundLotId", wireType)
+			}
+			m.StartingLockedEfundLotId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingLockedEfundLotId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistEntries = append(m.WhitelistEntries, WhitelistEntry{})
+			if err := m.WhitelistEntries[len(m.WhitelistEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LedgerEntries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LedgerEntries = append(m.LedgerEntries, EFUNDLedgerEntry{})
+			if err := m.LedgerEntries[len(m.LedgerEntries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingLedgerEntryId", wireType)
+			}
+			m.StartingLedgerEntryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingLedgerEntryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkedAddresses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkedAddresses = append(m.LinkedAddresses, LinkedAddress{})
+			if err := m.LinkedAddresses[len(m.LinkedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
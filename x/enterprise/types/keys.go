@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -28,6 +29,12 @@ var (
 	// key used to store the current highest purchase order ID
 	HighestPurchaseOrderIDKey = []byte{0x20}
 
+	// key used to store the next locked eFUND lot ID
+	HighestLockedEFUNDLotIDKey = []byte{0x21}
+
+	// key used to store the next eFUND ledger entry ID
+	HighestLedgerEntryIDKey = []byte{0x22}
+
 	// prefix used to store/retrieve an purchase order waiting to be processed from the store
 	PurchaseOrderIDKeyPrefix = []byte{0x01}
 
@@ -48,6 +55,30 @@ var (
 
 	ParamsKey = []byte{0x07}
 
+	// EntSignerKeyPrefix is the prefix for the registry of addresses authorised to decide on purchase orders
+	EntSignerKeyPrefix = []byte{0x08}
+
+	// LockedEFUNDLotKeyPrefix is the prefix used to store locked eFUND lots by ID
+	LockedEFUNDLotKeyPrefix = []byte{0x09}
+
+	// LockedEFUNDLotOwnerKeyPrefix is the prefix used to index locked eFUND lots by owner, oldest first
+	LockedEFUNDLotOwnerKeyPrefix = []byte{0x0A}
+
+	// LockedEFUNDLotExpiryQueuePrefix is the prefix used to queue expiring locked eFUND lots for the ABCI blocker
+	LockedEFUNDLotExpiryQueuePrefix = []byte{0x0B}
+
+	// PurchaseOrderPurchaserIndexPrefix is the prefix used to index purchase orders by purchaser
+	PurchaseOrderPurchaserIndexPrefix = []byte{0x0C}
+
+	// PurchaseOrderStatusIndexPrefix is the prefix used to index purchase orders by status
+	PurchaseOrderStatusIndexPrefix = []byte{0x0D}
+
+	// LedgerEntryKeyPrefix is the prefix used to store eFUND ledger entries by owner, oldest first
+	LedgerEntryKeyPrefix = []byte{0x0E}
+
+	// LinkedAddressKeyPrefix is the prefix used to store the addresses an owner can transfer locked eFUND to
+	LinkedAddressKeyPrefix = []byte{0x0F}
+
 	TotalSpentEFUNDKey = []byte{0x98}
 	TotalLockedUndKey  = []byte{0x99}
 )
@@ -86,6 +117,103 @@ func WhitelistAddressStoreKey(acc sdk.AccAddress) []byte {
 	return append(WhitelistKeyPrefix, acc.Bytes()...)
 }
 
+// EntSignerStoreKey turn an address to key used for the ent signer registry
+func EntSignerStoreKey(acc sdk.AccAddress) []byte {
+	return append(EntSignerKeyPrefix, acc.Bytes()...)
+}
+
+// LockedEFUNDLotKey gets a specific locked eFUND lot key for use in the store
+func LockedEFUNDLotKey(lotID uint64) []byte {
+	return append(LockedEFUNDLotKeyPrefix, GetPurchaseOrderIDBytes(lotID)...)
+}
+
+// LockedEFUNDLotsByOwnerPrefix gets the prefix used to iterate over an owner's locked eFUND lots
+func LockedEFUNDLotsByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(LockedEFUNDLotOwnerKeyPrefix, address.MustLengthPrefix(owner.Bytes())...)
+}
+
+// LockedEFUNDLotOwnerKey gets the owner index key for a locked eFUND lot
+func LockedEFUNDLotOwnerKey(owner sdk.AccAddress, lotID uint64) []byte {
+	return append(LockedEFUNDLotsByOwnerPrefix(owner), GetPurchaseOrderIDBytes(lotID)...)
+}
+
+// SplitLockedEFUNDLotOwnerKey is used to get the lot ID from an owner index key
+func SplitLockedEFUNDLotOwnerKey(key []byte) (lotID uint64) {
+	return GetPurchaseOrderIDFromBytes(key[len(key)-8:])
+}
+
+// LockedEFUNDLotExpiryQueueByTimePrefix gets the prefix for lots expiring at the given unix time
+func LockedEFUNDLotExpiryQueueByTimePrefix(expiryTime uint64) []byte {
+	return append(LockedEFUNDLotExpiryQueuePrefix, GetPurchaseOrderIDBytes(expiryTime)...)
+}
+
+// LockedEFUNDLotExpiryQueueKey us used to queue a lot ID by its expiry time for the ABCI blocker
+func LockedEFUNDLotExpiryQueueKey(expiryTime, lotID uint64) []byte {
+	return append(LockedEFUNDLotExpiryQueueByTimePrefix(expiryTime), GetPurchaseOrderIDBytes(lotID)...)
+}
+
+// SplitLockedEFUNDLotExpiryQueueKey is used to get the lot ID from the expiry queue key
+func SplitLockedEFUNDLotExpiryQueueKey(key []byte) (lotID uint64) {
+	if len(key[1:]) != 16 {
+		panic(fmt.Sprintf("unexpected key length (%d != 16)", len(key[1:])))
+	}
+	return GetPurchaseOrderIDFromBytes(key[9:])
+}
+
+// PurchaseOrdersByPurchaserPrefix gets the prefix used to iterate over a purchaser's purchase orders
+func PurchaseOrdersByPurchaserPrefix(purchaser sdk.AccAddress) []byte {
+	return append(PurchaseOrderPurchaserIndexPrefix, address.MustLengthPrefix(purchaser.Bytes())...)
+}
+
+// PurchaseOrderPurchaserIndexKey gets the purchaser index key for a purchase order
+func PurchaseOrderPurchaserIndexKey(purchaser sdk.AccAddress, purchaseOrderID uint64) []byte {
+	return append(PurchaseOrdersByPurchaserPrefix(purchaser), GetPurchaseOrderIDBytes(purchaseOrderID)...)
+}
+
+// PurchaseOrdersByStatusPrefix gets the prefix used to iterate over purchase orders with the given status
+func PurchaseOrdersByStatusPrefix(status PurchaseOrderStatus) []byte {
+	return append(PurchaseOrderStatusIndexPrefix, byte(status))
+}
+
+// PurchaseOrderStatusIndexKey gets the status index key for a purchase order
+func PurchaseOrderStatusIndexKey(status PurchaseOrderStatus, purchaseOrderID uint64) []byte {
+	return append(PurchaseOrdersByStatusPrefix(status), GetPurchaseOrderIDBytes(purchaseOrderID)...)
+}
+
+// LedgerEntriesByOwnerPrefix gets the prefix used to iterate over an owner's eFUND ledger entries
+func LedgerEntriesByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(LedgerEntryKeyPrefix, address.MustLengthPrefix(owner.Bytes())...)
+}
+
+// LedgerEntriesByOwnerTimePrefix gets the prefix for an owner's eFUND ledger entries recorded at the given unix time
+func LedgerEntriesByOwnerTimePrefix(owner sdk.AccAddress, timestamp uint64) []byte {
+	return append(LedgerEntriesByOwnerPrefix(owner), GetPurchaseOrderIDBytes(timestamp)...)
+}
+
+// LedgerEntryKey gets the key for an eFUND ledger entry
+func LedgerEntryKey(owner sdk.AccAddress, timestamp, entryID uint64) []byte {
+	return append(LedgerEntriesByOwnerTimePrefix(owner, timestamp), GetPurchaseOrderIDBytes(entryID)...)
+}
+
+// GetLedgerTimestampFromKey is used to get the unix timestamp from an eFUND ledger entry key. The key may have
+// had its owner prefix removed, since the timestamp is read from the end of the key
+func GetLedgerTimestampFromKey(key []byte) (timestamp uint64) {
+	if len(key) < 16 {
+		panic(fmt.Sprintf("unexpected key length (%d < 16)", len(key)))
+	}
+	return binary.BigEndian.Uint64(key[len(key)-16 : len(key)-8])
+}
+
+// LinkedAddressesByOwnerPrefix gets the prefix used to iterate over the addresses linked to an owner
+func LinkedAddressesByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return append(LinkedAddressKeyPrefix, address.MustLengthPrefix(owner.Bytes())...)
+}
+
+// LinkedAddressStoreKey gets the key for a link from an owner to an address it can transfer locked eFUND to
+func LinkedAddressStoreKey(owner, linked sdk.AccAddress) []byte {
+	return append(LinkedAddressesByOwnerPrefix(owner), linked.Bytes()...)
+}
+
 // RaisedQueueStoreKey us used to temporarily store raised PO order IDs for the ABCI blocker
 func RaisedQueueStoreKey(purchaseOrderID uint64) []byte {
 	return append(RaisedPoPrefix, GetPurchaseOrderIDBytes(purchaseOrderID)...)
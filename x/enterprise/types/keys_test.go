@@ -1,8 +1,10 @@
 package types_test
 
 import (
+	"math"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/unification-com/mainchain/x/enterprise/types"
@@ -23,3 +25,17 @@ func TestAcceptedQueueStoreKey(t *testing.T) {
 		require.Equal(t, i, poId)
 	}
 }
+
+func TestGetLedgerTimestampFromKey(t *testing.T) {
+	owner := sdk.AccAddress("addr1")
+	for _, timestamp := range []uint64{0, 1, 1700000000, math.MaxUint64} {
+		key := types.LedgerEntryKey(owner, timestamp, 12345)
+		require.Equal(t, timestamp, types.GetLedgerTimestampFromKey(key))
+
+		// with the owner prefix removed, as when iterating an owner's ledger
+		ownerPrefix := types.LedgerEntriesByOwnerPrefix(owner)
+		require.Equal(t, timestamp, types.GetLedgerTimestampFromKey(key[len(ownerPrefix):]))
+	}
+
+	require.Panics(t, func() { types.GetLedgerTimestampFromKey([]byte{0x01}) })
+}
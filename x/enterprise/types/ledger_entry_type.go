@@ -0,0 +1,14 @@
+package types
+
+// ValidLedgerEntryType returns true if the ledger entry type is valid and false
+// otherwise.
+func ValidLedgerEntryType(entryType LedgerEntryType) bool {
+	if entryType == LedgerEntryPurchaseOrder ||
+		entryType == LedgerEntryFee ||
+		entryType == LedgerEntryExpired ||
+		entryType == LedgerEntryTransferOut ||
+		entryType == LedgerEntryTransferIn {
+		return true
+	}
+	return false
+}
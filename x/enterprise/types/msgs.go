@@ -10,6 +10,10 @@ const (
 	PurchaseAction         = "raise_ent_po"
 	ProcessAction          = "proc_ent_po"
 	WhitelistAddressAction = "ent_whitelist"
+	WithdrawAction         = "withdraw_ent_po"
+	AmendAction            = "amend_ent_po"
+	TransferLockedAction   = "transfer_locked_efund"
+	LinkAddressAction      = "ent_link_address"
 )
 
 // __Enterprise_UND_Purchase_Order_Msg__________________________________
@@ -18,6 +22,12 @@ var (
 	_ sdk.Msg = &MsgUndPurchaseOrder{}
 	_ sdk.Msg = &MsgProcessUndPurchaseOrder{}
 	_ sdk.Msg = &MsgWhitelistAddress{}
+	_ sdk.Msg = &MsgWithdrawUndPurchaseOrder{}
+	_ sdk.Msg = &MsgAmendUndPurchaseOrder{}
+	_ sdk.Msg = &MsgTransferLockedEFUND{}
+	_ sdk.Msg = &MsgLinkAddress{}
+	_ sdk.Msg = &MsgAddEntSigner{}
+	_ sdk.Msg = &MsgRemoveEntSigner{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
 
@@ -105,6 +115,22 @@ func NewMsgWhitelistAddress(address sdk.AccAddress, action WhitelistAction, sign
 	}
 }
 
+// NewMsgWhitelistAddressWithAttributes is a constructor function for a MsgWhitelistAddress adding an address
+// with an expiry time, eFUND purchase cap and tier
+func NewMsgWhitelistAddressWithAttributes(address sdk.AccAddress, signer sdk.AccAddress, expiryTime uint64, maxPurchasable sdk.Coin, tier string) *MsgWhitelistAddress {
+	msg := &MsgWhitelistAddress{
+		Address:    address.String(),
+		Signer:     signer.String(),
+		Action:     WhitelistActionAdd,
+		ExpiryTime: expiryTime,
+		Tier:       tier,
+	}
+	if !maxPurchasable.Amount.IsNil() {
+		msg.MaxPurchasable = &maxPurchasable
+	}
+	return msg
+}
+
 // Route should return the name of the module
 func (msg MsgWhitelistAddress) Route() string { return RouterKey }
 
@@ -122,6 +148,166 @@ func (msg MsgWhitelistAddress) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid address (%s)", err)
 	}
+	if !ValidWhitelistAction(msg.Action) {
+		return errorsmod.Wrap(ErrInvalidWhitelistAction, "action must be add or remove")
+	}
+	if msg.MaxPurchasable != nil {
+		return ValidateWhitelistEntryAttributes(*msg.MaxPurchasable, msg.Tier)
+	}
+	return ValidateWhitelistEntryAttributes(sdk.Coin{}, msg.Tier)
+}
+
+// __Enterprise_UND_Withdraw_Purchase_Order_Msg_________________________
+
+// MsgWithdrawUndPurchaseOrder defines a WithdrawUndPurchaseOrder message - used by a purchaser to withdraw a raised PO
+
+// NewMsgWithdrawUndPurchaseOrder is a constructor function for MsgWithdrawUndPurchaseOrder
+func NewMsgWithdrawUndPurchaseOrder(purchaser sdk.AccAddress, purchaseOrderID uint64) *MsgWithdrawUndPurchaseOrder {
+	return &MsgWithdrawUndPurchaseOrder{
+		Purchaser:       purchaser.String(),
+		PurchaseOrderId: purchaseOrderID,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgWithdrawUndPurchaseOrder) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgWithdrawUndPurchaseOrder) Type() string { return WithdrawAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgWithdrawUndPurchaseOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Purchaser)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid purchaser address (%s)", err)
+	}
+
+	if msg.PurchaseOrderId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "purchase order id must be greater than zero")
+	}
+	return nil
+}
+
+// __Enterprise_UND_Amend_Purchase_Order_Msg____________________________
+
+// MsgAmendUndPurchaseOrder defines an AmendUndPurchaseOrder message - used by a purchaser to amend a raised PO's amount
+
+// NewMsgAmendUndPurchaseOrder is a constructor function for MsgAmendUndPurchaseOrder
+func NewMsgAmendUndPurchaseOrder(purchaser sdk.AccAddress, purchaseOrderID uint64, amount sdk.Coin) *MsgAmendUndPurchaseOrder {
+	return &MsgAmendUndPurchaseOrder{
+		Purchaser:       purchaser.String(),
+		PurchaseOrderId: purchaseOrderID,
+		Amount:          amount,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgAmendUndPurchaseOrder) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgAmendUndPurchaseOrder) Type() string { return AmendAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgAmendUndPurchaseOrder) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Purchaser)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid purchaser address (%s)", err)
+	}
+
+	if msg.PurchaseOrderId == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrUnknownRequest, "purchase order id must be greater than zero")
+	}
+
+	if !msg.Amount.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if msg.Amount.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount must be greater than zero")
+	}
+	return nil
+}
+
+// __Enterprise_Transfer_Locked_eFUND_Msg_______________________________
+
+// NewMsgTransferLockedEFUND is a constructor function for MsgTransferLockedEFUND
+func NewMsgTransferLockedEFUND(from, to sdk.AccAddress, amount sdk.Coin) *MsgTransferLockedEFUND {
+	return &MsgTransferLockedEFUND{
+		From:   from.String(),
+		To:     to.String(),
+		Amount: amount,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgTransferLockedEFUND) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgTransferLockedEFUND) Type() string { return TransferLockedAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTransferLockedEFUND) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid from address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.To)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid to address (%s)", err)
+	}
+
+	if msg.From == msg.To {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot transfer locked eFUND to the same address")
+	}
+
+	if !msg.Amount.IsValid() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if msg.Amount.IsZero() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "amount must be greater than zero")
+	}
+	return nil
+}
+
+// __Enterprise_Link_Address_Msg_______________________________________
+
+// NewMsgLinkAddress is a constructor function for MsgLinkAddress
+func NewMsgLinkAddress(owner, linked sdk.AccAddress, action WhitelistAction, signer sdk.AccAddress) *MsgLinkAddress {
+	return &MsgLinkAddress{
+		Owner:  owner.String(),
+		Linked: linked.String(),
+		Signer: signer.String(),
+		Action: action,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgLinkAddress) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgLinkAddress) Type() string { return LinkAddressAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgLinkAddress) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid signer address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+
+	_, err = sdk.AccAddressFromBech32(msg.Linked)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid linked address (%s)", err)
+	}
+
+	if msg.Owner == msg.Linked {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, "cannot link an address to itself")
+	}
+
 	if !ValidWhitelistAction(msg.Action) {
 		return errorsmod.Wrap(ErrInvalidWhitelistAction, "action must be add or remove")
 	}
@@ -131,6 +317,46 @@ func (msg MsgWhitelistAddress) ValidateBasic() error {
 // --- Modify Params Msg Type ---
 
 // ValidateBasic does a sanity check on the provided data.
+// NewMsgAddEntSigner is a constructor function for MsgAddEntSigner
+func NewMsgAddEntSigner(authority string, address sdk.AccAddress, name string) *MsgAddEntSigner {
+	return &MsgAddEntSigner{Authority: authority, Address: address.String(), Name: name}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgAddEntSigner) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if len(m.Name) > MaxEntSignerNameLength {
+		return errorsmod.Wrapf(ErrInvalidData, "name cannot be longer than %d characters", MaxEntSignerNameLength)
+	}
+
+	return nil
+}
+
+// NewMsgRemoveEntSigner is a constructor function for MsgRemoveEntSigner
+func NewMsgRemoveEntSigner(authority string, address sdk.AccAddress) *MsgRemoveEntSigner {
+	return &MsgRemoveEntSigner{Authority: authority, Address: address.String()}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m *MsgRemoveEntSigner) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	return nil
+}
+
 func (m *MsgUpdateParams) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
 		return errorsmod.Wrap(err, "invalid authority address")
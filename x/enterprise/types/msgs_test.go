@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -42,6 +43,26 @@ func TestMsgWhitelistAddress_Type(t *testing.T) {
 	require.Equal(t, types.WhitelistAddressAction, msg.Type())
 }
 
+func TestMsgWithdrawUndPurchaseOrder_Route(t *testing.T) {
+	msg := types.MsgWithdrawUndPurchaseOrder{}
+	require.Equal(t, types.ModuleName, msg.Route())
+}
+
+func TestMsgWithdrawUndPurchaseOrder_Type(t *testing.T) {
+	msg := types.MsgWithdrawUndPurchaseOrder{}
+	require.Equal(t, types.WithdrawAction, msg.Type())
+}
+
+func TestMsgAmendUndPurchaseOrder_Route(t *testing.T) {
+	msg := types.MsgAmendUndPurchaseOrder{}
+	require.Equal(t, types.ModuleName, msg.Route())
+}
+
+func TestMsgAmendUndPurchaseOrder_Type(t *testing.T) {
+	msg := types.MsgAmendUndPurchaseOrder{}
+	require.Equal(t, types.AmendAction, msg.Type())
+}
+
 func TestMsgUndPurchaseOrder_Validate(t *testing.T) {
 	tests := []struct {
 		amount     sdk.Coin
@@ -180,6 +201,59 @@ func TestMsgWhitelistAddress_Validate(t *testing.T) {
 	}
 }
 
+func TestMsgWithdrawUndPurchaseOrder_Validate(t *testing.T) {
+	tests := []struct {
+		id         uint64
+		purchaser  string
+		expectPass bool
+	}{
+		{1, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), true},
+		{0, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), false},
+		{1, "rubbish", false},
+	}
+
+	for i, tc := range tests {
+		msg := types.MsgWithdrawUndPurchaseOrder{
+			Purchaser:       tc.purchaser,
+			PurchaseOrderId: tc.id,
+		}
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgAmendUndPurchaseOrder_Validate(t *testing.T) {
+	tests := []struct {
+		id         uint64
+		amount     sdk.Coin
+		purchaser  string
+		expectPass bool
+	}{
+		{1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), true},
+		{0, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), false},
+		{1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), false},
+		{1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), "rubbish", false},
+	}
+
+	for i, tc := range tests {
+		msg := types.MsgAmendUndPurchaseOrder{
+			Purchaser:       tc.purchaser,
+			PurchaseOrderId: tc.id,
+			Amount:          tc.amount,
+		}
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 func TestMsgUndPurchaseOrderGetSignBytes(t *testing.T) {
 	addr := sdk.AccAddress("addr1")
 	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
@@ -211,3 +285,201 @@ func TestMsgWhitelistAddressGetSignBytes(t *testing.T) {
 	expected := `{"type":"enterprise/MsgWhitelistAddress","value":{"action":1,"address":"cosmos1v9jxgu3jc697dt","signer":"cosmos1v9jxgu33kfsgr5"}}`
 	require.Equal(t, expected, string(res))
 }
+
+func TestMsgWhitelistAddressWithAttributesGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress("addr1")
+	wl := sdk.AccAddress("addr2")
+	msg := types.NewMsgWhitelistAddressWithAttributes(wl, addr, 1767225600, sdk.NewInt64Coin("nund", 1000), "gold")
+	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"enterprise/MsgWhitelistAddress","value":{"action":1,"address":"cosmos1v9jxgu3jc697dt","expiry_time":"1767225600","max_purchasable":{"amount":"1000","denom":"nund"},"signer":"cosmos1v9jxgu33kfsgr5","tier":"gold"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgWithdrawUndPurchaseOrderGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress("addr1")
+	msg := types.NewMsgWithdrawUndPurchaseOrder(addr, 1)
+	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"enterprise/MsgWithdrawUndPurchaseOrder","value":{"purchase_order_id":"1","purchaser":"cosmos1v9jxgu33kfsgr5"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgAmendUndPurchaseOrderGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress("addr1")
+	amount := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	msg := types.NewMsgAmendUndPurchaseOrder(addr, 1, amount)
+	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"enterprise/MsgAmendUndPurchaseOrder","value":{"amount":{"amount":"1000","denom":"stake"},"purchase_order_id":"1","purchaser":"cosmos1v9jxgu33kfsgr5"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgTransferLockedEFUND_Route(t *testing.T) {
+	msg := types.MsgTransferLockedEFUND{}
+	require.Equal(t, types.ModuleName, msg.Route())
+}
+
+func TestMsgTransferLockedEFUND_Type(t *testing.T) {
+	msg := types.MsgTransferLockedEFUND{}
+	require.Equal(t, types.TransferLockedAction, msg.Type())
+}
+
+func TestMsgTransferLockedEFUND_Validate(t *testing.T) {
+	from := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	to := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	tests := []struct {
+		from       string
+		to         string
+		amount     sdk.Coin
+		expectPass bool
+	}{
+		{from, to, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), true},
+		{from, to, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), false},
+		{from, from, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{"rubbish", to, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+		{from, "rubbish", sdk.NewInt64Coin(sdk.DefaultBondDenom, 1), false},
+	}
+
+	for i, tc := range tests {
+		msg := types.MsgTransferLockedEFUND{
+			From:   tc.from,
+			To:     tc.to,
+			Amount: tc.amount,
+		}
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgTransferLockedEFUNDGetSignBytes(t *testing.T) {
+	from := sdk.AccAddress("addr1")
+	to := sdk.AccAddress("addr2")
+	msg := types.NewMsgTransferLockedEFUND(from, to, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"enterprise/MsgTransferLockedEFUND","value":{"amount":{"amount":"1000","denom":"stake"},"from":"cosmos1v9jxgu33kfsgr5","to":"cosmos1v9jxgu3jc697dt"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgLinkAddress_Route(t *testing.T) {
+	msg := types.MsgLinkAddress{}
+	require.Equal(t, types.ModuleName, msg.Route())
+}
+
+func TestMsgLinkAddress_Type(t *testing.T) {
+	msg := types.MsgLinkAddress{}
+	require.Equal(t, types.LinkAddressAction, msg.Type())
+}
+
+func TestMsgLinkAddress_Validate(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	linked := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	signer := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	tests := []struct {
+		owner      string
+		linked     string
+		signer     string
+		action     types.WhitelistAction
+		expectPass bool
+	}{
+		{owner, linked, signer, types.WhitelistActionAdd, true},
+		{owner, linked, signer, types.WhitelistActionRemove, true},
+		{owner, linked, signer, types.WhitelistActionNil, false},
+		{owner, owner, signer, types.WhitelistActionAdd, false},
+		{"rubbish", linked, signer, types.WhitelistActionAdd, false},
+		{owner, "rubbish", signer, types.WhitelistActionAdd, false},
+		{owner, linked, "rubbish", types.WhitelistActionAdd, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.MsgLinkAddress{
+			Owner:  tc.owner,
+			Linked: tc.linked,
+			Signer: tc.signer,
+			Action: tc.action,
+		}
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgLinkAddressGetSignBytes(t *testing.T) {
+	owner := sdk.AccAddress("addr1")
+	linked := sdk.AccAddress("addr2")
+	signer := sdk.AccAddress("addr3")
+	msg := types.NewMsgLinkAddress(owner, linked, types.WhitelistActionAdd, signer)
+	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"enterprise/MsgLinkAddress","value":{"action":1,"linked":"cosmos1v9jxgu3jc697dt","owner":"cosmos1v9jxgu33kfsgr5","signer":"cosmos1v9jxgu3n9v3tse"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgAddEntSigner_Validate(t *testing.T) {
+	authority := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	tests := []struct {
+		authority  string
+		address    string
+		name       string
+		expectPass bool
+	}{
+		{authority, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), "signer", true},
+		{authority, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), "", true},
+		{"rubbish", sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), "signer", false},
+		{authority, "rubbish", "signer", false},
+		{authority, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), strings.Repeat("a", types.MaxEntSignerNameLength+1), false},
+	}
+
+	for i, tc := range tests {
+		msg := types.MsgAddEntSigner{
+			Authority: tc.authority,
+			Address:   tc.address,
+			Name:      tc.name,
+		}
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgRemoveEntSigner_Validate(t *testing.T) {
+	authority := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	tests := []struct {
+		authority  string
+		address    string
+		expectPass bool
+	}{
+		{authority, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), true},
+		{"rubbish", sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(), false},
+		{authority, "rubbish", false},
+	}
+
+	for i, tc := range tests {
+		msg := types.MsgRemoveEntSigner{
+			Authority: tc.authority,
+			Address:   tc.address,
+		}
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
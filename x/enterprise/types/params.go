@@ -8,22 +8,45 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-func NewParams(denom string, minAccepts uint64, decisionLimit uint64, entSigners string) Params {
+// DefaultEFUNDFeeMsgTypes are the Msg type URLs whose Tx fees can be paid using locked eFUND by default
+var DefaultEFUNDFeeMsgTypes = []string{
+	"/mainchain.wrkchain.v1.MsgRegisterWrkChain",
+	"/mainchain.wrkchain.v1.MsgRecordWrkChainBlock",
+	"/mainchain.wrkchain.v1.MsgPurchaseWrkChainStateStorage",
+	"/mainchain.beacon.v1.MsgRegisterBeacon",
+	"/mainchain.beacon.v1.MsgRecordBeaconTimestamp",
+	"/mainchain.beacon.v1.MsgPurchaseBeaconStateStorage",
+	"/mainchain.stream.v1.MsgCreateStream",
+	"/mainchain.stream.v1.MsgTopUpDeposit",
+}
+
+// DefaultEFUNDMaxFee is the default maximum amount of locked eFUND that can be unlocked to pay the fees of a Tx
+// containing Msgs other than WRKChain and BEACON Msgs. 1 FUND
+const DefaultEFUNDMaxFee uint64 = 1000000000
+
+func NewParams(denom string, minAccepts uint64, decisionLimit uint64, entSigners string, efundFeeMsgTypes []string) Params {
 	return Params{
 		EntSigners:        entSigners,
 		Denom:             denom,
 		MinAccepts:        minAccepts,
 		DecisionTimeLimit: decisionLimit,
+		EfundFeeMsgTypes:  efundFeeMsgTypes,
+		EfundMaxFee:       DefaultEFUNDMaxFee,
 	}
 }
 
-// default enterprise FUND module parameters
+// default enterprise FUND module parameters. The legacy EntSigners value is imported into
+// the signer registry during InitGenesis
 func DefaultParams() Params {
 	return Params{
-		EntSigners:        "und1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq5x8kpm", // default to 0000000000000000000000000000000000000000
-		Denom:             sdk.DefaultBondDenom,
-		MinAccepts:        1,
-		DecisionTimeLimit: 84600,
+		EntSigners:            "und1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq5x8kpm", // default to 0000000000000000000000000000000000000000
+		Denom:                 sdk.DefaultBondDenom,
+		MinAccepts:            1,
+		DecisionTimeLimit:     84600,
+		EfundExpiryPeriod:     0, // locked eFUND does not expire
+		EfundFeeMsgTypes:      DefaultEFUNDFeeMsgTypes,
+		LedgerRetentionPeriod: 0, // eFUND ledger entries are kept indefinitely
+		EfundMaxFee:           DefaultEFUNDMaxFee,
 	}
 }
 
@@ -45,15 +68,46 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	entSigners := strings.Split(p.EntSigners, ",")
+	if err := validateEFUNDFeeMsgTypes(p.EfundFeeMsgTypes); err != nil {
+		return err
+	}
 
-	if len(entSigners) < int(p.MinAccepts) {
-		return fmt.Errorf("number of authorised accounts must be >= number of minimum accepts")
+	if err := validateGroupPolicyAddress(p.GroupPolicyAddress); err != nil {
+		return err
 	}
 
 	return nil
 }
 
+// IsGroupPolicyMode returns true if purchase orders are decided by an x/group policy rather than
+// tallying ent signer decisions
+func (p Params) IsGroupPolicyMode() bool {
+	return p.GroupPolicyAddress != ""
+}
+
+// IsEFUNDFeeMsgType returns true if Tx fees for the given Msg type URL can be paid using locked eFUND
+func (p Params) IsEFUNDFeeMsgType(msgTypeURL string) bool {
+	for _, t := range p.EfundFeeMsgTypes {
+		if t == msgTypeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// LegacyEntSigners returns the addresses held in the legacy comma separated EntSigners value.
+// Signers are otherwise held in the signer registry, and checked against MinAccepts there.
+func (p Params) LegacyEntSigners() []string {
+	var entSigners []string
+	if strings.TrimSpace(p.EntSigners) == "" {
+		return entSigners
+	}
+	for _, authAddr := range strings.Split(p.EntSigners, ",") {
+		entSigners = append(entSigners, authAddr)
+	}
+	return entSigners
+}
+
 func validateDenom(i interface{}) error {
 	v, ok := i.(string)
 	if !ok {
@@ -102,16 +156,13 @@ func validateEntSigners(i interface{}) error {
 		return fmt.Errorf("invalid parameter type: %T", i)
 	}
 
-	if len(v) == 0 {
-		return fmt.Errorf("must have at least one signer")
+	// signers are held in the signer registry, so the legacy value may be empty
+	if strings.TrimSpace(v) == "" {
+		return nil
 	}
 
 	entSigners := strings.Split(v, ",")
 
-	if len(entSigners) == 0 {
-		return fmt.Errorf("must have at least one signer")
-	}
-
 	for _, authAddr := range entSigners {
 		_, err := sdk.AccAddressFromBech32(authAddr)
 		if err != nil {
@@ -121,3 +172,41 @@ func validateEntSigners(i interface{}) error {
 
 	return nil
 }
+
+func validateEFUNDFeeMsgTypes(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, msgType := range v {
+		if !strings.HasPrefix(msgType, "/") || strings.TrimSpace(msgType) != msgType || len(msgType) == 1 {
+			return fmt.Errorf("invalid eFUND fee msg type url: %q", msgType)
+		}
+		if seen[msgType] {
+			return fmt.Errorf("duplicate eFUND fee msg type url: %s", msgType)
+		}
+		seen[msgType] = true
+	}
+
+	return nil
+}
+
+func validateGroupPolicyAddress(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// empty uses the ent signers to decide purchase orders
+	if v == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid group policy address: %s", err)
+	}
+
+	return nil
+}
@@ -17,6 +17,9 @@ func PurchaseOrderStatusFromString(str string) (PurchaseOrderStatus, error) {
 	case "complete":
 		return StatusCompleted, nil
 
+	case "withdrawn":
+		return StatusWithdrawn, nil
+
 	case "":
 		return StatusNil, nil
 
@@ -31,7 +34,8 @@ func ValidPurchaseOrderStatus(status PurchaseOrderStatus) bool {
 	if status == StatusRaised ||
 		status == StatusAccepted ||
 		status == StatusRejected ||
-		status == StatusCompleted {
+		status == StatusCompleted ||
+		status == StatusWithdrawn {
 		return true
 	}
 	return false
@@ -71,6 +75,9 @@ func (status PurchaseOrderStatus) StringNice() string {
 	case StatusCompleted:
 		return "complete"
 
+	case StatusWithdrawn:
+		return "withdrawn"
+
 	default:
 		return ""
 	}
@@ -423,6 +423,224 @@ func (m *QueryLockedUndByAddressResponse) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+// QueryLockedEFUNDLotsByAddressRequest is the request type for the Query/LockedEFUNDLotsByAddress RPC method
+type QueryLockedEFUNDLotsByAddressRequest struct {
+	// owner is the address to query
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *QueryLockedEFUNDLotsByAddressRequest) Reset()         { *m = QueryLockedEFUNDLotsByAddressRequest{} }
+func (m *QueryLockedEFUNDLotsByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockedEFUNDLotsByAddressRequest) ProtoMessage()    {}
+func (*QueryLockedEFUNDLotsByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{8}
+}
+func (m *QueryLockedEFUNDLotsByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockedEFUNDLotsByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockedEFUNDLotsByAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockedEFUNDLotsByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedEFUNDLotsByAddressRequest.Merge(m, src)
+}
+func (m *QueryLockedEFUNDLotsByAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockedEFUNDLotsByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedEFUNDLotsByAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockedEFUNDLotsByAddressRequest proto.InternalMessageInfo
+
+func (m *QueryLockedEFUNDLotsByAddressRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+// QueryLockedEFUNDLotsByAddressResponse is the response type for the Query/LockedEFUNDLotsByAddress RPC method
+type QueryLockedEFUNDLotsByAddressResponse struct {
+	// lots are the account's locked eFUND lots, oldest first
+	Lots []LockedEFUNDLot `protobuf:"bytes,1,rep,name=lots,proto3" json:"lots"`
+}
+
+func (m *QueryLockedEFUNDLotsByAddressResponse) Reset()         { *m = QueryLockedEFUNDLotsByAddressResponse{} }
+func (m *QueryLockedEFUNDLotsByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockedEFUNDLotsByAddressResponse) ProtoMessage()    {}
+func (*QueryLockedEFUNDLotsByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{9}
+}
+func (m *QueryLockedEFUNDLotsByAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockedEFUNDLotsByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockedEFUNDLotsByAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockedEFUNDLotsByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedEFUNDLotsByAddressResponse.Merge(m, src)
+}
+func (m *QueryLockedEFUNDLotsByAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockedEFUNDLotsByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedEFUNDLotsByAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockedEFUNDLotsByAddressResponse proto.InternalMessageInfo
+
+func (m *QueryLockedEFUNDLotsByAddressResponse) GetLots() []LockedEFUNDLot {
+	if m != nil {
+		return m.Lots
+	}
+	return nil
+}
+
+// QueryEnterpriseLedgerRequest is the request type for the Query/EnterpriseLedger RPC method
+type QueryEnterpriseLedgerRequest struct {
+	// owner is the address to query
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// from_time is the optional unix time from which to return entries, inclusive
+	FromTime uint64 `protobuf:"varint,2,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`
+	// to_time is the optional unix time up to which to return entries, exclusive. 0 means no upper limit
+	ToTime     uint64             `protobuf:"varint,3,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEnterpriseLedgerRequest) Reset()         { *m = QueryEnterpriseLedgerRequest{} }
+func (m *QueryEnterpriseLedgerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEnterpriseLedgerRequest) ProtoMessage()    {}
+func (*QueryEnterpriseLedgerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{10}
+}
+func (m *QueryEnterpriseLedgerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEnterpriseLedgerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEnterpriseLedgerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEnterpriseLedgerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEnterpriseLedgerRequest.Merge(m, src)
+}
+func (m *QueryEnterpriseLedgerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEnterpriseLedgerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEnterpriseLedgerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEnterpriseLedgerRequest proto.InternalMessageInfo
+
+func (m *QueryEnterpriseLedgerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryEnterpriseLedgerRequest) GetFromTime() uint64 {
+	if m != nil {
+		return m.FromTime
+	}
+	return 0
+}
+
+func (m *QueryEnterpriseLedgerRequest) GetToTime() uint64 {
+	if m != nil {
+		return m.ToTime
+	}
+	return 0
+}
+
+func (m *QueryEnterpriseLedgerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryEnterpriseLedgerResponse is the response type for the Query/EnterpriseLedger RPC method
+type QueryEnterpriseLedgerResponse struct {
+	// entries are the account's ledger entries, oldest first
+	Entries    []EFUNDLedgerEntry  `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryEnterpriseLedgerResponse) Reset()         { *m = QueryEnterpriseLedgerResponse{} }
+func (m *QueryEnterpriseLedgerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEnterpriseLedgerResponse) ProtoMessage()    {}
+func (*QueryEnterpriseLedgerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{11}
+}
+func (m *QueryEnterpriseLedgerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEnterpriseLedgerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEnterpriseLedgerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEnterpriseLedgerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEnterpriseLedgerResponse.Merge(m, src)
+}
+func (m *QueryEnterpriseLedgerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEnterpriseLedgerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEnterpriseLedgerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEnterpriseLedgerResponse proto.InternalMessageInfo
+
+func (m *QueryEnterpriseLedgerResponse) GetEntries() []EFUNDLedgerEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryEnterpriseLedgerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryTotalLockedRequest is the request type for the Query/TotalLocked RPC method
 type QueryTotalLockedRequest struct {
 }
@@ -431,7 +649,7 @@ func (m *QueryTotalLockedRequest) Reset()         { *m = QueryTotalLockedRequest
 func (m *QueryTotalLockedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalLockedRequest) ProtoMessage()    {}
 func (*QueryTotalLockedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{8}
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{12}
 }
 func (m *QueryTotalLockedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -469,7 +687,7 @@ func (m *QueryTotalLockedResponse) Reset()         { *m = QueryTotalLockedRespon
 func (m *QueryTotalLockedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalLockedResponse) ProtoMessage()    {}
 func (*QueryTotalLockedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{9}
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{13}
 }
 func (m *QueryTotalLockedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -513,7 +731,7 @@ func (m *QueryTotalUnlockedRequest) Reset()         { *m = QueryTotalUnlockedReq
 func (m *QueryTotalUnlockedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalUnlockedRequest) ProtoMessage()    {}
 func (*QueryTotalUnlockedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{10}
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{14}
 }
 func (m *QueryTotalUnlockedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -551,7 +769,7 @@ func (m *QueryTotalUnlockedResponse) Reset()         { *m = QueryTotalUnlockedRe
 func (m *QueryTotalUnlockedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalUnlockedResponse) ProtoMessage()    {}
 func (*QueryTotalUnlockedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{11}
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{15}
 }
 func (m *QueryTotalUnlockedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -596,7 +814,7 @@ func (m *QueryTotalSupplyRequest) Reset()         { *m = QueryTotalSupplyRequest
 func (m *QueryTotalSupplyRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSupplyRequest) ProtoMessage()    {}
 func (*QueryTotalSupplyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{12}
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{16}
 }
 func (m *QueryTotalSupplyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -642,7 +860,7 @@ func (m *QueryTotalSupplyResponse) Reset()         { *m = QueryTotalSupplyRespon
 func (m *QueryTotalSupplyResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalSupplyResponse) ProtoMessage()    {}
 func (*QueryTotalSupplyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{13}
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{17}
 }
 func (m *QueryTotalSupplyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -695,7 +913,7 @@ func (m *QuerySupplyOfRequest) Reset()         { *m = QuerySupplyOfRequest{} }
 func (m *QuerySupplyOfRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySupplyOfRequest) ProtoMessage()    {}
 func (*QuerySupplyOfRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{14}
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{18}
 }
 func (m *QuerySupplyOfRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -741,7 +959,7 @@ func (m *QuerySupplyOfResponse) Reset()         { *m = QuerySupplyOfResponse{} }
 func (m *QuerySupplyOfResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySupplyOfResponse) ProtoMessage()    {}
 func (*QuerySupplyOfResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{15}
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{19}
 }
 func (m *QuerySupplyOfResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -785,7 +1003,7 @@ func (m *QueryWhitelistRequest) Reset()         { *m = QueryWhitelistRequest{} }
 func (m *QueryWhitelistRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWhitelistRequest) ProtoMessage()    {}
 func (*QueryWhitelistRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{16}
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{20}
 }
 func (m *QueryWhitelistRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,13 +1035,15 @@ var xxx_messageInfo_QueryWhitelistRequest proto.InternalMessageInfo
 // QueryWhitelistResponse is the response type for the Query/Whitelist RPC method.
 type QueryWhitelistResponse struct {
 	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// entries are the whitelist entries for the addresses, including any expiry, cap and tier
+	Entries []WhitelistEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
 }
 
 func (m *QueryWhitelistResponse) Reset()         { *m = QueryWhitelistResponse{} }
 func (m *QueryWhitelistResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWhitelistResponse) ProtoMessage()    {}
 func (*QueryWhitelistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{17}
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{21}
 }
 func (m *QueryWhitelistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,6 +1079,13 @@ func (m *QueryWhitelistResponse) GetAddresses() []string {
 	return nil
 }
 
+func (m *QueryWhitelistResponse) GetEntries() []WhitelistEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
 // QueryWhitelistedRequest is the request type for the Query/Whitelisted RPC method.
 type QueryWhitelistedRequest struct {
 	// address is the address to query
@@ -869,7 +1096,7 @@ func (m *QueryWhitelistedRequest) Reset()         { *m = QueryWhitelistedRequest
 func (m *QueryWhitelistedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryWhitelistedRequest) ProtoMessage()    {}
 func (*QueryWhitelistedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{18}
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{22}
 }
 func (m *QueryWhitelistedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -909,13 +1136,15 @@ func (m *QueryWhitelistedRequest) GetAddress() string {
 type QueryWhitelistedResponse struct {
 	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Whitelisted bool   `protobuf:"varint,2,opt,name=whitelisted,proto3" json:"whitelisted,omitempty"`
+	// entry is the address's whitelist entry, if it has one. The entry may have expired
+	Entry WhitelistEntry `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry"`
 }
 
 func (m *QueryWhitelistedResponse) Reset()         { *m = QueryWhitelistedResponse{} }
 func (m *QueryWhitelistedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryWhitelistedResponse) ProtoMessage()    {}
 func (*QueryWhitelistedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{19}
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{23}
 }
 func (m *QueryWhitelistedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -958,24 +1187,31 @@ func (m *QueryWhitelistedResponse) GetWhitelisted() bool {
 	return false
 }
 
-// QueryEnterpriseAccountRequest is the request type for the Query/EnterpriseAccount RPC method.
-type QueryEnterpriseAccountRequest struct {
-	// address is the address to query
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+func (m *QueryWhitelistedResponse) GetEntry() WhitelistEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return WhitelistEntry{}
 }
 
-func (m *QueryEnterpriseAccountRequest) Reset()         { *m = QueryEnterpriseAccountRequest{} }
-func (m *QueryEnterpriseAccountRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryEnterpriseAccountRequest) ProtoMessage()    {}
-func (*QueryEnterpriseAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{20}
+// QueryLinkedAddressesRequest is the request type for the Query/LinkedAddresses RPC method.
+type QueryLinkedAddressesRequest struct {
+	// owner is the address to query
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
-func (m *QueryEnterpriseAccountRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryLinkedAddressesRequest) Reset()         { *m = QueryLinkedAddressesRequest{} }
+func (m *QueryLinkedAddressesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLinkedAddressesRequest) ProtoMessage()    {}
+func (*QueryLinkedAddressesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{24}
+}
+func (m *QueryLinkedAddressesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEnterpriseAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLinkedAddressesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEnterpriseAccountRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLinkedAddressesRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -985,42 +1221,42 @@ func (m *QueryEnterpriseAccountRequest) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *QueryEnterpriseAccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEnterpriseAccountRequest.Merge(m, src)
+func (m *QueryLinkedAddressesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLinkedAddressesRequest.Merge(m, src)
 }
-func (m *QueryEnterpriseAccountRequest) XXX_Size() int {
+func (m *QueryLinkedAddressesRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEnterpriseAccountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEnterpriseAccountRequest.DiscardUnknown(m)
+func (m *QueryLinkedAddressesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLinkedAddressesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEnterpriseAccountRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryLinkedAddressesRequest proto.InternalMessageInfo
 
-func (m *QueryEnterpriseAccountRequest) GetAddress() string {
+func (m *QueryLinkedAddressesRequest) GetOwner() string {
 	if m != nil {
-		return m.Address
+		return m.Owner
 	}
 	return ""
 }
 
-// QueryEnterpriseAccountResponse is the response type for the Query/EnterpriseAccount RPC method.
-type QueryEnterpriseAccountResponse struct {
-	Account EnterpriseUserAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+// QueryLinkedAddressesResponse is the response type for the Query/LinkedAddresses RPC method.
+type QueryLinkedAddressesResponse struct {
+	LinkedAddresses []LinkedAddress `protobuf:"bytes,1,rep,name=linked_addresses,json=linkedAddresses,proto3" json:"linked_addresses"`
 }
 
-func (m *QueryEnterpriseAccountResponse) Reset()         { *m = QueryEnterpriseAccountResponse{} }
-func (m *QueryEnterpriseAccountResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryEnterpriseAccountResponse) ProtoMessage()    {}
-func (*QueryEnterpriseAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{21}
+func (m *QueryLinkedAddressesResponse) Reset()         { *m = QueryLinkedAddressesResponse{} }
+func (m *QueryLinkedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLinkedAddressesResponse) ProtoMessage()    {}
+func (*QueryLinkedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{25}
 }
-func (m *QueryEnterpriseAccountResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryLinkedAddressesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEnterpriseAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryLinkedAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEnterpriseAccountResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryLinkedAddressesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1030,41 +1266,41 @@ func (m *QueryEnterpriseAccountResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryEnterpriseAccountResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEnterpriseAccountResponse.Merge(m, src)
+func (m *QueryLinkedAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLinkedAddressesResponse.Merge(m, src)
 }
-func (m *QueryEnterpriseAccountResponse) XXX_Size() int {
+func (m *QueryLinkedAddressesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEnterpriseAccountResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEnterpriseAccountResponse.DiscardUnknown(m)
+func (m *QueryLinkedAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLinkedAddressesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEnterpriseAccountResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryLinkedAddressesResponse proto.InternalMessageInfo
 
-func (m *QueryEnterpriseAccountResponse) GetAccount() EnterpriseUserAccount {
+func (m *QueryLinkedAddressesResponse) GetLinkedAddresses() []LinkedAddress {
 	if m != nil {
-		return m.Account
+		return m.LinkedAddresses
 	}
-	return EnterpriseUserAccount{}
+	return nil
 }
 
-// QueryTotalSpentEFUNDRequest is the request type for the Query/TotalSpentEFUND RPC method.
-type QueryTotalSpentEFUNDRequest struct {
+// QueryEntSignersRequest is the request type for the Query/EntSigners RPC method.
+type QueryEntSignersRequest struct {
 }
 
-func (m *QueryTotalSpentEFUNDRequest) Reset()         { *m = QueryTotalSpentEFUNDRequest{} }
-func (m *QueryTotalSpentEFUNDRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalSpentEFUNDRequest) ProtoMessage()    {}
-func (*QueryTotalSpentEFUNDRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{22}
+func (m *QueryEntSignersRequest) Reset()         { *m = QueryEntSignersRequest{} }
+func (m *QueryEntSignersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEntSignersRequest) ProtoMessage()    {}
+func (*QueryEntSignersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{26}
 }
-func (m *QueryTotalSpentEFUNDRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryEntSignersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalSpentEFUNDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEntSignersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalSpentEFUNDRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEntSignersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1074,35 +1310,35 @@ func (m *QueryTotalSpentEFUNDRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalSpentEFUNDRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalSpentEFUNDRequest.Merge(m, src)
+func (m *QueryEntSignersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntSignersRequest.Merge(m, src)
 }
-func (m *QueryTotalSpentEFUNDRequest) XXX_Size() int {
+func (m *QueryEntSignersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalSpentEFUNDRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalSpentEFUNDRequest.DiscardUnknown(m)
+func (m *QueryEntSignersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntSignersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalSpentEFUNDRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryEntSignersRequest proto.InternalMessageInfo
 
-// QueryTotalSpentEFUNDResponse is the response type for the Query/TotalSpentEFUND RPC method.
-type QueryTotalSpentEFUNDResponse struct {
-	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+// QueryEntSignersResponse is the response type for the Query/EntSigners RPC method.
+type QueryEntSignersResponse struct {
+	EntSigners []EntSigner `protobuf:"bytes,1,rep,name=ent_signers,json=entSigners,proto3" json:"ent_signers"`
 }
 
-func (m *QueryTotalSpentEFUNDResponse) Reset()         { *m = QueryTotalSpentEFUNDResponse{} }
-func (m *QueryTotalSpentEFUNDResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalSpentEFUNDResponse) ProtoMessage()    {}
-func (*QueryTotalSpentEFUNDResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{23}
+func (m *QueryEntSignersResponse) Reset()         { *m = QueryEntSignersResponse{} }
+func (m *QueryEntSignersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEntSignersResponse) ProtoMessage()    {}
+func (*QueryEntSignersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{27}
 }
-func (m *QueryTotalSpentEFUNDResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryEntSignersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalSpentEFUNDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEntSignersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalSpentEFUNDResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEntSignersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1112,28 +1348,201 @@ func (m *QueryTotalSpentEFUNDResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalSpentEFUNDResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalSpentEFUNDResponse.Merge(m, src)
+func (m *QueryEntSignersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEntSignersResponse.Merge(m, src)
 }
-func (m *QueryTotalSpentEFUNDResponse) XXX_Size() int {
+func (m *QueryEntSignersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalSpentEFUNDResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalSpentEFUNDResponse.DiscardUnknown(m)
+func (m *QueryEntSignersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEntSignersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalSpentEFUNDResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEntSignersResponse proto.InternalMessageInfo
 
-func (m *QueryTotalSpentEFUNDResponse) GetAmount() types.Coin {
+func (m *QueryEntSignersResponse) GetEntSigners() []EntSigner {
 	if m != nil {
-		return m.Amount
+		return m.EntSigners
 	}
-	return types.Coin{}
+	return nil
 }
 
-// QuerySpentEFUNDByAddressRequest is the request type for the Query/SpentEFUNDByAddress RPC method.
-type QuerySpentEFUNDByAddressRequest struct {
-	// address is the address to query
+// QueryEnterpriseAccountRequest is the request type for the Query/EnterpriseAccount RPC method.
+type QueryEnterpriseAccountRequest struct {
+	// address is the address to query
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryEnterpriseAccountRequest) Reset()         { *m = QueryEnterpriseAccountRequest{} }
+func (m *QueryEnterpriseAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEnterpriseAccountRequest) ProtoMessage()    {}
+func (*QueryEnterpriseAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{28}
+}
+func (m *QueryEnterpriseAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEnterpriseAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEnterpriseAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEnterpriseAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEnterpriseAccountRequest.Merge(m, src)
+}
+func (m *QueryEnterpriseAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEnterpriseAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEnterpriseAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEnterpriseAccountRequest proto.InternalMessageInfo
+
+func (m *QueryEnterpriseAccountRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryEnterpriseAccountResponse is the response type for the Query/EnterpriseAccount RPC method.
+type QueryEnterpriseAccountResponse struct {
+	Account EnterpriseUserAccount `protobuf:"bytes,1,opt,name=account,proto3" json:"account"`
+}
+
+func (m *QueryEnterpriseAccountResponse) Reset()         { *m = QueryEnterpriseAccountResponse{} }
+func (m *QueryEnterpriseAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEnterpriseAccountResponse) ProtoMessage()    {}
+func (*QueryEnterpriseAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{29}
+}
+func (m *QueryEnterpriseAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEnterpriseAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEnterpriseAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEnterpriseAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEnterpriseAccountResponse.Merge(m, src)
+}
+func (m *QueryEnterpriseAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEnterpriseAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEnterpriseAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEnterpriseAccountResponse proto.InternalMessageInfo
+
+func (m *QueryEnterpriseAccountResponse) GetAccount() EnterpriseUserAccount {
+	if m != nil {
+		return m.Account
+	}
+	return EnterpriseUserAccount{}
+}
+
+// QueryTotalSpentEFUNDRequest is the request type for the Query/TotalSpentEFUND RPC method.
+type QueryTotalSpentEFUNDRequest struct {
+}
+
+func (m *QueryTotalSpentEFUNDRequest) Reset()         { *m = QueryTotalSpentEFUNDRequest{} }
+func (m *QueryTotalSpentEFUNDRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalSpentEFUNDRequest) ProtoMessage()    {}
+func (*QueryTotalSpentEFUNDRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{30}
+}
+func (m *QueryTotalSpentEFUNDRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalSpentEFUNDRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalSpentEFUNDRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalSpentEFUNDRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalSpentEFUNDRequest.Merge(m, src)
+}
+func (m *QueryTotalSpentEFUNDRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalSpentEFUNDRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalSpentEFUNDRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalSpentEFUNDRequest proto.InternalMessageInfo
+
+// QueryTotalSpentEFUNDResponse is the response type for the Query/TotalSpentEFUND RPC method.
+type QueryTotalSpentEFUNDResponse struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *QueryTotalSpentEFUNDResponse) Reset()         { *m = QueryTotalSpentEFUNDResponse{} }
+func (m *QueryTotalSpentEFUNDResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalSpentEFUNDResponse) ProtoMessage()    {}
+func (*QueryTotalSpentEFUNDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{31}
+}
+func (m *QueryTotalSpentEFUNDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalSpentEFUNDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalSpentEFUNDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalSpentEFUNDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalSpentEFUNDResponse.Merge(m, src)
+}
+func (m *QueryTotalSpentEFUNDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalSpentEFUNDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalSpentEFUNDResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalSpentEFUNDResponse proto.InternalMessageInfo
+
+func (m *QueryTotalSpentEFUNDResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// QuerySpentEFUNDByAddressRequest is the request type for the Query/SpentEFUNDByAddress RPC method.
+type QuerySpentEFUNDByAddressRequest struct {
+	// address is the address to query
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
@@ -1141,7 +1550,7 @@ func (m *QuerySpentEFUNDByAddressRequest) Reset()         { *m = QuerySpentEFUND
 func (m *QuerySpentEFUNDByAddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySpentEFUNDByAddressRequest) ProtoMessage()    {}
 func (*QuerySpentEFUNDByAddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{24}
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{32}
 }
 func (m *QuerySpentEFUNDByAddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1186,7 +1595,7 @@ func (m *QuerySpentEFUNDByAddressResponse) Reset()         { *m = QuerySpentEFUN
 func (m *QuerySpentEFUNDByAddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySpentEFUNDByAddressResponse) ProtoMessage()    {}
 func (*QuerySpentEFUNDByAddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4dd8eb5b331b8d2b, []int{25}
+	return fileDescriptor_4dd8eb5b331b8d2b, []int{33}
 }
 func (m *QuerySpentEFUNDByAddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1231,6 +1640,10 @@ func init() {
 	proto.RegisterType((*QueryEnterpriseUndPurchaseOrdersResponse)(nil), "mainchain.enterprise.v1.QueryEnterpriseUndPurchaseOrdersResponse")
 	proto.RegisterType((*QueryLockedUndByAddressRequest)(nil), "mainchain.enterprise.v1.QueryLockedUndByAddressRequest")
 	proto.RegisterType((*QueryLockedUndByAddressResponse)(nil), "mainchain.enterprise.v1.QueryLockedUndByAddressResponse")
+	proto.RegisterType((*QueryLockedEFUNDLotsByAddressRequest)(nil), "mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressRequest")
+	proto.RegisterType((*QueryLockedEFUNDLotsByAddressResponse)(nil), "mainchain.enterprise.v1.QueryLockedEFUNDLotsByAddressResponse")
+	proto.RegisterType((*QueryEnterpriseLedgerRequest)(nil), "mainchain.enterprise.v1.QueryEnterpriseLedgerRequest")
+	proto.RegisterType((*QueryEnterpriseLedgerResponse)(nil), "mainchain.enterprise.v1.QueryEnterpriseLedgerResponse")
 	proto.RegisterType((*QueryTotalLockedRequest)(nil), "mainchain.enterprise.v1.QueryTotalLockedRequest")
 	proto.RegisterType((*QueryTotalLockedResponse)(nil), "mainchain.enterprise.v1.QueryTotalLockedResponse")
 	proto.RegisterType((*QueryTotalUnlockedRequest)(nil), "mainchain.enterprise.v1.QueryTotalUnlockedRequest")
@@ -1243,6 +1656,10 @@ func init() {
 	proto.RegisterType((*QueryWhitelistResponse)(nil), "mainchain.enterprise.v1.QueryWhitelistResponse")
 	proto.RegisterType((*QueryWhitelistedRequest)(nil), "mainchain.enterprise.v1.QueryWhitelistedRequest")
 	proto.RegisterType((*QueryWhitelistedResponse)(nil), "mainchain.enterprise.v1.QueryWhitelistedResponse")
+	proto.RegisterType((*QueryLinkedAddressesRequest)(nil), "mainchain.enterprise.v1.QueryLinkedAddressesRequest")
+	proto.RegisterType((*QueryLinkedAddressesResponse)(nil), "mainchain.enterprise.v1.QueryLinkedAddressesResponse")
+	proto.RegisterType((*QueryEntSignersRequest)(nil), "mainchain.enterprise.v1.QueryEntSignersRequest")
+	proto.RegisterType((*QueryEntSignersResponse)(nil), "mainchain.enterprise.v1.QueryEntSignersResponse")
 	proto.RegisterType((*QueryEnterpriseAccountRequest)(nil), "mainchain.enterprise.v1.QueryEnterpriseAccountRequest")
 	proto.RegisterType((*QueryEnterpriseAccountResponse)(nil), "mainchain.enterprise.v1.QueryEnterpriseAccountResponse")
 	proto.RegisterType((*QueryTotalSpentEFUNDRequest)(nil), "mainchain.enterprise.v1.QueryTotalSpentEFUNDRequest")
@@ -1256,82 +1673,102 @@ func init() {
 }
 
 var fileDescriptor_4dd8eb5b331b8d2b = []byte{
-	// 1188 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xa6, 0x8d, 0xfb, 0xcd, 0x8b, 0xbe, 0x89, 0x3a, 0x0d, 0xc4, 0xd9, 0xa4, 0x4e, 0x58,
-	0xa2, 0xc4, 0x04, 0x67, 0x37, 0x71, 0x4b, 0x52, 0x0e, 0x08, 0x12, 0xda, 0x22, 0x24, 0x68, 0x53,
-	0x27, 0xa6, 0x52, 0x39, 0x84, 0x8d, 0x77, 0xea, 0xac, 0x1a, 0xef, 0x6c, 0x77, 0xd6, 0x29, 0x51,
-	0x94, 0x0b, 0x12, 0x27, 0x38, 0x20, 0x71, 0x41, 0xe2, 0x1f, 0x40, 0x88, 0x23, 0x07, 0x0e, 0xfc,
-	0x01, 0x39, 0x56, 0x70, 0x00, 0x2e, 0x80, 0x12, 0xee, 0xfc, 0x0b, 0x68, 0x67, 0xde, 0xda, 0xbb,
-	0xb6, 0xd7, 0xeb, 0xc4, 0x3d, 0xc5, 0x3b, 0xf3, 0xde, 0xe7, 0x7d, 0xde, 0x8f, 0x79, 0xef, 0x29,
-	0xf0, 0x6a, 0xcd, 0xb4, 0x9d, 0xca, 0x9e, 0x69, 0x3b, 0x06, 0x75, 0x7c, 0xea, 0xb9, 0x9e, 0xcd,
-	0xa9, 0x71, 0xb0, 0x62, 0x3c, 0xad, 0x53, 0xef, 0x50, 0x77, 0x3d, 0xe6, 0x33, 0x32, 0xd1, 0x10,
-	0xd2, 0x9b, 0x42, 0xfa, 0xc1, 0x8a, 0xba, 0x58, 0x61, 0xbc, 0xc6, 0xb8, 0xb1, 0x6b, 0x72, 0x2a,
-	0x35, 0x8c, 0x83, 0x95, 0x5d, 0xea, 0x9b, 0x2b, 0x86, 0x6b, 0x56, 0x6d, 0xc7, 0xf4, 0x6d, 0xe6,
-	0x48, 0x10, 0x75, 0xbc, 0xca, 0xaa, 0x4c, 0xfc, 0x34, 0x82, 0x5f, 0x78, 0x3a, 0x5d, 0x65, 0xac,
-	0xba, 0x4f, 0x0d, 0xd3, 0xb5, 0x0d, 0xd3, 0x71, 0x98, 0x2f, 0x54, 0x38, 0xde, 0xe6, 0xa2, 0xf8,
-	0x21, 0x72, 0x85, 0xd9, 0x21, 0xe6, 0xa4, 0xbc, 0xdf, 0x91, 0xb0, 0xf2, 0x03, 0xaf, 0xf2, 0x49,
-	0x8e, 0x45, 0x3c, 0x10, 0x92, 0xda, 0x38, 0x90, 0x07, 0x01, 0xf5, 0x4d, 0xd3, 0x33, 0x6b, 0xbc,
-	0x44, 0x9f, 0xd6, 0x29, 0xf7, 0xb5, 0x6d, 0xb8, 0x16, 0x3b, 0xe5, 0x2e, 0x73, 0x38, 0x25, 0x6f,
-	0x41, 0xc6, 0x15, 0x27, 0x59, 0x65, 0x56, 0xc9, 0x8f, 0x14, 0x67, 0xf4, 0x84, 0xd8, 0xe8, 0x52,
-	0x71, 0xe3, 0xf2, 0xc9, 0x9f, 0x33, 0x03, 0x25, 0x54, 0xd2, 0xb6, 0x61, 0x5e, 0xa0, 0xde, 0x69,
-	0x88, 0x96, 0x1d, 0x6b, 0xb3, 0xee, 0x55, 0xf6, 0x4c, 0x4e, 0xef, 0x7b, 0x16, 0xf5, 0xd0, 0x3e,
-	0x59, 0x84, 0xab, 0x2e, 0x9e, 0xef, 0xb0, 0xe0, 0x62, 0xc7, 0xb6, 0x84, 0xcd, 0xcb, 0xa5, 0x31,
-	0x37, 0xaa, 0xf0, 0xbe, 0xa5, 0x7d, 0xa1, 0xc0, 0x42, 0x2a, 0x2c, 0x3a, 0xf0, 0x09, 0x8c, 0xc6,
-	0x71, 0xd1, 0x91, 0x1b, 0x89, 0x8e, 0x24, 0x83, 0xa2, 0x73, 0xff, 0x8f, 0xf1, 0xd1, 0xfe, 0x4d,
-	0x67, 0x13, 0x46, 0x99, 0xdc, 0x05, 0x68, 0x16, 0x0a, 0x32, 0x99, 0xd7, 0x31, 0x91, 0x41, 0xd6,
-	0x75, 0x59, 0x87, 0x98, 0x7b, 0x7d, 0xd3, 0xac, 0x52, 0xd4, 0x2d, 0x45, 0x34, 0xc9, 0x2a, 0x0c,
-	0x87, 0x24, 0xbc, 0xec, 0xe0, 0xac, 0x92, 0x1f, 0xde, 0xc8, 0xfe, 0xf2, 0xe3, 0xd2, 0x38, 0x22,
-	0xad, 0x5b, 0x96, 0x47, 0x39, 0xdf, 0xf2, 0x3d, 0xdb, 0xa9, 0x96, 0x9a, 0xa2, 0xe4, 0x36, 0x64,
-	0xb8, 0x6f, 0xfa, 0x75, 0x9e, 0xbd, 0x34, 0xab, 0xe4, 0x47, 0x8b, 0x85, 0xe4, 0x74, 0x46, 0xf9,
-	0x6f, 0x09, 0x9d, 0x12, 0xea, 0x6a, 0xbf, 0x29, 0x90, 0x4f, 0xf7, 0x18, 0x13, 0xb0, 0x0b, 0x63,
-	0xf1, 0x04, 0x04, 0xa5, 0x74, 0xa9, 0xbf, 0x0c, 0x8c, 0xc6, 0x32, 0xc0, 0xc9, 0x7b, 0xb1, 0xb0,
-	0x0e, 0x8a, 0xb0, 0x2e, 0xa4, 0x86, 0x55, 0x12, 0x8c, 0xc6, 0x55, 0xdb, 0x84, 0x9c, 0x70, 0xec,
-	0x03, 0x56, 0x79, 0x42, 0xad, 0xb2, 0x63, 0x6d, 0x1c, 0x62, 0x2c, 0xc3, 0x0c, 0xea, 0x30, 0xc4,
-	0x9e, 0x39, 0x58, 0x46, 0xdd, 0xa2, 0x2e, 0xc5, 0xb4, 0x47, 0x30, 0x93, 0x88, 0x88, 0x11, 0x5a,
-	0x83, 0x8c, 0x59, 0x63, 0x75, 0xc7, 0xc7, 0x82, 0x98, 0x8c, 0x31, 0x0f, 0x39, 0xbf, 0xcb, 0x6c,
-	0x27, 0x7c, 0x5d, 0x52, 0x5c, 0x9b, 0x84, 0x09, 0x81, 0xbd, 0xcd, 0x7c, 0x73, 0x5f, 0x1a, 0x08,
-	0x9f, 0xf3, 0x16, 0x64, 0xdb, 0xaf, 0xfa, 0xb5, 0x37, 0x05, 0x93, 0x4d, 0xd0, 0xb2, 0xb3, 0x1f,
-	0xb3, 0x58, 0x06, 0xb5, 0xd3, 0x65, 0xbf, 0x36, 0xcd, 0xa8, 0x8f, 0x5b, 0x75, 0xd7, 0xdd, 0x3f,
-	0x7c, 0xc1, 0x8f, 0x49, 0x3b, 0x51, 0xa2, 0xc1, 0x0a, 0x6d, 0x20, 0xf1, 0x0a, 0x64, 0xb8, 0x38,
-	0xc1, 0xaa, 0xed, 0x42, 0x7c, 0x39, 0x20, 0xfe, 0xfd, 0x5f, 0x33, 0xf9, 0xaa, 0xed, 0xef, 0xd5,
-	0x77, 0xf5, 0x0a, 0xab, 0x61, 0x8f, 0xc6, 0x3f, 0x4b, 0xdc, 0x7a, 0x62, 0xf8, 0x87, 0x2e, 0xe5,
-	0x42, 0x21, 0x78, 0x50, 0x02, 0xfa, 0xc5, 0xd5, 0x6f, 0x01, 0xc6, 0x85, 0x27, 0xd2, 0x89, 0xfb,
-	0x8f, 0xc3, 0x50, 0x8d, 0xc3, 0x90, 0x45, 0x1d, 0x56, 0x93, 0x55, 0x5b, 0x92, 0x1f, 0xda, 0x26,
-	0xbc, 0xd4, 0x22, 0xdd, 0x6f, 0xb6, 0x26, 0x10, 0xf1, 0xe1, 0x9e, 0xed, 0xd3, 0x7d, 0x9b, 0xfb,
-	0x61, 0x75, 0xac, 0xc2, 0xcb, 0xad, 0x17, 0x68, 0x6b, 0x1a, 0x86, 0x4d, 0xf9, 0x20, 0xa8, 0xec,
-	0x0c, 0xc3, 0xa5, 0xe6, 0x81, 0xf6, 0x21, 0xa6, 0xbf, 0xa1, 0xd7, 0x28, 0x38, 0x52, 0x84, 0x2b,
-	0x28, 0x97, 0xfa, 0x16, 0x43, 0x41, 0xed, 0x23, 0xcc, 0x74, 0x0c, 0x0e, 0x89, 0x64, 0x5b, 0xf0,
-	0x1a, 0x5a, 0x64, 0x16, 0x46, 0x9e, 0x35, 0x15, 0x44, 0x7e, 0xfe, 0x57, 0x8a, 0x1e, 0x69, 0x5b,
-	0x70, 0xbd, 0xa5, 0x21, 0xae, 0x57, 0x2a, 0x41, 0x44, 0xfa, 0x21, 0xeb, 0x62, 0x33, 0xea, 0x00,
-	0x8a, 0x94, 0xef, 0xc1, 0x15, 0x53, 0x1e, 0x61, 0xa2, 0xf4, 0x5e, 0x7a, 0x2a, 0xa7, 0x1e, 0x02,
-	0x61, 0xf6, 0x42, 0x10, 0xed, 0x3a, 0x4c, 0x45, 0x1e, 0x82, 0x4b, 0x1d, 0xff, 0xce, 0xdd, 0xf2,
-	0xbd, 0xdb, 0x61, 0x12, 0x1f, 0xc2, 0x74, 0xe7, 0xeb, 0x7e, 0xcb, 0xa6, 0x8c, 0x4d, 0xb2, 0x89,
-	0xd9, 0xd6, 0x77, 0x2f, 0x12, 0xc0, 0x8f, 0x61, 0x36, 0x19, 0xb6, 0x4f, 0xce, 0xc5, 0xcf, 0x47,
-	0x61, 0x48, 0xa0, 0x93, 0x2f, 0x15, 0xc8, 0xc8, 0xed, 0x87, 0xbc, 0x9e, 0x18, 0xff, 0xf6, 0x95,
-	0x4b, 0x2d, 0xf4, 0x26, 0x2c, 0x89, 0x6a, 0x0b, 0x9f, 0xfd, 0xfa, 0xcf, 0xd7, 0x83, 0xaf, 0x90,
-	0x19, 0x23, 0x69, 0xd3, 0x93, 0x3b, 0x17, 0xf9, 0x43, 0x01, 0x35, 0x79, 0x82, 0x92, 0xb7, 0xbb,
-	0x5b, 0x4d, 0xdd, 0xd4, 0xd4, 0x77, 0x2e, 0x0e, 0x80, 0xae, 0xdc, 0x12, 0xae, 0x14, 0xc9, 0x72,
-	0xb2, 0x2b, 0x8c, 0x1b, 0x47, 0x6d, 0xfb, 0xe0, 0x31, 0x39, 0x51, 0x60, 0xaa, 0xcb, 0xd2, 0x41,
-	0x2e, 0xcc, 0xad, 0x91, 0x94, 0xf5, 0x3e, 0x10, 0xd0, 0xbd, 0x39, 0xe1, 0x5e, 0x8e, 0x4c, 0x77,
-	0x73, 0x8f, 0xfc, 0xa4, 0x00, 0x69, 0x5f, 0x0a, 0xc8, 0x5a, 0x77, 0xfb, 0x89, 0x8b, 0x89, 0x7a,
-	0xeb, 0xfc, 0x8a, 0xc8, 0xd7, 0x10, 0x7c, 0x5f, 0x23, 0x0b, 0x89, 0x7c, 0xe5, 0x30, 0x37, 0x8e,
-	0xc4, 0x4a, 0x73, 0x4c, 0xbe, 0x55, 0x60, 0x24, 0xb2, 0x58, 0x90, 0xe5, 0xee, 0xa6, 0xdb, 0xd7,
-	0x13, 0x75, 0xe5, 0x1c, 0x1a, 0x3d, 0xd7, 0xbf, 0x64, 0x49, 0xbe, 0x51, 0x60, 0xb8, 0xd1, 0xdf,
-	0x89, 0xde, 0xdd, 0x52, 0xeb, 0xa0, 0x52, 0x8d, 0x9e, 0xe5, 0x91, 0xd7, 0xa2, 0xe0, 0x35, 0x47,
-	0xb4, 0x44, 0x5e, 0x8d, 0x41, 0x41, 0xbe, 0x53, 0x60, 0x24, 0x32, 0x7a, 0xd2, 0x02, 0xd7, 0x3e,
-	0xf4, 0xd2, 0x02, 0xd7, 0x61, 0xae, 0x69, 0x37, 0x05, 0x41, 0x9d, 0x14, 0xd2, 0x09, 0x1a, 0x47,
-	0xd8, 0x3a, 0x8f, 0x83, 0xf2, 0xbc, 0xda, 0x36, 0x78, 0xc8, 0x6a, 0xaf, 0xaf, 0x23, 0x3e, 0xfe,
-	0xd4, 0xb5, 0x73, 0xeb, 0x21, 0xf9, 0xa2, 0x20, 0x5f, 0x20, 0x8b, 0x89, 0xe4, 0x71, 0x76, 0x45,
-	0xa8, 0xff, 0xa0, 0xc0, 0x58, 0xcb, 0x88, 0x22, 0x37, 0x7b, 0x28, 0xb8, 0xb6, 0x81, 0xa7, 0xbe,
-	0x71, 0x4e, 0x2d, 0x24, 0x5d, 0x10, 0xa4, 0xe7, 0xc9, 0x5c, 0x22, 0x69, 0x3f, 0xd0, 0xdc, 0xe1,
-	0x81, 0x2a, 0xf9, 0x59, 0x81, 0x6b, 0x1d, 0x26, 0x14, 0x49, 0x79, 0xd0, 0xc9, 0xb3, 0x52, 0x7d,
-	0xf3, 0x02, 0x9a, 0x48, 0x7d, 0x59, 0x50, 0x5f, 0x24, 0xf9, 0x44, 0xea, 0x82, 0x74, 0x33, 0xda,
-	0x1b, 0x0f, 0x4e, 0x4e, 0x73, 0xca, 0xf3, 0xd3, 0x9c, 0xf2, 0xf7, 0x69, 0x4e, 0xf9, 0xea, 0x2c,
-	0x37, 0xf0, 0xfc, 0x2c, 0x37, 0xf0, 0xfb, 0x59, 0x6e, 0xe0, 0xd1, 0x5a, 0x64, 0x0f, 0xae, 0x3b,
-	0xf6, 0x63, 0xbb, 0x22, 0x96, 0xd4, 0xa5, 0xe0, 0xbb, 0x89, 0xfe, 0x69, 0x14, 0x5f, 0x2c, 0xc7,
-	0xbb, 0x19, 0xf1, 0x8f, 0x8a, 0x1b, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x2a, 0xa8, 0xf4,
-	0xad, 0x11, 0x00, 0x00,
+	// 1519 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xce, 0xa6, 0x89, 0xd3, 0xbc, 0xd1, 0xaf, 0x69, 0xa7, 0xf9, 0x11, 0x67, 0x93, 0x3a, 0x61,
+	0x09, 0x8d, 0x1b, 0x52, 0x6f, 0x92, 0x7e, 0xa4, 0x1c, 0x0a, 0x24, 0x6d, 0x5a, 0x55, 0xea, 0x47,
+	0xea, 0x24, 0x54, 0x2a, 0x07, 0xb3, 0xb1, 0xa7, 0xce, 0x52, 0x7b, 0xd7, 0xdd, 0x19, 0xb7, 0x44,
+	0x55, 0x85, 0xc4, 0x15, 0x0e, 0x48, 0x5c, 0x90, 0x2a, 0xee, 0x08, 0x21, 0x21, 0x01, 0x07, 0x0e,
+	0xfc, 0x01, 0x39, 0x20, 0x51, 0xc1, 0x01, 0xb8, 0x14, 0xd4, 0x72, 0xe7, 0x5f, 0x40, 0x3b, 0xf3,
+	0xee, 0x7a, 0xd7, 0xf6, 0xda, 0x6b, 0x3b, 0xa7, 0x64, 0x67, 0xe6, 0x79, 0xe6, 0x79, 0x3f, 0x66,
+	0xe6, 0x7d, 0x0d, 0xaf, 0x95, 0x0d, 0xd3, 0xca, 0xef, 0x1a, 0xa6, 0xa5, 0x53, 0x8b, 0x53, 0xa7,
+	0xe2, 0x98, 0x8c, 0xea, 0x0f, 0x97, 0xf4, 0x07, 0x55, 0xea, 0xec, 0x65, 0x2a, 0x8e, 0xcd, 0x6d,
+	0x32, 0xee, 0x2f, 0xca, 0xd4, 0x16, 0x65, 0x1e, 0x2e, 0xa9, 0xf3, 0x79, 0x9b, 0x95, 0x6d, 0xa6,
+	0xef, 0x18, 0x8c, 0x4a, 0x84, 0xfe, 0x70, 0x69, 0x87, 0x72, 0x63, 0x49, 0xaf, 0x18, 0x45, 0xd3,
+	0x32, 0xb8, 0x69, 0x5b, 0x92, 0x44, 0x1d, 0x2b, 0xda, 0x45, 0x5b, 0xfc, 0xab, 0xbb, 0xff, 0xe1,
+	0xe8, 0x54, 0xd1, 0xb6, 0x8b, 0x25, 0xaa, 0x1b, 0x15, 0x53, 0x37, 0x2c, 0xcb, 0xe6, 0x02, 0xc2,
+	0x70, 0x36, 0x15, 0xe4, 0xf7, 0x98, 0xf3, 0xb6, 0xe9, 0x71, 0x4e, 0xc8, 0xf9, 0x9c, 0xa4, 0x95,
+	0x1f, 0x38, 0x95, 0x8e, 0x32, 0xac, 0xf6, 0x25, 0x57, 0x6a, 0x63, 0x40, 0x6e, 0xbb, 0xd2, 0x37,
+	0x0c, 0xc7, 0x28, 0xb3, 0x2c, 0x7d, 0x50, 0xa5, 0x8c, 0x6b, 0x5b, 0x70, 0x3c, 0x34, 0xca, 0x2a,
+	0xb6, 0xc5, 0x28, 0xb9, 0x08, 0x89, 0x8a, 0x18, 0x49, 0x2a, 0x33, 0x4a, 0x7a, 0x64, 0x79, 0x3a,
+	0x13, 0xe1, 0x9b, 0x8c, 0x04, 0xae, 0x0d, 0xec, 0x3f, 0x9f, 0xee, 0xcb, 0x22, 0x48, 0xdb, 0x82,
+	0x93, 0x82, 0x75, 0xdd, 0x5f, 0xba, 0x6d, 0x15, 0x36, 0xaa, 0x4e, 0x7e, 0xd7, 0x60, 0xf4, 0x96,
+	0x53, 0xa0, 0x0e, 0xee, 0x4f, 0xe6, 0xe1, 0x58, 0x05, 0xc7, 0x73, 0xb6, 0x3b, 0x91, 0x33, 0x0b,
+	0x62, 0xcf, 0x81, 0xec, 0x68, 0x25, 0x08, 0xb8, 0x56, 0xd0, 0x3e, 0x51, 0x60, 0xae, 0x2d, 0x2d,
+	0x1a, 0xf0, 0x3e, 0x1c, 0x09, 0xf3, 0xa2, 0x21, 0x67, 0x22, 0x0d, 0x89, 0x26, 0x45, 0xe3, 0xfe,
+	0x17, 0xd2, 0xa3, 0xfd, 0xdb, 0x5e, 0x8d, 0xe7, 0x65, 0x72, 0x05, 0xa0, 0x96, 0x28, 0xa8, 0xe4,
+	0x64, 0x06, 0x03, 0xe9, 0x46, 0x3d, 0x23, 0xf3, 0x10, 0x63, 0x9f, 0xd9, 0x30, 0x8a, 0x14, 0xb1,
+	0xd9, 0x00, 0x92, 0x9c, 0x87, 0x61, 0x4f, 0x84, 0x93, 0xec, 0x9f, 0x51, 0xd2, 0xc3, 0x6b, 0xc9,
+	0x5f, 0x7f, 0x38, 0x3d, 0x86, 0x4c, 0xab, 0x85, 0x82, 0x43, 0x19, 0xdb, 0xe4, 0x8e, 0x69, 0x15,
+	0xb3, 0xb5, 0xa5, 0xe4, 0x32, 0x24, 0x18, 0x37, 0x78, 0x95, 0x25, 0x0f, 0xcd, 0x28, 0xe9, 0x23,
+	0xcb, 0x0b, 0xd1, 0xe1, 0x0c, 0xea, 0xdf, 0x14, 0x98, 0x2c, 0x62, 0xb5, 0xdf, 0x15, 0x48, 0xb7,
+	0xb7, 0x18, 0x03, 0xb0, 0x03, 0xa3, 0xe1, 0x00, 0xb8, 0xa9, 0x74, 0xa8, 0xb7, 0x08, 0x1c, 0x09,
+	0x45, 0x80, 0x91, 0xab, 0x21, 0xb7, 0xf6, 0x0b, 0xb7, 0xce, 0xb5, 0x75, 0xab, 0x14, 0x18, 0xf4,
+	0xab, 0xb6, 0x01, 0x29, 0x61, 0xd8, 0x75, 0x3b, 0x7f, 0x9f, 0x16, 0xb6, 0xad, 0xc2, 0xda, 0x1e,
+	0xfa, 0xd2, 0x8b, 0x60, 0x06, 0x06, 0xed, 0x47, 0x16, 0xa6, 0x51, 0x2b, 0xaf, 0xcb, 0x65, 0xda,
+	0x5d, 0x98, 0x8e, 0x64, 0x44, 0x0f, 0xad, 0x40, 0xc2, 0x28, 0xdb, 0x55, 0x8b, 0x63, 0x42, 0x4c,
+	0x84, 0x94, 0x7b, 0x9a, 0x2f, 0xd9, 0xa6, 0xe5, 0x9d, 0x2e, 0xb9, 0x5c, 0x7b, 0x17, 0x66, 0x03,
+	0xdc, 0xeb, 0x57, 0xb6, 0x6f, 0x5e, 0xbe, 0x6e, 0x73, 0xd6, 0xb3, 0xe6, 0x0f, 0xe0, 0xf5, 0x36,
+	0xbc, 0xa8, 0x7c, 0x15, 0x06, 0x4a, 0x36, 0xf7, 0x02, 0x3a, 0x17, 0x19, 0xd0, 0x30, 0x11, 0x5a,
+	0x21, 0xa0, 0xda, 0xcf, 0x0a, 0x4c, 0xd5, 0xe5, 0xd2, 0x75, 0x5a, 0x28, 0x52, 0xa7, 0x4b, 0xf1,
+	0x64, 0x12, 0x86, 0xef, 0x39, 0x76, 0x39, 0xc7, 0xcd, 0x32, 0x15, 0xa9, 0x30, 0x90, 0x3d, 0xec,
+	0x0e, 0x6c, 0x99, 0x65, 0x4a, 0xc6, 0x61, 0x88, 0xdb, 0x72, 0xea, 0x90, 0x98, 0x4a, 0x70, 0x5b,
+	0x4c, 0x84, 0x0f, 0xe6, 0x40, 0xb7, 0x07, 0x53, 0xfb, 0x5e, 0x81, 0x13, 0x11, 0xe6, 0xa0, 0xcf,
+	0xae, 0xc1, 0x10, 0xb5, 0xb8, 0x63, 0x52, 0xcf, 0x6d, 0xa7, 0xa2, 0xcf, 0x81, 0x70, 0x98, 0x80,
+	0xaf, 0x5b, 0xdc, 0xd9, 0x43, 0xc7, 0x79, 0xf8, 0x83, 0x4b, 0xfb, 0x09, 0x18, 0x17, 0xa2, 0xb7,
+	0x6c, 0x6e, 0x94, 0x64, 0xb0, 0xbc, 0x77, 0x61, 0x13, 0x92, 0x8d, 0x53, 0xbd, 0x26, 0xee, 0x24,
+	0x4c, 0xd4, 0x48, 0xb7, 0xad, 0x52, 0x68, 0xc7, 0x6d, 0x50, 0x9b, 0x4d, 0xf6, 0xba, 0xa7, 0x11,
+	0xb4, 0x71, 0xb3, 0x5a, 0xa9, 0x94, 0xf6, 0x0e, 0xf8, 0x56, 0xd6, 0xf6, 0x15, 0x48, 0x36, 0xee,
+	0x81, 0xc2, 0xf3, 0x90, 0x60, 0x62, 0x04, 0xc3, 0xde, 0x42, 0xf8, 0xa2, 0x2b, 0xfc, 0xeb, 0xbf,
+	0xa6, 0xd3, 0x45, 0x93, 0xef, 0x56, 0x77, 0x32, 0x79, 0xbb, 0x8c, 0x8f, 0x3d, 0xfe, 0x39, 0xcd,
+	0x0a, 0xf7, 0x75, 0xbe, 0x57, 0xa1, 0x4c, 0x00, 0x58, 0x16, 0xa9, 0x0f, 0x2e, 0x23, 0x16, 0x60,
+	0x4c, 0x58, 0x22, 0x8d, 0xb8, 0x75, 0xcf, 0x73, 0xd5, 0x18, 0x0c, 0x16, 0xa8, 0x65, 0x97, 0xe5,
+	0x69, 0xcc, 0xca, 0x0f, 0x6d, 0x03, 0xfe, 0x5f, 0xb7, 0xba, 0xd7, 0x68, 0x8d, 0x23, 0xe3, 0x9d,
+	0x5d, 0x93, 0xd3, 0x92, 0xc9, 0xb8, 0x97, 0x1d, 0x1f, 0xc1, 0x2b, 0xf5, 0x13, 0xb8, 0xd7, 0x14,
+	0x0c, 0x1b, 0xf2, 0x42, 0xc0, 0xa3, 0x35, 0x9c, 0xad, 0x0d, 0x90, 0xab, 0xb5, 0x63, 0xd7, 0xdf,
+	0xe6, 0xb6, 0xf2, 0xa9, 0x9b, 0x1d, 0x3a, 0xed, 0x06, 0xe6, 0x91, 0xbf, 0xca, 0xcf, 0x5c, 0xb2,
+	0x0c, 0x43, 0xb8, 0x61, 0xdb, 0xcb, 0xca, 0x5b, 0xa8, 0x3d, 0xf5, 0x72, 0x26, 0xc4, 0x87, 0x26,
+	0x25, 0xeb, 0x08, 0x7d, 0x18, 0x99, 0x81, 0x91, 0x47, 0x35, 0x80, 0x88, 0xf4, 0xe1, 0x6c, 0x70,
+	0x88, 0x5c, 0x82, 0x41, 0x57, 0xf2, 0x9e, 0xb8, 0xe8, 0x3a, 0x36, 0x57, 0x62, 0xb5, 0x1b, 0x30,
+	0x29, 0x5f, 0x02, 0xd3, 0xba, 0x4f, 0x0b, 0xab, 0x9e, 0x37, 0xbb, 0x7d, 0x58, 0x1e, 0xc1, 0x54,
+	0x73, 0x3a, 0xb4, 0xf7, 0x0e, 0x1c, 0x2d, 0x89, 0xa9, 0x5c, 0x38, 0x92, 0xee, 0x71, 0x8c, 0x7c,
+	0x5b, 0x82, 0x5c, 0xa8, 0x7e, 0xb4, 0x14, 0xde, 0x40, 0x4b, 0x62, 0xd6, 0xac, 0x5b, 0x7c, 0xd3,
+	0x2c, 0x5a, 0xb5, 0x8a, 0x4c, 0x2b, 0xc0, 0x78, 0xc3, 0x8c, 0x7f, 0x53, 0x8f, 0x50, 0x8b, 0xe7,
+	0x98, 0x1c, 0x46, 0x21, 0x5a, 0xab, 0xaa, 0x45, 0x32, 0xa0, 0x08, 0xa0, 0x3e, 0xa5, 0xb6, 0xd9,
+	0xf0, 0x2a, 0xac, 0xe6, 0xf3, 0x6e, 0xa2, 0xf7, 0x92, 0x3a, 0x15, 0x48, 0x45, 0x91, 0xa2, 0x05,
+	0x37, 0x61, 0xc8, 0x90, 0x43, 0x78, 0xfe, 0x32, 0x71, 0x6a, 0x2e, 0x46, 0x1d, 0x24, 0xf2, 0x72,
+	0x1f, 0x49, 0xb4, 0x13, 0x30, 0x19, 0xb8, 0xdf, 0x2a, 0xd4, 0xe2, 0xe2, 0x89, 0xf2, 0x7c, 0x79,
+	0x07, 0xa6, 0x9a, 0x4f, 0xf7, 0x7a, 0x1b, 0x6c, 0x63, 0x11, 0x55, 0xe3, 0x6c, 0xa8, 0x71, 0xba,
+	0x71, 0xe0, 0x7b, 0x30, 0x13, 0x4d, 0xdb, 0xa3, 0xe6, 0xe5, 0xe7, 0xc7, 0x61, 0x50, 0xb0, 0x93,
+	0x4f, 0x15, 0x48, 0xc8, 0xee, 0x88, 0xbc, 0x11, 0xe9, 0xff, 0xc6, 0x96, 0x4c, 0x5d, 0x88, 0xb7,
+	0x58, 0x0a, 0xd5, 0xe6, 0x3e, 0xfe, 0xed, 0x9f, 0xcf, 0xfb, 0x5f, 0x25, 0xd3, 0x7a, 0x54, 0x27,
+	0x28, 0x7b, 0x32, 0xf2, 0xa7, 0x02, 0x6a, 0x74, 0x85, 0x4d, 0xde, 0x6e, 0xbd, 0x6b, 0xdb, 0x4e,
+	0x4e, 0x7d, 0xa7, 0x7b, 0x02, 0x34, 0xe5, 0x82, 0x30, 0x65, 0x99, 0x2c, 0x46, 0x9b, 0x62, 0x33,
+	0xfd, 0x71, 0x43, 0xbf, 0xf8, 0x84, 0xec, 0x2b, 0x30, 0x19, 0xbd, 0x01, 0x23, 0x5d, 0x6b, 0xf3,
+	0x83, 0xb2, 0xda, 0x03, 0x03, 0x9a, 0x37, 0x2b, 0xcc, 0x4b, 0x91, 0xa9, 0x56, 0xe6, 0x91, 0x1f,
+	0x15, 0x20, 0x8d, 0x4d, 0x03, 0x59, 0x69, 0xbd, 0x7f, 0x64, 0xe3, 0xa2, 0x5e, 0xe8, 0x1c, 0x88,
+	0x7a, 0x75, 0xa1, 0xf7, 0x14, 0x99, 0x8b, 0xd4, 0x2b, 0x6b, 0x34, 0xfd, 0xb1, 0xb8, 0xe5, 0x9f,
+	0x90, 0x5f, 0x14, 0x48, 0x46, 0xf5, 0x0e, 0xe4, 0x62, 0x1c, 0x1d, 0x91, 0xbd, 0x8c, 0xfa, 0x56,
+	0xb7, 0x70, 0x34, 0xe6, 0xac, 0x30, 0x26, 0x43, 0x16, 0x62, 0x1a, 0xa3, 0xbb, 0x5d, 0x0a, 0xf9,
+	0x4e, 0x81, 0xa3, 0xf5, 0x15, 0x3d, 0x39, 0x17, 0x37, 0x15, 0x42, 0x0d, 0x8d, 0x7a, 0xbe, 0x53,
+	0x58, 0xfc, 0x30, 0x08, 0x80, 0x1f, 0x86, 0xa7, 0x0a, 0x8c, 0x04, 0xca, 0x76, 0xb2, 0xd8, 0x7a,
+	0xe3, 0xc6, 0xe2, 0x5f, 0x5d, 0xea, 0x00, 0x11, 0xfb, 0x1a, 0x92, 0xfe, 0x25, 0x5f, 0x28, 0x30,
+	0xec, 0x97, 0x1e, 0x24, 0xd3, 0x7a, 0xa7, 0xfa, 0x32, 0x50, 0xd5, 0x63, 0xaf, 0x47, 0x5d, 0xf3,
+	0x42, 0xd7, 0x2c, 0xd1, 0x22, 0x75, 0xf9, 0xc5, 0x13, 0xf9, 0x4a, 0x81, 0x91, 0x40, 0x39, 0xd6,
+	0xce, 0x71, 0x8d, 0x95, 0xa0, 0xba, 0xd4, 0x01, 0x22, 0x76, 0x62, 0xfa, 0x02, 0xf5, 0xc7, 0xf8,
+	0x82, 0x3d, 0x21, 0xdf, 0x2a, 0x30, 0x5a, 0x57, 0x4d, 0x91, 0xb3, 0x6d, 0x8e, 0x48, 0xd3, 0x5a,
+	0x4e, 0x3d, 0xd7, 0x21, 0x2a, 0x7e, 0x56, 0x0a, 0xa4, 0x9f, 0x95, 0x5f, 0x2a, 0x00, 0xb5, 0x62,
+	0x8b, 0xe8, 0x6d, 0x4f, 0x43, 0xb8, 0x60, 0x53, 0x17, 0xe3, 0x03, 0x50, 0xe2, 0x82, 0x90, 0x78,
+	0x92, 0xcc, 0xea, 0x2d, 0x7e, 0x23, 0xf5, 0xca, 0x3c, 0xf7, 0xde, 0x3d, 0xd6, 0x50, 0x51, 0x91,
+	0xd8, 0x87, 0x36, 0x5c, 0xd7, 0xa9, 0x2b, 0x1d, 0xe3, 0x50, 0xf4, 0xb2, 0x10, 0xbd, 0x40, 0xe6,
+	0x23, 0x45, 0x63, 0x51, 0x16, 0x48, 0x86, 0x6f, 0x14, 0x18, 0xad, 0xab, 0xbd, 0xda, 0x25, 0x43,
+	0xf3, 0x4a, 0x4e, 0x3d, 0xd7, 0x21, 0x2a, 0xb6, 0xa7, 0xb9, 0x8b, 0xcc, 0x31, 0x17, 0x4a, 0x7e,
+	0x52, 0xe0, 0x78, 0x93, 0xd2, 0x8b, 0xb4, 0x79, 0xa9, 0xa2, 0x8b, 0x40, 0xf5, 0xcd, 0x2e, 0x90,
+	0x28, 0x7d, 0x51, 0x48, 0x9f, 0x27, 0xe9, 0x48, 0xe9, 0x42, 0x74, 0xcd, 0xdb, 0x6b, 0xb7, 0xf7,
+	0x5f, 0xa4, 0x94, 0x67, 0x2f, 0x52, 0xca, 0xdf, 0x2f, 0x52, 0xca, 0x67, 0x2f, 0x53, 0x7d, 0xcf,
+	0x5e, 0xa6, 0xfa, 0xfe, 0x78, 0x99, 0xea, 0xbb, 0xbb, 0x12, 0xe8, 0xdb, 0xab, 0x96, 0x79, 0xcf,
+	0xcc, 0x8b, 0xa6, 0xfa, 0xb4, 0xfb, 0x5d, 0x63, 0xff, 0x30, 0xc8, 0x2f, 0x9a, 0xf9, 0x9d, 0x84,
+	0xf8, 0x85, 0xfe, 0xcc, 0x7f, 0x03, 0x00, 0x55, 0xff, 0x1e, 0xc4, 0xa6, 0x18, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1354,12 +1791,20 @@ type QueryClient interface {
 	EnterpriseUndPurchaseOrders(ctx context.Context, in *QueryEnterpriseUndPurchaseOrdersRequest, opts ...grpc.CallOption) (*QueryEnterpriseUndPurchaseOrdersResponse, error)
 	// LockedUndByAddress queries an account address for their locked FUND
 	LockedUndByAddress(ctx context.Context, in *QueryLockedUndByAddressRequest, opts ...grpc.CallOption) (*QueryLockedUndByAddressResponse, error)
+	// LockedEFUNDLotsByAddress queries an account address for the lots making up their locked eFUND
+	LockedEFUNDLotsByAddress(ctx context.Context, in *QueryLockedEFUNDLotsByAddressRequest, opts ...grpc.CallOption) (*QueryLockedEFUNDLotsByAddressResponse, error)
+	// EnterpriseLedger queries an account's eFUND ledger, oldest first, optionally filtered by time range
+	EnterpriseLedger(ctx context.Context, in *QueryEnterpriseLedgerRequest, opts ...grpc.CallOption) (*QueryEnterpriseLedgerResponse, error)
 	// TotalLocked queries the total locked FUND
 	TotalLocked(ctx context.Context, in *QueryTotalLockedRequest, opts ...grpc.CallOption) (*QueryTotalLockedResponse, error)
 	// Whitelist queries whitelisted addresses authorised to raise new purchase orders
 	Whitelist(ctx context.Context, in *QueryWhitelistRequest, opts ...grpc.CallOption) (*QueryWhitelistResponse, error)
 	// Whitelisted queries whether or not the given address is authorised to raise new purchase orders
 	Whitelisted(ctx context.Context, in *QueryWhitelistedRequest, opts ...grpc.CallOption) (*QueryWhitelistedResponse, error)
+	// LinkedAddresses queries the addresses the given address can transfer locked eFUND to
+	LinkedAddresses(ctx context.Context, in *QueryLinkedAddressesRequest, opts ...grpc.CallOption) (*QueryLinkedAddressesResponse, error)
+	// EntSigners queries the addresses authorised to make decisions on raised purchase orders
+	EntSigners(ctx context.Context, in *QueryEntSignersRequest, opts ...grpc.CallOption) (*QueryEntSignersResponse, error)
 	// EnterpriseAccount queries an account address for their locked FUND and other data
 	EnterpriseAccount(ctx context.Context, in *QueryEnterpriseAccountRequest, opts ...grpc.CallOption) (*QueryEnterpriseAccountResponse, error)
 	// TotalSpentEFUND queries the total eFUND usage to date - i.e. the amount used to pay fees
@@ -1412,6 +1857,24 @@ func (c *queryClient) LockedUndByAddress(ctx context.Context, in *QueryLockedUnd
 	return out, nil
 }
 
+func (c *queryClient) LockedEFUNDLotsByAddress(ctx context.Context, in *QueryLockedEFUNDLotsByAddressRequest, opts ...grpc.CallOption) (*QueryLockedEFUNDLotsByAddressResponse, error) {
+	out := new(QueryLockedEFUNDLotsByAddressResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Query/LockedEFUNDLotsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EnterpriseLedger(ctx context.Context, in *QueryEnterpriseLedgerRequest, opts ...grpc.CallOption) (*QueryEnterpriseLedgerResponse, error) {
+	out := new(QueryEnterpriseLedgerResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Query/EnterpriseLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TotalLocked(ctx context.Context, in *QueryTotalLockedRequest, opts ...grpc.CallOption) (*QueryTotalLockedResponse, error) {
 	out := new(QueryTotalLockedResponse)
 	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Query/TotalLocked", in, out, opts...)
@@ -1439,6 +1902,24 @@ func (c *queryClient) Whitelisted(ctx context.Context, in *QueryWhitelistedReque
 	return out, nil
 }
 
+func (c *queryClient) LinkedAddresses(ctx context.Context, in *QueryLinkedAddressesRequest, opts ...grpc.CallOption) (*QueryLinkedAddressesResponse, error) {
+	out := new(QueryLinkedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Query/LinkedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EntSigners(ctx context.Context, in *QueryEntSignersRequest, opts ...grpc.CallOption) (*QueryEntSignersResponse, error) {
+	out := new(QueryEntSignersResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Query/EntSigners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) EnterpriseAccount(ctx context.Context, in *QueryEnterpriseAccountRequest, opts ...grpc.CallOption) (*QueryEnterpriseAccountResponse, error) {
 	out := new(QueryEnterpriseAccountResponse)
 	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Query/EnterpriseAccount", in, out, opts...)
@@ -1476,12 +1957,20 @@ type QueryServer interface {
 	EnterpriseUndPurchaseOrders(context.Context, *QueryEnterpriseUndPurchaseOrdersRequest) (*QueryEnterpriseUndPurchaseOrdersResponse, error)
 	// LockedUndByAddress queries an account address for their locked FUND
 	LockedUndByAddress(context.Context, *QueryLockedUndByAddressRequest) (*QueryLockedUndByAddressResponse, error)
+	// LockedEFUNDLotsByAddress queries an account address for the lots making up their locked eFUND
+	LockedEFUNDLotsByAddress(context.Context, *QueryLockedEFUNDLotsByAddressRequest) (*QueryLockedEFUNDLotsByAddressResponse, error)
+	// EnterpriseLedger queries an account's eFUND ledger, oldest first, optionally filtered by time range
+	EnterpriseLedger(context.Context, *QueryEnterpriseLedgerRequest) (*QueryEnterpriseLedgerResponse, error)
 	// TotalLocked queries the total locked FUND
 	TotalLocked(context.Context, *QueryTotalLockedRequest) (*QueryTotalLockedResponse, error)
 	// Whitelist queries whitelisted addresses authorised to raise new purchase orders
 	Whitelist(context.Context, *QueryWhitelistRequest) (*QueryWhitelistResponse, error)
 	// Whitelisted queries whether or not the given address is authorised to raise new purchase orders
 	Whitelisted(context.Context, *QueryWhitelistedRequest) (*QueryWhitelistedResponse, error)
+	// LinkedAddresses queries the addresses the given address can transfer locked eFUND to
+	LinkedAddresses(context.Context, *QueryLinkedAddressesRequest) (*QueryLinkedAddressesResponse, error)
+	// EntSigners queries the addresses authorised to make decisions on raised purchase orders
+	EntSigners(context.Context, *QueryEntSignersRequest) (*QueryEntSignersResponse, error)
 	// EnterpriseAccount queries an account address for their locked FUND and other data
 	EnterpriseAccount(context.Context, *QueryEnterpriseAccountRequest) (*QueryEnterpriseAccountResponse, error)
 	// TotalSpentEFUND queries the total eFUND usage to date - i.e. the amount used to pay fees
@@ -1506,6 +1995,12 @@ func (*UnimplementedQueryServer) EnterpriseUndPurchaseOrders(ctx context.Context
 func (*UnimplementedQueryServer) LockedUndByAddress(ctx context.Context, req *QueryLockedUndByAddressRequest) (*QueryLockedUndByAddressResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockedUndByAddress not implemented")
 }
+func (*UnimplementedQueryServer) LockedEFUNDLotsByAddress(ctx context.Context, req *QueryLockedEFUNDLotsByAddressRequest) (*QueryLockedEFUNDLotsByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockedEFUNDLotsByAddress not implemented")
+}
+func (*UnimplementedQueryServer) EnterpriseLedger(ctx context.Context, req *QueryEnterpriseLedgerRequest) (*QueryEnterpriseLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnterpriseLedger not implemented")
+}
 func (*UnimplementedQueryServer) TotalLocked(ctx context.Context, req *QueryTotalLockedRequest) (*QueryTotalLockedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalLocked not implemented")
 }
@@ -1515,6 +2010,12 @@ func (*UnimplementedQueryServer) Whitelist(ctx context.Context, req *QueryWhitel
 func (*UnimplementedQueryServer) Whitelisted(ctx context.Context, req *QueryWhitelistedRequest) (*QueryWhitelistedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Whitelisted not implemented")
 }
+func (*UnimplementedQueryServer) LinkedAddresses(ctx context.Context, req *QueryLinkedAddressesRequest) (*QueryLinkedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkedAddresses not implemented")
+}
+func (*UnimplementedQueryServer) EntSigners(ctx context.Context, req *QueryEntSignersRequest) (*QueryEntSignersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EntSigners not implemented")
+}
 func (*UnimplementedQueryServer) EnterpriseAccount(ctx context.Context, req *QueryEnterpriseAccountRequest) (*QueryEnterpriseAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EnterpriseAccount not implemented")
 }
@@ -1601,6 +2102,42 @@ func _Query_LockedUndByAddress_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LockedEFUNDLotsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockedEFUNDLotsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockedEFUNDLotsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.enterprise.v1.Query/LockedEFUNDLotsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockedEFUNDLotsByAddress(ctx, req.(*QueryLockedEFUNDLotsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EnterpriseLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEnterpriseLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EnterpriseLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.enterprise.v1.Query/EnterpriseLedger",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EnterpriseLedger(ctx, req.(*QueryEnterpriseLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TotalLocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTotalLockedRequest)
 	if err := dec(in); err != nil {
@@ -1655,6 +2192,42 @@ func _Query_Whitelisted_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LinkedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLinkedAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LinkedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.enterprise.v1.Query/LinkedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LinkedAddresses(ctx, req.(*QueryLinkedAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EntSigners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEntSignersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EntSigners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.enterprise.v1.Query/EntSigners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EntSigners(ctx, req.(*QueryEntSignersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_EnterpriseAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryEnterpriseAccountRequest)
 	if err := dec(in); err != nil {
@@ -1730,8 +2303,16 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Query_LockedUndByAddress_Handler,
 		},
 		{
-			MethodName: "TotalLocked",
-			Handler:    _Query_TotalLocked_Handler,
+			MethodName: "LockedEFUNDLotsByAddress",
+			Handler:    _Query_LockedEFUNDLotsByAddress_Handler,
+		},
+		{
+			MethodName: "EnterpriseLedger",
+			Handler:    _Query_EnterpriseLedger_Handler,
+		},
+		{
+			MethodName: "TotalLocked",
+			Handler:    _Query_TotalLocked_Handler,
 		},
 		{
 			MethodName: "Whitelist",
@@ -1741,6 +2322,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Whitelisted",
 			Handler:    _Query_Whitelisted_Handler,
 		},
+		{
+			MethodName: "LinkedAddresses",
+			Handler:    _Query_LinkedAddresses_Handler,
+		},
+		{
+			MethodName: "EntSigners",
+			Handler:    _Query_EntSigners_Handler,
+		},
 		{
 			MethodName: "EnterpriseAccount",
 			Handler:    _Query_EnterpriseAccount_Handler,
@@ -2034,6 +2623,174 @@ func (m *QueryLockedUndByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryLockedEFUNDLotsByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedEFUNDLotsByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedEFUNDLotsByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLockedEFUNDLotsByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLockedEFUNDLotsByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedEFUNDLotsByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Lots) > 0 {
+		for iNdEx := len(m.Lots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Lots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEnterpriseLedgerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEnterpriseLedgerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEnterpriseLedgerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.ToTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEnterpriseLedgerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEnterpriseLedgerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEnterpriseLedgerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTotalLockedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2336,6 +3093,20 @@ func (m *QueryWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Addresses) > 0 {
 		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Addresses[iNdEx])
@@ -2398,6 +3169,16 @@ func (m *QueryWhitelistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Whitelisted {
 		i--
 		if m.Whitelisted {
@@ -2418,7 +3199,7 @@ func (m *QueryWhitelistedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryEnterpriseAccountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryLinkedAddressesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2428,27 +3209,27 @@ func (m *QueryEnterpriseAccountRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryEnterpriseAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLinkedAddressesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEnterpriseAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLinkedAddressesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryEnterpriseAccountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryLinkedAddressesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2458,30 +3239,34 @@ func (m *QueryEnterpriseAccountResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryEnterpriseAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryLinkedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEnterpriseAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryLinkedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.LinkedAddresses) > 0 {
+		for iNdEx := len(m.LinkedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LinkedAddresses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalSpentEFUNDRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryEntSignersRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2491,12 +3276,12 @@ func (m *QueryTotalSpentEFUNDRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalSpentEFUNDRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryEntSignersRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalSpentEFUNDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEntSignersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2504,7 +3289,7 @@ func (m *QueryTotalSpentEFUNDRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTotalSpentEFUNDResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryEntSignersResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2514,30 +3299,34 @@ func (m *QueryTotalSpentEFUNDResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryTotalSpentEFUNDResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryEntSignersResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTotalSpentEFUNDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEntSignersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.EntSigners) > 0 {
+		for iNdEx := len(m.EntSigners) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EntSigners[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpentEFUNDByAddressRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryEnterpriseAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2547,12 +3336,12 @@ func (m *QuerySpentEFUNDByAddressRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySpentEFUNDByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryEnterpriseAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySpentEFUNDByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEnterpriseAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2567,7 +3356,7 @@ func (m *QuerySpentEFUNDByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpentEFUNDByAddressResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryEnterpriseAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2577,18 +3366,18 @@ func (m *QuerySpentEFUNDByAddressResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QuerySpentEFUNDByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryEnterpriseAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySpentEFUNDByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryEnterpriseAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Account.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -2600,16 +3389,135 @@ func (m *QuerySpentEFUNDByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryTotalSpentEFUNDRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSpentEFUNDRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSpentEFUNDRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalSpentEFUNDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalSpentEFUNDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalSpentEFUNDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpentEFUNDByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpentEFUNDByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpentEFUNDByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySpentEFUNDByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpentEFUNDByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpentEFUNDByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
@@ -2717,6 +3625,76 @@ func (m *QueryLockedUndByAddressResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryLockedEFUNDLotsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLockedEFUNDLotsByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lots) > 0 {
+		for _, e := range m.Lots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEnterpriseLedgerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromTime != 0 {
+		n += 1 + sovQuery(uint64(m.FromTime))
+	}
+	if m.ToTime != 0 {
+		n += 1 + sovQuery(uint64(m.ToTime))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEnterpriseLedgerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTotalLockedRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2834,6 +3812,12 @@ func (m *QueryWhitelistResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2863,6 +3847,60 @@ func (m *QueryWhitelistedResponse) Size() (n int) {
 	if m.Whitelisted {
 		n += 2
 	}
+	l = m.Entry.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLinkedAddressesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLinkedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LinkedAddresses) > 0 {
+		for _, e := range m.LinkedAddresses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEntSignersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEntSignersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EntSigners) > 0 {
+		for _, e := range m.EntSigners {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3647,7 +4685,7 @@ func (m *QueryLockedUndByAddressResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalLockedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLockedEFUNDLotsByAddressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3670,20 +4708,52 @@ func (m *QueryTotalLockedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalLockedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockedEFUNDLotsByAddressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalLockedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockedEFUNDLotsByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -3697,7 +4767,7 @@ func (m *QueryTotalLockedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalLockedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLockedEFUNDLotsByAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3720,15 +4790,15 @@ func (m *QueryTotalLockedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalLockedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLockedEFUNDLotsByAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalLockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLockedEFUNDLotsByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3755,7 +4825,8 @@ func (m *QueryTotalLockedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Lots = append(m.Lots, LockedEFUNDLot{})
+			if err := m.Lots[len(m.Lots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3780,57 +4851,7 @@ func (m *QueryTotalLockedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalUnlockedRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalUnlockedRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalUnlockedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalUnlockedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEnterpriseLedgerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3853,17 +4874,17 @@ func (m *QueryTotalUnlockedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalUnlockedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEnterpriseLedgerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalUnlockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEnterpriseLedgerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3873,76 +4894,63 @@ func (m *QueryTotalUnlockedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTime", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.FromTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTime", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ToTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSupplyRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3999,7 +5007,7 @@ func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEnterpriseLedgerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4022,15 +5030,15 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEnterpriseLedgerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEnterpriseLedgerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4057,8 +5065,8 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Supply = append(m.Supply, types.Coin{})
-			if err := m.Supply[len(m.Supply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, EFUNDLedgerEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4119,7 +5127,7 @@ func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupplyOfRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalLockedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4142,44 +5150,12 @@ func (m *QuerySupplyOfRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyOfRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalLockedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyOfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalLockedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4201,7 +5177,7 @@ func (m *QuerySupplyOfRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupplyOfResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalLockedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4224,10 +5200,10 @@ func (m *QuerySupplyOfResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyOfResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalLockedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyOfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalLockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4284,7 +5260,7 @@ func (m *QuerySupplyOfResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalUnlockedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4307,10 +5283,10 @@ func (m *QueryWhitelistRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalUnlockedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalUnlockedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4334,7 +5310,7 @@ func (m *QueryWhitelistRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalUnlockedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4357,17 +5333,17 @@ func (m *QueryWhitelistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalUnlockedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalUnlockedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4377,23 +5353,24 @@ func (m *QueryWhitelistResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4416,7 +5393,7 @@ func (m *QueryWhitelistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalSupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4439,15 +5416,634 @@ func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalSupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supply = append(m.Supply, types.Coin{})
+			if err := m.Supply[len(m.Supply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyOfRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyOfRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyOfRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyOfResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyOfResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyOfResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, WhitelistEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWhitelistedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWhitelistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4477,6 +6073,59 @@ func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelisted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Whitelisted = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4498,7 +6147,7 @@ func (m *QueryWhitelistedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLinkedAddressesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4521,15 +6170,15 @@ func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryWhitelistedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLinkedAddressesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryWhitelistedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLinkedAddressesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4557,13 +6206,63 @@ func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Whitelisted", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var v int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLinkedAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLinkedAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLinkedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkedAddresses", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4573,12 +6272,160 @@ func (m *QueryWhitelistedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Whitelisted = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkedAddresses = append(m.LinkedAddresses, LinkedAddress{})
+			if err := m.LinkedAddresses[len(m.LinkedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntSignersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntSignersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntSignersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEntSignersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEntSignersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEntSignersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EntSigners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EntSigners = append(m.EntSigners, EntSigner{})
+			if err := m.EntSigners[len(m.EntSigners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
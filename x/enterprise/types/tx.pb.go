@@ -207,6 +207,12 @@ type MsgWhitelistAddress struct {
 	Signer string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 	// action is the action being executed, and is either add or remove.
 	Action WhitelistAction `protobuf:"varint,3,opt,name=action,proto3,enum=mainchain.enterprise.v1.WhitelistAction" json:"action,omitempty"`
+	// expiry_time is the optional unix time from which the address can no longer raise purchase orders. Add only
+	ExpiryTime uint64 `protobuf:"varint,4,opt,name=expiry_time,json=expiryTime,proto3" json:"expiry_time,omitempty"`
+	// max_purchasable is the optional maximum cumulative amount of eFUND the address can purchase. Add only
+	MaxPurchasable *types.Coin `protobuf:"bytes,5,opt,name=max_purchasable,json=maxPurchasable,proto3" json:"max_purchasable,omitempty"`
+	// tier is an optional label for the whitelist entry. Add only
+	Tier string `protobuf:"bytes,6,opt,name=tier,proto3" json:"tier,omitempty"`
 }
 
 func (m *MsgWhitelistAddress) Reset()         { *m = MsgWhitelistAddress{} }
@@ -279,30 +285,26 @@ func (m *MsgWhitelistAddressResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWhitelistAddressResponse proto.InternalMessageInfo
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParams struct {
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/staking parameters to update.
-	//
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgWithdrawUndPurchaseOrder represents a message to withdraw a raised purchase order
+type MsgWithdrawUndPurchaseOrder struct {
+	// purchaser is the address of the account that raised the purchase order
+	Purchaser string `protobuf:"bytes,1,opt,name=purchaser,proto3" json:"purchaser,omitempty"`
+	// purchase_order_id is the ID of the purchase order being withdrawn
+	PurchaseOrderId uint64 `protobuf:"varint,2,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+func (m *MsgWithdrawUndPurchaseOrder) Reset()         { *m = MsgWithdrawUndPurchaseOrder{} }
+func (m *MsgWithdrawUndPurchaseOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawUndPurchaseOrder) ProtoMessage()    {}
+func (*MsgWithdrawUndPurchaseOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_91499ee206e3f069, []int{6}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdrawUndPurchaseOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdrawUndPurchaseOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdrawUndPurchaseOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -312,51 +314,77 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgWithdrawUndPurchaseOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawUndPurchaseOrder.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgWithdrawUndPurchaseOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgWithdrawUndPurchaseOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawUndPurchaseOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgWithdrawUndPurchaseOrder proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
-	if m != nil {
-		return m.Authority
-	}
-	return ""
+// MsgWithdrawUndPurchaseOrderResponse defines the Msg/WithdrawUndPurchaseOrder response type.
+type MsgWithdrawUndPurchaseOrderResponse struct {
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
-	if m != nil {
-		return m.Params
+func (m *MsgWithdrawUndPurchaseOrderResponse) Reset()         { *m = MsgWithdrawUndPurchaseOrderResponse{} }
+func (m *MsgWithdrawUndPurchaseOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawUndPurchaseOrderResponse) ProtoMessage()    {}
+func (*MsgWithdrawUndPurchaseOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91499ee206e3f069, []int{7}
+}
+func (m *MsgWithdrawUndPurchaseOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawUndPurchaseOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawUndPurchaseOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return Params{}
+}
+func (m *MsgWithdrawUndPurchaseOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawUndPurchaseOrderResponse.Merge(m, src)
+}
+func (m *MsgWithdrawUndPurchaseOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawUndPurchaseOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawUndPurchaseOrderResponse.DiscardUnknown(m)
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParamsResponse struct {
+var xxx_messageInfo_MsgWithdrawUndPurchaseOrderResponse proto.InternalMessageInfo
+
+// MsgAmendUndPurchaseOrder represents a message to amend the amount of a raised purchase order
+type MsgAmendUndPurchaseOrder struct {
+	// purchaser is the address of the account that raised the purchase order
+	Purchaser string `protobuf:"bytes,1,opt,name=purchaser,proto3" json:"purchaser,omitempty"`
+	// purchase_order_id is the ID of the purchase order being amended
+	PurchaseOrderId uint64 `protobuf:"varint,2,opt,name=purchase_order_id,json=purchaseOrderId,proto3" json:"purchase_order_id,omitempty"`
+	// amount is the new amount of eFUND in nund
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91499ee206e3f069, []int{7}
+func (m *MsgAmendUndPurchaseOrder) Reset()         { *m = MsgAmendUndPurchaseOrder{} }
+func (m *MsgAmendUndPurchaseOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendUndPurchaseOrder) ProtoMessage()    {}
+func (*MsgAmendUndPurchaseOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91499ee206e3f069, []int{8}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgAmendUndPurchaseOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAmendUndPurchaseOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAmendUndPurchaseOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -366,664 +394,3182 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgAmendUndPurchaseOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendUndPurchaseOrder.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgAmendUndPurchaseOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgAmendUndPurchaseOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendUndPurchaseOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgAmendUndPurchaseOrder proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUndPurchaseOrder)(nil), "mainchain.enterprise.v1.MsgUndPurchaseOrder")
-	proto.RegisterType((*MsgUndPurchaseOrderResponse)(nil), "mainchain.enterprise.v1.MsgUndPurchaseOrderResponse")
-	proto.RegisterType((*MsgProcessUndPurchaseOrder)(nil), "mainchain.enterprise.v1.MsgProcessUndPurchaseOrder")
-	proto.RegisterType((*MsgProcessUndPurchaseOrderResponse)(nil), "mainchain.enterprise.v1.MsgProcessUndPurchaseOrderResponse")
-	proto.RegisterType((*MsgWhitelistAddress)(nil), "mainchain.enterprise.v1.MsgWhitelistAddress")
-	proto.RegisterType((*MsgWhitelistAddressResponse)(nil), "mainchain.enterprise.v1.MsgWhitelistAddressResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "mainchain.enterprise.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "mainchain.enterprise.v1.MsgUpdateParamsResponse")
+// MsgAmendUndPurchaseOrderResponse defines the Msg/AmendUndPurchaseOrder response type.
+type MsgAmendUndPurchaseOrderResponse struct {
 }
 
-func init() { proto.RegisterFile("mainchain/enterprise/v1/tx.proto", fileDescriptor_91499ee206e3f069) }
-
-var fileDescriptor_91499ee206e3f069 = []byte{
-	// 701 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xc1, 0x6e, 0xd3, 0x4a,
-	0x14, 0x8d, 0x9b, 0xbe, 0xbc, 0xd7, 0x79, 0x4f, 0xaf, 0xad, 0xa9, 0x94, 0xd4, 0x08, 0xa7, 0xb2,
-	0x00, 0x45, 0x11, 0xb5, 0xdb, 0x14, 0xb5, 0x6a, 0x10, 0x12, 0x84, 0x0d, 0x5d, 0x54, 0x14, 0x57,
-	0x08, 0x89, 0x4d, 0x35, 0xb1, 0x07, 0x67, 0x10, 0x9e, 0xb1, 0x66, 0x26, 0x51, 0xbb, 0x43, 0xb0,
-	0x41, 0xb0, 0xe1, 0x13, 0xba, 0x64, 0xd9, 0x05, 0x3f, 0xc0, 0xae, 0xcb, 0x8a, 0x15, 0x2b, 0x84,
-	0xda, 0x45, 0xf9, 0x01, 0x58, 0xa3, 0xb1, 0x27, 0x8e, 0x9b, 0xd6, 0x69, 0xcb, 0x26, 0x9a, 0xb9,
-	0xf7, 0xdc, 0x3b, 0xf7, 0x9e, 0x9c, 0x93, 0x80, 0xb9, 0x10, 0x62, 0xe2, 0x75, 0x20, 0x26, 0x0e,
-	0x22, 0x02, 0xb1, 0x88, 0x61, 0x8e, 0x9c, 0xde, 0xa2, 0x23, 0xb6, 0xed, 0x88, 0x51, 0x41, 0xf5,
-	0x72, 0x8a, 0xb0, 0x07, 0x08, 0xbb, 0xb7, 0x68, 0x98, 0x1e, 0xe5, 0x21, 0xe5, 0x4e, 0x1b, 0xc6,
-	0x15, 0x6d, 0x24, 0xe0, 0xa2, 0xe3, 0x51, 0x4c, 0x92, 0x42, 0xa3, 0xac, 0xf2, 0x21, 0x0f, 0x64,
-	0xc3, 0x90, 0x07, 0x2a, 0x31, 0x9b, 0x24, 0xb6, 0xe2, 0x9b, 0x93, 0x5c, 0x54, 0x6a, 0x26, 0xa0,
-	0x01, 0x4d, 0xe2, 0xf2, 0xa4, 0xa2, 0xb5, 0xbc, 0x21, 0x33, 0x03, 0x25, 0xc8, 0x69, 0x18, 0x62,
-	0x42, 0x9d, 0xf8, 0x33, 0x09, 0x59, 0x9f, 0x35, 0x70, 0x65, 0x9d, 0x07, 0x4f, 0x88, 0xbf, 0xd1,
-	0x65, 0x5e, 0x07, 0x72, 0xf4, 0x88, 0xf9, 0x88, 0xe9, 0xcb, 0x60, 0x22, 0x52, 0x01, 0x56, 0xd1,
-	0xe6, 0xb4, 0xda, 0x44, 0xab, 0xf2, 0xe5, 0xd3, 0xfc, 0x8c, 0x9a, 0xe7, 0xbe, 0xef, 0x33, 0xc4,
-	0xf9, 0xa6, 0x60, 0x98, 0x04, 0xee, 0x00, 0xaa, 0xaf, 0x80, 0x12, 0x0c, 0x69, 0x97, 0x88, 0xca,
-	0xd8, 0x9c, 0x56, 0xfb, 0xb7, 0x31, 0x6b, 0xab, 0x0a, 0xc9, 0x83, 0xad, 0x78, 0xb0, 0x1f, 0x50,
-	0x4c, 0x5a, 0xe3, 0xfb, 0xdf, 0xaa, 0x05, 0x57, 0xc1, 0x9b, 0xab, 0x6f, 0x77, 0xab, 0x85, 0x1f,
-	0xbb, 0xd5, 0xc2, 0xeb, 0xe3, 0xbd, 0xfa, 0xa0, 0xe1, 0xbb, 0xe3, 0xbd, 0xba, 0x99, 0x59, 0xeb,
-	0x8c, 0x59, 0xad, 0x35, 0x70, 0xf5, 0x8c, 0xb0, 0x8b, 0x78, 0x44, 0x09, 0x47, 0x7a, 0x1d, 0x4c,
-	0xf7, 0xdb, 0x6d, 0x51, 0x99, 0xd9, 0xc2, 0x7e, 0xbc, 0xd2, 0xb8, 0x3b, 0x19, 0x65, 0x2b, 0xd6,
-	0x7c, 0xeb, 0xcd, 0x18, 0x30, 0xd6, 0x79, 0xb0, 0xc1, 0xa8, 0x87, 0x38, 0x3f, 0xc5, 0xca, 0x25,
-	0x5a, 0xe9, 0x0f, 0xc1, 0x3f, 0x3e, 0xf2, 0x30, 0xc7, 0x94, 0xc4, 0x5c, 0xfc, 0xdf, 0xb8, 0x65,
-	0xe7, 0x88, 0xc5, 0x3e, 0xf1, 0xca, 0xa6, 0x80, 0xa2, 0xcb, 0xdd, 0xb4, 0x5a, 0x5f, 0x00, 0x25,
-	0x8e, 0x03, 0x82, 0x58, 0xa5, 0x78, 0xce, 0x17, 0xa1, 0x70, 0xcd, 0xbb, 0x59, 0x32, 0x55, 0x50,
-	0x32, 0x79, 0xe3, 0x24, 0x93, 0x39, 0x6b, 0x5a, 0xd7, 0x81, 0x95, 0x9f, 0xed, 0xf3, 0x6a, 0xfd,
-	0x4c, 0xa4, 0xf3, 0xb4, 0x83, 0x05, 0x7a, 0x89, 0xb9, 0x50, 0xa3, 0xe8, 0x0d, 0xf0, 0x37, 0x4c,
-	0x8e, 0xe7, 0x0a, 0xa7, 0x0f, 0xcc, 0xac, 0x38, 0x76, 0xb1, 0x15, 0xf5, 0x7b, 0xa0, 0x04, 0x3d,
-	0x21, 0xc9, 0x2d, 0xc6, 0xe4, 0xd6, 0x72, 0xc9, 0x1d, 0x0c, 0x18, 0xe3, 0x5d, 0x55, 0xd7, 0x5c,
-	0xce, 0x21, 0x69, 0x48, 0x6e, 0xc3, 0xfb, 0x59, 0xd7, 0x62, 0xb9, 0x0d, 0x87, 0x53, 0x5a, 0xf6,
-	0x35, 0x30, 0x29, 0xe5, 0x18, 0xf9, 0x50, 0xa0, 0x0d, 0xc8, 0x60, 0xc8, 0xa5, 0x9b, 0x60, 0x57,
-	0x74, 0x28, 0xc3, 0x62, 0xe7, 0x7c, 0x37, 0xa5, 0x50, 0xbd, 0x05, 0x4a, 0x51, 0xdc, 0x41, 0xb9,
-	0xa9, 0x9a, 0xaf, 0xa0, 0x18, 0xd6, 0x9a, 0x90, 0x9e, 0xfa, 0x78, 0xbc, 0x57, 0xd7, 0x5c, 0x55,
-	0xd9, 0x5c, 0x8d, 0x0d, 0x95, 0xf6, 0x94, 0x1b, 0xde, 0x1c, 0xfc, 0x62, 0x6c, 0x3b, 0x43, 0xe6,
-	0xca, 0x8c, 0x6d, 0xcd, 0x82, 0xf2, 0x50, 0xa8, 0xbf, 0x65, 0xe3, 0x57, 0x11, 0x14, 0xd7, 0x79,
-	0xa0, 0xf7, 0xc0, 0xd4, 0x29, 0x97, 0xe4, 0xeb, 0xfc, 0x0c, 0x9b, 0x1a, 0xb7, 0x2f, 0x83, 0x4e,
-	0x4d, 0xfd, 0x5e, 0x03, 0xe5, 0x3c, 0x97, 0x2e, 0x8d, 0xea, 0x98, 0x53, 0x64, 0xdc, 0xf9, 0x83,
-	0xa2, 0x74, 0x9a, 0x1e, 0x98, 0x3a, 0x65, 0x83, 0x91, 0x2c, 0x0c, 0xa3, 0x47, 0xb3, 0x90, 0xa7,
-	0x35, 0xfd, 0x05, 0xf8, 0xef, 0x84, 0xce, 0x6a, 0x23, 0xb9, 0xcc, 0x20, 0x8d, 0x85, 0x8b, 0x22,
-	0xfb, 0x6f, 0x19, 0x7f, 0xbd, 0x92, 0xb2, 0x6a, 0x3d, 0xde, 0x3f, 0x34, 0xb5, 0x83, 0x43, 0x53,
-	0xfb, 0x7e, 0x68, 0x6a, 0x1f, 0x8e, 0xcc, 0xc2, 0xc1, 0x91, 0x59, 0xf8, 0x7a, 0x64, 0x16, 0x9e,
-	0xad, 0x04, 0x58, 0x74, 0xba, 0x6d, 0xdb, 0xa3, 0xa1, 0xd3, 0x25, 0xf8, 0x39, 0xf6, 0xa0, 0xf4,
-	0xd9, 0xbc, 0xbc, 0xe7, 0x08, 0x4e, 0xec, 0x44, 0x88, 0xb7, 0x4b, 0xf1, 0x5f, 0xd1, 0xd2, 0xef,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x24, 0x49, 0x51, 0xda, 0x6e, 0x07, 0x00, 0x00,
+func (m *MsgAmendUndPurchaseOrderResponse) Reset()         { *m = MsgAmendUndPurchaseOrderResponse{} }
+func (m *MsgAmendUndPurchaseOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendUndPurchaseOrderResponse) ProtoMessage()    {}
+func (*MsgAmendUndPurchaseOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91499ee206e3f069, []int{9}
+}
+func (m *MsgAmendUndPurchaseOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAmendUndPurchaseOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAmendUndPurchaseOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAmendUndPurchaseOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendUndPurchaseOrderResponse.Merge(m, src)
+}
+func (m *MsgAmendUndPurchaseOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAmendUndPurchaseOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendUndPurchaseOrderResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgAmendUndPurchaseOrderResponse proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UndPurchaseOrder defines a method to create new purchase order.
-	UndPurchaseOrder(ctx context.Context, in *MsgUndPurchaseOrder, opts ...grpc.CallOption) (*MsgUndPurchaseOrderResponse, error)
-	// ProcessUndPurchaseOrder defines a method to process a decision on a purchase order.
-	ProcessUndPurchaseOrder(ctx context.Context, in *MsgProcessUndPurchaseOrder, opts ...grpc.CallOption) (*MsgProcessUndPurchaseOrderResponse, error)
-	// WhitelistAddress defines a method to execute a whitelist action.
-	WhitelistAddress(ctx context.Context, in *MsgWhitelistAddress, opts ...grpc.CallOption) (*MsgWhitelistAddressResponse, error)
-	// UpdateParams defines an operation for updating the x/enterprise module
-	// parameters.
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+// MsgTransferLockedEFUND represents a message to move locked eFUND to another address, which is either
+// whitelisted or has been linked to the sender by an ent signer. The eFUND remains locked, and is not minted
+type MsgTransferLockedEFUND struct {
+	// from is the address of the account holding the locked eFUND
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the address receiving the locked eFUND. It must be whitelisted, or linked to the from address
+	To string `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	// amount is the amount of locked eFUND in nund
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgTransferLockedEFUND) Reset()         { *m = MsgTransferLockedEFUND{} }
+func (m *MsgTransferLockedEFUND) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLockedEFUND) ProtoMessage()    {}
+func (*MsgTransferLockedEFUND) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91499ee206e3f069, []int{10}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgTransferLockedEFUND) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) UndPurchaseOrder(ctx context.Context, in *MsgUndPurchaseOrder, opts ...grpc.CallOption) (*MsgUndPurchaseOrderResponse, error) {
-	out := new(MsgUndPurchaseOrderResponse)
-	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Msg/UndPurchaseOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferLockedEFUND) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLockedEFUND.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) ProcessUndPurchaseOrder(ctx context.Context, in *MsgProcessUndPurchaseOrder, opts ...grpc.CallOption) (*MsgProcessUndPurchaseOrderResponse, error) {
-	out := new(MsgProcessUndPurchaseOrderResponse)
-	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Msg/ProcessUndPurchaseOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgTransferLockedEFUND) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLockedEFUND.Merge(m, src)
+}
+func (m *MsgTransferLockedEFUND) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLockedEFUND) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLockedEFUND.DiscardUnknown(m)
 }
 
-func (c *msgClient) WhitelistAddress(ctx context.Context, in *MsgWhitelistAddress, opts ...grpc.CallOption) (*MsgWhitelistAddressResponse, error) {
-	out := new(MsgWhitelistAddressResponse)
-	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Msg/WhitelistAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgTransferLockedEFUND proto.InternalMessageInfo
+
+// MsgTransferLockedEFUNDResponse defines the Msg/TransferLockedEFUND response type.
+type MsgTransferLockedEFUNDResponse struct {
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferLockedEFUNDResponse) Reset()         { *m = MsgTransferLockedEFUNDResponse{} }
+func (m *MsgTransferLockedEFUNDResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferLockedEFUNDResponse) ProtoMessage()    {}
+func (*MsgTransferLockedEFUNDResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91499ee206e3f069, []int{11}
+}
+func (m *MsgTransferLockedEFUNDResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferLockedEFUNDResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferLockedEFUNDResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UndPurchaseOrder defines a method to create new purchase order.
-	UndPurchaseOrder(context.Context, *MsgUndPurchaseOrder) (*MsgUndPurchaseOrderResponse, error)
-	// ProcessUndPurchaseOrder defines a method to process a decision on a purchase order.
-	ProcessUndPurchaseOrder(context.Context, *MsgProcessUndPurchaseOrder) (*MsgProcessUndPurchaseOrderResponse, error)
-	// WhitelistAddress defines a method to execute a whitelist action.
-	WhitelistAddress(context.Context, *MsgWhitelistAddress) (*MsgWhitelistAddressResponse, error)
-	// UpdateParams defines an operation for updating the x/enterprise module
-	// parameters.
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgTransferLockedEFUNDResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferLockedEFUNDResponse.Merge(m, src)
+}
+func (m *MsgTransferLockedEFUNDResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferLockedEFUNDResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferLockedEFUNDResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgTransferLockedEFUNDResponse proto.InternalMessageInfo
+
+// MsgLinkAddress represents a message to add/remove a link from an owner address to another address it can
+// transfer locked eFUND to. Only authorised decision makers can link addresses
+type MsgLinkAddress struct {
+	// owner is the address which can transfer locked eFUND to the linked address
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// linked is the address which can receive locked eFUND from the owner
+	Linked string `protobuf:"bytes,2,opt,name=linked,proto3" json:"linked,omitempty"`
+	// signer is the address of the authorised decision maker
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	// action is the action being executed, and is either add or remove.
+	Action WhitelistAction `protobuf:"varint,4,opt,name=action,proto3,enum=mainchain.enterprise.v1.WhitelistAction" json:"action,omitempty"`
 }
 
-func (*UnimplementedMsgServer) UndPurchaseOrder(ctx context.Context, req *MsgUndPurchaseOrder) (*MsgUndPurchaseOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UndPurchaseOrder not implemented")
+func (m *MsgLinkAddress) Reset()         { *m = MsgLinkAddress{} }
+func (m *MsgLinkAddress) String() string { return proto.CompactTextString(m) }
+func (*MsgLinkAddress) ProtoMessage()    {}
+func (*MsgLinkAddress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91499ee206e3f069, []int{12}
 }
-func (*UnimplementedMsgServer) ProcessUndPurchaseOrder(ctx context.Context, req *MsgProcessUndPurchaseOrder) (*MsgProcessUndPurchaseOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProcessUndPurchaseOrder not implemented")
+func (m *MsgLinkAddress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) WhitelistAddress(ctx context.Context, req *MsgWhitelistAddress) (*MsgWhitelistAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WhitelistAddress not implemented")
+func (m *MsgLinkAddress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLinkAddress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgLinkAddress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLinkAddress.Merge(m, src)
+}
+func (m *MsgLinkAddress) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLinkAddress) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLinkAddress.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgLinkAddress proto.InternalMessageInfo
+
+// MsgLinkAddressResponse defines the Msg/LinkAddress response type.
+type MsgLinkAddressResponse struct {
 }
 
-func _Msg_UndPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUndPurchaseOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UndPurchaseOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mainchain.enterprise.v1.Msg/UndPurchaseOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UndPurchaseOrder(ctx, req.(*MsgUndPurchaseOrder))
+func (m *MsgLinkAddressResponse) Reset()         { *m = MsgLinkAddressResponse{} }
+func (m *MsgLinkAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLinkAddressResponse) ProtoMessage()    {}
+func (*MsgLinkAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91499ee206e3f069, []int{13}
+}
+func (m *MsgLinkAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLinkAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLinkAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgLinkAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLinkAddressResponse.Merge(m, src)
+}
+func (m *MsgLinkAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLinkAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLinkAddressResponse.DiscardUnknown(m)
 }
 
-func _Msg_ProcessUndPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgProcessUndPurchaseOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ProcessUndPurchaseOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mainchain.enterprise.v1.Msg/ProcessUndPurchaseOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ProcessUndPurchaseOrder(ctx, req.(*MsgProcessUndPurchaseOrder))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgLinkAddressResponse proto.InternalMessageInfo
+
+// MsgAddEntSigner is the Msg/AddEntSigner request type.
+type MsgAddEntSigner struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the address to add to the signer registry
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// name is an optional human readable identifier for the signer
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func _Msg_WhitelistAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWhitelistAddress)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WhitelistAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mainchain.enterprise.v1.Msg/WhitelistAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WhitelistAddress(ctx, req.(*MsgWhitelistAddress))
+func (m *MsgAddEntSigner) Reset()         { *m = MsgAddEntSigner{} }
+func (m *MsgAddEntSigner) String() string { return proto.CompactTextString(m) }
+func (*MsgAddEntSigner) ProtoMessage()    {}
+func (*MsgAddEntSigner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91499ee206e3f069, []int{14}
+}
+func (m *MsgAddEntSigner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddEntSigner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddEntSigner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgAddEntSigner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddEntSigner.Merge(m, src)
+}
+func (m *MsgAddEntSigner) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddEntSigner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddEntSigner.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mainchain.enterprise.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_MsgAddEntSigner proto.InternalMessageInfo
+
+func (m *MsgAddEntSigner) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "mainchain.enterprise.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UndPurchaseOrder",
-			Handler:    _Msg_UndPurchaseOrder_Handler,
-		},
-		{
-			MethodName: "ProcessUndPurchaseOrder",
-			Handler:    _Msg_ProcessUndPurchaseOrder_Handler,
-		},
-		{
-			MethodName: "WhitelistAddress",
-			Handler:    _Msg_WhitelistAddress_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "mainchain/enterprise/v1/tx.proto",
+func (m *MsgAddEntSigner) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *MsgUndPurchaseOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAddEntSigner) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUndPurchaseOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgAddEntSignerResponse defines the Msg/AddEntSigner response type.
+type MsgAddEntSignerResponse struct {
 }
 
-func (m *MsgUndPurchaseOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgAddEntSignerResponse) Reset()         { *m = MsgAddEntSignerResponse{} }
+func (m *MsgAddEntSignerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddEntSignerResponse) ProtoMessage()    {}
+func (*MsgAddEntSignerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91499ee206e3f069, []int{15}
+}
+func (m *MsgAddEntSignerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddEntSignerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddEntSignerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Purchaser) > 0 {
-		i -= len(m.Purchaser)
-		copy(dAtA[i:], m.Purchaser)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Purchaser)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUndPurchaseOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgAddEntSignerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddEntSignerResponse.Merge(m, src)
 }
-
-func (m *MsgUndPurchaseOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAddEntSignerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddEntSignerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddEntSignerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUndPurchaseOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PurchaseOrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PurchaseOrderId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgAddEntSignerResponse proto.InternalMessageInfo
+
+// MsgRemoveEntSigner is the Msg/RemoveEntSigner request type.
+type MsgRemoveEntSigner struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the address to remove from the signer registry
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *MsgProcessUndPurchaseOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveEntSigner) Reset()         { *m = MsgRemoveEntSigner{} }
+func (m *MsgRemoveEntSigner) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveEntSigner) ProtoMessage()    {}
+func (*MsgRemoveEntSigner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91499ee206e3f069, []int{16}
+}
+func (m *MsgRemoveEntSigner) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveEntSigner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveEntSigner.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgProcessUndPurchaseOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveEntSigner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveEntSigner.Merge(m, src)
+}
+func (m *MsgRemoveEntSigner) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveEntSigner) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveEntSigner.DiscardUnknown(m)
 }
 
-func (m *MsgProcessUndPurchaseOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Decision != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Decision))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.PurchaseOrderId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PurchaseOrderId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgRemoveEntSigner proto.InternalMessageInfo
+
+func (m *MsgRemoveEntSigner) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgProcessUndPurchaseOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveEntSigner) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgProcessUndPurchaseOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRemoveEntSignerResponse defines the Msg/RemoveEntSigner response type.
+type MsgRemoveEntSignerResponse struct {
 }
 
-func (m *MsgProcessUndPurchaseOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRemoveEntSignerResponse) Reset()         { *m = MsgRemoveEntSignerResponse{} }
+func (m *MsgRemoveEntSignerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveEntSignerResponse) ProtoMessage()    {}
+func (*MsgRemoveEntSignerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91499ee206e3f069, []int{17}
 }
-
-func (m *MsgWhitelistAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRemoveEntSignerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveEntSignerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveEntSignerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgWhitelistAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRemoveEntSignerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveEntSignerResponse.Merge(m, src)
+}
+func (m *MsgRemoveEntSignerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveEntSignerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveEntSignerResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWhitelistAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Action != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Action))
-		i--
-		dAtA[i] = 0x18
+var xxx_messageInfo_MsgRemoveEntSignerResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/staking parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91499ee206e3f069, []int{18}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return len(dAtA) - i, nil
+	return Params{}
 }
 
-func (m *MsgWhitelistAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91499ee206e3f069, []int{19}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWhitelistAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUndPurchaseOrder)(nil), "mainchain.enterprise.v1.MsgUndPurchaseOrder")
+	proto.RegisterType((*MsgUndPurchaseOrderResponse)(nil), "mainchain.enterprise.v1.MsgUndPurchaseOrderResponse")
+	proto.RegisterType((*MsgProcessUndPurchaseOrder)(nil), "mainchain.enterprise.v1.MsgProcessUndPurchaseOrder")
+	proto.RegisterType((*MsgProcessUndPurchaseOrderResponse)(nil), "mainchain.enterprise.v1.MsgProcessUndPurchaseOrderResponse")
+	proto.RegisterType((*MsgWhitelistAddress)(nil), "mainchain.enterprise.v1.MsgWhitelistAddress")
+	proto.RegisterType((*MsgWhitelistAddressResponse)(nil), "mainchain.enterprise.v1.MsgWhitelistAddressResponse")
+	proto.RegisterType((*MsgWithdrawUndPurchaseOrder)(nil), "mainchain.enterprise.v1.MsgWithdrawUndPurchaseOrder")
+	proto.RegisterType((*MsgWithdrawUndPurchaseOrderResponse)(nil), "mainchain.enterprise.v1.MsgWithdrawUndPurchaseOrderResponse")
+	proto.RegisterType((*MsgAmendUndPurchaseOrder)(nil), "mainchain.enterprise.v1.MsgAmendUndPurchaseOrder")
+	proto.RegisterType((*MsgAmendUndPurchaseOrderResponse)(nil), "mainchain.enterprise.v1.MsgAmendUndPurchaseOrderResponse")
+	proto.RegisterType((*MsgTransferLockedEFUND)(nil), "mainchain.enterprise.v1.MsgTransferLockedEFUND")
+	proto.RegisterType((*MsgTransferLockedEFUNDResponse)(nil), "mainchain.enterprise.v1.MsgTransferLockedEFUNDResponse")
+	proto.RegisterType((*MsgLinkAddress)(nil), "mainchain.enterprise.v1.MsgLinkAddress")
+	proto.RegisterType((*MsgLinkAddressResponse)(nil), "mainchain.enterprise.v1.MsgLinkAddressResponse")
+	proto.RegisterType((*MsgAddEntSigner)(nil), "mainchain.enterprise.v1.MsgAddEntSigner")
+	proto.RegisterType((*MsgAddEntSignerResponse)(nil), "mainchain.enterprise.v1.MsgAddEntSignerResponse")
+	proto.RegisterType((*MsgRemoveEntSigner)(nil), "mainchain.enterprise.v1.MsgRemoveEntSigner")
+	proto.RegisterType((*MsgRemoveEntSignerResponse)(nil), "mainchain.enterprise.v1.MsgRemoveEntSignerResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "mainchain.enterprise.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "mainchain.enterprise.v1.MsgUpdateParamsResponse")
 }
 
-func (m *MsgWhitelistAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("mainchain/enterprise/v1/tx.proto", fileDescriptor_91499ee206e3f069) }
+
+var fileDescriptor_91499ee206e3f069 = []byte{
+	// 1141 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x3a, 0x8e, 0x21, 0x2f, 0x28, 0x69, 0xb7, 0xa5, 0x71, 0xb6, 0xd4, 0x09, 0x5b, 0x5a,
+	0xac, 0xd0, 0x78, 0xf3, 0x51, 0x25, 0x8a, 0xf9, 0x10, 0x31, 0x14, 0x51, 0xa9, 0x86, 0xb0, 0x69,
+	0x84, 0xc4, 0x25, 0x9a, 0x78, 0x27, 0xeb, 0x21, 0xd9, 0x19, 0x6b, 0x67, 0x9c, 0x26, 0x27, 0x10,
+	0x08, 0x09, 0x81, 0x84, 0xb8, 0x72, 0xeb, 0x91, 0x63, 0x84, 0xf8, 0x03, 0x40, 0xe2, 0x90, 0x63,
+	0xc5, 0x09, 0x71, 0x40, 0x28, 0x39, 0x84, 0x13, 0x57, 0xae, 0x68, 0x3f, 0xbc, 0x5e, 0xaf, 0x77,
+	0xd7, 0x76, 0x54, 0xa9, 0x97, 0xc8, 0xfb, 0xde, 0xef, 0xbd, 0x79, 0xbf, 0xdf, 0xcc, 0xbc, 0x79,
+	0x81, 0x59, 0x0b, 0x11, 0x5a, 0x6f, 0x20, 0x42, 0x35, 0x4c, 0x05, 0xb6, 0x9b, 0x36, 0xe1, 0x58,
+	0x3b, 0x58, 0xd4, 0xc4, 0x61, 0xb9, 0x69, 0x33, 0xc1, 0xe4, 0xa9, 0x00, 0x51, 0xee, 0x20, 0xca,
+	0x07, 0x8b, 0x4a, 0xb1, 0xce, 0xb8, 0xc5, 0xb8, 0xb6, 0x83, 0xdc, 0x88, 0x1d, 0x2c, 0xd0, 0xa2,
+	0x56, 0x67, 0x84, 0x7a, 0x81, 0xca, 0x94, 0xef, 0xb7, 0xb8, 0xe9, 0x24, 0xb4, 0xb8, 0xe9, 0x3b,
+	0xa6, 0x3d, 0xc7, 0xb6, 0xfb, 0xa5, 0x79, 0x1f, 0xbe, 0xeb, 0xaa, 0xc9, 0x4c, 0xe6, 0xd9, 0x9d,
+	0x5f, 0xbe, 0xb5, 0x94, 0x54, 0x64, 0xe7, 0xcb, 0x47, 0x5e, 0x46, 0x16, 0xa1, 0x4c, 0x73, 0xff,
+	0x7a, 0x26, 0xf5, 0x57, 0x09, 0xae, 0xd4, 0xb8, 0xb9, 0x45, 0x8d, 0x8d, 0x96, 0x5d, 0x6f, 0x20,
+	0x8e, 0x3f, 0xb4, 0x0d, 0x6c, 0xcb, 0x2b, 0x30, 0xd6, 0xf4, 0x0d, 0x76, 0x41, 0x9a, 0x95, 0x4a,
+	0x63, 0xd5, 0xc2, 0xef, 0x3f, 0xcf, 0x5f, 0xf5, 0xeb, 0x59, 0x37, 0x0c, 0x1b, 0x73, 0xbe, 0x29,
+	0x6c, 0x42, 0x4d, 0xbd, 0x03, 0x95, 0x57, 0x21, 0x8f, 0x2c, 0xd6, 0xa2, 0xa2, 0x90, 0x9d, 0x95,
+	0x4a, 0xe3, 0x4b, 0xd3, 0x65, 0x3f, 0xc2, 0xd1, 0xa1, 0xec, 0xeb, 0x50, 0x7e, 0x87, 0x11, 0x5a,
+	0xcd, 0x9d, 0xfc, 0x35, 0x93, 0xd1, 0x7d, 0x78, 0x65, 0xed, 0xeb, 0xc7, 0x33, 0x99, 0x7f, 0x1e,
+	0xcf, 0x64, 0xbe, 0x38, 0x3f, 0x9e, 0xeb, 0x24, 0xfc, 0xe6, 0xfc, 0x78, 0xae, 0x18, 0xa2, 0x15,
+	0x53, 0xab, 0x7a, 0x1f, 0xae, 0xc7, 0x98, 0x75, 0xcc, 0x9b, 0x8c, 0x72, 0x2c, 0xcf, 0xc1, 0xe5,
+	0x76, 0xba, 0x6d, 0xe6, 0x78, 0xb6, 0x89, 0xe1, 0x52, 0xca, 0xe9, 0x93, 0xcd, 0x70, 0xc4, 0x7d,
+	0x43, 0xfd, 0x32, 0x0b, 0x4a, 0x8d, 0x9b, 0x1b, 0x36, 0xab, 0x63, 0xce, 0x7b, 0x54, 0x19, 0x22,
+	0x95, 0xfc, 0x3e, 0x3c, 0x6f, 0xe0, 0x3a, 0xe1, 0x84, 0x51, 0x57, 0x8b, 0x89, 0xa5, 0x3b, 0xe5,
+	0x84, 0xc3, 0x52, 0xee, 0x5a, 0x65, 0x53, 0x20, 0xd1, 0xe2, 0x7a, 0x10, 0x2d, 0x2f, 0x40, 0x9e,
+	0x13, 0x93, 0x62, 0xbb, 0x30, 0xd2, 0x67, 0x23, 0x7c, 0x5c, 0xe5, 0xcd, 0xb0, 0x98, 0xbe, 0xd1,
+	0x51, 0xf2, 0x56, 0xb7, 0x92, 0x09, 0x34, 0xd5, 0x57, 0x40, 0x4d, 0xf6, 0xb6, 0x75, 0x55, 0xff,
+	0xcd, 0xba, 0x47, 0xe7, 0xe3, 0x06, 0x11, 0x78, 0x9f, 0x70, 0xe1, 0x97, 0x22, 0x2f, 0xc1, 0x73,
+	0xc8, 0xfb, 0xd9, 0xf7, 0xe0, 0xb4, 0x81, 0x21, 0x8a, 0xd9, 0xc1, 0x28, 0xca, 0x6f, 0x43, 0x1e,
+	0xd5, 0x85, 0x23, 0xee, 0x88, 0x2b, 0x6e, 0x29, 0x51, 0xdc, 0x4e, 0x81, 0x2e, 0x5e, 0xf7, 0xe3,
+	0xe4, 0x19, 0x18, 0xc7, 0x87, 0x4d, 0x62, 0x1f, 0x6d, 0x0b, 0x62, 0xe1, 0x42, 0xce, 0xdd, 0x46,
+	0xf0, 0x4c, 0x0f, 0x89, 0x85, 0xe5, 0x2a, 0x4c, 0x5a, 0xe8, 0x70, 0xdb, 0xdf, 0x58, 0xb4, 0xb3,
+	0x8f, 0x0b, 0xa3, 0x7d, 0x0e, 0xb5, 0x3e, 0x61, 0xa1, 0xc3, 0x8d, 0x4e, 0x80, 0x2c, 0x43, 0x4e,
+	0x10, 0x6c, 0x17, 0xf2, 0x0e, 0x2d, 0xdd, 0xfd, 0x5d, 0x59, 0x49, 0xd8, 0x9d, 0xc8, 0x39, 0x8f,
+	0x0a, 0xab, 0xde, 0x80, 0xeb, 0x31, 0xe6, 0x60, 0x3f, 0x7e, 0x93, 0x3c, 0x3f, 0x11, 0x0d, 0xc3,
+	0x46, 0x8f, 0x9e, 0xda, 0x95, 0x8e, 0x3d, 0xf4, 0xd9, 0xd8, 0x43, 0x5f, 0x59, 0x4f, 0xbe, 0xc5,
+	0xb7, 0x23, 0xec, 0x12, 0xca, 0x54, 0x6f, 0xc1, 0xcd, 0x14, 0x77, 0xc0, 0xf6, 0x3f, 0x09, 0x0a,
+	0x35, 0x6e, 0xae, 0x5b, 0x98, 0x1a, 0xcf, 0x82, 0x6a, 0xa8, 0xd3, 0x8d, 0x0c, 0xd7, 0xe9, 0xde,
+	0x4a, 0xd6, 0xe8, 0x66, 0xb7, 0x46, 0xb1, 0xe4, 0x54, 0x15, 0x66, 0x93, 0x7c, 0x81, 0x3a, 0xa7,
+	0x12, 0x5c, 0xab, 0x71, 0xf3, 0xa1, 0x8d, 0x28, 0xdf, 0xc5, 0xf6, 0x03, 0x56, 0xdf, 0xc3, 0xc6,
+	0xbd, 0xf7, 0xb6, 0x3e, 0x78, 0x57, 0xbe, 0x03, 0xb9, 0x5d, 0x9b, 0x59, 0x7d, 0x65, 0x71, 0x51,
+	0x72, 0x09, 0xb2, 0x82, 0xf5, 0xbd, 0x94, 0x59, 0xc1, 0x2e, 0xae, 0xc7, 0x6a, 0x58, 0x0f, 0x77,
+	0x55, 0x47, 0x8a, 0x97, 0xbb, 0xa5, 0x88, 0x61, 0xa2, 0xce, 0x42, 0x31, 0xde, 0x13, 0xc8, 0xf0,
+	0x43, 0x16, 0x26, 0x6a, 0xdc, 0x7c, 0x40, 0xe8, 0x5e, 0xbb, 0x3b, 0x95, 0x61, 0x94, 0x3d, 0xa2,
+	0x03, 0x1c, 0x0b, 0x0f, 0xe6, 0x74, 0xa6, 0x7d, 0x42, 0xf7, 0xb0, 0xd1, 0xbf, 0x33, 0x79, 0xb8,
+	0xe1, 0xdb, 0x75, 0xa8, 0x97, 0xe5, 0x2e, 0xd6, 0xcb, 0x2a, 0x8b, 0x09, 0x2d, 0x65, 0xba, 0x5b,
+	0xc5, 0x90, 0x10, 0x6a, 0x01, 0xae, 0x75, 0x5b, 0x02, 0xd5, 0x7e, 0x91, 0x60, 0xd2, 0x39, 0x61,
+	0x86, 0x71, 0x8f, 0x8a, 0x4d, 0xaf, 0xc4, 0x15, 0x18, 0x43, 0x2d, 0xd1, 0x60, 0x36, 0x11, 0x47,
+	0xfd, 0x6f, 0x54, 0x00, 0x0d, 0x3f, 0x06, 0xd9, 0x41, 0x1f, 0x03, 0x19, 0x72, 0x14, 0x59, 0xd8,
+	0x93, 0x4f, 0x77, 0x7f, 0x57, 0xe6, 0xdd, 0xcb, 0x12, 0xe4, 0x75, 0xb8, 0x29, 0x91, 0xcb, 0x12,
+	0x2a, 0x57, 0x9d, 0x86, 0xa9, 0x88, 0x29, 0x60, 0xf7, 0x93, 0x04, 0x72, 0x8d, 0x9b, 0x3a, 0xb6,
+	0xd8, 0x01, 0x7e, 0x26, 0x04, 0x2b, 0x0b, 0xbd, 0x64, 0x6e, 0x74, 0x93, 0x89, 0x54, 0xa7, 0xbe,
+	0x04, 0x4a, 0xaf, 0x35, 0xa0, 0x74, 0xe2, 0x6d, 0xd8, 0x56, 0xd3, 0x40, 0x02, 0x6f, 0x20, 0x1b,
+	0x59, 0xfc, 0xc2, 0x7c, 0xaa, 0x90, 0x6f, 0xba, 0x19, 0xfc, 0x01, 0x6e, 0x26, 0x79, 0x68, 0x71,
+	0x61, 0xd5, 0x31, 0xe7, 0x32, 0xff, 0x78, 0x7e, 0x3c, 0x27, 0xe9, 0x7e, 0x64, 0x65, 0xad, 0x97,
+	0xdf, 0xed, 0xce, 0x90, 0x7a, 0xa8, 0x45, 0xe6, 0xb9, 0x50, 0xd9, 0xfe, 0xc6, 0x85, 0x4d, 0x6d,
+	0x96, 0x4b, 0x7f, 0x8e, 0xc1, 0x48, 0x8d, 0x9b, 0xf2, 0x01, 0x5c, 0xea, 0x69, 0xf8, 0xc9, 0xa3,
+	0x55, 0xcc, 0x64, 0xa8, 0xdc, 0x1d, 0x06, 0x1d, 0xcc, 0x91, 0xdf, 0x4a, 0x30, 0x95, 0x34, 0x18,
+	0x2e, 0xa7, 0x65, 0x4c, 0x08, 0x52, 0x5e, 0xbf, 0x40, 0x50, 0x50, 0xcd, 0x01, 0x5c, 0xea, 0x99,
+	0xbc, 0x52, 0x55, 0x88, 0xa2, 0x95, 0xbb, 0xc3, 0xa0, 0x83, 0x75, 0xbf, 0x93, 0xa0, 0x90, 0x38,
+	0x62, 0xa4, 0xa7, 0x4c, 0x88, 0x52, 0xde, 0xb8, 0x48, 0x54, 0x50, 0xd0, 0x57, 0x12, 0xbc, 0x18,
+	0x3f, 0x05, 0x2c, 0xa6, 0xe5, 0x8d, 0x0d, 0x51, 0xd6, 0x86, 0x0e, 0x09, 0xea, 0xf8, 0x0c, 0xae,
+	0xc4, 0x3d, 0xb7, 0x5a, 0x5a, 0xc6, 0x98, 0x00, 0x65, 0x75, 0xc8, 0x80, 0xa0, 0x00, 0x13, 0xc6,
+	0xc3, 0x0f, 0xdd, 0xab, 0x69, 0x79, 0x42, 0x40, 0x45, 0x1b, 0x10, 0x18, 0x2c, 0xf4, 0x29, 0xbc,
+	0xd0, 0xf5, 0x36, 0x94, 0x52, 0x45, 0x0b, 0x21, 0x95, 0x85, 0x41, 0x91, 0xc1, 0x5a, 0x1c, 0x26,
+	0xa3, 0x9d, 0xfa, 0xb5, 0xb4, 0x24, 0x11, 0xb0, 0xb2, 0x3c, 0x04, 0x38, 0x4c, 0xb0, 0xab, 0x97,
+	0xa6, 0x12, 0x0c, 0x23, 0x95, 0x85, 0x41, 0x91, 0xed, 0xb5, 0x94, 0xd1, 0xcf, 0x9d, 0xd6, 0x59,
+	0xfd, 0xe8, 0xe4, 0xb4, 0x28, 0x3d, 0x39, 0x2d, 0x4a, 0x7f, 0x9f, 0x16, 0xa5, 0xef, 0xcf, 0x8a,
+	0x99, 0x27, 0x67, 0xc5, 0xcc, 0x1f, 0x67, 0xc5, 0xcc, 0x27, 0xab, 0x26, 0x11, 0x8d, 0xd6, 0x4e,
+	0xb9, 0xce, 0x2c, 0xad, 0x45, 0xc9, 0x2e, 0xa9, 0x23, 0xe7, 0xc9, 0x9f, 0x77, 0xbe, 0x13, 0x9a,
+	0xaa, 0x38, 0x6a, 0x62, 0xbe, 0x93, 0x77, 0xff, 0xc3, 0x5f, 0xfe, 0x7f, 0x00, 0x79, 0x64, 0xd0,
+	0xe6, 0xc5, 0x10, 0x00, 0x00,
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UndPurchaseOrder defines a method to create new purchase order.
+	UndPurchaseOrder(ctx context.Context, in *MsgUndPurchaseOrder, opts ...grpc.CallOption) (*MsgUndPurchaseOrderResponse, error)
+	// ProcessUndPurchaseOrder defines a method to process a decision on a purchase order.
+	ProcessUndPurchaseOrder(ctx context.Context, in *MsgProcessUndPurchaseOrder, opts ...grpc.CallOption) (*MsgProcessUndPurchaseOrderResponse, error)
+	// WhitelistAddress defines a method to execute a whitelist action.
+	WhitelistAddress(ctx context.Context, in *MsgWhitelistAddress, opts ...grpc.CallOption) (*MsgWhitelistAddressResponse, error)
+	// WithdrawUndPurchaseOrder defines a method for a purchaser to withdraw a raised purchase order.
+	WithdrawUndPurchaseOrder(ctx context.Context, in *MsgWithdrawUndPurchaseOrder, opts ...grpc.CallOption) (*MsgWithdrawUndPurchaseOrderResponse, error)
+	// AmendUndPurchaseOrder defines a method for a purchaser to amend the amount of a raised purchase order.
+	AmendUndPurchaseOrder(ctx context.Context, in *MsgAmendUndPurchaseOrder, opts ...grpc.CallOption) (*MsgAmendUndPurchaseOrderResponse, error)
+	// TransferLockedEFUND defines a method for moving locked eFUND to another whitelisted or linked address.
+	TransferLockedEFUND(ctx context.Context, in *MsgTransferLockedEFUND, opts ...grpc.CallOption) (*MsgTransferLockedEFUNDResponse, error)
+	// LinkAddress defines a method for an ent signer to add/remove a link from an address to another address it can
+	// transfer locked eFUND to.
+	LinkAddress(ctx context.Context, in *MsgLinkAddress, opts ...grpc.CallOption) (*MsgLinkAddressResponse, error)
+	// AddEntSigner defines a governance operation for adding an address to the purchase order signer registry.
+	AddEntSigner(ctx context.Context, in *MsgAddEntSigner, opts ...grpc.CallOption) (*MsgAddEntSignerResponse, error)
+	// RemoveEntSigner defines a governance operation for removing an address from the purchase order signer registry.
+	RemoveEntSigner(ctx context.Context, in *MsgRemoveEntSigner, opts ...grpc.CallOption) (*MsgRemoveEntSignerResponse, error)
+	// UpdateParams defines an operation for updating the x/enterprise module
+	// parameters.
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UndPurchaseOrder(ctx context.Context, in *MsgUndPurchaseOrder, opts ...grpc.CallOption) (*MsgUndPurchaseOrderResponse, error) {
+	out := new(MsgUndPurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Msg/UndPurchaseOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ProcessUndPurchaseOrder(ctx context.Context, in *MsgProcessUndPurchaseOrder, opts ...grpc.CallOption) (*MsgProcessUndPurchaseOrderResponse, error) {
+	out := new(MsgProcessUndPurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Msg/ProcessUndPurchaseOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (c *msgClient) WhitelistAddress(ctx context.Context, in *MsgWhitelistAddress, opts ...grpc.CallOption) (*MsgWhitelistAddressResponse, error) {
+	out := new(MsgWhitelistAddressResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Msg/WhitelistAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) WithdrawUndPurchaseOrder(ctx context.Context, in *MsgWithdrawUndPurchaseOrder, opts ...grpc.CallOption) (*MsgWithdrawUndPurchaseOrderResponse, error) {
+	out := new(MsgWithdrawUndPurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Msg/WithdrawUndPurchaseOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) AmendUndPurchaseOrder(ctx context.Context, in *MsgAmendUndPurchaseOrder, opts ...grpc.CallOption) (*MsgAmendUndPurchaseOrderResponse, error) {
+	out := new(MsgAmendUndPurchaseOrderResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Msg/AmendUndPurchaseOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TransferLockedEFUND(ctx context.Context, in *MsgTransferLockedEFUND, opts ...grpc.CallOption) (*MsgTransferLockedEFUNDResponse, error) {
+	out := new(MsgTransferLockedEFUNDResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Msg/TransferLockedEFUND", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) LinkAddress(ctx context.Context, in *MsgLinkAddress, opts ...grpc.CallOption) (*MsgLinkAddressResponse, error) {
+	out := new(MsgLinkAddressResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Msg/LinkAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) AddEntSigner(ctx context.Context, in *MsgAddEntSigner, opts ...grpc.CallOption) (*MsgAddEntSignerResponse, error) {
+	out := new(MsgAddEntSignerResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Msg/AddEntSigner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgUndPurchaseOrder) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) RemoveEntSigner(ctx context.Context, in *MsgRemoveEntSigner, opts ...grpc.CallOption) (*MsgRemoveEntSignerResponse, error) {
+	out := new(MsgRemoveEntSignerResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Msg/RemoveEntSigner", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Purchaser)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.enterprise.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgUndPurchaseOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UndPurchaseOrder defines a method to create new purchase order.
+	UndPurchaseOrder(context.Context, *MsgUndPurchaseOrder) (*MsgUndPurchaseOrderResponse, error)
+	// ProcessUndPurchaseOrder defines a method to process a decision on a purchase order.
+	ProcessUndPurchaseOrder(context.Context, *MsgProcessUndPurchaseOrder) (*MsgProcessUndPurchaseOrderResponse, error)
+	// WhitelistAddress defines a method to execute a whitelist action.
+	WhitelistAddress(context.Context, *MsgWhitelistAddress) (*MsgWhitelistAddressResponse, error)
+	// WithdrawUndPurchaseOrder defines a method for a purchaser to withdraw a raised purchase order.
+	WithdrawUndPurchaseOrder(context.Context, *MsgWithdrawUndPurchaseOrder) (*MsgWithdrawUndPurchaseOrderResponse, error)
+	// AmendUndPurchaseOrder defines a method for a purchaser to amend the amount of a raised purchase order.
+	AmendUndPurchaseOrder(context.Context, *MsgAmendUndPurchaseOrder) (*MsgAmendUndPurchaseOrderResponse, error)
+	// TransferLockedEFUND defines a method for moving locked eFUND to another whitelisted or linked address.
+	TransferLockedEFUND(context.Context, *MsgTransferLockedEFUND) (*MsgTransferLockedEFUNDResponse, error)
+	// LinkAddress defines a method for an ent signer to add/remove a link from an address to another address it can
+	// transfer locked eFUND to.
+	LinkAddress(context.Context, *MsgLinkAddress) (*MsgLinkAddressResponse, error)
+	// AddEntSigner defines a governance operation for adding an address to the purchase order signer registry.
+	AddEntSigner(context.Context, *MsgAddEntSigner) (*MsgAddEntSignerResponse, error)
+	// RemoveEntSigner defines a governance operation for removing an address from the purchase order signer registry.
+	RemoveEntSigner(context.Context, *MsgRemoveEntSigner) (*MsgRemoveEntSignerResponse, error)
+	// UpdateParams defines an operation for updating the x/enterprise module
+	// parameters.
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UndPurchaseOrder(ctx context.Context, req *MsgUndPurchaseOrder) (*MsgUndPurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndPurchaseOrder not implemented")
+}
+func (*UnimplementedMsgServer) ProcessUndPurchaseOrder(ctx context.Context, req *MsgProcessUndPurchaseOrder) (*MsgProcessUndPurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessUndPurchaseOrder not implemented")
+}
+func (*UnimplementedMsgServer) WhitelistAddress(ctx context.Context, req *MsgWhitelistAddress) (*MsgWhitelistAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhitelistAddress not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawUndPurchaseOrder(ctx context.Context, req *MsgWithdrawUndPurchaseOrder) (*MsgWithdrawUndPurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawUndPurchaseOrder not implemented")
+}
+func (*UnimplementedMsgServer) AmendUndPurchaseOrder(ctx context.Context, req *MsgAmendUndPurchaseOrder) (*MsgAmendUndPurchaseOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendUndPurchaseOrder not implemented")
+}
+func (*UnimplementedMsgServer) TransferLockedEFUND(ctx context.Context, req *MsgTransferLockedEFUND) (*MsgTransferLockedEFUNDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferLockedEFUND not implemented")
+}
+func (*UnimplementedMsgServer) LinkAddress(ctx context.Context, req *MsgLinkAddress) (*MsgLinkAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkAddress not implemented")
+}
+func (*UnimplementedMsgServer) AddEntSigner(ctx context.Context, req *MsgAddEntSigner) (*MsgAddEntSignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEntSigner not implemented")
+}
+func (*UnimplementedMsgServer) RemoveEntSigner(ctx context.Context, req *MsgRemoveEntSigner) (*MsgRemoveEntSignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEntSigner not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UndPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUndPurchaseOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PurchaseOrderId != 0 {
-		n += 1 + sovTx(uint64(m.PurchaseOrderId))
+	if interceptor == nil {
+		return srv.(MsgServer).UndPurchaseOrder(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.enterprise.v1.Msg/UndPurchaseOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UndPurchaseOrder(ctx, req.(*MsgUndPurchaseOrder))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProcessUndPurchaseOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ProcessUndPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProcessUndPurchaseOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PurchaseOrderId != 0 {
-		n += 1 + sovTx(uint64(m.PurchaseOrderId))
+	if interceptor == nil {
+		return srv.(MsgServer).ProcessUndPurchaseOrder(ctx, in)
 	}
-	if m.Decision != 0 {
-		n += 1 + sovTx(uint64(m.Decision))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.enterprise.v1.Msg/ProcessUndPurchaseOrder",
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProcessUndPurchaseOrder(ctx, req.(*MsgProcessUndPurchaseOrder))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgProcessUndPurchaseOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_WhitelistAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWhitelistAddress)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).WhitelistAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.enterprise.v1.Msg/WhitelistAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WhitelistAddress(ctx, req.(*MsgWhitelistAddress))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWhitelistAddress) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_WithdrawUndPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawUndPurchaseOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawUndPurchaseOrder(ctx, in)
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.enterprise.v1.Msg/WithdrawUndPurchaseOrder",
 	}
-	if m.Action != 0 {
-		n += 1 + sovTx(uint64(m.Action))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawUndPurchaseOrder(ctx, req.(*MsgWithdrawUndPurchaseOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AmendUndPurchaseOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendUndPurchaseOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendUndPurchaseOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.enterprise.v1.Msg/AmendUndPurchaseOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendUndPurchaseOrder(ctx, req.(*MsgAmendUndPurchaseOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferLockedEFUND_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferLockedEFUND)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferLockedEFUND(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.enterprise.v1.Msg/TransferLockedEFUND",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferLockedEFUND(ctx, req.(*MsgTransferLockedEFUND))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LinkAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLinkAddress)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LinkAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.enterprise.v1.Msg/LinkAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LinkAddress(ctx, req.(*MsgLinkAddress))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddEntSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddEntSigner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddEntSigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.enterprise.v1.Msg/AddEntSigner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddEntSigner(ctx, req.(*MsgAddEntSigner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveEntSigner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveEntSigner)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveEntSigner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.enterprise.v1.Msg/RemoveEntSigner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveEntSigner(ctx, req.(*MsgRemoveEntSigner))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.enterprise.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "mainchain.enterprise.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UndPurchaseOrder",
+			Handler:    _Msg_UndPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "ProcessUndPurchaseOrder",
+			Handler:    _Msg_ProcessUndPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "WhitelistAddress",
+			Handler:    _Msg_WhitelistAddress_Handler,
+		},
+		{
+			MethodName: "WithdrawUndPurchaseOrder",
+			Handler:    _Msg_WithdrawUndPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "AmendUndPurchaseOrder",
+			Handler:    _Msg_AmendUndPurchaseOrder_Handler,
+		},
+		{
+			MethodName: "TransferLockedEFUND",
+			Handler:    _Msg_TransferLockedEFUND_Handler,
+		},
+		{
+			MethodName: "LinkAddress",
+			Handler:    _Msg_LinkAddress_Handler,
+		},
+		{
+			MethodName: "AddEntSigner",
+			Handler:    _Msg_AddEntSigner_Handler,
+		},
+		{
+			MethodName: "RemoveEntSigner",
+			Handler:    _Msg_RemoveEntSigner_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mainchain/enterprise/v1/tx.proto",
+}
+
+func (m *MsgUndPurchaseOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndPurchaseOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndPurchaseOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Purchaser) > 0 {
+		i -= len(m.Purchaser)
+		copy(dAtA[i:], m.Purchaser)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Purchaser)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUndPurchaseOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUndPurchaseOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUndPurchaseOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PurchaseOrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PurchaseOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProcessUndPurchaseOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProcessUndPurchaseOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProcessUndPurchaseOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Decision != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Decision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PurchaseOrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PurchaseOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProcessUndPurchaseOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProcessUndPurchaseOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProcessUndPurchaseOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWhitelistAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWhitelistAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWhitelistAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tier) > 0 {
+		i -= len(m.Tier)
+		copy(dAtA[i:], m.Tier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tier)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxPurchasable != nil {
+		{
+			size, err := m.MaxPurchasable.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ExpiryTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Action != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWhitelistAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWhitelistAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWhitelistAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawUndPurchaseOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawUndPurchaseOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawUndPurchaseOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PurchaseOrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PurchaseOrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Purchaser) > 0 {
+		i -= len(m.Purchaser)
+		copy(dAtA[i:], m.Purchaser)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Purchaser)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawUndPurchaseOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawUndPurchaseOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawUndPurchaseOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendUndPurchaseOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendUndPurchaseOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendUndPurchaseOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PurchaseOrderId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PurchaseOrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Purchaser) > 0 {
+		i -= len(m.Purchaser)
+		copy(dAtA[i:], m.Purchaser)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Purchaser)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendUndPurchaseOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendUndPurchaseOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendUndPurchaseOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferLockedEFUND) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferLockedEFUND) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLockedEFUND) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferLockedEFUNDResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferLockedEFUNDResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferLockedEFUNDResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLinkAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLinkAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLinkAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Linked) > 0 {
+		i -= len(m.Linked)
+		copy(dAtA[i:], m.Linked)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Linked)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLinkAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLinkAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLinkAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddEntSigner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddEntSigner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddEntSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddEntSignerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddEntSignerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddEntSignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveEntSigner) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveEntSigner) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveEntSigner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveEntSignerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveEntSignerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveEntSignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUndPurchaseOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Purchaser)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUndPurchaseOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PurchaseOrderId != 0 {
+		n += 1 + sovTx(uint64(m.PurchaseOrderId))
+	}
+	return n
+}
+
+func (m *MsgProcessUndPurchaseOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PurchaseOrderId != 0 {
+		n += 1 + sovTx(uint64(m.PurchaseOrderId))
+	}
+	if m.Decision != 0 {
+		n += 1 + sovTx(uint64(m.Decision))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProcessUndPurchaseOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWhitelistAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovTx(uint64(m.Action))
+	}
+	if m.ExpiryTime != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryTime))
+	}
+	if m.MaxPurchasable != nil {
+		l = m.MaxPurchasable.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWhitelistAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawUndPurchaseOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Purchaser)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PurchaseOrderId != 0 {
+		n += 1 + sovTx(uint64(m.PurchaseOrderId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawUndPurchaseOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAmendUndPurchaseOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Purchaser)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PurchaseOrderId != 0 {
+		n += 1 + sovTx(uint64(m.PurchaseOrderId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAmendUndPurchaseOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferLockedEFUND) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferLockedEFUNDResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgLinkAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Linked)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Action != 0 {
+		n += 1 + sovTx(uint64(m.Action))
+	}
+	return n
+}
+
+func (m *MsgLinkAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddEntSigner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddEntSignerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveEntSigner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveEntSignerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUndPurchaseOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndPurchaseOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndPurchaseOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchaser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUndPurchaseOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUndPurchaseOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUndPurchaseOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseOrderId", wireType)
+			}
+			m.PurchaseOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurchaseOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProcessUndPurchaseOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProcessUndPurchaseOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProcessUndPurchaseOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseOrderId", wireType)
+			}
+			m.PurchaseOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurchaseOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+			}
+			m.Decision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decision |= PurchaseOrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProcessUndPurchaseOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProcessUndPurchaseOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProcessUndPurchaseOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWhitelistAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWhitelistAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWhitelistAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= WhitelistAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			m.ExpiryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPurchasable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxPurchasable == nil {
+				m.MaxPurchasable = &types.Coin{}
+			}
+			if err := m.MaxPurchasable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWhitelistAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWhitelistAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWhitelistAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawUndPurchaseOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawUndPurchaseOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawUndPurchaseOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchaser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseOrderId", wireType)
+			}
+			m.PurchaseOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurchaseOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawUndPurchaseOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawUndPurchaseOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawUndPurchaseOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAmendUndPurchaseOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendUndPurchaseOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendUndPurchaseOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchaser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseOrderId", wireType)
+			}
+			m.PurchaseOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PurchaseOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAmendUndPurchaseOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendUndPurchaseOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendUndPurchaseOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferLockedEFUND) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferLockedEFUND: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferLockedEFUND: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgWhitelistAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgTransferLockedEFUNDResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferLockedEFUNDResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferLockedEFUNDResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUndPurchaseOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgLinkAddress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1046,15 +3592,15 @@ func (m *MsgUndPurchaseOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndPurchaseOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLinkAddress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndPurchaseOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLinkAddress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Purchaser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1082,13 +3628,13 @@ func (m *MsgUndPurchaseOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Purchaser = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Linked", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1098,25 +3644,75 @@ func (m *MsgUndPurchaseOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Linked = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= WhitelistAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1138,7 +3734,7 @@ func (m *MsgUndPurchaseOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndPurchaseOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLinkAddressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1161,31 +3757,12 @@ func (m *MsgUndPurchaseOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndPurchaseOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLinkAddressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndPurchaseOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLinkAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseOrderId", wireType)
-			}
-			m.PurchaseOrderId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PurchaseOrderId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1207,7 +3784,7 @@ func (m *MsgUndPurchaseOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProcessUndPurchaseOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgAddEntSigner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1230,17 +3807,17 @@ func (m *MsgProcessUndPurchaseOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProcessUndPurchaseOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddEntSigner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProcessUndPurchaseOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddEntSigner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PurchaseOrderId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			m.PurchaseOrderId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1250,16 +3827,29 @@ func (m *MsgProcessUndPurchaseOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PurchaseOrderId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decision", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Decision = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1269,14 +3859,27 @@ func (m *MsgProcessUndPurchaseOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Decision |= PurchaseOrderStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1304,7 +3907,7 @@ func (m *MsgProcessUndPurchaseOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1327,7 +3930,7 @@ func (m *MsgProcessUndPurchaseOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgProcessUndPurchaseOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddEntSignerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1350,10 +3953,10 @@ func (m *MsgProcessUndPurchaseOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgProcessUndPurchaseOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddEntSignerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgProcessUndPurchaseOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddEntSignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1377,7 +3980,7 @@ func (m *MsgProcessUndPurchaseOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWhitelistAddress) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveEntSigner) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1400,15 +4003,15 @@ func (m *MsgWhitelistAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWhitelistAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveEntSigner: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWhitelistAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveEntSigner: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1436,11 +4039,11 @@ func (m *MsgWhitelistAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1468,27 +4071,8 @@ func (m *MsgWhitelistAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
-			}
-			m.Action = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Action |= WhitelistAction(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1510,7 +4094,7 @@ func (m *MsgWhitelistAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWhitelistAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveEntSignerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1533,10 +4117,10 @@ func (m *MsgWhitelistAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWhitelistAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveEntSignerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWhitelistAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveEntSignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1,12 +1,28 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MaxEntSignerNameLength is the maximum length of an ent signer's name in the signer registry
+	MaxEntSignerNameLength = 64
+
+	// MaxWhitelistTierLength is the maximum length of a whitelist entry's tier label
+	MaxWhitelistTierLength = 64
+
+	// MaxLockedEFUNDLotExpiriesPerBlock is the maximum number of expired locked eFUND lots the EndBlocker will
+	// remove in a single block. Any remaining expired lots are removed in subsequent blocks
+	MaxLockedEFUNDLotExpiriesPerBlock = 100
+)
 
 type EnterpriseUndPurchaseOrders []EnterpriseUndPurchaseOrder
 type LockedUnds []LockedUnd
 type SpentEFUNDs []SpentEFUND
 type Whitelists []string
 type PurchaseOrderDecisions []PurchaseOrderDecision
+type LockedEFUNDLots []LockedEFUNDLot
 
 // NewEnterpriseUndPurchaseOrder is currently only used in the simulation decoder unit tests
 func NewEnterpriseUndPurchaseOrder(poId uint64, purchaser string, amount sdk.Coin, status PurchaseOrderStatus, raisedTime, completionTime uint64) (EnterpriseUndPurchaseOrder, error) {
@@ -32,3 +48,28 @@ func NewLockedUnd(owner string, amount sdk.Coin) (LockedUnd, error) {
 
 	return l, nil
 }
+
+// IsActive returns true if the whitelist entry has not expired at the given unix time
+func (e WhitelistEntry) IsActive(timeNow uint64) bool {
+	return e.ExpiryTime == 0 || timeNow < e.ExpiryTime
+}
+
+// HasPurchaseCap returns true if the whitelist entry limits the cumulative amount of eFUND purchasable
+func (e WhitelistEntry) HasPurchaseCap() bool {
+	return !e.MaxPurchasable.Amount.IsNil() && e.MaxPurchasable.IsPositive()
+}
+
+// ValidateWhitelistEntryAttributes runs stateless checks on the optional cap and tier of a whitelist entry
+func ValidateWhitelistEntryAttributes(maxPurchasable sdk.Coin, tier string) error {
+	if !maxPurchasable.Amount.IsNil() && !maxPurchasable.IsZero() {
+		if err := maxPurchasable.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidData, "invalid max purchasable: %s", err)
+		}
+	}
+
+	if len(tier) > MaxWhitelistTierLength {
+		return errorsmod.Wrapf(ErrInvalidData, "tier cannot be longer than %d characters", MaxWhitelistTierLength)
+	}
+
+	return nil
+}
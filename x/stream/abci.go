@@ -0,0 +1,26 @@
+package stream
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/stream/keeper"
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+// EndBlocker settles depleted streams, alerts streams with a low deposit, and prunes the audit data of deleted
+// streams. Failures are isolated to the stream concerned, and never returned, so a single stream cannot halt the chain
+func EndBlocker(ctx context.Context, k keeper.Keeper) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.SettleDepletedStreams(sdkCtx)
+	k.AlertLowDepositStreams(sdkCtx)
+	k.PruneDeletedStreamAudits(sdkCtx)
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+// ClaimStreamFeeDecorator allows the receiver of a stream to pay the fees for a Tx claiming from that
+// stream out of the claim itself, so that receivers with no liquid balance are still able to claim.
+// The claim is made during the Ante process, before the DeductFeeDecorator, and only if:
+//
+// 1. The Tx contains a single MsgClaimStream or MsgClaimStreamById, and no other Msgs
+// 2. The fee payer is the stream's receiver, and no fee granter is set
+// 3. The fee payer does not have sufficient spendable funds to pay the fees
+//
+// In this case, the fees must be a single coin in the stream's denomination, and must not be more than the
+// max_claim_fee_ratio param's share of the amount received from the claim, otherwise the Tx is rejected. The
+// claim's result is passed on to the Msg handler, which returns it instead of claiming again. In all other
+// cases, the decorator does nothing and continues on to the next AnteHandler in the chain.
+//
+// The claim is made before the signatures are verified, so that the DeductFeeDecorator can take the fees from
+// it. This is safe because BaseApp runs the whole AnteHandler chain on a cached context, which is only written
+// if every decorator succeeds. A Tx with a missing or invalid signature fails in the SigVerificationDecorator
+// and its claim is discarded, in both CheckTx and DeliverTx. The claim's gas is consumed from the Tx's gas
+// limit, and only the stream's receiver, as the fee payer, can trigger it.
+type ClaimStreamFeeDecorator struct {
+	bankKeeper   BankKeeper
+	streamKeeper StreamKeeper
+}
+
+func NewClaimStreamFeeDecorator(bankKeeper BankKeeper, streamKeeper StreamKeeper) ClaimStreamFeeDecorator {
+	return ClaimStreamFeeDecorator{
+		bankKeeper:   bankKeeper,
+		streamKeeper: streamKeeper,
+	}
+}
+
+func (cfd ClaimStreamFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fees := feeTx.GetFee()
+
+	if fees.IsZero() || feeTx.FeeGranter() != nil {
+		return next(ctx, tx, simulate)
+	}
+
+	feePayer := sdk.AccAddress(feeTx.FeePayer())
+
+	stream, ok := cfd.getClaimedStream(ctx, feeTx, feePayer)
+	if !ok {
+		// not a claim by the receiver. Ignore and move on to the next decorator in the chain
+		return next(ctx, tx, simulate)
+	}
+
+	spendable := cfd.bankKeeper.SpendableCoins(ctx, feePayer)
+	if spendable.IsAllGTE(fees) {
+		// the receiver can pay the fees as normal
+		return next(ctx, tx, simulate)
+	}
+
+	if len(fees) != 1 || fees[0].Denom != stream.Deposit.Denom {
+		return ctx, errorsmod.Wrapf(types.ErrInvalidData, "fees paid from stream %d must be a single %s coin", stream.StreamId, stream.Deposit.Denom)
+	}
+
+	streamPayment, valFee, claimTotal, remainingDeposit, err := cfd.streamKeeper.ClaimFromStream(ctx, stream.StreamId)
+
+	if err != nil {
+		return ctx, errorsmod.Wrap(err, "failed to claim from stream to pay fees")
+	}
+
+	// the max fee is at most the amount claimed, so the claim always covers any shortfall
+	params := cfd.streamKeeper.GetParams(ctx)
+	maxFee := params.MaxClaimFee(streamPayment)
+	if maxFee.IsLT(fees[0]) {
+		return ctx, errorsmod.Wrapf(types.ErrInsufficientClaim, "claimed %s from stream %d. Fees paid from the claim cannot be more than %s, got %s", streamPayment.String(), stream.StreamId, maxFee.String(), fees.String())
+	}
+
+	feeCollectorAmount, communityPoolAmount, burnedAmount := params.SplitValidatorFee(valFee)
+
+	ctx = types.WithFeeClaim(ctx, types.StreamClaimResult{
+		StreamId:            stream.StreamId,
+		Sender:              stream.Sender,
+		TotalClaimed:        claimTotal,
+		StreamPayment:       streamPayment,
+		ValidatorFee:        valFee,
+		RemainingDeposit:    remainingDeposit,
+		FeeCollectorAmount:  feeCollectorAmount,
+		CommunityPoolAmount: communityPoolAmount,
+		BurnedAmount:        burnedAmount,
+	})
+
+	return next(ctx, tx, simulate)
+}
+
+// getClaimedStream returns the stream being claimed if the Tx consists of a single claim Msg, made by the fee payer
+// as the stream's receiver
+func (cfd ClaimStreamFeeDecorator) getClaimedStream(ctx sdk.Context, tx sdk.FeeTx, feePayer sdk.AccAddress) (types.Stream, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return types.Stream{}, false
+	}
+
+	var streamID uint64
+	switch msg := msgs[0].(type) {
+	case *types.MsgClaimStream:
+		receiverAddr, err := sdk.AccAddressFromBech32(msg.Receiver)
+		if err != nil {
+			return types.Stream{}, false
+		}
+		senderAddr, err := sdk.AccAddressFromBech32(msg.Sender)
+		if err != nil {
+			return types.Stream{}, false
+		}
+		streamID, err = cfd.streamKeeper.ResolveStreamID(ctx, receiverAddr, senderAddr, msg.Denom)
+		if err != nil {
+			return types.Stream{}, false
+		}
+	case *types.MsgClaimStreamById:
+		if msg.Receiver != feePayer.String() {
+			return types.Stream{}, false
+		}
+		streamID = msg.StreamId
+	default:
+		return types.Stream{}, false
+	}
+
+	stream, ok := cfd.streamKeeper.GetStream(ctx, streamID)
+	if !ok || stream.Receiver != feePayer.String() {
+		return types.Stream{}, false
+	}
+
+	return stream, true
+}
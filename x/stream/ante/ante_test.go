@@ -0,0 +1,331 @@
+package ante_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	mathmod "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/stretchr/testify/suite"
+
+	simapp "github.com/unification-com/mainchain/app"
+	simapphelpers "github.com/unification-com/mainchain/app/helpers"
+	"github.com/unification-com/mainchain/x/stream/ante"
+	"github.com/unification-com/mainchain/x/stream/keeper"
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+type AnteTestSuite struct {
+	suite.Suite
+
+	app         *simapp.App
+	ctx         sdk.Context
+	txGen       client.TxConfig
+	anteHandler sdk.AnteHandler
+	privKey     *ed25519.PrivKey
+	receiver    sdk.AccAddress
+	sender      sdk.AccAddress
+	streamID    uint64
+}
+
+func TestAnteTestSuite(t *testing.T) {
+	suite.Run(t, new(AnteTestSuite))
+}
+
+func (s *AnteTestSuite) SetupTest() {
+	app := simapphelpers.Setup(s.T())
+	ctx := app.BaseApp.NewContext(false)
+
+	feeDecorator := ante.NewClaimStreamFeeDecorator(app.BankKeeper, app.StreamKeeper)
+	deductFeeDecorator := authante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, nil)
+	anteHandler := sdk.ChainAnteDecorators(feeDecorator, deductFeeDecorator)
+
+	// the receiver has no liquid balance
+	privK, receiver := simapphelpers.AddTestAccForTxSigning(app, ctx, sdk.NewCoins())
+	sender := simapphelpers.AddTestAddrsIncremental(app, ctx, 1, mathmod.NewInt(1000000000))[0]
+
+	// 10nund per second for 10,000 seconds
+	msgServer := keeper.NewMsgServerImpl(app.StreamKeeper)
+	res, err := msgServer.CreateStream(ctx, types.NewMsgCreateStream(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000), 10, receiver, sender))
+	s.Require().NoError(err)
+
+	// 1000nund accrued. 990nund is claimable by the receiver after the 1% validator fee
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second * 100))
+
+	s.app = app
+	s.ctx = ctx
+	s.txGen = app.GetTxConfig()
+	s.anteHandler = anteHandler
+	s.privKey = privK
+	s.receiver = receiver
+	s.sender = sender
+	s.streamID = res.StreamId
+}
+
+func (s *AnteTestSuite) TestAnteHandler() {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	testCases := []struct {
+		name          string
+		msgs          func() []sdk.Msg
+		feeToSend     sdk.Coins
+		receiverFunds sdk.Coins
+		expectErr     bool
+		expErrMsg     string
+		expFeeClaim   bool
+		expBalance    sdk.Coins
+	}{
+		{
+			name: "fee paid from MsgClaimStreamById claim",
+			msgs: func() []sdk.Msg {
+				return []sdk.Msg{types.NewMsgClaimStreamById(s.receiver, s.streamID)}
+			},
+			feeToSend:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			expectErr:   false,
+			expFeeClaim: true,
+			expBalance:  sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 890)),
+		},
+		{
+			name: "fee paid from MsgClaimStream claim",
+			msgs: func() []sdk.Msg {
+				return []sdk.Msg{types.NewMsgClaimStream(s.receiver, s.sender, "")}
+			},
+			feeToSend:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 495)),
+			expectErr:   false,
+			expFeeClaim: true,
+			expBalance:  sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 495)),
+		},
+		{
+			name: "fee partly paid from receiver balance",
+			msgs: func() []sdk.Msg {
+				return []sdk.Msg{types.NewMsgClaimStreamById(s.receiver, s.streamID)}
+			},
+			feeToSend:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400)),
+			receiverFunds: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)),
+			expectErr:     false,
+			expFeeClaim:   true,
+			expBalance:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600)),
+		},
+		{
+			name: "receiver can pay fee, nothing claimed",
+			msgs: func() []sdk.Msg {
+				return []sdk.Msg{types.NewMsgClaimStreamById(s.receiver, s.streamID)}
+			},
+			feeToSend:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			receiverFunds: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150)),
+			expectErr:     false,
+			expFeeClaim:   false,
+			expBalance:    sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)),
+		},
+		{
+			name: "fee more than max claim fee",
+			msgs: func() []sdk.Msg {
+				return []sdk.Msg{types.NewMsgClaimStreamById(s.receiver, s.streamID)}
+			},
+			feeToSend: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 496)),
+			expectErr: true,
+			expErrMsg: "claimed 990nund from stream 1. Fees paid from the claim cannot be more than 495nund, got 496nund: insufficient claim to pay fees",
+		},
+		{
+			name: "fee more than claim",
+			msgs: func() []sdk.Msg {
+				return []sdk.Msg{types.NewMsgClaimStreamById(s.receiver, s.streamID)}
+			},
+			feeToSend:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000)),
+			receiverFunds: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 99999)),
+			expectErr:     true,
+			expErrMsg:     "claimed 990nund from stream 1. Fees paid from the claim cannot be more than 495nund, got 100000nund: insufficient claim to pay fees",
+		},
+		{
+			name: "fee not in stream denom",
+			msgs: func() []sdk.Msg {
+				return []sdk.Msg{types.NewMsgClaimStreamById(s.receiver, s.streamID)}
+			},
+			feeToSend: sdk.NewCoins(sdk.NewInt64Coin("uatom", 100)),
+			expectErr: true,
+			expErrMsg: "fees paid from stream 1 must be a single nund coin: invalid data",
+		},
+		{
+			name: "claim with other msgs not paid from claim",
+			msgs: func() []sdk.Msg {
+				return []sdk.Msg{
+					types.NewMsgClaimStreamById(s.receiver, s.streamID),
+					types.NewMsgClaimStream(s.receiver, s.sender, ""),
+				}
+			},
+			feeToSend: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			expectErr: true,
+			expErrMsg: "insufficient funds",
+		},
+		{
+			name: "claim by non receiver not paid from claim",
+			msgs: func() []sdk.Msg {
+				return []sdk.Msg{types.NewMsgClaimStreamById(s.sender, s.streamID)}
+			},
+			feeToSend:   sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+			expectErr:   false,
+			expFeeClaim: false,
+			expBalance:  sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			ctx, _ := s.ctx.CacheContext()
+
+			if !tc.receiverFunds.IsZero() {
+				s.Require().NoError(s.app.BankKeeper.SendCoins(ctx, s.sender, s.receiver, tc.receiverFunds))
+			}
+
+			tx, err := simtestutil.GenSignedMockTx(r, s.txGen, tc.msgs(), tc.feeToSend, simtestutil.DefaultGenTxGas, simapphelpers.SimAppChainID, []uint64{0}, []uint64{0}, s.privKey)
+			s.Require().NoError(err)
+
+			newCtx, err := s.anteHandler(ctx, tx, false)
+
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				return
+			}
+
+			s.Require().NoError(err)
+
+			feeClaim, ok := types.GetFeeClaim(newCtx, s.streamID)
+			s.Require().Equal(tc.expFeeClaim, ok)
+			s.Require().Equal(tc.expBalance.String(), s.app.BankKeeper.GetAllBalances(ctx, s.receiver).String())
+
+			stream, _ := s.app.StreamKeeper.GetStream(ctx, s.streamID)
+			if tc.expFeeClaim {
+				s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), feeClaim.TotalClaimed)
+				s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 990), feeClaim.StreamPayment)
+				s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), feeClaim.ValidatorFee)
+				s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 99000), feeClaim.RemainingDeposit)
+				s.Require().Equal(s.sender.String(), feeClaim.Sender)
+				s.Require().Equal(stream.Deposit, feeClaim.RemainingDeposit)
+				s.Require().Equal(ctx.BlockTime(), stream.LastOutflowTime)
+			} else {
+				s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000), stream.Deposit)
+			}
+		})
+	}
+}
+
+func (s *AnteTestSuite) TestFeeClaimUsedByMsgServer() {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	msg := types.NewMsgClaimStreamById(s.receiver, s.streamID)
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	tx, err := simtestutil.GenSignedMockTx(r, s.txGen, []sdk.Msg{msg}, fee, simtestutil.DefaultGenTxGas, simapphelpers.SimAppChainID, []uint64{0}, []uint64{0}, s.privKey)
+	s.Require().NoError(err)
+
+	newCtx, err := s.anteHandler(s.ctx, tx, false)
+	s.Require().NoError(err)
+
+	// the Msg handler returns the ante handler's claim, and does not claim again
+	msgServer := keeper.NewMsgServerImpl(s.app.StreamKeeper)
+	res, err := msgServer.ClaimStreamById(newCtx, msg)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), res.TotalClaimed)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 990), res.StreamPayment)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 99000), res.RemainingDeposit)
+
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 890)), s.app.BankKeeper.GetAllBalances(newCtx, s.receiver))
+}
+
+func (s *AnteTestSuite) TestClaimDiscardedWithoutValidSignature() {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	wrongPrivKey := ed25519.GenPrivKey()
+
+	testCases := []struct {
+		name       string
+		chainID    string
+		privKeys   func() []cryptotypes.PrivKey
+		expCode    uint32
+		expClaimed bool
+	}{
+		{
+			name:       "valid signature, fee paid from claim",
+			chainID:    s.app.ChainID(),
+			privKeys:   func() []cryptotypes.PrivKey { return []cryptotypes.PrivKey{s.privKey} },
+			expCode:    0,
+			expClaimed: true,
+		},
+		{
+			name:     "unsigned",
+			chainID:  s.app.ChainID(),
+			privKeys: func() []cryptotypes.PrivKey { return []cryptotypes.PrivKey{} },
+			expCode:  sdkerrors.ErrNoSignatures.ABCICode(),
+		},
+		{
+			name:     "invalid signature",
+			chainID:  "wrong-chain-id",
+			privKeys: func() []cryptotypes.PrivKey { return []cryptotypes.PrivKey{s.privKey} },
+			expCode:  sdkerrors.ErrUnauthorized.ABCICode(),
+		},
+		{
+			name:     "signed by another key",
+			chainID:  s.app.ChainID(),
+			privKeys: func() []cryptotypes.PrivKey { return []cryptotypes.PrivKey{wrongPrivKey} },
+			expCode:  sdkerrors.ErrInvalidPubKey.ABCICode(),
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+
+			// commit the stream, then deliver the claim in the next block through the full AnteHandler chain
+			stream, _ := s.app.StreamKeeper.GetStream(s.ctx, s.streamID)
+			s.ctx.MultiStore().(storetypes.CacheMultiStore).Write()
+			_, err := s.app.Commit()
+			s.Require().NoError(err)
+
+			privKeys := tc.privKeys()
+			accNum := s.app.AccountKeeper.GetAccount(s.ctx, s.receiver).GetAccountNumber()
+			accNums := make([]uint64, len(privKeys))
+			accSeqs := make([]uint64, len(privKeys))
+			for i := range privKeys {
+				accNums[i] = accNum
+			}
+
+			msgs := []sdk.Msg{types.NewMsgClaimStreamById(s.receiver, s.streamID)}
+			fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+			tx, err := simtestutil.GenSignedMockTx(r, s.txGen, msgs, fee, 200000, tc.chainID, accNums, accSeqs, privKeys...)
+			s.Require().NoError(err)
+			txBytes, err := s.txGen.TxEncoder()(tx)
+			s.Require().NoError(err)
+
+			res, err := s.app.FinalizeBlock(&abci.RequestFinalizeBlock{
+				Height: s.app.LastBlockHeight() + 1,
+				Time:   s.ctx.BlockTime(),
+				Txs:    [][]byte{txBytes},
+			})
+			s.Require().NoError(err)
+			s.Require().Len(res.TxResults, 1)
+			s.Require().Equal(tc.expCode, res.TxResults[0].Code, res.TxResults[0].Log)
+
+			ctx := s.app.BaseApp.NewContext(false)
+			streamAfter, _ := s.app.StreamKeeper.GetStream(ctx, s.streamID)
+			if tc.expClaimed {
+				s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 99000), streamAfter.Deposit)
+				s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 890)), s.app.BankKeeper.GetAllBalances(ctx, s.receiver))
+			} else {
+				// the claim made before the signatures were verified is discarded with the rest of the Tx
+				s.Require().Equal(stream.Deposit, streamAfter.Deposit)
+				s.Require().Equal(stream.LastOutflowTime, streamAfter.LastOutflowTime)
+				s.Require().True(s.app.BankKeeper.GetAllBalances(ctx, s.receiver).IsZero())
+			}
+		})
+	}
+}
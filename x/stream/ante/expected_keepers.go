@@ -0,0 +1,20 @@
+package ante
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+type BankKeeper interface {
+	SpendableCoins(ctx context.Context, address sdk.AccAddress) sdk.Coins
+}
+
+type StreamKeeper interface {
+	GetParams(ctx sdk.Context) types.Params
+	GetStream(ctx sdk.Context, streamID uint64) (types.Stream, bool)
+	ResolveStreamID(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddress, denom string) (uint64, error)
+	ClaimFromStream(ctx sdk.Context, streamID uint64) (sdk.Coin, sdk.Coin, sdk.Coin, sdk.Coin, error)
+}
@@ -43,23 +43,66 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 				},
 				{
 					RpcMethod: "StreamByReceiverSender",
-					Use:       "stream [receiver_addr] [sender_addr]",
+					Use:       "stream [receiver_addr] [sender_addr] [denom]",
 					Short:     "Query a stream for receiver/sender pair",
-					Example:   fmt.Sprintf("$ %s query stream stream und1eq239sgefyzm4crl85nfyvt7kw83vrna3f0eed und1chknpc8nf2tmj5582vhlvphnjyekc9ypspx5ay", version.AppName),
+					Long:      "Query a stream for receiver/sender pair. The denom is optional if the pair only has a single stream",
+					Example:   fmt.Sprintf("$ %s query stream stream und1eq239sgefyzm4crl85nfyvt7kw83vrna3f0eed und1chknpc8nf2tmj5582vhlvphnjyekc9ypspx5ay nund", version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "receiver_addr"},
 						{ProtoField: "sender_addr"},
+						{ProtoField: "denom", Optional: true},
+					},
+				},
+				{
+					RpcMethod: "StreamById",
+					Use:       "stream-by-id [stream_id]",
+					Short:     "Query a stream by its stream ID",
+					Long:      "Query a stream by its stream ID. Required if a receiver/sender pair has more than one stream in the same denom",
+					Example:   fmt.Sprintf("$ %s query stream stream-by-id 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "stream_id"},
+					},
+				},
+				{
+					RpcMethod: "StreamStats",
+					Use:       "stream-stats [stream_id]",
+					Short:     "Query a stream's claim statistics",
+					Long:      "Query the total claimed, total validator fees and number of claims for a stream",
+					Example:   fmt.Sprintf("$ %s query stream stream-stats 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "stream_id"},
+					},
+				},
+				{
+					RpcMethod: "StreamHistory",
+					Use:       "stream-history [stream_id]",
+					Short:     "Query a stream's flow rate change history",
+					Long:      "Query the most recent flow rate changes for a stream, oldest first",
+					Example:   fmt.Sprintf("$ %s query stream stream-history 1", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "stream_id"},
+					},
+				},
+				{
+					RpcMethod: "StreamsExpiringBefore",
+					Use:       "expiring-before [time]",
+					Short:     "Query streams which stop flowing before a given time",
+					Long:      "Query streams whose deposit runs out, or which reach their end time, before the given RFC3339 time, in expiry order",
+					Example:   fmt.Sprintf("$ %s query stream expiring-before 2025-01-01T00:00:00Z", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "before"},
 					},
 				},
 				{
 					RpcMethod: "StreamReceiverSenderCurrentFlow",
-					Use:       "stream-flow [receiver_addr] [sender_addr]",
+					Use:       "stream-flow [receiver_addr] [sender_addr] [denom]",
 					Short:     "Query a stream's current flow for receiver/sender pair",
-					Long:      "Query a stream's current flow rate for receiver/sender pair. This will be zero if the stream has expired",
-					Example:   fmt.Sprintf("$ %s query stream stream-flow und1eq239sgefyzm4crl85nfyvt7kw83vrna3f0eed und1chknpc8nf2tmj5582vhlvphnjyekc9ypspx5ay", version.AppName),
+					Long:      "Query a stream's current flow rate for receiver/sender pair. This will be zero if the stream has expired. The denom is optional if the pair only has a single stream",
+					Example:   fmt.Sprintf("$ %s query stream stream-flow und1eq239sgefyzm4crl85nfyvt7kw83vrna3f0eed und1chknpc8nf2tmj5582vhlvphnjyekc9ypspx5ay nund", version.AppName),
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
 						{ProtoField: "receiver_addr"},
 						{ProtoField: "sender_addr"},
+						{ProtoField: "denom", Optional: true},
 					},
 				},
 				{
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+// GetCmdStreamAudit returns the command to run the stream module's invariant checks against an exported genesis file
+func GetCmdStreamAudit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stream-audit [genesis-file]",
+		Short: "Audit the stream module state in an exported genesis file",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Run the stream module's invariant checks against an exported genesis file. Checks that
+the stream module account's balance equals the sum of every stream's deposit, that no stream has a negative
+deposit, and that each stream's deposit zero time is consistent with its last outflow time, deposit and flow rate.
+
+Example:
+$ %s debug stream-audit /path/to/exported_genesis.json
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			appGenesis, err := genutiltypes.AppGenesisFromFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var appState map[string]json.RawMessage
+			if err := json.Unmarshal(appGenesis.AppState, &appState); err != nil {
+				return fmt.Errorf("failed to unmarshal app state: %w", err)
+			}
+
+			if appState[types.ModuleName] == nil {
+				return fmt.Errorf("%s module state not found in genesis file", types.ModuleName)
+			}
+
+			var streamGenesis types.GenesisState
+			if err := clientCtx.Codec.UnmarshalJSON(appState[types.ModuleName], &streamGenesis); err != nil {
+				return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+			}
+
+			bankGenesis := banktypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+
+			moduleAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+			moduleBalance := sdk.NewCoins()
+			for _, balance := range bankGenesis.Balances {
+				if balance.Address == moduleAddr {
+					moduleBalance = balance.Coins
+					break
+				}
+			}
+
+			streams := make([]types.Stream, 0, len(streamGenesis.Streams))
+			for _, s := range streamGenesis.Streams {
+				streams = append(streams, s.Stream)
+			}
+
+			problems := types.AuditStreams(streams, moduleBalance)
+			if err := streamGenesis.Validate(); err != nil {
+				problems = append(problems, fmt.Errorf("invalid genesis state: %w", err))
+			}
+
+			cmd.Printf("audited %d streams. module account %s balance: %s\n", len(streams), moduleAddr, moduleBalance.String())
+
+			if len(problems) == 0 {
+				cmd.Println("no problems found")
+				return nil
+			}
+
+			for _, problem := range problems {
+				cmd.Printf("\t%s\n", problem.Error())
+			}
+
+			return fmt.Errorf("found %d problems", len(problems))
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,110 @@
+package cli_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+
+	"github.com/unification-com/mainchain/x/stream/client/cli"
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+func (s *CLITestSuite) writeAuditGenesis(stream types.Stream, moduleBalance sdk.Coins) string {
+	streamGenesis := types.DefaultGenesis()
+	streamGenesis.StartingStreamId = 2
+	streamGenesis.Streams = []types.StreamExport{
+		{
+			Receiver: stream.Receiver,
+			Sender:   stream.Sender,
+			Stream:   stream,
+		},
+	}
+
+	bankGenesis := banktypes.DefaultGenesisState()
+	bankGenesis.Balances = []banktypes.Balance{
+		{
+			Address: authtypes.NewModuleAddress(types.ModuleName).String(),
+			Coins:   moduleBalance,
+		},
+	}
+
+	appState, err := json.Marshal(map[string]json.RawMessage{
+		types.ModuleName:     s.encCfg.Codec.MustMarshalJSON(streamGenesis),
+		banktypes.ModuleName: s.encCfg.Codec.MustMarshalJSON(bankGenesis),
+	})
+	s.Require().NoError(err)
+
+	genFile := filepath.Join(s.T().TempDir(), "genesis.json")
+	s.Require().NoError(genutiltypes.NewAppGenesisWithVersion("test-chain", appState).SaveAs(genFile))
+
+	return genFile
+}
+
+func (s *CLITestSuite) TestStreamAuditCmd() {
+	lastOutflowTime := time.Unix(1700000000, 0).UTC()
+
+	stream := types.Stream{
+		StreamId:        1,
+		Receiver:        sdk.AccAddress("receiver____________").String(),
+		Sender:          sdk.AccAddress("sender______________").String(),
+		Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+		FlowRate:        10,
+		LastOutflowTime: lastOutflowTime,
+		DepositZeroTime: lastOutflowTime.Add(time.Second * 100),
+	}
+
+	invalidStream := stream
+	invalidStream.DepositZeroTime = lastOutflowTime.Add(time.Second * 200)
+
+	testCases := []struct {
+		name      string
+		genFile   string
+		expErrMsg string
+	}{
+		{
+			"valid",
+			s.writeAuditGenesis(stream, sdk.NewCoins(stream.Deposit)),
+			"",
+		},
+		{
+			"module account balance does not match",
+			s.writeAuditGenesis(stream, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 999))),
+			"found 1 problems",
+		},
+		{
+			"invalid deposit zero time",
+			s.writeAuditGenesis(invalidStream, sdk.NewCoins(stream.Deposit)),
+			"found 1 problems",
+		},
+		{
+			"genesis file does not exist",
+			filepath.Join(s.T().TempDir(), "missing.json"),
+			"no such file or directory",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdStreamAudit()
+			cmd.SetOut(io.Discard)
+			cmd.SetArgs([]string{tc.genFile})
+
+			ctx := context.WithValue(context.Background(), client.ClientContextKey, &s.baseCtx)
+			err := cmd.ExecuteContext(ctx)
+
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package cli
 
 import (
+	"encoding/base64"
 	"fmt"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -15,6 +17,17 @@ import (
 	"github.com/unification-com/mainchain/x/stream/types"
 )
 
+const (
+	FlagDenom      = "denom"
+	FlagStartTime  = "start-time"
+	FlagCliffTime  = "cliff-time"
+	FlagEndTime    = "end-time"
+	FlagSenders    = "senders"
+	FlagMaxStreams = "max-streams"
+	FlagStartKey   = "start-key"
+	FlagPausable   = "pausable"
+)
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -31,6 +44,18 @@ func GetTxCmd() *cobra.Command {
 		GetCmdTopUpDeposit(),
 		GetCmdUpdateFlowRate(),
 		GetCmdCancelStream(),
+		GetCmdClaimStreamById(),
+		GetCmdTopUpDepositById(),
+		GetCmdUpdateFlowRateById(),
+		GetCmdCancelStreamById(),
+		GetCmdClaimAllStreams(),
+		GetCmdTransferStreamReceiver(),
+		GetCmdPauseStream(),
+		GetCmdResumeStream(),
+		GetCmdSetLowDepositThreshold(),
+		GetCmdRequestStreamTermination(),
+		GetCmdAcceptStreamTermination(),
+		GetCmdAcceptPausableStream(),
 	)
 
 	return cmd
@@ -45,6 +70,8 @@ func GetCmdCreateStream() *cobra.Command {
 			fmt.Sprintf(`Create a new payment stream
 Example:
 $ %s tx %s create und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy 777000000000nund 299768 --from t1
+$ %[1]s tx %[2]s create und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy 777000000000nund 299768 --start-time 2026-01-01T00:00:00Z --cliff-time 2026-02-01T00:00:00Z --end-time 2026-12-31T00:00:00Z --from t1
+$ %[1]s tx %[2]s create und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy 777000000000nund 299768 --pausable --from t1
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -75,6 +102,20 @@ $ %s tx %s create und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy 777000000000nund 29
 			}
 
 			msg := types.NewMsgCreateStream(deposit, flowRate, receiver, sender)
+
+			if msg.StartTime, err = getTimeFlag(cmd, FlagStartTime); err != nil {
+				return err
+			}
+			if msg.CliffTime, err = getTimeFlag(cmd, FlagCliffTime); err != nil {
+				return err
+			}
+			if msg.EndTime, err = getTimeFlag(cmd, FlagEndTime); err != nil {
+				return err
+			}
+			if msg.Pausable, err = cmd.Flags().GetBool(FlagPausable); err != nil {
+				return err
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -82,11 +123,31 @@ $ %s tx %s create und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy 777000000000nund 29
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(FlagStartTime, "", "(optional) RFC3339 time the stream starts flowing. Defaults to the block time the stream is created")
+	cmd.Flags().String(FlagCliffTime, "", "(optional) RFC3339 time before which nothing can be claimed from the stream")
+	cmd.Flags().String(FlagEndTime, "", "(optional) RFC3339 time the stream ends. Any deposit remaining is returned to the sender")
+	cmd.Flags().Bool(FlagPausable, false, "(optional) allow the stream to be paused by the sender, once the receiver has accepted")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
-// GetCmdClaimStreamById is the CLI command for claiming funds held in a stream
+// getTimeFlag parses an optional RFC3339 time flag, returning nil if it is not set
+func getTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	val, _ := cmd.Flags().GetString(flag)
+	if val == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, val)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", flag, err)
+	}
+
+	t = t.UTC()
+	return &t, nil
+}
+
+// GetCmdClaimStream is the CLI command for claiming funds held in a stream
 func GetCmdClaimStream() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "claim [sender_Addr]",
@@ -95,6 +156,7 @@ func GetCmdClaimStream() *cobra.Command {
 			fmt.Sprintf(`Claim funds held in a stream by sender address
 Example:
 $ %s tx %s claim und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy --from t1
+$ %[1]s tx %[2]s claim und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy --denom nund --from t1
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -113,7 +175,9 @@ $ %s tx %s claim und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy --from t1
 				return err
 			}
 
-			msg := types.NewMsgClaimStream(receiver, sender)
+			denom, _ := cmd.Flags().GetString(FlagDenom)
+
+			msg := types.NewMsgClaimStream(receiver, sender, denom)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -121,6 +185,7 @@ $ %s tx %s claim und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy --from t1
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(FlagDenom, "", "(optional) denom of the stream. Required if the pair has streams in more than one denom. Use the by-id command if the pair has more than one stream in the same denom")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -178,6 +243,7 @@ func GetCmdUpdateFlowRate() *cobra.Command {
 			fmt.Sprintf(`Change the flow rate of a stream
 Example:
 $ %s tx %s update-flow und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy 246973 --from t1
+$ %[1]s tx %[2]s update-flow und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy 246973 --denom nund --from t1
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -201,7 +267,9 @@ $ %s tx %s update-flow und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy 246973 --from
 				return err
 			}
 
-			msg := types.NewMsgUpdateFlowRate(receiver, sender, flowRate)
+			denom, _ := cmd.Flags().GetString(FlagDenom)
+
+			msg := types.NewMsgUpdateFlowRate(receiver, sender, flowRate, denom)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -209,6 +277,7 @@ $ %s tx %s update-flow und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy 246973 --from
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(FlagDenom, "", "(optional) denom of the stream. Required if the pair has streams in more than one denom. Use the by-id command if the pair has more than one stream in the same denom")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -222,6 +291,7 @@ func GetCmdCancelStream() *cobra.Command {
 			fmt.Sprintf(`Cancel a stream
 Example:
 $ %s tx %s cancel und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy --from t1
+$ %[1]s tx %[2]s cancel und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy --denom nund --from t1
 `,
 				version.AppName, types.ModuleName,
 			),
@@ -240,7 +310,547 @@ $ %s tx %s cancel und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy --from t1
 				return err
 			}
 
-			msg := types.NewMsgCancelStream(receiver, sender)
+			denom, _ := cmd.Flags().GetString(FlagDenom)
+
+			msg := types.NewMsgCancelStream(receiver, sender, denom)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().String(FlagDenom, "", "(optional) denom of the stream. Required if the pair has streams in more than one denom. Use the by-id command if the pair has more than one stream in the same denom")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdClaimStreamById is the CLI command for claiming funds held in a stream, by stream ID
+func GetCmdClaimStreamById() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-by-id [stream_id]",
+		Short: "Claim funds held in a stream by stream ID",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim funds held in a stream by stream ID
+Example:
+$ %s tx %s claim-by-id 1 --from t1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			receiver := clientCtx.GetFromAddress()
+
+			streamId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClaimStreamById(receiver, streamId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdTopUpDepositById is the CLI command for topping up deposit in a stream, by stream ID
+func GetCmdTopUpDepositById() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "topup-by-id [stream_id] [deposit]",
+		Short: "Top up deposit in a stream by stream ID",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Top up deposit in a stream by stream ID
+Example:
+$ %s tx %s topup-by-id 1 100000000000nund --from t1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender := clientCtx.GetFromAddress()
+
+			streamId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTopUpDepositById(sender, streamId, deposit)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdUpdateFlowRateById is the CLI command for updating the flow rate of a stream, by stream ID
+func GetCmdUpdateFlowRateById() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-flow-by-id [stream_id] [new_flow_rate]",
+		Short: "Change the flow rate of a stream by stream ID",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Change the flow rate of a stream by stream ID
+Example:
+$ %s tx %s update-flow-by-id 1 246973 --from t1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender := clientCtx.GetFromAddress()
+
+			streamId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			flowRate, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateFlowRateById(sender, streamId, flowRate)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdCancelStreamById is the CLI command for cancelling a stream, by stream ID
+func GetCmdCancelStreamById() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-by-id [stream_id]",
+		Short: "Cancel a stream by stream ID",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a stream by stream ID
+Example:
+$ %s tx %s cancel-by-id 1 --from t1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender := clientCtx.GetFromAddress()
+
+			streamId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelStreamById(sender, streamId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdClaimAllStreams is the CLI command for claiming funds held in a batch of the receiver's streams
+func GetCmdClaimAllStreams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-all",
+		Short: "Claim funds held in all, or a batch of, your incoming streams",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Claim funds held in all, or a batch of, your incoming streams. At most %[3]d streams
+are processed per transaction. If more remain, the returned next_key can be passed with --start-key to continue.
+Example:
+$ %[1]s tx %[2]s claim-all --from t1
+$ %[1]s tx %[2]s claim-all --max-streams 100 --from t1
+$ %[1]s tx %[2]s claim-all --senders und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy,und1x8pl6wzqf9atkm77ymc5vn5dnpl5xytmn200xy --from t1
+$ %[1]s tx %[2]s claim-all --start-key EhQ...AAE= --from t1
+`,
+				version.AppName, types.ModuleName, types.MaxClaimAllStreams,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			receiver := clientCtx.GetFromAddress()
+
+			senderStrs, _ := cmd.Flags().GetStringSlice(FlagSenders)
+			var senders []sdk.AccAddress
+			for _, senderStr := range senderStrs {
+				sender, err := sdk.AccAddressFromBech32(senderStr)
+				if err != nil {
+					return err
+				}
+				senders = append(senders, sender)
+			}
+
+			maxStreams, _ := cmd.Flags().GetUint64(FlagMaxStreams)
+
+			var startKey []byte
+			startKeyStr, _ := cmd.Flags().GetString(FlagStartKey)
+			if startKeyStr != "" {
+				startKey, err = base64.StdEncoding.DecodeString(startKeyStr)
+				if err != nil {
+					return fmt.Errorf("invalid %s: %w", FlagStartKey, err)
+				}
+			}
+
+			msg := types.NewMsgClaimAllStreams(receiver, senders, maxStreams, startKey)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	cmd.Flags().StringSlice(FlagSenders, []string{}, "(optional) comma separated list of senders to claim from. Defaults to all senders")
+	cmd.Flags().Uint64(FlagMaxStreams, 0, fmt.Sprintf("(optional) maximum number of streams to process. Defaults to %d, max %d", types.DefaultClaimAllStreams, types.MaxClaimAllStreams))
+	cmd.Flags().String(FlagStartKey, "", "(optional) base64 encoded next_key returned by a previous claim-all, to continue claiming from")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdTransferStreamReceiver is the CLI command for transferring a stream to a new receiver
+func GetCmdTransferStreamReceiver() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-receiver [stream_id] [new_receiver]",
+		Short: "Transfer a stream you receive to a new receiver wallet",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer a stream you receive to a new receiver wallet. Any payments accrued so far are
+claimed to your wallet first. The transfer can also be granted to another wallet using authz, for example:
+$ %[1]s tx authz grant [grantee] generic --msg-type /mainchain.stream.v1.MsgTransferStreamReceiver --from t1
+
+Example:
+$ %[1]s tx %[2]s transfer-receiver 1 und173qnkw458p646fahmd53xa45vqqvga7kyu6ryy --from t1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			receiver := clientCtx.GetFromAddress()
+
+			streamId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			newReceiver, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferStreamReceiver(receiver, streamId, newReceiver)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdPauseStream is the CLI command for pausing a stream
+func GetCmdPauseStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause [stream_id]",
+		Short: "Pause a stream you are sending. The stream must have been created as pausable",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Pause a stream you are sending. Any payments accrued so far are claimed for the receiver,
+and nothing further accrues until the stream is resumed. The stream must have been created with --pausable, and the receiver
+must have accepted it with accept-pausable.
+Example:
+$ %s tx %s pause 1 --from t1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender := clientCtx.GetFromAddress()
+
+			streamId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPauseStream(sender, streamId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdResumeStream is the CLI command for resuming a paused stream
+func GetCmdResumeStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume [stream_id]",
+		Short: "Resume a stream you have paused",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Resume a stream you have paused. The stream's deposit zero time is extended by the time it was paused.
+Example:
+$ %s tx %s resume 1 --from t1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender := clientCtx.GetFromAddress()
+
+			streamId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgResumeStream(sender, streamId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdSetLowDepositThreshold is the CLI command for setting a stream's low deposit alert threshold
+func GetCmdSetLowDepositThreshold() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-low-deposit-threshold [stream_id] [threshold]",
+		Short: "Set the remaining deposit time below which a stream_low_deposit event is emitted for a stream you are sending",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Set the remaining deposit time below which a stream_low_deposit event is emitted for a stream
+you are sending. The event is emitted once each time the stream's remaining deposit falls below the threshold.
+The threshold is a duration, for example 72h. A threshold of 0 disables the alert.
+Example:
+$ %s tx %s set-low-deposit-threshold 1 72h --from t1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender := clientCtx.GetFromAddress()
+
+			streamId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			threshold, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			if threshold < 0 {
+				return fmt.Errorf("threshold cannot be negative")
+			}
+
+			msg := types.NewMsgSetLowDepositThreshold(sender, streamId, uint64(threshold.Seconds()))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRequestStreamTermination is the CLI command for requesting the termination of a stream after a notice period
+func GetCmdRequestStreamTermination() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-termination [stream_id] [notice]",
+		Short: "Request the termination of a stream you are sending, after a notice period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Request the termination of a stream you are sending, including streams that cannot be cancelled.
+The stream continues to flow until the notice period has passed, after which it is settled and any remaining
+deposit is returned to you. The notice is a duration, for example 168h, and must be at least the
+min_termination_notice param. The receiver may accept the termination before the notice period has passed.
+Example:
+$ %s tx %s request-termination 1 168h --from t1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender := clientCtx.GetFromAddress()
+
+			streamId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			notice, err := time.ParseDuration(args[1])
+			if err != nil {
+				return err
+			}
+
+			if notice < 0 {
+				return fmt.Errorf("notice cannot be negative")
+			}
+
+			msg := types.NewMsgRequestStreamTermination(sender, streamId, uint64(notice.Seconds()))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAcceptStreamTermination is the CLI command for accepting the early termination of a stream
+func GetCmdAcceptStreamTermination() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-termination [stream_id]",
+		Short: "Accept the termination of a stream you are receiving, without waiting for the notice period",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Accept the termination of a stream you are receiving, which its sender has requested, without
+waiting for the notice period to pass. Any payment accrued so far is sent to you, and the remaining deposit is
+returned to the sender.
+Example:
+$ %s tx %s accept-termination 1 --from t1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			receiver := clientCtx.GetFromAddress()
+
+			streamId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptStreamTermination(receiver, streamId)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAcceptPausableStream is the CLI command for accepting that a pausable stream can be paused
+func GetCmdAcceptPausableStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-pausable [stream_id]",
+		Short: "Accept that a pausable stream you are receiving can be paused by its sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Accept that a stream you are receiving, which its sender created as pausable, can be paused
+by its sender. The stream cannot be paused until you have accepted.
+Example:
+$ %s tx %s accept-pausable 1 --from t1
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			receiver := clientCtx.GetFromAddress()
+
+			streamId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptPausableStream(receiver, streamId)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -13,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/unification-com/mainchain/x/stream/client/cli"
+	"github.com/unification-com/mainchain/x/stream/types"
 )
 
 func (s *CLITestSuite) TestCreateStreamTxCmd() {
@@ -94,7 +95,7 @@ func (s *CLITestSuite) TestCreateStreamTxCmd() {
 			accounts[1].Address,
 			fmt.Sprintf("--%s=%s", flags.FlagFrom, "key-0"),
 			sdk.NewCoin("stake", mathmod.NewInt(10)),
-			"10",
+			"20",
 			extraArgs,
 			true,
 		},
@@ -134,6 +135,73 @@ func (s *CLITestSuite) TestCreateStreamTxCmd() {
 			extraArgs,
 			true,
 		},
+		{
+			"valid create with schedule",
+			func() client.Context {
+				return s.baseCtx
+			},
+			accounts[1].Address,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, "key-0"),
+			sdk.NewCoin("stake", mathmod.NewInt(1000)),
+			"10",
+			append([]string{
+				fmt.Sprintf("--%s=%s", cli.FlagStartTime, "2030-01-01T00:00:00Z"),
+				fmt.Sprintf("--%s=%s", cli.FlagCliffTime, "2030-01-01T00:01:00Z"),
+				fmt.Sprintf("--%s=%s", cli.FlagEndTime, "2030-01-02T00:00:00Z"),
+			}, extraArgs...),
+			false,
+		},
+		{
+			"valid pausable stream",
+			func() client.Context {
+				return s.baseCtx
+			},
+			accounts[1].Address,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, "key-0"),
+			sdk.NewCoin("stake", mathmod.NewInt(1000)),
+			"10",
+			append([]string{fmt.Sprintf("--%s", cli.FlagPausable)}, extraArgs...),
+			false,
+		},
+		{
+			"invalid pausable flag",
+			func() client.Context {
+				return s.baseCtx
+			},
+			accounts[1].Address,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, "key-0"),
+			sdk.NewCoin("stake", mathmod.NewInt(1000)),
+			"10",
+			append([]string{fmt.Sprintf("--%s=%s", cli.FlagPausable, "maybe")}, extraArgs...),
+			true,
+		},
+		{
+			"invalid start time",
+			func() client.Context {
+				return s.baseCtx
+			},
+			accounts[1].Address,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, "key-0"),
+			sdk.NewCoin("stake", mathmod.NewInt(1000)),
+			"10",
+			append([]string{fmt.Sprintf("--%s=%s", cli.FlagStartTime, "tomorrow")}, extraArgs...),
+			true,
+		},
+		{
+			"fail msg validate basic - end before start",
+			func() client.Context {
+				return s.baseCtx
+			},
+			accounts[1].Address,
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, "key-0"),
+			sdk.NewCoin("stake", mathmod.NewInt(1000)),
+			"10",
+			append([]string{
+				fmt.Sprintf("--%s=%s", cli.FlagStartTime, "2030-01-02T00:00:00Z"),
+				fmt.Sprintf("--%s=%s", cli.FlagEndTime, "2030-01-01T00:00:00Z"),
+			}, extraArgs...),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -457,3 +525,456 @@ func (s *CLITestSuite) TestCancelStreamTxCmd() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestClaimStreamByIdTxCmd() {
+	cmd := cli.GetCmdClaimStreamById()
+	cmd.SetOutput(io.Discard)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", mathmod.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+	}
+
+	testCases := []struct {
+		name      string
+		ctxGen    func() client.Context
+		streamId  string
+		from      string
+		extraArgs []string
+		expectErr bool
+	}{
+		{
+			"valid claim",
+			func() client.Context {
+				return s.baseCtx
+			},
+			"1",
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, "key-0"),
+			extraArgs,
+			false,
+		},
+		{
+			"invalid stream id",
+			func() client.Context {
+				return s.baseCtx
+			},
+			"rubbish",
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, "key-0"),
+			extraArgs,
+			true,
+		},
+		{
+			"zero stream id",
+			func() client.Context {
+				return s.baseCtx
+			},
+			"0",
+			fmt.Sprintf("--%s=%s", flags.FlagFrom, "key-0"),
+			extraArgs,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(append([]string{tc.streamId, tc.from}, tc.extraArgs...))
+
+			s.Require().NoError(client.SetCmdClientContextHandler(tc.ctxGen(), cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestClaimAllStreamsTxCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 2)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", mathmod.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, "key-0"),
+	}
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{
+			"valid claim all",
+			[]string{},
+			false,
+		},
+		{
+			"valid claim with senders, max streams and start key",
+			[]string{
+				fmt.Sprintf("--%s=%s,%s", cli.FlagSenders, accounts[1].Address.String(), sdk.AccAddress("sender______________").String()),
+				fmt.Sprintf("--%s=%d", cli.FlagMaxStreams, 10),
+				fmt.Sprintf("--%s=%s", cli.FlagStartKey, "EwE="),
+			},
+			false,
+		},
+		{
+			"invalid sender",
+			[]string{fmt.Sprintf("--%s=%s", cli.FlagSenders, "rubbish")},
+			true,
+		},
+		{
+			"invalid max streams",
+			[]string{fmt.Sprintf("--%s=%d", cli.FlagMaxStreams, types.MaxClaimAllStreams+1)},
+			true,
+		},
+		{
+			"invalid start key",
+			[]string{fmt.Sprintf("--%s=%s", cli.FlagStartKey, "!!!")},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdClaimAllStreams()
+			cmd.SetOutput(io.Discard)
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(append(tc.args, extraArgs...))
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestTransferStreamReceiverTxCmd() {
+	accounts := testutil.CreateKeyringAccounts(s.T(), s.kr, 2)
+	cmd := cli.GetCmdTransferStreamReceiver()
+	cmd.SetOutput(io.Discard)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", mathmod.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, "key-0"),
+	}
+
+	testCases := []struct {
+		name        string
+		streamId    string
+		newReceiver string
+		expectErr   bool
+	}{
+		{"valid transfer", "1", accounts[1].Address.String(), false},
+		{"invalid stream id", "rubbish", accounts[1].Address.String(), true},
+		{"zero stream id", "0", accounts[1].Address.String(), true},
+		{"invalid new receiver", "1", "rubbish", true},
+		{"new receiver same as receiver", "1", accounts[0].Address.String(), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(append([]string{tc.streamId, tc.newReceiver}, extraArgs...))
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestPauseStreamTxCmd() {
+	cmd := cli.GetCmdPauseStream()
+	cmd.SetOutput(io.Discard)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", mathmod.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, "key-0"),
+	}
+
+	testCases := []struct {
+		name      string
+		streamId  string
+		expectErr bool
+	}{
+		{"valid pause", "1", false},
+		{"invalid stream id", "rubbish", true},
+		{"zero stream id", "0", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(append([]string{tc.streamId}, extraArgs...))
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestResumeStreamTxCmd() {
+	cmd := cli.GetCmdResumeStream()
+	cmd.SetOutput(io.Discard)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", mathmod.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, "key-0"),
+	}
+
+	testCases := []struct {
+		name      string
+		streamId  string
+		expectErr bool
+	}{
+		{"valid resume", "1", false},
+		{"invalid stream id", "rubbish", true},
+		{"zero stream id", "0", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(append([]string{tc.streamId}, extraArgs...))
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestSetLowDepositThresholdTxCmd() {
+	cmd := cli.GetCmdSetLowDepositThreshold()
+	cmd.SetOutput(io.Discard)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", mathmod.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, "key-0"),
+	}
+
+	testCases := []struct {
+		name      string
+		streamId  string
+		threshold string
+		expectErr bool
+	}{
+		{"valid threshold", "1", "72h", false},
+		{"disable alert", "1", "0", false},
+		{"invalid stream id", "rubbish", "72h", true},
+		{"zero stream id", "0", "72h", true},
+		{"invalid threshold", "1", "rubbish", true},
+		{"negative threshold", "1", "-1h", true},
+		{"threshold too long", "1", "87601h", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(append([]string{tc.streamId, tc.threshold}, extraArgs...))
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestRequestStreamTerminationTxCmd() {
+	testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	cmd := cli.GetCmdRequestStreamTermination()
+	cmd.SetOutput(io.Discard)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", mathmod.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, "key-0"),
+	}
+
+	testCases := []struct {
+		name      string
+		streamId  string
+		notice    string
+		expectErr bool
+	}{
+		{"valid notice", "1", "168h", false},
+		{"invalid stream id", "rubbish", "168h", true},
+		{"zero stream id", "0", "168h", true},
+		{"invalid notice", "1", "rubbish", true},
+		{"negative notice", "1", "-1h", true},
+		{"notice too long", "1", "87601h", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(append([]string{tc.streamId, tc.notice}, extraArgs...))
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestAcceptStreamTerminationTxCmd() {
+	testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	cmd := cli.GetCmdAcceptStreamTermination()
+	cmd.SetOutput(io.Discard)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", mathmod.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, "key-0"),
+	}
+
+	testCases := []struct {
+		name      string
+		streamId  string
+		expectErr bool
+	}{
+		{"valid accept", "1", false},
+		{"invalid stream id", "rubbish", true},
+		{"zero stream id", "0", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(append([]string{tc.streamId}, extraArgs...))
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
+
+func (s *CLITestSuite) TestAcceptPausableStreamTxCmd() {
+	testutil.CreateKeyringAccounts(s.T(), s.kr, 1)
+
+	cmd := cli.GetCmdAcceptPausableStream()
+	cmd.SetOutput(io.Discard)
+
+	extraArgs := []string{
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastSync),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin("photon", mathmod.NewInt(10))).String()),
+		fmt.Sprintf("--%s=test-chain", flags.FlagChainID),
+		fmt.Sprintf("--%s=%s", flags.FlagFrom, "key-0"),
+	}
+
+	testCases := []struct {
+		name      string
+		streamId  string
+		expectErr bool
+	}{
+		{"valid accept", "1", false},
+		{"invalid stream id", "rubbish", true},
+		{"zero stream id", "0", true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			ctx := svrcmd.CreateExecuteContext(context.Background())
+
+			cmd.SetContext(ctx)
+			cmd.SetArgs(append([]string{tc.streamId}, extraArgs...))
+
+			s.Require().NoError(client.SetCmdClientContextHandler(s.baseCtx, cmd))
+
+			err := cmd.Execute()
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+// SettleDepletedStreams settles streams in the expiry queue whose deposit zero time has passed.
+// At most types.MaxStreamSettlementsPerBlock streams are settled per block. Each stream is settled in its own
+// cached context, so a stream which fails to settle cannot halt the chain or affect any other stream. A failed
+// stream is removed from the expiry queue so that it does not block the queue, and is left in state to be
+// claimed or cancelled by its receiver or sender. It is queued again the next time it is updated.
+func (k Keeper) SettleDepletedStreams(ctx sdk.Context) {
+	logger := k.Logger(ctx)
+	var depletedStreams []uint64
+	var depositZeroTimes []time.Time
+
+	// collect first - settling modifies the queue
+	k.IterateStreamExpiryQueue(ctx, ctx.BlockTime(), func(streamID uint64, depositZeroTime time.Time) bool {
+		depletedStreams = append(depletedStreams, streamID)
+		depositZeroTimes = append(depositZeroTimes, depositZeroTime)
+		return len(depletedStreams) >= types.MaxStreamSettlementsPerBlock
+	})
+
+	for i, streamID := range depletedStreams {
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		err := k.SettleStream(cacheCtx, streamID)
+		if err != nil {
+			k.RemoveFromStreamExpiryQueue(ctx, streamID, depositZeroTimes[i])
+
+			logger.Error("failed to settle depleted stream", "stream_id", streamID, "err", err)
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeStreamSettleFailed,
+					sdk.NewAttribute(types.AttributeKeyStreamId, strconv.FormatUint(streamID, 10)),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			continue
+		}
+
+		writeCache()
+
+		if !ctx.IsCheckTx() {
+			logger.Debug("depleted stream settled", "stream_id", streamID)
+		}
+	}
+}
+
+// AlertLowDepositStreams emits a stream_low_deposit event for each stream in the low deposit queue whose
+// alert time has passed, and marks it as alerted so the event is only emitted once per crossing of its
+// threshold. At most types.MaxLowDepositAlertsPerBlock streams are alerted per block. A stream which cannot be
+// updated is logged and skipped.
+func (k Keeper) AlertLowDepositStreams(ctx sdk.Context) {
+	var lowDepositStreams []uint64
+	var alertTimes []time.Time
+
+	// collect first - alerting modifies the queue
+	k.IterateStreamLowDepositQueue(ctx, ctx.BlockTime(), func(streamID uint64, alertTime time.Time) bool {
+		lowDepositStreams = append(lowDepositStreams, streamID)
+		alertTimes = append(alertTimes, alertTime)
+		return len(lowDepositStreams) >= types.MaxLowDepositAlertsPerBlock
+	})
+
+	for i, streamID := range lowDepositStreams {
+		stream, ok := k.GetStream(ctx, streamID)
+		if !ok {
+			k.RemoveFromStreamLowDepositQueue(ctx, streamID, alertTimes[i])
+			continue
+		}
+
+		expiryTime, _ := stream.ExpiryQueueTime()
+		remainingDuration := int64(0)
+		if expiryTime.After(ctx.BlockTime()) {
+			remainingDuration = int64(expiryTime.Sub(ctx.BlockTime()).Seconds())
+		}
+
+		stream.LowDepositAlerted = true
+		if err := k.SetStream(ctx, stream); err != nil {
+			k.Logger(ctx).Error("failed to alert low deposit stream", "stream_id", streamID, "err", err)
+			k.RemoveFromStreamLowDepositQueue(ctx, streamID, alertTimes[i])
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeStreamLowDeposit,
+				sdk.NewAttribute(types.AttributeKeyStreamId, strconv.FormatUint(streamID, 10)),
+				sdk.NewAttribute(types.AttributeKeyStreamSender, stream.Sender),
+				sdk.NewAttribute(types.AttributeKeyStreamReceiver, stream.Receiver),
+				sdk.NewAttribute(types.AttributeKeyRemainingDeposit, stream.Deposit.String()),
+				sdk.NewAttribute(types.AttributeKeyDepositZeroTime, stream.DepositZeroTime.String()),
+				sdk.NewAttribute(types.AttributeKeyRemainingDuration, strconv.FormatInt(remainingDuration, 10)),
+				sdk.NewAttribute(types.AttributeKeyLowDepositThreshold, strconv.FormatUint(stream.LowDepositThreshold, 10)),
+			),
+		)
+	}
+}
+
+// PruneDeletedStreamAudits removes the claim statistics and flow rate change histories of deleted streams whose
+// audit retention period has passed. At most types.MaxStreamAuditPrunesPerBlock are pruned per block.
+func (k Keeper) PruneDeletedStreamAudits(ctx sdk.Context) {
+	var streamIDs []uint64
+	var pruneTimes []time.Time
+
+	// collect first - pruning modifies the queue
+	k.IterateStreamAuditPruneQueue(ctx, ctx.BlockTime(), func(streamID uint64, pruneTime time.Time) bool {
+		streamIDs = append(streamIDs, streamID)
+		pruneTimes = append(pruneTimes, pruneTime)
+		return len(streamIDs) >= types.MaxStreamAuditPrunesPerBlock
+	})
+
+	for i, streamID := range streamIDs {
+		k.RemoveFromStreamAuditPruneQueue(ctx, streamID, pruneTimes[i])
+
+		// the stream ID is never reused, but never prune a live stream's audit data
+		if k.IsStream(ctx, streamID) {
+			continue
+		}
+
+		k.deleteStreamStatsAndHistory(ctx, streamID)
+	}
+}
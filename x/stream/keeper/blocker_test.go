@@ -0,0 +1,494 @@
+package keeper_test
+
+import (
+	"strconv"
+	"time"
+
+	mathmod "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+func (s *KeeperTestSuite) TestStreamExpiryQueue_Consistency() {
+	tCtx := s.ctx
+
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx = tCtx.WithBlockTime(blockTime)
+
+	deposit := sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000))
+
+	stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1)
+	s.Require().NoError(err)
+	streamID := stream.StreamId
+	s.Require().True(s.app.StreamKeeper.IsInStreamExpiryQueue(tCtx, streamID, time.Unix(0, 0).UTC()))
+
+	// add deposit - moves stream in the queue
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, streamID, deposit)
+	s.Require().NoError(err)
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, streamID)
+	s.Require().Equal(blockTime.Add(time.Second*1000), stream.DepositZeroTime)
+	s.Require().False(s.app.StreamKeeper.IsInStreamExpiryQueue(tCtx, streamID, time.Unix(0, 0).UTC()))
+	s.Require().True(s.app.StreamKeeper.IsInStreamExpiryQueue(tCtx, streamID, stream.DepositZeroTime))
+
+	// update flow rate - moves stream in the queue
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Second * 100))
+	err = s.app.StreamKeeper.SetNewFlowRate(tCtx, streamID, 2)
+	s.Require().NoError(err)
+	oldDepositZeroTime := stream.DepositZeroTime
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, streamID)
+	s.Require().Equal(tCtx.BlockTime().Add(time.Second*450), stream.DepositZeroTime)
+	s.Require().False(s.app.StreamKeeper.IsInStreamExpiryQueue(tCtx, streamID, oldDepositZeroTime))
+	s.Require().True(s.app.StreamKeeper.IsInStreamExpiryQueue(tCtx, streamID, stream.DepositZeroTime))
+
+	// cancel - removes stream from the queue
+	err = s.app.StreamKeeper.CancelAndRefundStream(tCtx, streamID)
+	s.Require().NoError(err)
+	s.Require().False(s.app.StreamKeeper.IsInStreamExpiryQueue(tCtx, streamID, stream.DepositZeroTime))
+
+	numInQueue := 0
+	s.app.StreamKeeper.IterateStreamExpiryQueue(tCtx, time.Unix(1<<40, 0).UTC(), func(_ uint64, _ time.Time) bool {
+		numInQueue++
+		return false
+	})
+	s.Require().Equal(0, numInQueue)
+}
+
+func (s *KeeperTestSuite) TestIterateStreamExpiryQueue() {
+	tCtx := s.ctx
+
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx = tCtx.WithBlockTime(blockTime)
+
+	for i := int64(1); i <= 10; i++ {
+		deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100*i)
+		stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[i], s.addrs[0], deposit, 1)
+		s.Require().NoError(err)
+		_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+		s.Require().NoError(err)
+	}
+
+	var depositZeroTimes []time.Time
+	s.app.StreamKeeper.IterateStreamExpiryQueue(tCtx, blockTime.Add(time.Second*500), func(streamID uint64, depositZeroTime time.Time) bool {
+		stream, ok := s.app.StreamKeeper.GetStream(tCtx, streamID)
+		s.Require().True(ok)
+		s.Require().Equal(stream.DepositZeroTime, depositZeroTime)
+		s.Require().Equal(s.addrs[0].String(), stream.Sender)
+		depositZeroTimes = append(depositZeroTimes, depositZeroTime)
+		return false
+	})
+
+	// only streams with deposit zero time <= end time, in ascending order
+	s.Require().Len(depositZeroTimes, 5)
+	for i, dzt := range depositZeroTimes {
+		s.Require().Equal(blockTime.Add(time.Second*time.Duration(100*(i+1))), dzt)
+	}
+}
+
+func (s *KeeperTestSuite) TestSettleDepletedStreams() {
+	tCtx := s.ctx
+
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx = tCtx.WithBlockTime(blockTime)
+
+	// set validator fee
+	valFee := mathmod.LegacyNewDecWithPrec(1, 2)
+	_ = s.app.StreamKeeper.SetParams(tCtx, types.Params{ValidatorFee: valFee, FeeCollectorRatio: types.DefaultFeeCollectorRatio, CommunityPoolRatio: types.DefaultCommunityPoolRatio, BurnRatio: types.DefaultBurnRatio, MaxClaimFeeRatio: types.DefaultMaxClaimFeeRatio})
+
+	deposit := sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000))
+
+	// depleted at blockTime + 1000
+	stream1, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream1.StreamId, deposit)
+	s.Require().NoError(err)
+
+	// depleted at blockTime + 2000
+	stream2, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[2], s.addrs[0], deposit, 1)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream2.StreamId, deposit.Add(deposit))
+	s.Require().NoError(err)
+
+	receiverBalBefore := s.app.BankKeeper.GetBalance(tCtx, s.addrs[1], sdk.DefaultBondDenom)
+
+	// nothing depleted yet
+	s.app.StreamKeeper.SettleDepletedStreams(tCtx)
+	s.Require().True(s.app.StreamKeeper.IsStream(tCtx, stream1.StreamId))
+	s.Require().True(s.app.StreamKeeper.IsStream(tCtx, stream2.StreamId))
+
+	// time travel past first stream's deposit zero time
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Second * 1500)).WithEventManager(sdk.NewEventManager())
+	s.app.StreamKeeper.SettleDepletedStreams(tCtx)
+
+	s.Require().False(s.app.StreamKeeper.IsStream(tCtx, stream1.StreamId))
+	s.Require().True(s.app.StreamKeeper.IsStream(tCtx, stream2.StreamId))
+
+	receiverBalAfter := s.app.BankKeeper.GetBalance(tCtx, s.addrs[1], sdk.DefaultBondDenom)
+	s.Require().Equal(receiverBalBefore.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 990)), receiverBalAfter)
+
+	hasSettledEvent := false
+	for _, ev := range tCtx.EventManager().Events() {
+		if ev.Type == types.EventTypeStreamSettled {
+			hasSettledEvent = true
+
+			attrReceiver, ok := ev.GetAttribute(types.AttributeKeyStreamReceiver)
+			s.Require().True(ok)
+			s.Require().Equal(s.addrs[1].String(), attrReceiver.Value)
+
+			attrAmountReceived, ok := ev.GetAttribute(types.AttributeKeyClaimAmountReceived)
+			s.Require().True(ok)
+			s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 990).String(), attrAmountReceived.Value)
+
+			attrValFee, ok := ev.GetAttribute(types.AttributeKeyClaimValidatorFee)
+			s.Require().True(ok)
+			s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10).String(), attrValFee.Value)
+		}
+	}
+	s.Require().True(hasSettledEvent)
+
+	// time travel past second stream's deposit zero time
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Second * 2000))
+	s.app.StreamKeeper.SettleDepletedStreams(tCtx)
+	s.Require().False(s.app.StreamKeeper.IsStream(tCtx, stream2.StreamId))
+
+	s.Require().True(s.app.StreamKeeper.GetTotalDeposits(tCtx).IsZero())
+}
+
+func (s *KeeperTestSuite) TestSettleDepletedStreams_EndTimeRefund() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	valFee := mathmod.LegacyNewDecWithPrec(1, 2)
+	_ = s.app.StreamKeeper.SetParams(tCtx, types.Params{ValidatorFee: valFee, FeeCollectorRatio: types.DefaultFeeCollectorRatio, CommunityPoolRatio: types.DefaultCommunityPoolRatio, BurnRatio: types.DefaultBurnRatio, MaxClaimFeeRatio: types.DefaultMaxClaimFeeRatio})
+
+	deposit := sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000))
+	endTime := blockTime.Add(time.Second * 400)
+
+	stream, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1, time.Time{}, time.Time{}, endTime, false)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+
+	// deposit zero time is capped at the end time
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, stream.StreamId)
+	s.Require().Equal(endTime, stream.DepositZeroTime)
+	s.Require().True(s.app.StreamKeeper.IsInStreamExpiryQueue(tCtx, stream.StreamId, endTime))
+
+	receiverBalBefore := s.app.BankKeeper.GetBalance(tCtx, s.addrs[1], sdk.DefaultBondDenom)
+	senderBalBefore := s.app.BankKeeper.GetBalance(tCtx, s.addrs[0], sdk.DefaultBondDenom)
+
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Second * 500)).WithEventManager(sdk.NewEventManager())
+	s.app.StreamKeeper.SettleDepletedStreams(tCtx)
+	s.Require().False(s.app.StreamKeeper.IsStream(tCtx, stream.StreamId))
+
+	// receiver paid up to the end time, remaining deposit refunded to the sender
+	receiverBalAfter := s.app.BankKeeper.GetBalance(tCtx, s.addrs[1], sdk.DefaultBondDenom)
+	senderBalAfter := s.app.BankKeeper.GetBalance(tCtx, s.addrs[0], sdk.DefaultBondDenom)
+	s.Require().Equal(receiverBalBefore.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 396)), receiverBalAfter)
+	s.Require().Equal(senderBalBefore.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600)), senderBalAfter)
+	s.Require().True(s.app.StreamKeeper.GetTotalDeposits(tCtx).IsZero())
+
+	hasSettledEvent := false
+	for _, ev := range tCtx.EventManager().Events() {
+		if ev.Type == types.EventTypeStreamSettled {
+			hasSettledEvent = true
+			attrRefund, ok := ev.GetAttribute(types.AttributeKeyRefundAmount)
+			s.Require().True(ok)
+			s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600).String(), attrRefund.Value)
+		}
+	}
+	s.Require().True(hasSettledEvent)
+}
+
+func (s *KeeperTestSuite) TestSettleDepletedStreams_ZeroDeposit() {
+	tCtx := s.ctx
+
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx = tCtx.WithBlockTime(blockTime)
+
+	deposit := sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000))
+
+	stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+
+	// receiver claims everything after deposit zero time, leaving a zero deposit stream
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Second * 1001))
+	_, _, _, _, err = s.app.StreamKeeper.ClaimFromStream(tCtx, stream.StreamId)
+	s.Require().NoError(err)
+
+	s.app.StreamKeeper.SettleDepletedStreams(tCtx)
+	s.Require().False(s.app.StreamKeeper.IsStream(tCtx, stream.StreamId))
+}
+
+func (s *KeeperTestSuite) TestSettleDepletedStreams_MaxPerBlock() {
+	tCtx := s.ctx
+
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx = tCtx.WithBlockTime(blockTime)
+
+	deposit := sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(100))
+	numStreams := types.MaxStreamSettlementsPerBlock + 10
+
+	for i := 0; i < numStreams; i++ {
+		receiverAddr := sdk.AccAddress([]byte{byte(i), 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13})
+		stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiverAddr, s.addrs[0], deposit, 1)
+		s.Require().NoError(err)
+		_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+		s.Require().NoError(err)
+	}
+
+	countStreams := func(ctx sdk.Context) int {
+		n := 0
+		s.app.StreamKeeper.IterateAllStreams(ctx, func(_ types.Stream) bool {
+			n++
+			return false
+		})
+		return n
+	}
+
+	s.Require().Equal(numStreams, countStreams(tCtx))
+
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Second * 200))
+	s.app.StreamKeeper.SettleDepletedStreams(tCtx)
+	s.Require().Equal(10, countStreams(tCtx))
+
+	s.app.StreamKeeper.SettleDepletedStreams(tCtx)
+	s.Require().Equal(0, countStreams(tCtx))
+}
+
+func (s *KeeperTestSuite) TestSettleDepletedStreams_FailureIsolated() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	deposit := sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000))
+
+	var streamIDs []uint64
+	for i := 1; i <= 3; i++ {
+		stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[i], s.addrs[0], deposit, 1)
+		s.Require().NoError(err)
+		_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+		s.Require().NoError(err)
+		streamIDs = append(streamIDs, stream.StreamId)
+	}
+
+	// the second stream's deposit is in a denom the module account does not hold, so it cannot be paid out
+	badStream, ok := s.app.StreamKeeper.GetStream(tCtx, streamIDs[1])
+	s.Require().True(ok)
+	badStream.Deposit = sdk.NewInt64Coin("nothing", 1000)
+	s.Require().NoError(s.app.StreamKeeper.SetStream(tCtx, badStream))
+
+	receiverBalBefore := s.app.BankKeeper.GetBalance(tCtx, s.addrs[3], sdk.DefaultBondDenom)
+
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Second * 1500)).WithEventManager(sdk.NewEventManager())
+	s.Require().NotPanics(func() {
+		s.app.StreamKeeper.SettleDepletedStreams(tCtx)
+	})
+
+	// the other streams are settled
+	s.Require().False(s.app.StreamKeeper.IsStream(tCtx, streamIDs[0]))
+	s.Require().False(s.app.StreamKeeper.IsStream(tCtx, streamIDs[2]))
+	receiverBalAfter := s.app.BankKeeper.GetBalance(tCtx, s.addrs[3], sdk.DefaultBondDenom)
+	s.Require().True(receiverBalAfter.IsGTE(receiverBalBefore.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 900))))
+
+	// the failed stream is untouched, and no longer blocks the expiry queue
+	stream, ok := s.app.StreamKeeper.GetStream(tCtx, streamIDs[1])
+	s.Require().True(ok)
+	s.Require().Equal(badStream.Deposit, stream.Deposit)
+	s.Require().Equal(badStream.LastOutflowTime, stream.LastOutflowTime)
+	s.Require().False(s.app.StreamKeeper.IsInStreamExpiryQueue(tCtx, streamIDs[1], stream.DepositZeroTime))
+
+	numSettled := 0
+	numFailed := 0
+	for _, ev := range tCtx.EventManager().Events() {
+		switch ev.Type {
+		case types.EventTypeStreamSettled:
+			numSettled++
+		case types.EventTypeStreamSettleFailed:
+			numFailed++
+			attrID, ok := ev.GetAttribute(types.AttributeKeyStreamId)
+			s.Require().True(ok)
+			s.Require().Equal(strconv.FormatUint(streamIDs[1], 10), attrID.Value)
+		}
+	}
+	s.Require().Equal(2, numSettled)
+	s.Require().Equal(1, numFailed)
+}
+
+func (s *KeeperTestSuite) TestSettleDepletedStreams_Paused() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	deposit := sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000))
+	endTime := blockTime.Add(time.Second * 5000)
+
+	noEnd, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1, time.Time{}, time.Time{}, time.Time{}, true)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, noEnd.StreamId, deposit)
+	s.Require().NoError(err)
+
+	withEnd, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, s.addrs[2], s.addrs[0], deposit, 1, time.Time{}, time.Time{}, endTime, true)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, withEnd.StreamId, deposit)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.app.StreamKeeper.AcceptPausable(tCtx, noEnd.StreamId))
+	s.Require().NoError(s.app.StreamKeeper.AcceptPausable(tCtx, withEnd.StreamId))
+
+	pauseCtx := tCtx.WithBlockTime(blockTime.Add(time.Second * 100))
+	_, err = s.app.StreamKeeper.PauseStreamFlow(pauseCtx, noEnd.StreamId)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.PauseStreamFlow(pauseCtx, withEnd.StreamId)
+	s.Require().NoError(err)
+
+	// paused streams are not settled when their original deposit zero time passes
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Second * 2000))
+	s.app.StreamKeeper.SettleDepletedStreams(tCtx)
+	s.Require().True(s.app.StreamKeeper.IsStream(tCtx, noEnd.StreamId))
+	s.Require().True(s.app.StreamKeeper.IsStream(tCtx, withEnd.StreamId))
+
+	err = s.app.StreamKeeper.SettleStream(tCtx, noEnd.StreamId)
+	s.Require().ErrorIs(err, types.ErrStreamPaused)
+
+	// a paused stream is settled at its end time, and the remaining deposit refunded to the sender
+	senderBalBefore := s.app.BankKeeper.GetBalance(tCtx, s.addrs[0], sdk.DefaultBondDenom)
+	tCtx = tCtx.WithBlockTime(endTime)
+	s.app.StreamKeeper.SettleDepletedStreams(tCtx)
+	s.Require().True(s.app.StreamKeeper.IsStream(tCtx, noEnd.StreamId))
+	s.Require().False(s.app.StreamKeeper.IsStream(tCtx, withEnd.StreamId))
+
+	senderBalAfter := s.app.BankKeeper.GetBalance(tCtx, s.addrs[0], sdk.DefaultBondDenom)
+	s.Require().Equal(senderBalBefore.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 900)), senderBalAfter)
+}
+
+func (s *KeeperTestSuite) TestAlertLowDepositStreams() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	deposit := sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000))
+
+	stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1)
+	s.Require().NoError(err)
+	streamID := stream.StreamId
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, streamID, deposit)
+	s.Require().NoError(err)
+
+	// alert when there are 300 seconds of deposit left
+	err = s.app.StreamKeeper.SetStreamLowDepositThreshold(tCtx, streamID, 300)
+	s.Require().NoError(err)
+	s.Require().True(s.app.StreamKeeper.IsInStreamLowDepositQueue(tCtx, streamID, blockTime.Add(time.Second*700)))
+
+	countLowDepositEvents := func(atTime time.Time) int {
+		ctx := tCtx.WithBlockTime(atTime).WithEventManager(sdk.NewEventManager())
+		s.app.StreamKeeper.AlertLowDepositStreams(ctx)
+		num := 0
+		for _, ev := range ctx.EventManager().Events() {
+			if ev.Type == types.EventTypeStreamLowDeposit {
+				num++
+			}
+		}
+		return num
+	}
+
+	// not yet below the threshold
+	s.Require().Equal(0, countLowDepositEvents(blockTime.Add(time.Second*699)))
+
+	// crosses the threshold - alerted once
+	s.Require().Equal(1, countLowDepositEvents(blockTime.Add(time.Second*700)))
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, streamID)
+	s.Require().True(stream.LowDepositAlerted)
+	s.Require().False(s.app.StreamKeeper.IsInStreamLowDepositQueue(tCtx, streamID, blockTime.Add(time.Second*700)))
+	s.Require().Equal(0, countLowDepositEvents(blockTime.Add(time.Second*710)))
+
+	// a small top up which leaves the stream below the threshold does not reset the alert
+	topUpCtx := tCtx.WithBlockTime(blockTime.Add(time.Second * 720))
+	_, err = s.app.StreamKeeper.AddDeposit(topUpCtx, streamID, sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(10)))
+	s.Require().NoError(err)
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, streamID)
+	s.Require().True(stream.LowDepositAlerted)
+	s.Require().Equal(0, countLowDepositEvents(blockTime.Add(time.Second*730)))
+
+	// topping up above the threshold resets the alert
+	_, err = s.app.StreamKeeper.AddDeposit(topUpCtx, streamID, deposit)
+	s.Require().NoError(err)
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, streamID)
+	s.Require().False(stream.LowDepositAlerted)
+	s.Require().Equal(blockTime.Add(time.Second*2010), stream.DepositZeroTime)
+	s.Require().True(s.app.StreamKeeper.IsInStreamLowDepositQueue(tCtx, streamID, blockTime.Add(time.Second*1710)))
+
+	s.Require().Equal(0, countLowDepositEvents(blockTime.Add(time.Second*1709)))
+	s.Require().Equal(1, countLowDepositEvents(blockTime.Add(time.Second*1710)))
+
+	// a new threshold resets the alert, and the stream is immediately below it
+	err = s.app.StreamKeeper.SetStreamLowDepositThreshold(tCtx.WithBlockTime(blockTime.Add(time.Second*1720)), streamID, 600)
+	s.Require().NoError(err)
+	s.Require().Equal(1, countLowDepositEvents(blockTime.Add(time.Second*1720)))
+
+	// a zero threshold disables the alert
+	err = s.app.StreamKeeper.SetStreamLowDepositThreshold(tCtx, streamID, 0)
+	s.Require().NoError(err)
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, streamID)
+	_, queued := stream.LowDepositAlertTime()
+	s.Require().False(queued)
+
+	numInQueue := 0
+	s.app.StreamKeeper.IterateStreamLowDepositQueue(tCtx, time.Unix(1<<40, 0).UTC(), func(_ uint64, _ time.Time) bool {
+		numInQueue++
+		return false
+	})
+	s.Require().Equal(0, numInQueue)
+
+	err = s.app.StreamKeeper.SetStreamLowDepositThreshold(tCtx, 99, 300)
+	s.Require().ErrorContains(err, "stream id 99")
+}
+
+func (s *KeeperTestSuite) TestSettleDepletedStreams_AuditDataKept() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+
+	// claim, then double the flow rate. The remaining 900 is depleted at blockTime + 550
+	claimCtx := tCtx.WithBlockTime(blockTime.Add(time.Second * 100))
+	_, _, _, _, err = s.app.StreamKeeper.ClaimFromStream(claimCtx, stream.StreamId)
+	s.Require().NoError(err)
+	s.Require().NoError(s.app.StreamKeeper.SetNewFlowRate(claimCtx, stream.StreamId, 2))
+
+	settledAt := blockTime.Add(time.Second * 600)
+	settleCtx := tCtx.WithBlockTime(settledAt)
+	s.app.StreamKeeper.SettleDepletedStreams(settleCtx)
+	s.app.StreamKeeper.PruneDeletedStreamAudits(settleCtx)
+	s.Require().False(s.app.StreamKeeper.IsStream(settleCtx, stream.StreamId))
+
+	// the settled stream can still be audited
+	statsRes, err := s.app.StreamKeeper.StreamStats(settleCtx, &types.QueryStreamStatsRequest{StreamId: stream.StreamId})
+	s.Require().NoError(err)
+	s.Require().Equal(deposit, statsRes.Stats.TotalClaimed)
+	s.Require().Equal(uint64(2), statsRes.Stats.NumClaims)
+
+	historyRes, err := s.app.StreamKeeper.StreamHistory(settleCtx, &types.QueryStreamHistoryRequest{StreamId: stream.StreamId})
+	s.Require().NoError(err)
+	s.Require().Len(historyRes.History.FlowRateChanges, 1)
+	s.Require().Equal(int64(1), historyRes.History.FlowRateChanges[0].OldFlowRate)
+	s.Require().Equal(int64(2), historyRes.History.FlowRateChanges[0].NewFlowRate)
+
+	// kept in genesis
+	genState := s.app.StreamKeeper.ExportGenesis(settleCtx)
+	s.Require().NoError(genState.Validate())
+	s.Require().Len(genState.Stats, 1)
+	s.Require().Len(genState.Histories, 1)
+
+	// pruned once the retention period has passed
+	pruneCtx := tCtx.WithBlockTime(settledAt.Add(types.DeletedStreamAuditRetention))
+	s.app.StreamKeeper.PruneDeletedStreamAudits(pruneCtx)
+
+	_, err = s.app.StreamKeeper.StreamStats(pruneCtx, &types.QueryStreamStatsRequest{StreamId: stream.StreamId})
+	s.Require().ErrorIs(err, types.ErrStreamDoesNotExist)
+	_, err = s.app.StreamKeeper.StreamHistory(pruneCtx, &types.QueryStreamHistoryRequest{StreamId: stream.StreamId})
+	s.Require().ErrorIs(err, types.ErrStreamDoesNotExist)
+}
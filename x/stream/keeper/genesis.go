@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -18,26 +19,51 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	}
 
 	k.SetParams(ctx, genState.Params)
+	k.SetHighestStreamID(ctx, genState.StartingStreamId)
 
 	for _, stream := range genState.Streams {
+		s := stream.Stream
+		s.Receiver = stream.Receiver
+		s.Sender = stream.Sender
 
-		senderAddr, err := sdk.AccAddressFromBech32(stream.Sender)
-		if err != nil {
-			panic(err)
-		}
+		err := k.SetStream(ctx, s)
 
-		receiverAddr, err := sdk.AccAddressFromBech32(stream.Receiver)
 		if err != nil {
 			panic(err)
 		}
 
-		err = k.SetStream(ctx, receiverAddr, senderAddr, stream.Stream)
+		moduleHoldings = moduleHoldings.Add(stream.Stream.Deposit)
+	}
 
-		if err != nil {
-			panic(err)
+	for _, stats := range genState.Stats {
+		k.SetStreamStats(ctx, stats)
+	}
+
+	for _, history := range genState.Histories {
+		k.SetStreamHistory(ctx, history)
+	}
+
+	// audit data kept for deleted streams is pruned at its exported prune time. Any without one
+	// is retained from genesis
+	pruneIds := make(map[uint64]bool)
+	for _, prune := range genState.AuditPrunes {
+		k.InsertStreamAuditPruneQueue(ctx, prune.StreamId, prune.PruneTime)
+		pruneIds[prune.StreamId] = true
+	}
+
+	pruneTime := ctx.BlockTime().Add(types.DeletedStreamAuditRetention)
+	for _, stats := range genState.Stats {
+		if !k.IsStream(ctx, stats.StreamId) && !pruneIds[stats.StreamId] {
+			k.InsertStreamAuditPruneQueue(ctx, stats.StreamId, pruneTime)
+			pruneIds[stats.StreamId] = true
 		}
+	}
 
-		moduleHoldings = moduleHoldings.Add(stream.Stream.Deposit)
+	for _, history := range genState.Histories {
+		if !k.IsStream(ctx, history.StreamId) && !pruneIds[history.StreamId] {
+			k.InsertStreamAuditPruneQueue(ctx, history.StreamId, pruneTime)
+			pruneIds[history.StreamId] = true
+		}
 	}
 
 	balances := k.bankKeeper.GetAllBalances(ctx, moduleAcc.GetAddress())
@@ -54,17 +80,36 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	params := k.GetParams(ctx)
 	var streams []types.StreamExport
+	startingStreamID, _ := k.GetHighestStreamID(ctx)
 
-	k.IterateAllStreams(ctx, func(receiverAddr, senderAddr sdk.AccAddress, stream types.Stream) bool {
+	k.IterateAllStreams(ctx, func(stream types.Stream) bool {
 		streams = append(streams,
 			types.StreamExport{
-				Receiver: receiverAddr.String(),
-				Sender:   senderAddr.String(),
+				Receiver: stream.Receiver,
+				Sender:   stream.Sender,
 				Stream:   stream,
 			},
 		)
 		return false
 	})
 
-	return types.NewGenesisState(streams, params)
+	stats := []types.StreamStats{}
+	k.IterateAllStreamStats(ctx, func(s types.StreamStats) bool {
+		stats = append(stats, s)
+		return false
+	})
+
+	histories := []types.StreamHistory{}
+	k.IterateAllStreamHistories(ctx, func(h types.StreamHistory) bool {
+		histories = append(histories, h)
+		return false
+	})
+
+	auditPrunes := []types.StreamAuditPrune{}
+	k.IterateAllStreamAuditPruneQueue(ctx, func(streamID uint64, pruneTime time.Time) bool {
+		auditPrunes = append(auditPrunes, types.StreamAuditPrune{StreamId: streamID, PruneTime: pruneTime})
+		return false
+	})
+
+	return types.NewGenesisState(streams, params, startingStreamID, stats, histories, auditPrunes)
 }
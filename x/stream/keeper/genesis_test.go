@@ -4,6 +4,8 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/stream/types"
 )
 
 func (s *KeeperTestSuite) TestImportExportGenesis() {
@@ -14,20 +16,43 @@ func (s *KeeperTestSuite) TestImportExportGenesis() {
 		deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000*i)
 		createTime := time.Unix(nowTime.Unix()-(i*9), 0).UTC()
 		tCtx = tCtx.WithBlockTime(createTime)
-		_, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[i-1], s.addrs[i], deposit, i)
+		stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[i-1], s.addrs[i], deposit, i)
 		s.Require().NoError(err)
-		_, err = s.app.StreamKeeper.AddDeposit(tCtx, s.addrs[i-1], s.addrs[i], deposit)
+		_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
 		s.Require().NoError(err)
 
 		// simulate some claims etc.
 		tCtx = tCtx.WithBlockTime(nowTime)
-		_, _, _, _, err = s.app.StreamKeeper.ClaimFromStream(tCtx, s.addrs[i-1], s.addrs[i])
+		_, _, _, _, err = s.app.StreamKeeper.ClaimFromStream(tCtx, stream.StreamId)
 		s.Require().NoError(err)
+		if i%10 == 0 {
+			err = s.app.StreamKeeper.SetNewFlowRate(tCtx, stream.StreamId, i+1)
+			s.Require().NoError(err)
+		}
 	}
 
 	tCtx = tCtx.WithBlockTime(nowTime)
+
+	// a deleted stream's stats and history are kept
+	s.Require().NoError(s.app.StreamKeeper.CancelAndRefundStream(tCtx, 10))
+
 	genesis := s.app.StreamKeeper.ExportGenesis(tCtx)
+	s.Require().NotEmpty(genesis.Stats)
+	s.Require().Len(genesis.Streams, 98)
+	s.Require().Len(genesis.Histories, 9)
+	s.Require().Equal([]types.StreamAuditPrune{{StreamId: 10, PruneTime: nowTime.Add(types.DeletedStreamAuditRetention)}}, genesis.AuditPrunes)
+	s.Require().NoError(genesis.Validate())
 	s.app.StreamKeeper.InitGenesis(s.ctx, genesis)
 	newGenesis := s.app.StreamKeeper.ExportGenesis(tCtx)
 	s.Require().Equal(genesis, newGenesis)
+
+	// and pruned at the exported prune time, not the retention period from genesis
+	s.app.StreamKeeper.PruneDeletedStreamAudits(tCtx.WithBlockTime(nowTime.Add(types.DeletedStreamAuditRetention).Add(-time.Second)))
+	_, ok := s.app.StreamKeeper.GetStreamHistory(tCtx, 10)
+	s.Require().True(ok)
+
+	s.app.StreamKeeper.PruneDeletedStreamAudits(tCtx.WithBlockTime(nowTime.Add(types.DeletedStreamAuditRetention)))
+	_, ok = s.app.StreamKeeper.GetStreamHistory(tCtx, 10)
+	s.Require().False(ok)
+	s.Require().Len(s.app.StreamKeeper.ExportGenesis(tCtx).Histories, 8)
 }
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+//__STREAM_STATS__________________________________________________________
+
+// GetStreamStats gets a stream's claim statistics
+func (k Keeper) GetStreamStats(ctx sdk.Context, streamID uint64) (types.StreamStats, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStreamStatsKey(streamID))
+	if bz == nil {
+		return types.StreamStats{}, false
+	}
+	var stats types.StreamStats
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats, true
+}
+
+// SetStreamStats sets a stream's claim statistics
+func (k Keeper) SetStreamStats(ctx sdk.Context, stats types.StreamStats) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetStreamStatsKey(stats.StreamId), k.cdc.MustMarshal(&stats))
+}
+
+// IterateAllStreamStats iterates over all stream claim statistics
+func (k Keeper) IterateAllStreamStats(ctx sdk.Context, cb func(types.StreamStats) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StreamStatsKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.StreamStats
+		k.cdc.MustUnmarshal(iterator.Value(), &stats)
+		if cb(stats) {
+			break
+		}
+	}
+}
+
+// recordClaim adds a claim that paid out from a stream to its claim statistics
+func (k Keeper) recordClaim(ctx sdk.Context, streamID uint64, claimTotal, valFee sdk.Coin) {
+	if !claimTotal.IsPositive() {
+		return
+	}
+
+	stats, ok := k.GetStreamStats(ctx, streamID)
+	if !ok {
+		stats = types.NewStreamStats(streamID, claimTotal.Denom)
+	}
+
+	stats.TotalClaimed = stats.TotalClaimed.Add(claimTotal)
+	stats.TotalValidatorFees = stats.TotalValidatorFees.Add(valFee)
+	stats.NumClaims++
+	stats.LastClaimTime = ctx.BlockTime()
+
+	k.SetStreamStats(ctx, stats)
+}
+
+//__STREAM_HISTORY________________________________________________________
+
+// GetStreamHistory gets a stream's flow rate change history
+func (k Keeper) GetStreamHistory(ctx sdk.Context, streamID uint64) (types.StreamHistory, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetStreamHistoryKey(streamID))
+	if bz == nil {
+		return types.StreamHistory{}, false
+	}
+	var history types.StreamHistory
+	k.cdc.MustUnmarshal(bz, &history)
+	return history, true
+}
+
+// SetStreamHistory sets a stream's flow rate change history
+func (k Keeper) SetStreamHistory(ctx sdk.Context, history types.StreamHistory) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetStreamHistoryKey(history.StreamId), k.cdc.MustMarshal(&history))
+}
+
+// IterateAllStreamHistories iterates over all stream flow rate change histories
+func (k Keeper) IterateAllStreamHistories(ctx sdk.Context, cb func(types.StreamHistory) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StreamHistoryKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.StreamHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &history)
+		if cb(history) {
+			break
+		}
+	}
+}
+
+// recordFlowRateChange appends a flow rate change to a stream's history, dropping the oldest change once
+// the history holds types.MaxFlowRateChangeHistory changes
+func (k Keeper) recordFlowRateChange(ctx sdk.Context, streamID uint64, oldFlowRate, newFlowRate int64, changedAt time.Time) {
+	history, ok := k.GetStreamHistory(ctx, streamID)
+	if !ok {
+		history = types.StreamHistory{StreamId: streamID}
+	}
+
+	history.FlowRateChanges = append(history.FlowRateChanges, types.FlowRateChange{
+		OldFlowRate: oldFlowRate,
+		NewFlowRate: newFlowRate,
+		ChangedAt:   changedAt,
+		BlockHeight: ctx.BlockHeight(),
+	})
+
+	if len(history.FlowRateChanges) > types.MaxFlowRateChangeHistory {
+		history.FlowRateChanges = history.FlowRateChanges[len(history.FlowRateChanges)-types.MaxFlowRateChangeHistory:]
+	}
+
+	k.SetStreamHistory(ctx, history)
+}
+
+// retainStreamStatsAndHistory keeps a deleted stream's claim statistics and flow rate change history for
+// types.DeletedStreamAuditRetention, after which they are pruned by the EndBlocker
+func (k Keeper) retainStreamStatsAndHistory(ctx sdk.Context, streamID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.GetStreamStatsKey(streamID)) && !store.Has(types.GetStreamHistoryKey(streamID)) {
+		return
+	}
+	k.InsertStreamAuditPruneQueue(ctx, streamID, ctx.BlockTime().Add(types.DeletedStreamAuditRetention))
+}
+
+// deleteStreamStatsAndHistory removes a stream's claim statistics and flow rate change history
+func (k Keeper) deleteStreamStatsAndHistory(ctx sdk.Context, streamID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetStreamStatsKey(streamID))
+	store.Delete(types.GetStreamHistoryKey(streamID))
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+// RegisterInvariants registers all stream module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account", ModuleAccountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "nonnegative-deposits", NonNegativeDepositsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "deposit-zero-times", DepositZeroTimesInvariant(k))
+}
+
+// AllInvariants runs all invariants of the stream module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleAccountInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = NonNegativeDepositsInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return DepositZeroTimesInvariant(k)(ctx)
+	}
+}
+
+// ModuleAccountInvariant checks that the stream module account's balance equals the sum of every stream's deposit
+func ModuleAccountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var streams []types.Stream
+		k.IterateAllStreams(ctx, func(stream types.Stream) bool {
+			streams = append(streams, stream)
+			return false
+		})
+
+		err := types.CheckTotalDeposits(streams, k.GetTotalDeposits(ctx))
+		broken := err != nil
+
+		msg := "stream module account balance equals the sum of stream deposits\n"
+		if broken {
+			msg = err.Error() + "\n"
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "module-account", msg), broken
+	}
+}
+
+// NonNegativeDepositsInvariant checks that no stream has a nil or negative deposit
+func NonNegativeDepositsInvariant(k Keeper) sdk.Invariant {
+	return streamsInvariant(k, "nonnegative-deposits", types.CheckStreamDeposit)
+}
+
+// DepositZeroTimesInvariant checks that every stream's deposit zero time is consistent with its last outflow time,
+// deposit and flow rate
+func DepositZeroTimesInvariant(k Keeper) sdk.Invariant {
+	return streamsInvariant(k, "deposit-zero-times", types.CheckStreamDepositZeroTime)
+}
+
+// streamsInvariant returns an invariant which runs the check against every stream, and is broken if any stream fails it
+func streamsInvariant(k Keeper, route string, check func(types.Stream) error) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		k.IterateAllStreams(ctx, func(stream types.Stream) bool {
+			if err := check(stream); err != nil {
+				count++
+				msg += fmt.Sprintf("\t%s\n", err.Error())
+			}
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(types.ModuleName, route, fmt.Sprintf("found %d invalid streams\n%s", count, msg)), broken
+	}
+}
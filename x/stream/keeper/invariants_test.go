@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/stream/keeper"
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+func (s *KeeperTestSuite) TestInvariants() {
+	// sub-second block times, so durations are rounded
+	blockTime := time.Unix(1700000000, 500000000).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10007)
+
+	stream, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, s.addrs[1], s.addrs[0], deposit, 3, time.Time{}, time.Time{}, time.Time{}, true)
+	s.Require().NoError(err)
+	streamID := stream.StreamId
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, streamID, deposit)
+	s.Require().NoError(err)
+
+	stream2, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[2], s.addrs[0], deposit, 7)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream2.StreamId, deposit)
+	s.Require().NoError(err)
+
+	checkInvariants := func(ctx sdk.Context) {
+		msg, broken := keeper.AllInvariants(s.app.StreamKeeper)(ctx)
+		s.Require().False(broken, msg)
+		s.Require().NoError(s.app.AssertInvariants(ctx))
+	}
+
+	checkInvariants(tCtx)
+
+	// claim, top up, change flow rate, pause and resume
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Millisecond * 100300))
+	_, _, _, _, err = s.app.StreamKeeper.ClaimFromStream(tCtx, streamID)
+	s.Require().NoError(err)
+	checkInvariants(tCtx)
+
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, streamID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1001))
+	s.Require().NoError(err)
+	checkInvariants(tCtx)
+
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Millisecond * 200700))
+	s.Require().NoError(s.app.StreamKeeper.SetNewFlowRate(tCtx, streamID, 11))
+	checkInvariants(tCtx)
+
+	s.Require().NoError(s.app.StreamKeeper.AcceptPausable(tCtx, streamID))
+	_, err = s.app.StreamKeeper.PauseStreamFlow(tCtx, streamID)
+	s.Require().NoError(err)
+	checkInvariants(tCtx)
+
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Millisecond * 300900))
+	_, err = s.app.StreamKeeper.ResumeStreamFlow(tCtx, streamID)
+	s.Require().NoError(err)
+	checkInvariants(tCtx)
+
+	// deposit used up, and the stream settled
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Hour))
+	s.Require().NoError(s.app.StreamKeeper.SettleStream(tCtx, stream2.StreamId))
+	checkInvariants(tCtx)
+
+	// break the module account balance
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, streamID)
+	stream.Deposit = stream.Deposit.AddAmount(stream.Deposit.Amount)
+	s.Require().NoError(s.app.StreamKeeper.SetStream(tCtx, stream))
+
+	msg, broken := keeper.ModuleAccountInvariant(s.app.StreamKeeper)(tCtx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, "does not equal the sum of stream deposits")
+
+	// break the deposit zero time
+	stream.DepositZeroTime = stream.DepositZeroTime.Add(time.Hour * 24 * 365)
+	s.Require().NoError(s.app.StreamKeeper.SetStream(tCtx, stream))
+
+	msg, broken = keeper.DepositZeroTimesInvariant(s.app.StreamKeeper)(tCtx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, "found 1 invalid streams")
+
+	// break the deposit
+	stream.Deposit = sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: stream.Deposit.Amount.Neg()}
+	s.Require().NoError(s.app.StreamKeeper.SetStream(tCtx, stream))
+
+	msg, broken = keeper.NonNegativeDepositsInvariant(s.app.StreamKeeper)(tCtx)
+	s.Require().True(broken)
+	s.Require().Contains(msg, "has a nil or negative deposit")
+
+	_, broken = keeper.AllInvariants(s.app.StreamKeeper)(tCtx)
+	s.Require().True(broken)
+	s.Require().ErrorContains(s.app.AssertInvariants(tCtx), "2 invariants broken")
+}
+
+// TestInvariants_RandomOperations runs a random sequence of stream operations over many blocks, including the
+// EndBlocker's settlement of depleted streams, and checks the invariants hold after every block
+func (s *KeeperTestSuite) TestInvariants_RandomOperations() {
+	const (
+		seed      = 42
+		numBlocks = 300
+		numAddrs  = 10
+	)
+
+	r := rand.New(rand.NewSource(seed))
+	tCtx := s.ctx.WithBlockTime(time.Unix(1700000000, 0).UTC()).WithEventManager(sdk.NewEventManager())
+
+	randomStreamID := func(ctx sdk.Context) (uint64, bool) {
+		var streamIDs []uint64
+		s.app.StreamKeeper.IterateAllStreams(ctx, func(stream types.Stream) bool {
+			streamIDs = append(streamIDs, stream.StreamId)
+			return false
+		})
+		if len(streamIDs) == 0 {
+			return 0, false
+		}
+		return streamIDs[r.Intn(len(streamIDs))], true
+	}
+
+	randomCoin := func() sdk.Coin {
+		return sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000+r.Int63n(100000))
+	}
+
+	numOps := 0
+	for block := 0; block < numBlocks; block++ {
+		tCtx = tCtx.WithBlockHeight(tCtx.BlockHeight() + 1).WithBlockTime(tCtx.BlockTime().Add(time.Duration(1+r.Intn(120)) * time.Second))
+
+		for i := 0; i < 1+r.Intn(3); i++ {
+			var err error
+			streamID, found := randomStreamID(tCtx)
+
+			switch op := r.Intn(9); {
+			case op == 0 || !found:
+				receiver := s.addrs[r.Intn(numAddrs)]
+				sender := s.addrs[numAddrs+r.Intn(numAddrs)]
+				_, err = s.app.StreamKeeper.CreateNewScheduledStream(tCtx, receiver, sender, randomCoin(), 1+r.Int63n(100), time.Time{}, time.Time{}, time.Time{}, r.Intn(2) == 0)
+			case op == 1:
+				_, _, _, _, err = s.app.StreamKeeper.ClaimFromStream(tCtx, streamID)
+			case op == 2:
+				_, err = s.app.StreamKeeper.AddDeposit(tCtx, streamID, randomCoin())
+			case op == 3:
+				err = s.app.StreamKeeper.SetNewFlowRate(tCtx, streamID, 1+r.Int63n(100))
+			case op == 4:
+				_, err = s.app.StreamKeeper.PauseStreamFlow(tCtx, streamID)
+			case op == 5:
+				_, err = s.app.StreamKeeper.ResumeStreamFlow(tCtx, streamID)
+			case op == 6:
+				_, _, _, _, err = s.app.StreamKeeper.ReassignStreamReceiver(tCtx, streamID, s.addrs[r.Intn(numAddrs)])
+			case op == 7:
+				err = s.app.StreamKeeper.CancelAndRefundStream(tCtx, streamID)
+			case op == 8:
+				err = s.app.StreamKeeper.AcceptPausable(tCtx, streamID)
+			}
+
+			// random operations are not always valid, e.g. resuming a stream which is not paused
+			if err == nil {
+				numOps++
+			}
+		}
+
+		s.app.StreamKeeper.SettleDepletedStreams(tCtx)
+		s.app.StreamKeeper.AlertLowDepositStreams(tCtx)
+
+		msg, broken := keeper.AllInvariants(s.app.StreamKeeper)(tCtx)
+		s.Require().False(broken, "block %d: %s", block, msg)
+		s.Require().NoError(s.app.AssertInvariants(tCtx), "block %d", block)
+	}
+
+	s.Require().Greater(numOps, numBlocks)
+
+	// depleted streams were settled by the EndBlocker
+	numSettled := 0
+	for _, ev := range tCtx.EventManager().Events() {
+		if ev.Type == types.EventTypeStreamSettled {
+			numSettled++
+		}
+	}
+	s.Require().Positive(numSettled)
+}
@@ -18,6 +18,7 @@ type (
 		storeKey         storetypes.StoreKey
 		bankKeeper       types.BankKeeper
 		accKeeper        types.AccountKeeper
+		distrKeeper      types.DistributionKeeper
 		feeCollectorName string
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
@@ -29,6 +30,7 @@ func NewKeeper(
 	storeKey storetypes.StoreKey,
 	bankKeeper types.BankKeeper,
 	accKeeper types.AccountKeeper,
+	distrKeeper types.DistributionKeeper,
 	cdc codec.BinaryCodec,
 	feeCollectorName string,
 	authority string,
@@ -45,6 +47,7 @@ func NewKeeper(
 		authority:        authority,
 		bankKeeper:       bankKeeper,
 		accKeeper:        accKeeper,
+		distrKeeper:      distrKeeper,
 		feeCollectorName: feeCollectorName,
 	}
 }
@@ -47,3 +47,24 @@ func (s *KeeperTestSuite) TestGetAuthority() {
 	authority := s.app.StreamKeeper.GetAuthority()
 	s.Equal("und10d07y265gmmuvt4z0w9aw880jnsr700ja85vs4", authority)
 }
+
+// setTestStream assigns the next stream ID, receiver and sender to the stream, and sets it in the store
+func (s *KeeperTestSuite) setTestStream(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddress, stream types.Stream) (uint64, error) {
+	streamID, err := s.app.StreamKeeper.GetHighestStreamID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	stream.StreamId = streamID
+	stream.Receiver = receiverAddr.String()
+	stream.Sender = senderAddr.String()
+
+	err = s.app.StreamKeeper.SetStream(ctx, stream)
+	if err != nil {
+		return 0, err
+	}
+
+	s.app.StreamKeeper.SetHighestStreamID(ctx, streamID+1)
+
+	return streamID, nil
+}
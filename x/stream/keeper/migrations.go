@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/unification-com/mainchain/x/stream/migrations/v2"
+	v3 "github.com/unification-com/mainchain/x/stream/migrations/v3"
+	v4 "github.com/unification-com/mainchain/x/stream/migrations/v4"
+	v5 "github.com/unification-com/mainchain/x/stream/migrations/v5"
+	v6 "github.com/unification-com/mainchain/x/stream/migrations/v6"
+	v7 "github.com/unification-com/mainchain/x/stream/migrations/v7"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate1to2 migrates the x/stream module state from the consensus version 1 to
+// version 2. Specifically, it adds all existing streams to the stream expiry queue.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
+// Migrate2to3 migrates the x/stream module state from the consensus version 2 to
+// version 3. Specifically, it re-keys existing streams by denom and sets the allowed denoms param.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
+// Migrate3to4 migrates the x/stream module state from the consensus version 3 to
+// version 4. Specifically, it assigns stream IDs to existing streams and re-keys them by ID.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
+// Migrate4to5 migrates the x/stream module state from the consensus version 4 to
+// version 5. Specifically, it sets the min duration, min deposit and max flow rate params.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
+
+// Migrate5to6 migrates the x/stream module state from the consensus version 5 to
+// version 6. Specifically, it sets the validator fee routing params and grants the module account the
+// burner permission.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc, m.keeper.accKeeper)
+}
+
+// Migrate6to7 migrates the x/stream module state from the consensus version 6 to
+// version 7. Specifically, it sets the min termination notice param.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.Migrate(ctx, ctx.KVStore(m.keeper.storeKey), m.keeper.cdc)
+}
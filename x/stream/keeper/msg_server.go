@@ -2,6 +2,8 @@ package keeper
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -45,33 +47,76 @@ func (k msgServer) CreateStream(goCtx context.Context, msg *types.MsgCreateStrea
 		return nil, errorsmod.Wrap(types.ErrInvalidData, "sender and receiver cannot be same address")
 	}
 
-	if k.IsStream(ctx, receiverAddr, senderAddr) {
-		return nil, errorsmod.Wrap(types.ErrStreamExists, "use update stream msg to modify existing stream")
-	}
-
 	if msg.Deposit.IsNil() || msg.Deposit.IsNegative() || msg.Deposit.IsZero() {
 		return nil, errorsmod.Wrap(types.ErrInvalidData, "deposit must be > zero")
 	}
 
+	if !k.IsAllowedStreamDenom(ctx, msg.Deposit.Denom) {
+		return nil, errorsmod.Wrapf(types.ErrDenomNotAllowed, "%s cannot be used for streams", msg.Deposit.Denom)
+	}
+
 	if msg.FlowRate <= 0 {
 		return nil, errorsmod.Wrap(types.ErrInvalidData, "flow rate must be > zero")
 	}
 
-	duration := types.CalculateDuration(msg.Deposit, msg.FlowRate)
+	params := k.GetParams(ctx)
+
+	if err := params.CheckDeposit(msg.Deposit); err != nil {
+		return nil, err
+	}
+
+	if err := params.CheckFlowRate(msg.FlowRate); err != nil {
+		return nil, err
+	}
+
+	if err := types.ValidateStreamSchedule(msg.StartTime, msg.CliffTime, msg.EndTime); err != nil {
+		return nil, err
+	}
+
+	nowTime := ctx.BlockTime()
+	startTime := nowTime
+	var cliffTime, endTime time.Time
+
+	if msg.StartTime != nil {
+		if msg.StartTime.Before(nowTime) {
+			return nil, errorsmod.Wrap(types.ErrInvalidData, "start time cannot be in the past")
+		}
+		startTime = *msg.StartTime
+	}
+
+	if msg.CliffTime != nil {
+		if msg.CliffTime.Before(startTime) {
+			return nil, errorsmod.Wrap(types.ErrInvalidData, "cliff time cannot be before start time")
+		}
+		cliffTime = *msg.CliffTime
+	}
+
+	if msg.EndTime != nil {
+		if !msg.EndTime.After(startTime) {
+			return nil, errorsmod.Wrap(types.ErrInvalidData, "end time must be after start time")
+		}
+		endTime = *msg.EndTime
+	}
+
+	duration := types.CalculateDuration(msg.Deposit, msg.FlowRate, startTime, endTime)
 
-	if duration < 60 {
-		return nil, errorsmod.Wrap(types.ErrInvalidData, "calculated duration too short. Must be > 1 minute")
+	if err := params.CheckDuration(duration); err != nil {
+		return nil, err
+	}
+
+	if startTime.Add(time.Second * time.Duration(duration)).Before(cliffTime) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidData, "deposit must cover the stream until its cliff time %s", cliffTime.String())
 	}
 
 	// create the "empty" stream
-	_, err := k.CreateNewStream(ctx, receiverAddr, senderAddr, msg.Deposit, msg.FlowRate)
+	stream, err := k.CreateNewScheduledStream(ctx, receiverAddr, senderAddr, msg.Deposit, msg.FlowRate, startTime, cliffTime, endTime, msg.Pausable)
 
 	if err != nil {
 		return nil, err
 	}
 
 	// add the deposit
-	_, err = k.AddDeposit(ctx, receiverAddr, senderAddr, msg.Deposit)
+	_, err = k.AddDeposit(ctx, stream.StreamId, msg.Deposit)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +128,7 @@ func (k msgServer) CreateStream(goCtx context.Context, msg *types.MsgCreateStrea
 		Sender:   msg.Sender,
 		Deposit:  msg.Deposit,
 		FlowRate: msg.FlowRate,
+		StreamId: stream.StreamId,
 	}, nil
 
 }
@@ -99,23 +145,41 @@ func (k msgServer) ClaimStream(goCtx context.Context, msg *types.MsgClaimStream)
 		return nil, accErr
 	}
 
-	ok := k.IsStream(ctx, receiverAddr, senderAddr)
+	streamID, err := k.ResolveStreamID(ctx, receiverAddr, senderAddr, msg.Denom)
 
-	if !ok {
-		return nil, errorsmod.Wrap(types.ErrInvalidData, "stream not found")
+	if err != nil {
+		return nil, err
 	}
 
-	finalClaimCoin, valFeeCoin, totalClaimValue, remainingDeposit, err := k.ClaimFromStream(ctx, receiverAddr, senderAddr)
+	// the claim has already been made by the ante handler to pay the Tx fees
+	if feeClaim, ok := types.GetFeeClaim(ctx, streamID); ok {
+		return &types.MsgClaimStreamResponse{
+			TotalClaimed:        feeClaim.TotalClaimed,
+			StreamPayment:       feeClaim.StreamPayment,
+			ValidatorFee:        feeClaim.ValidatorFee,
+			RemainingDeposit:    feeClaim.RemainingDeposit,
+			FeeCollectorAmount:  feeClaim.FeeCollectorAmount,
+			CommunityPoolAmount: feeClaim.CommunityPoolAmount,
+			BurnedAmount:        feeClaim.BurnedAmount,
+		}, nil
+	}
+
+	finalClaimCoin, valFeeCoin, totalClaimValue, remainingDeposit, err := k.ClaimFromStream(ctx, streamID)
 
 	if err != nil {
 		return nil, err
 	}
 
+	feeCollectorAmount, communityPoolAmount, burnedAmount := k.GetParams(ctx).SplitValidatorFee(valFeeCoin)
+
 	return &types.MsgClaimStreamResponse{
-		TotalClaimed:     totalClaimValue,
-		StreamPayment:    finalClaimCoin,
-		ValidatorFee:     valFeeCoin,
-		RemainingDeposit: remainingDeposit,
+		TotalClaimed:        totalClaimValue,
+		StreamPayment:       finalClaimCoin,
+		ValidatorFee:        valFeeCoin,
+		RemainingDeposit:    remainingDeposit,
+		FeeCollectorAmount:  feeCollectorAmount,
+		CommunityPoolAmount: communityPoolAmount,
+		BurnedAmount:        burnedAmount,
 	}, nil
 }
 
@@ -137,25 +201,29 @@ func (k msgServer) TopUpDeposit(goCtx context.Context, msg *types.MsgTopUpDeposi
 		return nil, errorsmod.Wrap(types.ErrInvalidData, "deposit must be > zero")
 	}
 
-	stream, ok := k.GetStream(ctx, receiverAddr, senderAddr)
+	if !k.IsAllowedStreamDenom(ctx, msg.Deposit.Denom) {
+		return nil, errorsmod.Wrapf(types.ErrDenomNotAllowed, "%s cannot be used for streams", msg.Deposit.Denom)
+	}
 
-	if !ok {
-		return nil, errorsmod.Wrap(types.ErrInvalidData, "stream not found")
+	if err := k.GetParams(ctx).CheckDeposit(msg.Deposit); err != nil {
+		return nil, err
 	}
 
-	if msg.Deposit.Denom != stream.Deposit.Denom {
-		return nil, errorsmod.Wrapf(types.ErrInvalidData, "top up denom does not match stream denom. stream: %s, top up %s", stream.Deposit.Denom, msg.Deposit.Denom)
+	streamID, err := k.ResolveStreamID(ctx, receiverAddr, senderAddr, msg.Deposit.Denom)
+
+	if err != nil {
+		return nil, err
 	}
 
 	// Add the requested deposit
-	_, err := k.AddDeposit(ctx, receiverAddr, senderAddr, msg.Deposit)
+	_, err = k.AddDeposit(ctx, streamID, msg.Deposit)
 
 	if err != nil {
 		return nil, err
 	}
 
 	// get updated stream data
-	stream, _ = k.GetStream(ctx, receiverAddr, senderAddr)
+	stream, _ := k.GetStream(ctx, streamID)
 
 	return &types.MsgTopUpDepositResponse{
 		DepositAmount:   msg.Deposit,
@@ -183,12 +251,18 @@ func (k msgServer) UpdateFlowRate(goCtx context.Context, msg *types.MsgUpdateFlo
 		return nil, errorsmod.Wrap(types.ErrInvalidData, "flow rate must be > zero")
 	}
 
-	if !k.IsStream(ctx, receiverAddr, senderAddr) {
-		return nil, errorsmod.Wrap(types.ErrInvalidData, "stream not found")
+	if err := k.GetParams(ctx).CheckFlowRate(msg.FlowRate); err != nil {
+		return nil, err
+	}
+
+	streamID, err := k.ResolveStreamID(ctx, receiverAddr, senderAddr, msg.Denom)
+
+	if err != nil {
+		return nil, err
 	}
 
 	// update the flow rate
-	err := k.SetNewFlowRate(ctx, receiverAddr, senderAddr, msg.FlowRate)
+	err = k.SetNewFlowRate(ctx, streamID, msg.FlowRate)
 
 	if err != nil {
 		return nil, err
@@ -212,18 +286,20 @@ func (k msgServer) CancelStream(goCtx context.Context, msg *types.MsgCancelStrea
 		return nil, accErr
 	}
 
-	stream, ok := k.GetStream(ctx, receiverAddr, senderAddr)
+	streamID, err := k.ResolveStreamID(ctx, receiverAddr, senderAddr, msg.Denom)
 
-	if !ok {
-		return nil, errorsmod.Wrap(types.ErrInvalidData, "stream not found")
+	if err != nil {
+		return nil, err
 	}
 
+	stream, _ := k.GetStream(ctx, streamID)
+
 	if !stream.Cancellable {
 		return nil, errorsmod.Wrap(types.ErrStreamNotCancellable, "cannot be cancelled")
 	}
 
 	// cancel stream
-	err := k.CancelStreamBySenderReceiver(ctx, receiverAddr, senderAddr)
+	err = k.CancelAndRefundStream(ctx, streamID)
 
 	if err != nil {
 		return nil, err
@@ -232,6 +308,459 @@ func (k msgServer) CancelStream(goCtx context.Context, msg *types.MsgCancelStrea
 	return &types.MsgCancelStreamResponse{}, nil
 }
 
+// ClaimStreamById claims from a stream using the stream ID
+func (k msgServer) ClaimStreamById(goCtx context.Context, msg *types.MsgClaimStreamById) (*types.MsgClaimStreamByIdResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, accErr := sdk.AccAddressFromBech32(msg.Receiver)
+	if accErr != nil {
+		return nil, accErr
+	}
+
+	stream, ok := k.GetStream(ctx, msg.StreamId)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream not found. stream id %d", msg.StreamId)
+	}
+
+	if stream.Receiver != msg.Receiver {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the receiver of stream %d", msg.Receiver, msg.StreamId)
+	}
+
+	// the claim has already been made by the ante handler to pay the Tx fees
+	if feeClaim, ok := types.GetFeeClaim(ctx, msg.StreamId); ok {
+		return &types.MsgClaimStreamByIdResponse{
+			TotalClaimed:        feeClaim.TotalClaimed,
+			StreamPayment:       feeClaim.StreamPayment,
+			ValidatorFee:        feeClaim.ValidatorFee,
+			RemainingDeposit:    feeClaim.RemainingDeposit,
+			FeeCollectorAmount:  feeClaim.FeeCollectorAmount,
+			CommunityPoolAmount: feeClaim.CommunityPoolAmount,
+			BurnedAmount:        feeClaim.BurnedAmount,
+		}, nil
+	}
+
+	finalClaimCoin, valFeeCoin, totalClaimValue, remainingDeposit, err := k.ClaimFromStream(ctx, msg.StreamId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	feeCollectorAmount, communityPoolAmount, burnedAmount := k.GetParams(ctx).SplitValidatorFee(valFeeCoin)
+
+	return &types.MsgClaimStreamByIdResponse{
+		TotalClaimed:        totalClaimValue,
+		StreamPayment:       finalClaimCoin,
+		ValidatorFee:        valFeeCoin,
+		RemainingDeposit:    remainingDeposit,
+		FeeCollectorAmount:  feeCollectorAmount,
+		CommunityPoolAmount: communityPoolAmount,
+		BurnedAmount:        burnedAmount,
+	}, nil
+}
+
+// TopUpDepositById adds more deposit to a stream using the stream ID
+func (k msgServer) TopUpDepositById(goCtx context.Context, msg *types.MsgTopUpDepositById) (*types.MsgTopUpDepositByIdResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, accErr := sdk.AccAddressFromBech32(msg.Sender)
+	if accErr != nil {
+		return nil, accErr
+	}
+
+	if msg.Deposit.IsNil() || msg.Deposit.IsNegative() || msg.Deposit.IsZero() {
+		return nil, errorsmod.Wrap(types.ErrInvalidData, "deposit must be > zero")
+	}
+
+	if !k.IsAllowedStreamDenom(ctx, msg.Deposit.Denom) {
+		return nil, errorsmod.Wrapf(types.ErrDenomNotAllowed, "%s cannot be used for streams", msg.Deposit.Denom)
+	}
+
+	if err := k.GetParams(ctx).CheckDeposit(msg.Deposit); err != nil {
+		return nil, err
+	}
+
+	stream, ok := k.GetStream(ctx, msg.StreamId)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream not found. stream id %d", msg.StreamId)
+	}
+
+	if stream.Sender != msg.Sender {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of stream %d", msg.Sender, msg.StreamId)
+	}
+
+	// Add the requested deposit
+	_, err := k.AddDeposit(ctx, msg.StreamId, msg.Deposit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// get updated stream data
+	stream, _ = k.GetStream(ctx, msg.StreamId)
+
+	return &types.MsgTopUpDepositByIdResponse{
+		DepositAmount:   msg.Deposit,
+		CurrentDeposit:  stream.Deposit,
+		DepositZeroTime: stream.DepositZeroTime,
+	}, nil
+}
+
+// UpdateFlowRateById updates a stream's flow rate using the stream ID
+func (k msgServer) UpdateFlowRateById(goCtx context.Context, msg *types.MsgUpdateFlowRateById) (*types.MsgUpdateFlowRateByIdResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, accErr := sdk.AccAddressFromBech32(msg.Sender)
+	if accErr != nil {
+		return nil, accErr
+	}
+
+	if msg.FlowRate <= 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidData, "flow rate must be > zero")
+	}
+
+	if err := k.GetParams(ctx).CheckFlowRate(msg.FlowRate); err != nil {
+		return nil, err
+	}
+
+	stream, ok := k.GetStream(ctx, msg.StreamId)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream not found. stream id %d", msg.StreamId)
+	}
+
+	if stream.Sender != msg.Sender {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of stream %d", msg.Sender, msg.StreamId)
+	}
+
+	// update the flow rate
+	err := k.SetNewFlowRate(ctx, msg.StreamId, msg.FlowRate)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateFlowRateByIdResponse{
+		FlowRate: msg.FlowRate,
+	}, nil
+}
+
+// CancelStreamById cancels a stream using the stream ID
+func (k msgServer) CancelStreamById(goCtx context.Context, msg *types.MsgCancelStreamById) (*types.MsgCancelStreamByIdResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, accErr := sdk.AccAddressFromBech32(msg.Sender)
+	if accErr != nil {
+		return nil, accErr
+	}
+
+	stream, ok := k.GetStream(ctx, msg.StreamId)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream not found. stream id %d", msg.StreamId)
+	}
+
+	if stream.Sender != msg.Sender {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of stream %d", msg.Sender, msg.StreamId)
+	}
+
+	if !stream.Cancellable {
+		return nil, errorsmod.Wrap(types.ErrStreamNotCancellable, "cannot be cancelled")
+	}
+
+	// cancel stream
+	err := k.CancelAndRefundStream(ctx, msg.StreamId)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelStreamByIdResponse{}, nil
+}
+
+// ClaimAllStreams claims from a batch of a receiver's streams
+func (k msgServer) ClaimAllStreams(goCtx context.Context, msg *types.MsgClaimAllStreams) (*types.MsgClaimAllStreamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	receiverAddr, accErr := sdk.AccAddressFromBech32(msg.Receiver)
+	if accErr != nil {
+		return nil, accErr
+	}
+
+	if len(msg.Senders) > types.MaxClaimAllStreams {
+		return nil, errorsmod.Wrapf(types.ErrInvalidData, "too many senders. Max %d", types.MaxClaimAllStreams)
+	}
+
+	senderAddrs := make([]sdk.AccAddress, 0, len(msg.Senders))
+	for _, sender := range msg.Senders {
+		senderAddr, err := sdk.AccAddressFromBech32(sender)
+		if err != nil {
+			return nil, err
+		}
+		senderAddrs = append(senderAddrs, senderAddr)
+	}
+
+	limit := msg.MaxStreams
+	if limit == 0 {
+		limit = types.DefaultClaimAllStreams
+	}
+	if limit > types.MaxClaimAllStreams {
+		return nil, errorsmod.Wrapf(types.ErrInvalidData, "max streams cannot be more than %d", types.MaxClaimAllStreams)
+	}
+
+	results, nextKey, err := k.ClaimFromReceiverStreams(ctx, receiverAddr, senderAddrs, msg.StartKey, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	totalClaimed := sdk.NewCoins()
+	totalPayments := sdk.NewCoins()
+	totalValFees := sdk.NewCoins()
+
+	for _, res := range results {
+		totalClaimed = totalClaimed.Add(res.TotalClaimed)
+		totalPayments = totalPayments.Add(res.StreamPayment)
+		totalValFees = totalValFees.Add(res.ValidatorFee)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimAllStreams,
+			sdk.NewAttribute(types.AttributeKeyStreamReceiver, msg.Receiver),
+			sdk.NewAttribute(types.AttributeKeyNumStreamsClaimed, strconv.Itoa(len(results))),
+			sdk.NewAttribute(types.AttributeKeyClaimTotal, totalClaimed.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimAmountReceived, totalPayments.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimValidatorFee, totalValFees.String()),
+		),
+	)
+
+	defer telemetry.IncrCounter(float32(len(results)), types.ModuleName, types.EventTypeClaimAllStreams)
+
+	return &types.MsgClaimAllStreamsResponse{
+		Results:             results,
+		TotalClaimed:        totalClaimed,
+		TotalStreamPayments: totalPayments,
+		TotalValidatorFees:  totalValFees,
+		NextKey:             nextKey,
+	}, nil
+}
+
+// TransferStreamReceiver transfers a stream to a new receiver
+func (k msgServer) TransferStreamReceiver(goCtx context.Context, msg *types.MsgTransferStreamReceiver) (*types.MsgTransferStreamReceiverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, accErr := sdk.AccAddressFromBech32(msg.Receiver)
+	if accErr != nil {
+		return nil, accErr
+	}
+
+	newReceiverAddr, accErr := sdk.AccAddressFromBech32(msg.NewReceiver)
+	if accErr != nil {
+		return nil, accErr
+	}
+
+	if k.bankKeeper.BlockedAddr(newReceiverAddr) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", msg.NewReceiver)
+	}
+
+	stream, ok := k.GetStream(ctx, msg.StreamId)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream not found. stream id %d", msg.StreamId)
+	}
+
+	if stream.Receiver != msg.Receiver {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the receiver of stream %d", msg.Receiver, msg.StreamId)
+	}
+
+	finalClaimCoin, valFeeCoin, totalClaimValue, remainingDeposit, err := k.ReassignStreamReceiver(ctx, msg.StreamId, newReceiverAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	feeCollectorAmount, communityPoolAmount, burnedAmount := k.GetParams(ctx).SplitValidatorFee(valFeeCoin)
+
+	return &types.MsgTransferStreamReceiverResponse{
+		TotalClaimed:        totalClaimValue,
+		StreamPayment:       finalClaimCoin,
+		ValidatorFee:        valFeeCoin,
+		RemainingDeposit:    remainingDeposit,
+		FeeCollectorAmount:  feeCollectorAmount,
+		CommunityPoolAmount: communityPoolAmount,
+		BurnedAmount:        burnedAmount,
+	}, nil
+}
+
+// PauseStream pauses a pausable stream
+func (k msgServer) PauseStream(goCtx context.Context, msg *types.MsgPauseStream) (*types.MsgPauseStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, accErr := sdk.AccAddressFromBech32(msg.Sender)
+	if accErr != nil {
+		return nil, accErr
+	}
+
+	stream, ok := k.GetStream(ctx, msg.StreamId)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream not found. stream id %d", msg.StreamId)
+	}
+
+	if stream.Sender != msg.Sender {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of stream %d", msg.Sender, msg.StreamId)
+	}
+
+	pausedAt, err := k.PauseStreamFlow(ctx, msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(1, types.ModuleName, types.EventTypeStreamPaused)
+
+	return &types.MsgPauseStreamResponse{
+		PausedAt: pausedAt,
+	}, nil
+}
+
+// ResumeStream resumes a paused stream
+func (k msgServer) ResumeStream(goCtx context.Context, msg *types.MsgResumeStream) (*types.MsgResumeStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, accErr := sdk.AccAddressFromBech32(msg.Sender)
+	if accErr != nil {
+		return nil, accErr
+	}
+
+	stream, ok := k.GetStream(ctx, msg.StreamId)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream not found. stream id %d", msg.StreamId)
+	}
+
+	if stream.Sender != msg.Sender {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of stream %d", msg.Sender, msg.StreamId)
+	}
+
+	depositZeroTime, err := k.ResumeStreamFlow(ctx, msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(1, types.ModuleName, types.EventTypeStreamResumed)
+
+	return &types.MsgResumeStreamResponse{
+		DepositZeroTime: depositZeroTime,
+	}, nil
+}
+
+// SetLowDepositThreshold sets a stream's low deposit alert threshold
+func (k msgServer) SetLowDepositThreshold(goCtx context.Context, msg *types.MsgSetLowDepositThreshold) (*types.MsgSetLowDepositThresholdResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, accErr := sdk.AccAddressFromBech32(msg.Sender)
+	if accErr != nil {
+		return nil, accErr
+	}
+
+	stream, ok := k.GetStream(ctx, msg.StreamId)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream not found. stream id %d", msg.StreamId)
+	}
+
+	if stream.Sender != msg.Sender {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of stream %d", msg.Sender, msg.StreamId)
+	}
+
+	err := k.SetStreamLowDepositThreshold(ctx, msg.StreamId, msg.LowDepositThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetLowDepositThresholdResponse{}, nil
+}
+
+// RequestStreamTermination requests the termination of a stream after a notice period
+func (k msgServer) RequestStreamTermination(goCtx context.Context, msg *types.MsgRequestStreamTermination) (*types.MsgRequestStreamTerminationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, accErr := sdk.AccAddressFromBech32(msg.Sender)
+	if accErr != nil {
+		return nil, accErr
+	}
+
+	stream, ok := k.GetStream(ctx, msg.StreamId)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream not found. stream id %d", msg.StreamId)
+	}
+
+	if stream.Sender != msg.Sender {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the sender of stream %d", msg.Sender, msg.StreamId)
+	}
+
+	terminationTime, err := k.RequestTermination(ctx, msg.StreamId, msg.NoticeDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(1, types.ModuleName, types.EventTypeTerminationRequested)
+
+	return &types.MsgRequestStreamTerminationResponse{
+		TerminationTime: terminationTime,
+	}, nil
+}
+
+// AcceptStreamTermination accepts the early termination of a stream
+func (k msgServer) AcceptStreamTermination(goCtx context.Context, msg *types.MsgAcceptStreamTermination) (*types.MsgAcceptStreamTerminationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, accErr := sdk.AccAddressFromBech32(msg.Receiver)
+	if accErr != nil {
+		return nil, accErr
+	}
+
+	stream, ok := k.GetStream(ctx, msg.StreamId)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream not found. stream id %d", msg.StreamId)
+	}
+
+	if stream.Receiver != msg.Receiver {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the receiver of stream %d", msg.Receiver, msg.StreamId)
+	}
+
+	refundAmount, err := k.AcceptTermination(ctx, msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(1, types.ModuleName, types.EventTypeStreamTerminated)
+
+	return &types.MsgAcceptStreamTerminationResponse{
+		RefundAmount: refundAmount,
+	}, nil
+}
+
+// AcceptPausableStream accepts that a pausable stream can be paused by its sender
+func (k msgServer) AcceptPausableStream(goCtx context.Context, msg *types.MsgAcceptPausableStream) (*types.MsgAcceptPausableStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, accErr := sdk.AccAddressFromBech32(msg.Receiver)
+	if accErr != nil {
+		return nil, accErr
+	}
+
+	stream, ok := k.GetStream(ctx, msg.StreamId)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream not found. stream id %d", msg.StreamId)
+	}
+
+	if stream.Receiver != msg.Receiver {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not the receiver of stream %d", msg.Receiver, msg.StreamId)
+	}
+
+	err := k.AcceptPausable(ctx, msg.StreamId)
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(1, types.ModuleName, types.EventTypePausableAccepted)
+
+	return &types.MsgAcceptPausableStreamResponse{}, nil
+}
+
 func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.authority != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
@@ -5,7 +5,10 @@ import (
 
 	mathmod "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
+	simapphelpers "github.com/unification-com/mainchain/app/helpers"
 	"github.com/unification-com/mainchain/x/stream/types"
 )
 
@@ -21,7 +24,11 @@ func (s *KeeperTestSuite) TestMsgServerUpdateParams() {
 			request: &types.MsgUpdateParams{
 				Authority: s.app.StreamKeeper.GetAuthority(),
 				Params: types.Params{
-					ValidatorFee: mathmod.LegacyNewDecWithPrec(24, 2),
+					ValidatorFee:       mathmod.LegacyNewDecWithPrec(24, 2),
+					FeeCollectorRatio:  types.DefaultFeeCollectorRatio,
+					CommunityPoolRatio: types.DefaultCommunityPoolRatio,
+					BurnRatio:          types.DefaultBurnRatio,
+					MaxClaimFeeRatio:   types.DefaultMaxClaimFeeRatio,
 				},
 			},
 			expectErr: false,
@@ -40,7 +47,11 @@ func (s *KeeperTestSuite) TestMsgServerUpdateParams() {
 			request: &types.MsgUpdateParams{
 				Authority: s.app.StreamKeeper.GetAuthority(),
 				Params: types.Params{
-					ValidatorFee: mathmod.LegacyNewDecWithPrec(101, 2),
+					ValidatorFee:       mathmod.LegacyNewDecWithPrec(101, 2),
+					FeeCollectorRatio:  types.DefaultFeeCollectorRatio,
+					CommunityPoolRatio: types.DefaultCommunityPoolRatio,
+					BurnRatio:          types.DefaultBurnRatio,
+					MaxClaimFeeRatio:   types.DefaultMaxClaimFeeRatio,
 				},
 			},
 			expectErr: true,
@@ -51,7 +62,11 @@ func (s *KeeperTestSuite) TestMsgServerUpdateParams() {
 			request: &types.MsgUpdateParams{
 				Authority: s.app.StreamKeeper.GetAuthority(),
 				Params: types.Params{
-					ValidatorFee: mathmod.LegacyNewDecWithPrec(-1, 2),
+					ValidatorFee:       mathmod.LegacyNewDecWithPrec(-1, 2),
+					FeeCollectorRatio:  types.DefaultFeeCollectorRatio,
+					CommunityPoolRatio: types.DefaultCommunityPoolRatio,
+					BurnRatio:          types.DefaultBurnRatio,
+					MaxClaimFeeRatio:   types.DefaultMaxClaimFeeRatio,
 				},
 			},
 			expectErr: true,
@@ -62,7 +77,11 @@ func (s *KeeperTestSuite) TestMsgServerUpdateParams() {
 			request: &types.MsgUpdateParams{
 				Authority: s.app.StreamKeeper.GetAuthority(),
 				Params: types.Params{
-					ValidatorFee: mathmod.LegacyDec{},
+					ValidatorFee:       mathmod.LegacyDec{},
+					FeeCollectorRatio:  types.DefaultFeeCollectorRatio,
+					CommunityPoolRatio: types.DefaultCommunityPoolRatio,
+					BurnRatio:          types.DefaultBurnRatio,
+					MaxClaimFeeRatio:   types.DefaultMaxClaimFeeRatio,
 				},
 			},
 			expectErr: true,
@@ -104,21 +123,28 @@ func (s *KeeperTestSuite) TestMsgServerCreateStream() {
 				Receiver: s.addrs[1].String(),
 				Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
 				FlowRate: 1,
+				StreamId: 1,
 			},
 			expectErr: false,
 			expErrMsg: "",
 		},
 		{
-			name: "invalid - stream exists",
+			name: "create valid second stream for pair",
 			request: &types.MsgCreateStream{
 				Sender:   s.addrs[0].String(),
 				Receiver: s.addrs[1].String(),
-				Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
-				FlowRate: 1,
+				Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000),
+				FlowRate: 2,
 			},
-			expResult: nil,
-			expectErr: true,
-			expErrMsg: "use update stream msg to modify existing stream",
+			expResult: &types.MsgCreateStreamResponse{
+				Sender:   s.addrs[0].String(),
+				Receiver: s.addrs[1].String(),
+				Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000),
+				FlowRate: 2,
+				StreamId: 2,
+			},
+			expectErr: false,
+			expErrMsg: "",
 		},
 		{
 			name: "invalid - empty sender address",
@@ -251,7 +277,7 @@ func (s *KeeperTestSuite) TestMsgServerCreateStream() {
 			},
 			expResult: nil,
 			expectErr: true,
-			expErrMsg: "calculated duration too short. Must be > 1 minute",
+			expErrMsg: "calculated duration too short. Must be at least 60 seconds",
 		},
 	}
 
@@ -270,10 +296,109 @@ func (s *KeeperTestSuite) TestMsgServerCreateStream() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgServerCreateStream_Schedule() {
+	nowTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(nowTime)
+
+	startTime := nowTime.Add(time.Hour)
+	cliffTime := nowTime.Add(time.Hour * 2)
+	endTime := nowTime.Add(time.Hour * 3)
+	pastTime := nowTime.Add(-time.Hour)
+	lateCliffTime := nowTime.Add(time.Hour * 24)
+
+	testCases := []struct {
+		name      string
+		deposit   sdk.Coin
+		startTime *time.Time
+		cliffTime *time.Time
+		endTime   *time.Time
+		expectErr bool
+		expErrMsg string
+	}{
+		{
+			name:      "valid start, cliff and end",
+			deposit:   sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000),
+			startTime: &startTime,
+			cliffTime: &cliffTime,
+			endTime:   &endTime,
+		},
+		{
+			name:    "valid end only",
+			deposit: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000),
+			endTime: &endTime,
+		},
+		{
+			name:      "invalid - start time in past",
+			deposit:   sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000),
+			startTime: &pastTime,
+			expectErr: true,
+			expErrMsg: "start time cannot be in the past",
+		},
+		{
+			name:      "invalid - cliff before now",
+			deposit:   sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000),
+			cliffTime: &pastTime,
+			expectErr: true,
+			expErrMsg: "cliff time cannot be before start time",
+		},
+		{
+			name:      "invalid - end before now",
+			deposit:   sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000),
+			endTime:   &pastTime,
+			expectErr: true,
+			expErrMsg: "end time must be after start time",
+		},
+		{
+			name:      "invalid - deposit does not cover cliff",
+			deposit:   sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+			startTime: &startTime,
+			cliffTime: &lateCliffTime,
+			expectErr: true,
+			expErrMsg: "deposit must cover the stream until its cliff time",
+		},
+	}
+
+	for i, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.msgServer.CreateStream(tCtx, &types.MsgCreateStream{
+				Sender:    s.addrs[0].String(),
+				Receiver:  s.addrs[i+1].String(),
+				Deposit:   tc.deposit,
+				FlowRate:  1,
+				StartTime: tc.startTime,
+				CliffTime: tc.cliffTime,
+				EndTime:   tc.endTime,
+			})
+			if tc.expectErr {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				s.Require().Nil(res)
+				return
+			}
+
+			s.Require().NoError(err)
+			stream, ok := s.app.StreamKeeper.GetStream(tCtx, res.StreamId)
+			s.Require().True(ok)
+
+			expStartTime := nowTime
+			if tc.startTime != nil {
+				expStartTime = *tc.startTime
+			}
+			s.Require().Equal(expStartTime, stream.StartTime)
+			if tc.cliffTime != nil {
+				s.Require().Equal(*tc.cliffTime, stream.CliffTime)
+			}
+			if tc.endTime != nil {
+				s.Require().Equal(*tc.endTime, stream.EndTime)
+				s.Require().Equal(*tc.endTime, stream.DepositZeroTime)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestMsgServerClaimStream() {
 
 	// Set fee to 0.01 (default is 0.00)
-	_ = s.app.StreamKeeper.SetParams(s.ctx, types.NewParams(mathmod.LegacyNewDecWithPrec(1, 2)))
+	_ = s.app.StreamKeeper.SetParams(s.ctx, types.NewParams(mathmod.LegacyNewDecWithPrec(1, 2), types.DefaultAllowedDenoms, types.DefaultMinDuration, types.DefaultMinDeposit, types.DefaultMaxFlowRate, types.DefaultFeeCollectorRatio, types.DefaultCommunityPoolRatio, types.DefaultBurnRatio, types.DefaultMinTerminationNotice, types.DefaultMaxClaimFeeRatio))
 
 	testCases := []struct {
 		name      string
@@ -296,10 +421,13 @@ func (s *KeeperTestSuite) TestMsgServerClaimStream() {
 				Receiver: s.addrs[1].String(),
 			},
 			expResult: &types.MsgClaimStreamResponse{
-				TotalClaimed:     sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
-				StreamPayment:    sdk.NewInt64Coin(sdk.DefaultBondDenom, 990),
-				ValidatorFee:     sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), // default fee is 1%
-				RemainingDeposit: sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+				TotalClaimed:        sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+				StreamPayment:       sdk.NewInt64Coin(sdk.DefaultBondDenom, 990),
+				ValidatorFee:        sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), // default fee is 1%
+				RemainingDeposit:    sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+				FeeCollectorAmount:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 10), // default routing sends all to fee collector
+				CommunityPoolAmount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+				BurnedAmount:        sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
 			},
 			expectErr: false,
 			expErrMsg: "",
@@ -422,7 +550,7 @@ func (s *KeeperTestSuite) TestMsgServerTopUpDeposit() {
 			expErrMsg: "",
 		},
 		{
-			name: "invalid topup - denom mistmatch",
+			name: "invalid topup - denom not allowed",
 			create: &types.MsgCreateStream{
 				Sender:   s.addrs[2].String(),
 				Receiver: s.addrs[3].String(),
@@ -436,7 +564,7 @@ func (s *KeeperTestSuite) TestMsgServerTopUpDeposit() {
 			},
 			expResult: nil,
 			expectErr: true,
-			expErrMsg: "top up denom does not match stream denom",
+			expErrMsg: "notstake cannot be used for streams: denom not allowed",
 		},
 		{
 			name:   "invalid topup - bad sender address",
@@ -805,6 +933,9 @@ func (s *KeeperTestSuite) TestMsgServerCancelStream_Fail_NotCancellable() {
 
 	nowTime := time.Unix(time.Now().Unix(), 0).UTC()
 	expStream := types.Stream{
+		StreamId:        1,
+		Receiver:        s.addrs[1].String(),
+		Sender:          s.addrs[0].String(),
 		Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
 		FlowRate:        1,
 		LastOutflowTime: nowTime,
@@ -812,7 +943,7 @@ func (s *KeeperTestSuite) TestMsgServerCancelStream_Fail_NotCancellable() {
 		Cancellable:     false,
 	}
 
-	err := s.app.StreamKeeper.SetStream(s.ctx, s.addrs[1], s.addrs[0], expStream)
+	err := s.app.StreamKeeper.SetStream(s.ctx, expStream)
 	s.Require().NoError(err)
 
 	cancelMsg := &types.MsgCancelStream{
@@ -824,8 +955,615 @@ func (s *KeeperTestSuite) TestMsgServerCancelStream_Fail_NotCancellable() {
 	s.Require().Nil(resp)
 	s.Require().ErrorContains(err, "cannot be cancelled")
 
+	resp2, err := s.msgServer.CancelStreamById(s.ctx, types.NewMsgCancelStreamById(s.addrs[0], 1))
+	s.Require().Nil(resp2)
+	s.Require().ErrorContains(err, "cannot be cancelled")
+
 	// double check
-	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, s.addrs[1], s.addrs[0])
+	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, 1)
 	s.Require().True(ok)
 	s.Require().Equal(expStream, stream)
 }
+
+func (s *KeeperTestSuite) TestMsgServerAllowedDenoms() {
+	newAccs := simapphelpers.AddTestAddrsWithExtraNonBondCoin(s.app, s.ctx, 2, mathmod.NewIntFromUint64(10000000), sdk.NewInt64Coin("testdenom", 1000000))
+	sender := newAccs[0]
+	receiver := newAccs[1]
+
+	tCtx := s.ctx
+	nowTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx = tCtx.WithBlockTime(nowTime)
+
+	// testdenom not yet allowed
+	_, err := s.msgServer.CreateStream(tCtx, types.NewMsgCreateStream(sdk.NewInt64Coin("testdenom", 1000), 1, receiver, sender))
+	s.Require().ErrorContains(err, "testdenom cannot be used for streams: denom not allowed")
+
+	err = s.app.StreamKeeper.SetParams(tCtx, types.NewParams(types.DefaultValidatorFee, []string{sdk.DefaultBondDenom, "testdenom"}, types.DefaultMinDuration, types.DefaultMinDeposit, types.DefaultMaxFlowRate, types.DefaultFeeCollectorRatio, types.DefaultCommunityPoolRatio, types.DefaultBurnRatio, types.DefaultMinTerminationNotice, types.DefaultMaxClaimFeeRatio))
+	s.Require().NoError(err)
+
+	testDenomRes, err := s.msgServer.CreateStream(tCtx, types.NewMsgCreateStream(sdk.NewInt64Coin("testdenom", 1000), 1, receiver, sender))
+	s.Require().NoError(err)
+	nundRes, err := s.msgServer.CreateStream(tCtx, types.NewMsgCreateStream(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000), 1, receiver, sender))
+	s.Require().NoError(err)
+
+	tCtx = tCtx.WithBlockTime(nowTime.Add(time.Second * 100))
+
+	// the pair has two streams, so the denom is required
+	_, err = s.msgServer.ClaimStream(tCtx, types.NewMsgClaimStream(receiver, sender, ""))
+	s.Require().ErrorContains(err, "denom or stream id required")
+
+	res, err := s.msgServer.ClaimStream(tCtx, types.NewMsgClaimStream(receiver, sender, "testdenom"))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("testdenom", 100), res.TotalClaimed)
+
+	_, err = s.msgServer.UpdateFlowRate(tCtx, types.NewMsgUpdateFlowRate(receiver, sender, 2, sdk.DefaultBondDenom))
+	s.Require().NoError(err)
+
+	stream, _ := s.app.StreamKeeper.GetStream(tCtx, nundRes.StreamId)
+	s.Require().Equal(int64(2), stream.FlowRate)
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, testDenomRes.StreamId)
+	s.Require().Equal(int64(1), stream.FlowRate)
+
+	// governance removes testdenom. Existing streams can still be claimed and cancelled, but not topped up
+	err = s.app.StreamKeeper.SetParams(tCtx, types.DefaultParams())
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.TopUpDeposit(tCtx, types.NewMsgTopUpDeposit(receiver, sender, sdk.NewInt64Coin("testdenom", 1000)))
+	s.Require().ErrorContains(err, "denom not allowed")
+
+	_, err = s.msgServer.ClaimStream(tCtx, types.NewMsgClaimStream(receiver, sender, "testdenom"))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.CancelStream(tCtx, types.NewMsgCancelStream(receiver, sender, "testdenom"))
+	s.Require().NoError(err)
+
+	// only the nund stream remains, so the denom can be omitted
+	_, err = s.msgServer.CancelStream(tCtx, types.NewMsgCancelStream(receiver, sender, ""))
+	s.Require().NoError(err)
+	s.Require().Len(s.app.StreamKeeper.GetStreamsForReceiverSender(tCtx, receiver, sender), 0)
+}
+
+func (s *KeeperTestSuite) TestMsgServerMultipleStreamsPerPair() {
+	tCtx := s.ctx
+	nowTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx = tCtx.WithBlockTime(nowTime)
+
+	sender := s.addrs[0]
+	receiver := s.addrs[1]
+
+	salaryRes, err := s.msgServer.CreateStream(tCtx, types.NewMsgCreateStream(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), 1, receiver, sender))
+	s.Require().NoError(err)
+	bonusRes, err := s.msgServer.CreateStream(tCtx, types.NewMsgCreateStream(sdk.NewInt64Coin(sdk.DefaultBondDenom, 4000), 2, receiver, sender))
+	s.Require().NoError(err)
+	s.Require().NotEqual(salaryRes.StreamId, bonusRes.StreamId)
+	s.Require().Len(s.app.StreamKeeper.GetStreamsForReceiverSender(tCtx, receiver, sender), 2)
+
+	tCtx = tCtx.WithBlockTime(nowTime.Add(time.Second * 100))
+
+	// same pair and denom - pair based msgs are ambiguous
+	_, err = s.msgServer.ClaimStream(tCtx, types.NewMsgClaimStream(receiver, sender, sdk.DefaultBondDenom))
+	s.Require().ErrorContains(err, "denom or stream id required")
+	_, err = s.msgServer.TopUpDeposit(tCtx, types.NewMsgTopUpDeposit(receiver, sender, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	s.Require().ErrorContains(err, "denom or stream id required")
+	_, err = s.msgServer.UpdateFlowRate(tCtx, types.NewMsgUpdateFlowRate(receiver, sender, 3, ""))
+	s.Require().ErrorContains(err, "denom or stream id required")
+	_, err = s.msgServer.CancelStream(tCtx, types.NewMsgCancelStream(receiver, sender, ""))
+	s.Require().ErrorContains(err, "denom or stream id required")
+
+	// claim
+	_, err = s.msgServer.ClaimStreamById(tCtx, types.NewMsgClaimStreamById(sender, bonusRes.StreamId))
+	s.Require().ErrorContains(err, "is not the receiver of stream")
+
+	claimRes, err := s.msgServer.ClaimStreamById(tCtx, types.NewMsgClaimStreamById(receiver, bonusRes.StreamId))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200), claimRes.TotalClaimed)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 3800), claimRes.RemainingDeposit)
+
+	salaryStream, _ := s.app.StreamKeeper.GetStream(tCtx, salaryRes.StreamId)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), salaryStream.Deposit)
+
+	// top up
+	_, err = s.msgServer.TopUpDepositById(tCtx, types.NewMsgTopUpDepositById(receiver, salaryRes.StreamId, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	s.Require().ErrorContains(err, "is not the sender of stream")
+
+	_, err = s.msgServer.TopUpDepositById(tCtx, types.NewMsgTopUpDepositById(sender, salaryRes.StreamId, sdk.NewInt64Coin("notstake", 100)))
+	s.Require().ErrorContains(err, "denom not allowed")
+
+	topUpRes, err := s.msgServer.TopUpDepositById(tCtx, types.NewMsgTopUpDepositById(sender, salaryRes.StreamId, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)))
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1100), topUpRes.CurrentDeposit)
+	s.Require().Equal(nowTime.Add(time.Second*1100), topUpRes.DepositZeroTime)
+
+	// update flow rate
+	_, err = s.msgServer.UpdateFlowRateById(tCtx, types.NewMsgUpdateFlowRateById(receiver, salaryRes.StreamId, 3))
+	s.Require().ErrorContains(err, "is not the sender of stream")
+
+	_, err = s.msgServer.UpdateFlowRateById(tCtx, types.NewMsgUpdateFlowRateById(sender, salaryRes.StreamId, 3))
+	s.Require().NoError(err)
+	salaryStream, _ = s.app.StreamKeeper.GetStream(tCtx, salaryRes.StreamId)
+	s.Require().Equal(int64(3), salaryStream.FlowRate)
+	bonusStream, _ := s.app.StreamKeeper.GetStream(tCtx, bonusRes.StreamId)
+	s.Require().Equal(int64(2), bonusStream.FlowRate)
+
+	// cancel
+	_, err = s.msgServer.CancelStreamById(tCtx, types.NewMsgCancelStreamById(receiver, bonusRes.StreamId))
+	s.Require().ErrorContains(err, "is not the sender of stream")
+
+	_, err = s.msgServer.CancelStreamById(tCtx, types.NewMsgCancelStreamById(sender, bonusRes.StreamId))
+	s.Require().NoError(err)
+	s.Require().False(s.app.StreamKeeper.IsStream(tCtx, bonusRes.StreamId))
+
+	_, err = s.msgServer.ClaimStreamById(tCtx, types.NewMsgClaimStreamById(receiver, bonusRes.StreamId))
+	s.Require().ErrorContains(err, "stream not found")
+
+	// only one stream remains, so the pair based msgs can be used again
+	_, err = s.msgServer.CancelStream(tCtx, types.NewMsgCancelStream(receiver, sender, ""))
+	s.Require().NoError(err)
+	s.Require().Len(s.app.StreamKeeper.GetStreamsForReceiverSender(tCtx, receiver, sender), 0)
+}
+
+func (s *KeeperTestSuite) TestMsgServerClaimAllStreams() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	params := s.app.StreamKeeper.GetParams(tCtx)
+	params.ValidatorFee = mathmod.LegacyNewDecWithPrec(1, 2)
+	_ = s.app.StreamKeeper.SetParams(tCtx, params)
+
+	receiver := s.addrs[0]
+
+	for i := 1; i <= 3; i++ {
+		_, err := s.msgServer.CreateStream(tCtx, &types.MsgCreateStream{
+			Sender:   s.addrs[i].String(),
+			Receiver: receiver.String(),
+			Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000),
+			FlowRate: 10,
+		})
+		s.Require().NoError(err)
+	}
+
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Second * 100)).WithEventManager(sdk.NewEventManager())
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgClaimAllStreams
+		expNum    int
+		expNext   bool
+		expErrMsg string
+	}{
+		{
+			name:    "claim first two",
+			request: &types.MsgClaimAllStreams{Receiver: receiver.String(), MaxStreams: 2},
+			expNum:  2,
+			expNext: true,
+		},
+		{
+			name:      "invalid - max streams too high",
+			request:   &types.MsgClaimAllStreams{Receiver: receiver.String(), MaxStreams: types.MaxClaimAllStreams + 1},
+			expErrMsg: "max streams cannot be more than",
+		},
+		{
+			name:      "invalid - bad sender",
+			request:   &types.MsgClaimAllStreams{Receiver: receiver.String(), Senders: []string{"rubbish"}},
+			expErrMsg: "decoding bech32 failed",
+		},
+		{
+			name:      "invalid - bad receiver",
+			request:   &types.MsgClaimAllStreams{Receiver: "rubbish"},
+			expErrMsg: "decoding bech32 failed",
+		},
+	}
+
+	var nextKey []byte
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.msgServer.ClaimAllStreams(tCtx, tc.request)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				s.Require().Nil(res)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Len(res.Results, tc.expNum)
+			s.Require().Equal(tc.expNext, len(res.NextKey) > 0)
+			s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 2000)), res.TotalClaimed)
+			s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1980)), res.TotalStreamPayments)
+			s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 20)), res.TotalValidatorFees)
+			nextKey = res.NextKey
+		})
+	}
+
+	// continue from the next key
+	res, err := s.msgServer.ClaimAllStreams(tCtx, &types.MsgClaimAllStreams{Receiver: receiver.String(), StartKey: nextKey})
+	s.Require().NoError(err)
+	s.Require().Len(res.Results, 1)
+	s.Require().Empty(res.NextKey)
+	s.Require().Equal(s.addrs[3].String(), res.Results[0].Sender)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), res.TotalValidatorFees)
+
+	hasEvent := false
+	for _, ev := range tCtx.EventManager().Events() {
+		if ev.Type == types.EventTypeClaimAllStreams {
+			hasEvent = true
+			attrNum, ok := ev.GetAttribute(types.AttributeKeyNumStreamsClaimed)
+			s.Require().True(ok)
+			s.Require().Contains([]string{"1", "2"}, attrNum.Value)
+		}
+	}
+	s.Require().True(hasEvent)
+}
+
+func (s *KeeperTestSuite) TestMsgServerTransferStreamReceiver() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	sender := s.addrs[0]
+	receiver := s.addrs[1]
+	newReceiver := s.addrs[2]
+
+	res, err := s.msgServer.CreateStream(tCtx, &types.MsgCreateStream{
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+		Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+		FlowRate: 1,
+	})
+	s.Require().NoError(err)
+	streamID := res.StreamId
+
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Second * 100))
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgTransferStreamReceiver
+		expErrMsg string
+	}{
+		{
+			name:      "invalid - not receiver",
+			request:   &types.MsgTransferStreamReceiver{Receiver: sender.String(), StreamId: streamID, NewReceiver: newReceiver.String()},
+			expErrMsg: "is not the receiver of stream",
+		},
+		{
+			name:      "invalid - stream does not exist",
+			request:   &types.MsgTransferStreamReceiver{Receiver: receiver.String(), StreamId: 99, NewReceiver: newReceiver.String()},
+			expErrMsg: "stream not found",
+		},
+		{
+			name:      "invalid - blocked new receiver",
+			request:   &types.MsgTransferStreamReceiver{Receiver: receiver.String(), StreamId: streamID, NewReceiver: authtypes.NewModuleAddress(types.ModuleName).String()},
+			expErrMsg: "is not allowed to receive funds",
+		},
+		{
+			name:      "invalid - bad new receiver",
+			request:   &types.MsgTransferStreamReceiver{Receiver: receiver.String(), StreamId: streamID, NewReceiver: "rubbish"},
+			expErrMsg: "decoding bech32 failed",
+		},
+		{
+			name:    "valid transfer",
+			request: &types.MsgTransferStreamReceiver{Receiver: receiver.String(), StreamId: streamID, NewReceiver: newReceiver.String()},
+		},
+		{
+			name:      "invalid - old receiver cannot transfer again",
+			request:   &types.MsgTransferStreamReceiver{Receiver: receiver.String(), StreamId: streamID, NewReceiver: s.addrs[3].String()},
+			expErrMsg: "is not the receiver of stream",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			res, err := s.msgServer.TransferStreamReceiver(tCtx, tc.request)
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				s.Require().Nil(res)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), res.TotalClaimed)
+			s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 900), res.RemainingDeposit)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestMsgServerTransferStreamReceiver_Authz() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	sender := s.addrs[0]
+	receiver := s.addrs[1]
+	grantee := s.addrs[2]
+	newReceiver := s.addrs[3]
+
+	res, err := s.msgServer.CreateStream(tCtx, &types.MsgCreateStream{
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+		Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+		FlowRate: 1,
+	})
+	s.Require().NoError(err)
+
+	msg := types.NewMsgTransferStreamReceiver(receiver, res.StreamId, newReceiver)
+
+	// no grant
+	_, err = s.app.AuthzKeeper.DispatchActions(tCtx, grantee, []sdk.Msg{msg})
+	s.Require().ErrorContains(err, "authorization not found")
+
+	expiration := blockTime.Add(time.Hour)
+	err = s.app.AuthzKeeper.SaveGrant(tCtx, grantee, receiver, authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgTransferStreamReceiver{})), &expiration)
+	s.Require().NoError(err)
+
+	_, err = s.app.AuthzKeeper.DispatchActions(tCtx, grantee, []sdk.Msg{msg})
+	s.Require().NoError(err)
+
+	stream, ok := s.app.StreamKeeper.GetStream(tCtx, res.StreamId)
+	s.Require().True(ok)
+	s.Require().Equal(newReceiver.String(), stream.Receiver)
+}
+
+func (s *KeeperTestSuite) TestMsgServerPauseResumeStream() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	sender := s.addrs[0]
+	receiver := s.addrs[1]
+
+	res, err := s.msgServer.CreateStream(tCtx, &types.MsgCreateStream{
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+		Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+		FlowRate: 1,
+		Pausable: true,
+	})
+	s.Require().NoError(err)
+	streamID := res.StreamId
+
+	stream, _ := s.app.StreamKeeper.GetStream(tCtx, streamID)
+	s.Require().True(stream.Pausable)
+
+	pauseTime := blockTime.Add(time.Second * 100)
+	pauseCtx := tCtx.WithBlockTime(pauseTime)
+
+	// the receiver must accept that the stream can be paused
+	_, err = s.msgServer.PauseStream(pauseCtx, &types.MsgPauseStream{Sender: sender.String(), StreamId: streamID})
+	s.Require().ErrorIs(err, types.ErrStreamNotPausable)
+
+	_, err = s.msgServer.AcceptPausableStream(tCtx, &types.MsgAcceptPausableStream{Receiver: sender.String(), StreamId: streamID})
+	s.Require().ErrorContains(err, "is not the receiver of stream")
+
+	_, err = s.msgServer.AcceptPausableStream(tCtx, &types.MsgAcceptPausableStream{Receiver: receiver.String(), StreamId: streamID})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.PauseStream(pauseCtx, &types.MsgPauseStream{Sender: receiver.String(), StreamId: streamID})
+	s.Require().ErrorContains(err, "is not the sender of stream")
+
+	_, err = s.msgServer.PauseStream(pauseCtx, &types.MsgPauseStream{Sender: sender.String(), StreamId: 99})
+	s.Require().ErrorContains(err, "stream not found")
+
+	_, err = s.msgServer.ResumeStream(pauseCtx, &types.MsgResumeStream{Sender: sender.String(), StreamId: streamID})
+	s.Require().ErrorIs(err, types.ErrStreamNotPaused)
+
+	pauseRes, err := s.msgServer.PauseStream(pauseCtx, &types.MsgPauseStream{Sender: sender.String(), StreamId: streamID})
+	s.Require().NoError(err)
+	s.Require().Equal(pauseTime, pauseRes.PausedAt)
+
+	resumeCtx := tCtx.WithBlockTime(blockTime.Add(time.Second * 600))
+
+	_, err = s.msgServer.ResumeStream(resumeCtx, &types.MsgResumeStream{Sender: receiver.String(), StreamId: streamID})
+	s.Require().ErrorContains(err, "is not the sender of stream")
+
+	resumeRes, err := s.msgServer.ResumeStream(resumeCtx, &types.MsgResumeStream{Sender: sender.String(), StreamId: streamID})
+	s.Require().NoError(err)
+	s.Require().Equal(blockTime.Add(time.Second*1500), resumeRes.DepositZeroTime)
+
+	// streams are not pausable by default
+	res, err = s.msgServer.CreateStream(tCtx, &types.MsgCreateStream{
+		Sender:   sender.String(),
+		Receiver: s.addrs[2].String(),
+		Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+		FlowRate: 1,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.PauseStream(pauseCtx, &types.MsgPauseStream{Sender: sender.String(), StreamId: res.StreamId})
+	s.Require().ErrorIs(err, types.ErrStreamNotPausable)
+}
+
+func (s *KeeperTestSuite) TestMsgServerParamLimits() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	sender := s.addrs[0]
+	receiver := s.addrs[1]
+
+	params := s.app.StreamKeeper.GetParams(tCtx)
+	params.MinDuration = 3600
+	params.MinDeposit = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000))
+	params.MaxFlowRate = 2
+	s.Require().NoError(s.app.StreamKeeper.SetParams(tCtx, params))
+
+	createTestCases := []struct {
+		name      string
+		deposit   int64
+		flowRate  int64
+		expErrMsg string
+	}{
+		{"invalid - deposit less than min deposit", 4999, 1, "is less than the minimum deposit 5000nund"},
+		{"invalid - flow rate greater than max", 10000, 3, "is greater than the maximum flow rate 2"},
+		{"invalid - duration less than min duration", 5000, 2, "calculated duration too short. Must be at least 3600 seconds"},
+		{"valid", 7200, 2, ""},
+	}
+
+	var streamID uint64
+	for _, tc := range createTestCases {
+		s.Run(tc.name, func() {
+			res, err := s.msgServer.CreateStream(tCtx, &types.MsgCreateStream{
+				Sender:   sender.String(),
+				Receiver: receiver.String(),
+				Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, tc.deposit),
+				FlowRate: tc.flowRate,
+			})
+			if tc.expErrMsg != "" {
+				s.Require().ErrorContains(err, tc.expErrMsg)
+				s.Require().Nil(res)
+				return
+			}
+			s.Require().NoError(err)
+			streamID = res.StreamId
+		})
+	}
+
+	// top ups are checked against the min deposit
+	_, err := s.msgServer.TopUpDepositById(tCtx, &types.MsgTopUpDepositById{
+		Sender:   sender.String(),
+		StreamId: streamID,
+		Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+	})
+	s.Require().ErrorContains(err, "is less than the minimum deposit")
+
+	_, err = s.msgServer.TopUpDeposit(tCtx, &types.MsgTopUpDeposit{
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+		Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 100),
+	})
+	s.Require().ErrorContains(err, "is less than the minimum deposit")
+
+	_, err = s.msgServer.TopUpDepositById(tCtx, &types.MsgTopUpDepositById{
+		Sender:   sender.String(),
+		StreamId: streamID,
+		Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 5000),
+	})
+	s.Require().NoError(err)
+
+	// flow rate updates are checked against the max flow rate
+	_, err = s.msgServer.UpdateFlowRateById(tCtx, &types.MsgUpdateFlowRateById{
+		Sender:   sender.String(),
+		StreamId: streamID,
+		FlowRate: 3,
+	})
+	s.Require().ErrorContains(err, "is greater than the maximum flow rate 2")
+
+	_, err = s.msgServer.UpdateFlowRate(tCtx, &types.MsgUpdateFlowRate{
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+		Denom:    sdk.DefaultBondDenom,
+		FlowRate: 3,
+	})
+	s.Require().ErrorContains(err, "is greater than the maximum flow rate 2")
+
+	_, err = s.msgServer.UpdateFlowRateById(tCtx, &types.MsgUpdateFlowRateById{
+		Sender:   sender.String(),
+		StreamId: streamID,
+		FlowRate: 1,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestMsgServerSetLowDepositThreshold() {
+	tCtx := s.ctx.WithBlockTime(time.Unix(time.Now().Unix(), 0).UTC())
+	sender := s.addrs[0]
+	receiver := s.addrs[1]
+
+	res, err := s.msgServer.CreateStream(tCtx, &types.MsgCreateStream{
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+		Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+		FlowRate: 1,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.SetLowDepositThreshold(tCtx, &types.MsgSetLowDepositThreshold{
+		Sender:              sender.String(),
+		StreamId:            99,
+		LowDepositThreshold: 300,
+	})
+	s.Require().ErrorContains(err, "stream not found. stream id 99")
+
+	_, err = s.msgServer.SetLowDepositThreshold(tCtx, &types.MsgSetLowDepositThreshold{
+		Sender:              receiver.String(),
+		StreamId:            res.StreamId,
+		LowDepositThreshold: 300,
+	})
+	s.Require().ErrorContains(err, "is not the sender of stream")
+
+	_, err = s.msgServer.SetLowDepositThreshold(tCtx, &types.MsgSetLowDepositThreshold{
+		Sender:              sender.String(),
+		StreamId:            res.StreamId,
+		LowDepositThreshold: types.MaxLowDepositThreshold + 1,
+	})
+	s.Require().ErrorContains(err, "low deposit threshold cannot be more than")
+
+	_, err = s.msgServer.SetLowDepositThreshold(tCtx, &types.MsgSetLowDepositThreshold{
+		Sender:              sender.String(),
+		StreamId:            res.StreamId,
+		LowDepositThreshold: 300,
+	})
+	s.Require().NoError(err)
+
+	stream, ok := s.app.StreamKeeper.GetStream(tCtx, res.StreamId)
+	s.Require().True(ok)
+	s.Require().Equal(uint64(300), stream.LowDepositThreshold)
+	s.Require().False(stream.LowDepositAlerted)
+}
+
+func (s *KeeperTestSuite) TestMsgServerRequestAcceptStreamTermination() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+	sender := s.addrs[0]
+	receiver := s.addrs[1]
+
+	res, err := s.msgServer.CreateStream(tCtx, &types.MsgCreateStream{
+		Sender:   sender.String(),
+		Receiver: receiver.String(),
+		Deposit:  sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(types.DefaultMinTerminationNotice*2)),
+		FlowRate: 1,
+	})
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.RequestStreamTermination(tCtx, &types.MsgRequestStreamTermination{
+		Sender:         sender.String(),
+		StreamId:       99,
+		NoticeDuration: types.DefaultMinTerminationNotice,
+	})
+	s.Require().ErrorContains(err, "stream not found. stream id 99")
+
+	_, err = s.msgServer.RequestStreamTermination(tCtx, &types.MsgRequestStreamTermination{
+		Sender:         receiver.String(),
+		StreamId:       res.StreamId,
+		NoticeDuration: types.DefaultMinTerminationNotice,
+	})
+	s.Require().ErrorContains(err, "is not the sender of stream")
+
+	_, err = s.msgServer.RequestStreamTermination(tCtx, &types.MsgRequestStreamTermination{
+		Sender:         sender.String(),
+		StreamId:       res.StreamId,
+		NoticeDuration: types.DefaultMinTerminationNotice - 1,
+	})
+	s.Require().ErrorContains(err, "termination notice too short")
+
+	// the receiver cannot accept a termination which has not been requested
+	_, err = s.msgServer.AcceptStreamTermination(tCtx, &types.MsgAcceptStreamTermination{
+		Receiver: receiver.String(),
+		StreamId: res.StreamId,
+	})
+	s.Require().ErrorIs(err, types.ErrTerminationNotReq)
+
+	termRes, err := s.msgServer.RequestStreamTermination(tCtx, &types.MsgRequestStreamTermination{
+		Sender:         sender.String(),
+		StreamId:       res.StreamId,
+		NoticeDuration: types.DefaultMinTerminationNotice,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(blockTime.Add(time.Second*time.Duration(types.DefaultMinTerminationNotice)), termRes.TerminationTime)
+
+	_, err = s.msgServer.AcceptStreamTermination(tCtx, &types.MsgAcceptStreamTermination{
+		Receiver: sender.String(),
+		StreamId: res.StreamId,
+	})
+	s.Require().ErrorContains(err, "is not the receiver of stream")
+
+	acceptRes, err := s.msgServer.AcceptStreamTermination(tCtx.WithBlockTime(blockTime.Add(time.Second*100)), &types.MsgAcceptStreamTermination{
+		Receiver: receiver.String(),
+		StreamId: res.StreamId,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, int64(types.DefaultMinTerminationNotice*2-100)), acceptRes.RefundAmount)
+	s.Require().False(s.app.StreamKeeper.IsStream(tCtx, res.StreamId))
+}
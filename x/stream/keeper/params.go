@@ -32,3 +32,8 @@ func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 
 	return nil
 }
+
+// IsAllowedStreamDenom checks if governance has allowed the denom to be used for streams
+func (k Keeper) IsAllowedStreamDenom(ctx sdk.Context, denom string) bool {
+	return k.GetParams(ctx).IsAllowedDenom(denom)
+}
@@ -15,28 +15,44 @@ func (s *KeeperTestSuite) TestParams() {
 		{
 			name: "set full valid params",
 			input: types.Params{
-				ValidatorFee: mathmod.LegacyNewDecWithPrec(24, 2),
+				ValidatorFee:       mathmod.LegacyNewDecWithPrec(24, 2),
+				FeeCollectorRatio:  types.DefaultFeeCollectorRatio,
+				CommunityPoolRatio: types.DefaultCommunityPoolRatio,
+				BurnRatio:          types.DefaultBurnRatio,
+				MaxClaimFeeRatio:   types.DefaultMaxClaimFeeRatio,
 			},
 			expectErr: false,
 		},
 		{
 			name: "set invalid params > 100%",
 			input: types.Params{
-				ValidatorFee: mathmod.LegacyNewDecWithPrec(101, 2),
+				ValidatorFee:       mathmod.LegacyNewDecWithPrec(101, 2),
+				FeeCollectorRatio:  types.DefaultFeeCollectorRatio,
+				CommunityPoolRatio: types.DefaultCommunityPoolRatio,
+				BurnRatio:          types.DefaultBurnRatio,
+				MaxClaimFeeRatio:   types.DefaultMaxClaimFeeRatio,
 			},
 			expectErr: true,
 		},
 		{
 			name: "set invalid params negative value",
 			input: types.Params{
-				ValidatorFee: mathmod.LegacyNewDecWithPrec(-1, 2),
+				ValidatorFee:       mathmod.LegacyNewDecWithPrec(-1, 2),
+				FeeCollectorRatio:  types.DefaultFeeCollectorRatio,
+				CommunityPoolRatio: types.DefaultCommunityPoolRatio,
+				BurnRatio:          types.DefaultBurnRatio,
+				MaxClaimFeeRatio:   types.DefaultMaxClaimFeeRatio,
 			},
 			expectErr: true,
 		},
 		{
 			name: "set invalid params nil value",
 			input: types.Params{
-				ValidatorFee: mathmod.LegacyDec{},
+				ValidatorFee:       mathmod.LegacyDec{},
+				FeeCollectorRatio:  types.DefaultFeeCollectorRatio,
+				CommunityPoolRatio: types.DefaultCommunityPoolRatio,
+				BurnRatio:          types.DefaultBurnRatio,
+				MaxClaimFeeRatio:   types.DefaultMaxClaimFeeRatio,
 			},
 			expectErr: true,
 		},
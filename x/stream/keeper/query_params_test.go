@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	mathmod "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	simapphelpers "github.com/unification-com/mainchain/app/helpers"
 	"github.com/unification-com/mainchain/x/stream/types"
@@ -20,16 +21,16 @@ func (s *KeeperTestSuite) TestParamsQuery() {
 	s.Require().Equal(expRes1, res1)
 
 	req2 := &types.QueryParamsRequest{}
-	expRes2 := &types.QueryParamsResponse{Params: types.Params{ValidatorFee: defaultFee}}
+	expRes2 := &types.QueryParamsResponse{Params: types.Params{ValidatorFee: defaultFee, FeeCollectorRatio: types.DefaultFeeCollectorRatio, CommunityPoolRatio: types.DefaultCommunityPoolRatio, BurnRatio: types.DefaultBurnRatio, AllowedDenoms: types.DefaultAllowedDenoms, MinDuration: types.DefaultMinDuration, MinTerminationNotice: types.DefaultMinTerminationNotice, MaxClaimFeeRatio: types.DefaultMaxClaimFeeRatio}}
 
 	res2, err2 := s.app.StreamKeeper.Params(s.ctx, req2)
 
 	s.Require().NoError(err2)
 	s.Require().Equal(expRes2, res2)
 
-	_ = s.app.StreamKeeper.SetParams(s.ctx, types.NewParams(newFee))
+	_ = s.app.StreamKeeper.SetParams(s.ctx, types.NewParams(newFee, []string{sdk.DefaultBondDenom, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}, types.DefaultMinDuration, types.DefaultMinDeposit, types.DefaultMaxFlowRate, types.DefaultFeeCollectorRatio, types.DefaultCommunityPoolRatio, types.DefaultBurnRatio, types.DefaultMinTerminationNotice, types.DefaultMaxClaimFeeRatio))
 	req3 := &types.QueryParamsRequest{}
-	expRes3 := &types.QueryParamsResponse{Params: types.Params{ValidatorFee: newFee}}
+	expRes3 := &types.QueryParamsResponse{Params: types.Params{ValidatorFee: newFee, FeeCollectorRatio: types.DefaultFeeCollectorRatio, CommunityPoolRatio: types.DefaultCommunityPoolRatio, BurnRatio: types.DefaultBurnRatio, AllowedDenoms: []string{sdk.DefaultBondDenom, "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}, MinDuration: types.DefaultMinDuration, MinTerminationNotice: types.DefaultMinTerminationNotice, MaxClaimFeeRatio: types.DefaultMaxClaimFeeRatio}}
 
 	res3, err3 := s.app.StreamKeeper.Params(s.ctx, req3)
 
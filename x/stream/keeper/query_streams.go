@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
@@ -23,13 +25,9 @@ func (q Keeper) Streams(c context.Context, req *types.QueryStreamsRequest) (*typ
 	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.StreamKeyPrefix)
 
 	streams, pageRes, err := query.GenericFilteredPaginate(q.cdc, store, req.Pagination, func(key []byte, stream *types.Stream) (*types.StreamResult, error) {
-
-		// need to prefix the StreamKeyPrefix 0x11 to the returned key as AddressesFromStreamKey expects it
-		receiverAddr, senderAddr := types.AddressesFromStreamKey(append(types.StreamKeyPrefix, key...))
-
 		return &types.StreamResult{
-			Receiver: receiverAddr.String(),
-			Sender:   senderAddr.String(),
+			Receiver: stream.Receiver,
+			Sender:   stream.Sender,
 			Stream:   stream,
 		}, nil
 	}, func() *types.Stream { return &types.Stream{} })
@@ -59,17 +57,19 @@ func (q Keeper) AllStreamsForSender(c context.Context, req *types.QueryAllStream
 
 	streams, pageRes, err := query.GenericFilteredPaginate(q.cdc, store, req.Pagination, func(key []byte, stream *types.Stream) (*types.StreamResult, error) {
 
-		// need to prefix the StreamKeyPrefix 0x11 to the returned key as AddressesFromStreamKey expects it
-		receiverAddr, s := types.AddressesFromStreamKey(append(types.StreamKeyPrefix, key...))
-
 		// filter by sender address
-		if !s.Equals(senderAddr) {
+		if stream.Sender != senderAddr.String() {
+			return nil, nil
+		}
+
+		// optionally filter by denom
+		if req.Denom != "" && stream.Deposit.Denom != req.Denom {
 			return nil, nil
 		}
 
 		return &types.StreamResult{
-			Receiver: receiverAddr.String(),
-			Sender:   senderAddr.String(),
+			Receiver: stream.Receiver,
+			Sender:   stream.Sender,
 			Stream:   stream,
 		}, nil
 	}, func() *types.Stream { return &types.Stream{} })
@@ -100,7 +100,12 @@ func (q Keeper) StreamByReceiverSender(c context.Context, req *types.QueryStream
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	stream, ok := q.GetStream(ctx, receiverAddr, senderAddr)
+	streamID, err := q.ResolveStreamID(ctx, receiverAddr, senderAddr, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, ok := q.GetStream(ctx, streamID)
 
 	if !ok {
 		return nil, errorsmod.Wrap(types.ErrInvalidData, "stream not found")
@@ -132,7 +137,12 @@ func (q Keeper) StreamReceiverSenderCurrentFlow(c context.Context, req *types.Qu
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	stream, ok := q.GetStream(ctx, receiverAddr, senderAddr)
+	streamID, err := q.ResolveStreamID(ctx, receiverAddr, senderAddr, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	stream, ok := q.GetStream(ctx, streamID)
 
 	if !ok {
 		return nil, errorsmod.Wrap(types.ErrInvalidData, "stream not found")
@@ -140,18 +150,17 @@ func (q Keeper) StreamReceiverSenderCurrentFlow(c context.Context, req *types.Qu
 
 	nowTime := ctx.BlockTime()
 	currentFlow := stream.FlowRate
+	status := stream.StatusAt(nowTime)
 
-	if stream.DepositZeroTime.Before(nowTime) {
-		currentFlow = 0
-	}
-
-	if stream.Deposit.IsNil() || stream.Deposit.IsZero() || stream.Deposit.IsNegative() {
+	// payments accrue during the cliff, but not before the stream starts, while it is paused or after it ends
+	if status == types.StreamStatusPending || status == types.StreamStatusPaused || status == types.StreamStatusEnded {
 		currentFlow = 0
 	}
 
 	return &types.QueryStreamReceiverSenderCurrentFlowResponse{
 		ConfiguredFlowRate: stream.FlowRate,
 		CurrentFlowRate:    currentFlow,
+		Status:             status,
 	}, nil
 }
 
@@ -167,17 +176,31 @@ func (q Keeper) AllStreamsForReceiver(c context.Context, req *types.QueryAllStre
 
 	ctx := sdk.UnwrapSDKContext(c)
 
+	streams := []*types.StreamResult{}
 	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.GetStreamsByReceiverKey(receiverAddr))
 
-	streams, pageRes, err := query.GenericFilteredPaginate(q.cdc, store, req.Pagination, func(key []byte, stream *types.Stream) (*types.StreamResult, error) {
-		senderAddr := types.FirstAddressFromStreamStoreKey(key)
+	// iterate the receiver/sender index. Keys are of the form <senderAddressLen><senderAddress><streamID>
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key []byte, _ []byte, accumulate bool) (bool, error) {
+		stream, ok := q.GetStream(ctx, types.GetStreamIDFromBytes(key[len(key)-8:]))
+		if !ok {
+			return false, nil
+		}
 
-		return &types.StreamResult{
-			Receiver: receiverAddr.String(),
-			Sender:   senderAddr.String(),
-			Stream:   stream,
-		}, nil
-	}, func() *types.Stream { return &types.Stream{} })
+		// optionally filter by denom
+		if req.Denom != "" && stream.Deposit.Denom != req.Denom {
+			return false, nil
+		}
+
+		if accumulate {
+			streams = append(streams, &types.StreamResult{
+				Receiver: stream.Receiver,
+				Sender:   stream.Sender,
+				Stream:   &stream,
+			})
+		}
+
+		return true, nil
+	})
 
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -189,3 +212,132 @@ func (q Keeper) AllStreamsForReceiver(c context.Context, req *types.QueryAllStre
 		Pagination:   pageRes,
 	}, nil
 }
+
+func (q Keeper) StreamById(c context.Context, req *types.QueryStreamByIdRequest) (*types.QueryStreamByIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	stream, ok := q.GetStream(ctx, req.StreamId)
+
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream not found. stream id %d", req.StreamId)
+	}
+
+	return &types.QueryStreamByIdResponse{
+		Stream: types.StreamResult{
+			Receiver: stream.Receiver,
+			Sender:   stream.Sender,
+			Stream:   &stream,
+		},
+	}, nil
+}
+
+func (q Keeper) StreamStats(c context.Context, req *types.QueryStreamStatsRequest) (*types.QueryStreamStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// kept for a period after the stream is deleted
+	stats, ok := q.GetStreamStats(ctx, req.StreamId)
+	if !ok {
+		stream, found := q.GetStream(ctx, req.StreamId)
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream not found. stream id %d", req.StreamId)
+		}
+
+		// nothing has been claimed from the stream yet
+		stats = types.NewStreamStats(req.StreamId, stream.Deposit.Denom)
+	}
+
+	return &types.QueryStreamStatsResponse{
+		Stats: stats,
+	}, nil
+}
+
+func (q Keeper) StreamHistory(c context.Context, req *types.QueryStreamHistoryRequest) (*types.QueryStreamHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// kept for a period after the stream is deleted
+	history, ok := q.GetStreamHistory(ctx, req.StreamId)
+	if !ok {
+		if !q.IsStream(ctx, req.StreamId) {
+			return nil, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream not found. stream id %d", req.StreamId)
+		}
+
+		// the flow rate has not been changed yet
+		history = types.StreamHistory{StreamId: req.StreamId}
+	}
+
+	return &types.QueryStreamHistoryResponse{
+		History: history,
+	}, nil
+}
+
+func (q Keeper) StreamsExpiringBefore(c context.Context, req *types.QueryStreamsExpiringBeforeRequest) (*types.QueryStreamsExpiringBeforeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the expiry queue is ordered by expiry time, so only iterate the part of it before the requested time
+	store := upperBoundStore{
+		KVStore: prefix.NewStore(ctx.KVStore(q.storeKey), types.StreamExpiryQueuePrefix),
+		end:     sdk.FormatTimeBytes(req.Before),
+	}
+
+	var streams []*types.StreamResult
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		_, streamID := types.SplitStreamExpiryQueueKey(append(types.StreamExpiryQueuePrefix, key...))
+		stream, ok := q.GetStream(ctx, streamID)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream id %d in expiry queue not found", streamID)
+		}
+
+		streams = append(streams, &types.StreamResult{
+			Receiver: stream.Receiver,
+			Sender:   stream.Sender,
+			Stream:   &stream,
+		})
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryStreamsExpiringBeforeResponse{
+		Streams: streams, Pagination: pageRes,
+	}, nil
+}
+
+// upperBoundStore limits iteration over a store to keys before end, so a range of an ordered index can be
+// paginated
+type upperBoundStore struct {
+	storetypes.KVStore
+	end []byte
+}
+
+func (s upperBoundStore) Iterator(start, end []byte) storetypes.Iterator {
+	return s.KVStore.Iterator(start, s.upperBound(end))
+}
+
+func (s upperBoundStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.KVStore.ReverseIterator(start, s.upperBound(end))
+}
+
+func (s upperBoundStore) upperBound(end []byte) []byte {
+	if end == nil || bytes.Compare(end, s.end) > 0 {
+		return s.end
+	}
+	return end
+}
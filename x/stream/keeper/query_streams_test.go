@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"time"
 
+	mathmod "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	simapphelpers "github.com/unification-com/mainchain/app/helpers"
 	"github.com/unification-com/mainchain/x/stream/types"
 )
 
@@ -17,9 +19,9 @@ func (s *KeeperTestSuite) TestQueryStreams() {
 
 	for i := int64(1); i <= 10; i++ {
 		deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
-		_, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[i-1], s.addrs[i], deposit, 1)
+		stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[i-1], s.addrs[i], deposit, 1)
 		s.Require().NoError(err)
-		_, err = s.app.StreamKeeper.AddDeposit(tCtx, s.addrs[i-1], s.addrs[i], deposit)
+		_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
 		s.Require().NoError(err)
 	}
 
@@ -29,9 +31,13 @@ func (s *KeeperTestSuite) TestQueryStreams() {
 				Receiver: s.addrs[0].String(),
 				Sender:   s.addrs[1].String(),
 				Stream: &types.Stream{
+					StreamId:        1,
+					Receiver:        s.addrs[0].String(),
+					Sender:          s.addrs[1].String(),
 					Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
 					FlowRate:        1,
 					LastOutflowTime: nowTime,
+					StartTime:       nowTime,
 					DepositZeroTime: time.Unix(nowTime.Unix()+1000, 0).UTC(),
 					Cancellable:     true,
 				},
@@ -40,9 +46,13 @@ func (s *KeeperTestSuite) TestQueryStreams() {
 				Receiver: s.addrs[1].String(),
 				Sender:   s.addrs[2].String(),
 				Stream: &types.Stream{
+					StreamId:        2,
+					Receiver:        s.addrs[1].String(),
+					Sender:          s.addrs[2].String(),
 					Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
 					FlowRate:        1,
 					LastOutflowTime: nowTime,
+					StartTime:       nowTime,
 					DepositZeroTime: time.Unix(nowTime.Unix()+1000, 0).UTC(),
 					Cancellable:     true,
 				},
@@ -96,9 +106,13 @@ func (s *KeeperTestSuite) TestQueryStreamByReceiverSender() {
 					Receiver: successReceiver.String(),
 					Sender:   successSender.String(),
 					Stream: &types.Stream{
+						StreamId:        1,
+						Receiver:        successReceiver.String(),
+						Sender:          successSender.String(),
 						Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
 						FlowRate:        1,
 						LastOutflowTime: nowTime,
+						StartTime:       nowTime,
 						DepositZeroTime: time.Unix(nowTime.Unix()+1000, 0).UTC(),
 						Cancellable:     true,
 					},
@@ -163,8 +177,8 @@ func (s *KeeperTestSuite) TestQueryStreamByReceiverSender() {
 		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
 			if tc.sender != nil {
 				// create & deposit
-				_, _ = s.app.StreamKeeper.CreateNewStream(tCtx, tc.receiver, tc.sender, tc.deposit, tc.flowRate)
-				_, _ = s.app.StreamKeeper.AddDeposit(tCtx, tc.receiver, tc.sender, tc.deposit)
+				stream, _ := s.app.StreamKeeper.CreateNewStream(tCtx, tc.receiver, tc.sender, tc.deposit, tc.flowRate)
+				_, _ = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, tc.deposit)
 			}
 
 			resp, err := s.app.StreamKeeper.StreamByReceiverSender(tCtx, tc.query)
@@ -179,6 +193,101 @@ func (s *KeeperTestSuite) TestQueryStreamByReceiverSender() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryStreamById() {
+
+	tCtx := s.ctx
+	nowTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx = tCtx.WithBlockTime(nowTime)
+
+	sender := s.addrs[0]
+	receiver := s.addrs[1]
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+
+	// create two streams for the same pair
+	for i := int64(1); i <= 2; i++ {
+		stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiver, sender, deposit, i)
+		s.Require().NoError(err)
+		_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+		s.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name      string
+		query     *types.QueryStreamByIdRequest
+		expResp   *types.QueryStreamByIdResponse
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:  "success - stream 1",
+			query: &types.QueryStreamByIdRequest{StreamId: 1},
+			expResp: &types.QueryStreamByIdResponse{
+				Stream: types.StreamResult{
+					Receiver: receiver.String(),
+					Sender:   sender.String(),
+					Stream: &types.Stream{
+						StreamId:        1,
+						Receiver:        receiver.String(),
+						Sender:          sender.String(),
+						Deposit:         deposit,
+						FlowRate:        1,
+						LastOutflowTime: nowTime,
+						StartTime:       nowTime,
+						DepositZeroTime: time.Unix(nowTime.Unix()+1000, 0).UTC(),
+						Cancellable:     true,
+					},
+				},
+			},
+		},
+		{
+			name:  "success - stream 2",
+			query: &types.QueryStreamByIdRequest{StreamId: 2},
+			expResp: &types.QueryStreamByIdResponse{
+				Stream: types.StreamResult{
+					Receiver: receiver.String(),
+					Sender:   sender.String(),
+					Stream: &types.Stream{
+						StreamId:        2,
+						Receiver:        receiver.String(),
+						Sender:          sender.String(),
+						Deposit:         deposit,
+						FlowRate:        2,
+						LastOutflowTime: nowTime,
+						StartTime:       nowTime,
+						DepositZeroTime: time.Unix(nowTime.Unix()+500, 0).UTC(),
+						Cancellable:     true,
+					},
+				},
+			},
+		},
+		{
+			name:      "fail - stream does not exist",
+			query:     &types.QueryStreamByIdRequest{StreamId: 3},
+			expErr:    true,
+			expErrMsg: "stream not found. stream id 3",
+		},
+		{
+			name:      "fail - nil request",
+			query:     nil,
+			expErr:    true,
+			expErrMsg: "invalid request",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			resp, err := s.app.StreamKeeper.StreamById(tCtx, tc.query)
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().ErrorContains(err, tc.expErrMsg)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(tc.expResp, resp)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryStreamReceiverSenderCurrentFlow() {
 	tCtx := s.ctx
 	nowTime := time.Unix(time.Now().Unix(), 0).UTC()
@@ -213,6 +322,7 @@ func (s *KeeperTestSuite) TestQueryStreamReceiverSenderCurrentFlow() {
 			expResp: &types.QueryStreamReceiverSenderCurrentFlowResponse{
 				ConfiguredFlowRate: 1,
 				CurrentFlowRate:    1,
+				Status:             types.StreamStatusFlowing,
 			},
 			expErr:    false,
 			expErrMsg: "",
@@ -227,6 +337,7 @@ func (s *KeeperTestSuite) TestQueryStreamReceiverSenderCurrentFlow() {
 			expResp: &types.QueryStreamReceiverSenderCurrentFlowResponse{
 				ConfiguredFlowRate: 1,
 				CurrentFlowRate:    0,
+				Status:             types.StreamStatusEnded,
 			},
 			expErr:    false,
 			expErrMsg: "",
@@ -292,8 +403,8 @@ func (s *KeeperTestSuite) TestQueryStreamReceiverSenderCurrentFlow() {
 		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
 			if tc.sender != nil {
 				// create & deposit
-				_, _ = s.app.StreamKeeper.CreateNewStream(tCtx, tc.receiver, tc.sender, tc.deposit, tc.flowRate)
-				_, _ = s.app.StreamKeeper.AddDeposit(tCtx, tc.receiver, tc.sender, tc.deposit)
+				stream, _ := s.app.StreamKeeper.CreateNewStream(tCtx, tc.receiver, tc.sender, tc.deposit, tc.flowRate)
+				_, _ = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, tc.deposit)
 			}
 
 			queryTime := time.Unix(nowTime.Unix()+tc.queryFuture, 0).UTC()
@@ -311,6 +422,49 @@ func (s *KeeperTestSuite) TestQueryStreamReceiverSenderCurrentFlow() {
 	}
 }
 
+func (s *KeeperTestSuite) TestQueryStreamReceiverSenderCurrentFlow_Schedule() {
+	nowTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(nowTime)
+
+	sender := s.addrs[0]
+	receiver := s.addrs[1]
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	startTime := nowTime.Add(time.Second * 100)
+	cliffTime := nowTime.Add(time.Second * 200)
+	endTime := nowTime.Add(time.Second * 500)
+
+	stream, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, receiver, sender, deposit, 1, startTime, cliffTime, endTime, false)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+
+	testCases := []struct {
+		name           string
+		queryFuture    int64
+		expStatus      types.StreamStatus
+		expCurrentFlow int64
+	}{
+		{"pending", 50, types.StreamStatusPending, 0},
+		{"cliff", 150, types.StreamStatusCliff, 1},
+		{"flowing", 300, types.StreamStatusFlowing, 1},
+		{"ended", 500, types.StreamStatusEnded, 0},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			queryCtx := tCtx.WithBlockTime(nowTime.Add(time.Second * time.Duration(tc.queryFuture)))
+			resp, err := s.app.StreamKeeper.StreamReceiverSenderCurrentFlow(queryCtx, &types.QueryStreamReceiverSenderCurrentFlowRequest{
+				SenderAddr:   sender.String(),
+				ReceiverAddr: receiver.String(),
+			})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expStatus, resp.Status)
+			s.Require().Equal(int64(1), resp.ConfiguredFlowRate)
+			s.Require().Equal(tc.expCurrentFlow, resp.CurrentFlowRate)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestQueryAllStreamsForReceiver_Success() {
 	tCtx := s.ctx
 	nowTime := time.Unix(time.Now().Unix(), 0).UTC()
@@ -322,9 +476,9 @@ func (s *KeeperTestSuite) TestQueryAllStreamsForReceiver_Success() {
 		if i < 4 {
 			receiverAddr = s.addrs[0]
 		}
-		_, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiverAddr, s.addrs[i], deposit, 1)
+		stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiverAddr, s.addrs[i], deposit, 1)
 		s.Require().NoError(err)
-		_, err = s.app.StreamKeeper.AddDeposit(tCtx, receiverAddr, s.addrs[i], deposit)
+		_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
 		s.Require().NoError(err)
 	}
 
@@ -334,9 +488,13 @@ func (s *KeeperTestSuite) TestQueryAllStreamsForReceiver_Success() {
 				Receiver: s.addrs[0].String(),
 				Sender:   s.addrs[1].String(),
 				Stream: &types.Stream{
+					StreamId:        1,
+					Receiver:        s.addrs[0].String(),
+					Sender:          s.addrs[1].String(),
 					Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
 					FlowRate:        1,
 					LastOutflowTime: nowTime,
+					StartTime:       nowTime,
 					DepositZeroTime: time.Unix(nowTime.Unix()+1000, 0).UTC(),
 					Cancellable:     true,
 				},
@@ -345,9 +503,13 @@ func (s *KeeperTestSuite) TestQueryAllStreamsForReceiver_Success() {
 				Receiver: s.addrs[0].String(),
 				Sender:   s.addrs[2].String(),
 				Stream: &types.Stream{
+					StreamId:        2,
+					Receiver:        s.addrs[0].String(),
+					Sender:          s.addrs[2].String(),
 					Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
 					FlowRate:        1,
 					LastOutflowTime: nowTime,
+					StartTime:       nowTime,
 					DepositZeroTime: time.Unix(nowTime.Unix()+1000, 0).UTC(),
 					Cancellable:     true,
 				},
@@ -356,9 +518,13 @@ func (s *KeeperTestSuite) TestQueryAllStreamsForReceiver_Success() {
 				Receiver: s.addrs[0].String(),
 				Sender:   s.addrs[3].String(),
 				Stream: &types.Stream{
+					StreamId:        3,
+					Receiver:        s.addrs[0].String(),
+					Sender:          s.addrs[3].String(),
 					Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
 					FlowRate:        1,
 					LastOutflowTime: nowTime,
+					StartTime:       nowTime,
 					DepositZeroTime: time.Unix(nowTime.Unix()+1000, 0).UTC(),
 					Cancellable:     true,
 				},
@@ -430,22 +596,20 @@ func (s *KeeperTestSuite) TestQueryAllStreamsForSender_Success() {
 	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
 	qSender := s.addrs[0]
 	// first three for results, sender = s.addrs[0]
-	_, _ = s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], qSender, deposit, 1)
-	_, _ = s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[2], qSender, deposit, 1)
-	_, _ = s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[3], qSender, deposit, 1)
-
-	_, _ = s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[3], deposit, 1)
-	_, _ = s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[2], s.addrs[4], deposit, 1)
-	_, _ = s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[3], s.addrs[5], deposit, 1)
-	_, _ = s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[4], s.addrs[6], deposit, 1)
-
-	_, _ = s.app.StreamKeeper.AddDeposit(tCtx, s.addrs[1], qSender, deposit)
-	_, _ = s.app.StreamKeeper.AddDeposit(tCtx, s.addrs[2], qSender, deposit)
-	_, _ = s.app.StreamKeeper.AddDeposit(tCtx, s.addrs[3], qSender, deposit)
-	_, _ = s.app.StreamKeeper.AddDeposit(tCtx, s.addrs[1], s.addrs[3], deposit)
-	_, _ = s.app.StreamKeeper.AddDeposit(tCtx, s.addrs[2], s.addrs[4], deposit)
-	_, _ = s.app.StreamKeeper.AddDeposit(tCtx, s.addrs[3], s.addrs[5], deposit)
-	_, _ = s.app.StreamKeeper.AddDeposit(tCtx, s.addrs[4], s.addrs[6], deposit)
+	toCreate := [][2]sdk.AccAddress{
+		{s.addrs[1], qSender},
+		{s.addrs[2], qSender},
+		{s.addrs[3], qSender},
+		{s.addrs[1], s.addrs[3]},
+		{s.addrs[2], s.addrs[4]},
+		{s.addrs[3], s.addrs[5]},
+		{s.addrs[4], s.addrs[6]},
+	}
+
+	for _, pair := range toCreate {
+		stream, _ := s.app.StreamKeeper.CreateNewStream(tCtx, pair[0], pair[1], deposit, 1)
+		_, _ = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	}
 
 	expResp := &types.QueryAllStreamsForSenderResponse{
 		Streams: []*types.StreamResult{
@@ -453,9 +617,13 @@ func (s *KeeperTestSuite) TestQueryAllStreamsForSender_Success() {
 				Receiver: s.addrs[1].String(),
 				Sender:   qSender.String(),
 				Stream: &types.Stream{
+					StreamId:        1,
+					Receiver:        s.addrs[1].String(),
+					Sender:          qSender.String(),
 					Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
 					FlowRate:        1,
 					LastOutflowTime: nowTime,
+					StartTime:       nowTime,
 					DepositZeroTime: time.Unix(nowTime.Unix()+1000, 0).UTC(),
 					Cancellable:     true,
 				},
@@ -464,9 +632,13 @@ func (s *KeeperTestSuite) TestQueryAllStreamsForSender_Success() {
 				Receiver: s.addrs[2].String(),
 				Sender:   qSender.String(),
 				Stream: &types.Stream{
+					StreamId:        2,
+					Receiver:        s.addrs[2].String(),
+					Sender:          qSender.String(),
 					Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
 					FlowRate:        1,
 					LastOutflowTime: nowTime,
+					StartTime:       nowTime,
 					DepositZeroTime: time.Unix(nowTime.Unix()+1000, 0).UTC(),
 					Cancellable:     true,
 				},
@@ -475,9 +647,13 @@ func (s *KeeperTestSuite) TestQueryAllStreamsForSender_Success() {
 				Receiver: s.addrs[3].String(),
 				Sender:   qSender.String(),
 				Stream: &types.Stream{
+					StreamId:        3,
+					Receiver:        s.addrs[3].String(),
+					Sender:          qSender.String(),
 					Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
 					FlowRate:        1,
 					LastOutflowTime: nowTime,
+					StartTime:       nowTime,
 					DepositZeroTime: time.Unix(nowTime.Unix()+1000, 0).UTC(),
 					Cancellable:     true,
 				},
@@ -536,3 +712,201 @@ func (s *KeeperTestSuite) TestQueryAllStreamsForSender_Fail_Bad_Or_Empty_Receive
 	s.Require().ErrorContains(err2, "empty address string is not allowed")
 	s.Require().Nil(resp2)
 }
+
+func (s *KeeperTestSuite) TestQueryStreams_MultipleDenoms() {
+	newAccs := simapphelpers.AddTestAddrsWithExtraNonBondCoin(s.app, s.ctx, 3, mathmod.NewIntFromUint64(10000000), sdk.NewInt64Coin("testdenom", 1000000))
+	sender := newAccs[0]
+
+	tCtx := s.ctx
+	nowTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx = tCtx.WithBlockTime(nowTime)
+
+	// receiver 1 has a stream in each denom, receiver 2 only in testdenom
+	toCreate := []struct {
+		receiver sdk.AccAddress
+		deposit  sdk.Coin
+	}{
+		{newAccs[1], sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)},
+		{newAccs[1], sdk.NewInt64Coin("testdenom", 2000)},
+		{newAccs[2], sdk.NewInt64Coin("testdenom", 3000)},
+	}
+
+	for _, tc := range toCreate {
+		stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, tc.receiver, sender, tc.deposit, 1)
+		s.Require().NoError(err)
+		_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, tc.deposit)
+		s.Require().NoError(err)
+	}
+
+	// by receiver/sender pair
+	_, err := s.app.StreamKeeper.StreamByReceiverSender(tCtx, &types.QueryStreamByReceiverSenderRequest{
+		ReceiverAddr: newAccs[1].String(),
+		SenderAddr:   sender.String(),
+	})
+	s.Require().ErrorContains(err, "denom or stream id required")
+
+	res, err := s.app.StreamKeeper.StreamByReceiverSender(tCtx, &types.QueryStreamByReceiverSenderRequest{
+		ReceiverAddr: newAccs[1].String(),
+		SenderAddr:   sender.String(),
+		Denom:        "testdenom",
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("testdenom", 2000), res.Stream.Stream.Deposit)
+
+	res, err = s.app.StreamKeeper.StreamByReceiverSender(tCtx, &types.QueryStreamByReceiverSenderRequest{
+		ReceiverAddr: newAccs[2].String(),
+		SenderAddr:   sender.String(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("testdenom", 3000), res.Stream.Stream.Deposit)
+
+	flowRes, err := s.app.StreamKeeper.StreamReceiverSenderCurrentFlow(tCtx, &types.QueryStreamReceiverSenderCurrentFlowRequest{
+		ReceiverAddr: newAccs[1].String(),
+		SenderAddr:   sender.String(),
+		Denom:        sdk.DefaultBondDenom,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), flowRes.CurrentFlowRate)
+
+	// all streams, and denom filters
+	allRes, err := s.app.StreamKeeper.Streams(tCtx, &types.QueryStreamsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(allRes.Streams, 3)
+
+	senderRes, err := s.app.StreamKeeper.AllStreamsForSender(tCtx, &types.QueryAllStreamsForSenderRequest{SenderAddr: sender.String()})
+	s.Require().NoError(err)
+	s.Require().Len(senderRes.Streams, 3)
+
+	senderRes, err = s.app.StreamKeeper.AllStreamsForSender(tCtx, &types.QueryAllStreamsForSenderRequest{SenderAddr: sender.String(), Denom: "testdenom"})
+	s.Require().NoError(err)
+	s.Require().Len(senderRes.Streams, 2)
+
+	receiverRes, err := s.app.StreamKeeper.AllStreamsForReceiver(tCtx, &types.QueryAllStreamsForReceiverRequest{ReceiverAddr: newAccs[1].String()})
+	s.Require().NoError(err)
+	s.Require().Len(receiverRes.Streams, 2)
+
+	receiverRes, err = s.app.StreamKeeper.AllStreamsForReceiver(tCtx, &types.QueryAllStreamsForReceiverRequest{ReceiverAddr: newAccs[1].String(), Denom: sdk.DefaultBondDenom})
+	s.Require().NoError(err)
+	s.Require().Len(receiverRes.Streams, 1)
+	s.Require().Equal(sender.String(), receiverRes.Streams[0].Sender)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), receiverRes.Streams[0].Stream.Deposit)
+}
+
+func (s *KeeperTestSuite) TestQueryStreamStatsAndHistory() {
+	tCtx := s.ctx
+	nowTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx = tCtx.WithBlockTime(nowTime)
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+
+	// nil request
+	_, err = s.app.StreamKeeper.StreamStats(tCtx, nil)
+	s.Require().Error(err)
+	_, err = s.app.StreamKeeper.StreamHistory(tCtx, nil)
+	s.Require().Error(err)
+
+	// stream does not exist
+	_, err = s.app.StreamKeeper.StreamStats(tCtx, &types.QueryStreamStatsRequest{StreamId: 99})
+	s.Require().ErrorContains(err, "stream not found. stream id 99")
+	_, err = s.app.StreamKeeper.StreamHistory(tCtx, &types.QueryStreamHistoryRequest{StreamId: 99})
+	s.Require().ErrorContains(err, "stream not found. stream id 99")
+
+	// no claims or flow rate changes yet
+	statsRes, err := s.app.StreamKeeper.StreamStats(tCtx, &types.QueryStreamStatsRequest{StreamId: stream.StreamId})
+	s.Require().NoError(err)
+	s.Require().Equal(types.NewStreamStats(stream.StreamId, sdk.DefaultBondDenom), statsRes.Stats)
+	historyRes, err := s.app.StreamKeeper.StreamHistory(tCtx, &types.QueryStreamHistoryRequest{StreamId: stream.StreamId})
+	s.Require().NoError(err)
+	s.Require().Empty(historyRes.History.FlowRateChanges)
+
+	claimCtx := tCtx.WithBlockTime(nowTime.Add(time.Second * 100))
+	_, valFee, claimTotal, _, err := s.app.StreamKeeper.ClaimFromStream(claimCtx, stream.StreamId)
+	s.Require().NoError(err)
+	err = s.app.StreamKeeper.SetNewFlowRate(claimCtx, stream.StreamId, 2)
+	s.Require().NoError(err)
+
+	statsRes, err = s.app.StreamKeeper.StreamStats(tCtx, &types.QueryStreamStatsRequest{StreamId: stream.StreamId})
+	s.Require().NoError(err)
+	s.Require().Equal(claimTotal, statsRes.Stats.TotalClaimed)
+	s.Require().Equal(valFee, statsRes.Stats.TotalValidatorFees)
+	s.Require().Equal(uint64(1), statsRes.Stats.NumClaims)
+	s.Require().Equal(nowTime.Add(time.Second*100), statsRes.Stats.LastClaimTime)
+
+	historyRes, err = s.app.StreamKeeper.StreamHistory(tCtx, &types.QueryStreamHistoryRequest{StreamId: stream.StreamId})
+	s.Require().NoError(err)
+	s.Require().Len(historyRes.History.FlowRateChanges, 1)
+	s.Require().Equal(int64(1), historyRes.History.FlowRateChanges[0].OldFlowRate)
+	s.Require().Equal(int64(2), historyRes.History.FlowRateChanges[0].NewFlowRate)
+}
+
+func (s *KeeperTestSuite) TestQueryStreamsExpiringBefore() {
+	nowTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(nowTime)
+
+	// streams expire after 1000, 2000, 3000, 4000 and 5000 seconds
+	for i := int64(1); i <= 5; i++ {
+		deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000*i)
+		stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[i], s.addrs[0], deposit, 1)
+		s.Require().NoError(err)
+		_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+		s.Require().NoError(err)
+	}
+
+	_, err := s.app.StreamKeeper.StreamsExpiringBefore(tCtx, nil)
+	s.Require().Error(err)
+
+	res, err := s.app.StreamKeeper.StreamsExpiringBefore(tCtx, &types.QueryStreamsExpiringBeforeRequest{Before: nowTime.Add(time.Second * 3500)})
+	s.Require().NoError(err)
+	s.Require().Len(res.Streams, 3)
+	for i, streamRes := range res.Streams {
+		s.Require().Equal(uint64(i+1), streamRes.Stream.StreamId)
+		s.Require().Equal(s.addrs[i+1].String(), streamRes.Receiver)
+	}
+	s.Require().Nil(res.Pagination.NextKey)
+
+	// streams expiring exactly at the time are not included
+	res, err = s.app.StreamKeeper.StreamsExpiringBefore(tCtx, &types.QueryStreamsExpiringBeforeRequest{Before: nowTime.Add(time.Second * 1000)})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Streams)
+
+	// paginated by key
+	res, err = s.app.StreamKeeper.StreamsExpiringBefore(tCtx, &types.QueryStreamsExpiringBeforeRequest{
+		Before:     nowTime.Add(time.Second * 4500),
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Streams, 3)
+	s.Require().Equal(uint64(4), res.Pagination.Total)
+	s.Require().NotNil(res.Pagination.NextKey)
+
+	res, err = s.app.StreamKeeper.StreamsExpiringBefore(tCtx, &types.QueryStreamsExpiringBeforeRequest{
+		Before:     nowTime.Add(time.Second * 4500),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Streams, 1)
+	s.Require().Equal(uint64(4), res.Streams[0].Stream.StreamId)
+	s.Require().Nil(res.Pagination.NextKey)
+
+	// paginated by offset, in reverse
+	res, err = s.app.StreamKeeper.StreamsExpiringBefore(tCtx, &types.QueryStreamsExpiringBeforeRequest{
+		Before:     nowTime.Add(time.Second * 4500),
+		Pagination: &query.PageRequest{Offset: 1, Limit: 2, Reverse: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Streams, 2)
+	s.Require().Equal(uint64(3), res.Streams[0].Stream.StreamId)
+	s.Require().Equal(uint64(2), res.Streams[1].Stream.StreamId)
+
+	// a key after the requested time returns nothing
+	res, err = s.app.StreamKeeper.StreamsExpiringBefore(tCtx, &types.QueryStreamsExpiringBeforeRequest{
+		Before:     nowTime.Add(time.Second * 1500),
+		Pagination: &query.PageRequest{Key: types.GetStreamExpiryQueueKey(nowTime.Add(time.Second*5000), 5)[1:]},
+	})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Streams)
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+// InsertStreamExpiryQueue inserts a stream into the expiry queue at its deposit zero time
+func (k Keeper) InsertStreamExpiryQueue(ctx sdk.Context, streamID uint64, depositZeroTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetStreamExpiryQueueKey(depositZeroTime, streamID), []byte{})
+}
+
+// RemoveFromStreamExpiryQueue removes a stream from the expiry queue
+func (k Keeper) RemoveFromStreamExpiryQueue(ctx sdk.Context, streamID uint64, depositZeroTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetStreamExpiryQueueKey(depositZeroTime, streamID))
+}
+
+// IsInStreamExpiryQueue checks if a stream is in the expiry queue at the given deposit zero time
+func (k Keeper) IsInStreamExpiryQueue(ctx sdk.Context, streamID uint64, depositZeroTime time.Time) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetStreamExpiryQueueKey(depositZeroTime, streamID))
+}
+
+// StreamExpiryQueueIterator returns an iterator over all streams in the expiry queue whose
+// deposit zero time is <= endTime
+func (k Keeper) StreamExpiryQueueIterator(ctx sdk.Context, endTime time.Time) storetypes.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.StreamExpiryQueuePrefix, storetypes.PrefixEndBytes(types.GetStreamExpiryQueueByTimeKey(endTime)))
+}
+
+// IterateStreamExpiryQueue iterates over the streams in the expiry queue whose deposit zero time
+// is <= endTime, in order of deposit zero time, and performs a callback function
+func (k Keeper) IterateStreamExpiryQueue(ctx sdk.Context, endTime time.Time, cb func(streamID uint64, depositZeroTime time.Time) (stop bool)) {
+	iterator := k.StreamExpiryQueueIterator(ctx, endTime)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		depositZeroTime, streamID := types.SplitStreamExpiryQueueKey(iterator.Key())
+
+		if cb(streamID, depositZeroTime) {
+			break
+		}
+	}
+}
+
+// InsertStreamLowDepositQueue inserts a stream into the low deposit queue at its alert time
+func (k Keeper) InsertStreamLowDepositQueue(ctx sdk.Context, streamID uint64, alertTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetStreamLowDepositQueueKey(alertTime, streamID), []byte{})
+}
+
+// RemoveFromStreamLowDepositQueue removes a stream from the low deposit queue
+func (k Keeper) RemoveFromStreamLowDepositQueue(ctx sdk.Context, streamID uint64, alertTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetStreamLowDepositQueueKey(alertTime, streamID))
+}
+
+// IsInStreamLowDepositQueue checks if a stream is in the low deposit queue at the given alert time
+func (k Keeper) IsInStreamLowDepositQueue(ctx sdk.Context, streamID uint64, alertTime time.Time) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetStreamLowDepositQueueKey(alertTime, streamID))
+}
+
+// IterateStreamLowDepositQueue iterates over the streams in the low deposit queue whose alert time
+// is <= endTime, in order of alert time, and performs a callback function
+func (k Keeper) IterateStreamLowDepositQueue(ctx sdk.Context, endTime time.Time, cb func(streamID uint64, alertTime time.Time) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.StreamLowDepositQueuePrefix, storetypes.PrefixEndBytes(types.GetStreamLowDepositQueueByTimeKey(endTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		alertTime, streamID := types.SplitStreamLowDepositQueueKey(iterator.Key())
+
+		if cb(streamID, alertTime) {
+			break
+		}
+	}
+}
+
+// InsertStreamAuditPruneQueue inserts a deleted stream into the audit prune queue at its prune time
+func (k Keeper) InsertStreamAuditPruneQueue(ctx sdk.Context, streamID uint64, pruneTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetStreamAuditPruneQueueKey(pruneTime, streamID), []byte{})
+}
+
+// RemoveFromStreamAuditPruneQueue removes a deleted stream from the audit prune queue
+func (k Keeper) RemoveFromStreamAuditPruneQueue(ctx sdk.Context, streamID uint64, pruneTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetStreamAuditPruneQueueKey(pruneTime, streamID))
+}
+
+// IterateStreamAuditPruneQueue iterates over the deleted streams in the audit prune queue whose prune time
+// is <= endTime, in order of prune time, and performs a callback function
+func (k Keeper) IterateStreamAuditPruneQueue(ctx sdk.Context, endTime time.Time, cb func(streamID uint64, pruneTime time.Time) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.StreamAuditPruneQueuePrefix, storetypes.PrefixEndBytes(types.GetStreamAuditPruneQueueByTimeKey(endTime)))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pruneTime, streamID := types.SplitStreamAuditPruneQueueKey(iterator.Key())
+
+		if cb(streamID, pruneTime) {
+			break
+		}
+	}
+}
+
+// IterateAllStreamAuditPruneQueue iterates over every deleted stream in the audit prune queue, in order of
+// prune time, and performs a callback function
+func (k Keeper) IterateAllStreamAuditPruneQueue(ctx sdk.Context, cb func(streamID uint64, pruneTime time.Time) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StreamAuditPruneQueuePrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		pruneTime, streamID := types.SplitStreamAuditPruneQueueKey(iterator.Key())
+
+		if cb(streamID, pruneTime) {
+			break
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"bytes"
+	"sort"
 	"strconv"
 	"time"
 
@@ -12,31 +14,101 @@ import (
 	"github.com/unification-com/mainchain/x/stream/types"
 )
 
-// GetTotalDeposits gets the total deposits - just a wrapper for getting the module account's balances from the bank
+// GetTotalDeposits gets the total deposits across all stream denoms - just a wrapper for getting the module
+// account's balances from the bank
 func (k Keeper) GetTotalDeposits(ctx sdk.Context) sdk.Coins {
 	moduleAcc := k.GetStreamModuleAccount(ctx)
 	totalDeposits := k.bankKeeper.GetAllBalances(ctx, moduleAcc.GetAddress())
 	return totalDeposits
 }
 
-// SetStream Sets the stream
-func (k Keeper) SetStream(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddress, stream types.Stream) error {
+//__STREAM_ID__________________________________________________________
+
+// GetHighestStreamID gets the highest stream ID
+func (k Keeper) GetHighestStreamID(ctx sdk.Context) (streamID uint64, err error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.HighestStreamIDKey)
+	if bz == nil {
+		return 0, errorsmod.Wrap(types.ErrInvalidGenesis, "initial stream ID hasn't been set")
+	}
+	// convert from bytes to uint64
+	streamID = types.GetStreamIDFromBytes(bz)
+	return streamID, nil
+}
+
+// SetHighestStreamID sets the new highest stream ID to the store
+func (k Keeper) SetHighestStreamID(ctx sdk.Context, streamID uint64) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.GetStreamKey(receiverAddr, senderAddr), k.cdc.MustMarshal(&stream))
+	// convert from uint64 to bytes for storage
+	store.Set(types.HighestStreamIDKey, types.GetStreamIDBytes(streamID))
+}
+
+//__STREAMS_____________________________________________________________
+
+// SetStream Sets the stream, keyed by its stream ID, and keeps the receiver/sender index and the
+// stream's position in the expiry and low deposit queues up to date, including when the stream's receiver changes
+func (k Keeper) SetStream(ctx sdk.Context, stream types.Stream) error {
+	if stream.StreamId == 0 {
+		return errorsmod.Wrap(types.ErrMissingData, "stream id required")
+	}
+
+	receiverAddr, err := sdk.AccAddressFromBech32(stream.Receiver)
+	if err != nil {
+		return err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(stream.Sender)
+	if err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	if oldStream, ok := k.GetStream(ctx, stream.StreamId); ok {
+		if expiryTime, queued := oldStream.ExpiryQueueTime(); queued {
+			k.RemoveFromStreamExpiryQueue(ctx, stream.StreamId, expiryTime)
+		}
+		if alertTime, queued := oldStream.LowDepositAlertTime(); queued {
+			k.RemoveFromStreamLowDepositQueue(ctx, stream.StreamId, alertTime)
+		}
+
+		// stream has been transferred to a new receiver
+		if oldStream.Receiver != stream.Receiver || oldStream.Sender != stream.Sender {
+			store.Delete(types.GetStreamByReceiverSenderIndexKey(sdk.MustAccAddressFromBech32(oldStream.Receiver), sdk.MustAccAddressFromBech32(oldStream.Sender), stream.StreamId))
+		}
+	}
+
+	// a stream that has been topped up, or had its flow rate reduced, so that its remaining deposit is back
+	// above its low deposit threshold can be alerted again
+	if stream.LowDepositAlerted {
+		stream.LowDepositAlerted = false
+		if alertTime, queued := stream.LowDepositAlertTime(); !queued || !alertTime.After(ctx.BlockTime()) {
+			stream.LowDepositAlerted = true
+		}
+	}
+
+	store.Set(types.GetStreamKey(stream.StreamId), k.cdc.MustMarshal(&stream))
+	store.Set(types.GetStreamByReceiverSenderIndexKey(receiverAddr, senderAddr, stream.StreamId), []byte{})
+	if expiryTime, queued := stream.ExpiryQueueTime(); queued {
+		k.InsertStreamExpiryQueue(ctx, stream.StreamId, expiryTime)
+	}
+	if alertTime, queued := stream.LowDepositAlertTime(); queued {
+		k.InsertStreamLowDepositQueue(ctx, stream.StreamId, alertTime)
+	}
 
 	return nil
 }
 
 // IsStream Checks if the stream is present in the store or not
-func (k Keeper) IsStream(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddress) bool {
+func (k Keeper) IsStream(ctx sdk.Context, streamID uint64) bool {
 	store := ctx.KVStore(k.storeKey)
-	return store.Has(types.GetStreamKey(receiverAddr, senderAddr))
+	return store.Has(types.GetStreamKey(streamID))
 }
 
 // GetStream Gets the stream data
-func (k Keeper) GetStream(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddress) (types.Stream, bool) {
+func (k Keeper) GetStream(ctx sdk.Context, streamID uint64) (types.Stream, bool) {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetStreamKey(receiverAddr, senderAddr))
+	bz := store.Get(types.GetStreamKey(streamID))
 	if bz == nil {
 		// return a new empty stream struct
 		return types.Stream{}, false
@@ -46,26 +118,78 @@ func (k Keeper) GetStream(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddre
 	return stream, true
 }
 
-func (k Keeper) DeleteStream(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddress) {
-	if !k.IsStream(ctx, receiverAddr, senderAddr) {
+// DeleteStream deletes the stream and its receiver/sender index entry, and removes it from the expiry and low
+// deposit queues. Its claim statistics and flow rate history are kept for auditing, and pruned after
+// types.DeletedStreamAuditRetention
+func (k Keeper) DeleteStream(ctx sdk.Context, streamID uint64) {
+	stream, ok := k.GetStream(ctx, streamID)
+	if !ok {
 		return
 	}
 	store := ctx.KVStore(k.storeKey)
-	store.Delete(types.GetStreamKey(receiverAddr, senderAddr))
+	store.Delete(types.GetStreamKey(streamID))
+	store.Delete(types.GetStreamByReceiverSenderIndexKey(sdk.MustAccAddressFromBech32(stream.Receiver), sdk.MustAccAddressFromBech32(stream.Sender), streamID))
+	if expiryTime, queued := stream.ExpiryQueueTime(); queued {
+		k.RemoveFromStreamExpiryQueue(ctx, streamID, expiryTime)
+	}
+	if alertTime, queued := stream.LowDepositAlertTime(); queued {
+		k.RemoveFromStreamLowDepositQueue(ctx, streamID, alertTime)
+	}
+	k.retainStreamStatsAndHistory(ctx, streamID)
+}
+
+// GetStreamsForReceiverSender returns all of a receiver/sender pair's streams, in stream ID order
+func (k Keeper) GetStreamsForReceiverSender(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddress) []types.Stream {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetStreamsByReceiverSenderKey(receiverAddr, senderAddr))
+	defer iterator.Close()
+
+	var streams []types.Stream
+	for ; iterator.Valid(); iterator.Next() {
+		_, _, streamID := types.SplitStreamByReceiverSenderIndexKey(iterator.Key())
+		stream, ok := k.GetStream(ctx, streamID)
+		if ok {
+			streams = append(streams, stream)
+		}
+	}
+
+	return streams
+}
+
+// ResolveStreamID returns the ID of a receiver/sender pair's stream, optionally filtered by denom.
+// An error is returned if no stream matches, or if more than one stream matches, in which case the
+// stream ID must be used instead.
+func (k Keeper) ResolveStreamID(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddress, denom string) (uint64, error) {
+	var matches []uint64
+	for _, stream := range k.GetStreamsForReceiverSender(ctx, receiverAddr, senderAddr) {
+		if denom == "" || stream.Deposit.Denom == denom {
+			matches = append(matches, stream.StreamId)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		if denom != "" {
+			return 0, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream not found. sender: %s, receiver %s, denom %s", senderAddr.String(), receiverAddr.String(), denom)
+		}
+		return 0, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream not found. sender: %s, receiver %s", senderAddr.String(), receiverAddr.String())
+	case 1:
+		return matches[0], nil
+	default:
+		return 0, errorsmod.Wrapf(types.ErrMissingData, "sender %s has %d matching streams to receiver %s. denom or stream id required", senderAddr.String(), len(matches), receiverAddr.String())
+	}
 }
 
 // IterateAllStreams iterates over all the Streams of all accounts
 // that are provided to a callback. If true is returned from the
 // callback, iteration is halted. Potentially expensive, and only intended
 // for use during genesis export etc.
-func (k Keeper) IterateAllStreams(ctx sdk.Context, cb func(sdk.AccAddress, sdk.AccAddress, types.Stream) bool) {
+func (k Keeper) IterateAllStreams(ctx sdk.Context, cb func(types.Stream) bool) {
 	store := ctx.KVStore(k.storeKey)
 	iterator := storetypes.KVStorePrefixIterator(store, types.StreamKeyPrefix)
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		receiverAddr, senderAddr := types.AddressesFromStreamKey(iterator.Key())
-
 		var stream types.Stream
 		err := k.cdc.Unmarshal(iterator.Value(), &stream)
 
@@ -73,28 +197,45 @@ func (k Keeper) IterateAllStreams(ctx sdk.Context, cb func(sdk.AccAddress, sdk.A
 			panic(err)
 		}
 
-		if cb(receiverAddr, senderAddr, stream) {
+		if cb(stream) {
 			break
 		}
 	}
 }
 
-func (k Keeper) ClaimFromStream(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddress) (sdk.Coin, sdk.Coin, sdk.Coin, sdk.Coin, error) {
-	stream, ok := k.GetStream(ctx, receiverAddr, senderAddr)
+func (k Keeper) ClaimFromStream(ctx sdk.Context, streamID uint64) (sdk.Coin, sdk.Coin, sdk.Coin, sdk.Coin, error) {
+	stream, ok := k.GetStream(ctx, streamID)
 	params := k.GetParams(ctx)
 
 	if !ok {
 		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, errorsmod.Wrap(types.ErrInvalidData, "stream does not exist")
 	}
 
+	receiverAddr, err := sdk.AccAddressFromBech32(stream.Receiver)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
 	// 1. check current stream deposit > 0
 	if stream.Deposit.IsNil() || stream.Deposit.IsNegative() || stream.Deposit.IsZero() {
 		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, errorsmod.Wrap(types.ErrInvalidData, "stream deposit is zero")
 	}
 
-	// 2. calculate amount to claim
 	nowTime := ctx.BlockTime()
-	claimTotal, remainingDeposit := types.CalculateAmountToClaim(nowTime, stream.DepositZeroTime, stream.LastOutflowTime, stream.Deposit, stream.FlowRate)
+
+	// 1.1 check the stream has started, and any cliff has been reached
+	if nowTime.Before(stream.ClaimableFrom()) {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidData, "nothing can be claimed from stream until %s", stream.ClaimableFrom().String())
+	}
+
+	// 2. calculate amount to claim. Nothing accrues while the stream is paused. The cliff has already been
+	// checked above, and payments accrued before a pause are claimable once the cliff is reached
+	accruedUntil := stream.AccruedUntil(nowTime)
+	cliffTime := stream.CliffTime
+	if stream.IsPaused() {
+		cliffTime = time.Time{}
+	}
+	claimTotal, remainingDeposit := types.CalculateAmountToClaim(accruedUntil, stream.DepositZeroTime, stream.LastOutflowTime, cliffTime, stream.EndTime, stream.Deposit, stream.FlowRate)
 
 	// 3.1 sanity check 1: claimTotal is not negative or nil
 	if claimTotal.IsNil() || claimTotal.IsNegative() {
@@ -109,12 +250,10 @@ func (k Keeper) ClaimFromStream(ctx sdk.Context, receiverAddr, senderAddr sdk.Ac
 	// 4. calculate validator fee and deduct from claim amount
 	receiverAmount, valFee := types.CalculateValidatorFee(params.ValidatorFee, claimTotal)
 
-	if valFee.Amount.GT(mathmod.NewIntFromUint64(0)) {
-		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, sdk.NewCoins(valFee))
+	feeCollectorAmount, communityPoolAmount, burnedAmount := params.SplitValidatorFee(valFee)
 
-		if err != nil {
-			return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
-		}
+	if err := k.routeValidatorFee(ctx, feeCollectorAmount, communityPoolAmount, burnedAmount); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
 	}
 
 	// 5. send modified amount from module account to receiver
@@ -128,21 +267,27 @@ func (k Keeper) ClaimFromStream(ctx sdk.Context, receiverAddr, senderAddr sdk.Ac
 
 	// 6. update & save stream
 	stream.Deposit = remainingDeposit
-	stream.LastOutflowTime = nowTime
-	err := k.SetStream(ctx, receiverAddr, senderAddr, stream)
+	stream.LastOutflowTime = accruedUntil
+	err = k.SetStream(ctx, stream)
 
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	k.recordClaim(ctx, streamID, claimTotal, valFee)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeClaimStreamAction,
-			sdk.NewAttribute(types.AttributeKeyStreamSender, senderAddr.String()),
-			sdk.NewAttribute(types.AttributeKeyStreamReceiver, receiverAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyStreamId, strconv.FormatUint(streamID, 10)),
+			sdk.NewAttribute(types.AttributeKeyStreamSender, stream.Sender),
+			sdk.NewAttribute(types.AttributeKeyStreamReceiver, stream.Receiver),
 			sdk.NewAttribute(types.AttributeKeyClaimTotal, claimTotal.String()),
 			sdk.NewAttribute(types.AttributeKeyClaimAmountReceived, receiverAmount.String()),
 			sdk.NewAttribute(types.AttributeKeyClaimValidatorFee, valFee.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimFeeCollector, feeCollectorAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimCommunityPool, communityPoolAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimBurned, burnedAmount.String()),
 			sdk.NewAttribute(types.AttributeKeyRemainingDeposit, remainingDeposit.String()),
 		),
 	)
@@ -150,48 +295,241 @@ func (k Keeper) ClaimFromStream(ctx sdk.Context, receiverAddr, senderAddr sdk.Ac
 	return receiverAmount, valFee, claimTotal, remainingDeposit, nil
 }
 
-func (k Keeper) AddDeposit(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddress, topUpDeposit sdk.Coin) (bool, error) {
+// routeValidatorFee sends each part of a claim's validator fee to the fee collector and community pool, and
+// burns the rest. The parts come from Params.SplitValidatorFee, which only burns the native denomination
+func (k Keeper) routeValidatorFee(ctx sdk.Context, feeCollectorAmount, communityPoolAmount, burnedAmount sdk.Coin) error {
+	if feeCollectorAmount.IsPositive() {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, sdk.NewCoins(feeCollectorAmount))
+		if err != nil {
+			return err
+		}
+	}
+
+	if communityPoolAmount.IsPositive() {
+		err := k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(communityPoolAmount), k.accKeeper.GetModuleAddress(types.ModuleName))
+		if err != nil {
+			return err
+		}
+	}
+
+	if burnedAmount.IsPositive() {
+		err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(burnedAmount))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ClaimFromReceiverStreams claims from up to limit of a receiver's streams, walking the receiver/sender index in key
+// order and optionally only visiting the given senders' streams. startKey is a nextKey returned by a previous
+// call, and the returned nextKey is nil once there are no more streams to visit. Streams with no deposit, or
+// which have not reached their start or cliff time, are skipped but still count towards the limit.
+func (k Keeper) ClaimFromReceiverStreams(ctx sdk.Context, receiverAddr sdk.AccAddress, senderAddrs []sdk.AccAddress, startKey []byte, limit uint64) ([]types.StreamClaimResult, []byte, error) {
+	receiverPrefix := types.GetStreamsByReceiverKey(receiverAddr)
+
+	if len(startKey) > 0 && !bytes.HasPrefix(startKey, receiverPrefix) {
+		return nil, nil, errorsmod.Wrap(types.ErrInvalidData, "invalid start key for receiver")
+	}
+
+	// index prefixes to walk, in key order
+	prefixes := [][]byte{receiverPrefix}
+	if len(senderAddrs) > 0 {
+		prefixes = make([][]byte, 0, len(senderAddrs))
+		for _, senderAddr := range senderAddrs {
+			prefixes = append(prefixes, types.GetStreamsByReceiverSenderKey(receiverAddr, senderAddr))
+		}
+		sort.Slice(prefixes, func(i, j int) bool {
+			return bytes.Compare(prefixes[i], prefixes[j]) < 0
+		})
+
+		// a sender listed more than once would have its streams claimed, and counted towards the limit, twice
+		unique := prefixes[:1]
+		for _, pfx := range prefixes[1:] {
+			if !bytes.Equal(pfx, unique[len(unique)-1]) {
+				unique = append(unique, pfx)
+			}
+		}
+		prefixes = unique
+	}
+
+	// collect the stream IDs first, since claiming writes to the index being iterated
+	store := ctx.KVStore(k.storeKey)
+	var streamIDs []uint64
+	var nextKey []byte
+
+	for _, pfx := range prefixes {
+		start := pfx
+		if bytes.Compare(startKey, start) > 0 {
+			start = startKey
+		}
+		end := storetypes.PrefixEndBytes(pfx)
+		if bytes.Compare(start, end) >= 0 {
+			continue
+		}
+
+		iterator := store.Iterator(start, end)
+		for ; iterator.Valid(); iterator.Next() {
+			if uint64(len(streamIDs)) >= limit {
+				nextKey = bytes.Clone(iterator.Key())
+				break
+			}
+			_, _, streamID := types.SplitStreamByReceiverSenderIndexKey(iterator.Key())
+			streamIDs = append(streamIDs, streamID)
+		}
+		iterator.Close()
+
+		if nextKey != nil {
+			break
+		}
+	}
+
+	nowTime := ctx.BlockTime()
+	params := k.GetParams(ctx)
+	results := make([]types.StreamClaimResult, 0, len(streamIDs))
+
+	for _, streamID := range streamIDs {
+		stream, ok := k.GetStream(ctx, streamID)
+		if !ok || stream.Deposit.IsNil() || !stream.Deposit.IsPositive() || nowTime.Before(stream.ClaimableFrom()) {
+			continue
+		}
+
+		receiverAmount, valFee, claimTotal, remainingDeposit, err := k.ClaimFromStream(ctx, streamID)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		feeCollectorAmount, communityPoolAmount, burnedAmount := params.SplitValidatorFee(valFee)
+
+		results = append(results, types.StreamClaimResult{
+			StreamId:            streamID,
+			Sender:              stream.Sender,
+			TotalClaimed:        claimTotal,
+			StreamPayment:       receiverAmount,
+			ValidatorFee:        valFee,
+			RemainingDeposit:    remainingDeposit,
+			FeeCollectorAmount:  feeCollectorAmount,
+			CommunityPoolAmount: communityPoolAmount,
+			BurnedAmount:        burnedAmount,
+		})
+	}
+
+	return results, nextKey, nil
+}
+
+// ReassignStreamReceiver claims any payments accrued by a stream's current receiver, then re-keys the
+// stream under the new receiver. The stream's deposit, flow rate and schedule are unchanged.
+func (k Keeper) ReassignStreamReceiver(ctx sdk.Context, streamID uint64, newReceiverAddr sdk.AccAddress) (sdk.Coin, sdk.Coin, sdk.Coin, sdk.Coin, error) {
+	stream, ok := k.GetStream(ctx, streamID)
+	if !ok {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream id %d", streamID)
+	}
+
+	if stream.Receiver == newReceiverAddr.String() {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, errorsmod.Wrap(types.ErrInvalidData, "new receiver is already the stream receiver")
+	}
+
+	if stream.Sender == newReceiverAddr.String() {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, errorsmod.Wrap(types.ErrInvalidData, "sender and receiver cannot be same address")
+	}
+
+	oldReceiver := stream.Receiver
+	receiverAmount := sdk.NewCoin(stream.Deposit.Denom, mathmod.NewInt(0))
+	valFee := sdk.NewCoin(stream.Deposit.Denom, mathmod.NewInt(0))
+	claimTotal := sdk.NewCoin(stream.Deposit.Denom, mathmod.NewInt(0))
+	remainingDeposit := stream.Deposit
+
+	// settle anything accrued so far with the current receiver
+	if stream.Deposit.IsPositive() && !ctx.BlockTime().Before(stream.ClaimableFrom()) {
+		var err error
+		receiverAmount, valFee, claimTotal, remainingDeposit, err = k.ClaimFromStream(ctx, streamID)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+		}
+		stream, _ = k.GetStream(ctx, streamID)
+	}
+
+	stream.Receiver = newReceiverAddr.String()
+	// the new receiver must accept that the stream can be paused
+	stream.PausableAccepted = false
+	if err := k.SetStream(ctx, stream); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStreamTransferred,
+			sdk.NewAttribute(types.AttributeKeyStreamId, strconv.FormatUint(streamID, 10)),
+			sdk.NewAttribute(types.AttributeKeyStreamSender, stream.Sender),
+			sdk.NewAttribute(types.AttributeKeyOldReceiver, oldReceiver),
+			sdk.NewAttribute(types.AttributeKeyNewReceiver, stream.Receiver),
+			sdk.NewAttribute(types.AttributeKeyClaimAmountReceived, receiverAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimValidatorFee, valFee.String()),
+		),
+	)
+
+	return receiverAmount, valFee, claimTotal, remainingDeposit, nil
+}
+
+func (k Keeper) AddDeposit(ctx sdk.Context, streamID uint64, topUpDeposit sdk.Coin) (bool, error) {
 
-	stream, ok := k.GetStream(ctx, receiverAddr, senderAddr)
+	stream, ok := k.GetStream(ctx, streamID)
 
 	if !ok {
-		return false, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "sender: %s, receiver %s", senderAddr.String(), receiverAddr.String())
+		return false, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream id %d", streamID)
 	}
 
-	if topUpDeposit.Denom != stream.Deposit.Denom {
-		return false, errorsmod.Wrapf(types.ErrInvalidData, "top up denom does not match stream denom. stream: %s, top up %s", stream.Deposit.Denom, topUpDeposit.Denom)
+	if stream.Deposit.Denom != topUpDeposit.Denom {
+		return false, errorsmod.Wrapf(types.ErrInvalidData, "deposit denom %s does not match stream denom %s", topUpDeposit.Denom, stream.Deposit.Denom)
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(stream.Sender)
+	if err != nil {
+		return false, err
 	}
 
 	nowTime := ctx.BlockTime()
-	// calculate duration and deposit time to zero extension
-	durationExtension := types.CalculateDuration(topUpDeposit, stream.FlowRate)
-	// should be added to the current deposit zero time if the stream has not expired, or from
-	// "now" if it has.
+
+	if stream.HasEnded(nowTime) {
+		return false, errorsmod.Wrapf(types.ErrInvalidData, "stream ended at %s", stream.EndTime.String())
+	}
+
+	// duration and deposit time to zero extension should be added to the current deposit zero time
+	// if the stream has not expired, or from "now" if it has.
+	var durationExtension int64
 	var depositZeroTime time.Time
 
-	if stream.DepositZeroTime.Before(nowTime) || stream.DepositZeroTime.Equal(nowTime) {
+	// A paused stream's deposit zero time is recalculated when it is resumed, so is always extended.
+	if !stream.IsPaused() && !stream.DepositZeroTime.After(nowTime) {
 		// In the case of expired, ClaimFromStream is called first to "reset" deposit to zero and forward
 		// remaining payment to the receiver wallet, effectively creating a new stream
 		if stream.Deposit.Amount.GT(mathmod.NewIntFromUint64(0)) {
 			// only if stream has deposit
-			_, _, _, _, err := k.ClaimFromStream(ctx, receiverAddr, senderAddr)
+			_, _, _, _, err := k.ClaimFromStream(ctx, streamID)
 			if err != nil {
 				return false, err
 			}
 			// refresh stream data, since deposits and total streamed may have changed
 			// after claim stream call
-			stream, _ = k.GetStream(ctx, receiverAddr, senderAddr)
+			stream, _ = k.GetStream(ctx, streamID)
 		}
 
-		// stream expired or new. Calculate from now
-		depositZeroTime = nowTime.Add(time.Second * time.Duration(durationExtension))
+		// stream expired or new. Calculate from now, or from the start time if the stream is yet to start
+		flowStart := nowTime
+		if stream.LastOutflowTime.After(nowTime) {
+			flowStart = stream.LastOutflowTime
+		}
+		durationExtension = types.CalculateDuration(topUpDeposit, stream.FlowRate, flowStart, stream.EndTime)
+		depositZeroTime = flowStart.Add(time.Second * time.Duration(durationExtension))
 	} else {
 		// stream not expired. Add to current deposit zero time
+		durationExtension = types.CalculateDuration(topUpDeposit, stream.FlowRate, stream.DepositZeroTime, stream.EndTime)
 		depositZeroTime = stream.DepositZeroTime.Add(time.Second * time.Duration(durationExtension))
 	}
 
 	// Send topUpDeposit from user acc to module acc
-	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, types.ModuleName, sdk.NewCoins(topUpDeposit))
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, types.ModuleName, sdk.NewCoins(topUpDeposit))
 
 	if err != nil {
 		return false, err
@@ -203,7 +541,7 @@ func (k Keeper) AddDeposit(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddr
 	stream.Deposit = newDeposit
 	stream.DepositZeroTime = depositZeroTime
 
-	err = k.SetStream(ctx, receiverAddr, senderAddr, stream)
+	err = k.SetStream(ctx, stream)
 
 	if err != nil {
 		return false, err
@@ -212,8 +550,9 @@ func (k Keeper) AddDeposit(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddr
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeDepositToStream,
-			sdk.NewAttribute(types.AttributeKeyStreamSender, senderAddr.String()),
-			sdk.NewAttribute(types.AttributeKeyStreamReceiver, receiverAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyStreamId, strconv.FormatUint(streamID, 10)),
+			sdk.NewAttribute(types.AttributeKeyStreamSender, stream.Sender),
+			sdk.NewAttribute(types.AttributeKeyStreamReceiver, stream.Receiver),
 			sdk.NewAttribute(types.AttributeKeyAmountDeposited, topUpDeposit.String()),
 			sdk.NewAttribute(types.AttributeKeyDepositDuration, strconv.FormatInt(durationExtension, 10)),
 			sdk.NewAttribute(types.AttributeKeyDepositZeroTime, depositZeroTime.String()),
@@ -224,11 +563,11 @@ func (k Keeper) AddDeposit(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddr
 	return true, nil
 }
 
-func (k Keeper) SetNewFlowRate(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddress, newFlowRate int64) error {
-	stream, ok := k.GetStream(ctx, receiverAddr, senderAddr)
+func (k Keeper) SetNewFlowRate(ctx sdk.Context, streamID uint64, newFlowRate int64) error {
+	stream, ok := k.GetStream(ctx, streamID)
 
 	if !ok {
-		return errorsmod.Wrapf(types.ErrStreamDoesNotExist, "sender: %s, receiver %s", senderAddr.String(), receiverAddr.String())
+		return errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream id %d", streamID)
 	}
 
 	// for event emission
@@ -241,40 +580,59 @@ func (k Keeper) SetNewFlowRate(ctx sdk.Context, receiverAddr, senderAddr sdk.Acc
 	depositZeroTime := nowTime
 	duration := int64(0)
 
+	if stream.HasEnded(nowTime) {
+		return errorsmod.Wrapf(types.ErrInvalidData, "stream ended at %s", stream.EndTime.String())
+	}
+
+	if stream.IsPaused() {
+		return errorsmod.Wrapf(types.ErrStreamPaused, "stream paused at %s", stream.PausedAt.String())
+	}
+
 	// Check if the stream still has deposit value.
 	if stream.Deposit.Amount.GT(mathmod.NewIntFromUint64(0)) {
-		// still has deposit. Claim unpaid deposits with the old flow rate first
-		_, _, _, _, err := k.ClaimFromStream(ctx, receiverAddr, senderAddr)
-		if err != nil {
-			return err
-		}
+		// still has deposit. Claim unpaid deposits with the old flow rate first. Nothing can be claimed
+		// if the stream is pending or has not reached its cliff, in which case the new flow rate applies
+		// from the stream's start
+		if !nowTime.Before(stream.ClaimableFrom()) {
+			_, _, _, _, err := k.ClaimFromStream(ctx, streamID)
+			if err != nil {
+				return err
+			}
 
-		// refresh stream data
-		stream, _ = k.GetStream(ctx, receiverAddr, senderAddr)
+			// refresh stream data
+			stream, _ = k.GetStream(ctx, streamID)
+		}
 
 		// Calculate new duration & deposit zero time based on new flow rate & remaining deposit.
-		// Calculation is from "now", since the Claim function has been called
+		// Calculation is from the last outflow time, which is "now" if the Claim function has been called
 		// above. We're effectively creating a "new" stream, based on existing deposit value
 		// and the new flow rate
-		duration = types.CalculateDuration(stream.Deposit, newFlowRate)
-		depositZeroTime = nowTime.Add(time.Second * time.Duration(duration))
+		duration = types.CalculateDuration(stream.Deposit, newFlowRate, stream.LastOutflowTime, stream.EndTime)
+		depositZeroTime = stream.LastOutflowTime.Add(time.Second * time.Duration(duration))
+
+		if depositZeroTime.Before(stream.CliffTime) {
+			return errorsmod.Wrapf(types.ErrInvalidData, "deposit must cover the stream until its cliff time %s", stream.CliffTime.String())
+		}
 	}
 
 	// save new stream data
 	stream.FlowRate = newFlowRate
 	stream.DepositZeroTime = depositZeroTime
 
-	err := k.SetStream(ctx, receiverAddr, senderAddr, stream)
+	err := k.SetStream(ctx, stream)
 
 	if err != nil {
 		return err
 	}
 
+	k.recordFlowRateChange(ctx, streamID, oldFlowRate, newFlowRate, nowTime)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeUpdateFlowRate,
-			sdk.NewAttribute(types.AttributeKeyStreamSender, senderAddr.String()),
-			sdk.NewAttribute(types.AttributeKeyStreamReceiver, receiverAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyStreamId, strconv.FormatUint(streamID, 10)),
+			sdk.NewAttribute(types.AttributeKeyStreamSender, stream.Sender),
+			sdk.NewAttribute(types.AttributeKeyStreamReceiver, stream.Receiver),
 			sdk.NewAttribute(types.AttributeKeyOldFlowRate, strconv.FormatInt(oldFlowRate, 10)),
 			sdk.NewAttribute(types.AttributeKeyNewFlowRate, strconv.FormatInt(newFlowRate, 10)),
 			sdk.NewAttribute(types.AttributeKeyDepositDuration, strconv.FormatInt(duration, 10)),
@@ -286,45 +644,393 @@ func (k Keeper) SetNewFlowRate(ctx sdk.Context, receiverAddr, senderAddr sdk.Acc
 	return nil
 }
 
-func (k Keeper) CancelStreamBySenderReceiver(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddress) error {
+func (k Keeper) CancelAndRefundStream(ctx sdk.Context, streamID uint64) error {
 
-	stream, ok := k.GetStream(ctx, receiverAddr, senderAddr)
+	stream, ok := k.GetStream(ctx, streamID)
 
 	if !ok {
-		return errorsmod.Wrapf(types.ErrStreamDoesNotExist, "sender: %s, receiver %s", senderAddr.String(), receiverAddr.String())
+		return errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream id %d", streamID)
 	}
 
 	if !stream.Cancellable {
 		return errorsmod.Wrap(types.ErrStreamNotCancellable, "cannot be cancelled")
 	}
 
-	// claim any outstanding flow
-	if stream.Deposit.Amount.GT(mathmod.NewIntFromUint64(0)) {
-		_, _, _, _, err := k.ClaimFromStream(ctx, receiverAddr, senderAddr)
+	_, err := k.refundAndDeleteStream(ctx, stream, types.EventTypeStreamCancelled)
+
+	return err
+}
+
+// refundAndDeleteStream claims any outstanding flow for the receiver, returns the remaining deposit to the
+// sender and deletes the stream, emitting an event of the given type
+func (k Keeper) refundAndDeleteStream(ctx sdk.Context, stream types.Stream, eventType string) (sdk.Coin, error) {
+	streamID := stream.StreamId
+
+	senderAddr, err := sdk.AccAddressFromBech32(stream.Sender)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// claim any outstanding flow. Nothing is paid to the receiver if the stream is pending or has not
+	// reached its cliff
+	if stream.Deposit.Amount.GT(mathmod.NewIntFromUint64(0)) && !ctx.BlockTime().Before(stream.ClaimableFrom()) {
+		_, _, _, _, err := k.ClaimFromStream(ctx, streamID)
 		if err != nil {
-			return err
+			return sdk.Coin{}, err
 		}
 		// refresh stream data
-		stream, _ = k.GetStream(ctx, receiverAddr, senderAddr)
+		stream, _ = k.GetStream(ctx, streamID)
 	}
 
 	refundCoin := stream.Deposit
 	// return any existing deposit to the sender
 	if refundCoin.Amount.GT(mathmod.NewIntFromUint64(0)) {
 		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, senderAddr, sdk.NewCoins(refundCoin))
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	// Delete from store
+	k.DeleteStream(ctx, streamID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyStreamId, strconv.FormatUint(streamID, 10)),
+			sdk.NewAttribute(types.AttributeKeyStreamSender, stream.Sender),
+			sdk.NewAttribute(types.AttributeKeyStreamReceiver, stream.Receiver),
+			sdk.NewAttribute(types.AttributeKeyRefundAmount, refundCoin.String()),
+		),
+	)
+
+	return refundCoin, nil
+}
+
+// AcceptPausable records the receiver's acceptance that a pausable stream can be paused by its sender
+func (k Keeper) AcceptPausable(ctx sdk.Context, streamID uint64) error {
+	stream, ok := k.GetStream(ctx, streamID)
+
+	if !ok {
+		return errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream id %d", streamID)
+	}
+
+	if !stream.Pausable {
+		return errorsmod.Wrap(types.ErrStreamNotPausable, "sender did not create the stream as pausable")
+	}
+
+	if stream.PausableAccepted {
+		return errorsmod.Wrap(types.ErrInvalidData, "receiver has already accepted that the stream can be paused")
+	}
+
+	stream.PausableAccepted = true
+
+	err := k.SetStream(ctx, stream)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePausableAccepted,
+			sdk.NewAttribute(types.AttributeKeyStreamId, strconv.FormatUint(streamID, 10)),
+			sdk.NewAttribute(types.AttributeKeyStreamSender, stream.Sender),
+			sdk.NewAttribute(types.AttributeKeyStreamReceiver, stream.Receiver),
+		),
+	)
+
+	return nil
+}
+
+// PauseStreamFlow pauses a pausable stream whose receiver has accepted that it can be paused. Any payment
+// accrued so far is claimed for the receiver, and nothing further accrues until the stream is resumed.
+func (k Keeper) PauseStreamFlow(ctx sdk.Context, streamID uint64) (time.Time, error) {
+	stream, ok := k.GetStream(ctx, streamID)
+
+	if !ok {
+		return time.Time{}, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream id %d", streamID)
+	}
+
+	if !stream.Pausable {
+		return time.Time{}, errorsmod.Wrap(types.ErrStreamNotPausable, "cannot be paused")
+	}
+
+	if !stream.PausableAccepted {
+		return time.Time{}, errorsmod.Wrap(types.ErrStreamNotPausable, "receiver has not accepted that the stream can be paused")
+	}
+
+	if stream.IsPaused() {
+		return time.Time{}, errorsmod.Wrapf(types.ErrStreamPaused, "stream already paused at %s", stream.PausedAt.String())
+	}
+
+	nowTime := ctx.BlockTime()
+
+	if nowTime.Before(stream.StartTime) {
+		return time.Time{}, errorsmod.Wrapf(types.ErrInvalidData, "stream has not started. Starts at %s", stream.StartTime.String())
+	}
+
+	if stream.HasEnded(nowTime) {
+		return time.Time{}, errorsmod.Wrapf(types.ErrInvalidData, "stream ended at %s", stream.EndTime.String())
+	}
+
+	if !stream.Deposit.IsPositive() || !nowTime.Before(stream.DepositZeroTime) {
+		return time.Time{}, errorsmod.Wrap(types.ErrInvalidData, "stream deposit has run out")
+	}
+
+	// claim up to now with the current receiver, so the pause starts with nothing outstanding
+	if !nowTime.Before(stream.ClaimableFrom()) {
+		_, _, _, _, err := k.ClaimFromStream(ctx, streamID)
+		if err != nil {
+			return time.Time{}, err
+		}
+		// refresh stream data
+		stream, _ = k.GetStream(ctx, streamID)
+	}
+
+	stream.PausedAt = nowTime
+
+	err := k.SetStream(ctx, stream)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStreamPaused,
+			sdk.NewAttribute(types.AttributeKeyStreamId, strconv.FormatUint(streamID, 10)),
+			sdk.NewAttribute(types.AttributeKeyStreamSender, stream.Sender),
+			sdk.NewAttribute(types.AttributeKeyStreamReceiver, stream.Receiver),
+			sdk.NewAttribute(types.AttributeKeyPausedAt, nowTime.String()),
+		),
+	)
+
+	return nowTime, nil
+}
+
+// ResumeStreamFlow resumes a paused stream. The last outflow time is moved forward by the time the stream
+// was paused, and the deposit zero time recalculated from there, so the receiver is not paid for the pause.
+func (k Keeper) ResumeStreamFlow(ctx sdk.Context, streamID uint64) (time.Time, error) {
+	stream, ok := k.GetStream(ctx, streamID)
+
+	if !ok {
+		return time.Time{}, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream id %d", streamID)
+	}
+
+	if !stream.IsPaused() {
+		return time.Time{}, errorsmod.Wrap(types.ErrStreamNotPaused, "cannot be resumed")
+	}
+
+	nowTime := ctx.BlockTime()
+
+	if stream.HasEnded(nowTime) {
+		return time.Time{}, errorsmod.Wrapf(types.ErrInvalidData, "stream ended at %s", stream.EndTime.String())
+	}
+
+	pausedDuration := nowTime.Sub(stream.PausedAt)
+
+	stream.LastOutflowTime = stream.LastOutflowTime.Add(pausedDuration)
+	duration := types.CalculateDuration(stream.Deposit, stream.FlowRate, stream.LastOutflowTime, stream.EndTime)
+	stream.DepositZeroTime = stream.LastOutflowTime.Add(time.Second * time.Duration(duration))
+	stream.PausedAt = time.Time{}
+
+	err := k.SetStream(ctx, stream)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStreamResumed,
+			sdk.NewAttribute(types.AttributeKeyStreamId, strconv.FormatUint(streamID, 10)),
+			sdk.NewAttribute(types.AttributeKeyStreamSender, stream.Sender),
+			sdk.NewAttribute(types.AttributeKeyStreamReceiver, stream.Receiver),
+			sdk.NewAttribute(types.AttributeKeyPausedDuration, strconv.FormatInt(int64(pausedDuration.Seconds()), 10)),
+			sdk.NewAttribute(types.AttributeKeyDepositZeroTime, stream.DepositZeroTime.String()),
+		),
+	)
+
+	return stream.DepositZeroTime, nil
+}
+
+// SetStreamLowDepositThreshold sets the number of seconds of remaining deposit below which a stream_low_deposit
+// event is emitted for the stream. Setting a threshold resets the stream's alert, so if the stream is already
+// below the new threshold the event is emitted in the next EndBlocker. A zero threshold disables the alert.
+func (k Keeper) SetStreamLowDepositThreshold(ctx sdk.Context, streamID uint64, lowDepositThreshold uint64) error {
+	stream, ok := k.GetStream(ctx, streamID)
+
+	if !ok {
+		return errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream id %d", streamID)
+	}
+
+	if lowDepositThreshold > types.MaxLowDepositThreshold {
+		return errorsmod.Wrapf(types.ErrInvalidData, "low deposit threshold cannot be more than %d seconds", types.MaxLowDepositThreshold)
+	}
+
+	stream.LowDepositThreshold = lowDepositThreshold
+	stream.LowDepositAlerted = false
+
+	err := k.SetStream(ctx, stream)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetLowDepositThreshold,
+			sdk.NewAttribute(types.AttributeKeyStreamId, strconv.FormatUint(streamID, 10)),
+			sdk.NewAttribute(types.AttributeKeyStreamSender, stream.Sender),
+			sdk.NewAttribute(types.AttributeKeyStreamReceiver, stream.Receiver),
+			sdk.NewAttribute(types.AttributeKeyLowDepositThreshold, strconv.FormatUint(lowDepositThreshold, 10)),
+		),
+	)
+
+	return nil
+}
+
+// RequestTermination starts the termination notice period of a stream, including streams that cannot be
+// cancelled. The stream keeps flowing until the notice period has passed, at which point it ends, and is settled
+// by the EndBlocker in the same way as a stream reaching its end time. The notice period must be at least the
+// min termination notice param.
+func (k Keeper) RequestTermination(ctx sdk.Context, streamID uint64, noticeDuration uint64) (time.Time, error) {
+	stream, ok := k.GetStream(ctx, streamID)
+
+	if !ok {
+		return time.Time{}, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream id %d", streamID)
+	}
+
+	if !stream.TerminationTime.IsZero() {
+		return time.Time{}, errorsmod.Wrapf(types.ErrInvalidData, "termination already requested. Stream terminates at %s", stream.TerminationTime.String())
+	}
+
+	if err := k.GetParams(ctx).CheckTerminationNotice(noticeDuration); err != nil {
+		return time.Time{}, err
+	}
+
+	if noticeDuration > types.MaxTerminationNotice {
+		return time.Time{}, errorsmod.Wrapf(types.ErrInvalidData, "termination notice cannot be more than %d seconds", types.MaxTerminationNotice)
+	}
+
+	nowTime := ctx.BlockTime()
+
+	if stream.HasEnded(nowTime) {
+		return time.Time{}, errorsmod.Wrapf(types.ErrInvalidData, "stream ended at %s", stream.EndTime.String())
+	}
+
+	terminationTime := nowTime.Add(time.Second * time.Duration(noticeDuration))
+
+	if !stream.EndTime.IsZero() && !terminationTime.Before(stream.EndTime) {
+		return time.Time{}, errorsmod.Wrapf(types.ErrInvalidData, "stream already ends at %s, before the notice period has passed", stream.EndTime.String())
+	}
+
+	// nothing can be claimed, and so the stream cannot be settled, before its cliff
+	if terminationTime.Before(stream.CliffTime) {
+		return time.Time{}, errorsmod.Wrapf(types.ErrInvalidData, "notice period cannot end before the stream's cliff time %s", stream.CliffTime.String())
+	}
+
+	stream.TerminationTime = terminationTime
+	stream.EndTime = terminationTime
+
+	// the end time caps the deposit zero time of a flowing stream. Paused streams are queued by their end time,
+	// and their deposit zero time is recalculated when they are resumed
+	if !stream.IsPaused() && stream.Deposit.IsPositive() {
+		duration := types.CalculateDuration(stream.Deposit, stream.FlowRate, stream.LastOutflowTime, stream.EndTime)
+		depositZeroTime := stream.LastOutflowTime.Add(time.Second * time.Duration(duration))
+		if depositZeroTime.Before(stream.DepositZeroTime) {
+			stream.DepositZeroTime = depositZeroTime
+		}
+	}
+
+	err := k.SetStream(ctx, stream)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTerminationRequested,
+			sdk.NewAttribute(types.AttributeKeyStreamId, strconv.FormatUint(streamID, 10)),
+			sdk.NewAttribute(types.AttributeKeyStreamSender, stream.Sender),
+			sdk.NewAttribute(types.AttributeKeyStreamReceiver, stream.Receiver),
+			sdk.NewAttribute(types.AttributeKeyNoticeDuration, strconv.FormatUint(noticeDuration, 10)),
+			sdk.NewAttribute(types.AttributeKeyTerminationTime, terminationTime.String()),
+		),
+	)
+
+	return terminationTime, nil
+}
+
+// AcceptTermination terminates a stream whose sender has requested its termination, without waiting for the
+// notice period to pass. Any outstanding flow is claimed for the receiver, and the remaining deposit is returned
+// to the sender.
+func (k Keeper) AcceptTermination(ctx sdk.Context, streamID uint64) (sdk.Coin, error) {
+	stream, ok := k.GetStream(ctx, streamID)
+
+	if !ok {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream id %d", streamID)
+	}
+
+	if stream.TerminationTime.IsZero() {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrTerminationNotReq, "cannot be terminated")
+	}
+
+	return k.refundAndDeleteStream(ctx, stream, types.EventTypeStreamTerminated)
+}
+
+// SettleStream settles a depleted or ended stream. Any outstanding payment is made to the receiver,
+// minus the validator fee. Any deposit remaining after the stream's end time is refunded to the sender,
+// and the stream is deleted from the store.
+func (k Keeper) SettleStream(ctx sdk.Context, streamID uint64) error {
+	stream, ok := k.GetStream(ctx, streamID)
+
+	if !ok {
+		return errorsmod.Wrapf(types.ErrStreamDoesNotExist, "stream id %d", streamID)
+	}
+
+	expiryTime, queued := stream.ExpiryQueueTime()
+	if !queued {
+		return errorsmod.Wrapf(types.ErrStreamPaused, "stream paused at %s", stream.PausedAt.String())
+	}
+
+	if ctx.BlockTime().Before(expiryTime) {
+		return errorsmod.Wrapf(types.ErrInvalidData, "stream deposit not depleted until %s", expiryTime.String())
+	}
+
+	receiverAmount := sdk.NewCoin(stream.Deposit.Denom, mathmod.NewInt(0))
+	valFee := sdk.NewCoin(stream.Deposit.Denom, mathmod.NewInt(0))
+	refundCoin := sdk.NewCoin(stream.Deposit.Denom, mathmod.NewInt(0))
+
+	// pay out any remaining deposit
+	if stream.Deposit.Amount.GT(mathmod.NewIntFromUint64(0)) {
+		var err error
+		receiverAmount, valFee, _, refundCoin, err = k.ClaimFromStream(ctx, streamID)
+		if err != nil {
+			return err
+		}
+	}
+
+	// deposit left over after the stream's end time is returned to the sender
+	if refundCoin.Amount.GT(mathmod.NewIntFromUint64(0)) {
+		senderAddr, err := sdk.AccAddressFromBech32(stream.Sender)
+		if err != nil {
+			return err
+		}
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, senderAddr, sdk.NewCoins(refundCoin))
 		if err != nil {
 			return err
 		}
 	}
 
 	// Delete from store
-	k.DeleteStream(ctx, receiverAddr, senderAddr)
+	k.DeleteStream(ctx, streamID)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			types.EventTypeStreamCancelled,
-			sdk.NewAttribute(types.AttributeKeyStreamSender, senderAddr.String()),
-			sdk.NewAttribute(types.AttributeKeyStreamReceiver, receiverAddr.String()),
+			types.EventTypeStreamSettled,
+			sdk.NewAttribute(types.AttributeKeyStreamId, strconv.FormatUint(streamID, 10)),
+			sdk.NewAttribute(types.AttributeKeyStreamSender, stream.Sender),
+			sdk.NewAttribute(types.AttributeKeyStreamReceiver, stream.Receiver),
+			sdk.NewAttribute(types.AttributeKeyClaimAmountReceived, receiverAmount.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimValidatorFee, valFee.String()),
 			sdk.NewAttribute(types.AttributeKeyRefundAmount, refundCoin.String()),
 		),
 	)
@@ -332,38 +1038,73 @@ func (k Keeper) CancelStreamBySenderReceiver(ctx sdk.Context, receiverAddr, send
 	return nil
 }
 
-// CreateNewStream creates a new "empty" stream for a sender/receiver pair.
+// CreateNewStream creates a new "empty" stream for a sender/receiver pair in the deposit's denom, which
+// starts flowing immediately and has no cliff or end time. See CreateNewScheduledStream.
+func (k Keeper) CreateNewStream(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddress, deposit sdk.Coin, flowRate int64) (types.Stream, error) {
+	return k.CreateNewScheduledStream(ctx, receiverAddr, senderAddr, deposit, flowRate, time.Time{}, time.Time{}, time.Time{}, false)
+}
+
+// CreateNewScheduledStream creates a new "empty" stream for a sender/receiver pair in the deposit's denom, and
+// assigns it the next stream ID. A pair may have any number of streams.
+// The stream starts flowing at startTime, or the block time if startTime is zero or earlier. Nothing can be
+// claimed before the optional cliffTime, and the stream stops flowing at the optional endTime. Only pausable
+// streams can be paused by the sender.
 // Deposit and Deposit Zero Time are handled by the AddDeposit function.
 // The value passed in the deposit var is only used to determine the denomination of the deposit.
-func (k Keeper) CreateNewStream(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddress, deposit sdk.Coin, flowRate int64) (types.Stream, error) {
+func (k Keeper) CreateNewScheduledStream(ctx sdk.Context, receiverAddr, senderAddr sdk.AccAddress, deposit sdk.Coin, flowRate int64, startTime, cliffTime, endTime time.Time, pausable bool) (types.Stream, error) {
 
-	if k.IsStream(ctx, receiverAddr, senderAddr) {
-		return types.Stream{}, errorsmod.Wrap(types.ErrStreamExists, "stream exists")
+	streamID, err := k.GetHighestStreamID(ctx)
+	if err != nil {
+		return types.Stream{}, err
 	}
 
 	nowTime := ctx.BlockTime()
+	if startTime.Before(nowTime) {
+		startTime = nowTime
+	}
 
 	stream := types.Stream{
+		StreamId:        streamID,
+		Receiver:        receiverAddr.String(),
+		Sender:          senderAddr.String(),
 		Deposit:         sdk.NewCoin(deposit.Denom, mathmod.NewInt(0)), // set to zero for correct calculation in AddDeposit
 		FlowRate:        flowRate,
-		LastOutflowTime: nowTime,
+		LastOutflowTime: startTime,             // flow is calculated from the start time
 		DepositZeroTime: time.Unix(0, 0).UTC(), // set to past, so deposit zero time correctly calculated in AddDeposit
 		Cancellable:     true,                  // default to true for now. Eventually, using eFUND will set to false
+		StartTime:       startTime,
+		CliffTime:       cliffTime,
+		EndTime:         endTime,
+		Pausable:        pausable,
 	}
 
-	err := k.SetStream(ctx, receiverAddr, senderAddr, stream)
+	err = k.SetStream(ctx, stream)
 
 	if err != nil {
 		return types.Stream{}, err
 	}
 
+	k.SetHighestStreamID(ctx, streamID+1)
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyStreamId, strconv.FormatUint(streamID, 10)),
+		sdk.NewAttribute(types.AttributeKeyStreamSender, senderAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyStreamReceiver, receiverAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyFlowRate, strconv.FormatInt(flowRate, 10)),
+		sdk.NewAttribute(types.AttributeKeyStartTime, startTime.String()),
+		sdk.NewAttribute(types.AttributeKeyPausable, strconv.FormatBool(pausable)),
+	}
+
+	if !cliffTime.IsZero() {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyCliffTime, cliffTime.String()))
+	}
+
+	if !endTime.IsZero() {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyEndTime, endTime.String()))
+	}
+
 	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeCreateStreamAction,
-			sdk.NewAttribute(types.AttributeKeyStreamSender, senderAddr.String()),
-			sdk.NewAttribute(types.AttributeKeyStreamReceiver, receiverAddr.String()),
-			sdk.NewAttribute(types.AttributeKeyFlowRate, strconv.FormatInt(flowRate, 10)),
-		),
+		sdk.NewEvent(types.EventTypeCreateStreamAction, attributes...),
 	)
 
 	return stream, nil
@@ -5,18 +5,22 @@ import (
 
 	mathmod "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	simapphelpers "github.com/unification-com/mainchain/app/helpers"
 	"github.com/unification-com/mainchain/x/stream/types"
 )
 
 func (s *KeeperTestSuite) TestIsStream() {
-	ok := s.app.StreamKeeper.IsStream(s.ctx, s.addrs[1], s.addrs[0])
+	ok := s.app.StreamKeeper.IsStream(s.ctx, 1)
 	s.Require().False(ok)
 
 	nowTime := s.ctx.BlockTime()
 
 	expStream := types.Stream{
+		StreamId:        1,
+		Receiver:        s.addrs[1].String(),
+		Sender:          s.addrs[0].String(),
 		Deposit:         sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000)),
 		FlowRate:        100,
 		LastOutflowTime: nowTime,
@@ -24,22 +28,45 @@ func (s *KeeperTestSuite) TestIsStream() {
 		Cancellable:     true,
 	}
 
-	err := s.app.StreamKeeper.SetStream(s.ctx, s.addrs[1], s.addrs[0], expStream)
+	err := s.app.StreamKeeper.SetStream(s.ctx, expStream)
 	s.Require().NoError(err)
 
-	ok = s.app.StreamKeeper.IsStream(s.ctx, s.addrs[1], s.addrs[0])
+	ok = s.app.StreamKeeper.IsStream(s.ctx, 1)
 	s.Require().True(ok)
 }
 
+func (s *KeeperTestSuite) TestSetStream_Fail() {
+	expStream := types.Stream{
+		Receiver:        s.addrs[1].String(),
+		Sender:          s.addrs[0].String(),
+		Deposit:         sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000)),
+		FlowRate:        100,
+		LastOutflowTime: s.ctx.BlockTime(),
+		DepositZeroTime: time.Unix(0, 0).UTC(),
+		Cancellable:     true,
+	}
+
+	err := s.app.StreamKeeper.SetStream(s.ctx, expStream)
+	s.Require().ErrorContains(err, "stream id required")
+
+	expStream.StreamId = 1
+	expStream.Receiver = "rubbish"
+	err = s.app.StreamKeeper.SetStream(s.ctx, expStream)
+	s.Require().Error(err)
+}
+
 func (s *KeeperTestSuite) TestSetGetStream() {
 
-	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, s.addrs[1], s.addrs[0])
+	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, 1)
 	s.Require().False(ok)
 	s.Require().Equal(types.Stream{}, stream)
 
 	nowTime := s.ctx.BlockTime()
 
 	expStream := types.Stream{
+		StreamId:        1,
+		Receiver:        s.addrs[1].String(),
+		Sender:          s.addrs[0].String(),
 		Deposit:         sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000)),
 		FlowRate:        100,
 		LastOutflowTime: nowTime,
@@ -47,12 +74,13 @@ func (s *KeeperTestSuite) TestSetGetStream() {
 		Cancellable:     true,
 	}
 
-	err := s.app.StreamKeeper.SetStream(s.ctx, s.addrs[1], s.addrs[0], expStream)
+	err := s.app.StreamKeeper.SetStream(s.ctx, expStream)
 	s.Require().NoError(err)
 
-	stream, ok = s.app.StreamKeeper.GetStream(s.ctx, s.addrs[1], s.addrs[0])
+	stream, ok = s.app.StreamKeeper.GetStream(s.ctx, 1)
 
 	s.Require().True(ok)
+	s.Require().Equal(expStream, stream)
 	s.Require().Equal(expStream.Deposit, stream.Deposit)
 	s.Require().Equal(expStream.FlowRate, stream.FlowRate)
 	s.Require().Equal(expStream.LastOutflowTime, stream.LastOutflowTime)
@@ -75,6 +103,9 @@ func (s *KeeperTestSuite) TestCreateNewStream_BasicSuccess() {
 
 	s.Require().NoError(err)
 
+	s.Require().Equal(uint64(1), stream.StreamId)
+	s.Require().Equal(s.addrs[1].String(), stream.Receiver)
+	s.Require().Equal(s.addrs[0].String(), stream.Sender)
 	s.Require().Equal(expStream.Deposit, stream.Deposit)
 	s.Require().Equal(expStream.FlowRate, stream.FlowRate)
 	s.Require().Equal(expStream.LastOutflowTime, stream.LastOutflowTime)
@@ -98,6 +129,10 @@ func (s *KeeperTestSuite) TestCreateNewStream_BasicSuccess() {
 			s.Require().Equal(types.AttributeKeyStreamReceiver, attrReceiver.Key)
 			s.Require().Equal(s.addrs[1].String(), attrReceiver.Value)
 
+			attrStreamId, ok := ev.GetAttribute(types.AttributeKeyStreamId)
+			s.Require().True(ok)
+			s.Require().Equal("1", attrStreamId.Value)
+
 			attrFlowRate, ok := ev.GetAttribute(types.AttributeKeyFlowRate)
 			s.Require().True(ok)
 			s.Require().Equal(types.AttributeKeyFlowRate, attrFlowRate.Key)
@@ -108,7 +143,7 @@ func (s *KeeperTestSuite) TestCreateNewStream_BasicSuccess() {
 	// should emit create_stream event
 	s.Require().True(hasCreateStreamEvent)
 
-	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, s.addrs[1], s.addrs[0])
+	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, stream.StreamId)
 
 	s.Require().True(ok)
 	s.Require().Equal(expStream.Deposit, stream.Deposit)
@@ -118,25 +153,39 @@ func (s *KeeperTestSuite) TestCreateNewStream_BasicSuccess() {
 	s.Require().Equal(expStream.Cancellable, stream.Cancellable)
 }
 
-func (s *KeeperTestSuite) TestCreateNewStream_Fail_Stream_Exists() {
-	_, err := s.app.StreamKeeper.CreateNewStream(s.ctx, s.addrs[1], s.addrs[0], sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000)), 100)
+func (s *KeeperTestSuite) TestCreateNewStream_MultipleForPair() {
+	stream1, err := s.app.StreamKeeper.CreateNewStream(s.ctx, s.addrs[1], s.addrs[0], sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000)), 100)
+	s.Require().NoError(err)
+
+	stream2, err := s.app.StreamKeeper.CreateNewStream(s.ctx, s.addrs[1], s.addrs[0], sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000)), 100)
+	s.Require().NoError(err)
+
+	s.Require().Equal(uint64(1), stream1.StreamId)
+	s.Require().Equal(uint64(2), stream2.StreamId)
+
+	highestID, err := s.app.StreamKeeper.GetHighestStreamID(s.ctx)
 	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), highestID)
 
-	_, err = s.app.StreamKeeper.CreateNewStream(s.ctx, s.addrs[1], s.addrs[0], sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000)), 100)
-	s.Require().ErrorContains(err, "stream exists")
+	streams := s.app.StreamKeeper.GetStreamsForReceiverSender(s.ctx, s.addrs[1], s.addrs[0])
+	s.Require().Len(streams, 2)
+	s.Require().Equal(stream1, streams[0])
+	s.Require().Equal(stream2, streams[1])
 }
 
 func (s *KeeperTestSuite) TestDeleteStream() {
 	for i := 0; i < len(s.addrs)-1; i += 1 {
 		sender := s.addrs[i]
 		receiver := s.addrs[i+1]
-		_, err := s.app.StreamKeeper.CreateNewStream(s.ctx, receiver, sender, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), 123)
+		stream, err := s.app.StreamKeeper.CreateNewStream(s.ctx, receiver, sender, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), 123)
 		s.Require().NoError(err)
-		_, ok := s.app.StreamKeeper.GetStream(s.ctx, receiver, sender)
+		_, ok := s.app.StreamKeeper.GetStream(s.ctx, stream.StreamId)
 		s.Require().True(ok)
-		s.app.StreamKeeper.DeleteStream(s.ctx, receiver, sender)
-		_, ok = s.app.StreamKeeper.GetStream(s.ctx, receiver, sender)
+		s.app.StreamKeeper.DeleteStream(s.ctx, stream.StreamId)
+		_, ok = s.app.StreamKeeper.GetStream(s.ctx, stream.StreamId)
 		s.Require().False(ok)
+		s.Require().Empty(s.app.StreamKeeper.GetStreamsForReceiverSender(s.ctx, receiver, sender))
+		s.Require().False(s.app.StreamKeeper.IsInStreamExpiryQueue(s.ctx, stream.StreamId, stream.DepositZeroTime))
 	}
 }
 
@@ -153,11 +202,11 @@ func (s *KeeperTestSuite) TestAddDeposit_Basic_Success() {
 	}
 
 	// set stream
-	err := s.app.StreamKeeper.SetStream(s.ctx, s.addrs[1], s.addrs[0], expStream)
+	streamID, err := s.setTestStream(s.ctx, s.addrs[1], s.addrs[0], expStream)
 	s.Require().NoError(err)
 
 	// Add Deposit to stream
-	ok, err := s.app.StreamKeeper.AddDeposit(s.ctx, s.addrs[1], s.addrs[0], sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000)))
+	ok, err := s.app.StreamKeeper.AddDeposit(s.ctx, streamID, sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000)))
 	s.Require().True(ok)
 	s.Require().NoError(err)
 
@@ -206,7 +255,7 @@ func (s *KeeperTestSuite) TestAddDeposit_Basic_Success() {
 	s.Require().True(hasEvent)
 
 	// get stream from keeper
-	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, s.addrs[1], s.addrs[0])
+	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, streamID)
 	// should now be 1000stake
 	s.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000)), stream.Deposit)
 	// Deposit of 1000, flow rate of 100/s, should have deposit zero time of now + 10s
@@ -284,9 +333,9 @@ func (s *KeeperTestSuite) TestAddDeposit_Success_TopUpExistingNotExpired() {
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
 			// deposit zero time is in the queryFuture, so just use SetStream instead of create & add deposit combo
-			err := s.app.StreamKeeper.SetStream(tCtx, tc.receiver, tc.sender, tc.stream)
+			streamID, err := s.setTestStream(tCtx, tc.receiver, tc.sender, tc.stream)
 			s.Require().NoError(err, "SetStream NoError test name %s", tc.name)
-			ok, err := s.app.StreamKeeper.AddDeposit(tCtx, tc.receiver, tc.sender, tc.deposit)
+			ok, err := s.app.StreamKeeper.AddDeposit(tCtx, streamID, tc.deposit)
 			s.Require().True(ok, "AddDeposit True test name %s", tc.name)
 			s.Require().NoError(err, "AddDeposit NoError test name %s", tc.name)
 
@@ -300,7 +349,7 @@ func (s *KeeperTestSuite) TestAddDeposit_Success_TopUpExistingNotExpired() {
 			}
 			s.Require().False(hasEvent)
 
-			stream, ok := s.app.StreamKeeper.GetStream(tCtx, tc.receiver, tc.sender)
+			stream, ok := s.app.StreamKeeper.GetStream(tCtx, streamID)
 			s.Require().True(ok, "GetStream True test name %s", tc.name)
 			s.Require().Equal(tc.expDeposit, stream.Deposit, "GetStream Deposit Equal test name %s", tc.name)
 			s.Require().Equal(tc.expDepositZeroTime, stream.DepositZeroTime, "GetStream DepositZeroTime Equal test name %s", tc.name)
@@ -402,29 +451,29 @@ func (s *KeeperTestSuite) TestAddDeposit_Success_TopUpExistingExpired() {
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
 			// create
-			_, err := s.app.StreamKeeper.CreateNewStream(tCtx, tc.receiver, tc.sender, tc.initialDeposit, tc.stream.FlowRate)
+			newStream, err := s.app.StreamKeeper.CreateNewStream(tCtx, tc.receiver, tc.sender, tc.initialDeposit, tc.stream.FlowRate)
 			s.Require().NoError(err, "CreateNewStream NoError test name %s", tc.name)
 
 			// add initial deposit
 			if tc.initialDeposit.Amount.GT(mathmod.NewIntFromUint64(0)) {
-				ok, err := s.app.StreamKeeper.AddDeposit(tCtx, tc.receiver, tc.sender, tc.initialDeposit)
+				ok, err := s.app.StreamKeeper.AddDeposit(tCtx, newStream.StreamId, tc.initialDeposit)
 				s.Require().True(ok)
 				s.Require().NoError(err, "initialDeposit AddDeposit NoError test name %s", tc.name)
 			}
 
 			// check stream
-			stream, ok := s.app.StreamKeeper.GetStream(tCtx, tc.receiver, tc.sender)
+			stream, ok := s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 			s.Require().True(ok)
 			s.Require().Equal(tc.initialDeposit, stream.Deposit)
 
 			// set times etc.
 			stream.LastOutflowTime = tc.stream.LastOutflowTime
 			stream.DepositZeroTime = tc.stream.DepositZeroTime
-			err = s.app.StreamKeeper.SetStream(tCtx, tc.receiver, tc.sender, stream)
+			err = s.app.StreamKeeper.SetStream(tCtx, stream)
 			s.Require().NoError(err, "SetStream NoError test name %s", tc.name)
 
 			// top up with new deposit
-			ok, err = s.app.StreamKeeper.AddDeposit(tCtx, tc.receiver, tc.sender, tc.newDeposit)
+			ok, err = s.app.StreamKeeper.AddDeposit(tCtx, newStream.StreamId, tc.newDeposit)
 			s.Require().True(ok, "AddDeposit True test name %s", tc.name)
 			s.Require().NoError(err, "AddDeposit NoError test name %s", tc.name)
 
@@ -443,7 +492,7 @@ func (s *KeeperTestSuite) TestAddDeposit_Success_TopUpExistingExpired() {
 			}
 
 			// final check
-			stream, ok = s.app.StreamKeeper.GetStream(tCtx, tc.receiver, tc.sender)
+			stream, ok = s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 			s.Require().True(ok, "GetStream True test name %s", tc.name)
 			s.Require().Equal(tc.expDeposit, stream.Deposit, "GetStream Deposit Equal test name %s", tc.name)
 			s.Require().Equal(tc.expDepositZeroTime, stream.DepositZeroTime, "GetStream DepositZeroTime Equal test name %s", tc.name)
@@ -474,14 +523,14 @@ func (s *KeeperTestSuite) TestAddDeposit_ZeroFlowRate() {
 		Cancellable:     true,
 	}
 
-	err := s.app.StreamKeeper.SetStream(tCtx, rec1, sen1, str1Set)
+	streamID, err := s.setTestStream(tCtx, rec1, sen1, str1Set)
 	s.Require().NoError(err)
 
-	ok, err := s.app.StreamKeeper.AddDeposit(tCtx, rec1, sen1, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000))
+	ok, err := s.app.StreamKeeper.AddDeposit(tCtx, streamID, sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000))
 	s.Require().NoError(err)
 	s.Require().True(ok)
 
-	str1, ok := s.app.StreamKeeper.GetStream(tCtx, rec1, sen1)
+	str1, ok := s.app.StreamKeeper.GetStream(tCtx, streamID)
 	s.Require().True(ok)
 
 	s.Require().Equal(int64(0), str1.FlowRate)
@@ -613,17 +662,17 @@ func (s *KeeperTestSuite) TestAddDeposit_Scenarios() {
 			tCtx = tCtx.WithBlockTime(blockTimeCreate).WithBlockHeight(1)
 
 			// create
-			_, err := s.app.StreamKeeper.CreateNewStream(tCtx, tc.receiver, tc.sender, tc.initialDeposit, tc.flowRate)
+			newStream, err := s.app.StreamKeeper.CreateNewStream(tCtx, tc.receiver, tc.sender, tc.initialDeposit, tc.flowRate)
 
 			s.Require().NoError(err, "CreateNewStream NoError test name %s", tc.name)
 
 			// add initial deposit
-			ok, err := s.app.StreamKeeper.AddDeposit(tCtx, tc.receiver, tc.sender, tc.initialDeposit)
+			ok, err := s.app.StreamKeeper.AddDeposit(tCtx, newStream.StreamId, tc.initialDeposit)
 			s.Require().True(ok, "initialDeposit ok NoError test name %s", tc.name)
 			s.Require().NoError(err, "initialDeposit AddDeposit NoError test name %s", tc.name)
 
 			// check stream
-			stream, ok := s.app.StreamKeeper.GetStream(tCtx, tc.receiver, tc.sender)
+			stream, ok := s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 			// should be in the queryFuture from the creation time
 			expInitialDepZeroTime := time.Unix(blockTimeCreate.Unix()+tc.expInitialDepZeroTime, 0).UTC()
 			s.Require().True(ok, "GetStream ok NoError test name %s", tc.name)
@@ -634,7 +683,7 @@ func (s *KeeperTestSuite) TestAddDeposit_Scenarios() {
 			tCtx = tCtx.WithBlockTime(nowTime).WithBlockHeight(2)
 
 			// add new deposit
-			ok, err = s.app.StreamKeeper.AddDeposit(tCtx, tc.receiver, tc.sender, tc.newDeposit)
+			ok, err = s.app.StreamKeeper.AddDeposit(tCtx, newStream.StreamId, tc.newDeposit)
 			s.Require().True(ok, "newDeposit AddDeposit ok test name %s", tc.name)
 			s.Require().NoError(err, "newDeposit AddDeposit NoError test name %s", tc.name)
 
@@ -680,7 +729,7 @@ func (s *KeeperTestSuite) TestAddDeposit_Scenarios() {
 
 			// check results
 			expDepZeroTime := time.Unix(nowTime.Unix()+tc.expNewDepZeroTime, 0).UTC()
-			stream, ok = s.app.StreamKeeper.GetStream(tCtx, tc.receiver, tc.sender)
+			stream, ok = s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 			s.Require().True(ok, "GetStream ok NoError test name %s", tc.name)
 			s.Require().Equal(tc.expNewDeposit, stream.Deposit, "tc.expNewDeposit Equal stream.Deposit test name %s", tc.name)
 			s.Require().Equal(expDepZeroTime, stream.DepositZeroTime, "tc.expNewDeposit Equal stream.Deposit test name %s", tc.name)
@@ -689,12 +738,12 @@ func (s *KeeperTestSuite) TestAddDeposit_Scenarios() {
 }
 
 func (s *KeeperTestSuite) TestAddDeposit_Fail_StreamNotExist() {
-	ok, err := s.app.StreamKeeper.AddDeposit(s.ctx, s.addrs[1], s.addrs[0], sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000)))
+	ok, err := s.app.StreamKeeper.AddDeposit(s.ctx, 1, sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000)))
 	s.Require().False(ok)
 	s.Require().ErrorContains(err, "stream does not exist")
 
 	// double check
-	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, s.addrs[1], s.addrs[0])
+	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, 1)
 	s.Require().False(ok)
 	s.Require().Equal(types.Stream{}, stream)
 }
@@ -712,11 +761,11 @@ func (s *KeeperTestSuite) TestAddDeposit_Fail_InsufficientBalance() {
 	}
 
 	// set stream
-	err := s.app.StreamKeeper.SetStream(s.ctx, s.addrs[1], s.addrs[0], expStream)
+	streamID, err := s.setTestStream(s.ctx, s.addrs[1], s.addrs[0], expStream)
 	s.Require().NoError(err)
 
 	// deposit more than sender's balance
-	ok, err := s.app.StreamKeeper.AddDeposit(s.ctx, s.addrs[1], s.addrs[0], sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000000000000000001)))
+	ok, err := s.app.StreamKeeper.AddDeposit(s.ctx, streamID, sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000000000000000001)))
 	s.Require().False(ok)
 	s.Require().ErrorContains(err, "insufficient funds")
 
@@ -738,13 +787,13 @@ func (s *KeeperTestSuite) TestAddDeposit_Fail_Denom_Mismatch() {
 	}
 
 	// set stream
-	err := s.app.StreamKeeper.SetStream(s.ctx, newAccs[1], newAccs[0], expStream)
+	streamID, err := s.setTestStream(s.ctx, newAccs[1], newAccs[0], expStream)
 	s.Require().NoError(err)
 
 	// deposit more than sender's balance
-	ok, err := s.app.StreamKeeper.AddDeposit(s.ctx, newAccs[1], newAccs[0], sdk.NewCoin("notstake", mathmod.NewIntFromUint64(10000)))
+	ok, err := s.app.StreamKeeper.AddDeposit(s.ctx, streamID, sdk.NewCoin("notstake", mathmod.NewIntFromUint64(10000)))
 	s.Require().False(ok)
-	s.Require().ErrorContains(err, "top up denom does not match stream denom")
+	s.Require().ErrorContains(err, "deposit denom notstake does not match stream denom")
 
 }
 
@@ -758,23 +807,23 @@ func (s *KeeperTestSuite) TestAddDeposit_Fail_Cancelled() {
 	sender := s.addrs[1]
 	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)
 
-	_, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiver, sender, deposit, 123)
+	newStream, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiver, sender, deposit, 123)
 	s.Require().NoError(err)
 
-	ok, err := s.app.StreamKeeper.AddDeposit(tCtx, receiver, sender, deposit)
+	ok, err := s.app.StreamKeeper.AddDeposit(tCtx, newStream.StreamId, deposit)
 	s.Require().NoError(err)
 	s.Require().True(ok)
 
-	_, ok = s.app.StreamKeeper.GetStream(tCtx, receiver, sender)
+	_, ok = s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 	s.Require().True(ok)
 
-	err = s.app.StreamKeeper.CancelStreamBySenderReceiver(tCtx, receiver, sender)
+	err = s.app.StreamKeeper.CancelAndRefundStream(tCtx, newStream.StreamId)
 	s.Require().NoError(err)
 
-	_, ok = s.app.StreamKeeper.GetStream(tCtx, receiver, sender)
+	_, ok = s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 	s.Require().False(ok)
 
-	ok, err = s.app.StreamKeeper.AddDeposit(tCtx, receiver, sender, deposit)
+	ok, err = s.app.StreamKeeper.AddDeposit(tCtx, newStream.StreamId, deposit)
 	s.Require().ErrorContains(err, "stream does not exist")
 	s.Require().False(ok)
 }
@@ -789,16 +838,16 @@ func (s *KeeperTestSuite) TestSetNewFlowRate_Success() {
 	deposit := sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(2400))
 
 	// create stream
-	_, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1)
+	newStream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1)
 	s.Require().NoError(err)
 
 	// add deposit
-	ok, err := s.app.StreamKeeper.AddDeposit(tCtx, s.addrs[1], s.addrs[0], deposit)
+	ok, err := s.app.StreamKeeper.AddDeposit(tCtx, newStream.StreamId, deposit)
 	s.Require().True(ok)
 	s.Require().NoError(err)
 
 	// Set new flow rate
-	err = s.app.StreamKeeper.SetNewFlowRate(tCtx, s.addrs[1], s.addrs[0], 24)
+	err = s.app.StreamKeeper.SetNewFlowRate(tCtx, newStream.StreamId, 24)
 	s.Require().NoError(err)
 
 	// check events ar emitted
@@ -850,7 +899,7 @@ func (s *KeeperTestSuite) TestSetNewFlowRate_Success() {
 	s.Require().True(hasEvent)
 
 	// get stream from keeper
-	stream, ok := s.app.StreamKeeper.GetStream(tCtx, s.addrs[1], s.addrs[0])
+	stream, ok := s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 	s.Require().True(ok)
 	// should now be 1000stake
 	s.Require().Equal(int64(24), stream.FlowRate)
@@ -946,12 +995,12 @@ func (s *KeeperTestSuite) TestSetNewFlowRate_Success_ExistingNotExpired() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			err := s.app.StreamKeeper.SetStream(tCtx, tc.receiver, tc.sender, tc.stream)
+			streamID, err := s.setTestStream(tCtx, tc.receiver, tc.sender, tc.stream)
 			s.Require().NoError(err, "SetStream NoError test name %s", tc.name)
-			err = s.app.StreamKeeper.SetNewFlowRate(tCtx, tc.receiver, tc.sender, tc.newFlowRate)
+			err = s.app.StreamKeeper.SetNewFlowRate(tCtx, streamID, tc.newFlowRate)
 			s.Require().NoError(err, "AddDeposit NoError test name %s", tc.name)
 
-			stream, ok := s.app.StreamKeeper.GetStream(tCtx, tc.receiver, tc.sender)
+			stream, ok := s.app.StreamKeeper.GetStream(tCtx, streamID)
 			s.Require().True(ok, "GetStream True test name %s", tc.name)
 			s.Require().Equal(tc.expDepositZeroTime, stream.DepositZeroTime, "GetStream DepositZeroTime Equal test name %s", tc.name)
 			s.Require().Equal(tc.newFlowRate, stream.FlowRate, "GetStream FlowRate Equal test name %s", tc.name)
@@ -1125,19 +1174,19 @@ func (s *KeeperTestSuite) TestSetNewFlowRate_Scenarios() {
 			tCtx = tCtx.WithBlockTime(blockTimeCreate).WithBlockHeight(1)
 
 			// create
-			_, err := s.app.StreamKeeper.CreateNewStream(tCtx, tc.receiver, tc.sender, tc.initialDeposit, tc.startFlowRate)
+			newStream, err := s.app.StreamKeeper.CreateNewStream(tCtx, tc.receiver, tc.sender, tc.initialDeposit, tc.startFlowRate)
 
 			s.Require().NoError(err, "CreateNewStream NoError test name %s", tc.name)
 
 			// add initial deposit
 			if tc.initialDeposit.IsPositive() {
-				ok, err := s.app.StreamKeeper.AddDeposit(tCtx, tc.receiver, tc.sender, tc.initialDeposit)
+				ok, err := s.app.StreamKeeper.AddDeposit(tCtx, newStream.StreamId, tc.initialDeposit)
 				s.Require().True(ok, "initialDeposit ok NoError test name %s", tc.name)
 				s.Require().NoError(err, "initialDeposit AddDeposit NoError test name %s", tc.name)
 			}
 
 			// check stream
-			stream, ok := s.app.StreamKeeper.GetStream(tCtx, tc.receiver, tc.sender)
+			stream, ok := s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 			// should be in the queryFuture from the creation time
 			expInitialDepZeroTime := time.Unix(blockTimeCreate.Unix()+tc.expInitialDepZeroTime, 0).UTC()
 			s.Require().True(ok, "GetStream ok NoError test name %s", tc.name)
@@ -1149,7 +1198,7 @@ func (s *KeeperTestSuite) TestSetNewFlowRate_Scenarios() {
 			tCtx = tCtx.WithBlockTime(nowTime).WithBlockHeight(2)
 
 			// set new flow rate
-			err = s.app.StreamKeeper.SetNewFlowRate(tCtx, tc.receiver, tc.sender, tc.newFlowRate)
+			err = s.app.StreamKeeper.SetNewFlowRate(tCtx, newStream.StreamId, tc.newFlowRate)
 			s.Require().NoError(err, "newFlowRate SetNewFlowRate NoError test name %s", tc.name)
 
 			events := tCtx.EventManager().Events()
@@ -1194,7 +1243,7 @@ func (s *KeeperTestSuite) TestSetNewFlowRate_Scenarios() {
 
 			// check results
 			expDepZeroTime := time.Unix(nowTime.Unix()+tc.expNewDepZeroTime, 0).UTC()
-			stream, ok = s.app.StreamKeeper.GetStream(tCtx, tc.receiver, tc.sender)
+			stream, ok = s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 			s.Require().True(ok, "GetStream ok NoError test name %s", tc.name)
 			s.Require().Equal(tc.newFlowRate, stream.FlowRate, "tc.expNewDeposit Equal stream.Deposit test name %s", tc.name)
 			s.Require().Equal(expDepZeroTime, stream.DepositZeroTime, "tc.expNewDeposit Equal stream.Deposit test name %s", tc.name)
@@ -1203,11 +1252,11 @@ func (s *KeeperTestSuite) TestSetNewFlowRate_Scenarios() {
 }
 
 func (s *KeeperTestSuite) TestSetNewFlowRate_Fail() {
-	err := s.app.StreamKeeper.SetNewFlowRate(s.ctx, s.addrs[1], s.addrs[0], 24)
+	err := s.app.StreamKeeper.SetNewFlowRate(s.ctx, 1, 24)
 	s.Require().ErrorContains(err, "stream does not exist")
 
 	// double check
-	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, s.addrs[1], s.addrs[0])
+	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, 1)
 	s.Require().False(ok)
 	s.Require().Equal(types.Stream{}, stream)
 }
@@ -1222,23 +1271,23 @@ func (s *KeeperTestSuite) TestSetNewFlowRate_Fail_Cancelled() {
 	sender := s.addrs[1]
 	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)
 
-	_, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiver, sender, deposit, 123)
+	newStream, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiver, sender, deposit, 123)
 	s.Require().NoError(err)
 
-	ok, err := s.app.StreamKeeper.AddDeposit(tCtx, receiver, sender, deposit)
+	ok, err := s.app.StreamKeeper.AddDeposit(tCtx, newStream.StreamId, deposit)
 	s.Require().NoError(err)
 	s.Require().True(ok)
 
-	_, ok = s.app.StreamKeeper.GetStream(tCtx, receiver, sender)
+	_, ok = s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 	s.Require().True(ok)
 
-	err = s.app.StreamKeeper.CancelStreamBySenderReceiver(tCtx, receiver, sender)
+	err = s.app.StreamKeeper.CancelAndRefundStream(tCtx, newStream.StreamId)
 	s.Require().NoError(err)
 
-	_, ok = s.app.StreamKeeper.GetStream(tCtx, receiver, sender)
+	_, ok = s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 	s.Require().False(ok)
 
-	err = s.app.StreamKeeper.SetNewFlowRate(tCtx, receiver, sender, 321)
+	err = s.app.StreamKeeper.SetNewFlowRate(tCtx, newStream.StreamId, 321)
 	s.Require().ErrorContains(err, "stream does not exist")
 }
 
@@ -1252,15 +1301,15 @@ func (s *KeeperTestSuite) TestClaimFromStream_Success() {
 
 	// set validator fee
 	valFee := mathmod.LegacyNewDecWithPrec(1, 2)
-	_ = s.app.StreamKeeper.SetParams(tCtx, types.Params{ValidatorFee: valFee})
+	_ = s.app.StreamKeeper.SetParams(tCtx, types.Params{ValidatorFee: valFee, FeeCollectorRatio: types.DefaultFeeCollectorRatio, CommunityPoolRatio: types.DefaultCommunityPoolRatio, BurnRatio: types.DefaultBurnRatio, MaxClaimFeeRatio: types.DefaultMaxClaimFeeRatio})
 
 	deposit := sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000))
 
 	// create stream
-	_, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1)
+	newStream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1)
 	s.Require().NoError(err)
 	// add deposit
-	ok, err := s.app.StreamKeeper.AddDeposit(tCtx, s.addrs[1], s.addrs[0], deposit)
+	ok, err := s.app.StreamKeeper.AddDeposit(tCtx, newStream.StreamId, deposit)
 	s.Require().True(ok)
 	s.Require().NoError(err)
 
@@ -1269,7 +1318,7 @@ func (s *KeeperTestSuite) TestClaimFromStream_Success() {
 	tCtx = tCtx.WithBlockTime(future).WithBlockHeight(2)
 
 	// claim
-	amntClaimed, valFeeSent, totalClaim, remainingDeposit, err := s.app.StreamKeeper.ClaimFromStream(tCtx, s.addrs[1], s.addrs[0])
+	amntClaimed, valFeeSent, totalClaim, remainingDeposit, err := s.app.StreamKeeper.ClaimFromStream(tCtx, newStream.StreamId)
 	s.Require().NoError(err)
 	s.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(495)), amntClaimed, "amntClaimed")
 	s.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(5)), valFeeSent, "valFeeSent")
@@ -1324,7 +1373,7 @@ func (s *KeeperTestSuite) TestClaimFromStream_Success() {
 	s.Require().True(hasEvent)
 
 	// check stream in keeper
-	stream, ok := s.app.StreamKeeper.GetStream(tCtx, s.addrs[1], s.addrs[0])
+	stream, ok := s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 	s.Require().True(ok)
 	s.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(500)), stream.Deposit, "stream.Deposit")
 }
@@ -1446,33 +1495,37 @@ func (s *KeeperTestSuite) TestClaimFromStream_Scenarios() {
 
 			// set params
 			newParams := types.Params{
-				ValidatorFee: tc.valFee,
+				ValidatorFee:       tc.valFee,
+				FeeCollectorRatio:  types.DefaultFeeCollectorRatio,
+				CommunityPoolRatio: types.DefaultCommunityPoolRatio,
+				BurnRatio:          types.DefaultBurnRatio,
+				MaxClaimFeeRatio:   types.DefaultMaxClaimFeeRatio,
 			}
 
 			err := s.app.StreamKeeper.SetParams(tCtx, newParams)
 			s.Require().NoError(err, "SetParams NoError test name %s", tc.name)
 
 			// create
-			_, err = s.app.StreamKeeper.CreateNewStream(tCtx, tc.receiver, tc.sender, tc.deposit, tc.flowRate)
+			newStream, err := s.app.StreamKeeper.CreateNewStream(tCtx, tc.receiver, tc.sender, tc.deposit, tc.flowRate)
 
 			s.Require().NoError(err, "CreateNewStream NoError test name %s", tc.name)
 
 			// add initial deposit
 			if tc.deposit.IsPositive() {
-				ok, err := s.app.StreamKeeper.AddDeposit(tCtx, tc.receiver, tc.sender, tc.deposit)
+				ok, err := s.app.StreamKeeper.AddDeposit(tCtx, newStream.StreamId, tc.deposit)
 				s.Require().True(ok, "initialDeposit ok NoError test name %s", tc.name)
 				s.Require().NoError(err, "initialDeposit AddDeposit NoError test name %s", tc.name)
 			}
 
 			// check stream
-			stream, ok := s.app.StreamKeeper.GetStream(tCtx, tc.receiver, tc.sender)
+			stream, ok := s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 			s.Require().True(ok, "GetStream ok NoError test name %s", tc.name)
 
 			// set block time to now
 			tCtx = tCtx.WithBlockTime(nowTime).WithBlockHeight(2)
 
 			// claim
-			receiverAmount, valFee, claimTotal, remainingDeposit, err := s.app.StreamKeeper.ClaimFromStream(tCtx, tc.receiver, tc.sender)
+			receiverAmount, valFee, claimTotal, remainingDeposit, err := s.app.StreamKeeper.ClaimFromStream(tCtx, newStream.StreamId)
 			s.Require().NoError(err, "ClaimFromStream NoError test name %s", tc.name)
 			s.Require().Equal(tc.expReceiverAmount, receiverAmount, "ClaimFromStream receiverAmount test name %s", tc.name)
 			s.Require().Equal(tc.expValFee, valFee, "ClaimFromStream valFee test name %s", tc.name)
@@ -1530,7 +1583,7 @@ func (s *KeeperTestSuite) TestClaimFromStream_Scenarios() {
 			}
 
 			// check results
-			stream, ok = s.app.StreamKeeper.GetStream(tCtx, tc.receiver, tc.sender)
+			stream, ok = s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 			s.Require().True(ok, "GetStream ok NoError test name %s", tc.name)
 			s.Require().Equal(tc.expRemainDeposit, stream.Deposit, "tc.expRemainDeposit Equal stream.Deposit test name %s", tc.name)
 		})
@@ -1538,7 +1591,7 @@ func (s *KeeperTestSuite) TestClaimFromStream_Scenarios() {
 }
 
 func (s *KeeperTestSuite) TestClaimFromStream_Fail_NotExist() {
-	c, v, t, d, err := s.app.StreamKeeper.ClaimFromStream(s.ctx, s.addrs[1], s.addrs[0])
+	c, v, t, d, err := s.app.StreamKeeper.ClaimFromStream(s.ctx, 1)
 	s.Require().ErrorContains(err, "stream does not exist")
 	s.Require().Equal(sdk.Coin{}, c)
 	s.Require().Equal(sdk.Coin{}, v)
@@ -1546,7 +1599,7 @@ func (s *KeeperTestSuite) TestClaimFromStream_Fail_NotExist() {
 	s.Require().Equal(sdk.Coin{}, d)
 
 	// double check
-	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, s.addrs[1], s.addrs[0])
+	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, 1)
 	s.Require().False(ok)
 	s.Require().Equal(types.Stream{}, stream)
 }
@@ -1578,9 +1631,9 @@ func (s *KeeperTestSuite) TestClaimFromStream_Fail_NoDeposit() {
 
 	for _, tc := range testCases {
 		s.Run(tc.name, func() {
-			err := s.app.StreamKeeper.SetStream(s.ctx, tc.receiver, tc.sender, tc.stream)
+			streamID, err := s.setTestStream(s.ctx, tc.receiver, tc.sender, tc.stream)
 			s.Require().NoError(err, "SetStream NoError test name %s", tc.name)
-			c, v, t, d, err := s.app.StreamKeeper.ClaimFromStream(s.ctx, tc.receiver, tc.sender)
+			c, v, t, d, err := s.app.StreamKeeper.ClaimFromStream(s.ctx, streamID)
 			s.Require().ErrorContains(err, "stream deposit is zero")
 			s.Require().Equal(sdk.Coin{}, c)
 			s.Require().Equal(sdk.Coin{}, v)
@@ -1600,23 +1653,23 @@ func (s *KeeperTestSuite) TestClaimFromStream_Fail_Cancelled() {
 	sender := s.addrs[1]
 	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)
 
-	_, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiver, sender, deposit, 123)
+	newStream, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiver, sender, deposit, 123)
 	s.Require().NoError(err)
 
-	ok, err := s.app.StreamKeeper.AddDeposit(tCtx, receiver, sender, deposit)
+	ok, err := s.app.StreamKeeper.AddDeposit(tCtx, newStream.StreamId, deposit)
 	s.Require().NoError(err)
 	s.Require().True(ok)
 
-	_, ok = s.app.StreamKeeper.GetStream(tCtx, receiver, sender)
+	_, ok = s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 	s.Require().True(ok)
 
-	err = s.app.StreamKeeper.CancelStreamBySenderReceiver(tCtx, receiver, sender)
+	err = s.app.StreamKeeper.CancelAndRefundStream(tCtx, newStream.StreamId)
 	s.Require().NoError(err)
 
-	_, ok = s.app.StreamKeeper.GetStream(tCtx, receiver, sender)
+	_, ok = s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 	s.Require().False(ok)
 
-	receiverAmount, valFee, claimTotal, remainingDeposit, err := s.app.StreamKeeper.ClaimFromStream(tCtx, receiver, sender)
+	receiverAmount, valFee, claimTotal, remainingDeposit, err := s.app.StreamKeeper.ClaimFromStream(tCtx, newStream.StreamId)
 	s.Require().ErrorContains(err, "stream does not exist")
 	s.Require().Equal(sdk.Coin{}, receiverAmount)
 	s.Require().Equal(sdk.Coin{}, valFee)
@@ -1633,15 +1686,15 @@ func (s *KeeperTestSuite) TestCancelStreamBySenderReceiver_Success() {
 
 	// set validator fee
 	valFee := mathmod.LegacyNewDecWithPrec(1, 2)
-	_ = s.app.StreamKeeper.SetParams(tCtx, types.Params{ValidatorFee: valFee})
+	_ = s.app.StreamKeeper.SetParams(tCtx, types.Params{ValidatorFee: valFee, FeeCollectorRatio: types.DefaultFeeCollectorRatio, CommunityPoolRatio: types.DefaultCommunityPoolRatio, BurnRatio: types.DefaultBurnRatio, MaxClaimFeeRatio: types.DefaultMaxClaimFeeRatio})
 
 	deposit := sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(1000))
 
 	// create stream
-	_, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1)
+	newStream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1)
 	s.Require().NoError(err)
 	// add deposit
-	ok, err := s.app.StreamKeeper.AddDeposit(tCtx, s.addrs[1], s.addrs[0], deposit)
+	ok, err := s.app.StreamKeeper.AddDeposit(tCtx, newStream.StreamId, deposit)
 	s.Require().True(ok)
 	s.Require().NoError(err)
 
@@ -1650,7 +1703,7 @@ func (s *KeeperTestSuite) TestCancelStreamBySenderReceiver_Success() {
 	tCtx = tCtx.WithBlockTime(future).WithBlockHeight(2)
 
 	// claim
-	err = s.app.StreamKeeper.CancelStreamBySenderReceiver(tCtx, s.addrs[1], s.addrs[0])
+	err = s.app.StreamKeeper.CancelAndRefundStream(tCtx, newStream.StreamId)
 	s.Require().NoError(err)
 
 	// check event emission
@@ -1714,7 +1767,7 @@ func (s *KeeperTestSuite) TestCancelStreamBySenderReceiver_Success() {
 	s.Require().True(hasClaimEvent)
 
 	// check stream deleted from keeper
-	_, ok = s.app.StreamKeeper.GetStream(tCtx, s.addrs[1], s.addrs[0])
+	_, ok = s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 	s.Require().False(ok)
 }
 
@@ -1831,33 +1884,37 @@ func (s *KeeperTestSuite) TestCancelStreamBySenderReceiver_Scenarios() {
 
 			// set params
 			newParams := types.Params{
-				ValidatorFee: tc.valFee,
+				ValidatorFee:       tc.valFee,
+				FeeCollectorRatio:  types.DefaultFeeCollectorRatio,
+				CommunityPoolRatio: types.DefaultCommunityPoolRatio,
+				BurnRatio:          types.DefaultBurnRatio,
+				MaxClaimFeeRatio:   types.DefaultMaxClaimFeeRatio,
 			}
 
 			err := s.app.StreamKeeper.SetParams(tCtx, newParams)
 			s.Require().NoError(err, "SetParams NoError test name %s", tc.name)
 
 			// create
-			_, err = s.app.StreamKeeper.CreateNewStream(tCtx, tc.receiver, tc.sender, tc.deposit, tc.flowRate)
+			newStream, err := s.app.StreamKeeper.CreateNewStream(tCtx, tc.receiver, tc.sender, tc.deposit, tc.flowRate)
 
 			s.Require().NoError(err, "CreateNewStream NoError test name %s", tc.name)
 
 			// add initial deposit
 			if tc.deposit.IsPositive() {
-				ok, err := s.app.StreamKeeper.AddDeposit(tCtx, tc.receiver, tc.sender, tc.deposit)
+				ok, err := s.app.StreamKeeper.AddDeposit(tCtx, newStream.StreamId, tc.deposit)
 				s.Require().True(ok, "initialDeposit ok NoError test name %s", tc.name)
 				s.Require().NoError(err, "initialDeposit AddDeposit NoError test name %s", tc.name)
 			}
 
 			// check stream
-			stream, ok := s.app.StreamKeeper.GetStream(tCtx, tc.receiver, tc.sender)
+			stream, ok := s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 			s.Require().True(ok, "GetStream ok NoError test name %s", tc.name)
 
 			cancelTime := time.Unix(nowTime.Unix()+tc.cancelTimeOffset, 0).UTC()
 			tCtx = tCtx.WithBlockTime(cancelTime).WithBlockHeight(blockNum)
 
 			// cancel
-			err = s.app.StreamKeeper.CancelStreamBySenderReceiver(tCtx, tc.receiver, tc.sender)
+			err = s.app.StreamKeeper.CancelAndRefundStream(tCtx, newStream.StreamId)
 			s.Require().NoError(err)
 
 			events := tCtx.EventManager().Events()
@@ -1946,7 +2003,7 @@ func (s *KeeperTestSuite) TestCancelStreamBySenderReceiver_Scenarios() {
 			s.Require().True(hasCancelEvent)
 
 			// check stream deleted
-			stream, ok = s.app.StreamKeeper.GetStream(tCtx, tc.receiver, tc.sender)
+			stream, ok = s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 			s.Require().False(ok, "GetStream ok NoError test name %s", tc.name)
 			// should empty
 			s.Require().Equal(types.Stream{}, stream, "empty stream returned test name %s", tc.name)
@@ -1955,11 +2012,11 @@ func (s *KeeperTestSuite) TestCancelStreamBySenderReceiver_Scenarios() {
 }
 
 func (s *KeeperTestSuite) TestCancelStreamBySenderReceiver_Fail_NotExist() {
-	err := s.app.StreamKeeper.CancelStreamBySenderReceiver(s.ctx, s.addrs[1], s.addrs[0])
+	err := s.app.StreamKeeper.CancelAndRefundStream(s.ctx, 1)
 	s.Require().ErrorContains(err, "stream does not exist")
 
 	// double check
-	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, s.addrs[1], s.addrs[0])
+	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, 1)
 	s.Require().False(ok)
 	s.Require().Equal(types.Stream{}, stream)
 }
@@ -1975,15 +2032,18 @@ func (s *KeeperTestSuite) TestCancelStreamBySenderReceiver_Fail_NotCancellable()
 		Cancellable:     false,
 	}
 
-	err := s.app.StreamKeeper.SetStream(s.ctx, s.addrs[1], s.addrs[0], expStream)
+	streamID, err := s.setTestStream(s.ctx, s.addrs[1], s.addrs[0], expStream)
 	s.Require().NoError(err)
 
-	err = s.app.StreamKeeper.CancelStreamBySenderReceiver(s.ctx, s.addrs[1], s.addrs[0])
+	err = s.app.StreamKeeper.CancelAndRefundStream(s.ctx, streamID)
 	s.Require().ErrorContains(err, "cannot be cancelled")
 
 	// double check
-	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, s.addrs[1], s.addrs[0])
+	stream, ok := s.app.StreamKeeper.GetStream(s.ctx, streamID)
 	s.Require().True(ok)
+	expStream.StreamId = streamID
+	expStream.Receiver = s.addrs[1].String()
+	expStream.Sender = s.addrs[0].String()
 	s.Require().Equal(expStream, stream)
 }
 
@@ -1997,23 +2057,23 @@ func (s *KeeperTestSuite) TestCancelStreamBySenderReceiver_Fail_Cancelled() {
 	sender := s.addrs[1]
 	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)
 
-	_, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiver, sender, deposit, 123)
+	newStream, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiver, sender, deposit, 123)
 	s.Require().NoError(err)
 
-	ok, err := s.app.StreamKeeper.AddDeposit(tCtx, receiver, sender, deposit)
+	ok, err := s.app.StreamKeeper.AddDeposit(tCtx, newStream.StreamId, deposit)
 	s.Require().NoError(err)
 	s.Require().True(ok)
 
-	_, ok = s.app.StreamKeeper.GetStream(tCtx, receiver, sender)
+	_, ok = s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 	s.Require().True(ok)
 
-	err = s.app.StreamKeeper.CancelStreamBySenderReceiver(tCtx, receiver, sender)
+	err = s.app.StreamKeeper.CancelAndRefundStream(tCtx, newStream.StreamId)
 	s.Require().NoError(err)
 
-	_, ok = s.app.StreamKeeper.GetStream(tCtx, receiver, sender)
+	_, ok = s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
 	s.Require().False(ok)
 
-	err = s.app.StreamKeeper.CancelStreamBySenderReceiver(tCtx, receiver, sender)
+	err = s.app.StreamKeeper.CancelAndRefundStream(tCtx, newStream.StreamId)
 	s.Require().ErrorContains(err, "stream does not exist")
 }
 
@@ -2026,9 +2086,9 @@ func (s *KeeperTestSuite) TestGetTotalDeposits() {
 	// create and deposit
 	for i := int64(1); i <= 10; i++ {
 		deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000*i)
-		_, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[i-1], s.addrs[i], deposit, i)
+		stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[i-1], s.addrs[i], deposit, i)
 		s.Require().NoError(err)
-		ok, err := s.app.StreamKeeper.AddDeposit(tCtx, s.addrs[i-1], s.addrs[i], deposit)
+		ok, err := s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
 		s.Require().NoError(err)
 		s.Require().True(ok)
 	}
@@ -2043,7 +2103,7 @@ func (s *KeeperTestSuite) TestGetTotalDeposits() {
 
 	// claim - not expired
 	for i := int64(1); i <= 10; i++ {
-		_, _, _, _, err := s.app.StreamKeeper.ClaimFromStream(tCtx, s.addrs[i-1], s.addrs[i])
+		_, _, _, _, err := s.app.StreamKeeper.ClaimFromStream(tCtx, uint64(i))
 		s.Require().NoError(err)
 	}
 
@@ -2057,7 +2117,7 @@ func (s *KeeperTestSuite) TestGetTotalDeposits() {
 
 	// claim - expired
 	for i := int64(1); i <= 10; i++ {
-		_, _, _, _, err := s.app.StreamKeeper.ClaimFromStream(tCtx, s.addrs[i-1], s.addrs[i])
+		_, _, _, _, err := s.app.StreamKeeper.ClaimFromStream(tCtx, uint64(i))
 		s.Require().NoError(err)
 	}
 
@@ -2072,25 +2132,27 @@ func (s *KeeperTestSuite) TestIterateAllStreams() {
 	nowTime := time.Unix(time.Now().Unix(), 0).UTC()
 	tCtx = tCtx.WithBlockTime(nowTime)
 
-	streams := map[string]map[string]types.Stream{}
+	streams := map[uint64]types.Stream{}
 
 	for i := int64(1); i < 100; i++ {
 		deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000*i)
-		_, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[i-1], s.addrs[i], deposit, i)
+		newStream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[i-1], s.addrs[i], deposit, i)
 		s.Require().NoError(err)
-		stream, _ := s.app.StreamKeeper.GetStream(tCtx, s.addrs[i-1], s.addrs[i])
-		streams[s.addrs[i-1].String()] = map[string]types.Stream{}
-		streams[s.addrs[i-1].String()][s.addrs[i].String()] = stream
+		stream, _ := s.app.StreamKeeper.GetStream(tCtx, newStream.StreamId)
+		streams[stream.StreamId] = stream
 	}
 
-	s.app.StreamKeeper.IterateAllStreams(tCtx, func(receiverAddr, senderAddr sdk.AccAddress, stream types.Stream) bool {
+	count := 0
+	s.app.StreamKeeper.IterateAllStreams(tCtx, func(stream types.Stream) bool {
 
-		expectedStream, exists := streams[receiverAddr.String()][senderAddr.String()]
+		expectedStream, exists := streams[stream.StreamId]
 		s.Require().True(exists)
 		s.Require().Equal(expectedStream, stream)
+		count++
 
 		return false
 	})
+	s.Require().Equal(len(streams), count)
 }
 
 func (s *KeeperTestSuite) TestMultipleDenoms() {
@@ -2100,6 +2162,7 @@ func (s *KeeperTestSuite) TestMultipleDenoms() {
 	nowTime := time.Unix(time.Now().Unix(), 0).UTC()
 	tCtx = tCtx.WithBlockTime(nowTime).WithBlockHeight(1)
 	totalDeposits := sdk.NewCoins()
+	streamIDs := make([]uint64, 0, len(newAccs)-1)
 
 	for i := 0; i < len(newAccs)-1; i += 1 {
 		sender := newAccs[i]
@@ -2109,27 +2172,26 @@ func (s *KeeperTestSuite) TestMultipleDenoms() {
 			denom = "testdenom"
 		}
 		deposit := sdk.NewInt64Coin(denom, 100000)
-		_, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiver, sender, deposit, 123)
+		stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiver, sender, deposit, 123)
 		s.Require().NoError(err)
 
-		_, err = s.app.StreamKeeper.AddDeposit(tCtx, receiver, sender, deposit)
+		_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
 		s.Require().NoError(err)
+		streamIDs = append(streamIDs, stream.StreamId)
 	}
 
 	claimTime := time.Unix(nowTime.Unix()+500, 0).UTC()
 	tCtx = tCtx.WithBlockTime(claimTime).WithBlockHeight(2)
 
-	for i := 0; i < len(newAccs)-1; i += 1 {
-		sender := newAccs[i]
-		receiver := newAccs[i+1]
-		_, _, _, _, err := s.app.StreamKeeper.ClaimFromStream(tCtx, receiver, sender)
+	for _, streamID := range streamIDs {
+		_, _, _, _, err := s.app.StreamKeeper.ClaimFromStream(tCtx, streamID)
 		s.Require().NoError(err)
 	}
 
 	future := time.Unix(nowTime.Unix()+1000, 0).UTC()
 	tCtx = tCtx.WithBlockTime(future).WithBlockHeight(3)
 
-	s.app.StreamKeeper.IterateAllStreams(tCtx, func(receiverAddr, senderAddr sdk.AccAddress, stream types.Stream) bool {
+	s.app.StreamKeeper.IterateAllStreams(tCtx, func(stream types.Stream) bool {
 		totalDeposits = totalDeposits.Add(stream.Deposit)
 		return false
 	})
@@ -2137,3 +2199,896 @@ func (s *KeeperTestSuite) TestMultipleDenoms() {
 	mAccTotal := s.app.StreamKeeper.GetStreamModuleAccountBalances(tCtx)
 	s.Require().Equal(totalDeposits, mAccTotal)
 }
+
+func (s *KeeperTestSuite) TestStreamPerDenom() {
+	newAccs := simapphelpers.AddTestAddrsWithExtraNonBondCoin(s.app, s.ctx, 2, mathmod.NewIntFromUint64(10000000), sdk.NewInt64Coin("testdenom", 1000000))
+	sender := newAccs[0]
+	receiver := newAccs[1]
+
+	tCtx := s.ctx
+	nowTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx = tCtx.WithBlockTime(nowTime)
+
+	deposits := []sdk.Coin{
+		sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000),
+		sdk.NewInt64Coin("testdenom", 200000),
+	}
+
+	streamIDs := make([]uint64, 0, len(deposits))
+	for _, deposit := range deposits {
+		stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiver, sender, deposit, 10)
+		s.Require().NoError(err)
+		_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+		s.Require().NoError(err)
+		streamIDs = append(streamIDs, stream.StreamId)
+	}
+
+	for i, deposit := range deposits {
+		stream, ok := s.app.StreamKeeper.GetStream(tCtx, streamIDs[i])
+		s.Require().True(ok)
+		s.Require().Equal(deposit, stream.Deposit)
+		s.Require().True(s.app.StreamKeeper.IsInStreamExpiryQueue(tCtx, streamIDs[i], stream.DepositZeroTime))
+	}
+
+	streams := s.app.StreamKeeper.GetStreamsForReceiverSender(tCtx, receiver, sender)
+	s.Require().Len(streams, 2)
+	s.Require().Equal(sdk.NewCoins(deposits...), s.app.StreamKeeper.GetTotalDeposits(tCtx))
+
+	// denom or stream id is required when the pair has more than one stream
+	_, err := s.app.StreamKeeper.ResolveStreamID(tCtx, receiver, sender, "")
+	s.Require().ErrorContains(err, "denom or stream id required")
+
+	streamID, err := s.app.StreamKeeper.ResolveStreamID(tCtx, receiver, sender, "testdenom")
+	s.Require().NoError(err)
+	s.Require().Equal(streamIDs[1], streamID)
+
+	_, err = s.app.StreamKeeper.ResolveStreamID(tCtx, receiver, sender, "notstake")
+	s.Require().ErrorContains(err, "stream not found")
+
+	// deposit denom must match the stream's denom
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, streamIDs[0], deposits[1])
+	s.Require().ErrorContains(err, "does not match stream denom")
+
+	// claiming from one stream does not affect the other
+	tCtx = tCtx.WithBlockTime(nowTime.Add(time.Second * 100))
+	_, _, claimTotal, _, err := s.app.StreamKeeper.ClaimFromStream(tCtx, streamIDs[1])
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("testdenom", 1000), claimTotal)
+
+	stream, _ := s.app.StreamKeeper.GetStream(tCtx, streamIDs[0])
+	s.Require().Equal(deposits[0], stream.Deposit)
+
+	// cancelling one stream leaves the other intact, and the remaining stream is used if no denom given
+	err = s.app.StreamKeeper.CancelAndRefundStream(tCtx, streamIDs[0])
+	s.Require().NoError(err)
+	s.Require().False(s.app.StreamKeeper.IsStream(tCtx, streamIDs[0]))
+	s.Require().True(s.app.StreamKeeper.IsStream(tCtx, streamIDs[1]))
+
+	streamID, err = s.app.StreamKeeper.ResolveStreamID(tCtx, receiver, sender, "")
+	s.Require().NoError(err)
+	s.Require().Equal(streamIDs[1], streamID)
+}
+
+func (s *KeeperTestSuite) TestMultipleStreamsSameDenom() {
+	tCtx := s.ctx
+	nowTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx = tCtx.WithBlockTime(nowTime)
+
+	receiver := s.addrs[1]
+	sender := s.addrs[0]
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+
+	stream1, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiver, sender, deposit, 1)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream1.StreamId, deposit)
+	s.Require().NoError(err)
+
+	stream2, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiver, sender, deposit, 2)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream2.StreamId, deposit)
+	s.Require().NoError(err)
+
+	// denom alone cannot identify the stream
+	_, err = s.app.StreamKeeper.ResolveStreamID(tCtx, receiver, sender, sdk.DefaultBondDenom)
+	s.Require().ErrorContains(err, "denom or stream id required")
+
+	// streams flow independently
+	tCtx = tCtx.WithBlockTime(nowTime.Add(time.Second * 100))
+	_, _, claimTotal1, _, err := s.app.StreamKeeper.ClaimFromStream(tCtx, stream1.StreamId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), claimTotal1)
+
+	_, _, claimTotal2, _, err := s.app.StreamKeeper.ClaimFromStream(tCtx, stream2.StreamId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200), claimTotal2)
+
+	err = s.app.StreamKeeper.SetNewFlowRate(tCtx, stream1.StreamId, 10)
+	s.Require().NoError(err)
+
+	stream, _ := s.app.StreamKeeper.GetStream(tCtx, stream2.StreamId)
+	s.Require().Equal(int64(2), stream.FlowRate)
+}
+
+func (s *KeeperTestSuite) TestCreateNewScheduledStream() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	startTime := blockTime.Add(time.Second * 100)
+	cliffTime := blockTime.Add(time.Second * 200)
+	endTime := blockTime.Add(time.Second * 2000)
+
+	stream, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1, startTime, cliffTime, endTime, false)
+	s.Require().NoError(err)
+	s.Require().Equal(startTime, stream.StartTime)
+	s.Require().Equal(startTime, stream.LastOutflowTime)
+	s.Require().Equal(cliffTime, stream.CliffTime)
+	s.Require().Equal(endTime, stream.EndTime)
+
+	// deposit starts flowing from the start time
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, stream.StreamId)
+	s.Require().Equal(startTime.Add(time.Second*1000), stream.DepositZeroTime)
+
+	// start time in the past defaults to the current block time
+	stream, err = s.app.StreamKeeper.CreateNewScheduledStream(tCtx, s.addrs[2], s.addrs[0], deposit, 1, blockTime.Add(-time.Hour), time.Time{}, time.Time{}, false)
+	s.Require().NoError(err)
+	s.Require().Equal(blockTime, stream.StartTime)
+	s.Require().True(stream.CliffTime.IsZero())
+	s.Require().True(stream.EndTime.IsZero())
+}
+
+func (s *KeeperTestSuite) TestClaimFromStream_Schedule() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	startTime := blockTime.Add(time.Second * 100)
+	cliffTime := blockTime.Add(time.Second * 200)
+
+	stream, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1, startTime, cliffTime, time.Time{}, false)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+
+	// pending
+	_, _, _, _, err = s.app.StreamKeeper.ClaimFromStream(tCtx.WithBlockTime(blockTime.Add(time.Second*50)), stream.StreamId)
+	s.Require().ErrorContains(err, "nothing can be claimed from stream until")
+
+	// before cliff
+	_, _, _, _, err = s.app.StreamKeeper.ClaimFromStream(tCtx.WithBlockTime(blockTime.Add(time.Second*150)), stream.StreamId)
+	s.Require().ErrorContains(err, "nothing can be claimed from stream until")
+
+	// after cliff, everything accrued since the start time can be claimed
+	_, _, claimTotal, remainingDeposit, err := s.app.StreamKeeper.ClaimFromStream(tCtx.WithBlockTime(blockTime.Add(time.Second*250)), stream.StreamId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150), claimTotal)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 850), remainingDeposit)
+}
+
+func (s *KeeperTestSuite) TestAddDeposit_EndTime() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	endTime := blockTime.Add(time.Second * 1500)
+
+	stream, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1, time.Time{}, time.Time{}, endTime, false)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, stream.StreamId)
+	s.Require().Equal(blockTime.Add(time.Second*1000), stream.DepositZeroTime)
+
+	// top up beyond the end time - deposit zero time is capped
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, stream.StreamId)
+	s.Require().Equal(endTime, stream.DepositZeroTime)
+
+	// cannot top up once ended
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx.WithBlockTime(endTime), stream.StreamId, deposit)
+	s.Require().ErrorContains(err, "stream ended at")
+
+	// cannot change flow rate once ended
+	err = s.app.StreamKeeper.SetNewFlowRate(tCtx.WithBlockTime(endTime), stream.StreamId, 2)
+	s.Require().ErrorContains(err, "stream ended at")
+}
+
+func (s *KeeperTestSuite) TestClaimFromReceiverStreams() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	receiver := s.addrs[0]
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+
+	// 5 streams to the receiver from different senders, plus one not yet started
+	streamIDs := make(map[uint64]bool)
+	for i := 1; i <= 5; i++ {
+		stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiver, s.addrs[i], deposit, 1)
+		s.Require().NoError(err)
+		_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+		s.Require().NoError(err)
+		streamIDs[stream.StreamId] = true
+	}
+	pending, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, receiver, s.addrs[6], deposit, 1, blockTime.Add(time.Hour), time.Time{}, time.Time{}, false)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, pending.StreamId, deposit)
+	s.Require().NoError(err)
+
+	// stream to another receiver is never visited
+	other, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[7], s.addrs[1], deposit, 1)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, other.StreamId, deposit)
+	s.Require().NoError(err)
+
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Second * 100))
+
+	// page through 2 at a time
+	claimed := make(map[uint64]bool)
+	var startKey []byte
+	numPages := 0
+	for {
+		results, nextKey, err := s.app.StreamKeeper.ClaimFromReceiverStreams(tCtx, receiver, nil, startKey, 2)
+		s.Require().NoError(err)
+		numPages++
+		for _, res := range results {
+			s.Require().False(claimed[res.StreamId], "stream claimed twice")
+			claimed[res.StreamId] = true
+			s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), res.TotalClaimed)
+			s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 900), res.RemainingDeposit)
+		}
+		if nextKey == nil {
+			break
+		}
+		startKey = nextKey
+	}
+
+	// 6 streams visited, the pending stream is skipped
+	s.Require().Equal(3, numPages)
+	s.Require().Equal(streamIDs, claimed)
+
+	otherStream, _ := s.app.StreamKeeper.GetStream(tCtx, other.StreamId)
+	s.Require().Equal(deposit, otherStream.Deposit)
+
+	// filter by sender
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Second * 200))
+	results, nextKey, err := s.app.StreamKeeper.ClaimFromReceiverStreams(tCtx, receiver, []sdk.AccAddress{s.addrs[3], s.addrs[1]}, nil, 10)
+	s.Require().NoError(err)
+	s.Require().Nil(nextKey)
+	s.Require().Len(results, 2)
+	for _, res := range results {
+		s.Require().Contains([]string{s.addrs[1].String(), s.addrs[3].String()}, res.Sender)
+		s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), res.TotalClaimed)
+	}
+
+	// duplicate senders are only visited once
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Second * 300))
+	results, nextKey, err = s.app.StreamKeeper.ClaimFromReceiverStreams(tCtx, receiver, []sdk.AccAddress{s.addrs[2], s.addrs[4], s.addrs[2], s.addrs[2]}, nil, 10)
+	s.Require().NoError(err)
+	s.Require().Nil(nextKey)
+	s.Require().Len(results, 2)
+	s.Require().NotEqual(results[0].StreamId, results[1].StreamId)
+	for _, res := range results {
+		s.Require().Contains([]string{s.addrs[2].String(), s.addrs[4].String()}, res.Sender)
+		s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200), res.TotalClaimed)
+	}
+
+	// duplicates do not count towards the limit
+	results, nextKey, err = s.app.StreamKeeper.ClaimFromReceiverStreams(tCtx, receiver, []sdk.AccAddress{s.addrs[5], s.addrs[5]}, nil, 1)
+	s.Require().NoError(err)
+	s.Require().Nil(nextKey)
+	s.Require().Len(results, 1)
+
+	// start key must belong to the receiver
+	_, _, err = s.app.StreamKeeper.ClaimFromReceiverStreams(tCtx, receiver, nil, types.GetStreamsByReceiverKey(s.addrs[7]), 10)
+	s.Require().ErrorContains(err, "invalid start key for receiver")
+}
+
+func (s *KeeperTestSuite) TestReassignStreamReceiver() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	sender := s.addrs[0]
+	oldReceiver := s.addrs[1]
+	newReceiver := s.addrs[2]
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+
+	stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, oldReceiver, sender, deposit, 1)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Second * 100))
+
+	// fails
+	_, _, _, _, err = s.app.StreamKeeper.ReassignStreamReceiver(tCtx, 99, newReceiver)
+	s.Require().ErrorContains(err, "stream does not exist")
+	_, _, _, _, err = s.app.StreamKeeper.ReassignStreamReceiver(tCtx, stream.StreamId, oldReceiver)
+	s.Require().ErrorContains(err, "new receiver is already the stream receiver")
+	_, _, _, _, err = s.app.StreamKeeper.ReassignStreamReceiver(tCtx, stream.StreamId, sender)
+	s.Require().ErrorContains(err, "sender and receiver cannot be same address")
+
+	oldReceiverBalBefore := s.app.BankKeeper.GetBalance(tCtx, oldReceiver, sdk.DefaultBondDenom)
+
+	receiverAmount, valFee, claimTotal, remainingDeposit, err := s.app.StreamKeeper.ReassignStreamReceiver(tCtx, stream.StreamId, newReceiver)
+	s.Require().NoError(err)
+	s.Require().Equal(claimTotal, receiverAmount.Add(valFee))
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), claimTotal)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 900), remainingDeposit)
+
+	// accrued amount settled to the old receiver
+	oldReceiverBalAfter := s.app.BankKeeper.GetBalance(tCtx, oldReceiver, sdk.DefaultBondDenom)
+	s.Require().Equal(oldReceiverBalBefore.Add(receiverAmount), oldReceiverBalAfter)
+
+	// re-keyed under the new receiver
+	transferred, ok := s.app.StreamKeeper.GetStream(tCtx, stream.StreamId)
+	s.Require().True(ok)
+	s.Require().Equal(newReceiver.String(), transferred.Receiver)
+	s.Require().Equal(sender.String(), transferred.Sender)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 900), transferred.Deposit)
+	s.Require().Equal(blockTime.Add(time.Second*1000), transferred.DepositZeroTime)
+	s.Require().Len(s.app.StreamKeeper.GetStreamsForReceiverSender(tCtx, oldReceiver, sender), 0)
+	s.Require().Len(s.app.StreamKeeper.GetStreamsForReceiverSender(tCtx, newReceiver, sender), 1)
+	s.Require().True(s.app.StreamKeeper.IsInStreamExpiryQueue(tCtx, stream.StreamId, transferred.DepositZeroTime))
+
+	hasEvent := false
+	for _, ev := range tCtx.EventManager().Events() {
+		if ev.Type == types.EventTypeStreamTransferred {
+			hasEvent = true
+			attrOld, ok := ev.GetAttribute(types.AttributeKeyOldReceiver)
+			s.Require().True(ok)
+			s.Require().Equal(oldReceiver.String(), attrOld.Value)
+			attrNew, ok := ev.GetAttribute(types.AttributeKeyNewReceiver)
+			s.Require().True(ok)
+			s.Require().Equal(newReceiver.String(), attrNew.Value)
+		}
+	}
+	s.Require().True(hasEvent)
+
+	// new receiver claims from the transfer onwards
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Second * 150))
+	_, _, claimTotal, _, err = s.app.StreamKeeper.ClaimFromStream(tCtx, stream.StreamId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), claimTotal)
+}
+
+func (s *KeeperTestSuite) TestReassignStreamReceiver_BeforeCliff() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+
+	stream, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1, time.Time{}, blockTime.Add(time.Second*200), time.Time{}, false)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+
+	// nothing claimable yet, so nothing is settled and the accrued amount moves with the stream
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Second * 100))
+	_, _, claimTotal, remainingDeposit, err := s.app.StreamKeeper.ReassignStreamReceiver(tCtx, stream.StreamId, s.addrs[2])
+	s.Require().NoError(err)
+	s.Require().True(claimTotal.IsZero())
+	s.Require().Equal(deposit, remainingDeposit)
+
+	tCtx = tCtx.WithBlockTime(blockTime.Add(time.Second * 200))
+	_, _, claimTotal, _, err = s.app.StreamKeeper.ClaimFromStream(tCtx, stream.StreamId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200), claimTotal)
+}
+
+func (s *KeeperTestSuite) TestPauseResumeStreamFlow() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+
+	stream, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1, time.Time{}, time.Time{}, time.Time{}, true)
+	s.Require().NoError(err)
+	s.Require().True(stream.Pausable)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+	s.Require().NoError(s.app.StreamKeeper.AcceptPausable(tCtx, stream.StreamId))
+
+	// pausing claims everything accrued so far
+	pauseTime := blockTime.Add(time.Second * 100)
+	pausedAt, err := s.app.StreamKeeper.PauseStreamFlow(tCtx.WithBlockTime(pauseTime), stream.StreamId)
+	s.Require().NoError(err)
+	s.Require().Equal(pauseTime, pausedAt)
+
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, stream.StreamId)
+	s.Require().Equal(pauseTime, stream.PausedAt)
+	s.Require().Equal(pauseTime, stream.LastOutflowTime)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 900), stream.Deposit)
+	s.Require().Equal(types.StreamStatusPaused, stream.StatusAt(pauseTime.Add(time.Second*10)))
+
+	// paused streams are not in the expiry queue
+	s.Require().False(s.app.StreamKeeper.IsInStreamExpiryQueue(tCtx, stream.StreamId, stream.DepositZeroTime))
+
+	// cannot pause twice, or update the flow rate while paused
+	_, err = s.app.StreamKeeper.PauseStreamFlow(tCtx.WithBlockTime(pauseTime.Add(time.Second*10)), stream.StreamId)
+	s.Require().ErrorIs(err, types.ErrStreamPaused)
+	err = s.app.StreamKeeper.SetNewFlowRate(tCtx.WithBlockTime(pauseTime.Add(time.Second*10)), stream.StreamId, 2)
+	s.Require().ErrorIs(err, types.ErrStreamPaused)
+
+	// nothing accrues while paused, even beyond the original deposit zero time
+	_, _, claimTotal, remainingDeposit, err := s.app.StreamKeeper.ClaimFromStream(tCtx.WithBlockTime(blockTime.Add(time.Second*2000)), stream.StreamId)
+	s.Require().NoError(err)
+	s.Require().True(claimTotal.IsZero())
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 900), remainingDeposit)
+
+	// top ups are allowed while paused
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx.WithBlockTime(blockTime.Add(time.Second*2000)), stream.StreamId, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	s.Require().NoError(err)
+
+	// resuming shifts the last outflow and deposit zero times forward by the paused duration
+	resumeTime := blockTime.Add(time.Second * 3000)
+	depositZeroTime, err := s.app.StreamKeeper.ResumeStreamFlow(tCtx.WithBlockTime(resumeTime), stream.StreamId)
+	s.Require().NoError(err)
+	s.Require().Equal(resumeTime.Add(time.Second*1000), depositZeroTime)
+
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, stream.StreamId)
+	s.Require().False(stream.IsPaused())
+	s.Require().Equal(resumeTime, stream.LastOutflowTime)
+	s.Require().Equal(depositZeroTime, stream.DepositZeroTime)
+	s.Require().True(s.app.StreamKeeper.IsInStreamExpiryQueue(tCtx, stream.StreamId, depositZeroTime))
+
+	_, err = s.app.StreamKeeper.ResumeStreamFlow(tCtx.WithBlockTime(resumeTime), stream.StreamId)
+	s.Require().ErrorIs(err, types.ErrStreamNotPaused)
+
+	_, _, claimTotal, _, err = s.app.StreamKeeper.ClaimFromStream(tCtx.WithBlockTime(resumeTime.Add(time.Second*50)), stream.StreamId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50), claimTotal)
+}
+
+func (s *KeeperTestSuite) TestPauseStreamFlow_Errors() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+
+	_, err := s.app.StreamKeeper.PauseStreamFlow(tCtx, 99)
+	s.Require().ErrorIs(err, types.ErrStreamDoesNotExist)
+
+	notPausable, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, notPausable.StreamId, deposit)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.PauseStreamFlow(tCtx, notPausable.StreamId)
+	s.Require().ErrorIs(err, types.ErrStreamNotPausable)
+
+	pending, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, s.addrs[2], s.addrs[0], deposit, 1, blockTime.Add(time.Hour), time.Time{}, time.Time{}, true)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, pending.StreamId, deposit)
+	s.Require().NoError(err)
+	s.Require().NoError(s.app.StreamKeeper.AcceptPausable(tCtx, pending.StreamId))
+	_, err = s.app.StreamKeeper.PauseStreamFlow(tCtx, pending.StreamId)
+	s.Require().ErrorContains(err, "stream has not started")
+
+	depleted, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, s.addrs[3], s.addrs[0], deposit, 1, time.Time{}, time.Time{}, time.Time{}, true)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, depleted.StreamId, deposit)
+	s.Require().NoError(err)
+	s.Require().NoError(s.app.StreamKeeper.AcceptPausable(tCtx, depleted.StreamId))
+	_, err = s.app.StreamKeeper.PauseStreamFlow(tCtx.WithBlockTime(blockTime.Add(time.Second*1000)), depleted.StreamId)
+	s.Require().ErrorContains(err, "stream deposit has run out")
+}
+
+func (s *KeeperTestSuite) TestAcceptPausable() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+
+	s.Require().ErrorIs(s.app.StreamKeeper.AcceptPausable(tCtx, 99), types.ErrStreamDoesNotExist)
+
+	notPausable, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1)
+	s.Require().NoError(err)
+	s.Require().ErrorIs(s.app.StreamKeeper.AcceptPausable(tCtx, notPausable.StreamId), types.ErrStreamNotPausable)
+
+	stream, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, s.addrs[2], s.addrs[0], deposit, 1, time.Time{}, time.Time{}, time.Time{}, true)
+	s.Require().NoError(err)
+	s.Require().False(stream.PausableAccepted)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+
+	// the sender cannot pause until the receiver has accepted
+	_, err = s.app.StreamKeeper.PauseStreamFlow(tCtx.WithBlockTime(blockTime.Add(time.Second*10)), stream.StreamId)
+	s.Require().ErrorContains(err, "receiver has not accepted")
+
+	s.Require().NoError(s.app.StreamKeeper.AcceptPausable(tCtx, stream.StreamId))
+	s.Require().ErrorIs(s.app.StreamKeeper.AcceptPausable(tCtx, stream.StreamId), types.ErrInvalidData)
+
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, stream.StreamId)
+	s.Require().True(stream.PausableAccepted)
+
+	// a new receiver must accept again
+	_, _, _, _, err = s.app.StreamKeeper.ReassignStreamReceiver(tCtx.WithBlockTime(blockTime.Add(time.Second*10)), stream.StreamId, s.addrs[3])
+	s.Require().NoError(err)
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, stream.StreamId)
+	s.Require().False(stream.PausableAccepted)
+
+	_, err = s.app.StreamKeeper.PauseStreamFlow(tCtx.WithBlockTime(blockTime.Add(time.Second*20)), stream.StreamId)
+	s.Require().ErrorContains(err, "receiver has not accepted")
+
+	s.Require().NoError(s.app.StreamKeeper.AcceptPausable(tCtx, stream.StreamId))
+	_, err = s.app.StreamKeeper.PauseStreamFlow(tCtx.WithBlockTime(blockTime.Add(time.Second*20)), stream.StreamId)
+	s.Require().NoError(err)
+}
+
+func (s *KeeperTestSuite) TestPauseStreamFlow_BeforeCliff() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	cliffTime := blockTime.Add(time.Second * 200)
+
+	stream, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1, time.Time{}, cliffTime, time.Time{}, true)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+	s.Require().NoError(s.app.StreamKeeper.AcceptPausable(tCtx, stream.StreamId))
+
+	// nothing is claimed when pausing before the cliff
+	_, err = s.app.StreamKeeper.PauseStreamFlow(tCtx.WithBlockTime(blockTime.Add(time.Second*100)), stream.StreamId)
+	s.Require().NoError(err)
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, stream.StreamId)
+	s.Require().Equal(deposit, stream.Deposit)
+
+	// once the cliff is reached, payments accrued before the pause can be claimed
+	_, _, claimTotal, remainingDeposit, err := s.app.StreamKeeper.ClaimFromStream(tCtx.WithBlockTime(blockTime.Add(time.Second*300)), stream.StreamId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100), claimTotal)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 900), remainingDeposit)
+}
+
+func (s *KeeperTestSuite) TestRequestTermination_Settled() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	params := s.app.StreamKeeper.GetParams(tCtx)
+	params.MinTerminationNotice = 1000
+	s.Require().NoError(s.app.StreamKeeper.SetParams(tCtx, params))
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)
+
+	stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+
+	// streams that cannot be cancelled can still be terminated with notice
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, stream.StreamId)
+	stream.Cancellable = false
+	s.Require().NoError(s.app.StreamKeeper.SetStream(tCtx, stream))
+
+	requestCtx := tCtx.WithBlockTime(blockTime.Add(time.Second * 100))
+	_, err = s.app.StreamKeeper.RequestTermination(requestCtx, stream.StreamId, 999)
+	s.Require().ErrorContains(err, "termination notice too short. Must be at least 1000 seconds")
+
+	terminationTime, err := s.app.StreamKeeper.RequestTermination(requestCtx, stream.StreamId, 1000)
+	s.Require().NoError(err)
+	s.Require().Equal(blockTime.Add(time.Second*1100), terminationTime)
+
+	stream, _ = s.app.StreamKeeper.GetStream(tCtx, stream.StreamId)
+	s.Require().Equal(terminationTime, stream.TerminationTime)
+	s.Require().Equal(terminationTime, stream.EndTime)
+	s.Require().Equal(terminationTime, stream.DepositZeroTime)
+	s.Require().True(s.app.StreamKeeper.IsInStreamExpiryQueue(tCtx, stream.StreamId, terminationTime))
+
+	_, err = s.app.StreamKeeper.RequestTermination(requestCtx, stream.StreamId, 1000)
+	s.Require().ErrorContains(err, "termination already requested")
+
+	// the stream keeps flowing until the notice period has passed
+	tCtx = tCtx.WithBlockTime(terminationTime.Add(-time.Second))
+	s.app.StreamKeeper.SettleDepletedStreams(tCtx)
+	s.Require().True(s.app.StreamKeeper.IsStream(tCtx, stream.StreamId))
+
+	// then it is settled, and the remaining deposit refunded to the sender
+	senderBalBefore := s.app.BankKeeper.GetBalance(tCtx, s.addrs[0], sdk.DefaultBondDenom)
+	tCtx = tCtx.WithBlockTime(terminationTime)
+	s.app.StreamKeeper.SettleDepletedStreams(tCtx)
+	s.Require().False(s.app.StreamKeeper.IsStream(tCtx, stream.StreamId))
+
+	senderBalAfter := s.app.BankKeeper.GetBalance(tCtx, s.addrs[0], sdk.DefaultBondDenom)
+	s.Require().Equal(senderBalBefore.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 8900)), senderBalAfter)
+}
+
+func (s *KeeperTestSuite) TestRequestTermination_Paused() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	params := s.app.StreamKeeper.GetParams(tCtx)
+	params.MinTerminationNotice = 1000
+	s.Require().NoError(s.app.StreamKeeper.SetParams(tCtx, params))
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)
+
+	stream, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1, time.Time{}, time.Time{}, time.Time{}, true)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+	s.Require().NoError(s.app.StreamKeeper.AcceptPausable(tCtx, stream.StreamId))
+
+	_, err = s.app.StreamKeeper.PauseStreamFlow(tCtx.WithBlockTime(blockTime.Add(time.Second*100)), stream.StreamId)
+	s.Require().NoError(err)
+
+	// paused streams are queued for settlement at the termination time
+	terminationTime, err := s.app.StreamKeeper.RequestTermination(tCtx.WithBlockTime(blockTime.Add(time.Second*200)), stream.StreamId, 1000)
+	s.Require().NoError(err)
+	s.Require().True(s.app.StreamKeeper.IsInStreamExpiryQueue(tCtx, stream.StreamId, terminationTime))
+
+	// resuming does not extend the stream beyond the termination time
+	depositZeroTime, err := s.app.StreamKeeper.ResumeStreamFlow(tCtx.WithBlockTime(blockTime.Add(time.Second*500)), stream.StreamId)
+	s.Require().NoError(err)
+	s.Require().Equal(terminationTime, depositZeroTime)
+
+	senderBalBefore := s.app.BankKeeper.GetBalance(tCtx, s.addrs[0], sdk.DefaultBondDenom)
+	tCtx = tCtx.WithBlockTime(terminationTime)
+	s.app.StreamKeeper.SettleDepletedStreams(tCtx)
+	s.Require().False(s.app.StreamKeeper.IsStream(tCtx, stream.StreamId))
+
+	// 100 seconds flowed before the pause, and 700 after it
+	senderBalAfter := s.app.BankKeeper.GetBalance(tCtx, s.addrs[0], sdk.DefaultBondDenom)
+	s.Require().Equal(senderBalBefore.Add(sdk.NewInt64Coin(sdk.DefaultBondDenom, 9200)), senderBalAfter)
+}
+
+func (s *KeeperTestSuite) TestRequestTermination_Errors() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	params := s.app.StreamKeeper.GetParams(tCtx)
+	params.MinTerminationNotice = 1000
+	s.Require().NoError(s.app.StreamKeeper.SetParams(tCtx, params))
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)
+
+	_, err := s.app.StreamKeeper.RequestTermination(tCtx, 99, 1000)
+	s.Require().ErrorIs(err, types.ErrStreamDoesNotExist)
+
+	endsFirst, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1, time.Time{}, time.Time{}, blockTime.Add(time.Second*1000), false)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, endsFirst.StreamId, deposit)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.RequestTermination(tCtx, endsFirst.StreamId, 1000)
+	s.Require().ErrorContains(err, "before the notice period has passed")
+	_, err = s.app.StreamKeeper.RequestTermination(tCtx.WithBlockTime(blockTime.Add(time.Second*1000)), endsFirst.StreamId, 1000)
+	s.Require().ErrorContains(err, "stream ended at")
+
+	cliff, err := s.app.StreamKeeper.CreateNewScheduledStream(tCtx, s.addrs[2], s.addrs[0], deposit, 1, time.Time{}, blockTime.Add(time.Second*2000), time.Time{}, false)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, cliff.StreamId, deposit)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.RequestTermination(tCtx, cliff.StreamId, 1000)
+	s.Require().ErrorContains(err, "notice period cannot end before the stream's cliff time")
+	_, err = s.app.StreamKeeper.RequestTermination(tCtx, cliff.StreamId, 2000)
+	s.Require().NoError(err)
+
+	tooLong, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[3], s.addrs[0], deposit, 1)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, tooLong.StreamId, deposit)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.RequestTermination(tCtx, tooLong.StreamId, types.MaxTerminationNotice+1)
+	s.Require().ErrorContains(err, "termination notice cannot be more than")
+}
+
+func (s *KeeperTestSuite) TestAcceptTermination() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	params := s.app.StreamKeeper.GetParams(tCtx)
+	params.MinTerminationNotice = 1000
+	s.Require().NoError(s.app.StreamKeeper.SetParams(tCtx, params))
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000)
+
+	_, err := s.app.StreamKeeper.AcceptTermination(tCtx, 99)
+	s.Require().ErrorIs(err, types.ErrStreamDoesNotExist)
+
+	stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 1)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+
+	_, err = s.app.StreamKeeper.AcceptTermination(tCtx, stream.StreamId)
+	s.Require().ErrorIs(err, types.ErrTerminationNotReq)
+
+	_, err = s.app.StreamKeeper.RequestTermination(tCtx.WithBlockTime(blockTime.Add(time.Second*100)), stream.StreamId, 1000)
+	s.Require().NoError(err)
+
+	// accepting claims the accrued flow for the receiver, and refunds the rest to the sender
+	senderBalBefore := s.app.BankKeeper.GetBalance(tCtx, s.addrs[0], sdk.DefaultBondDenom)
+	acceptCtx := tCtx.WithBlockTime(blockTime.Add(time.Second * 200))
+	refund, err := s.app.StreamKeeper.AcceptTermination(acceptCtx, stream.StreamId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 9800), refund)
+	s.Require().False(s.app.StreamKeeper.IsStream(acceptCtx, stream.StreamId))
+
+	senderBalAfter := s.app.BankKeeper.GetBalance(acceptCtx, s.addrs[0], sdk.DefaultBondDenom)
+	s.Require().Equal(senderBalBefore.Add(refund), senderBalAfter)
+}
+
+func (s *KeeperTestSuite) TestStreamStatsAndHistory() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime).WithBlockHeight(10)
+
+	params := s.app.StreamKeeper.GetParams(tCtx)
+	params.ValidatorFee = mathmod.LegacyNewDecWithPrec(1, 2)
+	s.Require().NoError(s.app.StreamKeeper.SetParams(tCtx, params))
+
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000)
+
+	stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 100)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+
+	_, ok := s.app.StreamKeeper.GetStreamStats(tCtx, stream.StreamId)
+	s.Require().False(ok)
+
+	// claims are accumulated
+	_, valFee1, claimTotal1, _, err := s.app.StreamKeeper.ClaimFromStream(tCtx.WithBlockTime(blockTime.Add(time.Second*10)), stream.StreamId)
+	s.Require().NoError(err)
+	_, valFee2, claimTotal2, _, err := s.app.StreamKeeper.ClaimFromStream(tCtx.WithBlockTime(blockTime.Add(time.Second*30)), stream.StreamId)
+	s.Require().NoError(err)
+	// nothing to claim, so not counted
+	_, _, _, _, err = s.app.StreamKeeper.ClaimFromStream(tCtx.WithBlockTime(blockTime.Add(time.Second*30)), stream.StreamId)
+	s.Require().NoError(err)
+
+	stats, ok := s.app.StreamKeeper.GetStreamStats(tCtx, stream.StreamId)
+	s.Require().True(ok)
+	s.Require().Equal(claimTotal1.Add(claimTotal2), stats.TotalClaimed)
+	s.Require().Equal(valFee1.Add(valFee2), stats.TotalValidatorFees)
+	s.Require().Equal(uint64(2), stats.NumClaims)
+	s.Require().Equal(blockTime.Add(time.Second*30), stats.LastClaimTime)
+
+	// flow rate changes are kept up to the max, oldest first
+	for i := int64(1); i <= types.MaxFlowRateChangeHistory+5; i++ {
+		err = s.app.StreamKeeper.SetNewFlowRate(tCtx.WithBlockTime(blockTime.Add(time.Second*time.Duration(30+i))), stream.StreamId, 100+i)
+		s.Require().NoError(err)
+	}
+
+	history, ok := s.app.StreamKeeper.GetStreamHistory(tCtx, stream.StreamId)
+	s.Require().True(ok)
+	s.Require().Len(history.FlowRateChanges, types.MaxFlowRateChangeHistory)
+	s.Require().Equal(int64(105), history.FlowRateChanges[0].OldFlowRate)
+	s.Require().Equal(int64(106), history.FlowRateChanges[0].NewFlowRate)
+	last := history.FlowRateChanges[types.MaxFlowRateChangeHistory-1]
+	s.Require().Equal(int64(100+types.MaxFlowRateChangeHistory+5), last.NewFlowRate)
+	s.Require().Equal(blockTime.Add(time.Second*time.Duration(35+types.MaxFlowRateChangeHistory)), last.ChangedAt)
+	s.Require().Equal(int64(10), last.BlockHeight)
+
+	// stats and history are kept after the stream is deleted, until the retention period has passed
+	deletedAt := blockTime.Add(time.Second * 100)
+	err = s.app.StreamKeeper.CancelAndRefundStream(tCtx.WithBlockTime(deletedAt), stream.StreamId)
+	s.Require().NoError(err)
+	s.Require().False(s.app.StreamKeeper.IsStream(tCtx, stream.StreamId))
+	stats, ok = s.app.StreamKeeper.GetStreamStats(tCtx, stream.StreamId)
+	s.Require().True(ok)
+
+	s.app.StreamKeeper.PruneDeletedStreamAudits(tCtx.WithBlockTime(deletedAt.Add(types.DeletedStreamAuditRetention - time.Second)))
+	keptStats, ok := s.app.StreamKeeper.GetStreamStats(tCtx, stream.StreamId)
+	s.Require().True(ok)
+	s.Require().Equal(stats, keptStats)
+	keptHistory, ok := s.app.StreamKeeper.GetStreamHistory(tCtx, stream.StreamId)
+	s.Require().True(ok)
+	s.Require().Equal(history, keptHistory)
+
+	s.app.StreamKeeper.PruneDeletedStreamAudits(tCtx.WithBlockTime(deletedAt.Add(types.DeletedStreamAuditRetention)))
+	_, ok = s.app.StreamKeeper.GetStreamStats(tCtx, stream.StreamId)
+	s.Require().False(ok)
+	_, ok = s.app.StreamKeeper.GetStreamHistory(tCtx, stream.StreamId)
+	s.Require().False(ok)
+}
+
+func (s *KeeperTestSuite) TestClaimFromStreamFeeRouting() {
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	params := s.app.StreamKeeper.GetParams(tCtx)
+	params.ValidatorFee = mathmod.LegacyNewDecWithPrec(10, 2)
+	params.FeeCollectorRatio = mathmod.LegacyNewDecWithPrec(5, 1)
+	params.CommunityPoolRatio = mathmod.LegacyNewDecWithPrec(3, 1)
+	params.BurnRatio = mathmod.LegacyNewDecWithPrec(2, 1)
+	s.Require().NoError(s.app.StreamKeeper.SetParams(tCtx, params))
+
+	feeCollectorAddr := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100000)
+
+	stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, s.addrs[1], s.addrs[0], deposit, 100)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+
+	feeCollectorBefore := s.app.BankKeeper.GetBalance(tCtx, feeCollectorAddr, sdk.DefaultBondDenom)
+	feePoolBefore, err := s.app.DistrKeeper.FeePool.Get(tCtx)
+	s.Require().NoError(err)
+	supplyBefore := s.app.BankKeeper.GetSupply(tCtx, sdk.DefaultBondDenom)
+
+	// 100 seconds at 100 per second = 10000, of which 1000 is the validator fee
+	claimCtx := tCtx.WithBlockTime(blockTime.Add(time.Second * 100))
+	receiverAmount, valFee, claimTotal, _, err := s.app.StreamKeeper.ClaimFromStream(claimCtx, stream.StreamId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10000), claimTotal)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 9000), receiverAmount)
+	s.Require().Equal(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), valFee)
+
+	feeCollectorAfter := s.app.BankKeeper.GetBalance(claimCtx, feeCollectorAddr, sdk.DefaultBondDenom)
+	s.Require().Equal(mathmod.NewInt(500), feeCollectorAfter.Amount.Sub(feeCollectorBefore.Amount))
+
+	feePoolAfter, err := s.app.DistrKeeper.FeePool.Get(claimCtx)
+	s.Require().NoError(err)
+	communityPoolIncrease := feePoolAfter.CommunityPool.AmountOf(sdk.DefaultBondDenom).Sub(feePoolBefore.CommunityPool.AmountOf(sdk.DefaultBondDenom))
+	s.Require().Equal(mathmod.LegacyNewDec(300), communityPoolIncrease)
+
+	supplyAfter := s.app.BankKeeper.GetSupply(claimCtx, sdk.DefaultBondDenom)
+	s.Require().Equal(mathmod.NewInt(200), supplyBefore.Amount.Sub(supplyAfter.Amount))
+
+	// each leg is reported in the claim event
+	var found bool
+	for _, ev := range claimCtx.EventManager().Events() {
+		if ev.Type != types.EventTypeClaimStreamAction {
+			continue
+		}
+		found = true
+		attrs := make(map[string]string)
+		for _, attr := range ev.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		s.Require().Equal("500nund", attrs[types.AttributeKeyClaimFeeCollector])
+		s.Require().Equal("300nund", attrs[types.AttributeKeyClaimCommunityPool])
+		s.Require().Equal("200nund", attrs[types.AttributeKeyClaimBurned])
+	}
+	s.Require().True(found)
+
+	// cancelling routes the fee on the final claim
+	cancelCtx := tCtx.WithBlockTime(blockTime.Add(time.Second * 200))
+	err = s.app.StreamKeeper.CancelAndRefundStream(cancelCtx, stream.StreamId)
+	s.Require().NoError(err)
+
+	feeCollectorFinal := s.app.BankKeeper.GetBalance(cancelCtx, feeCollectorAddr, sdk.DefaultBondDenom)
+	s.Require().Equal(mathmod.NewInt(500), feeCollectorFinal.Amount.Sub(feeCollectorAfter.Amount))
+	supplyFinal := s.app.BankKeeper.GetSupply(cancelCtx, sdk.DefaultBondDenom)
+	s.Require().Equal(mathmod.NewInt(200), supplyAfter.Amount.Sub(supplyFinal.Amount))
+}
+
+func (s *KeeperTestSuite) TestClaimFromStreamFeeRoutingNonNativeDenom() {
+	newAccs := simapphelpers.AddTestAddrsWithExtraNonBondCoin(s.app, s.ctx, 2, mathmod.NewIntFromUint64(10000000), sdk.NewInt64Coin("testdenom", 1000000))
+	sender := newAccs[0]
+	receiver := newAccs[1]
+
+	blockTime := time.Unix(time.Now().Unix(), 0).UTC()
+	tCtx := s.ctx.WithBlockTime(blockTime)
+
+	params := s.app.StreamKeeper.GetParams(tCtx)
+	params.AllowedDenoms = []string{sdk.DefaultBondDenom, "testdenom"}
+	params.ValidatorFee = mathmod.LegacyNewDecWithPrec(10, 2)
+	params.FeeCollectorRatio = mathmod.LegacyNewDecWithPrec(5, 1)
+	params.CommunityPoolRatio = mathmod.LegacyNewDecWithPrec(3, 1)
+	params.BurnRatio = mathmod.LegacyNewDecWithPrec(2, 1)
+	s.Require().NoError(s.app.StreamKeeper.SetParams(tCtx, params))
+
+	feeCollectorAddr := s.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	deposit := sdk.NewInt64Coin("testdenom", 100000)
+
+	stream, err := s.app.StreamKeeper.CreateNewStream(tCtx, receiver, sender, deposit, 100)
+	s.Require().NoError(err)
+	_, err = s.app.StreamKeeper.AddDeposit(tCtx, stream.StreamId, deposit)
+	s.Require().NoError(err)
+
+	feeCollectorBefore := s.app.BankKeeper.GetBalance(tCtx, feeCollectorAddr, "testdenom")
+	supplyBefore := s.app.BankKeeper.GetSupply(tCtx, "testdenom")
+
+	claimCtx := tCtx.WithBlockTime(blockTime.Add(time.Second * 100))
+	_, valFee, _, _, err := s.app.StreamKeeper.ClaimFromStream(claimCtx, stream.StreamId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt64Coin("testdenom", 1000), valFee)
+
+	// the burn share is sent to the fee collector instead of being burned
+	feeCollectorAfter := s.app.BankKeeper.GetBalance(claimCtx, feeCollectorAddr, "testdenom")
+	s.Require().Equal(mathmod.NewInt(700), feeCollectorAfter.Amount.Sub(feeCollectorBefore.Amount))
+
+	supplyAfter := s.app.BankKeeper.GetSupply(claimCtx, "testdenom")
+	s.Require().Equal(supplyBefore, supplyAfter)
+}
@@ -0,0 +1,39 @@
+package v2
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+var (
+	StreamKeyPrefix         = []byte{0x11}
+	StreamExpiryQueuePrefix = []byte{0x12}
+)
+
+// GetStreamKey creates the v1/v2 key for a receiver/sender pair's stream
+func GetStreamKey(receiverAddr sdk.AccAddress, senderAddr sdk.AccAddress) []byte {
+	key := append(StreamKeyPrefix, address.MustLengthPrefix(receiverAddr)...)
+	return append(key, address.MustLengthPrefix(senderAddr)...)
+}
+
+// GetStreamExpiryQueueKey creates the v2 expiry queue key for a receiver/sender pair's stream
+func GetStreamExpiryQueueKey(depositZeroTime time.Time, receiverAddr sdk.AccAddress, senderAddr sdk.AccAddress) []byte {
+	key := append(StreamExpiryQueuePrefix, sdk.FormatTimeBytes(depositZeroTime)...)
+	key = append(key, address.MustLengthPrefix(receiverAddr)...)
+	return append(key, address.MustLengthPrefix(senderAddr)...)
+}
+
+// AddressesFromStreamKey returns a receiver and sender address from a v1/v2 stream store key.
+func AddressesFromStreamKey(key []byte) (sdk.AccAddress, sdk.AccAddress) {
+	receiverAddrLen, receiverAddrLenEndIndex := sdk.ParseLengthPrefixedBytes(key, 1, 1)
+	receiverAddr, receiverAddrEndIndex := sdk.ParseLengthPrefixedBytes(key, receiverAddrLenEndIndex+1, int(receiverAddrLen[0]))
+
+	senderAddrLen, senderAddrLenEndIndex := sdk.ParseLengthPrefixedBytes(key, receiverAddrEndIndex+1, 1)
+	senderAddr, senderAddrEndIndex := sdk.ParseLengthPrefixedBytes(key, senderAddrLenEndIndex+1, int(senderAddrLen[0]))
+
+	kv.AssertKeyAtLeastLength(key, senderAddrEndIndex+1)
+	return receiverAddr, senderAddr
+}
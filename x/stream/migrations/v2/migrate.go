@@ -0,0 +1,40 @@
+package v2
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+const (
+	ModuleName = "stream"
+)
+
+// buildStreamExpiryQueue adds all existing streams to the expiry queue, keyed by their deposit zero time
+func buildStreamExpiryQueue(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	iterator := storetypes.KVStorePrefixIterator(store, StreamKeyPrefix)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stream types.Stream
+
+		err := cdc.Unmarshal(iterator.Value(), &stream)
+		if err != nil {
+			return err
+		}
+
+		receiverAddr, senderAddr := AddressesFromStreamKey(iterator.Key())
+		store.Set(GetStreamExpiryQueueKey(stream.DepositZeroTime, receiverAddr, senderAddr), []byte{})
+	}
+
+	return nil
+}
+
+// Migrate performs in-place store migrations from v1 to v2.
+func Migrate(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Migrating Stream Module - building stream expiry queue")
+	return buildStreamExpiryQueue(store, cdc)
+}
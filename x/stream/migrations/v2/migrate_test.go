@@ -0,0 +1,61 @@
+package v2_test
+
+import (
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unification-com/mainchain/x/stream"
+	v2 "github.com/unification-com/mainchain/x/stream/migrations/v2"
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(stream.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v2.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	senderAddr := sdk.AccAddress("sender______________")
+
+	var receivers []sdk.AccAddress
+	var streams []types.Stream
+
+	for i := int64(1); i <= 3; i++ {
+		receiverAddr := sdk.AccAddress([]byte{byte(i), 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13})
+		stream := types.Stream{
+			Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000*i),
+			FlowRate:        1,
+			LastOutflowTime: time.Unix(1700000000, 0).UTC(),
+			DepositZeroTime: time.Unix(1700000000+(1000*i), 0).UTC(),
+			Cancellable:     true,
+		}
+		store.Set(v2.GetStreamKey(receiverAddr, senderAddr), cdc.MustMarshal(&stream))
+		receivers = append(receivers, receiverAddr)
+		streams = append(streams, stream)
+	}
+
+	// Run migrations.
+	err := v2.Migrate(ctx, store, cdc)
+	require.NoError(t, err)
+
+	for i, receiverAddr := range receivers {
+		require.True(t, store.Has(v2.GetStreamExpiryQueueKey(streams[i].DepositZeroTime, receiverAddr, senderAddr)))
+	}
+
+	numInQueue := 0
+	iterator := storetypes.KVStorePrefixIterator(store, v2.StreamExpiryQueuePrefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		numInQueue++
+	}
+	require.Equal(t, len(streams), numInQueue)
+}
@@ -0,0 +1,41 @@
+package v3
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+var (
+	StreamKeyPrefix         = []byte{0x11}
+	StreamExpiryQueuePrefix = []byte{0x12}
+)
+
+// GetStreamKey creates the v3 key for a receiver/sender pair's stream in the given denom
+func GetStreamKey(receiverAddr sdk.AccAddress, senderAddr sdk.AccAddress, denom string) []byte {
+	key := append(StreamKeyPrefix, address.MustLengthPrefix(receiverAddr)...)
+	key = append(key, address.MustLengthPrefix(senderAddr)...)
+	return append(key, []byte(denom)...)
+}
+
+// GetStreamExpiryQueueKey creates the v3 expiry queue key for a receiver/sender pair's stream in the given denom
+func GetStreamExpiryQueueKey(depositZeroTime time.Time, receiverAddr sdk.AccAddress, senderAddr sdk.AccAddress, denom string) []byte {
+	key := append(StreamExpiryQueuePrefix, sdk.FormatTimeBytes(depositZeroTime)...)
+	key = append(key, address.MustLengthPrefix(receiverAddr)...)
+	key = append(key, address.MustLengthPrefix(senderAddr)...)
+	return append(key, []byte(denom)...)
+}
+
+// SplitStreamKey returns the receiver and sender addresses and the denom from a v3 stream store key.
+func SplitStreamKey(key []byte) (sdk.AccAddress, sdk.AccAddress, string) {
+	receiverAddrLen, receiverAddrLenEndIndex := sdk.ParseLengthPrefixedBytes(key, 1, 1)
+	receiverAddr, receiverAddrEndIndex := sdk.ParseLengthPrefixedBytes(key, receiverAddrLenEndIndex+1, int(receiverAddrLen[0]))
+
+	senderAddrLen, senderAddrLenEndIndex := sdk.ParseLengthPrefixedBytes(key, receiverAddrEndIndex+1, 1)
+	senderAddr, senderAddrEndIndex := sdk.ParseLengthPrefixedBytes(key, senderAddrLenEndIndex+1, int(senderAddrLen[0]))
+
+	kv.AssertKeyAtLeastLength(key, senderAddrEndIndex+1)
+	return receiverAddr, senderAddr, string(key[senderAddrEndIndex+1:])
+}
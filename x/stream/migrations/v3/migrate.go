@@ -0,0 +1,114 @@
+package v3
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/unification-com/mainchain/x/stream/migrations/v2"
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+const (
+	ModuleName = "stream"
+)
+
+type pairStream struct {
+	oldKey       []byte
+	receiverAddr sdk.AccAddress
+	senderAddr   sdk.AccAddress
+	stream       types.Stream
+}
+
+// migrateStreamKeys re-keys all existing streams by denom, and rebuilds the expiry queue using
+// the new queue key format. Returns the set of denoms currently in use by streams.
+func migrateStreamKeys(store storetypes.KVStore, cdc codec.BinaryCodec) ([]string, error) {
+	var streams []pairStream
+	var denomsInUse []string
+	seenDenoms := make(map[string]bool)
+
+	// collect first - the new keys share the old keys' prefix
+	iterator := storetypes.KVStorePrefixIterator(store, v2.StreamKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var stream types.Stream
+
+		err := cdc.Unmarshal(iterator.Value(), &stream)
+		if err != nil {
+			iterator.Close()
+			return nil, err
+		}
+
+		receiverAddr, senderAddr := v2.AddressesFromStreamKey(iterator.Key())
+		streams = append(streams, pairStream{
+			oldKey:       iterator.Key(),
+			receiverAddr: receiverAddr,
+			senderAddr:   senderAddr,
+			stream:       stream,
+		})
+
+		if !seenDenoms[stream.Deposit.Denom] {
+			seenDenoms[stream.Deposit.Denom] = true
+			denomsInUse = append(denomsInUse, stream.Deposit.Denom)
+		}
+	}
+	iterator.Close()
+
+	// old queue entries do not contain the denom
+	var oldQueueKeys [][]byte
+	queueIterator := storetypes.KVStorePrefixIterator(store, v2.StreamExpiryQueuePrefix)
+	for ; queueIterator.Valid(); queueIterator.Next() {
+		oldQueueKeys = append(oldQueueKeys, queueIterator.Key())
+	}
+	queueIterator.Close()
+
+	for _, key := range oldQueueKeys {
+		store.Delete(key)
+	}
+
+	for _, ps := range streams {
+		denom := ps.stream.Deposit.Denom
+		store.Delete(ps.oldKey)
+		store.Set(GetStreamKey(ps.receiverAddr, ps.senderAddr, denom), cdc.MustMarshal(&ps.stream))
+		store.Set(GetStreamExpiryQueueKey(ps.stream.DepositZeroTime, ps.receiverAddr, ps.senderAddr, denom), []byte{})
+	}
+
+	return denomsInUse, nil
+}
+
+// migrateParams seeds the allowed denoms param with the default allowed denoms, plus any denoms
+// already in use by existing streams
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec, denomsInUse []string) error {
+	var params types.Params
+	bz := store.Get(types.ParamsKey)
+	if bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	} else {
+		params = types.DefaultParams()
+	}
+
+	params.AllowedDenoms = append([]string{}, types.DefaultAllowedDenoms...)
+	for _, denom := range denomsInUse {
+		if !params.IsAllowedDenom(denom) {
+			params.AllowedDenoms = append(params.AllowedDenoms, denom)
+		}
+	}
+
+	// The full set of params is validated by the v6 migration, once all params have been seeded
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
+
+// Migrate performs in-place store migrations from v2 to v3.
+func Migrate(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Migrating Stream Module - re-keying streams by denom")
+	denomsInUse, err := migrateStreamKeys(store, cdc)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("Migrating Stream Module - setting allowed denoms param")
+	return migrateParams(store, cdc, denomsInUse)
+}
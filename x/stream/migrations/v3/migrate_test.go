@@ -0,0 +1,96 @@
+package v3_test
+
+import (
+	"testing"
+	"time"
+
+	mathmod "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unification-com/mainchain/x/stream"
+	v2 "github.com/unification-com/mainchain/x/stream/migrations/v2"
+	v3 "github.com/unification-com/mainchain/x/stream/migrations/v3"
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(stream.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v3.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	senderAddr := sdk.AccAddress("sender______________")
+
+	// v2 params only have the validator fee
+	oldParams := types.Params{ValidatorFee: mathmod.LegacyNewDecWithPrec(2, 2)}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	var receivers []sdk.AccAddress
+	var streams []types.Stream
+
+	for i := int64(1); i <= 4; i++ {
+		denom := sdk.DefaultBondDenom
+		if i%2 == 0 {
+			denom = ibcDenom
+		}
+		receiverAddr := sdk.AccAddress([]byte{byte(i), 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13})
+		stream := types.Stream{
+			Deposit:         sdk.NewInt64Coin(denom, 1000*i),
+			FlowRate:        1,
+			LastOutflowTime: time.Unix(1700000000, 0).UTC(),
+			DepositZeroTime: time.Unix(1700000000+(1000*i), 0).UTC(),
+			Cancellable:     true,
+		}
+		store.Set(v2.GetStreamKey(receiverAddr, senderAddr), cdc.MustMarshal(&stream))
+		store.Set(v2.GetStreamExpiryQueueKey(stream.DepositZeroTime, receiverAddr, senderAddr), []byte{})
+		receivers = append(receivers, receiverAddr)
+		streams = append(streams, stream)
+	}
+
+	// Run migrations.
+	err := v3.Migrate(ctx, store, cdc)
+	require.NoError(t, err)
+
+	for i, receiverAddr := range receivers {
+		denom := streams[i].Deposit.Denom
+		require.False(t, store.Has(v2.GetStreamKey(receiverAddr, senderAddr)))
+		require.False(t, store.Has(v2.GetStreamExpiryQueueKey(streams[i].DepositZeroTime, receiverAddr, senderAddr)))
+
+		bz := store.Get(v3.GetStreamKey(receiverAddr, senderAddr, denom))
+		require.NotNil(t, bz)
+		var migrated types.Stream
+		cdc.MustUnmarshal(bz, &migrated)
+		require.Equal(t, streams[i], migrated)
+
+		require.True(t, store.Has(v3.GetStreamExpiryQueueKey(streams[i].DepositZeroTime, receiverAddr, senderAddr, denom)))
+	}
+
+	numStreams := 0
+	iterator := storetypes.KVStorePrefixIterator(store, v3.StreamKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		numStreams++
+	}
+	iterator.Close()
+	require.Equal(t, len(streams), numStreams)
+
+	numInQueue := 0
+	queueIterator := storetypes.KVStorePrefixIterator(store, v3.StreamExpiryQueuePrefix)
+	for ; queueIterator.Valid(); queueIterator.Next() {
+		numInQueue++
+	}
+	queueIterator.Close()
+	require.Equal(t, len(streams), numInQueue)
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, oldParams.ValidatorFee, params.ValidatorFee)
+	require.Equal(t, []string{sdk.DefaultBondDenom, ibcDenom}, params.AllowedDenoms)
+}
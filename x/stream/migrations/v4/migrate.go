@@ -0,0 +1,94 @@
+package v4
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v3 "github.com/unification-com/mainchain/x/stream/migrations/v3"
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+const (
+	ModuleName = "stream"
+)
+
+type pairStream struct {
+	oldKey       []byte
+	receiverAddr sdk.AccAddress
+	senderAddr   sdk.AccAddress
+	stream       types.Stream
+}
+
+// migrateStreams assigns a stream ID to each existing stream, in v3 key order, and re-keys it by
+// that ID. The receiver/sender index and the expiry queue are rebuilt using the new key formats.
+// Returns the next stream ID to be assigned.
+func migrateStreams(store storetypes.KVStore, cdc codec.BinaryCodec) (uint64, error) {
+	var streams []pairStream
+
+	// collect first - the new keys share the old keys' prefix
+	iterator := storetypes.KVStorePrefixIterator(store, v3.StreamKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		var stream types.Stream
+
+		err := cdc.Unmarshal(iterator.Value(), &stream)
+		if err != nil {
+			iterator.Close()
+			return 0, err
+		}
+
+		receiverAddr, senderAddr, _ := v3.SplitStreamKey(iterator.Key())
+		streams = append(streams, pairStream{
+			oldKey:       iterator.Key(),
+			receiverAddr: receiverAddr,
+			senderAddr:   senderAddr,
+			stream:       stream,
+		})
+	}
+	iterator.Close()
+
+	var oldQueueKeys [][]byte
+	queueIterator := storetypes.KVStorePrefixIterator(store, v3.StreamExpiryQueuePrefix)
+	for ; queueIterator.Valid(); queueIterator.Next() {
+		oldQueueKeys = append(oldQueueKeys, queueIterator.Key())
+	}
+	queueIterator.Close()
+
+	for _, key := range oldQueueKeys {
+		store.Delete(key)
+	}
+
+	for _, ps := range streams {
+		store.Delete(ps.oldKey)
+	}
+
+	nextStreamID := types.DefaultStartingStreamID
+	for _, ps := range streams {
+		stream := ps.stream
+		stream.StreamId = nextStreamID
+		stream.Receiver = ps.receiverAddr.String()
+		stream.Sender = ps.senderAddr.String()
+
+		store.Set(types.GetStreamKey(stream.StreamId), cdc.MustMarshal(&stream))
+		store.Set(types.GetStreamByReceiverSenderIndexKey(ps.receiverAddr, ps.senderAddr, stream.StreamId), []byte{})
+		store.Set(types.GetStreamExpiryQueueKey(stream.DepositZeroTime, stream.StreamId), []byte{})
+
+		nextStreamID++
+	}
+
+	return nextStreamID, nil
+}
+
+// Migrate performs in-place store migrations from v3 to v4.
+func Migrate(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Migrating Stream Module - assigning stream IDs")
+	nextStreamID, err := migrateStreams(store, cdc)
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("Migrating Stream Module - setting highest stream ID")
+	store.Set(types.HighestStreamIDKey, types.GetStreamIDBytes(nextStreamID))
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package v4_test
+
+import (
+	"testing"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unification-com/mainchain/x/stream"
+	v3 "github.com/unification-com/mainchain/x/stream/migrations/v3"
+	v4 "github.com/unification-com/mainchain/x/stream/migrations/v4"
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(stream.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v4.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	senderAddr := sdk.AccAddress("sender______________")
+
+	var receivers []sdk.AccAddress
+	var streams []types.Stream
+
+	// v3 streams are keyed by receiver, sender and denom. The "ibc/" denom sorts before "nund", so
+	// for each receiver, the ibc stream is expected to be assigned the lower ID
+	for i := int64(1); i <= 3; i++ {
+		receiverAddr := sdk.AccAddress([]byte{byte(i), 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13})
+		for _, denom := range []string{ibcDenom, sdk.DefaultBondDenom} {
+			stream := types.Stream{
+				Deposit:         sdk.NewInt64Coin(denom, 1000*i),
+				FlowRate:        1,
+				LastOutflowTime: time.Unix(1700000000, 0).UTC(),
+				DepositZeroTime: time.Unix(1700000000+(1000*i), 0).UTC(),
+				Cancellable:     true,
+			}
+			store.Set(v3.GetStreamKey(receiverAddr, senderAddr, denom), cdc.MustMarshal(&stream))
+			store.Set(v3.GetStreamExpiryQueueKey(stream.DepositZeroTime, receiverAddr, senderAddr, denom), []byte{})
+			receivers = append(receivers, receiverAddr)
+			streams = append(streams, stream)
+		}
+	}
+
+	// Run migrations.
+	err := v4.Migrate(ctx, store, cdc)
+	require.NoError(t, err)
+
+	for i, receiverAddr := range receivers {
+		streamID := uint64(i + 1)
+		denom := streams[i].Deposit.Denom
+		require.False(t, store.Has(v3.GetStreamKey(receiverAddr, senderAddr, denom)))
+		require.False(t, store.Has(v3.GetStreamExpiryQueueKey(streams[i].DepositZeroTime, receiverAddr, senderAddr, denom)))
+
+		bz := store.Get(types.GetStreamKey(streamID))
+		require.NotNil(t, bz)
+		var migrated types.Stream
+		cdc.MustUnmarshal(bz, &migrated)
+
+		expected := streams[i]
+		expected.StreamId = streamID
+		expected.Receiver = receiverAddr.String()
+		expected.Sender = senderAddr.String()
+		require.Equal(t, expected, migrated)
+
+		require.True(t, store.Has(types.GetStreamByReceiverSenderIndexKey(receiverAddr, senderAddr, streamID)))
+		require.True(t, store.Has(types.GetStreamExpiryQueueKey(streams[i].DepositZeroTime, streamID)))
+	}
+
+	numStreams := 0
+	iterator := storetypes.KVStorePrefixIterator(store, types.StreamKeyPrefix)
+	for ; iterator.Valid(); iterator.Next() {
+		numStreams++
+	}
+	iterator.Close()
+	require.Equal(t, len(streams), numStreams)
+
+	numInQueue := 0
+	queueIterator := storetypes.KVStorePrefixIterator(store, types.StreamExpiryQueuePrefix)
+	for ; queueIterator.Valid(); queueIterator.Next() {
+		numInQueue++
+	}
+	queueIterator.Close()
+	require.Equal(t, len(streams), numInQueue)
+
+	require.Equal(t, uint64(len(streams)+1), types.GetStreamIDFromBytes(store.Get(types.HighestStreamIDKey)))
+}
+
+func TestMigrate_NoStreams(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(stream.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v4.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	err := v4.Migrate(ctx, store, cdc)
+	require.NoError(t, err)
+
+	require.Equal(t, types.DefaultStartingStreamID, types.GetStreamIDFromBytes(store.Get(types.HighestStreamIDKey)))
+}
@@ -0,0 +1,42 @@
+package v5
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+const (
+	ModuleName = "stream"
+)
+
+// migrateParams seeds the min duration, min deposit and max flow rate params with their defaults. The
+// validator fee and allowed denoms are kept.
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	bz := store.Get(types.ParamsKey)
+	if bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	} else {
+		params = types.DefaultParams()
+	}
+
+	params.MinDuration = types.DefaultMinDuration
+	params.MinDeposit = types.DefaultMinDeposit
+	params.MaxFlowRate = types.DefaultMaxFlowRate
+
+	// The full set of params is validated by the v6 migration, once all params have been seeded
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
+
+// Migrate performs in-place store migrations from v4 to v5.
+func Migrate(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Migrating Stream Module - setting min duration, min deposit and max flow rate params")
+	return migrateParams(store, cdc)
+}
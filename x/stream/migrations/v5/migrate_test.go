@@ -0,0 +1,46 @@
+package v5_test
+
+import (
+	"testing"
+
+	mathmod "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unification-com/mainchain/x/stream"
+	v5 "github.com/unification-com/mainchain/x/stream/migrations/v5"
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(stream.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v5.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	// v4 params only have the validator fee and allowed denoms
+	oldParams := types.Params{
+		ValidatorFee:  mathmod.LegacyNewDecWithPrec(2, 2),
+		AllowedDenoms: []string{"nund", ibcDenom},
+	}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	// Run migrations.
+	err := v5.Migrate(ctx, store, cdc)
+	require.NoError(t, err)
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, oldParams.ValidatorFee, params.ValidatorFee)
+	require.Equal(t, oldParams.AllowedDenoms, params.AllowedDenoms)
+	require.Equal(t, types.DefaultMinDuration, params.MinDuration)
+	require.True(t, params.MinDeposit.Equal(types.DefaultMinDeposit))
+	require.Equal(t, types.DefaultMaxFlowRate, params.MaxFlowRate)
+}
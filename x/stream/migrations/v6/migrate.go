@@ -0,0 +1,77 @@
+package v6
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+const (
+	ModuleName = "stream"
+)
+
+// migrateParams seeds the validator fee routing params with their defaults, so the whole validator fee
+// continues to be sent to the fee collector. All other params are kept.
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	bz := store.Get(types.ParamsKey)
+	if bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	} else {
+		params = types.DefaultParams()
+	}
+
+	params.FeeCollectorRatio = types.DefaultFeeCollectorRatio
+	params.CommunityPoolRatio = types.DefaultCommunityPoolRatio
+	params.BurnRatio = types.DefaultBurnRatio
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
+
+// migrateModuleAccount grants the stream module account the burner permission, which is required to burn
+// the burn ratio of validator fees paid in the native denomination. Fees in any other allowed denomination are
+// never burned. Module accounts are only created with their permissions once, so an existing account must be
+// updated.
+func migrateModuleAccount(ctx sdk.Context, ak types.AccountKeeper) error {
+	acc := ak.GetModuleAccount(ctx, ModuleName)
+	if acc == nil {
+		return fmt.Errorf("%s module account has not been set", ModuleName)
+	}
+
+	if acc.HasPermission(authtypes.Burner) {
+		return nil
+	}
+
+	moduleAcc, ok := acc.(*authtypes.ModuleAccount)
+	if !ok {
+		return fmt.Errorf("unexpected %s module account type %T", ModuleName, acc)
+	}
+
+	moduleAcc.Permissions = append(moduleAcc.Permissions, authtypes.Burner)
+	ak.SetModuleAccount(ctx, moduleAcc)
+
+	return nil
+}
+
+// Migrate performs in-place store migrations from v5 to v6.
+func Migrate(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec, ak types.AccountKeeper) error {
+	ctx.Logger().Info("Migrating Stream Module - setting validator fee routing params and burner permission")
+	if err := migrateParams(store, cdc); err != nil {
+		return err
+	}
+
+	return migrateModuleAccount(ctx, ak)
+}
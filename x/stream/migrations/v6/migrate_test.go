@@ -0,0 +1,84 @@
+package v6_test
+
+import (
+	"context"
+	"testing"
+
+	mathmod "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unification-com/mainchain/x/stream"
+	v6 "github.com/unification-com/mainchain/x/stream/migrations/v6"
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+// mockAccountKeeper only stores module accounts
+type mockAccountKeeper struct {
+	accounts map[string]sdk.ModuleAccountI
+}
+
+func (m *mockAccountKeeper) GetAccount(_ context.Context, _ sdk.AccAddress) sdk.AccountI {
+	return nil
+}
+
+func (m *mockAccountKeeper) GetModuleAccount(_ context.Context, name string) sdk.ModuleAccountI {
+	return m.accounts[name]
+}
+
+func (m *mockAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+func (m *mockAccountKeeper) SetModuleAccount(_ context.Context, acc sdk.ModuleAccountI) {
+	m.accounts[acc.GetName()] = acc
+}
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(stream.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v6.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// v5 params do not have the fee routing ratios
+	oldParams := types.Params{
+		ValidatorFee:  mathmod.LegacyNewDecWithPrec(2, 2),
+		AllowedDenoms: []string{"nund"},
+		MinDuration:   120,
+		MaxFlowRate:   1000,
+	}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	// the existing module account was created without any permissions
+	ak := &mockAccountKeeper{accounts: make(map[string]sdk.ModuleAccountI)}
+	ak.SetModuleAccount(ctx, authtypes.NewEmptyModuleAccount(v6.ModuleName))
+
+	// Run migrations.
+	err := v6.Migrate(ctx, store, cdc, ak)
+	require.NoError(t, err)
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, oldParams.ValidatorFee, params.ValidatorFee)
+	require.Equal(t, oldParams.AllowedDenoms, params.AllowedDenoms)
+	require.Equal(t, oldParams.MinDuration, params.MinDuration)
+	require.Equal(t, oldParams.MaxFlowRate, params.MaxFlowRate)
+	require.True(t, params.FeeCollectorRatio.Equal(types.DefaultFeeCollectorRatio))
+	require.True(t, params.CommunityPoolRatio.Equal(types.DefaultCommunityPoolRatio))
+	require.True(t, params.BurnRatio.Equal(types.DefaultBurnRatio))
+
+	moduleAcc := ak.GetModuleAccount(ctx, v6.ModuleName)
+	require.True(t, moduleAcc.HasPermission(authtypes.Burner))
+
+	// running again does not duplicate the permission
+	err = v6.Migrate(ctx, store, cdc, ak)
+	require.NoError(t, err)
+	require.Equal(t, []string{authtypes.Burner}, ak.GetModuleAccount(ctx, v6.ModuleName).GetPermissions())
+}
@@ -0,0 +1,45 @@
+package v7
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+const (
+	ModuleName = "stream"
+)
+
+// migrateParams seeds the min termination notice and max claim fee ratio params with their defaults. Without them,
+// senders could request the immediate termination of streams that cannot be cancelled, and no fees could be paid
+// from claims. All other params are kept.
+func migrateParams(store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	var params types.Params
+	bz := store.Get(types.ParamsKey)
+	if bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	} else {
+		params = types.DefaultParams()
+	}
+
+	params.MinTerminationNotice = types.DefaultMinTerminationNotice
+	params.MaxClaimFeeRatio = types.DefaultMaxClaimFeeRatio
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	return nil
+}
+
+// Migrate performs in-place store migrations from v6 to v7.
+func Migrate(ctx sdk.Context, store storetypes.KVStore, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("Migrating Stream Module - setting min termination notice and max claim fee ratio params")
+	return migrateParams(store, cdc)
+}
@@ -0,0 +1,53 @@
+package v7_test
+
+import (
+	"testing"
+
+	mathmod "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unification-com/mainchain/x/stream"
+	v7 "github.com/unification-com/mainchain/x/stream/migrations/v7"
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(stream.AppModuleBasic{})
+	cdc := encCfg.Codec
+
+	storeKey := storetypes.NewKVStoreKey(v7.ModuleName)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	store := ctx.KVStore(storeKey)
+
+	// v6 params do not have the min termination notice
+	oldParams := types.Params{
+		ValidatorFee:       mathmod.LegacyNewDecWithPrec(2, 2),
+		AllowedDenoms:      []string{"nund"},
+		MinDuration:        120,
+		MaxFlowRate:        1000,
+		FeeCollectorRatio:  mathmod.LegacyNewDecWithPrec(5, 1),
+		CommunityPoolRatio: mathmod.LegacyNewDecWithPrec(3, 1),
+		BurnRatio:          mathmod.LegacyNewDecWithPrec(2, 1),
+	}
+	store.Set(types.ParamsKey, cdc.MustMarshal(&oldParams))
+
+	// Run migrations.
+	err := v7.Migrate(ctx, store, cdc)
+	require.NoError(t, err)
+
+	var params types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &params)
+	require.Equal(t, oldParams.ValidatorFee, params.ValidatorFee)
+	require.Equal(t, oldParams.AllowedDenoms, params.AllowedDenoms)
+	require.Equal(t, oldParams.MinDuration, params.MinDuration)
+	require.Equal(t, oldParams.MaxFlowRate, params.MaxFlowRate)
+	require.True(t, params.FeeCollectorRatio.Equal(oldParams.FeeCollectorRatio))
+	require.True(t, params.CommunityPoolRatio.Equal(oldParams.CommunityPoolRatio))
+	require.True(t, params.BurnRatio.Equal(oldParams.BurnRatio))
+	require.Equal(t, types.DefaultMinTerminationNotice, params.MinTerminationNotice)
+	require.Equal(t, types.DefaultMaxClaimFeeRatio, params.MaxClaimFeeRatio)
+}
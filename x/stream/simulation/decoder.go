@@ -20,6 +20,34 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &streamA)
 			cdc.MustUnmarshal(kvB.Value, &streamB)
 			return fmt.Sprintf("%v\n%v", streamA, streamB)
+		case bytes.Equal(kvA.Key[:1], types.StreamExpiryQueuePrefix):
+			dztA, streamIDA := types.SplitStreamExpiryQueueKey(kvA.Key)
+			dztB, streamIDB := types.SplitStreamExpiryQueueKey(kvB.Key)
+			return fmt.Sprintf("%s %d\n%s %d", dztA, streamIDA, dztB, streamIDB)
+		case bytes.Equal(kvA.Key[:1], types.StreamsByReceiverSenderPrefix):
+			receiverA, senderA, streamIDA := types.SplitStreamByReceiverSenderIndexKey(kvA.Key)
+			receiverB, senderB, streamIDB := types.SplitStreamByReceiverSenderIndexKey(kvB.Key)
+			return fmt.Sprintf("%s %s %d\n%s %s %d", receiverA, senderA, streamIDA, receiverB, senderB, streamIDB)
+		case bytes.Equal(kvA.Key[:1], types.StreamStatsKeyPrefix):
+			var statsA, statsB types.StreamStats
+			cdc.MustUnmarshal(kvA.Value, &statsA)
+			cdc.MustUnmarshal(kvB.Value, &statsB)
+			return fmt.Sprintf("%v\n%v", statsA, statsB)
+		case bytes.Equal(kvA.Key[:1], types.StreamHistoryKeyPrefix):
+			var historyA, historyB types.StreamHistory
+			cdc.MustUnmarshal(kvA.Value, &historyA)
+			cdc.MustUnmarshal(kvB.Value, &historyB)
+			return fmt.Sprintf("%v\n%v", historyA, historyB)
+		case bytes.Equal(kvA.Key[:1], types.StreamLowDepositQueuePrefix):
+			alertTimeA, streamIDA := types.SplitStreamLowDepositQueueKey(kvA.Key)
+			alertTimeB, streamIDB := types.SplitStreamLowDepositQueueKey(kvB.Key)
+			return fmt.Sprintf("%s %d\n%s %d", alertTimeA, streamIDA, alertTimeB, streamIDB)
+		case bytes.Equal(kvA.Key[:1], types.StreamAuditPruneQueuePrefix):
+			pruneTimeA, streamIDA := types.SplitStreamAuditPruneQueueKey(kvA.Key)
+			pruneTimeB, streamIDB := types.SplitStreamAuditPruneQueueKey(kvB.Key)
+			return fmt.Sprintf("%s %d\n%s %d", pruneTimeA, streamIDA, pruneTimeB, streamIDB)
+		case bytes.Equal(kvA.Key[:1], types.HighestStreamIDKey):
+			return fmt.Sprintf("%d\n%d", types.GetStreamIDFromBytes(kvA.Value), types.GetStreamIDFromBytes(kvB.Value))
 		default:
 			panic(fmt.Sprintf("invalid stream key %X", kvA.Key))
 		}
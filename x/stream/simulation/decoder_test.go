@@ -30,11 +30,33 @@ func TestDecodeStore(t *testing.T) {
 		Cancellable:     true,
 	}
 
+	receiverAddr := sdk.AccAddress("receiver____________")
+	senderAddr := sdk.AccAddress("sender______________")
+	depositZeroTime := time.Unix(1700000000, 0).UTC()
+
 	streamBz, err := encCfg.Codec.Marshal(&newStream)
 	require.NoError(t, err)
+
+	stats := types.NewStreamStats(1, sdk.DefaultBondDenom)
+	statsBz, err := encCfg.Codec.Marshal(&stats)
+	require.NoError(t, err)
+
+	history := types.StreamHistory{
+		StreamId:        1,
+		FlowRateChanges: []types.FlowRateChange{{OldFlowRate: 1, NewFlowRate: 2, ChangedAt: depositZeroTime, BlockHeight: 10}},
+	}
+	historyBz, err := encCfg.Codec.Marshal(&history)
+	require.NoError(t, err)
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: []byte(types.StreamKeyPrefix), Value: streamBz},
+			{Key: types.GetStreamExpiryQueueKey(depositZeroTime, 1), Value: []byte{}},
+			{Key: types.GetStreamByReceiverSenderIndexKey(receiverAddr, senderAddr, 1), Value: []byte{}},
+			{Key: types.GetStreamStatsKey(1), Value: statsBz},
+			{Key: types.GetStreamHistoryKey(1), Value: historyBz},
+			{Key: types.GetStreamLowDepositQueueKey(depositZeroTime, 1), Value: []byte{}},
+			{Key: types.GetStreamAuditPruneQueueKey(depositZeroTime, 1), Value: []byte{}},
+			{Key: types.HighestStreamIDKey, Value: types.GetStreamIDBytes(2)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -45,6 +67,13 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Stream", false, fmt.Sprintf("%v\n%v", newStream, newStream)},
+		{"StreamExpiryQueue", false, fmt.Sprintf("%s %d\n%s %d", depositZeroTime, 1, depositZeroTime, 1)},
+		{"StreamsByReceiverSender", false, fmt.Sprintf("%s %s %d\n%s %s %d", receiverAddr, senderAddr, 1, receiverAddr, senderAddr, 1)},
+		{"StreamStats", false, fmt.Sprintf("%v\n%v", stats, stats)},
+		{"StreamHistory", false, fmt.Sprintf("%v\n%v", history, history)},
+		{"StreamLowDepositQueue", false, fmt.Sprintf("%s %d\n%s %d", depositZeroTime, 1, depositZeroTime, 1)},
+		{"StreamAuditPruneQueue", false, fmt.Sprintf("%s %d\n%s %d", depositZeroTime, 1, depositZeroTime, 1)},
+		{"HighestStreamID", false, fmt.Sprintf("%d\n%d", 2, 2)},
 		{"other", true, ""},
 	}
 
@@ -32,9 +32,9 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { validatorFee = GenValidatorFee(r) },
 	)
 
-	params := types.NewParams(validatorFee)
+	params := types.NewParams(validatorFee, types.DefaultAllowedDenoms, types.DefaultMinDuration, types.DefaultMinDeposit, types.DefaultMaxFlowRate, types.DefaultFeeCollectorRatio, types.DefaultCommunityPoolRatio, types.DefaultBurnRatio, types.DefaultMinTerminationNotice, types.DefaultMaxClaimFeeRatio)
 
-	streamGenState := types.NewGenesisState(streams, params)
+	streamGenState := types.NewGenesisState(streams, params, types.DefaultStartingStreamID, []types.StreamStats{}, []types.StreamHistory{}, []types.StreamAuditPrune{})
 	bz, err := json.MarshalIndent(&streamGenState, "", " ")
 	if err != nil {
 		panic(err)
@@ -99,6 +99,23 @@ func WeightedOperations(
 	}
 }
 
+// findRandomStream attempts to find a random receiver/sender pair with at least one stream, and
+// returns one of the pair's streams at random
+func findRandomStream(r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simtypes.Account) (simtypes.Account, simtypes.Account, types.Stream, bool) {
+	maxTries := 2000
+
+	for i := 0; i < maxTries; i += 1 {
+		sen, _ := simtypes.RandomAcc(r, accs)
+		rec, _ := simtypes.RandomAcc(r, accs)
+		streams := k.GetStreamsForReceiverSender(ctx, rec.Address, sen.Address)
+		if len(streams) > 0 {
+			return sen, rec, streams[r.Intn(len(streams))], true
+		}
+	}
+
+	return simtypes.Account{}, simtypes.Account{}, types.Stream{}, false
+}
+
 // SimulateMsgCreateStream generates MsgCreateStream with random values.
 func SimulateMsgCreateStream(ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
 	return func(
@@ -111,10 +128,13 @@ func SimulateMsgCreateStream(ak types.AccountKeeper, bk types.BankKeeper, k keep
 			return simtypes.NoOpMsg(types.ModuleName, types.CreateStreamAction, "sender and receiver cannot be same"), nil, nil
 		}
 
-		if k.IsStream(ctx, receiver.Address, sender.Address) {
-			return simtypes.NoOpMsg(types.ModuleName, types.CreateStreamAction, "stream exists"), nil, nil
+		params := k.GetParams(ctx)
+		if len(params.AllowedDenoms) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, types.CreateStreamAction, "no allowed denoms"), nil, nil
 		}
 
+		denom := params.AllowedDenoms[r.Intn(len(params.AllowedDenoms))]
+
 		simAccount, _ := simtypes.FindAccount(accs, sender.Address)
 		if simAccount.PrivKey == nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.CreateStreamAction, "account private key is nil"), nil, nil // skip
@@ -123,7 +143,7 @@ func SimulateMsgCreateStream(ak types.AccountKeeper, bk types.BankKeeper, k keep
 		senderAccount := ak.GetAccount(ctx, sender.Address)
 
 		// ToDO - Spendable... no longer implemented in x/bank
-		spendable := bk.SpendableCoin(ctx, senderAccount.GetAddress(), sdk.DefaultBondDenom)
+		spendable := bk.SpendableCoin(ctx, senderAccount.GetAddress(), denom)
 
 		depositAmnt, err := simtypes.RandPositiveInt(r, spendable.Amount)
 
@@ -131,13 +151,22 @@ func SimulateMsgCreateStream(ak types.AccountKeeper, bk types.BankKeeper, k keep
 			return simtypes.NoOpMsg(types.ModuleName, types.CreateStreamAction, err.Error()), nil, nil
 		}
 
-		if depositAmnt.LT(mathmod.NewIntFromUint64(60)) {
+		minDuration := params.MinDuration
+		if minDuration == 0 {
+			minDuration = 1
+		}
+
+		if depositAmnt.LT(mathmod.NewIntFromUint64(minDuration)) || depositAmnt.LT(params.MinDeposit.AmountOf(denom)) {
 			return simtypes.NoOpMsg(types.ModuleName, types.CreateStreamAction, "depositAmnt too small"), nil, nil
 		}
 
-		deposit := sdk.NewCoin(sdk.DefaultBondDenom, depositAmnt)
+		deposit := sdk.NewCoin(denom, depositAmnt)
+
+		maxFlowRate := deposit.Amount.Quo(mathmod.NewIntFromUint64(minDuration))
 
-		maxFlowRate := deposit.Amount.Quo(mathmod.NewIntFromUint64(60))
+		if params.MaxFlowRate > 0 && maxFlowRate.GT(mathmod.NewInt(params.MaxFlowRate)) {
+			maxFlowRate = mathmod.NewInt(params.MaxFlowRate)
+		}
 
 		if maxFlowRate.LTE(mathmod.NewIntFromUint64(1)) {
 			return simtypes.NoOpMsg(types.ModuleName, types.CreateStreamAction, "maxFlowRate too low"), nil, nil
@@ -151,13 +180,13 @@ func SimulateMsgCreateStream(ak types.AccountKeeper, bk types.BankKeeper, k keep
 		// GenSignedMockTx is used instead of GenAndDeliverTxWithRandFees
 		var fees sdk.Coins
 		var feeErr error
-		remainingCoins, err := spendable.SafeSub(msg.Deposit)
+		remainingCoins, hasNeg := bk.SpendableCoins(ctx, senderAccount.GetAddress()).SafeSub(msg.Deposit)
 
-		if err != nil {
-			return simtypes.NoOpMsg(types.ModuleName, types.CreateStreamAction, err.Error()), nil, nil
+		if hasNeg {
+			return simtypes.NoOpMsg(types.ModuleName, types.CreateStreamAction, "not enough for deposit"), nil, nil
 		}
 
-		fees, feeErr = simtypes.RandomFees(r, ctx, sdk.NewCoins(remainingCoins))
+		fees, feeErr = simtypes.RandomFees(r, ctx, remainingCoins)
 		if feeErr != nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.CreateStreamAction, "not enough for fees"), nil, nil
 		}
@@ -196,30 +225,14 @@ func SimulateMsgClaimStream(ak types.AccountKeeper, bk types.BankKeeper, k keepe
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 
-		var sender simtypes.Account
-		var receiver simtypes.Account
-		maxTries := 2000
-		haveStream := false
-
-		for i := 0; i < maxTries; i += 1 {
-			sen, _ := simtypes.RandomAcc(r, accs)
-			rec, _ := simtypes.RandomAcc(r, accs)
-			if k.IsStream(ctx, rec.Address, sen.Address) {
-				haveStream = true
-				sender = sen
-				receiver = rec
-				break
-			}
-		}
+		_, receiver, stream, haveStream := findRandomStream(r, ctx, k, accs)
 
 		if !haveStream {
 			return simtypes.NoOpMsg(types.ModuleName, types.ClaimStreamAction, "suitable stream not found"), nil, nil
 		}
 
-		stream, _ := k.GetStream(ctx, receiver.Address, sender.Address)
-
 		nowTime := ctx.BlockTime()
-		claimTotal, _ := types.CalculateAmountToClaim(nowTime, stream.DepositZeroTime, stream.LastOutflowTime, stream.Deposit, stream.FlowRate)
+		claimTotal, _ := types.CalculateAmountToClaim(nowTime, stream.DepositZeroTime, stream.LastOutflowTime, stream.CliffTime, stream.EndTime, stream.Deposit, stream.FlowRate)
 
 		if claimTotal.IsZero() || claimTotal.IsNegative() || claimTotal.IsNil() {
 			return simtypes.NoOpMsg(types.ModuleName, types.ClaimStreamAction, "nothing to claim"), nil, nil
@@ -230,7 +243,7 @@ func SimulateMsgClaimStream(ak types.AccountKeeper, bk types.BankKeeper, k keepe
 			return simtypes.NoOpMsg(types.ModuleName, types.ClaimStreamAction, "account private key is nil"), nil, nil // skip
 		}
 
-		msg := types.NewMsgClaimStream(receiver.Address, sender.Address)
+		msg := types.NewMsgClaimStreamById(receiver.Address, stream.StreamId)
 
 		txCtx := simulation.OperationInput{
 			R:             r,
@@ -255,21 +268,7 @@ func SimulateMsgTopUpDeposit(ak types.AccountKeeper, bk types.BankKeeper, k keep
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 
-		var sender simtypes.Account
-		var receiver simtypes.Account
-		maxTries := 2000
-		haveStream := false
-
-		for i := 0; i < maxTries; i += 1 {
-			sen, _ := simtypes.RandomAcc(r, accs)
-			rec, _ := simtypes.RandomAcc(r, accs)
-			if k.IsStream(ctx, rec.Address, sen.Address) {
-				haveStream = true
-				sender = sen
-				receiver = rec
-				break
-			}
-		}
+		sender, _, stream, haveStream := findRandomStream(r, ctx, k, accs)
 
 		if !haveStream {
 			return simtypes.NoOpMsg(types.ModuleName, types.TopUpDepositAction, "suitable stream not found"), nil, nil
@@ -282,7 +281,7 @@ func SimulateMsgTopUpDeposit(ak types.AccountKeeper, bk types.BankKeeper, k keep
 
 		senderAccount := ak.GetAccount(ctx, sender.Address)
 
-		spendable := bk.SpendableCoin(ctx, senderAccount.GetAddress(), sdk.DefaultBondDenom)
+		spendable := bk.SpendableCoin(ctx, senderAccount.GetAddress(), stream.Deposit.Denom)
 
 		depositAmnt, err := simtypes.RandPositiveInt(r, spendable.Amount)
 
@@ -294,20 +293,24 @@ func SimulateMsgTopUpDeposit(ak types.AccountKeeper, bk types.BankKeeper, k keep
 			return simtypes.NoOpMsg(types.ModuleName, types.TopUpDepositAction, "depositAmnt too small"), nil, nil
 		}
 
-		deposit := sdk.NewCoin(sdk.DefaultBondDenom, depositAmnt)
+		deposit := sdk.NewCoin(stream.Deposit.Denom, depositAmnt)
 
-		msg := types.NewMsgTopUpDeposit(receiver.Address, sender.Address, deposit)
+		if err := k.GetParams(ctx).CheckDeposit(deposit); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.TopUpDepositAction, "depositAmnt less than min deposit"), nil, nil
+		}
+
+		msg := types.NewMsgTopUpDepositById(sender.Address, stream.StreamId, deposit)
 
 		// fees
 		var fees sdk.Coins
 		var feeErr error
-		remainingCoins, err := spendable.SafeSub(msg.Deposit)
+		remainingCoins, hasNeg := bk.SpendableCoins(ctx, senderAccount.GetAddress()).SafeSub(msg.Deposit)
 
-		if err != nil {
-			return simtypes.NoOpMsg(types.ModuleName, types.TopUpDepositAction, err.Error()), nil, nil
+		if hasNeg {
+			return simtypes.NoOpMsg(types.ModuleName, types.TopUpDepositAction, "not enough for deposit"), nil, nil
 		}
 
-		fees, feeErr = simtypes.RandomFees(r, ctx, sdk.NewCoins(remainingCoins))
+		fees, feeErr = simtypes.RandomFees(r, ctx, remainingCoins)
 		if feeErr != nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.TopUpDepositAction, "not enough for fees"), nil, nil
 		}
@@ -346,28 +349,12 @@ func SimulateMsgUpdateFlowRate(ak types.AccountKeeper, bk types.BankKeeper, k ke
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 
-		var sender simtypes.Account
-		var receiver simtypes.Account
-		maxTries := 2000
-		haveStream := false
-
-		for i := 0; i < maxTries; i += 1 {
-			sen, _ := simtypes.RandomAcc(r, accs)
-			rec, _ := simtypes.RandomAcc(r, accs)
-			if k.IsStream(ctx, rec.Address, sen.Address) {
-				haveStream = true
-				sender = sen
-				receiver = rec
-				break
-			}
-		}
+		sender, _, stream, haveStream := findRandomStream(r, ctx, k, accs)
 
 		if !haveStream {
 			return simtypes.NoOpMsg(types.ModuleName, types.UpdateFlowRateAction, "suitable stream not found"), nil, nil
 		}
 
-		stream, _ := k.GetStream(ctx, receiver.Address, sender.Address)
-
 		simAccount, _ := simtypes.FindAccount(accs, sender.Address)
 		if simAccount.PrivKey == nil {
 			return simtypes.NoOpMsg(types.ModuleName, types.UpdateFlowRateAction, "account private key is nil"), nil, nil // skip
@@ -388,7 +375,11 @@ func SimulateMsgUpdateFlowRate(ak types.AccountKeeper, bk types.BankKeeper, k ke
 			return simtypes.NoOpMsg(types.ModuleName, types.UpdateFlowRateAction, "new flow must be greater than zero"), nil, nil
 		}
 
-		msg := types.NewMsgUpdateFlowRate(receiver.Address, sender.Address, newFlow)
+		if err := k.GetParams(ctx).CheckFlowRate(newFlow); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.UpdateFlowRateAction, "new flow greater than max flow rate"), nil, nil
+		}
+
+		msg := types.NewMsgUpdateFlowRateById(sender.Address, stream.StreamId, newFlow)
 
 		txCtx := simulation.OperationInput{
 			R:             r,
@@ -413,21 +404,7 @@ func SimulateMsgCancelStream(ak types.AccountKeeper, bk types.BankKeeper, k keep
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 
-		var sender simtypes.Account
-		var receiver simtypes.Account
-		maxTries := 2000
-		haveStream := false
-
-		for i := 0; i < maxTries; i += 1 {
-			sen, _ := simtypes.RandomAcc(r, accs)
-			rec, _ := simtypes.RandomAcc(r, accs)
-			if k.IsStream(ctx, rec.Address, sen.Address) {
-				haveStream = true
-				sender = sen
-				receiver = rec
-				break
-			}
-		}
+		sender, _, stream, haveStream := findRandomStream(r, ctx, k, accs)
 
 		if !haveStream {
 			return simtypes.NoOpMsg(types.ModuleName, types.CancelStreamAction, "suitable stream not found"), nil, nil
@@ -438,7 +415,7 @@ func SimulateMsgCancelStream(ak types.AccountKeeper, bk types.BankKeeper, k keep
 			return simtypes.NoOpMsg(types.ModuleName, types.CancelStreamAction, "account private key is nil"), nil, nil // skip
 		}
 
-		msg := types.NewMsgCancelStream(receiver.Address, sender.Address)
+		msg := types.NewMsgCancelStreamById(sender.Address, stream.StreamId)
 
 		txCtx := simulation.OperationInput{
 			R:             r,
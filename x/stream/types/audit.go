@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+
+	mathmod "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CheckStreamDeposit returns an error if the stream's deposit is nil or negative
+func CheckStreamDeposit(stream Stream) error {
+	if stream.Deposit.IsNil() || stream.Deposit.IsNegative() {
+		return fmt.Errorf("stream %d has a nil or negative deposit: %s", stream.StreamId, stream.Deposit.String())
+	}
+	return nil
+}
+
+// CheckStreamDepositZeroTime returns an error if the stream's deposit zero time is not consistent with its last outflow
+// time, deposit and flow rate. The deposit zero time must not be before the last outflow time, and the deposit must
+// cover the flow until the deposit zero time, i.e. it must not be later than last_outflow_time + deposit/flow_rate.
+// It may be slightly earlier, since durations are rounded down each time a deposit is added, and an end time may
+// have capped it. Paused streams, and streams with no remaining deposit are not checked, since the deposit zero
+// time is recalculated when they are resumed or topped up. Nor are streams claimed up to their end time, whose
+// remaining deposit is refunded to the sender when they are settled.
+func CheckStreamDepositZeroTime(stream Stream) error {
+	if stream.IsPaused() || stream.Deposit.IsNil() || !stream.Deposit.IsPositive() || stream.HasEnded(stream.LastOutflowTime) {
+		return nil
+	}
+
+	if stream.DepositZeroTime.Before(stream.LastOutflowTime) {
+		return fmt.Errorf("stream %d deposit zero time %s is before its last outflow time %s",
+			stream.StreamId, stream.DepositZeroTime.String(), stream.LastOutflowTime.String())
+	}
+
+	if stream.FlowRate <= 0 {
+		return nil
+	}
+
+	flowSeconds := int64(stream.DepositZeroTime.Sub(stream.LastOutflowTime).Seconds())
+	flowed := sdk.NewCoin(stream.Deposit.Denom, mathmod.NewInt(flowSeconds).MulRaw(stream.FlowRate))
+
+	if stream.Deposit.IsLT(flowed) {
+		return fmt.Errorf("stream %d deposit %s does not cover %s flowing at %d per second from %s until its deposit zero time %s",
+			stream.StreamId, stream.Deposit.String(), flowed.String(), stream.FlowRate, stream.LastOutflowTime.String(), stream.DepositZeroTime.String())
+	}
+
+	return nil
+}
+
+// CheckTotalDeposits returns an error if the sum of every stream's deposit does not equal the stream module
+// account's balance
+func CheckTotalDeposits(streams []Stream, moduleBalance sdk.Coins) error {
+	totalDeposits := sdk.NewCoins()
+	for _, stream := range streams {
+		if stream.Deposit.IsNil() || stream.Deposit.IsNegative() {
+			continue
+		}
+		totalDeposits = totalDeposits.Add(stream.Deposit)
+	}
+
+	if !totalDeposits.Equal(moduleBalance) {
+		return fmt.Errorf("stream module account balance %s does not equal the sum of stream deposits %s",
+			moduleBalance.String(), totalDeposits.String())
+	}
+
+	return nil
+}
+
+// AuditStreams runs every stream check against the streams and the stream module account's balance, and returns
+// each problem found
+func AuditStreams(streams []Stream, moduleBalance sdk.Coins) []error {
+	var problems []error
+
+	if err := CheckTotalDeposits(streams, moduleBalance); err != nil {
+		problems = append(problems, err)
+	}
+
+	for _, stream := range streams {
+		if err := CheckStreamDeposit(stream); err != nil {
+			problems = append(problems, err)
+			continue
+		}
+		if err := CheckStreamDepositZeroTime(stream); err != nil {
+			problems = append(problems, err)
+		}
+	}
+
+	return problems
+}
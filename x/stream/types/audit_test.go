@@ -0,0 +1,132 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+func TestCheckStreamDeposit(t *testing.T) {
+	testCases := []struct {
+		name      string
+		deposit   sdk.Coin
+		expectErr bool
+	}{
+		{"positive", sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), false},
+		{"zero", sdk.NewInt64Coin(sdk.DefaultBondDenom, 0), false},
+		{"nil", sdk.Coin{}, true},
+		{"negative", sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 1).Amount.Neg()}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.CheckStreamDeposit(types.Stream{StreamId: 1, Deposit: tc.deposit})
+			if tc.expectErr {
+				require.ErrorContains(t, err, "stream 1 has a nil or negative deposit")
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCheckStreamDepositZeroTime(t *testing.T) {
+	lastOutflowTime := time.Unix(1700000000, 0).UTC()
+
+	valid := types.Stream{
+		StreamId:        1,
+		Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+		FlowRate:        10,
+		LastOutflowTime: lastOutflowTime,
+		DepositZeroTime: lastOutflowTime.Add(time.Second * 100),
+	}
+
+	roundedDown := valid
+	roundedDown.Deposit = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1009)
+
+	capped := valid
+	capped.DepositZeroTime = lastOutflowTime.Add(time.Second * 50)
+	capped.EndTime = capped.DepositZeroTime
+
+	tooLate := valid
+	tooLate.DepositZeroTime = lastOutflowTime.Add(time.Second * 101)
+
+	beforeLastOutflow := valid
+	beforeLastOutflow.DepositZeroTime = lastOutflowTime.Add(-time.Second)
+
+	paused := tooLate
+	paused.PausedAt = lastOutflowTime
+
+	noDeposit := tooLate
+	noDeposit.Deposit = sdk.NewInt64Coin(sdk.DefaultBondDenom, 0)
+
+	claimedToEnd := beforeLastOutflow
+	claimedToEnd.EndTime = lastOutflowTime
+
+	testCases := []struct {
+		name      string
+		stream    types.Stream
+		expErrMsg string
+	}{
+		{"valid", valid, ""},
+		{"duration rounded down", roundedDown, ""},
+		{"capped by end time", capped, ""},
+		{"paused not checked", paused, ""},
+		{"no deposit not checked", noDeposit, ""},
+		{"claimed to end time not checked", claimedToEnd, ""},
+		{"deposit does not cover flow", tooLate, "stream 1 deposit 1000nund does not cover 1010nund"},
+		{"before last outflow time", beforeLastOutflow, "is before its last outflow time"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.CheckStreamDepositZeroTime(tc.stream)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAuditStreams(t *testing.T) {
+	lastOutflowTime := time.Unix(1700000000, 0).UTC()
+
+	streams := []types.Stream{
+		{
+			StreamId:        1,
+			Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+			FlowRate:        10,
+			LastOutflowTime: lastOutflowTime,
+			DepositZeroTime: lastOutflowTime.Add(time.Second * 100),
+		},
+		{
+			StreamId:        2,
+			Deposit:         sdk.NewInt64Coin("uatom", 500),
+			FlowRate:        1,
+			LastOutflowTime: lastOutflowTime,
+			DepositZeroTime: lastOutflowTime.Add(time.Second * 500),
+		},
+	}
+
+	problems := types.AuditStreams(streams, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), sdk.NewInt64Coin("uatom", 500)))
+	require.Empty(t, problems)
+
+	// module account balance does not match
+	problems = types.AuditStreams(streams, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	require.Len(t, problems, 1)
+	require.ErrorContains(t, problems[0], "stream module account balance 1000nund does not equal the sum of stream deposits 1000nund,500uatom")
+
+	// invalid deposit zero time and nil deposit
+	streams[0].DepositZeroTime = lastOutflowTime.Add(time.Second * 200)
+	streams[1].Deposit = sdk.Coin{}
+	problems = types.AuditStreams(streams, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+	require.Len(t, problems, 2)
+	require.ErrorContains(t, problems[0], "stream 1 deposit 1000nund does not cover 2000nund")
+	require.ErrorContains(t, problems[1], "stream 2 has a nil or negative deposit")
+}
@@ -17,6 +17,18 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgTopUpDeposit{}, "stream/MsgTopUpDeposit")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateFlowRate{}, "stream/MsgUpdateFlowRate")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelStream{}, "stream/MsgCancelStream")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimStreamById{}, "stream/MsgClaimStreamById")
+	legacy.RegisterAminoMsg(cdc, &MsgTopUpDepositById{}, "stream/MsgTopUpDepositById")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateFlowRateById{}, "stream/MsgUpdateFlowRateById")
+	legacy.RegisterAminoMsg(cdc, &MsgCancelStreamById{}, "stream/MsgCancelStreamById")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimAllStreams{}, "stream/MsgClaimAllStreams")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferStreamReceiver{}, "stream/MsgTransferStreamReceiver")
+	legacy.RegisterAminoMsg(cdc, &MsgPauseStream{}, "stream/MsgPauseStream")
+	legacy.RegisterAminoMsg(cdc, &MsgResumeStream{}, "stream/MsgResumeStream")
+	legacy.RegisterAminoMsg(cdc, &MsgSetLowDepositThreshold{}, "stream/MsgSetLowDepositThreshold")
+	legacy.RegisterAminoMsg(cdc, &MsgRequestStreamTermination{}, "stream/MsgRequestStreamTermination")
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptStreamTermination{}, "stream/MsgAcceptStreamTermination")
+	legacy.RegisterAminoMsg(cdc, &MsgAcceptPausableStream{}, "stream/MsgAcceptPausableStream")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "mainchain/x/stream/MsgUpdateParams")
 }
 
@@ -27,6 +39,18 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgTopUpDeposit{},
 		&MsgUpdateFlowRate{},
 		&MsgCancelStream{},
+		&MsgClaimStreamById{},
+		&MsgTopUpDepositById{},
+		&MsgUpdateFlowRateById{},
+		&MsgCancelStreamById{},
+		&MsgClaimAllStreams{},
+		&MsgTransferStreamReceiver{},
+		&MsgPauseStream{},
+		&MsgResumeStream{},
+		&MsgSetLowDepositThreshold{},
+		&MsgRequestStreamTermination{},
+		&MsgAcceptStreamTermination{},
+		&MsgAcceptPausableStream{},
 		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -15,18 +15,32 @@ const (
 	CodeContentTooLarge     = 204
 	CodeInvalidData         = 205
 	CodeNotCancellable      = 206
+	CodeDenomNotAllowed     = 207
+	CodeNotPausable         = 208
+	CodeStreamPaused        = 209
+	CodeStreamNotPaused     = 210
+	CodeTerminationNotReq   = 211
 
 	CodeInsufficientDeposit = 301
+	CodeInsufficientClaim   = 302
 )
 
 // x/stream module sentinel errors
 var (
+	ErrInvalidGenesis = errorsmod.Register(ModuleName, CodeInvalidGenesis, "invalid genesis")
+
 	ErrStreamDoesNotExist   = errorsmod.Register(ModuleName, CodeStreamDoesNotExist, "stream does not exist")
 	ErrStreamExists         = errorsmod.Register(ModuleName, CodeStreamAlreadyExists, "stream exists")
 	ErrInvalidData          = errorsmod.Register(ModuleName, CodeInvalidData, "invalid data")
 	ErrMissingData          = errorsmod.Register(ModuleName, CodeMissingData, "missing data")
 	ErrContentTooLarge      = errorsmod.Register(ModuleName, CodeContentTooLarge, "msg content too large")
 	ErrStreamNotCancellable = errorsmod.Register(ModuleName, CodeNotCancellable, "stream not cancellable")
+	ErrDenomNotAllowed      = errorsmod.Register(ModuleName, CodeDenomNotAllowed, "denom not allowed")
+	ErrStreamNotPausable    = errorsmod.Register(ModuleName, CodeNotPausable, "stream not pausable")
+	ErrStreamPaused         = errorsmod.Register(ModuleName, CodeStreamPaused, "stream paused")
+	ErrStreamNotPaused      = errorsmod.Register(ModuleName, CodeStreamNotPaused, "stream not paused")
+	ErrTerminationNotReq    = errorsmod.Register(ModuleName, CodeTerminationNotReq, "stream termination not requested")
 
 	ErrInsufficientDeposit = errorsmod.Register(ModuleName, CodeInsufficientDeposit, "insufficient deposit")
+	ErrInsufficientClaim   = errorsmod.Register(ModuleName, CodeInsufficientClaim, "insufficient claim to pay fees")
 )
@@ -1,12 +1,24 @@
 package types
 
 const (
-	EventTypeCreateStreamAction = "create_stream"
-	EventTypeDepositToStream    = "stream_deposit"
-	EventTypeClaimStreamAction  = "claim_stream"
-	EventTypeUpdateFlowRate     = "update_flow_rate"
-	EventTypeStreamCancelled    = "cancel_stream"
+	EventTypeCreateStreamAction     = "create_stream"
+	EventTypeDepositToStream        = "stream_deposit"
+	EventTypeClaimStreamAction      = "claim_stream"
+	EventTypeUpdateFlowRate         = "update_flow_rate"
+	EventTypeStreamCancelled        = "cancel_stream"
+	EventTypeStreamSettled          = "stream_settled"
+	EventTypeClaimAllStreams        = "claim_all_streams"
+	EventTypeStreamTransferred      = "stream_transferred"
+	EventTypeStreamPaused           = "stream_paused"
+	EventTypeStreamResumed          = "stream_resumed"
+	EventTypeStreamLowDeposit       = "stream_low_deposit"
+	EventTypeSetLowDepositThreshold = "set_low_deposit_threshold"
+	EventTypeTerminationRequested   = "stream_termination_requested"
+	EventTypeStreamTerminated       = "stream_terminated"
+	EventTypeStreamSettleFailed     = "stream_settle_failed"
+	EventTypePausableAccepted       = "stream_pausable_accepted"
 
+	AttributeKeyStreamId            = "stream_id"
 	AttributeKeyStreamSender        = "sender"
 	AttributeKeyStreamReceiver      = "receiver"
 	AttributeKeyFlowRate            = "flow_rate"
@@ -15,9 +27,26 @@ const (
 	AttributeKeyDepositZeroTime     = "deposit_zero_time"
 	AttributeKeyClaimAmountReceived = "amount_received"
 	AttributeKeyClaimValidatorFee   = "validator_fee"
+	AttributeKeyClaimFeeCollector   = "fee_collector_amount"
+	AttributeKeyClaimCommunityPool  = "community_pool_amount"
+	AttributeKeyClaimBurned         = "burned_amount"
 	AttributeKeyClaimTotal          = "claim_total"
 	AttributeKeyOldFlowRate         = "old_flow_rate"
 	AttributeKeyNewFlowRate         = "new_flow_rate"
 	AttributeKeyRemainingDeposit    = "remaining_deposit"
 	AttributeKeyRefundAmount        = "refund_amount"
+	AttributeKeyStartTime           = "start_time"
+	AttributeKeyCliffTime           = "cliff_time"
+	AttributeKeyEndTime             = "end_time"
+	AttributeKeyNumStreamsClaimed   = "num_streams_claimed"
+	AttributeKeyOldReceiver         = "old_receiver"
+	AttributeKeyNewReceiver         = "new_receiver"
+	AttributeKeyPausable            = "pausable"
+	AttributeKeyPausedAt            = "paused_at"
+	AttributeKeyPausedDuration      = "paused_duration"
+	AttributeKeyLowDepositThreshold = "low_deposit_threshold"
+	AttributeKeyRemainingDuration   = "remaining_duration"
+	AttributeKeyNoticeDuration      = "notice_duration"
+	AttributeKeyTerminationTime     = "termination_time"
+	AttributeKeyError               = "error"
 )
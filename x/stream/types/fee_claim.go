@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// feeClaimContextKey is the context key under which a claim made by the ante handler is stored
+type feeClaimContextKey struct{}
+
+// WithFeeClaim returns a copy of the context recording a claim made by the ante handler to pay a Tx's fees.
+// The claim's Msg handler uses it as its result instead of claiming from the stream a second time
+func WithFeeClaim(ctx sdk.Context, claim StreamClaimResult) sdk.Context {
+	return ctx.WithValue(feeClaimContextKey{}, claim)
+}
+
+// GetFeeClaim returns the claim made by the ante handler for the given stream, if any
+func GetFeeClaim(ctx sdk.Context, streamID uint64) (StreamClaimResult, bool) {
+	claim, ok := ctx.Value(feeClaimContextKey{}).(StreamClaimResult)
+	if !ok || claim.StreamId != streamID {
+		return StreamClaimResult{}, false
+	}
+	return claim, true
+}
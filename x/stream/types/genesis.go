@@ -1,25 +1,122 @@
 package types
 
+import (
+	"fmt"
+)
+
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
+// DefaultStartingStreamID is the ID assigned to the first stream
+const DefaultStartingStreamID uint64 = 1
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:  DefaultParams(),
-		Streams: []StreamExport{},
+		Params:           DefaultParams(),
+		Streams:          []StreamExport{},
+		StartingStreamId: DefaultStartingStreamID,
+		Stats:            []StreamStats{},
+		Histories:        []StreamHistory{},
+		AuditPrunes:      []StreamAuditPrune{},
 	}
 }
 
-func NewGenesisState(streams []StreamExport, params Params) *GenesisState {
+func NewGenesisState(streams []StreamExport, params Params, startingStreamID uint64, stats []StreamStats, histories []StreamHistory, auditPrunes []StreamAuditPrune) *GenesisState {
 	return &GenesisState{
-		Params:  params,
-		Streams: streams,
+		Params:           params,
+		Streams:          streams,
+		StartingStreamId: startingStreamID,
+		Stats:            stats,
+		Histories:        histories,
+		AuditPrunes:      auditPrunes,
 	}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.StartingStreamId == 0 {
+		return fmt.Errorf("invalid starting stream ID: %d", gs.StartingStreamId)
+	}
+
+	streamIds := make(map[uint64]bool)
+
+	for _, stream := range gs.Streams {
+		if stream.Stream.StreamId == 0 {
+			return fmt.Errorf("invalid stream: sender %s, receiver %s. Error: Missing ID", stream.Sender, stream.Receiver)
+		}
+		if stream.Stream.StreamId >= gs.StartingStreamId {
+			return fmt.Errorf("invalid stream: ID %d. Error: ID must be lower than starting stream ID %d", stream.Stream.StreamId, gs.StartingStreamId)
+		}
+		if streamIds[stream.Stream.StreamId] {
+			return fmt.Errorf("invalid stream: ID %d. Error: duplicate ID", stream.Stream.StreamId)
+		}
+		streamIds[stream.Stream.StreamId] = true
+
+		if !stream.Stream.CliffTime.IsZero() && stream.Stream.CliffTime.Before(stream.Stream.StartTime) {
+			return fmt.Errorf("invalid stream: ID %d. Error: cliff time before start time", stream.Stream.StreamId)
+		}
+		if !stream.Stream.EndTime.IsZero() && !stream.Stream.EndTime.After(stream.Stream.StartTime) {
+			return fmt.Errorf("invalid stream: ID %d. Error: end time not after start time", stream.Stream.StreamId)
+		}
+		if stream.Stream.PausableAccepted && !stream.Stream.Pausable {
+			return fmt.Errorf("invalid stream: ID %d. Error: pausable accepted for a stream which is not pausable", stream.Stream.StreamId)
+		}
+	}
+
+	statsIds := make(map[uint64]bool)
+
+	for _, stats := range gs.Stats {
+		// deleted streams' audit data is kept until pruned
+		if !streamIds[stats.StreamId] && (stats.StreamId == 0 || stats.StreamId >= gs.StartingStreamId) {
+			return fmt.Errorf("invalid stream stats: ID %d. Error: stream does not exist", stats.StreamId)
+		}
+		if statsIds[stats.StreamId] {
+			return fmt.Errorf("invalid stream stats: ID %d. Error: duplicate ID", stats.StreamId)
+		}
+		statsIds[stats.StreamId] = true
+
+		if err := stats.Validate(); err != nil {
+			return fmt.Errorf("invalid stream stats: ID %d. Error: %w", stats.StreamId, err)
+		}
+	}
+
+	historyIds := make(map[uint64]bool)
+
+	for _, history := range gs.Histories {
+		// deleted streams' audit data is kept until pruned
+		if !streamIds[history.StreamId] && (history.StreamId == 0 || history.StreamId >= gs.StartingStreamId) {
+			return fmt.Errorf("invalid stream history: ID %d. Error: stream does not exist", history.StreamId)
+		}
+		if historyIds[history.StreamId] {
+			return fmt.Errorf("invalid stream history: ID %d. Error: duplicate ID", history.StreamId)
+		}
+		historyIds[history.StreamId] = true
+
+		if err := history.Validate(); err != nil {
+			return fmt.Errorf("invalid stream history: ID %d. Error: %w", history.StreamId, err)
+		}
+	}
+
+	pruneIds := make(map[uint64]bool)
+
+	for _, prune := range gs.AuditPrunes {
+		if streamIds[prune.StreamId] {
+			return fmt.Errorf("invalid stream audit prune: ID %d. Error: stream has not been deleted", prune.StreamId)
+		}
+		if !statsIds[prune.StreamId] && !historyIds[prune.StreamId] {
+			return fmt.Errorf("invalid stream audit prune: ID %d. Error: no stats or history for stream", prune.StreamId)
+		}
+		if pruneIds[prune.StreamId] {
+			return fmt.Errorf("invalid stream audit prune: ID %d. Error: duplicate ID", prune.StreamId)
+		}
+		pruneIds[prune.StreamId] = true
+	}
+
+	return nil
 }
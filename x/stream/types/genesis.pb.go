@@ -8,15 +8,19 @@ import (
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,6 +32,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params  Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	Streams []StreamExport `protobuf:"bytes,2,rep,name=streams,proto3" json:"streams"`
+	// starting_stream_id is the ID that will be assigned to the next new stream
+	StartingStreamId uint64 `protobuf:"varint,3,opt,name=starting_stream_id,json=startingStreamId,proto3" json:"starting_stream_id,omitempty"`
+	// stats are the cumulative claim statistics for streams
+	Stats []StreamStats `protobuf:"bytes,4,rep,name=stats,proto3" json:"stats"`
+	// histories are the flow rate change histories for streams
+	Histories []StreamHistory `protobuf:"bytes,5,rep,name=histories,proto3" json:"histories"`
+	// audit_prunes are the times deleted streams' claim statistics and flow rate change histories are pruned
+	AuditPrunes []StreamAuditPrune `protobuf:"bytes,6,rep,name=audit_prunes,json=auditPrunes,proto3" json:"audit_prunes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +89,34 @@ func (m *GenesisState) GetStreams() []StreamExport {
 	return nil
 }
 
+func (m *GenesisState) GetStartingStreamId() uint64 {
+	if m != nil {
+		return m.StartingStreamId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetStats() []StreamStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHistories() []StreamHistory {
+	if m != nil {
+		return m.Histories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuditPrunes() []StreamAuditPrune {
+	if m != nil {
+		return m.AuditPrunes
+	}
+	return nil
+}
+
 // StreamExport holds genesis export data for a stream
 type StreamExport struct {
 	// receiver is the wallet that will receive stream payments
@@ -141,36 +181,103 @@ func (m *StreamExport) GetStream() Stream {
 	return Stream{}
 }
 
+// StreamAuditPrune holds the time a deleted stream's audit data is pruned
+type StreamAuditPrune struct {
+	// stream_id is the ID of the deleted stream
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// prune_time is the time the deleted stream's claim statistics and flow rate change history are pruned
+	PruneTime time.Time `protobuf:"bytes,2,opt,name=prune_time,json=pruneTime,proto3,stdtime" json:"prune_time"`
+}
+
+func (m *StreamAuditPrune) Reset()         { *m = StreamAuditPrune{} }
+func (m *StreamAuditPrune) String() string { return proto.CompactTextString(m) }
+func (*StreamAuditPrune) ProtoMessage()    {}
+func (*StreamAuditPrune) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d898a81da94e0d10, []int{2}
+}
+func (m *StreamAuditPrune) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamAuditPrune) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamAuditPrune.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamAuditPrune) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamAuditPrune.Merge(m, src)
+}
+func (m *StreamAuditPrune) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamAuditPrune) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamAuditPrune.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamAuditPrune proto.InternalMessageInfo
+
+func (m *StreamAuditPrune) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+func (m *StreamAuditPrune) GetPruneTime() time.Time {
+	if m != nil {
+		return m.PruneTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "mainchain.stream.v1.GenesisState")
 	proto.RegisterType((*StreamExport)(nil), "mainchain.stream.v1.StreamExport")
+	proto.RegisterType((*StreamAuditPrune)(nil), "mainchain.stream.v1.StreamAuditPrune")
 }
 
 func init() { proto.RegisterFile("mainchain/stream/v1/genesis.proto", fileDescriptor_d898a81da94e0d10) }
 
 var fileDescriptor_d898a81da94e0d10 = []byte{
-	// 334 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0x31, 0x4f, 0x3a, 0x31,
-	0x18, 0xc6, 0xaf, 0xf0, 0x0f, 0x7f, 0x2d, 0x4c, 0x27, 0xc3, 0x89, 0x49, 0x05, 0x26, 0x16, 0x7a,
-	0x02, 0x2e, 0x8e, 0x90, 0x18, 0x27, 0x13, 0x03, 0x9b, 0x8b, 0x29, 0x47, 0x3d, 0x3a, 0x5c, 0x7b,
-	0x69, 0x0b, 0xc1, 0xef, 0xe0, 0xe0, 0x57, 0x31, 0xf1, 0x43, 0x30, 0x12, 0x27, 0x27, 0x63, 0xe0,
-	0x8b, 0x98, 0x6b, 0x7b, 0xe8, 0x70, 0xc1, 0xad, 0xef, 0x3d, 0xbf, 0xe7, 0x7d, 0x9f, 0xf7, 0x5e,
-	0xd8, 0x4a, 0x08, 0xe3, 0xd1, 0x9c, 0x30, 0x1e, 0x2a, 0x2d, 0x29, 0x49, 0xc2, 0x65, 0x2f, 0x8c,
-	0x29, 0xa7, 0x8a, 0x29, 0x9c, 0x4a, 0xa1, 0x85, 0x7f, 0xb2, 0x47, 0xb0, 0x45, 0xf0, 0xb2, 0xd7,
-	0xa8, 0xc7, 0x22, 0x16, 0x46, 0x0f, 0xb3, 0x97, 0x45, 0x1b, 0xa7, 0x91, 0x50, 0x89, 0x50, 0x0f,
-	0x56, 0xb0, 0x85, 0x93, 0x9a, 0x45, 0x83, 0x52, 0x22, 0x49, 0x72, 0x90, 0x70, 0x13, 0x0d, 0xd1,
-	0x7e, 0x06, 0xb0, 0x76, 0x63, 0xb3, 0x4d, 0x34, 0xd1, 0xd4, 0xbf, 0x82, 0x15, 0xdb, 0x22, 0x00,
-	0x4d, 0xd0, 0xa9, 0xf6, 0xcf, 0x70, 0x41, 0x56, 0x7c, 0x67, 0x90, 0xd1, 0xbf, 0xf5, 0xe7, 0xb9,
-	0x37, 0x76, 0x06, 0x7f, 0x08, 0xff, 0x5b, 0x42, 0x05, 0xa5, 0x66, 0xb9, 0x53, 0xed, 0xb7, 0x0a,
-	0xbd, 0x13, 0xf3, 0xba, 0x5e, 0xa5, 0x42, 0x6a, 0xd7, 0x21, 0xf7, 0xb5, 0x5f, 0x01, 0xac, 0xfd,
-	0xd6, 0xfd, 0x4b, 0x78, 0x24, 0x69, 0x44, 0xd9, 0x92, 0x4a, 0x13, 0xe8, 0x78, 0x14, 0xbc, 0xbf,
-	0x75, 0xeb, 0xee, 0x3f, 0x0c, 0x67, 0x33, 0x49, 0x95, 0x9a, 0x68, 0xc9, 0x78, 0x3c, 0xde, 0x93,
-	0xfe, 0x05, 0xac, 0x28, 0xca, 0x67, 0x54, 0x06, 0xa5, 0x3f, 0x3c, 0x8e, 0xcb, 0xd6, 0xb6, 0x19,
-	0x82, 0xf2, 0x81, 0xb5, 0x6d, 0xb4, 0x7c, 0x6d, 0xfb, 0x7d, 0x74, 0xbb, 0xde, 0x22, 0xb0, 0xd9,
-	0x22, 0xf0, 0xb5, 0x45, 0xe0, 0x65, 0x87, 0xbc, 0xcd, 0x0e, 0x79, 0x1f, 0x3b, 0xe4, 0xdd, 0x0f,
-	0x62, 0xa6, 0xe7, 0x8b, 0x29, 0x8e, 0x44, 0x12, 0x2e, 0x38, 0x7b, 0x64, 0x11, 0xd1, 0x4c, 0xf0,
-	0x6e, 0x56, 0xff, 0x5c, 0x66, 0x95, 0xdf, 0x46, 0x3f, 0xa5, 0x54, 0x4d, 0x2b, 0xe6, 0x30, 0x83,
-	0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbd, 0x6e, 0x3d, 0x2e, 0x47, 0x02, 0x00, 0x00,
+	// 507 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4f, 0x6f, 0xda, 0x30,
+	0x1c, 0x25, 0x85, 0x32, 0x30, 0x1c, 0x2a, 0xaf, 0x87, 0x8c, 0x4a, 0x21, 0x45, 0x9a, 0xc4, 0x61,
+	0x4d, 0x56, 0xba, 0xcb, 0xa4, 0x5d, 0x60, 0xda, 0xbf, 0xc3, 0xa6, 0x0a, 0x76, 0xda, 0x25, 0x32,
+	0x89, 0x1b, 0x2c, 0x2d, 0x71, 0xe4, 0x9f, 0x83, 0xda, 0x6f, 0xc1, 0x57, 0x99, 0xb4, 0x0f, 0xd1,
+	0x23, 0xda, 0x69, 0xa7, 0x6d, 0x82, 0x2f, 0x32, 0xc5, 0x76, 0x60, 0x9a, 0x50, 0x7a, 0xb3, 0xfd,
+	0x7b, 0xef, 0xfd, 0x9e, 0xfd, 0x7e, 0x46, 0xe7, 0x09, 0x61, 0x69, 0xb8, 0x20, 0x2c, 0xf5, 0x41,
+	0x0a, 0x4a, 0x12, 0x7f, 0x79, 0xe9, 0xc7, 0x34, 0xa5, 0xc0, 0xc0, 0xcb, 0x04, 0x97, 0x1c, 0x3f,
+	0xde, 0x41, 0x3c, 0x0d, 0xf1, 0x96, 0x97, 0xbd, 0xd3, 0x98, 0xc7, 0x5c, 0xd5, 0xfd, 0x62, 0xa5,
+	0xa1, 0xbd, 0x27, 0x21, 0x87, 0x84, 0x43, 0xa0, 0x0b, 0x7a, 0x63, 0x4a, 0xfd, 0x98, 0xf3, 0xf8,
+	0x2b, 0xf5, 0xd5, 0x6e, 0x9e, 0xdf, 0xf8, 0x92, 0x25, 0x14, 0x24, 0x49, 0x32, 0x03, 0x70, 0x0f,
+	0x39, 0xc9, 0x88, 0x20, 0x09, 0x54, 0x21, 0x8c, 0x25, 0x85, 0x18, 0xac, 0xea, 0xa8, 0xfb, 0x4e,
+	0x9b, 0x9f, 0x49, 0x22, 0x29, 0x7e, 0x89, 0x9a, 0x5a, 0xc2, 0xb6, 0x5c, 0x6b, 0xd8, 0x19, 0x9d,
+	0x79, 0x07, 0x2e, 0xe3, 0x5d, 0x2b, 0xc8, 0xa4, 0x71, 0xff, 0xab, 0x5f, 0x9b, 0x1a, 0x02, 0x1e,
+	0xa3, 0x47, 0x1a, 0x01, 0xf6, 0x91, 0x5b, 0x1f, 0x76, 0x46, 0xe7, 0x07, 0xb9, 0x33, 0xb5, 0x7a,
+	0x73, 0x9b, 0x71, 0x21, 0x8d, 0x42, 0xc9, 0xc3, 0xcf, 0x10, 0x06, 0x49, 0x84, 0x64, 0x69, 0x1c,
+	0xe8, 0xb3, 0x80, 0x45, 0x76, 0xdd, 0xb5, 0x86, 0x8d, 0xe9, 0x49, 0x59, 0xd1, 0x02, 0x1f, 0x22,
+	0xfc, 0x0a, 0x1d, 0x83, 0x24, 0x12, 0xec, 0x86, 0x6a, 0xe7, 0x56, 0xb4, 0x2b, 0x2e, 0x57, 0xfa,
+	0xd5, 0x24, 0xfc, 0x16, 0xb5, 0x17, 0x0c, 0x24, 0x17, 0x8c, 0x82, 0x7d, 0xac, 0x14, 0x06, 0x15,
+	0x0a, 0xef, 0x15, 0xf6, 0xce, 0x68, 0xec, 0xa9, 0xf8, 0x13, 0xea, 0x92, 0x3c, 0x62, 0x32, 0xc8,
+	0x44, 0x9e, 0x52, 0xb0, 0x9b, 0x4a, 0xea, 0x69, 0x85, 0xd4, 0xb8, 0x80, 0x5f, 0x17, 0x68, 0xa3,
+	0xd6, 0x21, 0xbb, 0x13, 0x18, 0x7c, 0xb3, 0x50, 0xf7, 0xdf, 0x37, 0xc2, 0x2f, 0x50, 0x4b, 0xd0,
+	0x90, 0xb2, 0x25, 0x15, 0x2a, 0x94, 0xf6, 0xc4, 0xfe, 0xf1, 0xfd, 0xe2, 0xd4, 0x0c, 0xcb, 0x38,
+	0x8a, 0x04, 0x05, 0x98, 0x49, 0xc1, 0xd2, 0x78, 0xba, 0x43, 0xe2, 0xe7, 0xa8, 0x09, 0x34, 0x8d,
+	0xa8, 0xb0, 0x8f, 0x1e, 0xe0, 0x18, 0x5c, 0x11, 0xbd, 0x76, 0x6a, 0xd7, 0x2b, 0xa2, 0xd7, 0xd6,
+	0xca, 0xe8, 0xf5, 0xf9, 0x40, 0xa2, 0x93, 0xff, 0xaf, 0x86, 0xcf, 0x50, 0x7b, 0x1f, 0xa1, 0xa5,
+	0x22, 0x6c, 0x41, 0x19, 0xdd, 0x6b, 0x84, 0xd4, 0x73, 0x05, 0xc5, 0x50, 0x2b, 0x87, 0x9d, 0x51,
+	0xcf, 0xd3, 0x13, 0xef, 0x95, 0x13, 0xef, 0x7d, 0x2e, 0x27, 0x7e, 0xd2, 0x2a, 0xda, 0xad, 0x7e,
+	0xf7, 0xad, 0x69, 0x5b, 0xf1, 0x8a, 0xca, 0xe4, 0xe3, 0xfd, 0xc6, 0xb1, 0xd6, 0x1b, 0xc7, 0xfa,
+	0xb3, 0x71, 0xac, 0xd5, 0xd6, 0xa9, 0xad, 0xb7, 0x4e, 0xed, 0xe7, 0xd6, 0xa9, 0x7d, 0xb9, 0x8a,
+	0x99, 0x5c, 0xe4, 0x73, 0x2f, 0xe4, 0x89, 0x9f, 0xa7, 0xec, 0x86, 0x85, 0x44, 0x32, 0x9e, 0x5e,
+	0x14, 0xfb, 0xfd, 0x9f, 0xb8, 0x2d, 0x7f, 0x85, 0xbc, 0xcb, 0x28, 0xcc, 0x9b, 0xaa, 0xef, 0xd5,
+	0xdf, 0x01, 0x00, 0xef, 0xea, 0xce, 0x2c, 0xe2, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -193,6 +300,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AuditPrunes) > 0 {
+		for iNdEx := len(m.AuditPrunes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuditPrunes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Histories) > 0 {
+		for iNdEx := len(m.Histories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Histories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.StartingStreamId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StartingStreamId))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Streams) > 0 {
 		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -267,6 +421,42 @@ func (m *StreamExport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *StreamAuditPrune) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamAuditPrune) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamAuditPrune) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PruneTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintGenesis(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.StreamId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -292,6 +482,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.StartingStreamId != 0 {
+		n += 1 + sovGenesis(uint64(m.StartingStreamId))
+	}
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Histories) > 0 {
+		for _, e := range m.Histories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuditPrunes) > 0 {
+		for _, e := range m.AuditPrunes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -314,6 +525,20 @@ func (m *StreamExport) Size() (n int) {
 	return n
 }
 
+func (m *StreamAuditPrune) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovGenesis(uint64(m.StreamId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTime)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -416,6 +641,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartingStreamId", wireType)
+			}
+			m.StartingStreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartingStreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stats = append(m.Stats, StreamStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Histories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Histories = append(m.Histories, StreamHistory{})
+			if err := m.Histories[len(m.Histories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuditPrunes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuditPrunes = append(m.AuditPrunes, StreamAuditPrune{})
+			if err := m.AuditPrunes[len(m.AuditPrunes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -584,6 +930,108 @@ func (m *StreamExport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *StreamAuditPrune) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamAuditPrune: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamAuditPrune: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PruneTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
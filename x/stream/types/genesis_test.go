@@ -25,8 +25,9 @@ func TestGenesisState_Validate(t *testing.T) {
 		{
 			desc: "valid genesis state default params",
 			genState: &types.GenesisState{
-				Params:  types.DefaultParams(),
-				Streams: []types.StreamExport{},
+				Params:           types.DefaultParams(),
+				Streams:          []types.StreamExport{},
+				StartingStreamId: 1,
 			},
 			expErr: false,
 		},
@@ -34,7 +35,11 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "valid genesis state and params",
 			genState: &types.GenesisState{
 				Params: types.Params{
-					ValidatorFee: mathmod.LegacyNewDecWithPrec(1, 2),
+					ValidatorFee:       mathmod.LegacyNewDecWithPrec(1, 2),
+					FeeCollectorRatio:  types.DefaultFeeCollectorRatio,
+					CommunityPoolRatio: types.DefaultCommunityPoolRatio,
+					BurnRatio:          types.DefaultBurnRatio,
+					MaxClaimFeeRatio:   types.DefaultMaxClaimFeeRatio,
 				},
 				Streams: []types.StreamExport{
 					{
@@ -46,6 +51,7 @@ func TestGenesisState_Validate(t *testing.T) {
 							LastOutflowTime: time.Now(),
 							DepositZeroTime: time.Now(),
 							Cancellable:     true,
+							StreamId:        1,
 						},
 					},
 					{
@@ -57,17 +63,159 @@ func TestGenesisState_Validate(t *testing.T) {
 							LastOutflowTime: time.Now(),
 							DepositZeroTime: time.Now(),
 							Cancellable:     false,
+							StreamId:        2,
 						},
 					},
 				},
+				StartingStreamId: 3,
 			},
 			expErr: false,
 		},
+		{
+			desc: "invalid: starting stream id zero",
+			genState: &types.GenesisState{
+				Params:           types.DefaultParams(),
+				Streams:          []types.StreamExport{},
+				StartingStreamId: 0,
+			},
+			expErr: true,
+		},
+		{
+			desc: "invalid: missing stream id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Streams: []types.StreamExport{
+					{
+						Sender:   "und1x8pl6wzqf9atkm77ymc5vn5dnpl5xytmn200xy",
+						Receiver: "und100aex49fh53r7mpeghdq6e645epp6r9qyqk5jq",
+						Stream: types.Stream{
+							Deposit:  sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(100000000)),
+							FlowRate: 123,
+						},
+					},
+				},
+				StartingStreamId: 2,
+			},
+			expErr: true,
+		},
+		{
+			desc: "invalid: stream id >= starting stream id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Streams: []types.StreamExport{
+					{
+						Sender:   "und1x8pl6wzqf9atkm77ymc5vn5dnpl5xytmn200xy",
+						Receiver: "und100aex49fh53r7mpeghdq6e645epp6r9qyqk5jq",
+						Stream: types.Stream{
+							Deposit:  sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(100000000)),
+							FlowRate: 123,
+							StreamId: 2,
+						},
+					},
+				},
+				StartingStreamId: 2,
+			},
+			expErr: true,
+		},
+		{
+			desc: "invalid: duplicate stream id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Streams: []types.StreamExport{
+					{
+						Sender:   "und1x8pl6wzqf9atkm77ymc5vn5dnpl5xytmn200xy",
+						Receiver: "und100aex49fh53r7mpeghdq6e645epp6r9qyqk5jq",
+						Stream: types.Stream{
+							Deposit:  sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(100000000)),
+							FlowRate: 123,
+							StreamId: 1,
+						},
+					},
+					{
+						Sender:   "und100aex49fh53r7mpeghdq6e645epp6r9qyqk5jq",
+						Receiver: "und1x8pl6wzqf9atkm77ymc5vn5dnpl5xytmn200xy",
+						Stream: types.Stream{
+							Deposit:  sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(100000000)),
+							FlowRate: 123,
+							StreamId: 1,
+						},
+					},
+				},
+				StartingStreamId: 2,
+			},
+			expErr: true,
+		},
+		{
+			desc: "invalid: cliff time before start time",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Streams: []types.StreamExport{
+					{
+						Sender:   "und1x8pl6wzqf9atkm77ymc5vn5dnpl5xytmn200xy",
+						Receiver: "und100aex49fh53r7mpeghdq6e645epp6r9qyqk5jq",
+						Stream: types.Stream{
+							Deposit:   sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(100000000)),
+							FlowRate:  123,
+							StreamId:  1,
+							StartTime: time.Unix(1700000000, 0).UTC(),
+							CliffTime: time.Unix(1600000000, 0).UTC(),
+						},
+					},
+				},
+				StartingStreamId: 2,
+			},
+			expErr: true,
+		},
+		{
+			desc: "invalid: end time before start time",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Streams: []types.StreamExport{
+					{
+						Sender:   "und1x8pl6wzqf9atkm77ymc5vn5dnpl5xytmn200xy",
+						Receiver: "und100aex49fh53r7mpeghdq6e645epp6r9qyqk5jq",
+						Stream: types.Stream{
+							Deposit:   sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(100000000)),
+							FlowRate:  123,
+							StreamId:  1,
+							StartTime: time.Unix(1700000000, 0).UTC(),
+							EndTime:   time.Unix(1600000000, 0).UTC(),
+						},
+					},
+				},
+				StartingStreamId: 2,
+			},
+			expErr: true,
+		},
+		{
+			desc: "invalid: pausable accepted for a stream which is not pausable",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Streams: []types.StreamExport{
+					{
+						Sender:   "und1x8pl6wzqf9atkm77ymc5vn5dnpl5xytmn200xy",
+						Receiver: "und100aex49fh53r7mpeghdq6e645epp6r9qyqk5jq",
+						Stream: types.Stream{
+							Deposit:          sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(100000000)),
+							FlowRate:         123,
+							StreamId:         1,
+							PausableAccepted: true,
+						},
+					},
+				},
+				StartingStreamId: 2,
+			},
+			expErr: true,
+		},
 		{
 			desc: "invalid: empty genesis state",
 			genState: &types.GenesisState{
 				Params: types.Params{
-					ValidatorFee: mathmod.LegacyDec{},
+					ValidatorFee:       mathmod.LegacyDec{},
+					FeeCollectorRatio:  types.DefaultFeeCollectorRatio,
+					CommunityPoolRatio: types.DefaultCommunityPoolRatio,
+					BurnRatio:          types.DefaultBurnRatio,
+					MaxClaimFeeRatio:   types.DefaultMaxClaimFeeRatio,
 				},
 				Streams: []types.StreamExport{},
 			},
@@ -77,7 +225,11 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "invalid: genesis state fee > 100%",
 			genState: &types.GenesisState{
 				Params: types.Params{
-					ValidatorFee: mathmod.LegacyNewDecWithPrec(101, 2),
+					ValidatorFee:       mathmod.LegacyNewDecWithPrec(101, 2),
+					FeeCollectorRatio:  types.DefaultFeeCollectorRatio,
+					CommunityPoolRatio: types.DefaultCommunityPoolRatio,
+					BurnRatio:          types.DefaultBurnRatio,
+					MaxClaimFeeRatio:   types.DefaultMaxClaimFeeRatio,
 				},
 				Streams: []types.StreamExport{},
 			},
@@ -87,7 +239,11 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "invalid: genesis state fee < 0%",
 			genState: &types.GenesisState{
 				Params: types.Params{
-					ValidatorFee: mathmod.LegacyNewDecWithPrec(-1, 2),
+					ValidatorFee:       mathmod.LegacyNewDecWithPrec(-1, 2),
+					FeeCollectorRatio:  types.DefaultFeeCollectorRatio,
+					CommunityPoolRatio: types.DefaultCommunityPoolRatio,
+					BurnRatio:          types.DefaultBurnRatio,
+					MaxClaimFeeRatio:   types.DefaultMaxClaimFeeRatio,
 				},
 				Streams: []types.StreamExport{},
 			},
@@ -105,3 +261,70 @@ func TestGenesisState_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestGenesisState_Validate_StatsAndHistories(t *testing.T) {
+	validStream := types.StreamExport{
+		Sender:   "und1x8pl6wzqf9atkm77ymc5vn5dnpl5xytmn200xy",
+		Receiver: "und100aex49fh53r7mpeghdq6e645epp6r9qyqk5jq",
+		Stream: types.Stream{
+			Deposit:         sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(100000000)),
+			FlowRate:        123,
+			LastOutflowTime: time.Now(),
+			DepositZeroTime: time.Now(),
+			Cancellable:     true,
+			StreamId:        1,
+		},
+	}
+
+	validStats := types.NewStreamStats(1, sdk.DefaultBondDenom)
+	validStats.TotalClaimed = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	validStats.TotalValidatorFees = sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)
+	validStats.NumClaims = 2
+
+	feesGreaterThanClaimed := validStats
+	feesGreaterThanClaimed.TotalValidatorFees = sdk.NewInt64Coin(sdk.DefaultBondDenom, 1001)
+
+	validHistory := types.StreamHistory{
+		StreamId:        1,
+		FlowRateChanges: []types.FlowRateChange{{OldFlowRate: 100, NewFlowRate: 123, ChangedAt: time.Now(), BlockHeight: 5}},
+	}
+
+	tooLongHistory := types.StreamHistory{StreamId: 1}
+	for i := 0; i <= types.MaxFlowRateChangeHistory; i++ {
+		tooLongHistory.FlowRateChanges = append(tooLongHistory.FlowRateChanges, types.FlowRateChange{OldFlowRate: 1, NewFlowRate: 2})
+	}
+
+	tests := []struct {
+		desc      string
+		stats     []types.StreamStats
+		histories []types.StreamHistory
+		prunes    []types.StreamAuditPrune
+		expErr    string
+	}{
+		{"valid", []types.StreamStats{validStats}, []types.StreamHistory{validHistory}, nil, ""},
+		{"stats for unknown stream", []types.StreamStats{types.NewStreamStats(4, sdk.DefaultBondDenom)}, nil, nil, "stream does not exist"},
+		{"duplicate stats", []types.StreamStats{validStats, validStats}, nil, nil, "duplicate ID"},
+		{"fees greater than claimed", []types.StreamStats{feesGreaterThanClaimed}, nil, nil, "greater than total claimed"},
+		{"history for unknown stream", nil, []types.StreamHistory{{StreamId: 4}}, nil, "stream does not exist"},
+		{"stats for deleted stream", []types.StreamStats{validStats, types.NewStreamStats(3, sdk.DefaultBondDenom)}, nil, nil, ""},
+		{"history for deleted stream", nil, []types.StreamHistory{validHistory, {StreamId: 3}}, nil, ""},
+		{"duplicate history", nil, []types.StreamHistory{validHistory, validHistory}, nil, "duplicate ID"},
+		{"history too long", nil, []types.StreamHistory{tooLongHistory}, nil, "too many flow rate changes"},
+		{"audit prune for deleted stream", []types.StreamStats{validStats, types.NewStreamStats(3, sdk.DefaultBondDenom)}, nil, []types.StreamAuditPrune{{StreamId: 3, PruneTime: time.Now()}}, ""},
+		{"audit prune for existing stream", []types.StreamStats{validStats}, nil, []types.StreamAuditPrune{{StreamId: 1, PruneTime: time.Now()}}, "stream has not been deleted"},
+		{"audit prune without stats or history", nil, nil, []types.StreamAuditPrune{{StreamId: 3, PruneTime: time.Now()}}, "no stats or history"},
+		{"duplicate audit prune", nil, []types.StreamHistory{{StreamId: 3}}, []types.StreamAuditPrune{{StreamId: 3, PruneTime: time.Now()}, {StreamId: 3, PruneTime: time.Now()}}, "duplicate ID"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			genState := types.NewGenesisState([]types.StreamExport{validStream}, types.DefaultParams(), 4, tc.stats, tc.histories, tc.prunes)
+			err := genState.Validate()
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"fmt"
+
+	mathmod "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewStreamStats returns empty claim statistics for a stream in the given denom
+func NewStreamStats(streamID uint64, denom string) StreamStats {
+	return StreamStats{
+		StreamId:           streamID,
+		TotalClaimed:       sdk.NewCoin(denom, mathmod.NewInt(0)),
+		TotalValidatorFees: sdk.NewCoin(denom, mathmod.NewInt(0)),
+	}
+}
+
+// Validate runs stateless checks on a stream's claim statistics
+func (s StreamStats) Validate() error {
+	if err := s.TotalClaimed.Validate(); err != nil {
+		return fmt.Errorf("invalid total claimed: %w", err)
+	}
+	if err := s.TotalValidatorFees.Validate(); err != nil {
+		return fmt.Errorf("invalid total validator fees: %w", err)
+	}
+	if s.TotalClaimed.Denom != s.TotalValidatorFees.Denom {
+		return fmt.Errorf("total claimed denom %s does not match total validator fees denom %s", s.TotalClaimed.Denom, s.TotalValidatorFees.Denom)
+	}
+	if s.TotalClaimed.IsLT(s.TotalValidatorFees) {
+		return fmt.Errorf("total validator fees %s greater than total claimed %s", s.TotalValidatorFees, s.TotalClaimed)
+	}
+	return nil
+}
+
+// Validate runs stateless checks on a stream's flow rate change history
+func (h StreamHistory) Validate() error {
+	if len(h.FlowRateChanges) > MaxFlowRateChangeHistory {
+		return fmt.Errorf("too many flow rate changes: %d > %d", len(h.FlowRateChanges), MaxFlowRateChangeHistory)
+	}
+	return nil
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"encoding/binary"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/types/kv"
@@ -24,29 +27,86 @@ var (
 	// ParamsKey is the prefix for the params store
 	ParamsKey = []byte{0x01}
 
-	// StreamKeyPrefix prefix for the Stream store
+	// HighestStreamIDKey key for the highest stream ID
+	HighestStreamIDKey = []byte{0x02}
+
+	// StreamKeyPrefix prefix for the Stream store, keyed by stream ID
 	StreamKeyPrefix = []byte{0x11}
+
+	// StreamExpiryQueuePrefix prefix for the time ordered queue of streams, keyed by deposit zero time
+	StreamExpiryQueuePrefix = []byte{0x12}
+
+	// StreamsByReceiverSenderPrefix prefix for the receiver/sender -> stream ID index
+	StreamsByReceiverSenderPrefix = []byte{0x13}
+
+	// StreamStatsKeyPrefix prefix for the stream claim statistics store, keyed by stream ID
+	StreamStatsKeyPrefix = []byte{0x14}
+
+	// StreamHistoryKeyPrefix prefix for the stream flow rate change history store, keyed by stream ID
+	StreamHistoryKeyPrefix = []byte{0x15}
+
+	// StreamLowDepositQueuePrefix prefix for the time ordered queue of streams, keyed by the time their remaining
+	// deposit falls below their low deposit threshold
+	StreamLowDepositQueuePrefix = []byte{0x16}
+
+	// StreamAuditPruneQueuePrefix prefix for the time ordered queue of deleted streams, keyed by the time their
+	// claim statistics and flow rate change history are pruned
+	StreamAuditPruneQueuePrefix = []byte{0x17}
+
+	lenTime = len(sdk.FormatTimeBytes(time.Now()))
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
 
-// GetStreamKey creates the key for receiver bond with sender
-func GetStreamKey(receiverAddr sdk.AccAddress, senderAddr sdk.AccAddress) []byte {
+// GetStreamIDBytes returns the byte representation of the streamID
+func GetStreamIDBytes(streamID uint64) (streamIDBz []byte) {
+	streamIDBz = make([]byte, 8)
+	binary.BigEndian.PutUint64(streamIDBz, streamID)
+	return
+}
+
+// GetStreamIDFromBytes returns streamID in uint64 format from a byte array
+func GetStreamIDFromBytes(bz []byte) (streamID uint64) {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetStreamKey creates the key for a stream
+func GetStreamKey(streamID uint64) []byte {
+	return append(StreamKeyPrefix, GetStreamIDBytes(streamID)...)
+}
+
+// GetStreamStatsKey creates the key for a stream's claim statistics
+func GetStreamStatsKey(streamID uint64) []byte {
+	return append(StreamStatsKeyPrefix, GetStreamIDBytes(streamID)...)
+}
+
+// GetStreamHistoryKey creates the key for a stream's flow rate change history
+func GetStreamHistoryKey(streamID uint64) []byte {
+	return append(StreamHistoryKeyPrefix, GetStreamIDBytes(streamID)...)
+}
+
+// GetStreamsByReceiverSenderKey creates the index prefix for all of a receiver/sender pair's streams
+func GetStreamsByReceiverSenderKey(receiverAddr sdk.AccAddress, senderAddr sdk.AccAddress) []byte {
 	return append(GetStreamsByReceiverKey(receiverAddr), address.MustLengthPrefix(senderAddr)...)
 }
 
-// GetStreamsByReceiverKey creates the prefix for a delegator for all validators
+// GetStreamsByReceiverKey creates the index prefix for all of a receiver's streams
 func GetStreamsByReceiverKey(receiverAddr sdk.AccAddress) []byte {
-	return append(StreamKeyPrefix, address.MustLengthPrefix(receiverAddr)...)
+	return append(StreamsByReceiverSenderPrefix, address.MustLengthPrefix(receiverAddr)...)
+}
+
+// GetStreamByReceiverSenderIndexKey creates the receiver/sender index key for a stream
+func GetStreamByReceiverSenderIndexKey(receiverAddr sdk.AccAddress, senderAddr sdk.AccAddress, streamID uint64) []byte {
+	return append(GetStreamsByReceiverSenderKey(receiverAddr, senderAddr), GetStreamIDBytes(streamID)...)
 }
 
-// AddressesFromStreamKey returns a receiver and sender address from a stream prefix
-// store key.
-func AddressesFromStreamKey(key []byte) (sdk.AccAddress, sdk.AccAddress) {
+// SplitStreamByReceiverSenderIndexKey returns the receiver and sender addresses and the stream ID
+// from a receiver/sender index store key.
+func SplitStreamByReceiverSenderIndexKey(key []byte) (sdk.AccAddress, sdk.AccAddress, uint64) {
 	// key is of format:
-	// 0x11<receiverAddressLen (1 Byte)><receiverAddress_Bytes><senderAddressLen (1 Byte)><senderAddress_Bytes>
+	// 0x13<receiverAddressLen (1 Byte)><receiverAddress_Bytes><senderAddressLen (1 Byte)><senderAddress_Bytes><streamID (8 Bytes)>
 
 	receiverAddrLen, receiverAddrLenEndIndex := sdk.ParseLengthPrefixedBytes(key, 1, 1) // ignore key[0] since it is a prefix key
 	receiverAddr, receiverAddrEndIndex := sdk.ParseLengthPrefixedBytes(key, receiverAddrLenEndIndex+1, int(receiverAddrLen[0]))
@@ -54,12 +114,78 @@ func AddressesFromStreamKey(key []byte) (sdk.AccAddress, sdk.AccAddress) {
 	senderAddrLen, senderAddrLenEndIndex := sdk.ParseLengthPrefixedBytes(key, receiverAddrEndIndex+1, 1)
 	senderAddr, senderAddrEndIndex := sdk.ParseLengthPrefixedBytes(key, senderAddrLenEndIndex+1, int(senderAddrLen[0]))
 
-	kv.AssertKeyAtLeastLength(key, senderAddrEndIndex+1)
-	return receiverAddr, senderAddr
+	kv.AssertKeyLength(key[senderAddrEndIndex+1:], 8)
+	return receiverAddr, senderAddr, GetStreamIDFromBytes(key[senderAddrEndIndex+1:])
+}
+
+// GetStreamExpiryQueueByTimeKey gets the expiry queue key prefix for a deposit zero time
+func GetStreamExpiryQueueByTimeKey(depositZeroTime time.Time) []byte {
+	return append(StreamExpiryQueuePrefix, sdk.FormatTimeBytes(depositZeroTime)...)
 }
 
-// FirstAddressFromStreamStoreKey parses the first address only
-func FirstAddressFromStreamStoreKey(key []byte) sdk.AccAddress {
-	addrLen := key[0]
-	return sdk.AccAddress(key[1 : 1+addrLen])
+// GetStreamExpiryQueueKey creates the expiry queue key for a stream
+func GetStreamExpiryQueueKey(depositZeroTime time.Time, streamID uint64) []byte {
+	return append(GetStreamExpiryQueueByTimeKey(depositZeroTime), GetStreamIDBytes(streamID)...)
+}
+
+// SplitStreamExpiryQueueKey returns the deposit zero time and stream ID from an expiry queue store key.
+func SplitStreamExpiryQueueKey(key []byte) (time.Time, uint64) {
+	// key is of format:
+	// 0x12<depositZeroTime (lenTime Bytes)><streamID (8 Bytes)>
+	kv.AssertKeyLength(key, 1+lenTime+8)
+
+	depositZeroTime, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	if err != nil {
+		panic(err)
+	}
+
+	return depositZeroTime, GetStreamIDFromBytes(key[1+lenTime:])
+}
+
+// GetStreamLowDepositQueueByTimeKey gets the low deposit queue key prefix for an alert time
+func GetStreamLowDepositQueueByTimeKey(alertTime time.Time) []byte {
+	return append(StreamLowDepositQueuePrefix, sdk.FormatTimeBytes(alertTime)...)
+}
+
+// GetStreamLowDepositQueueKey creates the low deposit queue key for a stream
+func GetStreamLowDepositQueueKey(alertTime time.Time, streamID uint64) []byte {
+	return append(GetStreamLowDepositQueueByTimeKey(alertTime), GetStreamIDBytes(streamID)...)
+}
+
+// SplitStreamLowDepositQueueKey returns the alert time and stream ID from a low deposit queue store key.
+func SplitStreamLowDepositQueueKey(key []byte) (time.Time, uint64) {
+	// key is of format:
+	// 0x16<alertTime (lenTime Bytes)><streamID (8 Bytes)>
+	kv.AssertKeyLength(key, 1+lenTime+8)
+
+	alertTime, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	if err != nil {
+		panic(err)
+	}
+
+	return alertTime, GetStreamIDFromBytes(key[1+lenTime:])
+}
+
+// GetStreamAuditPruneQueueByTimeKey gets the audit prune queue key prefix for a prune time
+func GetStreamAuditPruneQueueByTimeKey(pruneTime time.Time) []byte {
+	return append(StreamAuditPruneQueuePrefix, sdk.FormatTimeBytes(pruneTime)...)
+}
+
+// GetStreamAuditPruneQueueKey creates the audit prune queue key for a deleted stream
+func GetStreamAuditPruneQueueKey(pruneTime time.Time, streamID uint64) []byte {
+	return append(GetStreamAuditPruneQueueByTimeKey(pruneTime), GetStreamIDBytes(streamID)...)
+}
+
+// SplitStreamAuditPruneQueueKey returns the prune time and stream ID from an audit prune queue store key.
+func SplitStreamAuditPruneQueueKey(key []byte) (time.Time, uint64) {
+	// key is of format:
+	// 0x17<pruneTime (lenTime Bytes)><streamID (8 Bytes)>
+	kv.AssertKeyLength(key, 1+lenTime+8)
+
+	pruneTime, err := sdk.ParseTimeBytes(key[1 : 1+lenTime])
+	if err != nil {
+		panic(err)
+	}
+
+	return pruneTime, GetStreamIDFromBytes(key[1+lenTime:])
 }
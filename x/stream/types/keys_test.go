@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -11,7 +12,14 @@ import (
 	"github.com/unification-com/mainchain/x/stream/types"
 )
 
-func TestAddressFromStreamsStore(t *testing.T) {
+func TestStreamKey(t *testing.T) {
+	key := types.GetStreamKey(42)
+
+	require.Len(t, key, len(types.StreamKeyPrefix)+8)
+	require.Equal(t, uint64(42), types.GetStreamIDFromBytes(key[len(types.StreamKeyPrefix):]))
+}
+
+func TestStreamByReceiverSenderIndexKey(t *testing.T) {
 	appparams.SetAddressPrefixes()
 	receiverAddr, err := sdk.AccAddressFromBech32("und1n88uc38xhjgxzw9nwre4ep2c8ga4fjxc6xaj4c")
 	require.NoError(t, err)
@@ -23,12 +31,53 @@ func TestAddressFromStreamsStore(t *testing.T) {
 	senderAddrLen := len(senderAddr)
 	require.Equal(t, 20, senderAddrLen)
 
-	key := types.GetStreamKey(receiverAddr, senderAddr)
+	key := types.GetStreamByReceiverSenderIndexKey(receiverAddr, senderAddr, 42)
 
-	require.Len(t, key, len(types.StreamKeyPrefix)+len(address.MustLengthPrefix(receiverAddr))+len(address.MustLengthPrefix(senderAddr)))
+	require.Len(t, key, len(types.StreamsByReceiverSenderPrefix)+len(address.MustLengthPrefix(receiverAddr))+len(address.MustLengthPrefix(senderAddr))+8)
+	require.Equal(t, types.GetStreamsByReceiverSenderKey(receiverAddr, senderAddr), key[:len(key)-8])
 
-	r, s := types.AddressesFromStreamKey(key)
+	r, s, streamID := types.SplitStreamByReceiverSenderIndexKey(key)
 
 	require.Equal(t, receiverAddr, r)
 	require.Equal(t, senderAddr, s)
+	require.Equal(t, uint64(42), streamID)
+}
+
+func TestStreamExpiryQueueKey(t *testing.T) {
+	depositZeroTime := time.Unix(1700000000, 123).UTC()
+
+	key := types.GetStreamExpiryQueueKey(depositZeroTime, 42)
+
+	require.Len(t, key, len(types.GetStreamExpiryQueueByTimeKey(depositZeroTime))+8)
+
+	dzt, streamID := types.SplitStreamExpiryQueueKey(key)
+
+	require.Equal(t, depositZeroTime, dzt)
+	require.Equal(t, uint64(42), streamID)
+}
+
+func TestStreamLowDepositQueueKey(t *testing.T) {
+	alertTime := time.Unix(1700000000, 123).UTC()
+
+	key := types.GetStreamLowDepositQueueKey(alertTime, 42)
+
+	require.Len(t, key, len(types.GetStreamLowDepositQueueByTimeKey(alertTime))+8)
+	require.Equal(t, types.StreamLowDepositQueuePrefix, key[:1])
+
+	at, streamID := types.SplitStreamLowDepositQueueKey(key)
+
+	require.Equal(t, alertTime, at)
+	require.Equal(t, uint64(42), streamID)
+}
+
+func TestStreamStatsAndHistoryKeys(t *testing.T) {
+	statsKey := types.GetStreamStatsKey(42)
+	require.Len(t, statsKey, len(types.StreamStatsKeyPrefix)+8)
+	require.Equal(t, types.StreamStatsKeyPrefix, statsKey[:1])
+	require.Equal(t, uint64(42), types.GetStreamIDFromBytes(statsKey[1:]))
+
+	historyKey := types.GetStreamHistoryKey(42)
+	require.Len(t, historyKey, len(types.StreamHistoryKeyPrefix)+8)
+	require.Equal(t, types.StreamHistoryKeyPrefix, historyKey[:1])
+	require.Equal(t, uint64(42), types.GetStreamIDFromBytes(historyKey[1:]))
 }
@@ -1,16 +1,26 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
-	CreateStreamAction   = "create_stream"
-	ClaimStreamAction    = "claim_stream"
-	TopUpDepositAction   = "top_up_deposit"
-	UpdateFlowRateAction = "update_flow_rate"
-	CancelStreamAction   = "cancel_stream"
+	CreateStreamAction           = "create_stream"
+	ClaimStreamAction            = "claim_stream"
+	TopUpDepositAction           = "top_up_deposit"
+	UpdateFlowRateAction         = "update_flow_rate"
+	CancelStreamAction           = "cancel_stream"
+	ClaimAllStreamsAction        = "claim_all_streams"
+	TransferStreamReceiverAction = "transfer_stream_receiver"
+	PauseStreamAction            = "pause_stream"
+	ResumeStreamAction           = "resume_stream"
+	SetLowDepositThresholdAction = "set_low_deposit_threshold"
+	RequestTerminationAction     = "request_stream_termination"
+	AcceptTerminationAction      = "accept_stream_termination"
+	AcceptPausableAction         = "accept_pausable_stream"
 )
 
 var (
@@ -19,6 +29,18 @@ var (
 	_ sdk.Msg = &MsgTopUpDeposit{}
 	_ sdk.Msg = &MsgUpdateFlowRate{}
 	_ sdk.Msg = &MsgCancelStream{}
+	_ sdk.Msg = &MsgClaimStreamById{}
+	_ sdk.Msg = &MsgTopUpDepositById{}
+	_ sdk.Msg = &MsgUpdateFlowRateById{}
+	_ sdk.Msg = &MsgCancelStreamById{}
+	_ sdk.Msg = &MsgClaimAllStreams{}
+	_ sdk.Msg = &MsgTransferStreamReceiver{}
+	_ sdk.Msg = &MsgPauseStream{}
+	_ sdk.Msg = &MsgResumeStream{}
+	_ sdk.Msg = &MsgSetLowDepositThreshold{}
+	_ sdk.Msg = &MsgRequestStreamTermination{}
+	_ sdk.Msg = &MsgAcceptStreamTermination{}
+	_ sdk.Msg = &MsgAcceptPausableStream{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
 
@@ -68,10 +90,40 @@ func (msg MsgCreateStream) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidData, "receiver cannot be same as sender")
 	}
 
-	duration := CalculateDuration(msg.Deposit, msg.FlowRate)
+	if err := ValidateStreamSchedule(msg.StartTime, msg.CliffTime, msg.EndTime); err != nil {
+		return err
+	}
+
+	var flowStart, endTime time.Time
+	if msg.StartTime != nil {
+		flowStart = *msg.StartTime
+	}
+	if msg.EndTime != nil {
+		endTime = *msg.EndTime
+	}
+
+	// the minimum duration is a param, and is checked by the msg server
+	duration := CalculateDuration(msg.Deposit, msg.FlowRate, flowStart, endTime)
 
-	if duration < 60 {
-		return errorsmod.Wrap(ErrInvalidData, "calculated duration too short. Must be > 1 minute")
+	if duration < 1 {
+		return errorsmod.Wrap(ErrInvalidData, "calculated duration too short")
+	}
+
+	return nil
+}
+
+// ValidateStreamSchedule runs stateless checks on a stream's optional start, cliff and end times
+func ValidateStreamSchedule(startTime, cliffTime, endTime *time.Time) error {
+	if startTime != nil && cliffTime != nil && cliffTime.Before(*startTime) {
+		return errorsmod.Wrap(ErrInvalidData, "cliff time cannot be before start time")
+	}
+
+	if startTime != nil && endTime != nil && !endTime.After(*startTime) {
+		return errorsmod.Wrap(ErrInvalidData, "end time must be after start time")
+	}
+
+	if cliffTime != nil && endTime != nil && !endTime.After(*cliffTime) {
+		return errorsmod.Wrap(ErrInvalidData, "end time must be after cliff time")
 	}
 
 	return nil
@@ -82,10 +134,12 @@ func (msg MsgCreateStream) ValidateBasic() error {
 // NewMsgClaimStream is a constructor function for MsgClaimStream
 func NewMsgClaimStream(
 	receiver sdk.AccAddress,
-	sender sdk.AccAddress) *MsgClaimStream {
+	sender sdk.AccAddress,
+	denom string) *MsgClaimStream {
 	return &MsgClaimStream{
 		Receiver: receiver.String(),
 		Sender:   sender.String(),
+		Denom:    denom,
 	}
 }
 
@@ -108,6 +162,10 @@ func (msg MsgClaimStream) ValidateBasic() error {
 		return accErr
 	}
 
+	if err := validateOptionalDenom(msg.Denom); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -157,11 +215,13 @@ func (msg MsgTopUpDeposit) ValidateBasic() error {
 func NewMsgUpdateFlowRate(
 	receiver, sender sdk.AccAddress,
 	flowRate int64,
+	denom string,
 ) *MsgUpdateFlowRate {
 	return &MsgUpdateFlowRate{
 		Receiver: receiver.String(),
 		Sender:   sender.String(),
 		FlowRate: flowRate,
+		Denom:    denom,
 	}
 }
 
@@ -188,6 +248,10 @@ func (msg MsgUpdateFlowRate) ValidateBasic() error {
 		return errorsmod.Wrap(ErrInvalidData, "flow rate must be > zero")
 	}
 
+	if err := validateOptionalDenom(msg.Denom); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -196,10 +260,12 @@ func (msg MsgUpdateFlowRate) ValidateBasic() error {
 // NewMsgCancelStream is a constructor function for MsgCancelStream
 func NewMsgCancelStream(
 	reciever,
-	sender sdk.AccAddress) *MsgCancelStream {
+	sender sdk.AccAddress,
+	denom string) *MsgCancelStream {
 	return &MsgCancelStream{
 		Receiver: reciever.String(),
 		Sender:   sender.String(),
+		Denom:    denom,
 	}
 }
 
@@ -222,6 +288,461 @@ func (msg MsgCancelStream) ValidateBasic() error {
 		return accErr
 	}
 
+	if err := validateOptionalDenom(msg.Denom); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// --- Claim Stream By ID Msg ---
+
+// NewMsgClaimStreamById is a constructor function for MsgClaimStreamById
+func NewMsgClaimStreamById(
+	receiver sdk.AccAddress,
+	streamId uint64) *MsgClaimStreamById {
+	return &MsgClaimStreamById{
+		Receiver: receiver.String(),
+		StreamId: streamId,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgClaimStreamById) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgClaimStreamById) Type() string { return ClaimStreamAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgClaimStreamById) ValidateBasic() error {
+	_, accErr := sdk.AccAddressFromBech32(msg.Receiver)
+	if accErr != nil {
+		return accErr
+	}
+
+	if msg.StreamId == 0 {
+		return errorsmod.Wrap(ErrMissingData, "stream id required")
+	}
+
+	return nil
+}
+
+// --- Top up Deposit By ID Msg ---
+
+// NewMsgTopUpDepositById is a constructor function for MsgTopUpDepositById
+func NewMsgTopUpDepositById(
+	sender sdk.AccAddress,
+	streamId uint64,
+	deposit sdk.Coin,
+) *MsgTopUpDepositById {
+	return &MsgTopUpDepositById{
+		Sender:   sender.String(),
+		StreamId: streamId,
+		Deposit:  deposit,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgTopUpDepositById) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgTopUpDepositById) Type() string { return TopUpDepositAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTopUpDepositById) ValidateBasic() error {
+	_, accErr := sdk.AccAddressFromBech32(msg.Sender)
+	if accErr != nil {
+		return accErr
+	}
+
+	if msg.StreamId == 0 {
+		return errorsmod.Wrap(ErrMissingData, "stream id required")
+	}
+
+	if msg.Deposit.IsNil() || msg.Deposit.IsNegative() || msg.Deposit.IsZero() {
+		return errorsmod.Wrap(ErrInvalidData, "deposit must be > zero")
+	}
+
+	return nil
+}
+
+// --- Update Flow Rate By ID Msg ---
+
+// NewMsgUpdateFlowRateById is a constructor function for MsgUpdateFlowRateById
+func NewMsgUpdateFlowRateById(
+	sender sdk.AccAddress,
+	streamId uint64,
+	flowRate int64,
+) *MsgUpdateFlowRateById {
+	return &MsgUpdateFlowRateById{
+		Sender:   sender.String(),
+		StreamId: streamId,
+		FlowRate: flowRate,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgUpdateFlowRateById) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgUpdateFlowRateById) Type() string { return UpdateFlowRateAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgUpdateFlowRateById) ValidateBasic() error {
+	_, accErr := sdk.AccAddressFromBech32(msg.Sender)
+	if accErr != nil {
+		return accErr
+	}
+
+	if msg.StreamId == 0 {
+		return errorsmod.Wrap(ErrMissingData, "stream id required")
+	}
+
+	if msg.FlowRate < 1 {
+		return errorsmod.Wrap(ErrInvalidData, "flow rate must be > zero")
+	}
+
+	return nil
+}
+
+// --- Cancel Stream By ID Msg ---
+
+// NewMsgCancelStreamById is a constructor function for MsgCancelStreamById
+func NewMsgCancelStreamById(
+	sender sdk.AccAddress,
+	streamId uint64) *MsgCancelStreamById {
+	return &MsgCancelStreamById{
+		Sender:   sender.String(),
+		StreamId: streamId,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCancelStreamById) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelStreamById) Type() string { return CancelStreamAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelStreamById) ValidateBasic() error {
+	_, accErr := sdk.AccAddressFromBech32(msg.Sender)
+	if accErr != nil {
+		return accErr
+	}
+
+	if msg.StreamId == 0 {
+		return errorsmod.Wrap(ErrMissingData, "stream id required")
+	}
+
+	return nil
+}
+
+// --- Claim All Streams Msg ---
+
+// NewMsgClaimAllStreams is a constructor function for MsgClaimAllStreams
+func NewMsgClaimAllStreams(
+	receiver sdk.AccAddress,
+	senders []sdk.AccAddress,
+	maxStreams uint64,
+	startKey []byte,
+) *MsgClaimAllStreams {
+	var senderAddrs []string
+	for _, sender := range senders {
+		senderAddrs = append(senderAddrs, sender.String())
+	}
+	return &MsgClaimAllStreams{
+		Receiver:   receiver.String(),
+		Senders:    senderAddrs,
+		MaxStreams: maxStreams,
+		StartKey:   startKey,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgClaimAllStreams) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgClaimAllStreams) Type() string { return ClaimAllStreamsAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgClaimAllStreams) ValidateBasic() error {
+	receiverAddr, accErr := sdk.AccAddressFromBech32(msg.Receiver)
+	if accErr != nil {
+		return accErr
+	}
+
+	if len(msg.Senders) > MaxClaimAllStreams {
+		return errorsmod.Wrapf(ErrInvalidData, "too many senders. Max %d", MaxClaimAllStreams)
+	}
+
+	// compare decoded addresses, since the same address can be encoded in upper or lower case
+	seen := make(map[string]bool)
+	for _, sender := range msg.Senders {
+		senderAddr, accErr := sdk.AccAddressFromBech32(sender)
+		if accErr != nil {
+			return accErr
+		}
+		if senderAddr.Equals(receiverAddr) {
+			return errorsmod.Wrap(ErrInvalidData, "sender and receiver cannot be same address")
+		}
+		if seen[senderAddr.String()] {
+			return errorsmod.Wrapf(ErrInvalidData, "duplicate sender %s", sender)
+		}
+		seen[senderAddr.String()] = true
+	}
+
+	if msg.MaxStreams > MaxClaimAllStreams {
+		return errorsmod.Wrapf(ErrInvalidData, "max streams cannot be more than %d", MaxClaimAllStreams)
+	}
+
+	return nil
+}
+
+// --- Transfer Stream Receiver Msg ---
+
+// NewMsgTransferStreamReceiver is a constructor function for MsgTransferStreamReceiver
+func NewMsgTransferStreamReceiver(
+	receiver sdk.AccAddress,
+	streamId uint64,
+	newReceiver sdk.AccAddress,
+) *MsgTransferStreamReceiver {
+	return &MsgTransferStreamReceiver{
+		Receiver:    receiver.String(),
+		StreamId:    streamId,
+		NewReceiver: newReceiver.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgTransferStreamReceiver) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgTransferStreamReceiver) Type() string { return TransferStreamReceiverAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTransferStreamReceiver) ValidateBasic() error {
+	_, accErr := sdk.AccAddressFromBech32(msg.Receiver)
+	if accErr != nil {
+		return accErr
+	}
+
+	_, accErr = sdk.AccAddressFromBech32(msg.NewReceiver)
+	if accErr != nil {
+		return accErr
+	}
+
+	if msg.StreamId == 0 {
+		return errorsmod.Wrap(ErrMissingData, "stream id required")
+	}
+
+	if msg.Receiver == msg.NewReceiver {
+		return errorsmod.Wrap(ErrInvalidData, "new receiver cannot be the current receiver")
+	}
+
+	return nil
+}
+
+// --- Pause Stream Msg ---
+
+// NewMsgPauseStream is a constructor function for MsgPauseStream
+func NewMsgPauseStream(
+	sender sdk.AccAddress,
+	streamId uint64) *MsgPauseStream {
+	return &MsgPauseStream{
+		Sender:   sender.String(),
+		StreamId: streamId,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgPauseStream) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgPauseStream) Type() string { return PauseStreamAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgPauseStream) ValidateBasic() error {
+	_, accErr := sdk.AccAddressFromBech32(msg.Sender)
+	if accErr != nil {
+		return accErr
+	}
+
+	if msg.StreamId == 0 {
+		return errorsmod.Wrap(ErrMissingData, "stream id required")
+	}
+
+	return nil
+}
+
+// --- Resume Stream Msg ---
+
+// NewMsgResumeStream is a constructor function for MsgResumeStream
+func NewMsgResumeStream(
+	sender sdk.AccAddress,
+	streamId uint64) *MsgResumeStream {
+	return &MsgResumeStream{
+		Sender:   sender.String(),
+		StreamId: streamId,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgResumeStream) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgResumeStream) Type() string { return ResumeStreamAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgResumeStream) ValidateBasic() error {
+	_, accErr := sdk.AccAddressFromBech32(msg.Sender)
+	if accErr != nil {
+		return accErr
+	}
+
+	if msg.StreamId == 0 {
+		return errorsmod.Wrap(ErrMissingData, "stream id required")
+	}
+
+	return nil
+}
+
+// --- Set Low Deposit Threshold Msg ---
+
+// NewMsgSetLowDepositThreshold is a constructor function for MsgSetLowDepositThreshold
+func NewMsgSetLowDepositThreshold(
+	sender sdk.AccAddress,
+	streamId uint64,
+	lowDepositThreshold uint64) *MsgSetLowDepositThreshold {
+	return &MsgSetLowDepositThreshold{
+		Sender:              sender.String(),
+		StreamId:            streamId,
+		LowDepositThreshold: lowDepositThreshold,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetLowDepositThreshold) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetLowDepositThreshold) Type() string { return SetLowDepositThresholdAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetLowDepositThreshold) ValidateBasic() error {
+	_, accErr := sdk.AccAddressFromBech32(msg.Sender)
+	if accErr != nil {
+		return accErr
+	}
+
+	if msg.StreamId == 0 {
+		return errorsmod.Wrap(ErrMissingData, "stream id required")
+	}
+
+	if msg.LowDepositThreshold > MaxLowDepositThreshold {
+		return errorsmod.Wrapf(ErrInvalidData, "low deposit threshold cannot be more than %d seconds", MaxLowDepositThreshold)
+	}
+
+	return nil
+}
+
+// --- Request Stream Termination Msg ---
+
+// NewMsgRequestStreamTermination is a constructor function for MsgRequestStreamTermination
+func NewMsgRequestStreamTermination(
+	sender sdk.AccAddress,
+	streamId uint64,
+	noticeDuration uint64) *MsgRequestStreamTermination {
+	return &MsgRequestStreamTermination{
+		Sender:         sender.String(),
+		StreamId:       streamId,
+		NoticeDuration: noticeDuration,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRequestStreamTermination) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRequestStreamTermination) Type() string { return RequestTerminationAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRequestStreamTermination) ValidateBasic() error {
+	_, accErr := sdk.AccAddressFromBech32(msg.Sender)
+	if accErr != nil {
+		return accErr
+	}
+
+	if msg.StreamId == 0 {
+		return errorsmod.Wrap(ErrMissingData, "stream id required")
+	}
+
+	if msg.NoticeDuration > MaxTerminationNotice {
+		return errorsmod.Wrapf(ErrInvalidData, "termination notice cannot be more than %d seconds", MaxTerminationNotice)
+	}
+
+	return nil
+}
+
+// --- Accept Stream Termination Msg ---
+
+// NewMsgAcceptStreamTermination is a constructor function for MsgAcceptStreamTermination
+func NewMsgAcceptStreamTermination(
+	receiver sdk.AccAddress,
+	streamId uint64) *MsgAcceptStreamTermination {
+	return &MsgAcceptStreamTermination{
+		Receiver: receiver.String(),
+		StreamId: streamId,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgAcceptStreamTermination) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgAcceptStreamTermination) Type() string { return AcceptTerminationAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgAcceptStreamTermination) ValidateBasic() error {
+	_, accErr := sdk.AccAddressFromBech32(msg.Receiver)
+	if accErr != nil {
+		return accErr
+	}
+
+	if msg.StreamId == 0 {
+		return errorsmod.Wrap(ErrMissingData, "stream id required")
+	}
+
+	return nil
+}
+
+// --- Accept Pausable Stream Msg ---
+
+// NewMsgAcceptPausableStream is a constructor function for MsgAcceptPausableStream
+func NewMsgAcceptPausableStream(
+	receiver sdk.AccAddress,
+	streamId uint64) *MsgAcceptPausableStream {
+	return &MsgAcceptPausableStream{
+		Receiver: receiver.String(),
+		StreamId: streamId,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgAcceptPausableStream) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgAcceptPausableStream) Type() string { return AcceptPausableAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgAcceptPausableStream) ValidateBasic() error {
+	_, accErr := sdk.AccAddressFromBech32(msg.Receiver)
+	if accErr != nil {
+		return accErr
+	}
+
+	if msg.StreamId == 0 {
+		return errorsmod.Wrap(ErrMissingData, "stream id required")
+	}
+
 	return nil
 }
 
@@ -239,3 +760,16 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 
 	return nil
 }
+
+// validateOptionalDenom validates a stream denom if one has been given
+func validateOptionalDenom(denom string) error {
+	if denom == "" {
+		return nil
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidData, err.Error())
+	}
+
+	return nil
+}
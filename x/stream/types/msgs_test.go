@@ -3,7 +3,9 @@ package types_test
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"strings"
 	"testing"
+	"time"
 
 	mathmod "cosmossdk.io/math"
 	"github.com/cometbft/cometbft/crypto/ed25519"
@@ -42,7 +44,8 @@ func TestMsgCreateStream_ValidateBasic(t *testing.T) {
 		{sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(10000)), 0, r, s, false},
 		{sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(10000)), 100, sdk.AccAddress{}, s, false},
 		{sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(10000)), 100, r, sdk.AccAddress{}, false},
-		{sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(100)), 100, r, s, false},
+		{sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(100)), 100, r, s, true}, // min duration is checked by the msg server
+		{sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(10)), 100, r, s, false},
 		{sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(10000)), 100, r, r, false},
 	}
 
@@ -62,6 +65,50 @@ func TestMsgCreateStream_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgCreateStream_ValidateBasic_Schedule(t *testing.T) {
+	s := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	r := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	deposit := sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(10000))
+	start := time.Unix(1700000000, 0).UTC()
+	cliff := start.Add(time.Hour)
+	end := start.Add(time.Hour * 2)
+	tooSoon := start.Add(time.Second * 30)
+	beforeStart := start.Add(-time.Second)
+
+	tests := []struct {
+		name      string
+		startTime *time.Time
+		cliffTime *time.Time
+		endTime   *time.Time
+		expErrMsg string
+	}{
+		{"no schedule", nil, nil, nil, ""},
+		{"start only", &start, nil, nil, ""},
+		{"start, cliff and end", &start, &cliff, &end, ""},
+		{"cliff before start", &start, &beforeStart, nil, "cliff time cannot be before start time"},
+		{"end before start", &start, nil, &beforeStart, "end time must be after start time"},
+		{"end equals start", &start, nil, &start, "end time must be after start time"},
+		{"end before cliff", &start, &end, &cliff, "end time must be after cliff time"},
+		{"short duration checked by msg server", &start, nil, &tooSoon, ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgCreateStream(deposit, 1, r, s)
+			msg.StartTime = tc.startTime
+			msg.CliffTime = tc.cliffTime
+			msg.EndTime = tc.endTime
+
+			err := msg.ValidateBasic()
+			if tc.expErrMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			}
+		})
+	}
+}
+
 //	MsgClaimStream{}
 
 func TestMsgClaimStream_Route(t *testing.T) {
@@ -78,17 +125,21 @@ func TestMsgClaimStream_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		sender     sdk.AccAddress
 		receiver   sdk.AccAddress
+		denom      string
 		expectPass bool
 	}{
-		{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), true},
-		{sdk.AccAddress{}, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), false},
-		{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress{}, false},
+		{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), "", true},
+		{sdk.AccAddress{}, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), "", false},
+		{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress{}, "", false},
+		{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.DefaultBondDenom, true},
+		{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), "1nund", false},
 	}
 
 	for i, tc := range tests {
 		msg := types.NewMsgClaimStream(
 			tc.receiver,
 			tc.sender,
+			tc.denom,
 		)
 
 		if tc.expectPass {
@@ -156,12 +207,15 @@ func TestMsgUpdateFlowRate_ValidateBasic(t *testing.T) {
 		flowRate   int64
 		sender     sdk.AccAddress
 		receiver   sdk.AccAddress
+		denom      string
 		expectPass bool
 	}{
-		{1, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), true},
-		{1, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress{}, false},
-		{1, sdk.AccAddress{}, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), false},
-		{0, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), false},
+		{1, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), "", true},
+		{1, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress{}, "", false},
+		{1, sdk.AccAddress{}, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), "", false},
+		{0, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), "", false},
+		{1, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.DefaultBondDenom, true},
+		{1, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), "1nund", false},
 	}
 
 	for i, tc := range tests {
@@ -169,6 +223,7 @@ func TestMsgUpdateFlowRate_ValidateBasic(t *testing.T) {
 			tc.receiver,
 			tc.sender,
 			tc.flowRate,
+			tc.denom,
 		)
 
 		if tc.expectPass {
@@ -195,17 +250,465 @@ func TestMsgCancelStream_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		receiver   sdk.AccAddress
 		sender     sdk.AccAddress
+		denom      string
 		expectPass bool
 	}{
-		{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), true},
-		{sdk.AccAddress{}, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), false},
-		{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress{}, false},
+		{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), "", true},
+		{sdk.AccAddress{}, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), "", false},
+		{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress{}, "", false},
+		{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.DefaultBondDenom, true},
+		{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), "1nund", false},
 	}
 
 	for i, tc := range tests {
 		msg := types.NewMsgCancelStream(
 			tc.receiver,
 			tc.sender,
+			tc.denom,
+		)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+//	MsgClaimStreamById{}
+
+func TestMsgClaimStreamById_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		receiver   sdk.AccAddress
+		streamId   uint64
+		expectPass bool
+	}{
+		{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), 1, true},
+		{sdk.AccAddress{}, 1, false},
+		{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), 0, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgClaimStreamById(
+			tc.receiver,
+			tc.streamId,
+		)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+//	MsgTopUpDepositById{}
+
+func TestMsgTopUpDepositById_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		deposit    sdk.Coin
+		sender     sdk.AccAddress
+		streamId   uint64
+		expectPass bool
+	}{
+		{sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(100)), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), 1, true},
+		{sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(100)), sdk.AccAddress{}, 1, false},
+		{sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(100)), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), 0, false},
+		{sdk.NewCoin(sdk.DefaultBondDenom, mathmod.NewIntFromUint64(0)), sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), 1, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgTopUpDepositById(
+			tc.sender,
+			tc.streamId,
+			tc.deposit,
+		)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+//	MsgUpdateFlowRateById{}
+
+func TestMsgUpdateFlowRateById_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		flowRate   int64
+		sender     sdk.AccAddress
+		streamId   uint64
+		expectPass bool
+	}{
+		{1, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), 1, true},
+		{1, sdk.AccAddress{}, 1, false},
+		{1, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), 0, false},
+		{0, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), 1, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgUpdateFlowRateById(
+			tc.sender,
+			tc.streamId,
+			tc.flowRate,
+		)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+//	MsgCancelStreamById{}
+
+func TestMsgCancelStreamById_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		sender     sdk.AccAddress
+		streamId   uint64
+		expectPass bool
+	}{
+		{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), 1, true},
+		{sdk.AccAddress{}, 1, false},
+		{sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()), 0, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgCancelStreamById(
+			tc.sender,
+			tc.streamId,
+		)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+//	MsgClaimAllStreams{}
+
+func TestMsgClaimAllStreams_Route(t *testing.T) {
+	msg := types.MsgClaimAllStreams{}
+	require.Equal(t, types.ModuleName, msg.Route())
+}
+
+func TestMsgClaimAllStreams_Type(t *testing.T) {
+	msg := types.MsgClaimAllStreams{}
+	require.Equal(t, types.ClaimAllStreamsAction, msg.Type())
+}
+
+func TestMsgClaimAllStreams_ValidateBasic(t *testing.T) {
+	r := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	s1 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	s2 := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	tooManySenders := make([]sdk.AccAddress, types.MaxClaimAllStreams+1)
+	for i := range tooManySenders {
+		tooManySenders[i] = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	}
+
+	tests := []struct {
+		receiver   sdk.AccAddress
+		senders    []sdk.AccAddress
+		maxStreams uint64
+		expectPass bool
+	}{
+		{r, nil, 0, true},
+		{r, []sdk.AccAddress{s1, s2}, 10, true},
+		{r, nil, types.MaxClaimAllStreams, true},
+		{sdk.AccAddress{}, nil, 0, false},
+		{r, []sdk.AccAddress{s1, s1}, 0, false},
+		{r, []sdk.AccAddress{r}, 0, false},
+		{r, nil, types.MaxClaimAllStreams + 1, false},
+		{r, tooManySenders, 0, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgClaimAllStreams(
+			tc.receiver,
+			tc.senders,
+			tc.maxStreams,
+			nil,
+		)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	// the same sender encoded in upper case is a duplicate
+	msg := types.NewMsgClaimAllStreams(r, []sdk.AccAddress{s1}, 0, nil)
+	msg.Senders = append(msg.Senders, strings.ToUpper(s1.String()))
+	require.ErrorContains(t, msg.ValidateBasic(), "duplicate sender")
+}
+
+//	MsgTransferStreamReceiver{}
+
+func TestMsgTransferStreamReceiver_ValidateBasic(t *testing.T) {
+	r := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	nr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	tests := []struct {
+		receiver    sdk.AccAddress
+		streamId    uint64
+		newReceiver sdk.AccAddress
+		expectPass  bool
+	}{
+		{r, 1, nr, true},
+		{sdk.AccAddress{}, 1, nr, false},
+		{r, 1, sdk.AccAddress{}, false},
+		{r, 0, nr, false},
+		{r, 1, r, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgTransferStreamReceiver(
+			tc.receiver,
+			tc.streamId,
+			tc.newReceiver,
+		)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+//	MsgPauseStream{}
+
+func TestMsgPauseStream_Route(t *testing.T) {
+	msg := types.MsgPauseStream{}
+	require.Equal(t, types.ModuleName, msg.Route())
+}
+
+func TestMsgPauseStream_Type(t *testing.T) {
+	msg := types.MsgPauseStream{}
+	require.Equal(t, types.PauseStreamAction, msg.Type())
+}
+
+func TestMsgPauseStream_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	tests := []struct {
+		sender     sdk.AccAddress
+		streamId   uint64
+		expectPass bool
+	}{
+		{sender, 1, true},
+		{sdk.AccAddress{}, 1, false},
+		{sender, 0, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgPauseStream(
+			tc.sender,
+			tc.streamId,
+		)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+//	MsgResumeStream{}
+
+func TestMsgResumeStream_Route(t *testing.T) {
+	msg := types.MsgResumeStream{}
+	require.Equal(t, types.ModuleName, msg.Route())
+}
+
+func TestMsgResumeStream_Type(t *testing.T) {
+	msg := types.MsgResumeStream{}
+	require.Equal(t, types.ResumeStreamAction, msg.Type())
+}
+
+func TestMsgResumeStream_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	tests := []struct {
+		sender     sdk.AccAddress
+		streamId   uint64
+		expectPass bool
+	}{
+		{sender, 1, true},
+		{sdk.AccAddress{}, 1, false},
+		{sender, 0, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgResumeStream(
+			tc.sender,
+			tc.streamId,
+		)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+//	MsgSetLowDepositThreshold{}
+
+func TestMsgSetLowDepositThreshold_Route(t *testing.T) {
+	msg := types.MsgSetLowDepositThreshold{}
+	require.Equal(t, types.ModuleName, msg.Route())
+}
+
+func TestMsgSetLowDepositThreshold_Type(t *testing.T) {
+	msg := types.MsgSetLowDepositThreshold{}
+	require.Equal(t, types.SetLowDepositThresholdAction, msg.Type())
+}
+
+func TestMsgSetLowDepositThreshold_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	tests := []struct {
+		sender     sdk.AccAddress
+		streamId   uint64
+		threshold  uint64
+		expectPass bool
+	}{
+		{sender, 1, 86400, true},
+		{sender, 1, 0, true},
+		{sender, 1, types.MaxLowDepositThreshold, true},
+		{sender, 1, types.MaxLowDepositThreshold + 1, false},
+		{sdk.AccAddress{}, 1, 86400, false},
+		{sender, 0, 86400, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgSetLowDepositThreshold(
+			tc.sender,
+			tc.streamId,
+			tc.threshold,
+		)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+//	MsgRequestStreamTermination{}
+
+func TestMsgRequestStreamTermination_Route(t *testing.T) {
+	msg := types.MsgRequestStreamTermination{}
+	require.Equal(t, types.ModuleName, msg.Route())
+}
+
+func TestMsgRequestStreamTermination_Type(t *testing.T) {
+	msg := types.MsgRequestStreamTermination{}
+	require.Equal(t, types.RequestTerminationAction, msg.Type())
+}
+
+func TestMsgRequestStreamTermination_ValidateBasic(t *testing.T) {
+	sender := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	tests := []struct {
+		sender     sdk.AccAddress
+		streamId   uint64
+		notice     uint64
+		expectPass bool
+	}{
+		{sender, 1, 604800, true},
+		{sender, 1, 0, true},
+		{sender, 1, types.MaxTerminationNotice, true},
+		{sender, 1, types.MaxTerminationNotice + 1, false},
+		{sdk.AccAddress{}, 1, 604800, false},
+		{sender, 0, 604800, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgRequestStreamTermination(
+			tc.sender,
+			tc.streamId,
+			tc.notice,
+		)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+//	MsgAcceptStreamTermination{}
+
+func TestMsgAcceptStreamTermination_Route(t *testing.T) {
+	msg := types.MsgAcceptStreamTermination{}
+	require.Equal(t, types.ModuleName, msg.Route())
+}
+
+func TestMsgAcceptStreamTermination_Type(t *testing.T) {
+	msg := types.MsgAcceptStreamTermination{}
+	require.Equal(t, types.AcceptTerminationAction, msg.Type())
+}
+
+func TestMsgAcceptStreamTermination_ValidateBasic(t *testing.T) {
+	receiver := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	tests := []struct {
+		receiver   sdk.AccAddress
+		streamId   uint64
+		expectPass bool
+	}{
+		{receiver, 1, true},
+		{sdk.AccAddress{}, 1, false},
+		{receiver, 0, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgAcceptStreamTermination(
+			tc.receiver,
+			tc.streamId,
+		)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+//	MsgAcceptPausableStream{}
+
+func TestMsgAcceptPausableStream_Route(t *testing.T) {
+	msg := types.MsgAcceptPausableStream{}
+	require.Equal(t, types.ModuleName, msg.Route())
+}
+
+func TestMsgAcceptPausableStream_Type(t *testing.T) {
+	msg := types.MsgAcceptPausableStream{}
+	require.Equal(t, types.AcceptPausableAction, msg.Type())
+}
+
+func TestMsgAcceptPausableStream_ValidateBasic(t *testing.T) {
+	receiver := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	tests := []struct {
+		receiver   sdk.AccAddress
+		streamId   uint64
+		expectPass bool
+	}{
+		{receiver, 1, true},
+		{sdk.AccAddress{}, 1, false},
+		{receiver, 0, false},
+	}
+
+	for i, tc := range tests {
+		msg := types.NewMsgAcceptPausableStream(
+			tc.receiver,
+			tc.streamId,
 		)
 
 		if tc.expectPass {
@@ -239,7 +742,11 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 			types.MsgUpdateParams{
 				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 				Params: types.Params{
-					ValidatorFee: mathmod.LegacyNewDecWithPrec(-1, 2),
+					ValidatorFee:       mathmod.LegacyNewDecWithPrec(-1, 2),
+					FeeCollectorRatio:  types.DefaultFeeCollectorRatio,
+					CommunityPoolRatio: types.DefaultCommunityPoolRatio,
+					BurnRatio:          types.DefaultBurnRatio,
+					MaxClaimFeeRatio:   types.DefaultMaxClaimFeeRatio,
 				},
 			},
 			true,
@@ -250,7 +757,11 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 			types.MsgUpdateParams{
 				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 				Params: types.Params{
-					ValidatorFee: mathmod.LegacyNewDecWithPrec(101, 2),
+					ValidatorFee:       mathmod.LegacyNewDecWithPrec(101, 2),
+					FeeCollectorRatio:  types.DefaultFeeCollectorRatio,
+					CommunityPoolRatio: types.DefaultCommunityPoolRatio,
+					BurnRatio:          types.DefaultBurnRatio,
+					MaxClaimFeeRatio:   types.DefaultMaxClaimFeeRatio,
 				},
 			},
 			true,
@@ -261,7 +772,11 @@ func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
 			types.MsgUpdateParams{
 				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 				Params: types.Params{
-					ValidatorFee: mathmod.LegacyDec{},
+					ValidatorFee:       mathmod.LegacyDec{},
+					FeeCollectorRatio:  types.DefaultFeeCollectorRatio,
+					CommunityPoolRatio: types.DefaultCommunityPoolRatio,
+					BurnRatio:          types.DefaultBurnRatio,
+					MaxClaimFeeRatio:   types.DefaultMaxClaimFeeRatio,
 				},
 			},
 			true,
@@ -304,11 +819,11 @@ func TestMsgCreateStreamGetSignBytes(t *testing.T) {
 func TestMsgClaimStreamGetSignBytes(t *testing.T) {
 	sender := sdk.AccAddress("addr1")
 	receiver := sdk.AccAddress("addr2")
-	msg := types.NewMsgClaimStream(receiver, sender)
+	msg := types.NewMsgClaimStream(receiver, sender, sdk.DefaultBondDenom)
 	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 	res, err := pc.MarshalAminoJSON(msg)
 	require.NoError(t, err)
-	expected := `{"type":"stream/MsgClaimStream","value":{"receiver":"und1v9jxgu3jylfr2w","sender":"und1v9jxgu332vu4y3"}}`
+	expected := `{"type":"stream/MsgClaimStream","value":{"denom":"nund","receiver":"und1v9jxgu3jylfr2w","sender":"und1v9jxgu332vu4y3"}}`
 	require.Equal(t, expected, string(res))
 }
 
@@ -327,21 +842,135 @@ func TestMsgTopUpDepositGetSignBytes(t *testing.T) {
 func TestMsgUpdateFlowRateGetSignBytes(t *testing.T) {
 	sender := sdk.AccAddress("addr1")
 	receiver := sdk.AccAddress("addr2")
-	msg := types.NewMsgUpdateFlowRate(receiver, sender, 1)
+	msg := types.NewMsgUpdateFlowRate(receiver, sender, 1, sdk.DefaultBondDenom)
 	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 	res, err := pc.MarshalAminoJSON(msg)
 	require.NoError(t, err)
-	expected := `{"type":"stream/MsgUpdateFlowRate","value":{"flow_rate":"1","receiver":"und1v9jxgu3jylfr2w","sender":"und1v9jxgu332vu4y3"}}`
+	expected := `{"type":"stream/MsgUpdateFlowRate","value":{"denom":"nund","flow_rate":"1","receiver":"und1v9jxgu3jylfr2w","sender":"und1v9jxgu332vu4y3"}}`
 	require.Equal(t, expected, string(res))
 }
 
 func TestMsgCancelStreamGetSignBytes(t *testing.T) {
 	sender := sdk.AccAddress("addr1")
 	receiver := sdk.AccAddress("addr2")
-	msg := types.NewMsgCancelStream(receiver, sender)
+	msg := types.NewMsgCancelStream(receiver, sender, sdk.DefaultBondDenom)
+	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"stream/MsgCancelStream","value":{"denom":"nund","receiver":"und1v9jxgu3jylfr2w","sender":"und1v9jxgu332vu4y3"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgClaimStreamByIdGetSignBytes(t *testing.T) {
+	receiver := sdk.AccAddress("addr2")
+	msg := types.NewMsgClaimStreamById(receiver, 1)
+	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"stream/MsgClaimStreamById","value":{"receiver":"und1v9jxgu3jylfr2w","stream_id":"1"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgTopUpDepositByIdGetSignBytes(t *testing.T) {
+	sender := sdk.AccAddress("addr1")
+	deposit := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	msg := types.NewMsgTopUpDepositById(sender, 1, deposit)
+	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"stream/MsgTopUpDepositById","value":{"deposit":{"amount":"1000","denom":"nund"},"sender":"und1v9jxgu332vu4y3","stream_id":"1"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgUpdateFlowRateByIdGetSignBytes(t *testing.T) {
+	sender := sdk.AccAddress("addr1")
+	msg := types.NewMsgUpdateFlowRateById(sender, 1, 1)
+	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"stream/MsgUpdateFlowRateById","value":{"flow_rate":"1","sender":"und1v9jxgu332vu4y3","stream_id":"1"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgCancelStreamByIdGetSignBytes(t *testing.T) {
+	sender := sdk.AccAddress("addr1")
+	msg := types.NewMsgCancelStreamById(sender, 1)
+	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"stream/MsgCancelStreamById","value":{"sender":"und1v9jxgu332vu4y3","stream_id":"1"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgClaimAllStreamsGetSignBytes(t *testing.T) {
+	receiver := sdk.AccAddress("addr1")
+	msg := types.NewMsgClaimAllStreams(receiver, []sdk.AccAddress{sdk.AccAddress("addr2")}, 10, []byte{0x13, 0x01})
+	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"stream/MsgClaimAllStreams","value":{"max_streams":"10","receiver":"und1v9jxgu332vu4y3","senders":["und1v9jxgu3jylfr2w"],"start_key":"EwE="}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgTransferStreamReceiverGetSignBytes(t *testing.T) {
+	msg := types.NewMsgTransferStreamReceiver(sdk.AccAddress("addr1"), 1, sdk.AccAddress("addr2"))
+	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"stream/MsgTransferStreamReceiver","value":{"new_receiver":"und1v9jxgu3jylfr2w","receiver":"und1v9jxgu332vu4y3","stream_id":"1"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgPauseStreamGetSignBytes(t *testing.T) {
+	msg := types.NewMsgPauseStream(sdk.AccAddress("addr1"), 1)
+	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"stream/MsgPauseStream","value":{"sender":"und1v9jxgu332vu4y3","stream_id":"1"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgResumeStreamGetSignBytes(t *testing.T) {
+	msg := types.NewMsgResumeStream(sdk.AccAddress("addr1"), 1)
+	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"stream/MsgResumeStream","value":{"sender":"und1v9jxgu332vu4y3","stream_id":"1"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgSetLowDepositThresholdGetSignBytes(t *testing.T) {
+	msg := types.NewMsgSetLowDepositThreshold(sdk.AccAddress("addr1"), 1, 86400)
+	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"stream/MsgSetLowDepositThreshold","value":{"low_deposit_threshold":"86400","sender":"und1v9jxgu332vu4y3","stream_id":"1"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgRequestStreamTerminationGetSignBytes(t *testing.T) {
+	msg := types.NewMsgRequestStreamTermination(sdk.AccAddress("addr1"), 1, 604800)
+	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"stream/MsgRequestStreamTermination","value":{"notice_duration":"604800","sender":"und1v9jxgu332vu4y3","stream_id":"1"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgAcceptStreamTerminationGetSignBytes(t *testing.T) {
+	msg := types.NewMsgAcceptStreamTermination(sdk.AccAddress("addr1"), 1)
+	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"stream/MsgAcceptStreamTermination","value":{"receiver":"und1v9jxgu332vu4y3","stream_id":"1"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgAcceptPausableStreamGetSignBytes(t *testing.T) {
+	msg := types.NewMsgAcceptPausableStream(sdk.AccAddress("addr1"), 1)
 	pc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
 	res, err := pc.MarshalAminoJSON(msg)
 	require.NoError(t, err)
-	expected := `{"type":"stream/MsgCancelStream","value":{"receiver":"und1v9jxgu3jylfr2w","sender":"und1v9jxgu332vu4y3"}}`
+	expected := `{"type":"stream/MsgAcceptPausableStream","value":{"receiver":"und1v9jxgu332vu4y3","stream_id":"1"}}`
 	require.Equal(t, expected, string(res))
 }
@@ -3,7 +3,9 @@ package types
 import (
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	mathmod "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -12,16 +14,140 @@ var _ paramtypes.ParamSet = (*Params)(nil)
 // DefaultValidatorFee is set to 0%
 var DefaultValidatorFee = mathmod.LegacyNewDecWithPrec(1, 2)
 
+// DefaultAllowedDenoms only allows the native denomination by default
+var DefaultAllowedDenoms = []string{sdk.DefaultBondDenom}
+
+// DefaultMinDuration is the minimum number of seconds a new stream's deposit must last for
+const DefaultMinDuration uint64 = 60
+
+// DefaultMinDeposit sets no minimum deposit by default
+var DefaultMinDeposit sdk.Coins
+
+// DefaultMaxFlowRate sets no maximum flow rate by default
+const DefaultMaxFlowRate int64 = 0
+
+// DefaultFeeCollectorRatio sends the whole validator fee to the fee collector by default
+var DefaultFeeCollectorRatio = mathmod.LegacyOneDec()
+
+// DefaultCommunityPoolRatio sends none of the validator fee to the community pool by default
+var DefaultCommunityPoolRatio = mathmod.LegacyZeroDec()
+
+// DefaultBurnRatio burns none of the validator fee by default
+var DefaultBurnRatio = mathmod.LegacyZeroDec()
+
+// DefaultMinTerminationNotice is the minimum number of seconds of notice a sender must give to terminate a stream.
+// One week by default
+const DefaultMinTerminationNotice uint64 = 60 * 60 * 24 * 7
+
+// DefaultMaxClaimFeeRatio allows up to half of a claim to be used to pay the claim's fees by default
+var DefaultMaxClaimFeeRatio = mathmod.LegacyNewDecWithPrec(5, 1)
+
 // NewParams creates a new Params instance
-func NewParams(validatorFee mathmod.LegacyDec) Params {
+func NewParams(
+	validatorFee mathmod.LegacyDec,
+	allowedDenoms []string,
+	minDuration uint64,
+	minDeposit sdk.Coins,
+	maxFlowRate int64,
+	feeCollectorRatio, communityPoolRatio, burnRatio mathmod.LegacyDec,
+	minTerminationNotice uint64,
+	maxClaimFeeRatio mathmod.LegacyDec,
+) Params {
 	return Params{
-		ValidatorFee: validatorFee,
+		ValidatorFee:         validatorFee,
+		AllowedDenoms:        allowedDenoms,
+		MinDuration:          minDuration,
+		MinDeposit:           minDeposit,
+		MaxFlowRate:          maxFlowRate,
+		FeeCollectorRatio:    feeCollectorRatio,
+		CommunityPoolRatio:   communityPoolRatio,
+		BurnRatio:            burnRatio,
+		MinTerminationNotice: minTerminationNotice,
+		MaxClaimFeeRatio:     maxClaimFeeRatio,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultValidatorFee)
+	return NewParams(
+		DefaultValidatorFee, DefaultAllowedDenoms, DefaultMinDuration, DefaultMinDeposit, DefaultMaxFlowRate,
+		DefaultFeeCollectorRatio, DefaultCommunityPoolRatio, DefaultBurnRatio, DefaultMinTerminationNotice,
+		DefaultMaxClaimFeeRatio,
+	)
+}
+
+// IsAllowedDenom checks if the given denom may be used for streams
+func (p Params) IsAllowedDenom(denom string) bool {
+	for _, d := range p.AllowedDenoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckDeposit checks a deposit against the minimum deposit for its denom, if one is set
+func (p Params) CheckDeposit(deposit sdk.Coin) error {
+	minDeposit := p.MinDeposit.AmountOf(deposit.Denom)
+	if deposit.Amount.LT(minDeposit) {
+		return errorsmod.Wrapf(ErrInvalidData, "deposit %s is less than the minimum deposit %s%s", deposit, minDeposit, deposit.Denom)
+	}
+	return nil
+}
+
+// CheckFlowRate checks a flow rate against the maximum flow rate, if one is set
+func (p Params) CheckFlowRate(flowRate int64) error {
+	if p.MaxFlowRate > 0 && flowRate > p.MaxFlowRate {
+		return errorsmod.Wrapf(ErrInvalidData, "flow rate %d is greater than the maximum flow rate %d", flowRate, p.MaxFlowRate)
+	}
+	return nil
+}
+
+// CheckDuration checks a stream's calculated duration, in seconds, against the minimum duration
+func (p Params) CheckDuration(duration int64) error {
+	if duration < 0 || uint64(duration) < p.MinDuration {
+		return errorsmod.Wrapf(ErrInvalidData, "calculated duration too short. Must be at least %d seconds", p.MinDuration)
+	}
+	return nil
+}
+
+// CheckTerminationNotice checks a termination notice period, in seconds, against the minimum termination notice
+func (p Params) CheckTerminationNotice(notice uint64) error {
+	if notice < p.MinTerminationNotice {
+		return errorsmod.Wrapf(ErrInvalidData, "termination notice too short. Must be at least %d seconds", p.MinTerminationNotice)
+	}
+	return nil
+}
+
+// MaxClaimFee returns the maximum fee that can be paid from a stream payment. An unset ratio is treated as zero,
+// so no fees can be paid from the payment.
+func (p Params) MaxClaimFee(streamPayment sdk.Coin) sdk.Coin {
+	maxFee := sdk.NewCoin(streamPayment.Denom, mathmod.ZeroInt())
+	if !p.MaxClaimFeeRatio.IsNil() {
+		maxFee.Amount = p.MaxClaimFeeRatio.MulInt(streamPayment.Amount).TruncateInt()
+	}
+	return maxFee
+}
+
+// SplitValidatorFee splits a validator fee between the fee collector, community pool and burn according to
+// the fee routing ratios. The community pool and burn amounts are rounded down, and any remainder is sent to
+// the fee collector. Unset ratios are treated as zero, so the whole fee goes to the fee collector. Only fees in
+// the native denomination are burned, so the burn share of a fee in any other allowed denom goes to the fee
+// collector.
+func (p Params) SplitValidatorFee(valFee sdk.Coin) (sdk.Coin, sdk.Coin, sdk.Coin) {
+	communityPool := sdk.NewCoin(valFee.Denom, mathmod.ZeroInt())
+	if !p.CommunityPoolRatio.IsNil() {
+		communityPool.Amount = p.CommunityPoolRatio.MulInt(valFee.Amount).TruncateInt()
+	}
+
+	burned := sdk.NewCoin(valFee.Denom, mathmod.ZeroInt())
+	if !p.BurnRatio.IsNil() && valFee.Denom == sdk.DefaultBondDenom {
+		burned.Amount = p.BurnRatio.MulInt(valFee.Amount).TruncateInt()
+	}
+
+	feeCollector := valFee.Sub(communityPool).Sub(burned)
+
+	return feeCollector, communityPool, burned
 }
 
 // Validate validates the set of params
@@ -31,6 +157,26 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateAllowedDenoms(p.AllowedDenoms); err != nil {
+		return err
+	}
+
+	if err := validateMinDeposit(p.MinDeposit); err != nil {
+		return err
+	}
+
+	if err := validateMaxFlowRate(p.MaxFlowRate); err != nil {
+		return err
+	}
+
+	if err := validateFeeRouting(p.FeeCollectorRatio, p.CommunityPoolRatio, p.BurnRatio); err != nil {
+		return err
+	}
+
+	if err := validateFeeRatio("max claim fee", p.MaxClaimFeeRatio); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -54,3 +200,87 @@ func validateBaseValidatorFee(i interface{}) error {
 
 	return nil
 }
+
+func validateAllowedDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid allowed denom %s: %w", denom, err)
+		}
+
+		if seen[denom] {
+			return fmt.Errorf("duplicate allowed denom: %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+func validateMinDeposit(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid min deposit: %w", err)
+	}
+
+	return nil
+}
+
+func validateMaxFlowRate(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("max flow rate cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateFeeRatio(name string, v mathmod.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("%s ratio cannot be nil", name)
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("%s ratio cannot be negative: %s", name, v)
+	}
+
+	if v.GT(mathmod.LegacyOneDec()) {
+		return fmt.Errorf("%s ratio cannot be greater than 1. Sent %s", name, v)
+	}
+
+	return nil
+}
+
+func validateFeeRouting(feeCollectorRatio, communityPoolRatio, burnRatio mathmod.LegacyDec) error {
+	if err := validateFeeRatio("fee collector", feeCollectorRatio); err != nil {
+		return err
+	}
+
+	if err := validateFeeRatio("community pool", communityPoolRatio); err != nil {
+		return err
+	}
+
+	if err := validateFeeRatio("burn", burnRatio); err != nil {
+		return err
+	}
+
+	total := feeCollectorRatio.Add(communityPoolRatio).Add(burnRatio)
+	if !total.Equal(mathmod.LegacyOneDec()) {
+		return fmt.Errorf("fee collector, community pool and burn ratios must sum to 1. Sum is %s", total)
+	}
+
+	return nil
+}
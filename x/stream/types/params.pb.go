@@ -7,6 +7,8 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -30,6 +32,32 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// validator_fee is the chain-wide fee validators will receive from stream payments. A percentage value from 0 to 1
 	ValidatorFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=validator_fee,json=validatorFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"validator_fee"`
+	// allowed_denoms is the list of denominations, including IBC vouchers, that may be used to create and fund streams
+	AllowedDenoms []string `protobuf:"bytes,2,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty"`
+	// min_duration is the minimum number of seconds a new stream's deposit must last for at its flow rate
+	MinDuration uint64 `protobuf:"varint,3,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`
+	// min_deposit is the minimum amount, per denom, that can be deposited when creating or topping up a stream.
+	// Denoms not listed have no minimum
+	MinDeposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=min_deposit,json=minDeposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_deposit"`
+	// max_flow_rate is the maximum flow rate, in the smallest unit of a denom per second, a stream can have.
+	// Zero means there is no maximum
+	MaxFlowRate int64 `protobuf:"varint,5,opt,name=max_flow_rate,json=maxFlowRate,proto3" json:"max_flow_rate,omitempty"`
+	// fee_collector_ratio is the share of the validator fee sent to the fee collector for distribution to
+	// validators and delegators. A value from 0 to 1
+	FeeCollectorRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=fee_collector_ratio,json=feeCollectorRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_collector_ratio"`
+	// community_pool_ratio is the share of the validator fee sent to the community pool. A value from 0 to 1
+	CommunityPoolRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=community_pool_ratio,json=communityPoolRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool_ratio"`
+	// burn_ratio is the share of the validator fee that is burned. A value from 0 to 1. The fee_collector_ratio,
+	// community_pool_ratio and burn_ratio must sum to 1. Only fees in the native denomination are burned, and the
+	// burn share of fees in any other allowed denomination is sent to the fee collector
+	BurnRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=burn_ratio,json=burnRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn_ratio"`
+	// min_termination_notice is the minimum number of seconds of notice a sender must give when requesting the
+	// termination of a stream
+	MinTerminationNotice uint64 `protobuf:"varint,9,opt,name=min_termination_notice,json=minTerminationNotice,proto3" json:"min_termination_notice,omitempty"`
+	// max_claim_fee_ratio is the maximum share of the amount a receiver claims from a stream that can be used to pay
+	// the fees of the claim Tx, when the receiver does not have the funds to pay them. A value from 0 to 1. Zero means
+	// fees can never be paid from a claim
+	MaxClaimFeeRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=max_claim_fee_ratio,json=maxClaimFeeRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_claim_fee_ratio"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -65,6 +93,41 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMinDuration() uint64 {
+	if m != nil {
+		return m.MinDuration
+	}
+	return 0
+}
+
+func (m *Params) GetMinDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetMaxFlowRate() int64 {
+	if m != nil {
+		return m.MaxFlowRate
+	}
+	return 0
+}
+
+func (m *Params) GetMinTerminationNotice() uint64 {
+	if m != nil {
+		return m.MinTerminationNotice
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "mainchain.stream.v1.Params")
 }
@@ -72,24 +135,43 @@ func init() {
 func init() { proto.RegisterFile("mainchain/stream/v1/params.proto", fileDescriptor_b9cab0b9668730be) }
 
 var fileDescriptor_b9cab0b9668730be = []byte{
-	// 273 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0x4d, 0xcc, 0xcc,
-	0x4b, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x2f, 0x2e, 0x29, 0x4a, 0x4d, 0xcc, 0xd5, 0x2f, 0x33, 0xd4,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xab,
-	0xd0, 0x83, 0xa8, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93,
-	0x10, 0x75, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x60, 0x9e, 0x3e, 0x84, 0x03,
-	0x95, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x87, 0x88, 0x83, 0x58, 0x10, 0x51, 0xa5, 0x1a, 0x2e, 0xb6,
-	0x00, 0xb0, 0x45, 0x42, 0xd1, 0x5c, 0xbc, 0x65, 0x89, 0x39, 0x99, 0x29, 0x89, 0x25, 0xf9, 0x45,
-	0xf1, 0x69, 0xa9, 0xa9, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x4e, 0x66, 0x27, 0xee, 0xc9, 0x33,
-	0xdc, 0xba, 0x27, 0x2f, 0x0d, 0x31, 0xac, 0x38, 0x25, 0x5b, 0x2f, 0x33, 0x5f, 0x3f, 0x37, 0xb1,
-	0x24, 0x43, 0xcf, 0x27, 0x35, 0x3d, 0x31, 0xb9, 0xd2, 0x25, 0x35, 0xf9, 0xd2, 0x16, 0x5d, 0x2e,
-	0xa8, 0x5d, 0x2e, 0xa9, 0xc9, 0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0xe2, 0x81, 0x1b, 0xe6, 0x96,
-	0x9a, 0x6a, 0x25, 0xda, 0xf5, 0x7c, 0x83, 0x96, 0x00, 0xc2, 0x73, 0x10, 0x3b, 0x9d, 0x7c, 0x4f,
-	0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18,
-	0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x38, 0x3d, 0xb3, 0x24, 0xa3, 0x34,
-	0x49, 0x2f, 0x39, 0x3f, 0x57, 0xbf, 0x34, 0x2f, 0x33, 0x2d, 0x33, 0x39, 0xb1, 0x24, 0x33, 0x3f,
-	0x4f, 0x17, 0xc4, 0x47, 0x84, 0x56, 0x05, 0x2c, 0xbc, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8,
-	0xc0, 0x7e, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa3, 0x5a, 0x82, 0xb2, 0x50, 0x01, 0x00,
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x3d, 0x6f, 0x13, 0x41,
+	0x10, 0xf5, 0xe1, 0x60, 0xf0, 0x3a, 0x41, 0xc9, 0x25, 0xa0, 0x4b, 0x90, 0x2e, 0x26, 0x12, 0x92,
+	0x15, 0x29, 0x77, 0x32, 0x41, 0x14, 0x94, 0x89, 0xe5, 0x0a, 0x50, 0x64, 0x41, 0x03, 0xc5, 0x69,
+	0xbd, 0x9e, 0xb3, 0x57, 0xb9, 0xdd, 0xb1, 0x6e, 0xd7, 0x8e, 0xd3, 0x52, 0x52, 0x51, 0xf3, 0x0b,
+	0x10, 0x55, 0x0a, 0xf8, 0x0f, 0x29, 0x23, 0x2a, 0x44, 0x11, 0x50, 0x52, 0xe4, 0x6f, 0xa0, 0xfd,
+	0x48, 0x42, 0xef, 0xe6, 0xee, 0x76, 0x66, 0xee, 0xbd, 0x37, 0x6f, 0x76, 0x97, 0x34, 0x05, 0xe5,
+	0x92, 0x8d, 0x28, 0x97, 0xa9, 0xd2, 0x25, 0x50, 0x91, 0x4e, 0xdb, 0xe9, 0x98, 0x96, 0x54, 0xa8,
+	0x64, 0x5c, 0xa2, 0xc6, 0x70, 0xf5, 0xa6, 0x22, 0x71, 0x15, 0xc9, 0xb4, 0xbd, 0xb1, 0x42, 0x05,
+	0x97, 0x98, 0xda, 0xa7, 0xab, 0xdb, 0x88, 0x19, 0x2a, 0x81, 0x2a, 0xed, 0x53, 0x05, 0xe9, 0xb4,
+	0xdd, 0x07, 0x4d, 0xdb, 0x29, 0x43, 0x2e, 0x7d, 0x7e, 0xdd, 0xe5, 0x33, 0xbb, 0x4a, 0xdd, 0xc2,
+	0xa7, 0xd6, 0x86, 0x38, 0x44, 0x17, 0x37, 0x5f, 0x2e, 0xba, 0xf5, 0xa3, 0x46, 0x6a, 0x07, 0x56,
+	0x49, 0xf8, 0x81, 0x2c, 0x4d, 0x69, 0xc1, 0x07, 0x54, 0x63, 0x99, 0xe5, 0x00, 0x51, 0xd0, 0x0c,
+	0x5a, 0xf5, 0xbd, 0x17, 0xa7, 0xe7, 0x9b, 0x95, 0xdf, 0xe7, 0x9b, 0x8f, 0x1d, 0x9a, 0x1a, 0x1c,
+	0x26, 0x1c, 0x53, 0x41, 0xf5, 0x28, 0x79, 0x05, 0x43, 0xca, 0x8e, 0x3b, 0xc0, 0x7e, 0x7e, 0xdf,
+	0x21, 0x9e, 0xac, 0x03, 0xec, 0xeb, 0xd5, 0xc9, 0x76, 0xd0, 0x5b, 0xbc, 0x01, 0xeb, 0x02, 0x84,
+	0x4f, 0xc9, 0x03, 0x5a, 0x14, 0x78, 0x04, 0x83, 0x6c, 0x00, 0x12, 0x85, 0x8a, 0xee, 0x34, 0xab,
+	0xad, 0x7a, 0x6f, 0xc9, 0x47, 0x3b, 0x36, 0x18, 0x3e, 0x21, 0x8b, 0x82, 0xcb, 0x6c, 0x30, 0x29,
+	0xa9, 0xe6, 0x28, 0xa3, 0x6a, 0x33, 0x68, 0x2d, 0xf4, 0x1a, 0x82, 0xcb, 0x8e, 0x0f, 0x85, 0x1f,
+	0x03, 0xd2, 0xb0, 0x35, 0x30, 0x46, 0xc5, 0x75, 0xb4, 0xd0, 0xac, 0xb6, 0x1a, 0xcf, 0xd6, 0x13,
+	0xcf, 0x6f, 0x9c, 0x49, 0xbc, 0x33, 0xc9, 0x3e, 0x72, 0xb9, 0xd7, 0x35, 0x0d, 0x7c, 0xfb, 0xb3,
+	0xd9, 0x1a, 0x72, 0x3d, 0x9a, 0xf4, 0x13, 0x86, 0xc2, 0x3b, 0xe3, 0x5f, 0x3b, 0x6a, 0x70, 0x98,
+	0xea, 0xe3, 0x31, 0x28, 0xfb, 0x83, 0xfa, 0x72, 0x75, 0xb2, 0xbd, 0x58, 0xd8, 0xde, 0x32, 0xe3,
+	0xad, 0x72, 0x0d, 0x11, 0xa3, 0xc2, 0x91, 0x86, 0x5b, 0x64, 0x49, 0xd0, 0x59, 0x96, 0x17, 0x78,
+	0x94, 0x95, 0x54, 0x43, 0x74, 0xb7, 0x19, 0xb4, 0xaa, 0xbd, 0x86, 0xa0, 0xb3, 0x6e, 0x81, 0x47,
+	0x3d, 0xaa, 0x21, 0xcc, 0xc9, 0x6a, 0x0e, 0x90, 0x31, 0x2c, 0x0a, 0x60, 0xc6, 0x53, 0xdb, 0x40,
+	0x54, 0x9b, 0xcb, 0xd5, 0x95, 0x1c, 0x60, 0xff, 0x1a, 0xb1, 0x67, 0x00, 0xc3, 0x11, 0x59, 0x63,
+	0x28, 0xc4, 0x44, 0x72, 0x7d, 0x9c, 0x8d, 0x11, 0x0b, 0x4f, 0x74, 0x6f, 0x2e, 0xa2, 0xf0, 0x06,
+	0xf3, 0x00, 0xb1, 0x70, 0x4c, 0xef, 0x08, 0xe9, 0x4f, 0x4a, 0xe9, 0xf1, 0xef, 0xcf, 0x85, 0x5f,
+	0x37, 0x48, 0x0e, 0xf6, 0x39, 0x79, 0x64, 0x06, 0xaa, 0xa1, 0x14, 0x5c, 0xda, 0x21, 0x67, 0x12,
+	0x35, 0x67, 0x10, 0xd5, 0xed, 0xf8, 0xd7, 0x04, 0x97, 0x6f, 0x6f, 0x93, 0x6f, 0x6c, 0x2e, 0x04,
+	0xb2, 0x6a, 0x46, 0xc0, 0x0a, 0xca, 0x85, 0xd9, 0xae, 0x5e, 0x15, 0x99, 0x4b, 0xd5, 0xb2, 0xa0,
+	0xb3, 0x7d, 0x83, 0xd8, 0x05, 0xb0, 0xe2, 0x5e, 0x3e, 0xfc, 0x74, 0x75, 0xb2, 0xbd, 0x7c, 0x7b,
+	0x6c, 0xdd, 0x61, 0xd9, 0x7b, 0x7d, 0x7a, 0x11, 0x07, 0x67, 0x17, 0x71, 0xf0, 0xf7, 0x22, 0x0e,
+	0x3e, 0x5f, 0xc6, 0x95, 0xb3, 0xcb, 0xb8, 0xf2, 0xeb, 0x32, 0xae, 0xbc, 0xdf, 0xfd, 0x6f, 0x9b,
+	0x4d, 0x24, 0xcf, 0x39, 0xb3, 0xaa, 0x77, 0xcc, 0xfa, 0xf6, 0x1e, 0x98, 0x5d, 0xdf, 0x04, 0x76,
+	0xdf, 0xf5, 0x6b, 0xf6, 0x34, 0xee, 0xfe, 0x1b, 0x00, 0x80, 0x3b, 0xa4, 0x97, 0x2a, 0x04, 0x00,
 	0x00,
 }
 
@@ -113,6 +195,84 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxClaimFeeRatio.Size()
+		i -= size
+		if _, err := m.MaxClaimFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.MinTerminationNotice != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinTerminationNotice))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.BurnRatio.Size()
+		i -= size
+		if _, err := m.BurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.CommunityPoolRatio.Size()
+		i -= size
+		if _, err := m.CommunityPoolRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.FeeCollectorRatio.Size()
+		i -= size
+		if _, err := m.FeeCollectorRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MaxFlowRate != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFlowRate))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MinDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size := m.ValidatorFee.Size()
 		i -= size
@@ -145,6 +305,35 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.ValidatorFee.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MinDuration != 0 {
+		n += 1 + sovParams(uint64(m.MinDuration))
+	}
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxFlowRate != 0 {
+		n += 1 + sovParams(uint64(m.MaxFlowRate))
+	}
+	l = m.FeeCollectorRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.CommunityPoolRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.BurnRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MinTerminationNotice != 0 {
+		n += 1 + sovParams(uint64(m.MinTerminationNotice))
+	}
+	l = m.MaxClaimFeeRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -217,6 +406,265 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDuration", wireType)
+			}
+			m.MinDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinDeposit = append(m.MinDeposit, types.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFlowRate", wireType)
+			}
+			m.MaxFlowRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFlowRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeCollectorRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTerminationNotice", wireType)
+			}
+			m.MinTerminationNotice = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTerminationNotice |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClaimFeeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxClaimFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -4,29 +4,182 @@ import (
 	"testing"
 
 	mathmod "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/unification-com/mainchain/x/stream/types"
 )
 
 func TestParamsValidate(t *testing.T) {
-	params1 := types.Params{ValidatorFee: mathmod.LegacyNewDecWithPrec(1, 2)}
+	params1 := types.Params{ValidatorFee: mathmod.LegacyNewDecWithPrec(1, 2), FeeCollectorRatio: types.DefaultFeeCollectorRatio, CommunityPoolRatio: types.DefaultCommunityPoolRatio, BurnRatio: types.DefaultBurnRatio, MaxClaimFeeRatio: types.DefaultMaxClaimFeeRatio}
 	err := params1.Validate()
 	require.NoError(t, err)
 
-	params2 := types.Params{ValidatorFee: mathmod.LegacyNewDecWithPrec(-1, 2)}
+	params2 := types.Params{ValidatorFee: mathmod.LegacyNewDecWithPrec(-1, 2), FeeCollectorRatio: types.DefaultFeeCollectorRatio, CommunityPoolRatio: types.DefaultCommunityPoolRatio, BurnRatio: types.DefaultBurnRatio, MaxClaimFeeRatio: types.DefaultMaxClaimFeeRatio}
 	err = params2.Validate()
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "validator fee cannot be negative:")
 
-	params3 := types.Params{ValidatorFee: mathmod.LegacyNewDecWithPrec(101, 2)}
+	params3 := types.Params{ValidatorFee: mathmod.LegacyNewDecWithPrec(101, 2), FeeCollectorRatio: types.DefaultFeeCollectorRatio, CommunityPoolRatio: types.DefaultCommunityPoolRatio, BurnRatio: types.DefaultBurnRatio, MaxClaimFeeRatio: types.DefaultMaxClaimFeeRatio}
 	err = params3.Validate()
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "validator fee cannot be greater than 100% (1.00). Sent")
 
-	params4 := types.Params{ValidatorFee: mathmod.LegacyDec{}}
+	params4 := types.Params{ValidatorFee: mathmod.LegacyDec{}, FeeCollectorRatio: types.DefaultFeeCollectorRatio, CommunityPoolRatio: types.DefaultCommunityPoolRatio, BurnRatio: types.DefaultBurnRatio, MaxClaimFeeRatio: types.DefaultMaxClaimFeeRatio}
 	err = params4.Validate()
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "validator fee cannot be nil")
 
+	params5 := types.Params{ValidatorFee: mathmod.LegacyNewDecWithPrec(1, 2), FeeCollectorRatio: types.DefaultFeeCollectorRatio, CommunityPoolRatio: types.DefaultCommunityPoolRatio, BurnRatio: types.DefaultBurnRatio, MaxClaimFeeRatio: types.DefaultMaxClaimFeeRatio, AllowedDenoms: []string{"nund", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}}
+	err = params5.Validate()
+	require.NoError(t, err)
+	require.True(t, params5.IsAllowedDenom("nund"))
+	require.False(t, params5.IsAllowedDenom("uatom"))
+
+	params6 := types.Params{ValidatorFee: mathmod.LegacyNewDecWithPrec(1, 2), FeeCollectorRatio: types.DefaultFeeCollectorRatio, CommunityPoolRatio: types.DefaultCommunityPoolRatio, BurnRatio: types.DefaultBurnRatio, MaxClaimFeeRatio: types.DefaultMaxClaimFeeRatio, AllowedDenoms: []string{"nund", "nund"}}
+	err = params6.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "duplicate allowed denom: nund")
+
+	params7 := types.Params{ValidatorFee: mathmod.LegacyNewDecWithPrec(1, 2), FeeCollectorRatio: types.DefaultFeeCollectorRatio, CommunityPoolRatio: types.DefaultCommunityPoolRatio, BurnRatio: types.DefaultBurnRatio, MaxClaimFeeRatio: types.DefaultMaxClaimFeeRatio, AllowedDenoms: []string{"1nund"}}
+	err = params7.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid allowed denom 1nund")
+}
+
+func TestParamsValidate_Limits(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+	require.Equal(t, types.DefaultMinDuration, params.MinDuration)
+
+	params.MinDeposit = sdk.NewCoins(sdk.NewInt64Coin("nund", 1000))
+	params.MaxFlowRate = 100
+	require.NoError(t, params.Validate())
+
+	params.MaxFlowRate = -1
+	err := params.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "max flow rate cannot be negative: -1")
+
+	params.MaxFlowRate = 0
+	params.MinDeposit = sdk.Coins{sdk.Coin{Denom: "nund", Amount: mathmod.NewInt(-1)}}
+	err = params.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid min deposit")
+
+	params.MinDeposit = sdk.Coins{sdk.NewInt64Coin("nund", 1), sdk.NewInt64Coin("nund", 2)}
+	err = params.Validate()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "invalid min deposit")
+}
+
+func TestParamsLimitChecks(t *testing.T) {
+	params := types.DefaultParams()
+	params.MinDeposit = sdk.NewCoins(sdk.NewInt64Coin("nund", 1000))
+	params.MaxFlowRate = 100
+
+	require.NoError(t, params.CheckDeposit(sdk.NewInt64Coin("nund", 1000)))
+	require.ErrorContains(t, params.CheckDeposit(sdk.NewInt64Coin("nund", 999)), "is less than the minimum deposit 1000nund")
+	// no minimum for denoms not listed
+	require.NoError(t, params.CheckDeposit(sdk.NewInt64Coin("uatom", 1)))
+
+	require.NoError(t, params.CheckFlowRate(100))
+	require.ErrorContains(t, params.CheckFlowRate(101), "is greater than the maximum flow rate 100")
+
+	params.MaxFlowRate = 0
+	require.NoError(t, params.CheckFlowRate(1000000000))
+
+	require.NoError(t, params.CheckDuration(60))
+	require.ErrorContains(t, params.CheckDuration(59), "calculated duration too short. Must be at least 60 seconds")
+	require.Error(t, params.CheckDuration(-1))
+
+	params.MinTerminationNotice = 86400
+	require.NoError(t, params.CheckTerminationNotice(86400))
+	require.ErrorContains(t, params.CheckTerminationNotice(86399), "termination notice too short. Must be at least 86400 seconds")
+}
+
+func TestParamsValidate_FeeRouting(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.FeeCollectorRatio.Equal(mathmod.LegacyOneDec()))
+	require.True(t, params.CommunityPoolRatio.IsZero())
+	require.True(t, params.BurnRatio.IsZero())
+
+	params.FeeCollectorRatio = mathmod.LegacyNewDecWithPrec(5, 1)
+	params.CommunityPoolRatio = mathmod.LegacyNewDecWithPrec(3, 1)
+	params.BurnRatio = mathmod.LegacyNewDecWithPrec(2, 1)
+	require.NoError(t, params.Validate())
+
+	params.BurnRatio = mathmod.LegacyNewDecWithPrec(3, 1)
+	require.ErrorContains(t, params.Validate(), "ratios must sum to 1. Sum is 1.100000000000000000")
+
+	params.FeeCollectorRatio = mathmod.LegacyNewDecWithPrec(-1, 1)
+	params.CommunityPoolRatio = mathmod.LegacyNewDecWithPrec(8, 1)
+	require.ErrorContains(t, params.Validate(), "fee collector ratio cannot be negative")
+
+	params.FeeCollectorRatio = mathmod.LegacyZeroDec()
+	params.CommunityPoolRatio = mathmod.LegacyNewDecWithPrec(11, 1)
+	params.BurnRatio = mathmod.LegacyNewDecWithPrec(-1, 1)
+	require.ErrorContains(t, params.Validate(), "community pool ratio cannot be greater than 1")
+
+	params.CommunityPoolRatio = mathmod.LegacyZeroDec()
+	params.BurnRatio = mathmod.LegacyDec{}
+	require.ErrorContains(t, params.Validate(), "burn ratio cannot be nil")
+}
+
+func TestParamsSplitValidatorFee(t *testing.T) {
+	params := types.DefaultParams()
+
+	feeCollector, communityPool, burned := params.SplitValidatorFee(sdk.NewInt64Coin("nund", 1001))
+	require.Equal(t, sdk.NewInt64Coin("nund", 1001), feeCollector)
+	require.Equal(t, sdk.NewInt64Coin("nund", 0), communityPool)
+	require.Equal(t, sdk.NewInt64Coin("nund", 0), burned)
+
+	params.FeeCollectorRatio = mathmod.LegacyNewDecWithPrec(5, 1)
+	params.CommunityPoolRatio = mathmod.LegacyNewDecWithPrec(3, 1)
+	params.BurnRatio = mathmod.LegacyNewDecWithPrec(2, 1)
+
+	// community pool and burn are rounded down, the remainder goes to the fee collector
+	feeCollector, communityPool, burned = params.SplitValidatorFee(sdk.NewInt64Coin("nund", 1009))
+	require.Equal(t, sdk.NewInt64Coin("nund", 506), feeCollector)
+	require.Equal(t, sdk.NewInt64Coin("nund", 302), communityPool)
+	require.Equal(t, sdk.NewInt64Coin("nund", 201), burned)
+
+	feeCollector, communityPool, burned = params.SplitValidatorFee(sdk.NewInt64Coin("nund", 0))
+	require.True(t, feeCollector.IsZero())
+	require.True(t, communityPool.IsZero())
+	require.True(t, burned.IsZero())
+
+	// only the native denom is burned, the burn share of any other denom goes to the fee collector
+	feeCollector, communityPool, burned = params.SplitValidatorFee(sdk.NewInt64Coin("testdenom", 1009))
+	require.Equal(t, sdk.NewInt64Coin("testdenom", 707), feeCollector)
+	require.Equal(t, sdk.NewInt64Coin("testdenom", 302), communityPool)
+	require.Equal(t, sdk.NewInt64Coin("testdenom", 0), burned)
+
+	// unset ratios send everything to the fee collector
+	feeCollector, communityPool, burned = types.Params{}.SplitValidatorFee(sdk.NewInt64Coin("nund", 100))
+	require.Equal(t, sdk.NewInt64Coin("nund", 100), feeCollector)
+	require.True(t, communityPool.IsZero())
+	require.True(t, burned.IsZero())
+}
+
+func TestParamsMaxClaimFee(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.MaxClaimFeeRatio.Equal(mathmod.LegacyNewDecWithPrec(5, 1)))
+	require.Equal(t, sdk.NewInt64Coin("nund", 495), params.MaxClaimFee(sdk.NewInt64Coin("nund", 990)))
+
+	// rounded down
+	require.Equal(t, sdk.NewInt64Coin("nund", 49), params.MaxClaimFee(sdk.NewInt64Coin("nund", 99)))
+
+	params.MaxClaimFeeRatio = mathmod.LegacyZeroDec()
+	require.NoError(t, params.Validate())
+	require.True(t, params.MaxClaimFee(sdk.NewInt64Coin("nund", 990)).IsZero())
+
+	params.MaxClaimFeeRatio = mathmod.LegacyNewDecWithPrec(11, 1)
+	require.ErrorContains(t, params.Validate(), "max claim fee ratio cannot be greater than 1")
+
+	params.MaxClaimFeeRatio = mathmod.LegacyDec{}
+	require.ErrorContains(t, params.Validate(), "max claim fee ratio cannot be nil")
+
+	// an unset ratio allows no fees to be paid from a claim
+	require.True(t, types.Params{}.MaxClaimFee(sdk.NewInt64Coin("nund", 990)).IsZero())
 }
@@ -13,19 +13,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -412,6 +416,8 @@ type QueryAllStreamsForReceiverRequest struct {
 	ReceiverAddr string `protobuf:"bytes,1,opt,name=receiver_addr,json=receiverAddr,proto3" json:"receiver_addr,omitempty"`
 	// pagination is the pagination parameters for the request
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// denom optionally filters the results by stream denomination
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryAllStreamsForReceiverRequest) Reset()         { *m = QueryAllStreamsForReceiverRequest{} }
@@ -461,6 +467,13 @@ func (m *QueryAllStreamsForReceiverRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllStreamsForReceiverRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // QueryAllStreamsForReceiverResponse is the response type for the Query/AllStreamsForReceiver RPC method
 type QueryAllStreamsForReceiverResponse struct {
 	// receiver_addr is the receiver wallet address being queried
@@ -531,6 +544,8 @@ type QueryStreamByReceiverSenderRequest struct {
 	ReceiverAddr string `protobuf:"bytes,1,opt,name=receiver_addr,json=receiverAddr,proto3" json:"receiver_addr,omitempty"`
 	// sender_addr is the sender wallet address being queried
 	SenderAddr string `protobuf:"bytes,2,opt,name=sender_addr,json=senderAddr,proto3" json:"sender_addr,omitempty"`
+	// denom is the denomination of the stream being queried. Optional if the pair only has a single stream
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryStreamByReceiverSenderRequest) Reset()         { *m = QueryStreamByReceiverSenderRequest{} }
@@ -580,6 +595,13 @@ func (m *QueryStreamByReceiverSenderRequest) GetSenderAddr() string {
 	return ""
 }
 
+func (m *QueryStreamByReceiverSenderRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // QueryStreamByReceiverSenderResponse is the response type for the Query/StreamByReceiverSender RPC method
 type QueryStreamByReceiverSenderResponse struct {
 	// stream is the StreamResult
@@ -632,6 +654,8 @@ type QueryStreamReceiverSenderCurrentFlowRequest struct {
 	ReceiverAddr string `protobuf:"bytes,1,opt,name=receiver_addr,json=receiverAddr,proto3" json:"receiver_addr,omitempty"`
 	// sender_addr is the sender wallet address being queried
 	SenderAddr string `protobuf:"bytes,2,opt,name=sender_addr,json=senderAddr,proto3" json:"sender_addr,omitempty"`
+	// denom is the denomination of the stream being queried. Optional if the pair only has a single stream
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryStreamReceiverSenderCurrentFlowRequest) Reset() {
@@ -685,12 +709,21 @@ func (m *QueryStreamReceiverSenderCurrentFlowRequest) GetSenderAddr() string {
 	return ""
 }
 
+func (m *QueryStreamReceiverSenderCurrentFlowRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // QueryStreamReceiverSenderCurrentFlowResponse is the response type for the Query/StreamReceiverSenderCurrentFlow RPC method
 type QueryStreamReceiverSenderCurrentFlowResponse struct {
 	// configured_flow_rate is the flow rate configured in the stream
 	ConfiguredFlowRate int64 `protobuf:"varint,1,opt,name=configured_flow_rate,json=configuredFlowRate,proto3" json:"configured_flow_rate,omitempty"`
 	// current_flow_rate is the actual flow rate. This will be zero if the depositZeroTime has passed, or deposit is zero
 	CurrentFlowRate int64 `protobuf:"varint,2,opt,name=current_flow_rate,json=currentFlowRate,proto3" json:"current_flow_rate,omitempty"`
+	// status is the current status of the stream - pending, cliff, flowing or ended
+	Status StreamStatus `protobuf:"varint,3,opt,name=status,proto3,enum=mainchain.stream.v1.StreamStatus" json:"status,omitempty"`
 }
 
 func (m *QueryStreamReceiverSenderCurrentFlowResponse) Reset() {
@@ -744,12 +777,21 @@ func (m *QueryStreamReceiverSenderCurrentFlowResponse) GetCurrentFlowRate() int6
 	return 0
 }
 
+func (m *QueryStreamReceiverSenderCurrentFlowResponse) GetStatus() StreamStatus {
+	if m != nil {
+		return m.Status
+	}
+	return StreamStatusUnspecified
+}
+
 // QueryAllStreamsForSenderRequest is the request type for the Query/AllStreamsForSender RPC method
 type QueryAllStreamsForSenderRequest struct {
 	// sender_addr is the receiver wallet address being queried
 	SenderAddr string `protobuf:"bytes,1,opt,name=sender_addr,json=senderAddr,proto3" json:"sender_addr,omitempty"`
 	// pagination is the pagination parameters for the request
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// denom optionally filters the results by stream denomination
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *QueryAllStreamsForSenderRequest) Reset()         { *m = QueryAllStreamsForSenderRequest{} }
@@ -799,6 +841,13 @@ func (m *QueryAllStreamsForSenderRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryAllStreamsForSenderRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // QueryAllStreamsForSenderResponse is the response type for the Query/AllStreamsForSender RPC method
 type QueryAllStreamsForSenderResponse struct {
 	// sender_addr is the receiver wallet address being queried
@@ -863,6 +912,392 @@ func (m *QueryAllStreamsForSenderResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryStreamByIdRequest is the request type for the Query/StreamById RPC method
+type QueryStreamByIdRequest struct {
+	// stream_id is the ID of the stream being queried
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *QueryStreamByIdRequest) Reset()         { *m = QueryStreamByIdRequest{} }
+func (m *QueryStreamByIdRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamByIdRequest) ProtoMessage()    {}
+func (*QueryStreamByIdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41f208b821ea45d, []int{15}
+}
+func (m *QueryStreamByIdRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamByIdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamByIdRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamByIdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamByIdRequest.Merge(m, src)
+}
+func (m *QueryStreamByIdRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamByIdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamByIdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamByIdRequest proto.InternalMessageInfo
+
+func (m *QueryStreamByIdRequest) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// QueryStreamByIdResponse is the response type for the Query/StreamById RPC method
+type QueryStreamByIdResponse struct {
+	// stream is the StreamResult
+	Stream StreamResult `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream"`
+}
+
+func (m *QueryStreamByIdResponse) Reset()         { *m = QueryStreamByIdResponse{} }
+func (m *QueryStreamByIdResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamByIdResponse) ProtoMessage()    {}
+func (*QueryStreamByIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41f208b821ea45d, []int{16}
+}
+func (m *QueryStreamByIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamByIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamByIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamByIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamByIdResponse.Merge(m, src)
+}
+func (m *QueryStreamByIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamByIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamByIdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamByIdResponse proto.InternalMessageInfo
+
+func (m *QueryStreamByIdResponse) GetStream() StreamResult {
+	if m != nil {
+		return m.Stream
+	}
+	return StreamResult{}
+}
+
+// QueryStreamStatsRequest is the request type for the Query/StreamStats RPC method
+type QueryStreamStatsRequest struct {
+	// stream_id is the ID of the stream being queried
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *QueryStreamStatsRequest) Reset()         { *m = QueryStreamStatsRequest{} }
+func (m *QueryStreamStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamStatsRequest) ProtoMessage()    {}
+func (*QueryStreamStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41f208b821ea45d, []int{17}
+}
+func (m *QueryStreamStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamStatsRequest.Merge(m, src)
+}
+func (m *QueryStreamStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamStatsRequest proto.InternalMessageInfo
+
+func (m *QueryStreamStatsRequest) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// QueryStreamStatsResponse is the response type for the Query/StreamStats RPC method
+type QueryStreamStatsResponse struct {
+	// stats are the stream's cumulative claim statistics
+	Stats StreamStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *QueryStreamStatsResponse) Reset()         { *m = QueryStreamStatsResponse{} }
+func (m *QueryStreamStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamStatsResponse) ProtoMessage()    {}
+func (*QueryStreamStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41f208b821ea45d, []int{18}
+}
+func (m *QueryStreamStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamStatsResponse.Merge(m, src)
+}
+func (m *QueryStreamStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamStatsResponse proto.InternalMessageInfo
+
+func (m *QueryStreamStatsResponse) GetStats() StreamStats {
+	if m != nil {
+		return m.Stats
+	}
+	return StreamStats{}
+}
+
+// QueryStreamHistoryRequest is the request type for the Query/StreamHistory RPC method
+type QueryStreamHistoryRequest struct {
+	// stream_id is the ID of the stream being queried
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *QueryStreamHistoryRequest) Reset()         { *m = QueryStreamHistoryRequest{} }
+func (m *QueryStreamHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamHistoryRequest) ProtoMessage()    {}
+func (*QueryStreamHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41f208b821ea45d, []int{19}
+}
+func (m *QueryStreamHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamHistoryRequest.Merge(m, src)
+}
+func (m *QueryStreamHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryStreamHistoryRequest) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+// QueryStreamHistoryResponse is the response type for the Query/StreamHistory RPC method
+type QueryStreamHistoryResponse struct {
+	// history is the stream's flow rate change history
+	History StreamHistory `protobuf:"bytes,1,opt,name=history,proto3" json:"history"`
+}
+
+func (m *QueryStreamHistoryResponse) Reset()         { *m = QueryStreamHistoryResponse{} }
+func (m *QueryStreamHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamHistoryResponse) ProtoMessage()    {}
+func (*QueryStreamHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41f208b821ea45d, []int{20}
+}
+func (m *QueryStreamHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamHistoryResponse.Merge(m, src)
+}
+func (m *QueryStreamHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryStreamHistoryResponse) GetHistory() StreamHistory {
+	if m != nil {
+		return m.History
+	}
+	return StreamHistory{}
+}
+
+// QueryStreamsExpiringBeforeRequest is the request type for the Query/StreamsExpiringBefore RPC method
+type QueryStreamsExpiringBeforeRequest struct {
+	// before is the time streams must expire before
+	Before time.Time `protobuf:"bytes,1,opt,name=before,proto3,stdtime" json:"before"`
+	// pagination is the pagination parameters for the request
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStreamsExpiringBeforeRequest) Reset()         { *m = QueryStreamsExpiringBeforeRequest{} }
+func (m *QueryStreamsExpiringBeforeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamsExpiringBeforeRequest) ProtoMessage()    {}
+func (*QueryStreamsExpiringBeforeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41f208b821ea45d, []int{21}
+}
+func (m *QueryStreamsExpiringBeforeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamsExpiringBeforeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamsExpiringBeforeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamsExpiringBeforeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamsExpiringBeforeRequest.Merge(m, src)
+}
+func (m *QueryStreamsExpiringBeforeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamsExpiringBeforeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamsExpiringBeforeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamsExpiringBeforeRequest proto.InternalMessageInfo
+
+func (m *QueryStreamsExpiringBeforeRequest) GetBefore() time.Time {
+	if m != nil {
+		return m.Before
+	}
+	return time.Time{}
+}
+
+func (m *QueryStreamsExpiringBeforeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryStreamsExpiringBeforeResponse is the response type for the Query/StreamsExpiringBefore RPC method
+type QueryStreamsExpiringBeforeResponse struct {
+	// streams is the list of streams expiring before the given time
+	Streams []*StreamResult `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStreamsExpiringBeforeResponse) Reset()         { *m = QueryStreamsExpiringBeforeResponse{} }
+func (m *QueryStreamsExpiringBeforeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStreamsExpiringBeforeResponse) ProtoMessage()    {}
+func (*QueryStreamsExpiringBeforeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e41f208b821ea45d, []int{22}
+}
+func (m *QueryStreamsExpiringBeforeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStreamsExpiringBeforeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStreamsExpiringBeforeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryStreamsExpiringBeforeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStreamsExpiringBeforeResponse.Merge(m, src)
+}
+func (m *QueryStreamsExpiringBeforeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStreamsExpiringBeforeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStreamsExpiringBeforeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStreamsExpiringBeforeResponse proto.InternalMessageInfo
+
+func (m *QueryStreamsExpiringBeforeResponse) GetStreams() []*StreamResult {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func (m *QueryStreamsExpiringBeforeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*StreamResult)(nil), "mainchain.stream.v1.StreamResult")
 	proto.RegisterType((*QueryParamsRequest)(nil), "mainchain.stream.v1.QueryParamsRequest")
@@ -879,80 +1314,108 @@ func init() {
 	proto.RegisterType((*QueryStreamReceiverSenderCurrentFlowResponse)(nil), "mainchain.stream.v1.QueryStreamReceiverSenderCurrentFlowResponse")
 	proto.RegisterType((*QueryAllStreamsForSenderRequest)(nil), "mainchain.stream.v1.QueryAllStreamsForSenderRequest")
 	proto.RegisterType((*QueryAllStreamsForSenderResponse)(nil), "mainchain.stream.v1.QueryAllStreamsForSenderResponse")
+	proto.RegisterType((*QueryStreamByIdRequest)(nil), "mainchain.stream.v1.QueryStreamByIdRequest")
+	proto.RegisterType((*QueryStreamByIdResponse)(nil), "mainchain.stream.v1.QueryStreamByIdResponse")
+	proto.RegisterType((*QueryStreamStatsRequest)(nil), "mainchain.stream.v1.QueryStreamStatsRequest")
+	proto.RegisterType((*QueryStreamStatsResponse)(nil), "mainchain.stream.v1.QueryStreamStatsResponse")
+	proto.RegisterType((*QueryStreamHistoryRequest)(nil), "mainchain.stream.v1.QueryStreamHistoryRequest")
+	proto.RegisterType((*QueryStreamHistoryResponse)(nil), "mainchain.stream.v1.QueryStreamHistoryResponse")
+	proto.RegisterType((*QueryStreamsExpiringBeforeRequest)(nil), "mainchain.stream.v1.QueryStreamsExpiringBeforeRequest")
+	proto.RegisterType((*QueryStreamsExpiringBeforeResponse)(nil), "mainchain.stream.v1.QueryStreamsExpiringBeforeResponse")
 }
 
 func init() { proto.RegisterFile("mainchain/stream/v1/query.proto", fileDescriptor_e41f208b821ea45d) }
 
 var fileDescriptor_e41f208b821ea45d = []byte{
-	// 1077 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0x38, 0x89, 0x93, 0xbc, 0x14, 0x50, 0x26, 0x06, 0xb9, 0x0e, 0xb5, 0xdd, 0xad, 0x44,
-	0x4d, 0xa0, 0xde, 0xd8, 0x6e, 0x09, 0x01, 0x55, 0x22, 0x0e, 0x84, 0x03, 0x42, 0x32, 0x9b, 0x0b,
-	0x42, 0x42, 0xd1, 0x64, 0x3d, 0xd9, 0xae, 0x58, 0xef, 0xb8, 0xfb, 0x23, 0x25, 0xaa, 0x2a, 0x55,
-	0x9c, 0x7a, 0x44, 0x2a, 0x47, 0x84, 0x7a, 0x40, 0x02, 0x81, 0x54, 0x71, 0xe0, 0xca, 0x99, 0x1e,
-	0x2b, 0xb8, 0xf4, 0x84, 0x50, 0x8c, 0x04, 0xfc, 0x17, 0x68, 0x67, 0x66, 0x9d, 0xdd, 0xc6, 0xbb,
-	0x9b, 0x5f, 0x08, 0x2e, 0x91, 0x67, 0xf6, 0xbd, 0x79, 0xdf, 0xf7, 0xde, 0xbc, 0xef, 0x4d, 0xa0,
-	0xda, 0x27, 0xa6, 0xad, 0xdf, 0x20, 0xa6, 0xad, 0xba, 0x9e, 0x43, 0x49, 0x5f, 0xdd, 0x6d, 0xaa,
-	0x37, 0x7d, 0xea, 0xec, 0x35, 0x06, 0x0e, 0xf3, 0x18, 0x5e, 0x18, 0x19, 0x34, 0x84, 0x41, 0x63,
-	0xb7, 0x59, 0xae, 0xe8, 0xcc, 0xed, 0x33, 0x57, 0xdd, 0x26, 0x2e, 0x55, 0x77, 0x9b, 0xdb, 0xd4,
-	0x23, 0x4d, 0x55, 0x67, 0xa6, 0x2d, 0x9c, 0xca, 0x4b, 0xd1, 0xef, 0xfc, 0xb4, 0x91, 0xd5, 0x80,
-	0x18, 0xa6, 0x4d, 0x3c, 0x93, 0x85, 0xb6, 0xe7, 0x85, 0xed, 0x16, 0x5f, 0xa9, 0x62, 0x21, 0x3f,
-	0x15, 0x0d, 0x66, 0x30, 0xb1, 0x1f, 0xfc, 0x92, 0xbb, 0x2f, 0x1a, 0x8c, 0x19, 0x16, 0x55, 0xc9,
-	0xc0, 0x54, 0x89, 0x6d, 0x33, 0x8f, 0x9f, 0x16, 0xfa, 0xcc, 0x93, 0xbe, 0x69, 0x33, 0x95, 0xff,
-	0x95, 0x5b, 0xb5, 0x71, 0x1c, 0x07, 0xc4, 0x21, 0x7d, 0x37, 0xcd, 0x42, 0xd2, 0xe5, 0x16, 0xca,
-	0xf7, 0x08, 0xce, 0x6d, 0xf2, 0x0d, 0x8d, 0xba, 0xbe, 0xe5, 0xe1, 0xab, 0x30, 0xe3, 0x50, 0x9d,
-	0x9a, 0xbb, 0xd4, 0x29, 0xa1, 0x1a, 0xaa, 0xcf, 0x76, 0x4a, 0xbf, 0xfc, 0x78, 0xa5, 0x28, 0xf1,
-	0xaf, 0xf5, 0x7a, 0x0e, 0x75, 0xdd, 0x4d, 0xcf, 0x31, 0x6d, 0x43, 0x1b, 0x59, 0xe2, 0x65, 0x28,
-	0xb8, 0xd4, 0xee, 0x51, 0xa7, 0x94, 0xcf, 0xf0, 0x91, 0x76, 0xb8, 0x0d, 0x05, 0x01, 0xa4, 0x34,
-	0x51, 0x43, 0xf5, 0xb9, 0xd6, 0x62, 0x63, 0x4c, 0x41, 0x1a, 0x12, 0x9a, 0x34, 0x55, 0x8a, 0x80,
-	0x3f, 0x08, 0xb2, 0xde, 0xe5, 0x24, 0x35, 0x7a, 0xd3, 0xa7, 0xae, 0xa7, 0x74, 0x61, 0x21, 0xb6,
-	0xeb, 0x0e, 0x98, 0xed, 0x52, 0xbc, 0x0a, 0x05, 0x91, 0x0c, 0xce, 0x23, 0x29, 0x82, 0x70, 0xea,
-	0x4c, 0x3e, 0xfa, 0xad, 0x9a, 0xd3, 0xa4, 0x83, 0xf2, 0x05, 0x82, 0x0b, 0xfc, 0xc8, 0x75, 0x62,
-	0xe9, 0xbe, 0x45, 0x3c, 0xba, 0x61, 0xb1, 0x5b, 0x1a, 0xf1, 0xa8, 0x8c, 0x89, 0x31, 0x4c, 0x06,
-	0xf7, 0x42, 0xa4, 0x48, 0xe3, 0xbf, 0x79, 0x40, 0xea, 0x98, 0xac, 0xc7, 0x93, 0xf0, 0x6c, 0xeb,
-	0x62, 0x0a, 0xa5, 0x2e, 0x37, 0xd4, 0xa4, 0x03, 0x2e, 0xc3, 0x4c, 0xcf, 0x77, 0x78, 0xc1, 0x79,
-	0x3e, 0x26, 0xb5, 0xd1, 0xfa, 0x8d, 0x99, 0x7b, 0x0f, 0xaa, 0xb9, 0xbf, 0x1e, 0x54, 0x73, 0xca,
-	0x10, 0x41, 0x25, 0x09, 0x96, 0x24, 0xdd, 0x8e, 0xe0, 0x9a, 0x6b, 0x9d, 0x6f, 0xc8, 0x1a, 0x04,
-	0x17, 0xb6, 0x21, 0xaf, 0x6a, 0x63, 0x9d, 0x99, 0xb6, 0x24, 0xfc, 0x6f, 0x02, 0xc7, 0x25, 0x98,
-	0x76, 0xa9, 0xce, 0xec, 0x9e, 0x5b, 0x9a, 0xe4, 0x9f, 0xc2, 0x25, 0x5e, 0x84, 0xd9, 0x1d, 0x8b,
-	0xdd, 0xda, 0x72, 0x88, 0x47, 0x4b, 0x53, 0x35, 0x54, 0x9f, 0xd0, 0x66, 0x76, 0x24, 0x15, 0xe5,
-	0x63, 0x59, 0x4e, 0x11, 0x2f, 0xac, 0x32, 0xde, 0x00, 0x38, 0xe8, 0x31, 0xc9, 0xef, 0xa5, 0x18,
-	0x3f, 0xd1, 0xde, 0x21, 0xcb, 0x2e, 0x31, 0xc2, 0x6a, 0x69, 0x11, 0x4f, 0xe5, 0x4b, 0x04, 0xc5,
-	0xf8, 0xf9, 0x32, 0x75, 0x6f, 0xc2, 0xb4, 0x20, 0x1b, 0x5c, 0x98, 0x89, 0xfa, 0x5c, 0x6a, 0x1a,
-	0x44, 0xb7, 0x68, 0xa1, 0x07, 0x7e, 0x37, 0x86, 0x2e, 0xcf, 0xd1, 0x5d, 0xce, 0x44, 0x27, 0x22,
-	0xc7, 0xe0, 0x7d, 0x87, 0xe0, 0x22, 0x87, 0xb7, 0x66, 0x59, 0x12, 0xe1, 0x06, 0x73, 0x34, 0xd9,
-	0x68, 0x61, 0x32, 0xae, 0xc3, 0x33, 0x61, 0xef, 0x6d, 0x91, 0x5e, 0x2f, 0xbb, 0x55, 0xcf, 0x85,
-	0xe6, 0xc1, 0xf6, 0x53, 0xb9, 0xcc, 0x9f, 0x38, 0x97, 0x7f, 0x23, 0x50, 0xd2, 0xc0, 0xca, 0xcc,
-	0x9e, 0x12, 0x6d, 0xa4, 0x30, 0xf9, 0x53, 0x16, 0x66, 0xe2, 0xe4, 0x85, 0xf9, 0x2a, 0xe4, 0x2a,
-	0xe2, 0x74, 0xf6, 0x42, 0x9a, 0x9b, 0x5c, 0xd0, 0xce, 0xa8, 0x32, 0xab, 0x30, 0x27, 0x04, 0x52,
-	0x38, 0x67, 0xa9, 0x29, 0x08, 0xe3, 0x60, 0x53, 0xf9, 0x04, 0x2e, 0xa5, 0xe2, 0x93, 0xc5, 0x78,
-	0x7b, 0x24, 0xbc, 0xa2, 0x87, 0xb2, 0x93, 0xd9, 0x99, 0x0d, 0xb4, 0xe2, 0xdb, 0x3f, 0x7f, 0x58,
-	0x42, 0x23, 0x25, 0xfe, 0x06, 0xc1, 0x2b, 0x91, 0x68, 0xf1, 0x58, 0xeb, 0xbe, 0xe3, 0x50, 0xdb,
-	0xe3, 0xe2, 0xf4, 0x9f, 0xa7, 0xe5, 0x3e, 0x82, 0x57, 0x8f, 0x86, 0x54, 0x26, 0x68, 0x19, 0x8a,
-	0x3a, 0xb3, 0x77, 0x4c, 0xc3, 0x77, 0x68, 0x6f, 0xeb, 0x40, 0xa7, 0x10, 0xd7, 0x29, 0x7c, 0xf0,
-	0x2d, 0x14, 0x5f, 0xdc, 0x84, 0x79, 0x5d, 0x1c, 0x14, 0x31, 0x0f, 0x30, 0x4e, 0x74, 0xa6, 0x44,
-	0xda, 0x9e, 0xd3, 0x23, 0x81, 0x02, 0x91, 0xfb, 0x1a, 0x41, 0xf5, 0x70, 0xe7, 0xc4, 0xaf, 0xd2,
-	0x53, 0xa4, 0xd1, 0xd1, 0x49, 0x9f, 0x59, 0x83, 0x0f, 0x11, 0xd4, 0x92, 0x61, 0x8e, 0x06, 0xed,
-	0x89, 0x71, 0xfe, 0x2f, 0x5a, 0xbb, 0xf5, 0x10, 0x60, 0x8a, 0xb3, 0xc4, 0x77, 0x11, 0x14, 0xc4,
-	0x8b, 0x00, 0x5f, 0x1e, 0x8b, 0xe4, 0xf0, 0xf3, 0xa3, 0x5c, 0xcf, 0x36, 0x14, 0x31, 0x95, 0x4b,
-	0x9f, 0xfd, 0xfa, 0xc7, 0xfd, 0xfc, 0x05, 0xbc, 0xa8, 0x26, 0xbf, 0xdc, 0xf0, 0x43, 0x04, 0xf3,
-	0x87, 0xe6, 0x3b, 0x6e, 0x25, 0x07, 0x49, 0x7a, 0xa3, 0x94, 0xdb, 0xc7, 0xf2, 0x91, 0x18, 0x97,
-	0x39, 0xc6, 0x25, 0x5c, 0x1f, 0x8b, 0x51, 0x0f, 0xfd, 0xc4, 0x45, 0xe7, 0xd0, 0xee, 0x21, 0x98,
-	0x96, 0x77, 0x03, 0xa7, 0xe4, 0x22, 0x3e, 0xce, 0xcb, 0x2f, 0x1f, 0xc1, 0x52, 0x42, 0xaa, 0x73,
-	0x48, 0x0a, 0xae, 0xa9, 0xc9, 0xcf, 0x59, 0x57, 0x25, 0x96, 0x85, 0x7f, 0x46, 0xf0, 0xfc, 0xd8,
-	0x51, 0x84, 0x5f, 0x4b, 0x0e, 0x97, 0x36, 0x68, 0xcb, 0x2b, 0xc7, 0xf6, 0x93, 0xa0, 0xaf, 0x73,
-	0xd0, 0x2b, 0xf8, 0x5a, 0x2a, 0xe8, 0x50, 0xe4, 0xd4, 0xdb, 0x31, 0x75, 0xbc, 0x83, 0x9f, 0x20,
-	0x78, 0x61, 0xbc, 0x90, 0xe3, 0x95, 0xac, 0xcc, 0x25, 0x8c, 0xa6, 0xf2, 0xeb, 0xc7, 0x77, 0x94,
-	0x64, 0xde, 0xe3, 0x64, 0xde, 0xc1, 0xeb, 0x27, 0x22, 0xa3, 0xde, 0x8e, 0xc8, 0xc3, 0x1d, 0x7c,
-	0x37, 0x0f, 0xd5, 0x0c, 0x2d, 0xc6, 0x6f, 0x65, 0x41, 0xcd, 0x1a, 0x38, 0xe5, 0xb5, 0x53, 0x9c,
-	0x20, 0x59, 0x7f, 0xc8, 0x59, 0x6b, 0xb8, 0x7b, 0x06, 0xac, 0xd5, 0xe8, 0x80, 0xc0, 0x3f, 0x21,
-	0x58, 0x18, 0xa3, 0xa8, 0xf8, 0xea, 0x11, 0x6f, 0x5b, 0xbc, 0xae, 0xd7, 0x8e, 0xe9, 0x25, 0xe9,
-	0xad, 0x72, 0x7a, 0x6d, 0xdc, 0x4c, 0xa5, 0x27, 0x48, 0xc4, 0xc9, 0x74, 0xde, 0x7f, 0xb4, 0x5f,
-	0x41, 0x8f, 0xf7, 0x2b, 0xe8, 0xf7, 0xfd, 0x0a, 0xfa, 0x7c, 0x58, 0xc9, 0x3d, 0x1e, 0x56, 0x72,
-	0x4f, 0x86, 0x95, 0xdc, 0x47, 0x6d, 0xc3, 0xf4, 0x6e, 0xf8, 0xdb, 0x0d, 0x9d, 0xf5, 0x55, 0xdf,
-	0x36, 0x77, 0x4c, 0x9d, 0x4b, 0xec, 0x95, 0x60, 0x7d, 0x10, 0xe6, 0xd3, 0x30, 0x90, 0xb7, 0x37,
-	0xa0, 0xee, 0x76, 0x81, 0xff, 0x2f, 0xda, 0xfe, 0x27, 0x00, 0x00, 0xff, 0xff, 0x09, 0x27, 0xd0,
-	0xb3, 0xb5, 0x0f, 0x00, 0x00,
+	// 1405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x13, 0xc7,
+	0x17, 0xcf, 0xe4, 0xa7, 0xf3, 0x02, 0x5f, 0xc4, 0x60, 0xf8, 0x9a, 0x4d, 0xb1, 0xcd, 0xa2, 0x16,
+	0x17, 0xc8, 0x2e, 0x8e, 0x09, 0x21, 0xa5, 0x48, 0x8d, 0x53, 0x42, 0x51, 0x55, 0x29, 0xdd, 0xf4,
+	0x50, 0x55, 0x42, 0xd6, 0x7a, 0x77, 0x6c, 0x56, 0xb5, 0x77, 0xcc, 0xee, 0x3a, 0x10, 0x21, 0x24,
+	0xd4, 0x13, 0x47, 0xa4, 0xf6, 0xc8, 0x81, 0x4b, 0xa5, 0xfe, 0x50, 0xab, 0x1e, 0x90, 0x2a, 0x55,
+	0x42, 0xea, 0xad, 0x9c, 0x2a, 0xd4, 0x5e, 0x38, 0x95, 0x8a, 0x54, 0x6a, 0xfb, 0x5f, 0x54, 0x3b,
+	0x33, 0xeb, 0xec, 0x62, 0x7b, 0xd7, 0x0e, 0x41, 0xe2, 0x62, 0x79, 0x66, 0xdf, 0x67, 0xde, 0xe7,
+	0xf3, 0xde, 0xcc, 0x9b, 0x37, 0x90, 0x6b, 0xea, 0x96, 0x6d, 0x5c, 0xd5, 0x2d, 0x5b, 0x75, 0x3d,
+	0x87, 0xe8, 0x4d, 0x75, 0xa3, 0xa8, 0x5e, 0x6b, 0x13, 0x67, 0x53, 0x69, 0x39, 0xd4, 0xa3, 0xf8,
+	0x40, 0xc7, 0x40, 0xe1, 0x06, 0xca, 0x46, 0x51, 0xca, 0x1a, 0xd4, 0x6d, 0x52, 0x57, 0xad, 0xea,
+	0x2e, 0x51, 0x37, 0x8a, 0x55, 0xe2, 0xe9, 0x45, 0xd5, 0xa0, 0x96, 0xcd, 0x41, 0xd2, 0x89, 0xf0,
+	0x77, 0xb6, 0x5a, 0xc7, 0xaa, 0xa5, 0xd7, 0x2d, 0x5b, 0xf7, 0x2c, 0x1a, 0xd8, 0x1e, 0xe6, 0xb6,
+	0x15, 0x36, 0x52, 0xf9, 0x40, 0x7c, 0x4a, 0xd7, 0x69, 0x9d, 0xf2, 0x79, 0xff, 0x9f, 0x98, 0x7d,
+	0xad, 0x4e, 0x69, 0xbd, 0x41, 0x54, 0xbd, 0x65, 0xa9, 0xba, 0x6d, 0x53, 0x8f, 0xad, 0x16, 0x60,
+	0x72, 0xe2, 0x2b, 0x1b, 0x55, 0xdb, 0x35, 0xd5, 0xb3, 0x9a, 0xc4, 0xf5, 0xf4, 0x66, 0x4b, 0x18,
+	0xec, 0xd7, 0x9b, 0x96, 0x4d, 0x55, 0xf6, 0x2b, 0xa6, 0xf2, 0xbd, 0x82, 0xd0, 0xd2, 0x1d, 0xbd,
+	0xe9, 0xc6, 0x59, 0xf0, 0x7f, 0xdc, 0x42, 0xfe, 0x16, 0xc1, 0x9e, 0x75, 0x36, 0xa1, 0x11, 0xb7,
+	0xdd, 0xf0, 0xf0, 0x19, 0x48, 0x39, 0xc4, 0x20, 0xd6, 0x06, 0x71, 0x32, 0x28, 0x8f, 0x0a, 0xd3,
+	0xe5, 0xcc, 0x6f, 0x0f, 0xe6, 0xd2, 0x42, 0xe0, 0xb2, 0x69, 0x3a, 0xc4, 0x75, 0xd7, 0x3d, 0xc7,
+	0xb2, 0xeb, 0x5a, 0xc7, 0x12, 0x9f, 0x86, 0x49, 0x97, 0xd8, 0x26, 0x71, 0x32, 0xa3, 0x09, 0x18,
+	0x61, 0x87, 0x4b, 0x30, 0xc9, 0x89, 0x64, 0xc6, 0xf2, 0xa8, 0x30, 0x33, 0x3f, 0xab, 0xf4, 0xc8,
+	0x98, 0x22, 0xa8, 0x09, 0x53, 0x39, 0x0d, 0xf8, 0x43, 0x3f, 0x2d, 0x6b, 0x4c, 0xa4, 0x46, 0xae,
+	0xb5, 0x89, 0xeb, 0xc9, 0x6b, 0x70, 0x20, 0x32, 0xeb, 0xb6, 0xa8, 0xed, 0x12, 0xbc, 0x04, 0x93,
+	0x3c, 0x18, 0x19, 0x14, 0xe3, 0x81, 0x83, 0xca, 0xe3, 0x8f, 0xfe, 0xc8, 0x8d, 0x68, 0x02, 0x20,
+	0x7f, 0x81, 0xe0, 0x08, 0x5b, 0x72, 0x45, 0x6f, 0x18, 0xed, 0x86, 0xee, 0x91, 0xd5, 0x06, 0xbd,
+	0xae, 0xe9, 0x1e, 0x11, 0x3e, 0x31, 0x86, 0x71, 0x7f, 0xe3, 0xf0, 0x10, 0x69, 0xec, 0x3f, 0x73,
+	0x48, 0x1c, 0x8b, 0x9a, 0x2c, 0x08, 0xff, 0x9b, 0x3f, 0x1a, 0x23, 0x69, 0x8d, 0x19, 0x6a, 0x02,
+	0x80, 0x25, 0x48, 0x99, 0x6d, 0x87, 0xed, 0x08, 0x16, 0x8f, 0x71, 0xad, 0x33, 0x7e, 0x2b, 0x75,
+	0xe7, 0x7e, 0x6e, 0xe4, 0x9f, 0xfb, 0xb9, 0x11, 0x79, 0x0b, 0x41, 0xb6, 0x1f, 0x2d, 0x21, 0xba,
+	0x14, 0xe2, 0x35, 0x33, 0x7f, 0x58, 0x11, 0x39, 0xf0, 0x77, 0xb4, 0x22, 0xf6, 0xb2, 0xb2, 0x42,
+	0x2d, 0x5b, 0x08, 0x7e, 0x99, 0xc4, 0x71, 0x06, 0xa6, 0x5c, 0x62, 0x50, 0xdb, 0x74, 0x33, 0xe3,
+	0xec, 0x53, 0x30, 0xc4, 0xb3, 0x30, 0x5d, 0x6b, 0xd0, 0xeb, 0x15, 0x47, 0xf7, 0x48, 0x66, 0x22,
+	0x8f, 0x0a, 0x63, 0x5a, 0xaa, 0x26, 0xa4, 0xc8, 0x57, 0x44, 0x3a, 0xb9, 0xbf, 0x20, 0xcb, 0x78,
+	0x15, 0x60, 0xfb, 0x10, 0x0a, 0x7d, 0x6f, 0x44, 0xf4, 0xf1, 0xf3, 0x1f, 0xa8, 0x5c, 0xd3, 0xeb,
+	0x41, 0xb6, 0xb4, 0x10, 0x52, 0xbe, 0x87, 0x20, 0x1d, 0x5d, 0x5f, 0x84, 0xee, 0x3c, 0x4c, 0x71,
+	0xb1, 0xfe, 0x86, 0x19, 0x2b, 0xcc, 0xc4, 0x86, 0x81, 0x9f, 0x16, 0x2d, 0x40, 0xe0, 0x4b, 0x11,
+	0x76, 0xa3, 0x8c, 0xdd, 0xf1, 0x44, 0x76, 0xdc, 0x73, 0x84, 0xde, 0xcf, 0x08, 0x8e, 0x32, 0x7a,
+	0xcb, 0x8d, 0x86, 0x60, 0xb8, 0x4a, 0x1d, 0x4d, 0x1c, 0xb4, 0x20, 0x18, 0x17, 0x60, 0x6f, 0x70,
+	0xf6, 0x2a, 0xba, 0x69, 0x26, 0x1f, 0xd5, 0x3d, 0x81, 0xb9, 0x3f, 0x8d, 0x57, 0x7b, 0xb0, 0xdd,
+	0x41, 0x2c, 0x71, 0x1a, 0x26, 0x4c, 0x62, 0x53, 0x7e, 0x86, 0xa7, 0x35, 0x3e, 0x90, 0xff, 0x45,
+	0x20, 0xc7, 0x49, 0x10, 0xf1, 0x7e, 0x41, 0x0d, 0xa1, 0x74, 0x8d, 0xbe, 0x60, 0xba, 0xc6, 0x76,
+	0x9e, 0xae, 0x07, 0x81, 0x56, 0xee, 0xa7, 0xbc, 0x19, 0xc8, 0x5c, 0x67, 0x65, 0x6e, 0x97, 0xf2,
+	0xb5, 0x04, 0x33, 0xbc, 0x6c, 0x72, 0x70, 0x52, 0x8d, 0x05, 0x6e, 0xcc, 0xa0, 0xbd, 0x53, 0xf4,
+	0x29, 0x1c, 0x8b, 0x65, 0x2d, 0x52, 0xf4, 0x6e, 0xa7, 0x48, 0xf3, 0xf3, 0x96, 0x1c, 0xe2, 0xf2,
+	0xb4, 0x5f, 0x57, 0xbe, 0xfa, 0xfb, 0x87, 0x13, 0xa8, 0x53, 0xb5, 0x1f, 0x22, 0x38, 0x19, 0xf2,
+	0x16, 0xf5, 0xb5, 0xd2, 0x76, 0x1c, 0x62, 0x7b, 0xac, 0x90, 0xbd, 0xa2, 0xc1, 0xfa, 0x15, 0xc1,
+	0xa9, 0xc1, 0xf8, 0x8b, 0xb0, 0x9d, 0x86, 0xb4, 0x41, 0xed, 0x9a, 0x55, 0x6f, 0x3b, 0xc4, 0xac,
+	0x6c, 0x57, 0x3a, 0xc4, 0x2a, 0x1d, 0xde, 0xfe, 0x16, 0x94, 0x6f, 0x5c, 0x84, 0xfd, 0x06, 0x5f,
+	0x28, 0x64, 0xee, 0x33, 0x1f, 0x2b, 0x4f, 0xf0, 0x60, 0xee, 0x33, 0x42, 0x8e, 0x7c, 0xc8, 0x92,
+	0x9f, 0x1b, 0xdd, 0x6b, 0xbb, 0x99, 0xb1, 0xc4, 0xa2, 0xbd, 0xce, 0x0c, 0x35, 0x01, 0x90, 0x7f,
+	0x42, 0x90, 0xeb, 0x3e, 0xa0, 0xd1, 0x1d, 0xfb, 0x5c, 0x14, 0xd1, 0x10, 0x51, 0x7c, 0xb9, 0xd5,
+	0x65, 0x0b, 0x41, 0xbe, 0x3f, 0xf9, 0xce, 0xdd, 0xbf, 0x63, 0xf6, 0xaf, 0x46, 0x5d, 0x59, 0x80,
+	0x43, 0x91, 0x03, 0x7a, 0xd9, 0x0c, 0x12, 0x33, 0x0b, 0xd3, 0xdc, 0x5b, 0xc5, 0x32, 0x99, 0xb0,
+	0x71, 0x2d, 0xc5, 0x27, 0x2e, 0x9b, 0x72, 0x05, 0xfe, 0xdf, 0x05, 0xdb, 0xd5, 0xb3, 0x7c, 0x36,
+	0xe2, 0xc0, 0xdf, 0x57, 0xee, 0x40, 0xc4, 0xae, 0x40, 0xa6, 0x1b, 0x27, 0x98, 0x2d, 0xc3, 0x84,
+	0xbf, 0x31, 0x83, 0x3e, 0x2d, 0x9f, 0xb0, 0x91, 0xdd, 0x30, 0x2f, 0x8e, 0x94, 0xcf, 0xc1, 0xe1,
+	0xd0, 0xf2, 0xef, 0x59, 0xae, 0x47, 0x9d, 0xcd, 0x81, 0x88, 0x11, 0x90, 0x7a, 0x21, 0x05, 0xb5,
+	0x4b, 0x30, 0x75, 0x95, 0x4f, 0x09, 0x72, 0x72, 0x0c, 0x39, 0x01, 0x0e, 0xd3, 0x0b, 0xd0, 0xf2,
+	0xd7, 0xc1, 0xb5, 0xce, 0x4d, 0xdd, 0x8b, 0x37, 0x5a, 0x96, 0xbf, 0xf5, 0xca, 0xa4, 0x46, 0x9d,
+	0x4e, 0x57, 0xf9, 0x36, 0x4c, 0x56, 0xd9, 0x84, 0xf0, 0x26, 0x29, 0xfc, 0x59, 0xa0, 0x04, 0xcf,
+	0x02, 0xe5, 0xa3, 0xe0, 0x59, 0x50, 0x4e, 0xf9, 0x5e, 0xee, 0x3e, 0xcd, 0x21, 0x4d, 0x60, 0x76,
+	0xeb, 0xdc, 0xc9, 0xdf, 0x44, 0xef, 0xb4, 0x2e, 0xae, 0xaf, 0x52, 0xbf, 0x34, 0xff, 0x74, 0x1f,
+	0x4c, 0x30, 0xb2, 0xf8, 0x36, 0x82, 0x49, 0xde, 0xcd, 0xe3, 0xe3, 0x3d, 0x99, 0x74, 0x3f, 0x1d,
+	0xa4, 0x42, 0xb2, 0x21, 0xf7, 0x29, 0x1f, 0xfb, 0xec, 0xf7, 0xbf, 0x3e, 0x1f, 0x3d, 0x82, 0x67,
+	0xd5, 0xfe, 0xaf, 0x2e, 0xfc, 0x3d, 0x82, 0xfd, 0x5d, 0xbd, 0x39, 0x9e, 0xef, 0xef, 0xa4, 0xdf,
+	0xfb, 0x42, 0x2a, 0x0d, 0x85, 0x11, 0x1c, 0x4f, 0x33, 0x8e, 0x27, 0x70, 0xa1, 0x27, 0x47, 0x23,
+	0xc0, 0xf1, 0x2b, 0x86, 0x51, 0xbb, 0x83, 0x60, 0x4a, 0x64, 0x19, 0xc7, 0xc4, 0x22, 0xda, 0x8a,
+	0x4b, 0x6f, 0x0e, 0x60, 0x29, 0x28, 0x15, 0x18, 0x25, 0x19, 0xe7, 0xd5, 0xfe, 0x4f, 0x51, 0x57,
+	0xd5, 0x1b, 0x0d, 0xfc, 0x0b, 0x82, 0x83, 0x3d, 0x1b, 0x46, 0x7c, 0xb6, 0xbf, 0xbb, 0xb8, 0x26,
+	0x59, 0x5a, 0x1c, 0x1a, 0x27, 0x48, 0x5f, 0x60, 0xa4, 0x17, 0xf1, 0x42, 0x2c, 0xe9, 0xa0, 0xe9,
+	0x50, 0x6f, 0x46, 0xba, 0x95, 0x5b, 0xf8, 0x09, 0x82, 0x43, 0xbd, 0x1b, 0x2b, 0xbc, 0x98, 0x14,
+	0xb9, 0x3e, 0x0d, 0xa4, 0x74, 0x6e, 0x78, 0xa0, 0x10, 0xf3, 0x3e, 0x13, 0x73, 0x11, 0xaf, 0xec,
+	0x48, 0x8c, 0x7a, 0x33, 0x74, 0x8f, 0xde, 0xc2, 0xb7, 0x47, 0x21, 0x97, 0xd0, 0x05, 0xe1, 0x77,
+	0x92, 0xa8, 0x26, 0x35, 0x80, 0xd2, 0xf2, 0x0b, 0xac, 0x20, 0x54, 0x7f, 0xcc, 0x54, 0x6b, 0x78,
+	0x6d, 0x17, 0x54, 0xab, 0xe1, 0xd6, 0x0c, 0x3f, 0x44, 0x70, 0xa0, 0x47, 0xeb, 0x81, 0xcf, 0x0c,
+	0xb8, 0xdb, 0xa2, 0x79, 0x5d, 0x18, 0x12, 0x25, 0xe4, 0x2d, 0x31, 0x79, 0x25, 0x5c, 0x8c, 0x95,
+	0xc7, 0x45, 0x3c, 0x97, 0xc2, 0x7b, 0x08, 0x60, 0xbb, 0x3d, 0xc0, 0x27, 0x93, 0x37, 0x56, 0xa7,
+	0xf7, 0x90, 0x4e, 0x0d, 0x66, 0x2c, 0x48, 0x96, 0x18, 0xc9, 0x39, 0x7c, 0x32, 0x96, 0xa4, 0x65,
+	0xaa, 0x37, 0x3b, 0xd7, 0xf3, 0x2d, 0xfc, 0x25, 0x82, 0x99, 0xd0, 0x5d, 0x8f, 0x13, 0x5d, 0x86,
+	0x7b, 0x10, 0x69, 0x6e, 0x40, 0xeb, 0xa1, 0xc2, 0x18, 0x65, 0xa8, 0xb2, 0x8e, 0x03, 0x7f, 0x87,
+	0x60, 0x6f, 0xe4, 0xda, 0xc7, 0x4a, 0x92, 0xef, 0x68, 0x5b, 0x22, 0xa9, 0x03, 0xdb, 0x0b, 0xb6,
+	0xe7, 0x19, 0xdb, 0x05, 0x5c, 0x1a, 0x86, 0xad, 0x68, 0x40, 0xf0, 0x8f, 0x08, 0x0e, 0xf6, 0xbc,
+	0xcf, 0xe3, 0xca, 0x6b, 0x5c, 0xb3, 0x22, 0x2d, 0x0e, 0x8d, 0x13, 0x3a, 0xe6, 0x98, 0x8e, 0xe3,
+	0xf8, 0xf5, 0x58, 0x1d, 0x24, 0x00, 0x7f, 0xf0, 0xe8, 0x59, 0x16, 0x3d, 0x7e, 0x96, 0x45, 0x7f,
+	0x3e, 0xcb, 0xa2, 0xbb, 0x5b, 0xd9, 0x91, 0xc7, 0x5b, 0xd9, 0x91, 0x27, 0x5b, 0xd9, 0x91, 0x4f,
+	0x4a, 0x75, 0xcb, 0xbb, 0xda, 0xae, 0x2a, 0x06, 0x6d, 0xaa, 0x6d, 0xdb, 0xaa, 0x59, 0x06, 0xeb,
+	0x09, 0xe6, 0xfc, 0xf1, 0xf6, 0xd2, 0x37, 0x82, 0xc5, 0xbd, 0xcd, 0x16, 0x71, 0xab, 0x93, 0xac,
+	0x97, 0x2a, 0xfd, 0x37, 0x00, 0xd5, 0x1e, 0x32, 0x08, 0x43, 0x16, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -981,6 +1444,15 @@ type QueryClient interface {
 	StreamReceiverSenderCurrentFlow(ctx context.Context, in *QueryStreamReceiverSenderCurrentFlowRequest, opts ...grpc.CallOption) (*QueryStreamReceiverSenderCurrentFlowResponse, error)
 	// AllStreamsForSender queries all Streams for a given sender address
 	AllStreamsForSender(ctx context.Context, in *QueryAllStreamsForSenderRequest, opts ...grpc.CallOption) (*QueryAllStreamsForSenderResponse, error)
+	// StreamById queries a stream by its stream ID
+	StreamById(ctx context.Context, in *QueryStreamByIdRequest, opts ...grpc.CallOption) (*QueryStreamByIdResponse, error)
+	// StreamStats queries the cumulative claim statistics for a stream, including a recently deleted stream
+	StreamStats(ctx context.Context, in *QueryStreamStatsRequest, opts ...grpc.CallOption) (*QueryStreamStatsResponse, error)
+	// StreamHistory queries the most recent flow rate changes for a stream, including a recently deleted stream
+	StreamHistory(ctx context.Context, in *QueryStreamHistoryRequest, opts ...grpc.CallOption) (*QueryStreamHistoryResponse, error)
+	// StreamsExpiringBefore queries streams which will stop flowing before the given time, either because their
+	// deposit runs out or they reach their end time, in expiry order
+	StreamsExpiringBefore(ctx context.Context, in *QueryStreamsExpiringBeforeRequest, opts ...grpc.CallOption) (*QueryStreamsExpiringBeforeResponse, error)
 }
 
 type queryClient struct {
@@ -1054,12 +1526,48 @@ func (c *queryClient) AllStreamsForSender(ctx context.Context, in *QueryAllStrea
 	return out, nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// CalculateFlowRate can be used to calculate a flow rate (coins per second) to be used when creating/updating a stream
-	CalculateFlowRate(context.Context, *QueryCalculateFlowRateRequest) (*QueryCalculateFlowRateResponse, error)
+func (c *queryClient) StreamById(ctx context.Context, in *QueryStreamByIdRequest, opts ...grpc.CallOption) (*QueryStreamByIdResponse, error) {
+	out := new(QueryStreamByIdResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Query/StreamById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamStats(ctx context.Context, in *QueryStreamStatsRequest, opts ...grpc.CallOption) (*QueryStreamStatsResponse, error) {
+	out := new(QueryStreamStatsResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Query/StreamStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamHistory(ctx context.Context, in *QueryStreamHistoryRequest, opts ...grpc.CallOption) (*QueryStreamHistoryResponse, error) {
+	out := new(QueryStreamHistoryResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Query/StreamHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamsExpiringBefore(ctx context.Context, in *QueryStreamsExpiringBeforeRequest, opts ...grpc.CallOption) (*QueryStreamsExpiringBeforeResponse, error) {
+	out := new(QueryStreamsExpiringBeforeResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Query/StreamsExpiringBefore", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// CalculateFlowRate can be used to calculate a flow rate (coins per second) to be used when creating/updating a stream
+	CalculateFlowRate(context.Context, *QueryCalculateFlowRateRequest) (*QueryCalculateFlowRateResponse, error)
 	// Streams queries all Streams.
 	Streams(context.Context, *QueryStreamsRequest) (*QueryStreamsResponse, error)
 	// AllStreamsForReceiver queries all Streams for a given receiver address
@@ -1070,6 +1578,15 @@ type QueryServer interface {
 	StreamReceiverSenderCurrentFlow(context.Context, *QueryStreamReceiverSenderCurrentFlowRequest) (*QueryStreamReceiverSenderCurrentFlowResponse, error)
 	// AllStreamsForSender queries all Streams for a given sender address
 	AllStreamsForSender(context.Context, *QueryAllStreamsForSenderRequest) (*QueryAllStreamsForSenderResponse, error)
+	// StreamById queries a stream by its stream ID
+	StreamById(context.Context, *QueryStreamByIdRequest) (*QueryStreamByIdResponse, error)
+	// StreamStats queries the cumulative claim statistics for a stream, including a recently deleted stream
+	StreamStats(context.Context, *QueryStreamStatsRequest) (*QueryStreamStatsResponse, error)
+	// StreamHistory queries the most recent flow rate changes for a stream, including a recently deleted stream
+	StreamHistory(context.Context, *QueryStreamHistoryRequest) (*QueryStreamHistoryResponse, error)
+	// StreamsExpiringBefore queries streams which will stop flowing before the given time, either because their
+	// deposit runs out or they reach their end time, in expiry order
+	StreamsExpiringBefore(context.Context, *QueryStreamsExpiringBeforeRequest) (*QueryStreamsExpiringBeforeResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1097,6 +1614,18 @@ func (*UnimplementedQueryServer) StreamReceiverSenderCurrentFlow(ctx context.Con
 func (*UnimplementedQueryServer) AllStreamsForSender(ctx context.Context, req *QueryAllStreamsForSenderRequest) (*QueryAllStreamsForSenderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllStreamsForSender not implemented")
 }
+func (*UnimplementedQueryServer) StreamById(ctx context.Context, req *QueryStreamByIdRequest) (*QueryStreamByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamById not implemented")
+}
+func (*UnimplementedQueryServer) StreamStats(ctx context.Context, req *QueryStreamStatsRequest) (*QueryStreamStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamStats not implemented")
+}
+func (*UnimplementedQueryServer) StreamHistory(ctx context.Context, req *QueryStreamHistoryRequest) (*QueryStreamHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamHistory not implemented")
+}
+func (*UnimplementedQueryServer) StreamsExpiringBefore(ctx context.Context, req *QueryStreamsExpiringBeforeRequest) (*QueryStreamsExpiringBeforeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StreamsExpiringBefore not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1228,6 +1757,78 @@ func _Query_AllStreamsForSender_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_StreamById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Query/StreamById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamById(ctx, req.(*QueryStreamByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Query/StreamStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamStats(ctx, req.(*QueryStreamStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Query/StreamHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamHistory(ctx, req.(*QueryStreamHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamsExpiringBefore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStreamsExpiringBeforeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StreamsExpiringBefore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Query/StreamsExpiringBefore",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StreamsExpiringBefore(ctx, req.(*QueryStreamsExpiringBeforeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "mainchain.stream.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1260,6 +1861,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllStreamsForSender",
 			Handler:    _Query_AllStreamsForSender_Handler,
 		},
+		{
+			MethodName: "StreamById",
+			Handler:    _Query_StreamById_Handler,
+		},
+		{
+			MethodName: "StreamStats",
+			Handler:    _Query_StreamStats_Handler,
+		},
+		{
+			MethodName: "StreamHistory",
+			Handler:    _Query_StreamHistory_Handler,
+		},
+		{
+			MethodName: "StreamsExpiringBefore",
+			Handler:    _Query_StreamsExpiringBefore_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "mainchain/stream/v1/query.proto",
@@ -1567,6 +2184,13 @@ func (m *QueryAllStreamsForReceiverRequest) MarshalToSizedBuffer(dAtA []byte) (i
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1665,6 +2289,13 @@ func (m *QueryStreamByReceiverSenderRequest) MarshalToSizedBuffer(dAtA []byte) (
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.SenderAddr) > 0 {
 		i -= len(m.SenderAddr)
 		copy(dAtA[i:], m.SenderAddr)
@@ -1735,6 +2366,13 @@ func (m *QueryStreamReceiverSenderCurrentFlowRequest) MarshalToSizedBuffer(dAtA
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.SenderAddr) > 0 {
 		i -= len(m.SenderAddr)
 		copy(dAtA[i:], m.SenderAddr)
@@ -1772,6 +2410,11 @@ func (m *QueryStreamReceiverSenderCurrentFlowResponse) MarshalToSizedBuffer(dAtA
 	_ = i
 	var l int
 	_ = l
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.CurrentFlowRate != 0 {
 		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentFlowRate))
 		i--
@@ -1805,6 +2448,13 @@ func (m *QueryAllStreamsForSenderRequest) MarshalToSizedBuffer(dAtA []byte) (int
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -1883,242 +2533,400 @@ func (m *QueryAllStreamsForSenderResponse) MarshalToSizedBuffer(dAtA []byte) (in
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryStreamByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StreamResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryStreamByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Stream != nil {
-		l = m.Stream.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.StreamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStreamByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryStreamByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCalculateFlowRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStreamByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Coin)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Period != 0 {
-		n += 1 + sovQuery(uint64(m.Period))
-	}
-	if m.Duration != 0 {
-		n += 1 + sovQuery(uint64(m.Duration))
+	{
+		size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalculateFlowRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Coin.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Period != 0 {
-		n += 1 + sovQuery(uint64(m.Period))
-	}
-	if m.Duration != 0 {
-		n += 1 + sovQuery(uint64(m.Duration))
-	}
-	if m.Seconds != 0 {
-		n += 1 + sovQuery(uint64(m.Seconds))
-	}
-	if m.FlowRate != 0 {
-		n += 1 + sovQuery(uint64(m.FlowRate))
+func (m *QueryStreamStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryStreamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryStreamStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.StreamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStreamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStreamStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Streams) > 0 {
-		for _, e := range m.Streams {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAllStreamsForReceiverRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStreamHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ReceiverAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.History.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStreamsExpiringBeforeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamsExpiringBeforeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamsExpiringBeforeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Before, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Before):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintQuery(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAllStreamsForReceiverResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStreamsExpiringBeforeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStreamsExpiringBeforeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStreamsExpiringBeforeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ReceiverAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
 	if len(m.Streams) > 0 {
-		for _, e := range m.Streams {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStreamByReceiverSenderRequest) Size() (n int) {
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StreamResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ReceiverAddr)
+	l = len(m.Receiver)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.SenderAddr)
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Stream != nil {
+		l = m.Stream.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
-func (m *QueryStreamByReceiverSenderResponse) Size() (n int) {
+func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Stream.Size()
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
 	return n
 }
 
-func (m *QueryStreamReceiverSenderCurrentFlowRequest) Size() (n int) {
+func (m *QueryCalculateFlowRateRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ReceiverAddr)
+	l = len(m.Coin)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	l = len(m.SenderAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovQuery(uint64(m.Duration))
 	}
 	return n
 }
 
-func (m *QueryStreamReceiverSenderCurrentFlowResponse) Size() (n int) {
+func (m *QueryCalculateFlowRateResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ConfiguredFlowRate != 0 {
-		n += 1 + sovQuery(uint64(m.ConfiguredFlowRate))
+	l = m.Coin.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
 	}
-	if m.CurrentFlowRate != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentFlowRate))
+	if m.Duration != 0 {
+		n += 1 + sovQuery(uint64(m.Duration))
+	}
+	if m.Seconds != 0 {
+		n += 1 + sovQuery(uint64(m.Seconds))
+	}
+	if m.FlowRate != 0 {
+		n += 1 + sovQuery(uint64(m.FlowRate))
 	}
 	return n
 }
 
-func (m *QueryAllStreamsForSenderRequest) Size() (n int) {
+func (m *QueryStreamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.SenderAddr)
-	if l > 0 {
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	return n
+}
+
+func (m *QueryStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if m.Pagination != nil {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
@@ -2126,36 +2934,837 @@ func (m *QueryAllStreamsForSenderRequest) Size() (n int) {
 	return n
 }
 
-func (m *QueryAllStreamsForSenderResponse) Size() (n int) {
+func (m *QueryAllStreamsForReceiverRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.SenderAddr)
+	l = len(m.ReceiverAddr)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
-	if len(m.Streams) > 0 {
-		for _, e := range m.Streams {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllStreamsForReceiverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReceiverAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamByReceiverSenderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReceiverAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SenderAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamByReceiverSenderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stream.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStreamReceiverSenderCurrentFlowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReceiverAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SenderAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamReceiverSenderCurrentFlowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConfiguredFlowRate != 0 {
+		n += 1 + sovQuery(uint64(m.ConfiguredFlowRate))
+	}
+	if m.CurrentFlowRate != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentFlowRate))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	return n
+}
+
+func (m *QueryAllStreamsForSenderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllStreamsForSenderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovQuery(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *QueryStreamByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stream.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStreamStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovQuery(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *QueryStreamStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStreamHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovQuery(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *QueryStreamHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.History.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStreamsExpiringBeforeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Before)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStreamsExpiringBeforeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *StreamResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Stream == nil {
+				m.Stream = &Stream{}
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalculateFlowRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalculateFlowRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalculateFlowRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= StreamPeriod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCalculateFlowRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCalculateFlowRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCalculateFlowRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= StreamPeriod(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seconds", wireType)
+			}
+			m.Seconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seconds |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowRate", wireType)
+			}
+			m.FlowRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlowRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *StreamResult) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2178,17 +3787,17 @@ func (m *StreamResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StreamResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StreamResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2198,29 +3807,83 @@ func (m *StreamResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2230,27 +3893,29 @@ func (m *StreamResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Streams = append(m.Streams, &StreamResult{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2277,10 +3942,10 @@ func (m *StreamResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stream == nil {
-				m.Stream = &Stream{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2305,7 +3970,7 @@ func (m *StreamResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllStreamsForReceiverRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2328,12 +3993,112 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllStreamsForReceiverRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllStreamsForReceiverRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2355,7 +4120,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllStreamsForReceiverResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2378,15 +4143,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllStreamsForReceiverResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllStreamsForReceiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2413,7 +4210,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Streams = append(m.Streams, &StreamResult{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2438,7 +4272,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalculateFlowRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamByReceiverSenderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2461,15 +4295,15 @@ func (m *QueryCalculateFlowRateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalculateFlowRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamByReceiverSenderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalculateFlowRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamByReceiverSenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2497,13 +4331,13 @@ func (m *QueryCalculateFlowRateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coin = string(dAtA[iNdEx:postIndex])
+			m.ReceiverAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddr", wireType)
 			}
-			m.Period = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2513,16 +4347,29 @@ func (m *QueryCalculateFlowRateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Period |= StreamPeriod(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.Duration = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2532,11 +4379,24 @@ func (m *QueryCalculateFlowRateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Duration |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2558,7 +4418,7 @@ func (m *QueryCalculateFlowRateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCalculateFlowRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamByReceiverSenderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2581,15 +4441,15 @@ func (m *QueryCalculateFlowRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCalculateFlowRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamByReceiverSenderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCalculateFlowRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamByReceiverSenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2616,34 +4476,65 @@ func (m *QueryCalculateFlowRateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Period = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Period |= StreamPeriod(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Duration = 0
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamReceiverSenderCurrentFlowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamReceiverSenderCurrentFlowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamReceiverSenderCurrentFlowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddr", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2653,16 +4544,29 @@ func (m *QueryCalculateFlowRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Duration |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seconds", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Seconds = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiverAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddr", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2672,16 +4576,29 @@ func (m *QueryCalculateFlowRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seconds |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlowRate", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.FlowRate = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2691,11 +4608,24 @@ func (m *QueryCalculateFlowRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FlowRate |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2717,7 +4647,7 @@ func (m *QueryCalculateFlowRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStreamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamReceiverSenderCurrentFlowResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2740,17 +4670,17 @@ func (m *QueryStreamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStreamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamReceiverSenderCurrentFlowResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamReceiverSenderCurrentFlowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfiguredFlowRate", wireType)
 			}
-			var msglen int
+			m.ConfiguredFlowRate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2760,28 +4690,49 @@ func (m *QueryStreamsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ConfiguredFlowRate |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFlowRate", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.CurrentFlowRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentFlowRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= StreamStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2803,7 +4754,7 @@ func (m *QueryStreamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStreamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllStreamsForSenderRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2826,17 +4777,17 @@ func (m *QueryStreamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStreamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllStreamsForSenderRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllStreamsForSenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2846,25 +4797,23 @@ func (m *QueryStreamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Streams = append(m.Streams, &StreamResult{})
-			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SenderAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2896,12 +4845,44 @@ func (m *QueryStreamsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2923,7 +4904,7 @@ func (m *QueryStreamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllStreamsForReceiverRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllStreamsForSenderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2946,15 +4927,15 @@ func (m *QueryAllStreamsForReceiverRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStreamsForReceiverRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllStreamsForSenderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStreamsForReceiverRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllStreamsForSenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2982,9 +4963,43 @@ func (m *QueryAllStreamsForReceiverRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiverAddr = string(dAtA[iNdEx:postIndex])
+			m.SenderAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, &StreamResult{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -3014,7 +5029,7 @@ func (m *QueryAllStreamsForReceiverRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -3041,7 +5056,7 @@ func (m *QueryAllStreamsForReceiverRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllStreamsForReceiverResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamByIdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3064,83 +5079,17 @@ func (m *QueryAllStreamsForReceiverResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStreamsForReceiverResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamByIdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStreamsForReceiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReceiverAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Streams = append(m.Streams, &StreamResult{})
-			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var msglen int
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3150,28 +5099,11 @@ func (m *QueryAllStreamsForReceiverResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3193,7 +5125,7 @@ func (m *QueryAllStreamsForReceiverResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStreamByReceiverSenderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamByIdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3216,17 +5148,17 @@ func (m *QueryStreamByReceiverSenderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStreamByReceiverSenderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamByIdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStreamByReceiverSenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3236,29 +5168,80 @@ func (m *QueryStreamByReceiverSenderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceiverAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddr", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStreamStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStreamStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStreamStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3268,24 +5251,11 @@ func (m *QueryStreamByReceiverSenderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SenderAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3307,7 +5277,7 @@ func (m *QueryStreamByReceiverSenderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStreamByReceiverSenderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3330,15 +5300,15 @@ func (m *QueryStreamByReceiverSenderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStreamByReceiverSenderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStreamByReceiverSenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3365,7 +5335,7 @@ func (m *QueryStreamByReceiverSenderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3390,7 +5360,7 @@ func (m *QueryStreamByReceiverSenderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryStreamReceiverSenderCurrentFlowRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3413,49 +5383,17 @@ func (m *QueryStreamReceiverSenderCurrentFlowRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStreamReceiverSenderCurrentFlowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStreamReceiverSenderCurrentFlowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceiverAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReceiverAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var stringLen uint64
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3465,24 +5403,11 @@ func (m *QueryStreamReceiverSenderCurrentFlowRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SenderAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3504,7 +5429,7 @@ func (m *QueryStreamReceiverSenderCurrentFlowRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryStreamReceiverSenderCurrentFlowResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3527,17 +5452,17 @@ func (m *QueryStreamReceiverSenderCurrentFlowResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryStreamReceiverSenderCurrentFlowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryStreamReceiverSenderCurrentFlowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfiguredFlowRate", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			m.ConfiguredFlowRate = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3547,30 +5472,25 @@ func (m *QueryStreamReceiverSenderCurrentFlowResponse) Unmarshal(dAtA []byte) er
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConfiguredFlowRate |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentFlowRate", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.CurrentFlowRate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentFlowRate |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if err := m.History.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3592,7 +5512,7 @@ func (m *QueryStreamReceiverSenderCurrentFlowResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryAllStreamsForSenderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamsExpiringBeforeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3615,17 +5535,17 @@ func (m *QueryAllStreamsForSenderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStreamsForSenderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamsExpiringBeforeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStreamsForSenderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamsExpiringBeforeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3635,23 +5555,24 @@ func (m *QueryAllStreamsForSenderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SenderAddr = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Before, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3710,7 +5631,7 @@ func (m *QueryAllStreamsForSenderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllStreamsForSenderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStreamsExpiringBeforeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3733,45 +5654,13 @@ func (m *QueryAllStreamsForSenderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllStreamsForSenderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStreamsExpiringBeforeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllStreamsForSenderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStreamsExpiringBeforeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddr", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SenderAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
 			}
@@ -3805,7 +5694,7 @@ func (m *QueryAllStreamsForSenderResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -82,6 +82,50 @@ func (StreamPeriod) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_835c4cdaca46b43c, []int{0}
 }
 
+// StreamStatus enumerates the states a stream can be in
+type StreamStatus int32
+
+const (
+	// STREAM_STATUS_UNSPECIFIED defines unspecified
+	StreamStatusUnspecified StreamStatus = 0
+	// STREAM_STATUS_PENDING defines a stream whose start time has not yet been reached
+	StreamStatusPending StreamStatus = 1
+	// STREAM_STATUS_CLIFF defines a stream that is flowing, but whose cliff time has not yet been reached
+	StreamStatusCliff StreamStatus = 2
+	// STREAM_STATUS_FLOWING defines a stream that is flowing and claimable
+	StreamStatusFlowing StreamStatus = 3
+	// STREAM_STATUS_ENDED defines a stream that has reached its end time, or whose deposit has run out
+	StreamStatusEnded StreamStatus = 4
+	// STREAM_STATUS_PAUSED defines a stream that has been paused by its sender
+	StreamStatusPaused StreamStatus = 5
+)
+
+var StreamStatus_name = map[int32]string{
+	0: "STREAM_STATUS_UNSPECIFIED",
+	1: "STREAM_STATUS_PENDING",
+	2: "STREAM_STATUS_CLIFF",
+	3: "STREAM_STATUS_FLOWING",
+	4: "STREAM_STATUS_ENDED",
+	5: "STREAM_STATUS_PAUSED",
+}
+
+var StreamStatus_value = map[string]int32{
+	"STREAM_STATUS_UNSPECIFIED": 0,
+	"STREAM_STATUS_PENDING":     1,
+	"STREAM_STATUS_CLIFF":       2,
+	"STREAM_STATUS_FLOWING":     3,
+	"STREAM_STATUS_ENDED":       4,
+	"STREAM_STATUS_PAUSED":      5,
+}
+
+func (x StreamStatus) String() string {
+	return proto.EnumName(StreamStatus_name, int32(x))
+}
+
+func (StreamStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_835c4cdaca46b43c, []int{1}
+}
+
 // Stream holds data about a stream
 type Stream struct {
 	// deposit tracks the total amount the user has deposited to cover the stream, including any updates to the stream
@@ -94,6 +138,35 @@ type Stream struct {
 	DepositZeroTime time.Time `protobuf:"bytes,4,opt,name=deposit_zero_time,json=depositZeroTime,proto3,stdtime" json:"deposit_zero_time" yaml:"deposit_zero_time"`
 	// cancellable is whether a stream can be cancelled. Default is true, but will be false for example id eFUND is used
 	Cancellable bool `protobuf:"varint,5,opt,name=cancellable,proto3" json:"cancellable,omitempty"`
+	// stream_id is the unique ID of the stream
+	StreamId uint64 `protobuf:"varint,6,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// receiver is the wallet that receives stream payments
+	Receiver string `protobuf:"bytes,7,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// sender is the wallet that created and funds the stream
+	Sender string `protobuf:"bytes,8,opt,name=sender,proto3" json:"sender,omitempty"`
+	// start_time is the timestamp from which the stream starts flowing
+	StartTime time.Time `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	// cliff_time is the optional timestamp before which nothing can be claimed from the stream
+	CliffTime time.Time `protobuf:"bytes,10,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time" yaml:"cliff_time"`
+	// end_time is the optional timestamp at which the stream stops flowing. Any remaining deposit is returned to the sender
+	EndTime time.Time `protobuf:"bytes,11,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	// pausable is whether the sender can pause the stream. It is set when the stream is created, so the receiver
+	// knows the terms of the stream they are receiving. The stream cannot be paused until the receiver has
+	// accepted this, see pausable_accepted
+	Pausable bool `protobuf:"varint,12,opt,name=pausable,proto3" json:"pausable,omitempty"`
+	// paused_at is the timestamp the stream was paused at. Zero if the stream is not paused
+	PausedAt time.Time `protobuf:"bytes,13,opt,name=paused_at,json=pausedAt,proto3,stdtime" json:"paused_at" yaml:"paused_at"`
+	// event is emitted. Zero disables the alert
+	LowDepositThreshold uint64 `protobuf:"varint,14,opt,name=low_deposit_threshold,json=lowDepositThreshold,proto3" json:"low_deposit_threshold,omitempty"`
+	// low_deposit_alerted is whether the stream_low_deposit event has been emitted since the stream's remaining
+	// deposit last fell below the low_deposit_threshold
+	LowDepositAlerted bool `protobuf:"varint,15,opt,name=low_deposit_alerted,json=lowDepositAlerted,proto3" json:"low_deposit_alerted,omitempty"`
+	// termination_time is the timestamp at which the stream will be terminated, following a termination request
+	// from the sender. Zero if termination has not been requested
+	TerminationTime time.Time `protobuf:"bytes,16,opt,name=termination_time,json=terminationTime,proto3,stdtime" json:"termination_time" yaml:"termination_time"`
+	// pausable_accepted is whether the receiver has accepted that a pausable stream can be paused by the sender.
+	// Reset when the stream is transferred to a new receiver
+	PausableAccepted bool `protobuf:"varint,17,opt,name=pausable_accepted,json=pausableAccepted,proto3" json:"pausable_accepted,omitempty"`
 }
 
 func (m *Stream) Reset()         { *m = Stream{} }
@@ -164,53 +237,394 @@ func (m *Stream) GetCancellable() bool {
 	return false
 }
 
+func (m *Stream) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+func (m *Stream) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *Stream) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *Stream) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Stream) GetCliffTime() time.Time {
+	if m != nil {
+		return m.CliffTime
+	}
+	return time.Time{}
+}
+
+func (m *Stream) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *Stream) GetPausable() bool {
+	if m != nil {
+		return m.Pausable
+	}
+	return false
+}
+
+func (m *Stream) GetPausedAt() time.Time {
+	if m != nil {
+		return m.PausedAt
+	}
+	return time.Time{}
+}
+
+func (m *Stream) GetLowDepositThreshold() uint64 {
+	if m != nil {
+		return m.LowDepositThreshold
+	}
+	return 0
+}
+
+func (m *Stream) GetLowDepositAlerted() bool {
+	if m != nil {
+		return m.LowDepositAlerted
+	}
+	return false
+}
+
+func (m *Stream) GetTerminationTime() time.Time {
+	if m != nil {
+		return m.TerminationTime
+	}
+	return time.Time{}
+}
+
+func (m *Stream) GetPausableAccepted() bool {
+	if m != nil {
+		return m.PausableAccepted
+	}
+	return false
+}
+
+// StreamStats holds cumulative claim statistics for a stream. They are kept for the lifetime of the stream, and
+// for DeletedStreamAuditRetention after it is deleted
+type StreamStats struct {
+	// stream_id is the ID of the stream
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// total_claimed is the total value claimed from the stream's deposit, including validator fees
+	TotalClaimed types.Coin `protobuf:"bytes,2,opt,name=total_claimed,json=totalClaimed,proto3" json:"total_claimed"`
+	// total_validator_fees is the total value of validator fees paid from the stream's claims
+	TotalValidatorFees types.Coin `protobuf:"bytes,3,opt,name=total_validator_fees,json=totalValidatorFees,proto3" json:"total_validator_fees"`
+	// num_claims is the number of claims that have paid out from the stream
+	NumClaims uint64 `protobuf:"varint,4,opt,name=num_claims,json=numClaims,proto3" json:"num_claims,omitempty"`
+	// last_claim_time is the timestamp of the most recent claim that paid out from the stream
+	LastClaimTime time.Time `protobuf:"bytes,5,opt,name=last_claim_time,json=lastClaimTime,proto3,stdtime" json:"last_claim_time" yaml:"last_claim_time"`
+}
+
+func (m *StreamStats) Reset()         { *m = StreamStats{} }
+func (m *StreamStats) String() string { return proto.CompactTextString(m) }
+func (*StreamStats) ProtoMessage()    {}
+func (*StreamStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_835c4cdaca46b43c, []int{1}
+}
+func (m *StreamStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamStats.Merge(m, src)
+}
+func (m *StreamStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamStats proto.InternalMessageInfo
+
+func (m *StreamStats) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+func (m *StreamStats) GetTotalClaimed() types.Coin {
+	if m != nil {
+		return m.TotalClaimed
+	}
+	return types.Coin{}
+}
+
+func (m *StreamStats) GetTotalValidatorFees() types.Coin {
+	if m != nil {
+		return m.TotalValidatorFees
+	}
+	return types.Coin{}
+}
+
+func (m *StreamStats) GetNumClaims() uint64 {
+	if m != nil {
+		return m.NumClaims
+	}
+	return 0
+}
+
+func (m *StreamStats) GetLastClaimTime() time.Time {
+	if m != nil {
+		return m.LastClaimTime
+	}
+	return time.Time{}
+}
+
+// FlowRateChange records a change to a stream's flow rate
+type FlowRateChange struct {
+	// old_flow_rate is the flow rate before the change
+	OldFlowRate int64 `protobuf:"varint,1,opt,name=old_flow_rate,json=oldFlowRate,proto3" json:"old_flow_rate,omitempty"`
+	// new_flow_rate is the flow rate after the change
+	NewFlowRate int64 `protobuf:"varint,2,opt,name=new_flow_rate,json=newFlowRate,proto3" json:"new_flow_rate,omitempty"`
+	// changed_at is the block time of the change
+	ChangedAt time.Time `protobuf:"bytes,3,opt,name=changed_at,json=changedAt,proto3,stdtime" json:"changed_at" yaml:"changed_at"`
+	// block_height is the block height of the change
+	BlockHeight int64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+}
+
+func (m *FlowRateChange) Reset()         { *m = FlowRateChange{} }
+func (m *FlowRateChange) String() string { return proto.CompactTextString(m) }
+func (*FlowRateChange) ProtoMessage()    {}
+func (*FlowRateChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_835c4cdaca46b43c, []int{2}
+}
+func (m *FlowRateChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlowRateChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlowRateChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlowRateChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowRateChange.Merge(m, src)
+}
+func (m *FlowRateChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlowRateChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowRateChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlowRateChange proto.InternalMessageInfo
+
+func (m *FlowRateChange) GetOldFlowRate() int64 {
+	if m != nil {
+		return m.OldFlowRate
+	}
+	return 0
+}
+
+func (m *FlowRateChange) GetNewFlowRate() int64 {
+	if m != nil {
+		return m.NewFlowRate
+	}
+	return 0
+}
+
+func (m *FlowRateChange) GetChangedAt() time.Time {
+	if m != nil {
+		return m.ChangedAt
+	}
+	return time.Time{}
+}
+
+func (m *FlowRateChange) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+// StreamHistory holds a stream's most recent flow rate changes, oldest first. At most MaxFlowRateChangeHistory
+// changes are kept, and the history is kept for the lifetime of the stream, and for DeletedStreamAuditRetention
+// after it is deleted
+type StreamHistory struct {
+	// stream_id is the ID of the stream
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// flow_rate_changes are the most recent flow rate changes, oldest first
+	FlowRateChanges []FlowRateChange `protobuf:"bytes,2,rep,name=flow_rate_changes,json=flowRateChanges,proto3" json:"flow_rate_changes"`
+}
+
+func (m *StreamHistory) Reset()         { *m = StreamHistory{} }
+func (m *StreamHistory) String() string { return proto.CompactTextString(m) }
+func (*StreamHistory) ProtoMessage()    {}
+func (*StreamHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_835c4cdaca46b43c, []int{3}
+}
+func (m *StreamHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamHistory.Merge(m, src)
+}
+func (m *StreamHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamHistory proto.InternalMessageInfo
+
+func (m *StreamHistory) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+func (m *StreamHistory) GetFlowRateChanges() []FlowRateChange {
+	if m != nil {
+		return m.FlowRateChanges
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("mainchain.stream.v1.StreamPeriod", StreamPeriod_name, StreamPeriod_value)
+	proto.RegisterEnum("mainchain.stream.v1.StreamStatus", StreamStatus_name, StreamStatus_value)
 	proto.RegisterType((*Stream)(nil), "mainchain.stream.v1.Stream")
+	proto.RegisterType((*StreamStats)(nil), "mainchain.stream.v1.StreamStats")
+	proto.RegisterType((*FlowRateChange)(nil), "mainchain.stream.v1.FlowRateChange")
+	proto.RegisterType((*StreamHistory)(nil), "mainchain.stream.v1.StreamHistory")
 }
 
 func init() { proto.RegisterFile("mainchain/stream/v1/stream.proto", fileDescriptor_835c4cdaca46b43c) }
 
 var fileDescriptor_835c4cdaca46b43c = []byte{
-	// 606 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x3d, 0x6f, 0xd3, 0x4c,
-	0x1c, 0xb7, 0x9b, 0xf4, 0xe5, 0xb9, 0x3e, 0x52, 0x1d, 0xb7, 0x85, 0xd4, 0x95, 0x1c, 0xab, 0x62,
-	0xa8, 0x2a, 0xb0, 0x09, 0x9d, 0xe8, 0xd6, 0x36, 0x46, 0x8d, 0x50, 0x92, 0xca, 0x49, 0x54, 0xa5,
-	0x8b, 0x75, 0x76, 0x2e, 0xc9, 0x09, 0xfb, 0x2e, 0xb2, 0xcf, 0x81, 0xf0, 0x09, 0x90, 0xa7, 0xce,
-	0x48, 0x99, 0xf8, 0x02, 0x7c, 0x8c, 0x8e, 0x1d, 0x99, 0x0a, 0x4a, 0x06, 0x76, 0x24, 0x76, 0xe4,
-	0x97, 0xd0, 0x04, 0xb3, 0xb0, 0x58, 0xff, 0xff, 0xef, 0x7e, 0x6f, 0xb2, 0x4e, 0x07, 0x14, 0x17,
-	0x62, 0x62, 0x0f, 0x20, 0x26, 0x9a, 0xcf, 0x3c, 0x04, 0x5d, 0x6d, 0x54, 0x4e, 0x27, 0x75, 0xe8,
-	0x51, 0x46, 0xc5, 0xed, 0xdf, 0x0c, 0x35, 0xc5, 0x47, 0x65, 0x49, 0xb6, 0xa9, 0xef, 0x52, 0x5f,
-	0xb3, 0xa0, 0x8f, 0xb4, 0x51, 0xd9, 0x42, 0x0c, 0x96, 0x35, 0x9b, 0x62, 0x92, 0x88, 0xa4, 0xbd,
-	0xe4, 0xdc, 0x8c, 0x37, 0x2d, 0x59, 0xd2, 0xa3, 0x9d, 0x3e, 0xed, 0xd3, 0x04, 0x8f, 0xa6, 0x14,
-	0x2d, 0xf5, 0x29, 0xed, 0x3b, 0x48, 0x8b, 0x37, 0x2b, 0xe8, 0x69, 0x0c, 0xbb, 0xc8, 0x67, 0xd0,
-	0x1d, 0xa6, 0x84, 0x02, 0x74, 0x31, 0xa1, 0x5a, 0xfc, 0x4d, 0xa0, 0x83, 0x9f, 0x2b, 0x60, 0xad,
-	0x19, 0x57, 0x12, 0x5f, 0x82, 0xf5, 0x2e, 0x1a, 0x52, 0x1f, 0xb3, 0x22, 0xaf, 0xf0, 0x87, 0x9b,
-	0x2f, 0xf6, 0xd4, 0x34, 0x34, 0x6a, 0xa8, 0xa6, 0x0d, 0xd5, 0x73, 0x8a, 0xc9, 0x59, 0xfe, 0xf6,
-	0xbe, 0xc4, 0x19, 0x73, 0xbe, 0xb8, 0x0f, 0xfe, 0xeb, 0x39, 0xf4, 0xad, 0xe9, 0x41, 0x86, 0x8a,
-	0x2b, 0x0a, 0x7f, 0x98, 0x33, 0x36, 0x22, 0xc0, 0x80, 0x0c, 0x89, 0x0e, 0x28, 0x38, 0xd0, 0x67,
-	0x26, 0x0d, 0x58, 0x4c, 0x8a, 0x5a, 0x15, 0x73, 0x71, 0x82, 0xa4, 0x26, 0x95, 0xd5, 0x79, 0x65,
-	0xb5, 0x35, 0xaf, 0x7c, 0xf6, 0x24, 0x8a, 0xf8, 0x71, 0x5f, 0x2a, 0x8e, 0xa1, 0xeb, 0x9c, 0x1c,
-	0x64, 0x2c, 0x0e, 0x6e, 0xbe, 0x96, 0x78, 0x63, 0x2b, 0xc2, 0x1b, 0x09, 0x1c, 0x69, 0xa3, 0xb4,
-	0xb4, 0x95, 0xf9, 0x1e, 0x79, 0x34, 0x49, 0xcb, 0xff, 0x6b, 0x5a, 0xc6, 0x22, 0x4d, 0x4b, 0xf1,
-	0x6b, 0xe4, 0xd1, 0x38, 0x4d, 0x01, 0x9b, 0x36, 0x24, 0x36, 0x72, 0x1c, 0x68, 0x39, 0xa8, 0xb8,
-	0xaa, 0xf0, 0x87, 0x1b, 0xc6, 0x22, 0x74, 0xb2, 0x1b, 0x7e, 0xff, 0x7c, 0x24, 0x3c, 0xdc, 0x8b,
-	0xe4, 0x67, 0x1f, 0x7d, 0xcc, 0x81, 0xff, 0x93, 0xf1, 0x12, 0x79, 0x98, 0x76, 0xc5, 0x13, 0xb0,
-	0xd7, 0x6c, 0x19, 0xfa, 0x69, 0xcd, 0xbc, 0xd4, 0x8d, 0x6a, 0xa3, 0x62, 0xb6, 0xeb, 0xcd, 0x4b,
-	0xfd, 0xbc, 0xfa, 0xaa, 0xaa, 0x57, 0x04, 0x4e, 0xda, 0x0f, 0x27, 0xca, 0xe3, 0x45, 0x41, 0x9b,
-	0xf8, 0x43, 0x64, 0xe3, 0x1e, 0x46, 0x5d, 0xf1, 0x39, 0xd8, 0x59, 0xd6, 0x36, 0xf5, 0xf3, 0x46,
-	0xbd, 0x22, 0xf0, 0xd2, 0xa3, 0x70, 0xa2, 0x88, 0x8b, 0xb2, 0x26, 0xb2, 0x29, 0xf9, 0x8b, 0xa2,
-	0x56, 0xad, 0xb7, 0x5b, 0xba, 0xb0, 0x92, 0x55, 0xd4, 0x30, 0x09, 0x18, 0x12, 0x9f, 0x02, 0x71,
-	0x59, 0x71, 0xd1, 0x68, 0x1b, 0x42, 0x4e, 0xda, 0x09, 0x27, 0x8a, 0xb0, 0xc8, 0xbf, 0xa0, 0x81,
-	0x27, 0x1e, 0x81, 0xc2, 0x32, 0xbb, 0x72, 0xda, 0x11, 0xf2, 0xd2, 0x76, 0x38, 0x51, 0xb6, 0x16,
-	0xc9, 0x15, 0x38, 0xce, 0x3a, 0x5f, 0xe9, 0xfa, 0x6b, 0x61, 0x35, 0xeb, 0x7c, 0x85, 0xd0, 0x1b,
-	0x51, 0x05, 0xdb, 0x7f, 0x34, 0x6f, 0xd4, 0x5b, 0x17, 0xc2, 0x9a, 0xb4, 0x1b, 0x4e, 0x94, 0xc2,
-	0x52, 0x71, 0x4a, 0xd8, 0x20, 0xeb, 0xde, 0xd1, 0x4f, 0x0d, 0x61, 0x3d, 0xeb, 0xde, 0x41, 0xd0,
-	0x93, 0xf2, 0x1f, 0x3e, 0xc9, 0xdc, 0x59, 0xed, 0x76, 0x2a, 0xf3, 0x77, 0x53, 0x99, 0xff, 0x36,
-	0x95, 0xf9, 0x9b, 0x99, 0xcc, 0xdd, 0xcd, 0x64, 0xee, 0xcb, 0x4c, 0xe6, 0xae, 0x8f, 0xfb, 0x98,
-	0x0d, 0x02, 0x4b, 0xb5, 0xa9, 0xab, 0x05, 0x04, 0xf7, 0xb0, 0x0d, 0x19, 0xa6, 0xe4, 0x59, 0xb4,
-	0x3f, 0xbc, 0x02, 0xef, 0xe6, 0xef, 0x00, 0x1b, 0x0f, 0x91, 0x6f, 0xad, 0xc5, 0xf7, 0xed, 0xf8,
-	0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5e, 0x4c, 0x19, 0xb5, 0x28, 0x04, 0x00, 0x00,
+	// 1235 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x73, 0xda, 0xc6,
+	0x1b, 0xb6, 0x00, 0xdb, 0x78, 0xb1, 0x63, 0x21, 0xdb, 0x89, 0x42, 0x7e, 0xc1, 0xfa, 0x91, 0x1e,
+	0x3c, 0x6e, 0x03, 0xb1, 0xd3, 0x4b, 0x73, 0xc3, 0x20, 0xd7, 0x4c, 0x63, 0xec, 0x0a, 0x68, 0xfe,
+	0x5c, 0x34, 0x8b, 0xb4, 0xc0, 0x36, 0x62, 0x97, 0x91, 0x16, 0xbb, 0xee, 0xb5, 0x97, 0x0e, 0xa7,
+	0x9c, 0x3b, 0xe3, 0x53, 0xbf, 0x40, 0x0f, 0xfd, 0x10, 0x99, 0x9c, 0x32, 0x9d, 0xe9, 0x4c, 0x4f,
+	0x69, 0x27, 0x39, 0xf4, 0x9e, 0x4f, 0xd0, 0xd1, 0xee, 0x0a, 0x84, 0x49, 0x4b, 0x72, 0x61, 0xa4,
+	0x67, 0xdf, 0xe7, 0x79, 0xde, 0x7d, 0xdf, 0xdd, 0x57, 0x00, 0xa3, 0x0f, 0x31, 0x71, 0x7a, 0x10,
+	0x93, 0x52, 0xc0, 0x7c, 0x04, 0xfb, 0xa5, 0xb3, 0x3d, 0xf9, 0x54, 0x1c, 0xf8, 0x94, 0x51, 0x6d,
+	0x63, 0x1c, 0x51, 0x94, 0xf8, 0xd9, 0x5e, 0x2e, 0xef, 0xd0, 0xa0, 0x4f, 0x83, 0x52, 0x1b, 0x06,
+	0xa8, 0x74, 0xb6, 0xd7, 0x46, 0x0c, 0xee, 0x95, 0x1c, 0x8a, 0x89, 0x20, 0xe5, 0x6e, 0x8a, 0x75,
+	0x9b, 0xbf, 0x95, 0xc4, 0x8b, 0x5c, 0xda, 0xec, 0xd2, 0x2e, 0x15, 0x78, 0xf8, 0x24, 0xd1, 0xed,
+	0x2e, 0xa5, 0x5d, 0x0f, 0x95, 0xf8, 0x5b, 0x7b, 0xd8, 0x29, 0x31, 0xdc, 0x47, 0x01, 0x83, 0xfd,
+	0x81, 0x0c, 0xc8, 0xc2, 0x3e, 0x26, 0xb4, 0xc4, 0x7f, 0x05, 0x54, 0x78, 0x97, 0x06, 0x4b, 0x0d,
+	0x9e, 0x92, 0xf6, 0x05, 0x58, 0x76, 0xd1, 0x80, 0x06, 0x98, 0xe9, 0x8a, 0xa1, 0xec, 0x64, 0xf6,
+	0x6f, 0x16, 0xa5, 0x69, 0x98, 0x61, 0x51, 0x66, 0x58, 0xac, 0x50, 0x4c, 0x0e, 0x52, 0x2f, 0x5e,
+	0x6f, 0x2f, 0x58, 0x51, 0xbc, 0x76, 0x0b, 0xac, 0x74, 0x3c, 0x7a, 0x6e, 0xfb, 0x90, 0x21, 0x3d,
+	0x61, 0x28, 0x3b, 0x49, 0x2b, 0x1d, 0x02, 0x16, 0x64, 0x48, 0xf3, 0x40, 0xd6, 0x83, 0x01, 0xb3,
+	0xe9, 0x90, 0xf1, 0xa0, 0x30, 0x2b, 0x3d, 0xc9, 0x1d, 0x72, 0x45, 0x91, 0x72, 0x31, 0x4a, 0xb9,
+	0xd8, 0x8c, 0x52, 0x3e, 0xf8, 0x24, 0xb4, 0x78, 0xf7, 0x7a, 0x5b, 0xbf, 0x80, 0x7d, 0xef, 0x41,
+	0x61, 0x46, 0xa2, 0xf0, 0xfc, 0xcf, 0x6d, 0xc5, 0x5a, 0x0f, 0xf1, 0x13, 0x01, 0x87, 0xdc, 0xd0,
+	0x4d, 0x66, 0x65, 0x7f, 0x8f, 0x7c, 0x2a, 0xdc, 0x52, 0x1f, 0xeb, 0x36, 0x23, 0x21, 0xdd, 0x24,
+	0xfe, 0x14, 0xf9, 0x94, 0xbb, 0x19, 0x20, 0xe3, 0x40, 0xe2, 0x20, 0xcf, 0x83, 0x6d, 0x0f, 0xe9,
+	0x8b, 0x86, 0xb2, 0x93, 0xb6, 0xe2, 0x50, 0x58, 0x1a, 0xd1, 0x72, 0x1b, 0xbb, 0xfa, 0x92, 0xa1,
+	0xec, 0xa4, 0xac, 0xb4, 0x00, 0x6a, 0xae, 0xf6, 0x39, 0x48, 0xfb, 0xc8, 0x41, 0xf8, 0x0c, 0xf9,
+	0xfa, 0xb2, 0xa1, 0xec, 0xac, 0x1c, 0xe8, 0xbf, 0xfd, 0x7a, 0x77, 0x53, 0x96, 0xbd, 0xec, 0xba,
+	0x3e, 0x0a, 0x82, 0x06, 0xf3, 0x31, 0xe9, 0x5a, 0xe3, 0x48, 0xed, 0x1e, 0x58, 0x0a, 0x10, 0x71,
+	0x91, 0xaf, 0xa7, 0xe7, 0x70, 0x64, 0x9c, 0xf6, 0x18, 0x80, 0x80, 0x41, 0x9f, 0x89, 0x6a, 0xac,
+	0xcc, 0xad, 0xc6, 0x6d, 0x59, 0x8d, 0xac, 0xa8, 0xc6, 0x84, 0x2b, 0xca, 0xb0, 0xc2, 0x01, 0x5e,
+	0x80, 0xc7, 0x00, 0x38, 0x1e, 0xee, 0x74, 0x84, 0x32, 0xf8, 0x58, 0xe5, 0x09, 0x57, 0x2a, 0x73,
+	0x80, 0x2b, 0x5b, 0x20, 0x8d, 0x88, 0x2b, 0x74, 0x33, 0x73, 0x75, 0x6f, 0x49, 0xdd, 0x75, 0xa1,
+	0x1b, 0x31, 0x85, 0xea, 0x32, 0x22, 0x2e, 0xd7, 0xcc, 0x81, 0xf4, 0x00, 0x0e, 0x03, 0xde, 0xab,
+	0x55, 0xde, 0xab, 0xf1, 0xbb, 0xd6, 0x02, 0x2b, 0xe1, 0x33, 0x72, 0x6d, 0xc8, 0xf4, 0xb5, 0xb9,
+	0x86, 0xff, 0x93, 0x86, 0xaa, 0x30, 0x1c, 0x53, 0x85, 0x63, 0x5a, 0xbc, 0x97, 0x99, 0xb6, 0x0f,
+	0xb6, 0xc2, 0x13, 0x1b, 0x1d, 0x28, 0xd6, 0xf3, 0x51, 0xd0, 0xa3, 0x9e, 0xab, 0x5f, 0xe3, 0x67,
+	0x61, 0xc3, 0xa3, 0xe7, 0x55, 0xb1, 0xd6, 0x8c, 0x96, 0xb4, 0x22, 0xd8, 0x88, 0x73, 0xa0, 0x87,
+	0x7c, 0x86, 0x5c, 0x7d, 0x9d, 0x67, 0x9c, 0x9d, 0x30, 0xca, 0x62, 0x41, 0xfb, 0x16, 0xa8, 0x0c,
+	0xf9, 0x7d, 0x4c, 0x20, 0xc3, 0x94, 0x88, 0x92, 0xa9, 0x73, 0x77, 0x70, 0x47, 0xee, 0xe0, 0x86,
+	0xd8, 0xc1, 0x55, 0x05, 0x79, 0xe2, 0x63, 0x30, 0x2f, 0xe1, 0xa7, 0x20, 0x1b, 0x95, 0xcc, 0x86,
+	0x8e, 0x83, 0x06, 0x61, 0x66, 0x59, 0x9e, 0x99, 0x1a, 0x2d, 0x94, 0x25, 0xfe, 0x60, 0x6b, 0xf4,
+	0xf7, 0x2f, 0xbb, 0xea, 0x64, 0x28, 0x8a, 0x49, 0x53, 0x78, 0x99, 0x00, 0x19, 0xf1, 0xd8, 0x60,
+	0x90, 0x05, 0xd3, 0x77, 0x44, 0xb9, 0x72, 0x47, 0xaa, 0x60, 0x8d, 0x51, 0x06, 0x3d, 0xdb, 0xf1,
+	0x20, 0xee, 0x23, 0x57, 0x4f, 0x7c, 0xd8, 0x70, 0x5a, 0xe5, 0xac, 0x8a, 0x20, 0x69, 0x5f, 0x83,
+	0x4d, 0xa1, 0x72, 0x06, 0x3d, 0xec, 0x42, 0x46, 0x7d, 0xbb, 0x83, 0x50, 0xa0, 0x27, 0x3f, 0x4c,
+	0x4c, 0xe3, 0xe4, 0x6f, 0x22, 0xee, 0x21, 0x42, 0x81, 0x76, 0x1b, 0x00, 0x32, 0xec, 0x8b, 0xb4,
+	0x02, 0x3e, 0x62, 0x52, 0xd6, 0x0a, 0x19, 0xf6, 0xb9, 0x65, 0xa0, 0x75, 0x00, 0x9f, 0x4d, 0x62,
+	0x5d, 0xf4, 0x64, 0x71, 0x6e, 0x4f, 0x0a, 0xb2, 0x27, 0xd7, 0x63, 0x43, 0x6f, 0x22, 0x20, 0x5a,
+	0xb2, 0x16, 0xa2, 0xdc, 0x24, 0xe4, 0x15, 0x7e, 0x57, 0xc0, 0xb5, 0x43, 0x39, 0x6b, 0x2b, 0x3d,
+	0x48, 0xba, 0x48, 0x2b, 0x80, 0x35, 0xea, 0xb9, 0xf6, 0x64, 0x24, 0x2b, 0x7c, 0x24, 0x67, 0xa8,
+	0xe7, 0x46, 0x91, 0x61, 0x0c, 0x41, 0xe7, 0xf6, 0xd5, 0xb1, 0x9d, 0x21, 0xe8, 0x7c, 0x1c, 0x13,
+	0x5e, 0x6e, 0xae, 0xc8, 0xef, 0x44, 0xf2, 0xa3, 0x2f, 0xf7, 0x98, 0x1b, 0x5d, 0x6e, 0x01, 0x94,
+	0x99, 0xf6, 0x7f, 0xb0, 0xda, 0xf6, 0xa8, 0xf3, 0xcc, 0xee, 0x21, 0xdc, 0xed, 0x31, 0x5e, 0xbd,
+	0xa4, 0x95, 0xe1, 0xd8, 0x11, 0x87, 0x0a, 0x3f, 0x28, 0x60, 0x4d, 0x1c, 0x92, 0x23, 0x1c, 0x30,
+	0xea, 0x5f, 0xfc, 0xf7, 0x31, 0x69, 0x81, 0xec, 0x78, 0x2f, 0xb6, 0x30, 0x0a, 0xf4, 0x84, 0x91,
+	0xdc, 0xc9, 0xec, 0xdf, 0x29, 0xbe, 0xe7, 0xf3, 0x5b, 0x9c, 0xae, 0x99, 0xec, 0xf3, 0x7a, 0x67,
+	0x0a, 0x0d, 0x76, 0x7f, 0x4a, 0x82, 0x55, 0x91, 0xc5, 0x29, 0xf2, 0x31, 0x75, 0xb5, 0x07, 0xe0,
+	0x66, 0xa3, 0x69, 0x99, 0xe5, 0x63, 0xfb, 0xd4, 0xb4, 0x6a, 0x27, 0x55, 0xbb, 0x55, 0x6f, 0x9c,
+	0x9a, 0x95, 0xda, 0x61, 0xcd, 0xac, 0xaa, 0x0b, 0xb9, 0x5b, 0xa3, 0x4b, 0xe3, 0x46, 0x9c, 0xd0,
+	0x22, 0xc1, 0x00, 0x39, 0xb8, 0x83, 0x91, 0xab, 0xdd, 0x03, 0x9b, 0xd3, 0xdc, 0x86, 0x59, 0x39,
+	0xa9, 0x57, 0x55, 0x25, 0x77, 0x7d, 0x74, 0x69, 0x68, 0x71, 0x5a, 0x03, 0x39, 0x94, 0xbc, 0x87,
+	0x71, 0x5c, 0xab, 0xb7, 0x9a, 0xa6, 0x9a, 0x98, 0x65, 0x1c, 0x63, 0x32, 0x64, 0x48, 0xfb, 0x0c,
+	0x68, 0xd3, 0x8c, 0xa3, 0x93, 0x96, 0xa5, 0x26, 0x73, 0x9b, 0xa3, 0x4b, 0x43, 0x8d, 0xc7, 0x1f,
+	0xd1, 0xa1, 0xaf, 0xed, 0x82, 0xec, 0x74, 0x74, 0xb5, 0xfc, 0x44, 0x4d, 0xe5, 0x36, 0x46, 0x97,
+	0xc6, 0x7a, 0x3c, 0xb8, 0x0a, 0x2f, 0x66, 0x95, 0x1f, 0x99, 0xe6, 0x57, 0xea, 0xe2, 0xac, 0xf2,
+	0x23, 0x84, 0x9e, 0x85, 0x33, 0xec, 0x4a, 0xe6, 0x27, 0xf5, 0xe6, 0x91, 0xba, 0x94, 0xdb, 0x1a,
+	0x5d, 0x1a, 0xd9, 0xa9, 0xc4, 0x29, 0x61, 0xbd, 0x59, 0xf5, 0x27, 0x66, 0xd9, 0x52, 0x97, 0x67,
+	0xd5, 0x9f, 0x20, 0xe8, 0xe7, 0x52, 0x3f, 0xfe, 0x9c, 0x5f, 0xd8, 0x7d, 0x99, 0x00, 0xab, 0x93,
+	0x39, 0x32, 0x0c, 0x62, 0xcd, 0x69, 0x34, 0xcb, 0xcd, 0x56, 0xe3, 0xdf, 0x9b, 0x23, 0x08, 0xf1,
+	0xe6, 0xec, 0x83, 0xad, 0x69, 0xee, 0xa9, 0x59, 0xaf, 0xd6, 0xea, 0x5f, 0xaa, 0x4a, 0xee, 0xc6,
+	0xe8, 0xd2, 0xd8, 0x88, 0xf3, 0x4e, 0x11, 0x71, 0x31, 0xe9, 0xc6, 0x36, 0x29, 0x39, 0x95, 0x87,
+	0xb5, 0xc3, 0x43, 0x35, 0x11, 0xdf, 0xa4, 0x60, 0x54, 0xc2, 0xef, 0xda, 0xac, 0xc7, 0xe1, 0xc3,
+	0x93, 0x47, 0xa1, 0x47, 0x72, 0xd6, 0x23, 0x3c, 0x9f, 0xef, 0xf5, 0x30, 0xeb, 0x55, 0xb3, 0xaa,
+	0xa6, 0x66, 0x3d, 0x4c, 0xe2, 0x4e, 0x1d, 0xb2, 0x68, 0x1f, 0xe5, 0x56, 0xc3, 0xac, 0xaa, 0x8b,
+	0xf1, 0x23, 0x23, 0xb7, 0xc1, 0x3f, 0x52, 0xa2, 0x98, 0x07, 0xc7, 0x2f, 0xde, 0xe4, 0x95, 0x57,
+	0x6f, 0xf2, 0xca, 0x5f, 0x6f, 0xf2, 0xca, 0xf3, 0xb7, 0xf9, 0x85, 0x57, 0x6f, 0xf3, 0x0b, 0x7f,
+	0xbc, 0xcd, 0x2f, 0x3c, 0xbd, 0xdf, 0xc5, 0xac, 0x37, 0x6c, 0x17, 0x1d, 0xda, 0x2f, 0x0d, 0x09,
+	0xee, 0x60, 0x87, 0x7f, 0x0e, 0xee, 0x86, 0xef, 0x93, 0xbf, 0xbe, 0xdf, 0x45, 0x7f, 0x7e, 0xd9,
+	0xc5, 0x00, 0x05, 0xed, 0x25, 0x3e, 0x1f, 0xee, 0xff, 0x33, 0x00, 0xfb, 0xdb, 0xad, 0x90, 0x1d,
+	0x0b, 0x00, 0x00,
 }
 
 func (m *Stream) Marshal() (dAtA []byte, err error) {
@@ -233,31 +647,129 @@ func (m *Stream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.Cancellable {
+	if m.PausableAccepted {
 		i--
-		if m.Cancellable {
+		if m.PausableAccepted {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DepositZeroTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DepositZeroTime):])
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.TerminationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TerminationTime):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintStream(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastOutflowTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastOutflowTime):])
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.LowDepositAlerted {
+		i--
+		if m.LowDepositAlerted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.LowDepositThreshold != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.LowDepositThreshold))
+		i--
+		dAtA[i] = 0x70
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PausedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PausedAt):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintStream(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x6a
+	if m.Pausable {
+		i--
+		if m.Pausable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintStream(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x5a
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CliffTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CliffTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintStream(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x52
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintStream(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Cancellable {
+		i--
+		if m.Cancellable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DepositZeroTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DepositZeroTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintStream(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastOutflowTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastOutflowTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintStream(dAtA, i, uint64(n7))
+	i--
 	dAtA[i] = 0x1a
 	if m.FlowRate != 0 {
 		i = encodeVarintStream(dAtA, i, uint64(m.FlowRate))
@@ -277,21 +789,170 @@ func (m *Stream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStream(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStream(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StreamStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Stream) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *StreamStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastClaimTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastClaimTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintStream(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x2a
+	if m.NumClaims != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.NumClaims))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.TotalValidatorFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TotalClaimed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.StreamId != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlowRateChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlowRateChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlowRateChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ChangedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ChangedAt):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintStream(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	if m.NewFlowRate != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.NewFlowRate))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldFlowRate != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.OldFlowRate))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FlowRateChanges) > 0 {
+		for iNdEx := len(m.FlowRateChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlowRateChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Stream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = m.Deposit.Size()
@@ -306,6 +967,98 @@ func (m *Stream) Size() (n int) {
 	if m.Cancellable {
 		n += 2
 	}
+	if m.StreamId != 0 {
+		n += 1 + sovStream(uint64(m.StreamId))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovStream(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CliffTime)
+	n += 1 + l + sovStream(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovStream(uint64(l))
+	if m.Pausable {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PausedAt)
+	n += 1 + l + sovStream(uint64(l))
+	if m.LowDepositThreshold != 0 {
+		n += 1 + sovStream(uint64(m.LowDepositThreshold))
+	}
+	if m.LowDepositAlerted {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TerminationTime)
+	n += 2 + l + sovStream(uint64(l))
+	if m.PausableAccepted {
+		n += 3
+	}
+	return n
+}
+
+func (m *StreamStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovStream(uint64(m.StreamId))
+	}
+	l = m.TotalClaimed.Size()
+	n += 1 + l + sovStream(uint64(l))
+	l = m.TotalValidatorFees.Size()
+	n += 1 + l + sovStream(uint64(l))
+	if m.NumClaims != 0 {
+		n += 1 + sovStream(uint64(m.NumClaims))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastClaimTime)
+	n += 1 + l + sovStream(uint64(l))
+	return n
+}
+
+func (m *FlowRateChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldFlowRate != 0 {
+		n += 1 + sovStream(uint64(m.OldFlowRate))
+	}
+	if m.NewFlowRate != 0 {
+		n += 1 + sovStream(uint64(m.NewFlowRate))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ChangedAt)
+	n += 1 + l + sovStream(uint64(l))
+	if m.BlockHeight != 0 {
+		n += 1 + sovStream(uint64(m.BlockHeight))
+	}
+	return n
+}
+
+func (m *StreamHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovStream(uint64(m.StreamId))
+	}
+	if len(m.FlowRateChanges) > 0 {
+		for _, e := range m.FlowRateChanges {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -482,6 +1235,763 @@ func (m *Stream) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Cancellable = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pausable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pausable = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PausedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowDepositThreshold", wireType)
+			}
+			m.LowDepositThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowDepositThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowDepositAlerted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.LowDepositAlerted = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.TerminationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausableAccepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PausableAccepted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalClaimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalClaimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidatorFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalValidatorFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumClaims", wireType)
+			}
+			m.NumClaims = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumClaims |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastClaimTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastClaimTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlowRateChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlowRateChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlowRateChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldFlowRate", wireType)
+			}
+			m.OldFlowRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldFlowRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFlowRate", wireType)
+			}
+			m.NewFlowRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewFlowRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ChangedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowRateChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlowRateChanges = append(m.FlowRateChanges, FlowRateChange{})
+			if err := m.FlowRateChanges[len(m.FlowRateChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStream(dAtA[iNdEx:])
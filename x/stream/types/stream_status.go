@@ -0,0 +1,82 @@
+package types
+
+import "time"
+
+// ClaimableFrom returns the time from which payments can be claimed from the stream - the later of
+// its start and cliff times. Streams created before start times were introduced have neither set.
+func (s Stream) ClaimableFrom() time.Time {
+	if s.CliffTime.After(s.StartTime) {
+		return s.CliffTime
+	}
+	return s.StartTime
+}
+
+// HasEnded returns true if the stream has an end time, and it has been reached
+func (s Stream) HasEnded(nowTime time.Time) bool {
+	return !s.EndTime.IsZero() && !nowTime.Before(s.EndTime)
+}
+
+// IsPaused returns true if the stream has been paused by its sender
+func (s Stream) IsPaused() bool {
+	return !s.PausedAt.IsZero()
+}
+
+// AccruedUntil returns the time up to which payments have accrued at the given time. Nothing accrues
+// while a stream is paused.
+func (s Stream) AccruedUntil(nowTime time.Time) time.Time {
+	if s.IsPaused() && s.PausedAt.Before(nowTime) {
+		return s.PausedAt
+	}
+	return nowTime
+}
+
+// ExpiryQueueTime returns the time at which the stream should be settled by the EndBlocker. Paused
+// streams do not deplete their deposit, so are only queued if they have an end time.
+func (s Stream) ExpiryQueueTime() (time.Time, bool) {
+	if !s.IsPaused() {
+		return s.DepositZeroTime, true
+	}
+	if !s.EndTime.IsZero() {
+		return s.EndTime, true
+	}
+	return time.Time{}, false
+}
+
+// LowDepositAlertTime returns the time at which the stream's remaining deposit, or time until its end time,
+// falls below its low deposit threshold. Streams without a threshold, which have already been alerted, or which
+// are not in the expiry queue, have no alert time.
+func (s Stream) LowDepositAlertTime() (time.Time, bool) {
+	if s.LowDepositThreshold == 0 || s.LowDepositAlerted {
+		return time.Time{}, false
+	}
+	expiryTime, queued := s.ExpiryQueueTime()
+	if !queued {
+		return time.Time{}, false
+	}
+	return expiryTime.Add(-time.Second * time.Duration(s.LowDepositThreshold)), true
+}
+
+// StatusAt returns the status of the stream at the given time
+func (s Stream) StatusAt(nowTime time.Time) StreamStatus {
+	if nowTime.Before(s.StartTime) {
+		return StreamStatusPending
+	}
+
+	if s.HasEnded(nowTime) || s.Deposit.IsNil() || s.Deposit.IsZero() {
+		return StreamStatusEnded
+	}
+
+	if s.IsPaused() {
+		return StreamStatusPaused
+	}
+
+	if !nowTime.Before(s.DepositZeroTime) {
+		return StreamStatusEnded
+	}
+
+	if nowTime.Before(s.CliffTime) {
+		return StreamStatusCliff
+	}
+
+	return StreamStatusFlowing
+}
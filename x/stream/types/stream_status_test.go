@@ -0,0 +1,137 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/unification-com/mainchain/x/stream/types"
+)
+
+func TestStreamStatusAt(t *testing.T) {
+	startTime := time.Unix(1700000000, 0).UTC()
+
+	scheduled := types.Stream{
+		Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+		FlowRate:        1,
+		LastOutflowTime: startTime,
+		DepositZeroTime: startTime.Add(time.Second * 1000),
+		StartTime:       startTime,
+		CliffTime:       startTime.Add(time.Second * 100),
+		EndTime:         startTime.Add(time.Second * 500),
+	}
+
+	unscheduled := types.Stream{
+		Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000),
+		FlowRate:        1,
+		LastOutflowTime: startTime,
+		DepositZeroTime: startTime.Add(time.Second * 1000),
+	}
+
+	noDeposit := types.Stream{
+		Deposit:         sdk.NewInt64Coin(sdk.DefaultBondDenom, 0),
+		FlowRate:        1,
+		LastOutflowTime: startTime,
+		DepositZeroTime: startTime,
+		StartTime:       startTime,
+	}
+
+	paused := unscheduled
+	paused.PausedAt = startTime.Add(time.Second * 200)
+
+	testCases := []struct {
+		name      string
+		stream    types.Stream
+		nowTime   time.Time
+		expStatus types.StreamStatus
+	}{
+		{"pending", scheduled, startTime.Add(-time.Second), types.StreamStatusPending},
+		{"cliff at start", scheduled, startTime, types.StreamStatusCliff},
+		{"cliff", scheduled, startTime.Add(time.Second * 99), types.StreamStatusCliff},
+		{"flowing at cliff", scheduled, startTime.Add(time.Second * 100), types.StreamStatusFlowing},
+		{"ended at end time", scheduled, startTime.Add(time.Second * 500), types.StreamStatusEnded},
+		{"unscheduled flowing", unscheduled, startTime.Add(time.Second * 999), types.StreamStatusFlowing},
+		{"unscheduled deposit used", unscheduled, startTime.Add(time.Second * 1000), types.StreamStatusEnded},
+		{"no deposit", noDeposit, startTime, types.StreamStatusEnded},
+		{"paused", paused, startTime.Add(time.Second * 300), types.StreamStatusPaused},
+		{"paused after deposit zero time", paused, startTime.Add(time.Second * 2000), types.StreamStatusPaused},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expStatus, tc.stream.StatusAt(tc.nowTime))
+		})
+	}
+}
+
+func TestStreamClaimableFrom(t *testing.T) {
+	startTime := time.Unix(1700000000, 0).UTC()
+	cliffTime := startTime.Add(time.Hour)
+
+	require.Equal(t, startTime, types.Stream{StartTime: startTime}.ClaimableFrom())
+	require.Equal(t, cliffTime, types.Stream{StartTime: startTime, CliffTime: cliffTime}.ClaimableFrom())
+	require.True(t, types.Stream{}.ClaimableFrom().IsZero())
+}
+
+func TestStreamPauseHelpers(t *testing.T) {
+	startTime := time.Unix(1700000000, 0).UTC()
+	pausedAt := startTime.Add(time.Second * 100)
+	endTime := startTime.Add(time.Second * 500)
+
+	stream := types.Stream{
+		DepositZeroTime: startTime.Add(time.Second * 1000),
+	}
+
+	require.False(t, stream.IsPaused())
+	require.Equal(t, startTime, stream.AccruedUntil(startTime))
+	expiry, queued := stream.ExpiryQueueTime()
+	require.True(t, queued)
+	require.Equal(t, stream.DepositZeroTime, expiry)
+
+	stream.PausedAt = pausedAt
+	require.True(t, stream.IsPaused())
+	require.Equal(t, pausedAt, stream.AccruedUntil(startTime.Add(time.Second*200)))
+	require.Equal(t, startTime, stream.AccruedUntil(startTime))
+	_, queued = stream.ExpiryQueueTime()
+	require.False(t, queued)
+
+	stream.EndTime = endTime
+	expiry, queued = stream.ExpiryQueueTime()
+	require.True(t, queued)
+	require.Equal(t, endTime, expiry)
+}
+
+func TestStreamLowDepositAlertTime(t *testing.T) {
+	startTime := time.Unix(1700000000, 0).UTC()
+
+	stream := types.Stream{
+		DepositZeroTime: startTime.Add(time.Second * 1000),
+	}
+
+	// no threshold set
+	_, queued := stream.LowDepositAlertTime()
+	require.False(t, queued)
+
+	stream.LowDepositThreshold = 300
+	alertTime, queued := stream.LowDepositAlertTime()
+	require.True(t, queued)
+	require.Equal(t, startTime.Add(time.Second*700), alertTime)
+
+	// already alerted
+	stream.LowDepositAlerted = true
+	_, queued = stream.LowDepositAlertTime()
+	require.False(t, queued)
+
+	// paused streams without an end time do not deplete
+	stream.LowDepositAlerted = false
+	stream.PausedAt = startTime.Add(time.Second * 100)
+	_, queued = stream.LowDepositAlertTime()
+	require.False(t, queued)
+
+	stream.EndTime = startTime.Add(time.Second * 500)
+	alertTime, queued = stream.LowDepositAlertTime()
+	require.True(t, queued)
+	require.Equal(t, startTime.Add(time.Second*200), alertTime)
+}
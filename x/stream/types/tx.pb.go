@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -46,6 +47,14 @@ type MsgCreateStream struct {
 	Deposit types.Coin `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit"`
 	// flow_rate is the rate of nund per second
 	FlowRate int64 `protobuf:"varint,4,opt,name=flow_rate,json=flowRate,proto3" json:"flow_rate,omitempty"`
+	// start_time is the optional future timestamp from which the stream starts flowing. Defaults to the block time
+	StartTime *time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty"`
+	// cliff_time is the optional timestamp before which nothing can be claimed from the stream
+	CliffTime *time.Time `protobuf:"bytes,6,opt,name=cliff_time,json=cliffTime,proto3,stdtime" json:"cliff_time,omitempty"`
+	// end_time is the optional timestamp at which the stream stops flowing. Any remaining deposit is returned to the sender
+	EndTime *time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	// pausable is whether the sender will be able to pause the stream
+	Pausable bool `protobuf:"varint,8,opt,name=pausable,proto3" json:"pausable,omitempty"`
 }
 
 func (m *MsgCreateStream) Reset()         { *m = MsgCreateStream{} }
@@ -91,6 +100,8 @@ type MsgCreateStreamResponse struct {
 	Deposit types.Coin `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit"`
 	// flow_rate is the rate of nund per second
 	FlowRate int64 `protobuf:"varint,4,opt,name=flow_rate,json=flowRate,proto3" json:"flow_rate,omitempty"`
+	// stream_id is the ID of the new stream
+	StreamId uint64 `protobuf:"varint,5,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 }
 
 func (m *MsgCreateStreamResponse) Reset()         { *m = MsgCreateStreamResponse{} }
@@ -154,12 +165,21 @@ func (m *MsgCreateStreamResponse) GetFlowRate() int64 {
 	return 0
 }
 
+func (m *MsgCreateStreamResponse) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
 // MsgClaimStream claims pending payments form a stream using the sender and receiver addresses
 type MsgClaimStream struct {
 	// sender is sender wallet of the stream being claimed
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
 	// receiver is the wallet making the claim
 	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// denom is the denomination of the stream being claimed. Optional if the pair only has a single stream
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MsgClaimStream) Reset()         { *m = MsgClaimStream{} }
@@ -204,6 +224,12 @@ type MsgClaimStreamResponse struct {
 	ValidatorFee types.Coin `protobuf:"bytes,4,opt,name=validator_fee,json=validatorFee,proto3" json:"validator_fee"`
 	// remaining_deposit is the amount of deposit remaining in the stream
 	RemainingDeposit types.Coin `protobuf:"bytes,5,opt,name=remaining_deposit,json=remainingDeposit,proto3" json:"remaining_deposit"`
+	// fee_collector_amount is the part of the validator fee sent to the fee collector
+	FeeCollectorAmount types.Coin `protobuf:"bytes,6,opt,name=fee_collector_amount,json=feeCollectorAmount,proto3" json:"fee_collector_amount"`
+	// community_pool_amount is the part of the validator fee sent to the community pool
+	CommunityPoolAmount types.Coin `protobuf:"bytes,7,opt,name=community_pool_amount,json=communityPoolAmount,proto3" json:"community_pool_amount"`
+	// burned_amount is the part of the validator fee that was burned
+	BurnedAmount types.Coin `protobuf:"bytes,8,opt,name=burned_amount,json=burnedAmount,proto3" json:"burned_amount"`
 }
 
 func (m *MsgClaimStreamResponse) Reset()         { *m = MsgClaimStreamResponse{} }
@@ -267,6 +293,27 @@ func (m *MsgClaimStreamResponse) GetRemainingDeposit() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgClaimStreamResponse) GetFeeCollectorAmount() types.Coin {
+	if m != nil {
+		return m.FeeCollectorAmount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgClaimStreamResponse) GetCommunityPoolAmount() types.Coin {
+	if m != nil {
+		return m.CommunityPoolAmount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgClaimStreamResponse) GetBurnedAmount() types.Coin {
+	if m != nil {
+		return m.BurnedAmount
+	}
+	return types.Coin{}
+}
+
 // MsgTopUpDeposit tops up deposits in an existing stream
 type MsgTopUpDeposit struct {
 	// receiver is the wallet that will receive stream payments
@@ -382,6 +429,8 @@ type MsgUpdateFlowRate struct {
 	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 	// flow_rate is the rate of nund per second
 	FlowRate int64 `protobuf:"varint,3,opt,name=flow_rate,json=flowRate,proto3" json:"flow_rate,omitempty"`
+	// denom is the denomination of the stream being updated. Optional if the pair only has a single stream
+	Denom string `protobuf:"bytes,4,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MsgUpdateFlowRate) Reset()         { *m = MsgUpdateFlowRate{} }
@@ -469,6 +518,8 @@ type MsgCancelStream struct {
 	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
 	// sender is the wallet cancelling
 	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// denom is the denomination of the stream being cancelled. Optional if the pair only has a single stream
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
 func (m *MsgCancelStream) Reset()         { *m = MsgCancelStream{} }
@@ -541,30 +592,26 @@ func (m *MsgCancelStreamResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelStreamResponse proto.InternalMessageInfo
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParams struct {
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/stream parameters to update.
-	//
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+// MsgClaimStreamById claims pending payments from a stream using the stream ID
+type MsgClaimStreamById struct {
+	// receiver is the wallet making the claim
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// stream_id is the ID of the stream being claimed
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+func (m *MsgClaimStreamById) Reset()         { *m = MsgClaimStreamById{} }
+func (m *MsgClaimStreamById) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimStreamById) ProtoMessage()    {}
+func (*MsgClaimStreamById) Descriptor() ([]byte, []int) {
 	return fileDescriptor_887eb49d9c70e8b4, []int{10}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgClaimStreamById) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgClaimStreamById) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgClaimStreamById.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -574,51 +621,48 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgClaimStreamById) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimStreamById.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgClaimStreamById) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
-
-func (m *MsgUpdateParams) GetAuthority() string {
-	if m != nil {
-		return m.Authority
-	}
-	return ""
-}
-
-func (m *MsgUpdateParams) GetParams() Params {
-	if m != nil {
-		return m.Params
-	}
-	return Params{}
+func (m *MsgClaimStreamById) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimStreamById.DiscardUnknown(m)
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-//
-// Since: cosmos-sdk 0.47
-type MsgUpdateParamsResponse struct {
-}
+var xxx_messageInfo_MsgClaimStreamById proto.InternalMessageInfo
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+// MsgClaimStreamByIdResponse is the response for MsgClaimStreamById
+type MsgClaimStreamByIdResponse struct {
+	// total_claimed is the total value of the claim
+	TotalClaimed types.Coin `protobuf:"bytes,1,opt,name=total_claimed,json=totalClaimed,proto3" json:"total_claimed"`
+	// stream_payment is the amount received by the receiver wallet
+	StreamPayment types.Coin `protobuf:"bytes,2,opt,name=stream_payment,json=streamPayment,proto3" json:"stream_payment"`
+	// validator_fee is the amount sent to validators
+	ValidatorFee types.Coin `protobuf:"bytes,3,opt,name=validator_fee,json=validatorFee,proto3" json:"validator_fee"`
+	// remaining_deposit is the amount of deposit remaining in the stream
+	RemainingDeposit types.Coin `protobuf:"bytes,4,opt,name=remaining_deposit,json=remainingDeposit,proto3" json:"remaining_deposit"`
+	// fee_collector_amount is the part of the validator fee sent to the fee collector
+	FeeCollectorAmount types.Coin `protobuf:"bytes,5,opt,name=fee_collector_amount,json=feeCollectorAmount,proto3" json:"fee_collector_amount"`
+	// community_pool_amount is the part of the validator fee sent to the community pool
+	CommunityPoolAmount types.Coin `protobuf:"bytes,6,opt,name=community_pool_amount,json=communityPoolAmount,proto3" json:"community_pool_amount"`
+	// burned_amount is the part of the validator fee that was burned
+	BurnedAmount types.Coin `protobuf:"bytes,7,opt,name=burned_amount,json=burnedAmount,proto3" json:"burned_amount"`
+}
+
+func (m *MsgClaimStreamByIdResponse) Reset()         { *m = MsgClaimStreamByIdResponse{} }
+func (m *MsgClaimStreamByIdResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimStreamByIdResponse) ProtoMessage()    {}
+func (*MsgClaimStreamByIdResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_887eb49d9c70e8b4, []int{11}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgClaimStreamByIdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgClaimStreamByIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgClaimStreamByIdResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -628,1081 +672,8128 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgClaimStreamByIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimStreamByIdResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgClaimStreamByIdResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
-
-func init() {
-	proto.RegisterType((*MsgCreateStream)(nil), "mainchain.stream.v1.MsgCreateStream")
-	proto.RegisterType((*MsgCreateStreamResponse)(nil), "mainchain.stream.v1.MsgCreateStreamResponse")
-	proto.RegisterType((*MsgClaimStream)(nil), "mainchain.stream.v1.MsgClaimStream")
-	proto.RegisterType((*MsgClaimStreamResponse)(nil), "mainchain.stream.v1.MsgClaimStreamResponse")
-	proto.RegisterType((*MsgTopUpDeposit)(nil), "mainchain.stream.v1.MsgTopUpDeposit")
-	proto.RegisterType((*MsgTopUpDepositResponse)(nil), "mainchain.stream.v1.MsgTopUpDepositResponse")
-	proto.RegisterType((*MsgUpdateFlowRate)(nil), "mainchain.stream.v1.MsgUpdateFlowRate")
-	proto.RegisterType((*MsgUpdateFlowRateResponse)(nil), "mainchain.stream.v1.MsgUpdateFlowRateResponse")
-	proto.RegisterType((*MsgCancelStream)(nil), "mainchain.stream.v1.MsgCancelStream")
-	proto.RegisterType((*MsgCancelStreamResponse)(nil), "mainchain.stream.v1.MsgCancelStreamResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "mainchain.stream.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "mainchain.stream.v1.MsgUpdateParamsResponse")
-}
-
-func init() { proto.RegisterFile("mainchain/stream/v1/tx.proto", fileDescriptor_887eb49d9c70e8b4) }
-
-var fileDescriptor_887eb49d9c70e8b4 = []byte{
-	// 931 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x31, 0x8f, 0x1b, 0x45,
-	0x14, 0xf6, 0xfa, 0x2e, 0x47, 0x6e, 0x72, 0xe7, 0xc3, 0x4b, 0x48, 0x6c, 0x07, 0xd9, 0xd6, 0x72,
-	0x42, 0xa7, 0x83, 0xec, 0xc6, 0x09, 0x20, 0x70, 0x81, 0x14, 0x5f, 0x64, 0x51, 0x60, 0x29, 0x72,
-	0x92, 0x26, 0xcd, 0x6a, 0xbc, 0x1e, 0xaf, 0x47, 0xda, 0xdd, 0x59, 0xcd, 0x8c, 0x4d, 0x4c, 0x05,
-	0x54, 0x88, 0x2a, 0x2d, 0x5d, 0x4a, 0xe8, 0xae, 0xa0, 0xa2, 0xa2, 0x8c, 0xa8, 0x22, 0x2a, 0x68,
-	0x02, 0xba, 0x43, 0x32, 0x35, 0xbf, 0x00, 0xcd, 0xce, 0xec, 0x7a, 0xd7, 0x67, 0x27, 0x9b, 0x26,
-	0xd2, 0x35, 0x96, 0xe7, 0xcd, 0xf7, 0xcd, 0xbc, 0xef, 0xbd, 0x37, 0xef, 0x2d, 0x78, 0xc7, 0x87,
-	0x38, 0x70, 0xc6, 0x10, 0x07, 0x16, 0xe3, 0x14, 0x41, 0xdf, 0x9a, 0xb6, 0x2c, 0xfe, 0xc8, 0x0c,
-	0x29, 0xe1, 0x44, 0x7f, 0x2b, 0xd9, 0x35, 0xe5, 0xae, 0x39, 0x6d, 0xd5, 0xea, 0x0e, 0x61, 0x3e,
-	0x61, 0xd6, 0x00, 0x32, 0x64, 0x4d, 0x5b, 0x03, 0xc4, 0x61, 0xcb, 0x72, 0x08, 0x0e, 0x24, 0xa9,
-	0x76, 0x55, 0xed, 0xfb, 0xcc, 0x15, 0x87, 0xf9, 0xcc, 0x55, 0x1b, 0x55, 0xb9, 0x61, 0x47, 0x2b,
-	0x4b, 0x2e, 0xd4, 0xd6, 0x65, 0x97, 0xb8, 0x44, 0xda, 0xc5, 0x3f, 0x65, 0x6d, 0xb8, 0x84, 0xb8,
-	0x1e, 0xb2, 0xa2, 0xd5, 0x60, 0x32, 0xb2, 0x38, 0xf6, 0x11, 0xe3, 0xd0, 0x0f, 0x15, 0xa0, 0x0c,
-	0x7d, 0x1c, 0x10, 0x2b, 0xfa, 0x55, 0xa6, 0xe6, 0x2a, 0x41, 0x21, 0xa4, 0xd0, 0x57, 0x77, 0x19,
-	0xdf, 0x14, 0xc1, 0x5e, 0x8f, 0xb9, 0x47, 0x14, 0x41, 0x8e, 0xee, 0x45, 0x18, 0xfd, 0x43, 0x70,
-	0x91, 0x22, 0x07, 0xe1, 0x29, 0xa2, 0x15, 0xad, 0xa9, 0x1d, 0x6c, 0x77, 0x2a, 0xbf, 0xff, 0x7c,
-	0xfd, 0xb2, 0xf2, 0xf1, 0xf6, 0x70, 0x48, 0x11, 0x63, 0xf7, 0x38, 0xc5, 0x81, 0xdb, 0x4f, 0x90,
-	0xfa, 0x0d, 0xb0, 0xc5, 0x50, 0x30, 0x44, 0xb4, 0x52, 0x7c, 0x09, 0x47, 0xe1, 0xf4, 0x4f, 0xc1,
-	0x1b, 0x43, 0x14, 0x12, 0x86, 0x79, 0x65, 0xa3, 0xa9, 0x1d, 0x5c, 0xba, 0x59, 0x35, 0x15, 0x5e,
-	0x44, 0xd3, 0x54, 0xd1, 0x34, 0x8f, 0x08, 0x0e, 0x3a, 0x9b, 0x4f, 0x9f, 0x37, 0x0a, 0xfd, 0x18,
-	0xaf, 0x5f, 0x03, 0xdb, 0x23, 0x8f, 0x7c, 0x69, 0x53, 0xc8, 0x51, 0x65, 0xb3, 0xa9, 0x1d, 0x6c,
-	0xf4, 0x2f, 0x0a, 0x43, 0x1f, 0x72, 0xd4, 0x36, 0xbf, 0x7b, 0xd2, 0x28, 0xfc, 0xfb, 0xa4, 0x51,
-	0xf8, 0x76, 0x7e, 0x7c, 0xa8, 0x2e, 0xfb, 0x7e, 0x7e, 0x7c, 0x78, 0x45, 0xc5, 0x60, 0x49, 0xaf,
-	0xf1, 0xa7, 0x06, 0xae, 0x2e, 0xd9, 0xfa, 0x88, 0x85, 0x24, 0x60, 0xe8, 0xbc, 0xc7, 0xc2, 0xf8,
-	0x49, 0x03, 0x25, 0xa1, 0xcd, 0x83, 0xd8, 0x57, 0xe9, 0x5d, 0x38, 0xa7, 0xe5, 0x74, 0x2e, 0x1d,
-	0x84, 0x62, 0xde, 0x20, 0xb4, 0xad, 0x74, 0x1a, 0x12, 0xb3, 0x48, 0xc4, 0xdb, 0xa9, 0x44, 0x2c,
-	0x1c, 0x33, 0x7e, 0x29, 0x82, 0x2b, 0x59, 0x53, 0x92, 0x86, 0x3b, 0x60, 0x97, 0x13, 0x0e, 0x3d,
-	0xdb, 0x11, 0x9b, 0x68, 0x18, 0xb9, 0x91, 0x23, 0x48, 0x3b, 0x11, 0xeb, 0x48, 0x92, 0xf4, 0x2e,
-	0x28, 0xc9, 0x9b, 0xed, 0x10, 0xce, 0x7c, 0x14, 0xe4, 0x8e, 0xf5, 0xae, 0xa4, 0xdd, 0x95, 0x2c,
-	0xe1, 0xcd, 0x14, 0x7a, 0x78, 0x08, 0x39, 0xa1, 0xf6, 0x08, 0xc9, 0xa8, 0xe7, 0xf1, 0x26, 0x61,
-	0x75, 0x11, 0xd2, 0xbf, 0x00, 0x65, 0x8a, 0xc4, 0x03, 0xc5, 0x81, 0x6b, 0xc7, 0xc9, 0xbf, 0x90,
-	0xef, 0xa4, 0x37, 0x13, 0xe6, 0x1d, 0x49, 0x34, 0xfe, 0xd1, 0xa2, 0x87, 0x7c, 0x9f, 0x84, 0x0f,
-	0x42, 0x65, 0x3b, 0x07, 0xc5, 0x9b, 0xe7, 0xad, 0xa6, 0x25, 0x19, 0x3f, 0x14, 0xa3, 0xb7, 0x9a,
-	0xb6, 0x25, 0x45, 0xd2, 0x05, 0x25, 0x75, 0xac, 0x0d, 0x7d, 0x32, 0x09, 0x78, 0xde, 0x2a, 0xd9,
-	0x55, 0xb4, 0xdb, 0x11, 0x4b, 0xff, 0x1c, 0xec, 0x39, 0x13, 0x4a, 0x51, 0xc0, 0xed, 0x57, 0x94,
-	0x55, 0x52, 0xbc, 0x38, 0x01, 0x1e, 0x28, 0xc7, 0x1e, 0x7d, 0x85, 0x28, 0xb1, 0x45, 0xcb, 0x56,
-	0xc5, 0x52, 0x33, 0x65, 0x3f, 0x37, 0xe3, 0x7e, 0x6e, 0xde, 0x8f, 0xfb, 0x79, 0x67, 0x5f, 0x1c,
-	0xf6, 0xdf, 0xf3, 0x46, 0x65, 0x06, 0x7d, 0xaf, 0x6d, 0x9c, 0x39, 0xc2, 0x78, 0xfc, 0x57, 0x43,
-	0xeb, 0xef, 0x29, 0xfb, 0x43, 0x44, 0x89, 0xe0, 0x1a, 0xbf, 0x69, 0xa0, 0xdc, 0x63, 0xee, 0x83,
-	0x70, 0x08, 0x39, 0xea, 0xaa, 0x0e, 0xf0, 0xda, 0x8a, 0x20, 0xd3, 0x86, 0x36, 0x96, 0x5a, 0xf2,
-	0x8d, 0x35, 0x69, 0xae, 0x2c, 0xd2, 0x9c, 0x75, 0xdb, 0xf8, 0x04, 0x54, 0xcf, 0x18, 0x93, 0x4c,
-	0x67, 0xee, 0x2a, 0x9e, 0x6d, 0x79, 0xd1, 0x48, 0x83, 0x81, 0x83, 0xbc, 0xd7, 0x3b, 0xd2, 0x72,
-	0x8d, 0x9e, 0x94, 0x5f, 0x46, 0x55, 0x4e, 0x9e, 0x94, 0x29, 0xd6, 0x68, 0xfc, 0x2a, 0x65, 0xc8,
-	0x08, 0xdc, 0x8d, 0x66, 0xb6, 0xfe, 0x31, 0xd8, 0x86, 0x13, 0x3e, 0x26, 0x14, 0xf3, 0xd9, 0x4b,
-	0x75, 0x2c, 0xa0, 0xfa, 0x67, 0x60, 0x4b, 0x4e, 0x7d, 0xf5, 0x22, 0xae, 0x99, 0x2b, 0xbe, 0x65,
-	0x4c, 0x79, 0x49, 0x67, 0x5b, 0x54, 0xdf, 0x8f, 0xf3, 0xe3, 0x43, 0xad, 0xaf, 0x58, 0xed, 0x8f,
-	0x84, 0x9c, 0xc5, 0x79, 0x42, 0x91, 0xb1, 0xf8, 0xb4, 0x78, 0x64, 0x2d, 0x67, 0x51, 0x9e, 0xa4,
-	0xd4, 0xa5, 0x4d, 0xb1, 0xba, 0x9b, 0xf3, 0x4d, 0xb0, 0xd1, 0x63, 0xae, 0x3e, 0x00, 0x3b, 0x99,
-	0x6f, 0x8f, 0xfd, 0x95, 0x9e, 0x2d, 0x4d, 0xe7, 0xda, 0x07, 0x79, 0x50, 0x49, 0xb5, 0xd8, 0xe0,
-	0x52, 0x7a, 0xfe, 0xbd, 0xbb, 0x96, 0xbc, 0x00, 0xd5, 0xde, 0xcf, 0x01, 0x4a, 0x2e, 0x18, 0x80,
-	0x9d, 0x4c, 0xdf, 0x5d, 0x2b, 0x22, 0x8d, 0x5a, 0x2f, 0x62, 0x65, 0x73, 0x1b, 0x83, 0xd2, 0xd2,
-	0xc3, 0x7e, 0x6f, 0x1d, 0x3f, 0x8b, 0xab, 0x99, 0xf9, 0x70, 0x69, 0x35, 0x99, 0xb7, 0xb3, 0x3e,
-	0x25, 0x29, 0xd4, 0x0b, 0x52, 0xb2, 0xa2, 0xb8, 0xc5, 0x1d, 0x99, 0xc2, 0xde, 0x7f, 0xb1, 0x8f,
-	0x12, 0xb5, 0xfe, 0x8e, 0x55, 0x25, 0x56, 0xbb, 0xf0, 0xb5, 0xa8, 0xe1, 0x4e, 0xef, 0xe9, 0x49,
-	0x5d, 0x7b, 0x76, 0x52, 0xd7, 0xfe, 0x3e, 0xa9, 0x6b, 0x8f, 0x4f, 0xeb, 0x85, 0x67, 0xa7, 0xf5,
-	0xc2, 0x1f, 0xa7, 0xf5, 0xc2, 0xc3, 0x5b, 0x2e, 0xe6, 0xe3, 0xc9, 0xc0, 0x74, 0x88, 0x6f, 0x4d,
-	0x02, 0x3c, 0xc2, 0x0e, 0xe4, 0x98, 0x04, 0xd7, 0xc5, 0x7a, 0x45, 0x75, 0xf3, 0x59, 0x88, 0xd8,
-	0x60, 0x2b, 0xea, 0xd7, 0xb7, 0xfe, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xc7, 0xcb, 0x2a, 0xbc, 0x2c,
-	0x0c, 0x00, 0x00,
+func (m *MsgClaimStreamByIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimStreamByIdResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgClaimStreamByIdResponse proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateStream defines a method to create a new stream
-	CreateStream(ctx context.Context, in *MsgCreateStream, opts ...grpc.CallOption) (*MsgCreateStreamResponse, error)
-	// ClaimStream defines a method for a receiver to claim from a stream using the sender and receiver
-	ClaimStream(ctx context.Context, in *MsgClaimStream, opts ...grpc.CallOption) (*MsgClaimStreamResponse, error)
-	// TopUpDeposit defines a method for senders to top up their streams
-	TopUpDeposit(ctx context.Context, in *MsgTopUpDeposit, opts ...grpc.CallOption) (*MsgTopUpDepositResponse, error)
-	// UpdateFlowRate defines a method to update a stream flow rate
-	UpdateFlowRate(ctx context.Context, in *MsgUpdateFlowRate, opts ...grpc.CallOption) (*MsgUpdateFlowRateResponse, error)
-	// CancelStream defines a method to cancel a stream
-	CancelStream(ctx context.Context, in *MsgCancelStream, opts ...grpc.CallOption) (*MsgCancelStreamResponse, error)
-	// UpdateParams defines an operation for updating the x/stream module
-	// parameters.
-	// Since: cosmos-sdk 0.47
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgClaimStreamByIdResponse) GetTotalClaimed() types.Coin {
+	if m != nil {
+		return m.TotalClaimed
+	}
+	return types.Coin{}
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgClaimStreamByIdResponse) GetStreamPayment() types.Coin {
+	if m != nil {
+		return m.StreamPayment
+	}
+	return types.Coin{}
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgClaimStreamByIdResponse) GetValidatorFee() types.Coin {
+	if m != nil {
+		return m.ValidatorFee
+	}
+	return types.Coin{}
 }
 
-func (c *msgClient) CreateStream(ctx context.Context, in *MsgCreateStream, opts ...grpc.CallOption) (*MsgCreateStreamResponse, error) {
-	out := new(MsgCreateStreamResponse)
-	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/CreateStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgClaimStreamByIdResponse) GetRemainingDeposit() types.Coin {
+	if m != nil {
+		return m.RemainingDeposit
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *msgClient) ClaimStream(ctx context.Context, in *MsgClaimStream, opts ...grpc.CallOption) (*MsgClaimStreamResponse, error) {
-	out := new(MsgClaimStreamResponse)
-	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/ClaimStream", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgClaimStreamByIdResponse) GetFeeCollectorAmount() types.Coin {
+	if m != nil {
+		return m.FeeCollectorAmount
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *msgClient) TopUpDeposit(ctx context.Context, in *MsgTopUpDeposit, opts ...grpc.CallOption) (*MsgTopUpDepositResponse, error) {
-	out := new(MsgTopUpDepositResponse)
-	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/TopUpDeposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgClaimStreamByIdResponse) GetCommunityPoolAmount() types.Coin {
+	if m != nil {
+		return m.CommunityPoolAmount
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *msgClient) UpdateFlowRate(ctx context.Context, in *MsgUpdateFlowRate, opts ...grpc.CallOption) (*MsgUpdateFlowRateResponse, error) {
-	out := new(MsgUpdateFlowRateResponse)
-	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/UpdateFlowRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgClaimStreamByIdResponse) GetBurnedAmount() types.Coin {
+	if m != nil {
+		return m.BurnedAmount
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *msgClient) CancelStream(ctx context.Context, in *MsgCancelStream, opts ...grpc.CallOption) (*MsgCancelStreamResponse, error) {
-	out := new(MsgCancelStreamResponse)
-	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/CancelStream", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgTopUpDepositById tops up deposits in an existing stream using the stream ID
+type MsgTopUpDepositById struct {
+	// sender is the wallet making the deposit
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// stream_id is the ID of the stream being topped up
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// deposit is the amount being sent to deposit for the stream
+	Deposit types.Coin `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTopUpDepositById) Reset()         { *m = MsgTopUpDepositById{} }
+func (m *MsgTopUpDepositById) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpDepositById) ProtoMessage()    {}
+func (*MsgTopUpDepositById) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{12}
+}
+func (m *MsgTopUpDepositById) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTopUpDepositById) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpDepositById.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateStream defines a method to create a new stream
-	CreateStream(context.Context, *MsgCreateStream) (*MsgCreateStreamResponse, error)
-	// ClaimStream defines a method for a receiver to claim from a stream using the sender and receiver
-	ClaimStream(context.Context, *MsgClaimStream) (*MsgClaimStreamResponse, error)
-	// TopUpDeposit defines a method for senders to top up their streams
-	TopUpDeposit(context.Context, *MsgTopUpDeposit) (*MsgTopUpDepositResponse, error)
-	// UpdateFlowRate defines a method to update a stream flow rate
-	UpdateFlowRate(context.Context, *MsgUpdateFlowRate) (*MsgUpdateFlowRateResponse, error)
-	// CancelStream defines a method to cancel a stream
-	CancelStream(context.Context, *MsgCancelStream) (*MsgCancelStreamResponse, error)
-	// UpdateParams defines an operation for updating the x/stream module
-	// parameters.
-	// Since: cosmos-sdk 0.47
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgTopUpDepositById) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpDepositById.Merge(m, src)
+}
+func (m *MsgTopUpDepositById) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTopUpDepositById) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpDepositById.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgTopUpDepositById proto.InternalMessageInfo
+
+// MsgTopUpDepositByIdResponse is the response for MsgTopUpDepositById
+type MsgTopUpDepositByIdResponse struct {
+	// deposit_amount is the amount deposited
+	DepositAmount types.Coin `protobuf:"bytes,1,opt,name=deposit_amount,json=depositAmount,proto3" json:"deposit_amount"`
+	// current_deposit is the total current deposit in the stream
+	CurrentDeposit types.Coin `protobuf:"bytes,2,opt,name=current_deposit,json=currentDeposit,proto3" json:"current_deposit"`
+	// deposit_zero_time is the timestamp for when the current deposited amount will run out
+	DepositZeroTime time.Time `protobuf:"bytes,3,opt,name=deposit_zero_time,json=depositZeroTime,proto3,stdtime" json:"deposit_zero_time" yaml:"deposit_zero_time"`
 }
 
-func (*UnimplementedMsgServer) CreateStream(ctx context.Context, req *MsgCreateStream) (*MsgCreateStreamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateStream not implemented")
+func (m *MsgTopUpDepositByIdResponse) Reset()         { *m = MsgTopUpDepositByIdResponse{} }
+func (m *MsgTopUpDepositByIdResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTopUpDepositByIdResponse) ProtoMessage()    {}
+func (*MsgTopUpDepositByIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{13}
 }
-func (*UnimplementedMsgServer) ClaimStream(ctx context.Context, req *MsgClaimStream) (*MsgClaimStreamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimStream not implemented")
+func (m *MsgTopUpDepositByIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) TopUpDeposit(ctx context.Context, req *MsgTopUpDeposit) (*MsgTopUpDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TopUpDeposit not implemented")
+func (m *MsgTopUpDepositByIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTopUpDepositByIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateFlowRate(ctx context.Context, req *MsgUpdateFlowRate) (*MsgUpdateFlowRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateFlowRate not implemented")
+func (m *MsgTopUpDepositByIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTopUpDepositByIdResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CancelStream(ctx context.Context, req *MsgCancelStream) (*MsgCancelStreamResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelStream not implemented")
+func (m *MsgTopUpDepositByIdResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgTopUpDepositByIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTopUpDepositByIdResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgTopUpDepositByIdResponse proto.InternalMessageInfo
 
-func _Msg_CreateStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateStream)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mainchain.stream.v1.Msg/CreateStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateStream(ctx, req.(*MsgCreateStream))
+func (m *MsgTopUpDepositByIdResponse) GetDepositAmount() types.Coin {
+	if m != nil {
+		return m.DepositAmount
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_ClaimStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimStream)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mainchain.stream.v1.Msg/ClaimStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimStream(ctx, req.(*MsgClaimStream))
+func (m *MsgTopUpDepositByIdResponse) GetCurrentDeposit() types.Coin {
+	if m != nil {
+		return m.CurrentDeposit
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_TopUpDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTopUpDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).TopUpDeposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mainchain.stream.v1.Msg/TopUpDeposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TopUpDeposit(ctx, req.(*MsgTopUpDeposit))
+func (m *MsgTopUpDepositByIdResponse) GetDepositZeroTime() time.Time {
+	if m != nil {
+		return m.DepositZeroTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Msg_UpdateFlowRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateFlowRate)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateFlowRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mainchain.stream.v1.Msg/UpdateFlowRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateFlowRate(ctx, req.(*MsgUpdateFlowRate))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgUpdateFlowRateById updates a stream's flow rate using the stream ID
+type MsgUpdateFlowRateById struct {
+	// sender is the wallet making the update
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// stream_id is the ID of the stream being updated
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// flow_rate is the rate of nund per second
+	FlowRate int64 `protobuf:"varint,3,opt,name=flow_rate,json=flowRate,proto3" json:"flow_rate,omitempty"`
 }
 
-func _Msg_CancelStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelStream)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelStream(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mainchain.stream.v1.Msg/CancelStream",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelStream(ctx, req.(*MsgCancelStream))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgUpdateFlowRateById) Reset()         { *m = MsgUpdateFlowRateById{} }
+func (m *MsgUpdateFlowRateById) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFlowRateById) ProtoMessage()    {}
+func (*MsgUpdateFlowRateById) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{14}
 }
-
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/mainchain.stream.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgUpdateFlowRateById) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFlowRateById) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFlowRateById.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "mainchain.stream.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateStream",
-			Handler:    _Msg_CreateStream_Handler,
-		},
-		{
-			MethodName: "ClaimStream",
-			Handler:    _Msg_ClaimStream_Handler,
-		},
-		{
-			MethodName: "TopUpDeposit",
-			Handler:    _Msg_TopUpDeposit_Handler,
-		},
-		{
-			MethodName: "UpdateFlowRate",
-			Handler:    _Msg_UpdateFlowRate_Handler,
-		},
-		{
-			MethodName: "CancelStream",
-			Handler:    _Msg_CancelStream_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "mainchain/stream/v1/tx.proto",
+func (m *MsgUpdateFlowRateById) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFlowRateById.Merge(m, src)
 }
-
-func (m *MsgCreateStream) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateFlowRateById) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFlowRateById) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFlowRateById.DiscardUnknown(m)
 }
 
-func (m *MsgCreateStream) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateFlowRateById proto.InternalMessageInfo
+
+// MsgUpdateFlowRateByIdResponse is the response for MsgUpdateFlowRateById
+type MsgUpdateFlowRateByIdResponse struct {
+	// flow_rate is the rate of nund per second
+	FlowRate int64 `protobuf:"varint,1,opt,name=flow_rate,json=flowRate,proto3" json:"flow_rate,omitempty"`
 }
 
-func (m *MsgCreateStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FlowRate != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FlowRate))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateFlowRateByIdResponse) Reset()         { *m = MsgUpdateFlowRateByIdResponse{} }
+func (m *MsgUpdateFlowRateByIdResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFlowRateByIdResponse) ProtoMessage()    {}
+func (*MsgUpdateFlowRateByIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{15}
+}
+func (m *MsgUpdateFlowRateByIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateFlowRateByIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateFlowRateByIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateFlowRateByIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFlowRateByIdResponse.Merge(m, src)
+}
+func (m *MsgUpdateFlowRateByIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateFlowRateByIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFlowRateByIdResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateStreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateFlowRateByIdResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateFlowRateByIdResponse) GetFlowRate() int64 {
+	if m != nil {
+		return m.FlowRate
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateStreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCancelStreamById cancels a stream using the stream ID
+type MsgCancelStreamById struct {
+	// sender is the wallet cancelling
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// stream_id is the ID of the stream being cancelled
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 }
 
-func (m *MsgCreateStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FlowRate != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FlowRate))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCancelStreamById) Reset()         { *m = MsgCancelStreamById{} }
+func (m *MsgCancelStreamById) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelStreamById) ProtoMessage()    {}
+func (*MsgCancelStreamById) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{16}
+}
+func (m *MsgCancelStreamById) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelStreamById) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelStreamById.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgClaimStream) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCancelStreamById) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelStreamById.Merge(m, src)
 }
-
-func (m *MsgClaimStream) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelStreamById) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgClaimStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgCancelStreamById) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelStreamById.DiscardUnknown(m)
 }
 
-func (m *MsgClaimStreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgCancelStreamById proto.InternalMessageInfo
 
-func (m *MsgClaimStreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCancelStreamByIdResponse is the response for MsgCancelStreamById
+type MsgCancelStreamByIdResponse struct {
 }
 
-func (m *MsgClaimStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.RemainingDeposit.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.ValidatorFee.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCancelStreamByIdResponse) Reset()         { *m = MsgCancelStreamByIdResponse{} }
+func (m *MsgCancelStreamByIdResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelStreamByIdResponse) ProtoMessage()    {}
+func (*MsgCancelStreamByIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{17}
+}
+func (m *MsgCancelStreamByIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelStreamByIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelStreamByIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.StreamPayment.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgCancelStreamByIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelStreamByIdResponse.Merge(m, src)
+}
+func (m *MsgCancelStreamByIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelStreamByIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelStreamByIdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelStreamByIdResponse proto.InternalMessageInfo
+
+// MsgClaimAllStreams claims pending payments from a batch of a receiver's streams
+type MsgClaimAllStreams struct {
+	// receiver is the wallet making the claim
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// senders is an optional list of senders to claim from. If empty, all the receiver's streams are claimed
+	Senders []string `protobuf:"bytes,2,rep,name=senders,proto3" json:"senders,omitempty"`
+	// max_streams is the maximum number of streams to process. Zero uses the default, and it is capped
+	// at MaxClaimAllStreams
+	MaxStreams uint64 `protobuf:"varint,3,opt,name=max_streams,json=maxStreams,proto3" json:"max_streams,omitempty"`
+	// start_key is the next_key returned by a previous MsgClaimAllStreams, to continue claiming from
+	StartKey []byte `protobuf:"bytes,4,opt,name=start_key,json=startKey,proto3" json:"start_key,omitempty"`
+}
+
+func (m *MsgClaimAllStreams) Reset()         { *m = MsgClaimAllStreams{} }
+func (m *MsgClaimAllStreams) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAllStreams) ProtoMessage()    {}
+func (*MsgClaimAllStreams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{18}
+}
+func (m *MsgClaimAllStreams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAllStreams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAllStreams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.TotalClaimed.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *MsgClaimAllStreams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAllStreams.Merge(m, src)
+}
+func (m *MsgClaimAllStreams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAllStreams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAllStreams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimAllStreams proto.InternalMessageInfo
+
+// StreamClaimResult is the result of claiming from a single stream in a MsgClaimAllStreams
+type StreamClaimResult struct {
+	// stream_id is the ID of the stream claimed from
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// sender is the stream's sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// total_claimed is the total value of the claim
+	TotalClaimed types.Coin `protobuf:"bytes,3,opt,name=total_claimed,json=totalClaimed,proto3" json:"total_claimed"`
+	// stream_payment is the amount received by the receiver wallet
+	StreamPayment types.Coin `protobuf:"bytes,4,opt,name=stream_payment,json=streamPayment,proto3" json:"stream_payment"`
+	// validator_fee is the amount sent to validators
+	ValidatorFee types.Coin `protobuf:"bytes,5,opt,name=validator_fee,json=validatorFee,proto3" json:"validator_fee"`
+	// remaining_deposit is the amount of deposit remaining in the stream
+	RemainingDeposit types.Coin `protobuf:"bytes,6,opt,name=remaining_deposit,json=remainingDeposit,proto3" json:"remaining_deposit"`
+	// fee_collector_amount is the part of the validator fee sent to the fee collector
+	FeeCollectorAmount types.Coin `protobuf:"bytes,7,opt,name=fee_collector_amount,json=feeCollectorAmount,proto3" json:"fee_collector_amount"`
+	// community_pool_amount is the part of the validator fee sent to the community pool
+	CommunityPoolAmount types.Coin `protobuf:"bytes,8,opt,name=community_pool_amount,json=communityPoolAmount,proto3" json:"community_pool_amount"`
+	// burned_amount is the part of the validator fee that was burned
+	BurnedAmount types.Coin `protobuf:"bytes,9,opt,name=burned_amount,json=burnedAmount,proto3" json:"burned_amount"`
+}
+
+func (m *StreamClaimResult) Reset()         { *m = StreamClaimResult{} }
+func (m *StreamClaimResult) String() string { return proto.CompactTextString(m) }
+func (*StreamClaimResult) ProtoMessage()    {}
+func (*StreamClaimResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{19}
+}
+func (m *StreamClaimResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamClaimResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamClaimResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
+}
+func (m *StreamClaimResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamClaimResult.Merge(m, src)
+}
+func (m *StreamClaimResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamClaimResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamClaimResult.DiscardUnknown(m)
 }
 
-func (m *MsgTopUpDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StreamClaimResult proto.InternalMessageInfo
+
+func (m *StreamClaimResult) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgTopUpDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamClaimResult) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgTopUpDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0xa
+func (m *StreamClaimResult) GetTotalClaimed() types.Coin {
+	if m != nil {
+		return m.TotalClaimed
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *MsgTopUpDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StreamClaimResult) GetStreamPayment() types.Coin {
+	if m != nil {
+		return m.StreamPayment
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgTopUpDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *StreamClaimResult) GetValidatorFee() types.Coin {
+	if m != nil {
+		return m.ValidatorFee
+	}
+	return types.Coin{}
 }
 
-func (m *MsgTopUpDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DepositZeroTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DepositZeroTime):])
-	if err8 != nil {
-		return 0, err8
-	}
-	i -= n8
-	i = encodeVarintTx(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.CurrentDeposit.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *StreamClaimResult) GetRemainingDeposit() types.Coin {
+	if m != nil {
+		return m.RemainingDeposit
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size, err := m.DepositAmount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return types.Coin{}
+}
+
+func (m *StreamClaimResult) GetFeeCollectorAmount() types.Coin {
+	if m != nil {
+		return m.FeeCollectorAmount
 	}
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *MsgUpdateFlowRate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *StreamClaimResult) GetCommunityPoolAmount() types.Coin {
+	if m != nil {
+		return m.CommunityPoolAmount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgUpdateFlowRate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateFlowRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FlowRate != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FlowRate))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0xa
+func (m *StreamClaimResult) GetBurnedAmount() types.Coin {
+	if m != nil {
+		return m.BurnedAmount
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *MsgUpdateFlowRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// MsgClaimAllStreamsResponse is the response for MsgClaimAllStreams
+type MsgClaimAllStreamsResponse struct {
+	// results are the per stream claim results
+	Results []StreamClaimResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	// total_claimed is the total value claimed from all streams
+	TotalClaimed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_claimed,json=totalClaimed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_claimed"`
+	// total_stream_payments is the total amount received by the receiver wallet
+	TotalStreamPayments github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_stream_payments,json=totalStreamPayments,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_stream_payments"`
+	// total_validator_fees is the total amount sent to validators
+	TotalValidatorFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_validator_fees,json=totalValidatorFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_validator_fees"`
+	// next_key is the start_key to use in a subsequent MsgClaimAllStreams to continue claiming. Empty
+	// if there are no more streams to process
+	NextKey []byte `protobuf:"bytes,5,opt,name=next_key,json=nextKey,proto3" json:"next_key,omitempty"`
+}
+
+func (m *MsgClaimAllStreamsResponse) Reset()         { *m = MsgClaimAllStreamsResponse{} }
+func (m *MsgClaimAllStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimAllStreamsResponse) ProtoMessage()    {}
+func (*MsgClaimAllStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{20}
+}
+func (m *MsgClaimAllStreamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimAllStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimAllStreamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateFlowRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimAllStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimAllStreamsResponse.Merge(m, src)
+}
+func (m *MsgClaimAllStreamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimAllStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimAllStreamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateFlowRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FlowRate != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.FlowRate))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_MsgClaimAllStreamsResponse proto.InternalMessageInfo
+
+func (m *MsgClaimAllStreamsResponse) GetResults() []StreamClaimResult {
+	if m != nil {
+		return m.Results
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgCancelStream) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimAllStreamsResponse) GetTotalClaimed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalClaimed
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCancelStream) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgClaimAllStreamsResponse) GetTotalStreamPayments() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalStreamPayments
+	}
+	return nil
 }
 
-func (m *MsgCancelStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgClaimAllStreamsResponse) GetTotalValidatorFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalValidatorFees
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgCancelStreamResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgClaimAllStreamsResponse) GetNextKey() []byte {
+	if m != nil {
+		return m.NextKey
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCancelStreamResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgTransferStreamReceiver transfers a stream to a new receiver. Any payments accrued up to the
+// transfer are claimed for the current receiver first
+type MsgTransferStreamReceiver struct {
+	// receiver is the stream's current receiver
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// stream_id is the ID of the stream being transferred
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// new_receiver is the wallet that will receive the stream's payments after the transfer
+	NewReceiver string `protobuf:"bytes,3,opt,name=new_receiver,json=newReceiver,proto3" json:"new_receiver,omitempty"`
 }
 
-func (m *MsgCancelStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgTransferStreamReceiver) Reset()         { *m = MsgTransferStreamReceiver{} }
+func (m *MsgTransferStreamReceiver) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferStreamReceiver) ProtoMessage()    {}
+func (*MsgTransferStreamReceiver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{21}
 }
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgTransferStreamReceiver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferStreamReceiver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferStreamReceiver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTransferStreamReceiver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferStreamReceiver.Merge(m, src)
+}
+func (m *MsgTransferStreamReceiver) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferStreamReceiver) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferStreamReceiver.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+var xxx_messageInfo_MsgTransferStreamReceiver proto.InternalMessageInfo
+
+// MsgTransferStreamReceiverResponse is the response for MsgTransferStreamReceiver
+type MsgTransferStreamReceiverResponse struct {
+	// total_claimed is the total value claimed for the previous receiver
+	TotalClaimed types.Coin `protobuf:"bytes,1,opt,name=total_claimed,json=totalClaimed,proto3" json:"total_claimed"`
+	// stream_payment is the amount received by the previous receiver
+	StreamPayment types.Coin `protobuf:"bytes,2,opt,name=stream_payment,json=streamPayment,proto3" json:"stream_payment"`
+	// validator_fee is the amount sent to validators
+	ValidatorFee types.Coin `protobuf:"bytes,3,opt,name=validator_fee,json=validatorFee,proto3" json:"validator_fee"`
+	// remaining_deposit is the amount of deposit remaining in the stream
+	RemainingDeposit types.Coin `protobuf:"bytes,4,opt,name=remaining_deposit,json=remainingDeposit,proto3" json:"remaining_deposit"`
+	// fee_collector_amount is the part of the validator fee sent to the fee collector
+	FeeCollectorAmount types.Coin `protobuf:"bytes,5,opt,name=fee_collector_amount,json=feeCollectorAmount,proto3" json:"fee_collector_amount"`
+	// community_pool_amount is the part of the validator fee sent to the community pool
+	CommunityPoolAmount types.Coin `protobuf:"bytes,6,opt,name=community_pool_amount,json=communityPoolAmount,proto3" json:"community_pool_amount"`
+	// burned_amount is the part of the validator fee that was burned
+	BurnedAmount types.Coin `protobuf:"bytes,7,opt,name=burned_amount,json=burnedAmount,proto3" json:"burned_amount"`
+}
+
+func (m *MsgTransferStreamReceiverResponse) Reset()         { *m = MsgTransferStreamReceiverResponse{} }
+func (m *MsgTransferStreamReceiverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferStreamReceiverResponse) ProtoMessage()    {}
+func (*MsgTransferStreamReceiverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{22}
+}
+func (m *MsgTransferStreamReceiverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferStreamReceiverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferStreamReceiverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgTransferStreamReceiverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferStreamReceiverResponse.Merge(m, src)
+}
+func (m *MsgTransferStreamReceiverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferStreamReceiverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferStreamReceiverResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTransferStreamReceiverResponse proto.InternalMessageInfo
+
+func (m *MsgTransferStreamReceiverResponse) GetTotalClaimed() types.Coin {
+	if m != nil {
+		return m.TotalClaimed
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgTransferStreamReceiverResponse) GetStreamPayment() types.Coin {
+	if m != nil {
+		return m.StreamPayment
+	}
+	return types.Coin{}
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgTransferStreamReceiverResponse) GetValidatorFee() types.Coin {
+	if m != nil {
+		return m.ValidatorFee
+	}
+	return types.Coin{}
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgTransferStreamReceiverResponse) GetRemainingDeposit() types.Coin {
+	if m != nil {
+		return m.RemainingDeposit
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return types.Coin{}
 }
-func (m *MsgCreateStream) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (m *MsgTransferStreamReceiverResponse) GetFeeCollectorAmount() types.Coin {
+	if m != nil {
+		return m.FeeCollectorAmount
 	}
-	l = m.Deposit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.FlowRate != 0 {
-		n += 1 + sovTx(uint64(m.FlowRate))
+	return types.Coin{}
+}
+
+func (m *MsgTransferStreamReceiverResponse) GetCommunityPoolAmount() types.Coin {
+	if m != nil {
+		return m.CommunityPoolAmount
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *MsgCreateStreamResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgTransferStreamReceiverResponse) GetBurnedAmount() types.Coin {
+	if m != nil {
+		return m.BurnedAmount
 	}
-	var l int
-	_ = l
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Deposit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.FlowRate != 0 {
-		n += 1 + sovTx(uint64(m.FlowRate))
-	}
-	return n
+	return types.Coin{}
 }
 
-func (m *MsgClaimStream) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+// MsgPauseStream pauses a stream. Payments accrued up to the pause can still be claimed, but nothing
+// accrues while the stream is paused
+type MsgPauseStream struct {
+	// sender is the wallet pausing the stream
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// stream_id is the ID of the stream being paused
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 }
 
-func (m *MsgClaimStreamResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPauseStream) Reset()         { *m = MsgPauseStream{} }
+func (m *MsgPauseStream) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseStream) ProtoMessage()    {}
+func (*MsgPauseStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{23}
+}
+func (m *MsgPauseStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.TotalClaimed.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.StreamPayment.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ValidatorFee.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.RemainingDeposit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgPauseStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseStream.Merge(m, src)
+}
+func (m *MsgPauseStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseStream.DiscardUnknown(m)
 }
 
-func (m *MsgTopUpDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Deposit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+var xxx_messageInfo_MsgPauseStream proto.InternalMessageInfo
+
+// MsgPauseStreamResponse is the response for MsgPauseStream
+type MsgPauseStreamResponse struct {
+	// paused_at is the timestamp the stream was paused at
+	PausedAt time.Time `protobuf:"bytes,1,opt,name=paused_at,json=pausedAt,proto3,stdtime" json:"paused_at" yaml:"paused_at"`
 }
 
-func (m *MsgTopUpDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPauseStreamResponse) Reset()         { *m = MsgPauseStreamResponse{} }
+func (m *MsgPauseStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseStreamResponse) ProtoMessage()    {}
+func (*MsgPauseStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{24}
+}
+func (m *MsgPauseStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.DepositAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.CurrentDeposit.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DepositZeroTime)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgPauseStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseStreamResponse.Merge(m, src)
+}
+func (m *MsgPauseStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseStreamResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateFlowRate) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.FlowRate != 0 {
-		n += 1 + sovTx(uint64(m.FlowRate))
+var xxx_messageInfo_MsgPauseStreamResponse proto.InternalMessageInfo
+
+func (m *MsgPauseStreamResponse) GetPausedAt() time.Time {
+	if m != nil {
+		return m.PausedAt
 	}
-	return n
+	return time.Time{}
 }
 
-func (m *MsgUpdateFlowRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FlowRate != 0 {
-		n += 1 + sovTx(uint64(m.FlowRate))
+// MsgResumeStream resumes a paused stream
+type MsgResumeStream struct {
+	// sender is the wallet resuming the stream
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// stream_id is the ID of the stream being resumed
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgResumeStream) Reset()         { *m = MsgResumeStream{} }
+func (m *MsgResumeStream) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeStream) ProtoMessage()    {}
+func (*MsgResumeStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{25}
+}
+func (m *MsgResumeStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgResumeStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeStream.Merge(m, src)
+}
+func (m *MsgResumeStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeStream.DiscardUnknown(m)
 }
 
-func (m *MsgCancelStream) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgResumeStream proto.InternalMessageInfo
+
+// MsgResumeStreamResponse is the response for MsgResumeStream
+type MsgResumeStreamResponse struct {
+	// deposit_zero_time is the timestamp for when the stream's deposit will now run out
+	DepositZeroTime time.Time `protobuf:"bytes,1,opt,name=deposit_zero_time,json=depositZeroTime,proto3,stdtime" json:"deposit_zero_time" yaml:"deposit_zero_time"`
+}
+
+func (m *MsgResumeStreamResponse) Reset()         { *m = MsgResumeStreamResponse{} }
+func (m *MsgResumeStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeStreamResponse) ProtoMessage()    {}
+func (*MsgResumeStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{26}
+}
+func (m *MsgResumeStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgResumeStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeStreamResponse.Merge(m, src)
+}
+func (m *MsgResumeStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeStreamResponse proto.InternalMessageInfo
+
+func (m *MsgResumeStreamResponse) GetDepositZeroTime() time.Time {
+	if m != nil {
+		return m.DepositZeroTime
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return time.Time{}
+}
+
+// MsgSetLowDepositThreshold sets the number of seconds of remaining deposit below which a stream_low_deposit
+// event is emitted for a stream
+type MsgSetLowDepositThreshold struct {
+	// sender is the wallet setting the threshold
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// stream_id is the ID of the stream
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// low_deposit_threshold is the threshold in seconds. Zero disables the alert
+	LowDepositThreshold uint64 `protobuf:"varint,3,opt,name=low_deposit_threshold,json=lowDepositThreshold,proto3" json:"low_deposit_threshold,omitempty"`
+}
+
+func (m *MsgSetLowDepositThreshold) Reset()         { *m = MsgSetLowDepositThreshold{} }
+func (m *MsgSetLowDepositThreshold) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLowDepositThreshold) ProtoMessage()    {}
+func (*MsgSetLowDepositThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{27}
+}
+func (m *MsgSetLowDepositThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLowDepositThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLowDepositThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetLowDepositThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLowDepositThreshold.Merge(m, src)
+}
+func (m *MsgSetLowDepositThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLowDepositThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLowDepositThreshold.DiscardUnknown(m)
 }
 
-func (m *MsgCancelStreamResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetLowDepositThreshold proto.InternalMessageInfo
+
+// MsgSetLowDepositThresholdResponse is the response for MsgSetLowDepositThreshold
+type MsgSetLowDepositThresholdResponse struct {
+}
+
+func (m *MsgSetLowDepositThresholdResponse) Reset()         { *m = MsgSetLowDepositThresholdResponse{} }
+func (m *MsgSetLowDepositThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetLowDepositThresholdResponse) ProtoMessage()    {}
+func (*MsgSetLowDepositThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{28}
+}
+func (m *MsgSetLowDepositThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetLowDepositThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetLowDepositThresholdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgSetLowDepositThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetLowDepositThresholdResponse.Merge(m, src)
+}
+func (m *MsgSetLowDepositThresholdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetLowDepositThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetLowDepositThresholdResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetLowDepositThresholdResponse proto.InternalMessageInfo
+
+// MsgRequestStreamTermination requests the termination of a stream, including streams that cannot be cancelled.
+// The stream continues to flow until the notice period has passed, after which it is settled automatically
+type MsgRequestStreamTermination struct {
+	// sender is the wallet requesting the termination
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// stream_id is the ID of the stream being terminated
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// notice_duration is the notice period in seconds. Must be at least the min_termination_notice param
+	NoticeDuration uint64 `protobuf:"varint,3,opt,name=notice_duration,json=noticeDuration,proto3" json:"notice_duration,omitempty"`
+}
+
+func (m *MsgRequestStreamTermination) Reset()         { *m = MsgRequestStreamTermination{} }
+func (m *MsgRequestStreamTermination) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestStreamTermination) ProtoMessage()    {}
+func (*MsgRequestStreamTermination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{29}
+}
+func (m *MsgRequestStreamTermination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestStreamTermination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestStreamTermination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgRequestStreamTermination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestStreamTermination.Merge(m, src)
+}
+func (m *MsgRequestStreamTermination) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestStreamTermination) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestStreamTermination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestStreamTermination proto.InternalMessageInfo
+
+// MsgRequestStreamTerminationResponse is the response for MsgRequestStreamTermination
+type MsgRequestStreamTerminationResponse struct {
+	// termination_time is the timestamp at which the stream will be terminated
+	TerminationTime time.Time `protobuf:"bytes,1,opt,name=termination_time,json=terminationTime,proto3,stdtime" json:"termination_time" yaml:"termination_time"`
+}
+
+func (m *MsgRequestStreamTerminationResponse) Reset()         { *m = MsgRequestStreamTerminationResponse{} }
+func (m *MsgRequestStreamTerminationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestStreamTerminationResponse) ProtoMessage()    {}
+func (*MsgRequestStreamTerminationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{30}
+}
+func (m *MsgRequestStreamTerminationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestStreamTerminationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestStreamTerminationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRequestStreamTerminationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestStreamTerminationResponse.Merge(m, src)
+}
+func (m *MsgRequestStreamTerminationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestStreamTerminationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestStreamTerminationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRequestStreamTerminationResponse proto.InternalMessageInfo
+
+func (m *MsgRequestStreamTerminationResponse) GetTerminationTime() time.Time {
+	if m != nil {
+		return m.TerminationTime
+	}
+	return time.Time{}
+}
+
+// MsgAcceptStreamTermination accepts the termination of a stream before its notice period has passed. Any payments
+// accrued are sent to the receiver, and the remaining deposit is returned to the sender
+type MsgAcceptStreamTermination struct {
+	// receiver is the wallet accepting the termination
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// stream_id is the ID of the stream being terminated
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgAcceptStreamTermination) Reset()         { *m = MsgAcceptStreamTermination{} }
+func (m *MsgAcceptStreamTermination) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptStreamTermination) ProtoMessage()    {}
+func (*MsgAcceptStreamTermination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{31}
+}
+func (m *MsgAcceptStreamTermination) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptStreamTermination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptStreamTermination.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptStreamTermination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptStreamTermination.Merge(m, src)
+}
+func (m *MsgAcceptStreamTermination) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptStreamTermination) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptStreamTermination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptStreamTermination proto.InternalMessageInfo
+
+// MsgAcceptStreamTerminationResponse is the response for MsgAcceptStreamTermination
+type MsgAcceptStreamTerminationResponse struct {
+	// refund_amount is the remaining deposit returned to the sender
+	RefundAmount types.Coin `protobuf:"bytes,1,opt,name=refund_amount,json=refundAmount,proto3" json:"refund_amount"`
+}
+
+func (m *MsgAcceptStreamTerminationResponse) Reset()         { *m = MsgAcceptStreamTerminationResponse{} }
+func (m *MsgAcceptStreamTerminationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptStreamTerminationResponse) ProtoMessage()    {}
+func (*MsgAcceptStreamTerminationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{32}
+}
+func (m *MsgAcceptStreamTerminationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptStreamTerminationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptStreamTerminationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptStreamTerminationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptStreamTerminationResponse.Merge(m, src)
+}
+func (m *MsgAcceptStreamTerminationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptStreamTerminationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptStreamTerminationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptStreamTerminationResponse proto.InternalMessageInfo
+
+func (m *MsgAcceptStreamTerminationResponse) GetRefundAmount() types.Coin {
+	if m != nil {
+		return m.RefundAmount
+	}
+	return types.Coin{}
+}
+
+// MsgAcceptPausableStream accepts that a pausable stream can be paused by its sender. Until the receiver has
+// accepted, the stream cannot be paused
+type MsgAcceptPausableStream struct {
+	// receiver is the wallet accepting that the stream can be paused
+	Receiver string `protobuf:"bytes,1,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// stream_id is the ID of the pausable stream
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgAcceptPausableStream) Reset()         { *m = MsgAcceptPausableStream{} }
+func (m *MsgAcceptPausableStream) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptPausableStream) ProtoMessage()    {}
+func (*MsgAcceptPausableStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{33}
+}
+func (m *MsgAcceptPausableStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptPausableStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptPausableStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptPausableStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptPausableStream.Merge(m, src)
+}
+func (m *MsgAcceptPausableStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptPausableStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptPausableStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptPausableStream proto.InternalMessageInfo
+
+// MsgAcceptPausableStreamResponse is the response for MsgAcceptPausableStream
+type MsgAcceptPausableStreamResponse struct {
+}
+
+func (m *MsgAcceptPausableStreamResponse) Reset()         { *m = MsgAcceptPausableStreamResponse{} }
+func (m *MsgAcceptPausableStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptPausableStreamResponse) ProtoMessage()    {}
+func (*MsgAcceptPausableStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{34}
+}
+func (m *MsgAcceptPausableStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptPausableStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptPausableStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptPausableStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptPausableStreamResponse.Merge(m, src)
+}
+func (m *MsgAcceptPausableStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptPausableStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptPausableStreamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptPausableStreamResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/stream parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{35}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+//
+// Since: cosmos-sdk 0.47
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_887eb49d9c70e8b4, []int{36}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateStream)(nil), "mainchain.stream.v1.MsgCreateStream")
+	proto.RegisterType((*MsgCreateStreamResponse)(nil), "mainchain.stream.v1.MsgCreateStreamResponse")
+	proto.RegisterType((*MsgClaimStream)(nil), "mainchain.stream.v1.MsgClaimStream")
+	proto.RegisterType((*MsgClaimStreamResponse)(nil), "mainchain.stream.v1.MsgClaimStreamResponse")
+	proto.RegisterType((*MsgTopUpDeposit)(nil), "mainchain.stream.v1.MsgTopUpDeposit")
+	proto.RegisterType((*MsgTopUpDepositResponse)(nil), "mainchain.stream.v1.MsgTopUpDepositResponse")
+	proto.RegisterType((*MsgUpdateFlowRate)(nil), "mainchain.stream.v1.MsgUpdateFlowRate")
+	proto.RegisterType((*MsgUpdateFlowRateResponse)(nil), "mainchain.stream.v1.MsgUpdateFlowRateResponse")
+	proto.RegisterType((*MsgCancelStream)(nil), "mainchain.stream.v1.MsgCancelStream")
+	proto.RegisterType((*MsgCancelStreamResponse)(nil), "mainchain.stream.v1.MsgCancelStreamResponse")
+	proto.RegisterType((*MsgClaimStreamById)(nil), "mainchain.stream.v1.MsgClaimStreamById")
+	proto.RegisterType((*MsgClaimStreamByIdResponse)(nil), "mainchain.stream.v1.MsgClaimStreamByIdResponse")
+	proto.RegisterType((*MsgTopUpDepositById)(nil), "mainchain.stream.v1.MsgTopUpDepositById")
+	proto.RegisterType((*MsgTopUpDepositByIdResponse)(nil), "mainchain.stream.v1.MsgTopUpDepositByIdResponse")
+	proto.RegisterType((*MsgUpdateFlowRateById)(nil), "mainchain.stream.v1.MsgUpdateFlowRateById")
+	proto.RegisterType((*MsgUpdateFlowRateByIdResponse)(nil), "mainchain.stream.v1.MsgUpdateFlowRateByIdResponse")
+	proto.RegisterType((*MsgCancelStreamById)(nil), "mainchain.stream.v1.MsgCancelStreamById")
+	proto.RegisterType((*MsgCancelStreamByIdResponse)(nil), "mainchain.stream.v1.MsgCancelStreamByIdResponse")
+	proto.RegisterType((*MsgClaimAllStreams)(nil), "mainchain.stream.v1.MsgClaimAllStreams")
+	proto.RegisterType((*StreamClaimResult)(nil), "mainchain.stream.v1.StreamClaimResult")
+	proto.RegisterType((*MsgClaimAllStreamsResponse)(nil), "mainchain.stream.v1.MsgClaimAllStreamsResponse")
+	proto.RegisterType((*MsgTransferStreamReceiver)(nil), "mainchain.stream.v1.MsgTransferStreamReceiver")
+	proto.RegisterType((*MsgTransferStreamReceiverResponse)(nil), "mainchain.stream.v1.MsgTransferStreamReceiverResponse")
+	proto.RegisterType((*MsgPauseStream)(nil), "mainchain.stream.v1.MsgPauseStream")
+	proto.RegisterType((*MsgPauseStreamResponse)(nil), "mainchain.stream.v1.MsgPauseStreamResponse")
+	proto.RegisterType((*MsgResumeStream)(nil), "mainchain.stream.v1.MsgResumeStream")
+	proto.RegisterType((*MsgResumeStreamResponse)(nil), "mainchain.stream.v1.MsgResumeStreamResponse")
+	proto.RegisterType((*MsgSetLowDepositThreshold)(nil), "mainchain.stream.v1.MsgSetLowDepositThreshold")
+	proto.RegisterType((*MsgSetLowDepositThresholdResponse)(nil), "mainchain.stream.v1.MsgSetLowDepositThresholdResponse")
+	proto.RegisterType((*MsgRequestStreamTermination)(nil), "mainchain.stream.v1.MsgRequestStreamTermination")
+	proto.RegisterType((*MsgRequestStreamTerminationResponse)(nil), "mainchain.stream.v1.MsgRequestStreamTerminationResponse")
+	proto.RegisterType((*MsgAcceptStreamTermination)(nil), "mainchain.stream.v1.MsgAcceptStreamTermination")
+	proto.RegisterType((*MsgAcceptStreamTerminationResponse)(nil), "mainchain.stream.v1.MsgAcceptStreamTerminationResponse")
+	proto.RegisterType((*MsgAcceptPausableStream)(nil), "mainchain.stream.v1.MsgAcceptPausableStream")
+	proto.RegisterType((*MsgAcceptPausableStreamResponse)(nil), "mainchain.stream.v1.MsgAcceptPausableStreamResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "mainchain.stream.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "mainchain.stream.v1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("mainchain/stream/v1/tx.proto", fileDescriptor_887eb49d9c70e8b4) }
+
+var fileDescriptor_887eb49d9c70e8b4 = []byte{
+	// 2089 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4f, 0x6c, 0xdb, 0xd6,
+	0x19, 0x37, 0x25, 0xd9, 0x92, 0x3e, 0x3b, 0x76, 0x4c, 0xdb, 0xb1, 0x4c, 0xa7, 0x96, 0xc3, 0x04,
+	0xad, 0x91, 0x3a, 0x52, 0xe2, 0x64, 0x59, 0xa6, 0x0c, 0x1b, 0x6c, 0x07, 0xc1, 0x8a, 0x36, 0x80,
+	0x27, 0x3b, 0x3b, 0xf4, 0x22, 0x50, 0xd2, 0x93, 0xcc, 0x86, 0xe4, 0xd3, 0xc8, 0x27, 0xc7, 0x2a,
+	0x30, 0xac, 0xd8, 0x80, 0x6d, 0xd8, 0x61, 0xc8, 0x6e, 0x1b, 0xb0, 0x01, 0x3d, 0x0d, 0xc5, 0xd0,
+	0x43, 0x80, 0xed, 0xb2, 0x53, 0x77, 0xec, 0xb1, 0xdb, 0x2e, 0x3d, 0x0c, 0x6d, 0x91, 0x0c, 0xc8,
+	0x2e, 0x3b, 0xb4, 0x87, 0x9d, 0x07, 0xbe, 0xf7, 0x48, 0x3d, 0x4a, 0xa4, 0x42, 0xb9, 0x74, 0xb1,
+	0x02, 0xb9, 0x24, 0xe2, 0x7b, 0xdf, 0xf7, 0xbd, 0xef, 0xdf, 0xfb, 0x7e, 0x1f, 0x3f, 0x1a, 0xce,
+	0x9b, 0x9a, 0x6e, 0x35, 0x0e, 0x35, 0xdd, 0x2a, 0x3b, 0xc4, 0x46, 0x9a, 0x59, 0x3e, 0xba, 0x56,
+	0x26, 0xc7, 0xa5, 0x8e, 0x8d, 0x09, 0x96, 0x17, 0xfc, 0xdd, 0x12, 0xdb, 0x2d, 0x1d, 0x5d, 0x53,
+	0xd6, 0x1a, 0xd8, 0x31, 0xb1, 0x53, 0xae, 0x6b, 0x0e, 0x2a, 0x1f, 0x5d, 0xab, 0x23, 0xa2, 0x5d,
+	0x2b, 0x37, 0xb0, 0x6e, 0x31, 0x26, 0x65, 0x99, 0xef, 0x9b, 0x4e, 0xdb, 0x15, 0x66, 0x3a, 0x6d,
+	0xbe, 0xb1, 0xc2, 0x36, 0x6a, 0xf4, 0xa9, 0xcc, 0x1e, 0xf8, 0xd6, 0x62, 0x1b, 0xb7, 0x31, 0x5b,
+	0x77, 0x7f, 0xf1, 0xd5, 0x62, 0x1b, 0xe3, 0xb6, 0x81, 0xca, 0xf4, 0xa9, 0xde, 0x6d, 0x95, 0x89,
+	0x6e, 0x22, 0x87, 0x68, 0x66, 0x87, 0x13, 0xcc, 0x6b, 0xa6, 0x6e, 0xe1, 0x32, 0xfd, 0x97, 0x2f,
+	0xad, 0x87, 0x19, 0xd4, 0xd1, 0x6c, 0xcd, 0xe4, 0x67, 0xa9, 0xff, 0x4c, 0xc3, 0xdc, 0x3d, 0xa7,
+	0xbd, 0x6b, 0x23, 0x8d, 0xa0, 0x7d, 0x4a, 0x23, 0xdf, 0x80, 0x9c, 0x8d, 0x1a, 0x48, 0x3f, 0x42,
+	0x76, 0x41, 0x5a, 0x97, 0x36, 0xf2, 0x3b, 0x85, 0xbf, 0xff, 0xf9, 0xca, 0x22, 0xd7, 0x71, 0xbb,
+	0xd9, 0xb4, 0x91, 0xe3, 0xec, 0x13, 0x5b, 0xb7, 0xda, 0x55, 0x9f, 0x52, 0xbe, 0x0a, 0x53, 0x0e,
+	0xb2, 0x9a, 0xc8, 0x2e, 0xa4, 0x9e, 0xc3, 0xc3, 0xe9, 0xe4, 0x6f, 0x41, 0xb6, 0x89, 0x3a, 0xd8,
+	0xd1, 0x49, 0x21, 0xbd, 0x2e, 0x6d, 0x4c, 0x6f, 0xad, 0x94, 0x38, 0xbd, 0xeb, 0xcd, 0x12, 0xf7,
+	0x66, 0x69, 0x17, 0xeb, 0xd6, 0x4e, 0xe6, 0xc3, 0x4f, 0x8a, 0x13, 0x55, 0x8f, 0x5e, 0x5e, 0x85,
+	0x7c, 0xcb, 0xc0, 0x0f, 0x6b, 0xb6, 0x46, 0x50, 0x21, 0xb3, 0x2e, 0x6d, 0xa4, 0xab, 0x39, 0x77,
+	0xa1, 0xaa, 0x11, 0x24, 0x7f, 0x17, 0xc0, 0x21, 0x9a, 0x4d, 0x6a, 0xae, 0x87, 0x0a, 0x93, 0x54,
+	0xb4, 0x52, 0x62, 0xee, 0x2b, 0x79, 0xee, 0x2b, 0x1d, 0x78, 0xee, 0xdb, 0xc9, 0x3c, 0xfa, 0xb4,
+	0x28, 0x55, 0xf3, 0x94, 0xc7, 0x5d, 0x75, 0x05, 0x34, 0x0c, 0xbd, 0xd5, 0x62, 0x02, 0xa6, 0xe2,
+	0x0a, 0xa0, 0x3c, 0x54, 0xc0, 0x6d, 0xc8, 0x21, 0xab, 0xc9, 0xd8, 0xb3, 0x31, 0xd9, 0xb3, 0xc8,
+	0x6a, 0x52, 0x66, 0x05, 0x72, 0x1d, 0xad, 0xeb, 0x68, 0x75, 0x03, 0x15, 0x72, 0xeb, 0xd2, 0x46,
+	0xae, 0xea, 0x3f, 0x57, 0x4a, 0xbf, 0x78, 0xb7, 0x38, 0xf1, 0xef, 0x77, 0x8b, 0x13, 0x3f, 0x79,
+	0xf6, 0xf8, 0x32, 0xf7, 0xe3, 0x2f, 0x9f, 0x3d, 0xbe, 0x7c, 0x8e, 0x87, 0x77, 0x20, 0x94, 0xea,
+	0x7f, 0x25, 0x58, 0x1e, 0x58, 0xab, 0x22, 0xa7, 0x83, 0x2d, 0x07, 0x7d, 0xed, 0xc3, 0xbc, 0x0a,
+	0x79, 0x66, 0x75, 0x4d, 0x6f, 0xd2, 0x28, 0x67, 0xaa, 0x39, 0xb6, 0xf0, 0x5a, 0x53, 0xfd, 0x40,
+	0x82, 0x59, 0xd7, 0x70, 0x43, 0xd3, 0x4d, 0x9e, 0xd6, 0x7d, 0xcd, 0xa5, 0x98, 0x9a, 0x8b, 0x1e,
+	0x4a, 0xc5, 0xf6, 0xd0, 0x22, 0x4c, 0x36, 0x91, 0x85, 0x4d, 0x6a, 0x6d, 0xbe, 0xca, 0x1e, 0x2a,
+	0x65, 0x31, 0x72, 0x3e, 0xb1, 0x1b, 0xbb, 0x25, 0x21, 0x76, 0x7d, 0x75, 0xd5, 0xf7, 0x32, 0x70,
+	0x2e, 0xb8, 0xe4, 0x47, 0xee, 0x0e, 0x9c, 0x21, 0x98, 0x68, 0x46, 0xad, 0xe1, 0x6e, 0xa2, 0x66,
+	0x21, 0x15, 0xcf, 0xaf, 0x33, 0x94, 0x6b, 0x97, 0x31, 0xc9, 0x77, 0x61, 0x96, 0xfb, 0xaf, 0xa3,
+	0xf5, 0x4c, 0x64, 0xc5, 0x0e, 0xcf, 0x19, 0xc6, 0xb6, 0xc7, 0xb8, 0x5c, 0x6d, 0x8e, 0x34, 0x43,
+	0x6f, 0x6a, 0x04, 0xdb, 0xb5, 0x16, 0x62, 0x81, 0x8a, 0xa3, 0x8d, 0xcf, 0x75, 0x17, 0x21, 0xf9,
+	0x0d, 0x98, 0xb7, 0x91, 0x5b, 0xae, 0x74, 0xab, 0x5d, 0xf3, 0xf2, 0x65, 0x32, 0x9e, 0xa4, 0xb3,
+	0x3e, 0xe7, 0x1d, 0x9e, 0x38, 0xdf, 0x87, 0xc5, 0x16, 0x42, 0xb5, 0x06, 0x36, 0x0c, 0xd4, 0x70,
+	0xf5, 0xd2, 0x4c, 0xdc, 0xb5, 0x48, 0x61, 0x2a, 0x9e, 0x40, 0xb9, 0x85, 0xd0, 0xae, 0xc7, 0xbb,
+	0x4d, 0x59, 0xe5, 0x7d, 0x58, 0x6a, 0x60, 0xd3, 0xec, 0x5a, 0x3a, 0xe9, 0xd5, 0x3a, 0x18, 0x1b,
+	0x9e, 0xcc, 0x6c, 0x3c, 0x99, 0x0b, 0x3e, 0xf7, 0x1e, 0xc6, 0x06, 0x17, 0x7a, 0x07, 0xce, 0xd4,
+	0xbb, 0xb6, 0x85, 0x9a, 0x9e, 0xb0, 0x5c, 0x4c, 0xdf, 0x31, 0x2e, 0x26, 0x45, 0xfd, 0x97, 0x44,
+	0x8b, 0xf8, 0x01, 0xee, 0xdc, 0xef, 0x78, 0x1e, 0xf8, 0xff, 0xbf, 0xdd, 0x71, 0x8a, 0x99, 0x68,
+	0x92, 0xfa, 0xdb, 0x14, 0x2c, 0x0f, 0xac, 0xf9, 0x57, 0xe2, 0x2e, 0xcc, 0x72, 0xb1, 0x9e, 0x27,
+	0x63, 0xde, 0x89, 0x33, 0x9c, 0x8d, 0x07, 0xe4, 0x7b, 0x30, 0xd7, 0xe8, 0xda, 0x36, 0xb2, 0x48,
+	0x6d, 0x4c, 0xb3, 0x66, 0x39, 0x9f, 0x17, 0x00, 0x03, 0xe6, 0x3d, 0x8d, 0xde, 0x46, 0x36, 0x66,
+	0x60, 0x90, 0x79, 0x2e, 0x18, 0x5c, 0x72, 0x85, 0x7d, 0xf1, 0x49, 0xb1, 0xd0, 0xd3, 0x4c, 0xa3,
+	0xa2, 0x0e, 0x89, 0x50, 0x29, 0x58, 0xcc, 0xf1, 0xf5, 0x37, 0x91, 0x8d, 0x5d, 0x5e, 0xf5, 0x33,
+	0x09, 0xe6, 0xef, 0x39, 0xed, 0xfb, 0x9d, 0xa6, 0x46, 0xd0, 0x5d, 0xaf, 0x44, 0x7e, 0x55, 0x49,
+	0x10, 0xa8, 0xd3, 0xe9, 0x81, 0x3a, 0xed, 0xd7, 0xc3, 0x8c, 0x58, 0x0f, 0xaf, 0x46, 0x04, 0xbf,
+	0xd0, 0x0f, 0x7e, 0xd0, 0x18, 0xf5, 0x16, 0xac, 0x0c, 0x2d, 0xfa, 0xf1, 0x0f, 0x68, 0x90, 0x0a,
+	0x6a, 0xa0, 0x7e, 0xc0, 0xee, 0xc7, 0xae, 0x66, 0x35, 0x90, 0xf1, 0x15, 0x37, 0x39, 0xe1, 0x68,
+	0x10, 0x07, 0xc7, 0x05, 0x6d, 0xd5, 0x15, 0x58, 0x1e, 0x58, 0xf2, 0x2c, 0x57, 0x7f, 0x27, 0x81,
+	0x1c, 0xc4, 0x89, 0x9d, 0xde, 0x6b, 0xcd, 0x13, 0xda, 0x17, 0xc0, 0xd4, 0x54, 0x10, 0x53, 0x2b,
+	0xd7, 0x23, 0x21, 0x6c, 0x25, 0x14, 0xc2, 0x5c, 0x3d, 0xd4, 0xf7, 0x33, 0xa0, 0x0c, 0x2f, 0x47,
+	0x43, 0x99, 0x94, 0x0c, 0x94, 0xa5, 0x92, 0x81, 0xb2, 0x74, 0x62, 0x50, 0x96, 0x49, 0x1a, 0xca,
+	0x26, 0x4f, 0x01, 0xca, 0xa6, 0x92, 0x84, 0xb2, 0xec, 0x49, 0xa0, 0xec, 0x1f, 0x12, 0x2c, 0x0c,
+	0xd4, 0x78, 0x9a, 0xce, 0xe3, 0x37, 0x6f, 0xa3, 0x52, 0xf9, 0xcb, 0xa0, 0xd6, 0x56, 0xc4, 0xd5,
+	0x55, 0xc2, 0x51, 0x8b, 0x5e, 0x82, 0xdf, 0xa7, 0x60, 0x35, 0x64, 0x7d, 0x04, 0x7a, 0x49, 0x49,
+	0xa1, 0x57, 0x2a, 0x41, 0xf4, 0x4a, 0x9f, 0x16, 0x7a, 0xfd, 0x45, 0x82, 0xa5, 0xa1, 0xda, 0x7e,
+	0x1a, 0x71, 0x1f, 0x05, 0x54, 0x95, 0x1b, 0x11, 0x91, 0x3d, 0x1f, 0x05, 0x49, 0x34, 0xb6, 0xdf,
+	0x86, 0x97, 0x42, 0x37, 0xc2, 0xa1, 0x49, 0x1a, 0x80, 0xa6, 0xdf, 0xb0, 0x7c, 0x17, 0x2b, 0xfb,
+	0x29, 0xd8, 0x1d, 0x27, 0x69, 0x07, 0x55, 0x50, 0x5f, 0x82, 0xd5, 0x90, 0x65, 0x1f, 0x77, 0x3e,
+	0x17, 0x70, 0x67, 0xdb, 0xe0, 0x14, 0xce, 0x09, 0x71, 0x67, 0x0b, 0xb2, 0x4c, 0x29, 0xa7, 0x90,
+	0x5a, 0x4f, 0x8f, 0x64, 0xf2, 0x08, 0xe5, 0x22, 0x4c, 0x9b, 0xda, 0x71, 0x8d, 0x59, 0xe0, 0xd0,
+	0x68, 0x66, 0xaa, 0x60, 0x6a, 0xc7, 0x9e, 0x2a, 0xd4, 0x23, 0xee, 0x1c, 0xe0, 0x01, 0xea, 0xd1,
+	0xfa, 0x3b, 0xe3, 0x7a, 0x44, 0xb3, 0xc9, 0xeb, 0xa8, 0x37, 0x06, 0x98, 0xf5, 0x8d, 0x53, 0xbf,
+	0xc8, 0xc0, 0x3c, 0xfb, 0x4d, 0x77, 0xaa, 0xc8, 0xe9, 0x1a, 0x24, 0xe8, 0x79, 0x69, 0x20, 0xe3,
+	0xc6, 0xef, 0x18, 0x86, 0x20, 0x31, 0x9d, 0x0c, 0x24, 0x66, 0x92, 0x81, 0xc4, 0xc9, 0xc4, 0x20,
+	0x71, 0x2a, 0x69, 0x48, 0xcc, 0x9e, 0x02, 0x24, 0xe6, 0x92, 0x84, 0xc4, 0xfc, 0x49, 0x20, 0xf1,
+	0xf3, 0x34, 0x28, 0xc3, 0xb9, 0x28, 0x60, 0x47, 0xd6, 0xa6, 0x79, 0xe8, 0x14, 0xa4, 0xf5, 0xf4,
+	0xc6, 0xf4, 0xd6, 0xcb, 0xa5, 0x90, 0x41, 0x65, 0x69, 0x28, 0x6d, 0x3d, 0x5c, 0xe3, 0xcc, 0x72,
+	0x67, 0x78, 0xa8, 0x90, 0x1e, 0xad, 0xec, 0x55, 0x57, 0xc0, 0x1f, 0x3f, 0x2d, 0x6e, 0xb4, 0x75,
+	0x72, 0xd8, 0xad, 0x97, 0x1a, 0xd8, 0xe4, 0x83, 0x4c, 0xfe, 0xdf, 0x15, 0xa7, 0xf9, 0xa0, 0x4c,
+	0x7a, 0x1d, 0xe4, 0x50, 0x06, 0x67, 0x20, 0x45, 0x7f, 0x0c, 0x4b, 0xec, 0xc4, 0x60, 0xa2, 0xba,
+	0x57, 0x39, 0xf1, 0x93, 0x17, 0xe8, 0x49, 0xfb, 0x62, 0x6a, 0x3b, 0xf2, 0x8f, 0x60, 0x91, 0x29,
+	0x10, 0xc8, 0x70, 0xa7, 0x90, 0x49, 0xfe, 0x7c, 0x99, 0x1e, 0xf4, 0x03, 0xe1, 0x4e, 0x38, 0xf2,
+	0x0a, 0xe4, 0x2c, 0x74, 0xcc, 0xca, 0xd3, 0x24, 0x2d, 0x4f, 0x59, 0xf7, 0xf9, 0x75, 0xd4, 0x53,
+	0x9f, 0x49, 0xf4, 0x65, 0xe7, 0xc0, 0xd6, 0x2c, 0xa7, 0x85, 0x6c, 0xaf, 0xe5, 0xe7, 0xd5, 0x32,
+	0xf9, 0xde, 0x5e, 0xbe, 0x0d, 0x33, 0x16, 0x7a, 0x58, 0xf3, 0xc5, 0xa6, 0x9f, 0x23, 0x76, 0xda,
+	0x42, 0x0f, 0x3d, 0x7d, 0x2a, 0x95, 0xc8, 0x5a, 0xba, 0x2e, 0x34, 0x45, 0xa1, 0xb6, 0xa8, 0x7f,
+	0xca, 0xc0, 0x85, 0xc8, 0xdd, 0x17, 0xaf, 0x09, 0x2f, 0x5e, 0x13, 0x42, 0x6b, 0xe2, 0xaf, 0xd8,
+	0x78, 0x77, 0x4f, 0xeb, 0x3a, 0xe8, 0xc4, 0xe3, 0xdd, 0x91, 0x1d, 0xd3, 0x95, 0x88, 0x8e, 0x49,
+	0x98, 0xd6, 0x0a, 0xa7, 0xab, 0x18, 0xce, 0x05, 0x57, 0xfc, 0xd4, 0xbd, 0x0f, 0x79, 0x77, 0x7c,
+	0xef, 0x1a, 0xec, 0xb5, 0xf5, 0xa3, 0x3a, 0xe8, 0xf3, 0xbc, 0x83, 0x3e, 0xcb, 0x3a, 0x68, 0x9f,
+	0x95, 0x75, 0xce, 0x39, 0xf6, 0xbc, 0x4d, 0xd4, 0x47, 0x6c, 0xa6, 0xe1, 0xd6, 0x72, 0xf3, 0x94,
+	0x5c, 0x10, 0x63, 0x48, 0x21, 0x1e, 0xaf, 0xfe, 0x9c, 0x7d, 0x6c, 0x10, 0xd7, 0x7c, 0x2f, 0x84,
+	0xbe, 0x4f, 0x48, 0xa7, 0xf5, 0x3e, 0xf1, 0x31, 0x2b, 0x9f, 0xfb, 0x88, 0xbc, 0x81, 0x1f, 0xf2,
+	0x1b, 0x72, 0x70, 0x68, 0x23, 0xe7, 0x10, 0x1b, 0x89, 0xbf, 0x53, 0x6c, 0xc1, 0x92, 0xdb, 0xde,
+	0x7b, 0xba, 0x11, 0xef, 0x1c, 0xde, 0x91, 0x2e, 0x18, 0xc3, 0x2a, 0x54, 0x6e, 0x45, 0xb8, 0x56,
+	0xa8, 0x97, 0xe1, 0xca, 0xab, 0x17, 0xe1, 0x42, 0xe4, 0xa6, 0xdf, 0x9b, 0xff, 0x4d, 0xa2, 0xbd,
+	0x7b, 0x15, 0xfd, 0xb0, 0x8b, 0x1c, 0xc2, 0x42, 0x71, 0x80, 0x6c, 0x53, 0xb7, 0x34, 0xa2, 0x63,
+	0x2b, 0x69, 0x0f, 0xbc, 0x02, 0x73, 0x16, 0x26, 0x7a, 0x03, 0xd5, 0x9a, 0x5d, 0x9b, 0x9e, 0xc0,
+	0x6d, 0x9f, 0x65, 0xcb, 0x77, 0xf8, 0x6a, 0x10, 0x28, 0x04, 0xb3, 0x55, 0x31, 0xa3, 0xc2, 0x75,
+	0x56, 0x7f, 0x2d, 0xc1, 0xc5, 0x11, 0xfb, 0x7e, 0xa6, 0xbd, 0x05, 0x67, 0x49, 0x7f, 0x39, 0x6e,
+	0xa2, 0x5d, 0xe4, 0x89, 0xb6, 0xcc, 0x12, 0x6d, 0x50, 0x02, 0xcf, 0x33, 0x61, 0x99, 0xe6, 0xd9,
+	0xfb, 0x12, 0x6d, 0xcd, 0xb6, 0x1b, 0x0d, 0xd4, 0x09, 0x71, 0xf3, 0x29, 0xcc, 0xe0, 0x6e, 0x47,
+	0x42, 0xed, 0x85, 0xbe, 0x0f, 0x23, 0xf4, 0x51, 0xdf, 0x02, 0x35, 0x7a, 0x57, 0xc4, 0x5a, 0x1b,
+	0xb5, 0xba, 0x56, 0x73, 0xcc, 0x59, 0xc4, 0x0c, 0xe3, 0xe2, 0x15, 0xfa, 0x0f, 0xac, 0x18, 0xb0,
+	0xc3, 0xf6, 0xf8, 0xf7, 0xcb, 0x2f, 0x35, 0x7b, 0x1d, 0xe9, 0x97, 0x5b, 0x91, 0x7e, 0x59, 0x1b,
+	0xf4, 0x4b, 0x50, 0x19, 0xf5, 0x02, 0x14, 0x23, 0xb6, 0xfc, 0xeb, 0xf4, 0x57, 0x56, 0x6b, 0xd9,
+	0x3b, 0xfe, 0x1e, 0xfd, 0x7c, 0x2e, 0xdf, 0x84, 0xbc, 0xd6, 0x25, 0x87, 0xd8, 0xd6, 0x49, 0xef,
+	0xb9, 0x46, 0xf4, 0x49, 0xe5, 0xef, 0xc0, 0x14, 0xfb, 0x00, 0xcf, 0x7b, 0x8f, 0xd5, 0xd0, 0x6e,
+	0x9d, 0x1d, 0xb2, 0x93, 0x77, 0x1d, 0xfb, 0xde, 0xb3, 0xc7, 0x97, 0xa5, 0x2a, 0xe7, 0xaa, 0x7c,
+	0xc3, 0x35, 0xb0, 0x2f, 0x8f, 0xde, 0x9e, 0xfe, 0x57, 0xfe, 0xe3, 0xf2, 0xe0, 0xac, 0x82, 0x49,
+	0xe2, 0x03, 0x64, 0x71, 0xc9, 0xb3, 0x6e, 0xeb, 0x3f, 0x73, 0x90, 0xbe, 0xe7, 0xb4, 0xe5, 0x3a,
+	0xcc, 0x04, 0xfe, 0x0c, 0xe0, 0x52, 0xa8, 0x66, 0x03, 0x5f, 0x93, 0x95, 0xcd, 0x38, 0x54, 0x7e,
+	0x6e, 0xd5, 0x60, 0x5a, 0xfc, 0x24, 0x7b, 0x31, 0x92, 0xb9, 0x4f, 0xa4, 0xbc, 0x1a, 0x83, 0xc8,
+	0x3f, 0xa0, 0x0e, 0x33, 0x81, 0xcf, 0x60, 0x91, 0x46, 0x88, 0x54, 0xca, 0x66, 0x1c, 0x2a, 0xff,
+	0x8c, 0x43, 0x98, 0x1d, 0xf8, 0xce, 0xf2, 0x72, 0x14, 0x7f, 0x90, 0x4e, 0x29, 0xc5, 0xa3, 0x13,
+	0xad, 0x09, 0x7c, 0xb4, 0x88, 0x0e, 0x89, 0x40, 0xa5, 0x6c, 0xc6, 0xa1, 0xf2, 0xcf, 0x78, 0x00,
+	0x73, 0x83, 0xdf, 0x0e, 0x5e, 0x89, 0xe1, 0x71, 0x97, 0x50, 0x29, 0xc7, 0x24, 0xf4, 0x0f, 0xb3,
+	0xe0, 0xec, 0xd0, 0x68, 0x77, 0x23, 0x8e, 0xf3, 0xe9, 0x71, 0x57, 0xe3, 0x52, 0xfa, 0xe7, 0x11,
+	0x90, 0x43, 0x86, 0x8a, 0x97, 0xe3, 0x85, 0x81, 0x9e, 0xb9, 0x15, 0x9f, 0x56, 0xb4, 0x72, 0x68,
+	0xa0, 0xb7, 0x11, 0x27, 0x28, 0xa3, 0xad, 0x8c, 0x1a, 0xc5, 0xf9, 0x21, 0x14, 0xc6, 0x70, 0xa3,
+	0x43, 0xd8, 0x27, 0x54, 0xca, 0x31, 0x09, 0xfd, 0xc3, 0xde, 0x91, 0xe0, 0x5c, 0xc4, 0x7b, 0x69,
+	0x64, 0x7a, 0x87, 0xd3, 0x2b, 0x37, 0xc7, 0xa3, 0x17, 0xab, 0x88, 0xd8, 0xf9, 0x47, 0x56, 0x11,
+	0x81, 0x48, 0x79, 0x35, 0x06, 0x91, 0x78, 0xef, 0x02, 0x8d, 0x75, 0xe4, 0xbd, 0x13, 0xa9, 0x94,
+	0xcd, 0x38, 0x54, 0x01, 0x3f, 0x46, 0x34, 0xa8, 0x91, 0x7e, 0x0c, 0xa7, 0x57, 0x6e, 0x8e, 0x47,
+	0xef, 0xab, 0xf0, 0x33, 0x09, 0x0a, 0xd1, 0x3d, 0x62, 0xb4, 0x35, 0xe1, 0x1c, 0xca, 0xad, 0x71,
+	0x39, 0x7c, 0x45, 0x7e, 0x2a, 0xc1, 0x72, 0x54, 0x13, 0x15, 0x99, 0xa0, 0x11, 0x0c, 0xca, 0x37,
+	0xc7, 0x64, 0xf0, 0xb5, 0x78, 0x1b, 0x16, 0x43, 0xdb, 0x95, 0xcd, 0xd1, 0x02, 0x83, 0xd4, 0xca,
+	0x8d, 0x71, 0xa8, 0xc5, 0x8c, 0x0b, 0xb4, 0x17, 0x97, 0x46, 0x97, 0x1d, 0x46, 0xa5, 0x6c, 0xc6,
+	0xa1, 0xf2, 0xce, 0x50, 0x26, 0xdf, 0x71, 0x3b, 0x89, 0x9d, 0x7b, 0x1f, 0x3e, 0x59, 0x93, 0x3e,
+	0x7a, 0xb2, 0x26, 0x7d, 0xf6, 0x64, 0x4d, 0x7a, 0xf4, 0x74, 0x6d, 0xe2, 0xa3, 0xa7, 0x6b, 0x13,
+	0x1f, 0x3f, 0x5d, 0x9b, 0x78, 0xf3, 0xba, 0x30, 0xce, 0xea, 0x5a, 0x7a, 0x4b, 0x6f, 0x50, 0x07,
+	0x5d, 0x71, 0x9f, 0x43, 0x7a, 0x0c, 0x3a, 0xdf, 0xaa, 0x4f, 0xd1, 0x6e, 0xfa, 0xfa, 0xff, 0x06,
+	0x00, 0x79, 0xfe, 0x40, 0xf1, 0x3d, 0x29, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateStream defines a method to create a new stream
+	CreateStream(ctx context.Context, in *MsgCreateStream, opts ...grpc.CallOption) (*MsgCreateStreamResponse, error)
+	// ClaimStream defines a method for a receiver to claim from a stream using the sender and receiver
+	ClaimStream(ctx context.Context, in *MsgClaimStream, opts ...grpc.CallOption) (*MsgClaimStreamResponse, error)
+	// TopUpDeposit defines a method for senders to top up their streams
+	TopUpDeposit(ctx context.Context, in *MsgTopUpDeposit, opts ...grpc.CallOption) (*MsgTopUpDepositResponse, error)
+	// UpdateFlowRate defines a method to update a stream flow rate
+	UpdateFlowRate(ctx context.Context, in *MsgUpdateFlowRate, opts ...grpc.CallOption) (*MsgUpdateFlowRateResponse, error)
+	// CancelStream defines a method to cancel a stream
+	CancelStream(ctx context.Context, in *MsgCancelStream, opts ...grpc.CallOption) (*MsgCancelStreamResponse, error)
+	// ClaimStreamById defines a method for a receiver to claim from a stream using the stream ID
+	ClaimStreamById(ctx context.Context, in *MsgClaimStreamById, opts ...grpc.CallOption) (*MsgClaimStreamByIdResponse, error)
+	// TopUpDepositById defines a method for senders to top up a stream using the stream ID
+	TopUpDepositById(ctx context.Context, in *MsgTopUpDepositById, opts ...grpc.CallOption) (*MsgTopUpDepositByIdResponse, error)
+	// UpdateFlowRateById defines a method to update a stream flow rate using the stream ID
+	UpdateFlowRateById(ctx context.Context, in *MsgUpdateFlowRateById, opts ...grpc.CallOption) (*MsgUpdateFlowRateByIdResponse, error)
+	// CancelStreamById defines a method to cancel a stream using the stream ID
+	CancelStreamById(ctx context.Context, in *MsgCancelStreamById, opts ...grpc.CallOption) (*MsgCancelStreamByIdResponse, error)
+	// ClaimAllStreams defines a method for a receiver to claim from all, or a batch of, their streams
+	ClaimAllStreams(ctx context.Context, in *MsgClaimAllStreams, opts ...grpc.CallOption) (*MsgClaimAllStreamsResponse, error)
+	// TransferStreamReceiver defines a method for a receiver to transfer a stream to a new receiver
+	TransferStreamReceiver(ctx context.Context, in *MsgTransferStreamReceiver, opts ...grpc.CallOption) (*MsgTransferStreamReceiverResponse, error)
+	// PauseStream defines a method for a sender to pause a pausable stream
+	PauseStream(ctx context.Context, in *MsgPauseStream, opts ...grpc.CallOption) (*MsgPauseStreamResponse, error)
+	// ResumeStream defines a method for a sender to resume a paused stream
+	ResumeStream(ctx context.Context, in *MsgResumeStream, opts ...grpc.CallOption) (*MsgResumeStreamResponse, error)
+	// SetLowDepositThreshold defines a method for a sender to set a stream's low deposit alert threshold
+	SetLowDepositThreshold(ctx context.Context, in *MsgSetLowDepositThreshold, opts ...grpc.CallOption) (*MsgSetLowDepositThresholdResponse, error)
+	// RequestStreamTermination defines a method for a sender to request the termination of a stream after a notice period
+	RequestStreamTermination(ctx context.Context, in *MsgRequestStreamTermination, opts ...grpc.CallOption) (*MsgRequestStreamTerminationResponse, error)
+	// AcceptStreamTermination defines a method for a receiver to accept the early termination of a stream
+	AcceptStreamTermination(ctx context.Context, in *MsgAcceptStreamTermination, opts ...grpc.CallOption) (*MsgAcceptStreamTerminationResponse, error)
+	// AcceptPausableStream defines a method for a receiver to accept that a pausable stream can be paused by its sender
+	AcceptPausableStream(ctx context.Context, in *MsgAcceptPausableStream, opts ...grpc.CallOption) (*MsgAcceptPausableStreamResponse, error)
+	// UpdateParams defines an operation for updating the x/stream module
+	// parameters.
+	// Since: cosmos-sdk 0.47
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateStream(ctx context.Context, in *MsgCreateStream, opts ...grpc.CallOption) (*MsgCreateStreamResponse, error) {
+	out := new(MsgCreateStreamResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/CreateStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimStream(ctx context.Context, in *MsgClaimStream, opts ...grpc.CallOption) (*MsgClaimStreamResponse, error) {
+	out := new(MsgClaimStreamResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/ClaimStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TopUpDeposit(ctx context.Context, in *MsgTopUpDeposit, opts ...grpc.CallOption) (*MsgTopUpDepositResponse, error) {
+	out := new(MsgTopUpDepositResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/TopUpDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFlowRate(ctx context.Context, in *MsgUpdateFlowRate, opts ...grpc.CallOption) (*MsgUpdateFlowRateResponse, error) {
+	out := new(MsgUpdateFlowRateResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/UpdateFlowRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelStream(ctx context.Context, in *MsgCancelStream, opts ...grpc.CallOption) (*MsgCancelStreamResponse, error) {
+	out := new(MsgCancelStreamResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/CancelStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimStreamById(ctx context.Context, in *MsgClaimStreamById, opts ...grpc.CallOption) (*MsgClaimStreamByIdResponse, error) {
+	out := new(MsgClaimStreamByIdResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/ClaimStreamById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TopUpDepositById(ctx context.Context, in *MsgTopUpDepositById, opts ...grpc.CallOption) (*MsgTopUpDepositByIdResponse, error) {
+	out := new(MsgTopUpDepositByIdResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/TopUpDepositById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFlowRateById(ctx context.Context, in *MsgUpdateFlowRateById, opts ...grpc.CallOption) (*MsgUpdateFlowRateByIdResponse, error) {
+	out := new(MsgUpdateFlowRateByIdResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/UpdateFlowRateById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelStreamById(ctx context.Context, in *MsgCancelStreamById, opts ...grpc.CallOption) (*MsgCancelStreamByIdResponse, error) {
+	out := new(MsgCancelStreamByIdResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/CancelStreamById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimAllStreams(ctx context.Context, in *MsgClaimAllStreams, opts ...grpc.CallOption) (*MsgClaimAllStreamsResponse, error) {
+	out := new(MsgClaimAllStreamsResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/ClaimAllStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferStreamReceiver(ctx context.Context, in *MsgTransferStreamReceiver, opts ...grpc.CallOption) (*MsgTransferStreamReceiverResponse, error) {
+	out := new(MsgTransferStreamReceiverResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/TransferStreamReceiver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseStream(ctx context.Context, in *MsgPauseStream, opts ...grpc.CallOption) (*MsgPauseStreamResponse, error) {
+	out := new(MsgPauseStreamResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/PauseStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeStream(ctx context.Context, in *MsgResumeStream, opts ...grpc.CallOption) (*MsgResumeStreamResponse, error) {
+	out := new(MsgResumeStreamResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/ResumeStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetLowDepositThreshold(ctx context.Context, in *MsgSetLowDepositThreshold, opts ...grpc.CallOption) (*MsgSetLowDepositThresholdResponse, error) {
+	out := new(MsgSetLowDepositThresholdResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/SetLowDepositThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestStreamTermination(ctx context.Context, in *MsgRequestStreamTermination, opts ...grpc.CallOption) (*MsgRequestStreamTerminationResponse, error) {
+	out := new(MsgRequestStreamTerminationResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/RequestStreamTermination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptStreamTermination(ctx context.Context, in *MsgAcceptStreamTermination, opts ...grpc.CallOption) (*MsgAcceptStreamTerminationResponse, error) {
+	out := new(MsgAcceptStreamTerminationResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/AcceptStreamTermination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptPausableStream(ctx context.Context, in *MsgAcceptPausableStream, opts ...grpc.CallOption) (*MsgAcceptPausableStreamResponse, error) {
+	out := new(MsgAcceptPausableStreamResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/AcceptPausableStream", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.stream.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateStream defines a method to create a new stream
+	CreateStream(context.Context, *MsgCreateStream) (*MsgCreateStreamResponse, error)
+	// ClaimStream defines a method for a receiver to claim from a stream using the sender and receiver
+	ClaimStream(context.Context, *MsgClaimStream) (*MsgClaimStreamResponse, error)
+	// TopUpDeposit defines a method for senders to top up their streams
+	TopUpDeposit(context.Context, *MsgTopUpDeposit) (*MsgTopUpDepositResponse, error)
+	// UpdateFlowRate defines a method to update a stream flow rate
+	UpdateFlowRate(context.Context, *MsgUpdateFlowRate) (*MsgUpdateFlowRateResponse, error)
+	// CancelStream defines a method to cancel a stream
+	CancelStream(context.Context, *MsgCancelStream) (*MsgCancelStreamResponse, error)
+	// ClaimStreamById defines a method for a receiver to claim from a stream using the stream ID
+	ClaimStreamById(context.Context, *MsgClaimStreamById) (*MsgClaimStreamByIdResponse, error)
+	// TopUpDepositById defines a method for senders to top up a stream using the stream ID
+	TopUpDepositById(context.Context, *MsgTopUpDepositById) (*MsgTopUpDepositByIdResponse, error)
+	// UpdateFlowRateById defines a method to update a stream flow rate using the stream ID
+	UpdateFlowRateById(context.Context, *MsgUpdateFlowRateById) (*MsgUpdateFlowRateByIdResponse, error)
+	// CancelStreamById defines a method to cancel a stream using the stream ID
+	CancelStreamById(context.Context, *MsgCancelStreamById) (*MsgCancelStreamByIdResponse, error)
+	// ClaimAllStreams defines a method for a receiver to claim from all, or a batch of, their streams
+	ClaimAllStreams(context.Context, *MsgClaimAllStreams) (*MsgClaimAllStreamsResponse, error)
+	// TransferStreamReceiver defines a method for a receiver to transfer a stream to a new receiver
+	TransferStreamReceiver(context.Context, *MsgTransferStreamReceiver) (*MsgTransferStreamReceiverResponse, error)
+	// PauseStream defines a method for a sender to pause a pausable stream
+	PauseStream(context.Context, *MsgPauseStream) (*MsgPauseStreamResponse, error)
+	// ResumeStream defines a method for a sender to resume a paused stream
+	ResumeStream(context.Context, *MsgResumeStream) (*MsgResumeStreamResponse, error)
+	// SetLowDepositThreshold defines a method for a sender to set a stream's low deposit alert threshold
+	SetLowDepositThreshold(context.Context, *MsgSetLowDepositThreshold) (*MsgSetLowDepositThresholdResponse, error)
+	// RequestStreamTermination defines a method for a sender to request the termination of a stream after a notice period
+	RequestStreamTermination(context.Context, *MsgRequestStreamTermination) (*MsgRequestStreamTerminationResponse, error)
+	// AcceptStreamTermination defines a method for a receiver to accept the early termination of a stream
+	AcceptStreamTermination(context.Context, *MsgAcceptStreamTermination) (*MsgAcceptStreamTerminationResponse, error)
+	// AcceptPausableStream defines a method for a receiver to accept that a pausable stream can be paused by its sender
+	AcceptPausableStream(context.Context, *MsgAcceptPausableStream) (*MsgAcceptPausableStreamResponse, error)
+	// UpdateParams defines an operation for updating the x/stream module
+	// parameters.
+	// Since: cosmos-sdk 0.47
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateStream(ctx context.Context, req *MsgCreateStream) (*MsgCreateStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStream not implemented")
+}
+func (*UnimplementedMsgServer) ClaimStream(ctx context.Context, req *MsgClaimStream) (*MsgClaimStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimStream not implemented")
+}
+func (*UnimplementedMsgServer) TopUpDeposit(ctx context.Context, req *MsgTopUpDeposit) (*MsgTopUpDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpDeposit not implemented")
+}
+func (*UnimplementedMsgServer) UpdateFlowRate(ctx context.Context, req *MsgUpdateFlowRate) (*MsgUpdateFlowRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFlowRate not implemented")
+}
+func (*UnimplementedMsgServer) CancelStream(ctx context.Context, req *MsgCancelStream) (*MsgCancelStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelStream not implemented")
+}
+func (*UnimplementedMsgServer) ClaimStreamById(ctx context.Context, req *MsgClaimStreamById) (*MsgClaimStreamByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimStreamById not implemented")
+}
+func (*UnimplementedMsgServer) TopUpDepositById(ctx context.Context, req *MsgTopUpDepositById) (*MsgTopUpDepositByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TopUpDepositById not implemented")
+}
+func (*UnimplementedMsgServer) UpdateFlowRateById(ctx context.Context, req *MsgUpdateFlowRateById) (*MsgUpdateFlowRateByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFlowRateById not implemented")
+}
+func (*UnimplementedMsgServer) CancelStreamById(ctx context.Context, req *MsgCancelStreamById) (*MsgCancelStreamByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelStreamById not implemented")
+}
+func (*UnimplementedMsgServer) ClaimAllStreams(ctx context.Context, req *MsgClaimAllStreams) (*MsgClaimAllStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimAllStreams not implemented")
+}
+func (*UnimplementedMsgServer) TransferStreamReceiver(ctx context.Context, req *MsgTransferStreamReceiver) (*MsgTransferStreamReceiverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferStreamReceiver not implemented")
+}
+func (*UnimplementedMsgServer) PauseStream(ctx context.Context, req *MsgPauseStream) (*MsgPauseStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseStream not implemented")
+}
+func (*UnimplementedMsgServer) ResumeStream(ctx context.Context, req *MsgResumeStream) (*MsgResumeStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeStream not implemented")
+}
+func (*UnimplementedMsgServer) SetLowDepositThreshold(ctx context.Context, req *MsgSetLowDepositThreshold) (*MsgSetLowDepositThresholdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLowDepositThreshold not implemented")
+}
+func (*UnimplementedMsgServer) RequestStreamTermination(ctx context.Context, req *MsgRequestStreamTermination) (*MsgRequestStreamTerminationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestStreamTermination not implemented")
+}
+func (*UnimplementedMsgServer) AcceptStreamTermination(ctx context.Context, req *MsgAcceptStreamTermination) (*MsgAcceptStreamTerminationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptStreamTermination not implemented")
+}
+func (*UnimplementedMsgServer) AcceptPausableStream(ctx context.Context, req *MsgAcceptPausableStream) (*MsgAcceptPausableStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPausableStream not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/CreateStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateStream(ctx, req.(*MsgCreateStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/ClaimStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimStream(ctx, req.(*MsgClaimStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TopUpDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTopUpDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TopUpDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/TopUpDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TopUpDeposit(ctx, req.(*MsgTopUpDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFlowRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFlowRate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFlowRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/UpdateFlowRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFlowRate(ctx, req.(*MsgUpdateFlowRate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/CancelStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelStream(ctx, req.(*MsgCancelStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimStreamById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimStreamById)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimStreamById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/ClaimStreamById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimStreamById(ctx, req.(*MsgClaimStreamById))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TopUpDepositById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTopUpDepositById)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TopUpDepositById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/TopUpDepositById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TopUpDepositById(ctx, req.(*MsgTopUpDepositById))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFlowRateById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFlowRateById)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFlowRateById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/UpdateFlowRateById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFlowRateById(ctx, req.(*MsgUpdateFlowRateById))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelStreamById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelStreamById)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelStreamById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/CancelStreamById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelStreamById(ctx, req.(*MsgCancelStreamById))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimAllStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimAllStreams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimAllStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/ClaimAllStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimAllStreams(ctx, req.(*MsgClaimAllStreams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferStreamReceiver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferStreamReceiver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferStreamReceiver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/TransferStreamReceiver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferStreamReceiver(ctx, req.(*MsgTransferStreamReceiver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/PauseStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseStream(ctx, req.(*MsgPauseStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/ResumeStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeStream(ctx, req.(*MsgResumeStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetLowDepositThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetLowDepositThreshold)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetLowDepositThreshold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/SetLowDepositThreshold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetLowDepositThreshold(ctx, req.(*MsgSetLowDepositThreshold))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RequestStreamTermination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestStreamTermination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RequestStreamTermination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/RequestStreamTermination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestStreamTermination(ctx, req.(*MsgRequestStreamTermination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptStreamTermination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptStreamTermination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptStreamTermination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/AcceptStreamTermination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptStreamTermination(ctx, req.(*MsgAcceptStreamTermination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptPausableStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptPausableStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptPausableStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/AcceptPausableStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptPausableStream(ctx, req.(*MsgAcceptPausableStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.stream.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "mainchain.stream.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateStream",
+			Handler:    _Msg_CreateStream_Handler,
+		},
+		{
+			MethodName: "ClaimStream",
+			Handler:    _Msg_ClaimStream_Handler,
+		},
+		{
+			MethodName: "TopUpDeposit",
+			Handler:    _Msg_TopUpDeposit_Handler,
+		},
+		{
+			MethodName: "UpdateFlowRate",
+			Handler:    _Msg_UpdateFlowRate_Handler,
+		},
+		{
+			MethodName: "CancelStream",
+			Handler:    _Msg_CancelStream_Handler,
+		},
+		{
+			MethodName: "ClaimStreamById",
+			Handler:    _Msg_ClaimStreamById_Handler,
+		},
+		{
+			MethodName: "TopUpDepositById",
+			Handler:    _Msg_TopUpDepositById_Handler,
+		},
+		{
+			MethodName: "UpdateFlowRateById",
+			Handler:    _Msg_UpdateFlowRateById_Handler,
+		},
+		{
+			MethodName: "CancelStreamById",
+			Handler:    _Msg_CancelStreamById_Handler,
+		},
+		{
+			MethodName: "ClaimAllStreams",
+			Handler:    _Msg_ClaimAllStreams_Handler,
+		},
+		{
+			MethodName: "TransferStreamReceiver",
+			Handler:    _Msg_TransferStreamReceiver_Handler,
+		},
+		{
+			MethodName: "PauseStream",
+			Handler:    _Msg_PauseStream_Handler,
+		},
+		{
+			MethodName: "ResumeStream",
+			Handler:    _Msg_ResumeStream_Handler,
+		},
+		{
+			MethodName: "SetLowDepositThreshold",
+			Handler:    _Msg_SetLowDepositThreshold_Handler,
+		},
+		{
+			MethodName: "RequestStreamTermination",
+			Handler:    _Msg_RequestStreamTermination_Handler,
+		},
+		{
+			MethodName: "AcceptStreamTermination",
+			Handler:    _Msg_AcceptStreamTermination_Handler,
+		},
+		{
+			MethodName: "AcceptPausableStream",
+			Handler:    _Msg_AcceptPausableStream_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "mainchain/stream/v1/tx.proto",
+}
+
+func (m *MsgCreateStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pausable {
+		i--
+		if m.Pausable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EndTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CliffTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CliffTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CliffTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintTx(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.StartTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.FlowRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FlowRate))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.FlowRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FlowRate))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BurnedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.CommunityPoolAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.FeeCollectorAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.RemainingDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.ValidatorFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.StreamPayment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.TotalClaimed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DepositZeroTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DepositZeroTime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTx(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.CurrentDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.DepositAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFlowRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFlowRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFlowRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.FlowRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FlowRate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFlowRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFlowRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFlowRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FlowRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FlowRate))
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimStreamById) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimStreamById) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimStreamById) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimStreamByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimStreamByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimStreamByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BurnedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.CommunityPoolAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.FeeCollectorAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.RemainingDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ValidatorFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.StreamPayment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TotalClaimed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpDepositById) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpDepositById) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpDepositById) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTopUpDepositByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTopUpDepositByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTopUpDepositByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n25, err25 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DepositZeroTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DepositZeroTime):])
+	if err25 != nil {
+		return 0, err25
+	}
+	i -= n25
+	i = encodeVarintTx(dAtA, i, uint64(n25))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CurrentDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.DepositAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFlowRateById) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFlowRateById) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFlowRateById) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FlowRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FlowRate))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFlowRateByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFlowRateByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFlowRateByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FlowRate != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FlowRate))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelStreamById) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelStreamById) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelStreamById) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelStreamByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelStreamByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelStreamByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAllStreams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAllStreams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAllStreams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StartKey) > 0 {
+		i -= len(m.StartKey)
+		copy(dAtA[i:], m.StartKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StartKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MaxStreams != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxStreams))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Senders) > 0 {
+		for iNdEx := len(m.Senders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Senders[iNdEx])
+			copy(dAtA[i:], m.Senders[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Senders[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamClaimResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamClaimResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamClaimResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BurnedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.CommunityPoolAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.FeeCollectorAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.RemainingDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.ValidatorFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.StreamPayment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.TotalClaimed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimAllStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimAllStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimAllStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextKey) > 0 {
+		i -= len(m.NextKey)
+		copy(dAtA[i:], m.NextKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NextKey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TotalValidatorFees) > 0 {
+		for iNdEx := len(m.TotalValidatorFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalValidatorFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TotalStreamPayments) > 0 {
+		for iNdEx := len(m.TotalStreamPayments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalStreamPayments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalClaimed) > 0 {
+		for iNdEx := len(m.TotalClaimed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalClaimed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferStreamReceiver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferStreamReceiver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferStreamReceiver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewReceiver) > 0 {
+		i -= len(m.NewReceiver)
+		copy(dAtA[i:], m.NewReceiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewReceiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferStreamReceiverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferStreamReceiverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferStreamReceiverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BurnedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.CommunityPoolAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.FeeCollectorAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.RemainingDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.ValidatorFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.StreamPayment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TotalClaimed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n42, err42 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PausedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PausedAt):])
+	if err42 != nil {
+		return 0, err42
+	}
+	i -= n42
+	i = encodeVarintTx(dAtA, i, uint64(n42))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n43, err43 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.DepositZeroTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DepositZeroTime):])
+	if err43 != nil {
+		return 0, err43
+	}
+	i -= n43
+	i = encodeVarintTx(dAtA, i, uint64(n43))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLowDepositThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLowDepositThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLowDepositThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LowDepositThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowDepositThreshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetLowDepositThresholdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetLowDepositThresholdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetLowDepositThresholdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestStreamTermination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestStreamTermination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestStreamTermination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NoticeDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NoticeDuration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRequestStreamTerminationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestStreamTerminationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestStreamTerminationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n44, err44 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.TerminationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TerminationTime):])
+	if err44 != nil {
+		return 0, err44
+	}
+	i -= n44
+	i = encodeVarintTx(dAtA, i, uint64(n44))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptStreamTermination) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptStreamTermination) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptStreamTermination) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptStreamTerminationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptStreamTerminationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptStreamTerminationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RefundAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptPausableStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptPausableStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptPausableStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptPausableStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptPausableStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptPausableStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.FlowRate != 0 {
+		n += 1 + sovTx(uint64(m.FlowRate))
+	}
+	if m.StartTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CliffTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CliffTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pausable {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreateStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.FlowRate != 0 {
+		n += 1 + sovTx(uint64(m.FlowRate))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgClaimStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalClaimed.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.StreamPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ValidatorFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RemainingDeposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FeeCollectorAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CommunityPoolAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BurnedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTopUpDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTopUpDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DepositAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CurrentDeposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DepositZeroTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateFlowRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FlowRate != 0 {
+		n += 1 + sovTx(uint64(m.FlowRate))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateFlowRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FlowRate != 0 {
+		n += 1 + sovTx(uint64(m.FlowRate))
+	}
+	return n
+}
+
+func (m *MsgCancelStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimStreamById) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgClaimStreamByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalClaimed.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.StreamPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ValidatorFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RemainingDeposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FeeCollectorAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CommunityPoolAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BurnedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTopUpDepositById) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTopUpDepositByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DepositAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CurrentDeposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DepositZeroTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateFlowRateById) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	if m.FlowRate != 0 {
+		n += 1 + sovTx(uint64(m.FlowRate))
+	}
+	return n
+}
+
+func (m *MsgUpdateFlowRateByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FlowRate != 0 {
+		n += 1 + sovTx(uint64(m.FlowRate))
+	}
+	return n
+}
+
+func (m *MsgCancelStreamById) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgCancelStreamByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimAllStreams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Senders) > 0 {
+		for _, s := range m.Senders {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.MaxStreams != 0 {
+		n += 1 + sovTx(uint64(m.MaxStreams))
+	}
+	l = len(m.StartKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamClaimResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TotalClaimed.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.StreamPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ValidatorFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RemainingDeposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FeeCollectorAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CommunityPoolAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BurnedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgClaimAllStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TotalClaimed) > 0 {
+		for _, e := range m.TotalClaimed {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TotalStreamPayments) > 0 {
+		for _, e := range m.TotalStreamPayments {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TotalValidatorFees) > 0 {
+		for _, e := range m.TotalValidatorFees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.NextKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferStreamReceiver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	l = len(m.NewReceiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferStreamReceiverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalClaimed.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.StreamPayment.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ValidatorFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.RemainingDeposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.FeeCollectorAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CommunityPoolAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.BurnedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPauseStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgPauseStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PausedAt)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgResumeStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgResumeStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.DepositZeroTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetLowDepositThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	if m.LowDepositThreshold != 0 {
+		n += 1 + sovTx(uint64(m.LowDepositThreshold))
+	}
+	return n
+}
+
+func (m *MsgSetLowDepositThresholdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestStreamTermination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	if m.NoticeDuration != 0 {
+		n += 1 + sovTx(uint64(m.NoticeDuration))
+	}
+	return n
+}
+
+func (m *MsgRequestStreamTerminationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TerminationTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAcceptStreamTermination) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgAcceptStreamTerminationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RefundAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAcceptPausableStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgAcceptPausableStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowRate", wireType)
+			}
+			m.FlowRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlowRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CliffTime == nil {
+				m.CliffTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CliffTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pausable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Pausable = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowRate", wireType)
+			}
+			m.FlowRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlowRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalClaimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalClaimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamPayment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StreamPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeCollectorAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTopUpDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTopUpDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTopUpDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTopUpDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTopUpDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTopUpDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositZeroTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DepositZeroTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFlowRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFlowRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFlowRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowRate", wireType)
+			}
+			m.FlowRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlowRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFlowRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFlowRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFlowRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowRate", wireType)
+			}
+			m.FlowRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlowRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimStreamById) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimStreamById: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimStreamById: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimStreamByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimStreamByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimStreamByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalClaimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalClaimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamPayment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StreamPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeCollectorAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTopUpDepositById) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTopUpDepositById: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTopUpDepositById: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTopUpDepositByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTopUpDepositByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTopUpDepositByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositZeroTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DepositZeroTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFlowRateById) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFlowRateById: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFlowRateById: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowRate", wireType)
+			}
+			m.FlowRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlowRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFlowRateByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFlowRateByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFlowRateByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlowRate", wireType)
+			}
+			m.FlowRate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FlowRate |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelStreamById) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelStreamById: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelStreamById: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelStreamByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelStreamByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelStreamByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAllStreams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAllStreams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAllStreams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Senders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Senders = append(m.Senders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStreams", wireType)
+			}
+			m.MaxStreams = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStreams |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartKey = append(m.StartKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.StartKey == nil {
+				m.StartKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StreamClaimResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StreamClaimResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StreamClaimResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalClaimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalClaimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamPayment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StreamPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeCollectorAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimAllStreamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimAllStreamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimAllStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, StreamClaimResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalClaimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalClaimed = append(m.TotalClaimed, types.Coin{})
+			if err := m.TotalClaimed[len(m.TotalClaimed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalStreamPayments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalStreamPayments = append(m.TotalStreamPayments, types.Coin{})
+			if err := m.TotalStreamPayments[len(m.TotalStreamPayments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalValidatorFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalValidatorFees = append(m.TotalValidatorFees, types.Coin{})
+			if err := m.TotalValidatorFees[len(m.TotalValidatorFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextKey = append(m.NextKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NextKey == nil {
+				m.NextKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgTransferStreamReceiver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferStreamReceiver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferStreamReceiver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateStream) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferStreamReceiverResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1725,17 +8816,116 @@ func (m *MsgCreateStream) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateStream: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferStreamReceiverResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateStream: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferStreamReceiverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalClaimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalClaimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamPayment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StreamPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValidatorFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingDeposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1745,29 +8935,30 @@ func (m *MsgCreateStream) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			if err := m.RemainingDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorAmount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1777,27 +8968,28 @@ func (m *MsgCreateStream) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.FeeCollectorAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1824,15 +9016,15 @@ func (m *MsgCreateStream) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommunityPoolAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlowRate", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedAmount", wireType)
 			}
-			m.FlowRate = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1842,11 +9034,25 @@ func (m *MsgCreateStream) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FlowRate |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1868,7 +9074,7 @@ func (m *MsgCreateStream) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1891,15 +9097,15 @@ func (m *MsgCreateStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1927,13 +9133,13 @@ func (m *MsgCreateStreamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var stringLen uint64
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1943,27 +9149,64 @@ func (m *MsgCreateStreamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseStreamResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseStreamResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1990,29 +9233,10 @@ func (m *MsgCreateStreamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PausedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlowRate", wireType)
-			}
-			m.FlowRate = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FlowRate |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2034,7 +9258,7 @@ func (m *MsgCreateStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimStream) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2057,10 +9281,10 @@ func (m *MsgClaimStream) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimStream: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimStream: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2096,10 +9320,10 @@ func (m *MsgClaimStream) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var stringLen uint64
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2109,24 +9333,11 @@ func (m *MsgClaimStream) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2148,7 +9359,7 @@ func (m *MsgClaimStream) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2171,15 +9382,15 @@ func (m *MsgClaimStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalClaimed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositZeroTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2206,15 +9417,65 @@ func (m *MsgClaimStreamResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalClaimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DepositZeroTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetLowDepositThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetLowDepositThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetLowDepositThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StreamPayment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2224,30 +9485,29 @@ func (m *MsgClaimStreamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StreamPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorFee", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var msglen int
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2257,30 +9517,16 @@ func (m *MsgClaimStreamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ValidatorFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingDeposit", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowDepositThreshold", wireType)
 			}
-			var msglen int
+			m.LowDepositThreshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2290,25 +9536,61 @@ func (m *MsgClaimStreamResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LowDepositThreshold |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetLowDepositThresholdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetLowDepositThresholdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetLowDepositThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2330,7 +9612,7 @@ func (m *MsgClaimStreamResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTopUpDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestStreamTermination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2353,15 +9635,15 @@ func (m *MsgTopUpDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTopUpDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestStreamTermination: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTopUpDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestStreamTermination: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2389,13 +9671,13 @@ func (m *MsgTopUpDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var stringLen uint64
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2405,29 +9687,16 @@ func (m *MsgTopUpDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoticeDuration", wireType)
 			}
-			var msglen int
+			m.NoticeDuration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2437,25 +9706,11 @@ func (m *MsgTopUpDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NoticeDuration |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2477,7 +9732,7 @@ func (m *MsgTopUpDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTopUpDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestStreamTerminationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2500,81 +9755,15 @@ func (m *MsgTopUpDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTopUpDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestStreamTerminationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTopUpDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestStreamTerminationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositAmount", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DepositAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentDeposit", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CurrentDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositZeroTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TerminationTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2601,7 +9790,7 @@ func (m *MsgTopUpDepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.DepositZeroTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.TerminationTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2626,7 +9815,7 @@ func (m *MsgTopUpDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateFlowRate) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptStreamTermination) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2649,10 +9838,10 @@ func (m *MsgUpdateFlowRate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateFlowRate: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptStreamTermination: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateFlowRate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptStreamTermination: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2688,42 +9877,10 @@ func (m *MsgUpdateFlowRate) Unmarshal(dAtA []byte) error {
 			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlowRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			m.FlowRate = 0
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2733,7 +9890,7 @@ func (m *MsgUpdateFlowRate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FlowRate |= int64(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2759,7 +9916,7 @@ func (m *MsgUpdateFlowRate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateFlowRateResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptStreamTerminationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2782,17 +9939,17 @@ func (m *MsgUpdateFlowRateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateFlowRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptStreamTerminationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateFlowRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptStreamTerminationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FlowRate", wireType)
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundAmount", wireType)
 			}
-			m.FlowRate = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2802,11 +9959,25 @@ func (m *MsgUpdateFlowRateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FlowRate |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2828,7 +9999,7 @@ func (m *MsgUpdateFlowRateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelStream) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptPausableStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2851,10 +10022,10 @@ func (m *MsgCancelStream) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelStream: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptPausableStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelStream: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptPausableStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2890,10 +10061,10 @@ func (m *MsgCancelStream) Unmarshal(dAtA []byte) error {
 			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var stringLen uint64
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2903,24 +10074,11 @@ func (m *MsgCancelStream) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2942,7 +10100,7 @@ func (m *MsgCancelStream) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelStreamResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptPausableStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2965,10 +10123,10 @@ func (m *MsgCancelStreamResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelStreamResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptPausableStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptPausableStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1 +1,40 @@
 package types
+
+import "time"
+
+// MaxStreamSettlementsPerBlock is the maximum number of depleted streams that will be settled
+// and removed by the EndBlocker in a single block. Any remaining depleted streams stay in the
+// expiry queue and are processed in subsequent blocks.
+const MaxStreamSettlementsPerBlock = 100
+
+// MaxLowDepositAlertsPerBlock is the maximum number of stream_low_deposit events the EndBlocker will emit in
+// a single block. Any remaining streams stay in the low deposit queue and are alerted in subsequent blocks.
+const MaxLowDepositAlertsPerBlock = 100
+
+// MaxClaimAllStreams is the maximum number of streams a single MsgClaimAllStreams will process,
+// keeping its gas use bounded. Receivers with more streams continue with the returned next_key.
+const MaxClaimAllStreams = 100
+
+// DefaultClaimAllStreams is the number of streams a MsgClaimAllStreams processes if max_streams is not set
+const DefaultClaimAllStreams = 50
+
+// DeletedStreamAuditRetention is how long a stream's claim statistics and flow rate change history are kept for
+// auditing after the stream is settled, cancelled or terminated and deleted. They are then pruned by the EndBlocker.
+// It is 90 days.
+const DeletedStreamAuditRetention = 90 * 24 * time.Hour
+
+// MaxStreamAuditPrunesPerBlock is the maximum number of deleted streams' claim statistics and flow rate change
+// histories the EndBlocker will prune in a single block. Any remaining are pruned in subsequent blocks.
+const MaxStreamAuditPrunesPerBlock = 100
+
+// MaxFlowRateChangeHistory is the maximum number of flow rate changes kept in a stream's history. Once
+// reached, the oldest change is dropped each time a new one is recorded.
+const MaxFlowRateChangeHistory = 20
+
+// MaxLowDepositThreshold is the maximum low deposit alert threshold a sender can set on a stream, in seconds.
+// It is 10 years.
+const MaxLowDepositThreshold uint64 = 60 * 60 * 24 * 365 * 10
+
+// MaxTerminationNotice is the maximum notice period a sender can give when requesting the termination of a stream,
+// in seconds. It is 10 years.
+const MaxTerminationNotice uint64 = 60 * 60 * 24 * 365 * 10
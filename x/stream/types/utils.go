@@ -68,7 +68,10 @@ func CalculateFlowRateForCoin(coin sdk.Coin, period StreamPeriod, duration uint6
 	return totalDuration, flowRate, flowRate.TruncateInt64()
 }
 
-func CalculateDuration(deposit sdk.Coin, flowRate int64) int64 {
+// CalculateDuration calculates the number of seconds the deposit will last at the given flow rate.
+// If the stream has an end time, the duration is capped so that the deposit does not flow beyond
+// it, starting from flowStart. A zero end time means the stream has no end.
+func CalculateDuration(deposit sdk.Coin, flowRate int64, flowStart, endTime time.Time) int64 {
 	// no point if flowRate is <= 0
 	if flowRate <= 0 {
 		return 0
@@ -81,30 +84,65 @@ func CalculateDuration(deposit sdk.Coin, flowRate int64) int64 {
 		decDeposit := sdk.NewDecCoinFromCoin(deposit)
 		decDuration := decDeposit.Amount.QuoTruncateMut(decFlowRate)
 		// note: decimal values are rounded down, e.g. 2628008.9 to just 2628008.
-		return decDuration.TruncateInt64()
+		duration := decDuration.TruncateInt64()
+
+		if !endTime.IsZero() {
+			untilEnd := endTime.Unix() - flowStart.Unix()
+			if untilEnd <= 0 {
+				return 0
+			}
+			if duration > untilEnd {
+				duration = untilEnd
+			}
+		}
+
+		return duration
 	}
 
 	return 0
 }
 
+// CalculateAmountToClaim calculates the amount that has flowed since the last claim, and the deposit
+// that will remain after claiming it. Nothing is claimable before the stream has started (i.e. the
+// last outflow time is in the future), or before the cliff time. Nothing flows after the end time,
+// and any remaining deposit is left for the sender. Zero cliff or end times are ignored.
 func CalculateAmountToClaim(
 	nowTime,
 	depositZeroTime,
-	lastOutflowTime time.Time,
+	lastOutflowTime,
+	cliffTime,
+	endTime time.Time,
 	deposit sdk.Coin,
 	flowRate int64,
 ) (sdk.Coin, sdk.Coin) {
 	var amountToClaim sdk.Coin
 	var remainingDepositValue sdk.Coin
 
-	if nowTime.After(depositZeroTime) || nowTime.Equal(depositZeroTime) {
+	// pending, or cliff not yet reached
+	if nowTime.Before(lastOutflowTime) || (!cliffTime.IsZero() && nowTime.Before(cliffTime)) {
+		return sdk.NewCoin(deposit.Denom, mathmod.NewInt(0)), deposit
+	}
+
+	// the stream does not flow beyond its end time
+	flowUntil := nowTime
+	if !endTime.IsZero() && endTime.Before(nowTime) {
+		flowUntil = endTime
+	}
+
+	depositUsedUp := !flowUntil.Before(depositZeroTime) && (endTime.IsZero() || depositZeroTime.Before(endTime))
+
+	if depositUsedUp {
 		// now > deposit_zero_time, use all remaining deposit
 		amountToClaim = deposit
 		remainingDepositValue = sdk.NewCoin(deposit.Denom, mathmod.NewInt(0))
 	} else {
 		// calculate based on flow rate and remaining deposit
-		timeSinceLast := nowTime.Sub(lastOutflowTime)
+		timeSinceLast := flowUntil.Sub(lastOutflowTime)
 		secondsSinceLast := int64(timeSinceLast.Seconds())
+		if secondsSinceLast < 0 {
+			// already claimed up to the end time
+			secondsSinceLast = 0
+		}
 		numCoins := secondsSinceLast * flowRate
 		amountToClaim = sdk.NewCoin(deposit.Denom, mathmod.NewIntFromUint64(uint64(numCoins)))
 		if deposit.Amount.GT(amountToClaim.Amount) {
@@ -100,7 +100,32 @@ func TestCalculateDuration(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(tt *testing.T) {
-			duration := types.CalculateDuration(tc.coin, tc.flowRate)
+			duration := types.CalculateDuration(tc.coin, tc.flowRate, time.Time{}, time.Time{})
+			require.Equal(t, tc.expectedDuration, duration)
+		})
+	}
+}
+
+func TestCalculateDuration_EndTime(t *testing.T) {
+	flowStart := time.Unix(1700000000, 0).UTC()
+	testCases := []struct {
+		name             string
+		coin             sdk.Coin
+		flowRate         int64
+		endTime          time.Time
+		expectedDuration int64
+	}{
+		{"no end time", sdk.NewInt64Coin("testdenom", 1000), 1, time.Time{}, 1000},
+		{"end time after deposit runs out", sdk.NewInt64Coin("testdenom", 1000), 1, flowStart.Add(time.Second * 2000), 1000},
+		{"end time when deposit runs out", sdk.NewInt64Coin("testdenom", 1000), 1, flowStart.Add(time.Second * 1000), 1000},
+		{"end time before deposit runs out", sdk.NewInt64Coin("testdenom", 1000), 1, flowStart.Add(time.Second * 400), 400},
+		{"end time at flow start", sdk.NewInt64Coin("testdenom", 1000), 1, flowStart, 0},
+		{"end time before flow start", sdk.NewInt64Coin("testdenom", 1000), 1, flowStart.Add(-time.Second), 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			duration := types.CalculateDuration(tc.coin, tc.flowRate, flowStart, tc.endTime)
 			require.Equal(t, tc.expectedDuration, duration)
 		})
 	}
@@ -202,7 +227,101 @@ func TestCalculateAmountToClaim(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(tt *testing.T) {
-			amountToClaim, remainingDeposit := types.CalculateAmountToClaim(tc.nowTime, tc.depositZeroTime, tc.lastOutflowTime, tc.deposit, tc.flowRate)
+			amountToClaim, remainingDeposit := types.CalculateAmountToClaim(tc.nowTime, tc.depositZeroTime, tc.lastOutflowTime, time.Time{}, time.Time{}, tc.deposit, tc.flowRate)
+			require.Equal(t, tc.expectedAmountToClaim, amountToClaim, "amountToClaim")
+			require.Equal(t, tc.expectedRemainingDepositValue, remainingDeposit, "remainingDeposit")
+		})
+	}
+}
+
+func TestCalculateAmountToClaim_Schedule(t *testing.T) {
+	startTime := time.Unix(1700000000, 0).UTC()
+	deposit := sdk.NewInt64Coin("testdenom", 1000)
+	testCases := []struct {
+		name                          string
+		nowTime                       time.Time
+		depositZeroTime               time.Time
+		lastOutflowTime               time.Time
+		cliffTime                     time.Time
+		endTime                       time.Time
+		expectedAmountToClaim         sdk.Coin
+		expectedRemainingDepositValue sdk.Coin
+	}{
+		{
+			"pending",
+			startTime.Add(-time.Second * 10),
+			startTime.Add(time.Second * 1000),
+			startTime,
+			time.Time{},
+			time.Time{},
+			sdk.NewInt64Coin("testdenom", 0),
+			deposit,
+		},
+		{
+			"before cliff",
+			startTime.Add(time.Second * 99),
+			startTime.Add(time.Second * 1000),
+			startTime,
+			startTime.Add(time.Second * 100),
+			time.Time{},
+			sdk.NewInt64Coin("testdenom", 0),
+			deposit,
+		},
+		{
+			"at cliff",
+			startTime.Add(time.Second * 100),
+			startTime.Add(time.Second * 1000),
+			startTime,
+			startTime.Add(time.Second * 100),
+			time.Time{},
+			sdk.NewInt64Coin("testdenom", 100),
+			sdk.NewInt64Coin("testdenom", 900),
+		},
+		{
+			"before end time",
+			startTime.Add(time.Second * 200),
+			startTime.Add(time.Second * 400),
+			startTime,
+			time.Time{},
+			startTime.Add(time.Second * 400),
+			sdk.NewInt64Coin("testdenom", 200),
+			sdk.NewInt64Coin("testdenom", 800),
+		},
+		{
+			"after end time",
+			startTime.Add(time.Second * 500),
+			startTime.Add(time.Second * 400),
+			startTime,
+			time.Time{},
+			startTime.Add(time.Second * 400),
+			sdk.NewInt64Coin("testdenom", 400),
+			sdk.NewInt64Coin("testdenom", 600),
+		},
+		{
+			"after end time, already claimed up to end time",
+			startTime.Add(time.Second * 500),
+			startTime.Add(time.Second * 400),
+			startTime.Add(time.Second * 450),
+			time.Time{},
+			startTime.Add(time.Second * 400),
+			sdk.NewInt64Coin("testdenom", 0),
+			deposit,
+		},
+		{
+			"deposit runs out before end time",
+			startTime.Add(time.Second * 1500),
+			startTime.Add(time.Second * 1000),
+			startTime,
+			time.Time{},
+			startTime.Add(time.Second * 2000),
+			deposit,
+			sdk.NewInt64Coin("testdenom", 0),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(tt *testing.T) {
+			amountToClaim, remainingDeposit := types.CalculateAmountToClaim(tc.nowTime, tc.depositZeroTime, tc.lastOutflowTime, tc.cliffTime, tc.endTime, deposit, 1)
 			require.Equal(t, tc.expectedAmountToClaim, amountToClaim, "amountToClaim")
 			require.Equal(t, tc.expectedRemainingDepositValue, remainingDeposit, "remainingDeposit")
 		})
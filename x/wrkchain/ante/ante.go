@@ -18,7 +18,7 @@ import (
 // AnteHandler in the chain. If a WRKChain Msg is detected, it then:
 //
 // 1. Checks sufficient fees have been included in the Tx, via the --fees flag
-// 2. Checks the fee payer is the WRKChain owner
+// 2. Checks the signer of each record Msg is the WRKChain owner or one of its authorised recorders
 // 3. Checks if the fee payer has sufficient funds in their account to pay for it, including any locked enterprise und
 //
 // If any of the checks fail, a suitable error is returned.
@@ -66,8 +66,16 @@ func (wfd CorrectWrkChainFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, si
 		}
 	}
 
+	// check record Msgs are signed by the WRKChain owner or an authorised recorder. Reject early so that
+	// fees are not deducted for hashes the module handler will refuse to record. The Msg signer is checked
+	// rather than the fee payer, so that fee grants can still be used to pay for recording.
+	err := checkWrkChainRecorders(ctx, feeTx, wfd.wrkchainKeeper)
+	if err != nil {
+		return ctx, err
+	}
+
 	// check sender has sufficient funds
-	err := checkFeePayerHasFunds(ctx, wfd.bankKeeper, wfd.accKeeper, wfd.entKeeper, wfd.wrkchainKeeper, feeTx)
+	err = checkFeePayerHasFunds(ctx, wfd.bankKeeper, wfd.accKeeper, wfd.entKeeper, wfd.wrkchainKeeper, feeTx)
 	if err != nil {
 		return ctx, err
 	}
@@ -85,6 +93,32 @@ func (wfd CorrectWrkChainFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, si
 	return next(ctx, tx, simulate)
 }
 
+func checkWrkChainRecorders(ctx sdk.Context, tx sdk.FeeTx, wck WrkchainKeeper) error {
+	for _, msg := range tx.GetMsgs() {
+		m, ok := msg.(*types.MsgRecordWrkChainBlock)
+		if !ok {
+			continue
+		}
+
+		// unregistered WRKChains are rejected by the module handler
+		if !wck.IsWrkChainRegistered(ctx, m.WrkchainId) {
+			continue
+		}
+
+		recorder, err := sdk.AccAddressFromBech32(m.Owner)
+		if err != nil {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recorder address (%s)", err)
+		}
+
+		if !wck.IsAuthorisedToRecord(ctx, m.WrkchainId, recorder) {
+			errMsg := fmt.Sprintf("%s is not the owner or an authorised recorder of wrkchain %d", m.Owner, m.WrkchainId)
+			return errorsmod.Wrap(exported.ErrNotWrkChainOwner, errMsg)
+		}
+	}
+
+	return nil
+}
+
 func checkWrkChainMaxSlots(ctx sdk.Context, tx sdk.FeeTx, wck WrkchainKeeper) error {
 	msgs := tx.GetMsgs()
 
@@ -194,10 +228,14 @@ func checkFeePayerHasFunds(ctx sdk.Context, bankKeeper BankKeeper, accKeeper Acc
 
 	potentialCoins := coins
 
-	//get any locked enterprise FUND
-	lockedUnd := ek.GetLockedUndAmountForAccount(ctx, feePayer)
+	// get any locked enterprise FUND. Locked FUND can only be used if every Msg in the Tx is in the
+	// enterprise module's EfundFeeMsgTypes param. Mixed Txs must pay fees from unlocked FUND
+	lockedUndCoins := sdk.NewCoins()
+	if ek.IsEFUNDFeeTx(ctx, tx.GetMsgs()) {
+		lockedUnd := ek.GetLockedUndAmountForAccount(ctx, feePayer)
+		lockedUndCoins = sdk.NewCoins(lockedUnd)
+	}
 
-	lockedUndCoins := sdk.NewCoins(lockedUnd)
 	// include any locked FUND in potential coins. We need to do this because if these checks pass,
 	// the locked FUND will be unlocked in the next decorator
 	potentialCoins = potentialCoins.Add(lockedUndCoins...)
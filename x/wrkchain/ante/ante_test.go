@@ -13,6 +13,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/require"
 
 	simapphelpers "github.com/unification-com/mainchain/app/helpers"
@@ -691,6 +692,61 @@ func TestCorrectWrkChainFeeDecoratorCorrectFeeSufficientLocked(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestCorrectWrkChainFeeDecoratorMixedTxIgnoresLocked(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(true)
+	txGen := app.GetTxConfig()
+
+	feeDecorator := ante.NewCorrectWrkChainFeeDecorator(app.BankKeeper, app.AccountKeeper, app.WrkchainKeeper, app.EnterpriseKeeper)
+	antehandler := sdk.ChainAnteDecorators(feeDecorator)
+
+	wrkParams := app.WrkchainKeeper.GetParams(ctx)
+	actualRegFeeAmt := wrkParams.FeeRegister
+	actualFeeDenom := wrkParams.Denom
+
+	privK := ed25519.GenPrivKey()
+	pubK := privK.PubKey()
+	addr := sdk.AccAddress(pubK.Address())
+
+	// fund the account
+	accAmt := mathmod.NewInt(int64(1))
+	initCoins := sdk.NewCoins(sdk.NewCoin(actualFeeDenom, accAmt))
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	err := fundAccount(ctx, app.BankKeeper, addr, initCoins)
+	require.NoError(t, err)
+
+	lockedUnd := enttypes.LockedUnd{
+		Owner:  addr.String(),
+		Amount: sdk.NewInt64Coin(actualFeeDenom, int64(actualRegFeeAmt*3)),
+	}
+	_ = app.EnterpriseKeeper.SetLockedUndForAccount(ctx, lockedUnd)
+
+	feeInt := int64(actualRegFeeAmt)
+	msg := types.NewMsgRegisterWrkChain("test", "hash", "Test", "geth", addr)
+	sendMsg := banktypes.NewMsgSend(addr, addr, initCoins)
+	fee := sdk.NewCoins(sdk.NewInt64Coin(actualFeeDenom, feeInt))
+
+	// locked eFUND cannot be used if the Tx contains Msgs not in the enterprise EfundFeeMsgTypes param
+	tx, _ := simtestutil.GenSignedMockTx(r, txGen, []sdk.Msg{msg, sendMsg}, fee, uint64(0), TestChainID, []uint64{0}, []uint64{0}, privK)
+
+	_, err = antehandler(ctx, tx, false)
+	expectedErr := errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds,
+		"insufficient und to pay for fees. unlocked und: %s, including locked und: %s, fee: %d%s", initCoins, initCoins, feeInt, actualFeeDenom)
+	require.NotNil(t, err, "Did not error on mixed Tx with insufficient unlocked funds")
+	require.True(t, err.Error() == expectedErr.Error(), "unexpected type of error: %s", err)
+
+	// locked eFUND can be used once the Msg type is allowed
+	entParams := app.EnterpriseKeeper.GetParams(ctx)
+	entParams.EfundFeeMsgTypes = append(entParams.EfundFeeMsgTypes, sdk.MsgTypeURL(sendMsg))
+	err = app.EnterpriseKeeper.SetParams(ctx, entParams)
+	require.NoError(t, err)
+
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+}
+
 func TestExceedsMaxStorageDecoratorInvalidTx(t *testing.T) {
 	r := rand.New(rand.NewSource(time.Now().UnixNano()))
 	app := simapphelpers.Setup(t)
@@ -750,3 +806,52 @@ func TestExceedsMaxStorageDecoratorInvalidTx(t *testing.T) {
 	require.NotNil(t, err, "Did not error on invalid tx")
 	require.Equal(t, expectedErr.Error(), err.Error(), "unexpected type of error: %s", err)
 }
+
+func TestCorrectWrkChainFeeDecoratorRecorders(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(true)
+	txGen := app.GetTxConfig()
+
+	feeDecorator := ante.NewCorrectWrkChainFeeDecorator(app.BankKeeper, app.AccountKeeper, app.WrkchainKeeper, app.EnterpriseKeeper)
+	antehandler := sdk.ChainAnteDecorators(feeDecorator)
+
+	wrkParams := app.WrkchainKeeper.GetParams(ctx)
+	actualRecFeeAmt := wrkParams.FeeRecord
+	actualFeeDenom := wrkParams.Denom
+
+	ownerAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	privK := ed25519.GenPrivKey()
+	addr := sdk.AccAddress(privK.PubKey().Address())
+
+	// fund the recording account
+	initCoins := sdk.NewCoins(sdk.NewCoin(actualFeeDenom, mathmod.NewInt(int64(actualRecFeeAmt*3))))
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	app.AccountKeeper.SetAccount(ctx, acc)
+	err := fundAccount(ctx, app.BankKeeper, addr, initCoins)
+	require.NoError(t, err)
+
+	wcID, err := app.WrkchainKeeper.RegisterNewWrkChain(ctx, "test", "test", "genesishash", "geth", ownerAddr)
+	require.NoError(t, err)
+
+	msg := types.NewMsgRecordWrkChainBlock(wcID, 1, "test", "test", "", "", "", addr)
+	fee := sdk.NewCoins(sdk.NewInt64Coin(actualFeeDenom, int64(actualRecFeeAmt)))
+
+	tx, _ := simtestutil.GenSignedMockTx(r, txGen, []sdk.Msg{msg}, fee, uint64(0), TestChainID, []uint64{0}, []uint64{0}, privK)
+
+	// not the owner or a recorder
+	_, err = antehandler(ctx, tx, false)
+	require.ErrorIs(t, err, types.ErrNotWrkChainOwner)
+
+	// authorised recorder
+	app.WrkchainKeeper.SetWrkChainRecorder(ctx, wcID, addr)
+
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// recorder removed
+	app.WrkchainKeeper.DeleteWrkChainRecorder(ctx, wcID, addr)
+
+	_, err = antehandler(ctx, tx, false)
+	require.ErrorIs(t, err, types.ErrNotWrkChainOwner)
+}
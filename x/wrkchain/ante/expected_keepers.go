@@ -25,6 +25,7 @@ type BankKeeper interface {
 
 type EnterpriseKeeper interface {
 	GetLockedUndAmountForAccount(ctx sdk.Context, address sdk.AccAddress) sdk.Coin
+	IsEFUNDFeeTx(ctx sdk.Context, msgs []sdk.Msg) bool
 }
 
 type WrkchainKeeper interface {
@@ -34,4 +35,6 @@ type WrkchainKeeper interface {
 	GetRecordFeeAsCoin(ctx sdk.Context) sdk.Coin
 	GetPurchaseStorageFeeAsCoin(ctx sdk.Context) sdk.Coin
 	GetMaxPurchasableSlots(ctx sdk.Context, wrkchainId uint64) uint64
+	IsWrkChainRegistered(ctx sdk.Context, wrkchainId uint64) bool
+	IsAuthorisedToRecord(ctx sdk.Context, wrkchainId uint64, recorder sdk.AccAddress) bool
 }
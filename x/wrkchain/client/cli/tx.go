@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/spf13/cobra"
@@ -44,6 +45,9 @@ func GetTxCmd() *cobra.Command {
 		GetCmdRegisterWrkChain(),
 		GetCmdRecordWrkChainBlock(),
 		GetCmdPurchaseStorage(),
+		GetCmdTransferOwnership(),
+		GetCmdAddRecorder(),
+		GetCmdRemoveRecorder(),
 	)
 
 	return wrkchainTxCmd
@@ -276,3 +280,151 @@ $ %s tx %s purchase_storage 1 100
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdTransferOwnership is the CLI command for sending a TransferWrkChainOwnership transaction
+func GetCmdTransferOwnership() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer_ownership [wrkchain_id] [new_owner]",
+		Short: "transfer ownership of a WrkChain to a new address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Transfer ownership of a WrkChain to a new address. Only the current
+owner can transfer ownership. Authorised recorders are kept.
+
+Example:
+$ %s tx %s transfer_ownership 1 und1chknpc8nf2tmj5582vhlvphnjyekc9ypspx5ay --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			wrkchainId, err := parseWrkChainId(args[0])
+			if err != nil {
+				return err
+			}
+
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferWrkChainOwnership(wrkchainId, newOwner, clientCtx.GetFromAddress())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdAddRecorder is the CLI command for sending an AddWrkChainRecorder transaction
+func GetCmdAddRecorder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add_recorder [wrkchain_id] [recorder]",
+		Short: "authorise an address to record a WrkChain's block hashes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Authorise an address to record a WrkChain's block hashes on the owner's
+behalf. Only the owner can add recorders.
+
+Example:
+$ %s tx %s add_recorder 1 und1chknpc8nf2tmj5582vhlvphnjyekc9ypspx5ay --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			wrkchainId, err := parseWrkChainId(args[0])
+			if err != nil {
+				return err
+			}
+
+			recorder, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddWrkChainRecorder(wrkchainId, recorder, clientCtx.GetFromAddress())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdRemoveRecorder is the CLI command for sending a RemoveWrkChainRecorder transaction
+func GetCmdRemoveRecorder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove_recorder [wrkchain_id] [recorder]",
+		Short: "revoke an address's authorisation to record a WrkChain's block hashes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke an address's authorisation to record a WrkChain's block hashes.
+Only the owner can remove recorders.
+
+Example:
+$ %s tx %s remove_recorder 1 und1chknpc8nf2tmj5582vhlvphnjyekc9ypspx5ay --from mykey
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			wrkchainId, err := parseWrkChainId(args[0])
+			if err != nil {
+				return err
+			}
+
+			recorder, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveWrkChainRecorder(wrkchainId, recorder, clientCtx.GetFromAddress())
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func parseWrkChainId(arg string) (uint64, error) {
+	wrkchainId, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if wrkchainId == 0 {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "wrkchain_id must be > 0")
+	}
+
+	return wrkchainId, nil
+}
@@ -18,6 +18,7 @@ var (
 	ErrInsufficientWrkChainFee  = types.ErrInsufficientWrkChainFee
 	ErrTooMuchWrkChainFee       = types.ErrTooMuchWrkChainFee
 	ErrExceedsMaxStorage        = types.ErrExceedsMaxStorage
+	ErrNotWrkChainOwner         = types.ErrNotWrkChainOwner
 )
 
 func CheckIsWrkChainTx(tx sdk.Tx) bool {
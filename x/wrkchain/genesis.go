@@ -36,6 +36,14 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data types.GenesisState)
 			panic(err)
 		}
 
+		for _, recorder := range record.Recorders {
+			recorderAddr, err := sdk.AccAddressFromBech32(recorder)
+			if err != nil {
+				panic(err)
+			}
+			keeper.SetWrkChainRecorder(ctx, wrkChain.WrkchainId, recorderAddr)
+		}
+
 		for _, block := range record.Blocks {
 			blk := types.WrkChainBlock{
 				Height:     block.He,
@@ -91,6 +99,7 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 			},
 			Blocks:       blockHashList,
 			InStateLimit: wrkchainStorage.InStateLimit,
+			Recorders:    k.GetWrkChainRecorders(ctx, wc.WrkchainId),
 		})
 	}
 
@@ -104,7 +104,7 @@ func (k msgServer) RecordWrkChainBlock(goCtx context.Context, msg *types.MsgReco
 	}
 
 	if !k.IsAuthorisedToRecord(ctx, msg.WrkchainId, ownerAddr) {
-		return nil, errorsmod.Wrap(types.ErrNotWrkChainOwner, "you are not the owner of this wrkchain")
+		return nil, errorsmod.Wrap(types.ErrNotWrkChainOwner, "you are not the owner of this wrkchain or one of its authorised recorders")
 	}
 
 	if !k.QuickCheckHeightIsNew(ctx, msg.WrkchainId, msg.Height) {
@@ -163,7 +163,7 @@ func (k msgServer) PurchaseWrkChainStateStorage(goCtx context.Context, msg *type
 		return nil, errorsmod.Wrap(types.ErrWrkChainDoesNotExist, "wrkchain has not been registered yet") // If not, throw an error
 	}
 
-	if !k.IsAuthorisedToRecord(ctx, msg.WrkchainId, ownerAddr) {
+	if !k.IsWrkChainOwner(ctx, msg.WrkchainId, ownerAddr) {
 		return nil, errorsmod.Wrap(types.ErrNotWrkChainOwner, "you are not the owner of this wrkchain")
 	}
 
@@ -206,6 +206,131 @@ func (k msgServer) PurchaseWrkChainStateStorage(goCtx context.Context, msg *type
 
 }
 
+func (k msgServer) TransferWrkChainOwnership(goCtx context.Context, msg *types.MsgTransferWrkChainOwnership) (*types.MsgTransferWrkChainOwnershipResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, accErr := sdk.AccAddressFromBech32(msg.Owner)
+	if accErr != nil {
+		return nil, accErr
+	}
+
+	newOwnerAddr, accErr := sdk.AccAddressFromBech32(msg.NewOwner)
+	if accErr != nil {
+		return nil, accErr
+	}
+
+	if !k.IsWrkChainRegistered(ctx, msg.WrkchainId) { // Checks if the WrkChain is registered
+		return nil, errorsmod.Wrap(types.ErrWrkChainDoesNotExist, "wrkchain has not been registered yet") // If not, throw an error
+	}
+
+	if !k.IsWrkChainOwner(ctx, msg.WrkchainId, ownerAddr) {
+		return nil, errorsmod.Wrap(types.ErrNotWrkChainOwner, "you are not the owner of this wrkchain")
+	}
+
+	err := k.Keeper.TransferWrkChainOwnership(ctx, msg.WrkchainId, newOwnerAddr)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer telemetry.IncrCounter(1, types.ModuleName, types.TransferOwnershipAction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferOwnership,
+			sdk.NewAttribute(types.AttributeKeyWrkChainId, strconv.FormatUint(msg.WrkchainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyNewOwner, msg.NewOwner),
+		),
+	)
+
+	return &types.MsgTransferWrkChainOwnershipResponse{}, nil
+}
+
+func (k msgServer) AddWrkChainRecorder(goCtx context.Context, msg *types.MsgAddWrkChainRecorder) (*types.MsgAddWrkChainRecorderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ownerAddr, recorderAddr, err := k.checkRecorderMsg(ctx, msg.WrkchainId, msg.Owner, msg.Recorder)
+	if err != nil {
+		return nil, err
+	}
+
+	if ownerAddr.Equals(recorderAddr) {
+		return nil, errorsmod.Wrap(types.ErrInvalidData, "the owner cannot be added as a recorder")
+	}
+
+	if k.IsWrkChainRecorder(ctx, msg.WrkchainId, recorderAddr) {
+		return nil, errorsmod.Wrapf(types.ErrAlreadyWrkChainRecorder, "%s is already a recorder for this wrkchain", msg.Recorder)
+	}
+
+	k.SetWrkChainRecorder(ctx, msg.WrkchainId, recorderAddr)
+
+	defer telemetry.IncrCounter(1, types.ModuleName, types.AddRecorderAction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddRecorder,
+			sdk.NewAttribute(types.AttributeKeyWrkChainId, strconv.FormatUint(msg.WrkchainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecorder, msg.Recorder),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+		),
+	)
+
+	return &types.MsgAddWrkChainRecorderResponse{}, nil
+}
+
+func (k msgServer) RemoveWrkChainRecorder(goCtx context.Context, msg *types.MsgRemoveWrkChainRecorder) (*types.MsgRemoveWrkChainRecorderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, recorderAddr, err := k.checkRecorderMsg(ctx, msg.WrkchainId, msg.Owner, msg.Recorder)
+	if err != nil {
+		return nil, err
+	}
+
+	if !k.IsWrkChainRecorder(ctx, msg.WrkchainId, recorderAddr) {
+		return nil, errorsmod.Wrapf(types.ErrNotWrkChainRecorder, "%s is not a recorder for this wrkchain", msg.Recorder)
+	}
+
+	k.DeleteWrkChainRecorder(ctx, msg.WrkchainId, recorderAddr)
+
+	defer telemetry.IncrCounter(1, types.ModuleName, types.RemoveRecorderAction)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveRecorder,
+			sdk.NewAttribute(types.AttributeKeyWrkChainId, strconv.FormatUint(msg.WrkchainId, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecorder, msg.Recorder),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+		),
+	)
+
+	return &types.MsgRemoveWrkChainRecorderResponse{}, nil
+}
+
+// checkRecorderMsg runs the checks shared by the add and remove recorder Msgs, returning the owner and recorder
+// addresses
+func (k msgServer) checkRecorderMsg(ctx sdk.Context, wrkchainId uint64, owner, recorder string) (sdk.AccAddress, sdk.AccAddress, error) {
+	ownerAddr, accErr := sdk.AccAddressFromBech32(owner)
+	if accErr != nil {
+		return nil, nil, accErr
+	}
+
+	recorderAddr, accErr := sdk.AccAddressFromBech32(recorder)
+	if accErr != nil {
+		return nil, nil, accErr
+	}
+
+	if !k.IsWrkChainRegistered(ctx, wrkchainId) { // Checks if the WrkChain is registered
+		return nil, nil, errorsmod.Wrap(types.ErrWrkChainDoesNotExist, "wrkchain has not been registered yet") // If not, throw an error
+	}
+
+	if !k.IsWrkChainOwner(ctx, wrkchainId, ownerAddr) {
+		return nil, nil, errorsmod.Wrap(types.ErrNotWrkChainOwner, "you are not the owner of this wrkchain")
+	}
+
+	return ownerAddr, recorderAddr, nil
+}
+
 func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.authority != req.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
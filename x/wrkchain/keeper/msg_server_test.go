@@ -385,3 +385,262 @@ func (s *KeeperTestSuite) TestPurchaseWrkChainStateStorage() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestRecordWrkChainBlockByRecorder() {
+
+	_, _ = s.msgServer.RegisterWrkChain(s.ctx, &types.MsgRegisterWrkChain{
+		Owner:   s.addrs[0].String(),
+		Name:    "testname",
+		Moniker: "testmoniker",
+	})
+
+	request := &types.MsgRecordWrkChainBlock{
+		Owner:      s.addrs[1].String(),
+		WrkchainId: 1,
+		Height:     1,
+		BlockHash:  "blockhash",
+	}
+
+	_, err := s.msgServer.RecordWrkChainBlock(s.ctx, request)
+	s.Require().ErrorContains(err, "you are not the owner of this wrkchain or one of its authorised recorders")
+
+	_, err = s.msgServer.AddWrkChainRecorder(s.ctx, types.NewMsgAddWrkChainRecorder(1, s.addrs[1], s.addrs[0]))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.RecordWrkChainBlock(s.ctx, request)
+	s.Require().NoError(err)
+
+	// recorders cannot purchase storage
+	_, err = s.msgServer.PurchaseWrkChainStateStorage(s.ctx, types.NewMsgPurchaseWrkChainStateStorage(1, 10, s.addrs[1]))
+	s.Require().ErrorContains(err, "you are not the owner of this wrkchain")
+
+	_, err = s.msgServer.RemoveWrkChainRecorder(s.ctx, types.NewMsgRemoveWrkChainRecorder(1, s.addrs[1], s.addrs[0]))
+	s.Require().NoError(err)
+
+	request.Height = 2
+	_, err = s.msgServer.RecordWrkChainBlock(s.ctx, request)
+	s.Require().ErrorContains(err, "you are not the owner of this wrkchain or one of its authorised recorders")
+}
+
+func (s *KeeperTestSuite) TestTransferWrkChainOwnership() {
+
+	_, _ = s.msgServer.RegisterWrkChain(s.ctx, &types.MsgRegisterWrkChain{
+		Owner:   s.addrs[0].String(),
+		Name:    "testname",
+		Moniker: "testmoniker",
+	})
+
+	testCases := []struct {
+		name        string
+		request     *types.MsgTransferWrkChainOwnership
+		expectErr   bool
+		expectedErr string
+	}{
+		{
+			name: "set invalid owner",
+			request: &types.MsgTransferWrkChainOwnership{
+				Owner:    "invalidaddr",
+				NewOwner: s.addrs[1].String(),
+			},
+			expectErr:   true,
+			expectedErr: "decoding bech32 failed",
+		},
+		{
+			name: "set invalid new owner",
+			request: &types.MsgTransferWrkChainOwnership{
+				Owner:    s.addrs[0].String(),
+				NewOwner: "invalidaddr",
+			},
+			expectErr:   true,
+			expectedErr: "decoding bech32 failed",
+		},
+		{
+			name:        "wrkchain not registered",
+			request:     types.NewMsgTransferWrkChainOwnership(99, s.addrs[1], s.addrs[0]),
+			expectErr:   true,
+			expectedErr: "wrkchain has not been registered yet",
+		},
+		{
+			name:        "not owner",
+			request:     types.NewMsgTransferWrkChainOwnership(1, s.addrs[1], s.addrs[1]),
+			expectErr:   true,
+			expectedErr: "you are not the owner of this wrkchain",
+		},
+		{
+			name:        "already owner",
+			request:     types.NewMsgTransferWrkChainOwnership(1, s.addrs[0], s.addrs[0]),
+			expectErr:   true,
+			expectedErr: "new owner is already the owner of this wrkchain",
+		},
+		{
+			name:        "transfer ok",
+			request:     types.NewMsgTransferWrkChainOwnership(1, s.addrs[1], s.addrs[0]),
+			expectErr:   false,
+			expectedErr: "",
+		},
+		{
+			name:        "previous owner can no longer transfer",
+			request:     types.NewMsgTransferWrkChainOwnership(1, s.addrs[2], s.addrs[0]),
+			expectErr:   true,
+			expectedErr: "you are not the owner of this wrkchain",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.TransferWrkChainOwnership(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectedErr)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	wrkchain, found := s.app.WrkchainKeeper.GetWrkChain(s.ctx, 1)
+	s.Require().True(found)
+	s.Require().Equal(s.addrs[1].String(), wrkchain.Owner)
+}
+
+func (s *KeeperTestSuite) TestAddWrkChainRecorder() {
+
+	_, _ = s.msgServer.RegisterWrkChain(s.ctx, &types.MsgRegisterWrkChain{
+		Owner:   s.addrs[0].String(),
+		Name:    "testname",
+		Moniker: "testmoniker",
+	})
+
+	testCases := []struct {
+		name        string
+		request     *types.MsgAddWrkChainRecorder
+		expectErr   bool
+		expectedErr string
+	}{
+		{
+			name: "set invalid recorder",
+			request: &types.MsgAddWrkChainRecorder{
+				Owner:    s.addrs[0].String(),
+				Recorder: "invalidaddr",
+			},
+			expectErr:   true,
+			expectedErr: "decoding bech32 failed",
+		},
+		{
+			name:        "wrkchain not registered",
+			request:     types.NewMsgAddWrkChainRecorder(99, s.addrs[1], s.addrs[0]),
+			expectErr:   true,
+			expectedErr: "wrkchain has not been registered yet",
+		},
+		{
+			name:        "not owner",
+			request:     types.NewMsgAddWrkChainRecorder(1, s.addrs[2], s.addrs[1]),
+			expectErr:   true,
+			expectedErr: "you are not the owner of this wrkchain",
+		},
+		{
+			name:        "owner as recorder",
+			request:     types.NewMsgAddWrkChainRecorder(1, s.addrs[0], s.addrs[0]),
+			expectErr:   true,
+			expectedErr: "the owner cannot be added as a recorder",
+		},
+		{
+			name:        "add ok",
+			request:     types.NewMsgAddWrkChainRecorder(1, s.addrs[1], s.addrs[0]),
+			expectErr:   false,
+			expectedErr: "",
+		},
+		{
+			name:        "already recorder",
+			request:     types.NewMsgAddWrkChainRecorder(1, s.addrs[1], s.addrs[0]),
+			expectErr:   true,
+			expectedErr: "is already a recorder for this wrkchain",
+		},
+		{
+			name:        "recorders cannot add recorders",
+			request:     types.NewMsgAddWrkChainRecorder(1, s.addrs[2], s.addrs[1]),
+			expectErr:   true,
+			expectedErr: "you are not the owner of this wrkchain",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.AddWrkChainRecorder(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectedErr)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	s.Require().Equal([]string{s.addrs[1].String()}, s.app.WrkchainKeeper.GetWrkChainRecorders(s.ctx, 1))
+}
+
+func (s *KeeperTestSuite) TestRemoveWrkChainRecorder() {
+
+	_, _ = s.msgServer.RegisterWrkChain(s.ctx, &types.MsgRegisterWrkChain{
+		Owner:   s.addrs[0].String(),
+		Name:    "testname",
+		Moniker: "testmoniker",
+	})
+
+	s.app.WrkchainKeeper.SetWrkChainRecorder(s.ctx, 1, s.addrs[1])
+
+	testCases := []struct {
+		name        string
+		request     *types.MsgRemoveWrkChainRecorder
+		expectErr   bool
+		expectedErr string
+	}{
+		{
+			name:        "wrkchain not registered",
+			request:     types.NewMsgRemoveWrkChainRecorder(99, s.addrs[1], s.addrs[0]),
+			expectErr:   true,
+			expectedErr: "wrkchain has not been registered yet",
+		},
+		{
+			name:        "not owner",
+			request:     types.NewMsgRemoveWrkChainRecorder(1, s.addrs[1], s.addrs[2]),
+			expectErr:   true,
+			expectedErr: "you are not the owner of this wrkchain",
+		},
+		{
+			name:        "not recorder",
+			request:     types.NewMsgRemoveWrkChainRecorder(1, s.addrs[2], s.addrs[0]),
+			expectErr:   true,
+			expectedErr: "is not a recorder for this wrkchain",
+		},
+		{
+			name:        "remove ok",
+			request:     types.NewMsgRemoveWrkChainRecorder(1, s.addrs[1], s.addrs[0]),
+			expectErr:   false,
+			expectedErr: "",
+		},
+		{
+			name:        "already removed",
+			request:     types.NewMsgRemoveWrkChainRecorder(1, s.addrs[1], s.addrs[0]),
+			expectErr:   true,
+			expectedErr: "is not a recorder for this wrkchain",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		s.Run(tc.name, func() {
+			_, err := s.msgServer.RemoveWrkChainRecorder(s.ctx, tc.request)
+			if tc.expectErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.expectedErr)
+			} else {
+				s.Require().NoError(err)
+			}
+		})
+	}
+
+	s.Require().Empty(s.app.WrkchainKeeper.GetWrkChainRecorders(s.ctx, 1))
+}
@@ -47,9 +47,9 @@ func (k Keeper) IsWrkChainBlockRecorded(ctx sdk.Context, wrkchainId uint64, heig
 	return store.Has(blockKey)
 }
 
-// IsAuthorisedToRecord ensures only the WRKChain owner is recording hashes
+// IsAuthorisedToRecord ensures only the WRKChain owner or one of its authorised recorders is recording hashes
 func (k Keeper) IsAuthorisedToRecord(ctx sdk.Context, wrkchainId uint64, recorder sdk.AccAddress) bool {
-	return recorder.Equals(k.GetWrkChainOwner(ctx, wrkchainId))
+	return k.IsWrkChainOwner(ctx, wrkchainId, recorder) || k.IsWrkChainRecorder(ctx, wrkchainId, recorder)
 }
 
 // GetWrkChainBlock Gets the entire WRKChain metadata struct for a wrkchainId
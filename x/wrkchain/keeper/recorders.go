@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/unification-com/mainchain/x/wrkchain/types"
+)
+
+//__OWNERSHIP___________________________________________________________
+
+// IsWrkChainOwner checks if the address is the current owner of a WRKChain
+func (k Keeper) IsWrkChainOwner(ctx sdk.Context, wrkchainId uint64, address sdk.AccAddress) bool {
+	owner := k.GetWrkChainOwner(ctx, wrkchainId)
+	return !owner.Empty() && address.Equals(owner)
+}
+
+// TransferWrkChainOwnership sets a new owner for a WRKChain. Authorised recorders are kept, except the new owner,
+// who no longer needs a recorder entry
+func (k Keeper) TransferWrkChainOwnership(ctx sdk.Context, wrkchainId uint64, newOwner sdk.AccAddress) error {
+	wrkchain, found := k.GetWrkChain(ctx, wrkchainId)
+	if !found {
+		return errorsmod.Wrap(types.ErrWrkChainDoesNotExist, "wrkchain has not been registered yet")
+	}
+
+	if k.IsWrkChainOwner(ctx, wrkchainId, newOwner) {
+		return errorsmod.Wrap(types.ErrInvalidData, "new owner is already the owner of this wrkchain")
+	}
+
+	wrkchain.Owner = newOwner.String()
+
+	err := k.SetWrkChain(ctx, wrkchain)
+	if err != nil {
+		return err
+	}
+
+	k.DeleteWrkChainRecorder(ctx, wrkchainId, newOwner)
+
+	return nil
+}
+
+//__RECORDERS___________________________________________________________
+
+// SetWrkChainRecorder authorises an address to record hashes for a WRKChain
+func (k Keeper) SetWrkChainRecorder(ctx sdk.Context, wrkchainId uint64, recorder sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.WrkChainRecorderKey(wrkchainId, recorder), recorder)
+}
+
+// DeleteWrkChainRecorder removes an address from a WRKChain's authorised recorders
+func (k Keeper) DeleteWrkChainRecorder(ctx sdk.Context, wrkchainId uint64, recorder sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.WrkChainRecorderKey(wrkchainId, recorder))
+}
+
+// IsWrkChainRecorder checks if the address is one of a WRKChain's authorised recorders
+func (k Keeper) IsWrkChainRecorder(ctx sdk.Context, wrkchainId uint64, recorder sdk.AccAddress) bool {
+	if recorder.Empty() {
+		return false
+	}
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.WrkChainRecorderKey(wrkchainId, recorder))
+}
+
+// IterateWrkChainRecorders iterates over a WRKChain's authorised recorders
+func (k Keeper) IterateWrkChainRecorders(ctx sdk.Context, wrkchainId uint64, cb func(recorder sdk.AccAddress) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.WrkChainAllRecordersKey(wrkchainId))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		if cb(iterator.Value()) {
+			break
+		}
+	}
+}
+
+// GetWrkChainRecorders returns the addresses of a WRKChain's authorised recorders
+func (k Keeper) GetWrkChainRecorders(ctx sdk.Context, wrkchainId uint64) (recorders []string) {
+	k.IterateWrkChainRecorders(ctx, wrkchainId, func(recorder sdk.AccAddress) bool {
+		recorders = append(recorders, recorder.String())
+		return false
+	})
+	return
+}
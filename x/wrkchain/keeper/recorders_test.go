@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	simapphelpers "github.com/unification-com/mainchain/app/helpers"
+	"github.com/unification-com/mainchain/x/wrkchain"
+	"github.com/unification-com/mainchain/x/wrkchain/types"
+)
+
+func TestSetDeleteWrkChainRecorder(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(5)
+	owner := testAddrs[0]
+
+	wcID, err := app.WrkchainKeeper.RegisterNewWrkChain(ctx, "moniker", "name", "genesis", "geth", owner)
+	require.NoError(t, err)
+
+	require.Empty(t, app.WrkchainKeeper.GetWrkChainRecorders(ctx, wcID))
+
+	for _, recorder := range testAddrs[1:] {
+		require.False(t, app.WrkchainKeeper.IsWrkChainRecorder(ctx, wcID, recorder))
+		require.False(t, app.WrkchainKeeper.IsAuthorisedToRecord(ctx, wcID, recorder))
+
+		app.WrkchainKeeper.SetWrkChainRecorder(ctx, wcID, recorder)
+
+		require.True(t, app.WrkchainKeeper.IsWrkChainRecorder(ctx, wcID, recorder))
+		require.True(t, app.WrkchainKeeper.IsAuthorisedToRecord(ctx, wcID, recorder))
+		require.False(t, app.WrkchainKeeper.IsWrkChainOwner(ctx, wcID, recorder))
+
+		// recorders are only authorised for their own wrkchain
+		require.False(t, app.WrkchainKeeper.IsAuthorisedToRecord(ctx, wcID+1, recorder))
+	}
+
+	require.Len(t, app.WrkchainKeeper.GetWrkChainRecorders(ctx, wcID), len(testAddrs)-1)
+
+	app.WrkchainKeeper.DeleteWrkChainRecorder(ctx, wcID, testAddrs[1])
+
+	require.False(t, app.WrkchainKeeper.IsAuthorisedToRecord(ctx, wcID, testAddrs[1]))
+	require.True(t, app.WrkchainKeeper.IsAuthorisedToRecord(ctx, wcID, testAddrs[2]))
+	require.True(t, app.WrkchainKeeper.IsAuthorisedToRecord(ctx, wcID, owner))
+	require.Len(t, app.WrkchainKeeper.GetWrkChainRecorders(ctx, wcID), len(testAddrs)-2)
+}
+
+func TestTransferWrkChainOwnership(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(4)
+	owner := testAddrs[0]
+	newOwner := testAddrs[1]
+	recorder := testAddrs[2]
+
+	err := app.WrkchainKeeper.TransferWrkChainOwnership(ctx, 1, newOwner)
+	require.ErrorIs(t, err, types.ErrWrkChainDoesNotExist)
+
+	wcID, err := app.WrkchainKeeper.RegisterNewWrkChain(ctx, "moniker", "name", "genesis", "geth", owner)
+	require.NoError(t, err)
+
+	app.WrkchainKeeper.SetWrkChainRecorder(ctx, wcID, newOwner)
+	app.WrkchainKeeper.SetWrkChainRecorder(ctx, wcID, recorder)
+
+	err = app.WrkchainKeeper.TransferWrkChainOwnership(ctx, wcID, owner)
+	require.ErrorIs(t, err, types.ErrInvalidData)
+
+	err = app.WrkchainKeeper.TransferWrkChainOwnership(ctx, wcID, newOwner)
+	require.NoError(t, err)
+
+	require.Equal(t, newOwner, app.WrkchainKeeper.GetWrkChainOwner(ctx, wcID))
+	require.True(t, app.WrkchainKeeper.IsWrkChainOwner(ctx, wcID, newOwner))
+	require.False(t, app.WrkchainKeeper.IsWrkChainOwner(ctx, wcID, owner))
+	require.False(t, app.WrkchainKeeper.IsAuthorisedToRecord(ctx, wcID, owner))
+
+	// the new owner no longer needs a recorder entry, other recorders are kept
+	require.False(t, app.WrkchainKeeper.IsWrkChainRecorder(ctx, wcID, newOwner))
+	require.True(t, app.WrkchainKeeper.IsAuthorisedToRecord(ctx, wcID, newOwner))
+	require.True(t, app.WrkchainKeeper.IsAuthorisedToRecord(ctx, wcID, recorder))
+	require.Equal(t, []string{recorder.String()}, app.WrkchainKeeper.GetWrkChainRecorders(ctx, wcID))
+}
+
+func TestWrkChainRecordersGenesis(t *testing.T) {
+	app := simapphelpers.Setup(t)
+	ctx := app.BaseApp.NewContext(false)
+	testAddrs := simapphelpers.GenerateRandomTestAccounts(3)
+	owner := testAddrs[0]
+
+	wcID, err := app.WrkchainKeeper.RegisterNewWrkChain(ctx, "moniker", "name", "genesis", "geth", owner)
+	require.NoError(t, err)
+
+	app.WrkchainKeeper.SetWrkChainRecorder(ctx, wcID, testAddrs[1])
+	app.WrkchainKeeper.SetWrkChainRecorder(ctx, wcID, testAddrs[2])
+
+	exported := wrkchain.ExportGenesis(ctx, app.WrkchainKeeper)
+	require.Len(t, exported.RegisteredWrkchains, 1)
+	require.ElementsMatch(t, []string{testAddrs[1].String(), testAddrs[2].String()}, exported.RegisteredWrkchains[0].Recorders)
+	require.NoError(t, types.ValidateGenesis(*exported))
+
+	app2 := simapphelpers.Setup(t)
+	ctx2 := app2.BaseApp.NewContext(false)
+
+	wrkchain.InitGenesis(ctx2, app2.WrkchainKeeper, *exported)
+
+	require.True(t, app2.WrkchainKeeper.IsAuthorisedToRecord(ctx2, wcID, owner))
+	require.True(t, app2.WrkchainKeeper.IsWrkChainRecorder(ctx2, wcID, testAddrs[1]))
+	require.True(t, app2.WrkchainKeeper.IsWrkChainRecorder(ctx2, wcID, testAddrs[2]))
+	require.Equal(t, exported, wrkchain.ExportGenesis(ctx2, app2.WrkchainKeeper))
+}
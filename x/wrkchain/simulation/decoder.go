@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/unification-com/mainchain/x/wrkchain/types"
@@ -29,6 +30,8 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			kA := binary.BigEndian.Uint64(kvA.Value)
 			kB := binary.BigEndian.Uint64(kvB.Value)
 			return fmt.Sprintf("%v\n%v", kA, kB)
+		case bytes.Equal(kvA.Key[:1], types.WrkChainRecorderPrefix):
+			return fmt.Sprintf("%v\n%v", sdk.AccAddress(kvA.Value), sdk.AccAddress(kvB.Value))
 		default:
 			panic(fmt.Sprintf("invalid wrkchain key prefix %X", kvA.Key[:1]))
 		}
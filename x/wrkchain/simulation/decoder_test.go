@@ -41,6 +41,7 @@ func TestDecodeStore(t *testing.T) {
 		Pairs: []kv.Pair{
 			{Key: types.WrkChainKey(1), Value: wcBz},
 			{Key: types.WrkChainBlockKey(1, 1), Value: blockBz},
+			{Key: types.WrkChainRecorderKey(1, bAddr1), Value: bAddr1},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -51,6 +52,7 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"wrkchain", fmt.Sprintf("%v\n%v", wc, wc)},
 		{"wrkchain block", fmt.Sprintf("%v\n%v", block, block)},
+		{"wrkchain recorder", fmt.Sprintf("%v\n%v", bAddr1, bAddr1)},
 		{"other", ""},
 	}
 
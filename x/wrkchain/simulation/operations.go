@@ -20,10 +20,12 @@ const (
 	OpWeightMsgRegisterWrkChain             = "op_weight_msg_register_wrkchain"
 	OpWeightMsgRecordWrkChainBlock          = "op_weight_msg_record_wrkchain_hash"
 	OpWeightMsgPurchaseWrkChainStateStorage = "op_weight_msg_wrkchain_purchase_storage"
+	OpWeightMsgAddWrkChainRecorder          = "op_weight_msg_wrkchain_add_recorder"
 
 	DefaultMsgRegisterWrkChain             = 10
 	DefaultMsgRecordWrkChainBlock          = 30
 	DefaultMsgPurchaseWrkChainStateStorage = 5
+	DefaultMsgAddWrkChainRecorder          = 5
 )
 
 //func WeightedOperations(
@@ -42,6 +44,7 @@ func WeightedOperations(
 		weightMsgRegisterWrkChain             int
 		weightMsgRecordWrkChainBlock          int
 		weightMsgPurchaseWrkChainStateStorage int
+		weightMsgAddWrkChainRecorder          int
 	)
 
 	appParams.GetOrGenerate(OpWeightMsgRegisterWrkChain, &weightMsgRegisterWrkChain, nil,
@@ -62,6 +65,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(OpWeightMsgAddWrkChainRecorder, &weightMsgAddWrkChainRecorder, nil,
+		func(_ *rand.Rand) {
+			weightMsgAddWrkChainRecorder = DefaultMsgAddWrkChainRecorder
+		},
+	)
+
 	wEntOps := simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgRegisterWrkChain,
@@ -75,6 +84,10 @@ func WeightedOperations(
 			weightMsgPurchaseWrkChainStateStorage,
 			SimulateMsgPurchaseWrkChainStateStorage(k, bk, ak),
 		),
+		simulation.NewWeightedOperation(
+			weightMsgAddWrkChainRecorder,
+			SimulateMsgAddWrkChainRecorder(k, bk, ak),
+		),
 	}
 
 	return wEntOps
@@ -296,6 +309,69 @@ func SimulateMsgPurchaseWrkChainStateStorage(k keeper.Keeper, bk types.BankKeepe
 	}
 }
 
+func SimulateMsgAddWrkChainRecorder(k keeper.Keeper, bk types.BankKeeper, ak types.AccountKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context,
+		accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		wrkchain, err := getRandomWrkChain(r, k, ctx)
+
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.AddRecorderAction, "no wrkchains"), nil, nil // skip
+		}
+
+		wrkchainOwnerAddr, err := sdk.AccAddressFromBech32(wrkchain.Owner)
+
+		simAccount, found := simtypes.FindAccount(accs, wrkchainOwnerAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, types.AddRecorderAction, "unable to find account"), nil, nil // skip
+		}
+
+		recorderAccount, _ := simtypes.RandomAcc(r, accs)
+		if recorderAccount.Address.Equals(wrkchainOwnerAddr) || k.IsWrkChainRecorder(ctx, wrkchain.WrkchainId, recorderAccount.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, types.AddRecorderAction, "already authorised to record"), nil, nil // skip
+		}
+
+		account := ak.GetAccount(ctx, wrkchainOwnerAddr)
+		spendable := bk.SpendableCoins(ctx, account.GetAddress())
+
+		fees, err := simtypes.RandomFees(r, ctx, spendable)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, types.AddRecorderAction, "unable to generate fees"), nil, err
+		}
+
+		msg := types.NewMsgAddWrkChainRecorder(wrkchain.WrkchainId, recorderAccount.Address, account.GetAddress())
+
+		txGen := moduletestutil.MakeTestEncodingConfig().TxConfig
+
+		tx, err := simtestutil.GenSignedMockTx(
+			r,
+			txGen,
+			[]sdk.Msg{msg},
+			fees,
+			simtestutil.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to generate mock tx"), nil, err
+		}
+
+		_, _, err = app.SimDeliver(txGen.TxEncoder(), tx)
+
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msg.Type(), "unable to deliver tx"), nil, err
+		}
+
+		opMsg := simtypes.NewOperationMsg(msg, true, "")
+
+		return opMsg, nil, nil
+	}
+}
+
 func getRandomWrkChain(r *rand.Rand, k keeper.Keeper,
 	ctx sdk.Context) (wrkchain types.WrkChain, err error) {
 	wrkChains := k.GetAllWrkChains(ctx)
@@ -19,6 +19,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgRegisterWrkChain{}, "wrkchain/MsgRegisterWrkChain")
 	legacy.RegisterAminoMsg(cdc, &MsgRecordWrkChainBlock{}, "wrkchain/MsgRecordWrkChainBlock")
 	legacy.RegisterAminoMsg(cdc, &MsgPurchaseWrkChainStateStorage{}, "wrkchain/MsgPurchaseWrkChainStorage")
+	legacy.RegisterAminoMsg(cdc, &MsgTransferWrkChainOwnership{}, "wrkchain/MsgTransferWrkChainOwnership")
+	legacy.RegisterAminoMsg(cdc, &MsgAddWrkChainRecorder{}, "wrkchain/MsgAddWrkChainRecorder")
+	legacy.RegisterAminoMsg(cdc, &MsgRemoveWrkChainRecorder{}, "wrkchain/MsgRemoveWrkChainRecorder")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "mainchain/x/wrkchain/MsgUpdateParams")
 
 }
@@ -29,6 +32,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgRecordWrkChainBlock{},
 		&MsgUpdateParams{},
 		&MsgPurchaseWrkChainStateStorage{},
+		&MsgTransferWrkChainOwnership{},
+		&MsgAddWrkChainRecorder{},
+		&MsgRemoveWrkChainRecorder{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -16,6 +16,8 @@ const (
 	CodeContentTooLarge              = 207
 	CodeExceedsMaxStorage            = 208
 	CodeNewHeightMustBeHigher        = 209
+	CodeAlreadyWrkChainRecorder      = 210
+	CodeNotWrkChainRecorder          = 211
 
 	CodeWrkChainInsufficientFee   = 301
 	CodeWrkChainTooMuchFee        = 302
@@ -39,4 +41,6 @@ var (
 	ErrContentTooLarge              = errorsmod.Register(ModuleName, CodeContentTooLarge, "msg content too large")
 	ErrExceedsMaxStorage            = errorsmod.Register(ModuleName, CodeExceedsMaxStorage, "exceeds max storage")
 	ErrNewHeightMustBeHigher        = errorsmod.Register(ModuleName, CodeNewHeightMustBeHigher, "invalid height")
+	ErrAlreadyWrkChainRecorder      = errorsmod.Register(ModuleName, CodeAlreadyWrkChainRecorder, "already wrkchain recorder")
+	ErrNotWrkChainRecorder          = errorsmod.Register(ModuleName, CodeNotWrkChainRecorder, "not wrkchain recorder")
 )
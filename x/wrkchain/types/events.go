@@ -4,10 +4,15 @@ var (
 	EventTypeRegisterWrkChain    = RegisterAction
 	EventTypeRecordWrkChainBlock = RecordAction
 	EventTypePurchaseStorage     = PurchaseStorageAction
+	EventTypeTransferOwnership   = TransferOwnershipAction
+	EventTypeAddRecorder         = AddRecorderAction
+	EventTypeRemoveRecorder      = RemoveRecorderAction
 
 	AttributeValueCategory = ModuleName
 
 	AttributeKeyOwner                         = "wrkchain_owner"
+	AttributeKeyNewOwner                      = "wrkchain_new_owner"
+	AttributeKeyRecorder                      = "wrkchain_recorder"
 	AttributeKeyWrkChainId                    = "wrkchain_id"
 	AttributeKeyWrkChainMoniker               = "wrkchain_moniker"
 	AttributeKeyWrkChainName                  = "wrkchain_name"
@@ -63,6 +63,19 @@ func ValidateGenesis(data GenesisState) error {
 		if record.InStateLimit == 0 {
 			return fmt.Errorf("invalid WrkChain: InStateLimit: %d. Error: Missing InStateLimit", record.InStateLimit)
 		}
+		recorders := make(map[string]bool)
+		for _, recorder := range record.Recorders {
+			if _, err := sdk.AccAddressFromBech32(recorder); err != nil {
+				return fmt.Errorf("invalid WrkChain recorder: %s. Error: %s", recorder, err)
+			}
+			if recorder == record.Wrkchain.Owner {
+				return fmt.Errorf("invalid WrkChain recorder: %s. Error: Recorder is the Owner", recorder)
+			}
+			if recorders[recorder] {
+				return fmt.Errorf("invalid WrkChain recorder: %s. Error: Duplicate Recorder", recorder)
+			}
+			recorders[recorder] = true
+		}
 		for _, block := range record.Blocks {
 			if block.Bh == "" {
 				return fmt.Errorf("invalid WrkChain block: BlockHash: %s. Error: Missing BlockHash", block.Bh)
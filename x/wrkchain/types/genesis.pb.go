@@ -184,6 +184,8 @@ type WrkChainExport struct {
 	Wrkchain     WrkChain                    `protobuf:"bytes,1,opt,name=wrkchain,proto3" json:"wrkchain"`
 	InStateLimit uint64                      `protobuf:"varint,2,opt,name=in_state_limit,json=inStateLimit,proto3" json:"in_state_limit,omitempty"`
 	Blocks       WrkChainBlockGenesisExports `protobuf:"bytes,3,rep,name=blocks,proto3,castrepeated=WrkChainBlockGenesisExports" json:"blocks"`
+	// recorders are the addresses authorised by the owner to record block hashes for the wrkchain
+	Recorders []string `protobuf:"bytes,4,rep,name=recorders,proto3" json:"recorders,omitempty"`
 }
 
 func (m *WrkChainExport) Reset()         { *m = WrkChainExport{} }
@@ -240,6 +242,13 @@ func (m *WrkChainExport) GetBlocks() WrkChainBlockGenesisExports {
 	return nil
 }
 
+func (m *WrkChainExport) GetRecorders() []string {
+	if m != nil {
+		return m.Recorders
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "mainchain.wrkchain.v1.GenesisState")
 	proto.RegisterType((*WrkChainBlockGenesisExport)(nil), "mainchain.wrkchain.v1.WrkChainBlockGenesisExport")
@@ -251,36 +260,36 @@ func init() {
 }
 
 var fileDescriptor_5caf598c578dc492 = []byte{
-	// 450 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x6f, 0xd3, 0x30,
-	0x18, 0xc6, 0xeb, 0xb6, 0x14, 0xf0, 0xa6, 0x22, 0x99, 0x22, 0xac, 0x22, 0xd2, 0xaa, 0x1b, 0x52,
-	0x2f, 0xa4, 0xa4, 0x15, 0x27, 0x4e, 0x04, 0x21, 0x84, 0x84, 0x04, 0x0a, 0x87, 0x49, 0x5c, 0xa2,
-	0x24, 0x35, 0xb1, 0xd5, 0xc5, 0x0e, 0xb6, 0x37, 0xc6, 0x77, 0xe0, 0xc0, 0xe7, 0xe0, 0x93, 0xec,
-	0xb8, 0x23, 0x27, 0x40, 0xed, 0x89, 0x1b, 0x1f, 0x01, 0xd9, 0x71, 0x52, 0x4d, 0x6c, 0xea, 0xcd,
-	0x3f, 0xfb, 0x79, 0xdf, 0xc7, 0xef, 0x1f, 0x78, 0x50, 0x24, 0x8c, 0x67, 0x34, 0x61, 0x7c, 0xf6,
-	0x59, 0xae, 0xaa, 0xc3, 0x69, 0x30, 0xcb, 0x09, 0x27, 0x8a, 0x29, 0xbf, 0x94, 0x42, 0x0b, 0x74,
-	0xaf, 0x11, 0xf9, 0xb5, 0xc8, 0x3f, 0x0d, 0x86, 0x83, 0x5c, 0xe4, 0xc2, 0x2a, 0x66, 0xe6, 0x54,
-	0x89, 0x87, 0x87, 0x57, 0x67, 0x6c, 0x02, 0xad, 0x6a, 0xf2, 0x17, 0xc0, 0xfd, 0x57, 0x95, 0xc9,
-	0x7b, 0x9d, 0x68, 0x82, 0x9e, 0xc1, 0x5e, 0x99, 0xc8, 0xa4, 0x50, 0x18, 0x8c, 0xc1, 0x74, 0x6f,
-	0xfe, 0xd0, 0xbf, 0xd2, 0xd4, 0x7f, 0x67, 0x45, 0x61, 0xf7, 0xfc, 0xe7, 0xa8, 0x15, 0xb9, 0x10,
-	0xf4, 0x04, 0x0e, 0x94, 0x4e, 0xa4, 0x66, 0x3c, 0x8f, 0x6b, 0x71, 0xcc, 0x96, 0xb8, 0x3d, 0x06,
-	0xd3, 0x6e, 0x84, 0xea, 0xb7, 0x23, 0xf7, 0xf4, 0x7a, 0x89, 0x3e, 0xc1, 0x81, 0x24, 0x39, 0x53,
-	0x9a, 0x48, 0xb2, 0x6c, 0x62, 0x14, 0xee, 0x8c, 0x3b, 0xd3, 0xbd, 0xf9, 0xa3, 0x6b, 0xcc, 0x8f,
-	0xe4, 0xea, 0x85, 0x39, 0xbf, 0x3c, 0x2b, 0x85, 0xd4, 0xe1, 0x7d, 0xf3, 0x89, 0xef, 0xbf, 0x46,
-	0x77, 0x2e, 0xdf, 0xab, 0xe8, 0xee, 0x36, 0x77, 0xed, 0xa9, 0x26, 0x5f, 0x01, 0x1c, 0xd6, 0xc2,
-	0xf0, 0x58, 0x64, 0x2b, 0x57, 0x7f, 0x15, 0x84, 0xfa, 0xb0, 0x4d, 0x89, 0x2d, 0xbe, 0x1b, 0xb5,
-	0x29, 0x31, 0x9c, 0x52, 0x5b, 0xc1, 0xed, 0xa8, 0x9d, 0x52, 0xc3, 0x25, 0xc5, 0x9d, 0x8a, 0x4b,
-	0xcb, 0x34, 0xc0, 0xdd, 0x8a, 0x69, 0x60, 0x79, 0x8e, 0x6f, 0x38, 0x9e, 0x5b, 0x5e, 0xe0, 0x9e,
-	0xe3, 0x85, 0x61, 0xa5, 0xf1, 0xcd, 0x2a, 0xbf, 0xd2, 0x93, 0x3f, 0x00, 0xf6, 0x2f, 0xff, 0x1b,
-	0x3d, 0x87, 0xb7, 0xea, 0x6a, 0xdd, 0x14, 0x46, 0x3b, 0x1a, 0xe1, 0xe6, 0xd0, 0x84, 0xa1, 0x43,
-	0xd8, 0x67, 0x3c, 0x56, 0x66, 0xa4, 0xf1, 0x31, 0x2b, 0x98, 0x76, 0x33, 0xd8, 0x67, 0xdc, 0xce,
-	0xf9, 0x8d, 0xb9, 0x43, 0x1c, 0xf6, 0x52, 0xd3, 0x81, 0xba, 0xdf, 0xc1, 0x2e, 0x9b, 0xff, 0xda,
-	0x15, 0x1e, 0xb8, 0xde, 0x3f, 0xb8, 0x5e, 0xa3, 0x22, 0xe7, 0x12, 0xbe, 0x3d, 0x5f, 0x7b, 0xe0,
-	0x62, 0xed, 0x81, 0xdf, 0x6b, 0x0f, 0x7c, 0xdb, 0x78, 0xad, 0x8b, 0x8d, 0xd7, 0xfa, 0xb1, 0xf1,
-	0x5a, 0x1f, 0x9e, 0xe6, 0x4c, 0xd3, 0x93, 0xd4, 0xcf, 0x44, 0x31, 0x3b, 0xe1, 0xec, 0x23, 0xcb,
-	0x12, 0xcd, 0x04, 0x7f, 0x6c, 0x78, 0xbb, 0xc8, 0x67, 0xdb, 0x55, 0xd6, 0x5f, 0x4a, 0xa2, 0xd2,
-	0x9e, 0xdd, 0xe2, 0xc5, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x26, 0x88, 0xd8, 0xa0, 0x3f, 0x03,
-	0x00, 0x00,
+	// 464 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc7, 0xeb, 0x34, 0x14, 0xea, 0x4d, 0x45, 0x32, 0x45, 0x58, 0x05, 0xd2, 0xaa, 0x1b, 0x52,
+	0x2f, 0xa4, 0xa4, 0x15, 0x27, 0x4e, 0x04, 0x21, 0x84, 0x84, 0x04, 0x0a, 0x87, 0x49, 0x5c, 0xaa,
+	0x24, 0x35, 0xb1, 0xd5, 0xd5, 0x0e, 0xb6, 0x37, 0xc6, 0x13, 0x70, 0xe1, 0xc0, 0x73, 0xf0, 0x24,
+	0x3b, 0xee, 0xc8, 0x09, 0x50, 0xfb, 0x02, 0x3c, 0x02, 0xb2, 0xe3, 0xb4, 0x9a, 0xd8, 0xd4, 0x9b,
+	0x3f, 0xf6, 0xf7, 0xf7, 0xfb, 0xfa, 0xf7, 0x07, 0x1e, 0x2c, 0x53, 0xc6, 0x73, 0x9a, 0x32, 0x3e,
+	0xfe, 0x2c, 0x17, 0xd5, 0xe1, 0x34, 0x1a, 0x17, 0x84, 0x13, 0xc5, 0x54, 0x58, 0x4a, 0xa1, 0x05,
+	0xba, 0xbb, 0x11, 0x85, 0xb5, 0x28, 0x3c, 0x8d, 0x7a, 0xdd, 0x42, 0x14, 0xc2, 0x2a, 0xc6, 0xe6,
+	0x54, 0x89, 0x7b, 0x87, 0x57, 0x67, 0xdc, 0x04, 0x5a, 0xd5, 0xf0, 0x2f, 0x80, 0xfb, 0xaf, 0x2a,
+	0x93, 0xf7, 0x3a, 0xd5, 0x04, 0x3d, 0x83, 0xad, 0x32, 0x95, 0xe9, 0x52, 0x61, 0x30, 0x00, 0xa3,
+	0xbd, 0xc9, 0xc3, 0xf0, 0x4a, 0xd3, 0xf0, 0x9d, 0x15, 0xc5, 0xfe, 0xf9, 0xaf, 0x7e, 0x23, 0x71,
+	0x21, 0xe8, 0x09, 0xec, 0x2a, 0x9d, 0x4a, 0xcd, 0x78, 0x31, 0xab, 0xc5, 0x33, 0x36, 0xc7, 0xde,
+	0x00, 0x8c, 0xfc, 0x04, 0xd5, 0x6f, 0x47, 0xee, 0xe9, 0xf5, 0x1c, 0x7d, 0x82, 0x5d, 0x49, 0x0a,
+	0xa6, 0x34, 0x91, 0x64, 0xbe, 0x89, 0x51, 0xb8, 0x39, 0x68, 0x8e, 0xf6, 0x26, 0x8f, 0xae, 0x31,
+	0x3f, 0x92, 0x8b, 0x17, 0xe6, 0xfc, 0xf2, 0xac, 0x14, 0x52, 0xc7, 0xf7, 0xcc, 0x27, 0x7e, 0xfc,
+	0xee, 0xdf, 0xbe, 0x7c, 0xaf, 0x92, 0x3b, 0xdb, 0xdc, 0xb5, 0xa7, 0x1a, 0x7e, 0x03, 0xb0, 0x57,
+	0x0b, 0xe3, 0x63, 0x91, 0x2f, 0x5c, 0xfd, 0x55, 0x10, 0xea, 0x40, 0x8f, 0x12, 0x5b, 0xbc, 0x9f,
+	0x78, 0x94, 0x18, 0xce, 0xa8, 0xad, 0xa0, 0x9d, 0x78, 0x19, 0x35, 0x5c, 0x52, 0xdc, 0xac, 0xb8,
+	0xb4, 0x4c, 0x23, 0xec, 0x57, 0x4c, 0x23, 0xcb, 0x13, 0x7c, 0xc3, 0xf1, 0xc4, 0xf2, 0x14, 0xb7,
+	0x1c, 0x4f, 0x0d, 0x2b, 0x8d, 0x6f, 0x56, 0xf9, 0x95, 0x1e, 0x7e, 0xf5, 0x60, 0xe7, 0xf2, 0xbf,
+	0xd1, 0x73, 0x78, 0xab, 0xae, 0xd6, 0x4d, 0xa1, 0xbf, 0xa3, 0x11, 0x6e, 0x0e, 0x9b, 0x30, 0x74,
+	0x08, 0x3b, 0x8c, 0xcf, 0x94, 0x19, 0xe9, 0xec, 0x98, 0x2d, 0x99, 0x76, 0x33, 0xd8, 0x67, 0xdc,
+	0xce, 0xf9, 0x8d, 0xb9, 0x43, 0x1c, 0xb6, 0x32, 0xd3, 0x81, 0xba, 0xdf, 0xd1, 0x2e, 0x9b, 0xff,
+	0xda, 0x15, 0x1f, 0xb8, 0xde, 0xdf, 0xbf, 0x5e, 0xa3, 0x12, 0xe7, 0x82, 0x1e, 0xc0, 0xb6, 0x24,
+	0xb9, 0x90, 0x73, 0x22, 0x15, 0xf6, 0x07, 0xcd, 0x51, 0x3b, 0xd9, 0x5e, 0xc4, 0x6f, 0xcf, 0x57,
+	0x01, 0xb8, 0x58, 0x05, 0xe0, 0xcf, 0x2a, 0x00, 0xdf, 0xd7, 0x41, 0xe3, 0x62, 0x1d, 0x34, 0x7e,
+	0xae, 0x83, 0xc6, 0x87, 0xa7, 0x05, 0xd3, 0xf4, 0x24, 0x0b, 0x73, 0xb1, 0x1c, 0x9f, 0x70, 0xf6,
+	0x91, 0xe5, 0xa9, 0x66, 0x82, 0x3f, 0x36, 0xbc, 0x5d, 0xf3, 0xb3, 0xed, 0xa2, 0xeb, 0x2f, 0x25,
+	0x51, 0x59, 0xcb, 0xee, 0xf8, 0xf4, 0xdf, 0x00, 0x80, 0xb6, 0xd3, 0xa4, 0x5d, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -423,6 +432,15 @@ func (m *WrkChainExport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Recorders) > 0 {
+		for iNdEx := len(m.Recorders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Recorders[iNdEx])
+			copy(dAtA[i:], m.Recorders[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Recorders[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Blocks) > 0 {
 		for iNdEx := len(m.Blocks) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -538,6 +556,12 @@ func (m *WrkChainExport) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Recorders) > 0 {
+		for _, s := range m.Recorders {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1046,6 +1070,38 @@ func (m *WrkChainExport) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recorders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recorders = append(m.Recorders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
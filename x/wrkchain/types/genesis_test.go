@@ -109,3 +109,35 @@ func TestValidateGenesis(t *testing.T) {
 	err = ValidateGenesis(*state3)
 	require.NoError(t, err)
 }
+
+func TestValidateGenesisRecorders(t *testing.T) {
+	ownerAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recorderAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	state := DefaultGenesisState()
+	state.RegisteredWrkchains = append(state.RegisteredWrkchains, WrkChainExport{
+		Wrkchain: WrkChain{
+			WrkchainId: 1,
+			Moniker:    "wrkchain",
+			BaseType:   "tendermint",
+			Owner:      ownerAddr.String(),
+		},
+		InStateLimit: 100,
+		Recorders:    []string{recorderAddr.String()},
+	})
+
+	err := ValidateGenesis(*state)
+	require.NoError(t, err)
+
+	state.RegisteredWrkchains[0].Recorders = []string{"invalid"}
+	err = ValidateGenesis(*state)
+	require.ErrorContains(t, err, "invalid WrkChain recorder: invalid")
+
+	state.RegisteredWrkchains[0].Recorders = []string{ownerAddr.String()}
+	err = ValidateGenesis(*state)
+	require.ErrorContains(t, err, "Recorder is the Owner")
+
+	state.RegisteredWrkchains[0].Recorders = []string{recorderAddr.String(), recorderAddr.String()}
+	err = ValidateGenesis(*state)
+	require.ErrorContains(t, err, "Duplicate Recorder")
+}
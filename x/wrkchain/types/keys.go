@@ -2,6 +2,9 @@ package types
 
 import (
 	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -32,6 +35,9 @@ var (
 	WrkChainStorageLimitPrefix = []byte{0x03}
 
 	ParamsKey = []byte{0x04}
+
+	// WrkChainRecorderPrefix prefix for WRKChain authorised recorder store
+	WrkChainRecorderPrefix = []byte{0x05}
 )
 
 // GetWrkChainIDBytes returns the byte representation of the wrkChainID
@@ -68,3 +74,13 @@ func WrkChainBlockKey(wrkChainID, height uint64) []byte {
 func WrkChainStorageLimitKey(wrkChainID uint64) []byte {
 	return append(WrkChainStorageLimitPrefix, GetWrkChainIDBytes(wrkChainID)...)
 }
+
+// WrkChainAllRecordersKey gets the key prefix for all of a wrkChain's authorised recorders
+func WrkChainAllRecordersKey(wrkChainID uint64) []byte {
+	return append(WrkChainRecorderPrefix, GetWrkChainIDBytes(wrkChainID)...)
+}
+
+// WrkChainRecorderKey gets the key for a single authorised recorder of a wrkChain
+func WrkChainRecorderKey(wrkChainID uint64, recorder sdk.AccAddress) []byte {
+	return append(WrkChainAllRecordersKey(wrkChainID), address.MustLengthPrefix(recorder)...)
+}
@@ -9,15 +9,21 @@ import (
 const (
 	RouterKey = ModuleName // defined in keys.go file
 
-	RegisterAction        = "register_wrkchain"
-	RecordAction          = "record_wrkchain_hash"
-	PurchaseStorageAction = "purchase_wrkchain_storage"
+	RegisterAction          = "register_wrkchain"
+	RecordAction            = "record_wrkchain_hash"
+	PurchaseStorageAction   = "purchase_wrkchain_storage"
+	TransferOwnershipAction = "transfer_wrkchain_ownership"
+	AddRecorderAction       = "add_wrkchain_recorder"
+	RemoveRecorderAction    = "remove_wrkchain_recorder"
 )
 
 var (
 	_ sdk.Msg = &MsgRegisterWrkChain{}
 	_ sdk.Msg = &MsgPurchaseWrkChainStateStorage{}
 	_ sdk.Msg = &MsgRecordWrkChainBlock{}
+	_ sdk.Msg = &MsgTransferWrkChainOwnership{}
+	_ sdk.Msg = &MsgAddWrkChainRecorder{}
+	_ sdk.Msg = &MsgRemoveWrkChainRecorder{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
 
@@ -178,6 +184,107 @@ func (msg MsgPurchaseWrkChainStateStorage) ValidateBasic() error {
 	return nil
 }
 
+// --- Transfer WRKChain ownership Msg ---
+
+// NewMsgTransferWrkChainOwnership is a constructor function for MsgTransferWrkChainOwnership
+func NewMsgTransferWrkChainOwnership(wrkchainId uint64, newOwner sdk.AccAddress, owner sdk.AccAddress) *MsgTransferWrkChainOwnership {
+	return &MsgTransferWrkChainOwnership{
+		WrkchainId: wrkchainId,
+		Owner:      owner.String(),
+		NewOwner:   newOwner.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgTransferWrkChainOwnership) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgTransferWrkChainOwnership) Type() string { return TransferOwnershipAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgTransferWrkChainOwnership) ValidateBasic() error {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid new owner address (%s)", err)
+	}
+	if msg.WrkchainId == 0 {
+		return errorsmod.Wrap(ErrMissingData, "id must be greater than zero")
+	}
+	if owner.Equals(newOwner) {
+		return errorsmod.Wrap(ErrInvalidData, "new owner cannot be the current owner")
+	}
+
+	return nil
+}
+
+// --- Add WRKChain recorder Msg ---
+
+// NewMsgAddWrkChainRecorder is a constructor function for MsgAddWrkChainRecorder
+func NewMsgAddWrkChainRecorder(wrkchainId uint64, recorder sdk.AccAddress, owner sdk.AccAddress) *MsgAddWrkChainRecorder {
+	return &MsgAddWrkChainRecorder{
+		WrkchainId: wrkchainId,
+		Recorder:   recorder.String(),
+		Owner:      owner.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgAddWrkChainRecorder) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgAddWrkChainRecorder) Type() string { return AddRecorderAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgAddWrkChainRecorder) ValidateBasic() error {
+	return validateRecorderMsg(msg.WrkchainId, msg.Recorder, msg.Owner)
+}
+
+// --- Remove WRKChain recorder Msg ---
+
+// NewMsgRemoveWrkChainRecorder is a constructor function for MsgRemoveWrkChainRecorder
+func NewMsgRemoveWrkChainRecorder(wrkchainId uint64, recorder sdk.AccAddress, owner sdk.AccAddress) *MsgRemoveWrkChainRecorder {
+	return &MsgRemoveWrkChainRecorder{
+		WrkchainId: wrkchainId,
+		Recorder:   recorder.String(),
+		Owner:      owner.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRemoveWrkChainRecorder) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRemoveWrkChainRecorder) Type() string { return RemoveRecorderAction }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgRemoveWrkChainRecorder) ValidateBasic() error {
+	return validateRecorderMsg(msg.WrkchainId, msg.Recorder, msg.Owner)
+}
+
+// validateRecorderMsg runs the stateless checks shared by the add and remove recorder Msgs
+func validateRecorderMsg(wrkchainId uint64, recorder, owner string) error {
+	ownerAddr, err := sdk.AccAddressFromBech32(owner)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid owner address (%s)", err)
+	}
+	recorderAddr, err := sdk.AccAddressFromBech32(recorder)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid recorder address (%s)", err)
+	}
+	if wrkchainId == 0 {
+		return errorsmod.Wrap(ErrMissingData, "id must be greater than zero")
+	}
+	if ownerAddr.Equals(recorderAddr) {
+		return errorsmod.Wrap(ErrInvalidData, "owner cannot be a recorder")
+	}
+
+	return nil
+}
+
 // --- Modify Params Msg Type ---
 
 // ValidateBasic does a sanity check on the provided data.
@@ -193,6 +193,73 @@ func TestMsgPurchaseWrkChainStateStorage(t *testing.T) {
 	}
 }
 
+func TestMsgTransferWrkChainOwnership(t *testing.T) {
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	newAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	emptyAddr := sdk.AccAddress{}
+
+	tests := []struct {
+		wrkchainId uint64
+		newOwner   sdk.AccAddress
+		owner      sdk.AccAddress
+		expectPass bool
+	}{
+		{1, newAddr, addr, true},
+		{1, addr, addr, false},
+		{1, emptyAddr, addr, false},
+		{1, newAddr, emptyAddr, false},
+		{0, newAddr, addr, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgTransferWrkChainOwnership(
+			tc.wrkchainId,
+			tc.newOwner,
+			tc.owner,
+		)
+
+		if tc.expectPass {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgAddRemoveWrkChainRecorder(t *testing.T) {
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	recorderAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	emptyAddr := sdk.AccAddress{}
+
+	tests := []struct {
+		wrkchainId uint64
+		recorder   sdk.AccAddress
+		owner      sdk.AccAddress
+		expectPass bool
+	}{
+		{1, recorderAddr, addr, true},
+		{1, addr, addr, false},
+		{1, emptyAddr, addr, false},
+		{1, recorderAddr, emptyAddr, false},
+		{0, recorderAddr, addr, false},
+	}
+
+	for i, tc := range tests {
+		addMsg := NewMsgAddWrkChainRecorder(tc.wrkchainId, tc.recorder, tc.owner)
+		removeMsg := NewMsgRemoveWrkChainRecorder(tc.wrkchainId, tc.recorder, tc.owner)
+
+		if tc.expectPass {
+			require.NoError(t, addMsg.ValidateBasic(), "test: %v", i)
+			require.NoError(t, removeMsg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, addMsg.ValidateBasic(), "test: %v", i)
+			require.Error(t, removeMsg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
 func TestMsgRegisterWrkChainGetSignBytes(t *testing.T) {
 	addr := sdk.AccAddress("addr1")
 	msg := NewMsgRegisterWrkChain("testwrkchain", "abc123", "testwrkchainname", "cosmos", addr)
@@ -222,3 +289,36 @@ func TestMsgRecordWrkChainBlockGetSignBytes(t *testing.T) {
 	expected := `{"type":"wrkchain/MsgRecordWrkChainBlock","value":{"block_hash":"blockhash","hash1":"hash1","hash2":"hash2","hash3":"hash3","height":"2","owner":"cosmos1v9jxgu33kfsgr5","parent_hash":"parenthash","wrkchain_id":"1"}}`
 	require.Equal(t, expected, string(res))
 }
+
+func TestMsgTransferWrkChainOwnershipGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress("addr1")
+	newAddr := sdk.AccAddress("addr2")
+	msg := NewMsgTransferWrkChainOwnership(1, newAddr, addr)
+	pc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"wrkchain/MsgTransferWrkChainOwnership","value":{"new_owner":"` + newAddr.String() + `","owner":"cosmos1v9jxgu33kfsgr5","wrkchain_id":"1"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgAddWrkChainRecorderGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress("addr1")
+	recorderAddr := sdk.AccAddress("addr2")
+	msg := NewMsgAddWrkChainRecorder(1, recorderAddr, addr)
+	pc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"wrkchain/MsgAddWrkChainRecorder","value":{"owner":"cosmos1v9jxgu33kfsgr5","recorder":"` + recorderAddr.String() + `","wrkchain_id":"1"}}`
+	require.Equal(t, expected, string(res))
+}
+
+func TestMsgRemoveWrkChainRecorderGetSignBytes(t *testing.T) {
+	addr := sdk.AccAddress("addr1")
+	recorderAddr := sdk.AccAddress("addr2")
+	msg := NewMsgRemoveWrkChainRecorder(1, recorderAddr, addr)
+	pc := codec.NewProtoCodec(types.NewInterfaceRegistry())
+	res, err := pc.MarshalAminoJSON(msg)
+	require.NoError(t, err)
+	expected := `{"type":"wrkchain/MsgRemoveWrkChainRecorder","value":{"owner":"cosmos1v9jxgu33kfsgr5","recorder":"` + recorderAddr.String() + `","wrkchain_id":"1"}}`
+	require.Equal(t, expected, string(res))
+}
@@ -140,7 +140,7 @@ type MsgRecordWrkChainBlock struct {
 	Hash2 string `protobuf:"bytes,6,opt,name=hash2,proto3" json:"hash2,omitempty"`
 	// hash3 is an optional supplementary hash to be submitted, for example TxHash
 	Hash3 string `protobuf:"bytes,7,opt,name=hash3,proto3" json:"hash3,omitempty"`
-	// owner is the address of the owner of the wrkchain
+	// owner is the address of the owner of the wrkchain, or one of its authorised recorders
 	Owner string `protobuf:"bytes,8,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
@@ -341,6 +341,246 @@ func (m *MsgPurchaseWrkChainStateStorageResponse) GetNumCanPurchase() uint64 {
 	return 0
 }
 
+// MsgTransferWrkChainOwnership represents a message to transfer a wrkchain to a new owner
+type MsgTransferWrkChainOwnership struct {
+	// wrkchain_id is the id of the wrkchain being transferred
+	WrkchainId uint64 `protobuf:"varint,1,opt,name=wrkchain_id,json=wrkchainId,proto3" json:"wrkchain_id,omitempty"`
+	// owner is the address of the current owner of the wrkchain
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// new_owner is the address of the new owner of the wrkchain
+	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+}
+
+func (m *MsgTransferWrkChainOwnership) Reset()         { *m = MsgTransferWrkChainOwnership{} }
+func (m *MsgTransferWrkChainOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferWrkChainOwnership) ProtoMessage()    {}
+func (*MsgTransferWrkChainOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631f41fcf7533fdf, []int{6}
+}
+func (m *MsgTransferWrkChainOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferWrkChainOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferWrkChainOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferWrkChainOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferWrkChainOwnership.Merge(m, src)
+}
+func (m *MsgTransferWrkChainOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferWrkChainOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferWrkChainOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferWrkChainOwnership proto.InternalMessageInfo
+
+// MsgTransferWrkChainOwnershipResponse defines the Msg/TransferWrkChainOwnership response type.
+type MsgTransferWrkChainOwnershipResponse struct {
+}
+
+func (m *MsgTransferWrkChainOwnershipResponse) Reset()         { *m = MsgTransferWrkChainOwnershipResponse{} }
+func (m *MsgTransferWrkChainOwnershipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferWrkChainOwnershipResponse) ProtoMessage()    {}
+func (*MsgTransferWrkChainOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631f41fcf7533fdf, []int{7}
+}
+func (m *MsgTransferWrkChainOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferWrkChainOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferWrkChainOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferWrkChainOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferWrkChainOwnershipResponse.Merge(m, src)
+}
+func (m *MsgTransferWrkChainOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferWrkChainOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferWrkChainOwnershipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferWrkChainOwnershipResponse proto.InternalMessageInfo
+
+// MsgAddWrkChainRecorder represents a message to authorise an address to record block hashes for a wrkchain
+type MsgAddWrkChainRecorder struct {
+	// wrkchain_id is the id of the wrkchain
+	WrkchainId uint64 `protobuf:"varint,1,opt,name=wrkchain_id,json=wrkchainId,proto3" json:"wrkchain_id,omitempty"`
+	// recorder is the address being authorised to record block hashes
+	Recorder string `protobuf:"bytes,2,opt,name=recorder,proto3" json:"recorder,omitempty"`
+	// owner is the address of the owner of the wrkchain
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgAddWrkChainRecorder) Reset()         { *m = MsgAddWrkChainRecorder{} }
+func (m *MsgAddWrkChainRecorder) String() string { return proto.CompactTextString(m) }
+func (*MsgAddWrkChainRecorder) ProtoMessage()    {}
+func (*MsgAddWrkChainRecorder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631f41fcf7533fdf, []int{8}
+}
+func (m *MsgAddWrkChainRecorder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddWrkChainRecorder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddWrkChainRecorder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddWrkChainRecorder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddWrkChainRecorder.Merge(m, src)
+}
+func (m *MsgAddWrkChainRecorder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddWrkChainRecorder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddWrkChainRecorder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddWrkChainRecorder proto.InternalMessageInfo
+
+// MsgAddWrkChainRecorderResponse defines the Msg/AddWrkChainRecorder response type.
+type MsgAddWrkChainRecorderResponse struct {
+}
+
+func (m *MsgAddWrkChainRecorderResponse) Reset()         { *m = MsgAddWrkChainRecorderResponse{} }
+func (m *MsgAddWrkChainRecorderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddWrkChainRecorderResponse) ProtoMessage()    {}
+func (*MsgAddWrkChainRecorderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631f41fcf7533fdf, []int{9}
+}
+func (m *MsgAddWrkChainRecorderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddWrkChainRecorderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddWrkChainRecorderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddWrkChainRecorderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddWrkChainRecorderResponse.Merge(m, src)
+}
+func (m *MsgAddWrkChainRecorderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddWrkChainRecorderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddWrkChainRecorderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddWrkChainRecorderResponse proto.InternalMessageInfo
+
+// MsgRemoveWrkChainRecorder represents a message to revoke a recorder's authorisation to record block hashes
+type MsgRemoveWrkChainRecorder struct {
+	// wrkchain_id is the id of the wrkchain
+	WrkchainId uint64 `protobuf:"varint,1,opt,name=wrkchain_id,json=wrkchainId,proto3" json:"wrkchain_id,omitempty"`
+	// recorder is the address being removed
+	Recorder string `protobuf:"bytes,2,opt,name=recorder,proto3" json:"recorder,omitempty"`
+	// owner is the address of the owner of the wrkchain
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *MsgRemoveWrkChainRecorder) Reset()         { *m = MsgRemoveWrkChainRecorder{} }
+func (m *MsgRemoveWrkChainRecorder) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveWrkChainRecorder) ProtoMessage()    {}
+func (*MsgRemoveWrkChainRecorder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631f41fcf7533fdf, []int{10}
+}
+func (m *MsgRemoveWrkChainRecorder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveWrkChainRecorder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveWrkChainRecorder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveWrkChainRecorder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveWrkChainRecorder.Merge(m, src)
+}
+func (m *MsgRemoveWrkChainRecorder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveWrkChainRecorder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveWrkChainRecorder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveWrkChainRecorder proto.InternalMessageInfo
+
+// MsgRemoveWrkChainRecorderResponse defines the Msg/RemoveWrkChainRecorder response type.
+type MsgRemoveWrkChainRecorderResponse struct {
+}
+
+func (m *MsgRemoveWrkChainRecorderResponse) Reset()         { *m = MsgRemoveWrkChainRecorderResponse{} }
+func (m *MsgRemoveWrkChainRecorderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveWrkChainRecorderResponse) ProtoMessage()    {}
+func (*MsgRemoveWrkChainRecorderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_631f41fcf7533fdf, []int{11}
+}
+func (m *MsgRemoveWrkChainRecorderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveWrkChainRecorderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveWrkChainRecorderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveWrkChainRecorderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveWrkChainRecorderResponse.Merge(m, src)
+}
+func (m *MsgRemoveWrkChainRecorderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveWrkChainRecorderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveWrkChainRecorderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveWrkChainRecorderResponse proto.InternalMessageInfo
+
 // MsgUpdateParams is the Msg/UpdateParams request type.
 //
 // Since: cosmos-sdk 0.47
@@ -357,7 +597,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631f41fcf7533fdf, []int{6}
+	return fileDescriptor_631f41fcf7533fdf, []int{12}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -411,7 +651,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_631f41fcf7533fdf, []int{7}
+	return fileDescriptor_631f41fcf7533fdf, []int{13}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -447,6 +687,12 @@ func init() {
 	proto.RegisterType((*MsgRecordWrkChainBlockResponse)(nil), "mainchain.wrkchain.v1.MsgRecordWrkChainBlockResponse")
 	proto.RegisterType((*MsgPurchaseWrkChainStateStorage)(nil), "mainchain.wrkchain.v1.MsgPurchaseWrkChainStateStorage")
 	proto.RegisterType((*MsgPurchaseWrkChainStateStorageResponse)(nil), "mainchain.wrkchain.v1.MsgPurchaseWrkChainStateStorageResponse")
+	proto.RegisterType((*MsgTransferWrkChainOwnership)(nil), "mainchain.wrkchain.v1.MsgTransferWrkChainOwnership")
+	proto.RegisterType((*MsgTransferWrkChainOwnershipResponse)(nil), "mainchain.wrkchain.v1.MsgTransferWrkChainOwnershipResponse")
+	proto.RegisterType((*MsgAddWrkChainRecorder)(nil), "mainchain.wrkchain.v1.MsgAddWrkChainRecorder")
+	proto.RegisterType((*MsgAddWrkChainRecorderResponse)(nil), "mainchain.wrkchain.v1.MsgAddWrkChainRecorderResponse")
+	proto.RegisterType((*MsgRemoveWrkChainRecorder)(nil), "mainchain.wrkchain.v1.MsgRemoveWrkChainRecorder")
+	proto.RegisterType((*MsgRemoveWrkChainRecorderResponse)(nil), "mainchain.wrkchain.v1.MsgRemoveWrkChainRecorderResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "mainchain.wrkchain.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "mainchain.wrkchain.v1.MsgUpdateParamsResponse")
 }
@@ -454,56 +700,68 @@ func init() {
 func init() { proto.RegisterFile("mainchain/wrkchain/v1/tx.proto", fileDescriptor_631f41fcf7533fdf) }
 
 var fileDescriptor_631f41fcf7533fdf = []byte{
-	// 777 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xbf, 0x6f, 0xdb, 0x46,
-	0x14, 0x16, 0xad, 0x1f, 0xb6, 0x4e, 0x46, 0xed, 0xd2, 0xae, 0x4d, 0xcb, 0x36, 0xd5, 0xb2, 0x45,
-	0xeb, 0x0a, 0x30, 0x05, 0x4b, 0xb0, 0x0b, 0x78, 0x30, 0x5a, 0x79, 0x69, 0x07, 0xa1, 0x06, 0xdd,
-	0xa2, 0x68, 0x16, 0xe1, 0x44, 0x9e, 0x49, 0x42, 0xe1, 0x1d, 0x71, 0x47, 0xf9, 0x07, 0xb2, 0x04,
-	0x99, 0x82, 0x4c, 0x99, 0xb2, 0xc6, 0x63, 0x46, 0x0f, 0xd9, 0xb2, 0x06, 0x81, 0x47, 0x23, 0x53,
-	0xa6, 0x20, 0xb0, 0x07, 0xfb, 0xaf, 0x08, 0x02, 0xde, 0x91, 0x92, 0x1c, 0x51, 0xb2, 0x92, 0x45,
-	0xe0, 0xfb, 0xde, 0xf7, 0xbe, 0x7b, 0xef, 0xe3, 0xe9, 0x11, 0xa8, 0x1e, 0x74, 0xb1, 0xe9, 0x40,
-	0x17, 0x57, 0x8e, 0x68, 0x5b, 0x3c, 0x1c, 0x6e, 0x54, 0x82, 0x63, 0xdd, 0xa7, 0x24, 0x20, 0xf2,
-	0x77, 0xdd, 0xbc, 0x1e, 0xe7, 0xf5, 0xc3, 0x8d, 0xe2, 0xa2, 0x49, 0x98, 0x47, 0x58, 0xc5, 0x63,
-	0x76, 0x48, 0xf7, 0x98, 0x2d, 0xf8, 0xc5, 0x25, 0x91, 0x68, 0xf2, 0xa8, 0x22, 0x82, 0x28, 0x35,
-	0x6f, 0x13, 0x9b, 0x08, 0x3c, 0x7c, 0x8a, 0xd0, 0x6f, 0xa1, 0xe7, 0x62, 0x52, 0xe1, 0xbf, 0x11,
-	0xf4, 0x53, 0x72, 0x4f, 0xdd, 0xf3, 0x39, 0x4b, 0xbb, 0x91, 0xc0, 0x5c, 0x83, 0xd9, 0x06, 0xb2,
-	0x5d, 0x16, 0x20, 0xfa, 0x1f, 0x6d, 0xef, 0x86, 0x59, 0x59, 0x01, 0x93, 0x1e, 0xc1, 0x6e, 0x1b,
-	0x51, 0x45, 0xfa, 0x5e, 0x5a, 0xcb, 0x1b, 0x71, 0x28, 0xcb, 0x20, 0x83, 0xa1, 0x87, 0x94, 0x09,
-	0x0e, 0xf3, 0x67, 0xf9, 0x07, 0x30, 0x6d, 0x23, 0x8c, 0x98, 0xcb, 0x9a, 0x0e, 0x64, 0x8e, 0x92,
-	0xe6, 0xb9, 0x42, 0x84, 0xfd, 0x09, 0x99, 0x23, 0x2f, 0x83, 0x7c, 0x0b, 0x32, 0xd4, 0x0c, 0x4e,
-	0x7c, 0xa4, 0x64, 0x78, 0x7e, 0x2a, 0x04, 0xfe, 0x39, 0xf1, 0x91, 0xac, 0x83, 0x2c, 0x39, 0xc2,
-	0x88, 0x2a, 0xd9, 0x30, 0x51, 0x57, 0xde, 0xbe, 0x5c, 0x9f, 0x8f, 0xa6, 0xfe, 0xc3, 0xb2, 0x28,
-	0x62, 0x6c, 0x3f, 0xa0, 0x2e, 0xb6, 0x0d, 0x41, 0xdb, 0xae, 0x3d, 0x3e, 0x2d, 0xa5, 0x6e, 0x4e,
-	0x4b, 0xa9, 0x47, 0xd7, 0x67, 0x65, 0x81, 0x3d, 0xb9, 0x3e, 0x2b, 0xaf, 0x74, 0x07, 0x4d, 0x18,
-	0x49, 0xdb, 0x01, 0xcb, 0x09, 0xb0, 0x81, 0x98, 0x4f, 0x30, 0x43, 0x72, 0x09, 0x14, 0xe2, 0xf2,
-	0xa6, 0x6b, 0xf1, 0xa9, 0x33, 0x06, 0x88, 0xa1, 0xbf, 0x2c, 0xed, 0xd5, 0x04, 0x58, 0xe0, 0x02,
-	0x26, 0xa1, 0x56, 0x5c, 0x5e, 0xbf, 0x4f, 0xcc, 0xf6, 0x9d, 0xb5, 0xf2, 0x02, 0xc8, 0x39, 0xc8,
-	0xb5, 0x9d, 0x80, 0xdb, 0x96, 0x31, 0xa2, 0x48, 0x5e, 0x05, 0xa0, 0x15, 0x2a, 0xf4, 0xdb, 0x96,
-	0xe7, 0x08, 0x37, 0xad, 0x04, 0x0a, 0x3e, 0xa4, 0x08, 0x07, 0x22, 0x2f, 0x6c, 0x03, 0x02, 0xe2,
-	0x84, 0x79, 0x90, 0x0d, 0x33, 0x1b, 0xc2, 0x38, 0x43, 0x04, 0x31, 0x5a, 0x55, 0x72, 0x3d, 0xb4,
-	0x1a, 0xa3, 0x35, 0x65, 0xb2, 0x87, 0xd6, 0x7a, 0xd6, 0x4f, 0x8d, 0x67, 0xfd, 0x56, 0xb2, 0xf5,
-	0xa5, 0xdb, 0xd6, 0x0f, 0x58, 0xa4, 0xfd, 0x0f, 0xd4, 0xe4, 0xcc, 0xd8, 0x2f, 0x60, 0x98, 0x89,
-	0xda, 0x1b, 0x09, 0x94, 0x1a, 0xcc, 0xde, 0xeb, 0x50, 0xd3, 0x81, 0x0c, 0xc5, 0xea, 0xfb, 0x01,
-	0x0c, 0xd0, 0x7e, 0x40, 0x28, 0xb4, 0xc7, 0x13, 0xc7, 0x1d, 0xaf, 0x85, 0x68, 0x2c, 0x2e, 0xa2,
-	0x9e, 0x3f, 0xe9, 0xf1, 0xfc, 0xd9, 0x4e, 0xf6, 0xe7, 0xc7, 0x7e, 0x7f, 0x06, 0x3b, 0xe5, 0x4d,
-	0x6a, 0xcf, 0x25, 0xf0, 0xcb, 0x1d, 0x83, 0x8c, 0xef, 0xd6, 0xaf, 0x60, 0x56, 0x8c, 0xd0, 0xf4,
-	0x23, 0x3d, 0x2b, 0x1a, 0x6d, 0x46, 0xe0, 0xf1, 0x31, 0x96, 0xbc, 0xc6, 0xa9, 0x4d, 0x13, 0xe2,
-	0x2e, 0x97, 0x8f, 0x9b, 0x31, 0xbe, 0xc1, 0x1d, 0x6f, 0x17, 0xe2, 0x98, 0xaa, 0xbd, 0x96, 0xc0,
-	0x4c, 0x83, 0xd9, 0xff, 0xfa, 0x16, 0x0c, 0xd0, 0x1e, 0xa4, 0xd0, 0x63, 0xf2, 0x16, 0xc8, 0xc3,
-	0x4e, 0xe0, 0x10, 0xea, 0x06, 0x27, 0x62, 0x59, 0x8c, 0x70, 0xa9, 0x47, 0x95, 0x7f, 0x07, 0x39,
-	0x9f, 0x2b, 0xf0, 0xb6, 0x0a, 0xd5, 0x55, 0x3d, 0x71, 0x4b, 0xea, 0xe2, 0x98, 0x7a, 0xfe, 0xfc,
-	0x7d, 0x29, 0xf5, 0xe2, 0xfa, 0xac, 0x2c, 0x19, 0x51, 0xdd, 0xf6, 0x6f, 0xa1, 0xc7, 0x3d, 0xc5,
-	0xd0, 0xe7, 0xbe, 0xad, 0x77, 0x5c, 0xe9, 0xf7, 0xbc, 0xbf, 0x65, 0x6d, 0x09, 0x2c, 0x7e, 0x06,
-	0xc5, 0xbe, 0x56, 0x3f, 0xa6, 0x41, 0xba, 0xc1, 0x6c, 0x99, 0x82, 0xd9, 0x81, 0xa5, 0x58, 0x1e,
-	0xd2, 0x61, 0xc2, 0x5a, 0x29, 0x56, 0xc7, 0xe7, 0x76, 0xdf, 0xe9, 0x03, 0x30, 0x97, 0xb4, 0x5d,
-	0xd6, 0x47, 0x49, 0x0d, 0xd0, 0x8b, 0x9b, 0x5f, 0x44, 0xef, 0x1e, 0xfe, 0x4c, 0x02, 0x2b, 0x23,
-	0xff, 0x42, 0x5b, 0xc3, 0x75, 0x47, 0xd5, 0x15, 0x77, 0xbe, 0xae, 0xae, 0xdb, 0xd8, 0x01, 0x98,
-	0xbe, 0x75, 0xdf, 0x7e, 0x1e, 0xae, 0xd7, 0xcf, 0x2b, 0xea, 0xe3, 0xf1, 0xe2, 0x73, 0x8a, 0xd9,
-	0x87, 0xe1, 0xe5, 0xaa, 0xff, 0x7d, 0x7e, 0xa9, 0x4a, 0x17, 0x97, 0xaa, 0xf4, 0xe1, 0x52, 0x95,
-	0x9e, 0x5e, 0xa9, 0xa9, 0x8b, 0x2b, 0x35, 0xf5, 0xee, 0x4a, 0x4d, 0xdd, 0xdb, 0xb4, 0xdd, 0xc0,
-	0xe9, 0xb4, 0x74, 0x93, 0x78, 0x95, 0x0e, 0x76, 0x0f, 0x5c, 0x13, 0x06, 0x2e, 0xc1, 0xeb, 0x61,
-	0x9c, 0x78, 0xed, 0xc2, 0x6f, 0x1d, 0x6b, 0xe5, 0xf8, 0x97, 0xb6, 0xf6, 0x29, 0x00, 0x00, 0xff,
-	0xff, 0xce, 0x12, 0xa5, 0x26, 0x25, 0x08, 0x00, 0x00,
+	// 961 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0x24, 0xce, 0x0f, 0xbf, 0x54, 0xb4, 0x6c, 0x43, 0xba, 0xd9, 0xa6, 0x76, 0xbb, 0x2d,
+	0xa5, 0x44, 0x8a, 0x4d, 0x6c, 0x12, 0x20, 0xa0, 0x8a, 0xa6, 0x17, 0x38, 0x58, 0xad, 0x36, 0x45,
+	0x08, 0x2e, 0xd6, 0xd8, 0x3b, 0xd9, 0x5d, 0x85, 0x9d, 0x59, 0xcd, 0xac, 0x93, 0x46, 0x5c, 0x10,
+	0xe2, 0x80, 0x90, 0x90, 0x10, 0x07, 0xae, 0xf4, 0xc8, 0x31, 0x07, 0x6e, 0x5c, 0x11, 0xea, 0xb1,
+	0xe2, 0x02, 0x17, 0x10, 0x4a, 0x90, 0xd2, 0x3f, 0x03, 0xed, 0xec, 0x0f, 0x3b, 0x64, 0xd6, 0xde,
+	0xe4, 0xd4, 0x4b, 0xb4, 0xf3, 0xbd, 0xef, 0xbd, 0x79, 0xdf, 0x37, 0xde, 0xb7, 0x13, 0xa8, 0xfa,
+	0xd8, 0xa3, 0x3d, 0x17, 0x7b, 0xb4, 0xb1, 0xc7, 0x77, 0xe2, 0x87, 0xdd, 0xd5, 0x46, 0xf8, 0xb8,
+	0x1e, 0x70, 0x16, 0x32, 0xed, 0x95, 0x2c, 0x5e, 0x4f, 0xe3, 0xf5, 0xdd, 0x55, 0xe3, 0x4a, 0x8f,
+	0x09, 0x9f, 0x89, 0x86, 0x2f, 0x9c, 0x88, 0xee, 0x0b, 0x27, 0xe6, 0x1b, 0x8b, 0x71, 0xa0, 0x23,
+	0x57, 0x8d, 0x78, 0x91, 0x84, 0xe6, 0x1d, 0xe6, 0xb0, 0x18, 0x8f, 0x9e, 0x12, 0xf4, 0x65, 0xec,
+	0x7b, 0x94, 0x35, 0xe4, 0xdf, 0x04, 0xba, 0xa5, 0xee, 0x29, 0xdb, 0x5f, 0xb2, 0xcc, 0xe7, 0x08,
+	0x2e, 0xb7, 0x85, 0x63, 0x11, 0xc7, 0x13, 0x21, 0xe1, 0x1f, 0xf3, 0x9d, 0xfb, 0x51, 0x54, 0xd3,
+	0x61, 0xc6, 0x67, 0xd4, 0xdb, 0x21, 0x5c, 0x47, 0xd7, 0xd1, 0x9d, 0x8a, 0x95, 0x2e, 0x35, 0x0d,
+	0xca, 0x14, 0xfb, 0x44, 0x9f, 0x90, 0xb0, 0x7c, 0xd6, 0x6e, 0xc0, 0x05, 0x87, 0x50, 0x22, 0x3c,
+	0xd1, 0x71, 0xb1, 0x70, 0xf5, 0x49, 0x19, 0x9b, 0x4b, 0xb0, 0x0f, 0xb0, 0x70, 0xb5, 0xab, 0x50,
+	0xe9, 0x62, 0x41, 0x3a, 0xe1, 0x7e, 0x40, 0xf4, 0xb2, 0x8c, 0xcf, 0x46, 0xc0, 0xa3, 0xfd, 0x80,
+	0x68, 0x75, 0x98, 0x62, 0x7b, 0x94, 0x70, 0x7d, 0x2a, 0x0a, 0x6c, 0xea, 0xbf, 0xff, 0xbc, 0x32,
+	0x9f, 0xa8, 0xbe, 0x67, 0xdb, 0x9c, 0x08, 0xb1, 0x15, 0x72, 0x8f, 0x3a, 0x56, 0x4c, 0xdb, 0x68,
+	0x7d, 0xfd, 0xa4, 0x56, 0x7a, 0xfe, 0xa4, 0x56, 0xfa, 0xf2, 0xf8, 0x60, 0x39, 0xc6, 0xbe, 0x39,
+	0x3e, 0x58, 0x5e, 0xca, 0x84, 0x2a, 0x24, 0x99, 0x77, 0xe1, 0xaa, 0x02, 0xb6, 0x88, 0x08, 0x18,
+	0x15, 0x44, 0xab, 0xc1, 0x5c, 0x9a, 0xde, 0xf1, 0x6c, 0xa9, 0xba, 0x6c, 0x41, 0x0a, 0x7d, 0x68,
+	0x9b, 0xbf, 0x4c, 0xc0, 0x82, 0x2c, 0xd0, 0x63, 0xdc, 0x4e, 0xd3, 0x37, 0x3f, 0x63, 0xbd, 0x9d,
+	0xb1, 0xb9, 0xda, 0x02, 0x4c, 0xbb, 0xc4, 0x73, 0xdc, 0x50, 0xda, 0x56, 0xb6, 0x92, 0x95, 0x76,
+	0x0d, 0xa0, 0x1b, 0x55, 0x18, 0xb6, 0xad, 0x22, 0x11, 0x69, 0x5a, 0x0d, 0xe6, 0x02, 0xcc, 0x09,
+	0x0d, 0xe3, 0x78, 0x6c, 0x1b, 0xc4, 0x90, 0x24, 0xcc, 0xc3, 0x54, 0x14, 0x59, 0x8d, 0x8d, 0xb3,
+	0xe2, 0x45, 0x8a, 0x36, 0xf5, 0xe9, 0x01, 0xda, 0x4c, 0xd1, 0x96, 0x3e, 0x33, 0x40, 0x5b, 0x03,
+	0xeb, 0x67, 0x8b, 0x59, 0xbf, 0xae, 0xb6, 0xbe, 0x76, 0xd2, 0xfa, 0x53, 0x16, 0x99, 0x9f, 0x40,
+	0x55, 0x1d, 0x29, 0x7c, 0x00, 0x79, 0x26, 0x9a, 0xbf, 0x21, 0xa8, 0xb5, 0x85, 0xf3, 0xb0, 0xcf,
+	0x7b, 0x2e, 0x16, 0x24, 0xad, 0xbe, 0x15, 0xe2, 0x90, 0x6c, 0x85, 0x8c, 0x63, 0xa7, 0x58, 0x71,
+	0xda, 0xf7, 0xbb, 0x84, 0xa7, 0xc5, 0xe3, 0xd5, 0xc0, 0x9f, 0xc9, 0x62, 0xfe, 0x6c, 0xa8, 0xfd,
+	0xb9, 0x39, 0xec, 0xcf, 0xe9, 0x4e, 0x65, 0x93, 0xe6, 0x8f, 0x08, 0x5e, 0x1b, 0x23, 0xa4, 0xb8,
+	0x5b, 0xaf, 0xc3, 0xa5, 0x58, 0x42, 0x27, 0x48, 0xea, 0xd9, 0x89, 0xb4, 0x8b, 0x31, 0x9e, 0x6e,
+	0x63, 0x6b, 0x77, 0x24, 0xb5, 0xd3, 0xc3, 0x34, 0xe3, 0x4a, 0xb9, 0x65, 0xeb, 0x25, 0xda, 0xf7,
+	0xef, 0x63, 0x9a, 0x52, 0xcd, 0x7f, 0x11, 0x2c, 0xb5, 0x85, 0xf3, 0x88, 0x63, 0x2a, 0xb6, 0x07,
+	0x2f, 0xd1, 0x83, 0x48, 0xa6, 0x70, 0xbd, 0x60, 0x7c, 0x5b, 0x99, 0x9f, 0x13, 0x85, 0xfc, 0xd4,
+	0xd6, 0xa0, 0x42, 0xc9, 0x5e, 0xa7, 0xd8, 0x19, 0xcc, 0x52, 0xb2, 0x27, 0x7b, 0xd9, 0x78, 0x4f,
+	0x7d, 0x0c, 0xaf, 0x0e, 0x1f, 0x43, 0xae, 0x0a, 0xf3, 0x36, 0xdc, 0x1a, 0x15, 0x4f, 0x0f, 0xc1,
+	0xfc, 0x03, 0xc9, 0x91, 0x70, 0xcf, 0xb6, 0x07, 0xe3, 0x24, 0xfa, 0x81, 0x13, 0x3e, 0xde, 0x88,
+	0x37, 0x61, 0x96, 0x27, 0xe4, 0xb1, 0x5e, 0x64, 0xcc, 0x33, 0xff, 0x1c, 0x8b, 0xbc, 0xae, 0x8a,
+	0xf6, 0xcd, 0xeb, 0x50, 0x55, 0x47, 0x32, 0xed, 0x7f, 0x21, 0x58, 0x94, 0x6f, 0xb4, 0xcf, 0x76,
+	0xc9, 0x8b, 0x2a, 0xff, 0x1d, 0xb5, 0x7c, 0xf3, 0xe4, 0xb4, 0x52, 0x29, 0x30, 0x6f, 0xc2, 0x8d,
+	0xdc, 0x60, 0x66, 0xc2, 0xaf, 0x08, 0x2e, 0xb6, 0x85, 0xf3, 0x51, 0x60, 0xe3, 0x90, 0x3c, 0xc4,
+	0x1c, 0xfb, 0x42, 0x5b, 0x87, 0x0a, 0xee, 0x87, 0x2e, 0xe3, 0x5e, 0xb8, 0xaf, 0xa3, 0x31, 0x7d,
+	0x0e, 0xa8, 0xda, 0xfb, 0x30, 0x1d, 0xc8, 0x0a, 0xd2, 0x8f, 0xb9, 0xe6, 0xb5, 0xba, 0xf2, 0xd6,
+	0x50, 0x8f, 0xb7, 0xd9, 0xac, 0x3c, 0xfd, 0xbb, 0x56, 0xfa, 0xe9, 0xf8, 0x60, 0x19, 0x59, 0x49,
+	0xde, 0xc6, 0x5b, 0x91, 0xca, 0x41, 0xc5, 0x48, 0xe9, 0xd0, 0x2d, 0xe0, 0x71, 0x63, 0x58, 0xf5,
+	0x70, 0xcb, 0xe6, 0x22, 0x5c, 0xf9, 0x1f, 0x94, 0x2a, 0x6c, 0x7e, 0x3f, 0x03, 0x93, 0x6d, 0xe1,
+	0x68, 0x1c, 0x2e, 0x9d, 0xba, 0x24, 0x2c, 0xe7, 0x74, 0xa8, 0xf8, 0xcc, 0x1a, 0xcd, 0xe2, 0xdc,
+	0x6c, 0xc6, 0x7d, 0x0e, 0x97, 0x55, 0x5f, 0xdb, 0x95, 0x51, 0xa5, 0x4e, 0xd1, 0x8d, 0xb5, 0x33,
+	0xd1, 0xb3, 0xcd, 0x7f, 0x40, 0xb0, 0x34, 0xf2, 0x93, 0xb2, 0x9e, 0x5f, 0x77, 0x54, 0x9e, 0x71,
+	0xf7, 0x7c, 0x79, 0x59, 0x63, 0xdf, 0x22, 0x58, 0xcc, 0x1f, 0xc0, 0xad, 0xfc, 0xea, 0xb9, 0x49,
+	0xc6, 0xbb, 0xe7, 0x48, 0x1a, 0x3e, 0x25, 0xd5, 0x00, 0x1c, 0x71, 0x4a, 0x0a, 0xba, 0xb1, 0x76,
+	0x26, 0x7a, 0xb6, 0xf9, 0x57, 0x08, 0x16, 0x72, 0x46, 0xd0, 0x1b, 0xa3, 0xce, 0x5d, 0x95, 0x61,
+	0xbc, 0x7d, 0xd6, 0x8c, 0xac, 0x8d, 0x6d, 0xb8, 0x70, 0x62, 0x06, 0xdc, 0xce, 0xaf, 0x34, 0xcc,
+	0x33, 0xea, 0xc5, 0x78, 0xe9, 0x3e, 0xc6, 0xd4, 0x17, 0xd1, 0x0b, 0xbf, 0xf9, 0xe0, 0xe9, 0x61,
+	0x15, 0x3d, 0x3b, 0xac, 0xa2, 0x7f, 0x0e, 0xab, 0xe8, 0xbb, 0xa3, 0x6a, 0xe9, 0xd9, 0x51, 0xb5,
+	0xf4, 0xe7, 0x51, 0xb5, 0xf4, 0xe9, 0x9a, 0xe3, 0x85, 0x6e, 0xbf, 0x5b, 0xef, 0x31, 0xbf, 0xd1,
+	0xa7, 0xde, 0xb6, 0xd7, 0xc3, 0xa1, 0xc7, 0xe8, 0x4a, 0xb4, 0x56, 0x8e, 0x82, 0xe8, 0x3e, 0x2e,
+	0xba, 0xd3, 0xf2, 0xbf, 0x81, 0xd6, 0x7f, 0x03, 0x00, 0x00, 0xda, 0xee, 0xa3, 0xc9, 0x0c, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -524,6 +782,12 @@ type MsgClient interface {
 	RecordWrkChainBlock(ctx context.Context, in *MsgRecordWrkChainBlock, opts ...grpc.CallOption) (*MsgRecordWrkChainBlockResponse, error)
 	// PurchaseWrkChainStateStorage defines the method to purchase more state storage
 	PurchaseWrkChainStateStorage(ctx context.Context, in *MsgPurchaseWrkChainStateStorage, opts ...grpc.CallOption) (*MsgPurchaseWrkChainStateStorageResponse, error)
+	// TransferWrkChainOwnership defines a method to transfer a wrkchain to a new owner
+	TransferWrkChainOwnership(ctx context.Context, in *MsgTransferWrkChainOwnership, opts ...grpc.CallOption) (*MsgTransferWrkChainOwnershipResponse, error)
+	// AddWrkChainRecorder defines a method for a wrkchain owner to authorise an address to record block hashes
+	AddWrkChainRecorder(ctx context.Context, in *MsgAddWrkChainRecorder, opts ...grpc.CallOption) (*MsgAddWrkChainRecorderResponse, error)
+	// RemoveWrkChainRecorder defines a method for a wrkchain owner to revoke a recorder's authorisation
+	RemoveWrkChainRecorder(ctx context.Context, in *MsgRemoveWrkChainRecorder, opts ...grpc.CallOption) (*MsgRemoveWrkChainRecorderResponse, error)
 	// UpdateParams defines an operation for updating the x/wrkchain module
 	// parameters.
 	// Since: cosmos-sdk 0.47
@@ -565,6 +829,33 @@ func (c *msgClient) PurchaseWrkChainStateStorage(ctx context.Context, in *MsgPur
 	return out, nil
 }
 
+func (c *msgClient) TransferWrkChainOwnership(ctx context.Context, in *MsgTransferWrkChainOwnership, opts ...grpc.CallOption) (*MsgTransferWrkChainOwnershipResponse, error) {
+	out := new(MsgTransferWrkChainOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.wrkchain.v1.Msg/TransferWrkChainOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddWrkChainRecorder(ctx context.Context, in *MsgAddWrkChainRecorder, opts ...grpc.CallOption) (*MsgAddWrkChainRecorderResponse, error) {
+	out := new(MsgAddWrkChainRecorderResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.wrkchain.v1.Msg/AddWrkChainRecorder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveWrkChainRecorder(ctx context.Context, in *MsgRemoveWrkChainRecorder, opts ...grpc.CallOption) (*MsgRemoveWrkChainRecorderResponse, error) {
+	out := new(MsgRemoveWrkChainRecorderResponse)
+	err := c.cc.Invoke(ctx, "/mainchain.wrkchain.v1.Msg/RemoveWrkChainRecorder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/mainchain.wrkchain.v1.Msg/UpdateParams", in, out, opts...)
@@ -582,6 +873,12 @@ type MsgServer interface {
 	RecordWrkChainBlock(context.Context, *MsgRecordWrkChainBlock) (*MsgRecordWrkChainBlockResponse, error)
 	// PurchaseWrkChainStateStorage defines the method to purchase more state storage
 	PurchaseWrkChainStateStorage(context.Context, *MsgPurchaseWrkChainStateStorage) (*MsgPurchaseWrkChainStateStorageResponse, error)
+	// TransferWrkChainOwnership defines a method to transfer a wrkchain to a new owner
+	TransferWrkChainOwnership(context.Context, *MsgTransferWrkChainOwnership) (*MsgTransferWrkChainOwnershipResponse, error)
+	// AddWrkChainRecorder defines a method for a wrkchain owner to authorise an address to record block hashes
+	AddWrkChainRecorder(context.Context, *MsgAddWrkChainRecorder) (*MsgAddWrkChainRecorderResponse, error)
+	// RemoveWrkChainRecorder defines a method for a wrkchain owner to revoke a recorder's authorisation
+	RemoveWrkChainRecorder(context.Context, *MsgRemoveWrkChainRecorder) (*MsgRemoveWrkChainRecorderResponse, error)
 	// UpdateParams defines an operation for updating the x/wrkchain module
 	// parameters.
 	// Since: cosmos-sdk 0.47
@@ -601,6 +898,15 @@ func (*UnimplementedMsgServer) RecordWrkChainBlock(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) PurchaseWrkChainStateStorage(ctx context.Context, req *MsgPurchaseWrkChainStateStorage) (*MsgPurchaseWrkChainStateStorageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PurchaseWrkChainStateStorage not implemented")
 }
+func (*UnimplementedMsgServer) TransferWrkChainOwnership(ctx context.Context, req *MsgTransferWrkChainOwnership) (*MsgTransferWrkChainOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferWrkChainOwnership not implemented")
+}
+func (*UnimplementedMsgServer) AddWrkChainRecorder(ctx context.Context, req *MsgAddWrkChainRecorder) (*MsgAddWrkChainRecorderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWrkChainRecorder not implemented")
+}
+func (*UnimplementedMsgServer) RemoveWrkChainRecorder(ctx context.Context, req *MsgRemoveWrkChainRecorder) (*MsgRemoveWrkChainRecorderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWrkChainRecorder not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -663,6 +969,60 @@ func _Msg_PurchaseWrkChainStateStorage_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_TransferWrkChainOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferWrkChainOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferWrkChainOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.wrkchain.v1.Msg/TransferWrkChainOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferWrkChainOwnership(ctx, req.(*MsgTransferWrkChainOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddWrkChainRecorder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddWrkChainRecorder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddWrkChainRecorder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.wrkchain.v1.Msg/AddWrkChainRecorder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddWrkChainRecorder(ctx, req.(*MsgAddWrkChainRecorder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveWrkChainRecorder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveWrkChainRecorder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveWrkChainRecorder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mainchain.wrkchain.v1.Msg/RemoveWrkChainRecorder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveWrkChainRecorder(ctx, req.(*MsgRemoveWrkChainRecorder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -697,6 +1057,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PurchaseWrkChainStateStorage",
 			Handler:    _Msg_PurchaseWrkChainStateStorage_Handler,
 		},
+		{
+			MethodName: "TransferWrkChainOwnership",
+			Handler:    _Msg_TransferWrkChainOwnership_Handler,
+		},
+		{
+			MethodName: "AddWrkChainRecorder",
+			Handler:    _Msg_AddWrkChainRecorder_Handler,
+		},
+		{
+			MethodName: "RemoveWrkChainRecorder",
+			Handler:    _Msg_RemoveWrkChainRecorder_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -978,7 +1350,7 @@ func (m *MsgPurchaseWrkChainStateStorageResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgTransferWrkChainOwnership) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -988,37 +1360,39 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTransferWrkChainOwnership) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTransferWrkChainOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.WrkchainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WrkchainId))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgTransferWrkChainOwnershipResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1028,12 +1402,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgTransferWrkChainOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgTransferWrkChainOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1041,20 +1415,213 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgAddWrkChainRecorder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRegisterWrkChain) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgAddWrkChainRecorder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddWrkChainRecorder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recorder) > 0 {
+		i -= len(m.Recorder)
+		copy(dAtA[i:], m.Recorder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recorder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.WrkchainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WrkchainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddWrkChainRecorderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddWrkChainRecorderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddWrkChainRecorderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveWrkChainRecorder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveWrkChainRecorder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveWrkChainRecorder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recorder) > 0 {
+		i -= len(m.Recorder)
+		copy(dAtA[i:], m.Recorder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recorder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.WrkchainId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WrkchainId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveWrkChainRecorderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveWrkChainRecorderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveWrkChainRecorderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterWrkChain) Size() (n int) {
+	if m == nil {
+		return 0
 	}
 	var l int
 	_ = l
@@ -1184,22 +1751,27 @@ func (m *MsgPurchaseWrkChainStateStorageResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgTransferWrkChainOwnership) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	if m.WrkchainId != 0 {
+		n += 1 + sovTx(uint64(m.WrkchainId))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
+func (m *MsgTransferWrkChainOwnershipResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1208,44 +1780,475 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgAddWrkChainRecorder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WrkchainId != 0 {
+		n += 1 + sovTx(uint64(m.WrkchainId))
+	}
+	l = len(m.Recorder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgAddWrkChainRecorderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgRegisterWrkChain) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+
+func (m *MsgRemoveWrkChainRecorder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WrkchainId != 0 {
+		n += 1 + sovTx(uint64(m.WrkchainId))
+	}
+	l = len(m.Recorder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveWrkChainRecorderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterWrkChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterWrkChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterWrkChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterWrkChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterWrkChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterWrkChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrkchainId", wireType)
+			}
+			m.WrkchainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WrkchainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecordWrkChainBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecordWrkChainBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecordWrkChainBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrkchainId", wireType)
+			}
+			m.WrkchainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WrkchainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterWrkChain: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterWrkChain: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1273,11 +2276,11 @@ func (m *MsgRegisterWrkChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			m.ParentHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash1", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1305,11 +2308,11 @@ func (m *MsgRegisterWrkChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Hash1 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash2", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1337,11 +2340,11 @@ func (m *MsgRegisterWrkChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenesisHash = string(dAtA[iNdEx:postIndex])
+			m.Hash2 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash3", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1369,9 +2372,9 @@ func (m *MsgRegisterWrkChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseType = string(dAtA[iNdEx:postIndex])
+			m.Hash3 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -1424,7 +2427,7 @@ func (m *MsgRegisterWrkChain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterWrkChainResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRecordWrkChainBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1447,10 +2450,10 @@ func (m *MsgRegisterWrkChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterWrkChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRecordWrkChainBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterWrkChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRecordWrkChainBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1472,6 +2475,25 @@ func (m *MsgRegisterWrkChainResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1493,7 +2515,7 @@ func (m *MsgRegisterWrkChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecordWrkChainBlock) Unmarshal(dAtA []byte) error {
+func (m *MsgPurchaseWrkChainStateStorage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1516,10 +2538,10 @@ func (m *MsgRecordWrkChainBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecordWrkChainBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPurchaseWrkChainStateStorage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecordWrkChainBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPurchaseWrkChainStateStorage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1543,9 +2565,9 @@ func (m *MsgRecordWrkChainBlock) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
 			}
-			m.Height = 0
+			m.Number = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1555,14 +2577,14 @@ func (m *MsgRecordWrkChainBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.Number |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1590,11 +2612,187 @@ func (m *MsgRecordWrkChainBlock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockHash = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPurchaseWrkChainStateStorageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPurchaseWrkChainStateStorageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPurchaseWrkChainStateStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrkchainId", wireType)
+			}
+			m.WrkchainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WrkchainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberPurchased", wireType)
+			}
+			m.NumberPurchased = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberPurchased |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumCanPurchase", wireType)
+			}
+			m.NumCanPurchase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumCanPurchase |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferWrkChainOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferWrkChainOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferWrkChainOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrkchainId", wireType)
+			}
+			m.WrkchainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WrkchainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParentHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1622,11 +2820,11 @@ func (m *MsgRecordWrkChainBlock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ParentHash = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1654,13 +2852,113 @@ func (m *MsgRecordWrkChainBlock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash1 = string(dAtA[iNdEx:postIndex])
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash2", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferWrkChainOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferWrkChainOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferWrkChainOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddWrkChainRecorder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddWrkChainRecorder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddWrkChainRecorder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrkchainId", wireType)
+			}
+			m.WrkchainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1670,27 +2968,14 @@ func (m *MsgRecordWrkChainBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.WrkchainId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Hash2 = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recorder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1718,9 +3003,9 @@ func (m *MsgRecordWrkChainBlock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash3 = string(dAtA[iNdEx:postIndex])
+			m.Recorder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
@@ -1773,7 +3058,7 @@ func (m *MsgRecordWrkChainBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRecordWrkChainBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddWrkChainRecorderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1796,50 +3081,12 @@ func (m *MsgRecordWrkChainBlockResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRecordWrkChainBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddWrkChainRecorderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRecordWrkChainBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddWrkChainRecorderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WrkchainId", wireType)
-			}
-			m.WrkchainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WrkchainId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1861,7 +3108,7 @@ func (m *MsgRecordWrkChainBlockResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPurchaseWrkChainStateStorage) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveWrkChainRecorder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1884,10 +3131,10 @@ func (m *MsgPurchaseWrkChainStateStorage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPurchaseWrkChainStateStorage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveWrkChainRecorder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPurchaseWrkChainStateStorage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveWrkChainRecorder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1910,10 +3157,10 @@ func (m *MsgPurchaseWrkChainStateStorage) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Number", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recorder", wireType)
 			}
-			m.Number = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1923,11 +3170,24 @@ func (m *MsgPurchaseWrkChainStateStorage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Number |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recorder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
@@ -1981,7 +3241,7 @@ func (m *MsgPurchaseWrkChainStateStorage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPurchaseWrkChainStateStorageResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveWrkChainRecorderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2004,69 +3264,12 @@ func (m *MsgPurchaseWrkChainStateStorageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPurchaseWrkChainStateStorageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveWrkChainRecorderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPurchaseWrkChainStateStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveWrkChainRecorderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WrkchainId", wireType)
-			}
-			m.WrkchainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.WrkchainId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumberPurchased", wireType)
-			}
-			m.NumberPurchased = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumberPurchased |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumCanPurchase", wireType)
-			}
-			m.NumCanPurchase = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumCanPurchase |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])